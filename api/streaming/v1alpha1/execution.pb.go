@@ -40,7 +40,14 @@ type LogEntry struct {
 	// message is the log content.
 	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	// fields are structured log fields.
-	Fields        map[string]string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Fields map[string]string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// sequence orders the events of a session, starting at 1. Runners replay
+	// events until they are acknowledged, so each control plane replica skips sequences
+	// of a session it has already processed. Zero disables deduplication.
+	Sequence int64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// session_id identifies the runner process that assigned sequence; a retried
+	// runner pod starts a new session.
+	SessionId     string `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,6 +117,20 @@ func (x *LogEntry) GetFields() map[string]string {
 	return nil
 }
 
+func (x *LogEntry) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LogEntry) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 // StreamLogsResponse is the response after log streaming completes.
 type StreamLogsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -168,7 +189,22 @@ type ProgressReport struct {
 	// message describes the current activity.
 	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	// timestamp is when this progress was reported (RFC3339).
-	Timestamp     string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Timestamp string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// sequence orders the events of a session, starting at 1. Runners replay
+	// events until they are acknowledged, so each control plane replica skips sequences
+	// of a session it has already processed. Zero disables deduplication.
+	Sequence int64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// session_id identifies the runner process that assigned sequence; a retried
+	// runner pod starts a new session.
+	SessionId string `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// completed_resources is the number of resources the executor has processed,
+	// when it reports progress per resource.
+	CompletedResources int32 `protobuf:"varint,8,opt,name=completed_resources,json=completedResources,proto3" json:"completed_resources,omitempty"`
+	// total_resources is the number of resources the executor processes; zero
+	// when the report is not per resource.
+	TotalResources int32 `protobuf:"varint,9,opt,name=total_resources,json=totalResources,proto3" json:"total_resources,omitempty"`
+	// resource identifies the resource processed last, e.g. "instance:my-db".
+	Resource      string `protobuf:"bytes,10,opt,name=resource,proto3" json:"resource,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -238,6 +274,41 @@ func (x *ProgressReport) GetTimestamp() string {
 	return ""
 }
 
+func (x *ProgressReport) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ProgressReport) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ProgressReport) GetCompletedResources() int32 {
+	if x != nil {
+		return x.CompletedResources
+	}
+	return 0
+}
+
+func (x *ProgressReport) GetTotalResources() int32 {
+	if x != nil {
+		return x.TotalResources
+	}
+	return 0
+}
+
+func (x *ProgressReport) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
 // ProgressResponse acknowledges a progress report.
 type ProgressResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -297,7 +368,22 @@ type CompletionReport struct {
 	DurationMs int64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 	// timestamp is when execution completed (RFC3339).
 	// Note: restore_data removed - runners persist directly to ConfigMap.
-	Timestamp     string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Timestamp string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// sequence orders the events of a session, starting at 1. Runners replay
+	// events until they are acknowledged, so each control plane replica skips sequences
+	// of a session it has already processed. Zero disables deduplication.
+	Sequence int64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// session_id identifies the runner process that assigned sequence; a retried
+	// runner pod starts a new session.
+	SessionId string `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// cancelled indicates the runner was terminated before the execution
+	// finished, after persisting the restore data gathered so far. success is
+	// false; a retried runner resumes from that restore data.
+	Cancelled bool `protobuf:"varint,8,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// failure_reason classifies a failed execution: AuthError, Throttled,
+	// ResourceNotFound, Timeout or PermanentAPIError. Empty when success is
+	// true or the failure is unclassified.
+	FailureReason string `protobuf:"bytes,9,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -367,6 +453,34 @@ func (x *CompletionReport) GetTimestamp() string {
 	return ""
 }
 
+func (x *CompletionReport) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CompletionReport) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CompletionReport) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *CompletionReport) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
 // CompletionResponse acknowledges a completion report.
 type CompletionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -527,33 +641,48 @@ var File_api_streaming_v1alpha1_execution_proto protoreflect.FileDescriptor
 
 const file_api_streaming_v1alpha1_execution_proto_rawDesc = "" +
 	"\n" +
-	"&api/streaming/v1alpha1/execution.proto\x12\x13hibernator.v1alpha1\"\xf9\x01\n" +
+	"&api/streaming/v1alpha1/execution.proto\x12\x13hibernator.v1alpha1\"\xb4\x02\n" +
 	"\bLogEntry\x12!\n" +
 	"\fexecution_id\x18\x01 \x01(\tR\vexecutionId\x12\x1c\n" +
 	"\ttimestamp\x18\x02 \x01(\tR\ttimestamp\x12\x14\n" +
 	"\x05level\x18\x03 \x01(\tR\x05level\x12\x18\n" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12A\n" +
-	"\x06fields\x18\x05 \x03(\v2).hibernator.v1alpha1.LogEntry.FieldsEntryR\x06fields\x1a9\n" +
+	"\x06fields\x18\x05 \x03(\v2).hibernator.v1alpha1.LogEntry.FieldsEntryR\x06fields\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x03R\bsequence\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x1a9\n" +
 	"\vFieldsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
 	"\x12StreamLogsResponse\x12%\n" +
-	"\x0ereceived_count\x18\x01 \x01(\x03R\rreceivedCount\"\xac\x01\n" +
+	"\x0ereceived_count\x18\x01 \x01(\x03R\rreceivedCount\"\xdd\x02\n" +
 	"\x0eProgressReport\x12!\n" +
 	"\fexecution_id\x18\x01 \x01(\tR\vexecutionId\x12\x14\n" +
 	"\x05phase\x18\x02 \x01(\tR\x05phase\x12)\n" +
 	"\x10progress_percent\x18\x03 \x01(\x05R\x0fprogressPercent\x12\x18\n" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1c\n" +
-	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\"6\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x03R\bsequence\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x12/\n" +
+	"\x13completed_resources\x18\b \x01(\x05R\x12completedResources\x12'\n" +
+	"\x0ftotal_resources\x18\t \x01(\x05R\x0etotalResources\x12\x1a\n" +
+	"\bresource\x18\n" +
+	" \x01(\tR\bresource\"6\n" +
 	"\x10ProgressResponse\x12\"\n" +
-	"\facknowledged\x18\x01 \x01(\bR\facknowledged\"\xb3\x01\n" +
+	"\facknowledged\x18\x01 \x01(\bR\facknowledged\"\xb3\x02\n" +
 	"\x10CompletionReport\x12!\n" +
 	"\fexecution_id\x18\x01 \x01(\tR\vexecutionId\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
 	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12\x1f\n" +
 	"\vduration_ms\x18\x04 \x01(\x03R\n" +
 	"durationMs\x12\x1c\n" +
-	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\"8\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x03R\bsequence\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x12\x1c\n" +
+	"\tcancelled\x18\b \x01(\bR\tcancelled\x12%\n" +
+	"\x0efailure_reason\x18\t \x01(\tR\rfailureReason\"8\n" +
 	"\x12CompletionResponse\x12\"\n" +
 	"\facknowledged\x18\x01 \x01(\bR\facknowledged\"S\n" +
 	"\x10HeartbeatRequest\x12!\n" +
@@ -10,11 +10,12 @@ import (
 )
 
 // CloudProviderType defines supported cloud providers.
-// +kubebuilder:validation:Enum=aws
+// +kubebuilder:validation:Enum=aws;gcp
 type CloudProviderType string
 
 const (
 	CloudProviderAWS CloudProviderType = "aws"
+	CloudProviderGCP CloudProviderType = "gcp"
 )
 
 // AWSAuth defines AWS authentication configuration.
@@ -26,6 +27,35 @@ type AWSAuth struct {
 	// Static configures static credential-based authentication.
 	// +optional
 	Static *StaticAuth `json:"static,omitempty"`
+
+	// RoleChain lists IAM roles assumed in order, starting from the ServiceAccount or
+	// static credentials, each hop using the credentials of the previous one. Use it
+	// when runners must pass through intermediate accounts, such as a central security
+	// account, before reaching the target account. AssumeRoleArn, when set, is assumed
+	// after the last role of the chain.
+	// +optional
+	// +kubebuilder:validation:MaxItems=5
+	RoleChain []AssumeRoleStep `json:"roleChain,omitempty"`
+}
+
+// AssumeRoleStep is one hop of an AWS role chain.
+type AssumeRoleStep struct {
+	// RoleArn is the IAM role to assume.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	RoleArn string `json:"roleArn"`
+
+	// ExternalID is passed to AssumeRole when the role's trust policy requires one.
+	// +optional
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=1224
+	ExternalID string `json:"externalId,omitempty"`
+
+	// SessionTags are attached to the role session, for trust and permission policies
+	// that condition on aws:PrincipalTag or aws:RequestTag.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=50
+	SessionTags map[string]string `json:"sessionTags,omitempty"`
 }
 
 // ServiceAccountAuth configures IRSA (IAM Roles for Service Accounts).
@@ -55,6 +85,48 @@ type SecretReference struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// ConnectorNetwork configures how runners reach the APIs behind a connector, for
+// clusters whose egress goes through a proxy.
+type ConnectorNetwork struct {
+	// Proxy routes API calls through an HTTP or HTTPS proxy.
+	// +optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+
+	// CABundleRef references PEM certificates trusted in addition to the system
+	// roots, such as the CA of a TLS-intercepting proxy.
+	// +optional
+	CABundleRef *CABundleRef `json:"caBundleRef,omitempty"`
+}
+
+// ProxyConfig configures an HTTP or HTTPS proxy.
+type ProxyConfig struct {
+	// URL of the proxy, e.g. http://proxy.corp.example:3128.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	URL string `json:"url"`
+
+	// NoProxy lists hosts, domains (".corp.example") and CIDRs reached without
+	// the proxy.
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
+}
+
+// CABundleRef references a key of a ConfigMap holding PEM certificates.
+type CABundleRef struct {
+	// Name is the name of the ConfigMap.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the ConfigMap.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Key is the ConfigMap key holding the certificates.
+	// +optional
+	// +kubebuilder:default=ca.crt
+	Key string `json:"key,omitempty"`
+}
+
 // AWSConfig holds AWS-specific configuration.
 type AWSConfig struct {
 	// AccountId is the AWS account ID.
@@ -78,6 +150,51 @@ type AWSConfig struct {
 	Auth AWSAuth `json:"auth"`
 }
 
+// GCPAuth defines GCP authentication configuration.
+type GCPAuth struct {
+	// ServiceAccount uses the Application Default Credentials of the runner pod,
+	// such as GKE Workload Identity bound to the runner ServiceAccount.
+	// +optional
+	ServiceAccount *ServiceAccountAuth `json:"serviceAccount,omitempty"`
+
+	// WorkloadIdentityFederation exchanges a projected token of the runner
+	// ServiceAccount for Google credentials through a workload identity pool, so
+	// runners outside GKE (e.g. on EKS) need no service account key.
+	// +optional
+	WorkloadIdentityFederation *GCPWorkloadIdentityFederation `json:"workloadIdentityFederation,omitempty"`
+
+	// ImpersonationChain lists Google service accounts impersonated in order,
+	// starting from the ServiceAccount or federated credentials. The last entry is
+	// the identity the runner acts as; the earlier ones are delegates, each of which
+	// must be allowed to create tokens for the next.
+	// +optional
+	// +kubebuilder:validation:MaxItems=5
+	// +kubebuilder:validation:items:Pattern=`^[a-z][a-z0-9-]{4,28}[a-z0-9]@[a-z0-9.-]+\.iam\.gserviceaccount\.com$`
+	ImpersonationChain []string `json:"impersonationChain,omitempty"`
+}
+
+// GCPWorkloadIdentityFederation configures Workload Identity Federation.
+type GCPWorkloadIdentityFederation struct {
+	// Audience is the full resource name of the workload identity pool provider,
+	// //iam.googleapis.com/projects/<number>/locations/global/workloadIdentityPools/<pool>/providers/<provider>.
+	// Runner pods receive a ServiceAccount token issued for this audience.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^//iam\.googleapis\.com/projects/[0-9]+/locations/global/workloadIdentityPools/[^/]+/providers/[^/]+$`
+	Audience string `json:"audience"`
+}
+
+// GCPConfig holds GCP-specific configuration.
+type GCPConfig struct {
+	// ProjectID is the GCP project.
+	// +kubebuilder:validation:Required
+	ProjectID string `json:"projectId"`
+
+	// Auth configures authentication method.
+	// Exactly one of Auth.ServiceAccount or Auth.WorkloadIdentityFederation must be specified.
+	// +kubebuilder:validation:Required
+	Auth GCPAuth `json:"auth"`
+}
+
 // CloudProviderSpec defines the desired state of CloudProvider.
 type CloudProviderSpec struct {
 	// Type of cloud provider.
@@ -87,6 +204,14 @@ type CloudProviderSpec struct {
 	// AWS holds AWS-specific configuration (required when Type=aws).
 	// +optional
 	AWS *AWSConfig `json:"aws,omitempty"`
+
+	// GCP holds GCP-specific configuration (required when Type=gcp).
+	// +optional
+	GCP *GCPConfig `json:"gcp,omitempty"`
+
+	// Network configures the proxy and CA bundle used to reach the provider's APIs.
+	// +optional
+	Network *ConnectorNetwork `json:"network,omitempty"`
 }
 
 // CloudProviderStatus defines the observed state of CloudProvider.
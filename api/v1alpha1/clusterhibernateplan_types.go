@@ -0,0 +1,112 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterHibernatePlanSpec defines a HibernatePlan template and the namespaces
+// it is applied to.
+type ClusterHibernatePlanSpec struct {
+	// NamespaceSelector selects the namespaces a HibernatePlan is generated in.
+	// An empty selector selects every namespace.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// Template is the HibernatePlan generated in each selected namespace.
+	Template HibernatePlanTemplate `json:"template"`
+}
+
+// HibernatePlanTemplate describes the HibernatePlans generated by a
+// ClusterHibernatePlan.
+type HibernatePlanTemplate struct {
+	// Metadata holds labels and annotations added to every generated plan.
+	// +optional
+	Metadata PlanTemplateMetadata `json:"metadata,omitempty"`
+
+	// Spec is the spec of every generated plan. Connector references without a
+	// namespace resolve to the namespace of the generated plan.
+	Spec HibernatePlanSpec `json:"spec"`
+}
+
+// PlanTemplateMetadata is the metadata copied to generated HibernatePlans.
+type PlanTemplateMetadata struct {
+	// Labels added to every generated plan.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to every generated plan.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ClusterPlanMember is a HibernatePlan generated by a ClusterHibernatePlan.
+type ClusterPlanMember struct {
+	// Namespace of the generated plan.
+	Namespace string `json:"namespace"`
+
+	// Name of the generated plan.
+	Name string `json:"name"`
+
+	// Phase is the generated plan's phase.
+	// +optional
+	Phase PlanPhase `json:"phase,omitempty"`
+
+	// Message explains why the plan could not be generated or updated.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterHibernatePlanStatus defines the observed state of ClusterHibernatePlan.
+type ClusterHibernatePlanStatus struct {
+	// ObservedGeneration is the last generation applied to the generated plans.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// SelectedNamespaces is the number of namespaces matching the selector.
+	// +optional
+	SelectedNamespaces int32 `json:"selectedNamespaces,omitempty"`
+
+	// Plans lists the generated plans, ordered by namespace.
+	// +optional
+	Plans []ClusterPlanMember `json:"plans,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=chplan
+// +kubebuilder:printcolumn:name="Namespaces",type=integer,JSONPath=`.status.selectedNamespaces`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterHibernatePlan defines a schedule and targets once and generates a
+// HibernatePlan with the same name in every namespace matching its selector.
+// Generated plans are owned by the ClusterHibernatePlan: they are updated when
+// the template changes and deleted when their namespace stops matching.
+type ClusterHibernatePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of ClusterHibernatePlan.
+	Spec ClusterHibernatePlanSpec `json:"spec,omitempty"`
+
+	// Status defines the observed state of ClusterHibernatePlan.
+	Status ClusterHibernatePlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterHibernatePlanList contains a list of ClusterHibernatePlan.
+type ClusterHibernatePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ClusterHibernatePlan resources.
+	Items []ClusterHibernatePlan `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterHibernatePlan{}, &ClusterHibernatePlanList{})
+}
@@ -0,0 +1,733 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package v1alpha1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/ardikabs/hibernator/api/v1beta1"
+)
+
+// HibernatePlan and ScheduleException are converted to and from v1beta1, the
+// storage version. Both versions carry the same information; v1beta1 only
+// renames a handful of fields, so conversion is lossless in both directions.
+
+var (
+	_ conversion.Convertible = &HibernatePlan{}
+	_ conversion.Convertible = &ScheduleException{}
+)
+
+// ConvertTo converts this HibernatePlan to the hub version (v1beta1).
+func (src *HibernatePlan) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.HibernatePlan)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = planSpecToHub(src.Spec)
+	dst.Status = planStatusToHub(src.Status)
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this HibernatePlan.
+func (dst *HibernatePlan) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.HibernatePlan)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = planSpecFromHub(src.Spec)
+	dst.Status = planStatusFromHub(src.Status)
+	return nil
+}
+
+// ConvertTo converts this ScheduleException to the hub version (v1beta1).
+func (src *ScheduleException) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.ScheduleException)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1beta1.ScheduleExceptionSpec{
+		PlanRef:          planRefToHub(src.Spec.PlanRef),
+		PlanRefs:         convertSlice(src.Spec.PlanRefs, planRefToHub),
+		PlanSelector:     src.Spec.PlanSelector,
+		ValidFrom:        src.Spec.ValidFrom,
+		ValidUntil:       src.Spec.ValidUntil,
+		Type:             v1beta1.ExceptionType(src.Spec.Type),
+		LeadTime:         src.Spec.LeadTime,
+		Windows:          convertSlice(src.Spec.Windows, offHourWindowToHub),
+		TargetOverrides:  convertSlice(src.Spec.TargetOverrides, targetOverrideToHub),
+		RequiresApproval: src.Spec.RequiresApproval,
+		Recurrence:       recurrenceToHub(src.Spec.Recurrence),
+		TTLAfterExpiry:   src.Spec.TTLAfterExpiry,
+	}
+	if o := src.Spec.ExecutionOverride; o != nil {
+		dst.Spec.ExecutionOverride = &v1beta1.ExecutionOverride{}
+		if o.Strategy != nil {
+			strategy := strategyToHub(*o.Strategy)
+			dst.Spec.ExecutionOverride.Strategy = &strategy
+		}
+		if o.Behavior != nil {
+			behavior := behaviorToHub(*o.Behavior)
+			dst.Spec.ExecutionOverride.Behavior = &behavior
+		}
+	}
+	dst.Status = v1beta1.ScheduleExceptionStatus{
+		State:      v1beta1.ExceptionState(src.Status.State),
+		AppliedAt:  src.Status.AppliedAt,
+		ExpiredAt:  src.Status.ExpiredAt,
+		DetachedAt: src.Status.DetachedAt,
+		Message:    src.Status.Message,
+		Approvals:  convertSlice(src.Status.Approvals, approvalToHub),
+		Occurrence: (*v1beta1.ExceptionOccurrence)(src.Status.Occurrence),
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ScheduleException.
+func (dst *ScheduleException) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.ScheduleException)
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = ScheduleExceptionSpec{
+		PlanRef:          planRefFromHub(src.Spec.PlanRef),
+		PlanRefs:         convertSlice(src.Spec.PlanRefs, planRefFromHub),
+		PlanSelector:     src.Spec.PlanSelector,
+		ValidFrom:        src.Spec.ValidFrom,
+		ValidUntil:       src.Spec.ValidUntil,
+		Type:             ExceptionType(src.Spec.Type),
+		LeadTime:         src.Spec.LeadTime,
+		Windows:          convertSlice(src.Spec.Windows, offHourWindowFromHub),
+		TargetOverrides:  convertSlice(src.Spec.TargetOverrides, targetOverrideFromHub),
+		RequiresApproval: src.Spec.RequiresApproval,
+		Recurrence:       recurrenceFromHub(src.Spec.Recurrence),
+		TTLAfterExpiry:   src.Spec.TTLAfterExpiry,
+	}
+	if o := src.Spec.ExecutionOverride; o != nil {
+		dst.Spec.ExecutionOverride = &ExecutionOverride{}
+		if o.Strategy != nil {
+			strategy := strategyFromHub(*o.Strategy)
+			dst.Spec.ExecutionOverride.Strategy = &strategy
+		}
+		if o.Behavior != nil {
+			behavior := behaviorFromHub(*o.Behavior)
+			dst.Spec.ExecutionOverride.Behavior = &behavior
+		}
+	}
+	dst.Status = ScheduleExceptionStatus{
+		State:      ExceptionState(src.Status.State),
+		AppliedAt:  src.Status.AppliedAt,
+		ExpiredAt:  src.Status.ExpiredAt,
+		DetachedAt: src.Status.DetachedAt,
+		Message:    src.Status.Message,
+		Approvals:  convertSlice(src.Status.Approvals, approvalFromHub),
+		Occurrence: (*ExceptionOccurrence)(src.Status.Occurrence),
+	}
+	return nil
+}
+
+// convertSlice maps in with fn, preserving nil.
+func convertSlice[T, U any](in []T, fn func(T) U) []U {
+	if in == nil {
+		return nil
+	}
+	out := make([]U, len(in))
+	for i := range in {
+		out[i] = fn(in[i])
+	}
+	return out
+}
+
+func planSpecToHub(in HibernatePlanSpec) v1beta1.HibernatePlanSpec {
+	out := v1beta1.HibernatePlanSpec{
+		Schedule: v1beta1.Schedule{
+			Timezone:           in.Schedule.Timezone,
+			DSTPolicy:          v1beta1.DSTPolicy(in.Schedule.DSTPolicy),
+			OffHours:           convertSlice(in.Schedule.OffHours, offHourWindowToHub),
+			Dates:              convertSlice(in.Schedule.Dates, dateWindowToHub),
+			Jitter:             in.Schedule.Jitter,
+			MinimumHibernation: in.Schedule.MinimumHibernation,
+			BlackoutWindows:    convertSlice(in.Schedule.BlackoutWindows, blackoutWindowToHub),
+		},
+		Execution:     executionToHub(in.Execution),
+		Behavior:      behaviorToHub(in.Behavior),
+		Suspend:       in.Suspend,
+		SuspendPolicy: v1beta1.SuspendPolicy(in.SuspendPolicy),
+		Targets:       convertSlice(in.Targets, targetToHub),
+		TargetsFrom:   convertSlice(in.TargetsFrom, func(r TargetPresetReference) v1beta1.TargetPresetReference { return v1beta1.TargetPresetReference(r) }),
+	}
+	if v := in.WakeVerification; v != nil {
+		out.WakeVerification = &v1beta1.WakeVerification{JobTemplateRef: v1beta1.JobTemplateReference(v.JobTemplateRef)}
+	}
+	if h := in.ExecutionHistory; h != nil {
+		out.ExecutionHistory = (*v1beta1.ExecutionHistorySpec)(h)
+	}
+	if r := in.Restore; r != nil {
+		out.Restore = &v1beta1.RestoreSpec{History: r.History}
+		if st := r.Storage; st != nil {
+			out.Restore.Storage = &v1beta1.RestoreStorage{
+				Type: v1beta1.RestoreStorageType(st.Type),
+				S3:   (*v1beta1.S3RestoreStorage)(st.S3),
+				GCS:  (*v1beta1.GCSRestoreStorage)(st.GCS),
+			}
+			if st.Encryption != nil {
+				out.Restore.Storage.Encryption = &v1beta1.RestoreEncryption{
+					KeySecretRef: v1beta1.ObjectKeyReference(st.Encryption.KeySecretRef),
+					PreviousKeys: st.Encryption.PreviousKeys,
+				}
+			}
+		}
+	}
+	return out
+}
+
+func planSpecFromHub(in v1beta1.HibernatePlanSpec) HibernatePlanSpec {
+	out := HibernatePlanSpec{
+		Schedule: Schedule{
+			Timezone:           in.Schedule.Timezone,
+			DSTPolicy:          DSTPolicy(in.Schedule.DSTPolicy),
+			OffHours:           convertSlice(in.Schedule.OffHours, offHourWindowFromHub),
+			Dates:              convertSlice(in.Schedule.Dates, dateWindowFromHub),
+			Jitter:             in.Schedule.Jitter,
+			MinimumHibernation: in.Schedule.MinimumHibernation,
+			BlackoutWindows:    convertSlice(in.Schedule.BlackoutWindows, blackoutWindowFromHub),
+		},
+		Execution:     executionFromHub(in.Execution),
+		Behavior:      behaviorFromHub(in.Behavior),
+		Suspend:       in.Suspend,
+		SuspendPolicy: SuspendPolicy(in.SuspendPolicy),
+		Targets:       convertSlice(in.Targets, targetFromHub),
+		TargetsFrom:   convertSlice(in.TargetsFrom, func(r v1beta1.TargetPresetReference) TargetPresetReference { return TargetPresetReference(r) }),
+	}
+	if v := in.WakeVerification; v != nil {
+		out.WakeVerification = &WakeVerification{JobTemplateRef: JobTemplateReference(v.JobTemplateRef)}
+	}
+	if h := in.ExecutionHistory; h != nil {
+		out.ExecutionHistory = (*ExecutionHistorySpec)(h)
+	}
+	if r := in.Restore; r != nil {
+		out.Restore = &RestoreSpec{History: r.History}
+		if st := r.Storage; st != nil {
+			out.Restore.Storage = &RestoreStorage{
+				Type: RestoreStorageType(st.Type),
+				S3:   (*S3RestoreStorage)(st.S3),
+				GCS:  (*GCSRestoreStorage)(st.GCS),
+			}
+			if st.Encryption != nil {
+				out.Restore.Storage.Encryption = &RestoreEncryption{
+					KeySecretRef: ObjectKeyReference(st.Encryption.KeySecretRef),
+					PreviousKeys: st.Encryption.PreviousKeys,
+				}
+			}
+		}
+	}
+	return out
+}
+
+func planStatusToHub(in HibernatePlanStatus) v1beta1.HibernatePlanStatus {
+	out := v1beta1.HibernatePlanStatus{
+		CurrentCycleID:           in.CurrentCycleID,
+		Phase:                    v1beta1.PlanPhase(in.Phase),
+		LastTransitionTime:       in.LastTransitionTime,
+		Executions:               convertSlice(in.Executions, executionStatusToHub),
+		ObservedGeneration:       in.ObservedGeneration,
+		PendingGeneration:        in.PendingGeneration,
+		RetryCount:               in.RetryCount,
+		LastRetryTime:            in.LastRetryTime,
+		ErrorMessage:             in.ErrorMessage,
+		ExceptionReferences:      convertSlice(in.ExceptionReferences, exceptionReferenceToHub),
+		AppliedExceptionOverride: in.AppliedExceptionOverride,
+		CurrentStageIndex:        in.CurrentStageIndex,
+		CurrentOperation:         v1beta1.PlanOperation(in.CurrentOperation),
+		NextHibernateTime:        in.NextHibernateTime,
+		NextWakeUpTime:           in.NextWakeUpTime,
+		PreWake:                  (*v1beta1.PreWakeStatus)(in.PreWake),
+		LastReassertTime:         in.LastReassertTime,
+		ExecutionHistory:         convertSlice(in.ExecutionHistory, executionCycleToHub),
+		Conditions:               in.Conditions,
+	}
+	if s := in.ExecutionSummary; s != nil {
+		out.ExecutionSummary = &v1beta1.ExecutionSummary{
+			CycleID:   s.CycleID,
+			Operation: v1beta1.PlanOperation(s.Operation),
+			Total:     s.Total,
+			Pending:   s.Pending,
+			Running:   s.Running,
+			Completed: s.Completed,
+			Failed:    s.Failed,
+			Aborted:   s.Aborted,
+			Cancelled: s.Cancelled,
+		}
+	}
+	if s := in.PlanSnapshot; s != nil {
+		out.PlanSnapshot = &v1beta1.PlanSnapshot{
+			CycleID:       s.CycleID,
+			ExceptionName: s.ExceptionName,
+			Targets:       convertSlice(s.Targets, targetToHub),
+			Execution:     executionToHub(s.Execution),
+			Behavior:      behaviorToHub(s.Behavior),
+		}
+	}
+	return out
+}
+
+func planStatusFromHub(in v1beta1.HibernatePlanStatus) HibernatePlanStatus {
+	out := HibernatePlanStatus{
+		CurrentCycleID:           in.CurrentCycleID,
+		Phase:                    PlanPhase(in.Phase),
+		LastTransitionTime:       in.LastTransitionTime,
+		Executions:               convertSlice(in.Executions, executionStatusFromHub),
+		ObservedGeneration:       in.ObservedGeneration,
+		PendingGeneration:        in.PendingGeneration,
+		RetryCount:               in.RetryCount,
+		LastRetryTime:            in.LastRetryTime,
+		ErrorMessage:             in.ErrorMessage,
+		ExceptionReferences:      convertSlice(in.ExceptionReferences, exceptionReferenceFromHub),
+		AppliedExceptionOverride: in.AppliedExceptionOverride,
+		CurrentStageIndex:        in.CurrentStageIndex,
+		CurrentOperation:         PlanOperation(in.CurrentOperation),
+		NextHibernateTime:        in.NextHibernateTime,
+		NextWakeUpTime:           in.NextWakeUpTime,
+		PreWake:                  (*PreWakeStatus)(in.PreWake),
+		LastReassertTime:         in.LastReassertTime,
+		ExecutionHistory:         convertSlice(in.ExecutionHistory, executionCycleFromHub),
+		Conditions:               in.Conditions,
+	}
+	if s := in.ExecutionSummary; s != nil {
+		out.ExecutionSummary = &ExecutionSummary{
+			CycleID:   s.CycleID,
+			Operation: PlanOperation(s.Operation),
+			Total:     s.Total,
+			Pending:   s.Pending,
+			Running:   s.Running,
+			Completed: s.Completed,
+			Failed:    s.Failed,
+			Aborted:   s.Aborted,
+			Cancelled: s.Cancelled,
+		}
+	}
+	if s := in.PlanSnapshot; s != nil {
+		out.PlanSnapshot = &PlanSnapshot{
+			CycleID:       s.CycleID,
+			ExceptionName: s.ExceptionName,
+			Targets:       convertSlice(s.Targets, targetFromHub),
+			Execution:     executionFromHub(s.Execution),
+			Behavior:      behaviorFromHub(s.Behavior),
+		}
+	}
+	return out
+}
+
+func offHourWindowToHub(in OffHourWindow) v1beta1.OffHourWindow {
+	return v1beta1.OffHourWindow(in)
+}
+
+func offHourWindowFromHub(in v1beta1.OffHourWindow) OffHourWindow {
+	return OffHourWindow(in)
+}
+
+func dateWindowToHub(in DateWindow) v1beta1.DateWindow {
+	return v1beta1.DateWindow(in)
+}
+
+func dateWindowFromHub(in v1beta1.DateWindow) DateWindow {
+	return DateWindow(in)
+}
+
+func blackoutWindowToHub(in BlackoutWindow) v1beta1.BlackoutWindow {
+	return v1beta1.BlackoutWindow(in)
+}
+
+func blackoutWindowFromHub(in v1beta1.BlackoutWindow) BlackoutWindow {
+	return BlackoutWindow(in)
+}
+
+func approvalToHub(in ExceptionApproval) v1beta1.ExceptionApproval {
+	return v1beta1.ExceptionApproval(in)
+}
+
+func approvalFromHub(in v1beta1.ExceptionApproval) ExceptionApproval {
+	return ExceptionApproval(in)
+}
+
+func planRefToHub(in PlanReference) v1beta1.PlanReference {
+	return v1beta1.PlanReference(in)
+}
+
+func planRefFromHub(in v1beta1.PlanReference) PlanReference {
+	return PlanReference(in)
+}
+
+func recurrenceToHub(in *ExceptionRecurrence) *v1beta1.ExceptionRecurrence {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.ExceptionRecurrence{
+		Frequency:  v1beta1.RecurrenceFrequency(in.Frequency),
+		Interval:   in.Interval,
+		ByDay:      in.ByDay,
+		ByMonthDay: in.ByMonthDay,
+		Duration:   in.Duration,
+		Timezone:   in.Timezone,
+	}
+}
+
+func recurrenceFromHub(in *v1beta1.ExceptionRecurrence) *ExceptionRecurrence {
+	if in == nil {
+		return nil
+	}
+	return &ExceptionRecurrence{
+		Frequency:  RecurrenceFrequency(in.Frequency),
+		Interval:   in.Interval,
+		ByDay:      in.ByDay,
+		ByMonthDay: in.ByMonthDay,
+		Duration:   in.Duration,
+		Timezone:   in.Timezone,
+	}
+}
+
+func executionToHub(in Execution) v1beta1.Execution {
+	return v1beta1.Execution{
+		Strategy:          strategyToHub(in.Strategy),
+		RunnerPodTemplate: (*v1beta1.RunnerPodTemplate)(in.RunnerPodTemplate),
+		JobPolicy:         (*v1beta1.JobPolicy)(in.JobPolicy),
+		RunnerCluster:     (*v1beta1.RunnerCluster)(in.RunnerCluster),
+	}
+}
+
+func executionFromHub(in v1beta1.Execution) Execution {
+	return Execution{
+		Strategy:          strategyFromHub(in.Strategy),
+		RunnerPodTemplate: (*RunnerPodTemplate)(in.RunnerPodTemplate),
+		JobPolicy:         (*JobPolicy)(in.JobPolicy),
+		RunnerCluster:     (*RunnerCluster)(in.RunnerCluster),
+	}
+}
+
+func strategyToHub(in ExecutionStrategy) v1beta1.ExecutionStrategy {
+	return v1beta1.ExecutionStrategy{
+		Type:           v1beta1.ExecutionStrategyType(in.Type),
+		MaxConcurrency: in.MaxConcurrency,
+		Dependencies:   convertSlice(in.Dependencies, func(d Dependency) v1beta1.Dependency { return v1beta1.Dependency(d) }),
+		Stages:         convertSlice(in.Stages, stageToHub),
+	}
+}
+
+func strategyFromHub(in v1beta1.ExecutionStrategy) ExecutionStrategy {
+	return ExecutionStrategy{
+		Type:           ExecutionStrategyType(in.Type),
+		MaxConcurrency: in.MaxConcurrency,
+		Dependencies:   convertSlice(in.Dependencies, func(d v1beta1.Dependency) Dependency { return Dependency(d) }),
+		Stages:         convertSlice(in.Stages, stageFromHub),
+	}
+}
+
+func stageToHub(in Stage) v1beta1.Stage {
+	return v1beta1.Stage{
+		Name:           in.Name,
+		Parallel:       in.Parallel,
+		MaxConcurrency: in.MaxConcurrency,
+		Targets:        in.Targets,
+		PostDelay:      in.PostDelay,
+		ReadinessProbe: readinessProbeToHub(in.ReadinessProbe),
+	}
+}
+
+func stageFromHub(in v1beta1.Stage) Stage {
+	return Stage{
+		Name:           in.Name,
+		Parallel:       in.Parallel,
+		MaxConcurrency: in.MaxConcurrency,
+		Targets:        in.Targets,
+		PostDelay:      in.PostDelay,
+		ReadinessProbe: readinessProbeFromHub(in.ReadinessProbe),
+	}
+}
+
+func readinessProbeToHub(in *StageReadinessProbe) *v1beta1.StageReadinessProbe {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.StageReadinessProbe{
+		HTTP:     (*v1beta1.HTTPReadinessCheck)(in.HTTP),
+		TCP:      (*v1beta1.TCPReadinessCheck)(in.TCP),
+		Resource: (*v1beta1.ResourceReadinessCheck)(in.Resource),
+		Timeout:  in.Timeout,
+	}
+}
+
+func readinessProbeFromHub(in *v1beta1.StageReadinessProbe) *StageReadinessProbe {
+	if in == nil {
+		return nil
+	}
+	return &StageReadinessProbe{
+		HTTP:     (*HTTPReadinessCheck)(in.HTTP),
+		TCP:      (*TCPReadinessCheck)(in.TCP),
+		Resource: (*ResourceReadinessCheck)(in.Resource),
+		Timeout:  in.Timeout,
+	}
+}
+
+func behaviorToHub(in Behavior) v1beta1.Behavior {
+	return v1beta1.Behavior{
+		Mode:                        v1beta1.BehaviorMode(in.Mode),
+		FailFast:                    in.FailFast,
+		Retries:                     in.Retries,
+		RetryPolicy:                 retryPolicyToHub(in.RetryPolicy),
+		AutoRecoverOnScheduleChange: in.AutoRecoverOnScheduleChange,
+		MaxCycleDuration:            in.MaxCycleDuration,
+		OnCycleTimeout:              v1beta1.CycleTimeoutPolicy(in.OnCycleTimeout),
+		MaxResourcesPerTarget:       in.MaxResourcesPerTarget,
+		ReassertInterval:            in.ReassertInterval,
+	}
+}
+
+func retryPolicyToHub(in *RetryPolicy) *v1beta1.RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.RetryPolicy{
+		MaxRetries:        in.MaxRetries,
+		InitialBackoff:    in.InitialBackoff,
+		MaxBackoff:        in.MaxBackoff,
+		BackoffMultiplier: in.BackoffMultiplier,
+		RetryOn:           convertSlice(in.RetryOn, func(c RetryClass) v1beta1.RetryClass { return v1beta1.RetryClass(c) }),
+	}
+}
+
+func behaviorFromHub(in v1beta1.Behavior) Behavior {
+	return Behavior{
+		Mode:                        BehaviorMode(in.Mode),
+		FailFast:                    in.FailFast,
+		Retries:                     in.Retries,
+		RetryPolicy:                 retryPolicyFromHub(in.RetryPolicy),
+		AutoRecoverOnScheduleChange: in.AutoRecoverOnScheduleChange,
+		MaxCycleDuration:            in.MaxCycleDuration,
+		OnCycleTimeout:              CycleTimeoutPolicy(in.OnCycleTimeout),
+		MaxResourcesPerTarget:       in.MaxResourcesPerTarget,
+		ReassertInterval:            in.ReassertInterval,
+	}
+}
+
+func retryPolicyFromHub(in *v1beta1.RetryPolicy) *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	return &RetryPolicy{
+		MaxRetries:        in.MaxRetries,
+		InitialBackoff:    in.InitialBackoff,
+		MaxBackoff:        in.MaxBackoff,
+		BackoffMultiplier: in.BackoffMultiplier,
+		RetryOn:           convertSlice(in.RetryOn, func(c v1beta1.RetryClass) RetryClass { return RetryClass(c) }),
+	}
+}
+
+func targetToHub(in Target) v1beta1.Target {
+	return v1beta1.Target{
+		Name:                 in.Name,
+		Type:                 in.Type,
+		ConnectorRef:         v1beta1.ConnectorRef(in.ConnectorRef),
+		Parameters:           (*v1beta1.Parameters)(in.Parameters),
+		RunnerImage:          in.RunnerImage,
+		RunnerServiceAccount: in.RunnerServiceAccount,
+		Priority:             in.Priority,
+		PreWake:              preWakeHookToHub(in.PreWake),
+	}
+}
+
+func targetFromHub(in v1beta1.Target) Target {
+	return Target{
+		Name:                 in.Name,
+		Type:                 in.Type,
+		ConnectorRef:         ConnectorRef(in.ConnectorRef),
+		Parameters:           (*Parameters)(in.Parameters),
+		RunnerImage:          in.RunnerImage,
+		RunnerServiceAccount: in.RunnerServiceAccount,
+		Priority:             in.Priority,
+		PreWake:              preWakeHookFromHub(in.PreWake),
+	}
+}
+
+func preWakeHookToHub(in *PreWakeHook) *v1beta1.PreWakeHook {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.PreWakeHook{LeadTime: in.LeadTime, Parameters: (*v1beta1.Parameters)(in.Parameters)}
+}
+
+func preWakeHookFromHub(in *v1beta1.PreWakeHook) *PreWakeHook {
+	if in == nil {
+		return nil
+	}
+	return &PreWakeHook{LeadTime: in.LeadTime, Parameters: (*Parameters)(in.Parameters)}
+}
+
+func targetOverrideToHub(in TargetOverride) v1beta1.TargetOverride {
+	return v1beta1.TargetOverride{
+		Target:     in.TargetName,
+		Parameters: (*v1beta1.Parameters)(in.Parameters),
+		Disabled:   in.Disabled,
+	}
+}
+
+func targetOverrideFromHub(in v1beta1.TargetOverride) TargetOverride {
+	return TargetOverride{
+		TargetName: in.Target,
+		Parameters: (*Parameters)(in.Parameters),
+		Disabled:   in.Disabled,
+	}
+}
+
+func executionStatusToHub(in ExecutionStatus) v1beta1.ExecutionStatus {
+	return v1beta1.ExecutionStatus{
+		Target:              in.Target,
+		Executor:            in.Executor,
+		State:               v1beta1.ExecutionState(in.State),
+		StartedAt:           in.StartedAt,
+		FinishedAt:          in.FinishedAt,
+		Attempts:            in.Attempts,
+		Message:             in.Message,
+		FailureReason:       v1beta1.FailureReason(in.FailureReason),
+		JobRef:              in.JobRef,
+		LogsRef:             in.LogsRef,
+		RestoreRef:          in.RestoreRef,
+		ServiceAccountRef:   in.ServiceAccountRef,
+		ConnectorSecretRef:  in.ConnectorSecretRef,
+		RestoreConfigMapRef: in.RestoreConfigMapRef,
+		Stale:               in.Stale,
+		Progress:            (*v1beta1.ExecutionProgress)(in.Progress),
+	}
+}
+
+func executionStatusFromHub(in v1beta1.ExecutionStatus) ExecutionStatus {
+	return ExecutionStatus{
+		Target:              in.Target,
+		Executor:            in.Executor,
+		State:               ExecutionState(in.State),
+		StartedAt:           in.StartedAt,
+		FinishedAt:          in.FinishedAt,
+		Attempts:            in.Attempts,
+		Message:             in.Message,
+		FailureReason:       FailureReason(in.FailureReason),
+		JobRef:              in.JobRef,
+		LogsRef:             in.LogsRef,
+		RestoreRef:          in.RestoreRef,
+		ServiceAccountRef:   in.ServiceAccountRef,
+		ConnectorSecretRef:  in.ConnectorSecretRef,
+		RestoreConfigMapRef: in.RestoreConfigMapRef,
+		Stale:               in.Stale,
+		Progress:            (*ExecutionProgress)(in.Progress),
+	}
+}
+
+func exceptionReferenceToHub(in ExceptionReference) v1beta1.ExceptionReference {
+	return v1beta1.ExceptionReference{
+		Name:       in.Name,
+		Type:       v1beta1.ExceptionType(in.Type),
+		ValidFrom:  in.ValidFrom,
+		ValidUntil: in.ValidUntil,
+		State:      v1beta1.ExceptionState(in.State),
+		AppliedAt:  in.AppliedAt,
+	}
+}
+
+func exceptionReferenceFromHub(in v1beta1.ExceptionReference) ExceptionReference {
+	return ExceptionReference{
+		Name:       in.Name,
+		Type:       ExceptionType(in.Type),
+		ValidFrom:  in.ValidFrom,
+		ValidUntil: in.ValidUntil,
+		State:      ExceptionState(in.State),
+		AppliedAt:  in.AppliedAt,
+	}
+}
+
+func executionCycleToHub(in ExecutionCycle) v1beta1.ExecutionCycle {
+	return v1beta1.ExecutionCycle{
+		CycleID:        in.CycleID,
+		CostAllocation: in.CostAllocation,
+		Shutdown:       operationSummaryToHub(in.ShutdownExecution),
+		WakeUp:         operationSummaryToHub(in.WakeupExecution),
+	}
+}
+
+func executionCycleFromHub(in v1beta1.ExecutionCycle) ExecutionCycle {
+	return ExecutionCycle{
+		CycleID:           in.CycleID,
+		CostAllocation:    in.CostAllocation,
+		ShutdownExecution: operationSummaryFromHub(in.Shutdown),
+		WakeupExecution:   operationSummaryFromHub(in.WakeUp),
+	}
+}
+
+func operationSummaryToHub(in *ExecutionOperationSummary) *v1beta1.ExecutionOperationSummary {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.ExecutionOperationSummary{
+		Operation: v1beta1.PlanOperation(in.Operation),
+		StartTime: in.StartTime,
+		EndTime:   in.EndTime,
+		TargetResults: convertSlice(in.TargetResults, func(r TargetExecutionResult) v1beta1.TargetExecutionResult {
+			return v1beta1.TargetExecutionResult{
+				Target:      r.Target,
+				State:       v1beta1.ExecutionState(r.State),
+				Attempts:    r.Attempts,
+				ExecutionID: r.ExecutionID,
+				StartedAt:   r.StartedAt,
+				FinishedAt:  r.FinishedAt,
+				Message:     r.Message,
+			}
+		}),
+		Success:      in.Success,
+		ErrorMessage: in.ErrorMessage,
+		AbortReason:  in.AbortReason,
+		Verification: wakeVerificationResultToHub(in.Verification),
+	}
+}
+
+func operationSummaryFromHub(in *v1beta1.ExecutionOperationSummary) *ExecutionOperationSummary {
+	if in == nil {
+		return nil
+	}
+	return &ExecutionOperationSummary{
+		Operation: PlanOperation(in.Operation),
+		StartTime: in.StartTime,
+		EndTime:   in.EndTime,
+		TargetResults: convertSlice(in.TargetResults, func(r v1beta1.TargetExecutionResult) TargetExecutionResult {
+			return TargetExecutionResult{
+				Target:      r.Target,
+				State:       ExecutionState(r.State),
+				Attempts:    r.Attempts,
+				ExecutionID: r.ExecutionID,
+				StartedAt:   r.StartedAt,
+				FinishedAt:  r.FinishedAt,
+				Message:     r.Message,
+			}
+		}),
+		Success:      in.Success,
+		ErrorMessage: in.ErrorMessage,
+		AbortReason:  in.AbortReason,
+		Verification: wakeVerificationResultFromHub(in.Verification),
+	}
+}
+
+func wakeVerificationResultToHub(in *WakeVerificationResult) *v1beta1.WakeVerificationResult {
+	if in == nil {
+		return nil
+	}
+	return &v1beta1.WakeVerificationResult{
+		JobRef:     in.JobRef,
+		State:      v1beta1.ExecutionState(in.State),
+		StartedAt:  in.StartedAt,
+		FinishedAt: in.FinishedAt,
+		Message:    in.Message,
+	}
+}
+
+func wakeVerificationResultFromHub(in *v1beta1.WakeVerificationResult) *WakeVerificationResult {
+	if in == nil {
+		return nil
+	}
+	return &WakeVerificationResult{
+		JobRef:     in.JobRef,
+		State:      ExecutionState(in.State),
+		StartedAt:  in.StartedAt,
+		FinishedAt: in.FinishedAt,
+		Message:    in.Message,
+	}
+}
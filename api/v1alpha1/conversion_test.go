@@ -0,0 +1,210 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/ardikabs/hibernator/api/v1beta1"
+)
+
+func TestHibernatePlan_ConversionRoundTrip(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	plan := &HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-a", Labels: map[string]string{"team": "a"}},
+		Spec: HibernatePlanSpec{
+			Schedule: Schedule{
+				Timezone:           "Asia/Jakarta",
+				DSTPolicy:          DSTPolicySkip,
+				OffHours:           []OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON", "TUE"}}},
+				Dates:              []DateWindow{{Start: "2026-12-24", End: "2027-01-02"}},
+				Jitter:             "5m",
+				MinimumHibernation: "1h",
+				BlackoutWindows: []BlackoutWindow{
+					{Name: "release-freeze", Start: now, End: metav1.NewTime(now.Add(72 * time.Hour))},
+				},
+			},
+			Execution: Execution{
+				Strategy: ExecutionStrategy{
+					Type:           StrategyDAG,
+					MaxConcurrency: ptr.To[int32](2),
+					Dependencies:   []Dependency{{From: "eks", To: "rds"}},
+				},
+				RunnerPodTemplate: &RunnerPodTemplate{
+					Labels:            map[string]string{"cost-center": "platform"},
+					NodeSelector:      map[string]string{"node-pool": "infra"},
+					Tolerations:       []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "infra", Effect: corev1.TaintEffectNoSchedule}},
+					PriorityClassName: "infra-critical",
+				},
+				JobPolicy:     &JobPolicy{TTLSecondsAfterFinished: ptr.To[int32](86400), ActiveDeadlineSeconds: ptr.To[int64](1800)},
+				RunnerCluster: &RunnerCluster{ClusterRef: "workloads", Namespace: "runners", ControlPlaneSecretName: "control-plane"},
+			},
+			Behavior: Behavior{Mode: BehaviorStrict, FailFast: true, Retries: ptr.To[int32](3), MaxCycleDuration: "2h", OnCycleTimeout: CycleTimeoutRollback, AutoRecoverOnScheduleChange: true, ReassertInterval: "24h", MaxResourcesPerTarget: ptr.To[int32](50),
+				RetryPolicy: &RetryPolicy{MaxRetries: ptr.To[int32](1), InitialBackoff: "5m", BackoffMultiplier: ptr.To[int32](1), RetryOn: []RetryClass{RetryOnTransient}}},
+			Targets: []Target{{
+				Name:                 "eks",
+				Type:                 "eks",
+				ConnectorRef:         ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+				Parameters:           &Parameters{Raw: []byte(`{"clusterName":"dev"}`)},
+				RunnerServiceAccount: "hibernator-runner-prod",
+				Priority:             10,
+				PreWake:              &PreWakeHook{LeadTime: "15m", Parameters: &Parameters{Raw: []byte(`{"warmNodes":2}`)}},
+			}},
+			TargetsFrom:   []TargetPresetReference{{Name: "shared"}},
+			SuspendPolicy: SuspendImmediate,
+			Restore: &RestoreSpec{
+				History: 2,
+				Storage: &RestoreStorage{
+					Type:       RestoreStorageS3,
+					S3:         &S3RestoreStorage{Bucket: "restore", Region: "ap-southeast-1"},
+					Encryption: &RestoreEncryption{KeySecretRef: ObjectKeyReference{Name: "key", Key: ptr.To("aes")}, PreviousKeys: []string{"aes-2025"}},
+				},
+			},
+			WakeVerification: &WakeVerification{JobTemplateRef: JobTemplateReference{Name: "smoke-tests"}},
+			ExecutionHistory: &ExecutionHistorySpec{Limit: 10, Archive: true, ArchiveRetention: "2160h"},
+		},
+		Status: HibernatePlanStatus{
+			Phase:          PhaseHibernated,
+			CurrentCycleID: "abc123",
+			Executions:     []ExecutionStatus{{Target: "eks/eks", State: StateCompleted, Attempts: 1, FailureReason: FailureThrottled, StartedAt: &now, Stale: true, Progress: &ExecutionProgress{Completed: 7, Total: 24, Resource: "instance:db-7"}}},
+			ExecutionHistory: []ExecutionCycle{{
+				CycleID:        "abc123",
+				CostAllocation: map[string]string{"team": "a"},
+				ShutdownExecution: &ExecutionOperationSummary{
+					Operation:     OperationHibernate,
+					StartTime:     now,
+					TargetResults: []TargetExecutionResult{{Target: "eks/eks", State: StateCompleted, Attempts: 1, ExecutionID: "e1"}},
+					Success:       true,
+				},
+				WakeupExecution: &ExecutionOperationSummary{
+					Operation:   OperationWakeUp,
+					StartTime:   now,
+					AbortReason: "wakeup exceeded maxCycleDuration of 2h0m0s",
+					Verification: &WakeVerificationResult{
+						JobRef: "default/verify-p-abc", State: StateFailed, FinishedAt: &now, Message: "smoke test failed",
+					},
+				},
+			}},
+			ExceptionReferences: []ExceptionReference{{Name: "holiday", Type: ExceptionExtend, State: ExceptionStateActive, ValidFrom: now, ValidUntil: now}},
+			PlanSnapshot:        &PlanSnapshot{CycleID: "abc123", Execution: Execution{Strategy: ExecutionStrategy{Type: StrategySequential}}},
+			ExecutionSummary:    &ExecutionSummary{CycleID: "abc123", Operation: OperationHibernate, Total: 2, Completed: 1, Cancelled: 1},
+			CurrentOperation:    OperationHibernate,
+			NextWakeUpTime:      &now,
+			PreWake:             &PreWakeStatus{CycleID: "abc123", Targets: []string{"eks"}},
+			LastReassertTime:    &now,
+		},
+	}
+
+	hub := &v1beta1.HibernatePlan{}
+	if err := plan.DeepCopy().ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if got := hub.Status.ExecutionHistory[0].Shutdown; got == nil || got.TargetResults[0].ExecutionID != "e1" {
+		t.Errorf("hub shutdown summary = %+v, want execution ID e1", got)
+	}
+
+	back := &HibernatePlan{}
+	if err := back.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	if !reflect.DeepEqual(plan, back) {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", back, plan)
+	}
+}
+
+func TestHibernatePlan_ConvertToRenamesFields(t *testing.T) {
+	plan := &HibernatePlan{
+		Status: HibernatePlanStatus{
+			ExecutionHistory: []ExecutionCycle{{
+				CycleID:         "c1",
+				WakeupExecution: &ExecutionOperationSummary{Operation: OperationWakeUp, TargetResults: []TargetExecutionResult{{ExecutionID: "e1"}}},
+			}},
+		},
+	}
+
+	hub := &v1beta1.HibernatePlan{}
+	if err := plan.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	data, err := json.Marshal(hub.Status)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, want := range []string{`"cycleID":"c1"`, `"wakeUp":`, `"executionID":"e1"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("v1beta1 status %s does not contain %s", data, want)
+		}
+	}
+}
+
+func TestScheduleException_ConversionRoundTrip(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	exc := &ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{Name: "holiday", Namespace: "team-a"},
+		Spec: ScheduleExceptionSpec{
+			PlanRef:      PlanReference{Name: "dev"},
+			PlanRefs:     []PlanReference{{Name: "staging"}},
+			PlanSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "non-prod"}},
+			ValidFrom:    now,
+			ValidUntil:   now,
+			Type:         ExceptionExtend,
+			Windows:      []OffHourWindow{{Start: "00:00", End: "23:59", DaysOfWeek: []string{"SAT"}}},
+			TargetOverrides: []TargetOverride{
+				{TargetName: "eks", Disabled: true},
+				{TargetName: "rds", Parameters: &Parameters{Raw: []byte(`{"snapshotBeforeStop":true}`)}},
+			},
+			ExecutionOverride: &ExecutionOverride{
+				Strategy: &ExecutionStrategy{Type: StrategyStaged, Stages: []Stage{
+					{Name: "data", Targets: []string{"rds"}, PostDelay: "30s", ReadinessProbe: &StageReadinessProbe{
+						TCP:     &TCPReadinessCheck{Address: "db.team-a.svc:5432"},
+						Timeout: "5m",
+					}},
+					{Name: "compute", Parallel: true, Targets: []string{"eks"}},
+				}},
+				Behavior: &Behavior{Mode: BehaviorBestEffort},
+			},
+			RequiresApproval: true,
+			Recurrence: &ExceptionRecurrence{
+				Frequency: RecurrenceMonthly,
+				Interval:  1,
+				ByDay:     []string{"1SAT"},
+				Duration:  "48h",
+				Timezone:  "Asia/Jakarta",
+			},
+			TTLAfterExpiry: "168h",
+		},
+		Status: ScheduleExceptionStatus{
+			State:      ExceptionStateActive,
+			AppliedAt:  &now,
+			Approvals:  []ExceptionApproval{{Approver: "alice", Generation: 2, ApprovedAt: now}},
+			Occurrence: &ExceptionOccurrence{Start: now, End: now},
+		},
+	}
+
+	hub := &v1beta1.ScheduleException{}
+	if err := exc.DeepCopy().ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if got := hub.Spec.TargetOverrides[0].Target; got != "eks" {
+		t.Errorf("hub target override = %q, want eks", got)
+	}
+
+	back := &ScheduleException{}
+	if err := back.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	if !reflect.DeepEqual(exc, back) {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", back, exc)
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HibernateExecutionSpec identifies the plan, cycle and ledger slot a
+// HibernateExecution belongs to.
+type HibernateExecutionSpec struct {
+	// PlanRef references the owning HibernatePlan.
+	PlanRef PlanReference `json:"planRef"`
+
+	// CycleID is the hibernation cycle this execution belongs to.
+	CycleID string `json:"cycleID"`
+
+	// Operation is the operation this execution belongs to (shutdown or wakeup).
+	Operation PlanOperation `json:"operation"`
+
+	// Index is the position of this execution in the plan's execution ledger.
+	// +kubebuilder:validation:Minimum=0
+	Index int32 `json:"index"`
+}
+
+// ExecutionSummary aggregates the execution ledger of the current operation.
+// It is set when the per-target ledger is stored as HibernateExecution objects
+// instead of inline in status.executions.
+type ExecutionSummary struct {
+	// CycleID is the cycle the externalized ledger belongs to.
+	CycleID string `json:"cycleID"`
+
+	// Operation is the operation the externalized ledger belongs to.
+	Operation PlanOperation `json:"operation"`
+
+	// Total is the number of targets in the ledger.
+	Total int32 `json:"total"`
+
+	// Pending is the number of targets that have not started.
+	// +optional
+	Pending int32 `json:"pending,omitempty"`
+
+	// Running is the number of targets currently executing.
+	// +optional
+	Running int32 `json:"running,omitempty"`
+
+	// Completed is the number of targets that finished successfully.
+	// +optional
+	Completed int32 `json:"completed,omitempty"`
+
+	// Failed is the number of targets that failed.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// Aborted is the number of targets that were skipped due to upstream failures.
+	// +optional
+	Aborted int32 `json:"aborted,omitempty"`
+
+	// Cancelled is the number of targets whose runner Job was deleted by an
+	// immediate suspension.
+	// +optional
+	Cancelled int32 `json:"cancelled,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=hexec
+// +kubebuilder:printcolumn:name="Plan",type=string,JSONPath=`.spec.planRef.name`
+// +kubebuilder:printcolumn:name="Operation",type=string,JSONPath=`.spec.operation`
+// +kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.status.target`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HibernateExecution holds one target's execution status for a cycle of a large
+// HibernatePlan. The controller creates these objects instead of growing
+// status.executions once a plan reaches the configured target threshold, and
+// owns them through the plan so they are garbage-collected with it.
+type HibernateExecution struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec identifies the plan, cycle and ledger slot.
+	Spec HibernateExecutionSpec `json:"spec,omitempty"`
+
+	// Status is the target's execution status.
+	Status ExecutionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HibernateExecutionList contains a list of HibernateExecution.
+type HibernateExecutionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of HibernateExecution resources.
+	Items []HibernateExecution `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HibernateExecution{}, &HibernateExecutionList{})
+}
@@ -6,6 +6,9 @@ Licensed under the Apache License, Version 2.0.
 package v1alpha1
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,6 +42,45 @@ const (
 	BehaviorBestEffort BehaviorMode = "BestEffort"
 )
 
+// CycleTimeoutPolicy defines what happens when an operation exceeds maxCycleDuration.
+// +kubebuilder:validation:Enum=Error;Rollback;Continue
+type CycleTimeoutPolicy string
+
+const (
+	// CycleTimeoutError moves the plan to Error; the usual retry policy resumes the operation.
+	CycleTimeoutError CycleTimeoutPolicy = "Error"
+	// CycleTimeoutRollback reverses the operation on the targets that already ran.
+	CycleTimeoutRollback CycleTimeoutPolicy = "Rollback"
+	// CycleTimeoutContinue aborts the remaining targets and settles the plan in the
+	// operation's end phase as if it had finished.
+	CycleTimeoutContinue CycleTimeoutPolicy = "Continue"
+)
+
+// SuspendPolicy defines how a suspension treats an in-flight shutdown or wakeup.
+// +kubebuilder:validation:Enum=Graceful;Immediate
+type SuspendPolicy string
+
+const (
+	// SuspendGraceful lets running Jobs finish before the plan is suspended.
+	SuspendGraceful SuspendPolicy = "Graceful"
+	// SuspendImmediate deletes the running Jobs, marks their executions Cancelled
+	// and suspends the plan at once.
+	SuspendImmediate SuspendPolicy = "Immediate"
+)
+
+// DSTPolicy defines how schedule boundaries at a local time that a daylight
+// saving time change skips or repeats are resolved.
+// +kubebuilder:validation:Enum=ShiftForward;Skip
+type DSTPolicy string
+
+const (
+	// DSTPolicyShiftForward moves a boundary in a skipped hour forward by the length
+	// of the gap and runs a boundary in a repeated hour once, at its first occurrence.
+	DSTPolicyShiftForward DSTPolicy = "ShiftForward"
+	// DSTPolicySkip skips a boundary in a skipped or repeated hour on the day of the change.
+	DSTPolicySkip DSTPolicy = "Skip"
+)
+
 // PlanPhase represents the overall phase of the HibernatePlan.
 // +kubebuilder:validation:Enum=Pending;Active;Hibernating;Hibernated;WakingUp;Suspended;Error
 type PlanPhase string
@@ -76,10 +118,22 @@ const (
 	OperationHibernate PlanOperation = "shutdown"
 	// OperationWakeUp is the operation value for a wakeup cycle.
 	OperationWakeUp PlanOperation = "wakeup"
+	// OperationPreWake is the runner Job operation label for pre-wake hooks.
+	// It never appears in status.
+	OperationPreWake PlanOperation = "prewake"
+	// OperationReassert is the runner Job operation label for the reassertion
+	// of a hibernated target. It never appears in status.
+	OperationReassert PlanOperation = "reassert"
+	// OperationWakeVerify is the Job operation label for wake verification Jobs.
+	// It never appears in status.
+	OperationWakeVerify PlanOperation = "wakeverify"
+	// OperationPreview is the runner Job operation label for previews of the
+	// resources the targets select. It never appears in status.
+	OperationPreview PlanOperation = "preview"
 )
 
 // ExecutionState represents per-target execution state.
-// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed;Aborted
+// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed;Aborted;Cancelled
 type ExecutionState string
 
 const (
@@ -97,6 +151,75 @@ const (
 	// Currently only relevant with DAG strategy and BestEffort behavior,
 	// but may be extended to other strategies/behaviors in the future.
 	StateAborted ExecutionState = "Aborted"
+	// StateCancelled means the runner Job was deleted before it finished because the
+	// plan was suspended with suspendPolicy Immediate. A resume in the same cycle
+	// dispatches the target again.
+	StateCancelled ExecutionState = "Cancelled"
+)
+
+// FailureReason classifies why a target execution failed, so that recovery can
+// tell failures worth retrying from those that need intervention.
+// +kubebuilder:validation:Enum=AuthError;Throttled;ResourceNotFound;Timeout;PermanentAPIError;ResourceLimitExceeded
+type FailureReason string
+
+const (
+	// FailureAuthError means the executor was not authenticated or not
+	// authorized to act on the target, e.g. expired or missing credentials.
+	FailureAuthError FailureReason = "AuthError"
+	// FailureThrottled means the cloud provider or Kubernetes API rate-limited the executor.
+	FailureThrottled FailureReason = "Throttled"
+	// FailureResourceNotFound means a resource the executor acts on does not exist.
+	FailureResourceNotFound FailureReason = "ResourceNotFound"
+	// FailureTimeout means the operation or the runner Job ran out of time.
+	FailureTimeout FailureReason = "Timeout"
+	// FailurePermanentAPIError means the API rejected the request in a way a
+	// retry will not fix, e.g. invalid parameters.
+	FailurePermanentAPIError FailureReason = "PermanentAPIError"
+	// FailureResourceLimitExceeded means the target selected more resources
+	// than behavior.maxResourcesPerTarget allows, so nothing was changed.
+	FailureResourceLimitExceeded FailureReason = "ResourceLimitExceeded"
+)
+
+// Condition types and reasons reported in HibernatePlanStatus.Conditions.
+const (
+	// ConditionSpecChangeDeferred is True while a spec change observed during a
+	// Hibernating or WakingUp operation waits for that operation to complete.
+	ConditionSpecChangeDeferred = "SpecChangeDeferred"
+
+	// ReasonOperationInProgress explains a deferral caused by an in-flight operation.
+	ReasonOperationInProgress = "OperationInProgress"
+	// ReasonSpecApplied marks a previously deferred spec change as applied.
+	ReasonSpecApplied = "SpecApplied"
+
+	// ConditionTargetsResolved reports whether spec.targetsFrom and connector selectors
+	// could be expanded into a valid target list. New hibernation cycles do not start
+	// while it is False.
+	ConditionTargetsResolved = "TargetsResolved"
+
+	// ReasonTargetsExpanded marks TargetPresets and connector selectors as expanded.
+	ReasonTargetsExpanded = "Expanded"
+	// ReasonTargetsInvalid explains a missing TargetPreset or an invalid expanded target list.
+	ReasonTargetsInvalid = "Invalid"
+
+	// ConditionConnectorsReady reports whether the connectors used by the plan's targets
+	// passed their last health check. It is informational: cycles still start while it
+	// is False, and their runner Jobs fail on the unhealthy connectors.
+	ConditionConnectorsReady = "ConnectorsReady"
+
+	// ReasonConnectorsHealthy marks every connector of the plan as ready.
+	ReasonConnectorsHealthy = "Healthy"
+	// ReasonConnectorsUnhealthy lists the connectors that failed their last health check.
+	ReasonConnectorsUnhealthy = "Unhealthy"
+
+	// ConditionOperationPaused is True while an in-flight Hibernating or WakingUp
+	// operation is held between stages by the pause-operation annotation, and False
+	// once it has been resumed. It is removed when the operation ends.
+	ConditionOperationPaused = "OperationPaused"
+
+	// ReasonPausedByUser marks an operation paused through the pause-operation annotation.
+	ReasonPausedByUser = "PausedByUser"
+	// ReasonOperationResumed marks a previously paused operation as resumed.
+	ReasonOperationResumed = "Resumed"
 )
 
 // OffHourWindow defines a time window for hibernation.
@@ -124,9 +247,86 @@ type Schedule struct {
 	// +kubebuilder:validation:Required
 	Timezone string `json:"timezone"`
 
+	// DSTPolicy resolves boundaries at local times that a daylight saving time
+	// change skips or repeats in Timezone, e.g. a 02:30 boundary on the night
+	// clocks move from 02:00 to 03:00.
+	// +kubebuilder:default=ShiftForward
+	// +optional
+	DSTPolicy DSTPolicy `json:"dstPolicy,omitempty"`
+
 	// OffHours defines when hibernation should occur.
 	// +kubebuilder:validation:MinItems=1
 	OffHours []OffHourWindow `json:"offHours"`
+
+	// Dates are one-off off-hour windows pinned to calendar dates, e.g. a
+	// year-end shutdown, evaluated alongside the weekly OffHours.
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	Dates []DateWindow `json:"dates,omitempty"`
+
+	// Jitter spreads the operations of plans sharing a schedule over a window
+	// after each boundary, to avoid starting them all at once. Hibernation and
+	// wakeup start a delay within the window after the boundary; the delay is
+	// derived from the plan's namespace and name, so it is the same every cycle.
+	// Format: duration string (e.g., "5m").
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	Jitter string `json:"jitter,omitempty"`
+
+	// MinimumHibernation is the shortest hibernation worth starting. When the
+	// next wakeup is closer than this as a window begins, the plan stays active
+	// through the window, avoiding shutdown and wakeup churn for resources that
+	// take longer to stop than the window lasts.
+	// Format: duration string (e.g., "1h").
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	MinimumHibernation string `json:"minimumHibernation,omitempty"`
+
+	// BlackoutWindows are periods, e.g. release freezes, during which no
+	// hibernate or wakeup operation starts. A transition due during a blackout
+	// is deferred until it ends; operations already running are not stopped.
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty"`
+}
+
+// DateWindow hibernates whole calendar days, from the start of Start to the
+// end of End in the schedule's timezone.
+type DateWindow struct {
+	// Start is the first day of the window in YYYY-MM-DD format (e.g., "2026-12-24").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	Start string `json:"start"`
+
+	// End is the last day of the window in YYYY-MM-DD format, inclusive.
+	// Defaults to Start.
+	// +kubebuilder:validation:Pattern=`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	// +optional
+	End string `json:"end,omitempty"`
+}
+
+// BlackoutWindow is a period during which no hibernate or wakeup operation starts.
+type BlackoutWindow struct {
+	// Name identifies the blackout in logs and events (e.g., "release-freeze").
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Start is when the blackout begins (RFC3339 format).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	Start metav1.Time `json:"start"`
+
+	// End is when the blackout ends (RFC3339 format).
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	End metav1.Time `json:"end"`
+}
+
+// Active reports whether the blackout covers t.
+func (w BlackoutWindow) Active(t time.Time) bool {
+	return !t.Before(w.Start.Time) && t.Before(w.End.Time)
 }
 
 // Dependency represents a DAG edge (from -> to).
@@ -153,6 +353,78 @@ type Stage struct {
 
 	// Targets are the names of targets in this stage.
 	Targets []string `json:"targets"`
+
+	// PostDelay is how long to wait after the stage completes before the next
+	// stage starts, e.g. to let a database warm up its caches.
+	// Format: duration string (e.g., "30s", "5m").
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	PostDelay string `json:"postDelay,omitempty"`
+
+	// ReadinessProbe must pass after the stage woke up before the next stage
+	// starts, e.g. until a database accepts connections. It is checked after
+	// PostDelay, and only on wakeup.
+	// +optional
+	ReadinessProbe *StageReadinessProbe `json:"readinessProbe,omitempty"`
+}
+
+// StageReadinessProbe checks that the resources of a stage are ready. Exactly
+// one of http, tcp and resource must be set.
+type StageReadinessProbe struct {
+	// HTTP passes once a GET request to the URL answers with a 2xx status.
+	// +optional
+	HTTP *HTTPReadinessCheck `json:"http,omitempty"`
+
+	// TCP passes once a connection to the address can be opened.
+	// +optional
+	TCP *TCPReadinessCheck `json:"tcp,omitempty"`
+
+	// Resource passes once a status condition of a resource in the plan
+	// namespace is True. The controller must be allowed to get the resource.
+	// +optional
+	Resource *ResourceReadinessCheck `json:"resource,omitempty"`
+
+	// Timeout is how long the probe may keep failing before the stage fails.
+	// Format: duration string (e.g., "5m"). Defaults to 10m.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// HTTPReadinessCheck is a readiness check against an HTTP endpoint.
+type HTTPReadinessCheck struct {
+	// URL requested by the controller.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	URL string `json:"url"`
+}
+
+// TCPReadinessCheck is a readiness check against a TCP endpoint.
+type TCPReadinessCheck struct {
+	// Address is the host:port the controller connects to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Address string `json:"address"`
+}
+
+// ResourceReadinessCheck is a readiness check against a status condition of a
+// Kubernetes resource.
+type ResourceReadinessCheck struct {
+	// APIVersion of the resource (e.g., "apps/v1").
+	// +kubebuilder:validation:Required
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the resource (e.g., "Deployment").
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Name of the resource in the plan namespace.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Condition is the type of the condition that must be True (e.g., "Available").
+	// +kubebuilder:validation:Required
+	Condition string `json:"condition"`
 }
 
 // ExecutionStrategy defines how targets are executed.
@@ -179,6 +451,108 @@ type ExecutionStrategy struct {
 type Execution struct {
 	// Strategy defines how targets are executed.
 	Strategy ExecutionStrategy `json:"strategy"`
+
+	// RunnerPodTemplate customizes the pods of the runner Jobs, e.g. to schedule
+	// them on a tainted node pool.
+	// +optional
+	RunnerPodTemplate *RunnerPodTemplate `json:"runnerPodTemplate,omitempty"`
+
+	// JobPolicy controls the retries and lifetime of the runner Jobs.
+	// +optional
+	JobPolicy *JobPolicy `json:"jobPolicy,omitempty"`
+
+	// RunnerCluster dispatches the runner Jobs into another cluster, e.g. the one
+	// the workloads live in, when the control plane cluster has no cloud
+	// credentials. Unset runs them next to the plan.
+	// +optional
+	RunnerCluster *RunnerCluster `json:"runnerCluster,omitempty"`
+}
+
+// RunnerCluster selects the cluster and namespace runner Jobs are created in.
+// The Jobs are not owned by the plan; the controller tracks them through the
+// results their runners stream back and relies on the TTL of the job policy to
+// remove them. Pod retries are disabled, so a failed runner fails its target
+// and the plan's retry policy applies.
+type RunnerCluster struct {
+	// ClusterRef names the K8SCluster in the plan's namespace whose API server
+	// the runner Jobs are created through. Its access must be usable by the
+	// controller: spec.k8s, or spec.eks with a CloudProvider using static
+	// credentials.
+	// +kubebuilder:validation:MinLength=1
+	ClusterRef string `json:"clusterRef"`
+
+	// Namespace is the namespace of the runner Jobs in that cluster. Defaults to
+	// the plan's namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ControlPlaneSecretName names a Secret in that namespace the runners reach
+	// the control plane with. The key "kubeconfig" holds a kubeconfig of the
+	// control plane cluster, used to read connectors and persist restore data;
+	// the key "token" holds a ServiceAccount token of the control plane the
+	// runners authenticate to the streaming server with.
+	// +kubebuilder:validation:MinLength=1
+	ControlPlaneSecretName string `json:"controlPlaneSecretName"`
+}
+
+// JobPolicy controls the retries and lifetime of the runner Jobs.
+type JobPolicy struct {
+	// TTLSecondsAfterFinished is how long finished runner Jobs and their pods are
+	// kept, e.g. to inspect the logs of failed runners. Defaults to 3600 (1 hour).
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// BackoffLimit is the number of times a failed runner pod is retried before
+	// the target fails. Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// ActiveDeadlineSeconds bounds how long a runner Job may run, retries
+	// included, before it is failed. Unbounded when unset.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// RunnerPodTemplate holds the pod settings merged into the runner Jobs.
+type RunnerPodTemplate struct {
+	// Labels are added to the runner pods. Labels set by Hibernator take precedence.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the runner pods. Annotations set by Hibernator take precedence.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Resources are the compute resources of the runner container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// NodeSelector constrains the runner pods to nodes with matching labels.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow the runner pods to be scheduled on tainted nodes.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity holds the scheduling constraints of the runner pods.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// PriorityClassName is the priority class of the runner pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// SecurityContext is the pod-level security context of the runner pods.
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// ContainerSecurityContext is the security context of the runner container.
+	// +optional
+	ContainerSecurityContext *corev1.SecurityContext `json:"containerSecurityContext,omitempty"`
 }
 
 // Behavior defines execution behavior.
@@ -201,20 +575,128 @@ type Behavior struct {
 	// +kubebuilder:validation:Maximum=10
 	// +optional
 	Retries *int32 `json:"retries,omitempty"`
+
+	// RetryPolicy configures the backoff between retries and which errors are
+	// retried. Its maxRetries takes precedence over retries.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// AutoRecoverOnScheduleChange lets a plan that stays in the Error phase,
+	// because its retries are exhausted or its error is permanent, recover by
+	// itself once the schedule calls for the opposite operation, e.g. when the
+	// wakeup time arrives after a failed hibernation. The plan then runs the
+	// operation the schedule calls for instead of waiting for a manual retry.
+	// +optional
+	AutoRecoverOnScheduleChange bool `json:"autoRecoverOnScheduleChange,omitempty"`
+
+	// MaxCycleDuration bounds how long a shutdown or wakeup operation may run.
+	// Once exceeded, no further targets are dispatched and, after in-flight
+	// runners finish, OnCycleTimeout decides how the operation ends.
+	// Format: duration string (e.g., "30m", "2h"). Empty disables the limit.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	MaxCycleDuration string `json:"maxCycleDuration,omitempty"`
+
+	// OnCycleTimeout is the failure policy applied when MaxCycleDuration is exceeded.
+	// +kubebuilder:default=Error
+	// +optional
+	OnCycleTimeout CycleTimeoutPolicy `json:"onCycleTimeout,omitempty"`
+
+	// MaxResourcesPerTarget bounds how many resources the shutdown of a single
+	// target may act on. An executor whose discovery selects more resources
+	// aborts before changing any of them, so an overly broad selector, e.g. a
+	// mistaken includeAll, cannot take down a whole account. Unset falls back
+	// to the controller default; 0 disables the limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxResourcesPerTarget *int32 `json:"maxResourcesPerTarget,omitempty"`
+
+	// ReassertInterval stops the resources of the targets again while the plan
+	// stays Hibernated, each time the interval has passed since the plan last
+	// hibernated or reasserted, so resources that came back up by themselves
+	// are shut down again, e.g. RDS instances that AWS starts after seven days
+	// stopped. Only targets whose executor supports it (rds) are reasserted;
+	// the plan stays Hibernated, and its restore data, history and
+	// notifications are left untouched.
+	// Format: duration string (e.g., "24h"), at least 1h. Empty disables it.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	ReassertInterval string `json:"reassertInterval,omitempty"`
+}
+
+// RetryClass is a class of error that the recovery of a failed operation retries.
+// +kubebuilder:validation:Enum=Transient;ExecutionFailed;Unknown;Permanent
+type RetryClass string
+
+const (
+	// RetryOnTransient retries errors expected to clear up by themselves, e.g.
+	// throttling or timeouts.
+	RetryOnTransient RetryClass = "Transient"
+	// RetryOnExecutionFailed retries stages in which targets failed without a
+	// classified failure reason.
+	RetryOnExecutionFailed RetryClass = "ExecutionFailed"
+	// RetryOnUnknown retries errors that could not be classified.
+	RetryOnUnknown RetryClass = "Unknown"
+	// RetryOnPermanent retries errors a retry is not expected to fix, e.g.
+	// invalid credentials or missing resources.
+	RetryOnPermanent RetryClass = "Permanent"
+)
+
+// RetryPolicy configures how a failed operation is retried. The delay before
+// retry n is initialBackoff * backoffMultiplier^(n-1), capped at maxBackoff.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retry attempts; 0 disables automatic
+	// retries. Defaults to behavior.retries.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// InitialBackoff is the delay before the first retry.
+	// Format: duration string (e.g., "30s", "5m"). Defaults to 1m.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	InitialBackoff string `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff caps the delay between retries.
+	// Format: duration string (e.g., "10m", "1h"). Defaults to 30m.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	MaxBackoff string `json:"maxBackoff,omitempty"`
+
+	// BackoffMultiplier multiplies the delay after each retry; 1 retries at a
+	// constant interval. Defaults to 2.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	BackoffMultiplier *int32 `json:"backoffMultiplier,omitempty"`
+
+	// RetryOn lists the classes of error that are retried. Defaults to
+	// Transient, ExecutionFailed and Unknown.
+	// +listType=set
+	// +optional
+	RetryOn []RetryClass `json:"retryOn,omitempty"`
 }
 
-// ConnectorRef references a connector resource.
+// ConnectorRef references a connector resource, either by name or by label selector.
 type ConnectorRef struct {
 	// Kind of the connector (CloudProvider or K8SCluster).
 	// +kubebuilder:validation:Enum=CloudProvider;K8SCluster
 	Kind string `json:"kind"`
 
-	// Name of the connector resource.
-	Name string `json:"name"`
+	// Name of the connector resource. Exactly one of name and selector is required.
+	// +optional
+	Name string `json:"name,omitempty"`
 
 	// Namespace of the connector resource (defaults to plan namespace).
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
+
+	// Selector discovers connectors of the given kind by label. The target is expanded
+	// into one target per matching connector, named <target>-<connector>, and follows
+	// connectors as they are registered or removed.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 }
 
 // Target defines a hibernation target.
@@ -235,6 +717,57 @@ type Target struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
 	Parameters *Parameters `json:"parameters,omitempty"`
+
+	// RunnerImage overrides the runner container image used for this target's Jobs.
+	// It must be allowed by the controller's --runner-image-allowlist. When
+	// empty, the controller's per-type default image is used, falling back to
+	// the global runner image.
+	// +optional
+	RunnerImage string `json:"runnerImage,omitempty"`
+
+	// RunnerServiceAccount overrides the ServiceAccount of this target's runner
+	// Jobs, e.g. one annotated with an IRSA role scoped to the target's account.
+	// It must be allowed by the controller's --runner-service-accounts. When
+	// empty, the controller's runner ServiceAccount is used.
+	// +optional
+	RunnerServiceAccount string `json:"runnerServiceAccount,omitempty"`
+
+	// Priority orders the targets of a stage: when maxConcurrency limits how
+	// many run at once, higher-priority targets get job slots first. Targets of
+	// equal priority keep their order.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// PreWake runs the executor's warm-up action ahead of the scheduled wakeup,
+	// e.g. starting a few nodes early so the wakeup does not wait on cold starts.
+	// Only supported by executors implementing a pre-wake action (currently eks).
+	// +optional
+	PreWake *PreWakeHook `json:"preWake,omitempty"`
+}
+
+// PreWakeHook configures a target's pre-wake action.
+type PreWakeHook struct {
+	// LeadTime is how long before the scheduled wakeup the action runs.
+	// Format: duration string (e.g., "10m", "1h"). Defaults to 10m.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	LeadTime string `json:"leadTime,omitempty"`
+
+	// Parameters are executor-specific pre-wake settings.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Parameters *Parameters `json:"parameters,omitempty"`
+}
+
+// TargetPresetReference references a TargetPreset.
+type TargetPresetReference struct {
+	// Name of the TargetPreset.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace of the TargetPreset (defaults to plan namespace).
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // Parameters is an opaque container for executor-specific config.
@@ -285,13 +818,201 @@ type HibernatePlanSpec struct {
 	// Suspend temporarily disables hibernation operations without deleting the plan.
 	// When set to true, the plan transitions to Suspended phase and stops all execution.
 	// When set to false, the plan transitions back to Active phase and resumes schedule evaluation.
-	// Running jobs complete naturally but no new jobs are created while suspended.
+	// Running jobs complete naturally but no new jobs are created while suspended,
+	// unless suspendPolicy is Immediate.
 	// +optional
 	Suspend bool `json:"suspend,omitempty"`
 
-	// Targets are the resources to hibernate.
-	// +kubebuilder:validation:MinItems=1
-	Targets []Target `json:"targets"`
+	// SuspendPolicy determines how a suspension treats an in-flight shutdown or wakeup.
+	// Graceful (default) waits for running jobs to finish before suspending.
+	// Immediate deletes the running runner Jobs, marks their executions Cancelled and
+	// freezes the plan as-is, for emergency stops.
+	// +kubebuilder:default=Graceful
+	// +optional
+	SuspendPolicy SuspendPolicy `json:"suspendPolicy,omitempty"`
+
+	// Targets are the resources to hibernate. At least one target is required
+	// across targets and targetsFrom.
+	// +optional
+	Targets []Target `json:"targets,omitempty"`
+
+	// TargetsFrom references TargetPresets whose targets are appended to Targets
+	// in order. Target names must be unique across the expanded list.
+	// +optional
+	TargetsFrom []TargetPresetReference `json:"targetsFrom,omitempty"`
+
+	// Restore configures how restore data captured during hibernation is persisted.
+	// +optional
+	Restore *RestoreSpec `json:"restore,omitempty"`
+
+	// WakeVerification runs a user-provided smoke test after every wakeup. The plan
+	// only becomes Active once the verification Job succeeds.
+	// +optional
+	WakeVerification *WakeVerification `json:"wakeVerification,omitempty"`
+
+	// ExecutionHistory configures how many execution cycles the plan status keeps
+	// and whether older cycles are archived.
+	// +optional
+	ExecutionHistory *ExecutionHistorySpec `json:"executionHistory,omitempty"`
+}
+
+// ExecutionHistorySpec configures the retention of execution cycles.
+type ExecutionHistorySpec struct {
+	// Limit is the number of most recent cycles kept in status.executionHistory.
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	Limit int32 `json:"limit,omitempty"`
+
+	// Archive keeps every cycle pruned from status.executionHistory as a
+	// HibernationReport in the plan namespace. Reports are not owned by the
+	// plan, so they outlive it until their retention passes.
+	// +optional
+	Archive bool `json:"archive,omitempty"`
+
+	// ArchiveRetention is how long a HibernationReport is kept after its cycle
+	// is archived. Format: duration string (e.g., "2160h" for 90 days). Empty
+	// keeps reports until they are deleted.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	ArchiveRetention string `json:"archiveRetention,omitempty"`
+}
+
+// WakeVerification configures the Job that verifies an environment after wakeup.
+type WakeVerification struct {
+	// JobTemplateRef references the Job template run once all wakeup stages complete.
+	// +kubebuilder:validation:Required
+	JobTemplateRef JobTemplateReference `json:"jobTemplateRef"`
+}
+
+// JobTemplateReference references a CronJob whose spec.jobTemplate is used as a Job
+// template, the same way `kubectl create job --from=cronjob/<name>` does. Keep the
+// CronJob suspended so it only runs through the plan.
+type JobTemplateReference struct {
+	// Name of the CronJob in the plan namespace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// RestoreStorageType identifies a restore data storage backend.
+type RestoreStorageType string
+
+const (
+	// RestoreStorageConfigMap stores restore data in a ConfigMap in the plan namespace (default).
+	RestoreStorageConfigMap RestoreStorageType = "ConfigMap"
+	// RestoreStorageSecret stores restore data in a Secret in the plan namespace.
+	RestoreStorageSecret RestoreStorageType = "Secret"
+	// RestoreStorageS3 stores restore data as an object in an S3 bucket.
+	RestoreStorageS3 RestoreStorageType = "S3"
+	// RestoreStorageGCS stores restore data as an object in a GCS bucket.
+	RestoreStorageGCS RestoreStorageType = "GCS"
+)
+
+// RestoreSpec configures restore data persistence.
+type RestoreSpec struct {
+	// Storage selects the backend holding restore data.
+	// When omitted, restore data is stored in a ConfigMap.
+	// +optional
+	Storage *RestoreStorage `json:"storage,omitempty"`
+
+	// History is the number of past restore snapshots kept per target after a
+	// successful wakeup. Older snapshots can be promoted back to the current
+	// restore point when the latest data is unusable. Zero disables history.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	History int32 `json:"history,omitempty"`
+}
+
+// RestoreStorage defines the backend used to persist restore data.
+// ConfigMap and Secret backends are limited to ~1MB per plan; S3 and GCS
+// backends have no practical size limit.
+type RestoreStorage struct {
+	// Type is the storage backend.
+	// +kubebuilder:validation:Enum=ConfigMap;Secret;S3;GCS
+	// +kubebuilder:default=ConfigMap
+	Type RestoreStorageType `json:"type"`
+
+	// S3 configures the S3 backend. Required when Type is S3.
+	// +optional
+	S3 *S3RestoreStorage `json:"s3,omitempty"`
+
+	// GCS configures the GCS backend. Required when Type is GCS.
+	// +optional
+	GCS *GCSRestoreStorage `json:"gcs,omitempty"`
+
+	// Encryption enables client-side encryption of restore data before it is
+	// written to the backend.
+	// +optional
+	Encryption *RestoreEncryption `json:"encryption,omitempty"`
+}
+
+// S3RestoreStorage configures restore data storage in Amazon S3 or an
+// S3-compatible object store. Credentials are resolved from the default AWS
+// credential chain of the controller and runner (e.g., IRSA).
+type S3RestoreStorage struct {
+	// Bucket is the S3 bucket name.
+	// +kubebuilder:validation:Required
+	Bucket string `json:"bucket"`
+
+	// Prefix is prepended to object keys.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Region is the bucket region.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Endpoint overrides the S3 endpoint for S3-compatible stores. It must be
+	// one of the controller's --restore-s3-endpoints, as the controller sends
+	// its AWS credentials to it.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ForcePathStyle uses path-style addressing (endpoint/bucket/key).
+	// +optional
+	ForcePathStyle bool `json:"forcePathStyle,omitempty"`
+
+	// ServerSideEncryption requests server-side encryption of stored objects.
+	// +kubebuilder:validation:Enum=AES256;"aws:kms"
+	// +optional
+	ServerSideEncryption string `json:"serverSideEncryption,omitempty"`
+
+	// KMSKeyID is the KMS key used when ServerSideEncryption is aws:kms.
+	// +optional
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+}
+
+// GCSRestoreStorage configures restore data storage in Google Cloud Storage.
+// Credentials are resolved from the GCE metadata server (e.g., GKE Workload Identity).
+type GCSRestoreStorage struct {
+	// Bucket is the GCS bucket name.
+	// +kubebuilder:validation:Required
+	Bucket string `json:"bucket"`
+
+	// Prefix is prepended to object names.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// KMSKeyName is the Cloud KMS key used to encrypt stored objects.
+	// +optional
+	KMSKeyName string `json:"kmsKeyName,omitempty"`
+}
+
+// RestoreEncryption configures client-side AES-GCM encryption of restore data.
+type RestoreEncryption struct {
+	// KeySecretRef references a Secret in the plan namespace holding a 16, 24
+	// or 32 byte AES key. Restore data is always encrypted with this key.
+	// +kubebuilder:validation:Required
+	KeySecretRef ObjectKeyReference `json:"keySecretRef"`
+
+	// PreviousKeys names keys of the KeySecretRef Secret holding AES keys used
+	// before a rotation. Values encrypted with them stay readable, and are
+	// encrypted with the current key when they are next written.
+	// +optional
+	PreviousKeys []string `json:"previousKeys,omitempty"`
 }
 
 // ExecutionStatus represents per-target execution status.
@@ -320,6 +1041,10 @@ type ExecutionStatus struct {
 	// +optional
 	Message string `json:"message,omitempty"`
 
+	// FailureReason classifies the failure of a Failed execution, when known.
+	// +optional
+	FailureReason FailureReason `json:"failureReason,omitempty"`
+
 	// JobRef is the namespace/name of the runner Job.
 	// +optional
 	JobRef string `json:"jobRef,omitempty"`
@@ -343,6 +1068,34 @@ type ExecutionStatus struct {
 	// RestoreConfigMapRef is the namespace/name of restore hints ConfigMap.
 	// +optional
 	RestoreConfigMapRef string `json:"restoreConfigMapRef,omitempty"`
+
+	// Stale is set while the runner Job is active but its runner has not
+	// sent a heartbeat for longer than the controller's runner heartbeat
+	// timeout, e.g. because it hangs or lost its network.
+	// +optional
+	Stale bool `json:"stale,omitempty"`
+
+	// Progress is the per-resource progress last reported by the runner.
+	// +optional
+	Progress *ExecutionProgress `json:"progress,omitempty"`
+}
+
+// ExecutionProgress reports how many of its target's resources an executor
+// has processed, e.g. 7 of 24 instances stopped.
+type ExecutionProgress struct {
+	// Completed is the number of resources processed so far.
+	Completed int32 `json:"completed"`
+
+	// Total is the number of resources the executor processes.
+	Total int32 `json:"total"`
+
+	// Resource identifies the resource processed last, e.g. "instance:my-db".
+	// +optional
+	Resource string `json:"resource,omitempty"`
+
+	// Message describes the resource processed last.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ExecutionOperationSummary summarizes the results of a shutdown or wakeup operation.
@@ -367,6 +1120,34 @@ type ExecutionOperationSummary struct {
 	// ErrorMessage contains error details if the operation failed.
 	// +optional
 	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// AbortReason explains why the operation was cut short, e.g. because it
+	// exceeded spec.behavior.maxCycleDuration.
+	// +optional
+	AbortReason string `json:"abortReason,omitempty"`
+
+	// Verification is the outcome of spec.wakeVerification. Only set on wakeup
+	// summaries of plans that configure it.
+	// +optional
+	Verification *WakeVerificationResult `json:"verification,omitempty"`
+}
+
+// WakeVerificationResult records the outcome of a wake verification Job.
+type WakeVerificationResult struct {
+	// JobRef is the namespace/name of the verification Job.
+	JobRef string `json:"jobRef"`
+	// State is the final state of the Job (Completed or Failed).
+	State ExecutionState `json:"state"`
+	// StartedAt is when the Job started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// FinishedAt is when the Job finished.
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+	// Message is the termination message of the Job's last pod. When a container
+	// fails without writing one, it holds the tail of the container logs.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TargetExecutionResult is the result of a single target execution.
@@ -396,6 +1177,12 @@ type ExecutionCycle struct {
 	// CycleID is a unique identifier for this cycle.
 	CycleID string `json:"cycleId"`
 
+	// CostAllocation carries chargeback metadata (e.g., team, project, environment)
+	// copied from the plan labels when the cycle started, so savings can be
+	// attributed even if the labels change later.
+	// +optional
+	CostAllocation map[string]string `json:"costAllocation,omitempty"`
+
 	// ShutdownExecution summarizes the shutdown operation.
 	// +optional
 	ShutdownExecution *ExecutionOperationSummary `json:"shutdownExecution,omitempty"`
@@ -447,9 +1234,23 @@ type HibernatePlanStatus struct {
 	// +optional
 	Executions []ExecutionStatus `json:"executions,omitempty"`
 
+	// ExecutionSummary holds aggregate counts for the current operation when the
+	// ledger is stored as HibernateExecution objects. Plans with at least the
+	// controller's configured number of targets are externalized this way to keep
+	// the plan object small; Executions is then left empty.
+	// +optional
+	ExecutionSummary *ExecutionSummary `json:"executionSummary,omitempty"`
+
 	// ObservedGeneration is the last observed generation.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
+	// PendingGeneration is the newest generation whose spec change arrived while an
+	// operation was in progress. The in-flight operation keeps running with its locked
+	// PlanSnapshot and the change takes effect once it completes. Zero when no change
+	// is pending.
+	// +optional
+	PendingGeneration int64 `json:"pendingGeneration,omitempty"`
+
 	// RetryCount tracks the number of retry attempts for error recovery.
 	// +optional
 	RetryCount int32 `json:"retryCount,omitempty"`
@@ -498,11 +1299,48 @@ type HibernatePlanStatus struct {
 	// +optional
 	CurrentOperation PlanOperation `json:"currentOperation,omitempty"`
 
-	// ExecutionHistory records historical execution cycles (max 5).
+	// NextHibernateTime is the next hibernation boundary of the schedule, as
+	// resolved by the controller with exceptions, jitter and DSTPolicy applied.
+	// +optional
+	NextHibernateTime *metav1.Time `json:"nextHibernateTime,omitempty"`
+
+	// NextWakeUpTime is the next wakeup boundary of the schedule, as resolved by
+	// the controller with exceptions, jitter and DSTPolicy applied.
+	// +optional
+	NextWakeUpTime *metav1.Time `json:"nextWakeUpTime,omitempty"`
+
+	// PreWake records the pre-wake hooks dispatched ahead of the next wakeup.
+	// +optional
+	PreWake *PreWakeStatus `json:"preWake,omitempty"`
+
+	// LastReassertTime is when the targets of the hibernated plan were last
+	// reasserted, as configured by spec.behavior.reassertInterval.
+	// +optional
+	LastReassertTime *metav1.Time `json:"lastReassertTime,omitempty"`
+
+	// ExecutionHistory records historical execution cycles, up to
+	// spec.executionHistory.limit (5 by default).
 	// Each cycle contains shutdown and wakeup operation summaries.
-	// Oldest cycles are pruned when limit is exceeded.
+	// Oldest cycles are pruned when limit is exceeded, and archived as
+	// HibernationReports when spec.executionHistory.archive is set.
 	// +optional
 	ExecutionHistory []ExecutionCycle `json:"executionHistory,omitempty"`
+
+	// Conditions represent the latest available observations of the plan's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// PreWakeStatus records the pre-wake hooks dispatched for a cycle.
+type PreWakeStatus struct {
+	// CycleID is the hibernation cycle the hooks were dispatched for.
+	CycleID string `json:"cycleID"`
+
+	// Targets lists the targets whose pre-wake hook has been dispatched.
+	// +optional
+	Targets []string `json:"targets,omitempty"`
 }
 
 // ExceptionReference tracks an exception in the plan's history.
@@ -531,6 +1369,8 @@ type ExceptionReference struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,shortName=hplan
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Next Hibernate",type=string,format=date-time,JSONPath=`.status.nextHibernateTime`
+// +kubebuilder:printcolumn:name="Next WakeUp",type=string,format=date-time,JSONPath=`.status.nextWakeUpTime`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // HibernatePlan is the Schema for the hibernateplans API.
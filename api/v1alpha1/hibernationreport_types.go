@@ -0,0 +1,162 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReportType is the kind of a HibernationReport.
+// +kubebuilder:validation:Enum=Cycle;Daily;Weekly
+type ReportType string
+
+const (
+	// ReportTypeCycle archives one execution cycle of a plan.
+	ReportTypeCycle ReportType = "Cycle"
+	// ReportTypeDaily rolls up the plans of a namespace over a day.
+	ReportTypeDaily ReportType = "Daily"
+	// ReportTypeWeekly rolls up the plans of a namespace over a week, starting on Monday.
+	ReportTypeWeekly ReportType = "Weekly"
+)
+
+// HibernationReportSpec holds an archived execution cycle of a plan, or a
+// roll-up of the plans of a namespace over a period.
+type HibernationReportSpec struct {
+	// Type is the kind of report. Cycle reports set PlanRef and Cycle; Daily
+	// and Weekly reports set Period and Summary.
+	// +kubebuilder:default=Cycle
+	// +optional
+	Type ReportType `json:"type,omitempty"`
+
+	// PlanRef references the HibernatePlan the cycle belongs to.
+	// +optional
+	PlanRef *PlanReference `json:"planRef,omitempty"`
+
+	// Cycle is the archived execution cycle, as it was last recorded in the
+	// plan's status.executionHistory.
+	// +optional
+	Cycle *ExecutionCycle `json:"cycle,omitempty"`
+
+	// Period is the time range a roll-up covers, in UTC.
+	// +optional
+	Period *ReportPeriod `json:"period,omitempty"`
+
+	// Summary rolls up the operations of the namespace's plans over Period.
+	// +optional
+	Summary *ReportSummary `json:"summary,omitempty"`
+
+	// RetainUntil is when the controller deletes the report. Unset keeps the
+	// report until it is deleted.
+	// +optional
+	RetainUntil *metav1.Time `json:"retainUntil,omitempty"`
+}
+
+// ReportPeriod is the time range a roll-up covers.
+type ReportPeriod struct {
+	// Start is the inclusive start of the period.
+	Start metav1.Time `json:"start"`
+
+	// End is the exclusive end of the period.
+	End metav1.Time `json:"end"`
+}
+
+// ReportSummary rolls up the operations of a namespace's plans over a period.
+// An operation counts toward the period it ended in; hibernated time counts
+// toward the periods it overlaps.
+type ReportSummary struct {
+	// Plans is the number of plans with an operation or hibernated time in the period.
+	Plans int32 `json:"plans"`
+
+	// Operations is the number of shutdown and wakeup operations that ended in the period.
+	Operations int32 `json:"operations"`
+
+	// FailedOperations is the number of those operations that did not succeed.
+	FailedOperations int32 `json:"failedOperations"`
+
+	// SuccessRate is the percentage of operations that succeeded, e.g. "97.5".
+	// Empty when no operation ended in the period.
+	// +optional
+	SuccessRate string `json:"successRate,omitempty"`
+
+	// MeanShutdownSeconds is the mean duration of the shutdown operations.
+	// +optional
+	MeanShutdownSeconds int64 `json:"meanShutdownSeconds,omitempty"`
+
+	// MeanWakeupSeconds is the mean duration of the wakeup operations.
+	// +optional
+	MeanWakeupSeconds int64 `json:"meanWakeupSeconds,omitempty"`
+
+	// HibernatedSeconds is the time the plans were hibernated in the period,
+	// summed across plans.
+	HibernatedSeconds int64 `json:"hibernatedSeconds"`
+
+	// EstimatedSavings is the hibernated time of the plans that declare an
+	// hourly cost in the hibernator.ardikabs.com/hourly-cost annotation,
+	// multiplied by that cost, e.g. "412.50". Empty when no plan declares one.
+	// +optional
+	EstimatedSavings string `json:"estimatedSavings,omitempty"`
+
+	// Targets breaks the hibernated time and the operations down per target.
+	// +optional
+	Targets []TargetReportSummary `json:"targets,omitempty"`
+}
+
+// TargetReportSummary rolls up the executions of one target of a plan over a period.
+type TargetReportSummary struct {
+	// Plan is the name of the plan the target belongs to.
+	Plan string `json:"plan"`
+
+	// Target is the target identifier (type/name).
+	Target string `json:"target"`
+
+	// HibernatedSeconds is the time the target was hibernated in the period.
+	HibernatedSeconds int64 `json:"hibernatedSeconds"`
+
+	// Executions is the number of executions of the target that finished in the period.
+	Executions int32 `json:"executions"`
+
+	// FailedExecutions is the number of those executions that failed.
+	FailedExecutions int32 `json:"failedExecutions"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=hreport
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Plan",type=string,JSONPath=`.spec.planRef.name`
+// +kubebuilder:printcolumn:name="Cycle",type=string,JSONPath=`.spec.cycle.cycleId`
+// +kubebuilder:printcolumn:name="Success Rate",type=string,JSONPath=`.spec.summary.successRate`
+// +kubebuilder:printcolumn:name="Retain Until",type=date,JSONPath=`.spec.retainUntil`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HibernationReport records the hibernation history of a namespace. Cycle
+// reports archive an execution cycle pruned from a HibernatePlan's
+// status.executionHistory when spec.executionHistory.archive is set, so the
+// history of a plan can be kept longer than its status holds. Daily and Weekly
+// reports are roll-ups the controller generates per namespace when enabled.
+// Reports are not owned by plans; the controller deletes them once their
+// retention passes.
+type HibernationReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec holds the archived cycle or the roll-up.
+	Spec HibernationReportSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HibernationReportList contains a list of HibernationReport.
+type HibernationReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of HibernationReport resources.
+	Items []HibernationReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HibernationReport{}, &HibernationReportList{})
+}
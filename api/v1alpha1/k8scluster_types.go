@@ -93,6 +93,12 @@ type K8SClusterSpec struct {
 	// K8S holds generic Kubernetes access configuration.
 	// +optional
 	K8S *K8SAccessConfig `json:"k8s,omitempty"`
+
+	// Network configures the proxy and CA bundle used to reach the cluster's API
+	// server. When unset, the Network of the CloudProvider referenced by
+	// ProviderRef is used.
+	// +optional
+	Network *ConnectorNetwork `json:"network,omitempty"`
 }
 
 // K8SClusterStatus defines the observed state of K8SCluster.
@@ -111,12 +117,22 @@ type K8SClusterStatus struct {
 	// ClusterType is the detected cluster type.
 	// +optional
 	ClusterType K8SClusterType `json:"clusterType,omitempty"`
+
+	// KubernetesVersion is the version reported by the cluster's API server.
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// NodeCount is the number of nodes in the cluster when it was last validated.
+	// +optional
+	NodeCount *int32 `json:"nodeCount,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.status.clusterType`
 // +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.kubernetesVersion`
+// +kubebuilder:printcolumn:name="Nodes",type=integer,JSONPath=`.status.nodeCount`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // K8SCluster is the Schema for the k8sclusters API.
@@ -6,7 +6,11 @@ Licensed under the Apache License, Version 2.0.
 package v1alpha1
 
 import (
+	"slices"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // ExceptionType defines the type of schedule exception.
@@ -86,11 +90,81 @@ type ExecutionOverride struct {
 	Behavior *Behavior `json:"behavior,omitempty"`
 }
 
+// RecurrenceFrequency is the period a recurring exception repeats on.
+// +kubebuilder:validation:Enum=Weekly;Monthly
+type RecurrenceFrequency string
+
+const (
+	// RecurrenceWeekly repeats every interval weeks.
+	RecurrenceWeekly RecurrenceFrequency = "Weekly"
+	// RecurrenceMonthly repeats every interval months.
+	RecurrenceMonthly RecurrenceFrequency = "Monthly"
+)
+
+// ExceptionRecurrence repeats an exception within its validity period, in the
+// spirit of an iCalendar RRULE. Each occurrence starts at the time of day of
+// validFrom on a day selected by the rule and lasts Duration.
+type ExceptionRecurrence struct {
+	// Frequency is how often the exception recurs.
+	// +kubebuilder:validation:Required
+	Frequency RecurrenceFrequency `json:"frequency"`
+
+	// Interval is the number of weeks or months between occurrences.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=12
+	// +optional
+	Interval int32 `json:"interval,omitempty"`
+
+	// ByDay selects weekdays (MON..SUN). With Monthly frequency a day may carry
+	// an ordinal: "1SAT" is the first Saturday, "-1FRI" the last Friday.
+	// Defaults to the weekday of validFrom for Weekly frequency.
+	// +kubebuilder:validation:items:Pattern=`^(-?[1-5])?(MON|TUE|WED|THU|FRI|SAT|SUN)$`
+	// +optional
+	ByDay []string `json:"byDay,omitempty"`
+
+	// ByMonthDay selects days of the month for Monthly frequency; negative
+	// values count from the end of the month (-1 is the last day).
+	// +optional
+	ByMonthDay []int32 `json:"byMonthDay,omitempty"`
+
+	// Duration is how long each occurrence lasts (e.g., "48h").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	Duration string `json:"duration"`
+
+	// Timezone is the IANA timezone days and the time of day are evaluated in.
+	// Defaults to UTC.
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// ExceptionOccurrence is a single occurrence of a recurring exception.
+type ExceptionOccurrence struct {
+	// Start is when the occurrence begins.
+	Start metav1.Time `json:"start"`
+
+	// End is when the occurrence ends.
+	End metav1.Time `json:"end"`
+}
+
 // ScheduleExceptionSpec defines the desired state of ScheduleException.
 type ScheduleExceptionSpec struct {
 	// PlanRef references the HibernatePlan this exception applies to.
-	// +kubebuilder:validation:Required
-	PlanRef PlanReference `json:"planRef"`
+	// Exactly one of planRef, planRefs, or planSelector must be set.
+	// +kubebuilder:validation:Optional
+	PlanRef PlanReference `json:"planRef,omitempty"`
+
+	// PlanRefs references several HibernatePlans in the exception's namespace,
+	// e.g. for an org-wide freeze.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=50
+	PlanRefs []PlanReference `json:"planRefs,omitempty"`
+
+	// PlanSelector applies the exception to every HibernatePlan in the exception's
+	// namespace whose labels match, including plans created later.
+	// +kubebuilder:validation:Optional
+	PlanSelector *metav1.LabelSelector `json:"planSelector,omitempty"`
 
 	// ValidFrom is the start time of the exception period (RFC3339 format).
 	// +kubebuilder:validation:Required
@@ -136,6 +210,39 @@ type ScheduleExceptionSpec struct {
 	// +kubebuilder:validation:Optional
 	// +optional
 	ExecutionOverride *ExecutionOverride `json:"executionOverride,omitempty"`
+
+	// RequiresApproval, when true, keeps the exception Pending until a plan
+	// owner approves its current generation. Owners are listed in the plan's
+	// hibernator.ardikabs.com/owners annotation.
+	// +kubebuilder:default=false
+	// +optional
+	RequiresApproval bool `json:"requiresApproval,omitempty"`
+
+	// Recurrence repeats the exception within validFrom–validUntil instead of
+	// applying it for the whole period.
+	// +optional
+	Recurrence *ExceptionRecurrence `json:"recurrence,omitempty"`
+
+	// TTLAfterExpiry deletes the exception once it has been expired for this long,
+	// measured from validUntil. Its reference is then pruned from plan status.
+	// Format: duration string (e.g., "24h", "168h").
+	// When empty, expired exceptions are kept until deleted manually.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	TTLAfterExpiry string `json:"ttlAfterExpiry,omitempty"`
+}
+
+// ExceptionApproval records a plan owner's approval of an exception.
+type ExceptionApproval struct {
+	// Approver is the username of the plan owner who approved the exception.
+	Approver string `json:"approver"`
+
+	// Generation is the exception generation the approval applies to.
+	// Changing the spec invalidates earlier approvals.
+	Generation int64 `json:"generation"`
+
+	// ApprovedAt is when the controller observed the approval.
+	ApprovedAt metav1.Time `json:"approvedAt"`
 }
 
 // ScheduleExceptionStatus defines the observed state of ScheduleException.
@@ -159,6 +266,17 @@ type ScheduleExceptionStatus struct {
 	// Message provides diagnostic information about the exception state.
 	// +kubebuilder:validation:Optional
 	Message string `json:"message,omitempty"`
+
+	// Approvals is the audit trail of approvals for exceptions that require
+	// one, oldest first. Only the 10 most recent are kept.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	Approvals []ExceptionApproval `json:"approvals,omitempty"`
+
+	// Occurrence is the current occurrence of a recurring exception, or the
+	// next one when none is in progress. Computed by the controller.
+	// +kubebuilder:validation:Optional
+	Occurrence *ExceptionOccurrence `json:"occurrence,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -183,6 +301,68 @@ type ScheduleException struct {
 	Status ScheduleExceptionStatus `json:"status,omitempty"`
 }
 
+// EffectiveWindow returns the period the exception applies in now or next. For a
+// recurring exception this is the occurrence recorded in status, or zero times
+// when there is none; otherwise it is validFrom to validUntil.
+func (e *ScheduleException) EffectiveWindow() (from, until time.Time) {
+	if e.Spec.Recurrence == nil {
+		return e.Spec.ValidFrom.Time, e.Spec.ValidUntil.Time
+	}
+	if e.Status.Occurrence == nil {
+		return time.Time{}, time.Time{}
+	}
+	return e.Status.Occurrence.Start.Time, e.Status.Occurrence.End.Time
+}
+
+// DeleteAfter returns when an expired exception is due for deletion under
+// spec.ttlAfterExpiry. It returns false when no valid TTL is set.
+func (e *ScheduleException) DeleteAfter() (time.Time, bool) {
+	if e.Spec.TTLAfterExpiry == "" || e.Spec.ValidUntil.IsZero() {
+		return time.Time{}, false
+	}
+	ttl, err := time.ParseDuration(e.Spec.TTLAfterExpiry)
+	if err != nil || ttl < 0 {
+		return time.Time{}, false
+	}
+	return e.Spec.ValidUntil.Add(ttl), true
+}
+
+// TargetsMultiplePlans reports whether the exception selects its plans through
+// planRefs or planSelector rather than a single planRef.
+func (e *ScheduleException) TargetsMultiplePlans() bool {
+	return len(e.Spec.PlanRefs) > 0 || e.Spec.PlanSelector != nil
+}
+
+// PlanNames returns the names of the plans the exception references explicitly.
+// Plans matched by planSelector are not included.
+func (e *ScheduleException) PlanNames() []string {
+	var names []string
+	if e.Spec.PlanRef.Name != "" {
+		names = append(names, e.Spec.PlanRef.Name)
+	}
+	for _, ref := range e.Spec.PlanRefs {
+		names = append(names, ref.Name)
+	}
+	return names
+}
+
+// AppliesToPlan reports whether the exception applies to the plan with the given
+// name and labels in the exception's namespace. An invalid planSelector matches
+// nothing.
+func (e *ScheduleException) AppliesToPlan(name string, planLabels map[string]string) bool {
+	if slices.Contains(e.PlanNames(), name) {
+		return true
+	}
+	if e.Spec.PlanSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(e.Spec.PlanSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(planLabels))
+}
+
 // +kubebuilder:object:root=true
 
 // ScheduleExceptionList contains a list of ScheduleException.
@@ -13,6 +13,10 @@ func KindOf(obj interface{}) string {
 		kind = "K8SCluster"
 	case *HibernateNotification:
 		kind = "HibernateNotification"
+	case *ClusterHibernatePlan:
+		kind = "ClusterHibernatePlan"
+	case *TargetPreset:
+		kind = "TargetPreset"
 	default:
 		kind = "Unknown"
 	}
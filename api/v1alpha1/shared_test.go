@@ -38,6 +38,18 @@ func TestKindOf_K8SCluster(t *testing.T) {
 	}
 }
 
+func TestKindOf_ClusterHibernatePlan(t *testing.T) {
+	if got := KindOf(&ClusterHibernatePlan{}); got != "ClusterHibernatePlan" {
+		t.Errorf("KindOf(*ClusterHibernatePlan) = %q, want %q", got, "ClusterHibernatePlan")
+	}
+}
+
+func TestKindOf_TargetPreset(t *testing.T) {
+	if got := KindOf(&TargetPreset{}); got != "TargetPreset" {
+		t.Errorf("KindOf(*TargetPreset) = %q, want %q", got, "TargetPreset")
+	}
+}
+
 func TestKindOf_Unknown(t *testing.T) {
 	if got := KindOf("string value"); got != "Unknown" {
 		t.Errorf("KindOf(string) = %q, want %q", got, "Unknown")
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TargetPresetSpec defines reusable target definitions.
+type TargetPresetSpec struct {
+	// Targets are the target definitions added to every plan referencing this
+	// preset. Connector references without a namespace resolve to the namespace
+	// of the referencing plan.
+	// +kubebuilder:validation:MinItems=1
+	Targets []Target `json:"targets"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=tpreset
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TargetPreset holds target definitions shared by many HibernatePlans, such as a
+// standard EKS scale-down. Plans reference presets through spec.targetsFrom and
+// the controller expands them into the plan's target list.
+type TargetPreset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the targets of the TargetPreset.
+	Spec TargetPresetSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetPresetList contains a list of TargetPreset.
+type TargetPresetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of TargetPreset resources.
+	Items []TargetPreset `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TargetPreset{}, &TargetPresetList{})
+}
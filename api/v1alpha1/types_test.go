@@ -89,6 +89,7 @@ func TestExecutionState_Constants(t *testing.T) {
 		{"Completed", StateCompleted, "Completed"},
 		{"Failed", StateFailed, "Failed"},
 		{"Aborted", StateAborted, "Aborted"},
+		{"Cancelled", StateCancelled, "Cancelled"},
 	}
 
 	for _, tt := range tests {
@@ -1275,3 +1276,41 @@ connectorRef:
 		})
 	}
 }
+
+func TestScheduleException_AppliesToPlan(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       ScheduleExceptionSpec
+		plan       string
+		planLabels map[string]string
+		want       bool
+	}{
+		{"planRef match", ScheduleExceptionSpec{PlanRef: PlanReference{Name: "dev"}}, "dev", nil, true},
+		{"planRef mismatch", ScheduleExceptionSpec{PlanRef: PlanReference{Name: "dev"}}, "prod", nil, false},
+		{"planRefs match", ScheduleExceptionSpec{PlanRefs: []PlanReference{{Name: "dev"}, {Name: "staging"}}}, "staging", nil, true},
+		{
+			"selector match",
+			ScheduleExceptionSpec{PlanSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}}},
+			"shop", map[string]string{"tier": "web", "env": "prod"}, true,
+		},
+		{
+			"selector mismatch",
+			ScheduleExceptionSpec{PlanSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}}},
+			"batch", map[string]string{"tier": "jobs"}, false,
+		},
+		{
+			"invalid selector matches nothing",
+			ScheduleExceptionSpec{PlanSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}}}},
+			"shop", map[string]string{"tier": "web"}, false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exc := &ScheduleException{Spec: tt.spec}
+			if got := exc.AppliesToPlan(tt.plan, tt.planLabels); got != tt.want {
+				t.Errorf("AppliesToPlan(%q) = %v, want %v", tt.plan, got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -37,6 +39,13 @@ func (in *AWSAuth) DeepCopyInto(out *AWSAuth) {
 		*out = new(StaticAuth)
 		**out = **in
 	}
+	if in.RoleChain != nil {
+		in, out := &in.RoleChain, &out.RoleChain
+		*out = make([]AssumeRoleStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSAuth.
@@ -65,6 +74,28 @@ func (in *AWSConfig) DeepCopy() *AWSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRoleStep) DeepCopyInto(out *AssumeRoleStep) {
+	*out = *in
+	if in.SessionTags != nil {
+		in, out := &in.SessionTags, &out.SessionTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleStep.
+func (in *AssumeRoleStep) DeepCopy() *AssumeRoleStep {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRoleStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Behavior) DeepCopyInto(out *Behavior) {
 	*out = *in
@@ -73,6 +104,16 @@ func (in *Behavior) DeepCopyInto(out *Behavior) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxResourcesPerTarget != nil {
+		in, out := &in.MaxResourcesPerTarget, &out.MaxResourcesPerTarget
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Behavior.
@@ -85,6 +126,38 @@ func (in *Behavior) DeepCopy() *Behavior {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutWindow) DeepCopyInto(out *BlackoutWindow) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutWindow.
+func (in *BlackoutWindow) DeepCopy() *BlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleRef) DeepCopyInto(out *CABundleRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleRef.
+func (in *CABundleRef) DeepCopy() *CABundleRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProvider) DeepCopyInto(out *CloudProvider) {
 	*out = *in
@@ -152,6 +225,16 @@ func (in *CloudProviderSpec) DeepCopyInto(out *CloudProviderSpec) {
 		*out = new(AWSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ConnectorNetwork)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderSpec.
@@ -183,9 +266,150 @@ func (in *CloudProviderStatus) DeepCopy() *CloudProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHibernatePlan) DeepCopyInto(out *ClusterHibernatePlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHibernatePlan.
+func (in *ClusterHibernatePlan) DeepCopy() *ClusterHibernatePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHibernatePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterHibernatePlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHibernatePlanList) DeepCopyInto(out *ClusterHibernatePlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterHibernatePlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHibernatePlanList.
+func (in *ClusterHibernatePlanList) DeepCopy() *ClusterHibernatePlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHibernatePlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterHibernatePlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHibernatePlanSpec) DeepCopyInto(out *ClusterHibernatePlanSpec) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHibernatePlanSpec.
+func (in *ClusterHibernatePlanSpec) DeepCopy() *ClusterHibernatePlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHibernatePlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHibernatePlanStatus) DeepCopyInto(out *ClusterHibernatePlanStatus) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]ClusterPlanMember, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHibernatePlanStatus.
+func (in *ClusterHibernatePlanStatus) DeepCopy() *ClusterHibernatePlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHibernatePlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPlanMember) DeepCopyInto(out *ClusterPlanMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPlanMember.
+func (in *ClusterPlanMember) DeepCopy() *ClusterPlanMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPlanMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectorNetwork) DeepCopyInto(out *ConnectorNetwork) {
+	*out = *in
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CABundleRef != nil {
+		in, out := &in.CABundleRef, &out.CABundleRef
+		*out = new(CABundleRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorNetwork.
+func (in *ConnectorNetwork) DeepCopy() *ConnectorNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectorRef) DeepCopyInto(out *ConnectorRef) {
 	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorRef.
@@ -198,6 +422,21 @@ func (in *ConnectorRef) DeepCopy() *ConnectorRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DateWindow) DeepCopyInto(out *DateWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DateWindow.
+func (in *DateWindow) DeepCopy() *DateWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DateWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Dependency) DeepCopyInto(out *Dependency) {
 	*out = *in
@@ -228,6 +467,64 @@ func (in *EKSConfig) DeepCopy() *EKSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExceptionApproval) DeepCopyInto(out *ExceptionApproval) {
+	*out = *in
+	in.ApprovedAt.DeepCopyInto(&out.ApprovedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExceptionApproval.
+func (in *ExceptionApproval) DeepCopy() *ExceptionApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(ExceptionApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExceptionOccurrence) DeepCopyInto(out *ExceptionOccurrence) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExceptionOccurrence.
+func (in *ExceptionOccurrence) DeepCopy() *ExceptionOccurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(ExceptionOccurrence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExceptionRecurrence) DeepCopyInto(out *ExceptionRecurrence) {
+	*out = *in
+	if in.ByDay != nil {
+		in, out := &in.ByDay, &out.ByDay
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ByMonthDay != nil {
+		in, out := &in.ByMonthDay, &out.ByMonthDay
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExceptionRecurrence.
+func (in *ExceptionRecurrence) DeepCopy() *ExceptionRecurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(ExceptionRecurrence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExceptionReference) DeepCopyInto(out *ExceptionReference) {
 	*out = *in
@@ -253,6 +550,21 @@ func (in *ExceptionReference) DeepCopy() *ExceptionReference {
 func (in *Execution) DeepCopyInto(out *Execution) {
 	*out = *in
 	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.RunnerPodTemplate != nil {
+		in, out := &in.RunnerPodTemplate, &out.RunnerPodTemplate
+		*out = new(RunnerPodTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobPolicy != nil {
+		in, out := &in.JobPolicy, &out.JobPolicy
+		*out = new(JobPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunnerCluster != nil {
+		in, out := &in.RunnerCluster, &out.RunnerCluster
+		*out = new(RunnerCluster)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Execution.
@@ -268,6 +580,13 @@ func (in *Execution) DeepCopy() *Execution {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionCycle) DeepCopyInto(out *ExecutionCycle) {
 	*out = *in
+	if in.CostAllocation != nil {
+		in, out := &in.CostAllocation, &out.CostAllocation
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.ShutdownExecution != nil {
 		in, out := &in.ShutdownExecution, &out.ShutdownExecution
 		*out = new(ExecutionOperationSummary)
@@ -290,6 +609,21 @@ func (in *ExecutionCycle) DeepCopy() *ExecutionCycle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionHistorySpec) DeepCopyInto(out *ExecutionHistorySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionHistorySpec.
+func (in *ExecutionHistorySpec) DeepCopy() *ExecutionHistorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionHistorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionOperationSummary) DeepCopyInto(out *ExecutionOperationSummary) {
 	*out = *in
@@ -305,6 +639,11 @@ func (in *ExecutionOperationSummary) DeepCopyInto(out *ExecutionOperationSummary
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(WakeVerificationResult)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionOperationSummary.
@@ -342,6 +681,21 @@ func (in *ExecutionOverride) DeepCopy() *ExecutionOverride {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionProgress) DeepCopyInto(out *ExecutionProgress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionProgress.
+func (in *ExecutionProgress) DeepCopy() *ExecutionProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 	*out = *in
@@ -353,6 +707,11 @@ func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(ExecutionProgress)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionStatus.
@@ -398,27 +757,208 @@ func (in *ExecutionStrategy) DeepCopy() *ExecutionStrategy {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GKEConfig) DeepCopyInto(out *GKEConfig) {
+func (in *ExecutionSummary) DeepCopyInto(out *ExecutionSummary) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEConfig.
-func (in *GKEConfig) DeepCopy() *GKEConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionSummary.
+func (in *ExecutionSummary) DeepCopy() *ExecutionSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(GKEConfig)
+	out := new(ExecutionSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HibernateNotification) DeepCopyInto(out *HibernateNotification) {
+func (in *GCPAuth) DeepCopyInto(out *GCPAuth) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(ServiceAccountAuth)
+		**out = **in
+	}
+	if in.WorkloadIdentityFederation != nil {
+		in, out := &in.WorkloadIdentityFederation, &out.WorkloadIdentityFederation
+		*out = new(GCPWorkloadIdentityFederation)
+		**out = **in
+	}
+	if in.ImpersonationChain != nil {
+		in, out := &in.ImpersonationChain, &out.ImpersonationChain
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuth.
+func (in *GCPAuth) DeepCopy() *GCPAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPConfig) DeepCopyInto(out *GCPConfig) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPConfig.
+func (in *GCPConfig) DeepCopy() *GCPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPWorkloadIdentityFederation) DeepCopyInto(out *GCPWorkloadIdentityFederation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPWorkloadIdentityFederation.
+func (in *GCPWorkloadIdentityFederation) DeepCopy() *GCPWorkloadIdentityFederation {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPWorkloadIdentityFederation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSRestoreStorage) DeepCopyInto(out *GCSRestoreStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSRestoreStorage.
+func (in *GCSRestoreStorage) DeepCopy() *GCSRestoreStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSRestoreStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GKEConfig) DeepCopyInto(out *GKEConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GKEConfig.
+func (in *GKEConfig) DeepCopy() *GKEConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GKEConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPReadinessCheck) DeepCopyInto(out *HTTPReadinessCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReadinessCheck.
+func (in *HTTPReadinessCheck) DeepCopy() *HTTPReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernateExecution) DeepCopyInto(out *HibernateExecution) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernateExecution.
+func (in *HibernateExecution) DeepCopy() *HibernateExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernateExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HibernateExecution) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernateExecutionList) DeepCopyInto(out *HibernateExecutionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HibernateExecution, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernateExecutionList.
+func (in *HibernateExecutionList) DeepCopy() *HibernateExecutionList {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernateExecutionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HibernateExecutionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernateExecutionSpec) DeepCopyInto(out *HibernateExecutionSpec) {
+	*out = *in
+	out.PlanRef = in.PlanRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernateExecutionSpec.
+func (in *HibernateExecutionSpec) DeepCopy() *HibernateExecutionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernateExecutionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernateNotification) DeepCopyInto(out *HibernateNotification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernateNotification.
@@ -606,6 +1146,26 @@ func (in *HibernatePlanSpec) DeepCopyInto(out *HibernatePlanSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TargetsFrom != nil {
+		in, out := &in.TargetsFrom, &out.TargetsFrom
+		*out = make([]TargetPresetReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(RestoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WakeVerification != nil {
+		in, out := &in.WakeVerification, &out.WakeVerification
+		*out = new(WakeVerification)
+		**out = **in
+	}
+	if in.ExecutionHistory != nil {
+		in, out := &in.ExecutionHistory, &out.ExecutionHistory
+		*out = new(ExecutionHistorySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernatePlanSpec.
@@ -632,6 +1192,11 @@ func (in *HibernatePlanStatus) DeepCopyInto(out *HibernatePlanStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExecutionSummary != nil {
+		in, out := &in.ExecutionSummary, &out.ExecutionSummary
+		*out = new(ExecutionSummary)
+		**out = **in
+	}
 	if in.LastRetryTime != nil {
 		in, out := &in.LastRetryTime, &out.LastRetryTime
 		*out = (*in).DeepCopy()
@@ -648,6 +1213,23 @@ func (in *HibernatePlanStatus) DeepCopyInto(out *HibernatePlanStatus) {
 		*out = new(PlanSnapshot)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NextHibernateTime != nil {
+		in, out := &in.NextHibernateTime, &out.NextHibernateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextWakeUpTime != nil {
+		in, out := &in.NextWakeUpTime, &out.NextWakeUpTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PreWake != nil {
+		in, out := &in.PreWake, &out.PreWake
+		*out = new(PreWakeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastReassertTime != nil {
+		in, out := &in.LastReassertTime, &out.LastReassertTime
+		*out = (*in).DeepCopy()
+	}
 	if in.ExecutionHistory != nil {
 		in, out := &in.ExecutionHistory, &out.ExecutionHistory
 		*out = make([]ExecutionCycle, len(*in))
@@ -655,6 +1237,13 @@ func (in *HibernatePlanStatus) DeepCopyInto(out *HibernatePlanStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernatePlanStatus.
@@ -667,6 +1256,165 @@ func (in *HibernatePlanStatus) DeepCopy() *HibernatePlanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernatePlanTemplate) DeepCopyInto(out *HibernatePlanTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernatePlanTemplate.
+func (in *HibernatePlanTemplate) DeepCopy() *HibernatePlanTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernatePlanTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationReport) DeepCopyInto(out *HibernationReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationReport.
+func (in *HibernationReport) DeepCopy() *HibernationReport {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HibernationReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationReportList) DeepCopyInto(out *HibernationReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HibernationReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationReportList.
+func (in *HibernationReportList) DeepCopy() *HibernationReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HibernationReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationReportSpec) DeepCopyInto(out *HibernationReportSpec) {
+	*out = *in
+	if in.PlanRef != nil {
+		in, out := &in.PlanRef, &out.PlanRef
+		*out = new(PlanReference)
+		**out = **in
+	}
+	if in.Cycle != nil {
+		in, out := &in.Cycle, &out.Cycle
+		*out = new(ExecutionCycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(ReportPeriod)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(ReportSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetainUntil != nil {
+		in, out := &in.RetainUntil, &out.RetainUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationReportSpec.
+func (in *HibernationReportSpec) DeepCopy() *HibernationReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobPolicy) DeepCopyInto(out *JobPolicy) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobPolicy.
+func (in *JobPolicy) DeepCopy() *JobPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(JobPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTemplateReference) DeepCopyInto(out *JobTemplateReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTemplateReference.
+func (in *JobTemplateReference) DeepCopy() *JobTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SAccessConfig) DeepCopyInto(out *K8SAccessConfig) {
 	*out = *in
@@ -769,6 +1517,11 @@ func (in *K8SClusterSpec) DeepCopyInto(out *K8SClusterSpec) {
 		*out = new(K8SAccessConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ConnectorNetwork)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SClusterSpec.
@@ -788,6 +1541,11 @@ func (in *K8SClusterStatus) DeepCopyInto(out *K8SClusterStatus) {
 		in, out := &in.LastValidated, &out.LastValidated
 		*out = (*in).DeepCopy()
 	}
+	if in.NodeCount != nil {
+		in, out := &in.NodeCount, &out.NodeCount
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8SClusterStatus.
@@ -858,126 +1616,461 @@ func (in *NotificationSinkStatus) DeepCopyInto(out *NotificationSinkStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkStatus.
-func (in *NotificationSinkStatus) DeepCopy() *NotificationSinkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkStatus.
+func (in *NotificationSinkStatus) DeepCopy() *NotificationSinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectKeyReference) DeepCopyInto(out *ObjectKeyReference) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectKeyReference.
+func (in *ObjectKeyReference) DeepCopy() *ObjectKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OffHourWindow) DeepCopyInto(out *OffHourWindow) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OffHourWindow.
+func (in *OffHourWindow) DeepCopy() *OffHourWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(OffHourWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Parameters) DeepCopyInto(out *Parameters) {
+	*out = *in
+	if in.Raw != nil {
+		in, out := &in.Raw, &out.Raw
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameters.
+func (in *Parameters) DeepCopy() *Parameters {
+	if in == nil {
+		return nil
+	}
+	out := new(Parameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanReference) DeepCopyInto(out *PlanReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanReference.
+func (in *PlanReference) DeepCopy() *PlanReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanSnapshot) DeepCopyInto(out *PlanSnapshot) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]Target, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Execution.DeepCopyInto(&out.Execution)
+	in.Behavior.DeepCopyInto(&out.Behavior)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanSnapshot.
+func (in *PlanSnapshot) DeepCopy() *PlanSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanTemplateMetadata) DeepCopyInto(out *PlanTemplateMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanTemplateMetadata.
+func (in *PlanTemplateMetadata) DeepCopy() *PlanTemplateMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanTemplateMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreWakeHook) DeepCopyInto(out *PreWakeHook) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(Parameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreWakeHook.
+func (in *PreWakeHook) DeepCopy() *PreWakeHook {
+	if in == nil {
+		return nil
+	}
+	out := new(PreWakeHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreWakeStatus) DeepCopyInto(out *PreWakeStatus) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreWakeStatus.
+func (in *PreWakeStatus) DeepCopy() *PreWakeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreWakeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderRef) DeepCopyInto(out *ProviderRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderRef.
+func (in *ProviderRef) DeepCopy() *ProviderRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
+	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyConfig.
+func (in *ProxyConfig) DeepCopy() *ProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportPeriod) DeepCopyInto(out *ReportPeriod) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportPeriod.
+func (in *ReportPeriod) DeepCopy() *ReportPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportSummary) DeepCopyInto(out *ReportSummary) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetReportSummary, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportSummary.
+func (in *ReportSummary) DeepCopy() *ReportSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReadinessCheck) DeepCopyInto(out *ResourceReadinessCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReadinessCheck.
+func (in *ResourceReadinessCheck) DeepCopy() *ResourceReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreEncryption) DeepCopyInto(out *RestoreEncryption) {
+	*out = *in
+	in.KeySecretRef.DeepCopyInto(&out.KeySecretRef)
+	if in.PreviousKeys != nil {
+		in, out := &in.PreviousKeys, &out.PreviousKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreEncryption.
+func (in *RestoreEncryption) DeepCopy() *RestoreEncryption {
 	if in == nil {
 		return nil
 	}
-	out := new(NotificationSinkStatus)
+	out := new(RestoreEncryption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectKeyReference) DeepCopyInto(out *ObjectKeyReference) {
+func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
-	if in.Key != nil {
-		in, out := &in.Key, &out.Key
-		*out = new(string)
-		**out = **in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(RestoreStorage)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectKeyReference.
-func (in *ObjectKeyReference) DeepCopy() *ObjectKeyReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
+func (in *RestoreSpec) DeepCopy() *RestoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectKeyReference)
+	out := new(RestoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OffHourWindow) DeepCopyInto(out *OffHourWindow) {
+func (in *RestoreStorage) DeepCopyInto(out *RestoreStorage) {
 	*out = *in
-	if in.DaysOfWeek != nil {
-		in, out := &in.DaysOfWeek, &out.DaysOfWeek
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3RestoreStorage)
+		**out = **in
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(GCSRestoreStorage)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(RestoreEncryption)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OffHourWindow.
-func (in *OffHourWindow) DeepCopy() *OffHourWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStorage.
+func (in *RestoreStorage) DeepCopy() *RestoreStorage {
 	if in == nil {
 		return nil
 	}
-	out := new(OffHourWindow)
+	out := new(RestoreStorage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Parameters) DeepCopyInto(out *Parameters) {
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
-	if in.Raw != nil {
-		in, out := &in.Raw, &out.Raw
-		*out = make([]byte, len(*in))
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffMultiplier != nil {
+		in, out := &in.BackoffMultiplier, &out.BackoffMultiplier
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]RetryClass, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameters.
-func (in *Parameters) DeepCopy() *Parameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(Parameters)
+	out := new(RetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlanReference) DeepCopyInto(out *PlanReference) {
+func (in *RunnerCluster) DeepCopyInto(out *RunnerCluster) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanReference.
-func (in *PlanReference) DeepCopy() *PlanReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerCluster.
+func (in *RunnerCluster) DeepCopy() *RunnerCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(PlanReference)
+	out := new(RunnerCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlanSnapshot) DeepCopyInto(out *PlanSnapshot) {
+func (in *RunnerPodTemplate) DeepCopyInto(out *RunnerPodTemplate) {
 	*out = *in
-	if in.Targets != nil {
-		in, out := &in.Targets, &out.Targets
-		*out = make([]Target, len(*in))
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.Execution.DeepCopyInto(&out.Execution)
-	in.Behavior.DeepCopyInto(&out.Behavior)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerSecurityContext != nil {
+		in, out := &in.ContainerSecurityContext, &out.ContainerSecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanSnapshot.
-func (in *PlanSnapshot) DeepCopy() *PlanSnapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerPodTemplate.
+func (in *RunnerPodTemplate) DeepCopy() *RunnerPodTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(PlanSnapshot)
+	out := new(RunnerPodTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderRef) DeepCopyInto(out *ProviderRef) {
+func (in *S3RestoreStorage) DeepCopyInto(out *S3RestoreStorage) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderRef.
-func (in *ProviderRef) DeepCopy() *ProviderRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3RestoreStorage.
+func (in *S3RestoreStorage) DeepCopy() *S3RestoreStorage {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderRef)
+	out := new(S3RestoreStorage)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -992,6 +2085,18 @@ func (in *Schedule) DeepCopyInto(out *Schedule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]DateWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]BlackoutWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
@@ -1067,6 +2172,16 @@ func (in *ScheduleExceptionList) DeepCopyObject() runtime.Object {
 func (in *ScheduleExceptionSpec) DeepCopyInto(out *ScheduleExceptionSpec) {
 	*out = *in
 	out.PlanRef = in.PlanRef
+	if in.PlanRefs != nil {
+		in, out := &in.PlanRefs, &out.PlanRefs
+		*out = make([]PlanReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PlanSelector != nil {
+		in, out := &in.PlanSelector, &out.PlanSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ValidFrom.DeepCopyInto(&out.ValidFrom)
 	in.ValidUntil.DeepCopyInto(&out.ValidUntil)
 	if in.Windows != nil {
@@ -1088,6 +2203,11 @@ func (in *ScheduleExceptionSpec) DeepCopyInto(out *ScheduleExceptionSpec) {
 		*out = new(ExecutionOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Recurrence != nil {
+		in, out := &in.Recurrence, &out.Recurrence
+		*out = new(ExceptionRecurrence)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleExceptionSpec.
@@ -1115,6 +2235,18 @@ func (in *ScheduleExceptionStatus) DeepCopyInto(out *ScheduleExceptionStatus) {
 		in, out := &in.DetachedAt, &out.DetachedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]ExceptionApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Occurrence != nil {
+		in, out := &in.Occurrence, &out.Occurrence
+		*out = new(ExceptionOccurrence)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleExceptionStatus.
@@ -1170,6 +2302,11 @@ func (in *Stage) DeepCopyInto(out *Stage) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(StageReadinessProbe)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stage.
@@ -1182,6 +2319,36 @@ func (in *Stage) DeepCopy() *Stage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageReadinessProbe) DeepCopyInto(out *StageReadinessProbe) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPReadinessCheck)
+		**out = **in
+	}
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPReadinessCheck)
+		**out = **in
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(ResourceReadinessCheck)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageReadinessProbe.
+func (in *StageReadinessProbe) DeepCopy() *StageReadinessProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(StageReadinessProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticAuth) DeepCopyInto(out *StaticAuth) {
 	*out = *in
@@ -1198,15 +2365,35 @@ func (in *StaticAuth) DeepCopy() *StaticAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPReadinessCheck) DeepCopyInto(out *TCPReadinessCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPReadinessCheck.
+func (in *TCPReadinessCheck) DeepCopy() *TCPReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Target) DeepCopyInto(out *Target) {
 	*out = *in
-	out.ConnectorRef = in.ConnectorRef
+	in.ConnectorRef.DeepCopyInto(&out.ConnectorRef)
 	if in.Parameters != nil {
 		in, out := &in.Parameters, &out.Parameters
 		*out = new(Parameters)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PreWake != nil {
+		in, out := &in.PreWake, &out.PreWake
+		*out = new(PreWakeHook)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Target.
@@ -1261,3 +2448,152 @@ func (in *TargetOverride) DeepCopy() *TargetOverride {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetPreset) DeepCopyInto(out *TargetPreset) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetPreset.
+func (in *TargetPreset) DeepCopy() *TargetPreset {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetPreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetPreset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetPresetList) DeepCopyInto(out *TargetPresetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetPreset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetPresetList.
+func (in *TargetPresetList) DeepCopy() *TargetPresetList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetPresetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetPresetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetPresetReference) DeepCopyInto(out *TargetPresetReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetPresetReference.
+func (in *TargetPresetReference) DeepCopy() *TargetPresetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetPresetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetPresetSpec) DeepCopyInto(out *TargetPresetSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]Target, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetPresetSpec.
+func (in *TargetPresetSpec) DeepCopy() *TargetPresetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetPresetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetReportSummary) DeepCopyInto(out *TargetReportSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetReportSummary.
+func (in *TargetReportSummary) DeepCopy() *TargetReportSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetReportSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WakeVerification) DeepCopyInto(out *WakeVerification) {
+	*out = *in
+	out.JobTemplateRef = in.JobTemplateRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WakeVerification.
+func (in *WakeVerification) DeepCopy() *WakeVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(WakeVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WakeVerificationResult) DeepCopyInto(out *WakeVerificationResult) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WakeVerificationResult.
+func (in *WakeVerificationResult) DeepCopy() *WakeVerificationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(WakeVerificationResult)
+	in.DeepCopyInto(out)
+	return out
+}
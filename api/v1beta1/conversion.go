@@ -0,0 +1,15 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package v1beta1
+
+// v1beta1 is the storage version and the conversion hub. Older versions
+// implement conversion.Convertible against these types.
+
+// Hub marks HibernatePlan as a conversion hub.
+func (*HibernatePlan) Hub() {}
+
+// Hub marks ScheduleException as a conversion hub.
+func (*ScheduleException) Hub() {}
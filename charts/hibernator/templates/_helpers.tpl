@@ -65,6 +65,17 @@ Create the name of the service account to use
 {{- end }}
 {{- end }}
 
+{{/*
+Names of the streaming mutual TLS Secrets
+*/}}
+{{- define "hibernator.streamTLS.serverSecretName" -}}
+{{- default (printf "%s-stream-tls" (include "hibernator.fullname" .)) .Values.controlPlane.streamTLS.serverSecretName }}
+{{- end }}
+
+{{- define "hibernator.streamTLS.caSecretName" -}}
+{{- default (printf "%s-stream-ca" (include "hibernator.fullname" .)) .Values.controlPlane.streamTLS.caSecretName }}
+{{- end }}
+
 {{/*
 Create the name of the runner service account to use
 */}}
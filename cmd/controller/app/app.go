@@ -6,33 +6,59 @@ Licensed under the Apache License, Version 2.0.
 package app
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
+	"path"
+	"strings"
 	"time"
 
 	_ "time/tzdata"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	hibernatorv1beta1 "github.com/ardikabs/hibernator/api/v1beta1"
+	"github.com/ardikabs/hibernator/internal/audit"
+	"github.com/ardikabs/hibernator/internal/conversionwebhook"
+	"github.com/ardikabs/hibernator/internal/executionlog"
+	"github.com/ardikabs/hibernator/internal/incident"
+	"github.com/ardikabs/hibernator/internal/metrics"
+	"github.com/ardikabs/hibernator/internal/notification"
 	"github.com/ardikabs/hibernator/internal/provider"
+	"github.com/ardikabs/hibernator/internal/provider/processor/plan/state"
+	"github.com/ardikabs/hibernator/internal/runnerimage"
+	"github.com/ardikabs/hibernator/internal/statusapi"
 	"github.com/ardikabs/hibernator/internal/streaming"
+	"github.com/ardikabs/hibernator/internal/streaming/eventsink"
+	"github.com/ardikabs/hibernator/internal/streaming/streamtls"
+	"github.com/ardikabs/hibernator/internal/tracing"
 	"github.com/ardikabs/hibernator/internal/validationwebhook"
 	"github.com/ardikabs/hibernator/internal/version"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+	"github.com/ardikabs/hibernator/pkg/dedup"
 	"github.com/ardikabs/hibernator/pkg/envutil"
 )
 
+// minStreamTokenExpiration is the shortest lifetime Kubernetes accepts for a
+// projected ServiceAccount token.
+const minStreamTokenExpiration = 10 * time.Minute
+
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
@@ -41,25 +67,68 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(hibernatorv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(hibernatorv1beta1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 }
 
 // Options contains configuration for the controller app.
 type Options struct {
-	MetricsAddr             string
-	ProbeAddr               string
-	EnableLeaderElection    bool
-	LeaderElectionNamespace string
-	ControlPlaneEndpoint    string
-	ControlPlaneNamespace   string
-	RunnerImage             string
-	RunnerServiceAccount    string
-	GRPCServerAddr          string
-	WebSocketServerAddr     string
-	EnableStreaming         bool
-	WebhookCertDir          string
-	Workers                 int
-	SyncPeriod              time.Duration
-	ScheduleBufferDuration  string
+	MetricsAddr               string
+	ProbeAddr                 string
+	EnableLeaderElection      bool
+	LeaderElectionNamespace   string
+	LeaderElectionID          string
+	WatchNamespaces           string
+	Shard                     string
+	ControlPlaneEndpoint      string
+	ControlPlaneProbeTTL      time.Duration
+	RunnerHeartbeatTimeout    time.Duration
+	StaleRunnerRestartAfter   time.Duration
+	MaxConcurrentRunnerJobs   int
+	MaxResourcesPerTarget     int
+	ConnectorCheckInterval    time.Duration
+	ControlPlaneNamespace     string
+	RunnerImage               string
+	RunnerImages              string
+	RunnerImageAllowlist      string
+	RunnerServiceAccount      string
+	RunnerServiceAccounts     string
+	RestoreS3Endpoints        string
+	StreamTokenAudience       string
+	StreamTokenAudiences      string
+	StreamTokenExpiration     time.Duration
+	StreamTokenMountPath      string
+	StreamTLSCertDir          string
+	StreamTLSClientCADir      string
+	StreamTLSClientValidity   time.Duration
+	CostAllocationLabels      string
+	ExecutionObjectsThreshold int
+	GRPCServerAddr            string
+	WebSocketServerAddr       string
+	EnableStreaming           bool
+	ExecutionLogStore         bool
+	ExecutionLogRetention     time.Duration
+	ExecutionLogMaxSize       int
+	AuditLog                  bool
+	AuditLogMaxSize           int
+	ReportRollups             string
+	ReportRollupRetention     time.Duration
+	StatusAPIAddr             string
+	StatusAPICacheTTL         time.Duration
+	IncidentWebhookAddr       string
+	IncidentMaxDuration       time.Duration
+	WebhookCertDir            string
+	ConversionWebhookService  string
+	MigrateStorageVersion     bool
+	Workers                   int
+	SyncPeriod                time.Duration
+	ScheduleBufferDuration    string
+	NotificationDedup         dedup.Config
+	EventDedup                dedup.Config
+	MetricsPlanLabelMode      string
+	MetricsPlanLabelLimit     int
+	Tracing                   tracing.Config
+	EventSink                 eventsink.Config
 }
 
 // ParseFlags parses command-line flags and environment variables.
@@ -75,22 +144,92 @@ func ParseFlags() Options {
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.StringVar(&opts.LeaderElectionNamespace, "leader-election-namespace", envutil.GetString("LEADER_ELECTION_NAMESPACE", "hibernator-system"),
 		"The namespace in which the leader election resource will be created.")
+	flag.StringVar(&opts.LeaderElectionID, "leader-election-id", envutil.GetString("LEADER_ELECTION_ID", ""),
+		"The name of the leader election lease. Defaults to hibernator.ardikabs.com, prefixed with --shard when set. Every controller deployment splitting plans needs its own.")
+	flag.StringVar(&opts.WatchNamespaces, "watch-namespaces", envutil.GetString("WATCH_NAMESPACES", ""),
+		"Comma-separated namespaces whose plans, exceptions and connectors this controller reconciles, besides --control-plane-namespace. Empty watches all namespaces.")
+	flag.StringVar(&opts.Shard, "shard", envutil.GetString("SHARD", ""),
+		"Only reconcile HibernatePlans and ClusterHibernatePlans labeled "+wellknown.LabelShard+"=<shard>, along with the ScheduleExceptions of those plans. Empty reconciles all of them.")
 	flag.StringVar(&opts.RunnerImage, "runner-image", envutil.GetString("RUNNER_IMAGE", "ghcr.io/ardikabs/hibernator-runner:latest"),
 		"The runner container image to use for execution jobs.")
+	flag.StringVar(&opts.RunnerImages, "runner-images", envutil.GetString("RUNNER_IMAGES", ""),
+		"Comma-separated per-executor-type runner images (e.g. rds=ghcr.io/ardikabs/hibernator-rds-runner:latest,eks=...). Types without an entry use --runner-image.")
+	flag.StringVar(&opts.RunnerImageAllowlist, "runner-image-allowlist", envutil.GetString("RUNNER_IMAGE_ALLOWLIST", ""),
+		"Comma-separated images, or registry and repository prefixes ending in '/', that targets may select through runnerImage besides --runner-image and --runner-images. Empty only allows those.")
+	flag.StringVar(&opts.CostAllocationLabels, "cost-allocation-labels", envutil.GetString("COST_ALLOCATION_LABELS", "team=team,project=project,environment=environment"),
+		"Comma-separated dimension=label pairs copied from HibernatePlan labels onto every execution cycle for chargeback reporting. Set to empty to disable.")
+	flag.IntVar(&opts.ExecutionObjectsThreshold, "execution-objects-threshold", envutil.GetInt("EXECUTION_OBJECTS_THRESHOLD", 50),
+		"Number of targets from which a plan's per-target execution status is stored in HibernateExecution objects instead of the plan status. Set to 0 to always keep it in the plan status.")
 	flag.StringVar(&opts.RunnerServiceAccount, "runner-service-account", "hibernator-runner",
 		"The ServiceAccount name used by runner pods.")
+	flag.StringVar(&opts.RunnerServiceAccounts, "runner-service-accounts", envutil.GetString("RUNNER_SERVICE_ACCOUNTS", ""),
+		"Comma-separated ServiceAccounts, besides --runner-service-account, that targets may select for their runner pods through runnerServiceAccount, e.g. to assume a different IRSA role per AWS account.")
+	flag.StringVar(&opts.RestoreS3Endpoints, "restore-s3-endpoints", envutil.GetString("RESTORE_S3_ENDPOINTS", ""),
+		"Comma-separated custom S3 endpoints, e.g. of MinIO, that plans may select for their restore storage through spec.restore.storage.s3.endpoint. The controller sends its AWS credentials to them.")
+	flag.StringVar(&opts.StreamTokenAudience, "stream-token-audience", envutil.GetString("STREAM_TOKEN_AUDIENCE", wellknown.StreamTokenAudience),
+		"The audience of the projected token runner pods use to authenticate to the streaming servers.")
+	flag.StringVar(&opts.StreamTokenAudiences, "stream-token-additional-audiences", envutil.GetString("STREAM_TOKEN_ADDITIONAL_AUDIENCES", ""),
+		"Comma-separated audiences accepted by the streaming servers in addition to --stream-token-audience. Set the previous audience here while rotating it.")
+	flag.DurationVar(&opts.StreamTokenExpiration, "stream-token-expiration", envutil.GetDuration("STREAM_TOKEN_EXPIRATION", wellknown.StreamTokenExpirationSeconds*time.Second),
+		"The requested lifetime of the projected runner token. Must be at least 10m; the kubelet refreshes the token before it expires.")
+	flag.StringVar(&opts.StreamTokenMountPath, "stream-token-mount-path", envutil.GetString("STREAM_TOKEN_MOUNT_PATH", wellknown.StreamTokenMountPath),
+		"The directory the projected runner token is mounted at inside runner pods.")
+	flag.StringVar(&opts.StreamTLSCertDir, "stream-tls-cert-dir", envutil.GetString("STREAM_TLS_CERT_DIR", ""),
+		"The directory with the tls.crt and tls.key served by the streaming servers and the ca.crt that verifies runner client certificates and is given to runners to verify the servers. When set, the streaming servers require mutual TLS. Files are reloaded when they change.")
+	flag.StringVar(&opts.StreamTLSClientCADir, "stream-tls-client-ca-dir", envutil.GetString("STREAM_TLS_CLIENT_CA_DIR", ""),
+		"The directory with the tls.crt and tls.key of the CA that issues a client certificate to every runner job. Required with --stream-tls-cert-dir; its certificate must be in that directory's ca.crt.")
+	flag.DurationVar(&opts.StreamTLSClientValidity, "stream-tls-client-cert-validity", envutil.GetDuration("STREAM_TLS_CLIENT_CERT_VALIDITY", streamtls.DefaultClientCertValidity),
+		"How long runner client certificates are valid. Runners cannot reconnect to the streaming servers after it elapses.")
 	flag.StringVar(&opts.ControlPlaneEndpoint, "control-plane-endpoint", envutil.GetString("CONTROL_PLANE_ENDPOINT", ""),
-		"The endpoint for runner streaming callbacks.")
+		"The endpoint for runner streaming callbacks: a DNS name, an IPv4 address, or an IPv6 address with or without brackets.")
+	flag.DurationVar(&opts.ControlPlaneProbeTTL, "control-plane-probe-ttl", envutil.GetDuration("CONTROL_PLANE_PROBE_TTL", time.Minute),
+		"How long the reachability probe of --control-plane-endpoint is cached. Runner jobs are created without streaming endpoints while the probe fails. Set to 0 to disable the probe.")
+	flag.DurationVar(&opts.RunnerHeartbeatTimeout, "runner-heartbeat-timeout", envutil.GetDuration("RUNNER_HEARTBEAT_TIMEOUT", 3*time.Minute),
+		"How long an active runner Job may go without a heartbeat before its execution is reported stale in the plan status. Must exceed 90s; set to 0 to disable.")
+	flag.DurationVar(&opts.StaleRunnerRestartAfter, "stale-runner-restart-after", envutil.GetDuration("STALE_RUNNER_RESTART_AFTER", 0),
+		"How long after its last heartbeat the pod of a stale runner is deleted so that its Job retries it within its backoff limit. Must be at least --runner-heartbeat-timeout; set to 0 to only report stale runners.")
+	flag.IntVar(&opts.MaxConcurrentRunnerJobs, "max-concurrent-runner-jobs", envutil.GetInt("MAX_CONCURRENT_RUNNER_JOBS", 0),
+		"Maximum number of runner jobs running at once across all plans. Targets wait in Pending for a free slot beyond it. Set to 0 for no limit.")
+	flag.IntVar(&opts.MaxResourcesPerTarget, "max-resources-per-target", envutil.GetInt("MAX_RESOURCES_PER_TARGET", 0),
+		"Default maximum number of resources the shutdown of a single target may act on, for plans without spec.behavior.maxResourcesPerTarget. Executors selecting more abort before changing any. Set to 0 for no limit.")
+	flag.DurationVar(&opts.ConnectorCheckInterval, "connector-validation-interval", envutil.GetDuration("CONNECTOR_VALIDATION_INTERVAL", 10*time.Minute),
+		"How often CloudProvider credentials are validated and K8SCluster API servers probed, reported in their status. Set to 0 to disable both.")
 	flag.StringVar(&opts.ControlPlaneNamespace, "control-plane-namespace", envutil.GetString("CONTROL_PLANE_NAMESPACE", "hibernator-system"),
 		"The endpoint for runner streaming callbacks.")
 	flag.StringVar(&opts.GRPCServerAddr, "grpc-server-address", ":9444",
-		"The address for the gRPC streaming server.")
+		"The address for the gRPC streaming server. A bare port (e.g. :9444) listens on both IPv4 and IPv6.")
 	flag.StringVar(&opts.WebSocketServerAddr, "websocket-server-address", ":8082",
-		"The address for the WebSocket streaming server.")
+		"The address for the WebSocket streaming server. A bare port (e.g. :8082) listens on both IPv4 and IPv6.")
 	flag.BoolVar(&opts.EnableStreaming, "enable-streaming", true,
 		"Enable gRPC and WebSocket streaming servers for runner communication.")
+	flag.BoolVar(&opts.ExecutionLogStore, "execution-log-store", envutil.GetBool("EXECUTION_LOG_STORE", true),
+		"Persist streamed runner logs in a ConfigMap per execution, served by the status API and 'kubectl hibernator logs --source stored'.")
+	flag.DurationVar(&opts.ExecutionLogRetention, "execution-log-retention", envutil.GetDuration("EXECUTION_LOG_RETENTION", executionlog.DefaultRetention),
+		"How long persisted execution logs are kept after their last entry. Zero keeps them until their plan is deleted.")
+	flag.IntVar(&opts.ExecutionLogMaxSize, "execution-log-max-size", envutil.GetInt("EXECUTION_LOG_MAX_SIZE", executionlog.DefaultMaxSize),
+		"Maximum size in bytes of the persisted logs of one execution. The oldest entries are dropped beyond it.")
+	flag.BoolVar(&opts.AuditLog, "audit-log", envutil.GetBool("AUDIT_LOG", true),
+		"Record every phase transition of a plan and what triggered it in a ConfigMap per plan, served by the status API.")
+	flag.IntVar(&opts.AuditLogMaxSize, "audit-log-max-size", envutil.GetInt("AUDIT_LOG_MAX_SIZE", audit.DefaultMaxSize),
+		"Maximum size in bytes of the audit log of one plan. The oldest records are dropped beyond it.")
+	flag.StringVar(&opts.ReportRollups, "report-rollups", envutil.GetString("REPORT_ROLLUPS", ""),
+		"Comma-separated HibernationReport roll-ups (daily, weekly) to generate per namespace. Empty disables them.")
+	flag.DurationVar(&opts.ReportRollupRetention, "report-rollup-retention", envutil.GetDuration("REPORT_ROLLUP_RETENTION", 0),
+		"How long HibernationReport roll-ups are kept after they are generated. Zero keeps them until they are deleted.")
+	flag.StringVar(&opts.StatusAPIAddr, "status-api-address", envutil.GetString("STATUS_API_ADDRESS", ""),
+		"The address for the per-plan JSON status API used by dashboards, served over TLS with the certificate of --stream-tls-cert-dir. Empty disables it.")
+	flag.DurationVar(&opts.StatusAPICacheTTL, "status-api-cache-ttl", envutil.GetDuration("STATUS_API_CACHE_TTL", statusapi.DefaultCacheTTL),
+		"How long status documents and authorization decisions of the status API are cached.")
+	flag.StringVar(&opts.IncidentWebhookAddr, "incident-webhook-address", envutil.GetString("INCIDENT_WEBHOOK_ADDRESS", ""),
+		"The address for the incident webhook that suspends hibernation of affected plans while incidents are open, served over TLS with the certificate of --stream-tls-cert-dir. Empty disables it.")
+	flag.DurationVar(&opts.IncidentMaxDuration, "incident-max-duration", envutil.GetDuration("INCIDENT_MAX_DURATION", incident.DefaultMaxDuration),
+		"How long an incident keeps plans awake when its resolution is never delivered.")
 	flag.StringVar(&opts.WebhookCertDir, "webhook-cert-dir", "/tmp/k8s-webhook-server/serving-certs",
 		"The directory where webhook certificates are stored.")
+	flag.StringVar(&opts.ConversionWebhookService, "conversion-webhook-service", envutil.GetString("CONVERSION_WEBHOOK_SERVICE", ""),
+		"The <namespace>/<name> of the webhook Service. When set, multi-version CRDs are patched to use the conversion webhook with the CA from --webhook-cert-dir.")
+	flag.BoolVar(&opts.MigrateStorageVersion, "migrate-storage-version", envutil.GetBool("MIGRATE_STORAGE_VERSION", true),
+		"Re-write objects stored in an older API version in the current storage version and prune old versions from the CRD status.")
 	flag.IntVar(&opts.Workers, "workers", envutil.GetInt("WORKERS", 1),
 		"The number of concurrent reconcile workers. Controls MaxConcurrentReconciles for controllers.")
 	flag.DurationVar(&opts.SyncPeriod, "sync-period", envutil.GetDuration("SYNC_PERIOD", 10*time.Hour),
@@ -98,6 +237,34 @@ func ParseFlags() Options {
 	flag.StringVar(&opts.ScheduleBufferDuration, "schedule-buffer-duration", envutil.GetString("SCHEDULE_BUFFER_DURATION", "1m"),
 		"The buffer duration added to schedule evaluation windows. Defaults to 1m (1-minute) buffer duration to allow full-day operation both for shutdown and wakeup.")
 
+	flag.DurationVar(&opts.NotificationDedup.Window, "notification-dedup-window", envutil.GetDuration("NOTIFICATION_DEDUP_WINDOW", dedup.DefaultWindow),
+		"The window over which identical notifications are aggregated. Set to 0 to disable notification deduplication.")
+	flag.IntVar(&opts.NotificationDedup.Burst, "notification-dedup-burst", envutil.GetInt("NOTIFICATION_DEDUP_BURST", dedup.DefaultBurst),
+		"The number of identical notifications delivered per dedup window before further repeats are suppressed and summarized.")
+	flag.DurationVar(&opts.EventDedup.Window, "event-dedup-window", envutil.GetDuration("EVENT_DEDUP_WINDOW", dedup.DefaultWindow),
+		"The window over which identical Kubernetes Events are aggregated. Set to 0 to disable Event deduplication.")
+	flag.IntVar(&opts.EventDedup.Burst, "event-dedup-burst", envutil.GetInt("EVENT_DEDUP_BURST", dedup.DefaultBurst),
+		"The number of identical Kubernetes Events recorded per dedup window before further repeats are suppressed and summarized.")
+
+	flag.StringVar(&opts.MetricsPlanLabelMode, "metrics-plan-label-mode", envutil.GetString("METRICS_PLAN_LABEL_MODE", string(metrics.PlanLabelModePlan)),
+		"How per-plan metrics are labeled: 'plan' (namespace/name), 'namespace' (aggregated per namespace), or 'none' (aggregated across all plans).")
+	flag.IntVar(&opts.MetricsPlanLabelLimit, "metrics-plan-label-limit", envutil.GetInt("METRICS_PLAN_LABEL_LIMIT", 0),
+		"The maximum number of distinct plan label values on per-plan metrics; further plans are reported as '_overflow'. 0 means unlimited.")
+
+	flag.StringVar(&opts.Tracing.Endpoint, "tracing-endpoint", envutil.GetString("TRACING_ENDPOINT", ""),
+		"The host:port of an OTLP gRPC collector that controller and runner spans are exported to. Empty disables tracing.")
+	flag.BoolVar(&opts.Tracing.Insecure, "tracing-insecure", envutil.GetBool("TRACING_INSECURE", false),
+		"Export spans to the collector without TLS.")
+	flag.Float64Var(&opts.Tracing.SampleRatio, "tracing-sample-ratio", envutil.GetFloat64("TRACING_SAMPLE_RATIO", 1),
+		"The fraction of hibernation cycles traced, between 0 and 1.")
+
+	flag.StringVar(&opts.EventSink.Type, "event-sink-type", envutil.GetString("EVENT_SINK_TYPE", ""),
+		"The message bus execution progress and completion events are republished to: 'nats' or 'kafka' (through a Kafka REST Proxy). Empty disables it.")
+	flag.StringVar(&opts.EventSink.URL, "event-sink-url", envutil.GetString("EVENT_SINK_URL", ""),
+		"The comma-separated NATS server URLs, or the base URL of the Kafka REST Proxy. Credentials may be given as user info.")
+	flag.StringVar(&opts.EventSink.Topic, "event-sink-topic", envutil.GetString("EVENT_SINK_TOPIC", eventsink.DefaultTopic),
+		"The NATS subject or Kafka topic execution events are published to.")
+
 	zapOpts := zap.Options{
 		Development: true,
 	}
@@ -119,12 +286,39 @@ func ParseFlags() Options {
 
 // Run starts the hibernator controller manager.
 func Run(opts Options) error {
+	if err := metrics.ConfigurePlanLabels(metrics.PlanLabelMode(opts.MetricsPlanLabelMode), opts.MetricsPlanLabelLimit); err != nil {
+		setupLog.Error(err, "invalid metrics plan label configuration")
+		return err
+	}
+
+	if err := validateRunnerLiveness(opts.RunnerHeartbeatTimeout, opts.StaleRunnerRestartAfter); err != nil {
+		setupLog.Error(err, "invalid runner liveness configuration")
+		return err
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "hibernator-controller", opts.Tracing)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			setupLog.Error(err, "failed to flush spans")
+		}
+	}()
+
+	cacheOpts, err := shardCacheOptions(opts)
+	if err != nil {
+		setupLog.Error(err, "invalid sharding options")
+		return err
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
 		Logger: ctrl.Log.WithName("controller-runtime"),
-		Cache: cache.Options{
-			SyncPeriod: &opts.SyncPeriod,
-		},
+		Cache:  cacheOpts,
 		Metrics: metricsserver.Options{
 			BindAddress: opts.MetricsAddr,
 		},
@@ -134,7 +328,7 @@ func Run(opts Options) error {
 		}),
 		HealthProbeBindAddress:  opts.ProbeAddr,
 		LeaderElection:          opts.EnableLeaderElection,
-		LeaderElectionID:        "hibernator.ardikabs.com",
+		LeaderElectionID:        leaderElectionID(opts),
 		LeaderElectionNamespace: opts.LeaderElectionNamespace,
 	})
 	if err != nil {
@@ -142,26 +336,127 @@ func Run(opts Options) error {
 		return err
 	}
 
+	runnerImages, err := parseKeyValuePairs(opts.RunnerImages, "runner image", "<type>=<image>")
+	if err != nil {
+		setupLog.Error(err, "invalid runner images")
+		return err
+	}
+	runnerImageAllowlist := runnerimage.Allowlist(splitList(opts.RunnerImageAllowlist))
+	runnerImageAllowlist = append(runnerImageAllowlist, opts.RunnerImage)
+	for _, image := range runnerImages {
+		runnerImageAllowlist = append(runnerImageAllowlist, image)
+	}
+
+	costAllocationLabels, err := parseKeyValuePairs(opts.CostAllocationLabels, "cost allocation label", "<dimension>=<label>")
+	if err != nil {
+		setupLog.Error(err, "invalid cost allocation labels")
+		return err
+	}
+
+	reportRollups, err := parseReportRollups(opts.ReportRollups)
+	if err != nil {
+		setupLog.Error(err, "invalid report rollups")
+		return err
+	}
+	if opts.Shard != "" && len(reportRollups) > 0 {
+		// Roll-ups summarize every plan of a namespace, which may span shards.
+		err := fmt.Errorf("report rollups cannot be generated by shard %q", opts.Shard)
+		setupLog.Error(err, "invalid report rollups")
+		return err
+	}
+
+	if opts.StreamTokenExpiration < minStreamTokenExpiration {
+		err := fmt.Errorf("stream token expiration %s is below the minimum of %s", opts.StreamTokenExpiration, minStreamTokenExpiration)
+		setupLog.Error(err, "invalid stream token configuration")
+		return err
+	}
+	if !path.IsAbs(opts.StreamTokenMountPath) {
+		err := fmt.Errorf("stream token mount path %q must be absolute", opts.StreamTokenMountPath)
+		setupLog.Error(err, "invalid stream token configuration")
+		return err
+	}
+
 	clk := clock.RealClock{}
 
+	var runnerClientCerts state.ClientCertIssuer
+	if opts.StreamTLSCertDir != "" || opts.StreamTLSClientCADir != "" {
+		if opts.StreamTLSCertDir == "" || opts.StreamTLSClientCADir == "" {
+			err := fmt.Errorf("--stream-tls-cert-dir and --stream-tls-client-ca-dir must be set together")
+			setupLog.Error(err, "invalid streaming TLS configuration")
+			return err
+		}
+		issuer, err := streamtls.NewIssuer(opts.StreamTLSClientCADir, path.Join(opts.StreamTLSCertDir, streamtls.CAFile), opts.StreamTLSClientValidity, clk)
+		if err != nil {
+			setupLog.Error(err, "unable to load runner client CA")
+			return err
+		}
+		runnerClientCerts = issuer
+	}
+
+	var auditLog audit.Recorder
+	if opts.AuditLog {
+		auditLog = audit.NewStore(mgr.GetClient(), audit.Options{MaxSize: opts.AuditLogMaxSize})
+	}
+
 	setupLog.Info("setting up providers")
 	if err := provider.Setup(mgr, clk, provider.ProviderOptions{
 		Logger:                 ctrl.Log.WithName("provider"),
 		Workers:                opts.Workers,
 		ScheduleBufferDuration: opts.ScheduleBufferDuration,
 		ControlPlaneEndpoint:   opts.ControlPlaneEndpoint,
+		ControlPlaneProbeTTL:   opts.ControlPlaneProbeTTL,
 		RunnerImage:            opts.RunnerImage,
+		RunnerImages:           runnerImages,
+		RunnerImageAllowlist:   runnerImageAllowlist,
 		RunnerServiceAccount:   opts.RunnerServiceAccount,
+		RunnerServiceAccounts:  splitList(opts.RunnerServiceAccounts),
+		RestoreS3Endpoints:     splitList(opts.RestoreS3Endpoints),
+		StreamToken: state.StreamTokenConfig{
+			Audience:   opts.StreamTokenAudience,
+			Expiration: opts.StreamTokenExpiration,
+			MountPath:  opts.StreamTokenMountPath,
+		},
+		RunnerClientCerts:           runnerClientCerts,
+		Tracing:                     opts.Tracing,
+		RunnerHeartbeatTimeout:      opts.RunnerHeartbeatTimeout,
+		StaleRunnerRestartAfter:     opts.StaleRunnerRestartAfter,
+		MaxConcurrentRunnerJobs:     opts.MaxConcurrentRunnerJobs,
+		MaxResourcesPerTarget:       int32(opts.MaxResourcesPerTarget),
+		CostAllocationLabels:        costAllocationLabels,
+		ExecutionObjectsThreshold:   opts.ExecutionObjectsThreshold,
+		ConnectorValidationInterval: opts.ConnectorCheckInterval,
+		Audit:                       auditLog,
+		ReportRollups:               reportRollups,
+		ReportRollupRetention:       opts.ReportRollupRetention,
+		NamespaceScoped:             opts.WatchNamespaces != "",
+		Shard:                       opts.Shard,
+		NotificationOptions: []notification.Option{
+			notification.WithDispatcherConfig(notification.DispatcherConfig{
+				Dedup: opts.NotificationDedup,
+			}),
+		},
 	}); err != nil {
 		return err
 	}
 
 	// Set up validation webhooks
-	if err = validationwebhook.SetupWithManager(mgr, ctrl.Log.WithName("validationwebhook")); err != nil {
+	if err = validationwebhook.SetupWithManager(mgr, ctrl.Log.WithName("validationwebhook"), validationwebhook.Options{
+		RunnerImages:       runnerImageAllowlist,
+		RestoreS3Endpoints: splitList(opts.RestoreS3Endpoints),
+	}); err != nil {
 		setupLog.Error(err, "unable to setup webhooks")
 		return err
 	}
 
+	if err = conversionwebhook.SetupWithManager(mgr, ctrl.Log.WithName("conversionwebhook"), conversionwebhook.Options{
+		Service:        opts.ConversionWebhookService,
+		CertDir:        opts.WebhookCertDir,
+		MigrateStorage: opts.MigrateStorageVersion,
+	}); err != nil {
+		setupLog.Error(err, "unable to setup conversion webhook")
+		return err
+	}
+
 	// Add health checks
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -174,18 +469,58 @@ func Run(opts Options) error {
 
 	// Start streaming servers if enabled
 	if opts.EnableStreaming {
+		var logStore *executionlog.Store
+		if opts.ExecutionLogStore {
+			logStore = executionlog.NewStore(mgr.GetClient(), executionlog.Options{
+				MaxSize:   opts.ExecutionLogMaxSize,
+				Retention: opts.ExecutionLogRetention,
+				Clock:     clk,
+			}, ctrl.Log)
+			if err := mgr.Add(logStore); err != nil {
+				setupLog.Error(err, "unable to add execution log store")
+				return err
+			}
+		}
+
 		if err := streaming.SetupStreamingServerWithManager(mgr, streaming.Options{
 			GRPCAddr:                      opts.GRPCServerAddr,
 			WebSocketAddr:                 opts.WebSocketServerAddr,
 			Clock:                         clk,
 			RunnerServiceAccount:          opts.RunnerServiceAccount,
 			RunnerServiceAccountNamespace: opts.ControlPlaneNamespace,
+			RunnerServiceAccounts:         splitList(opts.RunnerServiceAccounts),
+			TokenAudience:                 opts.StreamTokenAudience,
+			AdditionalTokenAudiences:      splitList(opts.StreamTokenAudiences),
+			TLSCertDir:                    opts.StreamTLSCertDir,
+			LogStore:                      logStore,
+			EventDedup:                    opts.EventDedup,
+			EventSink:                     opts.EventSink,
 		}); err != nil {
 			setupLog.Error(err, "unable to initialize streaming servers")
 			return err
 		}
 	}
 
+	if err := statusapi.SetupWithManager(mgr, statusapi.Options{
+		Addr:       opts.StatusAPIAddr,
+		Clock:      clk,
+		CacheTTL:   opts.StatusAPICacheTTL,
+		TLSCertDir: opts.StreamTLSCertDir,
+	}); err != nil {
+		setupLog.Error(err, "unable to initialize status API server")
+		return err
+	}
+
+	if err := incident.SetupWithManager(mgr, incident.Options{
+		Addr:        opts.IncidentWebhookAddr,
+		Clock:       clk,
+		MaxDuration: opts.IncidentMaxDuration,
+		TLSCertDir:  opts.StreamTLSCertDir,
+	}); err != nil {
+		setupLog.Error(err, "unable to initialize incident webhook server")
+		return err
+	}
+
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
@@ -194,3 +529,108 @@ func Run(opts Options) error {
 
 	return nil
 }
+
+// splitList parses a comma-separated list, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseKeyValuePairs parses a comma-separated list of key=value pairs.
+// kind and format are only used in error messages.
+func parseKeyValuePairs(value, kind, format string) (map[string]string, error) {
+	pairs := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(pair, "=")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		if !ok || key == "" || val == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected %s", kind, pair, format)
+		}
+		pairs[key] = val
+	}
+	return pairs, nil
+}
+
+// parseReportRollups parses a comma-separated list of report roll-up types.
+func parseReportRollups(value string) ([]hibernatorv1alpha1.ReportType, error) {
+	var rollups []hibernatorv1alpha1.ReportType
+	for _, item := range splitList(value) {
+		switch strings.ToLower(item) {
+		case "daily":
+			rollups = append(rollups, hibernatorv1alpha1.ReportTypeDaily)
+		case "weekly":
+			rollups = append(rollups, hibernatorv1alpha1.ReportTypeWeekly)
+		default:
+			return nil, fmt.Errorf("invalid report rollup %q, expected daily or weekly", item)
+		}
+	}
+	return rollups, nil
+}
+
+// validateRunnerLiveness checks that the heartbeat timeout tolerates the
+// heartbeats recorded on runner Jobs being up to a record and a heartbeat
+// interval old, and that stale runners are only restarted once reported.
+func validateRunnerLiveness(timeout, restartAfter time.Duration) error {
+	if minTimeout := wellknown.RunnerHeartbeatRecordInterval + wellknown.RunnerHeartbeatInterval; timeout > 0 && timeout <= minTimeout {
+		return fmt.Errorf("runner heartbeat timeout %s must exceed %s", timeout, minTimeout)
+	}
+	if restartAfter > 0 && (timeout <= 0 || restartAfter < timeout) {
+		return fmt.Errorf("stale runner restart delay %s must be at least the runner heartbeat timeout", restartAfter)
+	}
+	return nil
+}
+
+// shardCacheOptions returns the cache options of the manager. With
+// --watch-namespaces the cache only holds objects of those namespaces and of
+// the control plane namespace, which holds the controller's own objects; runner
+// Jobs are created in the namespace of their plan. With --shard it only holds
+// the plans and cluster plans labeled with the shard. ScheduleExceptions are
+// not filtered: their creators do not set the label and a selector may match
+// plans of several shards, so each shard resolves them through its own plans.
+// The lifecycle of such an exception is driven by one shard; see
+// scheduleexception.LifecycleProcessor.
+func shardCacheOptions(opts Options) (cache.Options, error) {
+	cacheOpts := cache.Options{SyncPeriod: &opts.SyncPeriod}
+
+	if namespaces := splitList(opts.WatchNamespaces); len(namespaces) > 0 {
+		cacheOpts.DefaultNamespaces = map[string]cache.Config{opts.ControlPlaneNamespace: {}}
+		for _, ns := range namespaces {
+			cacheOpts.DefaultNamespaces[ns] = cache.Config{}
+		}
+	}
+
+	if opts.Shard != "" {
+		if errs := validation.IsValidLabelValue(opts.Shard); len(errs) > 0 {
+			return cache.Options{}, fmt.Errorf("invalid shard %q: %s", opts.Shard, strings.Join(errs, "; "))
+		}
+		byShard := cache.ByObject{Label: labels.SelectorFromSet(labels.Set{wellknown.LabelShard: opts.Shard})}
+		cacheOpts.ByObject = map[client.Object]cache.ByObject{
+			&hibernatorv1alpha1.HibernatePlan{}:        byShard,
+			&hibernatorv1alpha1.ClusterHibernatePlan{}: byShard,
+		}
+	}
+
+	return cacheOpts, nil
+}
+
+// leaderElectionID returns --leader-election-id, defaulting to an ID of the
+// shard so shards elect their leaders independently.
+func leaderElectionID(opts Options) string {
+	switch {
+	case opts.LeaderElectionID != "":
+		return opts.LeaderElectionID
+	case opts.Shard != "":
+		return opts.Shard + ".hibernator.ardikabs.com"
+	default:
+		return "hibernator.ardikabs.com"
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+)
+
+type dashboardOptions struct {
+	root          *common.RootOptions
+	allNamespaces bool
+	refresh       time.Duration
+}
+
+// NewCommand creates the "dashboard" subcommand.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	dashboardOpts := &dashboardOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "dashboard",
+		Short: "Show a live terminal dashboard of HibernatePlans",
+		Long: `Open a full-screen terminal dashboard listing HibernatePlans with their live
+phase, a countdown to the next scheduled transition and the progress of the
+current operation.
+
+The selected plan shows one progress bar per target. Running targets are
+estimated from how long the same target took in the previous cycle. The log
+pane streams the runner pod logs of the selected plan's current cycle.
+
+Plans are followed through a watch, so changes appear as soon as the controller
+writes them. The command needs an interactive terminal.
+
+Keys:
+  up/down, k/j   select a plan
+  l              show or hide the log pane
+  q, Ctrl-C      quit
+
+Examples:
+  kubectl hibernator dashboard
+  kubectl hibernator dashboard -n production
+  kubectl hibernator dashboard --all-namespaces`,
+		Args: cobra.NoArgs,
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runDashboard(ctx, dashboardOpts)
+		}),
+	}
+
+	cmd.Flags().BoolVarP(&dashboardOpts.allNamespaces, "all-namespaces", "A", false, "Show plans from all namespaces")
+	cmd.Flags().DurationVar(&dashboardOpts.refresh, "refresh", time.Second, "How often countdowns are redrawn and new runner pods are picked up")
+
+	return cmd
+}
+
+func runDashboard(ctx context.Context, opts *dashboardOptions) error {
+	if opts.refresh < 100*time.Millisecond {
+		return fmt.Errorf("--refresh must be at least 100ms")
+	}
+
+	restConfig, err := common.NewRESTConfig(opts.root)
+	if err != nil {
+		return err
+	}
+	c, err := client.NewWithWatch(restConfig, client.Options{Scheme: common.Scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+	if opts.allNamespaces {
+		ns = ""
+	}
+
+	tty, err := openTerminal(os.Stdin, os.Stdout)
+	if err != nil {
+		return err
+	}
+	defer tty.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan planEvent)
+	keys := make(chan key)
+	lines := make(chan logLine, 256)
+	go watchPlans(ctx, c, ns, events)
+	go tty.readKeys(ctx, keys)
+
+	m := newModel(ns)
+	logs := newLogFollower(clientset, lines)
+	defer logs.stop()
+
+	followSelected := func() {
+		changed, err := logs.follow(ctx, m.selectedPlan())
+		if changed {
+			m.logs = nil
+		}
+		if err != nil {
+			m.appendLog(fmt.Sprintf("%s%v%s", styleRed, err, styleReset))
+		}
+	}
+
+	ticker := time.NewTicker(opts.refresh)
+	defer ticker.Stop()
+
+	for {
+		width, height := tty.size()
+		tty.draw(m.render(width, height, time.Now()))
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case k := <-keys:
+			switch k {
+			case keyQuit:
+				return nil
+			case keyUp:
+				m.move(-1)
+			case keyDown:
+				m.move(1)
+			case keyToggleLogs:
+				m.showLogs = !m.showLogs
+			}
+			followSelected()
+
+		case ev := <-events:
+			switch {
+			case ev.err != nil:
+				m.watchErr = ev.err
+			case ev.snapshot != nil:
+				m.watchErr = nil
+				m.reset(ev.snapshot)
+			case ev.upsert != nil:
+				m.upsert(ev.upsert)
+			case ev.deleted != nil:
+				m.remove(*ev.deleted)
+			}
+			followSelected()
+
+		case l := <-lines:
+			// Drain what is buffered so a burst of log lines causes one redraw.
+			for drained := false; !drained; {
+				if l.gen == logs.gen {
+					m.appendLog(l.text)
+				}
+				select {
+				case l = <-lines:
+				default:
+					drained = true
+				}
+			}
+
+		case <-ticker.C:
+			if err := logs.refresh(); err != nil {
+				m.appendLog(fmt.Sprintf("%s%v%s", styleRed, err, styleReset))
+			}
+		}
+	}
+}
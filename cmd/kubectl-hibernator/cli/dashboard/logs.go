@@ -0,0 +1,154 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package dashboard
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// logTailLines is how many existing lines are read from each runner pod when
+// its stream starts.
+const logTailLines = 20
+
+// logLine is a runner log line tagged with the follow generation it belongs to,
+// so lines from streams of a previously selected plan can be dropped.
+type logLine struct {
+	gen  int
+	text string
+}
+
+// logFollower streams runner pod logs of the selected plan's current cycle. It
+// is driven from the dashboard's event loop and is not safe for concurrent use.
+type logFollower struct {
+	clientset kubernetes.Interface
+	lines     chan<- logLine
+
+	gen       int
+	namespace string
+	selector  string
+	ctx       context.Context
+	cancel    context.CancelFunc
+	streaming map[string]bool
+}
+
+func newLogFollower(clientset kubernetes.Interface, lines chan<- logLine) *logFollower {
+	return &logFollower{clientset: clientset, lines: lines}
+}
+
+// follow switches to the runner pods of plan's current cycle. It reports whether
+// the followed set changed, in which case existing streams are stopped.
+func (f *logFollower) follow(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) (bool, error) {
+	var namespace, selector string
+	if plan != nil && plan.Status.CurrentCycleID != "" {
+		namespace = plan.Namespace
+		selector = labels.SelectorFromSet(labels.Set{
+			wellknown.LabelPlan:    plan.Name,
+			wellknown.LabelCycleID: plan.Status.CurrentCycleID,
+		}).String()
+	}
+	if namespace == f.namespace && selector == f.selector {
+		return false, nil
+	}
+
+	f.stop()
+	f.gen++
+	f.namespace, f.selector = namespace, selector
+	if selector != "" {
+		f.ctx, f.cancel = context.WithCancel(ctx)
+		f.streaming = make(map[string]bool)
+		return true, f.refresh()
+	}
+	return true, nil
+}
+
+// refresh starts streams for runner pods that started since the last call.
+func (f *logFollower) refresh() error {
+	if f.selector == "" || f.ctx.Err() != nil {
+		return nil
+	}
+
+	pods, err := f.clientset.CoreV1().Pods(f.namespace).List(f.ctx, metav1.ListOptions{LabelSelector: f.selector})
+	if err != nil {
+		return fmt.Errorf("failed to list runner pods: %w", err)
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodPending || f.streaming[pod.Name] {
+			continue
+		}
+		f.streaming[pod.Name] = true
+		go f.stream(f.ctx, f.gen, pod)
+	}
+	return nil
+}
+
+// stop ends all streams of the current generation.
+func (f *logFollower) stop() {
+	if f.cancel != nil {
+		f.cancel()
+		f.cancel = nil
+	}
+}
+
+func (f *logFollower) stream(ctx context.Context, gen int, pod *corev1.Pod) {
+	target := pod.Labels[wellknown.LabelTarget]
+	req := f.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Follow:    true,
+		TailLines: ptr.To[int64](logTailLines),
+	})
+
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			send(ctx, f.lines, logLine{gen: gen, text: fmt.Sprintf("%s[%s] failed to stream logs: %v%s", styleRed, target, err, styleReset)})
+		}
+		return
+	}
+	// nolint:errcheck
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		send(ctx, f.lines, logLine{gen: gen, text: fmt.Sprintf("%s[%s]%s %s", styleDim, target, styleReset, summarizeLogLine(scanner.Text()))})
+	}
+}
+
+// summarizeLogLine reduces a structured runner log line to its level and
+// message, keeping the line as is when it is not JSON.
+func summarizeLogLine(line string) string {
+	var entry struct {
+		Level string `json:"level"`
+		Msg   string `json:"msg"`
+	}
+	if err := json.Unmarshal([]byte(line), &entry); err != nil || entry.Msg == "" {
+		return line
+	}
+
+	level := strings.ToUpper(entry.Level)
+	switch level {
+	case "ERROR":
+		return fmt.Sprintf("%s%-5s%s %s", styleRed, level, styleReset, entry.Msg)
+	case "WARN", "WARNING":
+		return fmt.Sprintf("%s%-5s%s %s", styleYellow, level, styleReset, entry.Msg)
+	default:
+		return fmt.Sprintf("%-5s %s", level, entry.Msg)
+	}
+}
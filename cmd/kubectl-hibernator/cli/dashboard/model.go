@@ -0,0 +1,351 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package dashboard
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+)
+
+// maxLogLines is how many log lines the log pane keeps for the selected plan.
+const maxLogLines = 200
+
+// planEntry is a plan as shown on the dashboard, with its next scheduled event.
+type planEntry struct {
+	plan      hibernatorv1alpha1.HibernatePlan
+	nextEvent *common.ScheduleEvent
+}
+
+// model holds everything the dashboard renders. It is only touched from the
+// command's event loop, so it needs no locking.
+type model struct {
+	namespace string
+	plans     map[types.NamespacedName]*planEntry
+	order     []types.NamespacedName
+	selected  types.NamespacedName
+	showLogs  bool
+	logs      []string
+	watchErr  error
+}
+
+func newModel(namespace string) *model {
+	return &model{
+		namespace: namespace,
+		plans:     make(map[types.NamespacedName]*planEntry),
+		showLogs:  true,
+	}
+}
+
+// upsert stores or replaces a plan and keeps the list sorted.
+func (m *model) upsert(entry *planEntry) {
+	key := types.NamespacedName{Namespace: entry.plan.Namespace, Name: entry.plan.Name}
+	m.plans[key] = entry
+	m.reorder()
+}
+
+// remove drops a plan from the list.
+func (m *model) remove(key types.NamespacedName) {
+	delete(m.plans, key)
+	m.reorder()
+}
+
+// reset replaces all plans, e.g. after the watch is re-established.
+func (m *model) reset(entries []*planEntry) {
+	m.plans = make(map[types.NamespacedName]*planEntry, len(entries))
+	for _, e := range entries {
+		m.plans[types.NamespacedName{Namespace: e.plan.Namespace, Name: e.plan.Name}] = e
+	}
+	m.reorder()
+}
+
+func (m *model) reorder() {
+	m.order = m.order[:0]
+	for key := range m.plans {
+		m.order = append(m.order, key)
+	}
+	sort.Slice(m.order, func(i, j int) bool {
+		return m.order[i].String() < m.order[j].String()
+	})
+	if _, ok := m.plans[m.selected]; !ok && len(m.order) > 0 {
+		m.selected = m.order[0]
+	}
+}
+
+// move shifts the selection by delta rows, clamped to the list.
+func (m *model) move(delta int) {
+	if len(m.order) == 0 {
+		return
+	}
+	idx := 0
+	for i, key := range m.order {
+		if key == m.selected {
+			idx = i
+		}
+	}
+	idx = max(0, min(len(m.order)-1, idx+delta))
+	m.selected = m.order[idx]
+}
+
+// selectedPlan returns the selected plan, or nil when there is none.
+func (m *model) selectedPlan() *hibernatorv1alpha1.HibernatePlan {
+	if e, ok := m.plans[m.selected]; ok {
+		return &e.plan
+	}
+	return nil
+}
+
+// appendLog adds a line to the log pane, dropping the oldest beyond maxLogLines.
+func (m *model) appendLog(line string) {
+	m.logs = append(m.logs, line)
+	if len(m.logs) > maxLogLines {
+		m.logs = m.logs[len(m.logs)-maxLogLines:]
+	}
+}
+
+// render draws the full screen for a terminal of the given size.
+func (m *model) render(width, height int, now time.Time) string {
+	var lines []string
+	add := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	scope := m.namespace
+	if scope == "" {
+		scope = "all namespaces"
+	}
+	add("%sHibernator dashboard%s  %s  %s", styleBold, styleReset, scope, now.Format("15:04:05"))
+	add("%s↑/↓ select  l toggle logs  q quit%s", styleDim, styleReset)
+	if m.watchErr != nil {
+		add("%sWatch interrupted, reconnecting: %v%s", styleRed, m.watchErr, styleReset)
+	}
+	add("")
+
+	add("  %-30s %-12s %-24s %s", "PLAN", "PHASE", "NEXT", "PROGRESS")
+	if len(m.order) == 0 {
+		add("  %sNo HibernatePlans found%s", styleDim, styleReset)
+	}
+	for _, key := range m.order {
+		e := m.plans[key]
+		name := e.plan.Name
+		if m.namespace == "" {
+			name = key.String()
+		}
+		cursor := " "
+		if key == m.selected {
+			cursor = ">"
+		}
+		add("%s %-30s %s %-24s %s", cursor, truncate(name, 30), phaseCell(e.plan.Status.Phase), nextCell(e.nextEvent, now), progressCell(e.plan.Status))
+	}
+
+	if plan := m.selectedPlan(); plan != nil {
+		add("")
+		lines = append(lines, m.renderTargets(plan, now)...)
+	}
+
+	if m.showLogs {
+		add("")
+		add("%sLogs%s", styleBold, styleReset)
+		// Give the log pane whatever space is left, keeping at least a few lines.
+		room := max(height-len(lines)-1, 3)
+		start := max(0, len(m.logs)-room)
+		if len(m.logs) == 0 {
+			add("  %sNo runner logs for the current cycle%s", styleDim, styleReset)
+		}
+		for _, l := range m.logs[start:] {
+			add("  %s", l)
+		}
+	}
+
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	for i, l := range lines {
+		lines[i] = clip(l, width)
+	}
+	return strings.Join(lines, "\r\n")
+}
+
+// renderTargets draws the selected plan's current operation, one progress bar
+// per target.
+func (m *model) renderTargets(plan *hibernatorv1alpha1.HibernatePlan, now time.Time) []string {
+	st := plan.Status
+	header := fmt.Sprintf("%s%s%s", styleBold, plan.Name, styleReset)
+	if st.CurrentOperation != "" {
+		header += fmt.Sprintf("  %s  cycle %s  stage %d", st.CurrentOperation, st.CurrentCycleID, st.CurrentStageIndex+1)
+	}
+	lines := []string{header}
+
+	if st.Phase == hibernatorv1alpha1.PhaseError && st.ErrorMessage != "" {
+		lines = append(lines, fmt.Sprintf("  %sError: %s%s", styleRed, st.ErrorMessage, styleReset))
+	}
+	if len(st.Executions) == 0 {
+		return append(lines, fmt.Sprintf("  %sNo executions in the current cycle%s", styleDim, styleReset))
+	}
+
+	estimates := previousDurations(st.ExecutionHistory, st.CurrentCycleID, st.CurrentOperation)
+	for _, exec := range st.Executions {
+		lines = append(lines, fmt.Sprintf("  %-7s %-32s %s %s",
+			printers.StateIcon(exec.State),
+			truncate(exec.Target, 32),
+			targetBar(exec, estimates[exec.Target], now),
+			targetElapsed(exec, now)))
+	}
+	return lines
+}
+
+// previousDurations returns how long each target took the last time the same
+// operation ran, used to estimate progress of running targets.
+func previousDurations(history []hibernatorv1alpha1.ExecutionCycle, currentCycle string, op hibernatorv1alpha1.PlanOperation) map[string]time.Duration {
+	durations := make(map[string]time.Duration)
+	for i := len(history) - 1; i >= 0; i-- {
+		cycle := history[i]
+		if cycle.CycleID == currentCycle {
+			continue
+		}
+		summary := cycle.ShutdownExecution
+		if op == hibernatorv1alpha1.OperationWakeUp {
+			summary = cycle.WakeupExecution
+		}
+		if summary == nil {
+			continue
+		}
+		for _, r := range summary.TargetResults {
+			if _, seen := durations[r.Target]; seen || r.State != hibernatorv1alpha1.StateCompleted || r.StartedAt == nil || r.FinishedAt == nil {
+				continue
+			}
+			durations[r.Target] = r.FinishedAt.Sub(r.StartedAt.Time)
+		}
+	}
+	return durations
+}
+
+const barWidth = 20
+
+// targetBar draws a target's progress. Running targets show the resources
+// their executor reported processed, or else are estimated from the target's
+// previous duration; either way they are never shown as complete.
+func targetBar(exec hibernatorv1alpha1.ExecutionStatus, estimate time.Duration, now time.Time) string {
+	switch exec.State {
+	case hibernatorv1alpha1.StateCompleted:
+		return bar(1, styleGreen)
+	case hibernatorv1alpha1.StateFailed:
+		return bar(1, styleRed)
+	case hibernatorv1alpha1.StateAborted, hibernatorv1alpha1.StateCancelled:
+		return bar(0, styleDim)
+	case hibernatorv1alpha1.StateRunning:
+		if p := exec.Progress; p != nil && p.Total > 0 {
+			return bar(min(float64(p.Completed)/float64(p.Total), 0.95), styleYellow)
+		}
+		if exec.StartedAt == nil || estimate <= 0 {
+			return bar(0.5, styleYellow)
+		}
+		return bar(min(now.Sub(exec.StartedAt.Time).Seconds()/estimate.Seconds(), 0.95), styleYellow)
+	default:
+		return bar(0, styleDim)
+	}
+}
+
+func bar(fraction float64, style string) string {
+	filled := int(fraction * barWidth)
+	return fmt.Sprintf("%s%s%s%s", style, strings.Repeat("█", filled), strings.Repeat("░", barWidth-filled), styleReset)
+}
+
+func targetElapsed(exec hibernatorv1alpha1.ExecutionStatus, now time.Time) string {
+	if exec.StartedAt == nil {
+		return ""
+	}
+	end := now
+	if exec.FinishedAt != nil {
+		end = exec.FinishedAt.Time
+	}
+	return printers.HumanDuration(end.Sub(exec.StartedAt.Time))
+}
+
+func phaseCell(phase hibernatorv1alpha1.PlanPhase) string {
+	style := ""
+	switch phase {
+	case hibernatorv1alpha1.PhaseActive:
+		style = styleGreen
+	case hibernatorv1alpha1.PhaseHibernated, hibernatorv1alpha1.PhaseSuspended:
+		style = styleBlue
+	case hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.PhaseWakingUp:
+		style = styleYellow
+	case hibernatorv1alpha1.PhaseError:
+		style = styleRed
+	}
+	return fmt.Sprintf("%s%-12s%s", style, phase, styleReset)
+}
+
+func nextCell(event *common.ScheduleEvent, now time.Time) string {
+	if event == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%s in %s", event.Operation, printers.HumanDuration(event.Time.Sub(now)))
+}
+
+// progressCell summarizes the current operation as done/total targets.
+func progressCell(st hibernatorv1alpha1.HibernatePlanStatus) string {
+	var done, total int
+	if s := st.ExecutionSummary; s != nil {
+		done, total = int(s.Completed+s.Failed+s.Aborted+s.Cancelled), int(s.Total)
+	} else {
+		total = len(st.Executions)
+		for _, e := range st.Executions {
+			switch e.State {
+			case hibernatorv1alpha1.StateCompleted, hibernatorv1alpha1.StateFailed, hibernatorv1alpha1.StateAborted, hibernatorv1alpha1.StateCancelled:
+				done++
+			}
+		}
+	}
+	if total == 0 {
+		return "-"
+	}
+	filled := done * 10 / total
+	return fmt.Sprintf("%s%s %d/%d", strings.Repeat("■", filled), strings.Repeat("□", 10-filled), done, total)
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
+
+// clip cuts a line to width visible columns, keeping ANSI escape sequences intact.
+func clip(s string, width int) string {
+	var (
+		b       strings.Builder
+		visible int
+		escape  bool
+	)
+	for _, r := range s {
+		switch {
+		case r == '\033':
+			escape = true
+		case escape:
+			if r == 'm' {
+				escape = false
+			}
+		default:
+			if visible >= width {
+				b.WriteString(styleReset)
+				return b.String()
+			}
+			visible++
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// ANSI styles used by the dashboard.
+const (
+	styleReset  = "\033[0m"
+	styleBold   = "\033[1m"
+	styleDim    = "\033[2m"
+	styleRed    = "\033[31m"
+	styleGreen  = "\033[32m"
+	styleYellow = "\033[33m"
+	styleBlue   = "\033[34m"
+)
+
+// Screen control sequences.
+const (
+	enterAltScreen = "\033[?1049h\033[?25l"
+	leaveAltScreen = "\033[?25h\033[?1049l"
+	cursorHome     = "\033[H"
+	clearScreen    = "\033[2J"
+)
+
+// key is a keyboard action understood by the dashboard.
+type key int
+
+const (
+	keyQuit key = iota
+	keyUp
+	keyDown
+	keyToggleLogs
+)
+
+// terminal owns the TTY while the dashboard runs: raw input, the alternate
+// screen, and full-frame redraws.
+type terminal struct {
+	in       *os.File
+	out      *os.File
+	oldState *term.State
+}
+
+// openTerminal switches stdin to raw mode and the output to the alternate
+// screen. It fails when either is not a terminal.
+func openTerminal(in, out *os.File) (*terminal, error) {
+	if !term.IsTerminal(int(in.Fd())) || !term.IsTerminal(int(out.Fd())) {
+		return nil, fmt.Errorf("dashboard requires an interactive terminal; use 'kubectl hibernator list' or 'status' instead")
+	}
+
+	oldState, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set terminal to raw mode: %w", err)
+	}
+
+	t := &terminal{in: in, out: out, oldState: oldState}
+	_, _ = io.WriteString(out, enterAltScreen)
+	return t, nil
+}
+
+// Close restores the terminal to its previous state.
+func (t *terminal) Close() {
+	_, _ = io.WriteString(t.out, leaveAltScreen)
+	_ = term.Restore(int(t.in.Fd()), t.oldState)
+}
+
+// size returns the terminal size, falling back to 80x24.
+func (t *terminal) size() (width, height int) {
+	width, height, err := term.GetSize(int(t.out.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// draw replaces the screen contents with frame.
+func (t *terminal) draw(frame string) {
+	_, _ = io.WriteString(t.out, cursorHome+clearScreen+frame)
+}
+
+// readKeys translates raw input into keys until ctx is done or input ends.
+func (t *terminal) readKeys(ctx context.Context, keys chan<- key) {
+	buf := make([]byte, 8)
+	for {
+		n, err := t.in.Read(buf)
+		if err != nil {
+			send(ctx, keys, keyQuit)
+			return
+		}
+
+		input := buf[:n]
+		switch {
+		case string(input) == "\033[A", string(input) == "k":
+			send(ctx, keys, keyUp)
+		case string(input) == "\033[B", string(input) == "j":
+			send(ctx, keys, keyDown)
+		case string(input) == "l":
+			send(ctx, keys, keyToggleLogs)
+		case string(input) == "q", input[0] == 3: // 3 is Ctrl-C in raw mode
+			send(ctx, keys, keyQuit)
+			return
+		}
+	}
+}
+
+// send delivers v unless ctx is done first.
+func send[T any](ctx context.Context, ch chan<- T, v T) {
+	select {
+	case ch <- v:
+	case <-ctx.Done():
+	}
+}
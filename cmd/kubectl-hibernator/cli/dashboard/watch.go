@@ -0,0 +1,122 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+)
+
+// rewatchDelay is how long the watcher waits before re-listing after the
+// watch ends or fails.
+const rewatchDelay = 2 * time.Second
+
+// planEvent is a change to the set of plans shown on the dashboard. Exactly one
+// of snapshot, upsert, deleted or err is set.
+type planEvent struct {
+	snapshot []*planEntry
+	upsert   *planEntry
+	deleted  *types.NamespacedName
+	err      error
+}
+
+// watchPlans lists the plans in namespace (all namespaces when empty) and then
+// follows changes through a watch, re-listing whenever the watch ends. Each
+// plan is enriched with its externalized executions and next scheduled event.
+func watchPlans(ctx context.Context, c client.WithWatch, namespace string, events chan<- planEvent) {
+	for ctx.Err() == nil {
+		if err := listAndWatch(ctx, c, namespace, events); err != nil && ctx.Err() == nil {
+			send(ctx, events, planEvent{err: err})
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rewatchDelay):
+		}
+	}
+}
+
+func listAndWatch(ctx context.Context, c client.WithWatch, namespace string, events chan<- planEvent) error {
+	var list hibernatorv1alpha1.HibernatePlanList
+	if err := c.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list HibernatePlans: %w", err)
+	}
+
+	snapshot := make([]*planEntry, 0, len(list.Items))
+	for i := range list.Items {
+		snapshot = append(snapshot, newPlanEntry(ctx, c, list.Items[i]))
+	}
+	send(ctx, events, planEvent{snapshot: snapshot})
+
+	w, err := c.Watch(ctx, &hibernatorv1alpha1.HibernatePlanList{},
+		client.InNamespace(namespace),
+		&client.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: list.ResourceVersion}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to watch HibernatePlans: %w", err)
+	}
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			plan, isPlan := ev.Object.(*hibernatorv1alpha1.HibernatePlan)
+			switch {
+			case ev.Type == watch.Error:
+				return fmt.Errorf("watch error: %v", ev.Object)
+			case !isPlan:
+				continue
+			case ev.Type == watch.Deleted:
+				key := types.NamespacedName{Namespace: plan.Namespace, Name: plan.Name}
+				send(ctx, events, planEvent{deleted: &key})
+			default:
+				send(ctx, events, planEvent{upsert: newPlanEntry(ctx, c, *plan)})
+			}
+		}
+	}
+}
+
+// newPlanEntry loads the plan's externalized executions and computes its next
+// scheduled event. Failures leave the respective field empty; the dashboard
+// still shows the plan.
+func newPlanEntry(ctx context.Context, c client.Client, plan hibernatorv1alpha1.HibernatePlan) *planEntry {
+	_ = common.LoadExecutions(ctx, c, &plan)
+
+	entry := &planEntry{plan: plan}
+	if plan.Spec.Suspend {
+		return entry
+	}
+
+	if event := common.StatusNextEvent(plan, time.Now()); event != nil {
+		entry.nextEvent = event
+		return entry
+	}
+
+	var exceptions []*scheduler.Exception
+	if excs, err := common.FetchActiveExceptions(ctx, c, plan); err == nil {
+		exceptions = excs
+	}
+	if event, err := common.ComputeNextEvent(plan.Spec.Schedule, exceptions); err == nil {
+		entry.nextEvent = event
+	}
+	return entry
+}
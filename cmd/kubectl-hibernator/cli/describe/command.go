@@ -34,14 +34,15 @@ func NewCommand(opts *common.RootOptions) *cobra.Command {
 - Schedule configuration (timezone, off-hour windows)
 - Execution strategy and behavior mode
 - List of targets with executor-specific parameters
-- Current status and execution history
+- Current status, next hibernate/wake-up times and execution history
 - Active exceptions and suspend state
 
 Examples:
   kubectl hibernator describe my-plan
   kubectl hibernator describe my-plan -n production
   kubectl hibernator describe my-plan --json`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runDescribe(ctx, describeOpts, args[0])
 		}),
@@ -62,7 +63,20 @@ func runDescribe(ctx context.Context, opts *describeOptions, planName string) er
 	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
 		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
 	}
+	if err := common.LoadExecutions(ctx, c, &plan); err != nil {
+		output.FromContext(ctx).Warning("Could not list target executions: %v", err)
+	}
+
+	out := &printers.DescribeOutput{StatusOutput: printers.StatusOutput{Plan: plan}}
+
+	exceptions, err := common.FetchActiveExceptions(ctx, c, plan)
+	if err != nil {
+		output.FromContext(ctx).Warning("Could not list schedule exceptions; next transitions ignore them: %v", err)
+	}
+	if out.NextHibernate, out.NextWakeUp, err = common.ComputeNextTransitions(plan.Spec.Schedule, exceptions); err != nil {
+		output.FromContext(ctx).Warning("Could not compute next transitions: %v", err)
+	}
 
 	d := &printers.Dispatcher{JSON: opts.root.JsonOutput}
-	return d.PrintObj(plan, os.Stdout)
+	return d.PrintObj(out, os.Stdout)
 }
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package discover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// pollInterval is how often the command re-reads the preview Jobs.
+const pollInterval = 2 * time.Second
+
+type discoverOptions struct {
+	root    *common.RootOptions
+	noWait  bool
+	timeout time.Duration
+}
+
+// NewCommand creates the "discover" command.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	discoverOpts := &discoverOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "discover <plan-name>",
+		Short: "List the resources each target of a HibernatePlan selects, without changing them",
+		Long: `Run only the discovery of each target's executor and list the concrete
+resources it selects, so tags, IDs, excludeTags and includeAll selectors can
+be verified before the first real shutdown. Nothing is stopped and no restore
+data is written.
+
+The command adds the one-shot preview annotation to an Active or Hibernated
+plan. The controller consumes it and dispatches a preview runner Job per
+target, and the command waits for the Jobs and prints what each one found.
+Executors without preview support fail their Job with a message saying so.
+
+Examples:
+  kubectl hibernator discover my-plan
+  kubectl hibernator discover my-plan --timeout 5m
+  kubectl hibernator discover my-plan --no-wait`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runDiscover(ctx, discoverOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().BoolVar(&discoverOpts.noWait, "no-wait", false, "Trigger the previews without waiting for their results")
+	cmd.Flags().DurationVar(&discoverOpts.timeout, "timeout", 10*time.Minute, "How long to wait for the preview Jobs before giving up")
+
+	return cmd
+}
+
+func runDiscover(ctx context.Context, opts *discoverOptions, planName string) error {
+	out := output.FromContext(ctx)
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
+		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
+	}
+
+	if plan.Status.Phase != hibernatorv1alpha1.PhaseActive && plan.Status.Phase != hibernatorv1alpha1.PhaseHibernated {
+		return fmt.Errorf("HibernatePlan %q is in %q phase; discover only applies to Active or Hibernated plans", planName, plan.Status.Phase)
+	}
+	if len(plan.Spec.Targets) == 0 {
+		return fmt.Errorf("HibernatePlan %q has no targets", planName)
+	}
+
+	// Jobs of earlier previews are told apart from the ones this run triggers.
+	earlier, err := listPreviewJobs(ctx, c, &plan)
+	if err != nil {
+		return err
+	}
+	seen := sets.New[string]()
+	for _, job := range earlier {
+		seen.Insert(job.Name)
+	}
+
+	patch := client.MergeFrom(plan.DeepCopy())
+	if plan.Annotations == nil {
+		plan.Annotations = make(map[string]string)
+	}
+	common.MarkTrue(plan.Annotations, wellknown.AnnotationPreview)
+	if err := c.Patch(ctx, &plan, patch); err != nil {
+		return fmt.Errorf("failed to patch HibernatePlan %q: %w", planName, err)
+	}
+
+	out.Success("Preview triggered for %d target(s) of HibernatePlan %q", len(plan.Spec.Targets), planName)
+	if opts.noWait {
+		out.Hint("Read the results from the termination messages of the Jobs labeled %s=%s", wellknown.LabelOperation, hibernatorv1alpha1.OperationPreview)
+		return nil
+	}
+
+	jobs, err := waitForPreviewJobs(ctx, c, &plan, seen, opts.timeout)
+	if err != nil {
+		return err
+	}
+
+	var failed int
+	for _, job := range jobs {
+		target := job.Labels[wellknown.LabelTarget]
+		msg, err := terminationMessage(ctx, c, &job)
+		if err != nil {
+			return err
+		}
+		if isJobFailed(&job) {
+			failed++
+			out.Error("%s: %s", target, msg)
+			continue
+		}
+		out.Success("%s: %s", target, msg)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d preview(s) failed", failed, len(jobs))
+	}
+	return nil
+}
+
+// listPreviewJobs returns the preview Jobs of the plan.
+func listPreviewJobs(ctx context.Context, c client.Client, plan *hibernatorv1alpha1.HibernatePlan) ([]batchv1.Job, error) {
+	var jobList batchv1.JobList
+	if err := c.List(ctx, &jobList,
+		client.InNamespace(plan.Namespace),
+		client.MatchingLabels{
+			wellknown.LabelPlan:      plan.Name,
+			wellknown.LabelOperation: string(hibernatorv1alpha1.OperationPreview),
+		},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list preview jobs in namespace %q: %w", plan.Namespace, err)
+	}
+	return jobList.Items, nil
+}
+
+// waitForPreviewJobs polls until a preview Job not in seen finished for every
+// target of the plan, and returns them sorted by target.
+func waitForPreviewJobs(ctx context.Context, c client.Client, plan *hibernatorv1alpha1.HibernatePlan, seen sets.Set[string], timeout time.Duration) ([]batchv1.Job, error) {
+	var (
+		jobs      []batchv1.Job
+		idleAfter int
+	)
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, false, func(ctx context.Context) (bool, error) {
+		all, err := listPreviewJobs(ctx, c, plan)
+		if err != nil {
+			return false, err
+		}
+
+		jobs = jobs[:0]
+		for _, job := range all {
+			if !seen.Has(job.Name) {
+				jobs = append(jobs, job)
+			}
+		}
+
+		if len(jobs) == 0 {
+			var current hibernatorv1alpha1.HibernatePlan
+			if err := c.Get(ctx, client.ObjectKeyFromObject(plan), &current); err != nil {
+				return false, fmt.Errorf("failed to get HibernatePlan %q: %w", plan.Name, err)
+			}
+			// The controller creates the Jobs right after consuming the
+			// annotation; allow one more poll for them to appear.
+			if !common.IsMarkedTrue(current.Annotations, wellknown.AnnotationPreview) {
+				if idleAfter++; idleAfter > 1 {
+					return false, fmt.Errorf("controller consumed %s without dispatching previews; check the controller logs", wellknown.AnnotationPreview)
+				}
+			}
+			return false, nil
+		}
+
+		if len(jobs) < len(plan.Spec.Targets) {
+			return false, nil
+		}
+		for i := range jobs {
+			if !isJobFinished(&jobs[i]) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+
+	if wait.Interrupted(err) && !errors.Is(ctx.Err(), context.Canceled) {
+		return nil, fmt.Errorf("timed out after %s waiting for the preview jobs of HibernatePlan %q", timeout, plan.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Labels[wellknown.LabelTarget] < jobs[j].Labels[wellknown.LabelTarget]
+	})
+	return jobs, nil
+}
+
+// terminationMessage returns the termination message the runner of the Job's
+// newest pod wrote: the selected resources, or the error of the preview.
+func terminationMessage(ctx context.Context, c client.Client, job *batchv1.Job) (string, error) {
+	var podList corev1.PodList
+	if err := c.List(ctx, &podList,
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: job.Name},
+	); err != nil {
+		return "", fmt.Errorf("failed to list pods of job %q: %w", job.Name, err)
+	}
+
+	var newest *corev1.Pod
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if newest == nil || newest.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			newest = pod
+		}
+	}
+	if newest != nil {
+		for _, status := range newest.Status.ContainerStatuses {
+			if status.Name == "runner" && status.State.Terminated != nil && status.State.Terminated.Message != "" {
+				return status.State.Terminated.Message, nil
+			}
+		}
+	}
+	return "no result reported; see the logs of job " + job.Name, nil
+}
+
+func isJobFinished(job *batchv1.Job) bool {
+	return hasJobCondition(job, batchv1.JobComplete) || isJobFailed(job)
+}
+
+func isJobFailed(job *batchv1.Job) bool {
+	return hasJobCondition(job, batchv1.JobFailed)
+}
+
+func hasJobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == conditionType && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
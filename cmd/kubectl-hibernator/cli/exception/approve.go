@@ -0,0 +1,120 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package exception
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+type approveOptions struct {
+	root   *common.RootOptions
+	dryRun bool
+}
+
+// newApproveCommand creates the "exception approve" command.
+func newApproveCommand(opts *common.RootOptions) *cobra.Command {
+	approveOpts := &approveOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "approve <exception-name>",
+		Short: "Approve a ScheduleException as a plan owner",
+		Long: `Approve a ScheduleException that sets spec.requiresApproval.
+
+The exception stays Pending until an owner of its plan approves it. Owners
+are listed in the plan's hibernator.ardikabs.com/owners annotation, and the
+admission webhook rejects approvals from anyone else. The approval covers the
+exception as it is now: changing its spec afterwards needs a new approval.
+Approvals are kept in the exception's status as an audit trail.
+
+Examples:
+  # Approve an exception requested by a developer
+  kubectl hibernator exception approve staging-suspend-20260115
+
+  # Check who you would approve as without changing anything
+  kubectl hibernator exception approve staging-suspend-20260115 --dry-run`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompleteExceptionNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runApprove(ctx, approveOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().BoolVar(&approveOpts.dryRun, "dry-run", false, "Preview the approval without making changes")
+
+	return cmd
+}
+
+func runApprove(ctx context.Context, opts *approveOptions, name string) error {
+	out := output.FromContext(ctx)
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+	var exc hibernatorv1alpha1.ScheduleException
+	if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ns}, &exc); err != nil {
+		return fmt.Errorf("failed to get ScheduleException %q in namespace %q: %w", name, ns, err)
+	}
+
+	if !exc.Spec.RequiresApproval {
+		out.Info("ScheduleException %s/%s does not require approval", exc.Namespace, exc.Name)
+		return nil
+	}
+
+	user, err := currentUser(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	generation := strconv.FormatInt(exc.Generation, 10)
+	if exc.Annotations[wellknown.AnnotationApprovedBy] == user &&
+		exc.Annotations[wellknown.AnnotationApprovedGeneration] == generation {
+		out.Info("ScheduleException %s/%s is already approved by %s", exc.Namespace, exc.Name, user)
+		return nil
+	}
+
+	if opts.dryRun {
+		out.Info("Would approve ScheduleException %s/%s (generation %s) as %s", exc.Namespace, exc.Name, generation, user)
+		return nil
+	}
+
+	patch := client.MergeFrom(exc.DeepCopy())
+	if exc.Annotations == nil {
+		exc.Annotations = make(map[string]string)
+	}
+	exc.Annotations[wellknown.AnnotationApprovedBy] = user
+	exc.Annotations[wellknown.AnnotationApprovedGeneration] = generation
+	if err := c.Patch(ctx, &exc, patch); err != nil {
+		return fmt.Errorf("failed to approve ScheduleException: %w", err)
+	}
+
+	out.Success("Approved ScheduleException %s/%s as %s", exc.Namespace, exc.Name, user)
+	out.Hint("It becomes Active once its validity period starts")
+	return nil
+}
+
+// currentUser returns the username the API server authenticates the caller as.
+func currentUser(ctx context.Context, c client.Client) (string, error) {
+	review := &authenticationv1.SelfSubjectReview{}
+	if err := c.Create(ctx, review); err != nil {
+		return "", fmt.Errorf("failed to determine the current user: %w", err)
+	}
+	return review.Status.UserInfo.Username, nil
+}
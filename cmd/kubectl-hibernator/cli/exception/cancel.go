@@ -0,0 +1,112 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package exception
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+)
+
+type cancelOptions struct {
+	root   *common.RootOptions
+	delete bool
+	dryRun bool
+}
+
+// newCancelCommand creates the "exception cancel" command.
+func newCancelCommand(opts *common.RootOptions) *cobra.Command {
+	cancelOpts := &cancelOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "cancel <exception-name>",
+		Short: "End a ScheduleException early",
+		Long: `Stop a ScheduleException from affecting its plan.
+
+An exception that is already in effect is ended now by moving its validUntil
+to the current time, so it expires and stays visible in the plan's exception
+history. An exception that has not started yet is deleted, as is any
+exception when --delete is given.
+
+Examples:
+  # End an exception now
+  kubectl hibernator exception cancel my-plan-suspend-20260115
+
+  # Remove it entirely
+  kubectl hibernator exception cancel my-plan-suspend-20260115 --delete`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompleteExceptionNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runCancel(ctx, cancelOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().BoolVar(&cancelOpts.delete, "delete", false, "Delete the exception instead of expiring it")
+	cmd.Flags().BoolVar(&cancelOpts.dryRun, "dry-run", false, "Preview what would happen without making changes")
+
+	return cmd
+}
+
+func runCancel(ctx context.Context, opts *cancelOptions, name string) error {
+	out := output.FromContext(ctx)
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+	var exc hibernatorv1alpha1.ScheduleException
+	if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ns}, &exc); err != nil {
+		return fmt.Errorf("failed to get ScheduleException %q in namespace %q: %w", name, ns, err)
+	}
+
+	now := time.Now()
+	switch {
+	case opts.delete || now.Before(exc.Spec.ValidFrom.Time):
+		if opts.dryRun {
+			out.Info("Would delete ScheduleException %s/%s", exc.Namespace, exc.Name)
+			return nil
+		}
+		if err := c.Delete(ctx, &exc); err != nil {
+			return fmt.Errorf("failed to delete ScheduleException: %w", err)
+		}
+		out.Success("Deleted ScheduleException %s/%s", exc.Namespace, exc.Name)
+
+	case !now.Before(exc.Spec.ValidUntil.Time):
+		out.Info("ScheduleException %s/%s has already expired", exc.Namespace, exc.Name)
+		out.Hint("Use --delete to remove it")
+
+	default:
+		if opts.dryRun {
+			out.Info("Would expire ScheduleException %s/%s now", exc.Namespace, exc.Name)
+			return nil
+		}
+		patch := client.MergeFrom(exc.DeepCopy())
+		exc.Spec.ValidUntil = metav1.NewTime(now.UTC().Truncate(time.Second))
+		if err := c.Patch(ctx, &exc, patch); err != nil {
+			return fmt.Errorf("failed to cancel ScheduleException: %w", err)
+		}
+		if exc.TargetsMultiplePlans() {
+			out.Success("Cancelled ScheduleException %s/%s; its plans return to their regular schedule",
+				exc.Namespace, exc.Name)
+			return nil
+		}
+		out.Success("Cancelled ScheduleException %s/%s; plan %s returns to its regular schedule",
+			exc.Namespace, exc.Name, exc.Spec.PlanRef.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package exception
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+)
+
+// NewCommand creates the "exception" parent command group.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "exception",
+		Aliases: []string{"schedex"},
+		Short:   "Manage ScheduleException resources",
+		Long: `Commands for authoring ScheduleException resources.
+
+ScheduleExceptions temporarily change a plan's schedule: suspend carves
+windows out of it, extend adds hibernation windows, and replace swaps the
+whole schedule for the exception period.
+
+Examples:
+  # Keep my-plan awake for the next 4 hours
+  kubectl hibernator exception create my-plan --for 4h
+
+  # Keep it awake for 2 more hours
+  kubectl hibernator exception extend my-plan-suspend-20260115 --for 2h
+
+  # End it early
+  kubectl hibernator exception cancel my-plan-suspend-20260115
+
+  # Approve an exception as a plan owner
+  kubectl hibernator exception approve my-plan-suspend-20260115
+
+  # Create an exception interactively, previewing its effect before applying
+  kubectl hibernator exception wizard my-plan
+
+  # Walk through the wizard and print the manifest instead of applying it
+  kubectl hibernator exception wizard my-plan --dry-run`,
+	}
+
+	cmd.AddCommand(newCreateCommand(opts))
+	cmd.AddCommand(newExtendCommand(opts))
+	cmd.AddCommand(newCancelCommand(opts))
+	cmd.AddCommand(newApproveCommand(opts))
+	cmd.AddCommand(newWizardCommand(opts))
+
+	return cmd
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package exception
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/runner/timeparse"
+)
+
+const (
+	// defaultValidity is how long an exception lasts when neither --until nor --for is given.
+	defaultValidity = 24 * time.Hour
+
+	// defaultLeadTime keeps hibernation from starting shortly before a
+	// suspension window given with --window.
+	defaultLeadTime = "1h"
+)
+
+// allDayWindow keeps a suspend exception in effect around the clock, so the
+// plan stays awake for the whole validity period.
+var allDayWindow = hibernatorv1alpha1.OffHourWindow{Start: "00:00", End: "00:00", DaysOfWeek: weekdays}
+
+type createOptions struct {
+	root     *common.RootOptions
+	typ      string
+	windows  []string
+	from     string
+	until    string
+	duration string
+	leadTime string
+	name     string
+	approval bool
+	dryRun   bool
+}
+
+// newCreateCommand creates the "exception create" command.
+func newCreateCommand(opts *common.RootOptions) *cobra.Command {
+	createOpts := &createOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "create <plan-name>",
+		Short: "Create a ScheduleException for a plan",
+		Long: `Create a ScheduleException for a plan without writing YAML.
+
+Defaults are chosen for the common incident case:
+  - --type defaults to suspend, keeping the plan awake.
+  - A suspend exception without --window covers the whole day, every day.
+  - The exception starts now and lasts 24 hours unless --from, --until or
+    --for are given.
+  - A suspend exception with --window gets a 1h lead time, so hibernation
+    does not start shortly before a suspension window. Use --lead-time 0 to
+    disable it.
+
+Windows are given as "<start>-<end> <days>", where days is a comma-separated
+list of MON..SUN, a range such as MON-FRI, or one of "daily", "weekdays" and
+"weekends".
+
+Examples:
+  # Keep my-plan awake for the next 4 hours
+  kubectl hibernator exception create my-plan --for 4h
+
+  # Keep my-plan awake during evenings this week
+  kubectl hibernator exception create my-plan --window "18:00-23:00 weekdays" --until "next saturday"
+
+  # Also hibernate over the weekend for the next two weeks
+  kubectl hibernator exception create my-plan --type extend --window "00:00-00:00 weekends" --for 14d
+
+  # Use a different schedule during a holiday period
+  kubectl hibernator exception create my-plan --type replace --window "00:00-00:00 daily" \
+    --from "2026-12-24 00:00" --until "2027-01-02 00:00"
+
+  # Ask a plan owner to approve the exception before it takes effect
+  kubectl hibernator exception create my-plan --for 3d --requires-approval
+
+  # Print the manifest instead of creating it
+  kubectl hibernator exception create my-plan --for 4h --dry-run`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runCreate(ctx, createOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().StringVar(&createOpts.typ, "type", string(hibernatorv1alpha1.ExceptionSuspend), "Exception type: suspend, extend or replace")
+	cmd.Flags().StringArrayVar(&createOpts.windows, "window", nil, `Exception window as "<start>-<end> <days>" (repeatable). Defaults to all day for suspend`)
+	cmd.Flags().StringVar(&createOpts.from, "from", "", `When the exception starts (e.g. "tomorrow at 6am"). Defaults to now`)
+	cmd.Flags().StringVar(&createOpts.until, "until", "", `When the exception ends (e.g. "next friday", "2026-01-15 14:30")`)
+	cmd.Flags().StringVar(&createOpts.duration, "for", "", "How long the exception lasts (e.g. 4h, 3d). Defaults to 24h")
+	cmd.Flags().StringVar(&createOpts.leadTime, "lead-time", "", "Buffer before each suspension window in which hibernation does not start (suspend only)")
+	cmd.Flags().StringVar(&createOpts.name, "name", "", "Exception name. Defaults to <plan>-<type>-<date>")
+	cmd.Flags().BoolVar(&createOpts.approval, "requires-approval", false, "Keep the exception pending until a plan owner approves it")
+	cmd.Flags().BoolVar(&createOpts.dryRun, "dry-run", false, "Print the manifest instead of creating it")
+
+	cmd.MarkFlagsMutuallyExclusive("until", "for")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("type", cobra.FixedCompletions([]string{
+		string(hibernatorv1alpha1.ExceptionSuspend),
+		string(hibernatorv1alpha1.ExceptionExtend),
+		string(hibernatorv1alpha1.ExceptionReplace),
+	}, cobra.ShellCompDirectiveNoFileComp)))
+
+	return cmd
+}
+
+func runCreate(ctx context.Context, opts *createOptions, planName string) error {
+	out := output.FromContext(ctx)
+
+	typ := hibernatorv1alpha1.ExceptionType(opts.typ)
+	switch typ {
+	case hibernatorv1alpha1.ExceptionSuspend, hibernatorv1alpha1.ExceptionExtend, hibernatorv1alpha1.ExceptionReplace:
+	default:
+		return fmt.Errorf("invalid --type %q, must be one of: suspend, extend, replace", opts.typ)
+	}
+
+	windows := make([]hibernatorv1alpha1.OffHourWindow, 0, len(opts.windows))
+	for _, w := range opts.windows {
+		window, err := parseWindow(w)
+		if err != nil {
+			return fmt.Errorf("invalid --window %q: %w", w, err)
+		}
+		windows = append(windows, window)
+	}
+	if len(windows) == 0 {
+		if typ != hibernatorv1alpha1.ExceptionSuspend {
+			return fmt.Errorf("--window is required for %s exceptions", typ)
+		}
+		windows = append(windows, allDayWindow)
+	}
+
+	now := time.Now()
+	from, until, err := resolvePeriod(now, opts.from, opts.until, opts.duration)
+	if err != nil {
+		return err
+	}
+
+	leadTime, err := resolveLeadTime(typ, opts.leadTime, len(opts.windows) > 0, until.Sub(from))
+	if err != nil {
+		return err
+	}
+	if err := validateLeadTime(leadTime, from, until); err != nil {
+		return fmt.Errorf("invalid --lead-time: %w", err)
+	}
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
+		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
+	}
+
+	name := opts.name
+	if name == "" {
+		name = defaultName(plan.Name, typ, from)
+	}
+	exc := newException(&plan, name, typ, from, until, leadTime, windows)
+	exc.Spec.RequiresApproval = opts.approval
+
+	if opts.dryRun {
+		return printManifest(out, exc)
+	}
+
+	if err := createException(ctx, c, out, exc); err != nil {
+		return err
+	}
+	out.Info("Valid %s until %s", timeparse.FormatDeadline(from), timeparse.FormatDeadline(until))
+	if exc.Spec.RequiresApproval {
+		out.Hint("A plan owner must approve it with: kubectl hibernator exception approve -n %s %s", exc.Namespace, exc.Name)
+	}
+	return nil
+}
+
+// resolvePeriod resolves the validity period from --from and either --until
+// or --for, defaulting to now and defaultValidity.
+func resolvePeriod(now time.Time, fromFlag, untilFlag, durationFlag string) (time.Time, time.Time, error) {
+	from := now.UTC().Truncate(time.Minute)
+	if fromFlag != "" {
+		t, err := timeparse.ParseDeadline(fromFlag, now)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --from: %w", err)
+		}
+		from = t
+	}
+
+	var until time.Time
+	switch {
+	case untilFlag != "":
+		t, err := timeparse.ParseDeadline(untilFlag, now)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --until: %w", err)
+		}
+		until = t
+	case durationFlag != "":
+		d, err := common.ParseSpan(durationFlag)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --for: %w", err)
+		}
+		until = from.Add(d)
+	default:
+		until = from.Add(defaultValidity)
+	}
+
+	if err := validatePeriod(from, until); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid validity period: %w", err)
+	}
+	return from, until, nil
+}
+
+// resolveLeadTime applies the lead time default for suspend exceptions with
+// explicit windows that outlast it, and rejects lead times on other exception
+// types.
+func resolveLeadTime(typ hibernatorv1alpha1.ExceptionType, leadTime string, customWindows bool, validity time.Duration) (string, error) {
+	if typ != hibernatorv1alpha1.ExceptionSuspend {
+		if leadTime != "" {
+			return "", fmt.Errorf("--lead-time is only valid for suspend exceptions")
+		}
+		return "", nil
+	}
+
+	switch {
+	case leadTime == "" && customWindows && validity > lo.Must(time.ParseDuration(defaultLeadTime)):
+		return defaultLeadTime, nil
+	case leadTime == "0":
+		return "", nil
+	}
+	return leadTime, nil
+}
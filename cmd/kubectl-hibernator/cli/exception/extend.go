@@ -0,0 +1,118 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package exception
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/runner/timeparse"
+)
+
+type extendOptions struct {
+	root     *common.RootOptions
+	until    string
+	duration string
+	dryRun   bool
+}
+
+// newExtendCommand creates the "exception extend" command.
+func newExtendCommand(opts *common.RootOptions) *cobra.Command {
+	extendOpts := &extendOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "extend <exception-name>",
+		Short: "Extend how long a ScheduleException stays valid",
+		Long: `Move the end of a ScheduleException's validity period.
+
+--for adds to the current end of the exception, or to now if it has already
+expired, so an expired exception becomes active again. --until sets the end
+explicitly.
+
+Examples:
+  # Keep the exception in effect for 2 more hours
+  kubectl hibernator exception extend my-plan-suspend-20260115 --for 2h
+
+  # Keep it until Friday evening
+  kubectl hibernator exception extend my-plan-suspend-20260115 --until "friday at 6pm"`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompleteExceptionNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runExtend(ctx, extendOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().StringVar(&extendOpts.until, "until", "", `New end of the exception (e.g. "friday at 6pm", "2026-01-15 14:30")`)
+	cmd.Flags().StringVar(&extendOpts.duration, "for", "", "How much longer the exception lasts (e.g. 2h, 1d)")
+	cmd.Flags().BoolVar(&extendOpts.dryRun, "dry-run", false, "Preview the new validity period without applying it")
+
+	cmd.MarkFlagsMutuallyExclusive("until", "for")
+	cmd.MarkFlagsOneRequired("until", "for")
+
+	return cmd
+}
+
+func runExtend(ctx context.Context, opts *extendOptions, name string) error {
+	out := output.FromContext(ctx)
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+	var exc hibernatorv1alpha1.ScheduleException
+	if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ns}, &exc); err != nil {
+		return fmt.Errorf("failed to get ScheduleException %q in namespace %q: %w", name, ns, err)
+	}
+
+	now := time.Now()
+	var until time.Time
+	if opts.until != "" {
+		if until, err = timeparse.ParseDeadline(opts.until, now); err != nil {
+			return fmt.Errorf("invalid --until: %w", err)
+		}
+	} else {
+		d, err := common.ParseSpan(opts.duration)
+		if err != nil {
+			return fmt.Errorf("invalid --for: %w", err)
+		}
+		base := exc.Spec.ValidUntil.Time
+		if base.Before(now) {
+			base = now.UTC().Truncate(time.Minute)
+		}
+		until = base.Add(d)
+	}
+
+	if err := validatePeriod(exc.Spec.ValidFrom.Time, until); err != nil {
+		return fmt.Errorf("invalid validity period: %w", err)
+	}
+
+	if opts.dryRun {
+		out.Info("Would extend ScheduleException %s/%s", exc.Namespace, exc.Name)
+		out.Info("  Valid until: %s -> %s", timeparse.FormatDeadline(exc.Spec.ValidUntil.Time), timeparse.FormatDeadline(until))
+		return nil
+	}
+
+	patch := client.MergeFrom(exc.DeepCopy())
+	exc.Spec.ValidUntil = metav1.NewTime(until)
+	if err := c.Patch(ctx, &exc, patch); err != nil {
+		return fmt.Errorf("failed to extend ScheduleException: %w", err)
+	}
+
+	out.Success("Extended ScheduleException %s/%s until %s (%s from now)",
+		exc.Namespace, exc.Name, timeparse.FormatDeadline(until), timeparse.FormatDuration(now, until))
+	return nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package exception
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/runner/timeparse"
+)
+
+const (
+	// maxValidity mirrors the admission webhook's limit on exception duration.
+	maxValidity = 90 * 24 * time.Hour
+
+	// maxLeadTime is the longest lead time the evaluator honours: it only
+	// looks for suspension windows starting today or tomorrow.
+	maxLeadTime = 24 * time.Hour
+)
+
+var (
+	windowRe = regexp.MustCompile(`^([0-1]?[0-9]|2[0-3]):[0-5][0-9]$`)
+	weekdays = []string{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}
+)
+
+// newException builds a ScheduleException for plan in the plan's namespace.
+func newException(plan *hibernatorv1alpha1.HibernatePlan, name string, typ hibernatorv1alpha1.ExceptionType, from, until time.Time, leadTime string, windows []hibernatorv1alpha1.OffHourWindow) *hibernatorv1alpha1.ScheduleException {
+	return &hibernatorv1alpha1.ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: plan.Namespace,
+		},
+		Spec: hibernatorv1alpha1.ScheduleExceptionSpec{
+			PlanRef:    hibernatorv1alpha1.PlanReference{Name: plan.Name},
+			ValidFrom:  metav1.NewTime(from),
+			ValidUntil: metav1.NewTime(until),
+			Type:       typ,
+			LeadTime:   leadTime,
+			Windows:    windows,
+		},
+	}
+}
+
+// defaultName derives an exception name from its plan, type and start date.
+func defaultName(planName string, typ hibernatorv1alpha1.ExceptionType, from time.Time) string {
+	return fmt.Sprintf("%s-%s-%s", planName, typ, from.Format("20060102"))
+}
+
+// printManifest writes exc as a YAML manifest that can be applied with kubectl.
+func printManifest(out output.Formatter, exc *hibernatorv1alpha1.ScheduleException) error {
+	exc.TypeMeta = metav1.TypeMeta{
+		APIVersion: hibernatorv1alpha1.GroupVersion.String(),
+		Kind:       "ScheduleException",
+	}
+	manifest, err := yaml.Marshal(exc)
+	if err != nil {
+		return fmt.Errorf("failed to render manifest: %w", err)
+	}
+	out.Info("%s", strings.TrimSpace(string(manifest)))
+	return nil
+}
+
+// createException creates exc and reports the result.
+func createException(ctx context.Context, c client.Client, out output.Formatter, exc *hibernatorv1alpha1.ScheduleException) error {
+	if err := c.Create(ctx, exc); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("ScheduleException %q already exists in namespace %q", exc.Name, exc.Namespace)
+		}
+		return fmt.Errorf("failed to create ScheduleException: %w", err)
+	}
+
+	out.Success("Created ScheduleException %s/%s for plan %s", exc.Namespace, exc.Name, exc.Spec.PlanRef.Name)
+	out.Hint("Track it with: kubectl get scheduleexceptions -n %s %s", exc.Namespace, exc.Name)
+	return nil
+}
+
+// parseWindow parses "<start>-<end> <days>" into an OffHourWindow.
+func parseWindow(s string) (hibernatorv1alpha1.OffHourWindow, error) {
+	span, days, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return hibernatorv1alpha1.OffHourWindow{}, fmt.Errorf("expected \"<start>-<end> <days>\", e.g. \"20:00-06:00 MON-FRI\"")
+	}
+
+	start, end, ok := strings.Cut(span, "-")
+	if !ok || !windowRe.MatchString(start) || !windowRe.MatchString(end) {
+		return hibernatorv1alpha1.OffHourWindow{}, fmt.Errorf("invalid time range %q, expected HH:MM-HH:MM", span)
+	}
+
+	daysOfWeek, err := parseDays(strings.TrimSpace(days))
+	if err != nil {
+		return hibernatorv1alpha1.OffHourWindow{}, err
+	}
+
+	return hibernatorv1alpha1.OffHourWindow{Start: start, End: end, DaysOfWeek: daysOfWeek}, nil
+}
+
+// parseDays expands a comma-separated list of days and day ranges.
+func parseDays(s string) ([]string, error) {
+	switch strings.ToLower(s) {
+	case "daily":
+		return slices.Clone(weekdays), nil
+	case "weekdays":
+		return slices.Clone(weekdays[:5]), nil
+	case "weekends":
+		return slices.Clone(weekdays[5:]), nil
+	}
+
+	var days []string
+	for _, part := range strings.Split(strings.ToUpper(s), ",") {
+		part = strings.TrimSpace(part)
+		from, to, isRange := strings.Cut(part, "-")
+		if !isRange {
+			to = from
+		}
+
+		i, j := slices.Index(weekdays, from), slices.Index(weekdays, to)
+		if i == -1 || j == -1 || i > j {
+			return nil, fmt.Errorf("invalid days %q, use MON..SUN, ranges such as MON-FRI, daily, weekdays or weekends", part)
+		}
+		for _, day := range weekdays[i : j+1] {
+			if !slices.Contains(days, day) {
+				days = append(days, day)
+			}
+		}
+	}
+	return days, nil
+}
+
+// validatePeriod mirrors the admission webhook's validity period checks so
+// mistakes are caught while the user can still correct them.
+func validatePeriod(from, until time.Time) error {
+	if !until.After(from) {
+		return fmt.Errorf("must be after the start of the exception (%s)", timeparse.FormatDeadline(from))
+	}
+	if until.Sub(from) > maxValidity {
+		return fmt.Errorf("exception may last at most 90 days (got %s)", timeparse.FormatDuration(from, until))
+	}
+	return nil
+}
+
+// validateLeadTime checks that a lead time can be honoured by the evaluator
+// for the given validity period.
+func validateLeadTime(s string, from, until time.Time) error {
+	if s == "" {
+		return nil
+	}
+
+	leadTime, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q, e.g. 30m or 1h", s)
+	}
+	if leadTime <= 0 {
+		return fmt.Errorf("lead time must be positive")
+	}
+	if leadTime >= maxLeadTime {
+		return fmt.Errorf("lead time must be shorter than %s, longer buffers are not honoured by the scheduler", maxLeadTime)
+	}
+	if leadTime >= until.Sub(from) {
+		return fmt.Errorf("lead time %s covers the whole exception period (%s)", leadTime, timeparse.FormatDuration(from, until))
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package exception
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// prompter asks questions on out and reads single-line answers from in.
+type prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func newPrompter(in io.Reader, out io.Writer) *prompter {
+	return &prompter{in: bufio.NewReader(in), out: out}
+}
+
+// ask prints question with an optional default and returns the trimmed
+// answer, or def when the answer is empty.
+func (p *prompter) ask(question, def string) (string, error) {
+	if def != "" {
+		lo.Must1(fmt.Fprintf(p.out, "%s [%s]: ", question, def))
+	} else {
+		lo.Must1(fmt.Fprintf(p.out, "%s: ", question))
+	}
+
+	line, err := p.in.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		if errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("input closed before the wizard completed")
+		}
+		return "", fmt.Errorf("failed to read answer: %w", err)
+	}
+
+	answer := strings.TrimSpace(line)
+	if answer == "" {
+		return def, nil
+	}
+	return answer, nil
+}
+
+// askValid repeats question until parse accepts the answer.
+func (p *prompter) askValid(question, def string, parse func(string) error) (string, error) {
+	for {
+		answer, err := p.ask(question, def)
+		if err != nil {
+			return "", err
+		}
+		if err := parse(answer); err != nil {
+			lo.Must1(fmt.Fprintf(p.out, "  %v\n", err))
+			continue
+		}
+		return answer, nil
+	}
+}
+
+// choose asks for one of choices, accepting either the choice or its 1-based index.
+func (p *prompter) choose(question string, choices []string, def string) (string, error) {
+	for i, choice := range choices {
+		lo.Must1(fmt.Fprintf(p.out, "  %d) %s\n", i+1, choice))
+	}
+
+	var picked string
+	_, err := p.askValid(question, def, func(answer string) error {
+		for i, choice := range choices {
+			if strings.EqualFold(answer, choice) || answer == fmt.Sprint(i+1) {
+				picked = choice
+				return nil
+			}
+		}
+		return fmt.Errorf("choose one of: %s", strings.Join(choices, ", "))
+	})
+	return picked, err
+}
+
+// confirm asks a yes/no question, defaulting to no.
+func (p *prompter) confirm(question string) (bool, error) {
+	answer, err := p.ask(question+" (y/N)", "")
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains([]string{"y", "yes"}, strings.ToLower(answer)), nil
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package exception
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+	"github.com/ardikabs/hibernator/cmd/runner/timeparse"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+)
+
+// previewSpan is how far ahead the wizard visualizes the effective schedule.
+const previewSpan = 7 * 24 * time.Hour
+
+type wizardOptions struct {
+	root   *common.RootOptions
+	dryRun bool
+	yes    bool
+}
+
+// newWizardCommand creates the "exception wizard" command.
+func newWizardCommand(opts *common.RootOptions) *cobra.Command {
+	wizardOpts := &wizardOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "wizard <plan-name>",
+		Short: "Interactively create a ScheduleException for a plan",
+		Long: `Walk through creating a ScheduleException step by step.
+
+The wizard asks for the exception type (suspend, extend or replace), its
+validity period, windows and, for suspend exceptions, the lead time. It then
+shows the plan's effective schedule for the next 7 days with the new exception
+and the plan's existing active and pending exceptions applied, validates the
+exception against the cluster with a server-side dry run, and applies it
+after confirmation.
+
+Windows are entered one per line as "<start>-<end> <days>", where days is a
+comma-separated list of MON..SUN, a range such as MON-FRI, or one of
+"daily", "weekdays" and "weekends". An empty line finishes the list.
+
+Examples:
+  # Create an exception interactively
+  kubectl hibernator exception wizard my-plan
+
+  # Print the resulting manifest instead of applying it
+  kubectl hibernator exception wizard my-plan --dry-run > exception.yaml`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runWizard(ctx, wizardOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().BoolVar(&wizardOpts.dryRun, "dry-run", false, "Print the resulting manifest instead of applying it")
+	cmd.Flags().BoolVarP(&wizardOpts.yes, "yes", "y", false, "Apply without asking for final confirmation")
+
+	return cmd
+}
+
+func runWizard(ctx context.Context, opts *wizardOptions, planName string) error {
+	out := output.FromContext(ctx)
+
+	if opts.root.JsonOutput {
+		return fmt.Errorf("the wizard is interactive and does not support --json")
+	}
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
+		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
+	}
+
+	// Prompts go to stderr so --dry-run output can be redirected to a file.
+	p := newPrompter(os.Stdin, os.Stderr)
+	exc, err := askException(p, &plan, time.Now())
+	if err != nil {
+		return err
+	}
+
+	existing, err := common.FetchScheduledExceptions(ctx, c, plan)
+	if err != nil {
+		return err
+	}
+	if err := previewException(&plan, existing, exc, time.Now()); err != nil {
+		return err
+	}
+
+	// The dry run goes through the admission webhook, which catches collisions
+	// with existing exceptions and invalid overrides.
+	if err := c.Create(ctx, exc.DeepCopy(), client.DryRunAll); err != nil {
+		return fmt.Errorf("ScheduleException %q was rejected: %w", exc.Name, err)
+	}
+
+	if opts.dryRun {
+		return printManifest(out, exc)
+	}
+
+	if !opts.yes {
+		ok, err := p.confirm(fmt.Sprintf("Apply ScheduleException %s/%s?", exc.Namespace, exc.Name))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			out.Info("Cancelled - no changes made")
+			return nil
+		}
+	}
+
+	return createException(ctx, c, out, exc)
+}
+
+// askException walks the user through every field of a ScheduleException.
+func askException(p *prompter, plan *hibernatorv1alpha1.HibernatePlan, now time.Time) (*hibernatorv1alpha1.ScheduleException, error) {
+	typ, err := p.choose("Exception type",
+		[]string{string(hibernatorv1alpha1.ExceptionSuspend), string(hibernatorv1alpha1.ExceptionExtend), string(hibernatorv1alpha1.ExceptionReplace)},
+		string(hibernatorv1alpha1.ExceptionSuspend))
+	if err != nil {
+		return nil, err
+	}
+
+	var validFrom, validUntil time.Time
+	if _, err := p.askValid("Valid from (e.g. \"tomorrow at 6am\", \"2026-01-15 14:30\")", "now", func(answer string) error {
+		if answer == "now" {
+			validFrom = now.UTC().Truncate(time.Minute)
+			return nil
+		}
+		var perr error
+		validFrom, perr = timeparse.ParseDeadline(answer, now)
+		return perr
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := p.askValid("Valid until (e.g. \"in 3 days\", \"next friday\")", "", func(answer string) error {
+		var perr error
+		if validUntil, perr = timeparse.ParseDeadline(answer, now); perr != nil {
+			return perr
+		}
+		validUntil = validUntil.Truncate(time.Minute)
+		return validatePeriod(validFrom, validUntil)
+	}); err != nil {
+		return nil, err
+	}
+
+	windows, err := askWindows(p, hibernatorv1alpha1.ExceptionType(typ))
+	if err != nil {
+		return nil, err
+	}
+
+	var leadTime string
+	if hibernatorv1alpha1.ExceptionType(typ) == hibernatorv1alpha1.ExceptionSuspend {
+		if leadTime, err = p.askValid("Lead time before each suspension window (e.g. 1h, empty for none)", "", func(answer string) error {
+			return validateLeadTime(answer, validFrom, validUntil)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	name, err := p.ask("Name", defaultName(plan.Name, hibernatorv1alpha1.ExceptionType(typ), validFrom))
+	if err != nil {
+		return nil, err
+	}
+
+	return newException(plan, name, hibernatorv1alpha1.ExceptionType(typ), validFrom, validUntil, leadTime, windows), nil
+}
+
+// askWindows reads windows until an empty line, requiring at least one.
+func askWindows(p *prompter, typ hibernatorv1alpha1.ExceptionType) ([]hibernatorv1alpha1.OffHourWindow, error) {
+	question := map[hibernatorv1alpha1.ExceptionType]string{
+		hibernatorv1alpha1.ExceptionSuspend: "Window to keep awake",
+		hibernatorv1alpha1.ExceptionExtend:  "Additional hibernation window",
+		hibernatorv1alpha1.ExceptionReplace: "Replacement hibernation window",
+	}[typ]
+
+	var windows []hibernatorv1alpha1.OffHourWindow
+	for {
+		q := fmt.Sprintf("%s #%d (e.g. \"20:00-06:00 MON-FRI\")", question, len(windows)+1)
+		if len(windows) > 0 {
+			q += ", empty to finish"
+		}
+
+		var window hibernatorv1alpha1.OffHourWindow
+		answer, err := p.askValid(q, "", func(answer string) error {
+			if answer == "" {
+				if len(windows) == 0 {
+					return fmt.Errorf("at least one window is required")
+				}
+				return nil
+			}
+			var perr error
+			window, perr = parseWindow(answer)
+			return perr
+		})
+		if err != nil {
+			return nil, err
+		}
+		if answer == "" {
+			return windows, nil
+		}
+		windows = append(windows, window)
+	}
+}
+
+// previewException prints the plan's effective schedule for the next week
+// with the new exception applied alongside the existing ones.
+func previewException(plan *hibernatorv1alpha1.HibernatePlan, existing []hibernatorv1alpha1.ScheduleException, exc *hibernatorv1alpha1.ScheduleException, now time.Time) error {
+	all := append(slices.Clone(existing), *exc)
+
+	exceptions := make([]*scheduler.Exception, len(all))
+	exRefs := make([]hibernatorv1alpha1.ExceptionReference, len(all))
+	for i, e := range all {
+		exceptions[i] = common.ConvertAPIException(e)
+		exRefs[i] = hibernatorv1alpha1.ExceptionReference{
+			Name:       e.Name,
+			Type:       e.Spec.Type,
+			ValidFrom:  e.Spec.ValidFrom,
+			ValidUntil: e.Spec.ValidUntil,
+			State:      e.Status.State,
+		}
+	}
+
+	windows := common.ConvertAPIWindows(plan.Spec.Schedule.OffHours)
+	result, err := common.EvaluateAt(windows, plan.Spec.Schedule.Timezone, exceptions, now)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate schedule: %w", err)
+	}
+
+	until := now.Add(previewSpan)
+	transitions, err := common.ComputeTransitions(windows, plan.Spec.Schedule.Timezone, exceptions, now, until)
+	if err != nil {
+		return fmt.Errorf("failed to simulate schedule: %w", err)
+	}
+
+	// The preview shares stderr with the prompts, keeping stdout for the manifest.
+	d := &printers.Dispatcher{}
+	return d.PrintObj(&printers.ScheduleOutput{
+		Plan:        *plan,
+		Result:      result,
+		Exceptions:  exRefs,
+		Range:       &printers.ScheduleRange{From: now, Until: until},
+		Transitions: transitions,
+	}, os.Stderr)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package hibernate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/runner/timeparse"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+type hibernateOptions struct {
+	root    *common.RootOptions
+	dryRun  bool
+	wait    bool
+	timeout time.Duration
+}
+
+// NewCommand creates the "hibernate" subcommand.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	hibernateOpts := &hibernateOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "hibernate <plan-name>",
+		Short: "Hibernate a plan now until its next scheduled wake-up",
+		Long: `Hibernate an Active plan immediately and keep it hibernated until the schedule's
+next regular wake-up, e.g. when the team leaves early on a Friday.
+
+The command adds the one-shot hibernate-until-wake annotation. The controller
+consumes it, records the request as an extend ScheduleException that lasts
+until the next wake-up, and starts hibernation. The exception expires at the
+wake-up boundary, so the plan wakes up on schedule without further action.
+
+Unlike override, there is nothing to clean up afterwards. Use
+"kubectl hibernator exception cancel" on the generated exception to wake the
+plan earlier.
+
+Examples:
+  kubectl hibernator hibernate my-plan
+  kubectl hibernator hibernate my-plan --dry-run
+  kubectl hibernator hibernate my-plan --wait`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runHibernate(ctx, hibernateOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().BoolVar(&hibernateOpts.dryRun, "dry-run", false, "Show when the plan would wake up without making changes")
+	cmd.Flags().BoolVar(&hibernateOpts.wait, "wait", false, "Wait until the plan reaches the Hibernated phase, showing progress")
+	cmd.Flags().DurationVar(&hibernateOpts.timeout, "timeout", 30*time.Minute, "How long --wait waits before giving up")
+
+	return cmd
+}
+
+func runHibernate(ctx context.Context, opts *hibernateOptions, planName string) error {
+	out := output.FromContext(ctx)
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
+		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
+	}
+
+	if plan.Status.Phase != hibernatorv1alpha1.PhaseActive {
+		return fmt.Errorf("HibernatePlan %q is in %q phase; hibernate only applies to Active plans", planName, plan.Status.Phase)
+	}
+	if common.IsMarkedTrue(plan.Annotations, wellknown.AnnotationOverrideAction) {
+		return fmt.Errorf("HibernatePlan %q has an active override; disable it first with 'kubectl hibernator override %s --disable'", planName, planName)
+	}
+
+	exceptions, err := common.FetchActiveExceptions(ctx, c, plan)
+	if err != nil {
+		return err
+	}
+	_, wakeUp, err := common.ComputeNextTransitions(plan.Spec.Schedule, exceptions)
+	if err != nil {
+		return fmt.Errorf("failed to compute next wake-up: %w", err)
+	}
+	if wakeUp == nil {
+		return fmt.Errorf("HibernatePlan %q has no upcoming scheduled wake-up", planName)
+	}
+
+	if opts.dryRun {
+		out.Info("Would hibernate HibernatePlan %q now", planName)
+		out.Info("  Wakes up: %s (in %s)", timeparse.FormatDeadline(*wakeUp), timeparse.FormatDuration(time.Now(), *wakeUp))
+		return nil
+	}
+
+	patch := client.MergeFrom(plan.DeepCopy())
+
+	if plan.Annotations == nil {
+		plan.Annotations = make(map[string]string)
+	}
+	common.MarkTrue(plan.Annotations, wellknown.AnnotationHibernateUntilWake)
+
+	if err := c.Patch(ctx, &plan, patch); err != nil {
+		return fmt.Errorf("failed to patch HibernatePlan %q: %w", planName, err)
+	}
+
+	out.Success("Hibernation triggered for HibernatePlan %q", planName)
+	out.Info("  Wakes up: %s (in %s)", timeparse.FormatDeadline(*wakeUp), timeparse.FormatDuration(time.Now(), *wakeUp))
+
+	if !opts.wait {
+		return nil
+	}
+	key := types.NamespacedName{Name: planName, Namespace: ns}
+	if err := common.WaitForPhase(ctx, c, key, wellknown.AnnotationHibernateUntilWake, hibernatorv1alpha1.PhaseHibernated, opts.timeout, out); err != nil {
+		return err
+	}
+	out.Success("HibernatePlan %q is Hibernated", planName)
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -73,6 +74,11 @@ func runList(ctx context.Context, opts *listOptions) error {
 		items[i].Plan = plan
 
 		if !plan.Spec.Suspend {
+			if event := common.StatusNextEvent(plan, time.Now()); event != nil {
+				items[i].NextEvent = event
+				continue
+			}
+
 			var exceptions []*scheduler.Exception
 			if excs, err := common.FetchActiveExceptions(ctx, c, plan); err == nil && len(excs) > 0 {
 				exceptions = excs
@@ -15,17 +15,18 @@ import (
 	"io"
 	"os"
 	"os/signal"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
 	"syscall"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
@@ -34,12 +35,22 @@ import (
 	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
+// Log sources accepted by --source.
+const (
+	sourceAuto       = "auto"
+	sourceController = "controller"
+	sourceRunner     = "runner"
+	sourceStored     = "stored"
+)
+
 type logsOptions struct {
-	root   *common.RootOptions
-	target string
-	tail   int64
-	follow bool
-	level  string
+	root      *common.RootOptions
+	target    string
+	tail      int64
+	follow    bool
+	level     string
+	source    string
+	execution string
 }
 
 // NewCommand creates the "logs" command.
@@ -48,46 +59,70 @@ func NewCommand(opts *common.RootOptions) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "logs <plan-name>",
-		Short: "View controller logs filtered by plan context",
-		Long: `Fetch logs from the Hibernator controller pod and filter them
-by plan name, target, or execution ID.
-
-The command discovers the controller pod automatically by label selector
-(app.kubernetes.io/name=hibernator), then streams or tails its logs.
+		Short: "View runner execution logs of a plan",
+		Long: `Fetch runner execution logs of a plan and filter them by target
+or level.
+
+Runners stream their logs to the control plane, which relays them into the
+controller pod logs. The command discovers the controller pod automatically by
+label selector (app.kubernetes.io/name=hibernator), then streams or tails its
+logs filtered by plan name and execution ID.
+
+Runners that could not reach the control plane only log to their own Job pods.
+With --source=runner the logs are read from the runner Job pods of the plan's
+current executions instead. The default, --source=auto, falls back to the
+runner Job pods when no controller pod is running.
+
+The control plane also persists the logs runners stream, so they remain
+available after the runner Jobs and controller pods are gone. With
+--source=stored the persisted logs of the plan's current executions are shown;
+--execution selects the logs of an earlier execution. The default falls back
+to them when neither controller nor runner pods are available.
 
 Examples:
   kubectl hibernator logs my-plan
   kubectl hibernator logs my-plan --tail 100
   kubectl hibernator logs my-plan --target my-cluster
   kubectl hibernator logs my-plan --follow
+  kubectl hibernator logs my-plan --source runner
+  kubectl hibernator logs my-plan --source stored --level error
+  kubectl hibernator logs my-plan --execution my-plan-my-cluster-1760583600
   kubectl hibernator logs my-plan --json`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runLogs(ctx, logsOpts, args[0])
 		}),
 	}
 
 	cmd.Flags().StringVar(&logsOpts.target, "target", "", "Filter logs by target name")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("target", common.CompleteTargetNames(opts)))
 	cmd.Flags().StringVar(&logsOpts.level, "level", "", "Filter logs by level: 'error' (logs with error field) or 'info' (logs without errors)")
 	cmd.Flags().Int64Var(&logsOpts.tail, "tail", 500, "Number of recent log lines to fetch")
 	cmd.Flags().BoolVarP(&logsOpts.follow, "follow", "f", false, "Follow log output (stream)")
+	cmd.Flags().StringVar(&logsOpts.source, "source", sourceAuto, "Where to read logs from: 'controller' (relayed runner logs), 'runner' (runner Job pods), 'stored' (persisted runner logs), or 'auto'")
+	cmd.Flags().StringVar(&logsOpts.execution, "execution", "", "Show the stored logs of this execution ID (implies --source=stored)")
 
 	return cmd
 }
 
 func runLogs(ctx context.Context, opts *logsOptions, planName string) error {
-	// Build kubeconfig
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	if opts.root.Kubeconfig != "" {
-		loadingRules.ExplicitPath = opts.root.Kubeconfig
+	switch opts.source {
+	case sourceAuto, sourceController, sourceRunner, sourceStored:
+	default:
+		return fmt.Errorf("invalid --source %q, expected one of: %s, %s, %s, %s", opts.source, sourceAuto, sourceController, sourceRunner, sourceStored)
+	}
+	if opts.execution != "" {
+		if opts.source != sourceAuto && opts.source != sourceStored {
+			return fmt.Errorf("--execution cannot be used with --source=%s", opts.source)
+		}
+		opts.source = sourceStored
 	}
 
-	configOverrides := &clientcmd.ConfigOverrides{}
-	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
-
-	restConfig, err := kubeConfig.ClientConfig()
+	// Build kubeconfig
+	restConfig, err := common.NewRESTConfig(opts.root)
 	if err != nil {
-		return fmt.Errorf("failed to build kubeconfig: %w", err)
+		return err
 	}
 
 	// Build controller-runtime client for fetching plan info
@@ -102,20 +137,77 @@ func runLogs(ctx context.Context, opts *logsOptions, planName string) error {
 	if err := k8sClient.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
 		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
 	}
+	if err := common.LoadExecutions(ctx, k8sClient, &plan); err != nil {
+		return err
+	}
+
+	// Build filter context from plan
+	filter := buildLogFilter(planName, opts, &plan)
+
+	if opts.source == sourceStored {
+		return showStoredLogs(ctx, k8sClient, opts, filter, &plan)
+	}
 
-	// Discover controller namespace and fetch all running controller pods
-	controllerNS := discoverControllerNamespace()
+	var pods []*corev1.Pod
+	if opts.source != sourceRunner {
+		pods, err = findControllerPods(ctx, k8sClient, opts.source == sourceController)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(pods) == 0 {
+		if opts.source == sourceAuto {
+			output.FromContext(ctx).Hint("No running controller pod found, reading logs from runner Job pods instead.")
+		}
+
+		pods, err = findRunnerPods(ctx, k8sClient, &plan, filter)
+		if err != nil {
+			return err
+		}
+		if len(pods) == 0 {
+			if opts.source == sourceAuto {
+				output.FromContext(ctx).Hint("No runner pod found, reading the stored logs of the plan instead.")
+				return showStoredLogs(ctx, k8sClient, opts, filter, &plan)
+			}
+			return fmt.Errorf("no runner pods found for the current executions of plan %q", planName)
+		}
+		filter.runner = true
+	}
+
+	// Build clientset for pod logs API
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+
+	// Fetch logs from all selected pods
+	if opts.follow {
+		return followLogsFromPods(ctx, clientset, pods, opts, filter)
+	}
+
+	return tailLogsFromPods(ctx, clientset, pods, opts, filter)
+}
+
+// findControllerPods returns the running controller pods. When required is
+// set and none is running, any controller pod is returned instead, and an
+// error if there is none at all.
+func findControllerPods(ctx context.Context, k8sClient client.Client, required bool) ([]*corev1.Pod, error) {
+	controllerNS := common.ControllerNamespace()
 
 	var podList corev1.PodList
 	if err := k8sClient.List(ctx, &podList,
 		client.InNamespace(controllerNS),
 		client.MatchingLabels{"app.kubernetes.io/name": "hibernator"},
 	); err != nil {
-		return fmt.Errorf("failed to list controller pods in namespace %q: %w", controllerNS, err)
+		return nil, fmt.Errorf("failed to list controller pods in namespace %q: %w", controllerNS, err)
 	}
 
 	if len(podList.Items) == 0 {
-		return fmt.Errorf("no controller pod found with label app.kubernetes.io/name=hibernator in namespace %q", controllerNS)
+		if !required {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("no controller pod found with label app.kubernetes.io/name=hibernator in namespace %q", controllerNS)
 	}
 
 	// Filter running pods
@@ -125,25 +217,39 @@ func runLogs(ctx context.Context, opts *logsOptions, planName string) error {
 			runningPods = append(runningPods, &podList.Items[i])
 		}
 	}
-	if len(runningPods) == 0 {
+	if len(runningPods) == 0 && required {
 		runningPods = append(runningPods, &podList.Items[0])
 	}
 
-	// Build clientset for pod logs API
-	clientset, err := kubernetes.NewForConfig(restConfig)
-	if err != nil {
-		return fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	return runningPods, nil
+}
+
+// findRunnerPods returns the started runner pods of the plan's current
+// executions, optionally restricted to the filtered target.
+func findRunnerPods(ctx context.Context, k8sClient client.Client, plan *hibernatorv1alpha1.HibernatePlan, filter *logFilter) ([]*corev1.Pod, error) {
+	labels := client.MatchingLabels{wellknown.LabelPlan: plan.Name}
+	if filter.target != "" {
+		labels[wellknown.LabelTarget] = filter.target
 	}
 
-	// Build filter context from plan
-	filter := buildLogFilter(planName, opts, &plan)
+	var podList corev1.PodList
+	if err := k8sClient.List(ctx, &podList, client.InNamespace(plan.Namespace), labels); err != nil {
+		return nil, fmt.Errorf("failed to list runner pods in namespace %q: %w", plan.Namespace, err)
+	}
 
-	// Fetch logs from all controller pods
-	if opts.follow {
-		return followLogsFromPods(ctx, clientset, runningPods, opts, filter)
+	var pods []*corev1.Pod
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase == corev1.PodPending {
+			continue
+		}
+		if len(filter.executionIDs) > 0 && !slices.Contains(filter.executionIDs, pod.Labels[wellknown.LabelExecutionID]) {
+			continue
+		}
+		pods = append(pods, pod)
 	}
 
-	return tailLogsFromPods(ctx, clientset, runningPods, opts, filter)
+	return pods, nil
 }
 
 // logLine represents a parsed log entry with timestamp for sorting.
@@ -329,7 +435,7 @@ func fetchAndFilterPodLogs(ctx context.Context, clientset *kubernetes.Clientset,
 	// nolint:errcheck
 	defer stream.Close()
 
-	return parseLogs(stream, filter, logChan)
+	return parseLogs(stream, pod, filter, logChan)
 }
 
 // streamPodLogs streams logs from a single pod in follow mode.
@@ -342,11 +448,11 @@ func streamPodLogs(ctx context.Context, clientset *kubernetes.Clientset, pod *co
 	// nolint:errcheck
 	defer stream.Close()
 
-	return parseLogs(stream, filter, logChan)
+	return parseLogs(stream, pod, filter, logChan)
 }
 
 // parseLogs reads a log stream, filters lines, and sends to channel.
-func parseLogs(stream io.ReadCloser, filter *logFilter, logChan chan<- *logLine) error {
+func parseLogs(stream io.ReadCloser, pod *corev1.Pod, filter *logFilter, logChan chan<- *logLine) error {
 	scanner := bufio.NewScanner(stream)
 	// Increase buffer for long log lines
 	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
@@ -371,11 +477,16 @@ func parseLogs(stream io.ReadCloser, filter *logFilter, logChan chan<- *logLine)
 			// Calculate content hash for deduplication
 			hash := fmt.Sprintf("%x", md5.Sum([]byte(line)))
 
+			formatted := formatLogLine(line)
+			if filter.runner {
+				formatted = tagRunnerLine(pod, line, formatted)
+			}
+
 			logChan <- &logLine{
 				raw:       line,
 				timestamp: ts,
 				hash:      hash,
-				line:      formatLogLine(line),
+				line:      formatted,
 			}
 		}
 	}
@@ -389,6 +500,11 @@ type logFilter struct {
 	target       string
 	executionIDs []string
 	level        string
+
+	// runner is set when logs are read from runner Job pods rather than
+	// relayed through the controller. Those pods are already selected by
+	// plan, target and execution ID, so only the level filter applies.
+	runner bool
 }
 
 func buildLogFilter(planName string, opts *logsOptions, plan *hibernatorv1alpha1.HibernatePlan) *logFilter {
@@ -410,6 +526,10 @@ func buildLogFilter(planName string, opts *logsOptions, plan *hibernatorv1alpha1
 
 // matches checks if a log line matches the filter criteria.
 func (f *logFilter) matches(line string) bool {
+	if f.runner {
+		return f.matchesLevel(line)
+	}
+
 	if !strings.Contains(line, "execution-service.runner-logs") {
 		return false
 	}
@@ -434,6 +554,11 @@ func (f *logFilter) matches(line string) bool {
 		return false
 	}
 
+	return f.matchesLevel(line)
+}
+
+// matchesLevel checks the optional level filter.
+func (f *logFilter) matchesLevel(line string) bool {
 	// Optional level filter
 	// Note: Runner logs are all INFO level, so we detect "error" by presence of error field
 	if f.level != "" {
@@ -460,6 +585,17 @@ func (f *logFilter) matches(line string) bool {
 	return true
 }
 
+// tagRunnerLine prefixes a formatted runner pod log line with its execution
+// context, which the controller adds to relayed lines but runners do not log.
+func tagRunnerLine(pod *corev1.Pod, raw, formatted string) string {
+	var logEntry map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &logEntry); err == nil && extractString(logEntry, "executionId") != "" {
+		return formatted
+	}
+	return fmt.Sprintf("(exec=%s, target=%s) %s",
+		pod.Labels[wellknown.LabelExecutionID], pod.Labels[wellknown.LabelTarget], formatted)
+}
+
 // formatLogLine attempts to pretty-print a structured JSON log line.
 // Handles different log types (startup, progress, error, waiting, polling, operation).
 // Falls back to returning the raw line if parsing fails.
@@ -689,12 +825,3 @@ func extractString(m map[string]interface{}, key string) string {
 	}
 	return ""
 }
-
-// discoverControllerNamespace returns the namespace where the controller is expected to run.
-// Defaults to "hibernator-system" if not overridden.
-func discoverControllerNamespace() string {
-	if ns := os.Getenv("HIBERNATOR_CONTROLLER_NAMESPACE"); ns != "" {
-		return ns
-	}
-	return "hibernator-system"
-}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package logs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/internal/executionlog"
+)
+
+// showStoredLogs prints the runner logs the control plane persisted for the
+// plan's executions. Without --execution, the logs of the plan's current
+// executions are shown, or of all stored executions when none of those has
+// stored logs.
+func showStoredLogs(ctx context.Context, k8sClient client.Client, opts *logsOptions, filter *logFilter, plan *hibernatorv1alpha1.HibernatePlan) error {
+	if opts.follow {
+		return fmt.Errorf("--follow is not supported with --source=%s", sourceStored)
+	}
+
+	out := output.FromContext(ctx)
+
+	var logs []executionlog.Log
+	if opts.execution != "" {
+		log, err := executionlog.Get(ctx, k8sClient, plan.Namespace, opts.execution)
+		if apierrors.IsNotFound(err) || (err == nil && log.Plan != plan.Name) {
+			return fmt.Errorf("no logs stored for execution %q of plan %q", opts.execution, plan.Name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get stored logs of execution %q: %w", opts.execution, err)
+		}
+		logs = []executionlog.Log{*log}
+	} else {
+		all, err := executionlog.List(ctx, k8sClient, plan.Namespace, plan.Name)
+		if err != nil {
+			return fmt.Errorf("failed to list stored logs of plan %q: %w", plan.Name, err)
+		}
+		logs = selectStoredLogs(all, filter)
+	}
+
+	if len(logs) == 0 {
+		return fmt.Errorf("no stored logs found for plan %q", plan.Name)
+	}
+
+	var lines []*logLine
+	dropped := 0
+	for _, log := range logs {
+		dropped += log.Dropped
+		for _, entry := range log.Entries {
+			raw := storedLogLine(&log, entry)
+			if !filter.matchesLevel(raw) {
+				continue
+			}
+			ts, _ := parseTimestampFromString(entry.Timestamp)
+			lines = append(lines, &logLine{raw: raw, timestamp: ts, line: formatLogLine(raw)})
+		}
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].timestamp.Before(lines[j].timestamp)
+	})
+	if opts.tail > 0 && int64(len(lines)) > opts.tail {
+		lines = lines[int64(len(lines))-opts.tail:]
+	}
+
+	for _, line := range lines {
+		if opts.root.JsonOutput {
+			out.Info(line.raw)
+		} else {
+			out.Info(line.line)
+		}
+	}
+
+	out.Info("Done. Found %d stored log line(s) from %d execution(s) of plan %q.", len(lines), len(logs), plan.Name)
+	if dropped > 0 {
+		out.Info("Note: %d older log line(s) were dropped to stay within the stored size limit.", dropped)
+	}
+
+	return nil
+}
+
+// selectStoredLogs narrows stored logs to the filtered target and, when any
+// of them has stored logs, the plan's current executions.
+func selectStoredLogs(all []executionlog.Log, filter *logFilter) []executionlog.Log {
+	var logs, current []executionlog.Log
+	for _, log := range all {
+		if filter.target != "" && log.Target != filter.target {
+			continue
+		}
+		logs = append(logs, log)
+		if slices.Contains(filter.executionIDs, log.ExecutionID) {
+			current = append(current, log)
+		}
+	}
+	if len(current) > 0 {
+		return current
+	}
+	return logs
+}
+
+// storedLogLine renders a stored entry as a JSON log line shaped like the
+// runner log lines the controller relays, so they are filtered and formatted
+// alike.
+func storedLogLine(log *executionlog.Log, entry executionlog.Entry) string {
+	fields := make(map[string]string, len(entry.Fields)+7)
+	for k, v := range entry.Fields {
+		fields[k] = v
+	}
+	fields["ts"] = entry.Timestamp
+	fields["level"] = strings.ToLower(entry.Level)
+	fields["runner_level"] = entry.Level
+	fields["msg"] = entry.Message
+	fields["plan"] = log.Plan
+	fields["target"] = log.Target
+	fields["executionId"] = log.ExecutionID
+
+	raw, _ := json.Marshal(fields)
+	return string(raw)
+}
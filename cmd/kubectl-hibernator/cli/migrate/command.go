@@ -0,0 +1,288 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/internal/validationwebhook"
+)
+
+// defaultTagName is the tag Instance Scheduler reads schedule names from.
+const defaultTagName = "Schedule"
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+type migrateOptions struct {
+	root          *common.RootOptions
+	configFile    string
+	schedules     []string
+	tagName       string
+	timezone      string
+	accountID     string
+	region        string
+	assumeRoleArn string
+	cloudProvider string
+	resourceTypes []string
+}
+
+// NewCommand creates the "migrate" subcommand.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	migrateOpts := &migrateOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Generate plans from AWS Instance Scheduler or tag-based schedules",
+		Long: `Generate HibernatePlan and CloudProvider manifests equivalent to the schedules
+of AWS Instance Scheduler or another scheduler that selects resources by a
+schedule tag such as Schedule=office-hours.
+
+Schedules are read from the Instance Scheduler config table, exported with
+  aws dynamodb scan --table-name <ConfigTable> --output json > config.json
+or declared with --schedule as <name>=<weekdays>@<begin>-<end>, where the hours
+are when resources run, as in Instance Scheduler periods.
+
+Each schedule becomes a HibernatePlan whose off-hour windows are the times its
+periods do not cover. Its targets select EC2 instances and RDS instances and
+clusters by the schedule tag, so resources keep their existing tags. Periods
+that use months, monthdays, nth-weekday or last-weekday expressions have no
+weekly equivalent; their schedules are skipped with a warning, as are schedules
+with an override_status.
+
+Manifests are written to stdout and are not applied. Review them, then remove
+the schedule tags or stop Instance Scheduler before applying, so two schedulers
+do not act on the same resources.
+
+Examples:
+  kubectl hibernator migrate --instance-scheduler-config config.json \
+    --account-id 123456789012 --region us-east-1 -n hibernator-system > plans.yaml
+  kubectl hibernator migrate --schedule office-hours=mon-fri@08:00-19:00 \
+    --timezone Asia/Jakarta --account-id 123456789012 --region ap-southeast-3`,
+		Args: cobra.NoArgs,
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runMigrate(ctx, migrateOpts)
+		}),
+	}
+
+	cmd.Flags().StringVar(&migrateOpts.configFile, "instance-scheduler-config", "", "JSON output of \"aws dynamodb scan\" of the Instance Scheduler config table")
+	cmd.Flags().StringArrayVar(&migrateOpts.schedules, "schedule", nil, "Schedule running hours as <name>=<weekdays>@<begin>-<end>, e.g. office-hours=mon-fri@09:00-18:00 (repeatable)")
+	cmd.Flags().StringVar(&migrateOpts.tagName, "tag-key", "", `Tag holding the schedule name (default: the tagname of the Instance Scheduler config, or "Schedule")`)
+	cmd.Flags().StringVar(&migrateOpts.timezone, "timezone", "", `Timezone of schedules without one (default: the default_timezone of the Instance Scheduler config, or "UTC")`)
+	cmd.Flags().StringVar(&migrateOpts.accountID, "account-id", "", "AWS account ID of the generated CloudProvider")
+	cmd.Flags().StringVar(&migrateOpts.region, "region", "", "AWS region of the generated CloudProvider")
+	cmd.Flags().StringVar(&migrateOpts.assumeRoleArn, "assume-role-arn", "", "IAM role the generated CloudProvider assumes")
+	cmd.Flags().StringVar(&migrateOpts.cloudProvider, "cloud-provider", "aws", "Name of the generated CloudProvider")
+	cmd.Flags().StringSliceVar(&migrateOpts.resourceTypes, "resource-types", []string{"ec2", "rds"}, "Resource types each plan targets by tag (ec2, rds)")
+	cmd.MarkFlagsOneRequired("instance-scheduler-config", "schedule")
+	lo.Must0(cmd.MarkFlagRequired("account-id"))
+	lo.Must0(cmd.MarkFlagRequired("region"))
+	lo.Must0(cmd.MarkFlagFilename("instance-scheduler-config", "json"))
+
+	return cmd
+}
+
+func runMigrate(ctx context.Context, opts *migrateOptions) error {
+	out := output.FromContext(ctx)
+
+	for _, t := range opts.resourceTypes {
+		if t != "ec2" && t != "rds" {
+			return fmt.Errorf("unsupported resource type %q: expected ec2 or rds", t)
+		}
+	}
+
+	cfg := &instanceSchedulerConfig{periods: map[string]period{}}
+	if opts.configFile != "" {
+		loaded, err := loadInstanceSchedulerConfig(opts.configFile)
+		if err != nil {
+			return err
+		}
+		cfg = loaded
+	}
+	if err := addFlagSchedules(cfg, opts.schedules); err != nil {
+		return err
+	}
+
+	tagName := lo.CoalesceOrEmpty(opts.tagName, cfg.tagName, defaultTagName)
+	timezone := lo.CoalesceOrEmpty(opts.timezone, cfg.timezone, "UTC")
+
+	manifests := []client.Object{newCloudProvider(opts)}
+	validator := validationwebhook.NewHibernatePlanValidator(logr.Discard())
+
+	for _, s := range cfg.schedules {
+		plan, err := newPlan(opts, cfg, s, tagName, lo.CoalesceOrEmpty(s.timezone, timezone))
+		if err != nil {
+			out.Warning("skipping schedule %q: %v", s.name, err)
+			continue
+		}
+		if _, err := validator.ValidateCreate(ctx, plan); err != nil {
+			out.Warning("skipping schedule %q: generated plan is invalid: %v", s.name, err)
+			continue
+		}
+		manifests = append(manifests, plan)
+	}
+
+	if len(manifests) == 1 {
+		return fmt.Errorf("no schedule could be converted")
+	}
+
+	for i, obj := range manifests {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("failed to render manifest: %w", err)
+		}
+		if i > 0 {
+			out.Info("---")
+		}
+		out.Info("%s", strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// addFlagSchedules adds the --schedule values to cfg. Values sharing a name are
+// periods of the same schedule.
+func addFlagSchedules(cfg *instanceSchedulerConfig, values []string) error {
+	for i, value := range values {
+		name, p, err := parseScheduleFlag(value)
+		if err != nil {
+			return err
+		}
+		p.name = fmt.Sprintf("%s-%d", name, i)
+		cfg.periods[p.name] = p
+
+		if _, idx, ok := lo.FindIndexOf(cfg.schedules, func(s isSchedule) bool { return s.name == name }); ok {
+			cfg.schedules[idx].periods = append(cfg.schedules[idx].periods, p.name)
+			continue
+		}
+		cfg.schedules = append(cfg.schedules, isSchedule{name: name, periods: []string{p.name}})
+	}
+	return nil
+}
+
+func newCloudProvider(opts *migrateOptions) *hibernatorv1alpha1.CloudProvider {
+	return &hibernatorv1alpha1.CloudProvider{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: hibernatorv1alpha1.GroupVersion.String(),
+			Kind:       "CloudProvider",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: opts.cloudProvider, Namespace: opts.root.Namespace},
+		Spec: hibernatorv1alpha1.CloudProviderSpec{
+			Type: hibernatorv1alpha1.CloudProviderAWS,
+			AWS: &hibernatorv1alpha1.AWSConfig{
+				AccountId:     opts.accountID,
+				Region:        opts.region,
+				AssumeRoleArn: opts.assumeRoleArn,
+				Auth: hibernatorv1alpha1.AWSAuth{
+					ServiceAccount: &hibernatorv1alpha1.ServiceAccountAuth{},
+				},
+			},
+		},
+	}
+}
+
+// newPlan converts an Instance Scheduler schedule into a HibernatePlan whose
+// targets select resources tagged tagName=<schedule name>.
+func newPlan(opts *migrateOptions, cfg *instanceSchedulerConfig, s isSchedule, tagName, timezone string) (*hibernatorv1alpha1.HibernatePlan, error) {
+	if s.overrideStatus != "" {
+		return nil, fmt.Errorf("override_status %q keeps resources %s permanently", s.overrideStatus, s.overrideStatus)
+	}
+	if len(s.periods) == 0 {
+		return nil, fmt.Errorf("no periods")
+	}
+
+	var running runningHours
+	for _, ref := range s.periods {
+		// "<period>@<instance type>" resizes instances, which has no equivalent here.
+		name, _, _ := strings.Cut(ref, "@")
+		p, ok := cfg.periods[name]
+		if !ok {
+			return nil, fmt.Errorf("period %q not found", name)
+		}
+		if err := running.add(p); err != nil {
+			return nil, err
+		}
+	}
+
+	windows := running.offHours()
+	if len(windows) == 0 {
+		return nil, fmt.Errorf("resources run around the clock")
+	}
+
+	targets := make([]hibernatorv1alpha1.Target, 0, len(opts.resourceTypes))
+	for _, t := range opts.resourceTypes {
+		params, err := targetParameters(t, tagName, s.name)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, hibernatorv1alpha1.Target{
+			Name: t,
+			Type: t,
+			ConnectorRef: hibernatorv1alpha1.ConnectorRef{
+				Kind: "CloudProvider",
+				Name: opts.cloudProvider,
+			},
+			Parameters: params,
+		})
+	}
+
+	return &hibernatorv1alpha1.HibernatePlan{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: hibernatorv1alpha1.GroupVersion.String(),
+			Kind:       "HibernatePlan",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: planName(s.name), Namespace: opts.root.Namespace},
+		Spec: hibernatorv1alpha1.HibernatePlanSpec{
+			Schedule: hibernatorv1alpha1.Schedule{
+				Timezone: timezone,
+				OffHours: windows,
+			},
+			Execution: hibernatorv1alpha1.Execution{
+				Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategyParallel},
+			},
+			Targets: targets,
+		},
+	}, nil
+}
+
+// targetParameters selects resources of type t tagged tagName=value.
+func targetParameters(t, tagName, value string) (*hibernatorv1alpha1.Parameters, error) {
+	var selector map[string]any
+	switch t {
+	case "ec2":
+		selector = map[string]any{"tags": map[string]string{tagName: value}}
+	case "rds":
+		selector = map[string]any{
+			"tagSelector":       map[string]any{"matchTags": map[string]string{tagName: value}},
+			"discoverInstances": true,
+			"discoverClusters":  true,
+		}
+	}
+
+	raw, err := json.Marshal(map[string]any{"selector": selector})
+	if err != nil {
+		return nil, err
+	}
+	return &hibernatorv1alpha1.Parameters{Raw: raw}, nil
+}
+
+// planName turns a schedule name into a valid resource name.
+func planName(schedule string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(schedule), "-")
+	return strings.Trim(name, "-")
+}
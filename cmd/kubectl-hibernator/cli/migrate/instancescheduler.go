@@ -0,0 +1,166 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// attributeValue is a DynamoDB attribute as printed by "aws dynamodb scan".
+type attributeValue struct {
+	S    *string          `json:"S,omitempty"`
+	SS   []string         `json:"SS,omitempty"`
+	N    *string          `json:"N,omitempty"`
+	BOOL *bool            `json:"BOOL,omitempty"`
+	L    []attributeValue `json:"L,omitempty"`
+}
+
+// item is one row of the Instance Scheduler config table.
+type item map[string]attributeValue
+
+// str returns the string attribute key, or "" when it is absent.
+func (it item) str(key string) string {
+	v, ok := it[key]
+	if !ok {
+		return ""
+	}
+	switch {
+	case v.S != nil:
+		return *v.S
+	case v.N != nil:
+		return *v.N
+	case v.BOOL != nil:
+		return fmt.Sprint(*v.BOOL)
+	}
+	return ""
+}
+
+// strs returns the string set attribute key. Lists of strings and
+// comma-separated strings are accepted as well.
+func (it item) strs(key string) []string {
+	v, ok := it[key]
+	if !ok {
+		return nil
+	}
+	switch {
+	case len(v.SS) > 0:
+		return v.SS
+	case len(v.L) > 0:
+		var out []string
+		for _, e := range v.L {
+			if e.S != nil {
+				out = append(out, *e.S)
+			}
+		}
+		return out
+	case v.S != nil:
+		return splitList(*v.S)
+	}
+	return nil
+}
+
+// period is an Instance Scheduler period: a window in which instances run.
+type period struct {
+	name      string
+	beginTime string
+	endTime   string
+	weekdays  []string
+	months    []string
+	monthdays []string
+}
+
+// isSchedule is an Instance Scheduler schedule: a named set of periods.
+type isSchedule struct {
+	name           string
+	timezone       string
+	periods        []string
+	overrideStatus string
+}
+
+// instanceSchedulerConfig is the content of the Instance Scheduler config table.
+type instanceSchedulerConfig struct {
+	tagName   string
+	timezone  string
+	periods   map[string]period
+	schedules []isSchedule
+}
+
+// loadInstanceSchedulerConfig reads the JSON output of
+// "aws dynamodb scan --table-name <ConfigTable>".
+func loadInstanceSchedulerConfig(path string) (*instanceSchedulerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var scan struct {
+		Items []item `json:"Items"`
+	}
+	if err := json.Unmarshal(data, &scan); err != nil {
+		return nil, fmt.Errorf("failed to parse %s as DynamoDB scan output: %w", path, err)
+	}
+
+	cfg := &instanceSchedulerConfig{periods: map[string]period{}}
+	for _, it := range scan.Items {
+		switch it.str("type") {
+		case "config":
+			cfg.tagName = it.str("tagname")
+			cfg.timezone = it.str("default_timezone")
+		case "period":
+			p := period{
+				name:      it.str("name"),
+				beginTime: it.str("begintime"),
+				endTime:   it.str("endtime"),
+				weekdays:  it.strs("weekdays"),
+				months:    it.strs("months"),
+				monthdays: it.strs("monthdays"),
+			}
+			cfg.periods[p.name] = p
+		case "schedule":
+			cfg.schedules = append(cfg.schedules, isSchedule{
+				name:           it.str("name"),
+				timezone:       it.str("timezone"),
+				periods:        it.strs("periods"),
+				overrideStatus: it.str("override_status"),
+			})
+		}
+	}
+
+	sort.Slice(cfg.schedules, func(i, j int) bool { return cfg.schedules[i].name < cfg.schedules[j].name })
+	return cfg, nil
+}
+
+// parseScheduleFlag parses a --schedule value of the form
+// "<name>=<weekdays>@<begin>-<end>", e.g. "office-hours=mon-fri@09:00-18:00".
+func parseScheduleFlag(value string) (string, period, error) {
+	name, spec, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return "", period{}, fmt.Errorf("invalid --schedule %q: expected <name>=<weekdays>@<begin>-<end>", value)
+	}
+	days, hours, ok := strings.Cut(spec, "@")
+	if !ok {
+		return "", period{}, fmt.Errorf("invalid --schedule %q: expected <name>=<weekdays>@<begin>-<end>", value)
+	}
+	begin, end, ok := strings.Cut(hours, "-")
+	if !ok {
+		return "", period{}, fmt.Errorf("invalid --schedule %q: running hours must be <begin>-<end>", value)
+	}
+	return name, period{name: name, beginTime: begin, endTime: end, weekdays: splitList(days)}, nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package migrate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// minutesPerDay is the exclusive end of a day in minutes.
+const minutesPerDay = 24 * 60
+
+var weekdayNames = []string{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}
+
+// interval is a half-open range of minutes within a day.
+type interval struct {
+	start, end int
+}
+
+// runningHours collects, per weekday (0 = Monday), when instances should run.
+type runningHours [7][]interval
+
+// add records that instances run during p. Instance Scheduler periods without a
+// begin time start at midnight and periods without an end time run until midnight.
+func (h *runningHours) add(p period) error {
+	if len(p.months) > 0 || len(p.monthdays) > 0 {
+		return fmt.Errorf("period %q: months and monthdays have no equivalent in weekly off-hour windows", p.name)
+	}
+
+	begin, end := 0, minutesPerDay
+	var err error
+	if p.beginTime != "" {
+		if begin, err = parseClock(p.beginTime); err != nil {
+			return fmt.Errorf("period %q: begintime: %w", p.name, err)
+		}
+	}
+	if p.endTime != "" {
+		if end, err = parseClock(p.endTime); err != nil {
+			return fmt.Errorf("period %q: endtime: %w", p.name, err)
+		}
+	}
+
+	days, err := parseWeekdays(p.weekdays)
+	if err != nil {
+		return fmt.Errorf("period %q: %w", p.name, err)
+	}
+
+	for _, day := range days {
+		if begin < end {
+			h[day] = append(h[day], interval{begin, end})
+			continue
+		}
+		// A period ending before it begins runs overnight into the next day.
+		h[day] = append(h[day], interval{begin, minutesPerDay})
+		if end > 0 {
+			next := (day + 1) % 7
+			h[next] = append(h[next], interval{0, end})
+		}
+	}
+	return nil
+}
+
+// offHours returns the complement of the running hours as off-hour windows.
+// Each day contributes its own windows, and days with the same gaps share a
+// window; the controller treats windows that meet at midnight as one continuous
+// hibernation. It returns nil when instances never stop.
+func (h *runningHours) offHours() []hibernatorv1alpha1.OffHourWindow {
+	var windows []hibernatorv1alpha1.OffHourWindow
+	index := map[interval]int{}
+
+	for day := range h {
+		for _, gap := range gaps(h[day]) {
+			// Windows end at 23:59 at the latest, so a gap in the last minute is dropped.
+			if gap.start >= minutesPerDay-1 {
+				continue
+			}
+			i, ok := index[gap]
+			if !ok {
+				i = len(windows)
+				index[gap] = i
+				windows = append(windows, hibernatorv1alpha1.OffHourWindow{
+					Start: formatClock(gap.start),
+					End:   formatClock(min(gap.end, minutesPerDay-1)),
+				})
+			}
+			windows[i].DaysOfWeek = append(windows[i].DaysOfWeek, weekdayNames[day])
+		}
+	}
+	return windows
+}
+
+// gaps returns the parts of a day not covered by running.
+func gaps(running []interval) []interval {
+	sorted := append([]interval(nil), running...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].start < sorted[j].start })
+
+	var out []interval
+	cursor := 0
+	for _, r := range sorted {
+		if r.start > cursor {
+			out = append(out, interval{cursor, r.start})
+		}
+		cursor = max(cursor, r.end)
+	}
+	if cursor < minutesPerDay {
+		out = append(out, interval{cursor, minutesPerDay})
+	}
+	return out
+}
+
+// parseWeekdays expands Instance Scheduler weekday expressions ("mon", "mon-fri",
+// "0-4", where 0 is Monday) into day indexes. An empty list means every day.
+// Nth-weekday ("mon#1") and last-weekday ("friL") expressions are not supported.
+func parseWeekdays(exprs []string) ([]int, error) {
+	if len(exprs) == 0 {
+		return []int{0, 1, 2, 3, 4, 5, 6}, nil
+	}
+
+	seen := map[int]bool{}
+	for _, expr := range exprs {
+		for _, part := range splitList(expr) {
+			from, to, isRange := strings.Cut(part, "-")
+			start, err := parseWeekday(from)
+			if err != nil {
+				return nil, err
+			}
+			end := start
+			if isRange {
+				if end, err = parseWeekday(to); err != nil {
+					return nil, err
+				}
+			}
+			for day := start; ; day = (day + 1) % 7 {
+				seen[day] = true
+				if day == end {
+					break
+				}
+			}
+		}
+	}
+
+	days := make([]int, 0, len(seen))
+	for day := range seen {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days, nil
+}
+
+func parseWeekday(s string) (int, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 && n < 7 {
+		return n, nil
+	}
+	for i, name := range weekdayNames {
+		if s == name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported weekday %q", s)
+}
+
+// parseClock parses "H:MM" or "HH:MM" into minutes after midnight.
+func parseClock(s string) (int, error) {
+	hh, mm, ok := strings.Cut(strings.TrimSpace(s), ":")
+	h, errH := strconv.Atoi(hh)
+	m, errM := strconv.Atoi(mm)
+	if !ok || errH != nil || errM != nil || h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	return h*60 + m, nil
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
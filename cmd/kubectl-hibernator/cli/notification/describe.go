@@ -43,7 +43,8 @@ Examples:
   kubectl hibernator notification describe my-notification -n production
   kubectl hibernator notification describe my-notification --plan my-plan
   kubectl hibernator notification describe my-notification --json`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompleteNotificationNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runDescribe(ctx, describeOpts, args[0])
 		}),
@@ -104,7 +104,8 @@ Examples:
 
   # Control phase in synthetic payload
   kubectl hibernator notification send my-notification --event Start --phase Hibernating`,
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: common.CompleteNotificationNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			var notifName string
 			if len(args) > 0 {
@@ -501,6 +502,9 @@ func buildPayloadFromPlan(ctx context.Context, c client.Client, ns string, opts
 	if err := c.Get(ctx, types.NamespacedName{Name: opts.planName, Namespace: ns}, &plan); err != nil {
 		return sink.Payload{}, fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", opts.planName, ns, err)
 	}
+	if err := common.LoadExecutions(ctx, c, &plan); err != nil {
+		return sink.Payload{}, err
+	}
 
 	phase := string(plan.Status.Phase)
 	if opts.phase != "" {
@@ -89,7 +89,8 @@ Examples:
 
   # Preview what would happen without actually overriding
   kubectl hibernator override my-plan --to hibernate --until "in 2 hours" --dry-run`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runOverride(ctx, overrideOpts, args[0])
 		}),
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package pauseop
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+type pauseOptions struct {
+	root *common.RootOptions
+}
+
+// NewPauseCommand creates the "pause-op" command.
+func NewPauseCommand(opts *common.RootOptions) *cobra.Command {
+	pauseOpts := &pauseOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "pause-op <plan-name>",
+		Short: "Pause an in-progress hibernation or wakeup between stages",
+		Long: `Pause the in-progress operation of a HibernatePlan by adding the pause-operation
+annotation.
+
+The plan stays in its Hibernating or WakingUp phase with the OperationPaused condition
+set. Runner Jobs already dispatched run to completion and their results are recorded,
+but no further targets or stages are dispatched until the operation is resumed with
+'resume-op'. A paused operation is not aborted by spec.behavior.maxCycleDuration.
+
+Examples:
+  kubectl hibernator pause-op my-plan
+  kubectl hibernator pause-op my-plan -n production`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runPause(ctx, pauseOpts, args[0])
+		}),
+	}
+
+	return cmd
+}
+
+// NewResumeCommand creates the "resume-op" command.
+func NewResumeCommand(opts *common.RootOptions) *cobra.Command {
+	resumeOpts := &pauseOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "resume-op <plan-name>",
+		Short: "Resume an operation paused with pause-op",
+		Long: `Resume a paused hibernation or wakeup by removing the pause-operation annotation.
+
+The controller continues dispatching from the stage the operation was paused at, and
+the spec.behavior.maxCycleDuration budget restarts.
+
+To resume a suspended plan, use 'resume' instead.
+
+Examples:
+  kubectl hibernator resume-op my-plan`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runResume(ctx, resumeOpts, args[0])
+		}),
+	}
+
+	return cmd
+}
+
+func runPause(ctx context.Context, opts *pauseOptions, planName string) error {
+	out := output.FromContext(ctx)
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
+		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
+	}
+
+	switch plan.Status.Phase {
+	case hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.PhaseWakingUp:
+	default:
+		return fmt.Errorf("HibernatePlan %q is in %q phase; pause-op only applies to plans that are Hibernating or WakingUp", planName, plan.Status.Phase)
+	}
+
+	if common.IsMarkedTrue(plan.Annotations, wellknown.AnnotationPauseOperation) {
+		out.Info("HibernatePlan %q is already paused", planName)
+		return nil
+	}
+
+	patch := client.MergeFrom(plan.DeepCopy())
+
+	if plan.Annotations == nil {
+		plan.Annotations = make(map[string]string)
+	}
+	common.MarkTrue(plan.Annotations, wellknown.AnnotationPauseOperation)
+
+	if err := c.Patch(ctx, &plan, patch); err != nil {
+		return fmt.Errorf("failed to patch HibernatePlan %q: %w", planName, err)
+	}
+
+	out.Success("Paused %s operation of HibernatePlan %q at stage %d", plan.Status.CurrentOperation, planName, plan.Status.CurrentStageIndex+1)
+	out.Hint("Running jobs will finish; resume with 'kubectl hibernator resume-op %s'", planName)
+	return nil
+}
+
+func runResume(ctx context.Context, opts *pauseOptions, planName string) error {
+	out := output.FromContext(ctx)
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
+		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
+	}
+
+	if _, ok := plan.Annotations[wellknown.AnnotationPauseOperation]; !ok {
+		out.Info("HibernatePlan %q has no paused operation", planName)
+		return nil
+	}
+
+	patch := client.MergeFrom(plan.DeepCopy())
+	delete(plan.Annotations, wellknown.AnnotationPauseOperation)
+
+	if err := c.Patch(ctx, &plan, patch); err != nil {
+		return fmt.Errorf("failed to patch HibernatePlan %q: %w", planName, err)
+	}
+
+	out.Success("Resumed %s operation of HibernatePlan %q", plan.Status.CurrentOperation, planName)
+	return nil
+}
@@ -9,24 +9,26 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/utils/clock"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+	"github.com/ardikabs/hibernator/cmd/runner/timeparse"
 	"github.com/ardikabs/hibernator/internal/scheduler"
 )
 
 type previewOptions struct {
-	root   *common.RootOptions
-	file   string
-	events int
+	root     *common.RootOptions
+	file     string
+	events   int
+	from     string
+	duration string
 }
 
 // NewCommand creates the "preview" command.
@@ -40,10 +42,19 @@ func NewCommand(opts *common.RootOptions) *cobra.Command {
 		Long: `Show the hibernation schedule including timezone, off-hour windows,
 upcoming hibernate/wakeup events, and any active schedule exceptions.
 
+With --duration, the schedule is simulated over a time range starting at
+--from (default: now) and every hibernate/wakeup transition in it is listed.
+The simulation applies active and pending exceptions within their validity
+periods; with --file, ScheduleExceptions for the plan in the same file are
+applied instead, so windows and exceptions can be verified before applying.
+
 Works with both cluster resources and local YAML files:
   kubectl hibernator preview my-plan
-  kubectl hibernator preview --file plan.yaml`,
-		Args: cobra.MaximumNArgs(1),
+  kubectl hibernator preview --file plan.yaml
+  kubectl hibernator preview my-plan --duration 14d
+  kubectl hibernator preview --file plan-and-exceptions.yaml --from "tomorrow at 6am" --duration 7d`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runPreview(ctx, previewOpts, args)
 		}),
@@ -51,11 +62,20 @@ Works with both cluster resources and local YAML files:
 
 	cmd.Flags().StringVarP(&previewOpts.file, "file", "f", "", "Path to a local HibernatePlan YAML file")
 	cmd.Flags().IntVar(&previewOpts.events, "events", 5, "Number of upcoming events to display")
+	cmd.Flags().StringVar(&previewOpts.from, "from", "", `Start of the simulated range (e.g. "tomorrow at 6am", "2026-01-15 14:30"). Defaults to now. Requires --duration`)
+	cmd.Flags().StringVar(&previewOpts.duration, "duration", "", "Length of the simulated range (e.g. 14d, 36h). Lists every transition in the range instead of the next --events")
 
 	return cmd
 }
 
 func runPreview(ctx context.Context, opts *previewOptions, args []string) error {
+	if opts.duration != "" {
+		return runRange(ctx, opts, args)
+	}
+	if opts.from != "" {
+		return fmt.Errorf("--from requires --duration")
+	}
+
 	var (
 		plan       hibernatorv1alpha1.HibernatePlan
 		exceptions []*scheduler.Exception
@@ -64,7 +84,7 @@ func runPreview(ctx context.Context, opts *previewOptions, args []string) error
 
 	if opts.file != "" {
 		// Load from local YAML file
-		if err := loadPlanFromFile(opts.file, &plan); err != nil {
+		if err := common.LoadPlanFromFile(opts.file, &plan); err != nil {
 			return err
 		}
 	} else {
@@ -114,32 +134,84 @@ func runPreview(ctx context.Context, opts *previewOptions, args []string) error
 	return d.PrintObj(output, os.Stdout)
 }
 
-func loadPlanFromFile(path string, plan *hibernatorv1alpha1.HibernatePlan) error {
-	data, err := os.ReadFile(path)
+// runRange simulates the schedule over [--from, --from+--duration] and prints
+// every transition, applying the exceptions that are valid at each point.
+func runRange(ctx context.Context, opts *previewOptions, args []string) error {
+	span, err := common.ParseSpan(opts.duration)
 	if err != nil {
-		return fmt.Errorf("failed to read file %q: %w", path, err)
+		return fmt.Errorf("invalid --duration: %w", err)
+	}
+
+	from := time.Now()
+	if opts.from != "" {
+		if from, err = timeparse.ParseDeadline(opts.from, from); err != nil {
+			return fmt.Errorf("invalid --from: %w", err)
+		}
 	}
+	until := from.Add(span)
+
+	var (
+		plan          hibernatorv1alpha1.HibernatePlan
+		apiExceptions []hibernatorv1alpha1.ScheduleException
+	)
+
+	if opts.file != "" {
+		if err := common.LoadPlanFromFile(opts.file, &plan); err != nil {
+			return err
+		}
+		if apiExceptions, err = common.LoadExceptionsFromFile(opts.file, plan); err != nil {
+			return err
+		}
+	} else {
+		if len(args) == 0 {
+			return fmt.Errorf("plan name is required (or use --file for local YAML)")
+		}
 
-	// Handle multi-document YAML: find the HibernatePlan document
-	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(string(data)), 4096)
-	for {
-		var raw hibernatorv1alpha1.HibernatePlan
-		if err := decoder.Decode(&raw); err != nil {
-			if err.Error() == "EOF" {
-				break
-			}
-			return fmt.Errorf("failed to parse YAML from %q: %w", path, err)
+		c, err := common.NewK8sClient(opts.root)
+		if err != nil {
+			return err
+		}
+
+		ns := common.ResolveNamespace(opts.root)
+		if err := c.Get(ctx, types.NamespacedName{Name: args[0], Namespace: ns}, &plan); err != nil {
+			return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", args[0], ns, err)
+		}
+
+		if apiExceptions, err = common.FetchScheduledExceptions(ctx, c, plan); err != nil {
+			return err
 		}
-		if raw.Kind == "HibernatePlan" || (raw.Kind == "" && raw.Spec.Schedule.Timezone != "") {
-			*plan = raw
-			return nil
+	}
+
+	exceptions := make([]*scheduler.Exception, len(apiExceptions))
+	exRefs := make([]hibernatorv1alpha1.ExceptionReference, len(apiExceptions))
+	for i, exc := range apiExceptions {
+		exceptions[i] = common.ConvertAPIException(exc)
+		exRefs[i] = hibernatorv1alpha1.ExceptionReference{
+			Name:       exc.Name,
+			Type:       exc.Spec.Type,
+			ValidFrom:  exc.Spec.ValidFrom,
+			ValidUntil: exc.Spec.ValidUntil,
+			State:      exc.Status.State,
 		}
 	}
 
-	// Fallback: try as single-document
-	if err := yaml.UnmarshalStrict(data, plan); err != nil {
-		return fmt.Errorf("no HibernatePlan found in %q: %w", path, err)
+	windows := common.ConvertAPIWindows(plan.Spec.Schedule.OffHours)
+	result, err := common.EvaluateAt(windows, plan.Spec.Schedule.Timezone, exceptions, from)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate schedule: %w", err)
+	}
+
+	transitions, err := common.ComputeTransitions(windows, plan.Spec.Schedule.Timezone, exceptions, from, until)
+	if err != nil {
+		return fmt.Errorf("failed to simulate schedule: %w", err)
 	}
 
-	return nil
+	d := &printers.Dispatcher{JSON: opts.root.JsonOutput}
+	return d.PrintObj(&printers.ScheduleOutput{
+		Plan:        plan,
+		Result:      result,
+		Exceptions:  exRefs,
+		Range:       &printers.ScheduleRange{From: from, Until: until},
+		Transitions: transitions,
+	}, os.Stdout)
 }
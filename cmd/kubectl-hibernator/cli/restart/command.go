@@ -48,7 +48,8 @@ Use retry instead when the plan is stuck in PhaseError.
 Examples:
   kubectl hibernator restart my-plan
   kubectl hibernator restart my-plan -n production`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runRestart(ctx, restartOpts, args[0])
 		}),
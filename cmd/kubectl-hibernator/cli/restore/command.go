@@ -47,7 +47,11 @@ Examples:
   kubectl hibernator restore patch my-plan --target eks-cluster --resource-id xyz --set desiredCapacity=10
 
   # Drop a resource from the restore point (use with caution)
-  kubectl hibernator restore drop my-plan --target eks-cluster --resource-id xyz`,
+  kubectl hibernator restore drop my-plan --target eks-cluster --resource-id xyz
+
+  # List archived snapshots and roll back to an older one
+  kubectl hibernator restore history my-plan --target eks-cluster
+  kubectl hibernator restore rollback my-plan --target eks-cluster --version 3`,
 	}
 
 	cmd.AddCommand(newInitCommand(opts))
@@ -55,6 +59,8 @@ Examples:
 	cmd.AddCommand(newInspectCommand(opts))
 	cmd.AddCommand(newPatchCommand(opts))
 	cmd.AddCommand(newDropCommand(opts))
+	cmd.AddCommand(newHistoryCommand(opts))
+	cmd.AddCommand(newRollbackCommand(opts))
 
 	return cmd
 }
@@ -40,13 +40,15 @@ Flags:
 Examples:
   kubectl hibernator restore drop my-plan --target eks-cluster --resource-id node-xyz
   kubectl hibernator restore drop my-plan --target rds --resource-id db-prod-01`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runDrop(ctx, restoreOpts, args[0])
 		}),
 	}
 
 	cmd.Flags().StringVarP(&restoreOpts.target, "target", "t", "", "Target name (required)")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("target", common.CompleteTargetNames(opts)))
 	cmd.Flags().StringVarP(&restoreOpts.resourceID, "resource-id", "r", "", "Resource ID to drop (required)")
 
 	lo.Must0(cmd.MarkFlagRequired("target"))
@@ -72,6 +74,10 @@ func runDrop(ctx context.Context, opts *restorePointOptions, planName string) er
 		return fmt.Errorf("no restore point found for plan %q: %w", planName, err)
 	}
 
+	if restore.IsChunked(&cm) {
+		return fmt.Errorf("restore data of plan %q is split across multiple ConfigMaps and cannot be edited in place", planName)
+	}
+
 	// Find and update the target's restore data
 	found := false
 	for key, val := range cm.Data {
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+	"github.com/ardikabs/hibernator/internal/restore"
+)
+
+// newHistoryCommand lists archived restore snapshots of a target
+func newHistoryCommand(opts *common.RootOptions) *cobra.Command {
+	restoreOpts := &restorePointOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "history <plan-name>",
+		Short: "List archived restore snapshots of a target",
+		Long: `List the restore snapshots archived for a target after each successful wakeup.
+
+Snapshots are kept when the plan sets spec.restore.history. Use "restore rollback"
+to promote an older snapshot when the latest restore data is unusable.
+
+Flags:
+  --target  (required) The target name
+
+Examples:
+  kubectl hibernator restore history my-plan --target eks-cluster
+  kubectl hibernator restore history my-plan --target rds --json`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runHistory(ctx, restoreOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().StringVarP(&restoreOpts.target, "target", "t", "", "Target name (required)")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("target", common.CompleteTargetNames(opts)))
+
+	lo.Must0(cmd.MarkFlagRequired("target"))
+
+	return cmd
+}
+
+func runHistory(ctx context.Context, opts *restorePointOptions, planName string) error {
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+
+	snapshots, err := newRestoreManager(c).ListSnapshots(ctx, ns, planName, opts.target)
+	if err != nil {
+		return fmt.Errorf("failed to list restore snapshots for plan %q: %w", planName, err)
+	}
+
+	d := &printers.Dispatcher{JSON: opts.root.JsonOutput}
+	return d.PrintObj(&printers.RestoreHistoryOutput{
+		Plan:      planName,
+		Namespace: ns,
+		Target:    opts.target,
+		Snapshots: snapshots,
+	}, os.Stdout)
+}
+
+// newRestoreManager returns a restore manager that reads from the storage
+// backend configured on each plan.
+func newRestoreManager(c client.Client) *restore.Manager {
+	return restore.NewManager(c, logr.Discard(), restore.WithBackendResolver(restore.PlanStorageResolver(c)))
+}
@@ -52,13 +52,15 @@ Examples:
   kubectl hibernator restore init my-plan --target eks-cluster --executor eks
   kubectl hibernator restore init my-plan --target db-prod --executor rds --force
   kubectl hibernator restore init my-plan -t karpenter-target -x karpenter`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runInit(ctx, initOpts, args[0])
 		}),
 	}
 
 	cmd.Flags().StringVarP(&initOpts.target, "target", "t", "", "Target name (required)")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("target", common.CompleteTargetNames(opts)))
 	cmd.Flags().StringVarP(&initOpts.executor, "executor", "x", "", "Executor type (required)")
 	cmd.Flags().BoolVar(&initOpts.force, "force", false, "Overwrite existing restore point entry for the target")
 
@@ -108,6 +110,10 @@ func runInit(ctx context.Context, opts *initOptions, planName string) error {
 		return fmt.Errorf("failed to get restore ConfigMap: %w", err)
 	}
 
+	if restore.IsChunked(&cm) {
+		return fmt.Errorf("restore data of plan %q is split across multiple ConfigMaps and cannot be edited in place", planName)
+	}
+
 	// Check if target already exists
 	targetKey := fmt.Sprintf("%s.json", opts.target)
 	if _, exists := cm.Data[targetKey]; exists && !opts.force {
@@ -13,8 +13,6 @@ import (
 
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
@@ -43,13 +41,15 @@ Examples:
   kubectl hibernator restore inspect my-plan --target eks-cluster --resource-id node-123
   kubectl hibernator restore inspect my-plan --target rds --resource-id db-prod-01
   kubectl hibernator restore inspect my-plan -t eks-cluster -r node-123 --json`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runInspect(ctx, restoreOpts, args[0])
 		}),
 	}
 
 	cmd.Flags().StringVarP(&restoreOpts.target, "target", "t", "", "Target name (required)")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("target", common.CompleteTargetNames(opts)))
 	cmd.Flags().StringVarP(&restoreOpts.resourceID, "resource-id", "r", "", "Resource ID (required)")
 
 	lo.Must0(cmd.MarkFlagRequired("target"))
@@ -67,9 +67,8 @@ func runInspect(ctx context.Context, opts *restorePointOptions, planName string)
 	ns := common.ResolveNamespace(opts.root)
 
 	// Load restore ConfigMap
-	cmName := restore.GetRestoreConfigMap(planName)
-	var cm corev1.ConfigMap
-	if err := c.Get(ctx, types.NamespacedName{Name: cmName, Namespace: ns}, &cm); err != nil {
+	cm, err := restore.LoadConfigMap(ctx, c, ns, planName)
+	if err != nil {
 		return fmt.Errorf("no restore point found for plan %q: %w", planName, err)
 	}
 
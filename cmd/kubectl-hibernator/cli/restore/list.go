@@ -10,8 +10,8 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
@@ -47,7 +47,8 @@ Examples:
   kubectl hibernator restore list my-plan -o wide      # Show detailed list
   kubectl hibernator restore list my-plan --target eks-cluster -o wide
   kubectl hibernator restore list my-plan -o json      # Detailed list in JSON`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			switch outputFormat {
 			case "wide":
@@ -65,6 +66,7 @@ Examples:
 
 	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "Output format (json or wide)")
 	cmd.Flags().StringVarP(&restoreOpts.target, "target", "t", "", "Filter by specific target name")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("target", common.CompleteTargetNames(opts)))
 
 	return cmd
 }
@@ -78,15 +80,14 @@ func runListResources(ctx context.Context, opts *restorePointOptions, planName s
 	ns := common.ResolveNamespace(opts.root)
 
 	// Load restore ConfigMap
-	cmName := restore.GetRestoreConfigMap(planName)
-	var cm corev1.ConfigMap
-	if err := c.Get(ctx, types.NamespacedName{Name: cmName, Namespace: ns}, &cm); err != nil {
+	cm, err := restore.LoadConfigMap(ctx, c, ns, planName)
+	if err != nil {
 		return fmt.Errorf("no restore point found for plan %q", planName)
 	}
 
 	d := &printers.Dispatcher{JSON: opts.root.JsonOutput}
 	return d.PrintObj(&printers.RestoreResourcesOutput{
-		ConfigMap: cm,
+		ConfigMap: *cm,
 		Target:    opts.target,
 	}, os.Stdout)
 }
@@ -107,9 +108,8 @@ func runList(ctx context.Context, opts *restorePointOptions, planName string) er
 	}
 
 	// Load restore ConfigMap
-	cmName := restore.GetRestoreConfigMap(planName)
-	var cm corev1.ConfigMap
-	if err := c.Get(ctx, types.NamespacedName{Name: cmName, Namespace: ns}, &cm); err != nil {
+	cm, err := restore.LoadConfigMap(ctx, c, ns, planName)
+	if err != nil {
 		out := output.FromContext(ctx)
 		out.Hint("No restore point found for plan %q", planName)
 		return nil
@@ -76,13 +76,15 @@ kubectl hibernator restore patch my-plan -t eks -r node-123 \
 kubectl hibernator restore patch my-plan -t eks -r node-123 \
 --set desiredCapacity=10 \
 --dry-run`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runPatch(ctx, patchOpts, args[0])
 		}),
 	}
 
 	cmd.Flags().StringVarP(&patchOpts.target, "target", "t", "", "Target name (required)")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("target", common.CompleteTargetNames(opts)))
 	cmd.Flags().StringVarP(&patchOpts.resourceID, "resource-id", "r", "", "Resource ID (required)")
 	cmd.Flags().StringArrayVar(&patchOpts.sets, "set", nil, "Set field value (dot notation, repeatable). Example: --set config.min=5")
 	cmd.Flags().StringArrayVar(&patchOpts.removes, "remove", nil, "Remove field (dot notation, repeatable). Example: --remove config.deprecated")
@@ -125,6 +127,10 @@ func runPatch(ctx context.Context, opts *patchOptions, planName string) error {
 		return fmt.Errorf("no restore point found for plan %q: %w", planName, err)
 	}
 
+	if restore.IsChunked(&cm) {
+		return fmt.Errorf("restore data of plan %q is split across multiple ConfigMaps and cannot be edited in place", planName)
+	}
+
 	// Find the target's restore data
 	var targetData *restore.Data
 	var configKey string
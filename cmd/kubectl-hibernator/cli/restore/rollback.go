@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+)
+
+// newRollbackCommand promotes an archived restore snapshot to the current restore point
+func newRollbackCommand(opts *common.RootOptions) *cobra.Command {
+	restoreOpts := &restorePointOptions{root: opts}
+	var version int64
+
+	cmd := &cobra.Command{
+		Use:   "rollback <plan-name>",
+		Short: "Restore a target from an archived snapshot",
+		Long: `Replace a target's current restore data with an archived snapshot.
+
+The snapshot is marked live, so the next wakeup restores the target from it.
+Use this when the latest restore data is corrupted or captured a bad state.
+List available versions with "restore history".
+
+Flags:
+  --target   (required) The target name
+  --version  (required) The snapshot version to promote
+
+Examples:
+  kubectl hibernator restore rollback my-plan --target eks-cluster --version 3`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runRollback(ctx, restoreOpts, args[0], version)
+		}),
+	}
+
+	cmd.Flags().StringVarP(&restoreOpts.target, "target", "t", "", "Target name (required)")
+	lo.Must0(cmd.RegisterFlagCompletionFunc("target", common.CompleteTargetNames(opts)))
+	cmd.Flags().Int64Var(&version, "version", 0, "Snapshot version to promote (required)")
+
+	lo.Must0(cmd.MarkFlagRequired("target"))
+	lo.Must0(cmd.MarkFlagRequired("version"))
+
+	return cmd
+}
+
+func runRollback(ctx context.Context, opts *restorePointOptions, planName string, version int64) error {
+	out := output.FromContext(ctx)
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+
+	if err := newRestoreManager(c).PromoteSnapshot(ctx, ns, planName, opts.target, version); err != nil {
+		return fmt.Errorf("failed to roll back restore point: %w", err)
+	}
+
+	out.Success("Target %q will be restored from snapshot version %d on the next wakeup", opts.target, version)
+	return nil
+}
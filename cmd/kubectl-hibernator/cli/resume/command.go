@@ -39,7 +39,8 @@ allowing the controller to resume schedule evaluation.
 Examples:
   kubectl hibernator resume my-plan
   kubectl hibernator resume my-plan -n production`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runResume(ctx, resOpts, args[0])
 		}),
@@ -38,7 +38,8 @@ operation on an Active or Hibernated plan, use the 'restart' subcommand instead.
 
 Examples:
   kubectl hibernator retry my-plan`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runRetry(ctx, retryOpts, args[0])
 		}),
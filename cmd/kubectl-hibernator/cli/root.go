@@ -6,23 +6,35 @@ Licensed under the Apache License, Version 2.0.
 package cli
 
 import (
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/dashboard"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/describe"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/discover"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/exception"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/hibernate"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/list"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/logs"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/migrate"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/notification"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/override"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/pauseop"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/preview"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/restart"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/restore"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/resume"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/retry"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/simulate"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/state"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/status"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/suspend"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/validate"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/version"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/wake"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
 )
 
@@ -45,19 +57,31 @@ func NewRootCommand() *cobra.Command {
 It provides commands to inspect schedules, view plan status, suspend/resume
 hibernation, trigger retries, and tail controller logs.
 
-Install by copying the binary to your PATH:
+Install with krew, or by copying the binary to your PATH:
+  kubectl krew install --manifest-url=https://github.com/ardikabs/hibernator/releases/latest/download/hibernator.yaml
   cp bin/kubectl-hibernator /usr/local/bin/kubectl-hibernator
 
+Enable shell completion of commands, flags, and plan and target names:
+  source <(kubectl hibernator completion bash)
+
 Then use as:
   kubectl hibernator list
   kubectl hibernator describe my-plan
+  kubectl hibernator status my-plan
   kubectl hibernator preview my-plan
+  kubectl hibernator discover my-plan
+  kubectl hibernator validate -f plan.yaml
   kubectl hibernator suspend my-plan --hours 4 --reason "deployment"
   kubectl hibernator resume my-plan
   kubectl hibernator retry my-plan
   kubectl hibernator override my-plan --to hibernate
   kubectl hibernator restart my-plan
-  kubectl hibernator logs my-plan`,
+  kubectl hibernator pause-op my-plan
+  kubectl hibernator hibernate my-plan
+  kubectl hibernator wake my-plan --wait
+  kubectl hibernator logs my-plan
+  kubectl hibernator backup-state -A
+  kubectl hibernator migrate --instance-scheduler-config config.json --account-id 123456789012 --region us-east-1`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.ValidateRequiredFlags()
 		},
@@ -67,21 +91,38 @@ Then use as:
 
 	// Global flags
 	cmd.PersistentFlags().StringVar(&opts.Kubeconfig, "kubeconfig", "", "Path to kubeconfig file (defaults to $KUBECONFIG or ~/.kube/config)")
+	cmd.PersistentFlags().StringVar(&opts.Context, "context", "", "The name of the kubeconfig context to use")
 	cmd.PersistentFlags().StringVarP(&opts.Namespace, "namespace", "n", "", "Kubernetes namespace (defaults to current context namespace)")
 	cmd.PersistentFlags().BoolVar(&opts.JsonOutput, "json", false, "Output in JSON format")
 
+	lo.Must0(cmd.RegisterFlagCompletionFunc("context", common.CompleteContexts(opts)))
+	lo.Must0(cmd.RegisterFlagCompletionFunc("namespace", common.CompleteNamespaces(opts)))
+
 	// Register subcommands
-	cmd.AddCommand(version.NewCommand())
+	cmd.AddCommand(version.NewCommand(opts))
 	cmd.AddCommand(list.NewCommand(opts))
 	cmd.AddCommand(describe.NewCommand(opts))
+	cmd.AddCommand(status.NewCommand(opts))
+	cmd.AddCommand(dashboard.NewCommand(opts))
 	cmd.AddCommand(preview.NewCommand(opts))
+	cmd.AddCommand(discover.NewCommand(opts))
+	cmd.AddCommand(simulate.NewCommand(opts))
+	cmd.AddCommand(validate.NewCommand(opts))
 	cmd.AddCommand(suspend.NewCommand(opts))
 	cmd.AddCommand(resume.NewCommand(opts))
 	cmd.AddCommand(retry.NewCommand(opts))
 	cmd.AddCommand(override.NewCommand(opts))
 	cmd.AddCommand(restart.NewCommand(opts))
+	cmd.AddCommand(pauseop.NewPauseCommand(opts))
+	cmd.AddCommand(pauseop.NewResumeCommand(opts))
+	cmd.AddCommand(hibernate.NewCommand(opts))
+	cmd.AddCommand(wake.NewCommand(opts))
 	cmd.AddCommand(restore.NewCommand(opts))
+	cmd.AddCommand(state.NewBackupCommand(opts))
+	cmd.AddCommand(state.NewRestoreCommand(opts))
 	cmd.AddCommand(notification.NewCommand(opts))
+	cmd.AddCommand(exception.NewCommand(opts))
 	cmd.AddCommand(logs.NewCommand(opts))
+	cmd.AddCommand(migrate.NewCommand(opts))
 	return cmd
 }
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package simulate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+)
+
+type simulateOptions struct {
+	root          *common.RootOptions
+	file          string
+	prometheusURL string
+	token         string
+	query         string
+	since         time.Duration
+	step          time.Duration
+	threshold     float64
+}
+
+// NewCommand creates the "simulate" command.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	simOpts := &simulateOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "simulate [plan-name]",
+		Short: "Replay historical activity against a proposed hibernation schedule",
+		Long: `Replay historical usage metrics from Prometheus against a schedule and report
+how much active usage would have fallen inside its hibernation windows.
+
+The query should return a usage signal (for example request rate or CPU usage)
+for the workloads covered by the plan. A sample counts as active when its value
+is above --threshold; when the query returns several series, the highest value
+at each timestamp is used. Exceptions are not taken into account.
+
+The schedule is read from a local YAML file (typically a proposed change) or
+from an existing plan in the cluster:
+  kubectl hibernator simulate --file plan.yaml \
+    --prometheus-url http://prometheus:9090 \
+    --query 'sum(rate(http_requests_total{namespace="staging"}[5m]))' \
+    --threshold 0.1
+  kubectl hibernator simulate my-plan --prometheus-url http://prometheus:9090 \
+    --query 'sum(rate(container_cpu_usage_seconds_total{namespace="staging"}[5m]))' \
+    --since 336h --step 10m`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runSimulate(ctx, simOpts, args)
+		}),
+	}
+
+	cmd.Flags().StringVarP(&simOpts.file, "file", "f", "", "Path to a local HibernatePlan YAML file with the proposed schedule")
+	cmd.Flags().StringVar(&simOpts.prometheusURL, "prometheus-url", "", "Base URL of the Prometheus API (required)")
+	cmd.Flags().StringVar(&simOpts.token, "bearer-token", os.Getenv("PROMETHEUS_TOKEN"), "Bearer token for the Prometheus API (defaults to $PROMETHEUS_TOKEN)")
+	cmd.Flags().StringVar(&simOpts.query, "query", "", "PromQL query returning the usage signal (required)")
+	cmd.Flags().DurationVar(&simOpts.since, "since", 7*24*time.Hour, "How far back to replay activity")
+	cmd.Flags().DurationVar(&simOpts.step, "step", 5*time.Minute, "Resolution of the replay")
+	cmd.Flags().Float64Var(&simOpts.threshold, "threshold", 0, "Values above this are considered active usage")
+
+	lo.Must0(cmd.MarkFlagRequired("prometheus-url"))
+	lo.Must0(cmd.MarkFlagRequired("query"))
+
+	return cmd
+}
+
+func runSimulate(ctx context.Context, opts *simulateOptions, args []string) error {
+	if opts.since <= 0 || opts.step <= 0 {
+		return fmt.Errorf("--since and --step must be positive")
+	}
+	if opts.step > opts.since {
+		return fmt.Errorf("--step must not exceed --since")
+	}
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	if opts.file != "" {
+		if err := common.LoadPlanFromFile(opts.file, &plan); err != nil {
+			return err
+		}
+	} else {
+		if len(args) == 0 {
+			return fmt.Errorf("plan name is required (or use --file for local YAML)")
+		}
+
+		c, err := common.NewK8sClient(opts.root)
+		if err != nil {
+			return err
+		}
+
+		ns := common.ResolveNamespace(opts.root)
+		if err := c.Get(ctx, types.NamespacedName{Name: args[0], Namespace: ns}, &plan); err != nil {
+			return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", args[0], ns, err)
+		}
+	}
+
+	end := time.Now().Truncate(opts.step)
+	start := end.Add(-opts.since)
+
+	httpClient := &http.Client{Timeout: 60 * time.Second}
+	samples, err := queryRange(ctx, httpClient, opts.prometheusURL, opts.token, opts.query, start, end, opts.step)
+	if err != nil {
+		return err
+	}
+	if len(samples) == 0 {
+		return fmt.Errorf("query returned no samples between %s and %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	windows := common.ConvertAPIWindows(plan.Spec.Schedule.OffHours)
+	result, err := common.SimulateSchedule(windows, plan.Spec.Schedule.Timezone, nil, samples, opts.step, opts.threshold)
+	if err != nil {
+		return fmt.Errorf("failed to simulate schedule: %w", err)
+	}
+
+	d := &printers.Dispatcher{JSON: opts.root.JsonOutput}
+	return d.PrintObj(&printers.SimulationOutput{
+		Plan:      plan,
+		Query:     opts.query,
+		Start:     start,
+		End:       end,
+		Step:      opts.step,
+		Threshold: opts.threshold,
+		Result:    result,
+	}, os.Stdout)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package simulate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+)
+
+// queryRangeResponse is the subset of the Prometheus /api/v1/query_range
+// response used by the simulation.
+type queryRangeResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string    `json:"metric"`
+			Values [][2]json.RawMessage `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// queryRange runs a range query against Prometheus and returns one sample per
+// timestamp. When the query returns several series, the highest value at each
+// timestamp is kept so that activity on any series counts as activity.
+func queryRange(ctx context.Context, client *http.Client, baseURL, token, query string, start, end time.Time, step time.Duration) ([]common.ActivitySample, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/") + "/api/v1/query_range")
+	if err != nil {
+		return nil, fmt.Errorf("invalid prometheus URL %q: %w", baseURL, err)
+	}
+
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", strconv.FormatInt(start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(end.Unix(), 10))
+	params.Set("step", strconv.FormatFloat(step.Seconds(), 'f', -1, 64))
+	u.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build prometheus request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("query prometheus: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read prometheus response: %w", err)
+	}
+
+	var out queryRangeResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("prometheus returned HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if out.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed (%s): %s", out.ErrorType, out.Error)
+	}
+	if out.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("unexpected prometheus result type %q, expected matrix", out.Data.ResultType)
+	}
+
+	peaks := make(map[int64]float64)
+	for _, series := range out.Data.Result {
+		for _, pair := range series.Values {
+			var ts float64
+			if err := json.Unmarshal(pair[0], &ts); err != nil {
+				return nil, fmt.Errorf("decode sample timestamp: %w", err)
+			}
+			var raw string
+			if err := json.Unmarshal(pair[1], &raw); err != nil {
+				return nil, fmt.Errorf("decode sample value: %w", err)
+			}
+			val, err := strconv.ParseFloat(raw, 64)
+			if err != nil || math.IsNaN(val) {
+				continue
+			}
+
+			key := int64(ts * 1000)
+			if cur, ok := peaks[key]; !ok || val > cur {
+				peaks[key] = val
+			}
+		}
+	}
+
+	samples := make([]common.ActivitySample, 0, len(peaks))
+	for ms, val := range peaks {
+		samples = append(samples, common.ActivitySample{Time: time.UnixMilli(ms), Value: val})
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Time.Before(samples[j].Time) })
+	return samples, nil
+}
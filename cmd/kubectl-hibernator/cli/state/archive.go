@@ -0,0 +1,249 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/restore"
+)
+
+const (
+	// archiveFormatVersion is bumped when the archive layout changes in a way
+	// older CLI versions cannot read.
+	archiveFormatVersion = 1
+
+	// manifestFile is the archive entry describing the backup.
+	manifestFile = "manifest.json"
+)
+
+// manifest describes a state archive.
+type manifest struct {
+	FormatVersion     int       `json:"formatVersion"`
+	CreatedAt         time.Time `json:"createdAt"`
+	CLIVersion        string    `json:"cliVersion"`
+	ControllerVersion string    `json:"controllerVersion,omitempty"`
+
+	// Namespace is the namespace the backup was taken from, empty when it
+	// covers all namespaces.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Counts is the number of objects captured per kind.
+	Counts map[string]int `json:"counts"`
+}
+
+// resourceKind is a kind of object captured in a state archive.
+type resourceKind struct {
+	gvk schema.GroupVersionKind
+
+	// dir is the archive directory holding objects of this kind.
+	dir string
+
+	// clusterScoped kinds are stored without a namespace directory. Only the
+	// CRDs are cluster-scoped.
+	clusterScoped bool
+
+	// statusSubresource is set for kinds whose status must be written through
+	// the status subresource after the object itself is restored.
+	statusSubresource bool
+
+	// include filters listed objects; nil keeps all of them.
+	include func(obj *unstructured.Unstructured) bool
+}
+
+func hibernatorKind(kind, dir string, statusSubresource bool) resourceKind {
+	return resourceKind{
+		gvk:               hibernatorv1alpha1.GroupVersion.WithKind(kind),
+		dir:               dir,
+		statusSubresource: statusSubresource,
+	}
+}
+
+// kinds lists the captured kinds in restore order: CRDs first, connectors
+// before the plans referencing them, and plans before the exceptions,
+// executions and restore data that belong to them.
+var kinds = []resourceKind{
+	{
+		gvk:           schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"},
+		dir:           "customresourcedefinitions",
+		clusterScoped: true,
+		include: func(obj *unstructured.Unstructured) bool {
+			group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+			return group == hibernatorv1alpha1.GroupVersion.Group
+		},
+	},
+	hibernatorKind("CloudProvider", "cloudproviders", true),
+	hibernatorKind("K8SCluster", "k8sclusters", true),
+	hibernatorKind("HibernateNotification", "hibernatenotifications", true),
+	hibernatorKind("HibernatePlan", "hibernateplans", true),
+	hibernatorKind("ScheduleException", "scheduleexceptions", true),
+	hibernatorKind("HibernateExecution", "hibernateexecutions", false),
+	{
+		gvk: schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+		dir: "configmaps",
+		// Restore ConfigMaps and their chunks share the restore name prefix.
+		include: func(obj *unstructured.Unstructured) bool {
+			return strings.HasPrefix(obj.GetName(), restore.GetRestoreConfigMap(""))
+		},
+	},
+}
+
+// kindFor returns the captured kind matching gvk.
+func kindFor(gvk schema.GroupVersionKind) (resourceKind, int, bool) {
+	for i, k := range kinds {
+		if k.gvk == gvk {
+			return k, i, true
+		}
+	}
+	return resourceKind{}, 0, false
+}
+
+// sanitize drops server-populated metadata so the object can be created again
+// in a cluster. Owner references are kept; their UIDs are remapped on restore.
+func sanitize(obj *unstructured.Unstructured) {
+	obj.SetUID("")
+	obj.SetResourceVersion("")
+	obj.SetGeneration(0)
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetManagedFields(nil)
+	obj.SetSelfLink("")
+	obj.SetDeletionTimestamp(nil)
+	obj.SetDeletionGracePeriodSeconds(nil)
+}
+
+// entryPath returns the archive path of obj.
+func entryPath(k resourceKind, obj *unstructured.Unstructured) string {
+	if k.clusterScoped {
+		return path.Join(k.dir, obj.GetName()+".yaml")
+	}
+	return path.Join(k.dir, obj.GetNamespace(), obj.GetName()+".yaml")
+}
+
+// writeArchive writes m and objects as a gzipped tarball: the manifest first,
+// then one YAML file per object.
+func writeArchive(w io.Writer, m *manifest, objects []*unstructured.Unstructured) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	add := func(name string, data []byte) error {
+		hdr := &tar.Header{Name: name, Mode: 0o600, Size: int64(len(data)), ModTime: m.CreatedAt}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := add(manifestFile, data); err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		k, _, ok := kindFor(obj.GroupVersionKind())
+		if !ok {
+			return fmt.Errorf("unsupported kind %s", obj.GroupVersionKind())
+		}
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s %s: %w", k.gvk.Kind, obj.GetName(), err)
+		}
+		if err := add(entryPath(k, obj), data); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return gz.Close()
+}
+
+// readArchive reads an archive written by writeArchive. Objects are returned
+// in restore order.
+func readArchive(r io.Reader) (*manifest, []*unstructured.Unstructured, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("not a state archive: %w", err)
+	}
+	// nolint:errcheck
+	defer gz.Close()
+
+	var (
+		m       *manifest
+		objects []*unstructured.Unstructured
+	)
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s: %w", hdr.Name, err)
+		}
+
+		if hdr.Name == manifestFile {
+			m = &manifest{}
+			if err := json.Unmarshal(data, m); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode manifest: %w", err)
+			}
+			if m.FormatVersion > archiveFormatVersion {
+				return nil, nil, fmt.Errorf("archive format version %d is newer than supported version %d; upgrade kubectl-hibernator", m.FormatVersion, archiveFormatVersion)
+			}
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &obj.Object); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode %s: %w", hdr.Name, err)
+		}
+		if _, _, ok := kindFor(obj.GroupVersionKind()); !ok {
+			return nil, nil, fmt.Errorf("%s: unsupported kind %s", hdr.Name, obj.GroupVersionKind())
+		}
+		objects = append(objects, obj)
+	}
+
+	if m == nil {
+		return nil, nil, fmt.Errorf("not a state archive: %s is missing", manifestFile)
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		_, oi, _ := kindFor(objects[i].GroupVersionKind())
+		_, oj, _ := kindFor(objects[j].GroupVersionKind())
+		return oi < oj
+	})
+	return m, objects, nil
+}
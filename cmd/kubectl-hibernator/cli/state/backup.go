@@ -0,0 +1,155 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	versioncmd "github.com/ardikabs/hibernator/cmd/kubectl-hibernator/cli/version"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+	"github.com/ardikabs/hibernator/internal/version"
+)
+
+type backupOptions struct {
+	root          *common.RootOptions
+	file          string
+	allNamespaces bool
+}
+
+// NewBackupCommand creates the "backup-state" subcommand.
+func NewBackupCommand(opts *common.RootOptions) *cobra.Command {
+	backupOpts := &backupOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "backup-state",
+		Short: "Snapshot Hibernator resources to a local archive",
+		Long: `Write all Hibernator resources to a local archive before upgrading the
+controller, so they can be put back with "restore-state" if the new version
+misbehaves with existing plans.
+
+The archive is a gzipped tarball with one YAML file per object. It holds the
+Hibernator CRDs, CloudProviders, K8SClusters, HibernateNotifications,
+HibernatePlans (including their status), ScheduleExceptions,
+HibernateExecutions and the restore ConfigMaps holding the state captured
+during hibernation. Restore data kept in Secrets or object storage is not
+included.
+
+Examples:
+  kubectl hibernator backup-state -A
+  kubectl hibernator backup-state -n production -f prod-before-v0.3.tar.gz`,
+		Args: cobra.NoArgs,
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runBackup(ctx, backupOpts)
+		}),
+	}
+
+	cmd.Flags().StringVarP(&backupOpts.file, "file", "f", "", `Archive to write (default "hibernator-state-<timestamp>.tar.gz")`)
+	cmd.Flags().BoolVarP(&backupOpts.allNamespaces, "all-namespaces", "A", false, "Back up resources from all namespaces")
+
+	return cmd
+}
+
+func runBackup(ctx context.Context, opts *backupOptions) error {
+	out := output.FromContext(ctx)
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+	if opts.allNamespaces {
+		ns = ""
+	}
+
+	now := time.Now()
+	file := opts.file
+	if file == "" {
+		file = fmt.Sprintf("hibernator-state-%s.tar.gz", now.UTC().Format("20060102T150405Z"))
+	}
+
+	m := &manifest{
+		FormatVersion: archiveFormatVersion,
+		CreatedAt:     now.UTC(),
+		CLIVersion:    version.GetVersion(),
+		Namespace:     ns,
+		Counts:        make(map[string]int),
+	}
+	if v, err := versioncmd.ControllerVersion(ctx, c); err == nil {
+		m.ControllerVersion = v
+	}
+
+	result := &printers.StateBackupOutput{File: file, Namespace: ns, ControllerVersion: m.ControllerVersion}
+
+	var objects []*unstructured.Unstructured
+	for _, k := range kinds {
+		listed, err := listKind(ctx, c, k, ns)
+		if err != nil {
+			if k.clusterScoped && apierrors.IsForbidden(err) {
+				out.Warning("CRDs are not included in the archive: %v", err)
+				continue
+			}
+			return err
+		}
+		objects = append(objects, listed...)
+		m.Counts[k.gvk.Kind] = len(listed)
+		result.Counts = append(result.Counts, printers.StateKindCount{Kind: k.gvk.Kind, Count: len(listed)})
+	}
+
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	if err := writeArchive(f, m, objects); err != nil {
+		_ = f.Close()
+		_ = os.Remove(file)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	d := &printers.Dispatcher{JSON: opts.root.JsonOutput}
+	return d.PrintObj(result, os.Stdout)
+}
+
+// listKind lists the objects of kind k that belong in the archive, with
+// server-populated metadata removed.
+func listKind(ctx context.Context, c client.Client, k resourceKind, namespace string) ([]*unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(k.gvk.GroupVersion().WithKind(k.gvk.Kind + "List"))
+
+	var listOpts []client.ListOption
+	if !k.clusterScoped {
+		listOpts = append(listOpts, client.InNamespace(namespace))
+	}
+	if err := c.List(ctx, list, listOpts...); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", k.dir, err)
+	}
+
+	var objects []*unstructured.Unstructured
+	for i := range list.Items {
+		obj := &list.Items[i]
+		if k.include != nil && !k.include(obj) {
+			continue
+		}
+		// Items of a typed list may come back without apiVersion and kind.
+		obj.SetGroupVersionKind(k.gvk)
+		sanitize(obj)
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
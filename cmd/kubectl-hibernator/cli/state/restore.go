@@ -0,0 +1,231 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+)
+
+// Restore actions reported per object.
+const (
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionSkip   = "skip"
+	actionFail   = "fail"
+)
+
+type restoreOptions struct {
+	root        *common.RootOptions
+	file        string
+	overwrite   bool
+	includeCRDs bool
+	dryRun      bool
+}
+
+// NewRestoreCommand creates the "restore-state" subcommand.
+func NewRestoreCommand(opts *common.RootOptions) *cobra.Command {
+	restoreOpts := &restoreOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "restore-state -f <archive>",
+		Short: "Restore Hibernator resources from a backup-state archive",
+		Long: `Recreate Hibernator resources from an archive written by "backup-state",
+for example after rolling back a controller upgrade.
+
+Objects are restored in dependency order: connectors, notifications, plans,
+schedule exceptions, execution ledgers and restore ConfigMaps. Status is
+written back through the status subresource, so hibernated plans keep their
+phase and wake up from the restore data captured before the upgrade.
+
+Objects that already exist are skipped unless --overwrite is set. CRDs are
+only applied with --include-crds; use it when the upgrade changed the CRDs
+and Helm does not roll them back. When --namespace is given, only objects in
+that namespace are restored.
+
+Scale the controller down before restoring so it does not act on plans whose
+status is not written yet, and scale it back up afterwards.
+
+Examples:
+  kubectl hibernator restore-state -f hibernator-state-20260101T000000Z.tar.gz --dry-run
+  kubectl hibernator restore-state -f backup.tar.gz --overwrite --include-crds
+  kubectl hibernator restore-state -f backup.tar.gz -n production`,
+		Args: cobra.NoArgs,
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runRestore(ctx, restoreOpts)
+		}),
+	}
+
+	cmd.Flags().StringVarP(&restoreOpts.file, "file", "f", "", "Archive written by backup-state (required)")
+	cmd.Flags().BoolVar(&restoreOpts.overwrite, "overwrite", false, "Replace objects that already exist")
+	cmd.Flags().BoolVar(&restoreOpts.includeCRDs, "include-crds", false, "Also apply the CRDs stored in the archive")
+	cmd.Flags().BoolVar(&restoreOpts.dryRun, "dry-run", false, "Show what would be restored without making changes")
+	lo.Must0(cmd.MarkFlagRequired("file"))
+	lo.Must0(cmd.MarkFlagFilename("file", "tar.gz", "tgz"))
+
+	return cmd
+}
+
+func runRestore(ctx context.Context, opts *restoreOptions) error {
+	out := output.FromContext(ctx)
+
+	f, err := os.Open(opts.file)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	// nolint:errcheck
+	defer f.Close()
+
+	m, objects, err := readArchive(f)
+	if err != nil {
+		return err
+	}
+	out.Info("Restoring backup taken at %s (controller %s)", m.CreatedAt.Local().Format("2006-01-02 15:04:05"), lo.Ternary(m.ControllerVersion != "", m.ControllerVersion, "unknown"))
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	r := &restorer{client: c, overwrite: opts.overwrite, dryRun: opts.dryRun, planUIDs: make(map[types.NamespacedName]types.UID)}
+	result := &printers.StateRestoreOutput{File: opts.file, DryRun: opts.dryRun}
+
+	failed := 0
+	for _, obj := range objects {
+		k, _, _ := kindFor(obj.GroupVersionKind())
+		if k.clusterScoped && !opts.includeCRDs {
+			continue
+		}
+		if opts.root.Namespace != "" && !k.clusterScoped && obj.GetNamespace() != opts.root.Namespace {
+			continue
+		}
+
+		action, err := r.restore(ctx, k, obj)
+		res := printers.StateRestoreResult{Kind: k.gvk.Kind, Namespace: obj.GetNamespace(), Name: obj.GetName(), Action: action}
+		if err != nil {
+			res.Action = actionFail
+			res.Error = err.Error()
+			failed++
+		}
+		result.Results = append(result.Results, res)
+	}
+
+	d := &printers.Dispatcher{JSON: opts.root.JsonOutput}
+	if err := d.PrintObj(result, os.Stdout); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d object(s) failed to restore", failed)
+	}
+	return nil
+}
+
+// restorer writes archived objects back to the cluster. It remembers the UID
+// of every plan it sees, so owner references of objects restored after their
+// plan point to the plan as it exists now.
+type restorer struct {
+	client    client.Client
+	overwrite bool
+	dryRun    bool
+	planUIDs  map[types.NamespacedName]types.UID
+}
+
+// restore creates or updates obj and returns the action taken.
+func (r *restorer) restore(ctx context.Context, k resourceKind, obj *unstructured.Unstructured) (string, error) {
+	obj = obj.DeepCopy()
+	status, hasStatus := obj.Object["status"]
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(k.gvk)
+	var action string
+	switch err := r.client.Get(ctx, client.ObjectKeyFromObject(obj), existing); {
+	case apierrors.IsNotFound(err):
+		action = actionCreate
+		if r.dryRun {
+			return action, nil
+		}
+		r.remapOwners(ctx, obj)
+		if err := r.client.Create(ctx, obj); err != nil {
+			return action, fmt.Errorf("failed to create: %w", err)
+		}
+	case err != nil:
+		return actionFail, fmt.Errorf("failed to get: %w", err)
+	case !r.overwrite:
+		r.recordPlan(existing)
+		return actionSkip, nil
+	default:
+		action = actionUpdate
+		if r.dryRun {
+			return action, nil
+		}
+		r.remapOwners(ctx, obj)
+		obj.SetResourceVersion(existing.GetResourceVersion())
+		if err := r.client.Update(ctx, obj); err != nil {
+			return action, fmt.Errorf("failed to update: %w", err)
+		}
+	}
+	r.recordPlan(obj)
+
+	if k.statusSubresource && hasStatus {
+		obj.Object["status"] = status
+		if err := r.client.Status().Update(ctx, obj); err != nil {
+			return action, fmt.Errorf("failed to restore status: %w", err)
+		}
+	}
+	return action, nil
+}
+
+func (r *restorer) recordPlan(obj *unstructured.Unstructured) {
+	if obj.GroupVersionKind() == hibernatorv1alpha1.GroupVersion.WithKind("HibernatePlan") {
+		r.planUIDs[client.ObjectKeyFromObject(obj)] = obj.GetUID()
+	}
+}
+
+// remapOwners points owner references to HibernatePlans at the plans' current
+// UIDs, dropping references to plans that do not exist.
+func (r *restorer) remapOwners(ctx context.Context, obj *unstructured.Unstructured) {
+	refs := obj.GetOwnerReferences()
+	if len(refs) == 0 {
+		return
+	}
+
+	var remapped []metav1.OwnerReference
+	for _, ref := range refs {
+		if ref.Kind != "HibernatePlan" || ref.APIVersion != hibernatorv1alpha1.GroupVersion.String() {
+			remapped = append(remapped, ref)
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: ref.Name}
+		uid, ok := r.planUIDs[key]
+		if !ok {
+			var plan hibernatorv1alpha1.HibernatePlan
+			if err := r.client.Get(ctx, key, &plan); err != nil {
+				continue
+			}
+			uid = plan.UID
+			r.planUIDs[key] = uid
+		}
+		ref.UID = uid
+		remapped = append(remapped, ref)
+	}
+	obj.SetOwnerReferences(remapped)
+}
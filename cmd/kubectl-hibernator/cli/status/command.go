@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+)
+
+type statusOptions struct {
+	root    *common.RootOptions
+	history int
+}
+
+// NewCommand creates the "status" command.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	statusOpts := &statusOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "status <plan-name>",
+		Short: "Show the live status of a HibernatePlan",
+		Long: `Show the current state of a HibernatePlan at a glance:
+- Phase, suspend state and the next hibernate/wake-up times
+- Per-target execution state with runner Job references
+- Active schedule exceptions
+- Recent execution cycles
+
+Use "describe" for the full plan specification.
+
+Examples:
+  kubectl hibernator status my-plan
+  kubectl hibernator status my-plan --history 10
+  kubectl hibernator status my-plan --json`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runStatus(ctx, statusOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().IntVar(&statusOpts.history, "history", 5, "Number of recent execution cycles to show")
+
+	return cmd
+}
+
+func runStatus(ctx context.Context, opts *statusOptions, planName string) error {
+	if opts.history < 1 {
+		return fmt.Errorf("--history must be at least 1")
+	}
+
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
+		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
+	}
+	if err := common.LoadExecutions(ctx, c, &plan); err != nil {
+		output.FromContext(ctx).Warning("Could not list target executions: %v", err)
+	}
+
+	out := &printers.StatusOutput{Plan: plan, HistoryLimit: opts.history}
+
+	exceptions, err := common.FetchActiveExceptions(ctx, c, plan)
+	if err != nil {
+		output.FromContext(ctx).Warning("Could not list schedule exceptions; next transitions ignore them: %v", err)
+	}
+	if out.NextHibernate, out.NextWakeUp, err = common.ComputeNextTransitions(plan.Spec.Schedule, exceptions); err != nil {
+		output.FromContext(ctx).Warning("Could not compute next transitions: %v", err)
+	}
+
+	d := &printers.Dispatcher{JSON: opts.root.JsonOutput}
+	return d.PrintObj(out, os.Stdout)
+}
@@ -70,7 +70,8 @@ Examples:
 
   # Preview what would happen without actually suspending
   kubectl hibernator suspend my-plan --until "in 2 hours" --reason "test" --dry-run`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			return runSuspend(ctx, susOpts, args[0])
 		}),
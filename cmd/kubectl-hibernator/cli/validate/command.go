@@ -0,0 +1,179 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/printers"
+	"github.com/ardikabs/hibernator/internal/validationwebhook"
+)
+
+type validateOptions struct {
+	root  *common.RootOptions
+	files []string
+}
+
+// NewCommand creates the "validate" subcommand.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	validateOpts := &validateOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "validate -f <file>",
+		Short: "Lint HibernatePlan manifests offline",
+		Long: `Validate HibernatePlan manifests locally with the same checks the admission
+webhook runs on create: schedule syntax, target and connector references,
+execution strategy (DAG cycles, stage coverage), executor parameter schemas and
+restore settings. Plans are also decoded strictly, so misspelled fields are
+reported instead of being dropped.
+
+No cluster access is needed, which makes the command suitable for CI pipelines.
+Files may contain multiple documents; documents of other kinds are ignored.
+Directories are expanded to the .yaml, .yml and .json files they contain, and
+"-" reads from stdin.
+
+The command exits with a non-zero status when any plan is invalid. Checks that
+depend on cluster state, such as phase-based update restrictions, are not run.
+
+Examples:
+  kubectl hibernator validate -f plan.yaml
+  kubectl hibernator validate -f plans/ -f staging.yaml
+  kustomize build overlays/prod | kubectl hibernator validate -f - --json`,
+		Args: cobra.NoArgs,
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runValidate(ctx, validateOpts)
+		}),
+	}
+
+	cmd.Flags().StringSliceVarP(&validateOpts.files, "filename", "f", nil, `Manifest file or directory to validate; "-" reads stdin (repeatable)`)
+	lo.Must0(cmd.MarkFlagRequired("filename"))
+	lo.Must0(cmd.MarkFlagFilename("filename", "yaml", "yml", "json"))
+
+	return cmd
+}
+
+func runValidate(ctx context.Context, opts *validateOptions) error {
+	paths, err := expandPaths(opts.files)
+	if err != nil {
+		return err
+	}
+
+	validator := validationwebhook.NewHibernatePlanValidator(logr.Discard())
+	result := &printers.ValidationOutput{}
+
+	for _, path := range paths {
+		docs, err := common.LoadPlanDocuments(path)
+		if err != nil {
+			return err
+		}
+
+		for _, doc := range docs {
+			r := printers.ValidationResult{
+				Source: fmt.Sprintf("%s#%d", path, doc.Index),
+				Name:   doc.Plan.Name,
+			}
+			if doc.Err != nil {
+				r.Errors = []string{doc.Err.Error()}
+			} else {
+				if doc.StrictErr != nil {
+					r.Errors = append(r.Errors, doc.StrictErr.Error())
+				}
+				warnings, err := validator.ValidateCreate(ctx, &doc.Plan)
+				r.Warnings = warnings
+				r.Errors = append(r.Errors, flattenErrors(err)...)
+			}
+			r.Valid = len(r.Errors) == 0
+			result.Results = append(result.Results, r)
+		}
+	}
+
+	if len(result.Results) == 0 {
+		return fmt.Errorf("no HibernatePlan documents found in %s", strings.Join(opts.files, ", "))
+	}
+
+	printer := &printers.Dispatcher{JSON: opts.root.JsonOutput}
+	if err := printer.PrintObj(result, os.Stdout); err != nil {
+		return err
+	}
+
+	invalid := 0
+	for _, r := range result.Results {
+		if !r.Valid {
+			invalid++
+		}
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d HibernatePlan(s) failed validation", invalid, len(result.Results))
+	}
+	return nil
+}
+
+// expandPaths replaces directories with the manifest files they contain, sorted
+// by name. Subdirectories are not descended into.
+func expandPaths(inputs []string) ([]string, error) {
+	var paths []string
+	for _, in := range inputs {
+		if in == "-" {
+			paths = append(paths, in)
+			continue
+		}
+
+		info, err := os.Stat(in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %q: %w", in, err)
+		}
+		if !info.IsDir() {
+			paths = append(paths, in)
+			continue
+		}
+
+		entries, err := os.ReadDir(in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %q: %w", in, err)
+		}
+		var files []string
+		for _, e := range entries {
+			switch strings.ToLower(filepath.Ext(e.Name())) {
+			case ".yaml", ".yml", ".json":
+				if !e.IsDir() {
+					files = append(files, filepath.Join(in, e.Name()))
+				}
+			}
+		}
+		sort.Strings(files)
+		paths = append(paths, files...)
+	}
+	return paths, nil
+}
+
+// flattenErrors splits the webhook's aggregated field errors into one message each.
+func flattenErrors(err error) []string {
+	if err == nil {
+		return nil
+	}
+	var agg utilerrors.Aggregate
+	if errors.As(err, &agg) {
+		msgs := make([]string, 0, len(agg.Errors()))
+		for _, e := range agg.Errors() {
+			msgs = append(msgs, e.Error())
+		}
+		return msgs
+	}
+	return []string{err.Error()}
+}
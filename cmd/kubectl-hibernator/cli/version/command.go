@@ -7,23 +7,40 @@ package version
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
 	"github.com/ardikabs/hibernator/internal/version"
 )
 
+// controllerLookupTimeout bounds the controller version lookup, so the
+// command stays fast when the cluster is unreachable.
+const controllerLookupTimeout = 5 * time.Second
+
 // NewCommand creates the "version" command.
-func NewCommand() *cobra.Command {
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	var clientOnly bool
+
 	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Print the version of kubectl-hibernator",
-		Long:  "Print the version of kubectl-hibernator",
+		Long: `Print the version of kubectl-hibernator and of the controller installed
+in the current cluster, warning when their major or minor versions differ.
+
+The controller is looked up in the hibernator-system namespace, or in the
+namespace set by HIBERNATOR_CONTROLLER_NAMESPACE.`,
 		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
 			out := output.FromContext(ctx)
 			out.Info("kubectl-hibernator: %s", version.GetVersion())
 
+			if !clientOnly {
+				printControllerVersion(ctx, opts)
+			}
+
 			// Check for updates (silently ignore errors)
 			checker := NewChecker()
 			if newVersion, hasUpdate := checker.CheckForUpdate(ctx); hasUpdate {
@@ -35,5 +52,36 @@ func NewCommand() *cobra.Command {
 		}),
 	}
 
+	cmd.Flags().BoolVar(&clientOnly, "client", false, "Print the client version only, without contacting the cluster")
+
 	return cmd
 }
+
+// printControllerVersion prints the installed controller version and warns on
+// version skew. Lookup failures are reported but never fail the command.
+func printControllerVersion(ctx context.Context, opts *common.RootOptions) {
+	out := output.FromContext(ctx)
+
+	c, err := common.NewK8sClient(opts)
+	if err != nil {
+		out.Warning("controller: unknown (%v)", err)
+		return
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, controllerLookupTimeout)
+	defer cancel()
+
+	controllerVersion, err := ControllerVersion(lookupCtx, c)
+	if err != nil {
+		out.Warning("controller: unknown (%v)", err)
+		return
+	}
+
+	out.Info("controller: %s", controllerVersion)
+	if IsSkewed(version.Version, controllerVersion) {
+		out.Warning("kubectl-hibernator %s and controller %s differ in major or minor version; some commands may not behave as expected.",
+			version.Version, controllerVersion)
+		out.Hint("Install a matching CLI with: curl -sSL %s | bash -s -- --version v%s",
+			installScriptURL, strings.TrimPrefix(controllerVersion, "v"))
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package version
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+)
+
+const (
+	// controllerNameLabel selects the controller Deployment installed by the chart.
+	controllerNameLabel = "app.kubernetes.io/name"
+
+	// controllerVersionLabel carries the controller's app version on the Deployment.
+	controllerVersionLabel = "app.kubernetes.io/version"
+)
+
+var minorVersionRe = regexp.MustCompile(`^v?(\d+)\.(\d+)`)
+
+// ControllerVersion returns the version of the controller installed in the
+// cluster, read from the Deployment's version label or its image tag.
+func ControllerVersion(ctx context.Context, c client.Client) (string, error) {
+	var deployments appsv1.DeploymentList
+	if err := c.List(ctx, &deployments,
+		client.InNamespace(common.ControllerNamespace()),
+		client.MatchingLabels{controllerNameLabel: "hibernator"},
+	); err != nil {
+		return "", fmt.Errorf("failed to list controller deployments: %w", err)
+	}
+	if len(deployments.Items) == 0 {
+		return "", fmt.Errorf("no controller deployment found in namespace %q", common.ControllerNamespace())
+	}
+
+	deploy := deployments.Items[0]
+	if v := deploy.Labels[controllerVersionLabel]; v != "" {
+		return v, nil
+	}
+	for _, container := range deploy.Spec.Template.Spec.Containers {
+		if i := strings.LastIndex(container.Image, ":"); i != -1 && !strings.Contains(container.Image[i:], "/") {
+			return container.Image[i+1:], nil
+		}
+	}
+	return "", fmt.Errorf("controller deployment %s has no version label or image tag", deploy.Name)
+}
+
+// IsSkewed reports whether the CLI and controller versions differ in their
+// major or minor version. Unparseable versions, such as development builds,
+// are never considered skewed.
+func IsSkewed(cliVersion, controllerVersion string) bool {
+	cli := minorVersionRe.FindStringSubmatch(cliVersion)
+	ctrl := minorVersionRe.FindStringSubmatch(controllerVersion)
+	if cli == nil || ctrl == nil {
+		return false
+	}
+	return cli[1] != ctrl[1] || cli[2] != ctrl[2]
+}
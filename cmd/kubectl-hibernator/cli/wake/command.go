@@ -0,0 +1,132 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package wake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+	"github.com/ardikabs/hibernator/cmd/runner/timeparse"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+type wakeOptions struct {
+	root    *common.RootOptions
+	dryRun  bool
+	wait    bool
+	timeout time.Duration
+}
+
+// NewCommand creates the "wake" subcommand.
+func NewCommand(opts *common.RootOptions) *cobra.Command {
+	wakeOpts := &wakeOptions{root: opts}
+
+	cmd := &cobra.Command{
+		Use:   "wake <plan-name>",
+		Short: "Wake a hibernated plan now until its next scheduled hibernation",
+		Long: `Wake a Hibernated plan immediately, e.g. for an out-of-hours fix, and keep it
+awake for the rest of the current off-hours window.
+
+The command adds the one-shot wake-until-hibernate annotation. The controller
+consumes it, records the request as a suspend ScheduleException that lasts
+until the off-hours window would have ended, and starts the wakeup. The next
+scheduled hibernation happens as usual, so there is nothing to clean up.
+
+Use "kubectl hibernator exception cancel" on the generated exception to let
+the plan hibernate again earlier.
+
+Examples:
+  kubectl hibernator wake my-plan
+  kubectl hibernator wake my-plan --wait --timeout 15m`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.CompletePlanNames(opts),
+		RunE: output.WrapRunE(func(ctx context.Context, args []string) error {
+			return runWake(ctx, wakeOpts, args[0])
+		}),
+	}
+
+	cmd.Flags().BoolVar(&wakeOpts.dryRun, "dry-run", false, "Show when the plan would hibernate again without making changes")
+	cmd.Flags().BoolVar(&wakeOpts.wait, "wait", false, "Wait until the plan reaches the Active phase, showing progress")
+	cmd.Flags().DurationVar(&wakeOpts.timeout, "timeout", 30*time.Minute, "How long --wait waits before giving up")
+
+	return cmd
+}
+
+func runWake(ctx context.Context, opts *wakeOptions, planName string) error {
+	out := output.FromContext(ctx)
+	c, err := common.NewK8sClient(opts.root)
+	if err != nil {
+		return err
+	}
+
+	ns := common.ResolveNamespace(opts.root)
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := c.Get(ctx, types.NamespacedName{Name: planName, Namespace: ns}, &plan); err != nil {
+		return fmt.Errorf("failed to get HibernatePlan %q in namespace %q: %w", planName, ns, err)
+	}
+
+	if plan.Status.Phase != hibernatorv1alpha1.PhaseHibernated {
+		return fmt.Errorf("HibernatePlan %q is in %q phase; wake only applies to Hibernated plans", planName, plan.Status.Phase)
+	}
+	if common.IsMarkedTrue(plan.Annotations, wellknown.AnnotationOverrideAction) {
+		return fmt.Errorf("HibernatePlan %q has an active override; disable it first with 'kubectl hibernator override %s --disable'", planName, planName)
+	}
+
+	exceptions, err := common.FetchActiveExceptions(ctx, c, plan)
+	if err != nil {
+		return err
+	}
+	nextHibernate, _, err := common.ComputeNextTransitions(plan.Spec.Schedule, exceptions)
+	if err != nil {
+		return fmt.Errorf("failed to compute next hibernation: %w", err)
+	}
+
+	if opts.dryRun {
+		out.Info("Would wake up HibernatePlan %q now", planName)
+		printNextHibernation(out, nextHibernate)
+		return nil
+	}
+
+	patch := client.MergeFrom(plan.DeepCopy())
+
+	if plan.Annotations == nil {
+		plan.Annotations = make(map[string]string)
+	}
+	common.MarkTrue(plan.Annotations, wellknown.AnnotationWakeUntilHibernate)
+
+	if err := c.Patch(ctx, &plan, patch); err != nil {
+		return fmt.Errorf("failed to patch HibernatePlan %q: %w", planName, err)
+	}
+
+	out.Success("Wakeup triggered for HibernatePlan %q", planName)
+	printNextHibernation(out, nextHibernate)
+
+	if !opts.wait {
+		return nil
+	}
+	key := types.NamespacedName{Name: planName, Namespace: ns}
+	if err := common.WaitForPhase(ctx, c, key, wellknown.AnnotationWakeUntilHibernate, hibernatorv1alpha1.PhaseActive, opts.timeout, out); err != nil {
+		return err
+	}
+	out.Success("HibernatePlan %q is Active", planName)
+	return nil
+}
+
+func printNextHibernation(out output.Formatter, next *time.Time) {
+	if next == nil {
+		return
+	}
+	out.Info("  Next hibernation: %s (in %s)", timeparse.FormatDeadline(*next), timeparse.FormatDuration(time.Now(), *next))
+}
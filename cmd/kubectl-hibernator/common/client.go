@@ -7,10 +7,12 @@ package common
 
 import (
 	"fmt"
+	"os"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -22,24 +24,37 @@ func init() {
 	_ = hibernatorv1alpha1.AddToScheme(Scheme)
 }
 
-// NewK8sClient creates a controller-runtime client from the global options.
-func NewK8sClient(opts *RootOptions) (client.Client, error) {
+// NewClientConfig builds the kubeconfig loader from the global options,
+// honoring --kubeconfig, --context and --namespace like kubectl does.
+func NewClientConfig(opts *RootOptions) clientcmd.ClientConfig {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	if opts.Kubeconfig != "" {
 		loadingRules.ExplicitPath = opts.Kubeconfig
 	}
 
-	configOverrides := &clientcmd.ConfigOverrides{}
+	configOverrides := &clientcmd.ConfigOverrides{CurrentContext: opts.Context}
 	if opts.Namespace != "" {
 		configOverrides.Context.Namespace = opts.Namespace
 	}
 
-	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
+}
 
-	restConfig, err := kubeConfig.ClientConfig()
+// NewRESTConfig creates a REST config from the global options.
+func NewRESTConfig(opts *RootOptions) (*rest.Config, error) {
+	restConfig, err := NewClientConfig(opts).ClientConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build kubeconfig: %w", err)
 	}
+	return restConfig, nil
+}
+
+// NewK8sClient creates a controller-runtime client from the global options.
+func NewK8sClient(opts *RootOptions) (client.Client, error) {
+	restConfig, err := NewRESTConfig(opts)
+	if err != nil {
+		return nil, err
+	}
 
 	c, err := client.New(restConfig, client.Options{Scheme: Scheme})
 	if err != nil {
@@ -55,16 +70,19 @@ func ResolveNamespace(opts *RootOptions) string {
 		return opts.Namespace
 	}
 
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	if opts.Kubeconfig != "" {
-		loadingRules.ExplicitPath = opts.Kubeconfig
-	}
-
-	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{})
-	ns, _, err := kubeConfig.Namespace()
+	ns, _, err := NewClientConfig(opts).Namespace()
 	if err != nil || ns == "" {
 		return "default"
 	}
 
 	return ns
 }
+
+// ControllerNamespace returns the namespace where the controller is expected to run.
+// Defaults to "hibernator-system" unless HIBERNATOR_CONTROLLER_NAMESPACE is set.
+func ControllerNamespace() string {
+	if ns := os.Getenv("HIBERNATOR_CONTROLLER_NAMESPACE"); ns != "" {
+		return ns
+	}
+	return "hibernator-system"
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package common
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// completionTimeout bounds the API lookups made while completing a word, so
+// an unreachable cluster does not hang the shell.
+const completionTimeout = 5 * time.Second
+
+// CompletePlanNames completes the first positional argument with the names of
+// the HibernatePlans in the effective namespace.
+func CompletePlanNames(opts *RootOptions) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return completeNames(opts, toComplete, func(ctx context.Context, c client.Client) ([]string, error) {
+			var plans hibernatorv1alpha1.HibernatePlanList
+			if err := c.List(ctx, &plans, client.InNamespace(ResolveNamespace(opts))); err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(plans.Items))
+			for _, plan := range plans.Items {
+				names = append(names, plan.Name)
+			}
+			return names, nil
+		})
+	}
+}
+
+// CompleteTargetNames completes a --target flag with the target names of the
+// plan given as the first positional argument.
+func CompleteTargetNames(opts *RootOptions) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return completeNames(opts, toComplete, func(ctx context.Context, c client.Client) ([]string, error) {
+			var plan hibernatorv1alpha1.HibernatePlan
+			if err := c.Get(ctx, types.NamespacedName{Namespace: ResolveNamespace(opts), Name: args[0]}, &plan); err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(plan.Spec.Targets))
+			for _, target := range plan.Spec.Targets {
+				names = append(names, target.Name)
+			}
+			return names, nil
+		})
+	}
+}
+
+// CompleteNotificationNames completes the first positional argument with the
+// names of the HibernateNotifications in the effective namespace.
+func CompleteNotificationNames(opts *RootOptions) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return completeNames(opts, toComplete, func(ctx context.Context, c client.Client) ([]string, error) {
+			var notifications hibernatorv1alpha1.HibernateNotificationList
+			if err := c.List(ctx, &notifications, client.InNamespace(ResolveNamespace(opts))); err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(notifications.Items))
+			for _, notif := range notifications.Items {
+				names = append(names, notif.Name)
+			}
+			return names, nil
+		})
+	}
+}
+
+// CompleteExceptionNames completes the first positional argument with the
+// names of the ScheduleExceptions in the effective namespace.
+func CompleteExceptionNames(opts *RootOptions) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return completeNames(opts, toComplete, func(ctx context.Context, c client.Client) ([]string, error) {
+			var exceptions hibernatorv1alpha1.ScheduleExceptionList
+			if err := c.List(ctx, &exceptions, client.InNamespace(ResolveNamespace(opts))); err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(exceptions.Items))
+			for _, exc := range exceptions.Items {
+				names = append(names, exc.Name)
+			}
+			return names, nil
+		})
+	}
+}
+
+// CompleteNamespaces completes the --namespace flag with the cluster's namespaces.
+func CompleteNamespaces(opts *RootOptions) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		return completeNames(opts, toComplete, func(ctx context.Context, c client.Client) ([]string, error) {
+			var namespaces corev1.NamespaceList
+			if err := c.List(ctx, &namespaces); err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(namespaces.Items))
+			for _, ns := range namespaces.Items {
+				names = append(names, ns.Name)
+			}
+			return names, nil
+		})
+	}
+}
+
+// CompleteContexts completes the --context flag with the kubeconfig contexts.
+func CompleteContexts(opts *RootOptions) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		raw, err := NewClientConfig(opts).RawConfig()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		names := make([]string, 0, len(raw.Contexts))
+		for name := range raw.Contexts {
+			names = append(names, name)
+		}
+		return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeNames runs a bounded API lookup and returns the names starting with
+// toComplete. Lookup failures yield no completions rather than an error, since
+// the shell has no way to surface them.
+func completeNames(opts *RootOptions, toComplete string, lookup func(context.Context, client.Client) ([]string, error)) ([]cobra.Completion, cobra.ShellCompDirective) {
+	c, err := NewK8sClient(opts)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	names, err := lookup(ctx, c)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// filterCompletions returns the sorted names starting with prefix.
+func filterCompletions(names []string, prefix string) []cobra.Completion {
+	var out []cobra.Completion
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) {
+			out = append(out, name)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// LoadExecutions fills plan.Status.Executions from the plan's HibernateExecution
+// objects. The controller stores the execution ledger of large plans there and
+// only keeps an ExecutionSummary in the plan status. Plans with an inline ledger
+// are left untouched.
+func LoadExecutions(ctx context.Context, c client.Client, plan *hibernatorv1alpha1.HibernatePlan) error {
+	summary := plan.Status.ExecutionSummary
+	if summary == nil || len(plan.Status.Executions) > 0 {
+		return nil
+	}
+
+	var list hibernatorv1alpha1.HibernateExecutionList
+	if err := c.List(ctx, &list,
+		client.InNamespace(plan.Namespace),
+		client.MatchingLabels{
+			wellknown.LabelPlan:      plan.Name,
+			wellknown.LabelCycleID:   summary.CycleID,
+			wellknown.LabelOperation: string(summary.Operation),
+		},
+	); err != nil {
+		return fmt.Errorf("list executions: %w", err)
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Spec.Index < list.Items[j].Spec.Index
+	})
+	for _, item := range list.Items {
+		if item.Spec.Index < summary.Total {
+			plan.Status.Executions = append(plan.Status.Executions, item.Status)
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ package common
 // RootOptions holds global options shared across subcommands.
 type RootOptions struct {
 	Kubeconfig string
+	Context    string
 	Namespace  string
 	JsonOutput bool
 }
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// LoadPlanFromFile reads a HibernatePlan from a local YAML or JSON file. For
+// multi-document files, the first HibernatePlan document is used.
+func LoadPlanFromFile(path string, plan *hibernatorv1alpha1.HibernatePlan) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+
+	// Handle multi-document YAML: find the HibernatePlan document
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(string(data)), 4096)
+	for {
+		var raw hibernatorv1alpha1.HibernatePlan
+		if err := decoder.Decode(&raw); err != nil {
+			if err.Error() == "EOF" {
+				break
+			}
+			return fmt.Errorf("failed to parse YAML from %q: %w", path, err)
+		}
+		if raw.Kind == "HibernatePlan" || (raw.Kind == "" && raw.Spec.Schedule.Timezone != "") {
+			*plan = raw
+			return nil
+		}
+	}
+
+	// Fallback: try as single-document
+	if err := yaml.UnmarshalStrict(data, plan); err != nil {
+		return fmt.Errorf("no HibernatePlan found in %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// LoadExceptionsFromFile reads the ScheduleExceptions applying to plan from a
+// local multi-document YAML or JSON file. Other documents are ignored.
+func LoadExceptionsFromFile(path string, plan hibernatorv1alpha1.HibernatePlan) ([]hibernatorv1alpha1.ScheduleException, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+
+	var exceptions []hibernatorv1alpha1.ScheduleException
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(string(data)), 4096)
+	for {
+		var exc hibernatorv1alpha1.ScheduleException
+		if err := decoder.Decode(&exc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse YAML from %q: %w", path, err)
+		}
+		if exc.Kind == "ScheduleException" && exc.AppliesToPlan(plan.Name, plan.Labels) {
+			exceptions = append(exceptions, exc)
+		}
+	}
+
+	return exceptions, nil
+}
+
+// PlanDocument is a HibernatePlan read from a manifest.
+type PlanDocument struct {
+	// Index is the 0-based position of the document in its file.
+	Index int
+	Plan  hibernatorv1alpha1.HibernatePlan
+
+	// Err is set when the document could not be decoded at all; Plan is then empty.
+	Err error
+	// StrictErr reports unknown or duplicate fields. Plan still holds the
+	// leniently decoded document, as the API server would see it.
+	StrictErr error
+}
+
+// LoadPlanDocuments reads every HibernatePlan document from a multi-document
+// YAML or JSON file, or from stdin when path is "-". Documents of other kinds
+// are skipped. Unknown or misspelled fields are reported per document in
+// StrictErr instead of being silently dropped.
+func LoadPlanDocuments(path string) ([]PlanDocument, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+
+	var docs []PlanDocument
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for i := 0; ; i++ {
+		raw, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to split documents in %q: %w", path, err)
+		}
+		if len(bytes.TrimSpace(raw)) == 0 {
+			continue
+		}
+
+		var meta struct {
+			Kind string `json:"kind"`
+		}
+		if err := yaml.Unmarshal(raw, &meta); err != nil {
+			docs = append(docs, PlanDocument{Index: i, Err: fmt.Errorf("failed to parse document: %w", err)})
+			continue
+		}
+		if meta.Kind != "HibernatePlan" {
+			continue
+		}
+
+		doc := PlanDocument{Index: i}
+		if err := yaml.UnmarshalStrict(raw, &doc.Plan); err != nil {
+			doc.Plan = hibernatorv1alpha1.HibernatePlan{}
+			if lerr := yaml.Unmarshal(raw, &doc.Plan); lerr != nil {
+				doc.Err = fmt.Errorf("failed to decode HibernatePlan: %w", lerr)
+			} else {
+				doc.StrictErr = err
+			}
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
@@ -8,10 +8,13 @@ package common
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/scheduler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -67,10 +70,11 @@ func ConvertAPIException(exc hibernatorv1alpha1.ScheduleException) *scheduler.Ex
 		leadTime, _ = time.ParseDuration(exc.Spec.LeadTime)
 	}
 
+	validFrom, validUntil := exc.EffectiveWindow()
 	return &scheduler.Exception{
 		Type:       scheduler.ExceptionType(exc.Spec.Type),
-		ValidFrom:  exc.Spec.ValidFrom.Time,
-		ValidUntil: exc.Spec.ValidUntil.Time,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
 		LeadTime:   leadTime,
 		Windows:    windows,
 	}
@@ -128,6 +132,90 @@ func ComputeUpcomingEvents(baseWindows []scheduler.OffHourWindow, timezone strin
 	return events, nil
 }
 
+// transitionProbeOffset is how far past a candidate transition the schedule
+// is re-evaluated, mirroring the safety buffer the controller requeues with.
+const transitionProbeOffset = 10 * time.Second
+
+// maxTransitionSteps bounds the evaluation steps of ComputeTransitions.
+const maxTransitionSteps = 10000
+
+// EvaluateAt evaluates a schedule as the controller would at time t.
+func EvaluateAt(baseWindows []scheduler.OffHourWindow, timezone string, exceptions []*scheduler.Exception, t time.Time) (*scheduler.EvaluationResult, error) {
+	return scheduler.NewScheduleEvaluator(fixedClock{t: t}).Evaluate(baseWindows, timezone, exceptions)
+}
+
+// ComputeTransitions returns every hibernate/wakeup transition of a schedule
+// between from and until. Besides the evaluator's own next events it also
+// steps through exception validity boundaries, so exceptions that start or end
+// within the range are accounted for. The In field of each event is measured
+// from from.
+func ComputeTransitions(baseWindows []scheduler.OffHourWindow, timezone string, exceptions []*scheduler.Exception, from, until time.Time) ([]ScheduleEvent, error) {
+	result, err := EvaluateAt(baseWindows, timezone, exceptions, from)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate schedule: %w", err)
+	}
+
+	var events []ScheduleEvent
+	hibernated := result.ShouldHibernate
+	cursor := from
+
+	for range maxTransitionSteps {
+		next := result.NextHibernateTime
+		if hibernated {
+			next = result.NextWakeUpTime
+		}
+		if boundary := nextExceptionBoundary(exceptions, cursor); !boundary.IsZero() && (next.IsZero() || boundary.Before(next)) {
+			next = boundary
+		}
+		if next.IsZero() || next.After(until) {
+			break
+		}
+		if !next.After(cursor) {
+			next = cursor.Add(time.Minute)
+		}
+
+		cursor = next
+		result, err = EvaluateAt(baseWindows, timezone, exceptions, cursor.Add(transitionProbeOffset))
+		if err != nil {
+			return nil, fmt.Errorf("evaluate schedule: %w", err)
+		}
+
+		if result.ShouldHibernate == hibernated {
+			continue
+		}
+		hibernated = result.ShouldHibernate
+
+		operation := "WakeUp"
+		if hibernated {
+			operation = "Hibernate"
+		}
+		events = append(events, ScheduleEvent{
+			Time:      cursor,
+			Operation: operation,
+			In:        cursor.Sub(from),
+		})
+	}
+
+	return events, nil
+}
+
+// nextExceptionBoundary returns the earliest exception start or end after t,
+// or the zero time if there is none.
+func nextExceptionBoundary(exceptions []*scheduler.Exception, t time.Time) time.Time {
+	var next time.Time
+	for _, exc := range exceptions {
+		if exc == nil {
+			continue
+		}
+		for _, b := range []time.Time{exc.ValidFrom, exc.ValidUntil} {
+			if b.After(t) && (next.IsZero() || b.Before(next)) {
+				next = b
+			}
+		}
+	}
+	return next
+}
+
 // ComputeNextEvent computes the next hibernate or wakeup event for a schedule,
 // optionally considering an active exception.
 // Returns nil if the schedule has no off-hour windows defined.
@@ -148,25 +236,108 @@ func ComputeNextEvent(schedule hibernatorv1alpha1.Schedule, exceptions []*schedu
 	return &events[0], nil
 }
 
+// StatusNextEvent returns the next transition after now among those the
+// controller recorded in the plan's status, which account for jitter, date
+// windows and the DST policy. It returns nil when neither is recorded.
+func StatusNextEvent(plan hibernatorv1alpha1.HibernatePlan, now time.Time) *ScheduleEvent {
+	var next *ScheduleEvent
+	for _, candidate := range []struct {
+		at        *metav1.Time
+		operation string
+	}{
+		{plan.Status.NextHibernateTime, "Hibernate"},
+		{plan.Status.NextWakeUpTime, "WakeUp"},
+	} {
+		if candidate.at == nil || !candidate.at.After(now) {
+			continue
+		}
+		if next == nil || candidate.at.Time.Before(next.Time) {
+			next = &ScheduleEvent{Time: candidate.at.Time, Operation: candidate.operation, In: candidate.at.Sub(now)}
+		}
+	}
+	return next
+}
+
+// ComputeNextTransitions returns the next hibernate and wake-up times of a
+// schedule, considering active exceptions. Either is nil when it cannot be
+// determined (e.g., the schedule has no off-hour windows).
+func ComputeNextTransitions(schedule hibernatorv1alpha1.Schedule, exceptions []*scheduler.Exception) (nextHibernate, nextWakeUp *time.Time, err error) {
+	if len(schedule.OffHours) == 0 {
+		return nil, nil, nil
+	}
+
+	events, err := ComputeUpcomingEvents(ConvertAPIWindows(schedule.OffHours), schedule.Timezone, exceptions, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, event := range events {
+		t := event.Time
+		switch {
+		case event.Operation == "Hibernate" && nextHibernate == nil:
+			nextHibernate = &t
+		case event.Operation == "WakeUp" && nextWakeUp == nil:
+			nextWakeUp = &t
+		}
+	}
+
+	return nextHibernate, nextWakeUp, nil
+}
+
+// listPlanExceptions returns the ScheduleExceptions in the plan's namespace that
+// apply to it, whether by planRef, planRefs, or planSelector.
+func listPlanExceptions(ctx context.Context, c client.Client, plan hibernatorv1alpha1.HibernatePlan) ([]hibernatorv1alpha1.ScheduleException, error) {
+	var list hibernatorv1alpha1.ScheduleExceptionList
+	if err := c.List(ctx, &list, client.InNamespace(plan.Namespace)); err != nil {
+		return nil, fmt.Errorf("list schedule exceptions: %w", err)
+	}
+
+	var matched []hibernatorv1alpha1.ScheduleException
+	for _, exc := range list.Items {
+		if exc.AppliesToPlan(plan.Name, plan.Labels) {
+			matched = append(matched, exc)
+		}
+	}
+	return matched, nil
+}
+
+// FetchScheduledExceptions lists the ScheduleException resources of the given
+// plan that are active or pending, i.e. that may still affect its schedule.
+// Validity periods are left to the evaluator, which applies each exception
+// only within its own period.
+func FetchScheduledExceptions(ctx context.Context, c client.Client, plan hibernatorv1alpha1.HibernatePlan) ([]hibernatorv1alpha1.ScheduleException, error) {
+	list, err := listPlanExceptions(ctx, c, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduled []hibernatorv1alpha1.ScheduleException
+	for _, exc := range list {
+		switch exc.Status.State {
+		case hibernatorv1alpha1.ExceptionStateActive, hibernatorv1alpha1.ExceptionStatePending, "":
+			scheduled = append(scheduled, exc)
+		}
+	}
+
+	return scheduled, nil
+}
+
 // FetchActiveExceptions lists ScheduleException resources for the given plan and
 // returns all active ones as scheduler exceptions, ordered by creation timestamp
 // descending (newest first).
 func FetchActiveExceptions(ctx context.Context, c client.Client, plan hibernatorv1alpha1.HibernatePlan) ([]*scheduler.Exception, error) {
-	var list hibernatorv1alpha1.ScheduleExceptionList
-	if err := c.List(ctx, &list,
-		client.InNamespace(plan.Namespace),
-		client.MatchingLabels{"hibernator.ardikabs.com/plan": plan.Name},
-	); err != nil {
-		return nil, fmt.Errorf("list schedule exceptions: %w", err)
+	list, err := listPlanExceptions(ctx, c, plan)
+	if err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
 	var active []hibernatorv1alpha1.ScheduleException
-	for _, exc := range list.Items {
+	for _, exc := range list {
 		if exc.Status.State != hibernatorv1alpha1.ExceptionStateActive {
 			continue
 		}
-		if now.Before(exc.Spec.ValidFrom.Time) || now.After(exc.Spec.ValidUntil.Time) {
+		if from, until := exc.EffectiveWindow(); now.Before(from) || now.After(until) {
 			continue
 		}
 		active = append(active, exc)
@@ -183,3 +354,23 @@ func FetchActiveExceptions(ctx context.Context, c client.Client, plan hibernator
 
 	return result, nil
 }
+
+// ParseSpan parses a positive duration, additionally accepting whole days
+// such as "14d".
+func ParseSpan(s string) (time.Duration, error) {
+	var (
+		d   time.Duration
+		err error
+	)
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		var n int
+		n, err = strconv.Atoi(days)
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		d, err = time.ParseDuration(s)
+	}
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%q is not a positive duration such as 14d or 36h", s)
+	}
+	return d, nil
+}
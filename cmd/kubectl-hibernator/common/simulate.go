@@ -0,0 +1,92 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ardikabs/hibernator/internal/scheduler"
+)
+
+// ActivitySample is a single observation of a usage metric.
+type ActivitySample struct {
+	Time  time.Time
+	Value float64
+}
+
+// ActivityConflict is a contiguous period of active usage that falls inside a
+// hibernation window.
+type ActivityConflict struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Peak is the highest metric value observed during the conflict.
+	Peak float64 `json:"peak"`
+}
+
+// SimulationResult summarizes how a schedule would have treated historical activity.
+type SimulationResult struct {
+	// Observed is the total time covered by samples.
+	Observed time.Duration `json:"observed"`
+	// Active is the time during which the metric exceeded the threshold.
+	Active time.Duration `json:"active"`
+	// Hibernated is the time the schedule would have kept the plan hibernated.
+	Hibernated time.Duration `json:"hibernated"`
+	// ActiveWhileHibernated is the active time falling inside hibernation windows.
+	ActiveWhileHibernated time.Duration `json:"activeWhileHibernated"`
+	// Conflicts lists the periods of active usage inside hibernation windows.
+	Conflicts []ActivityConflict `json:"conflicts,omitempty"`
+}
+
+// SimulateSchedule replays samples against the schedule and reports how much
+// active usage would have fallen inside hibernation windows. Each sample is
+// taken to represent the step that follows it; a sample is active when its
+// value is above threshold. Samples must be sorted by time.
+func SimulateSchedule(baseWindows []scheduler.OffHourWindow, timezone string, exceptions []*scheduler.Exception, samples []ActivitySample, step time.Duration, threshold float64) (*SimulationResult, error) {
+	if len(baseWindows) == 0 {
+		return nil, fmt.Errorf("no base windows defined")
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("step must be positive")
+	}
+
+	result := &SimulationResult{}
+	current := -1 // index of the conflict being extended, if any
+
+	for _, sample := range samples {
+		eval := scheduler.NewScheduleEvaluator(fixedClock{t: sample.Time})
+		evalResult, err := eval.Evaluate(baseWindows, timezone, exceptions)
+		if err != nil {
+			return nil, fmt.Errorf("evaluate schedule at %s: %w", sample.Time.Format(time.RFC3339), err)
+		}
+
+		active := sample.Value > threshold
+		result.Observed += step
+		if active {
+			result.Active += step
+		}
+		if evalResult.ShouldHibernate {
+			result.Hibernated += step
+		}
+
+		if !active || !evalResult.ShouldHibernate {
+			current = -1
+			continue
+		}
+
+		result.ActiveWhileHibernated += step
+		end := sample.Time.Add(step)
+		if current >= 0 && !sample.Time.After(result.Conflicts[current].End) {
+			result.Conflicts[current].End = end
+			result.Conflicts[current].Peak = max(result.Conflicts[current].Peak, sample.Value)
+			continue
+		}
+		result.Conflicts = append(result.Conflicts, ActivityConflict{Start: sample.Time, End: end, Peak: sample.Value})
+		current = len(result.Conflicts) - 1
+	}
+
+	return result, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/output"
+)
+
+// waitPollInterval is how often WaitForPhase re-reads the plan.
+const waitPollInterval = 2 * time.Second
+
+// WaitForPhase polls a HibernatePlan until it reaches the target phase, reporting
+// phase changes and per-target progress through out.
+//
+// trigger is the one-shot annotation that requested the operation. When the
+// controller consumes it but the plan is still in its starting phase on the next
+// poll, the request was not honoured and WaitForPhase fails instead of waiting
+// for the timeout. Reaching PhaseError also fails.
+func WaitForPhase(ctx context.Context, c client.Client, key types.NamespacedName, trigger string, target hibernatorv1alpha1.PlanPhase, timeout time.Duration, out output.Formatter) error {
+	var (
+		startPhase hibernatorv1alpha1.PlanPhase
+		lastPhase  hibernatorv1alpha1.PlanPhase
+		lastDone   = -1
+		idleAfter  int
+	)
+
+	err := wait.PollUntilContextTimeout(ctx, waitPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		var plan hibernatorv1alpha1.HibernatePlan
+		if err := c.Get(ctx, key, &plan); err != nil {
+			return false, fmt.Errorf("failed to get HibernatePlan %q: %w", key.Name, err)
+		}
+
+		phase := plan.Status.Phase
+		if startPhase == "" {
+			startPhase = phase
+		}
+		if phase != lastPhase {
+			out.Info("Phase: %s", phase)
+			lastPhase = phase
+		}
+
+		switch phase {
+		case target:
+			return true, nil
+		case hibernatorv1alpha1.PhaseError:
+			return false, fmt.Errorf("HibernatePlan %q failed: %s", key.Name, plan.Status.ErrorMessage)
+		}
+
+		if done, total := executionProgress(plan.Status); total > 0 && done != lastDone {
+			out.Info("  %d/%d targets done", done, total)
+			lastDone = done
+		}
+
+		if phase == startPhase && !IsMarkedTrue(plan.Annotations, trigger) {
+			// Give the controller one more poll to publish the phase change that
+			// follows consuming the annotation.
+			if idleAfter++; idleAfter > 1 {
+				return false, fmt.Errorf("controller consumed %s without starting the operation; check the controller logs", trigger)
+			}
+		}
+		return false, nil
+	})
+
+	if wait.Interrupted(err) && !errors.Is(ctx.Err(), context.Canceled) {
+		return fmt.Errorf("timed out after %s waiting for HibernatePlan %q to reach %s", timeout, key.Name, target)
+	}
+	return err
+}
+
+// executionProgress counts targets that finished (completed, failed or aborted)
+// out of all targets of the current operation. Externalized ledgers are counted
+// from the plan's ExecutionSummary.
+func executionProgress(status hibernatorv1alpha1.HibernatePlanStatus) (done, total int) {
+	if s := status.ExecutionSummary; s != nil {
+		return int(s.Completed + s.Failed + s.Aborted + s.Cancelled), int(s.Total)
+	}
+
+	for _, e := range status.Executions {
+		switch e.State {
+		case hibernatorv1alpha1.StateCompleted, hibernatorv1alpha1.StateFailed, hibernatorv1alpha1.StateAborted, hibernatorv1alpha1.StateCancelled:
+			done++
+		}
+	}
+	return done, len(status.Executions)
+}
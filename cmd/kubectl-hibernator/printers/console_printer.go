@@ -14,14 +14,14 @@ import (
 	"time"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/recovery"
 	"github.com/ardikabs/hibernator/internal/restore"
 	"github.com/ardikabs/hibernator/internal/scheduler"
-	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/samber/lo"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/utils/ptr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ConsolePrinter handles table-like output for various resources
@@ -35,12 +35,29 @@ func formatLocalTime(t time.Time) string {
 	return t.Local().Format(localTimeFormat)
 }
 
+// formatHours formats a duration as a number of hours
+func formatHours(d time.Duration) string {
+	return fmt.Sprintf("%.1fh", d.Hours())
+}
+
+// formatPercent formats part as a percentage of total
+func formatPercent(part, total time.Duration) string {
+	if total <= 0 {
+		return "0.0%"
+	}
+	return fmt.Sprintf("%.1f%%", float64(part)/float64(total)*100)
+}
+
 func (p *ConsolePrinter) PrintObj(obj interface{}, w io.Writer) error {
 	switch v := obj.(type) {
 	case hibernatorv1alpha1.HibernatePlan:
 		return p.printPlan(v, w)
 	case *hibernatorv1alpha1.HibernatePlan:
 		return p.printPlan(*v, w)
+	case *DescribeOutput:
+		return p.printPlanWithStatus(&v.StatusOutput, w)
+	case *StatusOutput:
+		return p.printStatusReport(v, w)
 	case corev1.ConfigMap:
 		// Used for restore points
 		return p.printRestorePoint(v, w)
@@ -54,12 +71,22 @@ func (p *ConsolePrinter) PrintObj(obj interface{}, w io.Writer) error {
 		return p.printRestoreDetail(v, w)
 	case *RestoreResourcesOutput:
 		return p.printRestoreResources(v, w)
+	case *RestoreHistoryOutput:
+		return p.printRestoreHistory(v, w)
+	case *SimulationOutput:
+		return p.printSimulation(v, w)
 	case *NotifListOutput:
 		return p.printNotifList(v, w)
 	case *NotifDescribeOutput:
 		return p.printNotifDescribe(v, w)
 	case *NotifSendDryRunOutput:
 		return p.printNotifSendDryRun(v, w)
+	case *ValidationOutput:
+		return p.printValidation(v, w)
+	case *StateBackupOutput:
+		return p.printStateBackup(v, w)
+	case *StateRestoreOutput:
+		return p.printStateRestore(v, w)
 	default:
 		return fmt.Errorf("no human-readable printer registered for %T", obj)
 	}
@@ -87,8 +114,14 @@ func (p *ConsolePrinter) printPlanListOutput(out *PlanListOutput, w io.Writer) e
 	return tw.flush()
 }
 
-// printPlan renders full plan details (schedule, behavior, execution, targets, status) for `kubectl-hibernator describe`.
+// printPlan renders full plan details without schedule-derived status.
 func (p *ConsolePrinter) printPlan(plan hibernatorv1alpha1.HibernatePlan, w io.Writer) error {
+	return p.printPlanWithStatus(&StatusOutput{Plan: plan}, w)
+}
+
+// printPlanWithStatus renders full plan details (schedule, behavior, execution, targets, status) for `kubectl-hibernator describe`.
+func (p *ConsolePrinter) printPlanWithStatus(out *StatusOutput, w io.Writer) error {
+	plan := out.Plan
 	tw := newTextWriter(w)
 
 	tw.line("Name:       %s", plan.Name)
@@ -108,7 +141,12 @@ func (p *ConsolePrinter) printPlan(plan hibernatorv1alpha1.HibernatePlan, w io.W
 	// Behavior
 	tw.line("Behavior:")
 	tw.line("  Mode:     %s", plan.Spec.Behavior.Mode)
-	tw.line("  Retries:  %d", ptr.Deref(plan.Spec.Behavior.Retries, wellknown.DefaultRecoveryMaxRetryAttempts))
+	retryPolicy := recovery.PolicyFor(plan.Spec.Behavior)
+	tw.line("  Retries:  %d", retryPolicy.MaxRetries)
+	if plan.Spec.Behavior.RetryPolicy != nil {
+		tw.line("  Backoff:  %s, x%d up to %s", retryPolicy.InitialBackoff, retryPolicy.BackoffMultiplier, retryPolicy.MaxBackoff)
+		tw.line("  Retry On: %s", strings.Join(lo.Map(retryPolicy.RetryOn, func(c recovery.ErrorClassification, _ int) string { return string(c) }), ", "))
+	}
 	tw.newline()
 
 	// Execution strategy
@@ -132,7 +170,7 @@ func (p *ConsolePrinter) printPlan(plan hibernatorv1alpha1.HibernatePlan, w io.W
 	} else {
 		for i, target := range plan.Spec.Targets {
 			tw.line("  [%d] %s (%s)", i, target.Name, target.Type)
-			tw.line("      Connector: %s/%s", target.ConnectorRef.Kind, target.ConnectorRef.Name)
+			tw.line("      Connector: %s", formatConnectorRef(target.ConnectorRef))
 			if target.Parameters != nil && len(target.Parameters.Raw) > 0 {
 				tw.line("      Parameters:")
 
@@ -156,7 +194,21 @@ func (p *ConsolePrinter) printPlan(plan hibernatorv1alpha1.HibernatePlan, w io.W
 		return err
 	}
 
-	return p.printStatus(&StatusOutput{Plan: plan}, w)
+	return p.printStatus(out, w)
+}
+
+// printStatusReport renders plan status with a short header for `kubectl-hibernator status`.
+func (p *ConsolePrinter) printStatusReport(out *StatusOutput, w io.Writer) error {
+	tw := newTextWriter(w)
+	tw.line("Name:       %s", out.Plan.Name)
+	tw.line("Namespace:  %s", out.Plan.Namespace)
+	tw.line("Schedule:   %d off-hour window(s), %s", len(out.Plan.Spec.Schedule.OffHours), out.Plan.Spec.Schedule.Timezone)
+	tw.newline()
+	if err := tw.flush(); err != nil {
+		return err
+	}
+
+	return p.printStatus(out, w)
 }
 
 // printStatus renders the live status block (phase, executions, history, exceptions); called internally by printPlan.
@@ -179,17 +231,27 @@ func (p *ConsolePrinter) printStatus(out *StatusOutput, w io.Writer) error {
 		}
 	}
 
+	if out.NextHibernate != nil {
+		tw.line("  Next Hibernate: %s (in %s)", formatLocalTime(*out.NextHibernate), HumanDuration(time.Until(*out.NextHibernate)))
+	}
+	if out.NextWakeUp != nil {
+		tw.line("  Next Wake Up:   %s (in %s)", formatLocalTime(*out.NextWakeUp), HumanDuration(time.Until(*out.NextWakeUp)))
+	}
+
 	tw.newline()
 
 	if plan.Status.CurrentCycleID != "" {
 		tw.line("  Current Cycle: %s", plan.Status.CurrentCycleID)
 		tw.line("  Operation:     %s", plan.Status.CurrentOperation)
+		if isOperationPaused(plan) {
+			tw.line("  Paused:        true (resume with 'kubectl hibernator resume-op %s')", plan.Name)
+		}
 		tw.newline()
 	}
 
 	if plan.Status.Phase == hibernatorv1alpha1.PhaseError {
 		tw.line("  Error:       %s", plan.Status.ErrorMessage)
-		tw.line("  Retry Count: %d/%d", plan.Status.RetryCount, ptr.Deref(plan.Spec.Behavior.Retries, wellknown.DefaultRecoveryMaxRetryAttempts))
+		tw.line("  Retry Count: %d/%d", plan.Status.RetryCount, recovery.PolicyFor(plan.Spec.Behavior).MaxRetries)
 		if plan.Status.LastRetryTime != nil {
 			tw.line("  Last Retry:  %s (%s ago) [%s]", formatLocalTime(plan.Status.LastRetryTime.Time), HumanDuration(time.Since(plan.Status.LastRetryTime.Time)), time.Local.String())
 		}
@@ -209,18 +271,26 @@ func (p *ConsolePrinter) printStatus(out *StatusOutput, w io.Writer) error {
 			if exec.Message != "" {
 				tw.row("  ", "  ", "Message:", exec.Message)
 			}
+			if exec.Progress != nil {
+				tw.row("  ", "  ", "Progress:", formatExecutionProgress(exec.Progress))
+			}
 			if exec.StartedAt != nil {
 				tw.row("  ", "  ", "Started:", formatLocalTime(exec.StartedAt.Time))
 			}
 			if exec.FinishedAt != nil {
 				tw.row("  ", "  ", "Finished:", formatLocalTime(exec.FinishedAt.Time))
 			}
+			if exec.JobRef != "" {
+				tw.row("  ", "  ", "Job:", exec.JobRef)
+			}
 		}
 	}
 
 	tw.newline()
 
-	if len(plan.Status.ExecutionHistory) > 1 {
+	if out.HistoryLimit > 0 && len(plan.Status.ExecutionHistory) > 0 {
+		p.printRecentCycles(tw, plan.Status.ExecutionHistory, out.HistoryLimit)
+	} else if len(plan.Status.ExecutionHistory) > 1 {
 		last := plan.Status.ExecutionHistory[len(plan.Status.ExecutionHistory)-2]
 		// tw.line("\n  Last Cycle: %s", last.CycleID)
 		tw.row("", "Last Cycle:", last.CycleID)
@@ -248,6 +318,36 @@ func (p *ConsolePrinter) printStatus(out *StatusOutput, w io.Writer) error {
 	return tw.flush()
 }
 
+// printRecentCycles renders a table of the most recent execution cycles, newest first; called internally by printStatus.
+func (p *ConsolePrinter) printRecentCycles(tw *textWriter, history []hibernatorv1alpha1.ExecutionCycle, limit int) {
+	tw.line("  Recent Cycles:")
+	tw.row("  ", "CYCLE", "OPERATION", "RESULT", "STARTED", "DURATION", "TARGETS")
+
+	toTitle := cases.Title(language.English, cases.Compact)
+	for i := len(history) - 1; i >= 0 && i >= len(history)-limit; i-- {
+		cycle := history[i]
+		for _, op := range []*hibernatorv1alpha1.ExecutionOperationSummary{cycle.WakeupExecution, cycle.ShutdownExecution} {
+			if op == nil {
+				continue
+			}
+
+			result, duration := "Running", "-"
+			if op.EndTime != nil {
+				result = lo.Ternary(op.Success, "Succeeded", "Failed")
+				duration = HumanDuration(op.EndTime.Sub(op.StartTime.Time))
+			}
+
+			succeeded := lo.CountBy(op.TargetResults, func(r hibernatorv1alpha1.TargetExecutionResult) bool {
+				return r.State == hibernatorv1alpha1.StateCompleted
+			})
+
+			tw.row("  ", cycle.CycleID, toTitle.String(string(op.Operation)), result,
+				formatLocalTime(op.StartTime.Time), duration, fmt.Sprintf("%d/%d", succeeded, len(op.TargetResults)))
+		}
+	}
+	tw.newline()
+}
+
 // printOperationSummary renders a single shutdown/wakeup cycle summary line; called internally by printStatus.
 func (p *ConsolePrinter) printOperationSummary(tw *textWriter, op *hibernatorv1alpha1.ExecutionOperationSummary) {
 	successStr := "Failed"
@@ -293,11 +393,32 @@ func (p *ConsolePrinter) printSchedule(out *ScheduleOutput, w io.Writer) error {
 	}
 	tw.newline()
 
-	tw.line("Current State:")
-	tw.line("  State:              %s", result.CurrentState)
-	tw.line("  Next Hibernate:     %s (%s) [%s]", formatLocalTime(result.NextHibernateTime), HumanDuration(time.Until(result.NextHibernateTime)), time.Local.String())
-	tw.line("  Next WakeUp:        %s (%s) [%s]", formatLocalTime(result.NextWakeUpTime), HumanDuration(time.Until(result.NextWakeUpTime)), time.Local.String())
-	tw.newline()
+	if out.Range != nil {
+		tw.line("State at %s [%s]:", formatLocalTime(out.Range.From), time.Local.String())
+		tw.line("  State:              %s", result.CurrentState)
+		tw.newline()
+
+		tw.line("Transitions (%s - %s): %d", formatLocalTime(out.Range.From), formatLocalTime(out.Range.Until), len(out.Transitions))
+		if len(out.Transitions) > 0 {
+			trtw := newTextWriter(tw.w)
+			trtw.newline()
+
+			trtw.header("", "Operation", "Time", "After")
+			for _, ev := range out.Transitions {
+				trtw.row("", ev.Operation, formatLocalTime(ev.Time), HumanDuration(ev.In))
+			}
+
+			trtw.newline()
+		} else {
+			tw.newline()
+		}
+	} else {
+		tw.line("Current State:")
+		tw.line("  State:              %s", result.CurrentState)
+		tw.line("  Next Hibernate:     %s (%s) [%s]", formatLocalTime(result.NextHibernateTime), HumanDuration(time.Until(result.NextHibernateTime)), time.Local.String())
+		tw.line("  Next WakeUp:        %s (%s) [%s]", formatLocalTime(result.NextWakeUpTime), HumanDuration(time.Until(result.NextWakeUpTime)), time.Local.String())
+		tw.newline()
+	}
 
 	if len(events) > 0 {
 		tw.line("Upcoming Events (next %d):", len(events))
@@ -319,7 +440,11 @@ func (p *ConsolePrinter) printSchedule(out *ScheduleOutput, w io.Writer) error {
 	}
 
 	if len(exceptions) > 0 {
-		tw.line("Active Exceptions:")
+		if out.Range != nil {
+			tw.line("Exceptions:")
+		} else {
+			tw.line("Active Exceptions:")
+		}
 		for _, ex := range exceptions {
 			tw.line("  - %s (type=%s, until=%s, state=%s)",
 				ex.Name, ex.Type, formatLocalTime(ex.ValidUntil.Time), ex.State)
@@ -475,6 +600,81 @@ func (p *ConsolePrinter) printRestoreResources(out *RestoreResourcesOutput, w io
 	return tw.flush()
 }
 
+// printRestoreHistory renders the archived snapshots of a target for `kubectl-hibernator restore history`.
+func (p *ConsolePrinter) printRestoreHistory(out *RestoreHistoryOutput, w io.Writer) error {
+	tw := newTextWriter(w)
+	tw.row("Plan: ", fmt.Sprintf("%s/%s", out.Namespace, out.Plan))
+	tw.row("Target: ", out.Target)
+
+	if len(out.Snapshots) == 0 {
+		tw.newline()
+		tw.line("No restore snapshots found for target")
+		return tw.flush()
+	}
+
+	tw.newline()
+	tw.header("Version", "Executor", "Resources", fmt.Sprintf("Captured At (%s)", time.Local.String()), fmt.Sprintf("Archived At (%s)", time.Local.String()))
+
+	for _, s := range out.Snapshots {
+		capturedAt := "-"
+		if s.Data.CapturedAt != nil {
+			capturedAt = formatLocalTime(s.Data.CapturedAt.Time)
+		}
+		tw.row(s.Version, s.Data.Executor, len(s.Data.State), capturedAt, formatLocalTime(s.ArchivedAt.Time))
+	}
+
+	return tw.flush()
+}
+
+// printSimulation renders the result of replaying historical activity against a schedule for `kubectl-hibernator simulate`.
+func (p *ConsolePrinter) printSimulation(out *SimulationOutput, w io.Writer) error {
+	plan := out.Plan
+	result := out.Result
+
+	tw := newTextWriter(w)
+
+	tw.line("Plan:      %s", plan.Name)
+	if plan.Namespace != "" {
+		tw.line("Namespace: %s", plan.Namespace)
+	}
+	tw.line("Timezone:  %s", plan.Spec.Schedule.Timezone)
+	tw.line("Query:     %s", out.Query)
+	tw.line("Range:     %s - %s [%s] (step %s, threshold %g)",
+		formatLocalTime(out.Start), formatLocalTime(out.End), time.Local.String(), out.Step, out.Threshold)
+	tw.newline()
+
+	tw.line("Off-Hour Windows:")
+	for _, window := range plan.Spec.Schedule.OffHours {
+		tw.line("  %s - %s  [%s]", window.Start, window.End, strings.Join(window.DaysOfWeek, ", "))
+	}
+	tw.newline()
+
+	tw.line("Summary:")
+	tw.line("  Observed:                 %s", formatHours(result.Observed))
+	tw.line("  Active Usage:             %s", formatHours(result.Active))
+	tw.line("  Hibernated:               %s", formatHours(result.Hibernated))
+	tw.line("  Active While Hibernated:  %s (%s of active usage)",
+		formatHours(result.ActiveWhileHibernated), formatPercent(result.ActiveWhileHibernated, result.Active))
+	tw.newline()
+
+	if len(result.Conflicts) == 0 {
+		tw.line("No active usage falls inside the hibernation windows")
+		return tw.flush()
+	}
+
+	tw.line("Active Usage Inside Hibernation Windows:")
+
+	ctw := newTextWriter(tw.w)
+	ctw.newline()
+	ctw.header("", fmt.Sprintf("Start (%s)", time.Local.String()), fmt.Sprintf("End (%s)", time.Local.String()), "Duration", "Peak")
+	for _, c := range result.Conflicts {
+		ctw.row("", formatLocalTime(c.Start), formatLocalTime(c.End), HumanDuration(c.End.Sub(c.Start)), fmt.Sprintf("%g", c.Peak))
+	}
+	ctw.newline()
+
+	return tw.flush()
+}
+
 // printRestoreDetail renders the full metadata and raw state of a single restore resource for `kubectl-hibernator restore inspect`.
 func (p *ConsolePrinter) printRestoreDetail(out *RestoreDetailOutput, w io.Writer) error {
 	data := out.TargetData.(restore.Data)
@@ -660,6 +860,78 @@ func (p *ConsolePrinter) printNotifSendDryRun(out *NotifSendDryRunOutput, w io.W
 	return tw.flush()
 }
 
+// printValidation renders `kubectl-hibernator validate` results, one block per plan.
+func (p *ConsolePrinter) printValidation(out *ValidationOutput, w io.Writer) error {
+	tw := newTextWriter(w)
+
+	invalid := 0
+	for _, r := range out.Results {
+		status := "[OK]"
+		if !r.Valid {
+			status = "[FAIL]"
+			invalid++
+		}
+
+		name := r.Name
+		if name == "" {
+			name = "<unnamed>"
+		}
+		tw.line("%s %s (%s)", status, name, r.Source)
+		for _, e := range r.Errors {
+			tw.line("  error:   %s", e)
+		}
+		for _, warn := range r.Warnings {
+			tw.line("  warning: %s", warn)
+		}
+	}
+
+	tw.newline()
+	tw.line("%d plan(s) checked, %d invalid", len(out.Results), invalid)
+	return tw.flush()
+}
+
+// printStateBackup renders the per-kind object counts written by `kubectl-hibernator backup-state`.
+func (p *ConsolePrinter) printStateBackup(out *StateBackupOutput, w io.Writer) error {
+	tw := newTextWriter(w)
+
+	scope := out.Namespace
+	if scope == "" {
+		scope = "all namespaces"
+	}
+	tw.line("Archive:\t%s", out.File)
+	tw.line("Scope:\t%s", scope)
+	tw.line("Controller Version:\t%s", lo.Ternary(out.ControllerVersion != "", out.ControllerVersion, "unknown"))
+	tw.newline()
+
+	tw.header("Kind", "Objects")
+	for _, c := range out.Counts {
+		tw.row(c.Kind, c.Count)
+	}
+	return tw.flush()
+}
+
+// printStateRestore renders one row per object handled by `kubectl-hibernator restore-state`.
+func (p *ConsolePrinter) printStateRestore(out *StateRestoreOutput, w io.Writer) error {
+	tw := newTextWriter(w)
+
+	if out.DryRun {
+		tw.line("Dry run: no changes were made to the cluster")
+		tw.newline()
+	}
+
+	counts := make(map[string]int)
+	tw.header("Kind", "Namespace", "Name", "Action", "Error")
+	for _, r := range out.Results {
+		counts[r.Action]++
+		tw.row(r.Kind, lo.Ternary(r.Namespace != "", r.Namespace, "-"), r.Name, r.Action, r.Error)
+	}
+
+	tw.newline()
+	tw.line("%d object(s): %d create, %d update, %d skip, %d fail",
+		len(out.Results), counts["create"], counts["update"], counts["skip"], counts["fail"])
+	return tw.flush()
+}
+
 // formatObjectKeyRef formats an ObjectKeyReference as "name[key]" or just "name".
 func formatObjectKeyRef(ref hibernatorv1alpha1.ObjectKeyReference) string {
 	if ref.Key != nil {
@@ -667,3 +939,12 @@ func formatObjectKeyRef(ref hibernatorv1alpha1.ObjectKeyReference) string {
 	}
 	return ref.Name
 }
+
+// formatConnectorRef formats a ConnectorRef as "Kind/name" or, for selector-based
+// references, "Kind[selector]".
+func formatConnectorRef(ref hibernatorv1alpha1.ConnectorRef) string {
+	if ref.Selector != nil {
+		return fmt.Sprintf("%s[%s]", ref.Kind, metav1.FormatLabelSelector(ref.Selector))
+	}
+	return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
 )
@@ -78,6 +80,8 @@ func StateIcon(state hibernatorv1alpha1.ExecutionState) string {
 		return "[FAIL]"
 	case hibernatorv1alpha1.StateAborted:
 		return "[SKIP]"
+	case hibernatorv1alpha1.StateCancelled:
+		return "[STOP]"
 	case hibernatorv1alpha1.StateRunning:
 		return "[..]"
 	case hibernatorv1alpha1.StatePending:
@@ -86,3 +90,21 @@ func StateIcon(state hibernatorv1alpha1.ExecutionState) string {
 		return "[??]"
 	}
 }
+
+// isOperationPaused reports whether the plan's in-flight operation is held between stages.
+func isOperationPaused(plan hibernatorv1alpha1.HibernatePlan) bool {
+	return meta.IsStatusConditionTrue(plan.Status.Conditions, hibernatorv1alpha1.ConditionOperationPaused)
+}
+
+// formatExecutionProgress renders per-resource progress, e.g.
+// "stopped 7/24 RDS resource(s) (last: instance:db-7)".
+func formatExecutionProgress(progress *hibernatorv1alpha1.ExecutionProgress) string {
+	s := progress.Message
+	if s == "" {
+		s = fmt.Sprintf("%d/%d resource(s)", progress.Completed, progress.Total)
+	}
+	if progress.Resource != "" {
+		s += fmt.Sprintf(" (last: %s)", progress.Resource)
+	}
+	return s
+}
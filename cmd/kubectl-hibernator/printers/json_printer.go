@@ -7,15 +7,14 @@ package printers
 
 import (
 	"encoding/json"
-	"fmt"
 	"io"
 	"time"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/recovery"
 	"github.com/ardikabs/hibernator/internal/restore"
 	"github.com/ardikabs/hibernator/internal/scheduler"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/utils/ptr"
 )
 
 // JSONPrinter handles JSON output for various resources with context-relevant information.
@@ -41,6 +40,10 @@ func (p *JSONPrinter) PrintObj(obj interface{}, w io.Writer) error {
 		output, err = p.scheduleToJSON(v)
 	case *StatusOutput:
 		output = p.statusToJSON(v)
+	case *DescribeOutput:
+		plan := p.planToJSON(v.Plan)
+		plan.Status = p.statusToJSON(&v.StatusOutput)
+		output = plan
 	case corev1.ConfigMap:
 		output, err = p.printRestoreShowJSON(v)
 	case *corev1.ConfigMap:
@@ -49,6 +52,10 @@ func (p *JSONPrinter) PrintObj(obj interface{}, w io.Writer) error {
 		output = p.restoreDetailToJSON(v)
 	case *RestoreResourcesOutput:
 		output = p.restoreResourcesToJSON(v)
+	case *RestoreHistoryOutput:
+		output = p.restoreHistoryToJSON(v)
+	case *SimulationOutput:
+		output = p.simulationToJSON(v)
 	case *NotifListOutput:
 		output = p.notifListToJSON(v)
 	case *NotifDescribeOutput:
@@ -79,7 +86,7 @@ func (p *JSONPrinter) planToJSON(plan hibernatorv1alpha1.HibernatePlan) PlanJSON
 		},
 		Behavior: PlanBehaviorJSON{
 			Mode:    string(plan.Spec.Behavior.Mode),
-			Retries: ptr.Deref(plan.Spec.Behavior.Retries, 3),
+			Retries: recovery.PolicyFor(plan.Spec.Behavior).MaxRetries,
 		},
 		Execution: PlanExecutionJSON{
 			StrategyType:   string(plan.Spec.Execution.Strategy.Type),
@@ -108,7 +115,7 @@ func (p *JSONPrinter) planToJSON(plan hibernatorv1alpha1.HibernatePlan) PlanJSON
 		target := PlanTargetJSON{
 			Name:         t.Name,
 			Type:         string(t.Type),
-			ConnectorRef: fmt.Sprintf("%s/%s", t.ConnectorRef.Kind, t.ConnectorRef.Name),
+			ConnectorRef: formatConnectorRef(t.ConnectorRef),
 		}
 		if t.Parameters != nil && len(t.Parameters.Raw) > 0 {
 			var params map[string]interface{}
@@ -172,6 +179,7 @@ func (p *JSONPrinter) buildStatusJSON(plan hibernatorv1alpha1.HibernatePlan) Pla
 		CurrentOperation: string(plan.Status.CurrentOperation),
 		ErrorMessage:     plan.Status.ErrorMessage,
 		RetryCount:       plan.Status.RetryCount,
+		OperationPaused:  isOperationPaused(plan),
 	}
 
 	if plan.Spec.Suspend && plan.Annotations != nil {
@@ -193,6 +201,7 @@ func (p *JSONPrinter) buildStatusJSON(plan hibernatorv1alpha1.HibernatePlan) Pla
 			State:    string(exec.State),
 			Attempts: exec.Attempts,
 			Message:  exec.Message,
+			JobRef:   exec.JobRef,
 		}
 		if exec.StartedAt != nil {
 			e.StartedAt = formatUnixTime(exec.StartedAt.Time)
@@ -200,6 +209,14 @@ func (p *JSONPrinter) buildStatusJSON(plan hibernatorv1alpha1.HibernatePlan) Pla
 		if exec.FinishedAt != nil {
 			e.FinishedAt = formatUnixTime(exec.FinishedAt.Time)
 		}
+		if exec.Progress != nil {
+			e.Progress = &ExecutionProgressJSON{
+				Completed: exec.Progress.Completed,
+				Total:     exec.Progress.Total,
+				Resource:  exec.Progress.Resource,
+				Message:   exec.Progress.Message,
+			}
+		}
 		status.Executions = append(status.Executions, e)
 	}
 
@@ -304,6 +321,14 @@ func (p *JSONPrinter) scheduleToJSON(out *ScheduleOutput) (ScheduleJSON, error)
 		}
 	}
 
+	if out.Range != nil {
+		result.Range = &ScheduleRangeJSON{
+			From:  formatUnixTime(out.Range.From),
+			Until: formatUnixTime(out.Range.Until),
+		}
+		result.Transitions = out.Transitions
+	}
+
 	for _, exc := range out.Exceptions {
 		ref := ExceptionReferenceJSON{
 			Name:       exc.Name,
@@ -322,7 +347,17 @@ func (p *JSONPrinter) scheduleToJSON(out *ScheduleOutput) (ScheduleJSON, error)
 }
 
 func (p *JSONPrinter) statusToJSON(out *StatusOutput) PlanStatusJSON {
-	return p.buildStatusJSON(out.Plan)
+	status := p.buildStatusJSON(out.Plan)
+	if out.NextHibernate != nil {
+		status.NextHibernate = formatUnixTime(*out.NextHibernate)
+	}
+	if out.NextWakeUp != nil {
+		status.NextWakeUp = formatUnixTime(*out.NextWakeUp)
+	}
+	if out.HistoryLimit > 0 && len(status.ExecutionHistory) > out.HistoryLimit {
+		status.ExecutionHistory = status.ExecutionHistory[len(status.ExecutionHistory)-out.HistoryLimit:]
+	}
+	return status
 }
 
 func (p *JSONPrinter) restoreDetailToJSON(out *RestoreDetailOutput) RestoreDetailJSON {
@@ -389,6 +424,58 @@ func (p *JSONPrinter) restoreResourcesToJSON(out *RestoreResourcesOutput) Restor
 	return result
 }
 
+func (p *JSONPrinter) restoreHistoryToJSON(out *RestoreHistoryOutput) RestoreHistoryJSON {
+	result := RestoreHistoryJSON{
+		Plan:      out.Plan,
+		Namespace: out.Namespace,
+		Target:    out.Target,
+		Snapshots: []RestoreSnapshotJSON{},
+	}
+
+	for _, s := range out.Snapshots {
+		var capturedAtUnix int64
+		if s.Data.CapturedAt != nil {
+			capturedAtUnix = formatUnixTime(s.Data.CapturedAt.Time)
+		}
+		result.Snapshots = append(result.Snapshots, RestoreSnapshotJSON{
+			Version:       s.Version,
+			ArchivedAt:    formatUnixTime(s.ArchivedAt.Time),
+			CapturedAt:    capturedAtUnix,
+			Executor:      s.Data.Executor,
+			ResourceCount: len(s.Data.State),
+		})
+	}
+
+	return result
+}
+
+func (p *JSONPrinter) simulationToJSON(out *SimulationOutput) SimulationJSON {
+	result := SimulationJSON{
+		Plan:                       out.Plan.Name,
+		Timezone:                   out.Plan.Spec.Schedule.Timezone,
+		Query:                      out.Query,
+		Start:                      formatUnixTime(out.Start),
+		End:                        formatUnixTime(out.End),
+		Step:                       out.Step.String(),
+		Threshold:                  out.Threshold,
+		ObservedHours:              out.Result.Observed.Hours(),
+		ActiveHours:                out.Result.Active.Hours(),
+		HibernatedHours:            out.Result.Hibernated.Hours(),
+		ActiveWhileHibernatedHours: out.Result.ActiveWhileHibernated.Hours(),
+		Conflicts:                  []SimulationConflictJSON{},
+	}
+
+	for _, c := range out.Result.Conflicts {
+		result.Conflicts = append(result.Conflicts, SimulationConflictJSON{
+			Start: formatUnixTime(c.Start),
+			End:   formatUnixTime(c.End),
+			Peak:  c.Peak,
+		})
+	}
+
+	return result
+}
+
 func (p *JSONPrinter) notifListToJSON(out *NotifListOutput) NotifListJSON {
 	result := NotifListJSON{
 		Items: make([]NotifListItemJSON, len(out.Items)),
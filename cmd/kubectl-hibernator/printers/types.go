@@ -6,8 +6,11 @@ Licensed under the Apache License, Version 2.0.
 package printers
 
 import (
+	"time"
+
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/cmd/kubectl-hibernator/common"
+	"github.com/ardikabs/hibernator/internal/restore"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -17,6 +20,18 @@ type ScheduleOutput struct {
 	Result     interface{} // EvaluationResult
 	Exceptions []hibernatorv1alpha1.ExceptionReference
 	Events     []common.ScheduleEvent
+
+	// Range is set when simulating the schedule over a time range. Result
+	// is then the state at Range.From, and Transitions lists every
+	// hibernate/wakeup transition within the range instead of Events.
+	Range       *ScheduleRange
+	Transitions []common.ScheduleEvent
+}
+
+// ScheduleRange is the time range a schedule is simulated over.
+type ScheduleRange struct {
+	From  time.Time
+	Until time.Time
 }
 
 // PlanListItem represents a single plan with computed next event
@@ -33,6 +48,20 @@ type PlanListOutput struct {
 // StatusOutput is a wrapper for printing plan status
 type StatusOutput struct {
 	Plan hibernatorv1alpha1.HibernatePlan
+
+	// NextHibernate and NextWakeUp are the next transitions computed by the
+	// schedule evaluator; nil when unknown.
+	NextHibernate *time.Time
+	NextWakeUp    *time.Time
+
+	// HistoryLimit is the number of recent cycles to list. Zero shows only a
+	// summary of the last completed cycle.
+	HistoryLimit int
+}
+
+// DescribeOutput is a wrapper for printing full plan details with live status
+type DescribeOutput struct {
+	StatusOutput
 }
 
 // RestoreDetailOutput is a wrapper for printing restore resource details
@@ -50,6 +79,72 @@ type RestoreResourcesOutput struct {
 	Target    string
 }
 
+// RestoreHistoryOutput is a wrapper for listing archived restore snapshots of a target
+type RestoreHistoryOutput struct {
+	Plan      string
+	Namespace string
+	Target    string
+	Snapshots []restore.Snapshot
+}
+
+// SimulationOutput is a wrapper for printing schedule simulation results
+type SimulationOutput struct {
+	Plan      hibernatorv1alpha1.HibernatePlan
+	Query     string
+	Start     time.Time
+	End       time.Time
+	Step      time.Duration
+	Threshold float64
+	Result    *common.SimulationResult
+}
+
+// ValidationOutput is a wrapper for printing offline plan validation results.
+type ValidationOutput struct {
+	Results []ValidationResult `json:"results"`
+}
+
+// ValidationResult is the outcome of validating one HibernatePlan document.
+type ValidationResult struct {
+	// Source is the file the plan was read from, with the document index for
+	// multi-document files (e.g. "plans.yaml#2").
+	Source   string   `json:"source"`
+	Name     string   `json:"name,omitempty"`
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// StateBackupOutput is a wrapper for printing the result of backup-state.
+type StateBackupOutput struct {
+	File              string           `json:"file"`
+	Namespace         string           `json:"namespace,omitempty"`
+	ControllerVersion string           `json:"controllerVersion,omitempty"`
+	Counts            []StateKindCount `json:"counts"`
+}
+
+// StateKindCount is the number of objects of one kind in a state archive.
+type StateKindCount struct {
+	Kind  string `json:"kind"`
+	Count int    `json:"count"`
+}
+
+// StateRestoreOutput is a wrapper for printing the result of restore-state.
+type StateRestoreOutput struct {
+	File    string               `json:"file"`
+	DryRun  bool                 `json:"dryRun,omitempty"`
+	Results []StateRestoreResult `json:"results"`
+}
+
+// StateRestoreResult is what restore-state did, or would do, with one object.
+type StateRestoreResult struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Action is one of "create", "update", "skip" or "fail".
+	Action string `json:"action"`
+	Error  string `json:"error,omitempty"`
+}
+
 // PlanListItemJSON represents a single plan in the list output.
 type PlanListItemJSON struct {
 	Name      string                `json:"name"`
@@ -74,6 +169,14 @@ type ScheduleJSON struct {
 	State      ScheduleStateJSON        `json:"currentState"`
 	Events     []common.ScheduleEvent   `json:"upcomingEvents"`
 	Exceptions []ExceptionReferenceJSON `json:"exceptionReferences,omitempty"`
+
+	Range       *ScheduleRangeJSON     `json:"range,omitempty"`
+	Transitions []common.ScheduleEvent `json:"transitions,omitempty"`
+}
+
+type ScheduleRangeJSON struct {
+	From  int64 `json:"from"`
+	Until int64 `json:"until"`
 }
 
 type ScheduleStateJSON struct {
@@ -136,9 +239,12 @@ type PlanStatusJSON struct {
 	SuspendReason       string                   `json:"suspendReason,omitempty"`
 	CurrentCycleID      string                   `json:"currentCycleId,omitempty"`
 	CurrentOperation    string                   `json:"currentOperation,omitempty"`
+	OperationPaused     bool                     `json:"operationPaused,omitempty"`
 	ErrorMessage        string                   `json:"errorMessage,omitempty"`
 	RetryCount          int32                    `json:"retryCount,omitempty"`
 	LastRetryTime       int64                    `json:"lastRetryTime,omitempty"`
+	NextHibernate       int64                    `json:"nextHibernate,omitempty"`
+	NextWakeUp          int64                    `json:"nextWakeUp,omitempty"`
 	Executions          []ExecutionStatusJSON    `json:"executions,omitempty"`
 	ExecutionHistory    []ExecutionCycleJSON     `json:"executionHistory,omitempty"`
 	ExceptionReferences []ExceptionReferenceJSON `json:"exceptionReferences,omitempty"`
@@ -149,8 +255,18 @@ type ExecutionStatusJSON struct {
 	State      string `json:"state"`
 	Attempts   int32  `json:"attempts,omitempty"`
 	Message    string `json:"message,omitempty"`
+	JobRef     string `json:"jobRef,omitempty"`
 	StartedAt  int64  `json:"startedAt,omitempty"`
 	FinishedAt int64  `json:"finishedAt,omitempty"`
+
+	Progress *ExecutionProgressJSON `json:"progress,omitempty"`
+}
+
+type ExecutionProgressJSON struct {
+	Completed int32  `json:"completed"`
+	Total     int32  `json:"total"`
+	Resource  string `json:"resource,omitempty"`
+	Message   string `json:"message,omitempty"`
 }
 
 // RestoreDetailJSON represents the JSON output for restore detail command.
@@ -182,6 +298,44 @@ type RestoreResourceJSON struct {
 	State      map[string]any `json:"state,omitempty"`
 }
 
+type RestoreHistoryJSON struct {
+	Plan      string                `json:"plan"`
+	Namespace string                `json:"namespace"`
+	Target    string                `json:"target"`
+	Snapshots []RestoreSnapshotJSON `json:"snapshots"`
+}
+
+type RestoreSnapshotJSON struct {
+	Version       int64  `json:"version"`
+	ArchivedAt    int64  `json:"archivedAt"`
+	CapturedAt    int64  `json:"capturedAt,omitempty"`
+	Executor      string `json:"executor"`
+	ResourceCount int    `json:"resourceCount"`
+}
+
+// SimulationJSON represents the result of replaying activity against a schedule.
+// Durations are expressed in hours.
+type SimulationJSON struct {
+	Plan                       string                   `json:"plan"`
+	Timezone                   string                   `json:"timezone"`
+	Query                      string                   `json:"query"`
+	Start                      int64                    `json:"start"`
+	End                        int64                    `json:"end"`
+	Step                       string                   `json:"step"`
+	Threshold                  float64                  `json:"threshold"`
+	ObservedHours              float64                  `json:"observedHours"`
+	ActiveHours                float64                  `json:"activeHours"`
+	HibernatedHours            float64                  `json:"hibernatedHours"`
+	ActiveWhileHibernatedHours float64                  `json:"activeWhileHibernatedHours"`
+	Conflicts                  []SimulationConflictJSON `json:"conflicts"`
+}
+
+type SimulationConflictJSON struct {
+	Start int64   `json:"start"`
+	End   int64   `json:"end"`
+	Peak  float64 `json:"peak"`
+}
+
 type ExceptionReferenceJSON struct {
 	Name       string `json:"name"`
 	Type       string `json:"type"`
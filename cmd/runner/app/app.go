@@ -10,13 +10,19 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/tracing"
 	"github.com/ardikabs/hibernator/internal/version"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 )
@@ -24,23 +30,33 @@ import (
 // Config holds runner configuration.
 type Config struct {
 	Timeout              time.Duration // Overall execution timeout
-	Operation            string        // "shutdown" or "wakeup"
+	Operation            string        // "shutdown", "wakeup", "prewake", "reassert" or "preview"
 	Target               string        // Target name
 	TargetType           string        // Executor type (e.g., "eks", "rds", "ec2")
 	Plan                 string        // HibernatePlan name
 	Namespace            string        // HibernatePlan namespace
 	ExecutionID          string        // Unique execution identifier
+	JobName              string        // Name of the runner Job
+	JobUID               string        // UID of the runner Job
 	CycleID              string        // Current execution cycle ID for intent tracking
 	TargetParams         string        // JSON-encoded target parameters
+	PreWakeParams        string        // JSON-encoded pre-wake hook parameters
 	ConnectorKind        string        // Connector kind (CloudProvider, K8SCluster)
 	ConnectorName        string        // Connector name
 	ConnectorNamespace   string        // Connector namespace
+	RestoreStorage       string        // JSON-encoded restore storage spec (empty = ConfigMap)
 	TokenPath            string        // Path to the stream token
 	ControlPlaneEndpoint string        // Legacy streaming endpoint
 	GRPCEndpoint         string        // gRPC streaming endpoint
 	WebSocketEndpoint    string        // WebSocket streaming endpoint
 	HTTPCallbackEndpoint string        // HTTP callback endpoint (fallback)
 	UseTLS               bool          // Enable TLS for gRPC connections
+	TLSDir               string        // Client certificate and CA bundle for mutual TLS streaming
+	StreamSpillDir       string        // Directory for streaming events not yet delivered
+	TracingEndpoint      string        // OTLP gRPC collector spans are exported to (empty = disabled)
+	TracingInsecure      bool          // Export spans without TLS
+	Traceparent          string        // W3C trace context of the span that dispatched the Job
+	MaxResources         int           // Resources a shutdown may act on (0 = unlimited)
 }
 
 // ParseFlags parses command-line flags and environment variables.
@@ -50,11 +66,12 @@ func ParseFlags() *Config {
 
 	flag.BoolVar(&showVersion, "version", false, "Print version and exit.")
 	flag.DurationVar(&cfg.Timeout, "timeout", time.Hour, "Overall execution timeout, default 1h")
-	flag.StringVar(&cfg.Operation, "operation", "", "Operation: shutdown or wakeup")
+	flag.StringVar(&cfg.Operation, "operation", "", "Operation: shutdown, wakeup, prewake, reassert or preview")
 	flag.StringVar(&cfg.Target, "target", "", "Target name")
 	flag.StringVar(&cfg.TargetType, "target-type", "", "Target type (executor type)")
 	flag.StringVar(&cfg.Plan, "plan", "", "HibernatePlan name")
 	flag.StringVar(&cfg.TokenPath, "token-path", "/var/run/secrets/stream/token", "Path to stream token")
+	flag.StringVar(&cfg.StreamSpillDir, "stream-spill-dir", os.TempDir(), "Directory for streaming events not yet delivered")
 	flag.Parse()
 
 	// Check if version flag is set
@@ -66,15 +83,24 @@ func ParseFlags() *Config {
 	// Environment variable overrides
 	envMappings := map[string]*string{
 		"HIBERNATOR_EXECUTION_ID":           &cfg.ExecutionID,
+		"HIBERNATOR_JOB_NAME":               &cfg.JobName,
+		"HIBERNATOR_JOB_UID":                &cfg.JobUID,
 		"HIBERNATOR_CYCLE_ID":               &cfg.CycleID,
 		"HIBERNATOR_CONTROL_PLANE_ENDPOINT": &cfg.ControlPlaneEndpoint,
 		"HIBERNATOR_GRPC_ENDPOINT":          &cfg.GRPCEndpoint,
 		"HIBERNATOR_WEBSOCKET_ENDPOINT":     &cfg.WebSocketEndpoint,
 		"HIBERNATOR_HTTP_CALLBACK_ENDPOINT": &cfg.HTTPCallbackEndpoint,
 		"HIBERNATOR_TARGET_PARAMS":          &cfg.TargetParams,
+		"HIBERNATOR_PREWAKE_PARAMS":         &cfg.PreWakeParams,
 		"HIBERNATOR_CONNECTOR_KIND":         &cfg.ConnectorKind,
 		"HIBERNATOR_CONNECTOR_NAME":         &cfg.ConnectorName,
 		"HIBERNATOR_CONNECTOR_NAMESPACE":    &cfg.ConnectorNamespace,
+		"HIBERNATOR_RESTORE_STORAGE":        &cfg.RestoreStorage,
+		"HIBERNATOR_TOKEN_PATH":             &cfg.TokenPath,
+		"HIBERNATOR_TLS_DIR":                &cfg.TLSDir,
+		"HIBERNATOR_STREAM_SPILL_DIR":       &cfg.StreamSpillDir,
+		tracing.EnvEndpoint:                 &cfg.TracingEndpoint,
+		tracing.EnvTraceparent:              &cfg.Traceparent,
 		"POD_NAMESPACE":                     &cfg.Namespace,
 	}
 	for envKey, target := range envMappings {
@@ -84,6 +110,10 @@ func ParseFlags() *Config {
 	}
 
 	cfg.UseTLS = os.Getenv("HIBERNATOR_USE_TLS") == "true"
+	cfg.TracingInsecure = os.Getenv(tracing.EnvInsecure) == "true"
+	if v, err := strconv.Atoi(os.Getenv("HIBERNATOR_MAX_RESOURCES")); err == nil {
+		cfg.MaxResources = v
+	}
 
 	return cfg
 }
@@ -102,6 +132,40 @@ func Run(cfg *Config) error {
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
 	defer cancel()
 
+	// SIGTERM (Job deletion, node drain) cancels the execution, so the
+	// restore data gathered so far is flushed and the cancellation reported
+	// within the termination grace period.
+	ctx, stopSignals := signal.NotifyContext(ctx, syscall.SIGTERM, os.Interrupt)
+	defer stopSignals()
+
+	// Tracing is best-effort: the runner proceeds without it.
+	shutdownTracing, tracingErr := tracing.Setup(ctx, "hibernator-runner", tracing.Config{
+		Endpoint:    cfg.TracingEndpoint,
+		Insecure:    cfg.TracingInsecure,
+		SampleRatio: 1,
+	})
+	if tracingErr != nil {
+		log.Error(tracingErr, "failed to set up tracing, continuing without tracing")
+	} else {
+		defer func() {
+			flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(flushCtx); err != nil {
+				log.Error(err, "failed to flush spans")
+			}
+		}()
+	}
+
+	ctx, span := tracing.Tracer().Start(tracing.ContextWithTraceparent(ctx, cfg.Traceparent), "Runner.run",
+		trace.WithAttributes(
+			attribute.String("hibernator.plan", cfg.Namespace+"/"+cfg.Plan),
+			attribute.String("hibernator.target", cfg.Target),
+			attribute.String("hibernator.executor", cfg.TargetType),
+			attribute.String("hibernator.operation", cfg.Operation),
+			attribute.String("hibernator.execution_id", cfg.ExecutionID),
+		))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// Create and run the runner
 	r, err := newRunner(ctx, log, cfg)
 	if err != nil {
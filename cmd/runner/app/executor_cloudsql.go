@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_cloudsql
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/cloudsql"
+)
+
+func init() {
+	registerExecutorFactory("cloudsql", executorRegistration{
+		factory:        func() executor.Executor { return cloudsql.New() },
+		defaultEnabled: false,
+		description:    "GCP Cloud SQL instances (pending API integration)",
+	})
+}
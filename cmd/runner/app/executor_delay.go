@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_delay
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/delay"
+)
+
+func init() {
+	registerExecutorFactory("delay", executorRegistration{
+		factory:        func() executor.Executor { return delay.New() },
+		defaultEnabled: true,
+		description:    "Fixed-delay executor with failure injection for rehearsing plans",
+	})
+}
@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_ec2
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/ec2"
+)
+
+func init() {
+	registerExecutorFactory("ec2", executorRegistration{
+		factory:        func() executor.Executor { return ec2.New() },
+		defaultEnabled: true,
+		description:    "AWS EC2 instances",
+	})
+}
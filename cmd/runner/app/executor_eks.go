@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_eks
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/eks"
+)
+
+func init() {
+	registerExecutorFactory("eks", executorRegistration{
+		factory:        func() executor.Executor { return eks.New() },
+		defaultEnabled: true,
+		description:    "AWS EKS managed node groups",
+	})
+}
@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_gke
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/gke"
+)
+
+func init() {
+	registerExecutorFactory("gke", executorRegistration{
+		factory:        func() executor.Executor { return gke.New() },
+		defaultEnabled: false,
+		description:    "GCP GKE clusters (pending API integration)",
+	})
+}
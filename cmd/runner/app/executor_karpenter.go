@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_karpenter
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/karpenter"
+)
+
+func init() {
+	registerExecutorFactory("karpenter", executorRegistration{
+		factory:        func() executor.Executor { return karpenter.New() },
+		defaultEnabled: true,
+		description:    "Karpenter node pools",
+	})
+}
@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_noop
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/noop"
+)
+
+func init() {
+	registerExecutorFactory("noop", executorRegistration{
+		factory:        func() executor.Executor { return noop.New() },
+		defaultEnabled: true,
+		description:    "No-operation executor for testing without external dependencies",
+	})
+}
@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_rds
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/rds"
+)
+
+func init() {
+	registerExecutorFactory("rds", executorRegistration{
+		factory:        func() executor.Executor { return rds.New() },
+		defaultEnabled: true,
+		description:    "AWS RDS instances and clusters",
+	})
+}
@@ -0,0 +1,21 @@
+//go:build !runner_slim || runner_workloadscaler
+
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package app
+
+import (
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/workloadscaler"
+)
+
+func init() {
+	registerExecutorFactory("workloadscaler", executorRegistration{
+		factory:        func() executor.Executor { return workloadscaler.New() },
+		defaultEnabled: true,
+		description:    "Kubernetes workload scaling via scale subresource",
+	})
+}
@@ -12,14 +12,6 @@ import (
 	"github.com/go-logr/logr"
 
 	"github.com/ardikabs/hibernator/internal/executor"
-	"github.com/ardikabs/hibernator/internal/executor/cloudsql"
-	"github.com/ardikabs/hibernator/internal/executor/ec2"
-	"github.com/ardikabs/hibernator/internal/executor/eks"
-	"github.com/ardikabs/hibernator/internal/executor/gke"
-	"github.com/ardikabs/hibernator/internal/executor/karpenter"
-	"github.com/ardikabs/hibernator/internal/executor/noop"
-	"github.com/ardikabs/hibernator/internal/executor/rds"
-	"github.com/ardikabs/hibernator/internal/executor/workloadscaler"
 )
 
 // ExecutorFactory creates executor instances.
@@ -32,57 +24,30 @@ type executorRegistration struct {
 	description    string
 }
 
+// executorRegistrations holds the executors compiled into this binary. Each
+// executor lives in its own executor_<type>.go file guarded by a build
+// constraint: a default build includes every executor, while a slim build
+// (-tags runner_slim,runner_<type>) includes only the selected ones.
+var executorRegistrations = map[string]executorRegistration{}
+
+// registerExecutorFactory adds an executor to the compiled-in registrations.
+// It is called from init() of the build-tagged executor files.
+func registerExecutorFactory(name string, reg executorRegistration) {
+	executorRegistrations[name] = reg
+}
+
 // executorFactoryRegistry manages all available executor factories.
 type executorFactoryRegistry struct {
 	registrations map[string]executorRegistration
 }
 
-// newExecutorFactoryRegistry creates a registry with all known executors.
+// newExecutorFactoryRegistry creates a registry with all compiled-in executors.
 func newExecutorFactoryRegistry() *executorFactoryRegistry {
-	return &executorFactoryRegistry{
-		registrations: map[string]executorRegistration{
-			"eks": {
-				factory:        func() executor.Executor { return eks.New() },
-				defaultEnabled: true,
-				description:    "AWS EKS managed node groups",
-			},
-			"rds": {
-				factory:        func() executor.Executor { return rds.New() },
-				defaultEnabled: true,
-				description:    "AWS RDS instances and clusters",
-			},
-			"ec2": {
-				factory:        func() executor.Executor { return ec2.New() },
-				defaultEnabled: true,
-				description:    "AWS EC2 instances",
-			},
-			"karpenter": {
-				factory:        func() executor.Executor { return karpenter.New() },
-				defaultEnabled: true,
-				description:    "Karpenter node pools",
-			},
-			"gke": {
-				factory:        func() executor.Executor { return gke.New() },
-				defaultEnabled: false,
-				description:    "GCP GKE clusters (pending API integration)",
-			},
-			"cloudsql": {
-				factory:        func() executor.Executor { return cloudsql.New() },
-				defaultEnabled: false,
-				description:    "GCP Cloud SQL instances (pending API integration)",
-			},
-			"workloadscaler": {
-				factory:        func() executor.Executor { return workloadscaler.New() },
-				defaultEnabled: true,
-				description:    "Kubernetes workload scaling via scale subresource",
-			},
-			"noop": {
-				factory:        func() executor.Executor { return noop.New() },
-				defaultEnabled: true,
-				description:    "No-operation executor for testing without external dependencies",
-			},
-		},
+	registrations := make(map[string]executorRegistration, len(executorRegistrations))
+	for name, reg := range executorRegistrations {
+		registrations[name] = reg
 	}
+	return &executorFactoryRegistry{registrations: registrations}
 }
 
 // resolveEnabledExecutors returns the set of enabled executor types,
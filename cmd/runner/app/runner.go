@@ -8,11 +8,18 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,6 +31,8 @@ import (
 	"github.com/ardikabs/hibernator/cmd/runner/telemetry"
 	"github.com/ardikabs/hibernator/internal/executor"
 	"github.com/ardikabs/hibernator/internal/restore"
+	"github.com/ardikabs/hibernator/internal/tracing"
+	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
 var scheme = runtime.NewScheme()
@@ -41,6 +50,8 @@ type runner struct {
 	telemetryMgr  *telemetry.Manager
 	registry      *executor.Registry
 	configBuilder *metadata.ConfigBuilder
+	client        client.Client
+	checkpoint    *state.Checkpoint
 }
 
 // newRunner creates a new runner instance.
@@ -60,6 +71,8 @@ func newRunner(ctx context.Context, log logr.Logger, cfg *Config) (*runner, erro
 		ExecutionID:          cfg.ExecutionID,
 		TokenPath:            cfg.TokenPath,
 		UseTLS:               cfg.UseTLS,
+		TLSDir:               cfg.TLSDir,
+		SpillDir:             cfg.StreamSpillDir,
 	}
 
 	telemetryMgr, err := telemetry.NewManager(ctx, r.log, telemetryCfg)
@@ -84,8 +97,22 @@ func newRunner(ctx context.Context, log logr.Logger, cfg *Config) (*runner, erro
 	}
 
 	r.configBuilder = metadata.NewConfigBuilder(k8sClient, r.log)
+	r.client = k8sClient
 
-	r.restoreMgr = restore.NewManager(k8sClient, r.log)
+	var restoreOpts []restore.Option
+	if cfg.RestoreStorage != "" {
+		storage := &hibernatorv1alpha1.RestoreStorage{}
+		if err := json.Unmarshal([]byte(cfg.RestoreStorage), storage); err != nil {
+			return nil, fmt.Errorf("parse restore storage: %w", err)
+		}
+		backend, err := restore.NewBackend(ctx, k8sClient, cfg.Namespace, storage)
+		if err != nil {
+			r.log.Error(err, "failed to initialize restore storage backend")
+			return nil, fmt.Errorf("init restore storage: %w", err)
+		}
+		restoreOpts = append(restoreOpts, restore.WithBackend(backend))
+	}
+	r.restoreMgr = restore.NewManager(k8sClient, r.log, restoreOpts...)
 
 	// Register executors
 	factory := newExecutorFactoryRegistry()
@@ -120,7 +147,7 @@ func (r *runner) run(ctx context.Context) (*executor.Result, error) {
 
 	// Start heartbeat if streaming is available
 	if r.telemetryMgr != nil {
-		r.telemetryMgr.StartHeartbeat(30 * time.Second)
+		r.telemetryMgr.StartHeartbeat(wellknown.RunnerHeartbeatInterval)
 	}
 
 	// Report progress: initializing
@@ -151,7 +178,9 @@ func (r *runner) run(ctx context.Context) (*executor.Result, error) {
 	}
 
 	// Build executor spec from connector
-	spec, flusher, err := r.buildExecutorSpec(ctx, params)
+	specCtx, span := tracing.Tracer().Start(ctx, "Runner.buildExecutorSpec")
+	spec, flusher, err := r.buildExecutorSpec(specCtx, params)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		r.log.Error(err, "failed to build executor spec")
 		return nil, fmt.Errorf("build executor spec: %w", err)
@@ -174,13 +203,24 @@ func (r *runner) run(ctx context.Context) (*executor.Result, error) {
 	// Execute the operation
 	result, err := r.executeOperation(ctx, exec, spec, flusher)
 
-	// Operation failure: report and return
+	// Cancelled by a termination signal: the restore data gathered so far was
+	// flushed, so a retried runner resumes from it.
+	if err != nil && isCancelled(ctx) {
+		err = fmt.Errorf("execution cancelled: %w", err)
+		r.log.Info("execution cancelled by termination signal", "error", err.Error())
+		if r.telemetryMgr != nil {
+			r.telemetryMgr.ReportCancellation(ctx, err.Error(), result.ElapsedMs)
+		}
+		return nil, err
+	}
+
+	// Operation failure: report with its class and return
 	if err != nil {
 		if cfg.Operation == "shutdown" {
 			r.log.Error(err, "shutdown failed")
 		}
 		if r.telemetryMgr != nil {
-			r.telemetryMgr.ReportCompletion(ctx, false, err.Error(), result.ElapsedMs)
+			r.telemetryMgr.ReportFailure(ctx, string(executor.ReasonOf(err)), err.Error(), result.ElapsedMs)
 		}
 		return nil, err
 	}
@@ -212,7 +252,46 @@ func (r *runner) run(ctx context.Context) (*executor.Result, error) {
 	return result, nil
 }
 
-// executeOperation runs the shutdown or wakeup operation.
+// reportResourceProgress returns a callback streaming the per-resource
+// progress of the executor. It spreads the progress percentage over the
+// executing phase, between its start at 50% and finalizing at 90%.
+func (r *runner) reportResourceProgress(ctx context.Context) executor.ReportProgressCallback {
+	return func(progress executor.ResourceProgress) {
+		percent := int32(50)
+		if progress.Total > 0 {
+			percent += int32(40 * min(progress.Completed, progress.Total) / progress.Total)
+		}
+		r.telemetryMgr.ReportResourceProgress(ctx, "executing", percent, progress.Message,
+			int32(progress.Completed), int32(progress.Total), progress.Resource)
+	}
+}
+
+// isCancelled reports whether ctx was cancelled by a termination signal rather
+// than by the execution timeout.
+func isCancelled(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.Canceled)
+}
+
+// maxPreviewResources bounds the resources listed in the result message of a
+// preview, which ends up in the size-limited termination log. The runner logs
+// every resource.
+const maxPreviewResources = 50
+
+// formatPreviewMessage summarizes the resources a preview selected.
+func formatPreviewMessage(resources []string) string {
+	if len(resources) == 0 {
+		return "selected 0 resource(s)"
+	}
+
+	listed := resources[:min(len(resources), maxPreviewResources)]
+	msg := fmt.Sprintf("selected %d resource(s): %s", len(resources), strings.Join(listed, ", "))
+	if more := len(resources) - len(listed); more > 0 {
+		msg += fmt.Sprintf(" and %d more", more)
+	}
+	return msg
+}
+
+// executeOperation runs the shutdown, wakeup, prewake, reassert or preview operation.
 // For shutdown operations, returns a flush function to save accumulated restore data.
 // Returns the executor Result (always non-nil) for the caller to inspect.
 // On error the Result still carries ElapsedMs so callers can report timing.
@@ -222,12 +301,24 @@ func (r *runner) executeOperation(ctx context.Context, exec executor.Executor, s
 	var operationErr error
 	var executorResult *executor.Result
 
+	ctx, span := tracing.Tracer().Start(ctx, "Executor."+r.cfg.Operation,
+		trace.WithAttributes(attribute.String("hibernator.executor", exec.Type())))
+	defer func() { tracing.EndSpan(span, operationErr) }()
+
+	// Settle the checkpoint once the restore data is flushed (deferred calls
+	// run in reverse order).
+	restoreFlushed := true
+	if r.checkpoint != nil {
+		defer func() { r.settleCheckpoint(ctx, operationErr == nil, restoreFlushed) }()
+	}
+
 	switch r.cfg.Operation {
 	case "shutdown":
 		// Defer flush to ensure accumulated restore data is saved even on error
 		if flushFunc != nil {
 			defer func() {
 				if flushErr := flushFunc(); flushErr != nil {
+					restoreFlushed = false
 					r.log.Error(flushErr, "failed to flush accumulated restore data")
 					// If shutdown succeeded but flush failed, prioritize flush error
 					if operationErr == nil {
@@ -254,12 +345,92 @@ func (r *runner) executeOperation(ctx context.Context, exec executor.Executor, s
 			r.log.Info("warning: restore data is from non-live source; restore point may be outdated.")
 		}
 
+		// Fail fast on malformed restore data before any resource is touched.
+		if rd.Type != "" && rd.Type != exec.Type() {
+			operationErr = fmt.Errorf("%w: captured by executor %q, not %q", executor.ErrInvalidRestoreData, rd.Type, exec.Type())
+			break
+		}
+		if err := exec.ValidateRestore(*rd); err != nil {
+			operationErr = fmt.Errorf("validate restore data: %w", err)
+			break
+		}
+
 		result, err := exec.WakeUp(ctx, r.log, *spec, *rd)
 		if err != nil {
 			operationErr = err
 		} else {
 			executorResult = result
 		}
+	case "prewake":
+		preWaker, ok := exec.(executor.PreWaker)
+		if !ok {
+			operationErr = fmt.Errorf("executor %q does not support pre-wake hooks", exec.Type())
+			break
+		}
+
+		rd, err := state.LoadRestoreData(ctx, r.restoreMgr, r.log, r.cfg.Namespace, r.cfg.Plan, r.cfg.Target)
+		if err != nil {
+			operationErr = fmt.Errorf("load restore data: %w", err)
+			break
+		}
+		if rd.Type != "" && rd.Type != exec.Type() {
+			operationErr = fmt.Errorf("%w: captured by executor %q, not %q", executor.ErrInvalidRestoreData, rd.Type, exec.Type())
+			break
+		}
+		if err := exec.ValidateRestore(*rd); err != nil {
+			operationErr = fmt.Errorf("validate restore data: %w", err)
+			break
+		}
+
+		result, err := preWaker.PreWake(ctx, r.log, *spec, *rd)
+		if err != nil {
+			operationErr = err
+		} else {
+			executorResult = result
+		}
+	case "reassert":
+		reasserter, ok := exec.(executor.Reasserter)
+		if !ok {
+			operationErr = fmt.Errorf("executor %q does not support reassertion", exec.Type())
+			break
+		}
+
+		rd, err := state.LoadRestoreData(ctx, r.restoreMgr, r.log, r.cfg.Namespace, r.cfg.Plan, r.cfg.Target)
+		if err != nil {
+			operationErr = fmt.Errorf("load restore data: %w", err)
+			break
+		}
+		if rd.Type != "" && rd.Type != exec.Type() {
+			operationErr = fmt.Errorf("%w: captured by executor %q, not %q", executor.ErrInvalidRestoreData, rd.Type, exec.Type())
+			break
+		}
+		if err := exec.ValidateRestore(*rd); err != nil {
+			operationErr = fmt.Errorf("validate restore data: %w", err)
+			break
+		}
+
+		result, err := reasserter.Reassert(ctx, r.log, *spec, *rd)
+		if err != nil {
+			operationErr = err
+		} else {
+			executorResult = result
+		}
+	case "preview":
+		previewer, ok := exec.(executor.Previewer)
+		if !ok {
+			operationErr = fmt.Errorf("executor %q does not support preview", exec.Type())
+			break
+		}
+
+		resources, err := previewer.Preview(ctx, r.log, *spec)
+		if err != nil {
+			operationErr = err
+			break
+		}
+		for _, resource := range resources {
+			r.log.Info("resource selected", "resource", resource)
+		}
+		executorResult = &executor.Result{Message: formatPreviewMessage(resources)}
 	default:
 		operationErr = fmt.Errorf("unknown operation: %s", r.cfg.Operation)
 	}
@@ -282,13 +453,62 @@ func (r *runner) executeOperation(ctx context.Context, exec executor.Executor, s
 	return executorResult, nil
 }
 
+// settleCheckpoint deletes the checkpoint of a successful execution, and saves
+// it for the Job to retry a failed one. A checkpoint is not saved when the
+// restore data of its resources could not be, so the retry processes them
+// again. Checkpoint errors are non-fatal: a retry without one starts over.
+func (r *runner) settleCheckpoint(ctx context.Context, succeeded, restoreFlushed bool) {
+	switch {
+	case succeeded:
+		if err := r.checkpoint.Delete(ctx); err != nil {
+			r.log.Error(err, "failed to delete checkpoint (non-fatal)")
+		}
+	case !restoreFlushed:
+		r.log.Info("not saving checkpoint, restore data was not flushed")
+	default:
+		if err := r.checkpoint.Save(ctx); err != nil {
+			r.log.Error(err, "failed to save checkpoint (non-fatal)")
+		}
+	}
+}
+
+// loadCheckpoint loads the checkpoint of shutdown and wakeup executions, so
+// executors skip the resources earlier attempts processed. It returns nil when
+// the checkpoint cannot be loaded.
+func (r *runner) loadCheckpoint(ctx context.Context) *state.Checkpoint {
+	if r.client == nil || r.cfg.ExecutionID == "" || (r.cfg.Operation != "shutdown" && r.cfg.Operation != "wakeup") {
+		return nil
+	}
+
+	var owner *metav1.OwnerReference
+	if r.cfg.JobName != "" && r.cfg.JobUID != "" {
+		owner = &metav1.OwnerReference{
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+			Kind:       "Job",
+			Name:       r.cfg.JobName,
+			UID:        types.UID(r.cfg.JobUID),
+		}
+	}
+
+	checkpoint, err := state.LoadCheckpoint(ctx, r.client, r.log, r.cfg.Namespace, r.cfg.Plan, r.cfg.ExecutionID, owner)
+	if err != nil {
+		r.log.Error(err, "failed to load checkpoint, processing all resources (non-fatal)")
+		return nil
+	}
+	return checkpoint
+}
+
 // buildExecutorSpec constructs the executor spec from connector configuration.
 func (r *runner) buildExecutorSpec(ctx context.Context, params map[string]any) (*executor.Spec, func() error, error) {
 	paramsBytes, _ := json.Marshal(params)
 	spec := &executor.Spec{
-		TargetName: r.cfg.Target,
-		TargetType: r.cfg.TargetType,
-		Parameters: paramsBytes,
+		TargetName:   r.cfg.Target,
+		TargetType:   r.cfg.TargetType,
+		Parameters:   paramsBytes,
+		MaxResources: r.cfg.MaxResources,
+	}
+	if r.cfg.Operation == "prewake" && r.cfg.PreWakeParams != "" {
+		spec.PreWakeParameters = json.RawMessage(r.cfg.PreWakeParams)
 	}
 
 	// Add incremental save callback for shutdown operations
@@ -299,6 +519,14 @@ func (r *runner) buildExecutorSpec(ctx context.Context, params map[string]any) (
 		flusher = flush
 	}
 
+	if r.checkpoint = r.loadCheckpoint(ctx); r.checkpoint != nil {
+		spec.Checkpoint = r.checkpoint
+	}
+
+	if r.telemetryMgr != nil {
+		spec.ReportProgressCallback = r.reportResourceProgress(ctx)
+	}
+
 	if r.cfg.ConnectorKind == "CloudProvider" || r.cfg.ConnectorKind == "K8SCluster" {
 		connectorCfg, err := r.configBuilder.BuildConnectorConfig(ctx, r.cfg.ConnectorKind, r.cfg.ConnectorNamespace, r.cfg.ConnectorName)
 		if err != nil {
@@ -20,16 +20,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/ardikabs/hibernator/cmd/runner/state"
 	"github.com/ardikabs/hibernator/internal/executor"
 	"github.com/ardikabs/hibernator/internal/restore"
 )
@@ -41,16 +44,22 @@ import (
 // fakeExecutor records every call made to it and allows callers to inject
 // pre-canned errors or restore-data emissions.
 type fakeExecutor struct {
-	typeVal     string
-	validateErr error
-	shutdownErr error
-	wakeupErr   error
+	typeVal            string
+	validateErr        error
+	validateRestoreErr error
+	shutdownErr        error
+	wakeupErr          error
 
 	// restoreKeysToEmit: if non-nil, Shutdown will call spec.ReportStateCallback
 	// once per entry, simulating an executor that emits restore state.
 	restoreKeysToEmit map[string]any
 
+	// cancelShutdown: if non-nil, Shutdown calls it after emitting restore
+	// state and fails with the context error, simulating a termination signal.
+	cancelShutdown context.CancelFunc
+
 	// Captured during execution for use in assertions.
+	checkpointed    []string
 	shutdownCalled  bool
 	wakeupCalled    bool
 	receivedRestore executor.RestoreData
@@ -59,15 +68,28 @@ type fakeExecutor struct {
 func (f *fakeExecutor) Type() string                   { return f.typeVal }
 func (f *fakeExecutor) Validate(_ executor.Spec) error { return f.validateErr }
 
-func (f *fakeExecutor) Shutdown(_ context.Context, _ logr.Logger, spec executor.Spec) (*executor.Result, error) {
+func (f *fakeExecutor) ValidateRestore(_ executor.RestoreData) error {
+	return f.validateRestoreErr
+}
+
+func (f *fakeExecutor) Shutdown(ctx context.Context, _ logr.Logger, spec executor.Spec) (*executor.Result, error) {
 	f.shutdownCalled = true
 	if spec.ReportStateCallback != nil {
 		for k, v := range f.restoreKeysToEmit {
+			if spec.Checkpointed(k) {
+				f.checkpointed = append(f.checkpointed, k)
+				continue
+			}
 			if err := spec.ReportStateCallback(k, v); err != nil {
 				return nil, fmt.Errorf("save restore data for %s: %w", k, err)
 			}
+			spec.MarkCheckpoint(k)
 		}
 	}
+	if f.cancelShutdown != nil {
+		f.cancelShutdown()
+		return nil, ctx.Err()
+	}
 	if f.shutdownErr != nil {
 		return nil, f.shutdownErr
 	}
@@ -105,6 +127,7 @@ func newTestRunner(cfg *Config, fakeExec *fakeExecutor, preloaded ...client.Obje
 		log:        logr.Discard(),
 		restoreMgr: restore.NewManager(fc, logr.Discard()),
 		registry:   reg,
+		client:     fc,
 		// streamClient nil  → no streaming, reportProgress/reportCompletion log-only
 	}, fc
 }
@@ -258,6 +281,72 @@ func TestRunner_Shutdown_ExecutorError_ReturnsError(t *testing.T) {
 	assert.True(t, fakeExec.shutdownCalled)
 }
 
+// TestRunner_Shutdown_Cancelled_FlushesRestoreData verifies that when the
+// execution is cancelled by a termination signal, the restore data gathered so
+// far is still persisted so a retried runner resumes from it.
+func TestRunner_Shutdown_Cancelled_FlushesRestoreData(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fakeExec := &fakeExecutor{
+		typeVal: "fake",
+		restoreKeysToEmit: map[string]any{
+			"instance-1": map[string]any{"minSize": 0, "maxSize": 3},
+		},
+		cancelShutdown: cancel,
+	}
+	r, fc := newTestRunner(baseConfig("shutdown", "fake"), fakeExec)
+
+	_, err := r.run(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "execution cancelled")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	rd := readRestoreData(t, fc)
+	assert.Contains(t, rd.State, "instance-1")
+}
+
+// TestRunner_Shutdown_RetryResumesFromCheckpoint verifies that a retried
+// runner skips the resources a failed attempt processed, keeps their restore
+// data, and removes the checkpoint once the execution succeeds.
+func TestRunner_Shutdown_RetryResumesFromCheckpoint(t *testing.T) {
+	failing := &fakeExecutor{
+		typeVal: "fake",
+		restoreKeysToEmit: map[string]any{
+			"instance-1": map[string]any{"minSize": 0, "maxSize": 3},
+		},
+		shutdownErr: fmt.Errorf("throttled"),
+	}
+	r, fc := newTestRunner(baseConfig("shutdown", "fake"), failing)
+	_, err := r.run(context.Background())
+	require.Error(t, err)
+
+	checkpointKey := client.ObjectKey{Namespace: "default", Name: state.CheckpointName("exec-001")}
+	require.NoError(t, fc.Get(context.Background(), checkpointKey, &corev1.ConfigMap{}))
+
+	retried := &fakeExecutor{
+		typeVal: "fake",
+		restoreKeysToEmit: map[string]any{
+			"instance-1": map[string]any{"minSize": 0, "maxSize": 0},
+			"instance-2": map[string]any{"minSize": 0, "maxSize": 5},
+		},
+	}
+	reg := executor.NewRegistry()
+	reg.Register(retried)
+	r.registry = reg
+	_, err = r.run(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"instance-1"}, retried.checkpointed)
+	rd := readRestoreData(t, fc)
+	assert.Equal(t, map[string]any{"minSize": float64(0), "maxSize": float64(3)}, rd.State["instance-1"],
+		"restore data of the failed attempt is kept")
+	assert.Contains(t, rd.State, "instance-2")
+
+	err = fc.Get(context.Background(), checkpointKey, &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err), "checkpoint is removed after success")
+}
+
 // TestRunner_Wakeup_MissingRestoreData_ReturnsError verifies that wakeup fails
 // when no ConfigMap / restore data exists for the target.
 func TestRunner_Wakeup_MissingRestoreData_ReturnsError(t *testing.T) {
@@ -271,6 +360,37 @@ func TestRunner_Wakeup_MissingRestoreData_ReturnsError(t *testing.T) {
 	assert.False(t, fakeExec.wakeupCalled, "WakeUp should not be called when restore data is missing")
 }
 
+// TestRunner_Wakeup_InvalidRestoreData_FailsFast verifies that wakeup fails
+// before WakeUp is called when the executor rejects the restore data.
+func TestRunner_Wakeup_InvalidRestoreData_FailsFast(t *testing.T) {
+	state := map[string]any{
+		"instance-1": map[string]any{"desiredCapacity": "three"},
+	}
+	fakeExec := &fakeExecutor{
+		typeVal:            "fake",
+		validateRestoreErr: fmt.Errorf("%w: instance-1: desiredCapacity must be a number", executor.ErrInvalidRestoreData),
+	}
+	r, _ := newTestRunner(baseConfig("wakeup", "fake"), fakeExec, restoreCM(t, state))
+
+	_, err := r.run(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, executor.ErrInvalidRestoreData)
+	assert.Contains(t, err.Error(), "instance-1")
+	assert.False(t, fakeExec.wakeupCalled, "WakeUp should not be called with invalid restore data")
+}
+
+// TestRunner_Wakeup_ExecutorTypeMismatch_FailsFast verifies that restore data
+// captured by a different executor is rejected before WakeUp is called.
+func TestRunner_Wakeup_ExecutorTypeMismatch_FailsFast(t *testing.T) {
+	fakeExec := &fakeExecutor{typeVal: "other"}
+	r, _ := newTestRunner(baseConfig("wakeup", "other"), fakeExec, restoreCM(t, nil))
+
+	_, err := r.run(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, executor.ErrInvalidRestoreData)
+	assert.False(t, fakeExec.wakeupCalled)
+}
+
 // TestRunner_UnknownExecutorType_ReturnsError verifies that requesting an
 // executor type not in the registry returns a descriptive error immediately.
 func TestRunner_UnknownExecutorType_ReturnsError(t *testing.T) {
@@ -282,3 +402,115 @@ func TestRunner_UnknownExecutorType_ReturnsError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "executor not found")
 }
+
+// fakePreWakeExecutor adds a pre-wake action to fakeExecutor.
+type fakePreWakeExecutor struct {
+	fakeExecutor
+	preWakeCalled   bool
+	preWakeParams   json.RawMessage
+	preWakeRestored executor.RestoreData
+}
+
+func (f *fakePreWakeExecutor) PreWake(_ context.Context, _ logr.Logger, spec executor.Spec, rd executor.RestoreData) (*executor.Result, error) {
+	f.preWakeCalled = true
+	f.preWakeParams = spec.PreWakeParameters
+	f.preWakeRestored = rd
+	return &executor.Result{Message: "fake pre-wake completed"}, nil
+}
+
+// TestRunner_PreWake_PassesRestoreDataAndHookParams verifies that a prewake
+// run hands the restore data and hook parameters to the executor without
+// calling WakeUp.
+func TestRunner_PreWake_PassesRestoreDataAndHookParams(t *testing.T) {
+	state := map[string]any{
+		"instance-1": map[string]any{"desiredCapacity": float64(3)},
+	}
+	fakeExec := &fakePreWakeExecutor{fakeExecutor: fakeExecutor{typeVal: "fake"}}
+	cfg := baseConfig("prewake", "fake")
+	cfg.PreWakeParams = `{"warmNodes":2}`
+
+	reg := executor.NewRegistry()
+	reg.Register(fakeExec)
+	fc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(restoreCM(t, state)).Build()
+	r := &runner{cfg: cfg, log: logr.Discard(), restoreMgr: restore.NewManager(fc, logr.Discard()), registry: reg}
+
+	result, err := r.run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "fake pre-wake completed", result.Message)
+
+	assert.True(t, fakeExec.preWakeCalled)
+	assert.False(t, fakeExec.wakeupCalled)
+	assert.JSONEq(t, `{"warmNodes":2}`, string(fakeExec.preWakeParams))
+	assert.Contains(t, fakeExec.preWakeRestored.Data, "instance-1")
+}
+
+// TestRunner_PreWake_UnsupportedExecutor_ReturnsError verifies that a prewake
+// run fails for executors without a pre-wake action.
+func TestRunner_PreWake_UnsupportedExecutor_ReturnsError(t *testing.T) {
+	fakeExec := &fakeExecutor{typeVal: "fake"}
+	r, _ := newTestRunner(baseConfig("prewake", "fake"), fakeExec, restoreCM(t, nil))
+
+	_, err := r.run(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support pre-wake hooks")
+	assert.False(t, fakeExec.wakeupCalled)
+}
+
+// fakePreviewExecutor adds a preview action to fakeExecutor.
+type fakePreviewExecutor struct {
+	fakeExecutor
+	resources []string
+}
+
+func (f *fakePreviewExecutor) Preview(_ context.Context, _ logr.Logger, _ executor.Spec) ([]string, error) {
+	return f.resources, nil
+}
+
+// TestRunner_Preview_ReportsSelectedResources verifies that a preview run
+// reports the selected resources without shutting them down or writing
+// restore data.
+func TestRunner_Preview_ReportsSelectedResources(t *testing.T) {
+	fakeExec := &fakePreviewExecutor{
+		fakeExecutor: fakeExecutor{typeVal: "fake"},
+		resources:    []string{"instance:db-1", "cluster:cluster-1"},
+	}
+	cfg := baseConfig("preview", "fake")
+
+	reg := executor.NewRegistry()
+	reg.Register(fakeExec)
+	fc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &runner{cfg: cfg, log: logr.Discard(), restoreMgr: restore.NewManager(fc, logr.Discard()), registry: reg, client: fc}
+
+	result, err := r.run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "selected 2 resource(s): instance:db-1, cluster:cluster-1", result.Message)
+	assert.False(t, fakeExec.shutdownCalled)
+
+	var cms corev1.ConfigMapList
+	require.NoError(t, fc.List(context.Background(), &cms))
+	assert.Empty(t, cms.Items)
+}
+
+// TestRunner_Preview_UnsupportedExecutor_ReturnsError verifies that a preview
+// run fails for executors without a preview action.
+func TestRunner_Preview_UnsupportedExecutor_ReturnsError(t *testing.T) {
+	fakeExec := &fakeExecutor{typeVal: "fake"}
+	r, _ := newTestRunner(baseConfig("preview", "fake"), fakeExec)
+
+	_, err := r.run(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support preview")
+	assert.False(t, fakeExec.shutdownCalled)
+}
+
+func TestFormatPreviewMessage(t *testing.T) {
+	assert.Equal(t, "selected 0 resource(s)", formatPreviewMessage(nil))
+
+	resources := make([]string, maxPreviewResources+2)
+	for i := range resources {
+		resources[i] = fmt.Sprintf("i-%d", i)
+	}
+	msg := formatPreviewMessage(resources)
+	assert.True(t, strings.HasPrefix(msg, fmt.Sprintf("selected %d resource(s): i-0, i-1, ", len(resources))))
+	assert.True(t, strings.HasSuffix(msg, fmt.Sprintf("i-%d and 2 more", maxPreviewResources-1)))
+}
@@ -9,16 +9,20 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"path"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/go-logr/logr"
+	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/ardikabs/hibernator/pkg/awsutil"
+	"github.com/ardikabs/hibernator/pkg/netutil"
 )
 
 const (
@@ -26,6 +30,7 @@ const (
 	awsSecretAccessKeyKey = "AWS_SECRET_ACCESS_KEY"
 	awsSessionToken       = "AWS_SESSION_TOKEN"
 	kubeconfigKey         = "kubeconfig"
+	caBundleKey           = "ca.crt"
 )
 
 // ConfigBuilder constructs the executor.ConnectorConfig from Kubernetes resources.
@@ -47,7 +52,19 @@ func (b *ConfigBuilder) BuildConnectorConfig(ctx context.Context, kind, namespac
 	var cfg executor.ConnectorConfig
 	switch kind {
 	case "CloudProvider":
-		awsCfg, err := b.loadCloudProviderConfig(ctx, namespace, name)
+		provider, err := b.getCloudProvider(ctx, namespace, name)
+		if err != nil {
+			return cfg, err
+		}
+		if provider.Spec.Type == hibernatorv1alpha1.CloudProviderGCP {
+			gcpCfg, err := b.buildGCPConnectorConfig(ctx, &provider)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.GCP = gcpCfg
+			return cfg, nil
+		}
+		awsCfg, err := b.buildAWSConnectorConfig(ctx, &provider)
 		if err != nil {
 			return cfg, err
 		}
@@ -69,15 +86,6 @@ func resolveNamespace(defaultNamespace, override string) string {
 	return defaultNamespace
 }
 
-func (b *ConfigBuilder) loadCloudProviderConfig(ctx context.Context, namespace, name string) (*executor.AWSConnectorConfig, error) {
-	provider, err := b.getCloudProvider(ctx, namespace, name)
-	if err != nil {
-		return nil, err
-	}
-
-	return b.buildAWSConnectorConfig(ctx, &provider)
-}
-
 func (b *ConfigBuilder) getCloudProvider(ctx context.Context, namespace, name string) (hibernatorv1alpha1.CloudProvider, error) {
 	var provider hibernatorv1alpha1.CloudProvider
 	key := client.ObjectKey{
@@ -98,15 +106,28 @@ func (b *ConfigBuilder) buildAWSConnectorConfig(ctx context.Context, provider *h
 		return nil, fmt.Errorf("AWS config is required")
 	}
 
+	network, err := b.buildNetworkConfig(ctx, provider.Namespace, provider.Spec.Network)
+	if err != nil {
+		return nil, err
+	}
+
 	awsCfg := &executor.AWSConnectorConfig{
 		Region:    provider.Spec.AWS.Region,
 		AccountID: provider.Spec.AWS.AccountId,
+		Network:   network,
 	}
 
 	// AssumeRoleArn is now at AWS spec level (cross-cutting for both auth methods)
 	if provider.Spec.AWS.AssumeRoleArn != "" {
 		awsCfg.AssumeRoleArn = provider.Spec.AWS.AssumeRoleArn
 	}
+	for _, step := range provider.Spec.AWS.Auth.RoleChain {
+		awsCfg.RoleChain = append(awsCfg.RoleChain, awsutil.AssumeRoleStep{
+			RoleArn:     step.RoleArn,
+			ExternalID:  step.ExternalID,
+			SessionTags: step.SessionTags,
+		})
+	}
 
 	if provider.Spec.AWS.Auth.Static != nil {
 		ref := provider.Spec.AWS.Auth.Static.SecretRef
@@ -134,6 +155,58 @@ func (b *ConfigBuilder) buildAWSConnectorConfig(ctx context.Context, provider *h
 	return awsCfg, nil
 }
 
+// buildGCPConnectorConfig resolves the GCP connector settings. With Workload
+// Identity Federation, the runner exchanges the ServiceAccount token the controller
+// projects into its pod for the pool provider's audience.
+func (b *ConfigBuilder) buildGCPConnectorConfig(ctx context.Context, provider *hibernatorv1alpha1.CloudProvider) (*executor.GCPConnectorConfig, error) {
+	if provider.Spec.GCP == nil {
+		return nil, fmt.Errorf("GCP config is required")
+	}
+	network, err := b.buildNetworkConfig(ctx, provider.Namespace, provider.Spec.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	gcpCfg := &executor.GCPConnectorConfig{
+		ProjectID:          provider.Spec.GCP.ProjectID,
+		ImpersonationChain: provider.Spec.GCP.Auth.ImpersonationChain,
+		Network:            network,
+	}
+	if wif := provider.Spec.GCP.Auth.WorkloadIdentityFederation; wif != nil {
+		gcpCfg.Audience = wif.Audience
+		gcpCfg.SubjectTokenFile = path.Join(wellknown.GCPFederationTokenMountPath, wellknown.GCPFederationTokenFile)
+	}
+	return gcpCfg, nil
+}
+
+// buildNetworkConfig resolves the proxy and CA bundle of a connector. It returns
+// nil when network is unset.
+func (b *ConfigBuilder) buildNetworkConfig(ctx context.Context, namespace string, network *hibernatorv1alpha1.ConnectorNetwork) (*netutil.Config, error) {
+	if network == nil {
+		return nil, nil
+	}
+
+	cfg := &netutil.Config{}
+	if network.Proxy != nil {
+		cfg.ProxyURL = network.Proxy.URL
+		cfg.NoProxy = network.Proxy.NoProxy
+	}
+	if ref := network.CABundleRef; ref != nil {
+		var cm corev1.ConfigMap
+		key := client.ObjectKey{Namespace: resolveNamespace(namespace, ref.Namespace), Name: ref.Name}
+		if err := b.k8sClient.Get(ctx, key, &cm); err != nil {
+			return nil, fmt.Errorf("get CA bundle ConfigMap %s: %w", key, err)
+		}
+		dataKey := lo.CoalesceOrEmpty(ref.Key, caBundleKey)
+		bundle := cm.Data[dataKey]
+		if bundle == "" {
+			return nil, fmt.Errorf("CA bundle ConfigMap %s missing %s key", key, dataKey)
+		}
+		cfg.CABundle = []byte(bundle)
+	}
+	return cfg, nil
+}
+
 func (b *ConfigBuilder) getSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error) {
 	var secret corev1.Secret
 	key := client.ObjectKey{
@@ -160,6 +233,12 @@ func (b *ConfigBuilder) loadK8SClusterConfig(ctx context.Context, namespace, nam
 		return nil, fmt.Errorf("spec.eks and spec.k8s are mutually exclusive")
 	}
 
+	// The cluster's own network settings take precedence over its CloudProvider's.
+	clusterNetwork, err := b.buildNetworkConfig(ctx, cluster.Namespace, cluster.Spec.Network)
+	if err != nil {
+		return nil, err
+	}
+
 	if cluster.Spec.EKS != nil {
 		if cluster.Spec.ProviderRef == nil {
 			return nil, fmt.Errorf("providerRef is required for EKS clusters")
@@ -219,12 +298,13 @@ func (b *ConfigBuilder) loadK8SClusterConfig(ctx context.Context, namespace, nam
 			ClusterCAData:   decodedCA,
 			UseEKSToken:     true,
 			AWS:             awsCfg,
+			Network:         lo.CoalesceOrEmpty(clusterNetwork, awsCfg.Network),
 		}, nil
 	}
 
 	if cluster.Spec.K8S != nil {
 		if cluster.Spec.K8S.InCluster {
-			return &executor.K8SConnectorConfig{}, nil
+			return &executor.K8SConnectorConfig{Network: clusterNetwork}, nil
 		}
 
 		if cluster.Spec.K8S.KubeconfigRef != nil {
@@ -241,6 +321,7 @@ func (b *ConfigBuilder) loadK8SClusterConfig(ctx context.Context, namespace, nam
 
 			return &executor.K8SConnectorConfig{
 				Kubeconfig: kubeconfigBytes,
+				Network:    clusterNetwork,
 			}, nil
 		}
 
@@ -248,10 +329,28 @@ func (b *ConfigBuilder) loadK8SClusterConfig(ctx context.Context, namespace, nam
 	}
 
 	if cluster.Spec.GKE != nil {
-		return &executor.K8SConnectorConfig{
+		k8sCfg := &executor.K8SConnectorConfig{
 			ClusterName: cluster.Spec.GKE.Name,
 			Region:      cluster.Spec.GKE.Location,
-		}, nil
+		}
+		if cluster.Spec.ProviderRef != nil {
+			providerNamespace := resolveNamespace(cluster.Namespace, cluster.Spec.ProviderRef.Namespace)
+			provider, err := b.getCloudProvider(ctx, providerNamespace, cluster.Spec.ProviderRef.Name)
+			if err != nil {
+				return nil, err
+			}
+			if provider.Spec.Type != hibernatorv1alpha1.CloudProviderGCP {
+				return nil, fmt.Errorf("GKE clusters require a gcp CloudProvider, got %s", provider.Spec.Type)
+			}
+			if k8sCfg.GCP, err = b.buildGCPConnectorConfig(ctx, &provider); err != nil {
+				return nil, err
+			}
+		}
+		k8sCfg.Network = clusterNetwork
+		if k8sCfg.Network == nil && k8sCfg.GCP != nil {
+			k8sCfg.Network = k8sCfg.GCP.Network
+		}
+		return k8sCfg, nil
 	}
 
 	return nil, nil
@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/pkg/awsutil"
 )
 
 func schemeForBuilder() *runtime.Scheme {
@@ -200,6 +201,188 @@ func TestBuildConnectorConfig_CloudProvider_AssumeRole(t *testing.T) {
 	assert.Equal(t, "arn:aws:iam::123456789:role/my-role", cfg.AWS.AssumeRoleArn)
 }
 
+func TestBuildConnectorConfig_CloudProvider_RoleChain(t *testing.T) {
+	secret := buildAWSStaticSecret("default", "aws-creds", "AKIA1234567890", "super-secret", "")
+	provider := cloudProviderAwsObj("my-provider", "default", "us-west-2", "123456789", "arn:aws:iam::123456789:role/my-role", &hibernatorv1alpha1.SecretReference{Name: "aws-creds", Namespace: "default"})
+	provider.Spec.AWS.Auth.RoleChain = []hibernatorv1alpha1.AssumeRoleStep{{
+		RoleArn:     "arn:aws:iam::111111111111:role/security-hop",
+		ExternalID:  "hibernator",
+		SessionTags: map[string]string{"team": "platform"},
+	}}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(schemeForBuilder()).
+		WithObjects(secret, provider).
+		Build()
+
+	b := NewConfigBuilder(fakeClient, logr.Discard())
+
+	cfg, err := b.BuildConnectorConfig(context.Background(), "CloudProvider", "default", "my-provider")
+	require.NoError(t, err)
+
+	assert.Equal(t, []awsutil.AssumeRoleStep{{
+		RoleArn:     "arn:aws:iam::111111111111:role/security-hop",
+		ExternalID:  "hibernator",
+		SessionTags: map[string]string{"team": "platform"},
+	}}, cfg.AWS.RoleChain)
+	assert.Equal(t, "arn:aws:iam::123456789:role/my-role", cfg.AWS.AssumeRoleArn)
+}
+
+func cloudProviderGcpWIF(name, namespace string) *hibernatorv1alpha1.CloudProvider {
+	return &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: hibernatorv1alpha1.CloudProviderSpec{
+			Type: hibernatorv1alpha1.CloudProviderGCP,
+			GCP: &hibernatorv1alpha1.GCPConfig{
+				ProjectID: "my-project",
+				Auth: hibernatorv1alpha1.GCPAuth{
+					WorkloadIdentityFederation: &hibernatorv1alpha1.GCPWorkloadIdentityFederation{
+						Audience: "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod",
+					},
+					ImpersonationChain: []string{"hibernator@my-project.iam.gserviceaccount.com"},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildConnectorConfig_CloudProvider_GCPWorkloadIdentityFederation(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(schemeForBuilder()).
+		WithObjects(cloudProviderGcpWIF("gcp", "default")).
+		Build()
+
+	b := NewConfigBuilder(fakeClient, logr.Discard())
+
+	cfg, err := b.BuildConnectorConfig(context.Background(), "CloudProvider", "default", "gcp")
+	require.NoError(t, err)
+
+	assert.Nil(t, cfg.AWS)
+	require.NotNil(t, cfg.GCP)
+	assert.Equal(t, "my-project", cfg.GCP.ProjectID)
+	assert.Equal(t, "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod", cfg.GCP.Audience)
+	assert.Equal(t, "/var/run/secrets/gcp-federation/token", cfg.GCP.SubjectTokenFile)
+	assert.Equal(t, []string{"hibernator@my-project.iam.gserviceaccount.com"}, cfg.GCP.ImpersonationChain)
+}
+
+func TestBuildConnectorConfig_K8SCluster_GKEWithProvider(t *testing.T) {
+	cluster := k8sClusterGkeObj("my-cluster", "default", "my-gke-cluster", "us-central1")
+	cluster.Spec.ProviderRef = &hibernatorv1alpha1.ProviderRef{Name: "gcp"}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(schemeForBuilder()).
+		WithObjects(cluster, cloudProviderGcpWIF("gcp", "default")).
+		Build()
+
+	b := NewConfigBuilder(fakeClient, logr.Discard())
+
+	cfg, err := b.BuildConnectorConfig(context.Background(), "K8SCluster", "default", "my-cluster")
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg.K8S)
+	require.NotNil(t, cfg.K8S.GCP)
+	assert.Equal(t, "my-project", cfg.K8S.GCP.ProjectID)
+	assert.Equal(t, "/var/run/secrets/gcp-federation/token", cfg.K8S.GCP.SubjectTokenFile)
+}
+
+func corporateNetwork() *hibernatorv1alpha1.ConnectorNetwork {
+	return &hibernatorv1alpha1.ConnectorNetwork{
+		Proxy: &hibernatorv1alpha1.ProxyConfig{
+			URL:     "http://proxy.corp.example:3128",
+			NoProxy: []string{".svc", "10.0.0.0/8"},
+		},
+		CABundleRef: &hibernatorv1alpha1.CABundleRef{Name: "corp-ca"},
+	}
+}
+
+func corporateCABundle(namespace string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "corp-ca"},
+		Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----\n...\n-----END CERTIFICATE-----\n"},
+	}
+}
+
+func TestBuildConnectorConfig_CloudProvider_Network(t *testing.T) {
+	secret := buildAWSStaticSecret("default", "aws-creds", "AKIA1234567890", "super-secret", "")
+	provider := cloudProviderAwsObj("my-provider", "default", "us-west-2", "123456789", "", &hibernatorv1alpha1.SecretReference{Name: "aws-creds", Namespace: "default"})
+	provider.Spec.Network = corporateNetwork()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(schemeForBuilder()).
+		WithObjects(secret, provider, corporateCABundle("default")).
+		Build()
+
+	b := NewConfigBuilder(fakeClient, logr.Discard())
+
+	cfg, err := b.BuildConnectorConfig(context.Background(), "CloudProvider", "default", "my-provider")
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg.AWS.Network)
+	assert.Equal(t, "http://proxy.corp.example:3128", cfg.AWS.Network.ProxyURL)
+	assert.Equal(t, []string{".svc", "10.0.0.0/8"}, cfg.AWS.Network.NoProxy)
+	assert.Contains(t, string(cfg.AWS.Network.CABundle), "BEGIN CERTIFICATE")
+}
+
+func TestBuildConnectorConfig_CloudProvider_NetworkMissingCABundleKey(t *testing.T) {
+	secret := buildAWSStaticSecret("default", "aws-creds", "AKIA1234567890", "super-secret", "")
+	provider := cloudProviderAwsObj("my-provider", "default", "us-west-2", "123456789", "", &hibernatorv1alpha1.SecretReference{Name: "aws-creds", Namespace: "default"})
+	provider.Spec.Network = corporateNetwork()
+	provider.Spec.Network.CABundleRef.Key = "bundle.pem"
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(schemeForBuilder()).
+		WithObjects(secret, provider, corporateCABundle("default")).
+		Build()
+
+	b := NewConfigBuilder(fakeClient, logr.Discard())
+
+	_, err := b.BuildConnectorConfig(context.Background(), "CloudProvider", "default", "my-provider")
+	assert.ErrorContains(t, err, "CA bundle ConfigMap default/corp-ca missing bundle.pem key")
+}
+
+func TestBuildConnectorConfig_K8SCluster_GKEInheritsProviderNetwork(t *testing.T) {
+	cluster := k8sClusterGkeObj("my-cluster", "default", "my-gke-cluster", "us-central1")
+	cluster.Spec.ProviderRef = &hibernatorv1alpha1.ProviderRef{Name: "gcp"}
+	provider := cloudProviderGcpWIF("gcp", "default")
+	provider.Spec.Network = corporateNetwork()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(schemeForBuilder()).
+		WithObjects(cluster, provider, corporateCABundle("default")).
+		Build()
+
+	b := NewConfigBuilder(fakeClient, logr.Discard())
+
+	cfg, err := b.BuildConnectorConfig(context.Background(), "K8SCluster", "default", "my-cluster")
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg.K8S.Network)
+	assert.Equal(t, "http://proxy.corp.example:3128", cfg.K8S.Network.ProxyURL)
+	assert.Same(t, cfg.K8S.GCP.Network, cfg.K8S.Network)
+}
+
+func TestBuildConnectorConfig_K8SCluster_KubeconfigRef_Network(t *testing.T) {
+	secret := buildKubeconfigSecret("default", "kubeconfig-secret", "yaml-content-here")
+	cluster := k8sClusterK8sKubeconfigRef("my-cluster", "default", &hibernatorv1alpha1.KubeconfigRef{Name: "kubeconfig-secret"})
+	cluster.Spec.Network = &hibernatorv1alpha1.ConnectorNetwork{
+		Proxy: &hibernatorv1alpha1.ProxyConfig{URL: "http://proxy.corp.example:3128"},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(schemeForBuilder()).
+		WithObjects(secret, cluster).
+		Build()
+
+	b := NewConfigBuilder(fakeClient, logr.Discard())
+
+	cfg, err := b.BuildConnectorConfig(context.Background(), "K8SCluster", "default", "my-cluster")
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg.K8S.Network)
+	assert.Equal(t, "http://proxy.corp.example:3128", cfg.K8S.Network.ProxyURL)
+	assert.Empty(t, cfg.K8S.Network.CABundle)
+}
+
 func TestBuildConnectorConfig_CloudProvider_MissingSecret(t *testing.T) {
 	provider := cloudProviderAwsObj("my-provider", "default", "us-west-2", "123456789", "", &hibernatorv1alpha1.SecretReference{Name: "nonexistent", Namespace: "default"})
 
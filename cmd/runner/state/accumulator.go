@@ -8,16 +8,35 @@ package state
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/ardikabs/hibernator/internal/executor"
 	"github.com/ardikabs/hibernator/internal/restore"
 )
 
+// flushTimeout bounds how long a flush keeps retrying. The flush runs detached
+// from the operation context so restore data is still persisted when the
+// operation was cancelled or timed out after resources were already changed.
+const flushTimeout = 2 * time.Minute
+
+// flushBackoff controls retries of transient failures while persisting restore data.
+var flushBackoff = wait.Backoff{
+	Steps:    8,
+	Duration: 500 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+	Cap:      15 * time.Second,
+}
+
 // Accumulator batches incremental saves in memory before flushing to ConfigMap.
 // This reduces Kubernetes API calls from N to 1 (where N = number of resources).
 type Accumulator struct {
@@ -151,13 +170,46 @@ func (a *Accumulator) flush(ctx context.Context) error {
 		Status:    status,  // Pre-populated with LastReportedAt for each resource
 	}
 
-	if err := a.restoreMgr.SaveState(ctx, a.namespace, a.plan, a.target, data, maxStaleCount, a.cycleID); err != nil {
-		return fmt.Errorf("save state to ConfigMap: %w", err)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), flushTimeout)
+	defer cancel()
+
+	attempt := 0
+	err := retry.OnError(flushBackoff, isRetryableSaveError, func() error {
+		attempt++
+		err := a.restoreMgr.SaveState(ctx, a.namespace, a.plan, a.target, data, maxStaleCount, a.cycleID)
+		if err != nil && ctx.Err() == nil && isRetryableSaveError(err) {
+			log.Error(err, "failed to save restore data, retrying", "attempt", attempt)
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("save state to ConfigMap after %d attempt(s): %w", attempt, err)
 	}
 
 	log.Info("restore data flushed to ConfigMap",
 		"reportedKeys", len(a.state),
+		"attempts", attempt,
 	)
 
 	return nil
 }
+
+// isRetryableSaveError reports whether a failed save may succeed when retried.
+// Conflicts are already retried by the restore manager, but are retried here as
+// well in case another writer keeps winning. Errors that will not change on
+// retry, such as rejected or oversized data and missing permissions, are not.
+func isRetryableSaveError(err error) bool {
+	switch {
+	case err == nil,
+		errors.Is(err, context.Canceled),
+		errors.Is(err, context.DeadlineExceeded),
+		apierrors.IsInvalid(err),
+		apierrors.IsBadRequest(err),
+		apierrors.IsForbidden(err),
+		apierrors.IsUnauthorized(err),
+		apierrors.IsRequestEntityTooLargeError(err),
+		apierrors.IsNotFound(err):
+		return false
+	}
+	return true
+}
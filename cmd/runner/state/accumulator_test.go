@@ -9,16 +9,21 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/ardikabs/hibernator/internal/restore"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 // TestAccumulator_StructConversion verifies that the accumulator properly converts
@@ -418,3 +423,90 @@ func TestAccumulator_NewCycle_IncrementsStaleness(t *testing.T) {
 	require.Equal(t, 1, finalData.Status["nodepool-1"].StaleCount)
 	require.Equal(t, "cycle-NEW", finalData.CycleID)
 }
+
+// useFastFlushBackoff shortens flush retries for the duration of a test.
+func useFastFlushBackoff(t *testing.T) {
+	orig := flushBackoff
+	flushBackoff = wait.Backoff{Steps: 4, Duration: time.Millisecond, Factor: 1.0}
+	t.Cleanup(func() { flushBackoff = orig })
+}
+
+// TestAccumulator_FlushRetriesTransientErrors verifies that restore data is
+// persisted once the API server recovers from transient failures.
+func TestAccumulator_FlushRetriesTransientErrors(t *testing.T) {
+	useFastFlushBackoff(t)
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	failures := 2
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if failures > 0 {
+				failures--
+				return apierrors.NewServiceUnavailable("apiserver is restarting")
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	}).Build()
+
+	ctx := context.Background()
+	restoreMgr := restore.NewManager(fakeClient, logr.Discard())
+	callback, flush := NewReportStateHandlers(ctx, restoreMgr, logr.Discard(), "test-ns", "test-plan", "test-target", "ec2", "cycle-001")
+
+	require.NoError(t, callback("i-123", map[string]any{"wasRunning": true}))
+	require.NoError(t, flush())
+	require.Zero(t, failures)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "test-ns", Name: "hibernator-restore-test-plan"}, cm))
+	require.Contains(t, cm.Data, "test-target.json")
+}
+
+// TestAccumulator_FlushDoesNotRetryPermanentErrors verifies that errors which
+// cannot succeed on retry fail the flush immediately.
+func TestAccumulator_FlushDoesNotRetryPermanentErrors(t *testing.T) {
+	useFastFlushBackoff(t)
+
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	attempts := 0
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			attempts++
+			return apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "hibernator-restore-test-plan", nil)
+		},
+	}).Build()
+
+	ctx := context.Background()
+	restoreMgr := restore.NewManager(fakeClient, logr.Discard())
+	callback, flush := NewReportStateHandlers(ctx, restoreMgr, logr.Discard(), "test-ns", "test-plan", "test-target", "ec2", "cycle-001")
+
+	require.NoError(t, callback("i-123", map[string]any{"wasRunning": true}))
+	err := flush()
+	require.Error(t, err)
+	require.True(t, apierrors.IsForbidden(err))
+	require.Equal(t, 1, attempts)
+}
+
+// TestAccumulator_FlushSurvivesCancelledContext verifies that restore data is
+// still persisted when the operation context was cancelled.
+func TestAccumulator_FlushSurvivesCancelledContext(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	restoreMgr := restore.NewManager(fakeClient, logr.Discard())
+	callback, flush := NewReportStateHandlers(ctx, restoreMgr, logr.Discard(), "test-ns", "test-plan", "test-target", "ec2", "cycle-001")
+
+	require.NoError(t, callback("i-123", map[string]any{"wasRunning": true}))
+	cancel()
+	require.NoError(t, flush())
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKey{Namespace: "test-ns", Name: "hibernator-restore-test-plan"}, cm))
+	require.Contains(t, cm.Data, "test-target.json")
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// checkpointDataKey is the ConfigMap key holding the JSON list of processed
+// resource keys.
+const checkpointDataKey = "processed"
+
+// Checkpoint is an executor.Checkpoint kept in a ConfigMap per execution, so
+// a runner pod retried by its Job skips the resources a failed pod processed.
+//
+// Marks are held in memory until Save. The runner saves them only after the
+// restore data gathered by the same attempt was flushed, so a retry never
+// skips a resource whose restore data was lost.
+type Checkpoint struct {
+	mu        sync.Mutex
+	client    client.Client
+	log       logr.Logger
+	key       types.NamespacedName
+	plan      string
+	execution string
+	owner     *metav1.OwnerReference
+	done      map[string]struct{} // Processed by earlier attempts
+	processed map[string]struct{} // Processed by any attempt
+}
+
+var _ executor.Checkpoint = (*Checkpoint)(nil)
+
+// CheckpointName returns the name of the ConfigMap holding the checkpoint of an execution.
+func CheckpointName(executionID string) string {
+	return executionID + "-checkpoint"
+}
+
+// LoadCheckpoint loads the checkpoint of an execution, which is empty on the
+// first attempt. The ConfigMap is created owned by owner when non-nil, so it is
+// deleted with the runner Job.
+func LoadCheckpoint(ctx context.Context, c client.Client, log logr.Logger, namespace, plan, executionID string, owner *metav1.OwnerReference) (*Checkpoint, error) {
+	cp := &Checkpoint{
+		client:    c,
+		log:       log.WithValues("checkpoint", CheckpointName(executionID)),
+		key:       types.NamespacedName{Namespace: namespace, Name: CheckpointName(executionID)},
+		plan:      plan,
+		execution: executionID,
+		owner:     owner,
+		done:      make(map[string]struct{}),
+		processed: make(map[string]struct{}),
+	}
+
+	var cm corev1.ConfigMap
+	if err := c.Get(ctx, cp.key, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return cp, nil
+		}
+		return nil, fmt.Errorf("get checkpoint: %w", err)
+	}
+
+	var keys []string
+	if raw := cm.Data[checkpointDataKey]; raw != "" {
+		if err := json.Unmarshal([]byte(raw), &keys); err != nil {
+			return nil, fmt.Errorf("decode checkpoint: %w", err)
+		}
+	}
+	for _, key := range keys {
+		cp.done[key] = struct{}{}
+		cp.processed[key] = struct{}{}
+	}
+
+	cp.log.Info("resuming from checkpoint", "processed", len(keys))
+	return cp, nil
+}
+
+// Done reports whether key was processed by an earlier attempt.
+func (c *Checkpoint) Done(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.done[key]
+	return ok
+}
+
+// Mark records key as processed.
+func (c *Checkpoint) Mark(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.processed[key] = struct{}{}
+}
+
+// Save persists the processed keys for the next attempt. Like a restore data
+// flush, it runs detached from ctx so it still succeeds after cancellation.
+func (c *Checkpoint) Save(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.processed) == len(c.done) {
+		return nil
+	}
+
+	raw, err := json.Marshal(slices.Sorted(maps.Keys(c.processed)))
+	if err != nil {
+		return fmt.Errorf("encode checkpoint: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), flushTimeout)
+	defer cancel()
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var cm corev1.ConfigMap
+		err := c.client.Get(ctx, c.key, &cm)
+		if apierrors.IsNotFound(err) {
+			cm = corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      c.key.Name,
+					Namespace: c.key.Namespace,
+					Labels: map[string]string{
+						wellknown.LabelPlan:        c.plan,
+						wellknown.LabelExecutionID: c.execution,
+					},
+				},
+				Data: map[string]string{checkpointDataKey: string(raw)},
+			}
+			if c.owner != nil {
+				cm.OwnerReferences = []metav1.OwnerReference{*c.owner}
+			}
+			return c.client.Create(ctx, &cm)
+		}
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[checkpointDataKey] = string(raw)
+		return c.client.Update(ctx, &cm)
+	})
+	if err != nil {
+		return fmt.Errorf("save checkpoint: %w", err)
+	}
+
+	for key := range c.processed {
+		c.done[key] = struct{}{}
+	}
+	c.log.Info("checkpoint saved", "processed", len(c.processed))
+	return nil
+}
+
+// Delete removes the checkpoint once the execution succeeded.
+func (c *Checkpoint) Delete(ctx context.Context) error {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: c.key.Name, Namespace: c.key.Namespace}}
+	if err := c.client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete checkpoint: %w", err)
+	}
+	return nil
+}
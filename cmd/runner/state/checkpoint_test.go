@@ -0,0 +1,73 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func TestCheckpoint_ResumesAcrossAttempts(t *testing.T) {
+	ctx := context.Background()
+	fakeClient := fake.NewClientBuilder().WithScheme(schemeWithRestore()).Build()
+	owner := &metav1.OwnerReference{APIVersion: "batch/v1", Kind: "Job", Name: "runner-test-plan-db-abcde", UID: "job-uid"}
+
+	first, err := LoadCheckpoint(ctx, fakeClient, logr.Discard(), "default", "test-plan", "exec-001", owner)
+	require.NoError(t, err)
+	assert.False(t, first.Done("instance:db-1"))
+
+	first.Mark("instance:db-1")
+	first.Mark("instance:db-2")
+	assert.False(t, first.Done("instance:db-1"), "only keys of earlier attempts are done")
+	require.NoError(t, first.Save(ctx))
+
+	var cm corev1.ConfigMap
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: CheckpointName("exec-001")}, &cm))
+	assert.JSONEq(t, `["instance:db-1","instance:db-2"]`, cm.Data[checkpointDataKey])
+	assert.Equal(t, "exec-001", cm.Labels[wellknown.LabelExecutionID])
+	require.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, "runner-test-plan-db-abcde", cm.OwnerReferences[0].Name)
+
+	retry, err := LoadCheckpoint(ctx, fakeClient, logr.Discard(), "default", "test-plan", "exec-001", owner)
+	require.NoError(t, err)
+	assert.True(t, retry.Done("instance:db-1"))
+	assert.True(t, retry.Done("instance:db-2"))
+	assert.False(t, retry.Done("instance:db-3"))
+
+	retry.Mark("instance:db-3")
+	require.NoError(t, retry.Save(ctx))
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: CheckpointName("exec-001")}, &cm))
+	assert.JSONEq(t, `["instance:db-1","instance:db-2","instance:db-3"]`, cm.Data[checkpointDataKey])
+
+	require.NoError(t, retry.Delete(ctx))
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: CheckpointName("exec-001")}, &cm)
+	assert.True(t, apierrors.IsNotFound(err))
+	assert.NoError(t, retry.Delete(ctx), "deleting a missing checkpoint succeeds")
+}
+
+func TestCheckpoint_SaveWithoutMarksIsNoop(t *testing.T) {
+	ctx := context.Background()
+	fakeClient := fake.NewClientBuilder().WithScheme(schemeWithRestore()).Build()
+
+	cp, err := LoadCheckpoint(ctx, fakeClient, logr.Discard(), "default", "test-plan", "exec-001", nil)
+	require.NoError(t, err)
+	require.NoError(t, cp.Save(ctx))
+
+	var cm corev1.ConfigMap
+	err = fakeClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: CheckpointName("exec-001")}, &cm)
+	assert.True(t, apierrors.IsNotFound(err))
+}
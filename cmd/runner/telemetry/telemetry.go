@@ -7,10 +7,13 @@ package telemetry
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"time"
 
 	streamclient "github.com/ardikabs/hibernator/internal/streaming/client"
+	streamendpoint "github.com/ardikabs/hibernator/internal/streaming/endpoint"
+	"github.com/ardikabs/hibernator/internal/streaming/streamtls"
 	"github.com/ardikabs/hibernator/pkg/logsink"
 	"github.com/go-logr/logr"
 )
@@ -24,6 +27,15 @@ type Config struct {
 	ExecutionID          string
 	TokenPath            string
 	UseTLS               bool
+
+	// TLSDir holds the client certificate (tls.crt, tls.key) presented to the
+	// streaming servers and the CA bundle (ca.crt) they are verified with.
+	// Setting it enables TLS for every transport.
+	TLSDir string
+
+	// SpillDir holds the log entries and reports not yet delivered to the
+	// control plane beyond those kept in memory.
+	SpillDir string
 }
 
 // Manager wraps the streaming client to report telemetry data (progress/completion).
@@ -43,6 +55,23 @@ func NewManager(ctx context.Context, log logr.Logger, cfg Config) (*Manager, err
 		return &Manager{log: log}, nil
 	}
 
+	var tlsConfig *tls.Config
+	if cfg.TLSDir != "" {
+		var err error
+		if tlsConfig, err = streamtls.ClientConfig(cfg.TLSDir); err != nil {
+			return nil, fmt.Errorf("load streaming TLS configuration: %w", err)
+		}
+		cfg.UseTLS = true
+	}
+
+	// Only the legacy control-plane endpoint is set: derive the streaming
+	// addresses from it, bracketing IPv6 literals.
+	if cfg.GRPCEndpoint == "" && cfg.WebSocketEndpoint == "" && cfg.HTTPCallbackEndpoint == "" {
+		cfg.GRPCEndpoint = streamendpoint.GRPCAddress(cfg.ControlPlaneEndpoint)
+		cfg.WebSocketEndpoint = streamendpoint.WebSocketURL(cfg.ControlPlaneEndpoint, cfg.UseTLS)
+		cfg.HTTPCallbackEndpoint = streamendpoint.HTTPCallbackURL(cfg.ControlPlaneEndpoint, cfg.UseTLS)
+	}
+
 	clientCfg := streamclient.ClientConfig{
 		Type:         streamclient.ClientTypeAuto,
 		GRPCAddress:  cfg.GRPCEndpoint,
@@ -51,14 +80,18 @@ func NewManager(ctx context.Context, log logr.Logger, cfg Config) (*Manager, err
 		ExecutionID:  cfg.ExecutionID,
 		TokenPath:    cfg.TokenPath,
 		UseTLS:       cfg.UseTLS,
+		TLSConfig:    tlsConfig,
 		Timeout:      30 * time.Second,
 		Log:          log,
 	}
 
-	client, err := streamclient.NewClient(clientCfg)
-	if err != nil {
-		return nil, fmt.Errorf("create streaming client: %w", err)
-	}
+	// Events are replayed across transient disconnects, so the completion
+	// report reaches the control plane even when a delivery attempt fails.
+	client := streamclient.NewReliableClient(streamclient.NewAutoClient(clientCfg), streamclient.ReliableClientOptions{
+		ExecutionID: cfg.ExecutionID,
+		SpillDir:    cfg.SpillDir,
+		Log:         log,
+	})
 
 	if err := client.Connect(ctx); err != nil {
 		return nil, fmt.Errorf("connect to streaming server: %w", err)
@@ -91,7 +124,8 @@ func (m *Manager) StartHeartbeat(interval time.Duration) {
 	}
 }
 
-// Close closes the underlying stream client.
+// Close closes the underlying stream client, which waits for the pending log
+// entries and reports to be delivered first.
 func (m *Manager) Close() error {
 	if m.dualSink != nil {
 		m.dualSink.Stop()
@@ -120,6 +154,34 @@ func (m *Manager) ReportProgress(ctx context.Context, phase string, percent int3
 	}
 }
 
+// ReportResourceProgress logs per-resource progress to stdout and reports it
+// via the streaming client if available. Clients unable to carry the resource
+// counts report the message and percentage only.
+func (m *Manager) ReportResourceProgress(ctx context.Context, phase string, percent int32, message string, completed, total int32, resource string) {
+	m.log.Info("progress",
+		"phase", phase,
+		"percent", percent,
+		"message", message,
+		"completed", completed,
+		"total", total,
+		"resource", resource,
+	)
+
+	if m.client == nil {
+		return
+	}
+
+	var err error
+	if reporter, ok := m.client.(streamclient.ResourceProgressReporter); ok {
+		err = reporter.ReportResourceProgress(ctx, phase, percent, message, completed, total, resource)
+	} else {
+		err = m.client.ReportProgress(ctx, phase, percent, message)
+	}
+	if err != nil {
+		m.log.Info("failed to report progress", "error", err.Error())
+	}
+}
+
 // ReportCompletion logs completion to stdout and reports it via the streaming client if available.
 func (m *Manager) ReportCompletion(ctx context.Context, success bool, errorMsg string, durationMs int64) {
 	// Always log to stdout
@@ -136,3 +198,53 @@ func (m *Manager) ReportCompletion(ctx context.Context, success bool, errorMsg s
 		}
 	}
 }
+
+// ReportFailure logs the failure and its class to stdout and reports it via
+// the streaming client if available. Clients unable to carry the class report
+// a plain failed completion.
+func (m *Manager) ReportFailure(ctx context.Context, reason, errorMsg string, durationMs int64) {
+	m.log.Info("completion",
+		"success", false,
+		"durationMs", durationMs,
+		"errorMessage", errorMsg,
+		"failureReason", reason,
+	)
+
+	if m.client == nil {
+		return
+	}
+
+	var err error
+	if reporter, ok := m.client.(streamclient.FailureReporter); ok {
+		err = reporter.ReportFailure(ctx, reason, errorMsg, durationMs)
+	} else {
+		err = m.client.ReportCompletion(ctx, false, errorMsg, durationMs)
+	}
+	if err != nil {
+		m.log.Info("failed to report completion", "error", err.Error())
+	}
+}
+
+// ReportCancellation logs the cancellation to stdout and reports it via the
+// streaming client if available. Clients unable to mark a completion
+// cancelled report it as a failure.
+func (m *Manager) ReportCancellation(ctx context.Context, message string, durationMs int64) {
+	m.log.Info("cancellation",
+		"durationMs", durationMs,
+		"message", message,
+	)
+
+	if m.client == nil {
+		return
+	}
+
+	var err error
+	if reporter, ok := m.client.(streamclient.CancellationReporter); ok {
+		err = reporter.ReportCancellation(ctx, message, durationMs)
+	} else {
+		err = m.client.ReportCompletion(ctx, false, message, durationMs)
+	}
+	if err != nil {
+		m.log.Info("failed to report cancellation", "error", err.Error())
+	}
+}
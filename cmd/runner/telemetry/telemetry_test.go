@@ -176,3 +176,91 @@ func TestManager_ReportCompletion_WithClient_Error(t *testing.T) {
 	mgr.ReportCompletion(context.Background(), false, "something failed", 100)
 	assert.True(t, mockClient.reportCompletionCalled)
 }
+
+// cancellingStreamingClient is a mockStreamingClient reporting cancellations.
+type cancellingStreamingClient struct {
+	mockStreamingClient
+	cancellationMessage string
+}
+
+func (m *cancellingStreamingClient) ReportCancellation(ctx context.Context, message string, durationMs int64) error {
+	m.cancellationMessage = message
+	return nil
+}
+
+func TestManager_ReportCancellation(t *testing.T) {
+	mgr := &Manager{client: nil, log: logr.Discard()}
+	mgr.ReportCancellation(context.Background(), "execution cancelled", 100)
+
+	reporter := &cancellingStreamingClient{}
+	mgr = &Manager{client: reporter, log: logr.Discard()}
+	mgr.ReportCancellation(context.Background(), "execution cancelled", 100)
+	assert.Equal(t, "execution cancelled", reporter.cancellationMessage)
+	assert.False(t, reporter.reportCompletionCalled)
+
+	// Clients unable to mark the completion cancelled report a failure.
+	mockClient := &mockStreamingClient{}
+	mgr = &Manager{client: mockClient, log: logr.Discard()}
+	mgr.ReportCancellation(context.Background(), "execution cancelled", 100)
+	assert.True(t, mockClient.reportCompletionCalled)
+}
+
+// failingStreamingClient is a mockStreamingClient reporting classified failures.
+type failingStreamingClient struct {
+	mockStreamingClient
+	reason string
+}
+
+func (m *failingStreamingClient) ReportFailure(ctx context.Context, reason, message string, durationMs int64) error {
+	m.reason = reason
+	return nil
+}
+
+func TestManager_ReportFailure(t *testing.T) {
+	mgr := &Manager{client: nil, log: logr.Discard()}
+	mgr.ReportFailure(context.Background(), "Throttled", "rate exceeded", 100)
+
+	reporter := &failingStreamingClient{}
+	mgr = &Manager{client: reporter, log: logr.Discard()}
+	mgr.ReportFailure(context.Background(), "Throttled", "rate exceeded", 100)
+	assert.Equal(t, "Throttled", reporter.reason)
+	assert.False(t, reporter.reportCompletionCalled)
+
+	// Clients unable to carry the class report a plain failure.
+	mockClient := &mockStreamingClient{}
+	mgr = &Manager{client: mockClient, log: logr.Discard()}
+	mgr.ReportFailure(context.Background(), "Throttled", "rate exceeded", 100)
+	assert.True(t, mockClient.reportCompletionCalled)
+}
+
+// resourceProgressStreamingClient is a mockStreamingClient reporting
+// per-resource progress.
+type resourceProgressStreamingClient struct {
+	mockStreamingClient
+	completed, total int32
+	resource         string
+}
+
+func (m *resourceProgressStreamingClient) ReportResourceProgress(ctx context.Context, phase string, percent int32, message string, completed, total int32, resource string) error {
+	m.completed, m.total, m.resource = completed, total, resource
+	return nil
+}
+
+func TestManager_ReportResourceProgress(t *testing.T) {
+	mgr := &Manager{client: nil, log: logr.Discard()}
+	mgr.ReportResourceProgress(context.Background(), "executing", 60, "stopped 7/24 RDS resource(s)", 7, 24, "instance:db-7")
+
+	reporter := &resourceProgressStreamingClient{}
+	mgr = &Manager{client: reporter, log: logr.Discard()}
+	mgr.ReportResourceProgress(context.Background(), "executing", 60, "stopped 7/24 RDS resource(s)", 7, 24, "instance:db-7")
+	assert.Equal(t, int32(7), reporter.completed)
+	assert.Equal(t, int32(24), reporter.total)
+	assert.Equal(t, "instance:db-7", reporter.resource)
+	assert.False(t, reporter.reportProgressCalled)
+
+	// Clients unable to carry resource counts report the message only.
+	mockClient := &mockStreamingClient{}
+	mgr = &Manager{client: mockClient, log: logr.Discard()}
+	mgr.ReportResourceProgress(context.Background(), "executing", 60, "stopped 7/24 RDS resource(s)", 7, 24, "instance:db-7")
+	assert.True(t, mockClient.reportProgressCalled)
+}
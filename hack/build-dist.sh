@@ -25,6 +25,7 @@ Options:
 Outputs:
   - Tarballs: kubectl-hibernator_<version>_<os>_<arch>.tar.gz
   - Checksums: checksums.txt (SHA256 of all tarballs)
+  - Krew manifest: hibernator.yaml (install with kubectl krew install --manifest=hibernator.yaml)
 
 Install:
   curl -sSL https://hibernator.ardikabs.com/install-cli.sh | bash
@@ -51,6 +52,7 @@ COMMIT_HASH="${COMMIT_HASH:-$(git rev-parse --short HEAD 2>/dev/null || echo "un
 OUTPUT_DIR="${OUTPUT_DIR:-}"
 GO_CMD="${GO_CMD:-go}"
 PLATFORMS="${PLATFORMS:-darwin/amd64 darwin/arm64 linux/amd64 linux/arm64}"
+RELEASE_URL="${RELEASE_URL:-https://github.com/ardikabs/hibernator/releases/download}"
 
 # Parse arguments
 while [[ $# -gt 0 ]]; do
@@ -111,6 +113,9 @@ trap "rm -rf $TMP_DIR" EXIT
 # Array to store tarball paths for checksum generation
 declare -a TARBALLS=()
 
+# Krew platform entries, one per tarball
+KREW_PLATFORMS=""
+
 # Build for each platform
 for platform in $PLATFORMS; do
   OS=$(echo "$platform" | cut -d'/' -f1)
@@ -134,10 +139,26 @@ for platform in $PLATFORMS; do
   fi
 
   # Create tarball
-  tar -czf "$TARBALL_PATH" -C "$TMP_DIR" "${BINARY_NAME}"
+  cp LICENSE "${TMP_DIR}/LICENSE"
+  tar -czf "$TARBALL_PATH" -C "$TMP_DIR" "${BINARY_NAME}" LICENSE
   TARBALL_SIZE=$(ls -lh "$TARBALL_PATH" | awk '{print $5}')
   TARBALLS+=("$TARBALL_PATH")
   msg "${GREEN}    ✓ ${TARBALL_NAME} (${TARBALL_SIZE})${RESET}"
+
+  TARBALL_SHA=$(sha256sum "$TARBALL_PATH" | awk '{print $1}')
+  KREW_PLATFORMS+="
+  - selector:
+      matchLabels:
+        os: ${OS}
+        arch: ${ARCH}
+    uri: ${RELEASE_URL}/v${VERSION#v}/${TARBALL_NAME}
+    sha256: ${TARBALL_SHA}
+    files:
+    - from: ${BINARY_NAME}
+      to: kubectl-hibernator
+    - from: LICENSE
+      to: .
+    bin: kubectl-hibernator"
 done
 
 msg ""
@@ -153,6 +174,28 @@ if [ ${#TARBALLS[@]} -gt 0 ]; then
   msg "${GREEN}    ✓ checksums.txt${RESET}"
 fi
 
+# Generate the krew plugin manifest
+if [ -n "$KREW_PLATFORMS" ]; then
+  msg "${CYAN}  Generating krew manifest...${RESET}"
+
+  cat > "${OUTPUT_DIR}/hibernator.yaml" <<EOF
+apiVersion: krew.googlecontainertools.github.com/v1alpha2
+kind: Plugin
+metadata:
+  name: hibernator
+spec:
+  version: v${VERSION#v}
+  homepage: https://hibernator.ardikabs.com
+  shortDescription: Manage Hibernator plans from the command line
+  description: |
+    Inspect, suspend, resume, retry and override HibernatePlans, preview
+    their schedules, manage restore points, and tail runner execution logs.
+  platforms:${KREW_PLATFORMS}
+EOF
+
+  msg "${GREEN}    ✓ hibernator.yaml${RESET}"
+fi
+
 msg ""
 msg "${GREEN}CLI distribution built in ${OUTPUT_DIR}${RESET}"
 msg ""
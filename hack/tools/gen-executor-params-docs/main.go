@@ -49,6 +49,7 @@ var executorTypes = []struct {
 	{"CloudSQLParameters", "cloudsql"},
 	{"WorkloadScalerParameters", "workloadscaler"},
 	{"NoOpParameters", "noop"},
+	{"DelayParameters", "delay"},
 }
 
 // externalTypes maps well-known external (non-local) fully-qualified type names
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package audit records the phase transitions of plans and what triggered
+// them: the schedule, a schedule exception, a user's manual action, error
+// recovery or the controller itself. Unlike the execution history in the plan
+// status, which keeps the last few cycles, the audit log spans as many
+// transitions as its size limit holds.
+//
+// Each plan's records are kept as JSON lines in a ConfigMap named
+// <plan>-audit in the plan namespace, owned by the HibernatePlan. The log is a
+// bounded ring buffer, not an append-only archive: once the ConfigMap reaches
+// its size limit, the oldest records are dropped and counted in
+// AnnotationLogDropped.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/ringlog"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+const (
+	// DataKey is the ConfigMap data key holding the JSON-lines encoded records.
+	DataKey = "audit.jsonl"
+
+	// DefaultMaxSize is the default size limit of a plan's stored records,
+	// roughly two thousand transitions. It keeps the ConfigMap well below the
+	// 1MiB object size limit.
+	DefaultMaxSize = 512 * 1024
+)
+
+// Trigger is what caused a phase transition.
+type Trigger string
+
+const (
+	// TriggerSchedule is a boundary of the plan's schedule.
+	TriggerSchedule Trigger = "Schedule"
+	// TriggerException is a schedule exception; the actor names it.
+	TriggerException Trigger = "Exception"
+	// TriggerManual is an action a user requested, e.g. through an annotation
+	// or spec.suspend; the actor names the user and the source what they set.
+	TriggerManual Trigger = "Manual"
+	// TriggerDeadline is the expiry of a deadline a user set, e.g. the
+	// suspend-until annotation; the actor names it.
+	TriggerDeadline Trigger = "Deadline"
+	// TriggerExecution is the completion of the operation's runner Jobs.
+	TriggerExecution Trigger = "Execution"
+	// TriggerRecovery is the error recovery of the plan.
+	TriggerRecovery Trigger = "Recovery"
+	// TriggerFailure is a failure of the operation or of the controller.
+	TriggerFailure Trigger = "Failure"
+	// TriggerTimeout is a cycle outliving its maximum duration.
+	TriggerTimeout Trigger = "Timeout"
+	// TriggerController is a transition the controller makes on its own, e.g.
+	// initializing a new plan.
+	TriggerController Trigger = "Controller"
+)
+
+// Record is an audited phase transition of a plan.
+type Record struct {
+	Time metav1.Time `json:"time"`
+
+	// From is the phase before the transition, empty for a new plan.
+	From hibernatorv1alpha1.PlanPhase `json:"from,omitempty"`
+	To   hibernatorv1alpha1.PlanPhase `json:"to"`
+
+	// Operation and CycleID identify the cycle the transition belongs to.
+	Operation hibernatorv1alpha1.PlanOperation `json:"operation,omitempty"`
+	CycleID   string                           `json:"cycleId,omitempty"`
+
+	Trigger Trigger `json:"trigger"`
+	// Actor names who or what triggered the transition: the user who
+	// requested a manual action, or e.g. the exceptions. It is empty when the
+	// trigger says it all, or when the user of a manual action is unknown.
+	Actor string `json:"actor,omitempty"`
+	// Source names what a manual action was requested through: the
+	// annotation the user set, or spec.suspend.
+	Source  string `json:"source,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Log is the stored audit log of a plan.
+type Log struct {
+	Namespace string `json:"namespace"`
+	Plan      string `json:"plan"`
+
+	// Dropped is the number of oldest records dropped to stay within the size limit.
+	Dropped int `json:"dropped,omitempty"`
+
+	Records []Record `json:"records"`
+}
+
+// Recorder records the phase transitions of plans.
+type Recorder interface {
+	Record(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan, record Record) error
+}
+
+// ConfigMapName returns the name of the ConfigMap storing a plan's audit log.
+func ConfigMapName(plan string) string {
+	return plan + "-audit"
+}
+
+// Get returns the stored audit log of a plan in namespace. It returns a
+// NotFound error when no transition of the plan was recorded.
+func Get(ctx context.Context, c client.Reader, namespace, plan string) (*Log, error) {
+	cm := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: namespace, Name: ConfigMapName(plan)}
+	if err := c.Get(ctx, key, cm); err != nil {
+		return nil, err
+	}
+	if cm.Labels[wellknown.LabelAuditLog] != "true" || cm.Labels[wellknown.LabelPlan] != plan {
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), key.Name)
+	}
+	return decode(cm), nil
+}
+
+func decode(cm *corev1.ConfigMap) *Log {
+	return &Log{
+		Namespace: cm.Namespace,
+		Plan:      cm.Labels[wellknown.LabelPlan],
+		Dropped:   ringlog.Dropped(cm),
+		Records:   ringlog.Decode[Record](cm, DataKey),
+	}
+}
+
+// Options configures a Store.
+type Options struct {
+	// MaxSize bounds the size of a plan's stored records. Zero uses DefaultMaxSize.
+	MaxSize int
+}
+
+// Store writes audit records to the audit log ConfigMaps of plans.
+type Store struct {
+	client  client.Client
+	maxSize int
+}
+
+var _ Recorder = (*Store)(nil)
+
+// NewStore creates a Store writing audit logs with c.
+func NewStore(c client.Client, opts Options) *Store {
+	s := &Store{client: c, maxSize: opts.MaxSize}
+	if s.maxSize <= 0 {
+		s.maxSize = DefaultMaxSize
+	}
+	return s
+}
+
+// Record adds record to the audit log of plan, dropping its oldest records
+// beyond the size limit.
+func (s *Store) Record(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan, record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	key := types.NamespacedName{Namespace: plan.Namespace, Name: ConfigMapName(plan.Name)}
+	return ringlog.Write(ctx, s.client, key,
+		func() *corev1.ConfigMap { return s.newConfigMap(key, plan) },
+		func(cm *corev1.ConfigMap) { ringlog.Append(cm, DataKey, [][]byte{line}, s.maxSize, 0) },
+	)
+}
+
+func (s *Store) newConfigMap(key types.NamespacedName, plan *hibernatorv1alpha1.HibernatePlan) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				wellknown.LabelAuditLog: "true",
+				wellknown.LabelPlan:     plan.Name,
+			},
+		},
+	}
+
+	// The audit log is deleted with its plan.
+	if plan.UID != "" {
+		_ = controllerutil.SetOwnerReference(plan, cm, s.client.Scheme())
+	}
+	return cm
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func newTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = hibernatorv1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func testPlan() *hibernatorv1alpha1.HibernatePlan {
+	return &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-a", UID: "plan-uid"},
+	}
+}
+
+func record(to hibernatorv1alpha1.PlanPhase, trigger Trigger, actor string) Record {
+	return Record{
+		Time:    metav1.NewTime(time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC)),
+		From:    hibernatorv1alpha1.PhaseActive,
+		To:      to,
+		Trigger: trigger,
+		Actor:   actor,
+	}
+}
+
+func TestStore_RecordAppendsToConfigMap(t *testing.T) {
+	ctx := context.Background()
+	plan := testPlan()
+	c := newTestClient(plan)
+	store := NewStore(c, Options{})
+
+	require.NoError(t, store.Record(ctx, plan, record(hibernatorv1alpha1.PhaseHibernating, TriggerSchedule, "")))
+	require.NoError(t, store.Record(ctx, plan, record(hibernatorv1alpha1.PhaseHibernating, TriggerException, "holiday")))
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "dev-audit"}, cm))
+	assert.Equal(t, "true", cm.Labels[wellknown.LabelAuditLog])
+	assert.Equal(t, "dev", cm.Labels[wellknown.LabelPlan])
+	require.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, "HibernatePlan", cm.OwnerReferences[0].Kind)
+
+	log, err := Get(ctx, c, "team-a", "dev")
+	require.NoError(t, err)
+	require.Len(t, log.Records, 2)
+	assert.Equal(t, TriggerSchedule, log.Records[0].Trigger)
+	assert.Equal(t, TriggerException, log.Records[1].Trigger)
+	assert.Equal(t, "holiday", log.Records[1].Actor)
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, log.Records[1].To)
+}
+
+func TestStore_DropsOldestRecordsBeyondMaxSize(t *testing.T) {
+	ctx := context.Background()
+	plan := testPlan()
+	c := newTestClient(plan)
+
+	// Each record encodes to about 110 bytes, so two of them fit.
+	store := NewStore(c, Options{MaxSize: 250})
+	for _, actor := range []string{"first", "second", "third"} {
+		require.NoError(t, store.Record(ctx, plan, record(hibernatorv1alpha1.PhaseHibernating, TriggerManual, actor)))
+	}
+
+	log, err := Get(ctx, c, "team-a", "dev")
+	require.NoError(t, err)
+	require.Len(t, log.Records, 2)
+	assert.Equal(t, "second", log.Records[0].Actor)
+	assert.Equal(t, "third", log.Records[1].Actor)
+	assert.Equal(t, 1, log.Dropped)
+}
+
+func TestGet_IgnoresUnrelatedConfigMap(t *testing.T) {
+	c := newTestClient(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev-audit", Namespace: "team-a"},
+	})
+
+	_, err := Get(context.Background(), c, "team-a", "dev")
+	assert.True(t, apierrors.IsNotFound(err))
+}
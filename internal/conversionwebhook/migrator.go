@@ -0,0 +1,205 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package conversionwebhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=get;update;patch
+
+// convertibleCRDs are the CRDs served in more than one version.
+var convertibleCRDs = []string{
+	"hibernateplans.hibernator.ardikabs.com",
+	"scheduleexceptions.hibernator.ardikabs.com",
+}
+
+// caBundleFiles are the CA file names probed in the certificate directory, in
+// order: cert-manager, kube-webhook-certgen/Helm, and a self-signed serving cert.
+var caBundleFiles = []string{"ca.crt", "ca", "tls.crt"}
+
+// migrationRetryInterval is the delay between attempts when the API server or
+// the conversion webhook is not ready yet.
+const migrationRetryInterval = 30 * time.Second
+
+// storageMigrator points the multi-version CRDs at the conversion webhook and
+// migrates objects still stored in an older version to the storage version.
+// It runs once on the elected leader and retries until it succeeds.
+type storageMigrator struct {
+	client  client.Client
+	reader  client.Reader
+	log     logr.Logger
+	opts    Options
+	crdKeys []string
+}
+
+var (
+	_ manager.Runnable               = &storageMigrator{}
+	_ manager.LeaderElectionRunnable = &storageMigrator{}
+)
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (m *storageMigrator) NeedLeaderElection() bool { return true }
+
+// Start implements manager.Runnable.
+func (m *storageMigrator) Start(ctx context.Context) error {
+	pending := m.crdKeys
+	for {
+		var failed []string
+		for _, name := range pending {
+			if err := m.reconcileCRD(ctx, name); err != nil {
+				m.log.Error(err, "storage version migration failed, will retry", "crd", name)
+				failed = append(failed, name)
+			}
+		}
+		if len(failed) == 0 {
+			return nil
+		}
+		pending = failed
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(migrationRetryInterval):
+		}
+	}
+}
+
+func (m *storageMigrator) reconcileCRD(ctx context.Context, name string) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := m.reader.Get(ctx, client.ObjectKey{Name: name}, crd); err != nil {
+		return fmt.Errorf("get CRD: %w", err)
+	}
+
+	if m.opts.Service != "" {
+		if err := m.ensureConversion(ctx, crd); err != nil {
+			return err
+		}
+	}
+
+	if m.opts.MigrateStorage {
+		return m.migrateStorage(ctx, crd)
+	}
+	return nil
+}
+
+// ensureConversion routes conversion requests for crd to the webhook service.
+func (m *storageMigrator) ensureConversion(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) error {
+	namespace, name, ok := strings.Cut(m.opts.Service, "/")
+	if !ok || namespace == "" || name == "" {
+		return fmt.Errorf("invalid conversion webhook service %q, expected <namespace>/<name>", m.opts.Service)
+	}
+
+	caBundle, err := readCABundle(m.opts.CertDir)
+	if err != nil {
+		return err
+	}
+
+	desired := &apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.WebhookConverter,
+		Webhook: &apiextensionsv1.WebhookConversion{
+			ClientConfig: &apiextensionsv1.WebhookClientConfig{
+				Service: &apiextensionsv1.ServiceReference{
+					Namespace: namespace,
+					Name:      name,
+					Path:      ptr.To(WebhookPath),
+					Port:      ptr.To[int32](443),
+				},
+				CABundle: caBundle,
+			},
+			ConversionReviewVersions: []string{"v1"},
+		},
+	}
+	if equality.Semantic.DeepEqual(crd.Spec.Conversion, desired) {
+		return nil
+	}
+
+	patch := client.MergeFrom(crd.DeepCopy())
+	crd.Spec.Conversion = desired
+	if err := m.client.Patch(ctx, crd, patch); err != nil {
+		return fmt.Errorf("patch CRD conversion: %w", err)
+	}
+	m.log.Info("configured conversion webhook", "crd", crd.Name, "service", m.opts.Service)
+	return nil
+}
+
+// migrateStorage re-writes every object of crd so the API server stores it in
+// the storage version, then drops older versions from status.storedVersions.
+func (m *storageMigrator) migrateStorage(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) error {
+	storageVersion := ""
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			storageVersion = v.Name
+		}
+	}
+	if storageVersion == "" {
+		return errors.New("CRD has no storage version")
+	}
+
+	stored := crd.Status.StoredVersions
+	if len(stored) == 1 && stored[0] == storageVersion {
+		return nil
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   crd.Spec.Group,
+		Version: storageVersion,
+		Kind:    crd.Spec.Names.ListKind,
+	})
+	if err := m.reader.List(ctx, list); err != nil {
+		return fmt.Errorf("list %s: %w", crd.Spec.Names.Plural, err)
+	}
+
+	// An unchanged update re-encodes the object in the storage version. A
+	// conflict or a missing object means it was written since the list and is
+	// already stored in the storage version.
+	for i := range list.Items {
+		obj := &list.Items[i]
+		if err := m.client.Update(ctx, obj); err != nil && !apierrors.IsConflict(err) && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("migrate %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+
+	crd.Status.StoredVersions = []string{storageVersion}
+	if err := m.client.Status().Update(ctx, crd); err != nil {
+		return fmt.Errorf("update stored versions: %w", err)
+	}
+	m.log.Info("migrated stored objects", "crd", crd.Name, "count", len(list.Items),
+		"storageVersion", storageVersion, "previousStoredVersions", stored)
+	return nil
+}
+
+// readCABundle returns the first CA bundle found in certDir.
+func readCABundle(certDir string) ([]byte, error) {
+	for _, name := range caBundleFiles {
+		data, err := os.ReadFile(filepath.Join(certDir, name))
+		if err == nil && len(data) > 0 {
+			return data, nil
+		}
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("read CA bundle: %w", err)
+		}
+	}
+	return nil, fmt.Errorf("no CA bundle found in %s (looked for %s)", certDir, strings.Join(caBundleFiles, ", "))
+}
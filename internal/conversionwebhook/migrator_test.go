@@ -0,0 +1,126 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package conversionwebhook
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	hibernatorv1beta1 "github.com/ardikabs/hibernator/api/v1beta1"
+)
+
+const planCRD = "hibernateplans.hibernator.ardikabs.com"
+
+func newPlanCRD(storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: planCRD},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "hibernator.ardikabs.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   "hibernateplans",
+				Kind:     "HibernatePlan",
+				ListKind: "HibernatePlanList",
+			},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true},
+				{Name: "v1beta1", Served: true, Storage: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+	}
+}
+
+func newMigrator(t *testing.T, opts Options, updates *int, objs ...client.Object) (*storageMigrator, client.Client) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	require.NoError(t, hibernatorv1beta1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&apiextensionsv1.CustomResourceDefinition{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				*updates++
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+
+	return &storageMigrator{client: c, reader: c, log: logr.Discard(), opts: opts, crdKeys: []string{planCRD}}, c
+}
+
+func TestStorageMigrator_RewritesObjectsAndPrunesStoredVersions(t *testing.T) {
+	plans := []client.Object{
+		&hibernatorv1beta1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "team-a"}},
+		&hibernatorv1beta1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "team-b"}},
+	}
+	updates := 0
+	m, c := newMigrator(t, Options{MigrateStorage: true}, &updates, append(plans, newPlanCRD("v1alpha1", "v1beta1"))...)
+
+	require.NoError(t, m.Start(context.Background()))
+
+	assert.Equal(t, 2, updates)
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: planCRD}, crd))
+	assert.Equal(t, []string{"v1beta1"}, crd.Status.StoredVersions)
+}
+
+func TestStorageMigrator_SkipsMigratedCRD(t *testing.T) {
+	plan := &hibernatorv1beta1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "team-a"}}
+	updates := 0
+	m, _ := newMigrator(t, Options{MigrateStorage: true}, &updates, plan, newPlanCRD("v1beta1"))
+
+	require.NoError(t, m.Start(context.Background()))
+	assert.Zero(t, updates)
+}
+
+func TestStorageMigrator_ConfiguresConversionWebhook(t *testing.T) {
+	certDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(certDir, "ca"), []byte("CA"), 0o600))
+
+	updates := 0
+	m, c := newMigrator(t, Options{Service: "hibernator-system/hibernator-webhook", CertDir: certDir}, &updates, newPlanCRD("v1beta1"))
+
+	require.NoError(t, m.Start(context.Background()))
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: planCRD}, crd))
+	require.NotNil(t, crd.Spec.Conversion)
+	assert.Equal(t, apiextensionsv1.WebhookConverter, crd.Spec.Conversion.Strategy)
+	svc := crd.Spec.Conversion.Webhook.ClientConfig.Service
+	assert.Equal(t, "hibernator-system", svc.Namespace)
+	assert.Equal(t, "hibernator-webhook", svc.Name)
+	assert.Equal(t, WebhookPath, *svc.Path)
+	assert.Equal(t, []byte("CA"), crd.Spec.Conversion.Webhook.ClientConfig.CABundle)
+}
+
+func TestReadCABundle_PrefersCAFile(t *testing.T) {
+	certDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(certDir, "ca.crt"), []byte("CA"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(certDir, "tls.crt"), []byte("SERVING"), 0o600))
+
+	got, err := readCABundle(certDir)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("CA"), got)
+
+	_, err = readCABundle(t.TempDir())
+	assert.Error(t, err)
+}
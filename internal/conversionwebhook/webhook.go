@@ -0,0 +1,49 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package conversionwebhook
+
+import (
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+// WebhookPath is the conversion endpoint referenced by the multi-version CRDs.
+const WebhookPath = "/convert"
+
+// Options configures the conversion webhook and storage version migration.
+type Options struct {
+	// Service is the namespace/name of the Service fronting the webhook server.
+	// When set, the CRDs are patched to route conversion requests to it, using
+	// the CA found in CertDir. Leave empty when the CRDs are managed externally.
+	Service string
+
+	// CertDir is the webhook server certificate directory.
+	CertDir string
+
+	// MigrateStorage re-writes stored objects in the storage version and prunes
+	// older versions from the CRD status once every object has been migrated.
+	MigrateStorage bool
+}
+
+// SetupWithManager registers the conversion endpoint on the manager's webhook
+// server and, when configured, the leader-elected storage version migrator.
+// The scheme must contain every served version of the converted kinds.
+func SetupWithManager(mgr ctrl.Manager, log logr.Logger, opts Options) error {
+	mgr.GetWebhookServer().Register(WebhookPath, conversion.NewWebhookHandler(mgr.GetScheme()))
+
+	if opts.Service == "" && !opts.MigrateStorage {
+		return nil
+	}
+
+	return mgr.Add(&storageMigrator{
+		client:  mgr.GetClient(),
+		reader:  mgr.GetAPIReader(),
+		log:     log.WithName("storage-migrator"),
+		opts:    opts,
+		crdKeys: convertibleCRDs,
+	})
+}
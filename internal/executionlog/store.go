@@ -0,0 +1,384 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package executionlog persists the log entries runners stream to the control
+// plane, so an execution's logs can be read after its runner Job and the
+// controller pod that relayed them are gone.
+//
+// Each execution's entries are kept as JSON lines in a ConfigMap named
+// <execution-id>-logs in the plan namespace, owned by the HibernatePlan. The
+// ConfigMap is a ring buffer: once it reaches its size limit, the oldest
+// entries are dropped and counted in AnnotationLogDropped.
+package executionlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/ringlog"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+const (
+	// DataKey is the ConfigMap data key holding the JSON-lines encoded entries.
+	DataKey = "logs.jsonl"
+
+	// DefaultMaxSize is the default size limit of an execution's stored entries.
+	// It keeps the ConfigMap well below the 1MiB object size limit.
+	DefaultMaxSize = 512 * 1024
+
+	// DefaultRetention is how long execution logs are kept by default after
+	// their last entry.
+	DefaultRetention = 7 * 24 * time.Hour
+
+	// DefaultFlushInterval is how often buffered entries are written by default.
+	DefaultFlushInterval = 10 * time.Second
+
+	// pruneInterval is how often logs past the retention are deleted.
+	pruneInterval = time.Hour
+)
+
+// Entry is a stored runner log entry.
+type Entry struct {
+	// Timestamp is the time the runner logged the entry, as reported by it.
+	Timestamp string            `json:"ts"`
+	Level     string            `json:"level"`
+	Message   string            `json:"msg"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
+
+// Execution identifies the execution a log belongs to.
+type Execution struct {
+	Namespace   string `json:"namespace"`
+	Plan        string `json:"plan"`
+	Target      string `json:"target"`
+	ExecutionID string `json:"executionId"`
+}
+
+// Log is the stored log of an execution.
+type Log struct {
+	Execution
+
+	// Dropped is the number of oldest entries dropped to stay within the size limit.
+	Dropped int `json:"dropped,omitempty"`
+
+	// UpdatedAt is when entries were last appended.
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Entries []Entry `json:"entries"`
+}
+
+// ConfigMapName returns the name of the ConfigMap storing an execution's logs.
+func ConfigMapName(executionID string) string {
+	return executionID + "-logs"
+}
+
+// Get returns the stored log of an execution in namespace. It returns a
+// NotFound error when no log is stored for it.
+func Get(ctx context.Context, c client.Reader, namespace, executionID string) (*Log, error) {
+	cm := &corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: namespace, Name: ConfigMapName(executionID)}
+	if err := c.Get(ctx, key, cm); err != nil {
+		return nil, err
+	}
+	if cm.Labels[wellknown.LabelExecutionLog] != "true" {
+		return nil, apierrors.NewNotFound(corev1.Resource("configmaps"), key.Name)
+	}
+	return decode(cm), nil
+}
+
+// List returns the stored logs of a plan's executions, oldest first.
+func List(ctx context.Context, c client.Reader, namespace, plan string) ([]Log, error) {
+	var list corev1.ConfigMapList
+	if err := c.List(ctx, &list, client.InNamespace(namespace), client.MatchingLabels{
+		wellknown.LabelExecutionLog: "true",
+		wellknown.LabelPlan:         plan,
+	}); err != nil {
+		return nil, fmt.Errorf("list execution logs: %w", err)
+	}
+
+	logs := make([]Log, 0, len(list.Items))
+	for i := range list.Items {
+		logs = append(logs, *decode(&list.Items[i]))
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		return logs[i].UpdatedAt.Before(logs[j].UpdatedAt)
+	})
+	return logs, nil
+}
+
+func decode(cm *corev1.ConfigMap) *Log {
+	return &Log{
+		Execution: Execution{
+			Namespace:   cm.Namespace,
+			Plan:        cm.Labels[wellknown.LabelPlan],
+			Target:      cm.Labels[wellknown.LabelTarget],
+			ExecutionID: cm.Labels[wellknown.LabelExecutionID],
+		},
+		Dropped:   ringlog.Dropped(cm),
+		UpdatedAt: updatedAt(cm),
+		Entries:   ringlog.Decode[Entry](cm, DataKey),
+	}
+}
+
+func updatedAt(cm *corev1.ConfigMap) time.Time {
+	t, err := time.Parse(time.RFC3339, cm.Annotations[wellknown.AnnotationLogUpdatedAt])
+	if err != nil {
+		return cm.CreationTimestamp.Time
+	}
+	return t
+}
+
+// Options configures a Store.
+type Options struct {
+	// MaxSize bounds the size of an execution's stored entries. Zero uses DefaultMaxSize.
+	MaxSize int
+
+	// Retention is how long logs are kept after their last entry. Zero keeps
+	// them until their plan is deleted.
+	Retention time.Duration
+
+	// FlushInterval is how often buffered entries are written. Zero uses DefaultFlushInterval.
+	FlushInterval time.Duration
+
+	Clock clock.Clock
+}
+
+// pending holds the entries of an execution that are not written yet.
+type pending struct {
+	exec    Execution
+	lines   [][]byte
+	size    int
+	dropped int
+}
+
+// Store buffers runner log entries in memory and periodically writes them to
+// the execution log ConfigMaps. Every replica receiving runner streams writes
+// the entries it received, so the Store runs without leader election.
+type Store struct {
+	client        client.Client
+	maxSize       int
+	retention     time.Duration
+	flushInterval time.Duration
+	clock         clock.Clock
+	log           logr.Logger
+
+	mu      sync.Mutex
+	pending map[string]*pending
+}
+
+// NewStore creates a Store writing execution logs with c.
+func NewStore(c client.Client, opts Options, log logr.Logger) *Store {
+	s := &Store{
+		client:        c,
+		maxSize:       opts.MaxSize,
+		retention:     opts.Retention,
+		flushInterval: opts.FlushInterval,
+		clock:         clock.RealClock{},
+		log:           log.WithName("execution-log"),
+		pending:       make(map[string]*pending),
+	}
+	if opts.Clock != nil {
+		s.clock = opts.Clock
+	}
+	if s.maxSize <= 0 {
+		s.maxSize = DefaultMaxSize
+	}
+	if s.flushInterval <= 0 {
+		s.flushInterval = DefaultFlushInterval
+	}
+	return s
+}
+
+// Append buffers an entry of exec until the next flush. The buffer of an
+// execution is bounded by the size limit like its ConfigMap.
+func (s *Store) Append(exec Execution, entry Entry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.pending[exec.ExecutionID]
+	if !ok {
+		p = &pending{exec: exec}
+		s.pending[exec.ExecutionID] = p
+	}
+	p.lines = append(p.lines, line)
+	p.size += len(line)
+	s.trim(p)
+}
+
+// trim drops the oldest lines of p until it fits the size limit.
+func (s *Store) trim(p *pending) {
+	for p.size > s.maxSize && len(p.lines) > 0 {
+		p.size -= len(p.lines[0])
+		p.lines = p.lines[1:]
+		p.dropped++
+	}
+}
+
+// Flush writes all buffered entries. Entries that cannot be written are
+// buffered again for the next flush, unless their namespace is gone.
+func (s *Store) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	batch := s.pending
+	s.pending = make(map[string]*pending)
+	s.mu.Unlock()
+
+	var errs []error
+	for id, p := range batch {
+		err := s.write(ctx, p)
+		if err == nil {
+			continue
+		}
+		if apierrors.IsNotFound(err) {
+			s.log.V(1).Info("dropping execution log entries, namespace not found",
+				"namespace", p.exec.Namespace, "executionId", id)
+			continue
+		}
+		errs = append(errs, fmt.Errorf("execution %s: %w", id, err))
+		s.requeue(p)
+	}
+	return errors.Join(errs...)
+}
+
+// requeue buffers the entries of a failed write again, ahead of entries
+// appended since.
+func (s *Store) requeue(p *pending) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if newer, ok := s.pending[p.exec.ExecutionID]; ok {
+		p.lines = append(p.lines, newer.lines...)
+		p.size += newer.size
+		p.dropped += newer.dropped
+	}
+	s.pending[p.exec.ExecutionID] = p
+	s.trim(p)
+}
+
+// write appends the entries of p to the execution's ConfigMap.
+func (s *Store) write(ctx context.Context, p *pending) error {
+	key := types.NamespacedName{Namespace: p.exec.Namespace, Name: ConfigMapName(p.exec.ExecutionID)}
+
+	return ringlog.Write(ctx, s.client, key,
+		func() *corev1.ConfigMap { return s.newConfigMap(ctx, key, p.exec) },
+		func(cm *corev1.ConfigMap) { s.merge(cm, p) },
+	)
+}
+
+func (s *Store) newConfigMap(ctx context.Context, key types.NamespacedName, exec Execution) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				wellknown.LabelExecutionLog: "true",
+				wellknown.LabelPlan:         exec.Plan,
+				wellknown.LabelTarget:       exec.Target,
+				wellknown.LabelExecutionID:  exec.ExecutionID,
+			},
+		},
+	}
+
+	// Logs are deleted with their plan. When the plan cannot be read, the log
+	// is still stored and left to the retention.
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := s.client.Get(ctx, types.NamespacedName{Namespace: exec.Namespace, Name: exec.Plan}, &plan); err == nil {
+		_ = controllerutil.SetOwnerReference(&plan, cm, s.client.Scheme())
+	}
+	return cm
+}
+
+// merge appends the entries of p to cm, dropping its oldest entries beyond
+// the size limit.
+func (s *Store) merge(cm *corev1.ConfigMap, p *pending) {
+	ringlog.Append(cm, DataKey, p.lines, s.maxSize, p.dropped)
+
+	if cm.Annotations == nil {
+		cm.Annotations = make(map[string]string)
+	}
+	cm.Annotations[wellknown.AnnotationLogUpdatedAt] = s.clock.Now().UTC().Format(time.RFC3339)
+}
+
+// Prune deletes logs whose last entry is older than the retention. It is a
+// no-op without a retention.
+func (s *Store) Prune(ctx context.Context) error {
+	if s.retention <= 0 {
+		return nil
+	}
+
+	var list corev1.ConfigMapList
+	if err := s.client.List(ctx, &list, client.MatchingLabels{wellknown.LabelExecutionLog: "true"}); err != nil {
+		return fmt.Errorf("list execution logs: %w", err)
+	}
+
+	cutoff := s.clock.Now().Add(-s.retention)
+	var errs []error
+	for i := range list.Items {
+		cm := &list.Items[i]
+		if !updatedAt(cm).Before(cutoff) {
+			continue
+		}
+		if err := s.client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("delete execution log %s/%s: %w", cm.Namespace, cm.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Start flushes buffered entries and prunes expired logs periodically until
+// ctx is cancelled, then flushes what is left.
+func (s *Store) Start(ctx context.Context) error {
+	flush := time.NewTicker(s.flushInterval)
+	defer flush.Stop()
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := s.Flush(flushCtx); err != nil {
+				s.log.Error(err, "failed to flush execution logs on shutdown")
+			}
+			return nil
+		case <-flush.C:
+			if err := s.Flush(ctx); err != nil {
+				s.log.Error(err, "failed to flush execution logs")
+			}
+		case <-prune.C:
+			if err := s.Prune(ctx); err != nil {
+				s.log.Error(err, "failed to prune execution logs")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection reports false: each replica writes the entries of the
+// runner streams it receives.
+func (s *Store) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executionlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+var testExec = Execution{Namespace: "team-a", Plan: "dev", Target: "db", ExecutionID: "dev-db-1700000000"}
+
+func newTestClient(funcs interceptor.Funcs, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = hibernatorv1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithInterceptorFuncs(funcs).Build()
+}
+
+func testPlan() *hibernatorv1alpha1.HibernatePlan {
+	return &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-a", UID: "plan-uid"},
+	}
+}
+
+func entry(msg string) Entry {
+	return Entry{Timestamp: "2026-10-16T03:00:00Z", Level: "INFO", Message: msg}
+}
+
+func TestStore_FlushAppendsToConfigMap(t *testing.T) {
+	ctx := context.Background()
+	clk := clocktesting.NewFakeClock(time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC))
+	c := newTestClient(interceptor.Funcs{}, testPlan())
+	store := NewStore(c, Options{Clock: clk}, logr.Discard())
+
+	store.Append(testExec, entry("starting runner"))
+	store.Append(testExec, Entry{Timestamp: "2026-10-16T03:00:01Z", Level: "ERROR", Message: "wakeup failed", Fields: map[string]string{"error": "timeout"}})
+	require.NoError(t, store.Flush(ctx))
+
+	clk.Step(time.Minute)
+	store.Append(testExec, entry("runner exiting"))
+	require.NoError(t, store.Flush(ctx))
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "dev-db-1700000000-logs"}, cm))
+	assert.Equal(t, "true", cm.Labels[wellknown.LabelExecutionLog])
+	assert.Equal(t, "dev", cm.Labels[wellknown.LabelPlan])
+	assert.Equal(t, "db", cm.Labels[wellknown.LabelTarget])
+	require.Len(t, cm.OwnerReferences, 1)
+	assert.Equal(t, "HibernatePlan", cm.OwnerReferences[0].Kind)
+	assert.Equal(t, "2026-10-16T03:01:00Z", cm.Annotations[wellknown.AnnotationLogUpdatedAt])
+
+	log, err := Get(ctx, c, "team-a", testExec.ExecutionID)
+	require.NoError(t, err)
+	assert.Equal(t, testExec, log.Execution)
+	require.Len(t, log.Entries, 3)
+	assert.Equal(t, "wakeup failed", log.Entries[1].Message)
+	assert.Equal(t, "timeout", log.Entries[1].Fields["error"])
+	assert.Equal(t, "runner exiting", log.Entries[2].Message)
+	assert.Zero(t, log.Dropped)
+}
+
+func TestStore_DropsOldestEntriesBeyondMaxSize(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(interceptor.Funcs{}, testPlan())
+	line := len(mustLine(t, entry("message-00")))
+	store := NewStore(c, Options{MaxSize: 3 * line}, logr.Discard())
+
+	for i := range 2 {
+		store.Append(testExec, entry(fmt.Sprintf("message-%02d", i)))
+	}
+	require.NoError(t, store.Flush(ctx))
+	for i := 2; i < 6; i++ {
+		store.Append(testExec, entry(fmt.Sprintf("message-%02d", i)))
+	}
+	require.NoError(t, store.Flush(ctx))
+
+	log, err := Get(ctx, c, "team-a", testExec.ExecutionID)
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 3)
+	assert.Equal(t, "message-03", log.Entries[0].Message)
+	assert.Equal(t, "message-05", log.Entries[2].Message)
+	assert.Equal(t, 3, log.Dropped)
+}
+
+func TestStore_RequeuesEntriesOfFailedWrites(t *testing.T) {
+	ctx := context.Background()
+	fail := true
+	c := newTestClient(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if fail {
+				return errors.New("apiserver unavailable")
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	}, testPlan())
+	store := NewStore(c, Options{}, logr.Discard())
+
+	store.Append(testExec, entry("first"))
+	assert.ErrorContains(t, store.Flush(ctx), "apiserver unavailable")
+
+	fail = false
+	store.Append(testExec, entry("second"))
+	require.NoError(t, store.Flush(ctx))
+
+	log, err := Get(ctx, c, "team-a", testExec.ExecutionID)
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 2)
+	assert.Equal(t, "first", log.Entries[0].Message)
+	assert.Equal(t, "second", log.Entries[1].Message)
+}
+
+func TestStore_StoresLogWithoutPlan(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(interceptor.Funcs{})
+	store := NewStore(c, Options{}, logr.Discard())
+
+	store.Append(testExec, entry("orphan"))
+	require.NoError(t, store.Flush(ctx))
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: ConfigMapName(testExec.ExecutionID)}, cm))
+	assert.Empty(t, cm.OwnerReferences)
+}
+
+func TestStore_Prune(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC)
+	stored := func(id string, updated time.Time) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        ConfigMapName(id),
+				Namespace:   "team-a",
+				Labels:      map[string]string{wellknown.LabelExecutionLog: "true", wellknown.LabelPlan: "dev", wellknown.LabelExecutionID: id},
+				Annotations: map[string]string{wellknown.AnnotationLogUpdatedAt: updated.Format(time.RFC3339)},
+			},
+		}
+	}
+	c := newTestClient(interceptor.Funcs{},
+		stored("old", now.Add(-8*24*time.Hour)),
+		stored("recent", now.Add(-time.Hour)),
+	)
+
+	require.NoError(t, NewStore(c, Options{Clock: clocktesting.NewFakeClock(now)}, logr.Discard()).Prune(ctx),
+		"no retention keeps everything")
+	logs, err := List(ctx, c, "team-a", "dev")
+	require.NoError(t, err)
+	assert.Len(t, logs, 2)
+
+	store := NewStore(c, Options{Retention: 7 * 24 * time.Hour, Clock: clocktesting.NewFakeClock(now)}, logr.Discard())
+	require.NoError(t, store.Prune(ctx))
+
+	logs, err = List(ctx, c, "team-a", "dev")
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "recent", logs[0].ExecutionID)
+}
+
+func TestGet_IgnoresUnrelatedConfigMap(t *testing.T) {
+	c := newTestClient(interceptor.Funcs{}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName("app"), Namespace: "team-a"},
+	})
+
+	_, err := Get(context.Background(), c, "team-a", "app")
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestList_OrdersByLastUpdate(t *testing.T) {
+	ctx := context.Background()
+	clk := clocktesting.NewFakeClock(time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC))
+	c := newTestClient(interceptor.Funcs{}, testPlan())
+	store := NewStore(c, Options{Clock: clk}, logr.Discard())
+
+	store.Append(testExec, entry("first"))
+	require.NoError(t, store.Flush(ctx))
+	first := Execution{Namespace: "team-a", Plan: "dev", Target: "app", ExecutionID: "dev-app-1700000000"}
+	clk.Step(-time.Hour)
+	store.Append(first, entry("first"))
+	require.NoError(t, store.Flush(ctx))
+
+	logs, err := List(ctx, c, "team-a", "dev")
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, first.ExecutionID, logs[0].ExecutionID)
+	assert.Equal(t, testExec.ExecutionID, logs[1].ExecutionID)
+}
+
+func mustLine(t *testing.T, e Entry) []byte {
+	t.Helper()
+	store := NewStore(nil, Options{}, logr.Discard())
+	store.Append(testExec, e)
+	return store.pending[testExec.ExecutionID].lines[0]
+}
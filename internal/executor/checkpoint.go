@@ -0,0 +1,33 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+// Checkpoint records the resources an operation has processed, so that when
+// the runner Job retries the execution, the executor skips them instead of
+// processing them again. Implementations must be safe for concurrent use.
+type Checkpoint interface {
+	// Done reports whether key was processed by an earlier attempt of the
+	// execution.
+	Done(key string) bool
+
+	// Mark records key as processed. Executors call it once the operation was
+	// applied to the resource, or the resource was skipped for good, using the
+	// same key as its restore data.
+	Mark(key string)
+}
+
+// Checkpointed reports whether key was processed by an earlier attempt of the
+// execution. It is false when the spec carries no checkpoint.
+func (s Spec) Checkpointed(key string) bool {
+	return s.Checkpoint != nil && s.Checkpoint.Done(key)
+}
+
+// MarkCheckpoint records key as processed when the spec carries a checkpoint.
+func (s Spec) MarkCheckpoint(key string) {
+	if s.Checkpoint != nil {
+		s.Checkpoint.Mark(key)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package cloudsql
+
+import (
+	"context"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+)
+
+// Activation policies of a Cloud SQL instance. An instance runs while its
+// policy is ALWAYS and is stopped while it is NEVER.
+const (
+	ActivationPolicyAlways = "ALWAYS"
+	ActivationPolicyNever  = "NEVER"
+)
+
+// Instance is the subset of a Cloud SQL instance the executor reads.
+type Instance struct {
+	Name             string
+	Tier             string
+	State            string
+	ActivationPolicy string
+
+	// MasterInstanceName is the primary of a replica, empty for a primary.
+	MasterInstanceName string
+
+	// ReplicaNames are the replicas of a primary.
+	ReplicaNames []string
+
+	// FailoverReplicaName is the legacy high-availability failover replica of
+	// a primary, if any.
+	FailoverReplicaName string
+
+	// Labels are the user labels of the instance.
+	Labels map[string]string
+}
+
+// Client defines the subset of the Cloud SQL Admin API used by the executor.
+type Client interface {
+	// GetInstance retrieves the instance.
+	GetInstance(ctx context.Context, project, name string) (*Instance, error)
+
+	// SetActivationPolicy patches the activation policy of the instance and
+	// blocks until the operation has completed, so the next instance is only
+	// stopped or started once this one is.
+	SetActivationPolicy(ctx context.Context, project, name, policy string) error
+}
+
+// ClientFactory is a function type for creating Cloud SQL Admin API clients.
+type ClientFactory func(ctx context.Context, spec *executor.Spec) (Client, error)
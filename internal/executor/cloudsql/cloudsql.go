@@ -9,6 +9,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 
@@ -18,12 +20,36 @@ import (
 
 const ExecutorType = "cloudsql"
 
+// Roles of an instance in the replication topology of the target primary.
+const (
+	RolePrimary         = "primary"
+	RoleFailoverReplica = "failoverReplica"
+	RoleReadReplica     = "readReplica"
+)
+
+// startRank orders the roles for wakeup: the primary comes up first, then its
+// failover replica, then the read replicas replicating from it. Shutdown stops
+// them in the reverse order, so no replica loses its primary while running.
+var startRank = map[string]int{
+	RolePrimary:         0,
+	RoleFailoverReplica: 1,
+	RoleReadReplica:     2,
+}
+
 // Executor implements hibernation for GCP Cloud SQL instances.
-type Executor struct{}
+type Executor struct {
+	clientFactory ClientFactory
+}
 
 // New creates a new Cloud SQL executor.
 func New() *Executor {
-	return &Executor{}
+	return &Executor{clientFactory: newSQLAdminClient}
+}
+
+// NewWithClients creates a new Cloud SQL executor with an injected client factory.
+// This is useful for testing with mock clients.
+func NewWithClients(clientFactory ClientFactory) *Executor {
+	return &Executor{clientFactory: clientFactory}
 }
 
 // Type returns the executor type.
@@ -48,7 +74,8 @@ func (e *Executor) Validate(spec executor.Spec) error {
 	return nil
 }
 
-// Shutdown stops a Cloud SQL instance.
+// Shutdown stops a Cloud SQL instance along with its replicas, read replicas
+// first and the primary last.
 func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.Spec) (*executor.Result, error) {
 	log = log.WithName("cloudsql").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
 	log.Info("executor starting shutdown")
@@ -58,14 +85,136 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		return nil, fmt.Errorf("parse parameters: %w", err)
 	}
 
-	// TODO: Implement actual Cloud SQL API calls using google.golang.org/api/sqladmin/v1
-	// For now, return a placeholder implementation
+	client, err := e.clientFactory(ctx, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("build Cloud SQL client: %w", err)
+	}
+
+	topology, err := e.topology(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("replication topology discovered", "primary", params.InstanceName, "replicas", len(topology)-1)
 
-	log.Info("shutdown completed")
-	return &executor.Result{Message: fmt.Sprintf("stopped Cloud SQL instance %s", params.InstanceName)}, nil
+	if err := executor.CheckResourceLimit(spec, len(topology), "Cloud SQL instance"); err != nil {
+		log.Error(err, "refusing to shut down")
+		return nil, err
+	}
+
+	// Stop in the reverse of the start order.
+	sortForWakeUp(topology)
+	slices.Reverse(topology)
+
+	var stopped, protected int
+	for _, state := range topology {
+		if state.Protected {
+			log.Info("instance is protected, skipping", "instance", state.InstanceName, "role", state.Role)
+			protected++
+			continue
+		}
+
+		// An instance stopped by an earlier attempt keeps the state that
+		// attempt saved, which records it as running.
+		if state.ActivationPolicy != ActivationPolicyAlways {
+			log.Info("instance not running, skipping", "instance", state.InstanceName, "role", state.Role, "activationPolicy", state.ActivationPolicy)
+			continue
+		}
+
+		// Persist before stopping, so a failed attempt can still be woken up.
+		if spec.ReportStateCallback != nil {
+			if err := spec.ReportStateCallback(state.InstanceName, state); err != nil {
+				return nil, fmt.Errorf("save state of instance %s: %w", state.InstanceName, err)
+			}
+		}
+
+		log.Info("stopping instance", "instance", state.InstanceName, "role", state.Role)
+		if err := client.SetActivationPolicy(ctx, params.Project, state.InstanceName, ActivationPolicyNever); err != nil {
+			return nil, fmt.Errorf("stop instance %s: %w", state.InstanceName, err)
+		}
+		stopped++
+	}
+
+	log.Info("shutdown completed", "stopped", stopped, "protected", protected)
+	msg := fmt.Sprintf("stopped %d Cloud SQL instance(s) of %s (%d replica(s))", stopped, params.InstanceName, len(topology)-1)
+	if protected > 0 {
+		msg += fmt.Sprintf("; skipped %d protected instance(s)", protected)
+	}
+	return &executor.Result{Message: msg}, nil
 }
 
-// WakeUp starts a Cloud SQL instance.
+// topology returns the state of the primary instance and of its replicas.
+func (e *Executor) topology(ctx context.Context, client Client, params executorparams.CloudSQLParameters) ([]InstanceState, error) {
+	primary, err := client.GetInstance(ctx, params.Project, params.InstanceName)
+	if err != nil {
+		return nil, fmt.Errorf("get instance %s: %w", params.InstanceName, err)
+	}
+	if primary.MasterInstanceName != "" {
+		return nil, fmt.Errorf("instance %s is a replica of %s, target the primary instance instead", primary.Name, primary.MasterInstanceName)
+	}
+
+	topology := []InstanceState{stateOf(params.Project, primary, RolePrimary)}
+	replicas := primary.ReplicaNames
+	if primary.FailoverReplicaName != "" && !slices.Contains(replicas, primary.FailoverReplicaName) {
+		replicas = append(slices.Clone(replicas), primary.FailoverReplicaName)
+	}
+	for _, name := range replicas {
+		replica, err := client.GetInstance(ctx, params.Project, name)
+		if err != nil {
+			return nil, fmt.Errorf("get replica %s: %w", name, err)
+		}
+		role := RoleReadReplica
+		if name == primary.FailoverReplicaName {
+			role = RoleFailoverReplica
+		}
+		topology = append(topology, stateOf(params.Project, replica, role))
+	}
+	return topology, nil
+}
+
+// stateOf returns the restore state of an instance with the given role.
+func stateOf(project string, instance *Instance, role string) InstanceState {
+	return InstanceState{
+		InstanceName:     instance.Name,
+		Project:          project,
+		Tier:             instance.Tier,
+		Status:           instance.State,
+		ActivationPolicy: instance.ActivationPolicy,
+		Role:             role,
+		PrimaryInstance:  instance.MasterInstanceName,
+		Protected:        executor.IsGCPProtected(instance.Labels),
+	}
+}
+
+// sortForWakeUp orders states by role in start order, then by name.
+func sortForWakeUp(states []InstanceState) {
+	slices.SortStableFunc(states, func(a, b InstanceState) int {
+		if d := startRank[a.Role] - startRank[b.Role]; d != 0 {
+			return d
+		}
+		return strings.Compare(a.InstanceName, b.InstanceName)
+	})
+}
+
+// ValidateRestore checks that every restore entry is a valid instance state
+// whose replicas name their primary.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries(restore, func(_ string, state *InstanceState) error {
+		if state.Role == "" {
+			// Saved before replica topologies were recorded.
+			return nil
+		}
+		if _, ok := startRank[state.Role]; !ok {
+			return fmt.Errorf("unknown role %q", state.Role)
+		}
+		if state.Role != RolePrimary && state.PrimaryInstance == "" {
+			return fmt.Errorf("missing primary instance of %s", state.Role)
+		}
+		return nil
+	})
+}
+
+// WakeUp starts the Cloud SQL instances stopped by Shutdown, the primary first
+// and its read replicas last.
 func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
 	log = log.WithName("cloudsql").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
 	log.Info("executor starting wakeup")
@@ -74,20 +223,36 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 		return nil, fmt.Errorf("restore data is required for wake-up")
 	}
 
-	// Iterate over all instances in restore data
+	states := make([]InstanceState, 0, len(restore.Data))
 	for instanceName, stateBytes := range restore.Data {
 		var state InstanceState
 		if err := json.Unmarshal(stateBytes, &state); err != nil {
 			return nil, fmt.Errorf("unmarshal instance state %s: %w", instanceName, err)
 		}
+		if state.InstanceName == "" {
+			state.InstanceName = instanceName
+		}
+		if state.Role == "" {
+			state.Role = RolePrimary
+		}
+		states = append(states, state)
+	}
+	sortForWakeUp(states)
 
-		// TODO: Implement actual Cloud SQL API calls to start the instance
-		// For now, this is a placeholder
-		_ = state
+	client, err := e.clientFactory(ctx, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("build Cloud SQL client: %w", err)
 	}
 
-	log.Info("wakeup completed", "instanceCount", len(restore.Data))
-	return &executor.Result{Message: fmt.Sprintf("started %d Cloud SQL instance(s)", len(restore.Data))}, nil
+	for _, state := range states {
+		log.Info("starting instance", "instance", state.InstanceName, "role", state.Role)
+		if err := client.SetActivationPolicy(ctx, state.Project, state.InstanceName, ActivationPolicyAlways); err != nil {
+			return nil, fmt.Errorf("start instance %s: %w", state.InstanceName, err)
+		}
+	}
+
+	log.Info("wakeup completed", "instanceCount", len(states))
+	return &executor.Result{Message: fmt.Sprintf("started %d Cloud SQL instance(s)", len(states))}, nil
 }
 
 // InstanceState stores the original state of a Cloud SQL instance.
@@ -96,4 +261,17 @@ type InstanceState struct {
 	Project      string `json:"project"`
 	Tier         string `json:"tier"`
 	Status       string `json:"status"`
+
+	// ActivationPolicy is the policy of the instance before it was stopped.
+	ActivationPolicy string `json:"activationPolicy,omitempty"`
+
+	// Role is the role of the instance in the replication topology.
+	Role string `json:"role,omitempty"`
+
+	// PrimaryInstance is the primary a replica replicates from.
+	PrimaryInstance string `json:"primaryInstance,omitempty"`
+
+	// Protected is set for instances labeled hibernator-protected=true, which
+	// are never stopped (not persisted).
+	Protected bool `json:"-"`
 }
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package cloudsql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+)
+
+// fakeClient serves instances of a single project and records the activation
+// policy changes in order.
+type fakeClient struct {
+	instances map[string]*Instance
+	calls     []string
+}
+
+func (c *fakeClient) GetInstance(_ context.Context, project, name string) (*Instance, error) {
+	instance, ok := c.instances[name]
+	if !ok || project != "acme" {
+		return nil, fmt.Errorf("instance %s/%s not found", project, name)
+	}
+	copied := *instance
+	return &copied, nil
+}
+
+func (c *fakeClient) SetActivationPolicy(_ context.Context, project, name, policy string) error {
+	instance, ok := c.instances[name]
+	if !ok || project != "acme" {
+		return fmt.Errorf("instance %s/%s not found", project, name)
+	}
+	instance.ActivationPolicy = policy
+	c.calls = append(c.calls, policy+" "+name)
+	return nil
+}
+
+func newTopology() *fakeClient {
+	return &fakeClient{instances: map[string]*Instance{
+		"db": {
+			Name:                "db",
+			ActivationPolicy:    ActivationPolicyAlways,
+			ReplicaNames:        []string{"db-replica-b", "db-replica-a", "db-failover"},
+			FailoverReplicaName: "db-failover",
+		},
+		"db-failover":  {Name: "db-failover", ActivationPolicy: ActivationPolicyAlways, MasterInstanceName: "db"},
+		"db-replica-a": {Name: "db-replica-a", ActivationPolicy: ActivationPolicyAlways, MasterInstanceName: "db"},
+		// Already stopped before hibernation.
+		"db-replica-b": {Name: "db-replica-b", ActivationPolicy: ActivationPolicyNever, MasterInstanceName: "db"},
+	}}
+}
+
+func testSpec(restore *executor.RestoreData) executor.Spec {
+	return executor.Spec{
+		TargetName: "db",
+		TargetType: ExecutorType,
+		Parameters: json.RawMessage(`{"instanceName": "db", "project": "acme"}`),
+		ReportStateCallback: func(key string, value any) error {
+			raw, err := json.Marshal(value)
+			restore.Data[key] = raw
+			return err
+		},
+	}
+}
+
+func TestShutdownAndWakeUp_OrdersReplicas(t *testing.T) {
+	ctx := context.Background()
+	client := newTopology()
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return client, nil })
+
+	restore := executor.RestoreData{Type: ExecutorType, Data: map[string]json.RawMessage{}}
+	spec := testSpec(&restore)
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "stopped 3 Cloud SQL instance(s) of db (3 replica(s))", result.Message)
+	assert.Equal(t, []string{"NEVER db-replica-a", "NEVER db-failover", "NEVER db"}, client.calls)
+
+	require.NoError(t, e.ValidateRestore(restore))
+	var state InstanceState
+	require.NoError(t, json.Unmarshal(restore.Data["db-failover"], &state))
+	assert.Equal(t, RoleFailoverReplica, state.Role)
+	assert.Equal(t, "db", state.PrimaryInstance)
+	assert.NotContains(t, restore.Data, "db-replica-b")
+
+	// A retried shutdown keeps the states saved by the first attempt.
+	client.calls = nil
+	result, err = e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "stopped 0 Cloud SQL instance(s) of db (3 replica(s))", result.Message)
+	assert.Empty(t, client.calls)
+	require.NoError(t, json.Unmarshal(restore.Data["db"], &state))
+	assert.Equal(t, ActivationPolicyAlways, state.ActivationPolicy)
+
+	result, err = e.WakeUp(ctx, logr.Discard(), spec, restore)
+	require.NoError(t, err)
+	assert.Equal(t, "started 3 Cloud SQL instance(s)", result.Message)
+	assert.Equal(t, []string{"ALWAYS db", "ALWAYS db-failover", "ALWAYS db-replica-a"}, client.calls)
+	assert.Equal(t, ActivationPolicyNever, client.instances["db-replica-b"].ActivationPolicy)
+}
+
+func TestShutdown_SkipsProtectedInstances(t *testing.T) {
+	client := newTopology()
+	client.instances["db-replica-a"].Labels = map[string]string{"hibernator-protected": "true"}
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return client, nil })
+
+	restore := executor.RestoreData{Type: ExecutorType, Data: map[string]json.RawMessage{}}
+	spec := testSpec(&restore)
+
+	result, err := e.Shutdown(context.Background(), logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "stopped 2 Cloud SQL instance(s) of db (3 replica(s)); skipped 1 protected instance(s)", result.Message)
+	assert.Equal(t, []string{"NEVER db-failover", "NEVER db"}, client.calls)
+	assert.NotContains(t, restore.Data, "db-replica-a")
+}
+
+func TestShutdown_RejectsReplicaTarget(t *testing.T) {
+	client := newTopology()
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return client, nil })
+
+	restore := executor.RestoreData{Data: map[string]json.RawMessage{}}
+	spec := testSpec(&restore)
+	spec.Parameters = json.RawMessage(`{"instanceName": "db-replica-a", "project": "acme"}`)
+
+	_, err := e.Shutdown(context.Background(), logr.Discard(), spec)
+	assert.EqualError(t, err, "instance db-replica-a is a replica of db, target the primary instance instead")
+	assert.Empty(t, client.calls)
+}
+
+func TestValidateRestore(t *testing.T) {
+	e := New()
+	restore := executor.RestoreData{Type: ExecutorType, Data: map[string]json.RawMessage{
+		// Saved before replica topologies were recorded.
+		"legacy":  json.RawMessage(`{"instanceName": "legacy", "project": "acme"}`),
+		"replica": json.RawMessage(`{"instanceName": "replica", "project": "acme", "role": "readReplica"}`),
+		"other":   json.RawMessage(`{"instanceName": "other", "project": "acme", "role": "standby"}`),
+	}}
+
+	err := e.ValidateRestore(restore)
+	assert.ErrorIs(t, err, executor.ErrInvalidRestoreData)
+	assert.ErrorContains(t, err, "other: unknown role \"standby\"; replica: missing primary instance of readReplica")
+}
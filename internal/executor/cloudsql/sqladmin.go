@@ -0,0 +1,115 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package cloudsql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/pkg/gcputil"
+)
+
+// operationPollInterval is how often a pending Cloud SQL operation is polled.
+const operationPollInterval = 5 * time.Second
+
+// operationDone is the status of a completed Cloud SQL operation.
+const operationDone = "DONE"
+
+// newSQLAdminClient builds a Client on the Cloud SQL Admin API, authorized with
+// the GCP connector of spec.
+func newSQLAdminClient(ctx context.Context, spec *executor.Spec) (Client, error) {
+	httpClient, err := gcputil.HTTPClient(ctx, spec.ConnectorConfig.GCP)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := sqladmin.NewService(ctx, option.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("create Cloud SQL Admin service: %w", err)
+	}
+	return &sqlAdminClient{svc: svc, pollInterval: operationPollInterval}, nil
+}
+
+// sqlAdminClient implements Client with google.golang.org/api/sqladmin/v1.
+type sqlAdminClient struct {
+	svc          *sqladmin.Service
+	pollInterval time.Duration
+}
+
+// GetInstance implements Client.
+func (c *sqlAdminClient) GetInstance(ctx context.Context, project, name string) (*Instance, error) {
+	db, err := c.svc.Instances.Get(project, name).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	instance := &Instance{
+		Name:               db.Name,
+		State:              db.State,
+		MasterInstanceName: masterInstanceName(db.MasterInstanceName),
+		ReplicaNames:       db.ReplicaNames,
+	}
+	if db.Settings != nil {
+		instance.Tier = db.Settings.Tier
+		instance.ActivationPolicy = db.Settings.ActivationPolicy
+		instance.Labels = db.Settings.UserLabels
+	}
+	if db.FailoverReplica != nil {
+		instance.FailoverReplicaName = db.FailoverReplica.Name
+	}
+	return instance, nil
+}
+
+// SetActivationPolicy implements Client.
+func (c *sqlAdminClient) SetActivationPolicy(ctx context.Context, project, name, policy string) error {
+	op, err := c.svc.Instances.Patch(project, name, &sqladmin.DatabaseInstance{
+		Settings: &sqladmin.Settings{ActivationPolicy: policy},
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, project, op)
+}
+
+// wait polls op until it is done and returns the errors it completed with.
+func (c *sqlAdminClient) wait(ctx context.Context, project string, op *sqladmin.Operation) error {
+	for op.Status != operationDone {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+
+		var err error
+		op, err = c.svc.Operations.Get(project, op.Name).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("get operation: %w", err)
+		}
+	}
+
+	if op.Error == nil || len(op.Error.Errors) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(op.Error.Errors))
+	for _, e := range op.Error.Errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.Code, e.Message))
+	}
+	return fmt.Errorf("operation %s failed: %s", op.Name, strings.Join(msgs, "; "))
+}
+
+// masterInstanceName strips the "project:" prefix the API puts in front of the
+// primary of a replica.
+func masterInstanceName(name string) string {
+	if _, instance, ok := strings.Cut(name, ":"); ok {
+		return instance
+	}
+	return name
+}
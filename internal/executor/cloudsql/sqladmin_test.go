@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package cloudsql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1"
+)
+
+func newTestSQLAdminClient(t *testing.T, handler http.HandlerFunc) *sqlAdminClient {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	svc, err := sqladmin.NewService(context.Background(),
+		option.WithEndpoint(srv.URL),
+		option.WithHTTPClient(srv.Client()),
+	)
+	require.NoError(t, err)
+	return &sqlAdminClient{svc: svc, pollInterval: time.Millisecond}
+}
+
+func TestSQLAdminClient_GetInstance(t *testing.T) {
+	client := newTestSQLAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/acme/instances/db-replica", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(sqladmin.DatabaseInstance{
+			Name:               "db-replica",
+			State:              "RUNNABLE",
+			MasterInstanceName: "acme:db",
+			Settings: &sqladmin.Settings{
+				Tier:             "db-custom-2-7680",
+				ActivationPolicy: ActivationPolicyAlways,
+				UserLabels:       map[string]string{"hibernator-protected": "true"},
+			},
+		})
+	})
+
+	instance, err := client.GetInstance(context.Background(), "acme", "db-replica")
+	require.NoError(t, err)
+	assert.Equal(t, &Instance{
+		Name:               "db-replica",
+		Tier:               "db-custom-2-7680",
+		State:              "RUNNABLE",
+		ActivationPolicy:   ActivationPolicyAlways,
+		MasterInstanceName: "db",
+		Labels:             map[string]string{"hibernator-protected": "true"},
+	}, instance)
+}
+
+func TestSQLAdminClient_SetActivationPolicy(t *testing.T) {
+	var patched sqladmin.DatabaseInstance
+	polls := 0
+	client := newTestSQLAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/projects/acme/instances/db":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+			_ = json.NewEncoder(w).Encode(sqladmin.Operation{Name: "op-1", Status: "PENDING"})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/projects/acme/operations/op-1":
+			polls++
+			status := "RUNNING"
+			if polls == 2 {
+				status = operationDone
+			}
+			_ = json.NewEncoder(w).Encode(sqladmin.Operation{Name: "op-1", Status: status})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	require.NoError(t, client.SetActivationPolicy(context.Background(), "acme", "db", ActivationPolicyNever))
+	require.NotNil(t, patched.Settings)
+	assert.Equal(t, ActivationPolicyNever, patched.Settings.ActivationPolicy)
+	assert.Equal(t, 2, polls, "waits for the operation to complete")
+}
+
+func TestSQLAdminClient_SetActivationPolicyOperationError(t *testing.T) {
+	client := newTestSQLAdminClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(sqladmin.Operation{
+			Name:   "op-1",
+			Status: operationDone,
+			Error: &sqladmin.OperationErrors{Errors: []*sqladmin.OperationError{
+				{Code: "INVALID_REQUEST", Message: "replica cannot be stopped"},
+			}},
+		})
+	})
+
+	err := client.SetActivationPolicy(context.Background(), "acme", "db", ActivationPolicyNever)
+	assert.EqualError(t, err, "operation op-1 failed: INVALID_REQUEST: replica cannot be stopped")
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package delay implements a testing executor that sleeps for a fixed duration,
+// fails with a configurable probability and writes a filler restore payload of
+// a configurable size. It lets users rehearse execution strategies, stage
+// ordering, failure handling and restore storage limits without touching real
+// resources.
+package delay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/pkg/executorparams"
+)
+
+const ExecutorType = "delay"
+
+// DefaultDuration is the sleep applied when the parameters do not set one.
+const DefaultDuration = 5 * time.Second
+
+// Parameters is an alias for the shared delay parameter type.
+type Parameters = executorparams.DelayParameters
+
+// RestoreState is the restore data written by a delay shutdown.
+type RestoreState struct {
+	// OperationTime records when the shutdown operation was performed.
+	OperationTime time.Time `json:"operationTime"`
+	// PayloadBytes is the configured filler payload size.
+	PayloadBytes int `json:"payloadBytes"`
+	// Payload is the filler payload; its length must equal PayloadBytes.
+	Payload string `json:"payload,omitempty"`
+}
+
+// Executor implements the delay testing logic.
+type Executor struct {
+	// random returns a number in [0, 1) used for failure injection.
+	random func() float64
+}
+
+// New creates a new delay executor.
+func New() *Executor {
+	return &Executor{random: rand.Float64}
+}
+
+// Type returns the executor type.
+func (e *Executor) Type() string {
+	return ExecutorType
+}
+
+// Validate validates the executor spec.
+func (e *Executor) Validate(spec executor.Spec) error {
+	if spec.ConnectorConfig.AWS == nil && spec.ConnectorConfig.K8S == nil {
+		return fmt.Errorf("at least one connector (AWS or K8S) must be provided")
+	}
+
+	if result := executorparams.ValidateParams(ExecutorType, spec.Parameters); result != nil && result.HasErrors() {
+		return fmt.Errorf("invalid parameters: %s", strings.Join(result.Errors, "; "))
+	}
+	return nil
+}
+
+// Shutdown sleeps, optionally fails, and reports a filler restore payload.
+func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.Spec) (*executor.Result, error) {
+	log = log.WithName("delay").WithValues("target", spec.TargetName)
+
+	params, duration, err := e.parseParams(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.run(ctx, log, "shutdown", params, duration); err != nil {
+		return nil, err
+	}
+
+	state := RestoreState{
+		OperationTime: time.Now().UTC(),
+		PayloadBytes:  params.RestorePayloadBytes,
+		Payload:       strings.Repeat("x", params.RestorePayloadBytes),
+	}
+	if spec.ReportStateCallback != nil {
+		if err := spec.ReportStateCallback(spec.TargetName, state); err != nil {
+			return nil, fmt.Errorf("save restore data: %w", err)
+		}
+	}
+
+	return &executor.Result{Message: fmt.Sprintf("delay shutdown completed for target %s after %s", spec.TargetName, duration)}, nil
+}
+
+// ValidateRestore checks that every restore entry carries its full payload.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries(restore, func(_ string, state *RestoreState) error {
+		if len(state.Payload) != state.PayloadBytes {
+			return fmt.Errorf("payload is %d bytes, expected %d", len(state.Payload), state.PayloadBytes)
+		}
+		return nil
+	})
+}
+
+// WakeUp sleeps and optionally fails. The restore payload is only checked by
+// ValidateRestore.
+func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
+	log = log.WithName("delay").WithValues("target", spec.TargetName)
+
+	params, duration, err := e.parseParams(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.run(ctx, log, "wakeup", params, duration); err != nil {
+		return nil, err
+	}
+
+	return &executor.Result{Message: fmt.Sprintf("delay wakeup completed for target %s after %s (%d restore entries)",
+		spec.TargetName, duration, len(restore.Data))}, nil
+}
+
+// run sleeps for duration and then decides whether operation fails.
+func (e *Executor) run(ctx context.Context, log logr.Logger, operation string, params Parameters, duration time.Duration) error {
+	log.Info("sleeping", "operation", operation, "duration", duration)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(duration):
+	}
+
+	if params.FailOn != "both" && params.FailOn != operation {
+		return nil
+	}
+	if params.FailureProbability <= 0 || e.random() >= params.FailureProbability {
+		return nil
+	}
+
+	log.Info("injecting failure", "operation", operation, "failureProbability", params.FailureProbability)
+	if params.FailureMessage != "" {
+		return fmt.Errorf("%s (injected %s failure)", params.FailureMessage, operation)
+	}
+	return fmt.Errorf("injected %s failure (failureProbability=%g)", operation, params.FailureProbability)
+}
+
+// parseParams parses the parameters and applies defaults.
+func (e *Executor) parseParams(spec executor.Spec) (Parameters, time.Duration, error) {
+	var params Parameters
+	if len(spec.Parameters) > 0 {
+		if err := json.Unmarshal(spec.Parameters, &params); err != nil {
+			return Parameters{}, 0, fmt.Errorf("unmarshal parameters: %w", err)
+		}
+	}
+	if params.FailOn == "" {
+		params.FailOn = "both"
+	}
+
+	duration := DefaultDuration
+	if params.Duration != "" {
+		d, err := time.ParseDuration(params.Duration)
+		if err != nil {
+			return Parameters{}, 0, fmt.Errorf("parse duration: %w", err)
+		}
+		duration = d
+	}
+	return params, duration, nil
+}
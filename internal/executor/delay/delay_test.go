@@ -0,0 +1,127 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package delay
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+)
+
+func testSpec(params string) executor.Spec {
+	return executor.Spec{
+		TargetName:      "rehearsal",
+		TargetType:      ExecutorType,
+		Parameters:      json.RawMessage(params),
+		ConnectorConfig: executor.ConnectorConfig{K8S: &executor.K8SConnectorConfig{}},
+	}
+}
+
+func fixedRandom(v float64) func() float64 {
+	return func() float64 { return v }
+}
+
+func TestExecutor_Validate(t *testing.T) {
+	e := New()
+
+	require.NoError(t, e.Validate(testSpec(`{"duration": "1s"}`)))
+	assert.ErrorContains(t, e.Validate(testSpec(`{"failureProbability": 2}`)), "failureProbability")
+
+	spec := testSpec(`{}`)
+	spec.ConnectorConfig = executor.ConnectorConfig{}
+	assert.ErrorContains(t, e.Validate(spec), "connector")
+}
+
+func TestExecutor_Shutdown_ReportsPayload(t *testing.T) {
+	e := New()
+	spec := testSpec(`{"duration": "0s", "restorePayloadBytes": 2048}`)
+
+	var reported RestoreState
+	spec.ReportStateCallback = func(key string, value any) error {
+		assert.Equal(t, "rehearsal", key)
+		reported = value.(RestoreState)
+		return nil
+	}
+
+	result, err := e.Shutdown(context.Background(), logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Contains(t, result.Message, "delay shutdown completed")
+	assert.Equal(t, 2048, reported.PayloadBytes)
+	assert.Len(t, reported.Payload, 2048)
+}
+
+func TestExecutor_FailureInjection(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    string
+		random    float64
+		shutdown  bool
+		wantError bool
+	}{
+		{name: "below probability fails", params: `{"duration": "0s", "failureProbability": 0.5}`, random: 0.2, shutdown: true, wantError: true},
+		{name: "above probability succeeds", params: `{"duration": "0s", "failureProbability": 0.5}`, random: 0.7, shutdown: true},
+		{name: "zero probability never fails", params: `{"duration": "0s"}`, random: 0, shutdown: true},
+		{name: "failOn wakeup spares shutdown", params: `{"duration": "0s", "failureProbability": 1, "failOn": "wakeup"}`, random: 0, shutdown: true},
+		{name: "failOn wakeup fails wakeup", params: `{"duration": "0s", "failureProbability": 1, "failOn": "wakeup"}`, random: 0, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Executor{random: fixedRandom(tt.random)}
+			spec := testSpec(tt.params)
+
+			var err error
+			if tt.shutdown {
+				_, err = e.Shutdown(context.Background(), logr.Discard(), spec)
+			} else {
+				_, err = e.WakeUp(context.Background(), logr.Discard(), spec, executor.RestoreData{})
+			}
+
+			if tt.wantError {
+				assert.ErrorContains(t, err, "injected")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExecutor_FailureMessage(t *testing.T) {
+	e := &Executor{random: fixedRandom(0)}
+	spec := testSpec(`{"duration": "0s", "failureProbability": 1, "failureMessage": "quota exceeded"}`)
+
+	_, err := e.Shutdown(context.Background(), logr.Discard(), spec)
+	assert.ErrorContains(t, err, "quota exceeded")
+}
+
+func TestExecutor_Shutdown_ContextCancellation(t *testing.T) {
+	e := New()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := e.Shutdown(ctx, logr.Discard(), testSpec(`{"duration": "1m"}`))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestExecutor_ValidateRestore(t *testing.T) {
+	e := New()
+
+	full, err := json.Marshal(RestoreState{PayloadBytes: 3, Payload: "xxx"})
+	require.NoError(t, err)
+	truncated, err := json.Marshal(RestoreState{PayloadBytes: 3, Payload: "x"})
+	require.NoError(t, err)
+
+	assert.NoError(t, e.ValidateRestore(executor.RestoreData{Data: map[string]json.RawMessage{"rehearsal": full}}))
+	err = e.ValidateRestore(executor.RestoreData{Data: map[string]json.RawMessage{"rehearsal": truncated}})
+	assert.ErrorIs(t, err, executor.ErrInvalidRestoreData)
+}
@@ -16,6 +16,13 @@ type EC2Client interface {
 		optFns ...func(*ec2.Options),
 	) (*ec2.DescribeInstancesOutput, error)
 
+	// DescribeInstanceAttribute describes an attribute of an EC2 instance.
+	DescribeInstanceAttribute(
+		ctx context.Context,
+		params *ec2.DescribeInstanceAttributeInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeInstanceAttributeOutput, error)
+
 	// StopInstances stops one or more running EC2 instances.
 	StopInstances(
 		ctx context.Context,
@@ -29,4 +36,74 @@ type EC2Client interface {
 		params *ec2.StartInstancesInput,
 		optFns ...func(*ec2.Options),
 	) (*ec2.StartInstancesOutput, error)
+
+	// DescribeAddresses describes one or more Elastic IP addresses.
+	DescribeAddresses(
+		ctx context.Context,
+		params *ec2.DescribeAddressesInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeAddressesOutput, error)
+
+	// AllocateAddress allocates or recovers an Elastic IP address.
+	AllocateAddress(
+		ctx context.Context,
+		params *ec2.AllocateAddressInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.AllocateAddressOutput, error)
+
+	// AssociateAddress associates an Elastic IP address with a network interface.
+	AssociateAddress(
+		ctx context.Context,
+		params *ec2.AssociateAddressInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.AssociateAddressOutput, error)
+
+	// DisassociateAddress disassociates an Elastic IP address.
+	DisassociateAddress(
+		ctx context.Context,
+		params *ec2.DisassociateAddressInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DisassociateAddressOutput, error)
+
+	// ReleaseAddress releases an Elastic IP address.
+	ReleaseAddress(
+		ctx context.Context,
+		params *ec2.ReleaseAddressInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.ReleaseAddressOutput, error)
+
+	// DescribeVolumes describes one or more EBS volumes.
+	DescribeVolumes(
+		ctx context.Context,
+		params *ec2.DescribeVolumesInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeVolumesOutput, error)
+
+	// CreateVolume creates an EBS volume, e.g. from a snapshot.
+	CreateVolume(
+		ctx context.Context,
+		params *ec2.CreateVolumeInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.CreateVolumeOutput, error)
+
+	// DeleteVolume deletes an EBS volume.
+	DeleteVolume(
+		ctx context.Context,
+		params *ec2.DeleteVolumeInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DeleteVolumeOutput, error)
+
+	// CreateSnapshot creates a snapshot of an EBS volume.
+	CreateSnapshot(
+		ctx context.Context,
+		params *ec2.CreateSnapshotInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.CreateSnapshotOutput, error)
+
+	// DescribeSnapshots describes one or more EBS snapshots.
+	DescribeSnapshots(
+		ctx context.Context,
+		params *ec2.DescribeSnapshotsInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeSnapshotsOutput, error)
 }
@@ -0,0 +1,387 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+)
+
+// Cost actions release resources that keep being billed while instances are
+// stopped: the Elastic IPs associated with the stopped instances, and
+// unattached EBS volumes. Both are recorded in the restore data and recreated
+// at wakeup.
+
+const (
+	// volumeKeyPrefix prefixes the restore data keys of snapshotted volumes.
+	volumeKeyPrefix = "volume:"
+
+	// TagSourceVolume tags snapshots and recreated volumes with the ID of the
+	// volume they were taken from.
+	TagSourceVolume = "hibernator.ardikabs.com/source-volume"
+
+	// snapshotTimeout bounds how long to wait for a volume snapshot to complete
+	// before the volume is deleted.
+	snapshotTimeout = 30 * time.Minute
+)
+
+// ElasticIPState records an Elastic IP released from a stopped instance.
+type ElasticIPState struct {
+	AllocationID       string            `json:"allocationId"`
+	PublicIP           string            `json:"publicIp"`
+	NetworkInterfaceID string            `json:"networkInterfaceId"`
+	PrivateIPAddress   string            `json:"privateIpAddress,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+}
+
+// VolumeState records an unattached volume snapshotted and deleted during hibernation.
+type VolumeState struct {
+	VolumeID         string            `json:"volumeId"`
+	SnapshotID       string            `json:"snapshotId"`
+	AvailabilityZone string            `json:"availabilityZone"`
+	VolumeType       string            `json:"volumeType"`
+	Size             int32             `json:"size"`
+	Iops             int32             `json:"iops,omitempty"`
+	Throughput       int32             `json:"throughput,omitempty"`
+	Tags             map[string]string `json:"tags,omitempty"`
+}
+
+// volumeKey returns the restore data key of a snapshotted volume.
+func volumeKey(volumeID string) string {
+	return volumeKeyPrefix + volumeID
+}
+
+// findElasticIPs returns the Elastic IPs associated with the instances, by instance ID.
+func (e *Executor) findElasticIPs(ctx context.Context, client EC2Client, instanceIDs []string) (map[string][]types.Address, error) {
+	if len(instanceIDs) == 0 {
+		return nil, nil
+	}
+
+	resp, err := client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("instance-id"), Values: instanceIDs},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string][]types.Address)
+	for _, addr := range resp.Addresses {
+		if addr.AssociationId == nil || addr.AllocationId == nil {
+			continue
+		}
+		instanceID := aws.ToString(addr.InstanceId)
+		addresses[instanceID] = append(addresses[instanceID], addr)
+	}
+	return addresses, nil
+}
+
+// elasticIPStates converts addresses to their restore data.
+func elasticIPStates(addresses []types.Address) []ElasticIPState {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	states := make([]ElasticIPState, 0, len(addresses))
+	for _, addr := range addresses {
+		states = append(states, ElasticIPState{
+			AllocationID:       aws.ToString(addr.AllocationId),
+			PublicIP:           aws.ToString(addr.PublicIp),
+			NetworkInterfaceID: aws.ToString(addr.NetworkInterfaceId),
+			PrivateIPAddress:   aws.ToString(addr.PrivateIpAddress),
+			Tags:               tagMap(addr.Tags),
+		})
+	}
+	return states
+}
+
+// releaseElasticIPs disassociates and releases addresses.
+func (e *Executor) releaseElasticIPs(ctx context.Context, log logr.Logger, client EC2Client, addresses []types.Address) error {
+	for _, addr := range addresses {
+		publicIP := aws.ToString(addr.PublicIp)
+		if _, err := client.DisassociateAddress(ctx, &ec2.DisassociateAddressInput{
+			AssociationId: addr.AssociationId,
+		}); err != nil {
+			return fmt.Errorf("disassociate Elastic IP %s: %w", publicIP, err)
+		}
+		if _, err := client.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{
+			AllocationId: addr.AllocationId,
+		}); err != nil {
+			return fmt.Errorf("release Elastic IP %s: %w", publicIP, err)
+		}
+		log.Info("Elastic IP released",
+			"publicIp", publicIP,
+			"allocationId", aws.ToString(addr.AllocationId),
+			"instanceId", aws.ToString(addr.InstanceId),
+		)
+	}
+	return nil
+}
+
+// restoreElasticIP associates a released Elastic IP back with its network
+// interface. The address is recovered when it is still available, otherwise a
+// new address is allocated, in which case replaced is true. Nothing is done
+// when the private IP address already has an Elastic IP.
+func (e *Executor) restoreElasticIP(ctx context.Context, log logr.Logger, client EC2Client, state ElasticIPState) (replaced bool, err error) {
+	resp, err := client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("public-ip"), Values: []string{state.PublicIP}},
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe Elastic IP %s: %w", state.PublicIP, err)
+	}
+
+	var allocationID string
+	if len(resp.Addresses) > 0 {
+		addr := resp.Addresses[0]
+		if aws.ToString(addr.NetworkInterfaceId) == state.NetworkInterfaceID {
+			log.Info("Elastic IP already associated", "publicIp", state.PublicIP)
+			return false, nil
+		}
+		allocationID = aws.ToString(addr.AllocationId)
+	} else {
+		// A new address allocated by an earlier wakeup may already be associated.
+		associated, err := client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{
+			Filters: []types.Filter{
+				{Name: aws.String("network-interface-id"), Values: []string{state.NetworkInterfaceID}},
+			},
+		})
+		if err != nil {
+			return false, fmt.Errorf("describe Elastic IPs of %s: %w", state.NetworkInterfaceID, err)
+		}
+		for _, addr := range associated.Addresses {
+			if state.PrivateIPAddress == "" || aws.ToString(addr.PrivateIpAddress) == state.PrivateIPAddress {
+				log.Info("Elastic IP replaced by an earlier wakeup",
+					"previousPublicIp", state.PublicIP,
+					"publicIp", aws.ToString(addr.PublicIp),
+				)
+				return true, nil
+			}
+		}
+
+		tagSpecs := tagSpecifications(types.ResourceTypeElasticIp, state.Tags)
+		allocated, err := client.AllocateAddress(ctx, &ec2.AllocateAddressInput{
+			Domain:            types.DomainTypeVpc,
+			Address:           aws.String(state.PublicIP),
+			TagSpecifications: tagSpecs,
+		})
+		if err != nil {
+			log.Error(err, "failed to recover Elastic IP, allocating a new address", "publicIp", state.PublicIP)
+			allocated, err = client.AllocateAddress(ctx, &ec2.AllocateAddressInput{
+				Domain:            types.DomainTypeVpc,
+				TagSpecifications: tagSpecs,
+			})
+			if err != nil {
+				return false, fmt.Errorf("allocate Elastic IP for %s: %w", state.PublicIP, err)
+			}
+			replaced = true
+		}
+		allocationID = aws.ToString(allocated.AllocationId)
+		log.Info("Elastic IP allocated",
+			"previousPublicIp", state.PublicIP,
+			"publicIp", aws.ToString(allocated.PublicIp),
+			"replaced", replaced,
+		)
+	}
+
+	input := &ec2.AssociateAddressInput{
+		AllocationId:       aws.String(allocationID),
+		NetworkInterfaceId: aws.String(state.NetworkInterfaceID),
+	}
+	if state.PrivateIPAddress != "" {
+		input.PrivateIpAddress = aws.String(state.PrivateIPAddress)
+	}
+	if _, err := client.AssociateAddress(ctx, input); err != nil {
+		return replaced, fmt.Errorf("associate Elastic IP %s: %w", state.PublicIP, err)
+	}
+	return replaced, nil
+}
+
+// findUnattachedVolumes returns the available (unattached) volumes matching
+// the selector tags, tag selector and name pattern. Protected volumes are left
+// out.
+func (e *Executor) findUnattachedVolumes(ctx context.Context, client EC2Client, selector Selector) ([]types.Volume, error) {
+	filters := []types.Filter{
+		{Name: aws.String("status"), Values: []string{string(types.VolumeStateAvailable)}},
+	}
+
+	var tagKeyOnlySelectors []string
+	for key, value := range selector.Tags {
+		if value == "" {
+			tagKeyOnlySelectors = append(tagKeyOnlySelectors, key)
+			continue
+		}
+		filters = append(filters, types.Filter{
+			Name:   aws.String(fmt.Sprintf("tag:%s", key)),
+			Values: []string{value},
+		})
+	}
+	if len(tagKeyOnlySelectors) > 0 {
+		filters = append(filters, types.Filter{
+			Name:   aws.String("tag-key"),
+			Values: tagKeyOnlySelectors,
+		})
+	}
+
+	resp, err := client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+
+	matcher, err := newClientSideMatcher(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []types.Volume
+	for _, vol := range resp.Volumes {
+		res := resourceOf(aws.ToString(vol.VolumeId), vol.Tags)
+		if matcher.Match(res) && !executor.IsProtected(res.Tags) {
+			volumes = append(volumes, vol)
+		}
+	}
+	return volumes, nil
+}
+
+// snapshotVolume snapshots a volume and waits for the snapshot to complete.
+// The volume is left in place; it is deleted once its state is recorded.
+func (e *Executor) snapshotVolume(ctx context.Context, log logr.Logger, client EC2Client, vol types.Volume) (VolumeState, error) {
+	volumeID := aws.ToString(vol.VolumeId)
+	tags := tagMap(vol.Tags)
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+	tags[TagSourceVolume] = volumeID
+
+	log.Info("creating snapshot of unattached volume", "volumeId", volumeID)
+	snapshot, err := client.CreateSnapshot(ctx, &ec2.CreateSnapshotInput{
+		VolumeId:          aws.String(volumeID),
+		Description:       aws.String(fmt.Sprintf("Hibernator snapshot of unattached volume %s", volumeID)),
+		TagSpecifications: tagSpecifications(types.ResourceTypeSnapshot, tags),
+	})
+	if err != nil {
+		return VolumeState{}, fmt.Errorf("create snapshot: %w", err)
+	}
+	snapshotID := aws.ToString(snapshot.SnapshotId)
+
+	log.Info("waiting for snapshot to complete", "volumeId", volumeID, "snapshotId", snapshotID)
+	if err := ec2.NewSnapshotCompletedWaiter(client).Wait(ctx, &ec2.DescribeSnapshotsInput{
+		SnapshotIds: []string{snapshotID},
+	}, snapshotTimeout); err != nil {
+		return VolumeState{}, fmt.Errorf("wait for snapshot %s: %w", snapshotID, err)
+	}
+	log.Info("snapshot completed", "volumeId", volumeID, "snapshotId", snapshotID)
+
+	return VolumeState{
+		VolumeID:         volumeID,
+		SnapshotID:       snapshotID,
+		AvailabilityZone: aws.ToString(vol.AvailabilityZone),
+		VolumeType:       string(vol.VolumeType),
+		Size:             aws.ToInt32(vol.Size),
+		Iops:             aws.ToInt32(vol.Iops),
+		Throughput:       aws.ToInt32(vol.Throughput),
+		Tags:             tagMap(vol.Tags),
+	}, nil
+}
+
+// restoreVolume recreates a snapshotted volume in its availability zone.
+// Volumes already recreated from the snapshot are left as they are, in which
+// case created is false.
+func (e *Executor) restoreVolume(ctx context.Context, log logr.Logger, client EC2Client, state VolumeState) (created bool, err error) {
+	resp, err := client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		Filters: []types.Filter{
+			{Name: aws.String("snapshot-id"), Values: []string{state.SnapshotID}},
+			{Name: aws.String("tag:" + TagSourceVolume), Values: []string{state.VolumeID}},
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe volumes: %w", err)
+	}
+	if len(resp.Volumes) > 0 {
+		log.Info("volume already restored",
+			"volumeId", state.VolumeID,
+			"restoredVolumeId", aws.ToString(resp.Volumes[0].VolumeId),
+		)
+		return false, nil
+	}
+
+	tags := maps.Clone(state.Tags)
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+	tags[TagSourceVolume] = state.VolumeID
+
+	input := &ec2.CreateVolumeInput{
+		AvailabilityZone:  aws.String(state.AvailabilityZone),
+		SnapshotId:        aws.String(state.SnapshotID),
+		VolumeType:        types.VolumeType(state.VolumeType),
+		Size:              aws.Int32(state.Size),
+		TagSpecifications: tagSpecifications(types.ResourceTypeVolume, tags),
+	}
+	// Provisioned IOPS and throughput can only be set on the volume types supporting them.
+	switch types.VolumeType(state.VolumeType) {
+	case types.VolumeTypeIo1, types.VolumeTypeIo2, types.VolumeTypeGp3:
+		if state.Iops > 0 {
+			input.Iops = aws.Int32(state.Iops)
+		}
+	}
+	if types.VolumeType(state.VolumeType) == types.VolumeTypeGp3 && state.Throughput > 0 {
+		input.Throughput = aws.Int32(state.Throughput)
+	}
+
+	restored, err := client.CreateVolume(ctx, input)
+	if err != nil {
+		return false, fmt.Errorf("create volume from snapshot %s: %w", state.SnapshotID, err)
+	}
+	log.Info("volume restored from snapshot",
+		"volumeId", state.VolumeID,
+		"snapshotId", state.SnapshotID,
+		"restoredVolumeId", aws.ToString(restored.VolumeId),
+	)
+	return true, nil
+}
+
+// tagMap converts EC2 tags to a map, or nil when there are none.
+func tagMap(tags []types.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		m[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return m
+}
+
+// tagSpecifications returns the tag specifications applying tags to a
+// resource created by the executor. Tags reserved by AWS (aws:*) are dropped,
+// since they cannot be set.
+func tagSpecifications(resourceType types.ResourceType, tags map[string]string) []types.TagSpecification {
+	var ec2Tags []types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		if strings.HasPrefix(key, "aws:") {
+			continue
+		}
+		ec2Tags = append(ec2Tags, types.Tag{Key: aws.String(key), Value: aws.String(tags[key])})
+	}
+	if len(ec2Tags) == 0 {
+		return nil
+	}
+	return []types.TagSpecification{{ResourceType: resourceType, Tags: ec2Tags}}
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/ec2/mocks"
+)
+
+// addressFilter matches a DescribeAddresses call filtering on name=value.
+func addressFilter(name, value string) interface{} {
+	return mock.MatchedBy(func(input *awsec2.DescribeAddressesInput) bool {
+		return len(input.Filters) == 1 && aws.ToString(input.Filters[0].Name) == name &&
+			len(input.Filters[0].Values) == 1 && input.Filters[0].Values[0] == value
+	})
+}
+
+func TestShutdown_ReleasesElasticIPsAndSnapshotsVolumes(t *testing.T) {
+	ctx := context.Background()
+
+	mockEC2 := &mocks.EC2Client{}
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything).Return(&awsec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{InstanceId: aws.String("i-running"), State: &types.InstanceState{Name: types.InstanceStateNameRunning}},
+					{InstanceId: aws.String("i-stopped"), State: &types.InstanceState{Name: types.InstanceStateNameStopped}},
+				},
+			},
+		},
+	}, nil)
+	expectUnprotected(mockEC2)
+	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
+		InstanceIds: []string{"i-running"},
+	}).Return(&awsec2.StopInstancesOutput{}, nil)
+
+	// Only the Elastic IPs of the instances being stopped are looked up and released.
+	mockEC2.On("DescribeAddresses", mock.Anything, addressFilter("instance-id", "i-running")).
+		Return(&awsec2.DescribeAddressesOutput{
+			Addresses: []types.Address{
+				{
+					AllocationId:       aws.String("eipalloc-1"),
+					AssociationId:      aws.String("eipassoc-1"),
+					InstanceId:         aws.String("i-running"),
+					NetworkInterfaceId: aws.String("eni-1"),
+					PrivateIpAddress:   aws.String("10.0.0.10"),
+					PublicIp:           aws.String("203.0.113.10"),
+					Tags:               []types.Tag{{Key: aws.String("Name"), Value: aws.String("bastion")}},
+				},
+			},
+		}, nil)
+	mockEC2.On("DisassociateAddress", mock.Anything, &awsec2.DisassociateAddressInput{
+		AssociationId: aws.String("eipassoc-1"),
+	}).Return(&awsec2.DisassociateAddressOutput{}, nil)
+	mockEC2.On("ReleaseAddress", mock.Anything, &awsec2.ReleaseAddressInput{
+		AllocationId: aws.String("eipalloc-1"),
+	}).Return(&awsec2.ReleaseAddressOutput{}, nil)
+
+	mockEC2.On("DescribeVolumes", mock.Anything, mock.MatchedBy(func(input *awsec2.DescribeVolumesInput) bool {
+		return aws.ToString(input.Filters[0].Name) == "status" && input.Filters[0].Values[0] == "available"
+	})).Return(&awsec2.DescribeVolumesOutput{
+		Volumes: []types.Volume{
+			{
+				VolumeId:         aws.String("vol-1"),
+				AvailabilityZone: aws.String("us-east-1a"),
+				VolumeType:       types.VolumeTypeGp3,
+				Size:             aws.Int32(100),
+				Iops:             aws.Int32(3000),
+				Throughput:       aws.Int32(125),
+				Tags: []types.Tag{
+					{Key: aws.String("Environment"), Value: aws.String("dev")},
+					{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("dev")},
+				},
+			},
+		},
+	}, nil)
+	mockEC2.On("CreateSnapshot", mock.Anything, mock.MatchedBy(func(input *awsec2.CreateSnapshotInput) bool {
+		return aws.ToString(input.VolumeId) == "vol-1" && assert.ObjectsAreEqual([]types.TagSpecification{{
+			ResourceType: types.ResourceTypeSnapshot,
+			Tags: []types.Tag{
+				{Key: aws.String("Environment"), Value: aws.String("dev")},
+				{Key: aws.String(TagSourceVolume), Value: aws.String("vol-1")},
+			},
+		}}, input.TagSpecifications)
+	})).Return(&awsec2.CreateSnapshotOutput{SnapshotId: aws.String("snap-1")}, nil)
+	mockEC2.On("DescribeSnapshots", mock.Anything, mock.Anything, mock.Anything).Return(&awsec2.DescribeSnapshotsOutput{
+		Snapshots: []types.Snapshot{{SnapshotId: aws.String("snap-1"), State: types.SnapshotStateCompleted}},
+	}, nil)
+	mockEC2.On("DeleteVolume", mock.Anything, &awsec2.DeleteVolumeInput{
+		VolumeId: aws.String("vol-1"),
+	}).Return(&awsec2.DeleteVolumeOutput{}, nil)
+
+	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
+	e := NewWithClients(ec2Factory, nil)
+
+	captured := make(map[string]interface{})
+	spec := executor.Spec{
+		TargetName: "test-instances",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"tags": {"Environment": "dev"}}, "releaseElasticIps": true, "snapshotUnattachedVolumes": true}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			captured[key] = value
+			return nil
+		},
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "stopped 1 of 2 EC2 instance(s); released 1 Elastic IP(s); snapshotted and deleted 1 unattached volume(s)", result.Message)
+
+	assert.Equal(t, []ElasticIPState{{
+		AllocationID:       "eipalloc-1",
+		PublicIP:           "203.0.113.10",
+		NetworkInterfaceID: "eni-1",
+		PrivateIPAddress:   "10.0.0.10",
+		Tags:               map[string]string{"Name": "bastion"},
+	}}, captured["i-running"].(InstanceState).ElasticIPs)
+	assert.Empty(t, captured["i-stopped"].(InstanceState).ElasticIPs)
+
+	assert.Equal(t, VolumeState{
+		VolumeID:         "vol-1",
+		SnapshotID:       "snap-1",
+		AvailabilityZone: "us-east-1a",
+		VolumeType:       "gp3",
+		Size:             100,
+		Iops:             3000,
+		Throughput:       125,
+		Tags:             map[string]string{"Environment": "dev", "aws:cloudformation:stack-name": "dev"},
+	}, captured["volume:vol-1"])
+
+	mockEC2.AssertExpectations(t)
+}
+
+func TestShutdown_SnapshotFailureKeepsVolume(t *testing.T) {
+	ctx := context.Background()
+
+	mockEC2 := &mocks.EC2Client{}
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything).Return(&awsec2.DescribeInstancesOutput{}, nil)
+	mockEC2.On("DescribeVolumes", mock.Anything, mock.Anything).Return(&awsec2.DescribeVolumesOutput{
+		Volumes: []types.Volume{{VolumeId: aws.String("vol-1")}},
+	}, nil)
+	mockEC2.On("CreateSnapshot", mock.Anything, mock.Anything).Return(nil, errors.New("snapshot limit exceeded"))
+
+	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
+	e := NewWithClients(ec2Factory, nil)
+
+	spec := executor.Spec{
+		TargetName: "test-instances",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"tags": {"Environment": "dev"}}, "snapshotUnattachedVolumes": true}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+	}
+
+	_, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.ErrorContains(t, err, "snapshot volume vol-1")
+	mockEC2.AssertNotCalled(t, "DeleteVolume", mock.Anything, mock.Anything)
+}
+
+func TestWakeUp_RestoresElasticIPsAndVolumes(t *testing.T) {
+	ctx := context.Background()
+
+	mockEC2 := &mocks.EC2Client{}
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything).Return(&awsec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{InstanceId: aws.String("i-1"), State: &types.InstanceState{Name: types.InstanceStateNameStopped}},
+				},
+			},
+		},
+	}, nil)
+	mockEC2.On("StartInstances", mock.Anything, &awsec2.StartInstancesInput{
+		InstanceIds: []string{"i-1"},
+	}).Return(&awsec2.StartInstancesOutput{}, nil)
+
+	// 203.0.113.10 is recovered.
+	mockEC2.On("DescribeAddresses", mock.Anything, addressFilter("public-ip", "203.0.113.10")).
+		Return(&awsec2.DescribeAddressesOutput{}, nil)
+	mockEC2.On("DescribeAddresses", mock.Anything, addressFilter("network-interface-id", "eni-1")).
+		Return(&awsec2.DescribeAddressesOutput{}, nil)
+	mockEC2.On("AllocateAddress", mock.Anything, &awsec2.AllocateAddressInput{
+		Domain:  types.DomainTypeVpc,
+		Address: aws.String("203.0.113.10"),
+		TagSpecifications: []types.TagSpecification{{
+			ResourceType: types.ResourceTypeElasticIp,
+			Tags:         []types.Tag{{Key: aws.String("Name"), Value: aws.String("bastion")}},
+		}},
+	}).Return(&awsec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-new1"), PublicIp: aws.String("203.0.113.10")}, nil)
+	mockEC2.On("AssociateAddress", mock.Anything, &awsec2.AssociateAddressInput{
+		AllocationId:       aws.String("eipalloc-new1"),
+		NetworkInterfaceId: aws.String("eni-1"),
+		PrivateIpAddress:   aws.String("10.0.0.10"),
+	}).Return(&awsec2.AssociateAddressOutput{}, nil)
+
+	// 203.0.113.20 was taken by someone else and is replaced with a new address.
+	mockEC2.On("DescribeAddresses", mock.Anything, addressFilter("public-ip", "203.0.113.20")).
+		Return(&awsec2.DescribeAddressesOutput{}, nil)
+	mockEC2.On("DescribeAddresses", mock.Anything, addressFilter("network-interface-id", "eni-2")).
+		Return(&awsec2.DescribeAddressesOutput{}, nil)
+	mockEC2.On("AllocateAddress", mock.Anything, &awsec2.AllocateAddressInput{
+		Domain:  types.DomainTypeVpc,
+		Address: aws.String("203.0.113.20"),
+	}).Return(nil, errors.New("InvalidAddress.NotFound"))
+	mockEC2.On("AllocateAddress", mock.Anything, &awsec2.AllocateAddressInput{
+		Domain: types.DomainTypeVpc,
+	}).Return(&awsec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-new2"), PublicIp: aws.String("198.51.100.7")}, nil)
+	mockEC2.On("AssociateAddress", mock.Anything, &awsec2.AssociateAddressInput{
+		AllocationId:       aws.String("eipalloc-new2"),
+		NetworkInterfaceId: aws.String("eni-2"),
+	}).Return(&awsec2.AssociateAddressOutput{}, nil)
+
+	// vol-1 is recreated; vol-2 was recreated by an earlier wakeup.
+	mockEC2.On("DescribeVolumes", mock.Anything, mock.MatchedBy(func(input *awsec2.DescribeVolumesInput) bool {
+		return input.Filters[0].Values[0] == "snap-1"
+	})).Return(&awsec2.DescribeVolumesOutput{}, nil)
+	mockEC2.On("DescribeVolumes", mock.Anything, mock.MatchedBy(func(input *awsec2.DescribeVolumesInput) bool {
+		return input.Filters[0].Values[0] == "snap-2"
+	})).Return(&awsec2.DescribeVolumesOutput{Volumes: []types.Volume{{VolumeId: aws.String("vol-2b")}}}, nil)
+	mockEC2.On("CreateVolume", mock.Anything, &awsec2.CreateVolumeInput{
+		AvailabilityZone: aws.String("us-east-1a"),
+		SnapshotId:       aws.String("snap-1"),
+		VolumeType:       types.VolumeTypeGp2,
+		Size:             aws.Int32(20),
+		TagSpecifications: []types.TagSpecification{{
+			ResourceType: types.ResourceTypeVolume,
+			Tags:         []types.Tag{{Key: aws.String(TagSourceVolume), Value: aws.String("vol-1")}},
+		}},
+	}).Return(&awsec2.CreateVolumeOutput{VolumeId: aws.String("vol-1b")}, nil)
+
+	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
+	e := NewWithClients(ec2Factory, nil)
+
+	instanceState, _ := json.Marshal(InstanceState{
+		InstanceID: "i-1",
+		WasRunning: true,
+		StopMode:   StopModeStop,
+		ElasticIPs: []ElasticIPState{
+			{AllocationID: "eipalloc-1", PublicIP: "203.0.113.10", NetworkInterfaceID: "eni-1", PrivateIPAddress: "10.0.0.10", Tags: map[string]string{"Name": "bastion"}},
+			{AllocationID: "eipalloc-2", PublicIP: "203.0.113.20", NetworkInterfaceID: "eni-2"},
+		},
+	})
+	// gp2 volumes do not take provisioned IOPS.
+	volume1State, _ := json.Marshal(VolumeState{VolumeID: "vol-1", SnapshotID: "snap-1", AvailabilityZone: "us-east-1a", VolumeType: "gp2", Size: 20, Iops: 100})
+	volume2State, _ := json.Marshal(VolumeState{VolumeID: "vol-2", SnapshotID: "snap-2", AvailabilityZone: "us-east-1a", VolumeType: "gp3", Size: 20})
+
+	spec := executor.Spec{
+		TargetName: "test-instances",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"tags": {"Environment": "dev"}}, "releaseElasticIps": true, "snapshotUnattachedVolumes": true}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+	}
+	restore := executor.RestoreData{
+		Type: "ec2",
+		Data: map[string]json.RawMessage{
+			"i-1":          instanceState,
+			"volume:vol-1": volume1State,
+			"volume:vol-2": volume2State,
+		},
+	}
+	require.NoError(t, e.ValidateRestore(restore))
+
+	result, err := e.WakeUp(ctx, logr.Discard(), spec, restore)
+	require.NoError(t, err)
+	assert.Equal(t, "started 1 EC2 instance(s); restored 2 Elastic IP(s) (1 replaced with a new address); recreated 1 volume(s) from snapshots", result.Message)
+
+	mockEC2.AssertExpectations(t)
+}
+
+func TestWakeUp_ElasticIPAlreadyAssociated(t *testing.T) {
+	ctx := context.Background()
+
+	mockEC2 := &mocks.EC2Client{}
+	mockEC2.On("DescribeAddresses", mock.Anything, addressFilter("public-ip", "203.0.113.10")).
+		Return(&awsec2.DescribeAddressesOutput{
+			Addresses: []types.Address{{AllocationId: aws.String("eipalloc-1"), NetworkInterfaceId: aws.String("eni-1")}},
+		}, nil)
+
+	e := NewWithClients(func(cfg aws.Config) EC2Client { return mockEC2 }, nil)
+	replaced, err := e.restoreElasticIP(ctx, logr.Discard(), mockEC2, ElasticIPState{PublicIP: "203.0.113.10", NetworkInterfaceID: "eni-1"})
+	require.NoError(t, err)
+	assert.False(t, replaced)
+
+	mockEC2.AssertNotCalled(t, "AllocateAddress", mock.Anything, mock.Anything)
+	mockEC2.AssertNotCalled(t, "AssociateAddress", mock.Anything, mock.Anything)
+}
+
+func TestValidateRestore_VolumeEntries(t *testing.T) {
+	e := New()
+	err := e.ValidateRestore(executor.RestoreData{
+		Type: "ec2",
+		Data: map[string]json.RawMessage{
+			"i-1":          json.RawMessage(`{"instanceId": "i-1", "wasRunning": true}`),
+			"volume:vol-1": json.RawMessage(`{"volumeId": "vol-1", "size": "large"}`),
+		},
+	})
+	assert.ErrorIs(t, err, executor.ErrInvalidRestoreData)
+	assert.ErrorContains(t, err, "volume:vol-1")
+}
+
+func TestValidate_SnapshotUnattachedVolumesRequiresTags(t *testing.T) {
+	e := New()
+	spec := executor.Spec{
+		TargetName: "test-instances",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"instanceIds": ["i-123"]}, "snapshotUnattachedVolumes": true}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+	}
+	assert.ErrorContains(t, e.Validate(spec), "snapshotUnattachedVolumes requires")
+}
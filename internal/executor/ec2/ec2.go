@@ -11,14 +11,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/smithy-go"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/ardikabs/hibernator/pkg/awsutil"
 	"github.com/ardikabs/hibernator/pkg/executorparams"
 	"github.com/ardikabs/hibernator/pkg/waiter"
@@ -35,10 +38,30 @@ type Parameters = executorparams.EC2Parameters
 // Selector is an alias for the shared EC2 selector type.
 type Selector = executorparams.EC2Selector
 
+// Stop modes recorded in InstanceState.StopMode.
+const (
+	// StopModeStop is a regular stop.
+	StopModeStop = "stop"
+	// StopModeHibernate is a stop with Hibernate=true, preserving the instance RAM.
+	StopModeHibernate = "hibernate"
+)
+
 // InstanceState holds state for a single instance.
 type InstanceState struct {
 	InstanceID string `json:"instanceId"`
 	WasRunning bool   `json:"wasRunning"`
+
+	// StopMode is how the instance was stopped, StopModeStop or StopModeHibernate.
+	// Empty when the instance was not stopped.
+	StopMode string `json:"stopMode,omitempty"`
+
+	// SkipReason explains why a running instance was left running, e.g. because
+	// it has stop protection enabled.
+	SkipReason string `json:"skipReason,omitempty"`
+
+	// ElasticIPs are the Elastic IPs released from the instance after it was
+	// stopped, associated back at wakeup.
+	ElasticIPs []ElasticIPState `json:"elasticIps,omitempty"`
 }
 
 // Executor implements the EC2 hibernation logic.
@@ -47,6 +70,8 @@ type Executor struct {
 	awsConfigLoader AWSConfigLoader
 }
 
+var _ executor.Previewer = (*Executor)(nil)
+
 // EC2ClientFactory is a function type for creating EC2 clients.
 type EC2ClientFactory func(cfg aws.Config) EC2Client
 
@@ -90,9 +115,14 @@ func (e *Executor) Validate(spec executor.Spec) error {
 	hasTags := len(params.Selector.Tags) > 0
 	hasInstanceIDs := len(params.Selector.InstanceIDs) > 0
 	hasTagSelector := params.Selector.TagSelector != nil && (len(params.Selector.TagSelector.MatchTags) > 0 || len(params.Selector.TagSelector.MatchExpressions) > 0)
+	hasNamePattern := params.Selector.NamePattern != ""
+
+	if !hasTags && !hasTagSelector && !hasInstanceIDs && !hasNamePattern {
+		return fmt.Errorf("either tags, tagSelector, namePattern, or instanceIds must be specified in selector")
+	}
 
-	if !hasTags && !hasTagSelector && !hasInstanceIDs {
-		return fmt.Errorf("either tags, tagSelector, or instanceIds must be specified in selector")
+	if errs := awsutil.ValidateNamePattern(params.Selector.NamePattern, field.NewPath("selector").Child("namePattern")); len(errs) > 0 {
+		return errs.ToAggregate()
 	}
 
 	// Tags and InstanceIDs are mutually exclusive (both are server-side filters)
@@ -105,6 +135,11 @@ func (e *Executor) Validate(spec executor.Spec) error {
 		return fmt.Errorf("selector.tags and selector.tagSelector are mutually exclusive")
 	}
 
+	// Unattached volumes are only selectable by tags
+	if params.SnapshotUnattachedVolumes && !hasTags && !hasTagSelector {
+		return fmt.Errorf("snapshotUnattachedVolumes requires selector.tags or selector.tagSelector")
+	}
+
 	return nil
 }
 
@@ -153,7 +188,24 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		}
 	}
 
-	var instancesToStop []string
+	// Find the Elastic IPs to release once the instances are stopped.
+	var addresses map[string][]types.Address
+	if params.ReleaseElasticIPs {
+		instanceIDs := make([]string, 0, len(instances))
+		for _, inst := range instances {
+			if inst.State.Name == types.InstanceStateNameRunning {
+				instanceIDs = append(instanceIDs, aws.ToString(inst.InstanceId))
+			}
+		}
+		addresses, err = e.findElasticIPs(ctx, client, instanceIDs)
+		if err != nil {
+			log.Error(err, "failed to find Elastic IPs")
+			return nil, fmt.Errorf("find Elastic IPs: %w", err)
+		}
+	}
+
+	var instancesToStop, instancesToHibernate, skipped []string
+	var addressesToRelease []types.Address
 	for _, inst := range instances {
 		instanceID := aws.ToString(inst.InstanceId)
 		actualState := inst.State.Name
@@ -166,17 +218,40 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 			WasRunning: wasRunning,
 		}
 
+		// Add to the stop or hibernate list if running and not protected
+		if wasRunning {
+			reason, err := e.protectionReason(ctx, log, client, inst)
+			if err != nil {
+				log.Error(err, "failed to check instance protection", "instanceId", instanceID)
+				return nil, fmt.Errorf("check protection of instance %s: %w", instanceID, err)
+			}
+
+			switch {
+			case reason != "":
+				state.SkipReason = reason
+				skipped = append(skipped, fmt.Sprintf("%s (%s)", instanceID, reason))
+			case params.Hibernate && hibernationConfigured(inst):
+				state.StopMode = StopModeHibernate
+				instancesToHibernate = append(instancesToHibernate, instanceID)
+			default:
+				state.StopMode = StopModeStop
+				instancesToStop = append(instancesToStop, instanceID)
+			}
+
+			if state.StopMode != "" {
+				state.ElasticIPs = elasticIPStates(addresses[instanceID])
+				addressesToRelease = append(addressesToRelease, addresses[instanceID]...)
+			}
+		}
+
 		log.Info("instance state captured",
 			"instanceId", instanceID,
 			"actualState", actualState,
 			"wasRunning", wasRunning,
+			"stopMode", state.StopMode,
+			"skipReason", state.SkipReason,
 		)
 
-		// Add to stop list if running
-		if wasRunning {
-			instancesToStop = append(instancesToStop, instanceID)
-		}
-
 		// Incremental save: persist this instance's restore data immediately.
 		if spec.ReportStateCallback != nil {
 			if err := spec.ReportStateCallback(instanceID, state); err != nil {
@@ -187,18 +262,40 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 	}
 
 	// Stop running instances
-	msg := fmt.Sprintf("stopped %d of %d EC2 instance(s)", len(instancesToStop), len(instances))
+	stopped := append(instancesToStop, instancesToHibernate...)
+	msg := fmt.Sprintf("stopped %d of %d EC2 instance(s)", len(stopped), len(instances))
+	if len(instancesToHibernate) > 0 {
+		msg += fmt.Sprintf(" (%d hibernated)", len(instancesToHibernate))
+	}
+	if len(skipped) > 0 {
+		msg += fmt.Sprintf("; skipped %d protected instance(s): %s", len(skipped), strings.Join(skipped, ", "))
+	}
 
-	if len(instancesToStop) > 0 {
-		log.Info("stopping running instances", "count", len(instancesToStop))
-		_, err = client.StopInstances(ctx, &ec2.StopInstancesInput{
-			InstanceIds: instancesToStop,
-		})
-		if err != nil {
-			log.Error(err, "failed to stop instances")
-			return nil, fmt.Errorf("stop instances: %w", err)
+	if len(stopped) > 0 {
+		if len(instancesToHibernate) > 0 {
+			log.Info("hibernating running instances", "count", len(instancesToHibernate))
+			_, err = client.StopInstances(ctx, &ec2.StopInstancesInput{
+				InstanceIds: instancesToHibernate,
+				Hibernate:   aws.Bool(true),
+			})
+			if err != nil {
+				log.Error(err, "failed to hibernate instances")
+				return nil, fmt.Errorf("hibernate instances: %w", err)
+			}
+			log.Info("instances hibernated successfully", "count", len(instancesToHibernate))
+		}
+
+		if len(instancesToStop) > 0 {
+			log.Info("stopping running instances", "count", len(instancesToStop))
+			_, err = client.StopInstances(ctx, &ec2.StopInstancesInput{
+				InstanceIds: instancesToStop,
+			})
+			if err != nil {
+				log.Error(err, "failed to stop instances")
+				return nil, fmt.Errorf("stop instances: %w", err)
+			}
+			log.Info("instances stopped successfully", "count", len(instancesToStop))
 		}
-		log.Info("instances stopped successfully", "count", len(instancesToStop))
 
 		// Wait for instances to reach stopped state if configured
 		if params.AwaitCompletion.Enabled {
@@ -206,7 +303,7 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 			if timeout == "" {
 				timeout = DefaultWaitTimeout
 			}
-			if err := e.waitForInstancesStopped(ctx, log, client, instancesToStop, timeout); err != nil {
+			if err := e.waitForInstancesStopped(ctx, log, client, stopped, timeout); err != nil {
 				log.Error(err, "timeout waiting for instances to stop")
 				msg += fmt.Sprintf("; not all instances confirmed stopped after %s timeout", timeout)
 			} else {
@@ -217,15 +314,169 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		log.Info("no running instances to stop, all already at desired state")
 	}
 
+	// Release the Elastic IPs of the stopped instances
+	if len(addressesToRelease) > 0 {
+		if err := e.releaseElasticIPs(ctx, log, client, addressesToRelease); err != nil {
+			log.Error(err, "failed to release Elastic IPs")
+			return nil, fmt.Errorf("release Elastic IPs: %w", err)
+		}
+		msg += fmt.Sprintf("; released %d Elastic IP(s)", len(addressesToRelease))
+	}
+
+	// Snapshot and delete unattached volumes
+	if params.SnapshotUnattachedVolumes {
+		volumes, err := e.findUnattachedVolumes(ctx, client, params.Selector)
+		if err != nil {
+			log.Error(err, "failed to find unattached volumes")
+			return nil, fmt.Errorf("find unattached volumes: %w", err)
+		}
+
+		for _, vol := range volumes {
+			volumeID := aws.ToString(vol.VolumeId)
+			state, err := e.snapshotVolume(ctx, log, client, vol)
+			if err != nil {
+				log.Error(err, "failed to snapshot volume", "volumeId", volumeID)
+				return nil, fmt.Errorf("snapshot volume %s: %w", volumeID, err)
+			}
+
+			// Record the snapshot before the volume is gone.
+			if spec.ReportStateCallback != nil {
+				if err := spec.ReportStateCallback(volumeKey(volumeID), state); err != nil {
+					log.Error(err, "failed to save restore data incrementally", "volumeId", volumeID)
+				}
+			}
+
+			if _, err := client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{VolumeId: vol.VolumeId}); err != nil {
+				log.Error(err, "failed to delete volume", "volumeId", volumeID)
+				return nil, fmt.Errorf("delete volume %s: %w", volumeID, err)
+			}
+			log.Info("unattached volume deleted", "volumeId", volumeID, "snapshotId", state.SnapshotID)
+		}
+		if len(volumes) > 0 {
+			msg += fmt.Sprintf("; snapshotted and deleted %d unattached volume(s)", len(volumes))
+		}
+	}
+
 	log.Info("shutdown completed",
 		"totalInstances", len(instances),
-		"stoppedInstances", len(instancesToStop),
+		"stoppedInstances", len(stopped),
+		"hibernatedInstances", len(instancesToHibernate),
+		"skippedInstances", len(skipped),
+		"releasedElasticIPs", len(addressesToRelease),
 		"isLive", hasRunningInstances,
 	)
 
 	return &executor.Result{Message: msg}, nil
 }
 
+// hibernationConfigured reports whether the instance was launched with
+// hibernation enabled, which StopInstances requires for Hibernate=true.
+func hibernationConfigured(inst types.Instance) bool {
+	return inst.HibernationOptions != nil && aws.ToBool(inst.HibernationOptions.Configured)
+}
+
+// protectionReason returns why the instance must be left running: the
+// protected tag, stop protection or termination protection being enabled. It
+// returns an empty reason for unprotected instances, and when the credentials
+// are not allowed to describe instance attributes, so that missing permissions
+// keep the previous behavior of stopping every running instance.
+func (e *Executor) protectionReason(ctx context.Context, log logr.Logger, client EC2Client, inst types.Instance) (string, error) {
+	if executor.IsProtected(tagMap(inst.Tags)) {
+		return wellknown.LabelProtected + " tag set", nil
+	}
+
+	instanceID := aws.ToString(inst.InstanceId)
+	for _, check := range []struct {
+		attribute types.InstanceAttributeName
+		reason    string
+		enabled   func(*ec2.DescribeInstanceAttributeOutput) bool
+	}{
+		{
+			attribute: types.InstanceAttributeNameDisableApiStop,
+			reason:    "stop protection enabled",
+			enabled: func(out *ec2.DescribeInstanceAttributeOutput) bool {
+				return out.DisableApiStop != nil && aws.ToBool(out.DisableApiStop.Value)
+			},
+		},
+		{
+			attribute: types.InstanceAttributeNameDisableApiTermination,
+			reason:    "termination protection enabled",
+			enabled: func(out *ec2.DescribeInstanceAttributeOutput) bool {
+				return out.DisableApiTermination != nil && aws.ToBool(out.DisableApiTermination.Value)
+			},
+		},
+	} {
+		out, err := client.DescribeInstanceAttribute(ctx, &ec2.DescribeInstanceAttributeInput{
+			InstanceId: aws.String(instanceID),
+			Attribute:  check.attribute,
+		})
+		if isUnauthorized(err) {
+			log.Info("not allowed to describe instance attributes, skipping protection check",
+				"instanceId", instanceID, "attribute", check.attribute)
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("describe %s attribute: %w", check.attribute, err)
+		}
+		if check.enabled(out) {
+			return check.reason, nil
+		}
+	}
+	return "", nil
+}
+
+// isUnauthorized checks if the error is an UnauthorizedOperation error, which
+// indicates that the credentials lack the IAM permission for the call.
+func isUnauthorized(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.ErrorCode() == "UnauthorizedOperation"
+}
+
+// ValidateRestore checks that every restore entry is a valid instance state,
+// or volume state for the volume entries.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries(restore, func(key string, raw *json.RawMessage) error {
+		if strings.HasPrefix(key, volumeKeyPrefix) {
+			return json.Unmarshal(*raw, &VolumeState{})
+		}
+		return json.Unmarshal(*raw, &InstanceState{})
+	})
+}
+
+// Preview discovers the instances the selector matches, as Shutdown does,
+// without stopping them. Instances that are already stopped or protected from
+// stopping are listed too; Shutdown records them without stopping them.
+func (e *Executor) Preview(ctx context.Context, log logr.Logger, spec executor.Spec) ([]string, error) {
+	log = log.WithName("ec2").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
+	log.Info("executor starting preview")
+
+	params, err := e.parseParams(spec.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("parse parameters: %w", err)
+	}
+
+	cfg, err := e.loadAWSConfig(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config: %w", err)
+	}
+
+	instances, err := e.findInstances(ctx, e.ec2Factory(cfg), params.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("find instances: %w", err)
+	}
+	log.Info("instances discovered", "totalInstances", len(instances))
+
+	instanceIDs := make([]string, 0, len(instances))
+	for _, inst := range instances {
+		instanceIDs = append(instanceIDs, aws.ToString(inst.InstanceId))
+	}
+	return instanceIDs, nil
+}
+
 // WakeUp starts previously running EC2 instances.
 func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
 	log = log.WithName("ec2").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
@@ -254,7 +505,19 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 
 	// Build restore lookup for instances that were running before shutdown.
 	previouslyRunning := make(map[string]struct{}, len(restore.Data))
+	var elasticIPs []ElasticIPState
+	var volumes []VolumeState
 	for instanceID, stateBytes := range restore.Data {
+		if strings.HasPrefix(instanceID, volumeKeyPrefix) {
+			var vol VolumeState
+			if err := json.Unmarshal(stateBytes, &vol); err != nil {
+				log.Error(err, "failed to unmarshal volume state", "key", instanceID)
+				return nil, fmt.Errorf("unmarshal volume state %s: %w", instanceID, err)
+			}
+			volumes = append(volumes, vol)
+			continue
+		}
+
 		var inst InstanceState
 		if err := json.Unmarshal(stateBytes, &inst); err != nil {
 			log.Error(err, "failed to unmarshal instance state", "instanceId", instanceID)
@@ -262,6 +525,10 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 		}
 
 		if inst.WasRunning {
+			if inst.StopMode == StopModeHibernate {
+				log.Info("instance was hibernated, starting it resumes from RAM", "instanceId", instanceID)
+			}
+
 			id := inst.InstanceID
 			if id == "" {
 				id = instanceID
@@ -269,6 +536,33 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 
 			previouslyRunning[id] = struct{}{}
 		}
+		elasticIPs = append(elasticIPs, inst.ElasticIPs...)
+	}
+
+	// Associate the released Elastic IPs back before the instances start.
+	var replacedIPs int
+	for _, ip := range elasticIPs {
+		replaced, err := e.restoreElasticIP(ctx, log, client, ip)
+		if err != nil {
+			log.Error(err, "failed to restore Elastic IP", "publicIp", ip.PublicIP)
+			return nil, fmt.Errorf("restore Elastic IP: %w", err)
+		}
+		if replaced {
+			replacedIPs++
+		}
+	}
+
+	// Recreate the snapshotted volumes.
+	var restoredVolumes int
+	for _, vol := range volumes {
+		created, err := e.restoreVolume(ctx, log, client, vol)
+		if err != nil {
+			log.Error(err, "failed to restore volume", "volumeId", vol.VolumeID)
+			return nil, fmt.Errorf("restore volume %s: %w", vol.VolumeID, err)
+		}
+		if created {
+			restoredVolumes++
+		}
 	}
 
 	// Re-discover all current instances from selector
@@ -340,6 +634,16 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 		log.Info("no instances to start")
 	}
 
+	if len(elasticIPs) > 0 {
+		msg += fmt.Sprintf("; restored %d Elastic IP(s)", len(elasticIPs))
+		if replacedIPs > 0 {
+			msg += fmt.Sprintf(" (%d replaced with a new address)", replacedIPs)
+		}
+	}
+	if restoredVolumes > 0 {
+		msg += fmt.Sprintf("; recreated %d volume(s) from snapshots", restoredVolumes)
+	}
+
 	log.Info("wakeup completed", "instanceCount", len(instancesToStart))
 
 	return &executor.Result{Message: msg}, nil
@@ -551,37 +855,36 @@ func (e *Executor) findInstances(ctx context.Context, client EC2Client, selector
 		instances = append(instances, reservation.Instances...)
 	}
 
-	// Client-side filter: apply TagSelector to the instances returned by AWS.
-	// This runs locally and supports advanced expressions (Exists, Matches, etc.)
-	// that AWS native filters do not support.
-	hasTagSelector := selector.TagSelector != nil &&
-		(len(selector.TagSelector.MatchTags) > 0 ||
-			len(selector.TagSelector.MatchExpressions) > 0)
+	// Client-side filter: apply TagSelector and NamePattern to the instances
+	// returned by AWS. This runs locally and supports advanced expressions
+	// (Exists, Matches, etc.) that AWS native filters do not support.
+	matcher, err := newClientSideMatcher(selector)
+	if err != nil {
+		return nil, err
+	}
 
-	if hasTagSelector {
-		instances = filterInstancesByTagSelector(instances, selector.TagSelector)
+	var matched []types.Instance
+	for _, inst := range instances {
+		if matcher.Match(resourceOf(aws.ToString(inst.InstanceId), inst.Tags)) {
+			matched = append(matched, inst)
+		}
 	}
 
 	// Apply exclusions for ASG and Karpenter managed instances
-	return awsutil.ApplyExclusions(instances, awsutil.ExcludeByASGManaged, awsutil.ExcludeByKarpenterManaged), nil
+	return awsutil.ApplyExclusions(matched, awsutil.ExcludeByASGManaged, awsutil.ExcludeByKarpenterManaged), nil
 }
 
-// filterInstancesByTagSelector filters instances using client-side tag matching.
-func filterInstancesByTagSelector(instances []types.Instance, selector *awsutil.TagSelector) []types.Instance {
-	if selector == nil {
-		return instances
-	}
-
-	var filtered []types.Instance
-	for _, inst := range instances {
-		instanceTags := make(map[string]string)
-		for _, tag := range inst.Tags {
-			instanceTags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
-		}
+// newClientSideMatcher compiles the selector parts matched after resources
+// are fetched. Instance IDs and tags are already applied server-side.
+func newClientSideMatcher(selector Selector) (*awsutil.ResourceMatcher, error) {
+	return awsutil.NewResourceMatcher(awsutil.ResourceSelector{
+		NamePattern: selector.NamePattern,
+		TagSelector: selector.TagSelector,
+	})
+}
 
-		if awsutil.Match(instanceTags, selector) {
-			filtered = append(filtered, inst)
-		}
-	}
-	return filtered
+// resourceOf returns the selector view of an EC2 resource, named by its Name tag.
+func resourceOf(id string, tags []types.Tag) awsutil.Resource {
+	m := tagMap(tags)
+	return awsutil.Resource{ID: id, Name: m["Name"], Tags: m}
 }
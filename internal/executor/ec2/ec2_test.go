@@ -69,7 +69,7 @@ func TestValidate_MissingSelector(t *testing.T) {
 	}
 	err := e.Validate(spec)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "either tags, tagSelector, or instanceIds must be specified")
+	assert.Contains(t, err.Error(), "either tags, tagSelector, namePattern, or instanceIds must be specified")
 }
 
 func TestValidate_WithTags(t *testing.T) {
@@ -133,6 +133,7 @@ func TestShutdown_StopRunningInstances(t *testing.T) {
 	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
 		InstanceIds: []string{"i-123456"},
 	}).Return(&awsec2.StopInstancesOutput{}, nil)
+	expectUnprotected(mockEC2)
 
 	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
 
@@ -493,6 +494,7 @@ func TestShutdown_CapturesAllStatesButOnlyStopsRunning(t *testing.T) {
 	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
 		InstanceIds: []string{"i-running"},
 	}).Return(&awsec2.StopInstancesOutput{}, nil)
+	expectUnprotected(mockEC2)
 
 	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
 
@@ -635,6 +637,7 @@ func TestShutdown_TagSelector_MatchesWildcard(t *testing.T) {
 	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
 		InstanceIds: []string{"i-app-prod-01"},
 	}).Return(&awsec2.StopInstancesOutput{}, nil)
+	expectUnprotected(mockEC2)
 
 	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
 	e := NewWithClients(ec2Factory, nil)
@@ -688,6 +691,7 @@ func TestShutdown_TagSelector_Exists(t *testing.T) {
 	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
 		InstanceIds: []string{"i-with-tag"},
 	}).Return(&awsec2.StopInstancesOutput{}, nil)
+	expectUnprotected(mockEC2)
 
 	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
 	e := NewWithClients(ec2Factory, nil)
@@ -709,6 +713,76 @@ func TestShutdown_TagSelector_Exists(t *testing.T) {
 	mockEC2.AssertExpectations(t)
 }
 
+func TestShutdown_NamePattern_MatchesNameTag(t *testing.T) {
+	ctx := context.Background()
+	mockEC2 := &mocks.EC2Client{}
+
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything).Return(&awsec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{
+						InstanceId: aws.String("i-api-staging"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameRunning},
+						Tags:       []types.Tag{{Key: aws.String("Name"), Value: aws.String("api-staging-1")}},
+					},
+					{
+						InstanceId: aws.String("i-api-prod"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameRunning},
+						Tags:       []types.Tag{{Key: aws.String("Name"), Value: aws.String("api-prod-1")}},
+					},
+					{
+						InstanceId: aws.String("i-unnamed"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameRunning},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
+		InstanceIds: []string{"i-api-staging"},
+	}).Return(&awsec2.StopInstancesOutput{}, nil)
+	expectUnprotected(mockEC2)
+
+	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
+	e := NewWithClients(ec2Factory, nil)
+
+	spec := executor.Spec{
+		TargetName: "test-namepattern",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"namePattern": "api-(staging|dev)-\\d+"}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+	}
+
+	assert.NoError(t, e.Validate(spec))
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Contains(t, result.Message, "stopped 1 of 1 EC2 instance(s)")
+
+	mockEC2.AssertExpectations(t)
+}
+
+func TestValidate_InvalidNamePattern(t *testing.T) {
+	e := New()
+
+	spec := executor.Spec{
+		TargetName: "test-invalid-namepattern",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"namePattern": "api-("}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+	}
+
+	err := e.Validate(spec)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "selector.namePattern")
+}
+
 func TestValidate_TagSelectorAndOldTags_MutualExclusivity(t *testing.T) {
 	e := New()
 
@@ -742,3 +816,238 @@ func TestValidate_TagsAndInstanceIDs_MutualExclusivity(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "selector.tags and selector.instanceIds are mutually exclusive")
 }
+
+// expectUnprotected sets up instances without stop or termination protection.
+func expectUnprotected(mockEC2 *mocks.EC2Client) {
+	mockEC2.On("DescribeInstanceAttribute", mock.Anything, mock.Anything).
+		Return(&awsec2.DescribeInstanceAttributeOutput{}, nil)
+}
+
+func TestShutdown_HibernatesConfiguredInstances(t *testing.T) {
+	ctx := context.Background()
+
+	mockEC2 := &mocks.EC2Client{}
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything).Return(&awsec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{
+						InstanceId:         aws.String("i-hibernate"),
+						State:              &types.InstanceState{Name: types.InstanceStateNameRunning},
+						HibernationOptions: &types.HibernationOptions{Configured: aws.Bool(true)},
+					},
+					{
+						InstanceId: aws.String("i-stop"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameRunning},
+					},
+				},
+			},
+		},
+	}, nil)
+	expectUnprotected(mockEC2)
+
+	// Instances launched with hibernation are stopped with Hibernate=true,
+	// the others fall back to a regular stop.
+	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
+		InstanceIds: []string{"i-hibernate"},
+		Hibernate:   aws.Bool(true),
+	}).Return(&awsec2.StopInstancesOutput{}, nil)
+	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
+		InstanceIds: []string{"i-stop"},
+	}).Return(&awsec2.StopInstancesOutput{}, nil)
+
+	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
+	e := NewWithClients(ec2Factory, nil)
+
+	captured := make(map[string]InstanceState)
+	spec := executor.Spec{
+		TargetName: "test-instances",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"tags": {"Environment": "dev"}}, "hibernate": true}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			captured[key] = value.(InstanceState)
+			return nil
+		},
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "stopped 2 of 2 EC2 instance(s) (1 hibernated)", result.Message)
+	assert.Equal(t, StopModeHibernate, captured["i-hibernate"].StopMode)
+	assert.Equal(t, StopModeStop, captured["i-stop"].StopMode)
+
+	mockEC2.AssertExpectations(t)
+}
+
+func TestShutdown_SkipsProtectedInstances(t *testing.T) {
+	ctx := context.Background()
+
+	mockEC2 := &mocks.EC2Client{}
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything).Return(&awsec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{InstanceId: aws.String("i-stop-protected"), State: &types.InstanceState{Name: types.InstanceStateNameRunning}},
+					{InstanceId: aws.String("i-term-protected"), State: &types.InstanceState{Name: types.InstanceStateNameRunning}},
+					{InstanceId: aws.String("i-unprotected"), State: &types.InstanceState{Name: types.InstanceStateNameRunning}},
+				},
+			},
+		},
+	}, nil)
+
+	attribute := func(instanceID string, name types.InstanceAttributeName) interface{} {
+		return mock.MatchedBy(func(input *awsec2.DescribeInstanceAttributeInput) bool {
+			return aws.ToString(input.InstanceId) == instanceID && input.Attribute == name
+		})
+	}
+	enabled := &types.AttributeBooleanValue{Value: aws.Bool(true)}
+	disabled := &types.AttributeBooleanValue{Value: aws.Bool(false)}
+
+	mockEC2.On("DescribeInstanceAttribute", mock.Anything, attribute("i-stop-protected", types.InstanceAttributeNameDisableApiStop)).
+		Return(&awsec2.DescribeInstanceAttributeOutput{DisableApiStop: enabled}, nil)
+	mockEC2.On("DescribeInstanceAttribute", mock.Anything, attribute("i-term-protected", types.InstanceAttributeNameDisableApiStop)).
+		Return(&awsec2.DescribeInstanceAttributeOutput{DisableApiStop: disabled}, nil)
+	mockEC2.On("DescribeInstanceAttribute", mock.Anything, attribute("i-term-protected", types.InstanceAttributeNameDisableApiTermination)).
+		Return(&awsec2.DescribeInstanceAttributeOutput{DisableApiTermination: enabled}, nil)
+	// Credentials without ec2:DescribeInstanceAttribute treat the instance as unprotected.
+	mockEC2.On("DescribeInstanceAttribute", mock.Anything, attribute("i-unprotected", types.InstanceAttributeNameDisableApiStop)).
+		Return(nil, &smithy.GenericAPIError{Code: "UnauthorizedOperation", Message: "not authorized"})
+
+	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
+		InstanceIds: []string{"i-unprotected"},
+	}).Return(&awsec2.StopInstancesOutput{}, nil)
+
+	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
+	e := NewWithClients(ec2Factory, nil)
+
+	captured := make(map[string]InstanceState)
+	spec := executor.Spec{
+		TargetName: "test-instances",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"tags": {"Environment": "dev"}}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			captured[key] = value.(InstanceState)
+			return nil
+		},
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "stopped 1 of 3 EC2 instance(s); skipped 2 protected instance(s): "+
+		"i-stop-protected (stop protection enabled), i-term-protected (termination protection enabled)", result.Message)
+
+	assert.True(t, captured["i-stop-protected"].WasRunning)
+	assert.Equal(t, "stop protection enabled", captured["i-stop-protected"].SkipReason)
+	assert.Empty(t, captured["i-stop-protected"].StopMode)
+	assert.Equal(t, "termination protection enabled", captured["i-term-protected"].SkipReason)
+	assert.Equal(t, StopModeStop, captured["i-unprotected"].StopMode)
+
+	mockEC2.AssertExpectations(t)
+}
+
+func TestShutdown_SkipsInstancesTaggedProtected(t *testing.T) {
+	ctx := context.Background()
+
+	mockEC2 := &mocks.EC2Client{}
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything).Return(&awsec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{
+						InstanceId: aws.String("i-protected"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameRunning},
+						Tags:       []types.Tag{{Key: aws.String("hibernator.ardikabs.com/protected"), Value: aws.String("true")}},
+					},
+					{InstanceId: aws.String("i-unprotected"), State: &types.InstanceState{Name: types.InstanceStateNameRunning}},
+				},
+			},
+		},
+	}, nil)
+
+	// The tag is checked before the instance attributes.
+	mockEC2.On("DescribeInstanceAttribute", mock.Anything, mock.MatchedBy(func(input *awsec2.DescribeInstanceAttributeInput) bool {
+		return aws.ToString(input.InstanceId) == "i-unprotected"
+	})).Return(&awsec2.DescribeInstanceAttributeOutput{}, nil)
+	mockEC2.On("StopInstances", mock.Anything, &awsec2.StopInstancesInput{
+		InstanceIds: []string{"i-unprotected"},
+	}).Return(&awsec2.StopInstancesOutput{}, nil)
+
+	ec2Factory := func(cfg aws.Config) EC2Client { return mockEC2 }
+	e := NewWithClients(ec2Factory, nil)
+
+	captured := make(map[string]InstanceState)
+	spec := executor.Spec{
+		TargetName: "test-instances",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"tags": {"Environment": "dev"}}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			captured[key] = value.(InstanceState)
+			return nil
+		},
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "stopped 1 of 2 EC2 instance(s); skipped 1 protected instance(s): "+
+		"i-protected (hibernator.ardikabs.com/protected tag set)", result.Message)
+	assert.Equal(t, "hibernator.ardikabs.com/protected tag set", captured["i-protected"].SkipReason)
+
+	mockEC2.AssertExpectations(t)
+}
+
+func TestPreview_ListsSelectedInstancesWithoutStopping(t *testing.T) {
+	ctx := context.Background()
+	mockEC2 := &mocks.EC2Client{}
+
+	mockEC2.On("DescribeInstances", mock.Anything, mock.Anything).Return(&awsec2.DescribeInstancesOutput{
+		Reservations: []types.Reservation{
+			{
+				Instances: []types.Instance{
+					{
+						InstanceId: aws.String("i-app-01"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameRunning},
+						Tags:       []types.Tag{{Key: aws.String("Name"), Value: aws.String("app-01")}},
+					},
+					{
+						InstanceId: aws.String("i-app-02"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameStopped},
+						Tags:       []types.Tag{{Key: aws.String("Name"), Value: aws.String("app-02")}},
+					},
+					{
+						InstanceId: aws.String("i-db-01"),
+						State:      &types.InstanceState{Name: types.InstanceStateNameRunning},
+						Tags:       []types.Tag{{Key: aws.String("Name"), Value: aws.String("db-01")}},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	e := NewWithClients(func(cfg aws.Config) EC2Client { return mockEC2 }, nil)
+
+	spec := executor.Spec{
+		TargetName: "test-preview",
+		TargetType: "ec2",
+		Parameters: json.RawMessage(`{"selector": {"tagSelector": {"matchExpressions": [{"key": "Name", "operator": "Matches", "values": ["app-*"]}]}}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+	}
+
+	ids, err := e.Preview(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"i-app-01", "i-app-02"}, ids)
+
+	// Only DescribeInstances is called: nothing is stopped.
+	mockEC2.AssertExpectations(t)
+	mockEC2.AssertNotCalled(t, "StopInstances", mock.Anything, mock.Anything)
+}
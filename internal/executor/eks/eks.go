@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
 
 	"github.com/ardikabs/hibernator/internal/executor"
 	"github.com/ardikabs/hibernator/pkg/awsutil"
@@ -33,6 +34,10 @@ import (
 const (
 	ExecutorType       = "eks"
 	DefaultWaitTimeout = "10m"
+
+	// DefaultPreWakeWarmNodes is the number of nodes started per node group by
+	// the pre-wake hook when warmNodes is not set.
+	DefaultPreWakeWarmNodes int32 = 1
 )
 
 // Parameters is an alias for the shared EKS parameter type.
@@ -52,19 +57,22 @@ type NodeGroupState struct {
 type operationOutcome string
 
 const (
-	operationOutcomeApplied      operationOutcome = "applied"
-	operationOutcomeSkippedStale operationOutcome = "skipped_stale"
+	operationOutcomeApplied          operationOutcome = "applied"
+	operationOutcomeSkippedStale     operationOutcome = "skipped_stale"
+	operationOutcomeSkippedProtected operationOutcome = "skipped_protected"
 )
 
 type operationStats struct {
 	processed    int
 	applied      int
 	skippedStale int
+	protected    int
 }
 
 func formatShutdownMessage(clusterName string, stats operationStats) string {
 	msg := fmt.Sprintf("scaled %d node group(s) to zero in EKS cluster %s", stats.applied, clusterName)
-	return appendCountSegment(msg, "skipped", stats.skippedStale, "stale node group")
+	msg = appendCountSegment(msg, "skipped", stats.skippedStale, "stale node group")
+	return appendCountSegment(msg, "skipped", stats.protected, "protected node group")
 }
 
 func formatWakeUpMessage(clusterName string, stats operationStats) string {
@@ -72,6 +80,17 @@ func formatWakeUpMessage(clusterName string, stats operationStats) string {
 	return appendCountSegment(msg, "skipped", stats.skippedStale, "stale node group")
 }
 
+// reportProgress reports the node groups handled so far, up to ngName, through
+// the spec's progress callback.
+func reportProgress(spec executor.Spec, action string, handled, total int, ngName string) {
+	spec.ReportProgress(executor.ResourceProgress{
+		Completed: handled,
+		Total:     total,
+		Resource:  ngName,
+		Message:   fmt.Sprintf("%s %d/%d node group(s)", action, handled, total),
+	})
+}
+
 func appendCountSegment(msg, action string, count int, noun string) string {
 	if count <= 0 {
 		return msg
@@ -80,6 +99,8 @@ func appendCountSegment(msg, action string, count int, noun string) string {
 	return fmt.Sprintf("%s, %s %d %s(s)", msg, action, count, noun)
 }
 
+var _ executor.PreWaker = (*Executor)(nil)
+
 // Executor implements the EKS hibernation logic for Managed Node Groups.
 type Executor struct {
 	eksFactory      EKSClientFactory
@@ -202,10 +223,15 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		return nil, fmt.Errorf("determine target node groups: %w", err)
 	}
 
+	if err := executor.CheckResourceLimit(spec, len(targetNodeGroups), "node group"); err != nil {
+		log.Error(err, "refusing to shut down")
+		return nil, err
+	}
+
 	stats := operationStats{processed: len(targetNodeGroups)}
 
 	// Scale each node group to zero
-	for _, ngName := range targetNodeGroups {
+	for i, ngName := range targetNodeGroups {
 		log.Info("scaling node group to zero",
 			"clusterName", clusterName,
 			"nodeGroup", ngName,
@@ -225,7 +251,10 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 			stats.applied++
 		case operationOutcomeSkippedStale:
 			stats.skippedStale++
+		case operationOutcomeSkippedProtected:
+			stats.protected++
 		}
+		reportProgress(spec, "scaled down", i+1, stats.processed, ngName)
 	}
 
 	// Wait for all node groups to complete scaling down if configured
@@ -265,11 +294,26 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		"processed", stats.processed,
 		"scaled", stats.applied,
 		"skippedStale", stats.skippedStale,
+		"protected", stats.protected,
 	)
 
 	return &executor.Result{Message: msg}, nil
 }
 
+// ValidateRestore checks that every restore entry is a node group state with
+// a consistent scaling configuration.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries(restore, func(_ string, state *NodeGroupState) error {
+		if !state.WasScaled {
+			return nil
+		}
+		if state.MinSize < 0 || state.MinSize > state.DesiredSize || state.DesiredSize > state.MaxSize {
+			return fmt.Errorf("invalid scaling config min=%d desired=%d max=%d", state.MinSize, state.DesiredSize, state.MaxSize)
+		}
+		return nil
+	})
+}
+
 // WakeUp restores EKS Managed Node Groups to their original scaling configuration.
 func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
 	log = log.WithName("eks").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
@@ -301,7 +345,9 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 	stats := operationStats{processed: len(restore.Data)}
 
 	// Restore each node group
+	handled := 0
 	for ngName, stateBytes := range restore.Data {
+		handled++
 		var state NodeGroupState
 		if err := json.Unmarshal(stateBytes, &state); err != nil {
 			log.Error(err, "failed to unmarshal node group state", "nodeGroup", ngName)
@@ -314,6 +360,7 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 				"clusterName", clusterName,
 				"nodeGroup", ngName,
 			)
+			reportProgress(spec, "restored", handled, stats.processed, ngName)
 			continue
 		}
 
@@ -340,6 +387,7 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 		case operationOutcomeSkippedStale:
 			stats.skippedStale++
 		}
+		reportProgress(spec, "restored", handled, stats.processed, ngName)
 	}
 
 	// Wait for all node groups to become active if configured
@@ -383,6 +431,76 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 	return &executor.Result{Message: msg}, nil
 }
 
+// PreWake starts a few nodes in each restored node group ahead of the
+// scheduled wakeup, so workloads scaled up at the wake boundary land on warm
+// nodes instead of waiting for instance launch and image pulls. The restore
+// data is left untouched; WakeUp later applies the full scaling config.
+func (e *Executor) PreWake(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
+	log = log.WithName("eks").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
+	log.Info("executor starting pre-wake")
+
+	if len(restore.Data) == 0 {
+		log.Info("no restore data available, pre-wake operation is no-op")
+		return &executor.Result{Message: "pre-wake completed for EKS (no restore data)"}, nil
+	}
+
+	params, err := e.parseParams(spec.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("parse parameters: %w", err)
+	}
+
+	var hook executorparams.EKSPreWakeParameters
+	if len(spec.PreWakeParameters) > 0 {
+		if err := json.Unmarshal(spec.PreWakeParameters, &hook); err != nil {
+			return nil, fmt.Errorf("parse pre-wake parameters: %w", err)
+		}
+	}
+	warmNodes := ptr.Deref(hook.WarmNodes, DefaultPreWakeWarmNodes)
+
+	cfg, err := e.loadAWSConfig(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config: %w", err)
+	}
+
+	eksClient := e.eksFactory(cfg)
+	clusterName := params.ClusterName
+	warmed := 0
+
+	for ngName, stateBytes := range restore.Data {
+		var state NodeGroupState
+		if err := json.Unmarshal(stateBytes, &state); err != nil {
+			return nil, fmt.Errorf("unmarshal node group state %s: %w", ngName, err)
+		}
+		if !state.WasScaled || state.DesiredSize == 0 {
+			continue
+		}
+
+		desired := min(warmNodes, state.DesiredSize)
+		_, err := eksClient.UpdateNodegroupConfig(ctx, &eks.UpdateNodegroupConfigInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: aws.String(ngName),
+			ScalingConfig: &types.NodegroupScalingConfig{
+				MinSize:     aws.Int32(0),
+				DesiredSize: aws.Int32(desired),
+				MaxSize:     aws.Int32(state.MaxSize),
+			},
+		})
+		if err != nil {
+			var notFoundErr *types.ResourceNotFoundException
+			if errors.As(err, &notFoundErr) {
+				log.Info("node group not found, skipping pre-wake", "clusterName", clusterName, "nodeGroup", ngName)
+				continue
+			}
+			return nil, fmt.Errorf("warm node group %s: %w", ngName, err)
+		}
+
+		log.Info("node group warmed", "clusterName", clusterName, "nodeGroup", ngName, "desiredSize", desired)
+		warmed++
+	}
+
+	return &executor.Result{Message: fmt.Sprintf("warmed %d node group(s) in EKS cluster %s", warmed, clusterName)}, nil
+}
+
 func (e *Executor) parseParams(raw json.RawMessage) (Parameters, error) {
 	var params Parameters
 	if len(raw) == 0 {
@@ -434,6 +552,14 @@ func (e *Executor) scaleNodeGroupToZero(ctx context.Context, log logr.Logger, ek
 		return "", err
 	}
 
+	if executor.IsProtected(desc.Nodegroup.Tags) {
+		log.Info("node group is protected, skipping scale down",
+			"clusterName", clusterName,
+			"nodeGroup", ngName,
+		)
+		return operationOutcomeSkippedProtected, nil
+	}
+
 	desiredSize := aws.ToInt32(desc.Nodegroup.ScalingConfig.DesiredSize)
 	minSize := aws.ToInt32(desc.Nodegroup.ScalingConfig.MinSize)
 	maxSize := aws.ToInt32(desc.Nodegroup.ScalingConfig.MaxSize)
@@ -124,6 +124,47 @@ func TestValidate_WithNodeGroups(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidateRestore(t *testing.T) {
+	e := New()
+
+	tests := []struct {
+		name    string
+		data    map[string]json.RawMessage
+		wantErr string
+	}{
+		{
+			name: "valid scaled node group",
+			data: map[string]json.RawMessage{"ng-1": json.RawMessage(`{"desired":2,"min":1,"max":3,"wasScaled":true}`)},
+		},
+		{
+			name: "unscaled node group skips scaling checks",
+			data: map[string]json.RawMessage{"ng-1": json.RawMessage(`{"desired":0,"min":0,"max":0,"wasScaled":false}`)},
+		},
+		{
+			name:    "malformed JSON",
+			data:    map[string]json.RawMessage{"ng-1": json.RawMessage(`{"desired":"two"}`)},
+			wantErr: "ng-1",
+		},
+		{
+			name:    "inconsistent scaling config",
+			data:    map[string]json.RawMessage{"ng-1": json.RawMessage(`{"desired":5,"min":1,"max":3,"wasScaled":true}`)},
+			wantErr: "invalid scaling config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := e.ValidateRestore(executor.RestoreData{Type: "eks", Data: tt.data})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, executor.ErrInvalidRestoreData)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestShutdown_WithSpecificNodeGroups(t *testing.T) {
 	ctx := context.Background()
 
@@ -187,6 +228,60 @@ func TestShutdown_WithSpecificNodeGroups(t *testing.T) {
 	mockEKS.AssertExpectations(t)
 }
 
+func TestShutdown_SkipsProtectedNodeGroups(t *testing.T) {
+	ctx := context.Background()
+
+	mockEKS := &mocks.EKSClient{}
+	mockK8S := &mocks.K8SClient{}
+
+	mockEKS.On("DescribeCluster", mock.Anything, mock.Anything).Return(&eks.DescribeClusterOutput{
+		Cluster: &types.Cluster{
+			Endpoint: aws.String("https://eks.example.com"),
+			CertificateAuthority: &types.Certificate{
+				Data: aws.String(base64.StdEncoding.EncodeToString([]byte("test-ca-data"))),
+			},
+		},
+	}, nil)
+	mockEKS.On("DescribeNodegroup", mock.Anything, mock.Anything).Return(&eks.DescribeNodegroupOutput{
+		Nodegroup: &types.Nodegroup{
+			ScalingConfig: &types.NodegroupScalingConfig{
+				DesiredSize: aws.Int32(3),
+				MinSize:     aws.Int32(1),
+				MaxSize:     aws.Int32(5),
+			},
+			Tags: map[string]string{"hibernator.ardikabs.com/protected": "true"},
+		},
+	}, nil)
+
+	eksFactory := func(cfg aws.Config) EKSClient { return mockEKS }
+	stsFactory := func(cfg aws.Config) STSClient { return &mocks.STSClient{} }
+
+	e := NewWithClients(eksFactory, stsFactory, nil)
+	e.k8sFactory = func(ctx context.Context, spec *executor.Spec) (K8SClient, error) { return mockK8S, nil }
+
+	var reported []string
+	spec := executor.Spec{
+		TargetName: "test-cluster",
+		TargetType: "eks",
+		Parameters: json.RawMessage(`{"clusterName": "my-cluster", "nodeGroups": [{"name": "ng-1"}]}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			reported = append(reported, key)
+			return nil
+		},
+	}
+
+	// No UpdateNodegroupConfig expectation: the protected node group keeps its size.
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "scaled 0 node group(s) to zero in EKS cluster my-cluster, skipped 1 protected node group(s)", result.Message)
+	assert.Empty(t, reported)
+
+	mockEKS.AssertExpectations(t)
+}
+
 func TestShutdown_WithListAllNodeGroups(t *testing.T) {
 	ctx := context.Background()
 
@@ -455,6 +550,55 @@ func TestWakeUp_InvalidRestoreData(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPreWake_WarmsRestoredNodeGroups(t *testing.T) {
+	ctx := context.Background()
+
+	mockEKS := &mocks.EKSClient{}
+	mockEKS.On("UpdateNodegroupConfig", mock.Anything, mock.MatchedBy(func(input *eks.UpdateNodegroupConfigInput) bool {
+		return aws.ToString(input.NodegroupName) == "ng-1" &&
+			aws.ToInt32(input.ScalingConfig.MinSize) == 0 &&
+			aws.ToInt32(input.ScalingConfig.DesiredSize) == 2 &&
+			aws.ToInt32(input.ScalingConfig.MaxSize) == 5
+	})).Return(&eks.UpdateNodegroupConfigOutput{}, nil)
+
+	e := NewWithClients(
+		func(cfg aws.Config) EKSClient { return mockEKS },
+		func(cfg aws.Config) STSClient { return &mocks.STSClient{} },
+		nil,
+	)
+
+	scaled, _ := json.Marshal(NodeGroupState{DesiredSize: 3, MinSize: 1, MaxSize: 5, WasScaled: true})
+	voluntary, _ := json.Marshal(NodeGroupState{DesiredSize: 0, MinSize: 0, MaxSize: 5, WasScaled: false})
+
+	spec := executor.Spec{
+		TargetName:        "test-cluster",
+		TargetType:        "eks",
+		Parameters:        json.RawMessage(`{"clusterName": "my-cluster"}`),
+		PreWakeParameters: json.RawMessage(`{"warmNodes": 2}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+	}
+	restore := executor.RestoreData{
+		Type: "eks",
+		Data: map[string]json.RawMessage{"ng-1": scaled, "ng-voluntary": voluntary},
+	}
+
+	res, err := e.PreWake(ctx, logr.Discard(), spec, restore)
+	assert.NoError(t, err)
+	assert.Equal(t, "warmed 1 node group(s) in EKS cluster my-cluster", res.Message)
+	mockEKS.AssertExpectations(t)
+	mockEKS.AssertNumberOfCalls(t, "UpdateNodegroupConfig", 1)
+}
+
+func TestPreWake_NoRestoreData(t *testing.T) {
+	e := NewWithClients(nil, nil, nil)
+
+	res, err := e.PreWake(context.Background(), logr.Discard(), executor.Spec{TargetName: "t", TargetType: "eks"}, executor.RestoreData{})
+	assert.NoError(t, err)
+	assert.Contains(t, res.Message, "no restore data")
+}
+
 func TestShutdown_InvalidParameters(t *testing.T) {
 	e := New()
 	ctx := context.Background()
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/smithy-go"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// FailureReason classifies why an operation failed. The runner reports it with
+// the completion, and the controller stores it in the execution status for
+// recovery to decide whether a retry can succeed.
+type FailureReason string
+
+const (
+	// FailureAuthError means the executor was not authenticated or not
+	// authorized to act on the target.
+	FailureAuthError FailureReason = "AuthError"
+	// FailureThrottled means the API rate-limited the executor.
+	FailureThrottled FailureReason = "Throttled"
+	// FailureResourceNotFound means a resource the executor acts on does not exist.
+	FailureResourceNotFound FailureReason = "ResourceNotFound"
+	// FailureTimeout means the operation ran out of time.
+	FailureTimeout FailureReason = "Timeout"
+	// FailurePermanentAPIError means the API rejected a request in a way a
+	// retry will not fix.
+	FailurePermanentAPIError FailureReason = "PermanentAPIError"
+	// FailureResourceLimitExceeded means the target selected more resources
+	// than its shutdown may act on.
+	FailureResourceLimitExceeded FailureReason = "ResourceLimitExceeded"
+)
+
+// Failure is an error classified by its executor, for failures the generic
+// classification of ReasonOf cannot tell, e.g. a resource in a state the
+// operation does not support.
+type Failure struct {
+	Reason FailureReason
+	Err    error
+}
+
+// NewFailure classifies err with reason. It returns nil when err is nil.
+func NewFailure(reason FailureReason, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Failure{Reason: reason, Err: err}
+}
+
+func (f *Failure) Error() string {
+	return f.Err.Error()
+}
+
+func (f *Failure) Unwrap() error {
+	return f.Err
+}
+
+// AWS error codes by the failure they indicate. Codes ending in NotFound,
+// e.g. InvalidInstanceID.NotFound or DBInstanceNotFound, need no entry.
+var (
+	awsAuthCodes = map[string]bool{
+		"AccessDenied":                true,
+		"AccessDeniedException":       true,
+		"AuthFailure":                 true,
+		"ExpiredToken":                true,
+		"ExpiredTokenException":       true,
+		"InvalidClientTokenId":        true,
+		"SignatureDoesNotMatch":       true,
+		"UnauthorizedOperation":       true,
+		"UnrecognizedClientException": true,
+	}
+	awsThrottleCodes = map[string]bool{
+		"ProvisionedThroughputExceededException": true,
+		"RequestLimitExceeded":                   true,
+		"RequestThrottled":                       true,
+		"SlowDown":                               true,
+		"Throttling":                             true,
+		"ThrottlingException":                    true,
+		"TooManyRequestsException":               true,
+	}
+)
+
+// ReasonOf classifies err from the errors in its chain: an explicit Failure,
+// AWS API errors, Kubernetes API errors and exceeded deadlines. It returns an
+// empty reason for errors it cannot classify.
+func ReasonOf(err error) FailureReason {
+	if err == nil {
+		return ""
+	}
+
+	var failure *Failure
+	if errors.As(err, &failure) {
+		return failure.Reason
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		if reason := awsReason(apiErr); reason != "" {
+			return reason
+		}
+	}
+
+	if reason := kubernetesReason(err); reason != "" {
+		return reason
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return FailureTimeout
+	}
+	return ""
+}
+
+func awsReason(apiErr smithy.APIError) FailureReason {
+	code := apiErr.ErrorCode()
+	switch {
+	case awsAuthCodes[code]:
+		return FailureAuthError
+	case awsThrottleCodes[code]:
+		return FailureThrottled
+	case strings.HasSuffix(code, "NotFound"), strings.HasSuffix(code, "NotFoundFault"), strings.HasSuffix(code, "NotFoundException"):
+		return FailureResourceNotFound
+	case code == "RequestTimeout", code == "RequestTimeoutException":
+		return FailureTimeout
+	case apiErr.ErrorFault() == smithy.FaultClient:
+		return FailurePermanentAPIError
+	}
+	return ""
+}
+
+func kubernetesReason(err error) FailureReason {
+	switch {
+	case apierrors.IsUnauthorized(err), apierrors.IsForbidden(err):
+		return FailureAuthError
+	case apierrors.IsTooManyRequests(err):
+		return FailureThrottled
+	case apierrors.IsNotFound(err):
+		return FailureResourceNotFound
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err):
+		return FailureTimeout
+	case apierrors.IsBadRequest(err), apierrors.IsInvalid(err), apierrors.IsMethodNotSupported(err):
+		return FailurePermanentAPIError
+	}
+	return ""
+}
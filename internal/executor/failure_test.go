@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestReasonOf(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+
+	tests := []struct {
+		name string
+		err  error
+		want FailureReason
+	}{
+		{name: "nil", err: nil, want: ""},
+		{name: "unclassified", err: errors.New("boom"), want: ""},
+		{
+			name: "explicit failure",
+			err:  fmt.Errorf("stop cluster: %w", NewFailure(FailurePermanentAPIError, errors.New("unsupported engine"))),
+			want: FailurePermanentAPIError,
+		},
+		{
+			name: "aws expired token",
+			err:  fmt.Errorf("describe instances: %w", &smithy.GenericAPIError{Code: "ExpiredToken", Fault: smithy.FaultClient}),
+			want: FailureAuthError,
+		},
+		{
+			name: "aws throttling",
+			err:  &smithy.GenericAPIError{Code: "ThrottlingException", Fault: smithy.FaultClient},
+			want: FailureThrottled,
+		},
+		{
+			name: "aws not found",
+			err:  &smithy.GenericAPIError{Code: "InvalidInstanceID.NotFound", Fault: smithy.FaultClient},
+			want: FailureResourceNotFound,
+		},
+		{
+			name: "aws rds not found fault",
+			err:  &smithy.GenericAPIError{Code: "DBClusterNotFoundFault", Fault: smithy.FaultClient},
+			want: FailureResourceNotFound,
+		},
+		{
+			name: "aws other client fault",
+			err:  &smithy.GenericAPIError{Code: "InvalidParameterValue", Fault: smithy.FaultClient},
+			want: FailurePermanentAPIError,
+		},
+		{
+			name: "aws server fault",
+			err:  &smithy.GenericAPIError{Code: "InternalError", Fault: smithy.FaultServer},
+			want: "",
+		},
+		{
+			name: "kubernetes forbidden",
+			err:  fmt.Errorf("scale: %w", apierrors.NewForbidden(deployments, "api", errors.New("denied"))),
+			want: FailureAuthError,
+		},
+		{
+			name: "kubernetes too many requests",
+			err:  apierrors.NewTooManyRequests("slow down", 1),
+			want: FailureThrottled,
+		},
+		{
+			name: "kubernetes not found",
+			err:  apierrors.NewNotFound(deployments, "api"),
+			want: FailureResourceNotFound,
+		},
+		{
+			name: "kubernetes bad request",
+			err:  apierrors.NewBadRequest("invalid replicas"),
+			want: FailurePermanentAPIError,
+		},
+		{
+			name: "deadline exceeded",
+			err:  fmt.Errorf("timeout waiting for instances after 5m: %w", context.DeadlineExceeded),
+			want: FailureTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ReasonOf(tt.err))
+		})
+	}
+}
+
+func TestNewFailure(t *testing.T) {
+	assert.NoError(t, NewFailure(FailureTimeout, nil))
+
+	cause := errors.New("cluster is not available")
+	err := NewFailure(FailurePermanentAPIError, cause)
+	assert.EqualError(t, err, "cluster is not available")
+	assert.ErrorIs(t, err, cause)
+}
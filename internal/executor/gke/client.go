@@ -0,0 +1,58 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package gke
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+)
+
+// Cluster identifies a GKE cluster.
+type Cluster struct {
+	Project  string
+	Location string
+	Name     string
+}
+
+// String returns the resource name of the cluster in the Container API.
+func (c Cluster) String() string {
+	return fmt.Sprintf("projects/%s/locations/%s/clusters/%s", c.Project, c.Location, c.Name)
+}
+
+// Client defines the subset of the GKE Container API used by the executor.
+type Client interface {
+	// GetAutoprovisioning returns the node auto-provisioning settings of the cluster.
+	GetAutoprovisioning(ctx context.Context, cluster Cluster) (*AutoprovisioningState, error)
+
+	// SetAutoprovisioning updates the node auto-provisioning settings of the cluster.
+	SetAutoprovisioning(ctx context.Context, cluster Cluster, state AutoprovisioningState) error
+
+	// GetNodePool returns the size and autoscaling settings of a node pool.
+	GetNodePool(ctx context.Context, cluster Cluster, name string) (*NodePoolState, error)
+
+	// SetNodePoolAutoscaling updates the autoscaling settings of a node pool.
+	SetNodePoolAutoscaling(ctx context.Context, cluster Cluster, name string, state NodePoolState) error
+
+	// SetNodePoolSize resizes a node pool to nodeCount nodes in each of its zones.
+	SetNodePoolSize(ctx context.Context, cluster Cluster, name string, nodeCount int) error
+}
+
+// ClientFactory is a function type for creating GKE Container API clients.
+type ClientFactory func(ctx context.Context, spec *executor.Spec) (Client, error)
+
+// clusterOf returns the cluster the spec targets.
+func clusterOf(spec *executor.Spec) (Cluster, error) {
+	k8s := spec.ConnectorConfig.K8S
+	if k8s == nil {
+		return Cluster{}, fmt.Errorf("K8S connector config is required")
+	}
+	if k8s.GCP == nil || k8s.GCP.ProjectID == "" {
+		return Cluster{}, fmt.Errorf("GCP project is required")
+	}
+	return Cluster{Project: k8s.GCP.ProjectID, Location: k8s.Region, Name: k8s.ClusterName}, nil
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package gke
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/pkg/gcputil"
+	"github.com/ardikabs/hibernator/pkg/k8sutil"
+)
+
+// operationPollInterval is how often a pending GKE operation is polled.
+const operationPollInterval = 5 * time.Second
+
+// operationDone is the status of a completed GKE operation.
+const operationDone = "DONE"
+
+// nodePoolLabel is the label GKE puts on the nodes of a node pool.
+const nodePoolLabel = "cloud.google.com/gke-nodepool"
+
+// newContainerClient builds a Client on the GKE Container API, authorized with
+// the GCP identity of the K8SCluster connector of spec. Node pool sizes are
+// read from the nodes of the cluster, which the Container API does not report.
+func newContainerClient(ctx context.Context, spec *executor.Spec) (Client, error) {
+	k8s := spec.ConnectorConfig.K8S
+	if k8s == nil {
+		return nil, fmt.Errorf("K8S connector config is required")
+	}
+
+	httpClient, err := gcputil.HTTPClient(ctx, k8s.GCP)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := container.NewService(ctx, option.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("create GKE Container service: %w", err)
+	}
+
+	_, nodes, err := k8sutil.BuildClients(ctx, k8s)
+	if err != nil {
+		return nil, fmt.Errorf("build Kubernetes client: %w", err)
+	}
+
+	return &containerClient{svc: svc, nodes: nodes, pollInterval: operationPollInterval}, nil
+}
+
+// containerClient implements Client with google.golang.org/api/container/v1.
+type containerClient struct {
+	svc          *container.Service
+	nodes        kubernetes.Interface
+	pollInterval time.Duration
+}
+
+// GetAutoprovisioning implements Client.
+func (c *containerClient) GetAutoprovisioning(ctx context.Context, cluster Cluster) (*AutoprovisioningState, error) {
+	cl, err := c.svc.Projects.Locations.Clusters.Get(cluster.String()).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &AutoprovisioningState{}
+	if cl.Autoscaling == nil {
+		return state, nil
+	}
+	state.Enabled = cl.Autoscaling.EnableNodeAutoprovisioning
+	for _, l := range cl.Autoscaling.ResourceLimits {
+		state.ResourceLimits = append(state.ResourceLimits, ResourceLimit{
+			ResourceType: l.ResourceType,
+			Minimum:      l.Minimum,
+			Maximum:      l.Maximum,
+		})
+	}
+	return state, nil
+}
+
+// SetAutoprovisioning implements Client.
+func (c *containerClient) SetAutoprovisioning(ctx context.Context, cluster Cluster, state AutoprovisioningState) error {
+	autoscaling := &container.ClusterAutoscaling{
+		EnableNodeAutoprovisioning: state.Enabled,
+		ForceSendFields:            []string{"EnableNodeAutoprovisioning"},
+	}
+	for _, l := range state.ResourceLimits {
+		autoscaling.ResourceLimits = append(autoscaling.ResourceLimits, &container.ResourceLimit{
+			ResourceType: l.ResourceType,
+			Minimum:      l.Minimum,
+			Maximum:      l.Maximum,
+		})
+	}
+
+	op, err := c.svc.Projects.Locations.Clusters.Update(cluster.String(), &container.UpdateClusterRequest{
+		Update: &container.ClusterUpdate{DesiredClusterAutoscaling: autoscaling},
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, cluster, op)
+}
+
+// GetNodePool implements Client.
+func (c *containerClient) GetNodePool(ctx context.Context, cluster Cluster, name string) (*NodePoolState, error) {
+	np, err := c.svc.Projects.Locations.Clusters.NodePools.Get(nodePoolName(cluster, name)).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := c.nodes.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", nodePoolLabel, name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+
+	state := &NodePoolState{
+		Name:      np.Name,
+		NodeCount: perZone(len(nodes.Items), len(np.Locations)),
+	}
+	if np.Autoscaling != nil && np.Autoscaling.Enabled {
+		state.Autoscaling = true
+		state.MinNodeCount = int(np.Autoscaling.MinNodeCount)
+		state.MaxNodeCount = int(np.Autoscaling.MaxNodeCount)
+	}
+	return state, nil
+}
+
+// SetNodePoolAutoscaling implements Client.
+func (c *containerClient) SetNodePoolAutoscaling(ctx context.Context, cluster Cluster, name string, state NodePoolState) error {
+	autoscaling := &container.NodePoolAutoscaling{
+		Enabled:         state.Autoscaling,
+		ForceSendFields: []string{"Enabled"},
+	}
+	if state.Autoscaling {
+		autoscaling.MinNodeCount = int64(state.MinNodeCount)
+		autoscaling.MaxNodeCount = int64(state.MaxNodeCount)
+		autoscaling.ForceSendFields = append(autoscaling.ForceSendFields, "MinNodeCount")
+	}
+
+	op, err := c.svc.Projects.Locations.Clusters.NodePools.SetAutoscaling(nodePoolName(cluster, name), &container.SetNodePoolAutoscalingRequest{
+		Autoscaling: autoscaling,
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, cluster, op)
+}
+
+// SetNodePoolSize implements Client.
+func (c *containerClient) SetNodePoolSize(ctx context.Context, cluster Cluster, name string, nodeCount int) error {
+	op, err := c.svc.Projects.Locations.Clusters.NodePools.SetSize(nodePoolName(cluster, name), &container.SetNodePoolSizeRequest{
+		NodeCount:       int64(nodeCount),
+		ForceSendFields: []string{"NodeCount"},
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, cluster, op)
+}
+
+// wait polls op until it is done and returns the error it completed with. GKE
+// runs one operation per cluster at a time, so each change must complete
+// before the next one is requested.
+func (c *containerClient) wait(ctx context.Context, cluster Cluster, op *container.Operation) error {
+	name := fmt.Sprintf("projects/%s/locations/%s/operations/%s", cluster.Project, cluster.Location, op.Name)
+	for op.Status != operationDone {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+
+		var err error
+		op, err = c.svc.Projects.Locations.Operations.Get(name).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("get operation: %w", err)
+		}
+	}
+
+	if op.Error != nil {
+		return fmt.Errorf("operation %s failed: %s", op.Name, op.Error.Message)
+	}
+	return nil
+}
+
+// nodePoolName returns the resource name of a node pool in the Container API.
+func nodePoolName(cluster Cluster, name string) string {
+	return fmt.Sprintf("%s/nodePools/%s", cluster, name)
+}
+
+// perZone returns the per-zone node count of a node pool with nodes nodes
+// spread over zones zones, rounded up so no zone is left short.
+func perZone(nodes, zones int) int {
+	if zones <= 1 {
+		return nodes
+	}
+	return (nodes + zones - 1) / zones
+}
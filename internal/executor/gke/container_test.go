@@ -0,0 +1,108 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package gke
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var testCluster = Cluster{Project: "acme", Location: "europe-west1", Name: "prod"}
+
+func newTestContainerClient(t *testing.T, handler http.HandlerFunc, nodes ...runtime.Object) *containerClient {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	svc, err := container.NewService(context.Background(),
+		option.WithEndpoint(srv.URL),
+		option.WithHTTPClient(srv.Client()),
+	)
+	require.NoError(t, err)
+	return &containerClient{svc: svc, nodes: fake.NewClientset(nodes...), pollInterval: time.Millisecond}
+}
+
+func node(name, nodePool string) *corev1.Node {
+	return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{nodePoolLabel: nodePool}}}
+}
+
+func TestContainerClient_GetNodePool(t *testing.T) {
+	client := newTestContainerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/acme/locations/europe-west1/clusters/prod/nodePools/workers", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(container.NodePool{
+			Name:        "workers",
+			Locations:   []string{"europe-west1-b", "europe-west1-c"},
+			Autoscaling: &container.NodePoolAutoscaling{Enabled: true, MinNodeCount: 1, MaxNodeCount: 4},
+		})
+	},
+		node("workers-b-1", "workers"),
+		node("workers-b-2", "workers"),
+		node("workers-c-1", "workers"),
+		node("system-1", "system"),
+	)
+
+	state, err := client.GetNodePool(context.Background(), testCluster, "workers")
+	require.NoError(t, err)
+	assert.Equal(t, &NodePoolState{
+		Name:         "workers",
+		NodeCount:    2, // three nodes over two zones, rounded up
+		Autoscaling:  true,
+		MinNodeCount: 1,
+		MaxNodeCount: 4,
+	}, state)
+}
+
+func TestContainerClient_SetAutoprovisioning(t *testing.T) {
+	var body map[string]any
+	polls := 0
+	client := newTestContainerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/projects/acme/locations/europe-west1/clusters/prod":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			_ = json.NewEncoder(w).Encode(container.Operation{Name: "op-1", Status: "RUNNING"})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/projects/acme/locations/europe-west1/operations/op-1":
+			polls++
+			_ = json.NewEncoder(w).Encode(container.Operation{Name: "op-1", Status: operationDone})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	require.NoError(t, client.SetAutoprovisioning(context.Background(), testCluster, AutoprovisioningState{}))
+	assert.Equal(t, map[string]any{
+		"update": map[string]any{
+			// Disabling must be sent explicitly, or GKE keeps it enabled.
+			"desiredClusterAutoscaling": map[string]any{"enableNodeAutoprovisioning": false},
+		},
+	}, body)
+	assert.Equal(t, 1, polls)
+}
+
+func TestContainerClient_OperationError(t *testing.T) {
+	client := newTestContainerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(container.Operation{
+			Name:   "op-1",
+			Status: operationDone,
+			Error:  &container.Status{Message: "node pool is being updated"},
+		})
+	})
+
+	err := client.SetNodePoolSize(context.Background(), testCluster, "workers", 0)
+	assert.EqualError(t, err, "operation op-1 failed: node pool is being updated")
+}
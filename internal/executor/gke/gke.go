@@ -9,21 +9,46 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 
 	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/workloadscaler"
 	"github.com/ardikabs/hibernator/pkg/executorparams"
 )
 
 const ExecutorType = "gke"
 
+// autoprovisioningKey is the restore data key of the cluster's node
+// auto-provisioning settings. Node pool names cannot contain a colon.
+const autoprovisioningKey = "cluster:autoprovisioning"
+
 // Executor implements hibernation for GKE node pools.
-type Executor struct{}
+type Executor struct {
+	clientFactory ClientFactory
+
+	// workloads scales the workloads of Autopilot clusters.
+	workloads *workloadscaler.Executor
+}
 
 // New creates a new GKE executor.
 func New() *Executor {
-	return &Executor{}
+	return &Executor{
+		clientFactory: newContainerClient,
+		workloads:     workloadscaler.New(),
+	}
+}
+
+// NewWithClients creates a new GKE executor with injected clients.
+// This is useful for testing with mock clients.
+func NewWithClients(clientFactory ClientFactory, workloads *workloadscaler.Executor) *Executor {
+	return &Executor{
+		clientFactory: clientFactory,
+		workloads:     workloads,
+	}
 }
 
 // Type returns the executor type.
@@ -48,14 +73,40 @@ func (e *Executor) Validate(spec executor.Spec) error {
 		return fmt.Errorf("parse parameters: %w", err)
 	}
 
-	if len(params.NodePools) == 0 {
+	if params.Autopilot != nil {
+		if len(params.NodePools) > 0 || params.NodeAutoProvisioning {
+			return fmt.Errorf("autopilot is mutually exclusive with nodePools and nodeAutoProvisioning")
+		}
+		autopilot, err := autopilotSpec(spec, params.Autopilot)
+		if err != nil {
+			return err
+		}
+		if err := e.workloads.Validate(autopilot); err != nil {
+			return fmt.Errorf("autopilot: %w", err)
+		}
+		return nil
+	}
+
+	if len(params.NodePools) == 0 && !params.NodeAutoProvisioning {
 		return fmt.Errorf("at least one NodePool must be specified")
 	}
 
 	return nil
 }
 
-// Shutdown scales GKE node pools to zero.
+// autopilotSpec returns the spec of the workloadscaler hibernating the
+// workloads of an Autopilot cluster.
+func autopilotSpec(spec executor.Spec, params *executorparams.WorkloadScalerParameters) (executor.Spec, error) {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return executor.Spec{}, fmt.Errorf("marshal autopilot parameters: %w", err)
+	}
+	spec.Parameters = raw
+	return spec, nil
+}
+
+// Shutdown scales GKE node pools to zero and disables node auto-provisioning.
+// Autopilot clusters have their workloads scaled to zero instead.
 func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.Spec) (*executor.Result, error) {
 	log = log.WithName("gke").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
 	log.Info("executor starting shutdown")
@@ -65,53 +116,280 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		return nil, fmt.Errorf("parse parameters: %w", err)
 	}
 
-	// Store original state
-	nodePoolStates := make(map[string]NodePoolState)
+	if params.Autopilot != nil {
+		log.Info("scaling down workloads of Autopilot cluster")
+		autopilot, err := autopilotSpec(spec, params.Autopilot)
+		if err != nil {
+			return nil, err
+		}
+		return e.workloads.Shutdown(ctx, log, autopilot)
+	}
 
-	// TODO: Implement actual GKE API calls using google.golang.org/api/container/v1
-	// For now, return a placeholder implementation
+	if err := executor.CheckResourceLimit(spec, len(params.NodePools), "node pool"); err != nil {
+		log.Error(err, "refusing to shut down")
+		return nil, err
+	}
+
+	cluster, err := clusterOf(&spec)
+	if err != nil {
+		return nil, err
+	}
+	client, err := e.clientFactory(ctx, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("build GKE client: %w", err)
+	}
+
+	// Disable auto-provisioning first, so GKE creates no node pool for the
+	// pods evicted from the scaled-down ones.
+	var napMsg string
+	if params.NodeAutoProvisioning {
+		disabled, err := e.disableAutoprovisioning(ctx, log, spec, client, cluster)
+		if err != nil {
+			return nil, fmt.Errorf("disable node auto-provisioning: %w", err)
+		}
+		napMsg = "; node auto-provisioning already disabled"
+		if disabled {
+			napMsg = "; disabled node auto-provisioning"
+		}
+	}
+
+	var scaled int
 	for _, npName := range params.NodePools {
-		nodePoolStates[npName] = NodePoolState{
-			Name:         npName,
-			NodeCount:    0, // Would be fetched from GKE API
-			MinNodeCount: 0,
-			MaxNodeCount: 0,
+		applied, err := e.scaleNodePoolToZero(ctx, log, spec, client, cluster, npName)
+		if err != nil {
+			return nil, fmt.Errorf("scale node pool %s: %w", npName, err)
+		}
+		if applied {
+			scaled++
+		}
+	}
+
+	log.Info("shutdown completed", "nodePoolCount", scaled)
+	return &executor.Result{Message: fmt.Sprintf("scaled %d GKE node pool(s) to zero", scaled) + napMsg}, nil
+}
+
+// scaleNodePoolToZero saves the size and autoscaling settings of a node pool,
+// disables its autoscaling and resizes it to zero. It reports false when the
+// node pool was already scaled to zero, which keeps the state saved by an
+// earlier attempt.
+func (e *Executor) scaleNodePoolToZero(ctx context.Context, log logr.Logger, spec executor.Spec, client Client, cluster Cluster, name string) (bool, error) {
+	state, err := client.GetNodePool(ctx, cluster, name)
+	if err != nil {
+		return false, fmt.Errorf("get node pool: %w", err)
+	}
+	if state.NodeCount == 0 && !state.Autoscaling {
+		log.Info("node pool already scaled to zero, skipping", "nodePool", name)
+		return false, nil
+	}
+
+	// Persist before scaling, so a failed attempt can still be woken up.
+	if spec.ReportStateCallback != nil {
+		if err := spec.ReportStateCallback(name, *state); err != nil {
+			return false, fmt.Errorf("save node pool state: %w", err)
+		}
+	}
+
+	// The autoscaler would otherwise scale the node pool back up.
+	if state.Autoscaling {
+		if err := client.SetNodePoolAutoscaling(ctx, cluster, name, NodePoolState{Name: name}); err != nil {
+			return false, fmt.Errorf("disable autoscaling: %w", err)
 		}
 	}
+	if err := client.SetNodePoolSize(ctx, cluster, name, 0); err != nil {
+		return false, fmt.Errorf("resize to zero: %w", err)
+	}
 
-	log.Info("shutdown completed", "nodePoolCount", len(nodePoolStates))
-	return &executor.Result{Message: fmt.Sprintf("scaled %d GKE node pool(s) to zero", len(nodePoolStates))}, nil
+	log.Info("scaled node pool to zero",
+		"nodePool", name,
+		"nodeCount", state.NodeCount,
+		"autoscaling", state.Autoscaling,
+	)
+	return true, nil
 }
 
-// WakeUp restores GKE node pools from hibernation.
+// ValidateRestore checks that every restore entry is a valid node pool,
+// auto-provisioning or Autopilot workload state.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries(restore, func(key string, raw *json.RawMessage) error {
+		switch {
+		case key == autoprovisioningKey:
+			var state AutoprovisioningState
+			if err := json.Unmarshal(*raw, &state); err != nil {
+				return err
+			}
+			if !state.Enabled {
+				return fmt.Errorf("node auto-provisioning was not enabled")
+			}
+			return nil
+		case strings.Contains(key, "/"):
+			// Workload keys are namespace/kind/name.
+			var state workloadscaler.WorkloadState
+			return json.Unmarshal(*raw, &state)
+		default:
+			var state NodePoolState
+			return json.Unmarshal(*raw, &state)
+		}
+	})
+}
+
+// WakeUp restores GKE node pools and node auto-provisioning from hibernation.
+// Autopilot clusters have their workloads scaled back up instead.
 func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
 	log = log.WithName("gke").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
 	log.Info("executor starting wakeup")
 
+	var params executorparams.GKEParameters
+	if err := json.Unmarshal(spec.Parameters, &params); err != nil {
+		return nil, fmt.Errorf("parse parameters: %w", err)
+	}
+
+	if params.Autopilot != nil {
+		log.Info("restoring workloads of Autopilot cluster")
+		autopilot, err := autopilotSpec(spec, params.Autopilot)
+		if err != nil {
+			return nil, err
+		}
+		return e.workloads.WakeUp(ctx, log, autopilot, restore)
+	}
+
 	if len(restore.Data) == 0 {
 		return nil, fmt.Errorf("restore data is required for wake-up")
 	}
 
-	// Iterate over all node pools in restore data
-	for nodePoolName, stateBytes := range restore.Data {
+	cluster, err := clusterOf(&spec)
+	if err != nil {
+		return nil, err
+	}
+	client, err := e.clientFactory(ctx, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("build GKE client: %w", err)
+	}
+
+	// Restore the node pools first, in the reverse of the shutdown order.
+	var nodePools int
+	for _, nodePoolName := range slices.Sorted(maps.Keys(restore.Data)) {
+		if nodePoolName == autoprovisioningKey {
+			continue
+		}
+
 		var state NodePoolState
-		if err := json.Unmarshal(stateBytes, &state); err != nil {
+		if err := json.Unmarshal(restore.Data[nodePoolName], &state); err != nil {
 			return nil, fmt.Errorf("unmarshal node pool state %s: %w", nodePoolName, err)
 		}
+		if err := e.restoreNodePool(ctx, log, client, cluster, nodePoolName, state); err != nil {
+			return nil, fmt.Errorf("restore node pool %s: %w", nodePoolName, err)
+		}
+		nodePools++
+	}
 
-		// TODO: Implement actual GKE API calls to restore node pools
-		// For now, this is a placeholder
-		_ = state
+	var napMsg string
+	if stateBytes, ok := restore.Data[autoprovisioningKey]; ok {
+		var state AutoprovisioningState
+		if err := json.Unmarshal(stateBytes, &state); err != nil {
+			return nil, fmt.Errorf("unmarshal node auto-provisioning state: %w", err)
+		}
+		if err := e.restoreAutoprovisioning(ctx, log, client, cluster, state); err != nil {
+			return nil, fmt.Errorf("restore node auto-provisioning: %w", err)
+		}
+		napMsg = "; restored node auto-provisioning"
 	}
 
-	log.Info("wakeup completed", "nodePoolCount", len(restore.Data))
-	return &executor.Result{Message: fmt.Sprintf("restored %d GKE node pool(s)", len(restore.Data))}, nil
+	log.Info("wakeup completed", "nodePoolCount", nodePools)
+	return &executor.Result{Message: fmt.Sprintf("restored %d GKE node pool(s)", nodePools) + napMsg}, nil
+}
+
+// disableAutoprovisioning saves the node auto-provisioning settings of the
+// cluster and disables it. It reports false when auto-provisioning was
+// already disabled, which keeps the settings saved by an earlier attempt.
+func (e *Executor) disableAutoprovisioning(ctx context.Context, log logr.Logger, spec executor.Spec, client Client, cluster Cluster) (bool, error) {
+	state, err := client.GetAutoprovisioning(ctx, cluster)
+	if err != nil {
+		return false, fmt.Errorf("get node auto-provisioning: %w", err)
+	}
+	if !state.Enabled {
+		log.Info("node auto-provisioning already disabled", "cluster", cluster.String())
+		return false, nil
+	}
+
+	// GKE drops the resource limits along with auto-provisioning, so they
+	// must be saved before it is disabled.
+	if spec.ReportStateCallback != nil {
+		if err := spec.ReportStateCallback(autoprovisioningKey, *state); err != nil {
+			return false, fmt.Errorf("save node auto-provisioning state: %w", err)
+		}
+	}
+
+	if err := client.SetAutoprovisioning(ctx, cluster, AutoprovisioningState{Enabled: false}); err != nil {
+		return false, fmt.Errorf("set node auto-provisioning: %w", err)
+	}
+
+	log.Info("disabled node auto-provisioning",
+		"cluster", cluster.String(),
+		"resourceLimits", len(state.ResourceLimits),
+	)
+	return true, nil
+}
+
+// restoreAutoprovisioning enables node auto-provisioning of the cluster with
+// the saved resource limits.
+func (e *Executor) restoreAutoprovisioning(ctx context.Context, log logr.Logger, client Client, cluster Cluster, state AutoprovisioningState) error {
+	if err := client.SetAutoprovisioning(ctx, cluster, state); err != nil {
+		return fmt.Errorf("set node auto-provisioning: %w", err)
+	}
+
+	log.Info("restored node auto-provisioning",
+		"cluster", cluster.String(),
+		"resourceLimits", len(state.ResourceLimits),
+	)
+	return nil
+}
+
+// restoreNodePool resizes a node pool back to its saved size and restores its
+// autoscaling settings.
+func (e *Executor) restoreNodePool(ctx context.Context, log logr.Logger, client Client, cluster Cluster, name string, state NodePoolState) error {
+	if err := client.SetNodePoolSize(ctx, cluster, name, state.NodeCount); err != nil {
+		return fmt.Errorf("resize: %w", err)
+	}
+	if state.Autoscaling {
+		if err := client.SetNodePoolAutoscaling(ctx, cluster, name, state); err != nil {
+			return fmt.Errorf("enable autoscaling: %w", err)
+		}
+	}
+
+	log.Info("restored node pool",
+		"nodePool", name,
+		"nodeCount", state.NodeCount,
+		"autoscaling", state.Autoscaling,
+	)
+	return nil
 }
 
 // NodePoolState stores the original state of a GKE NodePool.
 type NodePoolState struct {
-	Name         string `json:"name"`
-	NodeCount    int    `json:"nodeCount"`
-	MinNodeCount int    `json:"minNodeCount"`
-	MaxNodeCount int    `json:"maxNodeCount"`
+	Name string `json:"name"`
+
+	// NodeCount is the number of nodes in each zone of the node pool.
+	NodeCount int `json:"nodeCount"`
+
+	// Autoscaling is set when the cluster autoscaler scaled the node pool
+	// between MinNodeCount and MaxNodeCount nodes per zone.
+	Autoscaling  bool `json:"autoscaling,omitempty"`
+	MinNodeCount int  `json:"minNodeCount"`
+	MaxNodeCount int  `json:"maxNodeCount"`
+}
+
+// AutoprovisioningState stores the node auto-provisioning settings of a GKE cluster.
+type AutoprovisioningState struct {
+	Enabled bool `json:"enabled"`
+
+	// ResourceLimits bound the total resources of the auto-provisioned node pools.
+	ResourceLimits []ResourceLimit `json:"resourceLimits,omitempty"`
+}
+
+// ResourceLimit is the minimum and maximum amount of a resource in the cluster.
+type ResourceLimit struct {
+	// ResourceType is "cpu", "memory" or a GPU type.
+	ResourceType string `json:"resourceType"`
+	Minimum      int64  `json:"minimum"`
+	Maximum      int64  `json:"maximum"`
 }
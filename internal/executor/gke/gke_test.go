@@ -0,0 +1,267 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package gke
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/workloadscaler"
+	wsmocks "github.com/ardikabs/hibernator/internal/executor/workloadscaler/mocks"
+	"github.com/ardikabs/hibernator/pkg/gcputil"
+)
+
+// fakeClient keeps the node auto-provisioning settings and the node pools of a
+// single cluster.
+type fakeClient struct {
+	cluster   Cluster
+	state     AutoprovisioningState
+	sets      int
+	nodePools map[string]*NodePoolState
+}
+
+func (c *fakeClient) GetAutoprovisioning(_ context.Context, cluster Cluster) (*AutoprovisioningState, error) {
+	if cluster != c.cluster {
+		return nil, errors.New("cluster not found")
+	}
+	state := c.state
+	return &state, nil
+}
+
+func (c *fakeClient) SetAutoprovisioning(_ context.Context, cluster Cluster, state AutoprovisioningState) error {
+	if cluster != c.cluster {
+		return errors.New("cluster not found")
+	}
+	c.state = state
+	c.sets++
+	return nil
+}
+
+func (c *fakeClient) nodePool(cluster Cluster, name string) (*NodePoolState, error) {
+	np, ok := c.nodePools[name]
+	if cluster != c.cluster || !ok {
+		return nil, errors.New("node pool not found")
+	}
+	return np, nil
+}
+
+func (c *fakeClient) GetNodePool(_ context.Context, cluster Cluster, name string) (*NodePoolState, error) {
+	np, err := c.nodePool(cluster, name)
+	if err != nil {
+		return nil, err
+	}
+	copied := *np
+	return &copied, nil
+}
+
+func (c *fakeClient) SetNodePoolAutoscaling(_ context.Context, cluster Cluster, name string, state NodePoolState) error {
+	np, err := c.nodePool(cluster, name)
+	if err != nil {
+		return err
+	}
+	np.Autoscaling = state.Autoscaling
+	np.MinNodeCount = state.MinNodeCount
+	np.MaxNodeCount = state.MaxNodeCount
+	return nil
+}
+
+func (c *fakeClient) SetNodePoolSize(_ context.Context, cluster Cluster, name string, nodeCount int) error {
+	np, err := c.nodePool(cluster, name)
+	if err != nil {
+		return err
+	}
+	np.NodeCount = nodeCount
+	return nil
+}
+
+func testSpec(parameters string) executor.Spec {
+	return executor.Spec{
+		TargetName: "test-cluster",
+		TargetType: "gke",
+		Parameters: json.RawMessage(parameters),
+		ConnectorConfig: executor.ConnectorConfig{
+			K8S: &executor.K8SConnectorConfig{
+				ClusterName: "prod",
+				Region:      "europe-west1",
+				GCP:         &gcputil.GCPConnectorConfig{ProjectID: "acme"},
+			},
+		},
+	}
+}
+
+func newTestExecutor(client Client) *Executor {
+	return NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return client, nil }, workloadscaler.New())
+}
+
+func TestValidate(t *testing.T) {
+	e := New()
+	assert.NoError(t, e.Validate(testSpec(`{"nodeAutoProvisioning": true}`)))
+	assert.NoError(t, e.Validate(testSpec(`{"autopilot": {"namespace": {"literals": ["apps"]}}}`)))
+
+	err := e.Validate(testSpec(`{}`))
+	assert.EqualError(t, err, "at least one NodePool must be specified")
+
+	err = e.Validate(testSpec(`{"nodeAutoProvisioning": true, "autopilot": {"namespace": {"literals": ["apps"]}}}`))
+	assert.EqualError(t, err, "autopilot is mutually exclusive with nodePools and nodeAutoProvisioning")
+
+	err = e.Validate(testSpec(`{"autopilot": {}}`))
+	assert.ErrorContains(t, err, "autopilot: namespace must specify either literals or selector")
+}
+
+func TestNodeAutoProvisioning_ShutdownAndWakeUp(t *testing.T) {
+	ctx := context.Background()
+	settings := AutoprovisioningState{
+		Enabled: true,
+		ResourceLimits: []ResourceLimit{
+			{ResourceType: "cpu", Minimum: 4, Maximum: 64},
+			{ResourceType: "memory", Minimum: 16, Maximum: 256},
+		},
+	}
+	client := &fakeClient{
+		cluster: Cluster{Project: "acme", Location: "europe-west1", Name: "prod"},
+		state:   settings,
+		nodePools: map[string]*NodePoolState{
+			"default-pool": {Name: "default-pool", NodeCount: 2},
+		},
+	}
+	e := newTestExecutor(client)
+
+	restore := executor.RestoreData{Type: ExecutorType, Data: map[string]json.RawMessage{}}
+	spec := testSpec(`{"nodePools": ["default-pool"], "nodeAutoProvisioning": true}`)
+	spec.ReportStateCallback = func(key string, value any) error {
+		raw, err := json.Marshal(value)
+		restore.Data[key] = raw
+		return err
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "scaled 1 GKE node pool(s) to zero; disabled node auto-provisioning", result.Message)
+	assert.Equal(t, AutoprovisioningState{}, client.state)
+	require.NoError(t, e.ValidateRestore(restore))
+
+	// A retried shutdown keeps the settings saved by the first attempt.
+	result, err = e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "scaled 0 GKE node pool(s) to zero; node auto-provisioning already disabled", result.Message)
+	assert.Equal(t, 1, client.sets)
+
+	result, err = e.WakeUp(ctx, logr.Discard(), spec, restore)
+	require.NoError(t, err)
+	assert.Equal(t, "restored 1 GKE node pool(s); restored node auto-provisioning", result.Message)
+	assert.Equal(t, settings, client.state)
+	assert.Equal(t, 2, client.nodePools["default-pool"].NodeCount)
+}
+
+func TestNodePools_ShutdownAndWakeUp(t *testing.T) {
+	ctx := context.Background()
+	autoscaled := NodePoolState{Name: "autoscaled", NodeCount: 3, Autoscaling: true, MinNodeCount: 1, MaxNodeCount: 5}
+	fixed := NodePoolState{Name: "fixed", NodeCount: 2}
+	client := &fakeClient{
+		cluster: Cluster{Project: "acme", Location: "europe-west1", Name: "prod"},
+		nodePools: map[string]*NodePoolState{
+			"autoscaled": {Name: "autoscaled", NodeCount: 3, Autoscaling: true, MinNodeCount: 1, MaxNodeCount: 5},
+			"fixed":      {Name: "fixed", NodeCount: 2},
+			"empty":      {Name: "empty"},
+		},
+	}
+	e := newTestExecutor(client)
+
+	restore := executor.RestoreData{Type: ExecutorType, Data: map[string]json.RawMessage{}}
+	spec := testSpec(`{"nodePools": ["autoscaled", "fixed", "empty"]}`)
+	spec.ReportStateCallback = func(key string, value any) error {
+		raw, err := json.Marshal(value)
+		restore.Data[key] = raw
+		return err
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "scaled 2 GKE node pool(s) to zero", result.Message)
+	assert.Equal(t, NodePoolState{Name: "autoscaled"}, *client.nodePools["autoscaled"])
+	assert.Equal(t, NodePoolState{Name: "fixed"}, *client.nodePools["fixed"])
+	assert.NotContains(t, restore.Data, "empty", "node pools already at zero are left alone")
+	require.NoError(t, e.ValidateRestore(restore))
+
+	// A retried shutdown keeps the sizes saved by the first attempt.
+	_, err = e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+
+	result, err = e.WakeUp(ctx, logr.Discard(), spec, restore)
+	require.NoError(t, err)
+	assert.Equal(t, "restored 2 GKE node pool(s)", result.Message)
+	assert.Equal(t, autoscaled, *client.nodePools["autoscaled"])
+	assert.Equal(t, fixed, *client.nodePools["fixed"])
+}
+
+func TestNodeAutoProvisioning_RequiresProject(t *testing.T) {
+	e := newTestExecutor(&fakeClient{})
+	spec := testSpec(`{"nodeAutoProvisioning": true}`)
+	spec.ConnectorConfig.K8S.GCP = nil
+
+	_, err := e.Shutdown(context.Background(), logr.Discard(), spec)
+	assert.ErrorContains(t, err, "GCP project is required")
+}
+
+func TestAutopilot_ScalesWorkloads(t *testing.T) {
+	ctx := context.Background()
+	mockClient := wsmocks.NewClient(t)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	mockClient.EXPECT().ListWorkloads(ctx, gvr, "apps", "").Return(&unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "apps"},
+		}}},
+	}, nil)
+	scale := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": int64(3)},
+	}}
+	mockClient.EXPECT().GetScale(ctx, gvr, "apps", "web").Return(scale, nil)
+	mockClient.EXPECT().UpdateScale(ctx, gvr, "apps", scale).Return(scale, nil)
+
+	workloads := workloadscaler.NewWithClients(func(ctx context.Context, spec *executor.Spec) (workloadscaler.Client, error) {
+		return mockClient, nil
+	})
+	// The Container API is not used for Autopilot clusters.
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) {
+		return nil, errors.New("unexpected GKE client")
+	}, workloads)
+
+	restore := executor.RestoreData{Type: ExecutorType, Data: map[string]json.RawMessage{}}
+	spec := testSpec(`{"autopilot": {"namespace": {"literals": ["apps"]}}}`)
+	spec.ReportStateCallback = func(key string, value any) error {
+		raw, err := json.Marshal(value)
+		restore.Data[key] = raw
+		return err
+	}
+
+	_, err := e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Contains(t, restore.Data, "apps/Deployment/web")
+	assert.NoError(t, e.ValidateRestore(restore))
+}
+
+func TestValidateRestore_InvalidAutoprovisioningState(t *testing.T) {
+	restore := executor.RestoreData{Type: ExecutorType, Data: map[string]json.RawMessage{
+		autoprovisioningKey: json.RawMessage(`{"enabled": false}`),
+		"default-pool":      json.RawMessage(`{"name": "default-pool"}`),
+	}}
+
+	err := New().ValidateRestore(restore)
+	assert.ErrorIs(t, err, executor.ErrInvalidRestoreData)
+	assert.ErrorContains(t, err, "node auto-provisioning was not enabled")
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-logr/logr"
 
 	"github.com/ardikabs/hibernator/pkg/awsutil"
+	"github.com/ardikabs/hibernator/pkg/gcputil"
 	"github.com/ardikabs/hibernator/pkg/k8sutil"
 )
 
@@ -60,18 +61,35 @@ type Spec struct {
 	TargetType string
 	// Parameters is the executor-specific configuration.
 	Parameters json.RawMessage
+	// PreWakeParameters is the target's pre-wake hook configuration; set only
+	// for pre-wake operations.
+	PreWakeParameters json.RawMessage
 	// ConnectorConfig holds resolved connector configuration.
 	ConnectorConfig ConnectorConfig
 	// ReportStateCallback is an optional callback for incremental persistence.
 	// If provided, executors should call this after each successful sub-resource
 	// operation to enable partial-success data preservation.
 	ReportStateCallback ReportStateCallback
+	// Checkpoint is an optional record of the resources earlier attempts of
+	// the execution processed. If provided, long-running executors should skip
+	// checkpointed resources and mark each resource they process.
+	Checkpoint Checkpoint
+	// ReportProgressCallback is an optional callback for per-resource
+	// progress. If provided, executors processing several resources should
+	// call it after each one.
+	ReportProgressCallback ReportProgressCallback
+	// MaxResources bounds how many resources Shutdown may act on. Executors
+	// check the resources their discovery selected with CheckResourceLimit
+	// before changing any of them. Zero means unlimited.
+	MaxResources int
 }
 
 // ConnectorConfig holds resolved connector settings.
 type ConnectorConfig struct {
 	// AWS holds AWS-specific configuration.
 	AWS *AWSConnectorConfig
+	// GCP holds GCP-specific configuration.
+	GCP *GCPConnectorConfig
 	// K8S holds Kubernetes-specific configuration.
 	K8S *K8SConnectorConfig
 }
@@ -79,6 +97,9 @@ type ConnectorConfig struct {
 // AWSConnectorConfig holds AWS connector settings.
 type AWSConnectorConfig = awsutil.AWSConnectorConfig
 
+// GCPConnectorConfig holds GCP connector settings.
+type GCPConnectorConfig = gcputil.GCPConnectorConfig
+
 // K8SConnectorConfig holds Kubernetes connector settings.
 type K8SConnectorConfig = k8sutil.K8SConnectorConfig
 
@@ -108,11 +129,44 @@ type Executor interface {
 	// Returns a Result with a summary message on success.
 	Shutdown(ctx context.Context, log logr.Logger, spec Spec) (*Result, error)
 
+	// ValidateRestore checks that restore data matches the executor's schema.
+	// It is called before WakeUp so malformed data fails fast, before any
+	// resource is touched. Implementations must not perform API calls.
+	ValidateRestore(restore RestoreData) error
+
 	// WakeUp performs the restore operation using saved restore data.
 	// Returns a Result with a summary message on success.
 	WakeUp(ctx context.Context, log logr.Logger, spec Spec, restore RestoreData) (*Result, error)
 }
 
+// PreWaker is implemented by executors that can warm capacity ahead of a
+// scheduled wakeup, so the WakeUp that follows completes faster.
+type PreWaker interface {
+	// PreWake prepares the hibernated resources for the coming wakeup, e.g. by
+	// starting a few nodes early. It must leave restore data untouched; the
+	// regular WakeUp still applies the full restore afterwards.
+	PreWake(ctx context.Context, log logr.Logger, spec Spec, restore RestoreData) (*Result, error)
+}
+
+// Reasserter is implemented by executors whose resources may come back up by
+// themselves while hibernated, so their hibernation can be applied again.
+type Reasserter interface {
+	// Reassert stops again the resources in restore that hibernation stopped
+	// and that are running anew. It must not report state: restore data keeps
+	// describing the resources as they were before hibernation.
+	Reassert(ctx context.Context, log logr.Logger, spec Spec, restore RestoreData) (*Result, error)
+}
+
+// Previewer is implemented by executors that can list the resources a target
+// selects without changing them, so selectors can be verified before the first
+// shutdown.
+type Previewer interface {
+	// Preview runs only the discovery of Shutdown and returns the restore data
+	// keys of the selected resources, in discovery order. It must not modify
+	// any resource nor report state.
+	Preview(ctx context.Context, log logr.Logger, spec Spec) ([]string, error)
+}
+
 // Registry holds registered executors.
 type Registry struct {
 	mu        sync.RWMutex
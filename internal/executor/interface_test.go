@@ -34,6 +34,8 @@ func (m *MockExecutor) Shutdown(ctx context.Context, log logr.Logger, spec Spec)
 	return &Result{Message: "mock shutdown completed"}, nil
 }
 
+func (m *MockExecutor) ValidateRestore(restore RestoreData) error { return nil }
+
 func (m *MockExecutor) WakeUp(ctx context.Context, log logr.Logger, spec Spec, restore RestoreData) (*Result, error) {
 	_ = log
 	if m.WakeUpErr != nil {
@@ -2,10 +2,14 @@ package karpenter
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
@@ -22,6 +26,16 @@ type Client interface {
 	// This is used to verify that all nodes managed by a NodePool have been deleted
 	// during the hibernation process.
 	ListNode(ctx context.Context, selector string) (*corev1.NodeList, error)
+
+	// SetNodeUnschedulable cordons (unschedulable=true) or uncordons the node.
+	SetNodeUnschedulable(ctx context.Context, name string, unschedulable bool) error
+
+	// ListPods retrieves the pods scheduled on the node, to drain it.
+	ListPods(ctx context.Context, nodeName string) (*corev1.PodList, error)
+
+	// EvictPod evicts the pod through the Eviction API, which honors
+	// PodDisruptionBudgets. A nil gracePeriodSeconds uses the pod's own.
+	EvictPod(ctx context.Context, pod *corev1.Pod, gracePeriodSeconds *int64) error
 }
 
 // client is the concrete implementation of the Client interface.
@@ -53,3 +67,32 @@ func (c *client) ListNode(ctx context.Context, selector string) (*corev1.NodeLis
 		LabelSelector: selector,
 	})
 }
+
+// SetNodeUnschedulable patches spec.unschedulable of the node, which is how
+// kubectl cordon and uncordon mark it.
+func (c *client) SetNodeUnschedulable(ctx context.Context, name string, unschedulable bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable))
+	_, err := c.Typed.CoreV1().Nodes().Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// ListPods retrieves the pods of all namespaces scheduled on the node.
+func (c *client) ListPods(ctx context.Context, nodeName string) (*corev1.PodList, error) {
+	return c.Typed.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+}
+
+// EvictPod creates an Eviction for the pod. The API server rejects it with
+// 429 Too Many Requests while a PodDisruptionBudget does not allow it.
+func (c *client) EvictPod(ctx context.Context, pod *corev1.Pod, gracePeriodSeconds *int64) error {
+	return c.Typed.CoreV1().Pods(pod.Namespace).EvictV1(ctx, &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+		DeleteOptions: &metav1.DeleteOptions{
+			GracePeriodSeconds: gracePeriodSeconds,
+		},
+	})
+}
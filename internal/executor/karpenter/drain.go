@@ -0,0 +1,264 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package karpenter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/ardikabs/hibernator/pkg/executorparams"
+	"github.com/ardikabs/hibernator/pkg/waiter"
+)
+
+const (
+	// DefaultDrainTimeout is how long the nodes of a NodePool are drained when
+	// drain.timeout is not set.
+	DefaultDrainTimeout = "5m"
+
+	// AnnotationHibernatedSettings holds the original limits, disruption and
+	// weight of a NodePool whose limits were zeroed for draining. It lets a
+	// shutdown interrupted before the NodePool is deleted be retried, or rolled
+	// back by a wakeup, without losing the original settings.
+	AnnotationHibernatedSettings = "hibernator.ardikabs.com/hibernated-settings"
+
+	// annotationMirrorPod marks static pods mirrored by the kubelet, which the
+	// Eviction API cannot remove.
+	annotationMirrorPod = "kubernetes.io/config.mirror"
+)
+
+// drainPollInterval is how often the pods of a draining NodePool are evicted
+// again and counted.
+var drainPollInterval = 5 * time.Second
+
+// NodePoolSettings are the capacity and disruption settings of a NodePool
+// that hibernation changes and wakeup puts back.
+type NodePoolSettings struct {
+	// Limits is spec.limits, absent when the NodePool is unbounded.
+	Limits map[string]interface{} `json:"limits,omitempty"`
+
+	// Disruption is spec.disruption, including its budgets.
+	Disruption map[string]interface{} `json:"disruption,omitempty"`
+
+	// Weight is spec.weight, absent when the NodePool has no priority.
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// settingsOf reads the settings from a NodePool spec.
+func settingsOf(spec map[string]interface{}) NodePoolSettings {
+	var s NodePoolSettings
+	if limits, found, _ := unstructured.NestedMap(spec, "limits"); found {
+		s.Limits = limits
+	}
+	if disruption, found, _ := unstructured.NestedMap(spec, "disruption"); found {
+		s.Disruption = disruption
+	}
+	switch weight := spec["weight"].(type) {
+	case int64:
+		s.Weight = &weight
+	case float64:
+		w := int64(weight)
+		s.Weight = &w
+	}
+	return s
+}
+
+// applySettings writes s into a NodePool spec, removing the fields s does not set.
+func applySettings(spec map[string]interface{}, s NodePoolSettings) {
+	delete(spec, "limits")
+	delete(spec, "disruption")
+	delete(spec, "weight")
+	if s.Limits != nil {
+		spec["limits"] = s.Limits
+	}
+	if s.Disruption != nil {
+		spec["disruption"] = s.Disruption
+	}
+	if s.Weight != nil {
+		spec["weight"] = *s.Weight
+	}
+}
+
+// hibernatedSettings returns the original settings recorded on a NodePool
+// whose limits were zeroed, if any.
+func hibernatedSettings(nodePool *unstructured.Unstructured) (NodePoolSettings, bool, error) {
+	value, ok := nodePool.GetAnnotations()[AnnotationHibernatedSettings]
+	if !ok {
+		return NodePoolSettings{}, false, nil
+	}
+	var s NodePoolSettings
+	if err := json.Unmarshal([]byte(value), &s); err != nil {
+		return NodePoolSettings{}, false, fmt.Errorf("parse %s annotation: %w", AnnotationHibernatedSettings, err)
+	}
+	return s, true, nil
+}
+
+// zeroLimits records the original settings on the NodePool and sets its
+// limits to zero, so Karpenter provisions no replacement for drained nodes.
+func (e *Executor) zeroLimits(ctx context.Context, log logr.Logger, client Client, nodePool *unstructured.Unstructured, settings NodePoolSettings) error {
+	value, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("marshal NodePool settings: %w", err)
+	}
+
+	nodePool = nodePool.DeepCopy()
+	annotations := nodePool.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[AnnotationHibernatedSettings] = string(value)
+	nodePool.SetAnnotations(annotations)
+	if err := unstructured.SetNestedMap(nodePool.Object, map[string]interface{}{"cpu": "0"}, "spec", "limits"); err != nil {
+		return fmt.Errorf("set NodePool limits: %w", err)
+	}
+
+	nodePoolGVR := schema.GroupVersionResource{
+		Group:    "karpenter.sh",
+		Version:  "v1",
+		Resource: "nodepools",
+	}
+	if _, err := client.Resource(nodePoolGVR).Update(ctx, nodePool, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update NodePool: %w", err)
+	}
+
+	log.Info("zeroed NodePool limits for draining", "nodePool", nodePool.GetName())
+	return nil
+}
+
+// drainNodes cordons the nodes of the NodePool and evicts their pods until
+// none is left or the timeout expires. It reports whether the nodes were fully
+// drained; pods left afterwards are evicted by Karpenter when it terminates
+// the nodes.
+func (e *Executor) drainNodes(ctx context.Context, log logr.Logger, client Client, nodePoolName string, drain executorparams.KarpenterDrain) (bool, error) {
+	nodes, err := client.ListNode(ctx, nodePoolSelector(nodePoolName))
+	if err != nil {
+		return false, fmt.Errorf("list nodes: %w", err)
+	}
+	if len(nodes.Items) == 0 {
+		return true, nil
+	}
+
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if err := client.SetNodeUnschedulable(ctx, node.Name, true); err != nil {
+			return false, fmt.Errorf("cordon node %s: %w", node.Name, err)
+		}
+	}
+	log.Info("cordoned NodePool nodes", "nodePool", nodePoolName, "nodes", len(nodes.Items))
+
+	var gracePeriod *int64
+	if drain.GracePeriod != "" {
+		d, err := time.ParseDuration(drain.GracePeriod)
+		if err != nil {
+			return false, fmt.Errorf("invalid drain gracePeriod %q: %w", drain.GracePeriod, err)
+		}
+		seconds := int64(d / time.Second)
+		gracePeriod = &seconds
+	}
+
+	timeout := drain.Timeout
+	if timeout == "" {
+		timeout = DefaultDrainTimeout
+	}
+	w, err := waiter.NewWaiter(ctx, log, waiter.WithTimeoutString(timeout), waiter.WithInterval(drainPollInterval))
+	if err != nil {
+		return false, fmt.Errorf("create waiter: %w", err)
+	}
+
+	err = w.Poll(fmt.Sprintf("NodePool %s nodes to be drained", nodePoolName), func() (bool, string, error) {
+		remaining := 0
+		for _, node := range nodes.Items {
+			pods, err := client.ListPods(ctx, node.Name)
+			if err != nil {
+				return false, "", fmt.Errorf("list pods on node %s: %w", node.Name, err)
+			}
+			for i := range pods.Items {
+				pod := &pods.Items[i]
+				if !evictable(pod) {
+					continue
+				}
+				remaining++
+				if pod.DeletionTimestamp != nil {
+					continue
+				}
+				// Evictions blocked by a PodDisruptionBudget are retried on the next poll.
+				if err := client.EvictPod(ctx, pod, gracePeriod); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsTooManyRequests(err) {
+					return false, "", fmt.Errorf("evict pod %s/%s: %w", pod.Namespace, pod.Name, err)
+				}
+			}
+		}
+		if remaining == 0 {
+			return true, "all pods evicted", nil
+		}
+		return false, fmt.Sprintf("%d pod(s) still running", remaining), nil
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			log.Info("NodePool nodes not fully drained before timeout, leaving the rest to Karpenter",
+				"nodePool", nodePoolName,
+				"timeout", timeout,
+			)
+			return false, nil
+		}
+		return false, err
+	}
+
+	log.Info("NodePool nodes drained", "nodePool", nodePoolName)
+	return true, nil
+}
+
+// uncordonNodes makes the cordoned nodes of the NodePool schedulable again.
+func (e *Executor) uncordonNodes(ctx context.Context, log logr.Logger, client Client, nodePoolName string) error {
+	nodes, err := client.ListNode(ctx, nodePoolSelector(nodePoolName))
+	if err != nil {
+		return fmt.Errorf("list nodes: %w", err)
+	}
+	for _, node := range nodes.Items {
+		if !node.Spec.Unschedulable {
+			continue
+		}
+		if err := client.SetNodeUnschedulable(ctx, node.Name, false); err != nil {
+			return fmt.Errorf("uncordon node %s: %w", node.Name, err)
+		}
+		log.Info("uncordoned node", "nodePool", nodePoolName, "node", node.Name)
+	}
+	return nil
+}
+
+// evictable reports whether draining a node has to evict the pod. DaemonSet
+// pods run on every node regardless of cordoning, mirror pods are managed by
+// the kubelet, and completed pods hold no resources.
+func evictable(pod *corev1.Pod) bool {
+	if _, ok := pod.Annotations[annotationMirrorPod]; ok {
+		return false
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return true
+}
+
+// nodePoolSelector returns the label selector of the nodes Karpenter
+// provisioned for the NodePool.
+func nodePoolSelector(nodePoolName string) string {
+	return fmt.Sprintf("karpenter.sh/nodepool=%s", nodePoolName)
+}
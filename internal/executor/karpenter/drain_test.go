@@ -0,0 +1,278 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package karpenter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/karpenter/mocks"
+)
+
+var testNodePoolGVR = schema.GroupVersionResource{
+	Group:    "karpenter.sh",
+	Version:  "v1",
+	Resource: "nodepools",
+}
+
+func init() {
+	drainPollInterval = 10 * time.Millisecond
+}
+
+func testNodePool(annotations map[string]string, limits map[string]interface{}) *unstructured.Unstructured {
+	nodePool := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "karpenter.sh/v1",
+			"kind":       "NodePool",
+			"metadata": map[string]interface{}{
+				"name": "default",
+			},
+			"spec": map[string]interface{}{
+				"disruption": map[string]interface{}{
+					"consolidationPolicy": "WhenEmptyOrUnderutilized",
+					"budgets": []interface{}{
+						map[string]interface{}{"nodes": "10%"},
+					},
+				},
+				"limits": limits,
+				"weight": int64(50),
+			},
+		},
+	}
+	nodePool.SetAnnotations(annotations)
+	return nodePool
+}
+
+func testSpec(parameters string) executor.Spec {
+	return executor.Spec{
+		TargetName: "test-cluster",
+		TargetType: "karpenter",
+		Parameters: json.RawMessage(parameters),
+		ConnectorConfig: executor.ConnectorConfig{
+			K8S: &executor.K8SConnectorConfig{
+				ClusterName: "my-cluster",
+				Region:      "us-east-1",
+			},
+		},
+	}
+}
+
+func testPod(name string, mutate func(*corev1.Pod)) corev1.Pod {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "apps"},
+		Spec:       corev1.PodSpec{NodeName: "node-a"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if mutate != nil {
+		mutate(&pod)
+	}
+	return pod
+}
+
+func TestShutdown_DrainsNodes(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		testNodePool(nil, map[string]interface{}{"cpu": "1000"}))
+	mockClient.On("Resource", testNodePoolGVR).Return(fakeDynamic.Resource(testNodePoolGVR))
+
+	mockClient.On("ListNode", ctx, "karpenter.sh/nodepool=default").Return(&corev1.NodeList{
+		Items: []corev1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}}},
+	}, nil)
+	mockClient.On("SetNodeUnschedulable", ctx, "node-a", true).Return(nil)
+
+	app := testPod("app", nil)
+	agent := testPod("agent", func(p *corev1.Pod) {
+		p.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "agent"}}
+	})
+	static := testPod("static", func(p *corev1.Pod) {
+		p.Annotations = map[string]string{annotationMirrorPod: "hash"}
+	})
+	mockClient.On("ListPods", ctx, "node-a").Return(&corev1.PodList{Items: []corev1.Pod{app, agent, static}}, nil).Once()
+	mockClient.On("ListPods", ctx, "node-a").Return(&corev1.PodList{Items: []corev1.Pod{agent, static}}, nil)
+	mockClient.On("EvictPod", ctx, mock.MatchedBy(func(p *corev1.Pod) bool { return p.Name == "app" }), mock.MatchedBy(func(s *int64) bool {
+		return s != nil && *s == 30
+	})).Return(nil).Once()
+
+	var saved NodePoolState
+	spec := testSpec(`{"nodePools": ["default"], "drain": {"enabled": true, "gracePeriod": "30s", "timeout": "1m"}}`)
+	spec.ReportStateCallback = func(key string, value any) error {
+		saved = value.(NodePoolState)
+		return nil
+	}
+
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return mockClient, nil })
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "scaled down 1 Karpenter NodePool(s)", result.Message)
+
+	// The original settings are saved, not the zeroed limits.
+	assert.Equal(t, map[string]interface{}{"cpu": "1000"}, saved.Limits)
+	assert.Equal(t, map[string]interface{}{"cpu": "1000"}, saved.Spec["limits"])
+	assert.Equal(t, "WhenEmptyOrUnderutilized", saved.Disruption["consolidationPolicy"])
+	require.NotNil(t, saved.Weight)
+	assert.Equal(t, int64(50), *saved.Weight)
+
+	_, err = fakeDynamic.Resource(testNodePoolGVR).Get(ctx, "default", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestShutdown_DrainTimeout(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		testNodePool(nil, map[string]interface{}{"cpu": "1000"}))
+	mockClient.On("Resource", testNodePoolGVR).Return(fakeDynamic.Resource(testNodePoolGVR))
+
+	// The node is already cordoned.
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}, Spec: corev1.NodeSpec{Unschedulable: true}}
+	mockClient.On("ListNode", ctx, "karpenter.sh/nodepool=default").Return(&corev1.NodeList{Items: []corev1.Node{node}}, nil)
+	mockClient.On("ListPods", ctx, "node-a").Return(&corev1.PodList{Items: []corev1.Pod{testPod("app", nil)}}, nil)
+	// A PodDisruptionBudget keeps blocking the eviction.
+	mockClient.On("EvictPod", ctx, mock.Anything, (*int64)(nil)).
+		Return(apierrors.NewTooManyRequests("disruption budget", 10))
+
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return mockClient, nil })
+	result, err := e.Shutdown(ctx, logr.Discard(), testSpec(`{"drain": {"enabled": true, "timeout": "50ms"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "scaled down 1 Karpenter NodePool(s); 1 NodePool(s) not fully drained after 50ms timeout", result.Message)
+
+	_, err = fakeDynamic.Resource(testNodePoolGVR).Get(ctx, "default", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestShutdown_RetriesZeroedNodePool(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	// A previous shutdown zeroed the limits but did not delete the NodePool.
+	original, _ := json.Marshal(NodePoolSettings{Limits: map[string]interface{}{"cpu": "1000"}})
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		testNodePool(map[string]string{AnnotationHibernatedSettings: string(original)}, map[string]interface{}{"cpu": "0"}))
+	mockClient.On("Resource", testNodePoolGVR).Return(fakeDynamic.Resource(testNodePoolGVR))
+
+	var saved NodePoolState
+	spec := testSpec(`{"nodePools": ["default"]}`)
+	spec.ReportStateCallback = func(key string, value any) error {
+		saved = value.(NodePoolState)
+		return nil
+	}
+
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return mockClient, nil })
+	_, err := e.Shutdown(ctx, logr.Discard(), spec)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"cpu": "1000"}, saved.Spec["limits"])
+	// The annotation recorded no disruption or weight.
+	assert.NotContains(t, saved.Spec, "disruption")
+	assert.Nil(t, saved.Weight)
+}
+
+func TestWakeUp_RollsBackZeroedNodePool(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	weight := int64(50)
+	settings := NodePoolSettings{
+		Limits:     map[string]interface{}{"cpu": "1000"},
+		Disruption: map[string]interface{}{"consolidationPolicy": "WhenEmpty"},
+		Weight:     &weight,
+	}
+	original, _ := json.Marshal(settings)
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		testNodePool(map[string]string{AnnotationHibernatedSettings: string(original), "team": "a"}, map[string]interface{}{"cpu": "0"}))
+	mockClient.On("Resource", testNodePoolGVR).Return(fakeDynamic.Resource(testNodePoolGVR))
+
+	mockClient.On("ListNode", ctx, "karpenter.sh/nodepool=default").Return(&corev1.NodeList{
+		Items: []corev1.Node{
+			{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}, Spec: corev1.NodeSpec{Unschedulable: true}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "node-b"}},
+		},
+	}, nil)
+	mockClient.On("SetNodeUnschedulable", ctx, "node-a", false).Return(nil)
+
+	state, _ := json.Marshal(NodePoolState{Name: "default", Spec: map[string]interface{}{}, NodePoolSettings: settings})
+	restore := executor.RestoreData{Type: "karpenter", Data: map[string]json.RawMessage{"default": state}}
+
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return mockClient, nil })
+	result, err := e.WakeUp(ctx, logr.Discard(), testSpec(`{}`), restore)
+	require.NoError(t, err)
+	assert.Equal(t, "restored 1 Karpenter NodePool(s)", result.Message)
+
+	nodePool, err := fakeDynamic.Resource(testNodePoolGVR).Get(ctx, "default", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "a"}, nodePool.GetAnnotations())
+	limits, _, _ := unstructured.NestedMap(nodePool.Object, "spec", "limits")
+	assert.Equal(t, map[string]interface{}{"cpu": "1000"}, limits)
+	policy, _, _ := unstructured.NestedString(nodePool.Object, "spec", "disruption", "consolidationPolicy")
+	assert.Equal(t, "WhenEmpty", policy)
+	assert.Equal(t, int64(50), nodePool.Object["spec"].(map[string]interface{})["weight"])
+}
+
+func TestWakeUp_ExistingNodePoolIsStale(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		testNodePool(nil, map[string]interface{}{"cpu": "1000"}))
+	mockClient.On("Resource", testNodePoolGVR).Return(fakeDynamic.Resource(testNodePoolGVR))
+
+	state, _ := json.Marshal(NodePoolState{Name: "default", Spec: map[string]interface{}{}})
+	restore := executor.RestoreData{Type: "karpenter", Data: map[string]json.RawMessage{"default": state}}
+
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) { return mockClient, nil })
+	result, err := e.WakeUp(ctx, logr.Discard(), testSpec(`{}`), restore)
+	require.NoError(t, err)
+	assert.Equal(t, "restored 0 Karpenter NodePool(s), skipped 1 stale NodePool(s)", result.Message)
+}
+
+func TestSettings(t *testing.T) {
+	spec := map[string]interface{}{
+		"limits":     map[string]interface{}{"cpu": "1000"},
+		"disruption": map[string]interface{}{"consolidateAfter": "30s"},
+		"weight":     float64(10),
+		"template":   map[string]interface{}{},
+	}
+
+	settings := settingsOf(spec)
+	require.NotNil(t, settings.Weight)
+	assert.Equal(t, int64(10), *settings.Weight)
+
+	// Settings not recorded are removed from the spec.
+	applySettings(spec, NodePoolSettings{Limits: settings.Limits})
+	assert.Equal(t, map[string]interface{}{
+		"limits":   map[string]interface{}{"cpu": "1000"},
+		"template": map[string]interface{}{},
+	}, spec)
+}
+
+func TestEvictable(t *testing.T) {
+	assert.True(t, evictable(&corev1.Pod{}))
+	assert.False(t, evictable(&corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodSucceeded}}))
+	assert.False(t, evictable(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet"}},
+	}}))
+	assert.False(t, evictable(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{annotationMirrorPod: "hash"},
+	}}))
+}
@@ -35,25 +35,29 @@ type Executor struct {
 	clientFactory ClientFactory
 
 	waitinglist  []string
+	undrained    []string
 	completionWg sync.WaitGroup
 }
 
 type operationOutcome string
 
 const (
-	operationOutcomeApplied      operationOutcome = "applied"
-	operationOutcomeSkippedStale operationOutcome = "skipped_stale"
+	operationOutcomeApplied          operationOutcome = "applied"
+	operationOutcomeSkippedStale     operationOutcome = "skipped_stale"
+	operationOutcomeSkippedProtected operationOutcome = "skipped_protected"
 )
 
 type operationStats struct {
 	processed    int
 	applied      int
 	skippedStale int
+	protected    int
 }
 
 func formatShutdownMessage(stats operationStats) string {
 	msg := fmt.Sprintf("scaled down %d Karpenter NodePool(s)", stats.applied)
-	return appendCountSegment(msg, "skipped", stats.skippedStale, "stale NodePool")
+	msg = appendCountSegment(msg, "skipped", stats.skippedStale, "stale NodePool")
+	return appendCountSegment(msg, "skipped", stats.protected, "protected NodePool")
 }
 
 func formatWakeUpMessage(stats operationStats) string {
@@ -133,12 +137,15 @@ func (e *Executor) Validate(spec executor.Spec) error {
 	return nil
 }
 
-// Shutdown scales Karpenter NodePools to zero by setting disruption budgets and resource limits.
+// Shutdown deletes the Karpenter NodePools so Karpenter removes their nodes.
+// With drain enabled, the NodePool limits are zeroed and its nodes cordoned
+// and drained first.
 func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.Spec) (*executor.Result, error) {
 
 	log = log.WithName("karpenter").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
 	log.Info("executor starting shutdown")
 	e.waitinglist = nil
+	e.undrained = nil
 
 	var params executorparams.KarpenterParameters
 	if len(spec.Parameters) > 0 {
@@ -197,6 +204,11 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		return &executor.Result{Message: "shutdown completed for Karpenter (no NodePools found)"}, nil
 	}
 
+	if err := executor.CheckResourceLimit(spec, len(targetNodePools), "NodePool"); err != nil {
+		log.Error(err, "refusing to shut down")
+		return nil, err
+	}
+
 	stats := operationStats{processed: len(targetNodePools)}
 
 	// Process each NodePool
@@ -213,11 +225,20 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 			stats.applied++
 		case operationOutcomeSkippedStale:
 			stats.skippedStale++
+		case operationOutcomeSkippedProtected:
+			stats.protected++
 		}
 	}
 
 	// Wait for all nodes corresponding to deleted NodePools to be removed if configured
 	msg := formatShutdownMessage(stats)
+	if len(e.undrained) > 0 {
+		timeout := params.Drain.Timeout
+		if timeout == "" {
+			timeout = DefaultDrainTimeout
+		}
+		msg += fmt.Sprintf("; %d NodePool(s) not fully drained after %s timeout", len(e.undrained), timeout)
+	}
 
 	if params.AwaitCompletion.Enabled {
 		timeout := params.AwaitCompletion.Timeout
@@ -252,11 +273,23 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		"processed", stats.processed,
 		"scaled", stats.applied,
 		"skippedStale", stats.skippedStale,
+		"protected", stats.protected,
 	)
 
 	return &executor.Result{Message: msg}, nil
 }
 
+// ValidateRestore checks that every restore entry carries the NodePool spec
+// needed to recreate it.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries(restore, func(_ string, state *NodePoolState) error {
+		if state.Spec == nil {
+			return fmt.Errorf("missing NodePool spec")
+		}
+		return nil
+	})
+}
+
 // WakeUp restores Karpenter NodePools from hibernation.
 func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
 	log = log.WithName("karpenter").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
@@ -395,10 +428,14 @@ func (e *Executor) listNodePoolsBySelector(ctx context.Context, client Client, l
 }
 
 // NodePoolState stores the complete NodePool manifest for recreation after hibernation.
+// The embedded settings repeat the limits, disruption and weight of Spec, which
+// are put back in place when the NodePool still exists at wakeup.
 type NodePoolState struct {
 	Name   string                 `json:"name"`
 	Spec   map[string]interface{} `json:"spec"`
 	Labels map[string]string      `json:"labels,omitempty"`
+
+	NodePoolSettings `json:",inline"`
 }
 
 // scaleDownNodePool deletes the NodePool to remove all managed nodes.
@@ -422,19 +459,53 @@ func (e *Executor) scaleDownNodePool(ctx context.Context, log logr.Logger, clien
 		return "", fmt.Errorf("get NodePool: %w", err)
 	}
 
+	if executor.IsProtected(nodePool.GetLabels()) {
+		log.Info("NodePool is protected, skipping scale down", "nodePool", nodePoolName)
+		return operationOutcomeSkippedProtected, nil
+	}
+
 	// Save complete spec for recreation
 	spec, found, err := unstructured.NestedMap(nodePool.Object, "spec")
 	if err != nil || !found {
 		return "", fmt.Errorf("get NodePool spec: %w", err)
 	}
 
+	// A NodePool zeroed by an interrupted shutdown keeps its original settings
+	// in an annotation.
+	settings, zeroed, err := hibernatedSettings(nodePool)
+	if err != nil {
+		return "", err
+	}
+	if zeroed {
+		applySettings(spec, settings)
+	} else {
+		settings = settingsOf(spec)
+	}
+
 	// Save labels if present
 	labels := nodePool.GetLabels()
 
 	state := NodePoolState{
-		Name:   nodePoolName,
-		Spec:   spec,
-		Labels: labels,
+		Name:             nodePoolName,
+		Spec:             spec,
+		Labels:           labels,
+		NodePoolSettings: settings,
+	}
+
+	if params.Drain.Enabled {
+		if !zeroed {
+			if err := e.zeroLimits(ctx, log, client, nodePool, settings); err != nil {
+				return "", err
+			}
+		}
+
+		drained, err := e.drainNodes(ctx, log, client, nodePoolName, params.Drain)
+		if err != nil {
+			return "", fmt.Errorf("drain nodes: %w", err)
+		}
+		if !drained {
+			e.undrained = append(e.undrained, nodePoolName)
+		}
 	}
 
 	log.Info("deleting NodePool to trigger node removal", "nodePool", nodePoolName)
@@ -501,8 +572,7 @@ func (e *Executor) restoreNodePool(ctx context.Context, log logr.Logger, client
 	// Create the NodePool
 	if _, err := client.Resource(nodePoolGVR).Create(ctx, nodePool, metav1.CreateOptions{}); err != nil {
 		if apierrors.IsAlreadyExists(err) {
-			log.Info("NodePool already exists, skipping stale restore entry", "nodePool", nodePoolName)
-			return operationOutcomeSkippedStale, nil
+			return e.rollbackNodePool(ctx, log, client, nodePoolName, state, params)
 		}
 
 		return "", fmt.Errorf("create NodePool: %w", err)
@@ -518,6 +588,63 @@ func (e *Executor) restoreNodePool(ctx context.Context, log logr.Logger, client
 	return operationOutcomeApplied, nil
 }
 
+// rollbackNodePool puts back the settings of a NodePool that a shutdown zeroed
+// but did not delete, and uncordons its nodes. Any other existing NodePool is
+// a stale restore entry.
+func (e *Executor) rollbackNodePool(ctx context.Context, log logr.Logger, client Client, nodePoolName string, state NodePoolState, params executorparams.KarpenterParameters) (operationOutcome, error) {
+	nodePoolGVR := schema.GroupVersionResource{
+		Group:    "karpenter.sh",
+		Version:  "v1",
+		Resource: "nodepools",
+	}
+
+	existing, err := client.Resource(nodePoolGVR).Get(ctx, nodePoolName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("get NodePool: %w", err)
+	}
+
+	settings, zeroed, err := hibernatedSettings(existing)
+	if err != nil {
+		// The saved state holds the same settings.
+		log.Error(err, "ignoring hibernated settings annotation", "nodePool", nodePoolName)
+		settings, zeroed = state.NodePoolSettings, true
+	}
+	if !zeroed {
+		log.Info("NodePool already exists, skipping stale restore entry", "nodePool", nodePoolName)
+		return operationOutcomeSkippedStale, nil
+	}
+
+	log.Info("NodePool was not deleted by shutdown, restoring its settings", "nodePool", nodePoolName)
+
+	spec, _, err := unstructured.NestedMap(existing.Object, "spec")
+	if err != nil {
+		return "", fmt.Errorf("get NodePool spec: %w", err)
+	}
+	if spec == nil {
+		spec = make(map[string]interface{})
+	}
+	applySettings(spec, settings)
+	if err := unstructured.SetNestedMap(existing.Object, spec, "spec"); err != nil {
+		return "", fmt.Errorf("set NodePool spec: %w", err)
+	}
+	annotations := existing.GetAnnotations()
+	delete(annotations, AnnotationHibernatedSettings)
+	existing.SetAnnotations(annotations)
+
+	if _, err := client.Resource(nodePoolGVR).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return "", fmt.Errorf("update NodePool: %w", err)
+	}
+	if err := e.uncordonNodes(ctx, log, client, nodePoolName); err != nil {
+		return "", err
+	}
+
+	if params.AwaitCompletion.Enabled {
+		e.waitinglist = append(e.waitinglist, nodePoolName)
+	}
+
+	return operationOutcomeApplied, nil
+}
+
 // waitForNodePoolReady waits for a NodePool to reach ready status.
 func (e *Executor) waitForNodePoolReady(ctx context.Context, log logr.Logger, client Client, nodePoolName string, timeout string) error {
 	nodePoolGVR := schema.GroupVersionResource{
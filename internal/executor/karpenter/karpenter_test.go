@@ -303,6 +303,66 @@ func TestShutdown_DeletesNodePools(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestShutdown_SkipsProtectedNodePools(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	gvr := schema.GroupVersionResource{
+		Group:    "karpenter.sh",
+		Version:  "v1",
+		Resource: "nodepools",
+	}
+
+	nodePool := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "karpenter.sh/v1",
+			"kind":       "NodePool",
+			"metadata": map[string]interface{}{
+				"name": "default",
+			},
+			"spec": map[string]interface{}{
+				"limits": map[string]interface{}{"cpu": "1000"},
+			},
+		},
+	}
+	nodePool.SetLabels(map[string]string{"hibernator.ardikabs.com/protected": "true"})
+
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), nodePool)
+	mockClient.On("Resource", gvr).Return(fakeDynamic.Resource(gvr))
+
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) {
+		return mockClient, nil
+	})
+
+	var reported []string
+	spec := executor.Spec{
+		TargetName: "test-cluster",
+		TargetType: "karpenter",
+		Parameters: json.RawMessage(`{"nodePools": ["default"]}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			K8S: &executor.K8SConnectorConfig{
+				ClusterName: "my-cluster",
+				Region:      "us-east-1",
+			},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			reported = append(reported, key)
+			return nil
+		},
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "scaled down 0 Karpenter NodePool(s), skipped 1 protected NodePool(s)", result.Message)
+	assert.Empty(t, reported)
+
+	// The NodePool keeps its limits.
+	got, err := fakeDynamic.Resource(gvr).Get(ctx, "default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	limits, _, _ := unstructured.NestedMap(got.Object, "spec", "limits")
+	assert.Equal(t, map[string]interface{}{"cpu": "1000"}, limits)
+}
+
 func TestShutdown_MultipleNodePools(t *testing.T) {
 	ctx := context.Background()
 	mockClient := mocks.NewClient(t)
@@ -19,6 +19,24 @@ type Client struct {
 	mock.Mock
 }
 
+// EvictPod provides a mock function with given fields: ctx, pod, gracePeriodSeconds
+func (_m *Client) EvictPod(ctx context.Context, pod *v1.Pod, gracePeriodSeconds *int64) error {
+	ret := _m.Called(ctx, pod, gracePeriodSeconds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvictPod")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1.Pod, *int64) error); ok {
+		r0 = rf(ctx, pod, gracePeriodSeconds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ListNode provides a mock function with given fields: ctx, selector
 func (_m *Client) ListNode(ctx context.Context, selector string) (*v1.NodeList, error) {
 	ret := _m.Called(ctx, selector)
@@ -49,6 +67,36 @@ func (_m *Client) ListNode(ctx context.Context, selector string) (*v1.NodeList,
 	return r0, r1
 }
 
+// ListPods provides a mock function with given fields: ctx, nodeName
+func (_m *Client) ListPods(ctx context.Context, nodeName string) (*v1.PodList, error) {
+	ret := _m.Called(ctx, nodeName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPods")
+	}
+
+	var r0 *v1.PodList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1.PodList, error)); ok {
+		return rf(ctx, nodeName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *v1.PodList); ok {
+		r0 = rf(ctx, nodeName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.PodList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, nodeName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Resource provides a mock function with given fields: gvr
 func (_m *Client) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
 	ret := _m.Called(gvr)
@@ -69,6 +117,24 @@ func (_m *Client) Resource(gvr schema.GroupVersionResource) dynamic.Namespaceabl
 	return r0
 }
 
+// SetNodeUnschedulable provides a mock function with given fields: ctx, name, unschedulable
+func (_m *Client) SetNodeUnschedulable(ctx context.Context, name string, unschedulable bool) error {
+	ret := _m.Called(ctx, name, unschedulable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNodeUnschedulable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, name, unschedulable)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClient(t interface {
@@ -0,0 +1,29 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrResourceLimitExceeded is returned by CheckResourceLimit when a target
+// selects more resources than its shutdown may act on.
+var ErrResourceLimitExceeded = errors.New("resource limit exceeded")
+
+// CheckResourceLimit guards against overly broad selectors, e.g. a mistaken
+// includeAll, taking down a whole account. It fails with
+// FailureResourceLimitExceeded when selected exceeds spec.MaxResources, so
+// Shutdown must call it after discovery and before changing any resource.
+// noun names the selected resources in the error, e.g. "RDS resource".
+func CheckResourceLimit(spec Spec, selected int, noun string) error {
+	if spec.MaxResources <= 0 || selected <= spec.MaxResources {
+		return nil
+	}
+	return NewFailure(FailureResourceLimitExceeded, fmt.Errorf(
+		"%w: target selected %d %s(s), more than the %d allowed; narrow the selector or raise spec.behavior.maxResourcesPerTarget",
+		ErrResourceLimitExceeded, selected, noun, spec.MaxResources))
+}
@@ -0,0 +1,22 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckResourceLimit(t *testing.T) {
+	assert.NoError(t, CheckResourceLimit(Spec{}, 1000, "instance"), "zero means unlimited")
+	assert.NoError(t, CheckResourceLimit(Spec{MaxResources: 3}, 3, "instance"))
+
+	err := CheckResourceLimit(Spec{MaxResources: 3}, 4, "instance")
+	assert.ErrorIs(t, err, ErrResourceLimitExceeded)
+	assert.Equal(t, FailureResourceLimitExceeded, ReasonOf(err))
+	assert.EqualError(t, err, "resource limit exceeded: target selected 4 instance(s), more than the 3 allowed; narrow the selector or raise spec.behavior.maxResourcesPerTarget")
+}
@@ -129,6 +129,11 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 	return &executor.Result{Message: fmt.Sprintf("noop shutdown completed for target %s", spec.TargetName)}, nil
 }
 
+// ValidateRestore checks that every restore entry is a valid NoOp state.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries[RestoreState](restore, nil)
+}
+
 // WakeUp simulates restoration using saved restore data.
 func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
 	log = log.WithName("noop").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
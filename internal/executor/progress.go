@@ -0,0 +1,33 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+// ResourceProgress reports how far an operation got through the resources of
+// its target.
+type ResourceProgress struct {
+	// Completed is the number of resources processed so far, including the
+	// ones skipped because they needed no change.
+	Completed int
+	// Total is the number of resources the operation processes.
+	Total int
+	// Resource identifies the resource processed last, using the same key as
+	// its restore data.
+	Resource string
+	// Message describes the progress, e.g. "stopped 7/24 instances".
+	Message string
+}
+
+// ReportProgressCallback is a callback for per-resource progress reporting.
+// Executors call it after each resource they process, so the control plane
+// can show how far a long-running operation got.
+type ReportProgressCallback func(progress ResourceProgress)
+
+// ReportProgress reports progress when the spec carries a progress callback.
+func (s Spec) ReportProgress(progress ResourceProgress) {
+	if s.ReportProgressCallback != nil {
+		s.ReportProgressCallback(progress)
+	}
+}
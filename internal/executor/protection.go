@@ -0,0 +1,22 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+import "github.com/ardikabs/hibernator/internal/wellknown"
+
+// IsProtected reports whether the tags or labels of a resource carry
+// wellknown.LabelProtected=true. Executors skip protected resources on
+// shutdown even when the selector of the target matches them, so application
+// teams can opt individual resources out of a broadly selected environment.
+func IsProtected(labels map[string]string) bool {
+	return labels[wellknown.LabelProtected] == "true"
+}
+
+// IsGCPProtected is IsProtected for GCP resource labels, which carry
+// wellknown.GCPLabelProtected instead.
+func IsGCPProtected(labels map[string]string) bool {
+	return labels[wellknown.GCPLabelProtected] == "true"
+}
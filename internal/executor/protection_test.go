@@ -0,0 +1,22 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsProtected(t *testing.T) {
+	assert.True(t, IsProtected(map[string]string{"hibernator.ardikabs.com/protected": "true"}))
+	assert.False(t, IsProtected(map[string]string{"hibernator.ardikabs.com/protected": "false"}))
+	assert.False(t, IsProtected(map[string]string{"hibernator-protected": "true"}))
+	assert.False(t, IsProtected(nil))
+
+	assert.True(t, IsGCPProtected(map[string]string{"hibernator-protected": "true"}))
+	assert.False(t, IsGCPProtected(map[string]string{"hibernator.ardikabs.com/protected": "true"}))
+}
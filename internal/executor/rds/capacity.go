@@ -0,0 +1,134 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+
+	"github.com/ardikabs/hibernator/pkg/executorparams"
+)
+
+// CapacityRange is an Aurora Serverless v2 capacity range in ACUs.
+type CapacityRange = executorparams.RDSCapacityRange
+
+// DefaultCapacityFloor is the capacity range the "scaleCapacity" cluster mode
+// applies when the parameters set no capacityFloor.
+var DefaultCapacityFloor = CapacityRange{MinCapacity: 0.5, MaxCapacity: 1}
+
+// capacityFloor returns the capacity range clusters are scaled down to.
+func capacityFloor(params Parameters) CapacityRange {
+	if params.CapacityFloor != nil {
+		return *params.CapacityFloor
+	}
+	return DefaultCapacityFloor
+}
+
+// capacityOf returns the Serverless v2 capacity range of the cluster, or false
+// if the cluster has no Serverless v2 capacity.
+func capacityOf(cluster types.DBCluster) (CapacityRange, bool) {
+	cfg := cluster.ServerlessV2ScalingConfiguration
+	if cfg == nil || cfg.MinCapacity == nil || cfg.MaxCapacity == nil {
+		return CapacityRange{}, false
+	}
+	return CapacityRange{MinCapacity: aws.ToFloat64(cfg.MinCapacity), MaxCapacity: aws.ToFloat64(cfg.MaxCapacity)}, true
+}
+
+// capacityScaled reports whether the state is of a cluster whose capacity was
+// scaled down instead of stopped.
+func capacityScaled(state ResourceState) bool {
+	cluster, ok := state.(DBClusterState)
+	return ok && cluster.Capacity != nil
+}
+
+// setCapacity applies the capacity range to the cluster immediately.
+func setCapacity(ctx context.Context, client RDSClient, id string, capacity CapacityRange) error {
+	_, err := client.ModifyDBCluster(ctx, &rds.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+		ServerlessV2ScalingConfiguration: &types.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(capacity.MinCapacity),
+			MaxCapacity: aws.Float64(capacity.MaxCapacity),
+		},
+		ApplyImmediately: aws.Bool(true),
+	})
+	return err
+}
+
+// scaleDownCapacity scales the Serverless v2 capacity range of an available
+// cluster down to the floor and returns its state, which records the original
+// range. Clusters without Serverless v2 capacity, and clusters already at the
+// floor, are skipped; the latter keeps the state saved by an earlier attempt.
+func scaleDownCapacity(ctx context.Context, log logr.Logger, client RDSClient, cluster types.DBCluster, floor CapacityRange) (DBClusterState, error) {
+	id := aws.ToString(cluster.DBClusterIdentifier)
+
+	current, ok := capacityOf(cluster)
+	if !ok {
+		log.Info("cluster has no Serverless v2 capacity to scale down, skipping ...", "clusterId", id)
+		return DBClusterState{Outcome: operationOutcomeSkippedStale}, nil
+	}
+	if current == floor {
+		log.Info("cluster capacity is already at the floor, skipping ...", "clusterId", id, "capacity", current)
+		return DBClusterState{Outcome: operationOutcomeSkippedStale}, nil
+	}
+
+	log.Info("scaling down DB cluster capacity", "clusterId", id, "from", current, "to", floor)
+	if err := setCapacity(ctx, client, id, floor); err != nil {
+		return DBClusterState{}, fmt.Errorf("scale down capacity: %w", err)
+	}
+
+	return DBClusterState{
+		ClusterId:  id,
+		WasRunning: true,
+		Capacity:   &current,
+		Outcome:    operationOutcomeApplied,
+	}, nil
+}
+
+// restoreCapacity restores the Serverless v2 capacity range a cluster had before
+// it was scaled down.
+func restoreCapacity(ctx context.Context, log logr.Logger, client RDSClient, id string, capacity CapacityRange) (ResourceState, error) {
+	desc, err := client.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(id),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "DBClusterNotFoundFault" {
+			log.Info("cluster not found, skipping ...", "clusterId", id)
+			return DBClusterState{Outcome: operationOutcomeSkippedStale}, nil
+		}
+		return nil, err
+	}
+
+	if len(desc.DBClusters) == 0 {
+		return nil, fmt.Errorf("cluster %s not found", id)
+	}
+
+	cluster := desc.DBClusters[0]
+	if current, ok := capacityOf(cluster); ok && current == capacity {
+		log.Info("cluster capacity is already restored", "clusterId", id, "capacity", current)
+		return DBClusterState{Outcome: operationOutcomeSkippedStale}, nil
+	}
+
+	// The capacity can only be modified while the cluster is available; failing
+	// lets a retry of the execution restore it once the cluster settles.
+	if status := aws.ToString(cluster.Status); status != "available" {
+		return nil, fmt.Errorf("cluster is %s, its capacity can only be restored while it is available", status)
+	}
+
+	log.Info("restoring DB cluster capacity", "clusterId", id, "capacity", capacity)
+	if err := setCapacity(ctx, client, id, capacity); err != nil {
+		return nil, fmt.Errorf("restore capacity: %w", err)
+	}
+
+	return DBClusterState{Capacity: &capacity, Outcome: operationOutcomeApplied}, nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package rds
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/rds/mocks"
+)
+
+func serverlessCluster(id, status string, minCapacity, maxCapacity float64) types.DBCluster {
+	return types.DBCluster{
+		DBClusterIdentifier: aws.String(id),
+		Status:              aws.String(status),
+		ServerlessV2ScalingConfiguration: &types.ServerlessV2ScalingConfigurationInfo{
+			MinCapacity: aws.Float64(minCapacity),
+			MaxCapacity: aws.Float64(maxCapacity),
+		},
+	}
+}
+
+func describeCluster(mockRDS *mocks.RDSClient, cluster types.DBCluster) {
+	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{DBClusterIdentifier: cluster.DBClusterIdentifier}).
+		Return(&rds.DescribeDBClustersOutput{DBClusters: []types.DBCluster{cluster}}, nil)
+}
+
+func modifyCapacity(mockRDS *mocks.RDSClient, id string, minCapacity, maxCapacity float64) {
+	mockRDS.On("ModifyDBCluster", mock.Anything, &rds.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+		ServerlessV2ScalingConfiguration: &types.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(minCapacity),
+			MaxCapacity: aws.Float64(maxCapacity),
+		},
+		ApplyImmediately: aws.Bool(true),
+	}).Return(&rds.ModifyDBClusterOutput{}, nil).Once()
+}
+
+func capacitySpec(params string, restore map[string]json.RawMessage) executor.Spec {
+	return executor.Spec{
+		TargetName: "test-cluster",
+		TargetType: "rds",
+		Parameters: json.RawMessage(params),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		ReportStateCallback: func(key string, value any) error {
+			raw, err := json.Marshal(value)
+			restore[key] = raw
+			return err
+		},
+	}
+}
+
+func TestShutdown_ScaleCapacity(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	describeCluster(mockRDS, serverlessCluster("global-primary", "available", 2, 16))
+	// A provisioned cluster has no Serverless v2 capacity to scale down.
+	describeCluster(mockRDS, types.DBCluster{DBClusterIdentifier: aws.String("provisioned"), Status: aws.String("available")})
+	modifyCapacity(mockRDS, "global-primary", 0, 2)
+
+	e := NewWithClients(func(cfg aws.Config) RDSClient { return mockRDS }, nil, nil)
+
+	restore := map[string]json.RawMessage{}
+	// Await completion does not wait for scaled-down clusters to stop.
+	spec := capacitySpec(`{
+		"selector": {"clusterIds": ["global-primary", "provisioned"]},
+		"clusterMode": "scaleCapacity",
+		"capacityFloor": {"minCapacity": 0, "maxCapacity": 2},
+		"awaitCompletion": {"enabled": true, "timeout": "1s"}
+	}`, restore)
+
+	result, err := e.Shutdown(context.Background(), logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "stopped 0 RDS resource(s), scaled down 1 Serverless v2 cluster(s), skipped 1 stale resource(s); all resources confirmed stopped", result.Message)
+
+	require.Contains(t, restore, "cluster:global-primary")
+	assert.NotContains(t, restore, "cluster:provisioned")
+	assert.NoError(t, e.ValidateRestore(executor.RestoreData{Type: ExecutorType, Data: restore}))
+
+	var state DBClusterState
+	require.NoError(t, json.Unmarshal(restore["cluster:global-primary"], &state))
+	assert.True(t, state.WasRunning)
+	assert.Equal(t, &CapacityRange{MinCapacity: 2, MaxCapacity: 16}, state.Capacity)
+}
+
+func TestShutdown_ScaleCapacity_AlreadyAtFloor(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	describeCluster(mockRDS, serverlessCluster("global-primary", "available", 0.5, 1))
+
+	e := NewWithClients(func(cfg aws.Config) RDSClient { return mockRDS }, nil, nil)
+
+	restore := map[string]json.RawMessage{}
+	spec := capacitySpec(`{"selector": {"clusterIds": ["global-primary"]}, "clusterMode": "scaleCapacity"}`, restore)
+
+	result, err := e.Shutdown(context.Background(), logr.Discard(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, "stopped 0 RDS resource(s), skipped 1 stale resource(s)", result.Message)
+	// The state saved by an earlier attempt is not overwritten with the floor.
+	assert.Empty(t, restore)
+}
+
+func TestWakeUp_RestoresCapacity(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	describeCluster(mockRDS, serverlessCluster("global-primary", "available", 0.5, 1))
+	describeCluster(mockRDS, serverlessCluster("restored", "available", 2, 16))
+	modifyCapacity(mockRDS, "global-primary", 2, 16)
+
+	e := NewWithClients(func(cfg aws.Config) RDSClient { return mockRDS }, nil, nil)
+
+	scaled, _ := json.Marshal(DBClusterState{ClusterId: "global-primary", WasRunning: true, Capacity: &CapacityRange{MinCapacity: 2, MaxCapacity: 16}})
+	restored, _ := json.Marshal(DBClusterState{ClusterId: "restored", WasRunning: true, Capacity: &CapacityRange{MinCapacity: 2, MaxCapacity: 16}})
+
+	spec := capacitySpec(`{"selector": {"clusterIds": ["global-primary", "restored"]}, "clusterMode": "scaleCapacity"}`, map[string]json.RawMessage{})
+	result, err := e.WakeUp(context.Background(), logr.Discard(), spec, executor.RestoreData{
+		Data: map[string]json.RawMessage{"cluster:global-primary": scaled, "cluster:restored": restored},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "started 0 RDS resource(s), restored capacity of 1 Serverless v2 cluster(s), skipped 1 stale resource(s)", result.Message)
+}
+
+func TestWakeUp_RestoreCapacity_ClusterNotAvailable(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	describeCluster(mockRDS, serverlessCluster("global-primary", "modifying", 0.5, 1))
+
+	e := NewWithClients(func(cfg aws.Config) RDSClient { return mockRDS }, nil, nil)
+
+	scaled, _ := json.Marshal(DBClusterState{ClusterId: "global-primary", WasRunning: true, Capacity: &CapacityRange{MinCapacity: 2, MaxCapacity: 16}})
+
+	spec := capacitySpec(`{"selector": {"clusterIds": ["global-primary"]}, "clusterMode": "scaleCapacity"}`, map[string]json.RawMessage{})
+	_, err := e.WakeUp(context.Background(), logr.Discard(), spec, executor.RestoreData{
+		Data: map[string]json.RawMessage{"cluster:global-primary": scaled},
+	})
+	assert.EqualError(t, err, "restore cluster global-primary: cluster is modifying, its capacity can only be restored while it is available")
+}
@@ -74,6 +74,12 @@ type RDSClient interface {
 		params *rds.ListTagsForResourceInput,
 		optFns ...func(*rds.Options),
 	) (*rds.ListTagsForResourceOutput, error)
+
+	ModifyDBCluster(
+		ctx context.Context,
+		params *rds.ModifyDBClusterInput,
+		optFns ...func(*rds.Options),
+	) (*rds.ModifyDBClusterOutput, error)
 }
 
 // STSClient is the interface for AWS STS operations used for role assumption.
@@ -17,7 +17,6 @@ import (
 	"github.com/go-logr/logr"
 
 	"github.com/ardikabs/hibernator/internal/executor"
-	"github.com/ardikabs/hibernator/pkg/awsutil"
 	"github.com/ardikabs/hibernator/pkg/executorparams"
 	"github.com/ardikabs/hibernator/pkg/waiter"
 )
@@ -39,68 +38,31 @@ func (s *clusterStrategy) Discover(ctx context.Context, log logr.Logger, client
 		return selector.ClusterIds, nil
 	}
 
-	// Otherwise, discover all clusters and apply tag filtering
+	// Otherwise, discover all clusters and apply the selector
 	log.Info("listing all DB clusters in account/region")
-	result, err := client.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{})
+	clusters, err := describeAllDBClusters(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("describe DB clusters: %w", err)
 	}
 
 	// If includeAll, skip tag fetching and include everything
 	if selector.IncludeAll {
-		log.Info("including all DB clusters (includeAll=true)", "count", len(result.DBClusters))
-		for _, cluster := range result.DBClusters {
+		log.Info("including all DB clusters (includeAll=true)", "count", len(clusters))
+		for _, cluster := range clusters {
 			clusterIDs = append(clusterIDs, aws.ToString(cluster.DBClusterIdentifier))
 		}
 		return clusterIDs, nil
 	}
 
-	// Build the effective tag selector
-	var effectiveSelector *awsutil.TagSelector
-	if selector.TagSelector != nil {
-		effectiveSelector = selector.TagSelector
-	} else if len(selector.Tags) > 0 {
-		effectiveSelector = awsutil.ToTagSelector(selector.Tags)
-	}
-
-	// For backward compat with ExcludeTags
-	usingExcludeTags := len(selector.ExcludeTags) > 0
-
-	// Apply tag-based filtering
-	for _, cluster := range result.DBClusters {
-		clusterID := aws.ToString(cluster.DBClusterIdentifier)
-
-		// Get tags for tag-based filtering
-		clusterARN := aws.ToString(cluster.DBClusterArn)
-		tagsResp, err := client.ListTagsForResource(ctx, &rds.ListTagsForResourceInput{
-			ResourceName: aws.String(clusterARN),
+	candidates := make([]taggedResource, 0, len(clusters))
+	for _, cluster := range clusters {
+		candidates = append(candidates, taggedResource{
+			ID:  aws.ToString(cluster.DBClusterIdentifier),
+			ARN: aws.ToString(cluster.DBClusterArn),
 		})
-		if err != nil {
-			log.Error(err, "failed to list tags for cluster", "clusterId", clusterID)
-			return nil, fmt.Errorf("list tags for cluster %s: %w", clusterID, err)
-		}
-
-		clusterTags := make(map[string]string)
-		for _, tag := range tagsResp.TagList {
-			clusterTags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
-		}
-
-		// Apply tag filtering
-		if effectiveSelector != nil {
-			if awsutil.Match(clusterTags, effectiveSelector) {
-				clusterIDs = append(clusterIDs, clusterID)
-				log.Info("cluster included (tagSelector match)", "clusterId", clusterID, "tags", clusterTags)
-			}
-		} else if usingExcludeTags {
-			// Include clusters NOT matching excludeTags (backward compat)
-			if !matchesTags(clusterTags, selector.ExcludeTags) {
-				clusterIDs = append(clusterIDs, clusterID)
-				log.Info("cluster included (excludeTags don't match)", "clusterId", clusterID, "tags", clusterTags)
-			}
-		}
 	}
 
-	return clusterIDs, nil
+	return selectDiscovered(ctx, log, client, "cluster", selector, candidates)
 }
 
 // Stop stops a DB cluster and returns its state (with embedded outcome)
@@ -123,6 +85,11 @@ func (s *clusterStrategy) Stop(ctx context.Context, log logr.Logger, client RDSC
 	}
 
 	cluster := desc.DBClusters[0]
+	if executor.IsProtected(tagsOf(cluster.TagList)) {
+		log.Info("cluster is protected, skipping ...", "clusterId", id)
+		return DBClusterState{Outcome: operationOutcomeSkippedProtected}, nil
+	}
+
 	state := DBClusterState{
 		ClusterId: id,
 	}
@@ -131,6 +98,18 @@ func (s *clusterStrategy) Stop(ctx context.Context, log logr.Logger, client RDSC
 
 	switch status {
 	case "available":
+		if params.ClusterMode == executorparams.RDSClusterModeScaleCapacity {
+			scaled, err := scaleDownCapacity(ctx, log, client, cluster, capacityFloor(params))
+			if err != nil {
+				return nil, err
+			}
+			if scaled.Outcome != operationOutcomeApplied {
+				return scaled, nil
+			}
+			state = scaled
+			break
+		}
+
 		state.WasRunning = true
 
 		// Create snapshot if requested
@@ -184,6 +163,7 @@ func (s *clusterStrategy) Stop(ctx context.Context, log logr.Logger, client RDSC
 		"clusterId", id,
 		"wasRunning", state.WasRunning,
 		"snapshotCreated", state.SnapshotId != "",
+		"capacityScaled", state.Capacity != nil,
 	)
 
 	return state, nil
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/go-logr/logr"
+
+	"github.com/ardikabs/hibernator/pkg/awsutil"
+	"github.com/ardikabs/hibernator/pkg/executorparams"
+)
+
+// tagFetchConcurrency bounds the ListTagsForResource calls in flight while
+// discovering resources by tags. The rate limiter of the client still paces them.
+const tagFetchConcurrency = 8
+
+// describeAllDBInstances returns the DB instances of the account and region,
+// across all pages.
+func describeAllDBInstances(ctx context.Context, client RDSClient) ([]types.DBInstance, error) {
+	var instances []types.DBInstance
+	paginator := rds.NewDescribeDBInstancesPaginator(client, &rds.DescribeDBInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, page.DBInstances...)
+	}
+	return instances, nil
+}
+
+// describeAllDBClusters returns the DB clusters of the account and region,
+// across all pages.
+func describeAllDBClusters(ctx context.Context, client RDSClient) ([]types.DBCluster, error) {
+	var clusters []types.DBCluster
+	paginator := rds.NewDescribeDBClustersPaginator(client, &rds.DescribeDBClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, page.DBClusters...)
+	}
+	return clusters, nil
+}
+
+// tagsOf converts the tag list of a described resource into a map.
+func tagsOf(tagList []types.Tag) map[string]string {
+	tags := make(map[string]string, len(tagList))
+	for _, tag := range tagList {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags
+}
+
+// taggedResource is a resource whose tags discovery needs.
+type taggedResource struct {
+	ID  string
+	ARN string
+}
+
+// fetchTags lists the tags of the resources of the given kind in parallel, with
+// at most tagFetchConcurrency calls in flight. The tags are returned in the
+// order of resources. The first failure cancels the remaining calls.
+func fetchTags(ctx context.Context, client RDSClient, kind string, resources []taggedResource) ([]map[string]string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tags := make([]map[string]string, len(resources))
+	sem := make(chan struct{}, tagFetchConcurrency)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failed   = -1
+		firstErr error
+	)
+	for i, res := range resources {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, res taggedResource) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := client.ListTagsForResource(ctx, &rds.ListTagsForResourceInput{
+				ResourceName: aws.String(res.ARN),
+			})
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					failed, firstErr = i, err
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+
+			tags[i] = tagsOf(resp.TagList)
+		}(i, res)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, fmt.Errorf("list tags for %s %s: %w", kind, resources[failed].ID, firstErr)
+	}
+	return tags, nil
+}
+
+// selectDiscovered returns the IDs of the discovered resources of the given
+// kind that the selector matches: by name pattern against the identifier, by
+// tag selector (or the legacy tags) and by excludeTags. Tags are only fetched
+// when the selector depends on them.
+func selectDiscovered(ctx context.Context, log logr.Logger, client RDSClient, kind string, selector executorparams.RDSSelector, candidates []taggedResource) ([]string, error) {
+	// Build the effective tag selector
+	var effectiveSelector *awsutil.TagSelector
+	if selector.TagSelector != nil {
+		effectiveSelector = selector.TagSelector
+	} else if len(selector.Tags) > 0 {
+		effectiveSelector = awsutil.ToTagSelector(selector.Tags)
+	}
+
+	matcher, err := awsutil.NewResourceMatcher(awsutil.ResourceSelector{
+		NamePattern: selector.NamePattern,
+		TagSelector: effectiveSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// ExcludeTags predates TagSelector and is kept for backward compat.
+	usingExcludeTags := len(selector.ExcludeTags) > 0
+
+	// Without any selection method nothing is selected.
+	if !matcher.NeedsTags() && !usingExcludeTags && selector.NamePattern == "" {
+		return nil, nil
+	}
+
+	var tags []map[string]string
+	if matcher.NeedsTags() || usingExcludeTags {
+		log.Info("listing tags of DB "+kind+"s", "count", len(candidates))
+		tags, err = fetchTags(ctx, client, kind, candidates)
+		if err != nil {
+			log.Error(err, "failed to list tags for "+kind+"s")
+			return nil, err
+		}
+	}
+
+	var ids []string
+	for i, candidate := range candidates {
+		var resourceTags map[string]string
+		if tags != nil {
+			resourceTags = tags[i]
+		}
+
+		if !matcher.Match(awsutil.Resource{ID: candidate.ID, Name: candidate.ID, Tags: resourceTags}) {
+			continue
+		}
+		// Include resources NOT matching excludeTags
+		if usingExcludeTags && matchesTags(resourceTags, selector.ExcludeTags) {
+			continue
+		}
+
+		ids = append(ids, candidate.ID)
+		log.Info(kind+" included (selector match)", kind+"Id", candidate.ID, "tags", resourceTags)
+	}
+
+	return ids, nil
+}
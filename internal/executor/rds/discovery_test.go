@@ -0,0 +1,189 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package rds
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardikabs/hibernator/internal/executor"
+	"github.com/ardikabs/hibernator/internal/executor/rds/mocks"
+	"github.com/ardikabs/hibernator/pkg/awsutil"
+	"github.com/ardikabs/hibernator/pkg/executorparams"
+	"github.com/ardikabs/hibernator/pkg/ratelimit"
+)
+
+func TestInstanceDiscover_PaginatesAndFetchesTagsInParallel(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+
+	// 48 instances over three pages; every even one is tagged env=dev.
+	var want []string
+	pages := make([][]types.DBInstance, 3)
+	for i := range 48 {
+		id := fmt.Sprintf("db-%02d", i)
+		pages[i/16] = append(pages[i/16], types.DBInstance{
+			DBInstanceIdentifier: aws.String(id),
+			DBInstanceArn:        aws.String("arn:" + id),
+		})
+
+		env := "prod"
+		if i%2 == 0 {
+			env = "dev"
+			want = append(want, id)
+		}
+		mockRDS.On("ListTagsForResource", mock.Anything, &rds.ListTagsForResourceInput{ResourceName: aws.String("arn:" + id)}).
+			Return(&rds.ListTagsForResourceOutput{TagList: []types.Tag{{Key: aws.String("env"), Value: aws.String(env)}}}, nil).Once()
+	}
+
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).
+		Return(&rds.DescribeDBInstancesOutput{DBInstances: pages[0], Marker: aws.String("page-2")}, nil).Once()
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{Marker: aws.String("page-2")}, mock.Anything).
+		Return(&rds.DescribeDBInstancesOutput{DBInstances: pages[1], Marker: aws.String("page-3")}, nil).Once()
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{Marker: aws.String("page-3")}, mock.Anything).
+		Return(&rds.DescribeDBInstancesOutput{DBInstances: pages[2]}, nil).Once()
+
+	ids, err := (&instanceStrategy{}).Discover(context.Background(), logr.Discard(), mockRDS, executorparams.RDSSelector{
+		Tags: map[string]string{"env": "dev"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, ids)
+}
+
+func TestClusterDiscover_Paginates(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}, mock.Anything).
+		Return(&rds.DescribeDBClustersOutput{
+			DBClusters: []types.DBCluster{{DBClusterIdentifier: aws.String("cluster-a")}},
+			Marker:     aws.String("next"),
+		}, nil).Once()
+	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{Marker: aws.String("next")}, mock.Anything).
+		Return(&rds.DescribeDBClustersOutput{
+			DBClusters: []types.DBCluster{{DBClusterIdentifier: aws.String("cluster-b")}},
+		}, nil).Once()
+
+	ids, err := (&clusterStrategy{}).Discover(context.Background(), logr.Discard(), mockRDS, executorparams.RDSSelector{
+		IncludeAll: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster-a", "cluster-b"}, ids)
+}
+
+func TestInstanceDiscover_NamePatternSkipsTagFetch(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).
+		Return(&rds.DescribeDBInstancesOutput{DBInstances: []types.DBInstance{
+			{DBInstanceIdentifier: aws.String("staging-api"), DBInstanceArn: aws.String("arn:staging-api")},
+			{DBInstanceIdentifier: aws.String("prod-api"), DBInstanceArn: aws.String("arn:prod-api")},
+			{DBInstanceIdentifier: aws.String("staging-api-replica"), DBInstanceArn: aws.String("arn:staging-api-replica")},
+		}}, nil).Once()
+
+	// No ListTagsForResource expectation: a name pattern alone needs no tags.
+	ids, err := (&instanceStrategy{}).Discover(context.Background(), logr.Discard(), mockRDS, executorparams.RDSSelector{
+		NamePattern: "staging-[a-z]+",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staging-api"}, ids)
+}
+
+func TestClusterDiscover_NamePatternAndTagSelector(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}, mock.Anything).
+		Return(&rds.DescribeDBClustersOutput{DBClusters: []types.DBCluster{
+			{DBClusterIdentifier: aws.String("staging-a"), DBClusterArn: aws.String("arn:staging-a")},
+			{DBClusterIdentifier: aws.String("staging-b"), DBClusterArn: aws.String("arn:staging-b")},
+			{DBClusterIdentifier: aws.String("prod-a"), DBClusterArn: aws.String("arn:prod-a")},
+		}}, nil).Once()
+	for id, team := range map[string]string{"staging-a": "backend", "staging-b": "data", "prod-a": "backend"} {
+		mockRDS.On("ListTagsForResource", mock.Anything, &rds.ListTagsForResourceInput{ResourceName: aws.String("arn:" + id)}).
+			Return(&rds.ListTagsForResourceOutput{TagList: []types.Tag{{Key: aws.String("team"), Value: aws.String(team)}}}, nil).Once()
+	}
+
+	ids, err := (&clusterStrategy{}).Discover(context.Background(), logr.Discard(), mockRDS, executorparams.RDSSelector{
+		NamePattern: "staging-.*",
+		TagSelector: &awsutil.TagSelector{MatchExpressions: []awsutil.TagSelectorRequirement{
+			{Key: "team", Operator: "NotIn", Values: []string{"data"}},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staging-a"}, ids)
+}
+
+func TestFetchTags_ReturnsFailure(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	mockRDS.On("ListTagsForResource", mock.Anything, &rds.ListTagsForResourceInput{ResourceName: aws.String("arn:ok")}).
+		Return(&rds.ListTagsForResourceOutput{}, nil).Maybe()
+	mockRDS.On("ListTagsForResource", mock.Anything, &rds.ListTagsForResourceInput{ResourceName: aws.String("arn:denied")}).
+		Return(nil, errors.New("access denied"))
+
+	_, err := fetchTags(context.Background(), mockRDS, "cluster", []taggedResource{
+		{ID: "ok", ARN: "arn:ok"},
+		{ID: "denied", ARN: "arn:denied"},
+	})
+	assert.EqualError(t, err, "list tags for cluster denied: access denied")
+}
+
+func TestRateLimitedClient_PacesCalls(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	mockRDS.On("DescribeDBInstances", mock.Anything, mock.Anything).Return(&rds.DescribeDBInstancesOutput{}, nil).Times(3)
+
+	client := newRateLimitedClient(mockRDS, ratelimit.Config{Rate: 20, Unit: time.Second, Burst: 1})
+
+	start := time.Now()
+	for range 3 {
+		_, err := client.DescribeDBInstances(context.Background(), &rds.DescribeDBInstancesInput{})
+		require.NoError(t, err)
+	}
+	// The burst covers the first call; the next two wait 50ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// A cancelled context fails without calling the API.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{})
+	assert.Error(t, err)
+}
+
+func TestPreview_ListsSelectedResourcesWithoutStopping(t *testing.T) {
+	mockRDS := mocks.NewRDSClient(t)
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).
+		Return(&rds.DescribeDBInstancesOutput{DBInstances: []types.DBInstance{
+			{DBInstanceIdentifier: aws.String("db-app"), DBInstanceArn: aws.String("arn:db-app")},
+			{DBInstanceIdentifier: aws.String("db-critical"), DBInstanceArn: aws.String("arn:db-critical")},
+		}}, nil).Once()
+	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}, mock.Anything).
+		Return(&rds.DescribeDBClustersOutput{DBClusters: []types.DBCluster{
+			{DBClusterIdentifier: aws.String("cluster-app"), DBClusterArn: aws.String("arn:cluster-app")},
+		}}, nil).Once()
+	for arn, critical := range map[string]string{"arn:db-app": "false", "arn:db-critical": "true", "arn:cluster-app": "false"} {
+		mockRDS.On("ListTagsForResource", mock.Anything, &rds.ListTagsForResourceInput{ResourceName: aws.String(arn)}).
+			Return(&rds.ListTagsForResourceOutput{TagList: []types.Tag{{Key: aws.String("Critical"), Value: aws.String(critical)}}}, nil).Once()
+	}
+
+	e := NewWithClients(func(cfg aws.Config) RDSClient { return mockRDS }, nil, nil)
+
+	keys, err := e.Preview(context.Background(), logr.Discard(), executor.Spec{
+		TargetName: "test-preview",
+		TargetType: "rds",
+		Parameters: json.RawMessage(`{"selector": {"excludeTags": {"Critical": "true"}, "discoverInstances": true, "discoverClusters": true}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"instance:db-app", "cluster:cluster-app"}, keys)
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/go-logr/logr"
 
 	"github.com/ardikabs/hibernator/internal/executor"
-	"github.com/ardikabs/hibernator/pkg/awsutil"
 	"github.com/ardikabs/hibernator/pkg/executorparams"
 	"github.com/ardikabs/hibernator/pkg/waiter"
 )
@@ -40,37 +39,24 @@ func (s *instanceStrategy) Discover(ctx context.Context, log logr.Logger, client
 		return selector.InstanceIds, nil
 	}
 
-	// Otherwise, discover all instances and apply tag filtering
+	// Otherwise, discover all instances and apply the selector
 	log.Info("listing all DB instances in account/region")
-	result, err := client.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{})
+	instances, err := describeAllDBInstances(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("describe DB instances: %w", err)
 	}
 
 	// If includeAll, skip tag fetching and include everything
 	if selector.IncludeAll {
-		log.Info("including all DB instances (includeAll=true)", "count", len(result.DBInstances))
-		for _, inst := range result.DBInstances {
+		log.Info("including all DB instances (includeAll=true)", "count", len(instances))
+		for _, inst := range instances {
 			instanceIDs = append(instanceIDs, aws.ToString(inst.DBInstanceIdentifier))
 		}
 		return instanceIDs, nil
 	}
 
-	// Build the effective tag selector
-	var effectiveSelector *awsutil.TagSelector
-	if selector.TagSelector != nil {
-		effectiveSelector = selector.TagSelector
-	} else if len(selector.Tags) > 0 {
-		effectiveSelector = awsutil.ToTagSelector(selector.Tags)
-	}
-
-	// For excludeTags, we need to build a negative selector
-	// But since TagSelector is expressive enough, we should have already migrated.
-	// For backward compat, if ExcludeTags is used, we fall back to old behavior.
-	usingExcludeTags := len(selector.ExcludeTags) > 0
-
-	// Apply tag-based filtering
-	for _, inst := range result.DBInstances {
+	var candidates []taggedResource
+	for _, inst := range instances {
 		instanceID := aws.ToString(inst.DBInstanceIdentifier)
 
 		// Skip AWS-managed restore job resources
@@ -78,38 +64,10 @@ func (s *instanceStrategy) Discover(ctx context.Context, log logr.Logger, client
 			log.Info("skipping instance that appears to be an AWS-managed restore job resource", "instanceId", instanceID)
 			continue
 		}
-
-		// Get tags for tag-based filtering
-		instanceARN := aws.ToString(inst.DBInstanceArn)
-		tagsResp, err := client.ListTagsForResource(ctx, &rds.ListTagsForResourceInput{
-			ResourceName: aws.String(instanceARN),
-		})
-		if err != nil {
-			log.Error(err, "failed to list tags for instance", "instanceId", instanceID)
-			return nil, fmt.Errorf("list tags for instance %s: %w", instanceID, err)
-		}
-
-		instanceTags := make(map[string]string)
-		for _, tag := range tagsResp.TagList {
-			instanceTags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
-		}
-
-		// Apply tag filtering
-		if effectiveSelector != nil {
-			if awsutil.Match(instanceTags, effectiveSelector) {
-				instanceIDs = append(instanceIDs, instanceID)
-				log.Info("instance included (tagSelector match)", "instanceId", instanceID, "tags", instanceTags)
-			}
-		} else if usingExcludeTags {
-			// Include instances NOT matching excludeTags (backward compat)
-			if !matchesTags(instanceTags, selector.ExcludeTags) {
-				instanceIDs = append(instanceIDs, instanceID)
-				log.Info("instance included (excludeTags don't match)", "instanceId", instanceID, "tags", instanceTags)
-			}
-		}
+		candidates = append(candidates, taggedResource{ID: instanceID, ARN: aws.ToString(inst.DBInstanceArn)})
 	}
 
-	return instanceIDs, nil
+	return selectDiscovered(ctx, log, client, "instance", selector, candidates)
 }
 
 // Stop stops a DB instance and returns its state (with embedded outcome)
@@ -132,6 +90,11 @@ func (s *instanceStrategy) Stop(ctx context.Context, log logr.Logger, client RDS
 	}
 
 	instance := desc.DBInstances[0]
+	if executor.IsProtected(tagsOf(instance.TagList)) {
+		log.Info("instance is protected, skipping ...", "instanceId", id)
+		return DBInstanceState{Outcome: operationOutcomeSkippedProtected}, nil
+	}
+
 	state := DBInstanceState{
 		InstanceId:   id,
 		InstanceType: aws.ToString(instance.DBInstanceClass),
@@ -274,6 +274,43 @@ func (_m *RDSClient) ListTagsForResource(ctx context.Context, params *rds.ListTa
 	return r0, r1
 }
 
+// ModifyDBCluster provides a mock function with given fields: ctx, params, optFns
+func (_m *RDSClient) ModifyDBCluster(ctx context.Context, params *rds.ModifyDBClusterInput, optFns ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModifyDBCluster")
+	}
+
+	var r0 *rds.ModifyDBClusterOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterInput, ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterInput, ...func(*rds.Options)) *rds.ModifyDBClusterOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBClusterOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterInput, ...func(*rds.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StartDBCluster provides a mock function with given fields: ctx, params, optFns
 func (_m *RDSClient) StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error) {
 	_va := make([]interface{}, len(optFns))
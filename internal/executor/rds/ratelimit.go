@@ -0,0 +1,121 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package rds
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/ardikabs/hibernator/pkg/ratelimit"
+)
+
+// DefaultAPIRateLimit paces the RDS API calls of one execution. Each execution
+// runs in its own runner Job with its own limiter, while the API throttling
+// limits of the account and region are shared by all of them, so the rate
+// leaves headroom for concurrent executions; --max-concurrent-runner-jobs
+// bounds how many run at once.
+var DefaultAPIRateLimit = ratelimit.Config{
+	Rate:  10,
+	Unit:  time.Second,
+	Burst: 20,
+}
+
+// rateLimitedClient is an RDSClient that waits for the limiter before every call.
+type rateLimitedClient struct {
+	client  RDSClient
+	limiter *ratelimit.Limiter
+}
+
+// newRateLimitedClient wraps client so its calls are paced by cfg.
+func newRateLimitedClient(client RDSClient, cfg ratelimit.Config) RDSClient {
+	return &rateLimitedClient{client: client, limiter: ratelimit.New(cfg)}
+}
+
+func (c *rateLimitedClient) DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.DescribeDBInstances(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) DescribeDBClusters(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.DescribeDBClusters(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) CreateDBSnapshot(ctx context.Context, params *rds.CreateDBSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBSnapshotOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.CreateDBSnapshot(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) DescribeDBSnapshots(ctx context.Context, params *rds.DescribeDBSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBSnapshotsOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.DescribeDBSnapshots(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) CreateDBClusterSnapshot(ctx context.Context, params *rds.CreateDBClusterSnapshotInput, optFns ...func(*rds.Options)) (*rds.CreateDBClusterSnapshotOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.CreateDBClusterSnapshot(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) DescribeDBClusterSnapshots(ctx context.Context, params *rds.DescribeDBClusterSnapshotsInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.DescribeDBClusterSnapshots(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.StopDBInstance(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) StartDBInstance(ctx context.Context, params *rds.StartDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.StartDBInstance(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) StopDBCluster(ctx context.Context, params *rds.StopDBClusterInput, optFns ...func(*rds.Options)) (*rds.StopDBClusterOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.StopDBCluster(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.StartDBCluster(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) ListTagsForResource(ctx context.Context, params *rds.ListTagsForResourceInput, optFns ...func(*rds.Options)) (*rds.ListTagsForResourceOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ListTagsForResource(ctx, params, optFns...)
+}
+
+func (c *rateLimitedClient) ModifyDBCluster(ctx context.Context, params *rds.ModifyDBClusterInput, optFns ...func(*rds.Options)) (*rds.ModifyDBClusterOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ModifyDBCluster(ctx, params, optFns...)
+}
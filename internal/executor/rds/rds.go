@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/go-logr/logr"
 	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/ardikabs/hibernator/internal/executor"
 	"github.com/ardikabs/hibernator/pkg/awsutil"
@@ -54,7 +55,8 @@ type DBClusterState struct {
 	ClusterId  string           `json:"clusterId"`
 	WasRunning bool             `json:"wasRunning"` // true if running when hibernator saw it (restore on wakeup), false if already stopped
 	SnapshotId string           `json:"snapshotId,omitempty"`
-	Outcome    operationOutcome `json:"-"` // Result of the operation (not persisted)
+	Capacity   *CapacityRange   `json:"capacity,omitempty"` // Serverless v2 capacity range before it was scaled down, set instead of stopping the cluster
+	Outcome    operationOutcome `json:"-"`                  // Result of the operation (not persisted)
 }
 
 // WasResourceRunning returns whether the cluster was running
@@ -70,32 +72,62 @@ const (
 	operationOutcomeApplied      operationOutcome = "applied" // Operation was successfully applied
 	operationOutcomeSkippedStale operationOutcome = "skipped" // Resource was in stale state, operation skipped
 	operationOutcomePending      operationOutcome = "pending" // Resource needs async processing
+
+	operationOutcomeSkippedProtected operationOutcome = "protected" // Resource is tagged protected, operation skipped
 )
 
 type operationStats struct {
 	processed    int
 	applied      int
+	scaled       int
 	skippedStale int
+	protected    int
 	skippedKey   int
 	pending      int
 	failed       int
+	resumed      int
 }
 
 func formatShutdownMessage(stats *operationStats) string {
 	msg := fmt.Sprintf("stopped %d RDS resource(s)", stats.applied)
+	msg = appendCountSegment(msg, "scaled down", stats.scaled, "Serverless v2 cluster")
 	msg = appendCountSegment(msg, "skipped", stats.skippedStale, "stale resource")
+	msg = appendCountSegment(msg, "skipped", stats.protected, "protected resource")
+	msg = appendCountSegment(msg, "skipped", stats.resumed, "resource stopped by an earlier attempt")
 	msg = appendCountSegment(msg, "pending", stats.pending, "resource awaiting state transition")
 	return msg
 }
 
+func formatReassertMessage(stats *operationStats) string {
+	msg := fmt.Sprintf("stopped %d RDS resource(s) again", stats.applied)
+	msg = appendCountSegment(msg, "skipped", stats.skippedStale, "stopped resource")
+	msg = appendCountSegment(msg, "skipped", stats.protected, "protected resource")
+	msg = appendCountSegment(msg, "skipped", stats.skippedKey, "unrecognized restore key")
+	return msg
+}
+
 func formatWakeUpMessage(stats *operationStats) string {
 	msg := fmt.Sprintf("started %d RDS resource(s)", stats.applied)
+	msg = appendCountSegment(msg, "restored capacity of", stats.scaled, "Serverless v2 cluster")
 	msg = appendCountSegment(msg, "skipped", stats.skippedStale, "stale resource")
 	msg = appendCountSegment(msg, "skipped", stats.skippedKey, "unrecognized restore key")
+	msg = appendCountSegment(msg, "skipped", stats.resumed, "resource started by an earlier attempt")
 	msg = appendCountSegment(msg, "pending", stats.pending, "resource awaiting state transition")
 	return msg
 }
 
+// reportProgress reports the resources handled so far, up to key, through the
+// spec's progress callback.
+func reportProgress(spec executor.Spec, stats *operationStats, action, key string) {
+	handled := stats.applied + stats.scaled + stats.skippedStale + stats.protected + stats.skippedKey + stats.pending + stats.failed + stats.resumed
+	spec.ReportProgress(executor.ResourceProgress{
+		Completed: handled,
+		Total:     stats.processed,
+		Resource:  key,
+		Message:   fmt.Sprintf("%s %d/%d RDS resource(s)", action, handled, stats.processed),
+	})
+}
+
 func appendCountSegment(msg, action string, count int, noun string) string {
 	if count <= 0 {
 		return msg
@@ -113,6 +145,11 @@ type Executor struct {
 	completionWg    sync.WaitGroup
 }
 
+var (
+	_ executor.Previewer  = (*Executor)(nil)
+	_ executor.Reasserter = (*Executor)(nil)
+)
+
 // RDSClientFactory is a function type for creating RDS clients.
 type RDSClientFactory func(cfg aws.Config) RDSClient
 
@@ -170,17 +207,19 @@ func (e *Executor) Validate(spec executor.Spec) error {
 	}
 
 	hasTagSelector := params.Selector.TagSelector != nil && (len(params.Selector.TagSelector.MatchTags) > 0 || len(params.Selector.TagSelector.MatchExpressions) > 0)
+	hasNamePattern := params.Selector.NamePattern != ""
 
 	// Validate selector - at least one selection method required
 	hasSelection := len(params.Selector.Tags) > 0 ||
 		len(params.Selector.ExcludeTags) > 0 ||
 		hasTagSelector ||
+		hasNamePattern ||
 		len(params.Selector.InstanceIds) > 0 ||
 		len(params.Selector.ClusterIds) > 0 ||
 		params.Selector.IncludeAll
 
 	if !hasSelection {
-		return fmt.Errorf("selector must specify at least one of: tags, excludeTags, tagSelector, InstanceIds, ClusterIds, or includeAll")
+		return fmt.Errorf("selector must specify at least one of: tags, excludeTags, tagSelector, namePattern, InstanceIds, ClusterIds, or includeAll")
 	}
 
 	// Tags and ExcludeTags are mutually exclusive
@@ -195,9 +234,19 @@ func (e *Executor) Validate(spec executor.Spec) error {
 
 	// IncludeAll cannot be combined with other selection methods
 	if params.Selector.IncludeAll {
-		if len(params.Selector.Tags) > 0 || len(params.Selector.ExcludeTags) > 0 || hasTagSelector ||
+		if len(params.Selector.Tags) > 0 || len(params.Selector.ExcludeTags) > 0 || hasTagSelector || hasNamePattern ||
 			len(params.Selector.InstanceIds) > 0 || len(params.Selector.ClusterIds) > 0 {
-			return fmt.Errorf("selector.includeAll cannot be combined with tags, excludeTags, tagSelector, InstanceIds, or ClusterIds")
+			return fmt.Errorf("selector.includeAll cannot be combined with tags, excludeTags, tagSelector, namePattern, InstanceIds, or ClusterIds")
+		}
+	}
+
+	// NamePattern narrows dynamic discovery; explicit IDs are taken as given
+	if hasNamePattern {
+		if len(params.Selector.InstanceIds) > 0 || len(params.Selector.ClusterIds) > 0 {
+			return fmt.Errorf("selector.namePattern cannot be combined with InstanceIds or ClusterIds")
+		}
+		if errs := awsutil.ValidateNamePattern(params.Selector.NamePattern, field.NewPath("selector").Child("namePattern")); len(errs) > 0 {
+			return errs.ToAggregate()
 		}
 	}
 
@@ -224,22 +273,27 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		return nil, fmt.Errorf("load AWS config: %w", err)
 	}
 
-	client := e.rdsFactory(cfg)
+	client := newRateLimitedClient(e.rdsFactory(cfg), DefaultAPIRateLimit)
 	stats := new(operationStats)
 
-	// Determine which resource types to discover
-	discoverInstances, discoverClusters := e.determineResourceTypes(params)
+	// Discover instances and clusters before stopping any, so the resource
+	// limit applies to the whole target.
+	discovered, err := e.discover(ctx, log, client, params)
+	if err != nil {
+		return nil, err
+	}
 
-	// Process instances
-	if discoverInstances {
-		if err := e.processResources(ctx, log, client, params, spec.ReportStateCallback, ResourceTypeInstance, stats); err != nil {
-			return nil, err
-		}
+	var selected int
+	for _, resources := range discovered {
+		selected += len(resources.ids)
+	}
+	if err := executor.CheckResourceLimit(spec, selected, "RDS resource"); err != nil {
+		log.Error(err, "refusing to shut down")
+		return nil, err
 	}
 
-	// Process clusters
-	if discoverClusters {
-		if err := e.processResources(ctx, log, client, params, spec.ReportStateCallback, ResourceTypeCluster, stats); err != nil {
+	for _, resources := range discovered {
+		if err := e.processResources(ctx, log, client, params, spec, resources, stats); err != nil {
 			return nil, err
 		}
 	}
@@ -247,7 +301,7 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 	// Handle await completion
 	result := &executor.Result{}
 	if params.AwaitCompletion.Enabled {
-		result.Message = e.handleShutdownAwaitCompletion(ctx, log, client, params, stats, spec.ReportStateCallback)
+		result.Message = e.handleShutdownAwaitCompletion(ctx, log, client, params, stats, spec)
 	} else {
 		result.Message = formatShutdownMessage(stats)
 	}
@@ -255,7 +309,10 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 	log.Info("shutdown completed",
 		"processed", stats.processed,
 		"stopped", stats.applied,
+		"scaledDown", stats.scaled,
 		"skippedStale", stats.skippedStale,
+		"protected", stats.protected,
+		"resumed", stats.resumed,
 		"pending", stats.pending,
 		"failed", stats.failed,
 	)
@@ -263,6 +320,64 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 	return result, nil
 }
 
+// ValidateRestore checks that every instance and cluster entry parses as its
+// resource state. Entries with unknown key prefixes are skipped on wakeup and
+// are therefore not validated.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries(restore, func(key string, raw *json.RawMessage) error {
+		var resourceType ResourceType
+		switch {
+		case strings.HasPrefix(key, "instance:"):
+			resourceType = ResourceTypeInstance
+		case strings.HasPrefix(key, "cluster:"):
+			resourceType = ResourceTypeCluster
+		default:
+			return nil
+		}
+
+		strategy, ok := e.registry.Get(resourceType)
+		if !ok {
+			return fmt.Errorf("unknown resource type: %s", resourceType)
+		}
+		_, err := strategy.ParseState(*raw)
+		return err
+	})
+}
+
+// Preview discovers the instances and clusters the selector matches, as
+// Shutdown does, without stopping them. Explicit IDs are returned as given;
+// Shutdown skips those that no longer exist.
+func (e *Executor) Preview(ctx context.Context, log logr.Logger, spec executor.Spec) ([]string, error) {
+	log = log.WithName("rds").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
+	log.Info("executor starting preview")
+
+	params, err := e.parseParams(spec.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("parse parameters: %w", err)
+	}
+
+	cfg, err := e.loadAWSConfig(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config: %w", err)
+	}
+
+	client := newRateLimitedClient(e.rdsFactory(cfg), DefaultAPIRateLimit)
+
+	discovered, err := e.discover(ctx, log, client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, resources := range discovered {
+		for _, id := range resources.ids {
+			keys = append(keys, resources.strategy.GetResourceKey(id))
+		}
+	}
+
+	return keys, nil
+}
+
 // WakeUp starts RDS instances/clusters.
 func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
 	log = log.WithName("rds").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
@@ -287,20 +402,27 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 		return nil, fmt.Errorf("load AWS config: %w", err)
 	}
 
-	client := e.rdsFactory(cfg)
+	client := newRateLimitedClient(e.rdsFactory(cfg), DefaultAPIRateLimit)
 	stats := &operationStats{processed: len(restore.Data)}
 
 	// Process each resource in restore data
 	for key, stateBytes := range restore.Data {
-		if err := e.restoreResource(ctx, log, client, params, key, stateBytes, stats); err != nil {
+		if spec.Checkpointed(key) {
+			stats.resumed++
+			log.Info("resource started by an earlier attempt, skipping", "key", key)
+			reportProgress(spec, stats, "started", key)
+			continue
+		}
+		if err := e.restoreResource(ctx, log, client, params, spec, key, stateBytes, stats); err != nil {
 			return nil, err
 		}
+		reportProgress(spec, stats, "started", key)
 	}
 
 	// Handle await completion
 	result := &executor.Result{}
 	if params.AwaitCompletion.Enabled {
-		result.Message = e.handleWakeupAwaitCompletion(ctx, log, client, params, stats)
+		result.Message = e.handleWakeupAwaitCompletion(ctx, log, client, params, stats, spec)
 	} else {
 		result.Message = formatWakeUpMessage(stats)
 	}
@@ -308,8 +430,10 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 	log.Info("wakeup completed",
 		"processed", stats.processed,
 		"started", stats.applied,
+		"capacityRestored", stats.scaled,
 		"skippedStale", stats.skippedStale,
 		"skippedUnknownKey", stats.skippedKey,
+		"resumed", stats.resumed,
 		"pending", stats.pending,
 		"failed", stats.failed,
 	)
@@ -317,6 +441,100 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 	return result, nil
 }
 
+// Reassert stops again the instances and clusters hibernation stopped that are
+// available anew, e.g. because AWS started them after seven days stopped.
+// Resources that were already stopped before hibernation, scaled-down
+// Serverless v2 clusters and resources in a transitional state are left as
+// they are; the next reassertion picks up the latter. No snapshot is taken and
+// no state is reported, so the restore data stays as hibernation captured it.
+func (e *Executor) Reassert(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
+	log = log.WithName("rds").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
+	log.Info("executor starting reassertion")
+
+	if len(restore.Data) == 0 {
+		return &executor.Result{Message: "reassertion completed for RDS (no restore data)"}, nil
+	}
+
+	params, err := e.parseParams(spec.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("parse parameters: %w", err)
+	}
+	// Resources are stopped outright: capacity scaling only applies to the
+	// clusters hibernation scaled, which are skipped, and resources still in
+	// transition are left to the next reassertion instead of awaited.
+	params.ClusterMode = executorparams.RDSClusterModeStop
+	params.AwaitCompletion.Enabled = false
+
+	cfg, err := e.loadAWSConfig(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config: %w", err)
+	}
+
+	client := newRateLimitedClient(e.rdsFactory(cfg), DefaultAPIRateLimit)
+	stats := &operationStats{processed: len(restore.Data)}
+
+	for key, stateBytes := range restore.Data {
+		resourceType, id, ok := parseResourceKey(key)
+		if !ok {
+			stats.skippedKey++
+			log.Info("unknown resource type in restore data, skipping", "key", key)
+			continue
+		}
+
+		strategy, ok := e.registry.Get(resourceType)
+		if !ok {
+			return nil, fmt.Errorf("unknown resource type: %s", resourceType)
+		}
+		persistedState, err := strategy.ParseState(stateBytes)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal %s state %s: %w", resourceType, id, err)
+		}
+		if !persistedState.WasResourceRunning() {
+			stats.skippedStale++
+			continue
+		}
+		if cluster, ok := persistedState.(DBClusterState); ok && cluster.Capacity != nil {
+			stats.skippedStale++
+			continue
+		}
+
+		resultState, err := strategy.Stop(ctx, log, client, id, false, params, nil)
+		if err != nil {
+			return nil, fmt.Errorf("stop %s %s: %w", resourceType, id, err)
+		}
+		switch {
+		case resultState.GetOutcome() == operationOutcomeSkippedProtected:
+			stats.protected++
+		case resultState.GetOutcome() == operationOutcomeApplied && resultState.WasResourceRunning():
+			stats.applied++
+			log.Info("resource came back up, stopped it again", "resourceType", resourceType, "id", id)
+		default:
+			stats.skippedStale++
+		}
+	}
+
+	log.Info("reassertion completed",
+		"processed", stats.processed,
+		"stopped", stats.applied,
+		"skipped", stats.skippedStale,
+		"protected", stats.protected,
+		"skippedUnknownKey", stats.skippedKey,
+	)
+
+	return &executor.Result{Message: formatReassertMessage(stats)}, nil
+}
+
+// parseResourceKey splits a restore data key into its resource type and ID.
+func parseResourceKey(key string) (ResourceType, string, bool) {
+	if id, ok := strings.CutPrefix(key, "instance:"); ok {
+		return ResourceTypeInstance, id, true
+	}
+	if id, ok := strings.CutPrefix(key, "cluster:"); ok {
+		return ResourceTypeCluster, id, true
+	}
+	return "", "", false
+}
+
 // determineResourceTypes determines which resource types to discover based on params
 func (e *Executor) determineResourceTypes(params Parameters) (instances, clusters bool) {
 	// For intent-based selection (explicit IDs), resource types are implicit
@@ -327,29 +545,65 @@ func (e *Executor) determineResourceTypes(params Parameters) (instances, cluster
 	return params.Selector.DiscoverInstances, params.Selector.DiscoverClusters
 }
 
-// processResources discovers and stops resources of the given type
-func (e *Executor) processResources(ctx context.Context, log logr.Logger, client RDSClient, params Parameters, callback executor.ReportStateCallback, resourceType ResourceType, stats *operationStats) error {
-	strategy, ok := e.registry.Get(resourceType)
-	if !ok {
-		return fmt.Errorf("unknown resource type: %s", resourceType)
+// discoveredResources are the resources of one type the selector matched.
+type discoveredResources struct {
+	resourceType ResourceType
+	strategy     ResourceStrategy
+	ids          []string
+}
+
+// discover discovers the instances and clusters the selector matches, in
+// that order.
+func (e *Executor) discover(ctx context.Context, log logr.Logger, client RDSClient, params Parameters) ([]discoveredResources, error) {
+	var resourceTypes []ResourceType
+	discoverInstances, discoverClusters := e.determineResourceTypes(params)
+	if discoverInstances {
+		resourceTypes = append(resourceTypes, ResourceTypeInstance)
+	}
+	if discoverClusters {
+		resourceTypes = append(resourceTypes, ResourceTypeCluster)
 	}
 
-	// Discover resources
-	log.Info("discovering resources", "resourceType", resourceType)
-	ids, err := strategy.Discover(ctx, log, client, params.Selector)
-	if err != nil {
-		return fmt.Errorf("discover %s: %w", resourceType, err)
+	discovered := make([]discoveredResources, 0, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		strategy, ok := e.registry.Get(resourceType)
+		if !ok {
+			return nil, fmt.Errorf("unknown resource type: %s", resourceType)
+		}
+
+		log.Info("discovering resources", "resourceType", resourceType)
+		ids, err := strategy.Discover(ctx, log, client, params.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("discover %s: %w", resourceType, err)
+		}
+		log.Info("resources discovered", "resourceType", resourceType, "count", len(ids))
+
+		discovered = append(discovered, discoveredResources{resourceType: resourceType, strategy: strategy, ids: ids})
 	}
-	log.Info("resources discovered", "resourceType", resourceType, "count", len(ids))
+	return discovered, nil
+}
+
+// processResources stops the discovered resources of one type, skipping
+// those an earlier attempt of the execution stopped.
+func (e *Executor) processResources(ctx context.Context, log logr.Logger, client RDSClient, params Parameters, spec executor.Spec, resources discoveredResources, stats *operationStats) error {
+	resourceType, strategy, ids := resources.resourceType, resources.strategy, resources.ids
 	stats.processed += len(ids)
 
 	// Process each resource
 	tracker := e.trackers[resourceType]
 	for _, id := range ids {
+		key := strategy.GetResourceKey(id)
+		if spec.Checkpointed(key) {
+			stats.resumed++
+			log.Info("resource stopped by an earlier attempt, skipping", "resourceType", resourceType, "id", id)
+			reportProgress(spec, stats, "stopped", key)
+			continue
+		}
+
 		log.Info("processing resource", "resourceType", resourceType, "id", id)
 
 		// Execute stop operation and get the result state
-		resultState, err := strategy.Stop(ctx, log, client, id, params.SnapshotBeforeStop, params, callback)
+		resultState, err := strategy.Stop(ctx, log, client, id, params.SnapshotBeforeStop, params, spec.ReportStateCallback)
 		if err != nil {
 			log.Error(err, "failed to stop resource", "resourceType", resourceType, "id", id)
 			return fmt.Errorf("stop %s %s: %w", resourceType, id, err)
@@ -357,10 +611,21 @@ func (e *Executor) processResources(ctx context.Context, log logr.Logger, client
 
 		switch resultState.GetOutcome() {
 		case operationOutcomeApplied:
+			if capacityScaled(resultState) {
+				// A scaled-down cluster keeps running; there is no stop to await.
+				stats.scaled++
+				spec.MarkCheckpoint(key)
+				break
+			}
 			stats.applied++
 			tracker.AddToWaitingList(id)
+			spec.MarkCheckpoint(key)
 		case operationOutcomeSkippedStale:
 			stats.skippedStale++
+			spec.MarkCheckpoint(key)
+		case operationOutcomeSkippedProtected:
+			stats.protected++
+			spec.MarkCheckpoint(key)
 		case operationOutcomePending:
 			stats.pending++
 			tracker.AddToPendingList(id, params.SnapshotBeforeStop)
@@ -371,23 +636,16 @@ func (e *Executor) processResources(ctx context.Context, log logr.Logger, client
 				"resourceType", resourceType,
 				"id", id)
 		}
+		reportProgress(spec, stats, "stopped", key)
 	}
 
 	return nil
 }
 
 // restoreResource restores a single resource from restore data
-func (e *Executor) restoreResource(ctx context.Context, log logr.Logger, client RDSClient, params Parameters, key string, stateBytes json.RawMessage, stats *operationStats) error {
-	var resourceType ResourceType
-	var id string
-
-	if strings.HasPrefix(key, "instance:") {
-		resourceType = ResourceTypeInstance
-		id = strings.TrimPrefix(key, "instance:")
-	} else if strings.HasPrefix(key, "cluster:") {
-		resourceType = ResourceTypeCluster
-		id = strings.TrimPrefix(key, "cluster:")
-	} else {
+func (e *Executor) restoreResource(ctx context.Context, log logr.Logger, client RDSClient, params Parameters, spec executor.Spec, key string, stateBytes json.RawMessage, stats *operationStats) error {
+	resourceType, id, ok := parseResourceKey(key)
+	if !ok {
 		stats.skippedKey++
 		log.Info("unknown resource type in restore data, skipping", "key", key)
 		return nil
@@ -407,11 +665,27 @@ func (e *Executor) restoreResource(ctx context.Context, log logr.Logger, client
 	// Check if the resource was running before hibernation
 	if !persistedState.WasResourceRunning() {
 		stats.skippedStale++
+		spec.MarkCheckpoint(key)
 		log.Info("resource was already stopped before hibernation, skipping start",
 			"resourceType", resourceType, "id", id)
 		return nil
 	}
 
+	// A cluster scaled down instead of stopped gets its capacity range back.
+	if cluster, ok := persistedState.(DBClusterState); ok && cluster.Capacity != nil {
+		resultState, err := restoreCapacity(ctx, log, client, id, *cluster.Capacity)
+		if err != nil {
+			return fmt.Errorf("restore %s %s: %w", resourceType, id, err)
+		}
+		if resultState.GetOutcome() == operationOutcomeApplied {
+			stats.scaled++
+		} else {
+			stats.skippedStale++
+		}
+		spec.MarkCheckpoint(key)
+		return nil
+	}
+
 	log.Info("starting resource", "resourceType", resourceType, "id", id)
 	// Execute start operation and get the result state
 	resultState, err := strategy.Start(ctx, log, client, id, params)
@@ -424,9 +698,11 @@ func (e *Executor) restoreResource(ctx context.Context, log logr.Logger, client
 	case operationOutcomeApplied:
 		stats.applied++
 		tracker.AddToWaitingList(id)
+		spec.MarkCheckpoint(key)
 		log.Info("resource started successfully", "resourceType", resourceType, "id", id)
 	case operationOutcomeSkippedStale:
 		stats.skippedStale++
+		spec.MarkCheckpoint(key)
 	case operationOutcomePending:
 		stats.pending++
 		tracker.AddToPendingList(id, false)
@@ -445,7 +721,7 @@ func (e *Executor) restoreResource(ctx context.Context, log logr.Logger, client
 // All resources (pending and waiting) share the same timeout window concurrently.
 // For pending resources: wait for available → stop → wait for stopped (all in one goroutine)
 // For waiting resources: just wait for stopped
-func (e *Executor) handleShutdownAwaitCompletion(ctx context.Context, log logr.Logger, client RDSClient, params Parameters, stats *operationStats, callback executor.ReportStateCallback) string {
+func (e *Executor) handleShutdownAwaitCompletion(ctx context.Context, log logr.Logger, client RDSClient, params Parameters, stats *operationStats, spec executor.Spec) string {
 	timeout := params.AwaitCompletion.Timeout
 	if timeout == "" {
 		timeout = DefaultWaitTimeout
@@ -464,6 +740,7 @@ func (e *Executor) handleShutdownAwaitCompletion(ctx context.Context, log logr.L
 	var (
 		timedOut       atomic.Int32
 		pendingApplied atomic.Int32
+		pendingScaled  atomic.Int32
 		failures       executor.ErrorList
 	)
 
@@ -485,15 +762,22 @@ func (e *Executor) handleShutdownAwaitCompletion(ctx context.Context, log logr.L
 				}
 
 				// Stop the resource
-				stopState, err := s.Stop(deadlineCtx, log, client, p.id, p.snapshotBefore, params, callback)
+				stopState, err := s.Stop(deadlineCtx, log, client, p.id, p.snapshotBefore, params, spec.ReportStateCallback)
 				if err != nil {
 					failures.Addf("%s %s: %w", rt, p.id, err)
 					log.Error(err, "failed to stop pending resource", "resourceType", rt, "id", p.id)
 					return
 				}
 
+				if stopState.GetOutcome() == operationOutcomeApplied && capacityScaled(stopState) {
+					pendingScaled.Add(1)
+					spec.MarkCheckpoint(s.GetResourceKey(p.id))
+					return
+				}
+
 				if stopState.GetOutcome() == operationOutcomeApplied {
 					pendingApplied.Add(1)
+					spec.MarkCheckpoint(s.GetResourceKey(p.id))
 					// Continue to wait for the resource to reach stopped state
 					if err := s.WaitForStopped(deadlineCtx, log, client, p.id, timeout); err != nil {
 						timedOut.Add(1)
@@ -529,6 +813,10 @@ func (e *Executor) handleShutdownAwaitCompletion(ctx context.Context, log logr.L
 		stats.applied += pendingCount
 		stats.pending -= pendingCount
 	}
+	if scaledCount := int(pendingScaled.Load()); scaledCount > 0 {
+		stats.scaled += scaledCount
+		stats.pending -= scaledCount
+	}
 
 	failedCount := failures.Len()
 	if failedCount > 0 {
@@ -561,7 +849,7 @@ func (e *Executor) handleShutdownAwaitCompletion(ctx context.Context, log logr.L
 // All resources (pending and waiting) share the same timeout window concurrently.
 // For pending resources: wait for stopped → start → wait for available (all in one goroutine)
 // For waiting resources: just wait for available
-func (e *Executor) handleWakeupAwaitCompletion(ctx context.Context, log logr.Logger, client RDSClient, params Parameters, stats *operationStats) string {
+func (e *Executor) handleWakeupAwaitCompletion(ctx context.Context, log logr.Logger, client RDSClient, params Parameters, stats *operationStats, spec executor.Spec) string {
 	timeout := params.AwaitCompletion.Timeout
 	if timeout == "" {
 		timeout = DefaultWaitTimeout
@@ -611,6 +899,7 @@ func (e *Executor) handleWakeupAwaitCompletion(ctx context.Context, log logr.Log
 
 				if startState.GetOutcome() == operationOutcomeApplied {
 					pendingApplied.Add(1)
+					spec.MarkCheckpoint(s.GetResourceKey(p.id))
 					// Continue to wait for the resource to reach available state
 					if err := s.WaitForAvailable(deadlineCtx, log, client, p.id, timeout); err != nil {
 						timedOut.Add(1)
@@ -131,6 +131,86 @@ func TestShutdown_StopInstance(t *testing.T) {
 	mockRDS.AssertExpectations(t)
 }
 
+func TestShutdown_SkipsProtectedResources(t *testing.T) {
+	ctx := context.Background()
+	mockRDS := mocks.NewRDSClient(t)
+	mockSTS := &mocks.STSClient{}
+
+	protected := []types.Tag{{Key: aws.String("hibernator.ardikabs.com/protected"), Value: aws.String("true")}}
+	mockRDS.On("DescribeDBInstances", mock.Anything, mock.Anything).Return(&rds.DescribeDBInstancesOutput{
+		DBInstances: []types.DBInstance{
+			{
+				DBInstanceIdentifier: aws.String("db-instance-1"),
+				DBInstanceStatus:     aws.String("available"),
+				TagList:              protected,
+			},
+		},
+	}, nil)
+	mockRDS.On("DescribeDBClusters", mock.Anything, mock.Anything).Return(&rds.DescribeDBClustersOutput{
+		DBClusters: []types.DBCluster{
+			{
+				DBClusterIdentifier: aws.String("cluster-1"),
+				Status:              aws.String("available"),
+				TagList:             protected,
+			},
+		},
+	}, nil)
+
+	e := NewWithClients(
+		func(cfg aws.Config) RDSClient { return mockRDS },
+		func(cfg aws.Config) STSClient { return mockSTS },
+		nil,
+	)
+
+	var reported []string
+	spec := executor.Spec{
+		TargetName: "test-db",
+		TargetType: "rds",
+		Parameters: json.RawMessage(`{"selector": {"instanceIds": ["db-instance-1"], "clusterIds": ["cluster-1"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			reported = append(reported, key)
+			return nil
+		},
+	}
+
+	// Neither StopDBInstance nor StopDBCluster is expected.
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, "stopped 0 RDS resource(s), skipped 2 protected resource(s)", result.Message)
+	assert.Empty(t, reported)
+}
+
+func TestShutdown_RefusesMoreResourcesThanLimit(t *testing.T) {
+	// No API call is expected: explicit IDs are discovered as given, and
+	// nothing is stopped once they exceed the limit.
+	mockRDS := mocks.NewRDSClient(t)
+	mockSTS := &mocks.STSClient{}
+
+	e := NewWithClients(
+		func(cfg aws.Config) RDSClient { return mockRDS },
+		func(cfg aws.Config) STSClient { return mockSTS },
+		nil,
+	)
+
+	spec := executor.Spec{
+		TargetName: "test-db",
+		TargetType: "rds",
+		Parameters: json.RawMessage(`{"selector": {"instanceIds": ["db-1", "db-2"], "clusterIds": ["cluster-1"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		MaxResources: 2,
+	}
+
+	_, err := e.Shutdown(context.Background(), logr.Discard(), spec)
+	assert.ErrorIs(t, err, executor.ErrResourceLimitExceeded)
+	assert.Equal(t, executor.FailureResourceLimitExceeded, executor.ReasonOf(err))
+	assert.ErrorContains(t, err, "target selected 3 RDS resource(s), more than the 2 allowed")
+}
+
 func TestShutdown_StopInstanceAlreadyStopped(t *testing.T) {
 	ctx := context.Background()
 	mockRDS := &mocks.RDSClient{}
@@ -286,6 +366,188 @@ func TestWakeUp_StartInstance(t *testing.T) {
 	mockRDS.AssertExpectations(t)
 }
 
+func TestReassert_StopsResourcesThatCameBackUp(t *testing.T) {
+	ctx := context.Background()
+	mockRDS := mocks.NewRDSClient(t)
+	mockSTS := &mocks.STSClient{}
+
+	describe := func(id, status string) {
+		mockRDS.On("DescribeDBInstances", mock.Anything, mock.MatchedBy(func(in *rds.DescribeDBInstancesInput) bool {
+			return aws.ToString(in.DBInstanceIdentifier) == id
+		})).Return(&rds.DescribeDBInstancesOutput{
+			DBInstances: []types.DBInstance{{DBInstanceIdentifier: aws.String(id), DBInstanceStatus: aws.String(status)}},
+		}, nil)
+	}
+	describe("restarted", "available")
+	describe("still-stopped", "stopped")
+	mockRDS.On("StopDBInstance", mock.Anything, mock.MatchedBy(func(in *rds.StopDBInstanceInput) bool {
+		return aws.ToString(in.DBInstanceIdentifier) == "restarted"
+	})).Return(&rds.StopDBInstanceOutput{}, nil).Once()
+
+	e := NewWithClients(
+		func(cfg aws.Config) RDSClient { return mockRDS },
+		func(cfg aws.Config) STSClient { return mockSTS },
+		nil,
+	)
+
+	restarted, _ := json.Marshal(DBInstanceState{InstanceId: "restarted", WasRunning: true})
+	stillStopped, _ := json.Marshal(DBInstanceState{InstanceId: "still-stopped", WasRunning: true})
+	stoppedBefore, _ := json.Marshal(DBInstanceState{InstanceId: "stopped-before", WasRunning: false})
+
+	spec := executor.Spec{
+		TargetName: "test-db",
+		TargetType: "rds",
+		Parameters: json.RawMessage(`{"selector": {"instanceIds": ["restarted", "still-stopped", "stopped-before"]}, "snapshotBeforeStop": true}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			t.Errorf("reassertion reported state for %s", key)
+			return nil
+		},
+	}
+
+	// No snapshot is taken, and the instance stopped before hibernation is
+	// not even described.
+	result, err := e.Reassert(ctx, logr.Discard(), spec, executor.RestoreData{
+		Data: map[string]json.RawMessage{
+			"instance:restarted":      restarted,
+			"instance:still-stopped":  stillStopped,
+			"instance:stopped-before": stoppedBefore,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "stopped 1 RDS resource(s) again, skipped 2 stopped resource(s)", result.Message)
+}
+
+// memoryCheckpoint is an in-memory executor.Checkpoint.
+type memoryCheckpoint struct {
+	done   map[string]bool
+	marked []string
+}
+
+func (c *memoryCheckpoint) Done(key string) bool { return c.done[key] }
+func (c *memoryCheckpoint) Mark(key string)      { c.marked = append(c.marked, key) }
+
+func TestShutdown_SkipsCheckpointedInstances(t *testing.T) {
+	ctx := context.Background()
+	mockRDS := &mocks.RDSClient{}
+	mockSTS := &mocks.STSClient{}
+
+	// Only the instance not stopped by the earlier attempt is touched.
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String("db-instance-2")}).Return(&rds.DescribeDBInstancesOutput{
+		DBInstances: []types.DBInstance{
+			{
+				DBInstanceIdentifier: aws.String("db-instance-2"),
+				DBInstanceStatus:     aws.String("available"),
+				DBInstanceClass:      aws.String("db.t3.medium"),
+			},
+		},
+	}, nil)
+	mockRDS.On("StopDBInstance", mock.Anything, mock.Anything).Return(&rds.StopDBInstanceOutput{}, nil).Once()
+
+	e := NewWithClients(
+		func(cfg aws.Config) RDSClient { return mockRDS },
+		func(cfg aws.Config) STSClient { return mockSTS },
+		nil,
+	)
+
+	checkpoint := &memoryCheckpoint{done: map[string]bool{"instance:db-instance-1": true}}
+	spec := executor.Spec{
+		TargetName: "test-db",
+		TargetType: "rds",
+		Parameters: json.RawMessage(`{"selector": {"InstanceIds": ["db-instance-1", "db-instance-2"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		Checkpoint: checkpoint,
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"instance:db-instance-2"}, checkpoint.marked)
+	assert.Equal(t, "stopped 1 RDS resource(s), skipped 1 resource stopped by an earlier attempt(s)", result.Message)
+
+	mockRDS.AssertExpectations(t)
+}
+
+func TestShutdown_ReportsResourceProgress(t *testing.T) {
+	ctx := context.Background()
+	mockRDS := &mocks.RDSClient{}
+	mockSTS := &mocks.STSClient{}
+
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String("db-instance-2")}).Return(&rds.DescribeDBInstancesOutput{
+		DBInstances: []types.DBInstance{
+			{
+				DBInstanceIdentifier: aws.String("db-instance-2"),
+				DBInstanceStatus:     aws.String("available"),
+				DBInstanceClass:      aws.String("db.t3.medium"),
+			},
+		},
+	}, nil)
+	mockRDS.On("StopDBInstance", mock.Anything, mock.Anything).Return(&rds.StopDBInstanceOutput{}, nil).Once()
+
+	e := NewWithClients(
+		func(cfg aws.Config) RDSClient { return mockRDS },
+		func(cfg aws.Config) STSClient { return mockSTS },
+		nil,
+	)
+
+	var progress []executor.ResourceProgress
+	spec := executor.Spec{
+		TargetName: "test-db",
+		TargetType: "rds",
+		Parameters: json.RawMessage(`{"selector": {"InstanceIds": ["db-instance-1", "db-instance-2"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		Checkpoint:             &memoryCheckpoint{done: map[string]bool{"instance:db-instance-1": true}},
+		ReportProgressCallback: func(p executor.ResourceProgress) { progress = append(progress, p) },
+	}
+
+	_, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, []executor.ResourceProgress{
+		{Completed: 1, Total: 2, Resource: "instance:db-instance-1", Message: "stopped 1/2 RDS resource(s)"},
+		{Completed: 2, Total: 2, Resource: "instance:db-instance-2", Message: "stopped 2/2 RDS resource(s)"},
+	}, progress)
+
+	mockRDS.AssertExpectations(t)
+}
+
+func TestWakeUp_SkipsCheckpointedInstances(t *testing.T) {
+	ctx := context.Background()
+	mockRDS := &mocks.RDSClient{}
+	mockSTS := &mocks.STSClient{}
+
+	e := NewWithClients(
+		func(cfg aws.Config) RDSClient { return mockRDS },
+		func(cfg aws.Config) STSClient { return mockSTS },
+		nil,
+	)
+
+	instanceState, _ := json.Marshal(DBInstanceState{InstanceId: "db-instance-1", WasRunning: true})
+	checkpoint := &memoryCheckpoint{done: map[string]bool{"instance:db-instance-1": true}}
+	spec := executor.Spec{
+		TargetName: "test-db",
+		TargetType: "rds",
+		Parameters: json.RawMessage(`{"selector": {"InstanceIds": ["db-instance-1"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			AWS: &executor.AWSConnectorConfig{Region: "us-east-1"},
+		},
+		Checkpoint: checkpoint,
+	}
+
+	result, err := e.WakeUp(ctx, logr.Discard(), spec, executor.RestoreData{
+		Data: map[string]json.RawMessage{"instance:db-instance-1": instanceState},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, checkpoint.marked)
+	assert.Equal(t, "started 0 RDS resource(s), skipped 1 resource started by an earlier attempt(s)", result.Message)
+
+	mockRDS.AssertNotCalled(t, "StartDBInstance", mock.Anything, mock.Anything)
+}
+
 func TestWakeUp_InstanceAlreadyRunning(t *testing.T) {
 	ctx := context.Background()
 	mockRDS := &mocks.RDSClient{}
@@ -430,7 +692,7 @@ func TestShutdown_DynamicDiscovery_TagsInstances(t *testing.T) {
 
 	// Mock for dynamic discovery - instances only (discoverInstances: true)
 	// First call: list all instances
-	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}).Return(&rds.DescribeDBInstancesOutput{
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).Return(&rds.DescribeDBInstancesOutput{
 		DBInstances: []types.DBInstance{
 			{
 				DBInstanceIdentifier: aws.String("tagged-instance-1"),
@@ -493,7 +755,7 @@ func TestShutdown_DynamicDiscovery_TagsClusters(t *testing.T) {
 
 	// Mock for dynamic discovery - clusters only (discoverClusters: true)
 	// First call: list all clusters
-	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}).Return(&rds.DescribeDBClustersOutput{
+	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}, mock.Anything).Return(&rds.DescribeDBClustersOutput{
 		DBClusters: []types.DBCluster{
 			{
 				DBClusterIdentifier: aws.String("tagged-cluster-1"),
@@ -554,7 +816,7 @@ func TestShutdown_DynamicDiscovery_ExcludeTags(t *testing.T) {
 
 	// Mock for dynamic discovery with excludeTags - both resource types
 	// First: list all instances
-	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}).Return(&rds.DescribeDBInstancesOutput{
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).Return(&rds.DescribeDBInstancesOutput{
 		DBInstances: []types.DBInstance{
 			{
 				DBInstanceIdentifier: aws.String("instance-1"),
@@ -571,7 +833,7 @@ func TestShutdown_DynamicDiscovery_ExcludeTags(t *testing.T) {
 		},
 	}, nil)
 	// First: list all clusters
-	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}).Return(&rds.DescribeDBClustersOutput{
+	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}, mock.Anything).Return(&rds.DescribeDBClustersOutput{
 		DBClusters: []types.DBCluster{
 			{
 				DBClusterIdentifier: aws.String("cluster-1"),
@@ -664,7 +926,7 @@ func TestShutdown_DynamicDiscovery_IncludeAll(t *testing.T) {
 
 	// Mock for includeAll - both resource types
 	// First: list all instances
-	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}).Return(&rds.DescribeDBInstancesOutput{
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).Return(&rds.DescribeDBInstancesOutput{
 		DBInstances: []types.DBInstance{
 			{
 				DBInstanceIdentifier: aws.String("all-instance-1"),
@@ -675,7 +937,7 @@ func TestShutdown_DynamicDiscovery_IncludeAll(t *testing.T) {
 		},
 	}, nil)
 	// First: list all clusters
-	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}).Return(&rds.DescribeDBClustersOutput{
+	mockRDS.On("DescribeDBClusters", mock.Anything, &rds.DescribeDBClustersInput{}, mock.Anything).Return(&rds.DescribeDBClustersOutput{
 		DBClusters: []types.DBCluster{
 			{
 				DBClusterIdentifier: aws.String("all-cluster-1"),
@@ -743,7 +1005,7 @@ func TestShutdown_DynamicDiscovery_TagKeyOnly(t *testing.T) {
 
 	// Mock for tag key-only matching (any value)
 	// First: list all instances
-	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}).Return(&rds.DescribeDBInstancesOutput{
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).Return(&rds.DescribeDBInstancesOutput{
 		DBInstances: []types.DBInstance{
 			{
 				DBInstanceIdentifier: aws.String("instance-with-env"),
@@ -820,7 +1082,7 @@ func TestShutdown_DynamicDiscovery_TagSelector_Matches(t *testing.T) {
 	mockSTS := &mocks.STSClient{}
 
 	// Mock for tag selector with Matches operator
-	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}).Return(&rds.DescribeDBInstancesOutput{
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).Return(&rds.DescribeDBInstancesOutput{
 		DBInstances: []types.DBInstance{
 			{
 				DBInstanceIdentifier: aws.String("app-prod-01"),
@@ -899,7 +1161,7 @@ func TestShutdown_DynamicDiscovery_TagSelector_Exists(t *testing.T) {
 	mockSTS := &mocks.STSClient{}
 
 	// Mock for tag selector with Exists operator
-	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}).Return(&rds.DescribeDBInstancesOutput{
+	mockRDS.On("DescribeDBInstances", mock.Anything, &rds.DescribeDBInstancesInput{}, mock.Anything).Return(&rds.DescribeDBInstancesOutput{
 		DBInstances: []types.DBInstance{
 			{
 				DBInstanceIdentifier: aws.String("instance-with-tag"),
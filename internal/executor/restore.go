@@ -0,0 +1,43 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// ErrInvalidRestoreData is returned by ValidateRestore when restore data does
+// not match the executor's schema.
+var ErrInvalidRestoreData = errors.New("invalid restore data")
+
+// ValidateRestoreEntries decodes every entry of restore into T and applies
+// check, when non-nil, to each decoded entry. All malformed entries are
+// reported in a single error wrapping ErrInvalidRestoreData.
+func ValidateRestoreEntries[T any](restore RestoreData, check func(key string, state *T) error) error {
+	var errs ErrorList
+	for _, key := range slices.Sorted(maps.Keys(restore.Data)) {
+		var state T
+		if err := json.Unmarshal(restore.Data[key], &state); err != nil {
+			errs.Addf("%s: %v", key, err)
+			continue
+		}
+		if check == nil {
+			continue
+		}
+		if err := check(key, &state); err != nil {
+			errs.Addf("%s: %v", key, err)
+		}
+	}
+
+	if errs.Len() > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidRestoreData, errs.Join("; "))
+	}
+	return nil
+}
@@ -139,16 +139,32 @@ type operationStats struct {
 	processed    int
 	applied      int
 	skippedStale int
+	protected    int
+	resumed      int
 }
 
 func formatShutdownMessage(stats operationStats, namespaceCount int) string {
 	msg := fmt.Sprintf("scaled %d workload(s) to zero across %d namespace(s)", stats.applied, namespaceCount)
-	return appendCountSegment(msg, "skipped", stats.skippedStale, "stale workload")
+	msg = appendCountSegment(msg, "skipped", stats.skippedStale, "stale workload")
+	msg = appendCountSegment(msg, "skipped", stats.protected, "protected workload")
+	return appendCountSegment(msg, "skipped", stats.resumed, "workload scaled down by an earlier attempt")
 }
 
 func formatWakeUpMessage(stats operationStats) string {
 	msg := fmt.Sprintf("restored %d workload(s)", stats.applied)
-	return appendCountSegment(msg, "skipped", stats.skippedStale, "stale workload")
+	msg = appendCountSegment(msg, "skipped", stats.skippedStale, "stale workload")
+	return appendCountSegment(msg, "skipped", stats.resumed, "workload restored by an earlier attempt")
+}
+
+// reportProgress reports the workloads handled so far, up to key, through the
+// spec's progress callback.
+func reportProgress(spec executor.Spec, action string, handled, total int, key string) {
+	spec.ReportProgress(executor.ResourceProgress{
+		Completed: handled,
+		Total:     total,
+		Resource:  key,
+		Message:   fmt.Sprintf("%s %d/%d workload(s)", action, handled, total),
+	})
 }
 
 func appendCountSegment(msg, action string, count int, noun string) string {
@@ -202,7 +218,12 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 
 	log.Info("target namespaces discovered", "count", len(targetNamespaces), "namespaces", strings.Join(targetNamespaces, ", "))
 
-	stats := operationStats{}
+	// List the workloads of every namespace before scaling any, so the
+	// resource limit applies to the whole target.
+	var (
+		batches  []workloadBatch
+		selected int
+	)
 	for _, ns := range targetNamespaces {
 		for _, kind := range includedGroups {
 			gvr, err := e.resolveGVR(kind)
@@ -210,14 +231,24 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 				return nil, fmt.Errorf("resolve GVR for %s: %w", kind, err)
 			}
 
-			counts, err := e.scaleDownWorkloads(ctx, log, client, ns, gvr, params.WorkloadSelector, params, spec.ReportStateCallback)
+			items, err := e.listWorkloads(ctx, log, client, ns, gvr, params.WorkloadSelector)
 			if err != nil {
 				return nil, fmt.Errorf("scale down %s in namespace %s: %w", kind, ns, err)
 			}
+			batches = append(batches, workloadBatch{kind: kind, namespace: ns, gvr: gvr, items: items})
+			selected += len(items)
+		}
+	}
 
-			stats.processed += counts.processed
-			stats.applied += counts.applied
-			stats.skippedStale += counts.skippedStale
+	if err := executor.CheckResourceLimit(spec, selected, "workload"); err != nil {
+		log.Error(err, "refusing to shut down")
+		return nil, err
+	}
+
+	stats := operationStats{}
+	for _, batch := range batches {
+		if err := e.scaleDownWorkloads(ctx, log, client, batch, params, spec, &stats); err != nil {
+			return nil, fmt.Errorf("scale down %s in namespace %s: %w", batch.kind, batch.namespace, err)
 		}
 	}
 
@@ -257,11 +288,30 @@ func (e *Executor) Shutdown(ctx context.Context, log logr.Logger, spec executor.
 		"processed", stats.processed,
 		"scaled", stats.applied,
 		"skippedStale", stats.skippedStale,
+		"protected", stats.protected,
+		"resumed", stats.resumed,
 	)
 
 	return &executor.Result{Message: msg}, nil
 }
 
+// ValidateRestore checks that every scaled-down workload entry identifies the
+// workload and its previous replica count.
+func (e *Executor) ValidateRestore(restore executor.RestoreData) error {
+	return executor.ValidateRestoreEntries(restore, func(_ string, state *WorkloadState) error {
+		if !state.WasScaled {
+			return nil
+		}
+		if state.Version == "" || state.Resource == "" || state.Name == "" {
+			return fmt.Errorf("missing workload reference (version=%q resource=%q name=%q)", state.Version, state.Resource, state.Name)
+		}
+		if state.Replicas <= 0 {
+			return fmt.Errorf("invalid replica count %d", state.Replicas)
+		}
+		return nil
+	})
+}
+
 // WakeUp restores all workloads to their previous replica counts.
 func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Spec, restore executor.RestoreData) (*executor.Result, error) {
 	log = log.WithName("workloadscaler").WithValues("target", spec.TargetName, "targetType", spec.TargetType)
@@ -292,7 +342,16 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 	stats := operationStats{processed: len(restore.Data)}
 
 	// Restore each workload
+	handled := 0
 	for workloadKey, stateBytes := range restore.Data {
+		handled++
+		if spec.Checkpointed(workloadKey) {
+			stats.resumed++
+			log.Info("workload restored by an earlier attempt, skipping", "workload", workloadKey)
+			reportProgress(spec, "restored", handled, stats.processed, workloadKey)
+			continue
+		}
+
 		var state WorkloadState
 		if err := json.Unmarshal(stateBytes, &state); err != nil {
 			log.Error(err, "failed to unmarshal workload state", "workload", workloadKey)
@@ -301,12 +360,14 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 
 		if !state.WasScaled {
 			stats.skippedStale++
+			spec.MarkCheckpoint(workloadKey)
 			log.Info("workload was already at zero replicas before hibernation, skipping restore",
 				"workload", workloadKey,
 				"namespace", state.Namespace,
 				"kind", state.Kind,
 				"name", state.Name,
 			)
+			reportProgress(spec, "restored", handled, stats.processed, workloadKey)
 			continue
 		}
 
@@ -321,6 +382,8 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 		case operationOutcomeSkippedStale:
 			stats.skippedStale++
 		}
+		spec.MarkCheckpoint(workloadKey)
+		reportProgress(spec, "restored", handled, stats.processed, workloadKey)
 	}
 
 	// Wait for all workloads to scale if configured
@@ -359,6 +422,7 @@ func (e *Executor) WakeUp(ctx context.Context, log logr.Logger, spec executor.Sp
 		"processed", stats.processed,
 		"restored", stats.applied,
 		"skippedStale", stats.skippedStale,
+		"resumed", stats.resumed,
 	)
 
 	return &executor.Result{Message: msg}, nil
@@ -389,133 +453,181 @@ func (e *Executor) discoverNamespaces(ctx context.Context, client Client, nsSele
 	return nil, fmt.Errorf("namespace selector must specify either literals or selector")
 }
 
-// scaleDownWorkloads scales down all matching workloads in a namespace and returns their states.
-// It returns the count of workloads that had non-zero replicas before scaling down, and any error encountered.
-func (e *Executor) scaleDownWorkloads(ctx context.Context,
+// workloadBatch holds the workloads of one kind listed in a namespace.
+type workloadBatch struct {
+	kind      string
+	namespace string
+	gvr       schema.GroupVersionResource
+	items     []unstructured.Unstructured
+}
+
+// listWorkloads lists the workloads of one kind in a namespace that match the
+// workload selector. A resource the cluster does not serve lists nothing.
+func (e *Executor) listWorkloads(ctx context.Context,
 	log logr.Logger,
 	client Client,
 	namespace string,
 	gvr schema.GroupVersionResource,
-	workloadSelector *metav1.LabelSelector,
-	params executorparams.WorkloadScalerParameters,
-	callback executor.ReportStateCallback) (operationStats, error) {
+	workloadSelector *metav1.LabelSelector) ([]unstructured.Unstructured, error) {
 
 	// Convert label selector to Kubernetes labels.Selector
 	selector, err := metav1.LabelSelectorAsSelector(workloadSelector)
 	if err != nil {
-		return operationStats{}, fmt.Errorf("invalid label selector: %w", err)
+		return nil, fmt.Errorf("invalid label selector: %w", err)
 	}
 
-	log.Info("scaling down workloads",
-		"namespace", namespace,
-		"resource", gvr.String(),
-		"selector", selector.String(),
-	)
-
-	// List all resources of this type in the namespace
 	list, err := client.ListWorkloads(ctx, gvr, namespace, selector.String())
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Info("no resources found, skipping", "namespace", namespace, "resource", gvr.Resource)
-			return operationStats{}, nil
+			return nil, nil
 		}
 
-		return operationStats{}, fmt.Errorf("list resources: %w", err)
+		return nil, fmt.Errorf("list resources: %w", err)
 	}
+	return list.Items, nil
+}
 
-	// Build unified map: key = namespace/kind/name
-	statesMap := make(map[string]json.RawMessage)
-	stats := operationStats{}
+// scaleDownWorkloads scales down the workloads of one kind in a namespace,
+// adding their counts to stats.
+func (e *Executor) scaleDownWorkloads(ctx context.Context,
+	log logr.Logger,
+	client Client,
+	batch workloadBatch,
+	params executorparams.WorkloadScalerParameters,
+	spec executor.Spec,
+	stats *operationStats) error {
 
-	for _, item := range list.Items {
-		stats.processed++
+	log.Info("scaling down workloads",
+		"namespace", batch.namespace,
+		"resource", batch.gvr.String(),
+		"count", len(batch.items),
+	)
 
-		// Get the scale subresource for this workload
-		scaleObj, err := client.GetScale(ctx, gvr, namespace, item.GetName())
-		if err != nil {
-			if apierrors.IsNotFound(err) {
-				stats.skippedStale++
-				log.Info("resource does not support the scale subresource, skipping", "name", item.GetName(), "namespace", namespace, "resource", gvr.String())
-			} else {
-				log.Info("failed to get scale subresource, skipping", "namespace", namespace, "name", item.GetName(), "kind", item.GetKind())
-			}
+	handled := stats.processed
+	stats.processed += len(batch.items)
 
-			continue
+	for _, item := range batch.items {
+		key := fmt.Sprintf("%s/%s/%s", item.GetNamespace(), item.GetKind(), item.GetName())
+		if err := e.scaleDownWorkload(ctx, log, client, batch.namespace, batch.gvr, item, key, params, spec, stats); err != nil {
+			return err
 		}
 
-		// Get current replica count from scale.spec.replicas
-		replicas, found, err := unstructured.NestedInt64(scaleObj.Object, "spec", "replicas")
-		if err != nil {
-			return operationStats{}, fmt.Errorf("get replicas from scale for %s/%s: %w", item.GetKind(), item.GetName(), err)
+		handled++
+		reportProgress(spec, "scaled down", handled, stats.processed, key)
+	}
+
+	return nil
+}
+
+// scaleDownWorkload scales a single workload, keyed namespace/kind/name, to
+// zero replicas and reports its restore data.
+func (e *Executor) scaleDownWorkload(ctx context.Context,
+	log logr.Logger,
+	client Client,
+	namespace string,
+	gvr schema.GroupVersionResource,
+	item unstructured.Unstructured,
+	key string,
+	params executorparams.WorkloadScalerParameters,
+	spec executor.Spec,
+	stats *operationStats) error {
+
+	if spec.Checkpointed(key) {
+		stats.resumed++
+		log.Info("workload scaled down by an earlier attempt, skipping", "workload", key)
+		return nil
+	}
+
+	if executor.IsProtected(item.GetLabels()) {
+		stats.protected++
+		log.Info("workload is protected, skipping scale down", "workload", key)
+		return nil
+	}
+
+	// Get the scale subresource for this workload
+	scaleObj, err := client.GetScale(ctx, gvr, namespace, item.GetName())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			stats.skippedStale++
+			log.Info("resource does not support the scale subresource, skipping", "name", item.GetName(), "namespace", namespace, "resource", gvr.String())
+		} else {
+			log.Info("failed to get scale subresource, skipping", "namespace", namespace, "name", item.GetName(), "kind", item.GetKind())
 		}
 
-		// Store current state with key = namespace/kind/name
-		key := fmt.Sprintf("%s/%s/%s", item.GetNamespace(), item.GetKind(), item.GetName())
-		state := WorkloadState{
-			Group:     gvr.Group,
-			Version:   gvr.Version,
-			Resource:  gvr.Resource,
-			Kind:      item.GetKind(),
-			Namespace: item.GetNamespace(),
-			Name:      item.GetName(),
-			Replicas:  int32(replicas),
-			WasScaled: found,
-		}
-		stateBytes, _ := json.Marshal(state)
-		statesMap[key] = stateBytes
-
-		// Scale to zero only if not already at zero
-		if found {
-			// Scale to zero by updating scale.spec.replicas
-			if err := unstructured.SetNestedField(scaleObj.Object, int64(0), "spec", "replicas"); err != nil {
-				return operationStats{}, fmt.Errorf("set replicas to zero in scale for %s/%s: %w", item.GetKind(), item.GetName(), err)
-			}
+		return nil
+	}
 
-			// Update the scale subresource
-			_, err = client.UpdateScale(ctx, gvr, namespace, scaleObj)
-			if err != nil {
-				if apierrors.IsNotFound(err) {
-					stats.skippedStale++
-					log.Info("resource not found, skipping", "namespace", namespace, "name", item.GetName(), "kind", item.GetKind())
+	// Get current replica count from scale.spec.replicas
+	replicas, found, err := unstructured.NestedInt64(scaleObj.Object, "spec", "replicas")
+	if err != nil {
+		return fmt.Errorf("get replicas from scale for %s/%s: %w", item.GetKind(), item.GetName(), err)
+	}
+
+	// Store current state with key = namespace/kind/name
+	state := WorkloadState{
+		Group:     gvr.Group,
+		Version:   gvr.Version,
+		Resource:  gvr.Resource,
+		Kind:      item.GetKind(),
+		Namespace: item.GetNamespace(),
+		Name:      item.GetName(),
+		Replicas:  int32(replicas),
+		WasScaled: found,
+	}
+
+	// Scale to zero only if not already at zero
+	if found {
+		// Scale to zero by updating scale.spec.replicas
+		if err := unstructured.SetNestedField(scaleObj.Object, int64(0), "spec", "replicas"); err != nil {
+			return fmt.Errorf("set replicas to zero in scale for %s/%s: %w", item.GetKind(), item.GetName(), err)
+		}
 
-					// Skip resources that no longer exist
-					continue
-				}
+		// Update the scale subresource
+		_, err = client.UpdateScale(ctx, gvr, namespace, scaleObj)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				stats.skippedStale++
+				log.Info("resource not found, skipping", "namespace", namespace, "name", item.GetName(), "kind", item.GetKind())
 
-				return operationStats{}, fmt.Errorf("update scale for %s/%s: %w", item.GetKind(), item.GetName(), err)
+				// Skip resources that no longer exist
+				return nil
 			}
 
-			stats.applied++
+			return fmt.Errorf("update scale for %s/%s: %w", item.GetKind(), item.GetName(), err)
+		}
 
-			// Add to waiting list if awaitCompletion is configured
-			if params.AwaitCompletion.Enabled {
-				e.waitinglist = append(e.waitinglist, state)
-			}
+		stats.applied++
 
-			log.Info("workload scaled to zero",
-				"namespace", namespace,
-				"name", item.GetName(),
-				"kind", item.GetKind(),
-				"previousReplicas", replicas,
-			)
-		} else {
-			log.Info("workload already at zero replicas, skipping scale down",
-				"namespace", namespace,
-				"name", item.GetName(),
-				"kind", item.GetKind(),
-			)
+		// Add to waiting list if awaitCompletion is configured
+		if params.AwaitCompletion.Enabled {
+			e.waitinglist = append(e.waitinglist, state)
 		}
 
-		// Incremental save: persist this workload's restore data immediately.
-		if callback != nil {
-			if err := callback(key, state); err != nil {
-				log.Error(err, "failed to save restore data incrementally", "workload", key)
-				// Continue processing - save at end as fallback
-			}
+		log.Info("workload scaled to zero",
+			"namespace", namespace,
+			"name", item.GetName(),
+			"kind", item.GetKind(),
+			"previousReplicas", replicas,
+		)
+	} else {
+		log.Info("workload already at zero replicas, skipping scale down",
+			"namespace", namespace,
+			"name", item.GetName(),
+			"kind", item.GetKind(),
+		)
+	}
+
+	// Incremental save: persist this workload's restore data immediately.
+	if spec.ReportStateCallback != nil {
+		if err := spec.ReportStateCallback(key, state); err != nil {
+			log.Error(err, "failed to save restore data incrementally", "workload", key)
+			// Continue processing - save at end as fallback
 		}
 	}
+	spec.MarkCheckpoint(key)
 
-	return stats, nil
+	return nil
 }
 
 // restoreWorkload restores a single workload to its previous replica count.
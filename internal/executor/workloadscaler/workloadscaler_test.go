@@ -109,6 +109,185 @@ func TestShutdown_ScalesMatchingWorkloads(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// memoryCheckpoint is an in-memory executor.Checkpoint.
+type memoryCheckpoint struct {
+	done   map[string]bool
+	marked []string
+}
+
+func (c *memoryCheckpoint) Done(key string) bool { return c.done[key] }
+func (c *memoryCheckpoint) Mark(key string)      { c.marked = append(c.marked, key) }
+
+func TestShutdown_SkipsCheckpointedWorkloads(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	deployment := func(name string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		}}
+	}
+	mockClient.EXPECT().ListWorkloads(ctx, gvr, "default", "").Return(&unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{deployment("api"), deployment("worker")},
+	}, nil)
+
+	// Only the workload not scaled down by the earlier attempt is touched.
+	scaleObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": int64(2)},
+	}}
+	mockClient.EXPECT().GetScale(ctx, gvr, "default", "worker").Return(scaleObj, nil)
+	mockClient.EXPECT().UpdateScale(ctx, gvr, "default", scaleObj).Return(scaleObj, nil)
+
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) {
+		return mockClient, nil
+	})
+
+	checkpoint := &memoryCheckpoint{done: map[string]bool{"default/Deployment/api": true}}
+	spec := executor.Spec{
+		TargetName: "test-workloads",
+		TargetType: "workloadscaler",
+		Parameters: json.RawMessage(`{"namespace": {"literals": ["default"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			K8S: &executor.K8SConnectorConfig{},
+		},
+		Checkpoint: checkpoint,
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"default/Deployment/worker"}, checkpoint.marked)
+	assert.Contains(t, result.Message, "skipped 1 workload scaled down by an earlier attempt(s)")
+}
+
+func TestShutdown_SkipsProtectedWorkloads(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	protected := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "api",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"hibernator.ardikabs.com/protected": "true"},
+		},
+	}}
+	mockClient.EXPECT().ListWorkloads(ctx, gvr, "default", "").Return(&unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{protected},
+	}, nil)
+
+	// No GetScale or UpdateScale expectation: the protected workload is left alone.
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) {
+		return mockClient, nil
+	})
+
+	var reported []string
+	spec := executor.Spec{
+		TargetName: "test-workloads",
+		TargetType: "workloadscaler",
+		Parameters: json.RawMessage(`{"includedGroups": ["Deployment"], "namespace": {"literals": ["default"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			K8S: &executor.K8SConnectorConfig{},
+		},
+		ReportStateCallback: func(key string, value interface{}) error {
+			reported = append(reported, key)
+			return nil
+		},
+	}
+
+	result, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	assert.Contains(t, result.Message, "skipped 1 protected workload(s)")
+	assert.Empty(t, reported)
+}
+
+func TestShutdown_RefusesMoreWorkloadsThanLimit(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	deployment := func(ns string) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "api", "namespace": ns},
+		}}
+	}
+	for _, ns := range []string{"team-a", "team-b"} {
+		mockClient.EXPECT().ListWorkloads(ctx, gvr, ns, "").Return(&unstructured.UnstructuredList{
+			Items: []unstructured.Unstructured{deployment(ns)},
+		}, nil)
+	}
+
+	// No GetScale or UpdateScale expectation: every namespace is listed
+	// before any workload is scaled.
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) {
+		return mockClient, nil
+	})
+
+	spec := executor.Spec{
+		TargetName: "test-workloads",
+		TargetType: "workloadscaler",
+		Parameters: json.RawMessage(`{"includedGroups": ["Deployment"], "namespace": {"literals": ["team-a", "team-b"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			K8S: &executor.K8SConnectorConfig{},
+		},
+		MaxResources: 1,
+	}
+
+	_, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.ErrorIs(t, err, executor.ErrResourceLimitExceeded)
+	assert.ErrorContains(t, err, "target selected 2 workload(s), more than the 1 allowed")
+}
+
+func TestShutdown_ReportsResourceProgress(t *testing.T) {
+	ctx := context.Background()
+	mockClient := mocks.NewClient(t)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	for _, ns := range []string{"team-a", "team-b"} {
+		mockClient.EXPECT().ListWorkloads(ctx, gvr, ns, "").Return(&unstructured.UnstructuredList{
+			Items: []unstructured.Unstructured{{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "api", "namespace": ns},
+			}}},
+		}, nil)
+		scaleObj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": int64(2)},
+		}}
+		mockClient.EXPECT().GetScale(ctx, gvr, ns, "api").Return(scaleObj, nil)
+		mockClient.EXPECT().UpdateScale(ctx, gvr, ns, scaleObj).Return(scaleObj, nil)
+	}
+
+	e := NewWithClients(func(ctx context.Context, spec *executor.Spec) (Client, error) {
+		return mockClient, nil
+	})
+
+	var progress []executor.ResourceProgress
+	spec := executor.Spec{
+		TargetName: "test-workloads",
+		TargetType: "workloadscaler",
+		Parameters: json.RawMessage(`{"namespace": {"literals": ["team-a", "team-b"]}}`),
+		ConnectorConfig: executor.ConnectorConfig{
+			K8S: &executor.K8SConnectorConfig{},
+		},
+		ReportProgressCallback: func(p executor.ResourceProgress) { progress = append(progress, p) },
+	}
+
+	_, err := e.Shutdown(ctx, logr.Discard(), spec)
+	assert.NoError(t, err)
+	// The total grows as the workloads of each namespace are listed.
+	assert.Equal(t, []executor.ResourceProgress{
+		{Completed: 1, Total: 1, Resource: "team-a/Deployment/api", Message: "scaled down 1/1 workload(s)"},
+		{Completed: 2, Total: 2, Resource: "team-b/Deployment/api", Message: "scaled down 2/2 workload(s)"},
+	}, progress)
+}
+
 func TestShutdown_SelectorNamespaces(t *testing.T) {
 	ctx := context.Background()
 	mockClient := mocks.NewClient(t)
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package history archives the execution cycles pruned from a plan's
+// status.executionHistory, so a plan's history can be kept for longer than the
+// few cycles its status holds.
+//
+// Archived cycles are written through an Archiver. The ReportArchiver keeps
+// each cycle as a HibernationReport named <plan>-<cycleID> in the plan
+// namespace; other backends, e.g. object storage, plug in by implementing
+// Archiver. Reports are not owned by the plan, so they outlive it; the Pruner
+// deletes them once their retention passes.
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// Archiver archives the execution cycles pruned from a plan's status.
+type Archiver interface {
+	Archive(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan, cycles []hibernatorv1alpha1.ExecutionCycle, now time.Time) error
+}
+
+// ReportName returns the name of the HibernationReport archiving a cycle of plan.
+func ReportName(plan, cycleID string) string {
+	return plan + "-" + cycleID
+}
+
+// RetainUntil returns when a cycle of plan archived at now is due for deletion,
+// or nil when the plan keeps its reports until they are deleted.
+func RetainUntil(plan *hibernatorv1alpha1.HibernatePlan, now time.Time) (*metav1.Time, error) {
+	if plan.Spec.ExecutionHistory == nil || plan.Spec.ExecutionHistory.ArchiveRetention == "" {
+		return nil, nil
+	}
+
+	retention, err := time.ParseDuration(plan.Spec.ExecutionHistory.ArchiveRetention)
+	if err != nil {
+		return nil, fmt.Errorf("parse archive retention: %w", err)
+	}
+	until := metav1.NewTime(now.Add(retention))
+	return &until, nil
+}
+
+// ReportArchiver archives cycles as HibernationReports.
+type ReportArchiver struct {
+	client client.Client
+}
+
+var _ Archiver = (*ReportArchiver)(nil)
+
+// NewReportArchiver creates a ReportArchiver writing reports with c.
+func NewReportArchiver(c client.Client) *ReportArchiver {
+	return &ReportArchiver{client: c}
+}
+
+// Archive creates a HibernationReport for each of cycles. Cycles archived
+// before are left as they are.
+func (a *ReportArchiver) Archive(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan, cycles []hibernatorv1alpha1.ExecutionCycle, now time.Time) error {
+	retainUntil, err := RetainUntil(plan, now)
+	if err != nil {
+		return err
+	}
+
+	for _, cycle := range cycles {
+		report := &hibernatorv1alpha1.HibernationReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ReportName(plan.Name, cycle.CycleID),
+				Namespace: plan.Namespace,
+				Labels: map[string]string{
+					wellknown.LabelPlan:       plan.Name,
+					wellknown.LabelReportType: string(hibernatorv1alpha1.ReportTypeCycle),
+				},
+			},
+			Spec: hibernatorv1alpha1.HibernationReportSpec{
+				Type: hibernatorv1alpha1.ReportTypeCycle,
+				PlanRef: &hibernatorv1alpha1.PlanReference{
+					Name:      plan.Name,
+					Namespace: plan.Namespace,
+				},
+				Cycle:       cycle.DeepCopy(),
+				RetainUntil: retainUntil,
+			},
+		}
+
+		if err := a.client.Create(ctx, report); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("create report %s: %w", report.Name, err)
+		}
+	}
+	return nil
+}
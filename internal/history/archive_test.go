@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+var now = time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC)
+
+func newTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = hibernatorv1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func testPlan(retention string) *hibernatorv1alpha1.HibernatePlan {
+	return &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-a"},
+		Spec: hibernatorv1alpha1.HibernatePlanSpec{
+			ExecutionHistory: &hibernatorv1alpha1.ExecutionHistorySpec{
+				Limit:            5,
+				Archive:          true,
+				ArchiveRetention: retention,
+			},
+		},
+	}
+}
+
+func TestReportArchiver_CreatesReports(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient()
+	archiver := NewReportArchiver(c)
+
+	cycles := []hibernatorv1alpha1.ExecutionCycle{{CycleID: "aaa"}, {CycleID: "bbb"}}
+	require.NoError(t, archiver.Archive(ctx, testPlan("2160h"), cycles, now))
+	// Archiving a cycle again leaves its report as it is.
+	require.NoError(t, archiver.Archive(ctx, testPlan("1h"), cycles[1:], now))
+
+	for _, cycle := range cycles {
+		report := &hibernatorv1alpha1.HibernationReport{}
+		key := types.NamespacedName{Namespace: "team-a", Name: "dev-" + cycle.CycleID}
+		require.NoError(t, c.Get(ctx, key, report))
+		assert.Equal(t, "dev", report.Labels[wellknown.LabelPlan])
+		assert.Equal(t, hibernatorv1alpha1.ReportTypeCycle, report.Spec.Type)
+		assert.Equal(t, "dev", report.Spec.PlanRef.Name)
+		assert.Equal(t, cycle.CycleID, report.Spec.Cycle.CycleID)
+		require.NotNil(t, report.Spec.RetainUntil)
+		assert.True(t, report.Spec.RetainUntil.Time.Equal(now.Add(90*24*time.Hour)))
+		assert.Empty(t, report.OwnerReferences)
+	}
+}
+
+func TestRetainUntil(t *testing.T) {
+	until, err := RetainUntil(testPlan(""), now)
+	require.NoError(t, err)
+	assert.Nil(t, until)
+
+	_, err = RetainUntil(testPlan("90d"), now)
+	assert.Error(t, err)
+}
+
+func TestPruner_DeletesExpiredReports(t *testing.T) {
+	report := func(name string, retainUntil *metav1.Time) *hibernatorv1alpha1.HibernationReport {
+		return &hibernatorv1alpha1.HibernationReport{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+			Spec:       hibernatorv1alpha1.HibernationReportSpec{RetainUntil: retainUntil},
+		}
+	}
+	past := metav1.NewTime(now.Add(-time.Minute))
+	future := metav1.NewTime(now.Add(time.Minute))
+
+	ctx := context.Background()
+	c := newTestClient(report("expired", &past), report("retained", &future), report("forever", nil))
+	pruner := &Pruner{Client: c, Clock: clocktesting.NewFakeClock(now), Log: logr.Discard()}
+	require.NoError(t, pruner.Prune(ctx))
+
+	var reports hibernatorv1alpha1.HibernationReportList
+	require.NoError(t, c.List(ctx, &reports))
+	var names []string
+	for _, r := range reports.Items {
+		names = append(names, r.Name)
+	}
+	assert.ElementsMatch(t, []string{"retained", "forever"}, names)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// DefaultPruneInterval is how often the Pruner looks for expired reports.
+const DefaultPruneInterval = time.Hour
+
+// Pruner periodically deletes the HibernationReports past their retention.
+type Pruner struct {
+	Client   client.Client
+	Clock    clock.Clock
+	Log      logr.Logger
+	Interval time.Duration
+}
+
+// NeedLeaderElection returns true — only the leader deletes expired reports.
+func (p *Pruner) NeedLeaderElection() bool { return true }
+
+// Start implements manager.Runnable. It blocks until ctx is cancelled.
+func (p *Pruner) Start(ctx context.Context) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultPruneInterval
+	}
+
+	log := p.Log.WithName("history-pruner")
+	log.Info("starting history pruner", "interval", interval)
+
+	for {
+		if err := p.Prune(ctx); err != nil {
+			log.Error(err, "failed to prune expired reports")
+		}
+
+		timer := p.Clock.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C():
+		}
+	}
+}
+
+// Prune deletes the HibernationReports whose retention has passed.
+func (p *Pruner) Prune(ctx context.Context) error {
+	var reports hibernatorv1alpha1.HibernationReportList
+	if err := p.Client.List(ctx, &reports); err != nil {
+		return err
+	}
+
+	now := p.Clock.Now()
+	for i := range reports.Items {
+		report := &reports.Items[i]
+		if report.Spec.RetainUntil == nil || report.Spec.RetainUntil.After(now) {
+			continue
+		}
+
+		if err := p.Client.Delete(ctx, report); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		p.Log.V(1).Info("deleted expired report", "namespace", report.Namespace, "report", report.Name)
+	}
+	return nil
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// DefaultRollupInterval is how often the Rollup looks for completed periods
+// without a report.
+const DefaultRollupInterval = time.Hour
+
+// Period returns the last period of type t completed at now, in UTC. Days
+// start at midnight and weeks on Monday.
+func Period(t hibernatorv1alpha1.ReportType, now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	end = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if t == hibernatorv1alpha1.ReportTypeWeekly {
+		end = end.AddDate(0, 0, -((int(end.Weekday()) + 6) % 7))
+		return end.AddDate(0, 0, -7), end
+	}
+	return end.AddDate(0, 0, -1), end
+}
+
+// RollupName returns the name of the roll-up of type t over the period starting at start.
+func RollupName(t hibernatorv1alpha1.ReportType, start time.Time) string {
+	return strings.ToLower(string(t)) + "-" + start.Format(time.DateOnly)
+}
+
+// PlanHistory is the execution history of a plan that a roll-up summarizes.
+type PlanHistory struct {
+	Name string
+
+	// HourlyCost is what the plan's targets cost per hour while awake, or
+	// zero when the plan declares no cost.
+	HourlyCost float64
+
+	// Hibernated reports whether the plan is still hibernated after its last
+	// cycle, whose hibernation then lasts until the end of any period.
+	Hibernated bool
+
+	// Cycles are the plan's execution cycles, oldest first.
+	Cycles []hibernatorv1alpha1.ExecutionCycle
+}
+
+// Summarize rolls up plans over the period [start, end).
+func Summarize(plans []PlanHistory, start, end time.Time) *hibernatorv1alpha1.ReportSummary {
+	summary := &hibernatorv1alpha1.ReportSummary{}
+	inPeriod := func(t *metav1.Time) bool {
+		return t != nil && !t.Time.Before(start) && t.Time.Before(end)
+	}
+
+	var succeeded, shutdowns, wakeups int32
+	var shutdownTotal, wakeupTotal time.Duration
+	var savings float64
+	var costed bool
+
+	for _, plan := range plans {
+		var operations int32
+		var hibernated time.Duration
+		targets := make(map[string]*hibernatorv1alpha1.TargetReportSummary)
+		target := func(name string) *hibernatorv1alpha1.TargetReportSummary {
+			if targets[name] == nil {
+				targets[name] = &hibernatorv1alpha1.TargetReportSummary{Plan: plan.Name, Target: name}
+			}
+			return targets[name]
+		}
+
+		for i, cycle := range plan.Cycles {
+			for _, op := range []*hibernatorv1alpha1.ExecutionOperationSummary{cycle.ShutdownExecution, cycle.WakeupExecution} {
+				if op == nil {
+					continue
+				}
+				for _, result := range op.TargetResults {
+					if !inPeriod(result.FinishedAt) {
+						continue
+					}
+					t := target(result.Target)
+					t.Executions++
+					if result.State == hibernatorv1alpha1.StateFailed {
+						t.FailedExecutions++
+					}
+				}
+				if !inPeriod(op.EndTime) {
+					continue
+				}
+
+				operations++
+				if op.Success {
+					succeeded++
+				}
+				if op.Operation == hibernatorv1alpha1.OperationHibernate {
+					shutdowns++
+					shutdownTotal += op.EndTime.Sub(op.StartTime.Time)
+				} else {
+					wakeups++
+					wakeupTotal += op.EndTime.Sub(op.StartTime.Time)
+				}
+			}
+
+			// The cycle was hibernated from the end of a successful shutdown
+			// until its wakeup started.
+			shutdown := cycle.ShutdownExecution
+			if shutdown == nil || !shutdown.Success || shutdown.EndTime == nil {
+				continue
+			}
+			wakeAt := end
+			switch {
+			case cycle.WakeupExecution != nil:
+				wakeAt = cycle.WakeupExecution.StartTime.Time
+			case i != len(plan.Cycles)-1 || !plan.Hibernated:
+				continue
+			}
+			hibernated += overlap(shutdown.EndTime.Time, wakeAt, start, end)
+
+			for _, result := range shutdown.TargetResults {
+				if result.State != hibernatorv1alpha1.StateCompleted || result.FinishedAt == nil {
+					continue
+				}
+				if d := overlap(result.FinishedAt.Time, targetWakeAt(cycle.WakeupExecution, result.Target, wakeAt), start, end); d > 0 {
+					target(result.Target).HibernatedSeconds += seconds(d)
+				}
+			}
+		}
+
+		if operations == 0 && hibernated == 0 {
+			continue
+		}
+		summary.Plans++
+		summary.Operations += operations
+		summary.HibernatedSeconds += seconds(hibernated)
+		if plan.HourlyCost > 0 {
+			costed = true
+			savings += hibernated.Hours() * plan.HourlyCost
+		}
+		for _, t := range targets {
+			summary.Targets = append(summary.Targets, *t)
+		}
+	}
+
+	summary.FailedOperations = summary.Operations - succeeded
+	if summary.Operations > 0 {
+		summary.SuccessRate = strconv.FormatFloat(100*float64(succeeded)/float64(summary.Operations), 'f', 1, 64)
+	}
+	if shutdowns > 0 {
+		summary.MeanShutdownSeconds = seconds(shutdownTotal / time.Duration(shutdowns))
+	}
+	if wakeups > 0 {
+		summary.MeanWakeupSeconds = seconds(wakeupTotal / time.Duration(wakeups))
+	}
+	if costed {
+		summary.EstimatedSavings = strconv.FormatFloat(savings, 'f', 2, 64)
+	}
+	slices.SortFunc(summary.Targets, func(a, b hibernatorv1alpha1.TargetReportSummary) int {
+		if c := strings.Compare(a.Plan, b.Plan); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Target, b.Target)
+	})
+	return summary
+}
+
+// targetWakeAt returns when the wakeup of target started, falling back to
+// wakeAt when the wakeup did not record it.
+func targetWakeAt(wakeup *hibernatorv1alpha1.ExecutionOperationSummary, target string, wakeAt time.Time) time.Time {
+	if wakeup == nil {
+		return wakeAt
+	}
+	for _, result := range wakeup.TargetResults {
+		if result.Target == target && result.StartedAt != nil {
+			return result.StartedAt.Time
+		}
+	}
+	return wakeAt
+}
+
+// overlap returns how much of [from, to) falls within [start, end).
+func overlap(from, to, start, end time.Time) time.Duration {
+	if from.Before(start) {
+		from = start
+	}
+	if to.After(end) {
+		to = end
+	}
+	return max(to.Sub(from), 0)
+}
+
+// seconds truncates d to whole seconds.
+func seconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
+
+// hourlyCost parses the hourly cost a plan declares in AnnotationHourlyCost.
+func hourlyCost(plan *hibernatorv1alpha1.HibernatePlan) (float64, error) {
+	value, ok := plan.Annotations[wellknown.AnnotationHourlyCost]
+	if !ok {
+		return 0, nil
+	}
+	cost, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || cost < 0 {
+		return 0, fmt.Errorf("invalid hourly cost %q", value)
+	}
+	return cost, nil
+}
+
+// Rollup periodically generates the Daily and Weekly HibernationReports of
+// every namespace with plans, once their period has completed. It summarizes
+// the execution history in the plan statuses together with the archived Cycle
+// reports, so roll-ups cover periods longer than a plan's status holds when
+// archival is enabled.
+type Rollup struct {
+	Client   client.Client
+	Clock    clock.Clock
+	Log      logr.Logger
+	Interval time.Duration
+
+	// Types are the roll-ups to generate, Daily and/or Weekly.
+	Types []hibernatorv1alpha1.ReportType
+
+	// Retention is how long roll-ups are kept after they are generated. Zero
+	// keeps them until they are deleted.
+	Retention time.Duration
+}
+
+// NeedLeaderElection returns true — only the leader generates reports.
+func (r *Rollup) NeedLeaderElection() bool { return true }
+
+// Start implements manager.Runnable. It blocks until ctx is cancelled.
+func (r *Rollup) Start(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultRollupInterval
+	}
+
+	log := r.Log.WithName("report-rollup")
+	log.Info("starting report rollup", "types", r.Types, "interval", interval)
+
+	for {
+		if err := r.Generate(ctx); err != nil {
+			log.Error(err, "failed to generate report rollups")
+		}
+
+		timer := r.Clock.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C():
+		}
+	}
+}
+
+// Generate creates the roll-ups of the last completed periods that do not exist yet.
+func (r *Rollup) Generate(ctx context.Context) error {
+	var plans hibernatorv1alpha1.HibernatePlanList
+	if err := r.Client.List(ctx, &plans); err != nil {
+		return err
+	}
+	var reports hibernatorv1alpha1.HibernationReportList
+	if err := r.Client.List(ctx, &reports); err != nil {
+		return err
+	}
+
+	histories := r.histories(plans.Items, reports.Items)
+	existing := make(map[types.NamespacedName]bool, len(reports.Items))
+	for _, report := range reports.Items {
+		existing[types.NamespacedName{Namespace: report.Namespace, Name: report.Name}] = true
+	}
+
+	now := r.Clock.Now()
+	for _, t := range r.Types {
+		start, end := Period(t, now)
+		name := RollupName(t, start)
+
+		for namespace, plans := range histories {
+			if existing[types.NamespacedName{Namespace: namespace, Name: name}] {
+				continue
+			}
+			summary := Summarize(plans, start, end)
+			if summary.Plans == 0 {
+				continue
+			}
+
+			report := &hibernatorv1alpha1.HibernationReport{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels: map[string]string{
+						wellknown.LabelReportType: string(t),
+					},
+				},
+				Spec: hibernatorv1alpha1.HibernationReportSpec{
+					Type: t,
+					Period: &hibernatorv1alpha1.ReportPeriod{
+						Start: metav1.NewTime(start),
+						End:   metav1.NewTime(end),
+					},
+					Summary: summary,
+				},
+			}
+			if r.Retention > 0 {
+				report.Spec.RetainUntil = &metav1.Time{Time: now.Add(r.Retention)}
+			}
+
+			if err := r.Client.Create(ctx, report); err != nil && !apierrors.IsAlreadyExists(err) {
+				return fmt.Errorf("create report %s/%s: %w", namespace, name, err)
+			}
+			r.Log.V(1).Info("generated report rollup", "namespace", namespace, "report", name)
+		}
+	}
+	return nil
+}
+
+// histories collects the execution history of every plan by namespace, from
+// the archived Cycle reports, which also cover deleted plans, followed by the
+// cycles still in the plan statuses.
+func (r *Rollup) histories(plans []hibernatorv1alpha1.HibernatePlan, reports []hibernatorv1alpha1.HibernationReport) map[string][]PlanHistory {
+	byPlan := make(map[types.NamespacedName]*PlanHistory)
+	var keys []types.NamespacedName
+	history := func(key types.NamespacedName) *PlanHistory {
+		if byPlan[key] == nil {
+			byPlan[key] = &PlanHistory{Name: key.Name}
+			keys = append(keys, key)
+		}
+		return byPlan[key]
+	}
+
+	for _, report := range reports {
+		if report.Spec.Type != hibernatorv1alpha1.ReportTypeCycle || report.Spec.PlanRef == nil || report.Spec.Cycle == nil {
+			continue
+		}
+		h := history(types.NamespacedName{Namespace: report.Namespace, Name: report.Spec.PlanRef.Name})
+		h.Cycles = append(h.Cycles, *report.Spec.Cycle)
+	}
+	for _, h := range byPlan {
+		slices.SortStableFunc(h.Cycles, func(a, b hibernatorv1alpha1.ExecutionCycle) int {
+			return cycleStart(a).Compare(cycleStart(b))
+		})
+	}
+
+	for i := range plans {
+		plan := &plans[i]
+		key := types.NamespacedName{Namespace: plan.Namespace, Name: plan.Name}
+		h := history(key)
+		h.Hibernated = plan.Status.Phase == hibernatorv1alpha1.PhaseHibernated
+		cost, err := hourlyCost(plan)
+		if err != nil {
+			r.Log.Info("ignoring hourly cost of plan", "plan", key, "error", err.Error())
+		}
+		h.HourlyCost = cost
+
+		// A cycle still in the status supersedes its archived copy.
+		h.Cycles = slices.DeleteFunc(h.Cycles, func(archived hibernatorv1alpha1.ExecutionCycle) bool {
+			return slices.ContainsFunc(plan.Status.ExecutionHistory, func(c hibernatorv1alpha1.ExecutionCycle) bool {
+				return c.CycleID == archived.CycleID
+			})
+		})
+		h.Cycles = append(h.Cycles, plan.Status.ExecutionHistory...)
+	}
+
+	result := make(map[string][]PlanHistory)
+	for _, key := range keys {
+		result[key.Namespace] = append(result[key.Namespace], *byPlan[key])
+	}
+	return result
+}
+
+// cycleStart returns when the first recorded operation of cycle started.
+func cycleStart(cycle hibernatorv1alpha1.ExecutionCycle) time.Time {
+	switch {
+	case cycle.ShutdownExecution != nil:
+		return cycle.ShutdownExecution.StartTime.Time
+	case cycle.WakeupExecution != nil:
+		return cycle.WakeupExecution.StartTime.Time
+	}
+	return time.Time{}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// at returns the time of day on 2026-10-15, the day before now.
+func at(hour, minute int) *metav1.Time {
+	t := metav1.NewTime(time.Date(2026, 10, 15, hour, minute, 0, 0, time.UTC))
+	return &t
+}
+
+func operation(op hibernatorv1alpha1.PlanOperation, start, end *metav1.Time, results ...hibernatorv1alpha1.TargetExecutionResult) *hibernatorv1alpha1.ExecutionOperationSummary {
+	success := true
+	for _, r := range results {
+		success = success && r.State == hibernatorv1alpha1.StateCompleted
+	}
+	return &hibernatorv1alpha1.ExecutionOperationSummary{
+		Operation:     op,
+		StartTime:     *start,
+		EndTime:       end,
+		TargetResults: results,
+		Success:       success,
+	}
+}
+
+func result(target string, state hibernatorv1alpha1.ExecutionState, started, finished *metav1.Time) hibernatorv1alpha1.TargetExecutionResult {
+	return hibernatorv1alpha1.TargetExecutionResult{Target: target, State: state, StartedAt: started, FinishedAt: finished}
+}
+
+func TestPeriod(t *testing.T) {
+	// 2026-10-16 is a Friday.
+	start, end := Period(hibernatorv1alpha1.ReportTypeDaily, now)
+	assert.Equal(t, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = Period(hibernatorv1alpha1.ReportTypeWeekly, now)
+	assert.Equal(t, time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), end)
+
+	assert.Equal(t, "weekly-2026-10-05", RollupName(hibernatorv1alpha1.ReportTypeWeekly, start))
+}
+
+func TestSummarize(t *testing.T) {
+	start, end := Period(hibernatorv1alpha1.ReportTypeDaily, now)
+
+	// Hibernated from 01:00 to 07:00 with one target failing to wake up.
+	woken := hibernatorv1alpha1.ExecutionCycle{
+		CycleID: "aaa",
+		ShutdownExecution: operation(hibernatorv1alpha1.OperationHibernate, at(0, 50), at(1, 0),
+			result("rds/db", hibernatorv1alpha1.StateCompleted, at(0, 50), at(0, 56)),
+			result("eks/web", hibernatorv1alpha1.StateCompleted, at(0, 50), at(1, 0))),
+		WakeupExecution: operation(hibernatorv1alpha1.OperationWakeUp, at(7, 0), at(7, 20),
+			result("rds/db", hibernatorv1alpha1.StateFailed, at(7, 0), at(7, 20)),
+			result("eks/web", hibernatorv1alpha1.StateCompleted, at(7, 10), at(7, 15))),
+	}
+	// Still hibernated at the end of the period, since 22:00.
+	ongoing := hibernatorv1alpha1.ExecutionCycle{
+		CycleID: "bbb",
+		ShutdownExecution: operation(hibernatorv1alpha1.OperationHibernate, at(21, 50), at(22, 0),
+			result("rds/db", hibernatorv1alpha1.StateCompleted, at(21, 50), at(22, 0))),
+	}
+
+	summary := Summarize([]PlanHistory{
+		{Name: "dev", HourlyCost: 2.5, Hibernated: true, Cycles: []hibernatorv1alpha1.ExecutionCycle{woken, ongoing}},
+		{Name: "idle"},
+	}, start, end)
+
+	assert.Equal(t, int32(1), summary.Plans)
+	assert.Equal(t, int32(3), summary.Operations)
+	assert.Equal(t, int32(1), summary.FailedOperations)
+	assert.Equal(t, "66.7", summary.SuccessRate)
+	assert.Equal(t, int64(10*60), summary.MeanShutdownSeconds)
+	assert.Equal(t, int64(20*60), summary.MeanWakeupSeconds)
+	assert.Equal(t, int64(8*3600), summary.HibernatedSeconds)
+	assert.Equal(t, "20.00", summary.EstimatedSavings)
+
+	assert.Equal(t, []hibernatorv1alpha1.TargetReportSummary{
+		{Plan: "dev", Target: "eks/web", HibernatedSeconds: 6*3600 + 10*60, Executions: 2},
+		{Plan: "dev", Target: "rds/db", HibernatedSeconds: 6*3600 + 4*60 + 2*3600, Executions: 3, FailedExecutions: 1},
+	}, summary.Targets)
+}
+
+func TestSummarize_NoCostDeclared(t *testing.T) {
+	start, end := Period(hibernatorv1alpha1.ReportTypeDaily, now)
+	cycle := hibernatorv1alpha1.ExecutionCycle{
+		CycleID:           "aaa",
+		ShutdownExecution: operation(hibernatorv1alpha1.OperationHibernate, at(1, 0), at(1, 5)),
+	}
+
+	summary := Summarize([]PlanHistory{{Name: "dev", Cycles: []hibernatorv1alpha1.ExecutionCycle{cycle}}}, start, end)
+	assert.Equal(t, int32(1), summary.Operations)
+	assert.Equal(t, "100.0", summary.SuccessRate)
+	assert.Zero(t, summary.HibernatedSeconds)
+	assert.Empty(t, summary.EstimatedSavings)
+}
+
+func TestRollup_GeneratesReportsPerNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	archived := hibernatorv1alpha1.ExecutionCycle{
+		CycleID:           "old",
+		ShutdownExecution: operation(hibernatorv1alpha1.OperationHibernate, at(0, 0), at(0, 10)),
+		WakeupExecution:   operation(hibernatorv1alpha1.OperationWakeUp, at(6, 0), at(6, 10)),
+	}
+	recent := hibernatorv1alpha1.ExecutionCycle{
+		CycleID:           "new",
+		ShutdownExecution: operation(hibernatorv1alpha1.OperationHibernate, at(20, 0), at(20, 10)),
+	}
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "dev",
+			Namespace:   "team-a",
+			Annotations: map[string]string{wellknown.AnnotationHourlyCost: "1"},
+		},
+		Status: hibernatorv1alpha1.HibernatePlanStatus{
+			Phase:            hibernatorv1alpha1.PhaseHibernated,
+			ExecutionHistory: []hibernatorv1alpha1.ExecutionCycle{recent},
+		},
+	}
+	cycleReport := &hibernatorv1alpha1.HibernationReport{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev-old", Namespace: "team-a"},
+		Spec: hibernatorv1alpha1.HibernationReportSpec{
+			Type:    hibernatorv1alpha1.ReportTypeCycle,
+			PlanRef: &hibernatorv1alpha1.PlanReference{Name: "dev", Namespace: "team-a"},
+			Cycle:   &archived,
+		},
+	}
+	idle := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: "team-b"}}
+
+	c := newTestClient(plan, cycleReport, idle)
+	rollup := &Rollup{
+		Client:    c,
+		Clock:     clocktesting.NewFakeClock(now),
+		Log:       logr.Discard(),
+		Types:     []hibernatorv1alpha1.ReportType{hibernatorv1alpha1.ReportTypeDaily},
+		Retention: time.Hour,
+	}
+	require.NoError(t, rollup.Generate(ctx))
+	// Generating again leaves the report as it is.
+	require.NoError(t, rollup.Generate(ctx))
+
+	report := &hibernatorv1alpha1.HibernationReport{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "daily-2026-10-15"}, report))
+	assert.Equal(t, hibernatorv1alpha1.ReportTypeDaily, report.Spec.Type)
+	assert.Equal(t, "Daily", report.Labels[wellknown.LabelReportType])
+	require.NotNil(t, report.Spec.Period)
+	assert.True(t, report.Spec.Period.Start.Time.Equal(time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)))
+	require.NotNil(t, report.Spec.RetainUntil)
+	assert.True(t, report.Spec.RetainUntil.Time.Equal(now.Add(time.Hour)))
+
+	require.NotNil(t, report.Spec.Summary)
+	assert.Equal(t, int32(3), report.Spec.Summary.Operations)
+	// 00:10-06:00 from the archived cycle and 20:10-24:00 from the ongoing one.
+	assert.Equal(t, int64(5*3600+50*60+3*3600+50*60), report.Spec.Summary.HibernatedSeconds)
+	assert.Equal(t, "9.67", report.Spec.Summary.EstimatedSavings)
+
+	// A namespace without activity in the period gets no report.
+	var reports hibernatorv1alpha1.HibernationReportList
+	require.NoError(t, c.List(ctx, &reports))
+	assert.Len(t, reports.Items, 2)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package incident
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Event is an incident notification normalized from a source-specific payload.
+type Event struct {
+	// ID identifies the incident within its source.
+	ID string
+
+	// Open is true when the incident was opened or reopened and false when it
+	// was resolved.
+	Open bool
+
+	// Summary is the incident title, used for logging only.
+	Summary string
+}
+
+// parseFunc decodes a webhook payload. It returns a nil Event for notifications
+// that neither open nor resolve an incident, such as acknowledgements.
+type parseFunc func(body []byte) (*Event, error)
+
+// parsers maps the {source} path segment of the webhook route to its payload format.
+var parsers = map[string]parseFunc{
+	"generic":   parseGeneric,
+	"opsgenie":  parseOpsgenie,
+	"pagerduty": parsePagerDuty,
+}
+
+// parseGeneric decodes the source-neutral payload:
+//
+//	{"id": "INC-42", "status": "open" | "resolved", "summary": "..."}
+func parseGeneric(body []byte) (*Event, error) {
+	var payload struct {
+		ID      string `json:"id"`
+		Status  string `json:"status"`
+		Summary string `json:"summary"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode payload: %w", err)
+	}
+	if payload.ID == "" {
+		return nil, fmt.Errorf("payload has no id")
+	}
+
+	switch payload.Status {
+	case "open":
+		return &Event{ID: payload.ID, Open: true, Summary: payload.Summary}, nil
+	case "resolved":
+		return &Event{ID: payload.ID, Summary: payload.Summary}, nil
+	default:
+		return nil, fmt.Errorf("unsupported status %q: must be open or resolved", payload.Status)
+	}
+}
+
+// parsePagerDuty decodes a PagerDuty V3 webhook. incident.triggered and
+// incident.reopened open the incident, incident.resolved resolves it.
+func parsePagerDuty(body []byte) (*Event, error) {
+	var payload struct {
+		Event struct {
+			EventType string `json:"event_type"`
+			Data      struct {
+				ID    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"data"`
+		} `json:"event"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode payload: %w", err)
+	}
+
+	event := &Event{ID: payload.Event.Data.ID, Summary: payload.Event.Data.Title}
+	switch payload.Event.EventType {
+	case "incident.triggered", "incident.reopened":
+		event.Open = true
+	case "incident.resolved":
+	default:
+		return nil, nil
+	}
+	if event.ID == "" {
+		return nil, fmt.Errorf("payload has no event.data.id")
+	}
+	return event, nil
+}
+
+// parseOpsgenie decodes an Opsgenie webhook integration payload. The Create
+// action opens the alert, Close and Delete resolve it.
+func parseOpsgenie(body []byte) (*Event, error) {
+	var payload struct {
+		Action string `json:"action"`
+		Alert  struct {
+			AlertID string `json:"alertId"`
+			Message string `json:"message"`
+		} `json:"alert"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode payload: %w", err)
+	}
+
+	event := &Event{ID: payload.Alert.AlertID, Summary: payload.Alert.Message}
+	switch payload.Action {
+	case "Create":
+		event.Open = true
+	case "Close", "Delete":
+	default:
+		return nil, nil
+	}
+	if event.ID == "" {
+		return nil, fmt.Errorf("payload has no alert.alertId")
+	}
+	return event, nil
+}
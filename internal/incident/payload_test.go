@@ -0,0 +1,94 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package incident
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsers(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		body    string
+		want    *Event
+		wantErr bool
+	}{
+		{
+			name:   "pagerduty triggered",
+			source: "pagerduty",
+			body:   `{"event":{"event_type":"incident.triggered","data":{"id":"Q1W2","title":"API down"}}}`,
+			want:   &Event{ID: "Q1W2", Open: true, Summary: "API down"},
+		},
+		{
+			name:   "pagerduty resolved",
+			source: "pagerduty",
+			body:   `{"event":{"event_type":"incident.resolved","data":{"id":"Q1W2"}}}`,
+			want:   &Event{ID: "Q1W2"},
+		},
+		{
+			name:   "pagerduty acknowledged is ignored",
+			source: "pagerduty",
+			body:   `{"event":{"event_type":"incident.acknowledged","data":{"id":"Q1W2"}}}`,
+		},
+		{
+			name:    "pagerduty without id",
+			source:  "pagerduty",
+			body:    `{"event":{"event_type":"incident.triggered","data":{}}}`,
+			wantErr: true,
+		},
+		{
+			name:   "opsgenie create",
+			source: "opsgenie",
+			body:   `{"action":"Create","alert":{"alertId":"a-1","message":"disk full"}}`,
+			want:   &Event{ID: "a-1", Open: true, Summary: "disk full"},
+		},
+		{
+			name:   "opsgenie close",
+			source: "opsgenie",
+			body:   `{"action":"Close","alert":{"alertId":"a-1"}}`,
+			want:   &Event{ID: "a-1"},
+		},
+		{
+			name:   "opsgenie note is ignored",
+			source: "opsgenie",
+			body:   `{"action":"AddNote","alert":{"alertId":"a-1"}}`,
+		},
+		{
+			name:   "generic open",
+			source: "generic",
+			body:   `{"id":"INC-42","status":"open"}`,
+			want:   &Event{ID: "INC-42", Open: true},
+		},
+		{
+			name:    "generic unknown status",
+			source:  "generic",
+			body:    `{"id":"INC-42","status":"acknowledged"}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			source:  "generic",
+			body:    `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsers[tt.source]([]byte(tt.body))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,447 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package incident receives incident webhooks from alerting systems such as
+// PagerDuty and Opsgenie and keeps the affected HibernatePlans awake while an
+// incident is open, by maintaining a suspend-type ScheduleException for them.
+package incident
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/statusapi"
+	"github.com/ardikabs/hibernator/internal/streaming/auth"
+	"github.com/ardikabs/hibernator/internal/streaming/streamtls"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+const (
+	// WebhookPath is the route incident webhooks are posted to. The affected plans
+	// are named by repeated "plan" query parameters or selected by a "selector"
+	// label selector query parameter.
+	WebhookPath = "/v1alpha1/namespaces/{namespace}/incidents/{source}"
+
+	// DefaultMaxDuration bounds how long an incident keeps plans awake when its
+	// resolution is never delivered.
+	DefaultMaxDuration = 24 * time.Hour
+
+	// exceptionPrefix prefixes the names of the exceptions managed by the webhook.
+	exceptionPrefix = "incident-"
+
+	// maxPayloadSize bounds the accepted webhook body.
+	maxPayloadSize = 1 << 20
+)
+
+// allDayWindows covers every minute of every day, so the incident exception
+// applies for its whole validity period.
+var allDayWindows = []hibernatorv1alpha1.OffHourWindow{{
+	Start:      "00:00",
+	End:        "00:00",
+	DaysOfWeek: []string{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"},
+}}
+
+// Options configures the incident webhook server.
+type Options struct {
+	// Addr is the listen address. An empty Addr disables the server.
+	Addr  string
+	Clock clock.Clock
+
+	// TLSCertDir holds the tls.crt and tls.key the server presents, reloaded
+	// when they change. Required when Addr is set: callers send Kubernetes
+	// bearer tokens, which must not cross the network in cleartext.
+	TLSCertDir string
+
+	// MaxDuration bounds each open incident. Zero uses DefaultMaxDuration.
+	MaxDuration time.Duration
+}
+
+// Response is the body returned for an accepted webhook.
+type Response struct {
+	// Exception is the ScheduleException maintained for the affected plans.
+	Exception string `json:"exception,omitempty"`
+
+	// Action is "opened", "resolved" or "ignored".
+	Action string `json:"action"`
+
+	// OpenIncidents lists the incidents still keeping the plans awake.
+	OpenIncidents []string `json:"openIncidents,omitempty"`
+}
+
+// Server receives incident webhooks over HTTP.
+type Server struct {
+	addr        string
+	tlsConfig   *tls.Config
+	client      client.Client
+	apiReader   client.Reader
+	authorizer  *statusapi.TokenAuthorizer
+	clock       clock.Clock
+	maxDuration time.Duration
+	log         logr.Logger
+}
+
+// NewServer creates an incident webhook server that manages exceptions with c,
+// reads them back with apiReader, and authorizes callers with authorizer.
+func NewServer(opts Options, c client.Client, apiReader client.Reader, authorizer *statusapi.TokenAuthorizer, log logr.Logger) *Server {
+	srv := &Server{
+		addr:        opts.Addr,
+		client:      c,
+		apiReader:   apiReader,
+		authorizer:  authorizer,
+		clock:       clock.RealClock{},
+		maxDuration: opts.MaxDuration,
+		log:         log.WithName("incident-webhook"),
+	}
+	if opts.Clock != nil {
+		srv.clock = opts.Clock
+	}
+	if srv.maxDuration == 0 {
+		srv.maxDuration = DefaultMaxDuration
+	}
+	return srv
+}
+
+// SetupWithManager adds the incident webhook server to the manager. It is a
+// no-op when opts.Addr is empty.
+func SetupWithManager(mgr ctrl.Manager, opts Options) error {
+	if opts.Addr == "" {
+		return nil
+	}
+	if opts.TLSCertDir == "" {
+		return fmt.Errorf("incident webhook requires TLS: set --stream-tls-cert-dir or disable it with an empty --incident-webhook-address")
+	}
+	tlsConfig, err := streamtls.ServingConfig(opts.TLSCertDir)
+	if err != nil {
+		return fmt.Errorf("failed to load incident webhook TLS configuration: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create kubernetes clientset: %w", err)
+	}
+
+	clk := opts.Clock
+	if clk == nil {
+		clk = clock.RealClock{}
+	}
+
+	authorizer := statusapi.NewTokenAuthorizer(clientset, clk, statusapi.DefaultCacheTTL)
+	srv := NewServer(opts, mgr.GetClient(), mgr.GetAPIReader(), authorizer, ctrl.Log)
+	srv.tlsConfig = tlsConfig
+	if err := mgr.Add(srv); err != nil {
+		return fmt.Errorf("failed to add incident webhook server to manager: %w", err)
+	}
+	return nil
+}
+
+// Handler returns the HTTP handler of the incident webhook.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+WebhookPath, s.handleWebhook)
+	return mux
+}
+
+// Start starts the incident webhook server and blocks until ctx is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	if s.tlsConfig == nil {
+		return fmt.Errorf("incident webhook server requires TLS")
+	}
+
+	server := &http.Server{
+		Addr:              s.addr,
+		Handler:           s.Handler(),
+		TLSConfig:         s.tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	s.log.Info("starting incident webhook server", "addr", s.addr)
+
+	errCh := make(chan error, 1)
+	go func() {
+		// The certificate comes from TLSConfig.
+		if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("incident webhook server: %w", err)
+	case <-ctx.Done():
+	}
+
+	s.log.Info("shutting down incident webhook server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
+}
+
+// NeedLeaderElection reports false: every change is an optimistic-concurrency
+// update of the incident exception, so replicas may serve webhooks concurrently.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// scope identifies the plans an incident webhook applies to.
+type scope struct {
+	plans    []string
+	selector *metav1.LabelSelector
+}
+
+// exceptionName derives a stable exception name from the scope, so every incident
+// posted for the same plans shares one exception.
+func (sc scope) exceptionName() string {
+	key := "plans:" + strings.Join(sc.plans, ",")
+	if sc.selector != nil {
+		selector, _ := metav1.LabelSelectorAsSelector(sc.selector)
+		key = "selector:" + selector.String()
+	}
+	sum := sha256.Sum256([]byte(key))
+	return exceptionPrefix + hex.EncodeToString(sum[:])[:10]
+}
+
+// parseScope reads the affected plans from the query parameters.
+func parseScope(r *http.Request) (scope, error) {
+	query := r.URL.Query()
+	plans := query["plan"]
+	selector := query.Get("selector")
+
+	switch {
+	case len(plans) > 0 && selector != "":
+		return scope{}, fmt.Errorf("only one of plan and selector may be given")
+	case selector != "":
+		sel, err := metav1.ParseToLabelSelector(selector)
+		if err != nil {
+			return scope{}, fmt.Errorf("invalid selector: %w", err)
+		}
+		return scope{selector: sel}, nil
+	case len(plans) > 0:
+		slices.Sort(plans)
+		return scope{plans: slices.Compact(plans)}, nil
+	default:
+		return scope{}, fmt.Errorf("at least one plan or a selector must be given")
+	}
+}
+
+// handleWebhook applies one incident notification.
+func (s *Server) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	namespace, source := r.PathValue("namespace"), r.PathValue("source")
+
+	token, err := auth.ExtractTokenFromHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	allowed, err := s.authorizer.Authorize(r.Context(), token, authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "create",
+		Group:     hibernatorv1alpha1.GroupVersion.Group,
+		Resource:  "scheduleexceptions",
+	})
+	if err != nil {
+		s.log.Error(err, "failed to authorize incident webhook", "namespace", namespace)
+		http.Error(w, "authorization failed", http.StatusInternalServerError)
+		return
+	}
+	if !allowed {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	parse, ok := parsers[source]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unsupported incident source %q", source), http.StatusNotFound)
+		return
+	}
+
+	sc, err := parseScope(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read payload", http.StatusBadRequest)
+		return
+	}
+	event, err := parse(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if event == nil {
+		writeResponse(w, http.StatusAccepted, Response{Action: "ignored"})
+		return
+	}
+	if strings.Contains(event.ID, ",") {
+		http.Error(w, "incident id must not contain commas", http.StatusBadRequest)
+		return
+	}
+
+	incidentID := source + "/" + event.ID
+	key := types.NamespacedName{Namespace: namespace, Name: sc.exceptionName()}
+	log := s.log.WithValues("exception", key.String(), "incident", incidentID, "summary", event.Summary)
+
+	var open []string
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		if event.Open {
+			open, err = s.openIncident(r.Context(), key, sc, incidentID)
+		} else {
+			open, err = s.resolveIncident(r.Context(), key, incidentID)
+		}
+		return err
+	})
+	if err != nil {
+		if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) {
+			log.Error(err, "incident exception rejected")
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		log.Error(err, "failed to apply incident")
+		http.Error(w, "failed to apply incident", http.StatusInternalServerError)
+		return
+	}
+
+	action := "resolved"
+	if event.Open {
+		action = "opened"
+	}
+	log.Info("incident "+action, "openIncidents", open)
+	writeResponse(w, http.StatusOK, Response{Exception: key.Name, Action: action, OpenIncidents: open})
+}
+
+// openIncident records the incident on the scope's exception, creating it or
+// re-arming an expired one. The exception stays valid for the maximum duration
+// from the latest opened incident.
+func (s *Server) openIncident(ctx context.Context, key types.NamespacedName, sc scope, incidentID string) ([]string, error) {
+	now := s.clock.Now().Truncate(time.Second)
+	until := now.Add(s.maxDuration)
+
+	var exc hibernatorv1alpha1.ScheduleException
+	if err := s.apiReader.Get(ctx, key, &exc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		exc = hibernatorv1alpha1.ScheduleException{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        key.Name,
+				Namespace:   key.Namespace,
+				Annotations: map[string]string{wellknown.AnnotationIncidents: incidentID},
+			},
+			Spec: hibernatorv1alpha1.ScheduleExceptionSpec{
+				PlanSelector: sc.selector,
+				ValidFrom:    metav1.NewTime(now),
+				ValidUntil:   metav1.NewTime(until),
+				Type:         hibernatorv1alpha1.ExceptionSuspend,
+				Windows:      allDayWindows,
+			},
+		}
+		switch len(sc.plans) {
+		case 0:
+		case 1:
+			exc.Spec.PlanRef = hibernatorv1alpha1.PlanReference{Name: sc.plans[0]}
+		default:
+			for _, plan := range sc.plans {
+				exc.Spec.PlanRefs = append(exc.Spec.PlanRefs, hibernatorv1alpha1.PlanReference{Name: plan})
+			}
+		}
+		if err := s.client.Create(ctx, &exc); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				// Created concurrently by another request; retry as an update.
+				return nil, apierrors.NewConflict(hibernatorv1alpha1.GroupVersion.WithResource("scheduleexceptions").GroupResource(), key.Name, err)
+			}
+			return nil, err
+		}
+		return []string{incidentID}, nil
+	}
+
+	open := openIncidents(&exc)
+	if !slices.Contains(open, incidentID) {
+		open = append(open, incidentID)
+	}
+	if !exc.Spec.ValidUntil.After(now) {
+		exc.Spec.ValidFrom = metav1.NewTime(now)
+	}
+	if exc.Spec.ValidUntil.Time.Before(until) {
+		exc.Spec.ValidUntil = metav1.NewTime(until)
+	}
+	setOpenIncidents(&exc, open)
+
+	return open, s.client.Update(ctx, &exc)
+}
+
+// resolveIncident removes the incident from the scope's exception and expires the
+// exception once no incident is left open.
+func (s *Server) resolveIncident(ctx context.Context, key types.NamespacedName, incidentID string) ([]string, error) {
+	var exc hibernatorv1alpha1.ScheduleException
+	if err := s.apiReader.Get(ctx, key, &exc); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	open := slices.DeleteFunc(openIncidents(&exc), func(id string) bool { return id == incidentID })
+	setOpenIncidents(&exc, open)
+
+	now := s.clock.Now().Truncate(time.Second)
+	if len(open) == 0 && exc.Spec.ValidUntil.After(now) {
+		// validUntil must stay after validFrom for the exception to pass validation.
+		until := now
+		if !until.After(exc.Spec.ValidFrom.Time) {
+			until = exc.Spec.ValidFrom.Add(time.Second)
+		}
+		exc.Spec.ValidUntil = metav1.NewTime(until)
+	}
+
+	return open, s.client.Update(ctx, &exc)
+}
+
+// openIncidents returns the incidents recorded on the exception.
+func openIncidents(exc *hibernatorv1alpha1.ScheduleException) []string {
+	value := exc.Annotations[wellknown.AnnotationIncidents]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// setOpenIncidents records the incidents on the exception.
+func setOpenIncidents(exc *hibernatorv1alpha1.ScheduleException, open []string) {
+	if exc.Annotations == nil {
+		exc.Annotations = make(map[string]string)
+	}
+	exc.Annotations[wellknown.AnnotationIncidents] = strings.Join(open, ",")
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
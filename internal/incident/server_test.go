@@ -0,0 +1,194 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/statusapi"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// reviewClientset authenticates the "pager" token and allows it to create
+// ScheduleExceptions in namespace team-a only.
+func reviewClientset() *k8sfake.Clientset {
+	cs := k8sfake.NewSimpleClientset()
+	cs.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		tr := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if tr.Spec.Token == "pager" {
+			tr.Status.Authenticated = true
+			tr.Status.User = authenticationv1.UserInfo{Username: "system:serviceaccount:ops:pagerduty"}
+		}
+		return true, tr, nil
+	})
+	cs.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attrs := sar.Spec.ResourceAttributes
+		sar.Status.Allowed = sar.Spec.User == "system:serviceaccount:ops:pagerduty" &&
+			attrs.Namespace == "team-a" && attrs.Resource == "scheduleexceptions" && attrs.Verb == "create"
+		return true, sar, nil
+	})
+	return cs
+}
+
+func newTestServer(t *testing.T, clk *clocktesting.FakeClock) (*Server, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, hibernatorv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	authorizer := statusapi.NewTokenAuthorizer(reviewClientset(), clk, time.Minute)
+	return NewServer(Options{Clock: clk, MaxDuration: 12 * time.Hour}, c, c, authorizer, logr.Discard()), c
+}
+
+func post(srv *Server, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func generic(id, status string) string {
+	return `{"id":"` + id + `","status":"` + status + `"}`
+}
+
+func getException(t *testing.T, c client.Client, name string) *hibernatorv1alpha1.ScheduleException {
+	t.Helper()
+	var exc hibernatorv1alpha1.ScheduleException
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "team-a", Name: name}, &exc))
+	return &exc
+}
+
+func TestWebhook_OpenCreatesSuspendException(t *testing.T) {
+	now := time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+	srv, c := newTestServer(t, clk)
+
+	rec := post(srv, "/v1alpha1/namespaces/team-a/incidents/pagerduty?plan=api",
+		"pager", `{"event":{"event_type":"incident.triggered","data":{"id":"Q1","title":"API down"}}}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "opened", resp.Action)
+	assert.Equal(t, []string{"pagerduty/Q1"}, resp.OpenIncidents)
+	require.True(t, strings.HasPrefix(resp.Exception, exceptionPrefix))
+
+	exc := getException(t, c, resp.Exception)
+	assert.Equal(t, hibernatorv1alpha1.ExceptionSuspend, exc.Spec.Type)
+	assert.Equal(t, "api", exc.Spec.PlanRef.Name)
+	assert.Equal(t, now, exc.Spec.ValidFrom.UTC())
+	assert.Equal(t, now.Add(12*time.Hour), exc.Spec.ValidUntil.UTC())
+	assert.Equal(t, "pagerduty/Q1", exc.Annotations[wellknown.AnnotationIncidents])
+}
+
+func TestWebhook_ExpiresAfterLastIncidentResolves(t *testing.T) {
+	now := time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+	srv, c := newTestServer(t, clk)
+	path := "/v1alpha1/namespaces/team-a/incidents/generic?plan=web&plan=api"
+
+	require.Equal(t, http.StatusOK, post(srv, path, "pager", generic("INC-1", "open")).Code)
+	clk.Step(time.Hour)
+	rec := post(srv, path, "pager", generic("INC-2", "open"))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	exc := getException(t, c, resp.Exception)
+	assert.Len(t, exc.Spec.PlanRefs, 2, "multiple plans are referenced through planRefs")
+	assert.Equal(t, now.Add(13*time.Hour), exc.Spec.ValidUntil.UTC(), "the latest incident extends the exception")
+
+	clk.Step(time.Hour)
+	require.Equal(t, http.StatusOK, post(srv, path, "pager", generic("INC-1", "resolved")).Code)
+	exc = getException(t, c, resp.Exception)
+	assert.Equal(t, "generic/INC-2", exc.Annotations[wellknown.AnnotationIncidents])
+	assert.True(t, exc.Spec.ValidUntil.After(clk.Now()), "an open incident keeps the exception valid")
+
+	require.Equal(t, http.StatusOK, post(srv, path, "pager", generic("INC-2", "resolved")).Code)
+	exc = getException(t, c, resp.Exception)
+	assert.Empty(t, exc.Annotations[wellknown.AnnotationIncidents])
+	assert.Equal(t, clk.Now(), exc.Spec.ValidUntil.UTC(), "the exception expires with the last incident")
+
+	// A new incident re-arms the expired exception from now.
+	clk.Step(time.Hour)
+	require.Equal(t, http.StatusOK, post(srv, path, "pager", generic("INC-3", "open")).Code)
+	exc = getException(t, c, resp.Exception)
+	assert.Equal(t, clk.Now(), exc.Spec.ValidFrom.UTC())
+	assert.Equal(t, clk.Now().Add(12*time.Hour), exc.Spec.ValidUntil.UTC())
+}
+
+func TestWebhook_SelectorScope(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC))
+	srv, c := newTestServer(t, clk)
+
+	rec := post(srv, "/v1alpha1/namespaces/team-a/incidents/opsgenie?selector=tier%3Dfrontend",
+		"pager", `{"action":"Create","alert":{"alertId":"a-1"}}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	exc := getException(t, c, resp.Exception)
+	require.NotNil(t, exc.Spec.PlanSelector)
+	assert.Equal(t, map[string]string{"tier": "frontend"}, exc.Spec.PlanSelector.MatchLabels)
+	assert.Empty(t, exc.Spec.PlanRef.Name)
+}
+
+func TestWebhook_RejectsInvalidRequests(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	srv, _ := newTestServer(t, clk)
+	open := generic("INC-1", "open")
+
+	assert.Equal(t, http.StatusUnauthorized, post(srv, "/v1alpha1/namespaces/team-a/incidents/generic?plan=api", "", open).Code)
+	assert.Equal(t, http.StatusForbidden, post(srv, "/v1alpha1/namespaces/team-b/incidents/generic?plan=api", "pager", open).Code)
+	assert.Equal(t, http.StatusNotFound, post(srv, "/v1alpha1/namespaces/team-a/incidents/statuspage?plan=api", "pager", open).Code)
+	assert.Equal(t, http.StatusBadRequest, post(srv, "/v1alpha1/namespaces/team-a/incidents/generic", "pager", open).Code)
+	assert.Equal(t, http.StatusBadRequest, post(srv, "/v1alpha1/namespaces/team-a/incidents/generic?plan=api&selector=a%3Db", "pager", open).Code)
+
+	rec := post(srv, "/v1alpha1/namespaces/team-a/incidents/pagerduty?plan=api", "pager",
+		`{"event":{"event_type":"incident.acknowledged","data":{"id":"Q1"}}}`)
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"ignored"`)
+}
+
+func TestScope_ExceptionNameIsStable(t *testing.T) {
+	a := scope{plans: []string{"api", "web"}}
+	b := scope{plans: []string{"api", "web"}}
+	c := scope{plans: []string{"api"}}
+
+	assert.Equal(t, a.exceptionName(), b.exceptionName())
+	assert.NotEqual(t, a.exceptionName(), c.exceptionName())
+	assert.LessOrEqual(t, len(a.exceptionName()), 63)
+}
+
+func TestSetupWithManager_RequiresTLS(t *testing.T) {
+	// Bearer tokens must not be accepted over plaintext HTTP.
+	err := SetupWithManager(nil, Options{Addr: ":8084"})
+	assert.ErrorContains(t, err, "requires TLS")
+}
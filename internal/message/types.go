@@ -98,6 +98,19 @@ type PlanContext struct {
 	// HasRestoreData indicates whether restore data exists for this plan.
 	HasRestoreData bool
 
+	// DynamicTargets indicates that the stored spec references TargetPresets or
+	// connector selectors, so Plan.Spec.Targets is the result of expanding them.
+	DynamicTargets bool
+
+	// TargetsError explains why spec.targetsFrom or connector selectors could not be
+	// expanded into a valid target list. Empty when the plan's targets resolved;
+	// Plan.Spec.Targets then holds the expanded targets.
+	TargetsError string
+
+	// ConnectorsError lists the connectors used by Plan.Spec.Targets that failed their
+	// last health check, with the reason each is not ready. Empty when all are ready.
+	ConnectorsError string
+
 	// DeliveryNonce is a monotonically increasing counter that increments whenever
 	// a dependent resource (external to the plan state itself) changes in a way that
 	// affects plan execution. Examples include Job terminal state transitions (success/failure),
@@ -120,8 +133,11 @@ func (pc *PlanContext) DeepCopy() *PlanContext {
 		return nil
 	}
 	result := &PlanContext{
-		HasRestoreData: pc.HasRestoreData,
-		DeliveryNonce:  pc.DeliveryNonce,
+		HasRestoreData:  pc.HasRestoreData,
+		DynamicTargets:  pc.DynamicTargets,
+		TargetsError:    pc.TargetsError,
+		ConnectorsError: pc.ConnectorsError,
+		DeliveryNonce:   pc.DeliveryNonce,
 	}
 	if pc.Plan != nil {
 		result.Plan = pc.Plan.DeepCopy()
@@ -147,6 +163,8 @@ func (pc *PlanContext) DeepCopy() *PlanContext {
 		result.Schedule = &ScheduleEvaluation{
 			ShouldHibernate: pc.Schedule.ShouldHibernate,
 			NextEvent:       pc.Schedule.NextEvent,
+			NextHibernate:   pc.Schedule.NextHibernate,
+			NextWakeUp:      pc.Schedule.NextWakeUp,
 			Exceptions:      schedExceptions,
 		}
 	}
@@ -173,6 +191,18 @@ func (pc *PlanContext) Equal(other *PlanContext) bool {
 		return false
 	}
 
+	if pc.DynamicTargets != other.DynamicTargets {
+		return false
+	}
+
+	if pc.TargetsError != other.TargetsError {
+		return false
+	}
+
+	if pc.ConnectorsError != other.ConnectorsError {
+		return false
+	}
+
 	if (pc.Plan == nil) != (other.Plan == nil) {
 		return false
 	}
@@ -225,6 +255,14 @@ func (pc *PlanContext) Equal(other *PlanContext) bool {
 		if !pc.Schedule.NextEvent.Equal(other.Schedule.NextEvent) {
 			return false
 		}
+
+		if !pc.Schedule.NextHibernate.Equal(other.Schedule.NextHibernate) {
+			return false
+		}
+
+		if !pc.Schedule.NextWakeUp.Equal(other.Schedule.NextWakeUp) {
+			return false
+		}
 	}
 	return true
 }
@@ -282,6 +320,14 @@ type ScheduleEvaluation struct {
 	// it only changes when the underlying schedule or exception windows change.
 	// Consumers compute time-until-event locally: time.Until(NextEvent).
 	NextEvent time.Time
+
+	// NextHibernate is the next hibernation boundary reported by the schedule
+	// evaluator, without buffers. Zero when the schedule has no upcoming hibernation.
+	NextHibernate time.Time
+
+	// NextWakeUp is the next wake-up boundary reported by the schedule evaluator,
+	// without buffers. Zero when the schedule has no upcoming wake-up.
+	NextWakeUp time.Time
 }
 
 // NotificationContext represents a single (notification, plan) binding stored in
@@ -40,6 +40,7 @@ func TestPlanContext_DeepCopy_Full_IsDeep(t *testing.T) {
 			},
 			ShouldHibernate: true,
 			NextEvent:       time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC),
+			NextWakeUp:      time.Date(2026, 1, 2, 6, 0, 0, 0, time.UTC),
 		},
 	}
 
@@ -52,6 +53,7 @@ func TestPlanContext_DeepCopy_Full_IsDeep(t *testing.T) {
 	assert.Equal(t, orig.HasRestoreData, copy.HasRestoreData)
 	assert.Equal(t, orig.Schedule.ShouldHibernate, copy.Schedule.ShouldHibernate)
 	assert.Equal(t, orig.Schedule.NextEvent, copy.Schedule.NextEvent)
+	assert.Equal(t, orig.Schedule.NextWakeUp, copy.Schedule.NextWakeUp)
 	assert.Len(t, copy.Schedule.Exceptions, 1)
 	assert.Equal(t, "ex1", copy.Schedule.Exceptions[0].Name)
 }
@@ -91,6 +93,27 @@ func TestPlanContext_Equal_DifferentHasRestoreData_IsFalse(t *testing.T) {
 	assert.False(t, a.Equal(b))
 }
 
+func TestPlanContext_Equal_DifferentTargetsError_IsFalse(t *testing.T) {
+	a := &PlanContext{TargetsError: `TargetPreset "default/eks" not found`}
+	b := &PlanContext{}
+	assert.False(t, a.Equal(b))
+	assert.Equal(t, a.TargetsError, a.DeepCopy().TargetsError)
+}
+
+func TestPlanContext_Equal_DifferentConnectorsError_IsFalse(t *testing.T) {
+	a := &PlanContext{ConnectorsError: "K8SCluster default/dev: connection refused"}
+	b := &PlanContext{}
+	assert.False(t, a.Equal(b))
+	assert.Equal(t, a.ConnectorsError, a.DeepCopy().ConnectorsError)
+}
+
+func TestPlanContext_Equal_DifferentDynamicTargets_IsFalse(t *testing.T) {
+	a := &PlanContext{DynamicTargets: true}
+	b := &PlanContext{}
+	assert.False(t, a.Equal(b))
+	assert.True(t, a.DeepCopy().DynamicTargets)
+}
+
 func TestPlanContext_Equal_DifferentPhase_IsFalse(t *testing.T) {
 	mkPlan := func(phase hibernatorv1alpha1.PlanPhase) *hibernatorv1alpha1.HibernatePlan {
 		p := &hibernatorv1alpha1.HibernatePlan{}
@@ -134,6 +157,14 @@ func TestPlanContext_Equal_SameNextEvent_IsTrue(t *testing.T) {
 	assert.True(t, a.Equal(b))
 }
 
+func TestPlanContext_Equal_DifferentNextWakeUp_IsFalse(t *testing.T) {
+	// NextWakeUp feeds the hibernate-until-wake handler; a moved boundary must be re-delivered.
+	event := time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)
+	a := &PlanContext{Schedule: &ScheduleEvaluation{NextEvent: event, NextWakeUp: time.Date(2026, 1, 2, 6, 0, 0, 0, time.UTC)}}
+	b := &PlanContext{Schedule: &ScheduleEvaluation{NextEvent: event, NextWakeUp: time.Date(2026, 1, 5, 6, 0, 0, 0, time.UTC)}}
+	assert.False(t, a.Equal(b))
+}
+
 func TestPlanContext_Equal_NilVsNonNilSchedule_IsFalse(t *testing.T) {
 	a := &PlanContext{Schedule: &ScheduleEvaluation{ShouldHibernate: true}}
 	b := &PlanContext{Schedule: nil}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PlanLabelMode controls how a HibernatePlan identity is rendered into the
+// "plan" label of per-plan metrics.
+type PlanLabelMode string
+
+const (
+	// PlanLabelModePlan labels each series with the plan's namespace/name.
+	PlanLabelModePlan PlanLabelMode = "plan"
+	// PlanLabelModeNamespace aggregates all plans of a namespace into one series.
+	PlanLabelModeNamespace PlanLabelMode = "namespace"
+	// PlanLabelModeNone aggregates every plan into a single series.
+	PlanLabelModeNone PlanLabelMode = "none"
+)
+
+const (
+	// AggregatePlanLabel is the plan label value used by PlanLabelModeNone.
+	AggregatePlanLabel = "_all"
+	// OverflowPlanLabel is the plan label value used once the configured plan
+	// limit has been reached.
+	OverflowPlanLabel = "_overflow"
+)
+
+// Reconcile outcome label values for ReconcileOutcomeTotal.
+const (
+	OutcomeSuccess        = "success"
+	OutcomeConflict       = "conflict"
+	OutcomeScheduleError  = "schedule_error"
+	OutcomeExecutionError = "execution_error"
+)
+
+// planLabeler maps plan keys to bounded "plan" label values.
+type planLabeler struct {
+	mu    sync.Mutex
+	mode  PlanLabelMode
+	limit int
+	seen  map[string]struct{}
+}
+
+var labeler = &planLabeler{
+	mode: PlanLabelModePlan,
+	seen: make(map[string]struct{}),
+}
+
+// perPlanVecs lists the metric vectors carrying a "plan" label. Their series
+// are deleted when a plan is forgotten.
+var perPlanVecs = []interface {
+	DeletePartialMatch(prometheus.Labels) int
+}{
+	ExecutionDuration,
+	ExecutionTotal,
+	ReconcileTotal,
+	ReconcileDuration,
+	ReconcileOutcomeTotal,
+	JobsCreatedTotal,
+	JobFailuresTotal,
+	EnqueueDropTotal,
+	StaleRunnerRestartTotal,
+}
+
+// ConfigurePlanLabels sets the plan label mode and, for PlanLabelModePlan and
+// PlanLabelModeNamespace, the maximum number of distinct label values. Once the
+// limit is reached further plans are reported under OverflowPlanLabel. A limit
+// of zero means unlimited. It must be called before any metric is recorded.
+func ConfigurePlanLabels(mode PlanLabelMode, limit int) error {
+	switch mode {
+	case "":
+		mode = PlanLabelModePlan
+	case PlanLabelModePlan, PlanLabelModeNamespace, PlanLabelModeNone:
+	default:
+		return fmt.Errorf("unsupported plan label mode %q (supported: %s, %s, %s)",
+			mode, PlanLabelModePlan, PlanLabelModeNamespace, PlanLabelModeNone)
+	}
+	if limit < 0 {
+		return fmt.Errorf("plan label limit must not be negative, got %d", limit)
+	}
+
+	labeler.mu.Lock()
+	defer labeler.mu.Unlock()
+	labeler.mode = mode
+	labeler.limit = limit
+	labeler.seen = make(map[string]struct{})
+	return nil
+}
+
+// PlanLabel returns the "plan" label value for key under the configured mode
+// and limit.
+func PlanLabel(key types.NamespacedName) string {
+	labeler.mu.Lock()
+	defer labeler.mu.Unlock()
+
+	var value string
+	switch labeler.mode {
+	case PlanLabelModeNone:
+		return AggregatePlanLabel
+	case PlanLabelModeNamespace:
+		value = key.Namespace
+	default:
+		value = key.String()
+	}
+
+	if labeler.limit == 0 {
+		return value
+	}
+	if _, ok := labeler.seen[value]; ok {
+		return value
+	}
+	if len(labeler.seen) >= labeler.limit {
+		return OverflowPlanLabel
+	}
+	labeler.seen[value] = struct{}{}
+	return value
+}
+
+// ForgetPlan releases the label slot held by a deleted plan and removes its
+// series from all per-plan metrics. It is a no-op for aggregated modes, where
+// the series are shared with other plans.
+func ForgetPlan(key types.NamespacedName) {
+	labeler.mu.Lock()
+	if labeler.mode != PlanLabelModePlan {
+		labeler.mu.Unlock()
+		return
+	}
+	value := key.String()
+	delete(labeler.seen, value)
+	labeler.mu.Unlock()
+
+	for _, vec := range perPlanVecs {
+		vec.DeletePartialMatch(prometheus.Labels{"plan": value})
+	}
+}
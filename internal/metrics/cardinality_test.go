@@ -0,0 +1,100 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func resetPlanLabels(t *testing.T, mode PlanLabelMode, limit int) {
+	t.Helper()
+	if err := ConfigurePlanLabels(mode, limit); err != nil {
+		t.Fatalf("ConfigurePlanLabels: %v", err)
+	}
+	t.Cleanup(func() { _ = ConfigurePlanLabels(PlanLabelModePlan, 0) })
+}
+
+func TestConfigurePlanLabels_Validation(t *testing.T) {
+	t.Cleanup(func() { _ = ConfigurePlanLabels(PlanLabelModePlan, 0) })
+
+	if err := ConfigurePlanLabels("bogus", 0); err == nil {
+		t.Error("expected error for unsupported mode")
+	}
+	if err := ConfigurePlanLabels(PlanLabelModePlan, -1); err == nil {
+		t.Error("expected error for negative limit")
+	}
+	if err := ConfigurePlanLabels("", 0); err != nil {
+		t.Errorf("empty mode should default to plan: %v", err)
+	}
+}
+
+func TestPlanLabel_Modes(t *testing.T) {
+	key := types.NamespacedName{Namespace: "team-a", Name: "dev"}
+
+	tests := []struct {
+		mode PlanLabelMode
+		want string
+	}{
+		{PlanLabelModePlan, "team-a/dev"},
+		{PlanLabelModeNamespace, "team-a"},
+		{PlanLabelModeNone, AggregatePlanLabel},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			resetPlanLabels(t, tt.mode, 0)
+			if got := PlanLabel(key); got != tt.want {
+				t.Errorf("PlanLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanLabel_Limit(t *testing.T) {
+	resetPlanLabels(t, PlanLabelModePlan, 2)
+
+	a := types.NamespacedName{Namespace: "ns", Name: "a"}
+	b := types.NamespacedName{Namespace: "ns", Name: "b"}
+	c := types.NamespacedName{Namespace: "ns", Name: "c"}
+
+	if got := PlanLabel(a); got != "ns/a" {
+		t.Errorf("PlanLabel(a) = %q", got)
+	}
+	if got := PlanLabel(b); got != "ns/b" {
+		t.Errorf("PlanLabel(b) = %q", got)
+	}
+	if got := PlanLabel(c); got != OverflowPlanLabel {
+		t.Errorf("PlanLabel(c) = %q, want %q", got, OverflowPlanLabel)
+	}
+	// Already admitted plans keep their label.
+	if got := PlanLabel(a); got != "ns/a" {
+		t.Errorf("PlanLabel(a) after overflow = %q", got)
+	}
+
+	// Forgetting a plan frees its slot.
+	ForgetPlan(a)
+	if got := PlanLabel(c); got != "ns/c" {
+		t.Errorf("PlanLabel(c) after ForgetPlan = %q, want ns/c", got)
+	}
+}
+
+func TestForgetPlan_DeletesSeries(t *testing.T) {
+	resetPlanLabels(t, PlanLabelModePlan, 0)
+
+	key := types.NamespacedName{Namespace: "ns", Name: "forget-me"}
+	label := PlanLabel(key)
+	ReconcileOutcomeTotal.WithLabelValues(label, OutcomeSuccess).Inc()
+	ReconcileTotal.WithLabelValues(label, "Active", "success").Inc()
+
+	ForgetPlan(key)
+	if ReconcileOutcomeTotal.DeleteLabelValues(label, OutcomeSuccess) {
+		t.Error("ReconcileOutcomeTotal series should have been deleted")
+	}
+	if ReconcileTotal.DeleteLabelValues(label, "Active", "success") {
+		t.Error("ReconcileTotal series should have been deleted")
+	}
+}
@@ -56,6 +56,18 @@ var (
 		[]string{"plan", "phase"},
 	)
 
+	// ReconcileOutcomeTotal counts plan processing outcomes so operators can
+	// alert on specific failing plans.
+	// Labels: plan (see PlanLabel for cardinality controls),
+	// outcome (success | conflict | schedule_error | execution_error).
+	ReconcileOutcomeTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_reconcile_outcome_total",
+			Help: "Total number of HibernatePlan reconciliations by outcome",
+		},
+		[]string{"plan", "outcome"},
+	)
+
 	// ActivePlanGauge tracks the number of active HibernatePlans
 	ActivePlanGauge = factory.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -150,9 +162,31 @@ var (
 		[]string{"type", "key"},
 	)
 
+	// StatusWriterBatchedTotal counts status updates written together with an
+	// earlier update for the same object instead of in a write of their own.
+	// Labels: type, key.
+	StatusWriterBatchedTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_status_writer_batched_total",
+			Help: "Total number of status updates coalesced into the write of an earlier update",
+		},
+		[]string{"type", "key"},
+	)
+
+	// StatusWriterConflictsTotal counts status writes rejected because the
+	// object changed since it was read. Each is retried on a fresh copy.
+	// Labels: type, key.
+	StatusWriterConflictsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_status_writer_conflicts_total",
+			Help: "Total number of status writes rejected with a conflict and retried",
+		},
+		[]string{"type", "key"},
+	)
+
 	// StatusWriterErrorsTotal counts errors encountered during status writes,
 	// broken down by the event where the error occurred.
-	// Labels: type, key, event (pre_hook | apply | post_hook).
+	// Labels: type, key, event (pre_hook | apply | externalize | post_hook).
 	StatusWriterErrorsTotal = factory.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "hibernator_status_writer_errors_total",
@@ -175,6 +209,17 @@ var (
 		[]string{"plan"},
 	)
 
+	// StaleRunnerRestartTotal counts runner pods deleted because they stopped
+	// sending heartbeats, so that their Job retries them.
+	// Labels: plan (namespace/name).
+	StaleRunnerRestartTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_stale_runner_restart_total",
+			Help: "Total number of runner pods restarted because they stopped sending heartbeats",
+		},
+		[]string{"plan"},
+	)
+
 	// NotificationSentTotal counts successfully dispatched notifications.
 	// Labels: sink_type (slack, telegram, webhook), event (Start, Success, Failure, Recovery, PhaseChange).
 	NotificationSentTotal = factory.NewCounterVec(
@@ -246,4 +291,58 @@ var (
 		},
 		[]string{"sink_name"},
 	)
+
+	// NotificationSuppressedTotal counts notifications withheld by the dispatcher's
+	// deduplication layer because an identical notification already reached its
+	// burst limit within the aggregation window.
+	// Labels: sink_type, event.
+	NotificationSuppressedTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_notification_suppressed_total",
+			Help: "Total number of duplicate notifications suppressed by deduplication",
+		},
+		[]string{"sink_type", "event"},
+	)
+
+	// EventSuppressedTotal counts Kubernetes Events withheld because an identical
+	// Event already reached its burst limit within the aggregation window.
+	// Labels: reason.
+	EventSuppressedTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_event_suppressed_total",
+			Help: "Total number of duplicate Kubernetes Events suppressed by deduplication",
+		},
+		[]string{"reason"},
+	)
+
+	// EventSinkPublishedTotal counts execution events republished to the event sink.
+	// Labels: sink (nats, kafka), type (progress, completion).
+	EventSinkPublishedTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_event_sink_published_total",
+			Help: "Total number of execution events published to the event sink",
+		},
+		[]string{"sink", "type"},
+	)
+
+	// EventSinkErrorsTotal counts execution events the event sink failed to publish.
+	// Labels: sink, type.
+	EventSinkErrorsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_event_sink_errors_total",
+			Help: "Total number of execution events that failed to publish to the event sink",
+		},
+		[]string{"sink", "type"},
+	)
+
+	// EventSinkDropTotal counts execution events dropped because the event
+	// sink's buffer was full, e.g. while the bus is unreachable.
+	// Labels: sink, type.
+	EventSinkDropTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hibernator_event_sink_drop_total",
+			Help: "Total number of execution events dropped because the event sink buffer was full",
+		},
+		[]string{"sink", "type"},
+	)
 )
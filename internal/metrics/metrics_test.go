@@ -37,6 +37,12 @@ func TestReconcileDuration_Defined(t *testing.T) {
 	}
 }
 
+func TestReconcileOutcomeTotal_Defined(t *testing.T) {
+	if ReconcileOutcomeTotal == nil {
+		t.Error("ReconcileOutcomeTotal should not be nil")
+	}
+}
+
 func TestActivePlanGauge_Defined(t *testing.T) {
 	if ActivePlanGauge == nil {
 		t.Error("ActivePlanGauge should not be nil")
@@ -102,6 +108,16 @@ func TestReconcileDuration_Labels(t *testing.T) {
 	}
 }
 
+func TestReconcileOutcomeTotal_Labels(t *testing.T) {
+	counter, err := ReconcileOutcomeTotal.GetMetricWithLabelValues("ns/my-plan", OutcomeConflict)
+	if err != nil {
+		t.Fatalf("Failed to get metric with labels: %v", err)
+	}
+	if counter == nil {
+		t.Error("Counter should not be nil")
+	}
+}
+
 func TestActivePlanGauge_Labels(t *testing.T) {
 	gauge, err := ActivePlanGauge.GetMetricWithLabelValues("Hibernated")
 	if err != nil {
@@ -148,6 +164,7 @@ func TestMetricsRegistration(t *testing.T) {
 		ExecutionTotal,
 		ReconcileTotal,
 		ReconcileDuration,
+		ReconcileOutcomeTotal,
 		ActivePlanGauge,
 		JobsCreatedTotal,
 		JobFailuresTotal,
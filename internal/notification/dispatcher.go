@@ -23,6 +23,7 @@ import (
 	"github.com/ardikabs/hibernator/internal/metrics"
 	"github.com/ardikabs/hibernator/internal/notification/sink"
 	"github.com/ardikabs/hibernator/pkg/cache"
+	"github.com/ardikabs/hibernator/pkg/dedup"
 	"github.com/ardikabs/hibernator/pkg/keyedworker"
 	"github.com/ardikabs/hibernator/pkg/ratelimit"
 )
@@ -74,6 +75,12 @@ type DispatcherConfig struct {
 	// WorkerIdleTTL is how long an idle per-stream worker stays alive before exiting.
 	// Default: 30m.
 	WorkerIdleTTL time.Duration
+
+	// Dedup bounds how many identical notifications (same notification, sink,
+	// plan, operation, event and error) are delivered per window. Repeats beyond
+	// the burst are suppressed and summarized on the next delivered one.
+	// Default: disabled (zero Window).
+	Dedup dedup.Config
 }
 
 // withDefaults returns a copy with zero fields replaced by defaults.
@@ -153,6 +160,9 @@ type Dispatcher struct {
 	// It is closed when the dispatcher shuts down to release background resources.
 	rateLimitRegistry *ratelimit.Registry
 
+	// dedup aggregates identical requests so that retry loops do not flood sinks.
+	dedup *dedup.Aggregator
+
 	// closeOnce ensures d.done is closed at most once, guarding against duplicate
 	// closure if Start() is invoked more than once.
 	closeOnce sync.Once
@@ -215,6 +225,7 @@ func NewDispatcher(log logr.Logger, c client.Reader, registry *sink.Registry, cf
 		workerIdleTTL:   cfg.WorkerIdleTTL,
 		done:            make(chan struct{}),
 		stateCache:      stateCache,
+		dedup:           dedup.New(cfg.Dedup),
 	}
 
 	for _, opt := range opts {
@@ -328,11 +339,43 @@ func (d *Dispatcher) Submit(req Request) {
 	default:
 	}
 
+	decision := d.dedup.Observe(dedupKey(req))
+	if !decision.Emit {
+		log.V(1).Info("duplicate notification suppressed")
+		metrics.NotificationSuppressedTotal.WithLabelValues(req.SinkType, req.Payload.Event).Inc()
+		return
+	}
+	if decision.Summarized() {
+		req.Payload.Repeat = &RepeatInfo{
+			Count:      decision.Count,
+			Suppressed: decision.Suppressed,
+			Since:      decision.Since,
+		}
+	}
+
 	key := streamKeyFromRequest(req)
 	log.V(1).Info("enqueueing notification request", "stream", key)
 	d.pool.Deliver(key, req)
 }
 
+// dedupKey identifies requests that are considered identical for deduplication.
+// The cycle ID and retry count are deliberately excluded so that a plan flapping
+// across retries or cycles still aggregates into a single run.
+func dedupKey(req Request) string {
+	key := fmt.Sprintf("%s|%s|%s|%s|%s|%s",
+		req.NotificationRef.String(),
+		req.SinkName,
+		req.Payload.Plan.String(),
+		req.Payload.Operation,
+		req.Payload.Event,
+		req.Payload.ErrorMessage,
+	)
+	if t := req.Payload.TargetExecution; t != nil {
+		key += fmt.Sprintf("|%s|%s|%s", t.Name, t.State, t.Message)
+	}
+	return key
+}
+
 func (d *Dispatcher) workerFactory(key streamKey, slot keyedworker.Slot[Request]) func(context.Context) {
 	return func(ctx context.Context) {
 		log := d.log.WithValues("stream", key)
@@ -435,6 +478,7 @@ func (d *Dispatcher) dispatch(ctx context.Context, req Request) {
 		SinkType:        req.SinkType,
 		Targets:         req.Payload.Targets,
 		TargetExecution: req.Payload.TargetExecution,
+		Repeat:          req.Payload.Repeat,
 	}
 	if len(req.Payload.Targets) > 0 {
 		sinkPayload.Targets = make([]TargetInfo, len(req.Payload.Targets))
@@ -33,6 +33,7 @@ import (
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	sinktypes "github.com/ardikabs/hibernator/internal/notification/sink"
 	slacksink "github.com/ardikabs/hibernator/internal/notification/sink/slack"
+	"github.com/ardikabs/hibernator/pkg/dedup"
 )
 
 // stubSink is a test double that records Send calls.
@@ -194,6 +195,54 @@ func TestDispatcher_SendsNotification(t *testing.T) {
 	assert.Equal(t, []byte(`{"webhook_url":"https://hooks.slack.com/test"}`), calls[0].Config)
 }
 
+func TestDispatcher_DedupSuppressesRepeats(t *testing.T) {
+	stub := newStubSink("slack")
+	registry := sinktypes.NewRegistry()
+	registry.Register(stub)
+
+	secret := sinkSecret("default", "slack-secret", []byte(`{}`))
+	client := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(secret).
+		Build()
+
+	d := NewDispatcher(logr.Discard(), client, registry, DispatcherConfig{
+		ChannelSize: 16,
+		Dedup:       dedup.Config{Window: time.Hour, Burst: 2},
+	})
+	startDispatcher(t, d)
+
+	failure := testPayload("Failure")
+	failure.ErrorMessage = "target db failed"
+	for i := range 5 {
+		p := failure
+		p.RetryCount = int32(i)
+		d.Submit(Request{
+			Payload:   p,
+			SinkName:  "test-slack",
+			SinkType:  "slack",
+			SecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "slack-secret"},
+		})
+	}
+	// A different event is not affected by the Failure run.
+	d.Submit(Request{
+		Payload:   testPayload("Recovery"),
+		SinkName:  "test-slack",
+		SinkType:  "slack",
+		SecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "slack-secret"},
+	})
+
+	require.True(t, stub.waitCalls(3, 2*time.Second))
+	assert.False(t, stub.waitCalls(4, 200*time.Millisecond), "repeats beyond burst should be suppressed")
+
+	events := map[string]int{}
+	for _, c := range stub.getCalls() {
+		events[c.Payload.Event]++
+		assert.Nil(t, c.Payload.Repeat)
+	}
+	assert.Equal(t, map[string]int{"Failure": 2, "Recovery": 1}, events)
+}
+
 func TestDispatcher_UnknownSinkType(t *testing.T) {
 	registry := sinktypes.NewRegistry()
 	client := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
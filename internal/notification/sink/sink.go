@@ -131,6 +131,24 @@ type Payload struct {
 
 	// SinkType is the sink provider type (e.g., "slack", "telegram", "webhook").
 	SinkType string `json:"sinkType"`
+
+	// Repeat summarizes identical notifications that the dispatcher suppressed
+	// before this one was delivered. Nil when nothing was suppressed.
+	Repeat *RepeatInfo `json:"repeat,omitempty"`
+}
+
+// RepeatInfo summarizes a run of identical notifications aggregated by the
+// dispatcher's deduplication layer, e.g. "failed 12 times since 02:00".
+type RepeatInfo struct {
+	// Count is the number of identical notifications observed since Since,
+	// including the one being delivered.
+	Count int `json:"count"`
+
+	// Suppressed is the number of those notifications that were not delivered.
+	Suppressed int `json:"suppressed"`
+
+	// Since is when the first notification of the run was observed.
+	Since time.Time `json:"since"`
 }
 
 // Sink is the interface that all notification sink providers must implement.
@@ -75,6 +75,7 @@ func (c *layoutComposer) buildDefault() []slackapi.Block {
 		).
 		AddWhen(c.payload.ErrorMessage != "",
 			slackapi.NewSectionBlock(mdText().WithText(fmt.Sprintf("*Error:* %s", c.payload.ErrorMessage)).Build(), nil, nil)).
+		AddWhenText(c.repeatLine(), c.repeatContextBlock).
 		AddWhenTextBlocks(targetLines(c.payload.Targets, c.maxTargets), func(targets string) []slackapi.Block {
 			return []slackapi.Block{
 				slackapi.NewDividerBlock(),
@@ -94,6 +95,7 @@ func (c *layoutComposer) buildCompact() []slackapi.Block {
 	return newBlockSetBuilder(5).
 		Add(slackapi.NewSectionBlock(mdText().WithText(c.compactSummary()).Build(), nil, nil)).
 		AddWhen(c.payload.ErrorMessage != "", slackapi.NewSectionBlock(mdText().WithText(fmt.Sprintf("*Error:* %s", c.payload.ErrorMessage)).Build(), nil, nil)).
+		AddWhenText(c.repeatLine(), c.repeatContextBlock).
 		AddWhenText(targets, func(v string) slackapi.Block {
 			return slackapi.NewSectionBlock(mdText().WithText(v).Build(), nil, nil)
 		}).
@@ -135,6 +137,7 @@ func (c *layoutComposer) buildProgress() []slackapi.Block {
 		AddWhen(hasScope, c.scopeContextBlock(scope)).
 		Add(slackapi.NewDividerBlock(), detailSection).
 		AddWhen(c.payload.ErrorMessage != "", slackapi.NewSectionBlock(mdText().WithText(fmt.Sprintf("*Error:* %s", c.payload.ErrorMessage)).Build(), nil, nil)).
+		AddWhenText(c.repeatLine(), c.repeatContextBlock).
 		Add(c.metaContextBlock()).
 		Build()
 }
@@ -258,7 +261,8 @@ func (c *layoutComposer) threadRootProgressLine() string {
 		switch hibernatorv1alpha1.ExecutionState(target.State) {
 		case hibernatorv1alpha1.StateCompleted,
 			hibernatorv1alpha1.StateFailed,
-			hibernatorv1alpha1.StateAborted:
+			hibernatorv1alpha1.StateAborted,
+			hibernatorv1alpha1.StateCancelled:
 			done++
 		}
 	}
@@ -325,6 +329,21 @@ func (c *layoutComposer) metaContextBlock() *slackapi.ContextBlock {
 	return slackapi.NewContextBlock("notification-meta", mdText().WithText(c.contextLine()).Build())
 }
 
+// repeatLine summarizes duplicate notifications suppressed before this one.
+// Returns an empty string when nothing was suppressed.
+func (c *layoutComposer) repeatLine() string {
+	r := c.payload.Repeat
+	if r == nil || r.Suppressed == 0 {
+		return ""
+	}
+	return fmt.Sprintf(":repeat: Occurred %d times since %s (%d suppressed)",
+		r.Count, c.formatContextTime(r.Since), r.Suppressed)
+}
+
+func (c *layoutComposer) repeatContextBlock(line string) slackapi.Block {
+	return slackapi.NewContextBlock("notification-repeat", mdText().WithText(line).Build())
+}
+
 func (c *layoutComposer) scopeContextBlock(scope string) *slackapi.ContextBlock {
 	return slackapi.NewContextBlock("notification-scope", mdText().WithText(scope).Build())
 }
@@ -149,6 +149,9 @@ type Payload = sink.Payload
 // TargetInfo holds execution state for a single target.
 type TargetInfo = sink.TargetInfo
 
+// RepeatInfo summarizes suppressed duplicate notifications.
+type RepeatInfo = sink.RepeatInfo
+
 // PlanInfo carries plan metadata for the template context.
 type PlanInfo = sink.PlanInfo
 
@@ -0,0 +1,447 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+	"github.com/ardikabs/hibernator/pkg/awsutil"
+	"github.com/ardikabs/hibernator/pkg/netutil"
+)
+
+// Event reasons recorded by the CloudProviderHealthReconciler.
+const (
+	// ReasonCredentialsValid marks a connector whose credentials validated after
+	// being unvalidated or failing.
+	ReasonCredentialsValid = "CredentialsValid"
+	// ReasonCredentialsInvalid marks a connector whose credentials were rejected or
+	// could not be loaded.
+	ReasonCredentialsInvalid = "CredentialsInvalid"
+	// ReasonCredentialsExpired marks a connector whose temporary credentials expired.
+	ReasonCredentialsExpired = "CredentialsExpired"
+	// ReasonCredentialsRotated marks a connector whose credential Secret now holds
+	// different credentials. The new credentials are validated right away.
+	ReasonCredentialsRotated = "CredentialsRotated"
+	// ReasonConnectorNotReady is recorded on HibernatePlans that use a failing connector.
+	ReasonConnectorNotReady = "ConnectorNotReady"
+)
+
+// Keys of the Secret referenced by spec.aws.auth.static, matching what runner Jobs read.
+const (
+	awsAccessKeyIDKey     = "AWS_ACCESS_KEY_ID"
+	awsSecretAccessKeyKey = "AWS_SECRET_ACCESS_KEY"
+	awsSessionTokenKey    = "AWS_SESSION_TOKEN"
+)
+
+// caBundleKey is the default key of the ConfigMap referenced by spec.network.caBundleRef.
+const caBundleKey = "ca.crt"
+
+// expiredTokenCodes are the STS error codes returned for expired temporary credentials.
+var expiredTokenCodes = map[string]bool{
+	"ExpiredToken":          true,
+	"ExpiredTokenException": true,
+}
+
+// CredentialValidator resolves connector credentials to the identity they belong to.
+type CredentialValidator interface {
+	// ValidateAWS returns the account ID and ARN the credentials in cfg resolve to.
+	ValidateAWS(ctx context.Context, cfg *awsutil.AWSConnectorConfig) (account, arn string, err error)
+}
+
+// STSValidator validates AWS credentials with STS GetCallerIdentity. The call
+// needs no IAM permissions, so it only fails for credentials AWS rejects.
+type STSValidator struct{}
+
+// ValidateAWS implements CredentialValidator. When cfg sets a role chain or
+// AssumeRoleArn the roles are assumed first, so their trust policies are
+// validated as well.
+func (STSValidator) ValidateAWS(ctx context.Context, cfg *awsutil.AWSConnectorConfig) (string, string, error) {
+	awsCfg, err := awsutil.BuildAWSConfig(ctx, cfg)
+	if err != nil {
+		return "", "", err
+	}
+
+	out, err := sts.NewFromConfig(awsCfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", "", err
+	}
+	return aws.ToString(out.Account), aws.ToString(out.Arn), nil
+}
+
+// healthResult is the outcome of validating a connector.
+type healthResult struct {
+	ready     bool
+	validated bool
+	reason    string
+	message   string
+}
+
+// CloudProviderHealthReconciler periodically validates CloudProvider credentials
+// and reports the outcome in status.ready, status.message and status.lastValidated.
+//
+// Broken connectors are reported when they break rather than when a plan next
+// runs: a Warning event is recorded on the CloudProvider and on every HibernatePlan
+// whose targets use it, directly or through a K8SCluster providerRef. Events are
+// only recorded when the outcome changes, so a connector that stays broken does not
+// produce an event every Interval.
+//
+// Static credentials are validated from the referenced Secret. Changes to that Secret
+// are watched, so rotated credentials are validated as soon as they are written
+// instead of at the next Interval, and a CredentialsRotated event records the
+// rotation. Connectors using spec.aws.auth.serviceAccount and GCP connectors get
+// their credentials from the runner ServiceAccount, which the controller cannot act
+// as; they are reported ready without validation.
+type CloudProviderHealthReconciler struct {
+	client.Client
+
+	// APIReader reads credential Secrets without caching every Secret in the cluster.
+	APIReader client.Reader
+	Clock     clock.Clock
+	Log       logr.Logger
+	Recorder  record.EventRecorder
+	Validator CredentialValidator
+
+	// Interval is how often each CloudProvider is validated again.
+	Interval time.Duration
+
+	// mu guards fingerprints, the digest of the credentials each CloudProvider was
+	// last validated with, used to tell rotations from periodic validations.
+	mu           sync.Mutex
+	fingerprints map[client.ObjectKey]string
+}
+
+// Reconcile validates the CloudProvider named by req and requeues it after Interval.
+func (r *CloudProviderHealthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("cloudprovider", req.NamespacedName)
+
+	var cp hibernatorv1alpha1.CloudProvider
+	if err := r.Get(ctx, req.NamespacedName, &cp); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forgetCredentials(req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	result := r.validate(ctx, &cp)
+	previous := cp.Status
+
+	orig := cp.DeepCopy()
+	cp.Status.Ready = result.ready
+	cp.Status.Message = result.message
+	if result.validated {
+		now := metav1.NewTime(r.Clock.Now())
+		cp.Status.LastValidated = &now
+	}
+	if err := r.Status().Patch(ctx, &cp, client.MergeFrom(orig)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("update CloudProvider status: %w", err)
+	}
+
+	if recordTransition(r.Recorder, log, &cp, previous.Ready, previous.Message, result) {
+		r.warnPlans(ctx, &cp, result.message)
+	}
+
+	return ctrl.Result{RequeueAfter: r.Interval}, nil
+}
+
+// recordTransition records an event on a connector whose health changed and
+// reports whether it started or kept failing with a new message. A connector that
+// keeps failing the same way, or keeps passing, records nothing.
+func recordTransition(recorder record.EventRecorder, log logr.Logger, obj client.Object, wasReady bool, previousMessage string, result healthResult) bool {
+	switch {
+	case !result.ready && (wasReady || previousMessage != result.message):
+		log.Info("connector failed validation", "reason", result.reason, "message", result.message)
+		recorder.Event(obj, corev1.EventTypeWarning, result.reason, result.message)
+		return true
+	case result.ready && result.validated && !wasReady:
+		log.Info("connector validated", "message", result.message)
+		recorder.Event(obj, corev1.EventTypeNormal, result.reason, result.message)
+	}
+	return false
+}
+
+// validate checks the credentials of cp. It never returns an error: every failure
+// is a reason for the connector not to be ready.
+func (r *CloudProviderHealthReconciler) validate(ctx context.Context, cp *hibernatorv1alpha1.CloudProvider) healthResult {
+	invalid := func(format string, args ...any) healthResult {
+		return healthResult{validated: true, reason: ReasonCredentialsInvalid, message: fmt.Sprintf(format, args...)}
+	}
+
+	if cp.Spec.Type == hibernatorv1alpha1.CloudProviderGCP {
+		if cp.Spec.GCP == nil {
+			return invalid("spec.gcp is required for type gcp")
+		}
+		return healthResult{
+			ready:   true,
+			reason:  ReasonCredentialsValid,
+			message: "credentials come from the runner ServiceAccount and are not validated by the controller",
+		}
+	}
+	if cp.Spec.Type != hibernatorv1alpha1.CloudProviderAWS {
+		return invalid("unsupported cloud provider type %q", cp.Spec.Type)
+	}
+	if cp.Spec.AWS == nil {
+		return invalid("spec.aws is required for type aws")
+	}
+
+	spec := cp.Spec.AWS
+	if spec.Auth.Static == nil {
+		return healthResult{
+			ready:   true,
+			reason:  ReasonCredentialsValid,
+			message: "credentials come from the runner ServiceAccount and are not validated by the controller",
+		}
+	}
+
+	cfg, err := staticAWSConfig(ctx, r.APIReader, cp)
+	if err != nil {
+		return invalid("%v", err)
+	}
+	r.observeCredentials(cp, cfg)
+
+	account, arn, err := r.Validator.ValidateAWS(ctx, cfg)
+	if err != nil {
+		// API errors are reported by code and message only; the full error carries a
+		// request ID that would make every failed validation look like a new failure.
+		var apiErr smithy.APIError
+		if !errors.As(err, &apiErr) {
+			return invalid("validate credentials: %v", err)
+		}
+		if expiredTokenCodes[apiErr.ErrorCode()] {
+			return healthResult{validated: true, reason: ReasonCredentialsExpired, message: fmt.Sprintf("credentials expired: %s", apiErr.ErrorMessage())}
+		}
+		return invalid("validate credentials: %s: %s", apiErr.ErrorCode(), apiErr.ErrorMessage())
+	}
+	if account != spec.AccountId {
+		return invalid("credentials resolve to account %s, expected %s", account, spec.AccountId)
+	}
+
+	return healthResult{ready: true, validated: true, reason: ReasonCredentialsValid, message: fmt.Sprintf("authenticated as %s", arn)}
+}
+
+// staticAWSConfig builds the connector config of cp from the Secret referenced by
+// spec.aws.auth.static.
+func staticAWSConfig(ctx context.Context, reader client.Reader, cp *hibernatorv1alpha1.CloudProvider) (*awsutil.AWSConnectorConfig, error) {
+	spec := cp.Spec.AWS
+	ref := spec.Auth.Static.SecretRef
+	key := client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+	if key.Namespace == "" {
+		key.Namespace = cp.Namespace
+	}
+
+	var secret corev1.Secret
+	if err := reader.Get(ctx, key, &secret); err != nil {
+		return nil, fmt.Errorf("get Secret %s: %w", key, err)
+	}
+
+	cfg := &awsutil.AWSConnectorConfig{
+		Region:          spec.Region,
+		AccountID:       spec.AccountId,
+		AssumeRoleArn:   spec.AssumeRoleArn,
+		AccessKeyID:     string(secret.Data[awsAccessKeyIDKey]),
+		SecretAccessKey: string(secret.Data[awsSecretAccessKeyKey]),
+		SessionToken:    string(secret.Data[awsSessionTokenKey]),
+	}
+	for _, step := range spec.Auth.RoleChain {
+		cfg.RoleChain = append(cfg.RoleChain, awsutil.AssumeRoleStep{
+			RoleArn:     step.RoleArn,
+			ExternalID:  step.ExternalID,
+			SessionTags: step.SessionTags,
+		})
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("secret %s must include %s and %s", key, awsAccessKeyIDKey, awsSecretAccessKeyKey)
+	}
+
+	network, err := connectorNetworkConfig(ctx, reader, cp.Namespace, cp.Spec.Network)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Network = network
+	return cfg, nil
+}
+
+// connectorNetworkConfig resolves the proxy and CA bundle of a connector the way
+// runners do, so health checks reach the same endpoints through the same proxy.
+func connectorNetworkConfig(ctx context.Context, reader client.Reader, namespace string, network *hibernatorv1alpha1.ConnectorNetwork) (*netutil.Config, error) {
+	if network == nil {
+		return nil, nil
+	}
+
+	cfg := &netutil.Config{}
+	if network.Proxy != nil {
+		cfg.ProxyURL = network.Proxy.URL
+		cfg.NoProxy = network.Proxy.NoProxy
+	}
+	if ref := network.CABundleRef; ref != nil {
+		key := client.ObjectKey{Namespace: namespaceOr(ref.Namespace, namespace), Name: ref.Name}
+		var cm corev1.ConfigMap
+		if err := reader.Get(ctx, key, &cm); err != nil {
+			return nil, fmt.Errorf("get CA bundle ConfigMap %s: %w", key, err)
+		}
+		dataKey := ref.Key
+		if dataKey == "" {
+			dataKey = caBundleKey
+		}
+		if cm.Data[dataKey] == "" {
+			return nil, fmt.Errorf("CA bundle ConfigMap %s missing %s key", key, dataKey)
+		}
+		cfg.CABundle = []byte(cm.Data[dataKey])
+	}
+	return cfg, nil
+}
+
+// observeCredentials remembers the credentials cp is validated with, and records a
+// CredentialsRotated event when they differ from the previous validation. The first
+// validation after the controller starts records nothing, as there is nothing to
+// compare with.
+func (r *CloudProviderHealthReconciler) observeCredentials(cp *hibernatorv1alpha1.CloudProvider, cfg *awsutil.AWSConnectorConfig) {
+	sum := sha256.Sum256([]byte(cfg.AccessKeyID + "\x00" + cfg.SecretAccessKey + "\x00" + cfg.SessionToken))
+	fingerprint := hex.EncodeToString(sum[:])
+	key := client.ObjectKeyFromObject(cp)
+
+	r.mu.Lock()
+	previous, seen := r.fingerprints[key]
+	if r.fingerprints == nil {
+		r.fingerprints = map[client.ObjectKey]string{}
+	}
+	r.fingerprints[key] = fingerprint
+	r.mu.Unlock()
+
+	if seen && previous != fingerprint {
+		ref := cp.Spec.AWS.Auth.Static.SecretRef
+		r.Log.Info("credentials rotated", "cloudprovider", key, "secret", ref.Name)
+		r.Recorder.Eventf(cp, corev1.EventTypeNormal, ReasonCredentialsRotated,
+			"credentials in Secret %s/%s changed, validating access key %s", namespaceOr(ref.Namespace, cp.Namespace), ref.Name, cfg.AccessKeyID)
+	}
+}
+
+// forgetCredentials drops the remembered credentials of a deleted CloudProvider.
+func (r *CloudProviderHealthReconciler) forgetCredentials(key client.ObjectKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.fingerprints, key)
+}
+
+// cloudProviderSecretRefIndexer indexes CloudProviders by the Secret holding their
+// static credentials, as "<namespace>/<name>".
+func cloudProviderSecretRefIndexer(obj client.Object) []string {
+	cp, ok := obj.(*hibernatorv1alpha1.CloudProvider)
+	if !ok || cp.Spec.AWS == nil || cp.Spec.AWS.Auth.Static == nil {
+		return nil
+	}
+	ref := cp.Spec.AWS.Auth.Static.SecretRef
+	return []string{client.ObjectKey{Namespace: namespaceOr(ref.Namespace, cp.Namespace), Name: ref.Name}.String()}
+}
+
+// findCloudProvidersForSecret returns reconcile requests for the CloudProviders
+// whose static credentials are stored in the Secret.
+func (r *CloudProviderHealthReconciler) findCloudProvidersForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	var list hibernatorv1alpha1.CloudProviderList
+	if err := r.List(ctx, &list, client.MatchingFields{
+		wellknown.FieldIndexCloudProviderSecretRef: client.ObjectKeyFromObject(obj).String(),
+	}); err != nil {
+		r.Log.Error(err, "failed to list CloudProviders for secret", "secret", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for i := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&list.Items[i])})
+	}
+	return requests
+}
+
+// warnPlans records a ConnectorNotReady event on every HibernatePlan with a target
+// that uses cp, either directly or through a K8SCluster that references it.
+func (r *CloudProviderHealthReconciler) warnPlans(ctx context.Context, cp *hibernatorv1alpha1.CloudProvider, message string) {
+	log := r.Log.WithValues("cloudprovider", client.ObjectKeyFromObject(cp))
+
+	refs := map[string]map[client.ObjectKey]bool{
+		"CloudProvider": {client.ObjectKeyFromObject(cp): true},
+		"K8SCluster":    {},
+	}
+
+	var clusters hibernatorv1alpha1.K8SClusterList
+	if err := r.List(ctx, &clusters); err != nil {
+		log.Error(err, "failed to list K8SClusters for connector")
+		return
+	}
+	for _, kc := range clusters.Items {
+		ref := kc.Spec.ProviderRef
+		if ref == nil || ref.Name != cp.Name || namespaceOr(ref.Namespace, kc.Namespace) != cp.Namespace {
+			continue
+		}
+		refs["K8SCluster"][client.ObjectKeyFromObject(&kc)] = true
+	}
+
+	var plans hibernatorv1alpha1.HibernatePlanList
+	if err := r.List(ctx, &plans); err != nil {
+		log.Error(err, "failed to list plans for connector")
+		return
+	}
+	for i := range plans.Items {
+		plan := &plans.Items[i]
+		for _, target := range plan.Spec.Targets {
+			ref := target.ConnectorRef
+			key := client.ObjectKey{Namespace: namespaceOr(ref.Namespace, plan.Namespace), Name: ref.Name}
+			if ref.Name == "" || !refs[ref.Kind][key] {
+				continue
+			}
+			r.Recorder.Eventf(plan, corev1.EventTypeWarning, ReasonConnectorNotReady,
+				"CloudProvider %s/%s used by target %q is not ready: %s", cp.Namespace, cp.Name, target.Name, message)
+			break
+		}
+	}
+}
+
+// SetupWithManager registers the reconciler. Status updates do not change the
+// generation, so the reconciler's own writes do not trigger another validation.
+// Secrets are watched as metadata only: the controller learns that a credential
+// Secret changed without caching the contents of every Secret in the cluster.
+func (r *CloudProviderHealthReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&hibernatorv1alpha1.CloudProvider{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findCloudProvidersForSecret),
+			builder.OnlyMetadata,
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Named("cloudprovider-health").
+		Complete(r)
+}
+
+// namespaceOr returns namespace, or fallback when namespace is empty.
+func namespaceOr(namespace, fallback string) string {
+	if namespace != "" {
+		return namespace
+	}
+	return fallback
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+	"github.com/ardikabs/hibernator/pkg/awsutil"
+)
+
+// fakeValidator resolves every credential to account, or fails with err.
+type fakeValidator struct {
+	account string
+	err     error
+	calls   []*awsutil.AWSConnectorConfig
+}
+
+func (v *fakeValidator) ValidateAWS(_ context.Context, cfg *awsutil.AWSConnectorConfig) (string, string, error) {
+	v.calls = append(v.calls, cfg)
+	if v.err != nil {
+		return "", "", v.err
+	}
+	return v.account, "arn:aws:iam::" + v.account + ":user/hibernator", nil
+}
+
+func staticCloudProvider() *hibernatorv1alpha1.CloudProvider {
+	return &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-prod", Namespace: "default"},
+		Spec: hibernatorv1alpha1.CloudProviderSpec{
+			Type: hibernatorv1alpha1.CloudProviderAWS,
+			AWS: &hibernatorv1alpha1.AWSConfig{
+				AccountId:     "123456789012",
+				Region:        "us-east-1",
+				AssumeRoleArn: "arn:aws:iam::123456789012:role/hibernator",
+				Auth: hibernatorv1alpha1.AWSAuth{
+					Static: &hibernatorv1alpha1.StaticAuth{
+						SecretRef: hibernatorv1alpha1.SecretReference{Name: "aws-creds"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func awsCredentialsSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-creds", Namespace: "default"},
+		Data: map[string][]byte{
+			awsAccessKeyIDKey:     []byte("AKIA"),
+			awsSecretAccessKeyKey: []byte("secret"),
+			awsSessionTokenKey:    []byte("session"),
+		},
+	}
+}
+
+func newHealthReconciler(clk *clocktesting.FakeClock, validator CredentialValidator, objs ...client.Object) (*CloudProviderHealthReconciler, client.Client, *record.FakeRecorder) {
+	c := fake.NewClientBuilder().
+		WithScheme(newProviderTestScheme()).
+		WithObjects(objs...).
+		WithStatusSubresource(&hibernatorv1alpha1.CloudProvider{}).
+		WithIndex(&hibernatorv1alpha1.CloudProvider{}, wellknown.FieldIndexCloudProviderSecretRef, cloudProviderSecretRefIndexer).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+
+	return &CloudProviderHealthReconciler{
+		Client:    c,
+		APIReader: c,
+		Clock:     clk,
+		Log:       logr.Discard(),
+		Recorder:  recorder,
+		Validator: validator,
+		Interval:  5 * time.Minute,
+	}, c, recorder
+}
+
+func reconcileHealth(t *testing.T, r *CloudProviderHealthReconciler, c client.Client) *hibernatorv1alpha1.CloudProvider {
+	t.Helper()
+
+	key := types.NamespacedName{Namespace: "default", Name: "aws-prod"}
+	res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, res.RequeueAfter)
+
+	var cp hibernatorv1alpha1.CloudProvider
+	require.NoError(t, c.Get(context.Background(), key, &cp))
+	return &cp
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestCloudProviderHealth_ValidStaticCredentials(t *testing.T) {
+	now := time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+	validator := &fakeValidator{account: "123456789012"}
+	r, c, recorder := newHealthReconciler(clk, validator, staticCloudProvider(), awsCredentialsSecret())
+
+	cp := reconcileHealth(t, r, c)
+	assert.True(t, cp.Status.Ready)
+	assert.Contains(t, cp.Status.Message, "arn:aws:iam::123456789012:user/hibernator")
+	require.NotNil(t, cp.Status.LastValidated)
+	assert.Equal(t, now, cp.Status.LastValidated.UTC())
+
+	require.Len(t, validator.calls, 1)
+	assert.Equal(t, "AKIA", validator.calls[0].AccessKeyID)
+	assert.Equal(t, "session", validator.calls[0].SessionToken)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/hibernator", validator.calls[0].AssumeRoleArn)
+
+	events := drainEvents(recorder)
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0], "Normal "+ReasonCredentialsValid)
+
+	// Staying healthy refreshes lastValidated without another event.
+	clk.Step(5 * time.Minute)
+	cp = reconcileHealth(t, r, c)
+	assert.Equal(t, clk.Now(), cp.Status.LastValidated.UTC())
+	assert.Empty(t, drainEvents(recorder))
+}
+
+func TestCloudProviderHealth_ExpiredCredentialsWarnPlans(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC))
+	validator := &fakeValidator{err: &smithy.GenericAPIError{Code: "ExpiredToken", Message: "The security token included in the request is expired"}}
+
+	cluster := &hibernatorv1alpha1.K8SCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod-eks", Namespace: "apps"},
+		Spec: hibernatorv1alpha1.K8SClusterSpec{
+			ProviderRef: &hibernatorv1alpha1.ProviderRef{Name: "aws-prod", Namespace: "default"},
+			EKS:         &hibernatorv1alpha1.EKSConfig{Name: "prod", Region: "us-east-1"},
+		},
+	}
+	direct := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}}
+	direct.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "rds", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws-prod"}},
+	}
+	viaCluster := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "nodes", Namespace: "apps"}}
+	viaCluster.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "eks", Type: "eks", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "prod-eks"}},
+	}
+	unrelated := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "apps"}}
+	unrelated.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "rds", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws-prod"}},
+	}
+
+	r, c, recorder := newHealthReconciler(clk, validator,
+		staticCloudProvider(), awsCredentialsSecret(), cluster, direct, viaCluster, unrelated)
+
+	cp := reconcileHealth(t, r, c)
+	assert.False(t, cp.Status.Ready)
+	assert.Contains(t, cp.Status.Message, "credentials expired")
+	require.NotNil(t, cp.Status.LastValidated)
+
+	events := drainEvents(recorder)
+	require.Len(t, events, 3, "one event on the connector and one per plan using it")
+	assert.Contains(t, events[0], "Warning "+ReasonCredentialsExpired)
+	for _, e := range events[1:] {
+		assert.Contains(t, e, "Warning "+ReasonConnectorNotReady)
+	}
+
+	// A connector that stays broken is not reported again.
+	clk.Step(5 * time.Minute)
+	reconcileHealth(t, r, c)
+	assert.Empty(t, drainEvents(recorder))
+
+	// Recovery is reported once.
+	validator.err = nil
+	validator.account = "123456789012"
+	cp = reconcileHealth(t, r, c)
+	assert.True(t, cp.Status.Ready)
+	events = drainEvents(recorder)
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0], "Normal "+ReasonCredentialsValid)
+}
+
+func TestCloudProviderHealth_InvalidConfigurations(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator *fakeValidator
+		secret    *corev1.Secret
+		want      string
+	}{
+		{
+			name:      "account mismatch",
+			validator: &fakeValidator{account: "999999999999"},
+			secret:    awsCredentialsSecret(),
+			want:      "credentials resolve to account 999999999999, expected 123456789012",
+		},
+		{
+			name:      "rejected credentials",
+			validator: &fakeValidator{err: &smithy.GenericAPIError{Code: "InvalidClientTokenId", Message: "The security token included in the request is invalid."}},
+			secret:    awsCredentialsSecret(),
+			want:      "validate credentials: InvalidClientTokenId: The security token included in the request is invalid.",
+		},
+		{
+			name:      "missing secret",
+			validator: &fakeValidator{account: "123456789012"},
+			want:      "get Secret default/aws-creds",
+		},
+		{
+			name:      "incomplete secret",
+			validator: &fakeValidator{account: "123456789012"},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-creds", Namespace: "default"},
+				Data:       map[string][]byte{awsAccessKeyIDKey: []byte("AKIA")},
+			},
+			want: "must include AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs := []client.Object{staticCloudProvider()}
+			if tt.secret != nil {
+				objs = append(objs, tt.secret)
+			}
+			r, c, recorder := newHealthReconciler(clocktesting.NewFakeClock(time.Now()), tt.validator, objs...)
+
+			cp := reconcileHealth(t, r, c)
+			assert.False(t, cp.Status.Ready)
+			assert.Contains(t, cp.Status.Message, tt.want)
+
+			events := drainEvents(recorder)
+			require.Len(t, events, 1)
+			assert.Contains(t, events[0], "Warning "+ReasonCredentialsInvalid)
+		})
+	}
+}
+
+func TestCloudProviderHealth_ServiceAccountAuthIsNotValidated(t *testing.T) {
+	cp := staticCloudProvider()
+	cp.Spec.AWS.Auth = hibernatorv1alpha1.AWSAuth{ServiceAccount: &hibernatorv1alpha1.ServiceAccountAuth{}}
+	validator := &fakeValidator{account: "123456789012"}
+	r, c, recorder := newHealthReconciler(clocktesting.NewFakeClock(time.Now()), validator, cp)
+
+	got := reconcileHealth(t, r, c)
+	assert.True(t, got.Status.Ready)
+	assert.Nil(t, got.Status.LastValidated)
+	assert.Contains(t, got.Status.Message, "runner ServiceAccount")
+	assert.Empty(t, validator.calls)
+	assert.Empty(t, drainEvents(recorder))
+}
+
+func TestCloudProviderHealth_GCPIsNotValidated(t *testing.T) {
+	cp := staticCloudProvider()
+	cp.Spec = hibernatorv1alpha1.CloudProviderSpec{
+		Type: hibernatorv1alpha1.CloudProviderGCP,
+		GCP: &hibernatorv1alpha1.GCPConfig{
+			ProjectID: "prod",
+			Auth: hibernatorv1alpha1.GCPAuth{
+				WorkloadIdentityFederation: &hibernatorv1alpha1.GCPWorkloadIdentityFederation{
+					Audience: "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod",
+				},
+			},
+		},
+	}
+	validator := &fakeValidator{account: "123456789012"}
+	r, c, recorder := newHealthReconciler(clocktesting.NewFakeClock(time.Now()), validator, cp)
+
+	got := reconcileHealth(t, r, c)
+	assert.True(t, got.Status.Ready)
+	assert.Nil(t, got.Status.LastValidated)
+	assert.Empty(t, validator.calls)
+	assert.Empty(t, drainEvents(recorder))
+}
+
+func TestCloudProviderHealth_RotatedCredentials(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC))
+	validator := &fakeValidator{account: "123456789012"}
+	secret := awsCredentialsSecret()
+	r, c, recorder := newHealthReconciler(clk, validator, staticCloudProvider(), secret)
+
+	reconcileHealth(t, r, c)
+	drainEvents(recorder)
+
+	// Revalidating unchanged credentials is not a rotation.
+	reconcileHealth(t, r, c)
+	assert.Empty(t, drainEvents(recorder))
+
+	secret.Data[awsAccessKeyIDKey] = []byte("AKIANEW")
+	require.NoError(t, c.Update(context.Background(), secret))
+
+	requests := r.findCloudProvidersForSecret(context.Background(), secret)
+	require.Len(t, requests, 1)
+	assert.Equal(t, types.NamespacedName{Namespace: "default", Name: "aws-prod"}, requests[0].NamespacedName)
+
+	// Rotated credentials that fail are reported right away.
+	validator.err = &smithy.GenericAPIError{Code: "InvalidClientTokenId", Message: "The security token included in the request is invalid."}
+	cp := reconcileHealth(t, r, c)
+	assert.False(t, cp.Status.Ready)
+	assert.Equal(t, "AKIANEW", validator.calls[len(validator.calls)-1].AccessKeyID)
+
+	events := drainEvents(recorder)
+	require.Len(t, events, 2)
+	assert.Contains(t, events[0], "Normal "+ReasonCredentialsRotated)
+	assert.Contains(t, events[0], "AKIANEW")
+	assert.Contains(t, events[1], "Warning "+ReasonCredentialsInvalid)
+}
+
+func TestFindCloudProvidersForSecret_IgnoresOtherSecrets(t *testing.T) {
+	r, _, _ := newHealthReconciler(clocktesting.NewFakeClock(time.Now()), &fakeValidator{}, staticCloudProvider())
+
+	other := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "aws-creds", Namespace: "apps"}}
+	assert.Empty(t, r.findCloudProvidersForSecret(context.Background(), other))
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// ClusterPlanReconciler fans a ClusterHibernatePlan out to one HibernatePlan per
+// selected namespace. Unlike the HibernatePlan provider it writes the generated
+// plans itself: they are plain desired state without a phase machine, and each
+// of them is then driven by the regular plan pipeline.
+type ClusterPlanReconciler struct {
+	client.Client
+
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Statuses statusprocessor.Updater[*hibernatorv1alpha1.ClusterHibernatePlan]
+}
+
+// +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=clusterhibernateplans,verbs=get;list;watch
+// +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=clusterhibernateplans/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// Reconcile creates or updates the generated plan in every selected namespace,
+// deletes generated plans in namespaces that no longer match, and records the
+// result in the ClusterHibernatePlan status.
+func (r *ClusterPlanReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("clusterPlan", req.Name)
+
+	cp := new(hibernatorv1alpha1.ClusterHibernatePlan)
+	if err := r.Get(ctx, req.NamespacedName, cp); err != nil {
+		// Generated plans are garbage-collected through their owner reference.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !cp.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&cp.Spec.NamespaceSelector)
+	if err != nil {
+		// The validation webhook rejects invalid selectors; nothing to retry.
+		log.Error(err, "invalid namespace selector")
+		return ctrl.Result{}, nil
+	}
+
+	var namespaces corev1.NamespaceList
+	if err := r.List(ctx, &namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("list namespaces: %w", err)
+	}
+
+	var generated hibernatorv1alpha1.HibernatePlanList
+	if err := r.List(ctx, &generated, client.MatchingLabels{wellknown.LabelClusterPlan: cp.Name}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("list generated plans: %w", err)
+	}
+
+	// The template's suspend flag is only pushed to existing plans when the
+	// ClusterHibernatePlan spec changed, so generated plans can be suspended
+	// and resumed one namespace at a time in between.
+	specChanged := cp.Status.ObservedGeneration != cp.Generation
+
+	selected := make(map[string]bool, len(namespaces.Items))
+	members := make([]hibernatorv1alpha1.ClusterPlanMember, 0, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		if ns.Status.Phase == corev1.NamespaceTerminating {
+			continue
+		}
+		selected[ns.Name] = true
+
+		member := hibernatorv1alpha1.ClusterPlanMember{Namespace: ns.Name, Name: cp.Name}
+		plan, err := r.applyPlan(ctx, cp, ns.Name, specChanged)
+		if err != nil {
+			log.Error(err, "failed to apply generated plan", "namespace", ns.Name)
+			member.Message = err.Error()
+		} else {
+			member.Phase = plan.Status.Phase
+		}
+		members = append(members, member)
+	}
+
+	for i := range generated.Items {
+		plan := &generated.Items[i]
+		if selected[plan.Namespace] || !metav1.IsControlledBy(plan, cp) {
+			continue
+		}
+		if err := r.Delete(ctx, plan); client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("delete generated plan %s: %w", client.ObjectKeyFromObject(plan), err)
+		}
+		log.Info("deleted generated plan of deselected namespace", "namespace", plan.Namespace)
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].Namespace < members[j].Namespace })
+
+	generation := cp.Generation
+	r.Statuses.Send(statusprocessor.Update[*hibernatorv1alpha1.ClusterHibernatePlan]{
+		NamespacedName: req.NamespacedName,
+		Resource:       cp,
+		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.ClusterHibernatePlan](func(cp *hibernatorv1alpha1.ClusterHibernatePlan) {
+			cp.Status.ObservedGeneration = generation
+			cp.Status.SelectedNamespaces = int32(len(members))
+			cp.Status.Plans = members
+		}),
+	})
+
+	return ctrl.Result{}, nil
+}
+
+// applyPlan creates the generated plan in namespace or brings an existing one in
+// line with the template. A plan with the same name that is not controlled by cp
+// is left untouched and reported as a conflict.
+func (r *ClusterPlanReconciler) applyPlan(ctx context.Context, cp *hibernatorv1alpha1.ClusterHibernatePlan, namespace string, specChanged bool) (*hibernatorv1alpha1.HibernatePlan, error) {
+	plan := new(hibernatorv1alpha1.HibernatePlan)
+	err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cp.Name}, plan)
+	if apierrors.IsNotFound(err) {
+		plan = &hibernatorv1alpha1.HibernatePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: cp.Name, Namespace: namespace},
+		}
+		renderPlan(cp, plan, true)
+		if err := controllerutil.SetControllerReference(cp, plan, r.Scheme); err != nil {
+			return nil, fmt.Errorf("set owner reference: %w", err)
+		}
+		if err := r.Create(ctx, plan); err != nil {
+			return nil, fmt.Errorf("create plan: %w", err)
+		}
+		return plan, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get plan: %w", err)
+	}
+
+	if !metav1.IsControlledBy(plan, cp) {
+		return nil, fmt.Errorf("HibernatePlan %s/%s already exists and is not managed by this ClusterHibernatePlan", namespace, cp.Name)
+	}
+
+	desired := plan.DeepCopy()
+	renderPlan(cp, desired, specChanged)
+	if equality.Semantic.DeepEqual(plan.Spec, desired.Spec) &&
+		maps.Equal(plan.Labels, desired.Labels) &&
+		maps.Equal(plan.Annotations, desired.Annotations) {
+		return plan, nil
+	}
+
+	if err := r.Update(ctx, desired); err != nil {
+		return nil, fmt.Errorf("update plan: %w", err)
+	}
+	return desired, nil
+}
+
+// renderPlan writes cp's template into plan. Template labels and annotations are
+// merged into the plan's own, so annotations set on a generated plan (e.g. by
+// the CLI) survive. The shard label of cp is copied so the plan is reconciled
+// by the same shard. The plan's suspend flag is kept unless applySuspend is set.
+func renderPlan(cp *hibernatorv1alpha1.ClusterHibernatePlan, plan *hibernatorv1alpha1.HibernatePlan, applySuspend bool) {
+	suspend := plan.Spec.Suspend
+	plan.Spec = *cp.Spec.Template.Spec.DeepCopy()
+	if !applySuspend {
+		plan.Spec.Suspend = suspend
+	}
+
+	if plan.Labels == nil {
+		plan.Labels = make(map[string]string)
+	}
+	maps.Copy(plan.Labels, cp.Spec.Template.Metadata.Labels)
+	plan.Labels[wellknown.LabelClusterPlan] = cp.Name
+	if shard, ok := cp.Labels[wellknown.LabelShard]; ok {
+		plan.Labels[wellknown.LabelShard] = shard
+	}
+
+	if len(cp.Spec.Template.Metadata.Annotations) > 0 {
+		if plan.Annotations == nil {
+			plan.Annotations = make(map[string]string)
+		}
+		maps.Copy(plan.Annotations, cp.Spec.Template.Metadata.Annotations)
+	}
+}
+
+// findClusterPlansForNamespace returns reconcile requests for every
+// ClusterHibernatePlan when a namespace is created, deleted or relabeled; the
+// reconciler works out which of them select it.
+func (r *ClusterPlanReconciler) findClusterPlansForNamespace(ctx context.Context, _ client.Object) []reconcile.Request {
+	var list hibernatorv1alpha1.ClusterHibernatePlanList
+	if err := r.List(ctx, &list); err != nil {
+		r.Log.Error(err, "failed to list cluster plans for namespace")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for i := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&list.Items[i])})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the ClusterHibernatePlan reconciler with the Manager.
+func (r *ClusterPlanReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// namespaceChangedPredicate ignores namespace updates other than label
+	// changes, which are the only ones that can change selection.
+	namespaceChangedPredicate := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !maps.Equal(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status writes do not bump the generation, so they do not re-trigger.
+		For(&hibernatorv1alpha1.ClusterHibernatePlan{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Generated plans are watched in full: spec edits are reverted, and phase
+		// changes are mirrored into the ClusterHibernatePlan status.
+		Owns(&hibernatorv1alpha1.HibernatePlan{}).
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.findClusterPlansForNamespace),
+			builder.WithPredicates(namespaceChangedPredicate),
+		).
+		Named("clusterhibernateplan").
+		Complete(r)
+}
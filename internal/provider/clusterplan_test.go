@@ -0,0 +1,201 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// captureUpdater applies sent mutators to the update's resource and keeps the
+// last update so tests can inspect the reported status.
+type captureUpdater[T client.Object] struct {
+	updates []statusprocessor.Update[T]
+}
+
+func (u *captureUpdater[T]) Send(upd statusprocessor.Update[T]) {
+	if upd.Mutator != nil {
+		upd.Mutator.Mutate(upd.Resource)
+	}
+	u.updates = append(u.updates, upd)
+}
+
+func newClusterPlanReconciler(objs ...client.Object) (*ClusterPlanReconciler, *captureUpdater[*hibernatorv1alpha1.ClusterHibernatePlan]) {
+	scheme := newProviderTestScheme()
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&hibernatorv1alpha1.ClusterHibernatePlan{}, &hibernatorv1alpha1.HibernatePlan{}).
+		Build()
+
+	statuses := &captureUpdater[*hibernatorv1alpha1.ClusterHibernatePlan]{}
+	return &ClusterPlanReconciler{
+		Client:   fakeClient,
+		Log:      logr.Discard(),
+		Scheme:   scheme,
+		Statuses: statuses,
+	}, statuses
+}
+
+func testNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func testClusterPlan() *hibernatorv1alpha1.ClusterHibernatePlan {
+	return &hibernatorv1alpha1.ClusterHibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", UID: "cp-uid", Generation: 1},
+		Spec: hibernatorv1alpha1.ClusterHibernatePlanSpec{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			Template: hibernatorv1alpha1.HibernatePlanTemplate{
+				Metadata: hibernatorv1alpha1.PlanTemplateMetadata{Labels: map[string]string{"team": "platform"}},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: hibernatorv1alpha1.Schedule{
+						Timezone: "UTC",
+						OffHours: []hibernatorv1alpha1.OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON"}}},
+					},
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+				},
+			},
+		},
+	}
+}
+
+func reconcileClusterPlan(t *testing.T, r *ClusterPlanReconciler, name string) {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+}
+
+func TestClusterPlanReconciler_GeneratesPlansInSelectedNamespaces(t *testing.T) {
+	cp := testClusterPlan()
+	r, statuses := newClusterPlanReconciler(cp,
+		testNamespace("team-b", map[string]string{"env": "dev"}),
+		testNamespace("team-a", map[string]string{"env": "dev"}),
+		testNamespace("prod", map[string]string{"env": "prod"}),
+	)
+
+	reconcileClusterPlan(t, r, cp.Name)
+
+	for _, ns := range []string{"team-a", "team-b"} {
+		var plan hibernatorv1alpha1.HibernatePlan
+		require.NoError(t, r.Get(context.Background(), types.NamespacedName{Namespace: ns, Name: cp.Name}, &plan))
+		assert.Equal(t, cp.Spec.Template.Spec, plan.Spec)
+		assert.Equal(t, cp.Name, plan.Labels[wellknown.LabelClusterPlan])
+		assert.Equal(t, "platform", plan.Labels["team"])
+		assert.True(t, metav1.IsControlledBy(&plan, cp))
+	}
+
+	err := r.Get(context.Background(), types.NamespacedName{Namespace: "prod", Name: cp.Name}, &hibernatorv1alpha1.HibernatePlan{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	require.Len(t, statuses.updates, 1)
+	status := statuses.updates[0].Resource.Status
+	assert.Equal(t, int64(1), status.ObservedGeneration)
+	assert.Equal(t, int32(2), status.SelectedNamespaces)
+	require.Len(t, status.Plans, 2)
+	assert.Equal(t, "team-a", status.Plans[0].Namespace)
+	assert.Equal(t, "team-b", status.Plans[1].Namespace)
+}
+
+func TestRenderPlan_CopiesShardLabel(t *testing.T) {
+	cp := testClusterPlan()
+	cp.Labels = map[string]string{wellknown.LabelShard: "tenants-a"}
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: cp.Name, Namespace: "team-a"},
+	}
+
+	renderPlan(cp, plan, true)
+
+	assert.Equal(t, "tenants-a", plan.Labels[wellknown.LabelShard])
+}
+
+func TestClusterPlanReconciler_DeletesPlansOfDeselectedNamespaces(t *testing.T) {
+	cp := testClusterPlan()
+	stale := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cp.Name,
+			Namespace: "old",
+			Labels:    map[string]string{wellknown.LabelClusterPlan: cp.Name},
+		},
+	}
+	require.NoError(t, controllerutil.SetControllerReference(cp, stale, newProviderTestScheme()))
+
+	r, _ := newClusterPlanReconciler(cp, testNamespace("old", nil), stale)
+
+	reconcileClusterPlan(t, r, cp.Name)
+
+	err := r.Get(context.Background(), client.ObjectKeyFromObject(stale), &hibernatorv1alpha1.HibernatePlan{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestClusterPlanReconciler_ReportsConflictWithUnmanagedPlan(t *testing.T) {
+	cp := testClusterPlan()
+	existing := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: cp.Name, Namespace: "team-a"},
+		Spec:       hibernatorv1alpha1.HibernatePlanSpec{Schedule: hibernatorv1alpha1.Schedule{Timezone: "Asia/Jakarta"}},
+	}
+	r, statuses := newClusterPlanReconciler(cp, testNamespace("team-a", map[string]string{"env": "dev"}), existing)
+
+	reconcileClusterPlan(t, r, cp.Name)
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(existing), &plan))
+	assert.Equal(t, "Asia/Jakarta", plan.Spec.Schedule.Timezone, "unmanaged plan must not be modified")
+
+	require.Len(t, statuses.updates, 1)
+	require.Len(t, statuses.updates[0].Resource.Status.Plans, 1)
+	assert.Contains(t, statuses.updates[0].Resource.Status.Plans[0].Message, "not managed")
+}
+
+func TestClusterPlanReconciler_KeepsSuspendUntilSpecChanges(t *testing.T) {
+	cp := testClusterPlan()
+	cp.Status.ObservedGeneration = cp.Generation
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: cp.Name, Namespace: "team-a"},
+	}
+	renderPlan(cp, plan, true)
+	plan.Spec.Suspend = true
+	require.NoError(t, controllerutil.SetControllerReference(cp, plan, newProviderTestScheme()))
+
+	r, _ := newClusterPlanReconciler(cp, testNamespace("team-a", map[string]string{"env": "dev"}), plan)
+
+	reconcileClusterPlan(t, r, cp.Name)
+
+	var got hibernatorv1alpha1.HibernatePlan
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(plan), &got))
+	assert.True(t, got.Spec.Suspend, "suspend set on the generated plan must be kept")
+
+	// A new ClusterHibernatePlan generation pushes the template's suspend flag.
+	var current hibernatorv1alpha1.ClusterHibernatePlan
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(cp), &current))
+	current.Spec.Template.Spec.Schedule.Timezone = "Asia/Jakarta"
+	current.Generation = 2 // the fake client does not bump generations
+	require.NoError(t, r.Update(context.Background(), &current))
+
+	reconcileClusterPlan(t, r, cp.Name)
+
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(plan), &got))
+	assert.Equal(t, "Asia/Jakarta", got.Spec.Schedule.Timezone)
+	assert.False(t, got.Spec.Suspend)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/pkg/cache"
+)
+
+const (
+	// connectorCacheSize bounds the number of cached connector objects.
+	connectorCacheSize = 1000
+
+	// connectorCacheTTL is a safety net for missed watch events; entries are
+	// normally invalidated as soon as the connector changes.
+	connectorCacheTTL = 10 * time.Minute
+)
+
+// connectorKey identifies a cached connector object.
+type connectorKey struct {
+	Kind string
+	types.NamespacedName
+}
+
+// ConnectorCache is a client.Reader that serves CloudProvider and K8SCluster
+// objects from memory, fetching them from the wrapped reader on first use.
+// Entries are dropped when a watch event reports that the connector changed.
+// Concurrent lookups of the same connector share a single API call, so the
+// burst of notifications at a schedule boundary reads each connector once.
+// Other object types are read from the wrapped reader directly.
+type ConnectorCache struct {
+	reader  client.Reader
+	log     logr.Logger
+	objects *cache.Cache[connectorKey, client.Object]
+}
+
+var _ client.Reader = (*ConnectorCache)(nil)
+
+// NewConnectorCache creates a ConnectorCache backed by reader, typically the
+// uncached mgr.GetAPIReader().
+func NewConnectorCache(reader client.Reader, log logr.Logger) (*ConnectorCache, error) {
+	objects, err := cache.New(
+		cache.WithMaxSize[connectorKey, client.Object](connectorCacheSize),
+		cache.WithTTL[connectorKey, client.Object](connectorCacheTTL),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create connector cache: %w", err)
+	}
+
+	return &ConnectorCache{reader: reader, log: log, objects: objects}, nil
+}
+
+// Get implements client.Reader. Cached objects are deep-copied into obj, so
+// callers may mutate the result freely. Errors, including NotFound, are not
+// cached.
+func (c *ConnectorCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	kind := connectorKind(obj)
+	if kind == "" || len(opts) > 0 {
+		return c.reader.Get(ctx, key, obj, opts...)
+	}
+
+	cached, err := c.objects.GetOrFetch(ctx, connectorKey{Kind: kind, NamespacedName: key}, func(ctx context.Context) (client.Object, error) {
+		fetched := obj.DeepCopyObject().(client.Object)
+		if err := c.reader.Get(ctx, key, fetched); err != nil {
+			return nil, err
+		}
+		return fetched, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	switch out := obj.(type) {
+	case *hibernatorv1alpha1.CloudProvider:
+		cached.(*hibernatorv1alpha1.CloudProvider).DeepCopyInto(out)
+	case *hibernatorv1alpha1.K8SCluster:
+		cached.(*hibernatorv1alpha1.K8SCluster).DeepCopyInto(out)
+	}
+	return nil
+}
+
+// List implements client.Reader. Lists are never cached.
+func (c *ConnectorCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
+// Invalidate drops any cached connector with the given namespace and name.
+func (c *ConnectorCache) Invalidate(key types.NamespacedName) {
+	for _, kind := range []string{"CloudProvider", "K8SCluster"} {
+		if c.objects.Remove(connectorKey{Kind: kind, NamespacedName: key}) {
+			c.log.V(1).Info("invalidated cached connector", "kind", kind, "connector", key)
+		}
+	}
+}
+
+// Reconcile invalidates the cached connector named by req. It is driven by
+// watch events on CloudProvider and K8SCluster resources.
+func (c *ConnectorCache) Reconcile(_ context.Context, req ctrl.Request) (ctrl.Result, error) {
+	c.Invalidate(req.NamespacedName)
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager registers the watches that keep the cache consistent.
+func (c *ConnectorCache) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&hibernatorv1alpha1.CloudProvider{}).
+		Watches(&hibernatorv1alpha1.K8SCluster{}, &handler.EnqueueRequestForObject{}).
+		Named("connector-cache").
+		Complete(c)
+}
+
+// connectorKind returns the connector kind of obj, or "" for other types.
+func connectorKind(obj client.Object) string {
+	switch obj.(type) {
+	case *hibernatorv1alpha1.CloudProvider:
+		return "CloudProvider"
+	case *hibernatorv1alpha1.K8SCluster:
+		return "K8SCluster"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// newCountingConnectorCache returns a ConnectorCache over a fake client seeded
+// with objs, and a pointer to the number of Get calls that reached the client.
+func newCountingConnectorCache(t *testing.T, objs ...client.Object) (*ConnectorCache, client.Client, *int) {
+	t.Helper()
+
+	gets := 0
+	c := fake.NewClientBuilder().
+		WithScheme(newProviderTestScheme()).
+		WithObjects(objs...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				gets++
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	cc, err := NewConnectorCache(c, logr.Discard())
+	require.NoError(t, err)
+	return cc, c, &gets
+}
+
+func TestConnectorCache_ServesRepeatedReadsFromMemory(t *testing.T) {
+	cp := &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-prod", Namespace: "default"},
+		Spec: hibernatorv1alpha1.CloudProviderSpec{
+			Type: hibernatorv1alpha1.CloudProviderAWS,
+			AWS:  &hibernatorv1alpha1.AWSConfig{AccountId: "123456789012", Region: "us-east-1"},
+		},
+	}
+	cc, _, gets := newCountingConnectorCache(t, cp)
+	ctx := context.Background()
+	key := client.ObjectKeyFromObject(cp)
+
+	for range 3 {
+		var got hibernatorv1alpha1.CloudProvider
+		require.NoError(t, cc.Get(ctx, key, &got))
+		assert.Equal(t, "123456789012", got.Spec.AWS.AccountId)
+
+		// Mutating the result must not leak into the cache.
+		got.Spec.AWS.AccountId = "mutated"
+	}
+
+	assert.Equal(t, 1, *gets)
+}
+
+func TestConnectorCache_ReconcileInvalidatesEntry(t *testing.T) {
+	kc := &hibernatorv1alpha1.K8SCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: "default"},
+		Spec: hibernatorv1alpha1.K8SClusterSpec{
+			EKS: &hibernatorv1alpha1.EKSConfig{Name: "staging-eks", Region: "us-east-1"},
+		},
+	}
+	cc, c, gets := newCountingConnectorCache(t, kc)
+	ctx := context.Background()
+	key := client.ObjectKeyFromObject(kc)
+
+	var got hibernatorv1alpha1.K8SCluster
+	require.NoError(t, cc.Get(ctx, key, &got))
+
+	// Simulate an update followed by the watch event.
+	got.Spec.EKS.Region = "eu-west-1"
+	require.NoError(t, c.Update(ctx, &got))
+	_, err := cc.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	var refreshed hibernatorv1alpha1.K8SCluster
+	require.NoError(t, cc.Get(ctx, key, &refreshed))
+	assert.Equal(t, "eu-west-1", refreshed.Spec.EKS.Region)
+	assert.Equal(t, 2, *gets)
+}
+
+func TestConnectorCache_DoesNotCacheNotFound(t *testing.T) {
+	cc, c, gets := newCountingConnectorCache(t)
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "aws-prod"}
+
+	err := cc.Get(ctx, key, &hibernatorv1alpha1.CloudProvider{})
+	require.True(t, apierrors.IsNotFound(err))
+
+	require.NoError(t, c.Create(ctx, &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Spec:       hibernatorv1alpha1.CloudProviderSpec{Type: hibernatorv1alpha1.CloudProviderAWS},
+	}))
+
+	require.NoError(t, cc.Get(ctx, key, &hibernatorv1alpha1.CloudProvider{}))
+	assert.Equal(t, 2, *gets)
+}
+
+func TestConnectorCache_PassesThroughOtherTypes(t *testing.T) {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default"}}
+	cc, _, gets := newCountingConnectorCache(t, cm)
+	ctx := context.Background()
+
+	for range 2 {
+		require.NoError(t, cc.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{}))
+	}
+	assert.Equal(t, 2, *gets)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// expandConnectorSelectors replaces every target whose connectorRef carries a selector
+// with one target per matching connector, named <target>-<connector> and referencing
+// that connector by name. Matching connectors are ordered by name so the expanded list
+// is stable across reconciles.
+//
+// The returned map holds the expanded names of each selector target, keyed by the
+// original target name, for rewriting strategy references.
+func (r *PlanReconciler) expandConnectorSelectors(
+	ctx context.Context,
+	plan *hibernatorv1alpha1.HibernatePlan,
+	targets []hibernatorv1alpha1.Target,
+) ([]hibernatorv1alpha1.Target, map[string][]string, error) {
+	expanded := make([]hibernatorv1alpha1.Target, 0, len(targets))
+	names := make(map[string][]string)
+
+	for _, target := range targets {
+		ref := target.ConnectorRef
+		if ref.Selector == nil {
+			expanded = append(expanded, target)
+			continue
+		}
+
+		connectors, err := r.listConnectorNames(ctx, plan, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		names[target.Name] = []string{}
+		for _, connector := range connectors {
+			t := *target.DeepCopy()
+			t.Name = fmt.Sprintf("%s-%s", target.Name, connector)
+			t.ConnectorRef = hibernatorv1alpha1.ConnectorRef{
+				Kind:      ref.Kind,
+				Name:      connector,
+				Namespace: ref.Namespace,
+			}
+			expanded = append(expanded, t)
+			names[target.Name] = append(names[target.Name], t.Name)
+		}
+	}
+
+	return expanded, names, nil
+}
+
+// listConnectorNames returns the sorted names of the connectors matching ref's selector.
+func (r *PlanReconciler) listConnectorNames(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan, ref hibernatorv1alpha1.ConnectorRef) ([]string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid %s selector: %v", errInvalidTargets, ref.Kind, err)
+	}
+
+	opts := []client.ListOption{
+		client.InNamespace(lo.Ternary(ref.Namespace != "", ref.Namespace, plan.Namespace)),
+		client.MatchingLabelsSelector{Selector: selector},
+	}
+
+	var names []string
+	switch ref.Kind {
+	case "CloudProvider":
+		var list hibernatorv1alpha1.CloudProviderList
+		if err := r.List(ctx, &list, opts...); err != nil {
+			return nil, fmt.Errorf("list CloudProviders: %w", err)
+		}
+		names = lo.Map(list.Items, func(c hibernatorv1alpha1.CloudProvider, _ int) string { return c.Name })
+	case "K8SCluster":
+		var list hibernatorv1alpha1.K8SClusterList
+		if err := r.List(ctx, &list, opts...); err != nil {
+			return nil, fmt.Errorf("list K8SClusters: %w", err)
+		}
+		names = lo.Map(list.Items, func(c hibernatorv1alpha1.K8SCluster, _ int) string { return c.Name })
+	default:
+		return nil, fmt.Errorf("%w: unsupported connector kind %q", errInvalidTargets, ref.Kind)
+	}
+
+	slices.Sort(names)
+	return names, nil
+}
+
+// expandStrategy rewrites strategy references to selector targets into references to
+// their expanded targets: a stage lists all of them, and a dependency applies to each.
+// Stages left without targets are dropped.
+func expandStrategy(strategy hibernatorv1alpha1.ExecutionStrategy, expanded map[string][]string) hibernatorv1alpha1.ExecutionStrategy {
+	if len(expanded) == 0 {
+		return strategy
+	}
+
+	out := *strategy.DeepCopy()
+	resolve := func(name string) []string {
+		if names, ok := expanded[name]; ok {
+			return names
+		}
+		return []string{name}
+	}
+
+	out.Dependencies = nil
+	for _, dep := range strategy.Dependencies {
+		for _, from := range resolve(dep.From) {
+			for _, to := range resolve(dep.To) {
+				out.Dependencies = append(out.Dependencies, hibernatorv1alpha1.Dependency{From: from, To: to})
+			}
+		}
+	}
+
+	out.Stages = nil
+	for _, stage := range strategy.Stages {
+		s := *stage.DeepCopy()
+		s.Targets = lo.FlatMap(stage.Targets, func(name string, _ int) []string { return resolve(name) })
+		if len(s.Targets) > 0 {
+			out.Stages = append(out.Stages, s)
+		}
+	}
+
+	return out
+}
+
+// planConnectorSelectorIndexer indexes HibernatePlans by the connector kind and
+// namespace their selector targets list, as "<kind>/<namespace>".
+func planConnectorSelectorIndexer(obj client.Object) []string {
+	plan, ok := obj.(*hibernatorv1alpha1.HibernatePlan)
+	if !ok {
+		return nil
+	}
+
+	var keys []string
+	for _, target := range plan.Spec.Targets {
+		ref := target.ConnectorRef
+		if ref.Selector == nil {
+			continue
+		}
+		keys = append(keys, connectorSelectorKey(ref.Kind, lo.Ternary(ref.Namespace != "", ref.Namespace, plan.Namespace)))
+	}
+	return lo.Uniq(keys)
+}
+
+func connectorSelectorKey(kind, namespace string) string {
+	return kind + "/" + namespace
+}
+
+// findPlansForConnector returns reconcile requests for HibernatePlans with selector
+// targets over the connector's kind and namespace. Plans are not filtered by labels:
+// a connector that stopped matching must drop out of the plans it was expanded into.
+func (r *PlanReconciler) findPlansForConnector(ctx context.Context, obj client.Object) []reconcile.Request {
+	kind := connectorKind(obj)
+	if kind == "" {
+		return nil
+	}
+
+	var planList hibernatorv1alpha1.HibernatePlanList
+	if err := r.List(ctx, &planList, client.MatchingFields{
+		wellknown.FieldIndexPlanConnectorSelector: connectorSelectorKey(kind, obj.GetNamespace()),
+	}); err != nil {
+		r.Log.Error(err, "failed to list plans for connector", "kind", kind, "connector", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(planList.Items))
+	for i := range planList.Items {
+		key := client.ObjectKeyFromObject(&planList.Items[i])
+		r.DependencyNonces.Inc(key)
+		requests = append(requests, reconcile.Request{NamespacedName: key})
+	}
+	return requests
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func testK8SCluster(name, namespace string, labels map[string]string) *hibernatorv1alpha1.K8SCluster {
+	return &hibernatorv1alpha1.K8SCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+	}
+}
+
+func selectorTarget(name string, matchLabels map[string]string) hibernatorv1alpha1.Target {
+	return hibernatorv1alpha1.Target{
+		Name: name,
+		Type: "workloadscaler",
+		ConnectorRef: hibernatorv1alpha1.ConnectorRef{
+			Kind:     "K8SCluster",
+			Selector: &metav1.LabelSelector{MatchLabels: matchLabels},
+		},
+	}
+}
+
+func targetNames(targets []hibernatorv1alpha1.Target) []string {
+	names := make([]string, 0, len(targets))
+	for _, t := range targets {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+func TestPlanReconciler_ResolveTargets_ExpandsConnectorSelector(t *testing.T) {
+	plan := simplePlan("my-plan", "default")
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+		selectorTarget("workloads", map[string]string{"env": "dev"}),
+	}
+	plan.Spec.Execution.Strategy = hibernatorv1alpha1.ExecutionStrategy{
+		Type: hibernatorv1alpha1.StrategyStaged,
+		Stages: []hibernatorv1alpha1.Stage{
+			{Name: "apps", Targets: []string{"workloads"}},
+			{Name: "data", Targets: []string{"db"}},
+		},
+	}
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan,
+		testK8SCluster("dev-b", "default", map[string]string{"env": "dev"}),
+		testK8SCluster("dev-a", "default", map[string]string{"env": "dev"}),
+		testK8SCluster("prod", "default", map[string]string{"env": "prod"}),
+		testK8SCluster("dev-other-ns", "other", map[string]string{"env": "dev"}),
+	)
+
+	require.NoError(t, r.resolveTargets(context.Background(), plan))
+
+	assert.Equal(t, []string{"db", "workloads-dev-a", "workloads-dev-b"}, targetNames(plan.Spec.Targets))
+	ref := plan.Spec.Targets[1].ConnectorRef
+	assert.Equal(t, "dev-a", ref.Name)
+	assert.Nil(t, ref.Selector)
+	assert.Equal(t, []string{"workloads-dev-a", "workloads-dev-b"}, plan.Spec.Execution.Strategy.Stages[0].Targets)
+}
+
+func TestPlanReconciler_ResolveTargets_NoMatchingConnector_DropsStage(t *testing.T) {
+	plan := simplePlan("my-plan", "default")
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+		selectorTarget("workloads", map[string]string{"env": "dev"}),
+	}
+	plan.Spec.Execution.Strategy = hibernatorv1alpha1.ExecutionStrategy{
+		Type: hibernatorv1alpha1.StrategyStaged,
+		Stages: []hibernatorv1alpha1.Stage{
+			{Name: "apps", Targets: []string{"workloads"}},
+			{Name: "data", Targets: []string{"db"}},
+		},
+	}
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan)
+
+	require.NoError(t, r.resolveTargets(context.Background(), plan))
+
+	assert.Equal(t, []string{"db"}, targetNames(plan.Spec.Targets))
+	require.Len(t, plan.Spec.Execution.Strategy.Stages, 1)
+	assert.Equal(t, "data", plan.Spec.Execution.Strategy.Stages[0].Name)
+}
+
+func TestPlanReconciler_ResolveTargets_OnlySelectorWithoutMatches_IsInvalid(t *testing.T) {
+	plan := simplePlan("my-plan", "default")
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{selectorTarget("workloads", map[string]string{"env": "dev"})}
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan)
+
+	err := r.resolveTargets(context.Background(), plan)
+
+	require.ErrorIs(t, err, errInvalidTargets)
+	assert.Len(t, plan.Spec.Targets, 1, "stored targets must be left untouched")
+}
+
+func TestExpandStrategy_ExpandsDependencies(t *testing.T) {
+	strategy := hibernatorv1alpha1.ExecutionStrategy{
+		Type:         hibernatorv1alpha1.StrategyDAG,
+		Dependencies: []hibernatorv1alpha1.Dependency{{From: "workloads", To: "db"}},
+	}
+
+	got := expandStrategy(strategy, map[string][]string{"workloads": {"workloads-a", "workloads-b"}})
+
+	assert.Equal(t, []hibernatorv1alpha1.Dependency{
+		{From: "workloads-a", To: "db"},
+		{From: "workloads-b", To: "db"},
+	}, got.Dependencies)
+	assert.Equal(t, "workloads", strategy.Dependencies[0].From, "input strategy must not be modified")
+}
+
+func TestPlanReconciler_FindPlansForConnector(t *testing.T) {
+	selecting := simplePlan("selecting", "default")
+	selecting.Spec.Targets = []hibernatorv1alpha1.Target{selectorTarget("workloads", map[string]string{"env": "dev"})}
+	static := simplePlan("static", "default")
+	static.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "cluster", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev-a"}},
+	}
+	cluster := testK8SCluster("dev-a", "default", nil)
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), selecting, static, cluster)
+
+	requests := r.findPlansForConnector(context.Background(), cluster)
+
+	key := types.NamespacedName{Name: "selecting", Namespace: "default"}
+	require.Len(t, requests, 1)
+	assert.Equal(t, key, requests[0].NamespacedName)
+	assert.Equal(t, int64(1), r.DependencyNonces.Get(key))
+
+	cloud := &hibernatorv1alpha1.CloudProvider{ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "default"}}
+	assert.Empty(t, r.findPlansForConnector(context.Background(), cloud))
+}
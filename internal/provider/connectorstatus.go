@@ -0,0 +1,162 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// unreadyConnectors describes the connectors used by the plan's (expanded) targets
+// that failed their last health check, as "<kind> <namespace>/<name>: <message>"
+// entries sorted and joined by "; ". It is empty when every connector is ready.
+//
+// Connectors that were never checked, because health checks are disabled or have
+// not run yet, count as ready. Connectors that cannot be read are left to the
+// runner Jobs, which report them when they start.
+func (r *PlanReconciler) unreadyConnectors(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) string {
+	var problems []string
+	seen := map[string]bool{}
+
+	for _, target := range plan.Spec.Targets {
+		ref := target.ConnectorRef
+		if ref.Name == "" {
+			continue
+		}
+		key := client.ObjectKey{Namespace: namespaceOr(ref.Namespace, plan.Namespace), Name: ref.Name}
+		id := connectorRefKey(ref.Kind, key.Namespace, key.Name)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		var ready, checked bool
+		var message string
+		switch ref.Kind {
+		case "CloudProvider":
+			var cp hibernatorv1alpha1.CloudProvider
+			if err := r.Get(ctx, key, &cp); err != nil {
+				continue
+			}
+			ready, checked, message = cp.Status.Ready, cp.Status.LastValidated != nil, cp.Status.Message
+		case "K8SCluster":
+			var kc hibernatorv1alpha1.K8SCluster
+			if err := r.Get(ctx, key, &kc); err != nil {
+				continue
+			}
+			ready, checked, message = kc.Status.Ready, kc.Status.LastValidated != nil, kc.Status.Message
+		default:
+			continue
+		}
+
+		if checked && !ready {
+			problems = append(problems, fmt.Sprintf("%s %s: %s", ref.Kind, key, message))
+		}
+	}
+
+	slices.Sort(problems)
+	return strings.Join(problems, "; ")
+}
+
+func connectorRefKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// planConnectorRefIndexer indexes HibernatePlans by the connectors their targets
+// reference by name, as "<kind>/<namespace>/<name>".
+func planConnectorRefIndexer(obj client.Object) []string {
+	plan, ok := obj.(*hibernatorv1alpha1.HibernatePlan)
+	if !ok {
+		return nil
+	}
+
+	var keys []string
+	for _, target := range plan.Spec.Targets {
+		ref := target.ConnectorRef
+		if ref.Name == "" {
+			continue
+		}
+		keys = append(keys, connectorRefKey(ref.Kind, namespaceOr(ref.Namespace, plan.Namespace), ref.Name))
+	}
+	return lo.Uniq(keys)
+}
+
+// findPlansUsingConnector returns reconcile requests for HibernatePlans whose targets
+// may use the connector: those naming it and those with selector targets over its
+// kind and namespace. It refreshes the ConnectorsReady condition of those plans when
+// the connector's health changes.
+func (r *PlanReconciler) findPlansUsingConnector(ctx context.Context, obj client.Object) []reconcile.Request {
+	kind := connectorKind(obj)
+	if kind == "" {
+		return nil
+	}
+
+	fields := []client.MatchingFields{
+		{wellknown.FieldIndexPlanConnectorRef: connectorRefKey(kind, obj.GetNamespace(), obj.GetName())},
+		{wellknown.FieldIndexPlanConnectorSelector: connectorSelectorKey(kind, obj.GetNamespace())},
+	}
+
+	seen := map[client.ObjectKey]bool{}
+	var requests []reconcile.Request
+	for _, match := range fields {
+		var planList hibernatorv1alpha1.HibernatePlanList
+		if err := r.List(ctx, &planList, match); err != nil {
+			r.Log.Error(err, "failed to list plans for connector", "kind", kind, "connector", client.ObjectKeyFromObject(obj))
+			return nil
+		}
+		for i := range planList.Items {
+			key := client.ObjectKeyFromObject(&planList.Items[i])
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			r.DependencyNonces.Inc(key)
+			requests = append(requests, reconcile.Request{NamespacedName: key})
+		}
+	}
+	return requests
+}
+
+// connectorHealthChangedPredicate passes connector updates that change whether the
+// connector is ready or why, ignoring the lastValidated timestamp written on every check.
+var connectorHealthChangedPredicate = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return false },
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldReady, oldMessage, ok := connectorHealth(e.ObjectOld)
+		if !ok {
+			return false
+		}
+		newReady, newMessage, ok := connectorHealth(e.ObjectNew)
+		if !ok {
+			return false
+		}
+		return oldReady != newReady || oldMessage != newMessage
+	},
+}
+
+func connectorHealth(obj client.Object) (bool, string, bool) {
+	switch c := obj.(type) {
+	case *hibernatorv1alpha1.CloudProvider:
+		return c.Status.Ready, c.Status.Message, true
+	case *hibernatorv1alpha1.K8SCluster:
+		return c.Status.Ready, c.Status.Message, true
+	default:
+		return false, "", false
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func TestPlanReconciler_UnreadyConnectors(t *testing.T) {
+	checked := metav1.NewTime(time.Now())
+	unreachable := testK8SCluster("dev-a", "default", nil)
+	unreachable.Status = hibernatorv1alpha1.K8SClusterStatus{Message: "list nodes: forbidden", LastValidated: &checked}
+	unchecked := testK8SCluster("dev-b", "default", nil)
+	expired := &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "default"},
+		Status:     hibernatorv1alpha1.CloudProviderStatus{Message: "ExpiredToken: token expired", LastValidated: &checked},
+	}
+
+	plan := simplePlan("p", "default")
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "workloads", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev-a"}},
+		{Name: "more-workloads", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev-a"}},
+		{Name: "fresh", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev-b"}},
+		{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+		{Name: "missing", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "gone"}},
+	}
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan, unreachable, unchecked, expired)
+
+	got := r.unreadyConnectors(context.Background(), plan)
+	assert.Equal(t, "CloudProvider default/aws: ExpiredToken: token expired; K8SCluster default/dev-a: list nodes: forbidden", got)
+}
+
+func TestPlanReconciler_FindPlansUsingConnector(t *testing.T) {
+	selecting := simplePlan("selecting", "default")
+	selecting.Spec.Targets = []hibernatorv1alpha1.Target{selectorTarget("workloads", map[string]string{"env": "dev"})}
+	static := simplePlan("static", "default")
+	static.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "cluster", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev-a"}},
+	}
+	other := simplePlan("other", "default")
+	other.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "cluster", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev-b"}},
+	}
+	cluster := testK8SCluster("dev-a", "default", nil)
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), selecting, static, other, cluster)
+
+	requests := r.findPlansUsingConnector(context.Background(), cluster)
+
+	var keys []types.NamespacedName
+	for _, req := range requests {
+		keys = append(keys, req.NamespacedName)
+	}
+	assert.ElementsMatch(t, []types.NamespacedName{
+		{Name: "static", Namespace: "default"},
+		{Name: "selecting", Namespace: "default"},
+	}, keys)
+}
+
+func TestConnectorHealthChangedPredicate(t *testing.T) {
+	old := testK8SCluster("dev-a", "default", nil)
+	old.Status = hibernatorv1alpha1.K8SClusterStatus{Ready: true, Message: "reachable"}
+
+	revalidated := old.DeepCopy()
+	now := metav1.NewTime(time.Now())
+	revalidated.Status.LastValidated = &now
+	require.False(t, connectorHealthChangedPredicate.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: revalidated}))
+
+	broken := old.DeepCopy()
+	broken.Status.Ready = false
+	broken.Status.Message = "connection refused"
+	assert.True(t, connectorHealthChangedPredicate.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: broken}))
+}
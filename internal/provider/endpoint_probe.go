@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	streamendpoint "github.com/ardikabs/hibernator/internal/streaming/endpoint"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+	"github.com/ardikabs/hibernator/pkg/cache"
+)
+
+// endpointProbeDialTimeout bounds each DNS lookup and port dial.
+const endpointProbeDialTimeout = 3 * time.Second
+
+// probeResult is the cached outcome of a probe. Failures are cached as well,
+// so an unreachable endpoint is not re-dialed for every runner Job.
+type probeResult struct {
+	err error
+}
+
+// EndpointProbe checks that the control-plane streaming endpoint resolves and
+// accepts connections on the gRPC and WebSocket ports before runner Jobs are
+// created. The controller runs in the cluster, so a failed probe is a strong
+// sign that runners would fail to connect too.
+type EndpointProbe struct {
+	log     logr.Logger
+	results *cache.Cache[string, probeResult]
+
+	lookupHost  func(ctx context.Context, host string) ([]string, error)
+	dialContext func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// NewEndpointProbe creates an EndpointProbe that caches each result for ttl.
+func NewEndpointProbe(ttl time.Duration, log logr.Logger) (*EndpointProbe, error) {
+	results, err := cache.New(
+		cache.WithMaxSize[string, probeResult](16),
+		cache.WithTTL[string, probeResult](ttl),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create endpoint probe cache: %w", err)
+	}
+
+	dialer := &net.Dialer{Timeout: endpointProbeDialTimeout}
+	return &EndpointProbe{
+		log:         log,
+		results:     results,
+		lookupHost:  net.DefaultResolver.LookupHost,
+		dialContext: dialer.DialContext,
+	}, nil
+}
+
+// Check returns nil when endpoint is reachable on every streaming port, and
+// the cached probe failure otherwise.
+func (p *EndpointProbe) Check(ctx context.Context, endpoint string) error {
+	if endpoint == "" {
+		return errors.New("control-plane endpoint is not configured")
+	}
+
+	res, err := p.results.GetOrFetch(ctx, endpoint, func(ctx context.Context) (probeResult, error) {
+		err := p.probe(ctx, endpoint)
+		if err != nil {
+			p.log.Info("control-plane endpoint is unreachable, runners will be dispatched without streaming",
+				"endpoint", endpoint, "error", err.Error())
+		}
+		return probeResult{err: err}, nil
+	})
+	if err != nil {
+		return err
+	}
+	return res.err
+}
+
+func (p *EndpointProbe) probe(ctx context.Context, endpoint string) error {
+	host := streamendpoint.Host(endpoint)
+
+	lookupCtx, cancel := context.WithTimeout(ctx, endpointProbeDialTimeout)
+	defer cancel()
+	if _, err := p.lookupHost(lookupCtx, host); err != nil {
+		return fmt.Errorf("resolve %s: %w", host, err)
+	}
+
+	for _, port := range []string{wellknown.StreamGRPCPort, wellknown.StreamWebSocketPort} {
+		addr := net.JoinHostPort(host, port)
+		dialCtx, cancel := context.WithTimeout(ctx, endpointProbeDialTimeout)
+		conn, err := p.dialContext(dialCtx, "tcp", addr)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("dial %s: %w", addr, err)
+		}
+		_ = conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeEndpointProbe returns an EndpointProbe whose lookups and dials are
+// served by the given functions, and a pointer to the number of lookups made.
+func newFakeEndpointProbe(t *testing.T, lookupErr error, refused map[string]bool) (*EndpointProbe, *int) {
+	t.Helper()
+
+	probe, err := NewEndpointProbe(time.Minute, logr.Discard())
+	require.NoError(t, err)
+
+	lookups := 0
+	probe.lookupHost = func(_ context.Context, host string) ([]string, error) {
+		lookups++
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		return []string{"10.0.0.1"}, nil
+	}
+	probe.dialContext = func(_ context.Context, _, address string) (net.Conn, error) {
+		if refused[address] {
+			return nil, errors.New("connection refused")
+		}
+		client, server := net.Pipe()
+		_ = server.Close()
+		return client, nil
+	}
+	return probe, &lookups
+}
+
+func TestEndpointProbe_Reachable(t *testing.T) {
+	probe, lookups := newFakeEndpointProbe(t, nil, nil)
+
+	for range 3 {
+		assert.NoError(t, probe.Check(context.Background(), "hibernator.svc"))
+	}
+	assert.Equal(t, 1, *lookups)
+}
+
+func TestEndpointProbe_CachesFailures(t *testing.T) {
+	probe, lookups := newFakeEndpointProbe(t, errors.New("no such host"), nil)
+
+	for range 3 {
+		err := probe.Check(context.Background(), "hibernator.svc")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "resolve hibernator.svc")
+	}
+	assert.Equal(t, 1, *lookups)
+}
+
+func TestEndpointProbe_UnreachablePort(t *testing.T) {
+	probe, _ := newFakeEndpointProbe(t, nil, map[string]bool{"hibernator.svc:8082": true})
+
+	err := probe.Check(context.Background(), "hibernator.svc")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hibernator.svc:8082")
+}
+
+func TestEndpointProbe_EmptyEndpoint(t *testing.T) {
+	probe, lookups := newFakeEndpointProbe(t, nil, nil)
+
+	assert.Error(t, probe.Check(context.Background(), ""))
+	assert.Zero(t, *lookups)
+}
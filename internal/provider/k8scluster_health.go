@@ -0,0 +1,298 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/pkg/awsutil"
+	"github.com/ardikabs/hibernator/pkg/k8sutil"
+)
+
+// Event reasons recorded by the K8SClusterHealthReconciler.
+const (
+	// ReasonClusterReachable marks a cluster whose API server answered after being
+	// unprobed or unreachable.
+	ReasonClusterReachable = "ClusterReachable"
+	// ReasonClusterUnreachable marks a cluster whose API server could not be reached
+	// with the configured access.
+	ReasonClusterUnreachable = "ClusterUnreachable"
+)
+
+// kubeconfigKey is the key of the Secret referenced by spec.k8s.kubeconfigRef,
+// matching what runner Jobs read.
+const kubeconfigKey = "kubeconfig"
+
+// clusterProbeTimeout bounds a single probe of a remote API server.
+const clusterProbeTimeout = 30 * time.Second
+
+// ClusterInfo is what a probe learns about a cluster.
+type ClusterInfo struct {
+	// Version is the GitVersion reported by the API server, e.g. "v1.31.2-eks-7f9249a".
+	Version string
+	// Nodes is the number of Node objects in the cluster.
+	Nodes int32
+}
+
+// ClusterProber connects to a cluster and reports its version and size.
+type ClusterProber interface {
+	Probe(ctx context.Context, cfg *k8sutil.K8SConnectorConfig) (ClusterInfo, error)
+}
+
+// APIServerProber probes clusters through their API server. EKS endpoints are
+// resolved with DescribeCluster when cfg does not carry one.
+type APIServerProber struct{}
+
+// Probe implements ClusterProber. Nodes are listed as metadata only, so large
+// clusters are counted without transferring their full Node objects.
+func (APIServerProber) Probe(ctx context.Context, cfg *k8sutil.K8SConnectorConfig) (ClusterInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, clusterProbeTimeout)
+	defer cancel()
+
+	if cfg.UseEKSToken && cfg.ClusterEndpoint == "" {
+		if err := resolveEKSEndpoint(ctx, cfg); err != nil {
+			return ClusterInfo{}, err
+		}
+	}
+
+	restConfig, err := k8sutil.BuildRESTConfig(ctx, cfg)
+	if err != nil {
+		return ClusterInfo{}, fmt.Errorf("build rest config: %w", err)
+	}
+	restConfig.Timeout = clusterProbeTimeout
+
+	dc, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return ClusterInfo{}, fmt.Errorf("create discovery client: %w", err)
+	}
+	version, err := dc.ServerVersion()
+	if err != nil {
+		return ClusterInfo{}, fmt.Errorf("get server version: %w", err)
+	}
+
+	mc, err := metadata.NewForConfig(restConfig)
+	if err != nil {
+		return ClusterInfo{}, fmt.Errorf("create metadata client: %w", err)
+	}
+	nodes, err := mc.Resource(corev1.SchemeGroupVersion.WithResource("nodes")).List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return ClusterInfo{}, fmt.Errorf("list nodes: %w", err)
+	}
+
+	return ClusterInfo{Version: version.GitVersion, Nodes: int32(len(nodes.Items))}, nil
+}
+
+// resolveEKSEndpoint fills in the endpoint and CA of the EKS cluster named in cfg.
+func resolveEKSEndpoint(ctx context.Context, cfg *k8sutil.K8SConnectorConfig) error {
+	awsCfg, err := awsutil.BuildAWSConfig(ctx, cfg.AWS)
+	if err != nil {
+		return err
+	}
+
+	out, err := eks.NewFromConfig(awsCfg).DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(cfg.ClusterName)})
+	if err != nil {
+		return fmt.Errorf("describe EKS cluster: %w", err)
+	}
+	if out.Cluster == nil || aws.ToString(out.Cluster.Endpoint) == "" || out.Cluster.CertificateAuthority == nil {
+		return fmt.Errorf("EKS cluster %s has no endpoint yet", cfg.ClusterName)
+	}
+
+	ca, err := base64.StdEncoding.DecodeString(aws.ToString(out.Cluster.CertificateAuthority.Data))
+	if err != nil {
+		return fmt.Errorf("decode EKS certificate authority data: %w", err)
+	}
+	cfg.ClusterEndpoint = aws.ToString(out.Cluster.Endpoint)
+	cfg.ClusterCAData = ca
+	return nil
+}
+
+// K8SClusterHealthReconciler periodically probes the API server of each K8SCluster
+// with the access it configures, and reports reachability, the Kubernetes version
+// and the node count in its status. HibernatePlans using an unreachable cluster
+// report it in their ConnectorsReady condition.
+//
+// Clusters reached through spec.k8s are always probed. EKS clusters are probed when
+// their CloudProvider uses static credentials; with spec.aws.auth.serviceAccount the
+// credentials belong to the runner ServiceAccount, which the controller cannot act
+// as. Those clusters and GKE clusters are reported ready without a probe.
+type K8SClusterHealthReconciler struct {
+	client.Client
+
+	// APIReader reads kubeconfig and credential Secrets without caching every
+	// Secret in the cluster.
+	APIReader client.Reader
+	Clock     clock.Clock
+	Log       logr.Logger
+	Recorder  record.EventRecorder
+	Prober    ClusterProber
+
+	// Interval is how often each K8SCluster is probed again.
+	Interval time.Duration
+}
+
+// Reconcile probes the K8SCluster named by req and requeues it after Interval.
+func (r *K8SClusterHealthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("k8scluster", req.NamespacedName)
+
+	var kc hibernatorv1alpha1.K8SCluster
+	if err := r.Get(ctx, req.NamespacedName, &kc); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	result, info := r.probe(ctx, &kc)
+	previous := kc.Status
+
+	orig := kc.DeepCopy()
+	kc.Status.Ready = result.ready
+	kc.Status.Message = result.message
+	kc.Status.ClusterType = clusterType(&kc)
+	if result.validated {
+		now := metav1.NewTime(r.Clock.Now())
+		kc.Status.LastValidated = &now
+	}
+	if info != nil {
+		kc.Status.KubernetesVersion = info.Version
+		kc.Status.NodeCount = ptr.To(info.Nodes)
+	}
+	if err := r.Status().Patch(ctx, &kc, client.MergeFrom(orig)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("update K8SCluster status: %w", err)
+	}
+
+	recordTransition(r.Recorder, log, &kc, previous.Ready, previous.Message, result)
+	return ctrl.Result{RequeueAfter: r.Interval}, nil
+}
+
+// probe connects to kc. Info is nil unless the API server answered. A failed
+// probe keeps the last known version and node count in the status.
+func (r *K8SClusterHealthReconciler) probe(ctx context.Context, kc *hibernatorv1alpha1.K8SCluster) (healthResult, *ClusterInfo) {
+	unreachable := func(format string, args ...any) healthResult {
+		return healthResult{validated: true, reason: ReasonClusterUnreachable, message: fmt.Sprintf(format, args...)}
+	}
+	skipped := func(message string) healthResult {
+		return healthResult{ready: true, reason: ReasonClusterReachable, message: message}
+	}
+
+	cfg, skip, err := clusterAccess(ctx, r.Client, r.APIReader, kc)
+	if err != nil {
+		return unreachable("%v", err), nil
+	}
+	if skip != "" {
+		return skipped(skip), nil
+	}
+
+	info, err := r.Prober.Probe(ctx, cfg)
+	if err != nil {
+		return unreachable("%v", err), nil
+	}
+	return healthResult{
+		ready:     true,
+		validated: true,
+		reason:    ReasonClusterReachable,
+		message:   fmt.Sprintf("reachable, %s with %d node(s)", info.Version, info.Nodes),
+	}, &info
+}
+
+// clusterAccess returns the connector config the controller reaches kc with.
+// When the controller cannot act with the configured access, cfg is nil and
+// skip says why. Secrets are read through apiReader.
+func clusterAccess(ctx context.Context, reader, apiReader client.Reader, kc *hibernatorv1alpha1.K8SCluster) (cfg *k8sutil.K8SConnectorConfig, skip string, err error) {
+	switch spec := kc.Spec; {
+	case spec.EKS != nil && spec.K8S != nil:
+		return nil, "", errors.New("spec.eks and spec.k8s are mutually exclusive")
+	case spec.EKS != nil:
+		if spec.ProviderRef == nil {
+			return nil, "", errors.New("providerRef is required for EKS clusters")
+		}
+		var cp hibernatorv1alpha1.CloudProvider
+		key := client.ObjectKey{Namespace: namespaceOr(spec.ProviderRef.Namespace, kc.Namespace), Name: spec.ProviderRef.Name}
+		if err := reader.Get(ctx, key, &cp); err != nil {
+			return nil, "", fmt.Errorf("get CloudProvider %s: %v", key, err)
+		}
+		if cp.Spec.AWS == nil {
+			return nil, "", fmt.Errorf("CloudProvider %s has no spec.aws", key)
+		}
+		if cp.Spec.AWS.Auth.Static == nil {
+			return nil, "credentials come from the runner ServiceAccount; the cluster is not probed by the controller", nil
+		}
+		awsCfg, err := staticAWSConfig(ctx, apiReader, &cp)
+		if err != nil {
+			return nil, "", err
+		}
+		awsCfg.Region = spec.EKS.Region
+		cfg = &k8sutil.K8SConnectorConfig{ClusterName: spec.EKS.Name, Region: spec.EKS.Region, UseEKSToken: true, AWS: awsCfg, Network: awsCfg.Network}
+	case spec.K8S != nil && spec.K8S.InCluster:
+		cfg = &k8sutil.K8SConnectorConfig{}
+	case spec.K8S != nil && spec.K8S.KubeconfigRef != nil:
+		ref := spec.K8S.KubeconfigRef
+		key := client.ObjectKey{Namespace: namespaceOr(ref.Namespace, kc.Namespace), Name: ref.Name}
+		var secret corev1.Secret
+		if err := apiReader.Get(ctx, key, &secret); err != nil {
+			return nil, "", fmt.Errorf("get Secret %s: %v", key, err)
+		}
+		if len(secret.Data[kubeconfigKey]) == 0 {
+			return nil, "", fmt.Errorf("secret %s is missing the %s key", key, kubeconfigKey)
+		}
+		cfg = &k8sutil.K8SConnectorConfig{Kubeconfig: secret.Data[kubeconfigKey]}
+	case spec.K8S != nil:
+		return nil, "", errors.New("kubeconfigRef or inCluster must be specified for K8S access")
+	case spec.GKE != nil:
+		return nil, "GKE clusters are not probed by the controller", nil
+	default:
+		return nil, "", errors.New("one of spec.eks, spec.gke or spec.k8s is required")
+	}
+
+	// The cluster's own network settings take precedence over its CloudProvider's.
+	if kc.Spec.Network != nil {
+		network, err := connectorNetworkConfig(ctx, apiReader, kc.Namespace, kc.Spec.Network)
+		if err != nil {
+			return nil, "", err
+		}
+		cfg.Network = network
+	}
+	return cfg, "", nil
+}
+
+// clusterType returns the type of cluster kc describes, or "" when it is invalid.
+func clusterType(kc *hibernatorv1alpha1.K8SCluster) hibernatorv1alpha1.K8SClusterType {
+	switch {
+	case kc.Spec.EKS != nil:
+		return hibernatorv1alpha1.ClusterTypeEKS
+	case kc.Spec.GKE != nil:
+		return hibernatorv1alpha1.ClusterTypeGKE
+	case kc.Spec.K8S != nil:
+		return hibernatorv1alpha1.ClusterTypeK8S
+	}
+	return ""
+}
+
+// SetupWithManager registers the reconciler. Status updates do not change the
+// generation, so the reconciler's own writes do not trigger another probe.
+func (r *K8SClusterHealthReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&hibernatorv1alpha1.K8SCluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("k8scluster-health").
+		Complete(r)
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/pkg/k8sutil"
+)
+
+// fakeProber answers every probe with info, or fails with err.
+type fakeProber struct {
+	info  ClusterInfo
+	err   error
+	calls []*k8sutil.K8SConnectorConfig
+}
+
+func (p *fakeProber) Probe(_ context.Context, cfg *k8sutil.K8SConnectorConfig) (ClusterInfo, error) {
+	p.calls = append(p.calls, cfg)
+	return p.info, p.err
+}
+
+func kubeconfigCluster() *hibernatorv1alpha1.K8SCluster {
+	return &hibernatorv1alpha1.K8SCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+		Spec: hibernatorv1alpha1.K8SClusterSpec{
+			K8S: &hibernatorv1alpha1.K8SAccessConfig{
+				KubeconfigRef: &hibernatorv1alpha1.KubeconfigRef{Name: "dev-kubeconfig"},
+			},
+		},
+	}
+}
+
+func newClusterHealthReconciler(clk *clocktesting.FakeClock, prober ClusterProber, objs ...client.Object) (*K8SClusterHealthReconciler, client.Client, *record.FakeRecorder) {
+	c := fake.NewClientBuilder().
+		WithScheme(newProviderTestScheme()).
+		WithObjects(objs...).
+		WithStatusSubresource(&hibernatorv1alpha1.K8SCluster{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+
+	return &K8SClusterHealthReconciler{
+		Client:    c,
+		APIReader: c,
+		Clock:     clk,
+		Log:       logr.Discard(),
+		Recorder:  recorder,
+		Prober:    prober,
+		Interval:  5 * time.Minute,
+	}, c, recorder
+}
+
+func reconcileClusterHealth(t *testing.T, r *K8SClusterHealthReconciler, c client.Client) *hibernatorv1alpha1.K8SCluster {
+	t.Helper()
+
+	key := types.NamespacedName{Namespace: "default", Name: "dev"}
+	res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, res.RequeueAfter)
+
+	var kc hibernatorv1alpha1.K8SCluster
+	require.NoError(t, c.Get(context.Background(), key, &kc))
+	return &kc
+}
+
+func TestK8SClusterHealth_ReachableThenUnreachable(t *testing.T) {
+	now := time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakeClock(now)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev-kubeconfig", Namespace: "default"},
+		Data:       map[string][]byte{kubeconfigKey: []byte("apiVersion: v1\nkind: Config\n")},
+	}
+	prober := &fakeProber{info: ClusterInfo{Version: "v1.31.2", Nodes: 3}}
+	r, c, recorder := newClusterHealthReconciler(clk, prober, kubeconfigCluster(), secret)
+
+	kc := reconcileClusterHealth(t, r, c)
+	assert.True(t, kc.Status.Ready)
+	assert.Equal(t, hibernatorv1alpha1.ClusterTypeK8S, kc.Status.ClusterType)
+	assert.Equal(t, "v1.31.2", kc.Status.KubernetesVersion)
+	require.NotNil(t, kc.Status.NodeCount)
+	assert.Equal(t, int32(3), *kc.Status.NodeCount)
+	require.NotNil(t, kc.Status.LastValidated)
+	assert.Equal(t, now, kc.Status.LastValidated.UTC())
+
+	require.Len(t, prober.calls, 1)
+	assert.Equal(t, secret.Data[kubeconfigKey], prober.calls[0].Kubeconfig)
+
+	events := drainEvents(recorder)
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0], "Normal "+ReasonClusterReachable)
+
+	// An unreachable cluster keeps its last known version and node count.
+	prober.err = errors.New("get server version: connection refused")
+	kc = reconcileClusterHealth(t, r, c)
+	assert.False(t, kc.Status.Ready)
+	assert.Equal(t, "get server version: connection refused", kc.Status.Message)
+	assert.Equal(t, "v1.31.2", kc.Status.KubernetesVersion)
+
+	events = drainEvents(recorder)
+	require.Len(t, events, 1)
+	assert.Contains(t, events[0], "Warning "+ReasonClusterUnreachable)
+
+	// Staying unreachable the same way records nothing.
+	reconcileClusterHealth(t, r, c)
+	assert.Empty(t, drainEvents(recorder))
+}
+
+func TestK8SClusterHealth_ProbesThroughClusterNetwork(t *testing.T) {
+	cluster := kubeconfigCluster()
+	cluster.Spec.Network = &hibernatorv1alpha1.ConnectorNetwork{
+		Proxy:       &hibernatorv1alpha1.ProxyConfig{URL: "http://proxy.corp.example:3128"},
+		CABundleRef: &hibernatorv1alpha1.CABundleRef{Name: "corp-ca"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev-kubeconfig", Namespace: "default"},
+		Data:       map[string][]byte{kubeconfigKey: []byte("apiVersion: v1\nkind: Config\n")},
+	}
+	bundle := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "corp-ca", Namespace: "default"},
+		Data:       map[string]string{caBundleKey: "corp-ca-pem"},
+	}
+	prober := &fakeProber{info: ClusterInfo{Version: "v1.31.2", Nodes: 3}}
+	r, c, _ := newClusterHealthReconciler(clocktesting.NewFakeClock(time.Now()), prober, cluster, secret, bundle)
+
+	kc := reconcileClusterHealth(t, r, c)
+	assert.True(t, kc.Status.Ready)
+
+	require.Len(t, prober.calls, 1)
+	require.NotNil(t, prober.calls[0].Network)
+	assert.Equal(t, "http://proxy.corp.example:3128", prober.calls[0].Network.ProxyURL)
+	assert.Equal(t, []byte("corp-ca-pem"), prober.calls[0].Network.CABundle)
+
+	// A missing CA bundle makes the cluster unreachable without probing it.
+	require.NoError(t, c.Delete(context.Background(), bundle))
+	kc = reconcileClusterHealth(t, r, c)
+	assert.False(t, kc.Status.Ready)
+	assert.Contains(t, kc.Status.Message, "get CA bundle ConfigMap default/corp-ca")
+	assert.Len(t, prober.calls, 1)
+}
+
+func TestK8SClusterHealth_NotProbed(t *testing.T) {
+	cases := map[string]struct {
+		cluster     *hibernatorv1alpha1.K8SCluster
+		objs        []client.Object
+		wantReady   bool
+		wantMessage string
+	}{
+		"missing kubeconfig secret": {
+			cluster:     kubeconfigCluster(),
+			wantMessage: "get Secret default/dev-kubeconfig",
+		},
+		"eks without providerRef": {
+			cluster: &hibernatorv1alpha1.K8SCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+				Spec:       hibernatorv1alpha1.K8SClusterSpec{EKS: &hibernatorv1alpha1.EKSConfig{Name: "dev", Region: "us-east-1"}},
+			},
+			wantMessage: "providerRef is required",
+		},
+		"eks with service account credentials": {
+			cluster: &hibernatorv1alpha1.K8SCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+				Spec: hibernatorv1alpha1.K8SClusterSpec{
+					ProviderRef: &hibernatorv1alpha1.ProviderRef{Name: "aws-irsa"},
+					EKS:         &hibernatorv1alpha1.EKSConfig{Name: "dev", Region: "us-east-1"},
+				},
+			},
+			objs: []client.Object{&hibernatorv1alpha1.CloudProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-irsa", Namespace: "default"},
+				Spec: hibernatorv1alpha1.CloudProviderSpec{
+					Type: hibernatorv1alpha1.CloudProviderAWS,
+					AWS: &hibernatorv1alpha1.AWSConfig{
+						AccountId: "123456789012",
+						Region:    "us-east-1",
+						Auth:      hibernatorv1alpha1.AWSAuth{ServiceAccount: &hibernatorv1alpha1.ServiceAccountAuth{}},
+					},
+				},
+			}},
+			wantReady:   true,
+			wantMessage: "not probed",
+		},
+		"gke": {
+			cluster: &hibernatorv1alpha1.K8SCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default"},
+				Spec:       hibernatorv1alpha1.K8SClusterSpec{GKE: &hibernatorv1alpha1.GKEConfig{Name: "dev"}},
+			},
+			wantReady:   true,
+			wantMessage: "not probed",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			prober := &fakeProber{}
+			objs := append([]client.Object{tc.cluster}, tc.objs...)
+			r, c, _ := newClusterHealthReconciler(clocktesting.NewFakeClock(time.Now()), prober, objs...)
+
+			kc := reconcileClusterHealth(t, r, c)
+			assert.Equal(t, tc.wantReady, kc.Status.Ready)
+			assert.Contains(t, kc.Status.Message, tc.wantMessage)
+			assert.Empty(t, prober.calls)
+			if tc.wantReady {
+				assert.Nil(t, kc.Status.LastValidated, "skipped clusters are not reported as validated")
+			}
+		})
+	}
+}
@@ -41,6 +41,7 @@ type Coordinator struct {
 
 	Log logr.Logger
 
+	CostAllocation state.CostAllocation
 	Planner        *scheduler.Planner
 	RestoreManager *restore.Manager
 	Resources      *message.ControllerResources
@@ -94,6 +95,7 @@ func (e *Coordinator) workerFactory(key types.NamespacedName, slot keyedworker.S
 		log:            e.Log.WithName("worker"),
 		Infrastructure: e.Infrastructure,
 		ExecutorInfra:  e.ExecutorInfra,
+		CostAllocation: e.CostAllocation,
 		Planner:        e.Planner,
 		Resources:      e.Resources,
 		Statuses:       e.Statuses,
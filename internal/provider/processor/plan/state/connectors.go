@@ -0,0 +1,62 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// observeConnectors reports the health of the plan's connectors in the
+// ConnectorsReady condition.
+//
+// The provider checks the connectors before the plan reaches the processor; the
+// ones that failed their last health check arrive as PlanContext.ConnectorsError.
+// The condition only appears once a connector is unhealthy, and turns True when all
+// recover, so plans whose connectors were never unhealthy carry no condition. It does
+// not hold back cycles: a connector may recover before the next one starts.
+func (s *state) observeConnectors() {
+	plan := s.plan()
+	if plan.Status.Phase == "" {
+		return
+	}
+
+	current := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionConnectorsReady)
+	msg := s.PlanCtx.ConnectorsError
+	if msg == "" && current == nil {
+		return
+	}
+
+	desired := metav1.Condition{
+		Type:               hibernatorv1alpha1.ConditionConnectorsReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             hibernatorv1alpha1.ReasonConnectorsHealthy,
+		Message:            "all connectors passed their last health check",
+		ObservedGeneration: plan.Generation,
+	}
+	if msg != "" {
+		desired.Status = metav1.ConditionFalse
+		desired.Reason = hibernatorv1alpha1.ReasonConnectorsUnhealthy
+		desired.Message = msg
+	}
+
+	if current != nil &&
+		current.Status == desired.Status &&
+		current.Reason == desired.Reason &&
+		current.Message == desired.Message &&
+		current.ObservedGeneration == desired.ObservedGeneration {
+		return
+	}
+
+	if desired.Status == metav1.ConditionFalse {
+		s.Log.Info("connectors not ready", "plan", s.Key.String(), "reason", desired.Message)
+	}
+	s.queueGenerationStatus(plan, func(p *hibernatorv1alpha1.HibernatePlan) {
+		meta.SetStatusCondition(&p.Status.Conditions, desired)
+	})
+}
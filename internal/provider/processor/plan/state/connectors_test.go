@@ -0,0 +1,64 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func TestObserveConnectors_Healthy_NoCondition(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	st.observeConnectors()
+
+	assert.Equal(t, 0, planStatuses(st).Len())
+	assert.Nil(t, meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionConnectorsReady))
+}
+
+func TestObserveConnectors_Unhealthy_SetsConditionFalse(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	st.PlanCtx.ConnectorsError = "K8SCluster default/dev: get server version: connection refused"
+
+	st.observeConnectors()
+
+	require.Equal(t, 1, planStatuses(st).Len())
+	cond := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionConnectorsReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, hibernatorv1alpha1.ReasonConnectorsUnhealthy, cond.Reason)
+	assert.Equal(t, st.PlanCtx.ConnectorsError, cond.Message)
+
+	// Unchanged: no further status updates.
+	st.observeConnectors()
+	assert.Equal(t, 1, planStatuses(st).Len())
+}
+
+func TestObserveConnectors_Recovered_SetsConditionTrue(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	meta.SetStatusCondition(&plan.Status.Conditions, metav1.Condition{
+		Type:   hibernatorv1alpha1.ConditionConnectorsReady,
+		Status: metav1.ConditionFalse,
+		Reason: hibernatorv1alpha1.ReasonConnectorsUnhealthy,
+	})
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	st.observeConnectors()
+
+	require.Equal(t, 1, planStatuses(st).Len())
+	cond := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionConnectorsReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, hibernatorv1alpha1.ReasonConnectorsHealthy, cond.Reason)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// observeGeneration reconciles status.observedGeneration with the plan's generation.
+//
+// Spec changes that arrive while a Hibernating or WakingUp operation is in flight are
+// not observed yet: the operation keeps running with the intent locked in its
+// PlanSnapshot, the new generation is recorded in status.pendingGeneration, and the
+// SpecChangeDeferred condition explains why. A DriftDetected Event records the change. Once the plan leaves the operation, the
+// generation is observed, the pending marker is cleared and the condition flips to
+// False, so the change applies from the next cycle.
+//
+// Plans without a phase are left to lifecycleState, which observes the first generation
+// as part of initialisation. A status update is only queued when something changes.
+func (s *state) observeGeneration() {
+	plan := s.plan()
+	if plan.Status.Phase == "" || plan.Generation == plan.Status.ObservedGeneration {
+		return
+	}
+
+	switch plan.Status.Phase {
+	case hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.PhaseWakingUp:
+		if plan.Status.PendingGeneration == plan.Generation {
+			return
+		}
+		s.Log.Info("spec changed during operation; deferring until it completes",
+			"plan", s.Key.String(),
+			"phase", plan.Status.Phase,
+			"generation", plan.Generation,
+			"observedGeneration", plan.Status.ObservedGeneration)
+		s.queueGenerationStatus(plan, func(p *hibernatorv1alpha1.HibernatePlan) {
+			p.Status.PendingGeneration = plan.Generation
+			meta.SetStatusCondition(&p.Status.Conditions, metav1.Condition{
+				Type:               hibernatorv1alpha1.ConditionSpecChangeDeferred,
+				Status:             metav1.ConditionTrue,
+				Reason:             hibernatorv1alpha1.ReasonOperationInProgress,
+				Message:            fmt.Sprintf("generation %d is applied after the in-progress %s operation completes", plan.Generation, plan.Status.CurrentOperation),
+				ObservedGeneration: plan.Generation,
+			})
+		}, s.eventHook(corev1.EventTypeNormal, wellknown.EventReasonDriftDetected, func(p *hibernatorv1alpha1.HibernatePlan) string {
+			return fmt.Sprintf("Spec generation %d drifted from the intent locked for the in-progress %s; it applies from the next cycle", plan.Generation, p.Status.CurrentOperation)
+		}))
+
+	default:
+		deferred := plan.Status.PendingGeneration != 0
+		s.queueGenerationStatus(plan, func(p *hibernatorv1alpha1.HibernatePlan) {
+			p.Status.ObservedGeneration = plan.Generation
+			p.Status.PendingGeneration = 0
+			if deferred {
+				meta.SetStatusCondition(&p.Status.Conditions, metav1.Condition{
+					Type:               hibernatorv1alpha1.ConditionSpecChangeDeferred,
+					Status:             metav1.ConditionFalse,
+					Reason:             hibernatorv1alpha1.ReasonSpecApplied,
+					Message:            fmt.Sprintf("generation %d applies from the next cycle", plan.Generation),
+					ObservedGeneration: plan.Generation,
+				})
+			}
+		})
+	}
+}
+
+func (s *state) queueGenerationStatus(plan *hibernatorv1alpha1.HibernatePlan, mutate func(p *hibernatorv1alpha1.HibernatePlan), postHooks ...func(context.Context, *hibernatorv1alpha1.HibernatePlan) error) {
+	s.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: s.Key,
+		Resource:       plan,
+		Mutator:        statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](mutate),
+		PostHook:       chainHooks(postHooks...),
+	})
+}
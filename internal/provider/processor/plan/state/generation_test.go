@@ -0,0 +1,107 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func TestObserveGeneration_DuringOperation_DefersChange(t *testing.T) {
+	for _, phase := range []hibernatorv1alpha1.PlanPhase{hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.PhaseWakingUp} {
+		t.Run(string(phase), func(t *testing.T) {
+			plan := basePlanForState("p", phase)
+			plan.Generation = 3
+			plan.Status.ObservedGeneration = 2
+			st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+			st.observeGeneration()
+
+			require.Equal(t, 1, planStatuses(st).Len())
+			assert.Equal(t, int64(2), plan.Status.ObservedGeneration, "generation must not be observed mid-operation")
+			assert.Equal(t, int64(3), plan.Status.PendingGeneration)
+			cond := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionSpecChangeDeferred)
+			require.NotNil(t, cond)
+			assert.Equal(t, metav1.ConditionTrue, cond.Status)
+			assert.Equal(t, hibernatorv1alpha1.ReasonOperationInProgress, cond.Reason)
+
+			// Already recorded: no further status updates.
+			st.observeGeneration()
+			assert.Equal(t, 1, planStatuses(st).Len())
+		})
+	}
+}
+
+func TestObserveGeneration_AfterOperation_AppliesPendingChange(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernated)
+	plan.Generation = 3
+	plan.Status.ObservedGeneration = 2
+	plan.Status.PendingGeneration = 3
+	meta.SetStatusCondition(&plan.Status.Conditions, metav1.Condition{
+		Type:   hibernatorv1alpha1.ConditionSpecChangeDeferred,
+		Status: metav1.ConditionTrue,
+		Reason: hibernatorv1alpha1.ReasonOperationInProgress,
+	})
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	st.observeGeneration()
+
+	require.Equal(t, 1, planStatuses(st).Len())
+	assert.Equal(t, int64(3), plan.Status.ObservedGeneration)
+	assert.Zero(t, plan.Status.PendingGeneration)
+	cond := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionSpecChangeDeferred)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, hibernatorv1alpha1.ReasonSpecApplied, cond.Reason)
+}
+
+func TestObserveGeneration_Idle_ObservesWithoutCondition(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Generation = 5
+	plan.Status.ObservedGeneration = 4
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	st.observeGeneration()
+
+	assert.Equal(t, int64(5), plan.Status.ObservedGeneration)
+	assert.Empty(t, plan.Status.Conditions, "no condition unless a change was deferred")
+}
+
+func TestObserveGeneration_UpToDateOrUninitialised_Noop(t *testing.T) {
+	upToDate := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	upToDate.Generation = 2
+	upToDate.Status.ObservedGeneration = 2
+
+	uninitialised := basePlanForState("q", "")
+	uninitialised.Generation = 1
+
+	for _, plan := range []*hibernatorv1alpha1.HibernatePlan{upToDate, uninitialised} {
+		st := newHandlerState(plan, newHandlerFakeClient(plan))
+		st.observeGeneration()
+		assert.Equal(t, 0, planStatuses(st).Len(), plan.Name)
+	}
+}
+
+func TestTransitionToHibernating_WithoutException_LocksSnapshot(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{{Name: "db", Type: "rds"}}
+	st := newOverrideActionState(plan, nil, false)
+	h := &idleState{state: st}
+
+	_, err := h.transitionToHibernating(t.Context(), st.Log, false)
+	require.NoError(t, err)
+
+	require.NotNil(t, plan.Status.PlanSnapshot, "every cycle must lock its intent")
+	assert.Equal(t, plan.Status.CurrentCycleID, plan.Status.PlanSnapshot.CycleID)
+	assert.Empty(t, plan.Status.PlanSnapshot.ExceptionName)
+	assert.Equal(t, plan.Spec.Targets, plan.Status.PlanSnapshot.Targets)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// isOperationPaused reports whether the plan's in-flight operation is held by the
+// pause-operation annotation.
+func isOperationPaused(plan *hibernatorv1alpha1.HibernatePlan) bool {
+	switch plan.Status.Phase {
+	case hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.PhaseWakingUp:
+		return plan.Annotations[wellknown.AnnotationPauseOperation] == "true"
+	default:
+		return false
+	}
+}
+
+// observePause reports the pause-operation annotation in the OperationPaused condition.
+//
+// While a Hibernating or WakingUp operation is paused the condition is True and execute
+// dispatches nothing. Removing the annotation flips it to False; its LastTransitionTime
+// then marks the resume, which restarts the maxCycleDuration budget (see
+// cycleTimeoutReason). The condition is removed once the plan leaves the operation. A
+// status update is only queued when something changes.
+func (s *state) observePause() {
+	plan := s.plan()
+	current := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionOperationPaused)
+
+	switch plan.Status.Phase {
+	case hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.PhaseWakingUp:
+	default:
+		if current != nil {
+			s.queueGenerationStatus(plan, func(p *hibernatorv1alpha1.HibernatePlan) {
+				meta.RemoveStatusCondition(&p.Status.Conditions, hibernatorv1alpha1.ConditionOperationPaused)
+			})
+		}
+		return
+	}
+
+	desired := metav1.Condition{
+		Type:               hibernatorv1alpha1.ConditionOperationPaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             hibernatorv1alpha1.ReasonPausedByUser,
+		Message:            fmt.Sprintf("%s operation paused at stage %d; running jobs finish but no new targets are dispatched", plan.Status.CurrentOperation, plan.Status.CurrentStageIndex+1),
+		LastTransitionTime: metav1.NewTime(s.Clock.Now()),
+		ObservedGeneration: plan.Generation,
+	}
+	if !isOperationPaused(plan) {
+		if current == nil {
+			return
+		}
+		desired.Status = metav1.ConditionFalse
+		desired.Reason = hibernatorv1alpha1.ReasonOperationResumed
+		desired.Message = fmt.Sprintf("%s operation resumed", plan.Status.CurrentOperation)
+	}
+
+	if current != nil && current.Status == desired.Status && current.Reason == desired.Reason {
+		return
+	}
+
+	if desired.Status == metav1.ConditionTrue {
+		s.Log.Info("operation paused", "plan", s.Key.String(), "phase", plan.Status.Phase, "stage", plan.Status.CurrentStageIndex)
+	} else {
+		s.Log.Info("operation resumed", "plan", s.Key.String(), "phase", plan.Status.Phase, "stage", plan.Status.CurrentStageIndex)
+	}
+	s.queueGenerationStatus(plan, func(p *hibernatorv1alpha1.HibernatePlan) {
+		meta.SetStatusCondition(&p.Status.Conditions, desired)
+	})
+}
+
+// resumedAt returns when the current operation was last resumed, or nil if it was
+// never paused.
+func resumedAt(plan *hibernatorv1alpha1.HibernatePlan) *metav1.Time {
+	cond := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionOperationPaused)
+	if cond == nil || cond.Status != metav1.ConditionFalse {
+		return nil
+	}
+	return &cond.LastTransitionTime
+}
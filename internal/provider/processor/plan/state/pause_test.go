@@ -0,0 +1,128 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// pausedPlan returns a sequential two-target plan in its second shutdown stage with
+// the pause-operation annotation set. target-a has finished; target-b has not been
+// dispatched yet.
+func pausedPlan() *hibernatorv1alpha1.HibernatePlan {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Annotations = map[string]string{wellknown.AnnotationPauseOperation: "true"}
+	plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategySequential
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "target-a", Type: "eks", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+		{Name: "target-b", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+	}
+	plan.Status.CurrentCycleID = "cycle-001"
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+	plan.Status.CurrentStageIndex = 1
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "target-a", Executor: "eks", State: hibernatorv1alpha1.StateCompleted},
+		{Target: "target-b", Executor: "rds", State: hibernatorv1alpha1.StatePending},
+	}
+	return plan
+}
+
+func TestObservePause_ReportsPauseAndResume(t *testing.T) {
+	plan := pausedPlan()
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	st.observePause()
+
+	require.Equal(t, 1, planStatuses(st).Len())
+	cond := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionOperationPaused)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, hibernatorv1alpha1.ReasonPausedByUser, cond.Reason)
+
+	// Already recorded: no further status updates.
+	st.observePause()
+	assert.Equal(t, 1, planStatuses(st).Len())
+
+	delete(plan.Annotations, wellknown.AnnotationPauseOperation)
+	st.observePause()
+	require.Equal(t, 2, planStatuses(st).Len())
+	cond = meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionOperationPaused)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, hibernatorv1alpha1.ReasonOperationResumed, cond.Reason)
+	assert.NotNil(t, resumedAt(plan))
+
+	plan.Status.Phase = hibernatorv1alpha1.PhaseHibernated
+	st.observePause()
+	require.Equal(t, 3, planStatuses(st).Len())
+	assert.Nil(t, meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionOperationPaused))
+}
+
+func TestObservePause_IdlePlan_IgnoresAnnotation(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Annotations = map[string]string{wellknown.AnnotationPauseOperation: "true"}
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	st.observePause()
+
+	assert.Equal(t, 0, planStatuses(st).Len())
+	assert.False(t, isOperationPaused(plan))
+}
+
+func TestHibernatingState_Paused_HoldsNextStage(t *testing.T) {
+	plan := pausedPlan()
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	h := &hibernatingState{state: st}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.NotZero(t, result.RequeueAfter)
+
+	var jobs batchv1.JobList
+	require.NoError(t, st.List(context.Background(), &jobs))
+	assert.Empty(t, jobs.Items, "no target may be dispatched while paused")
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, plan.Status.Phase)
+
+	delete(plan.Annotations, wellknown.AnnotationPauseOperation)
+	_, err = h.Handle(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, st.List(context.Background(), &jobs))
+	require.Len(t, jobs.Items, 1, "resuming dispatches the pending target")
+	assert.Equal(t, "target-b", jobs.Items[0].Labels[wellknown.LabelTarget])
+}
+
+func TestCycleTimeoutReason_ResumeRestartsBudget(t *testing.T) {
+	plan := pausedPlan()
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	execPlan := scheduler.ExecutionPlan{Stages: make([]scheduler.ExecutionStage, 2)}
+	now := st.Clock.Now()
+
+	plan.Spec.Behavior.MaxCycleDuration = "1h"
+	plan.Status.LastTransitionTime = ptr.To(metav1.NewTime(now.Add(-2 * time.Hour)))
+	require.NotEmpty(t, cycleTimeoutReason(st.Clock, plan, hibernatorv1alpha1.OperationHibernate, execPlan))
+
+	meta.SetStatusCondition(&plan.Status.Conditions, metav1.Condition{
+		Type:               hibernatorv1alpha1.ConditionOperationPaused,
+		Status:             metav1.ConditionFalse,
+		Reason:             hibernatorv1alpha1.ReasonOperationResumed,
+		LastTransitionTime: metav1.NewTime(now.Add(-10 * time.Minute)),
+	})
+	assert.Empty(t, cycleTimeoutReason(st.Clock, plan, hibernatorv1alpha1.OperationHibernate, execPlan))
+}
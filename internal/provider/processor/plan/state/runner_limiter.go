@@ -0,0 +1,108 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ardikabs/hibernator/internal/remoterunner"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// RunnerJobLimiter bounds the runner Jobs running at once across all plans, so
+// that plans sharing a schedule do not start hundreds of executors together
+// and exhaust the rate limits of the cloud APIs they call. Runner Jobs
+// dispatched into a runner cluster count as well, through their records.
+type RunnerJobLimiter struct {
+	reader client.Reader
+	limit  int
+
+	mu sync.Mutex
+	// reserved counts the slots reserved for runner Jobs being created.
+	reserved int
+}
+
+// NewRunnerJobLimiter returns a limiter allowing limit runner Jobs to run at
+// once. Running Jobs are counted through reader, which should read from the API
+// server directly: a cache lagging behind recent creations lets dispatches
+// overshoot the limit. A limit of zero or less disables it.
+func NewRunnerJobLimiter(reader client.Reader, limit int) *RunnerJobLimiter {
+	return &RunnerJobLimiter{reader: reader, limit: limit}
+}
+
+// Reserve reserves a slot for a new runner Job. It returns a nil release when
+// the limit is reached; otherwise the caller creates the Job and then calls
+// release. Reserved slots count as running until released, so concurrent
+// reconciles cannot overshoot the limit together while they create their Jobs.
+// A nil limiter never limits.
+func (l *RunnerJobLimiter) Reserve(ctx context.Context) (release func(), err error) {
+	if l == nil || l.limit <= 0 {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	running, err := l.running(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count running runner jobs: %w", err)
+	}
+	if running+l.reserved >= l.limit {
+		return nil, nil
+	}
+
+	l.reserved++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			l.reserved--
+			l.mu.Unlock()
+		})
+	}, nil
+}
+
+// Limit returns the number of runner Jobs allowed to run at once, zero when
+// unlimited.
+func (l *RunnerJobLimiter) Limit() int {
+	if l == nil || l.limit <= 0 {
+		return 0
+	}
+	return l.limit
+}
+
+// running counts the runner Jobs of all plans that have not terminated yet:
+// the local Jobs, and the records of the Jobs in runner clusters whose runner
+// has not reported a result.
+func (l *RunnerJobLimiter) running(ctx context.Context) (int, error) {
+	var jobs batchv1.JobList
+	if err := l.reader.List(ctx, &jobs, client.HasLabels{wellknown.LabelExecutionID}); err != nil {
+		return 0, err
+	}
+	var records corev1.ConfigMapList
+	if err := l.reader.List(ctx, &records, client.MatchingLabels{wellknown.LabelRemoteRunnerJob: "true"}); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := range jobs.Items {
+		if !isJobTerminal(&jobs.Items[i]) {
+			count++
+		}
+	}
+	for i := range records.Items {
+		if _, ok := remoterunner.ResultOf(&records.Items[i]); !ok {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/remoterunner"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func newLimiterTestJob(namespace, name string, conditions ...batchv1.JobConditionType) *batchv1.Job {
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    map[string]string{wellknown.LabelExecutionID: name},
+	}}
+	for _, cond := range conditions {
+		job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{Type: cond, Status: corev1.ConditionTrue})
+	}
+	return job
+}
+
+func TestRunnerJobLimiter_Reserve(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	c := newHandlerFakeClient(plan,
+		newLimiterTestJob("team-a", "running"),
+		newLimiterTestJob("team-b", "done", batchv1.JobComplete),
+		newLimiterTestJob("team-b", "failed", batchv1.JobFailed),
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "team-a"}},
+	)
+
+	release, err := NewRunnerJobLimiter(c, 2).Reserve(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, release, "only one runner job is running across namespaces")
+	release()
+
+	release, err = NewRunnerJobLimiter(c, 1).Reserve(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, release, "the limit is reached")
+
+	for _, limiter := range []*RunnerJobLimiter{nil, NewRunnerJobLimiter(c, 0)} {
+		release, err = limiter.Reserve(context.Background())
+		require.NoError(t, err)
+		require.NotNil(t, release, "a disabled limiter never limits")
+		release()
+		assert.Zero(t, limiter.Limit())
+	}
+}
+
+func TestRunnerJobLimiter_CountsRemoteRunnerJobs(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	result, err := json.Marshal(remoterunner.Result{Success: true})
+	require.NoError(t, err)
+
+	record := func(name string, annotations map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "team-a",
+			Labels:      map[string]string{wellknown.LabelRemoteRunnerJob: "true", wellknown.LabelExecutionID: name},
+			Annotations: annotations,
+		}}
+	}
+	c := newHandlerFakeClient(plan,
+		record("remote-running", nil),
+		record("remote-done", map[string]string{wellknown.AnnotationRemoteResult: string(result)}),
+	)
+
+	release, err := NewRunnerJobLimiter(c, 1).Reserve(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, release, "the remote runner job without a result holds the only slot")
+
+	release, err = NewRunnerJobLimiter(c, 2).Reserve(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, release)
+	release()
+}
+
+func TestRunnerJobLimiter_ReservedSlotsCountUntilReleased(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	limiter := NewRunnerJobLimiter(newHandlerFakeClient(plan, newLimiterTestJob("team-a", "running")), 2)
+
+	// Reservations do not hold the limiter while the Job is created, but
+	// count as running until released.
+	first, err := limiter.Reserve(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, first)
+
+	second, err := limiter.Reserve(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, second, "the running job and the reserved slot fill the limit")
+
+	first()
+	first()
+	second, err = limiter.Reserve(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, second, "releasing frees the slot, once")
+	second()
+}
+
+func TestExecuteForStage_WaitsForRunnerJobSlot(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "db", Type: "rds"},
+		{Name: "api", Type: "ec2"},
+	}
+	c := newHandlerFakeClient(plan, newLimiterTestJob("team-a", "other-plan"))
+	st := newHandlerState(plan, c)
+	st.ExecutorInfra.JobLimiter = NewRunnerJobLimiter(c, 2)
+
+	stage := scheduler.ExecutionStage{Targets: []string{"db", "api"}}
+	result, err := st.executeForStage(context.Background(), st.Log, plan, nil, stage, hibernatorv1alpha1.OperationHibernate)
+	require.NoError(t, err)
+	assert.Equal(t, wellknown.RequeueIntervalDuringStage, result.RequeueAfter)
+
+	var jobs batchv1.JobList
+	require.NoError(t, c.List(context.Background(), &jobs, client.InNamespace(plan.Namespace)))
+	require.Len(t, jobs.Items, 1, "the job of another plan holds one of the two slots")
+	assert.Equal(t, "db", jobs.Items[0].Labels[wellknown.LabelTarget])
+}
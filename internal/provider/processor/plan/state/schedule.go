@@ -0,0 +1,47 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// observeSchedule records the next transition times of the schedule evaluation in
+// status.nextHibernateTime and status.nextWakeUpTime, exposing how the controller
+// resolved the schedule, e.g. across a daylight saving time change or with jitter.
+//
+// Plans without a phase or schedule result are left alone. A status update is only
+// queued when something changes.
+func (s *state) observeSchedule() {
+	plan := s.plan()
+	if plan.Status.Phase == "" || s.PlanCtx.Schedule == nil {
+		return
+	}
+
+	nextHibernate := statusTime(s.PlanCtx.Schedule.NextHibernate)
+	nextWakeUp := statusTime(s.PlanCtx.Schedule.NextWakeUp)
+	if plan.Status.NextHibernateTime.Equal(nextHibernate) && plan.Status.NextWakeUpTime.Equal(nextWakeUp) {
+		return
+	}
+
+	s.queueGenerationStatus(plan, func(p *hibernatorv1alpha1.HibernatePlan) {
+		p.Status.NextHibernateTime = nextHibernate
+		p.Status.NextWakeUpTime = nextWakeUp
+	})
+}
+
+// statusTime returns t truncated to the second precision of status timestamps,
+// or nil when t is zero.
+func statusTime(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &metav1.Time{Time: t.Truncate(time.Second)}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/message"
+)
+
+func TestObserveSchedule_RecordsNextTransitions(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	nextHibernate := time.Date(2026, 11, 2, 1, 0, 0, 0, time.UTC)
+	nextWakeUp := time.Date(2026, 11, 1, 11, 0, 0, 0, time.UTC)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{NextHibernate: nextHibernate, NextWakeUp: nextWakeUp}
+
+	st.observeSchedule()
+	require.Equal(t, 1, planStatuses(st).Len())
+	require.NotNil(t, plan.Status.NextHibernateTime)
+	require.NotNil(t, plan.Status.NextWakeUpTime)
+	assert.True(t, nextHibernate.Equal(plan.Status.NextHibernateTime.Time))
+	assert.True(t, nextWakeUp.Equal(plan.Status.NextWakeUpTime.Time))
+
+	// Already recorded: no further status updates.
+	st.observeSchedule()
+	assert.Equal(t, 1, planStatuses(st).Len())
+
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{NextHibernate: nextHibernate}
+	st.observeSchedule()
+	require.Equal(t, 2, planStatuses(st).Len())
+	assert.Nil(t, plan.Status.NextWakeUpTime)
+}
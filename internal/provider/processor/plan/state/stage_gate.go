@@ -0,0 +1,167 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// stageGateWait reports how long the next stage must still wait on the gates
+// of a completed stage: its post delay and, on wakeup, its readiness probe.
+// Both are measured from when the last target of the stage finished. It
+// returns an error once the readiness probe kept failing past its timeout.
+func (s *state) stageGateWait(ctx context.Context, log logr.Logger,
+	plan *hibernatorv1alpha1.HibernatePlan,
+	stage scheduler.ExecutionStage,
+	operation hibernatorv1alpha1.PlanOperation) (time.Duration, error) {
+
+	idx := slices.IndexFunc(plan.Spec.Execution.Strategy.Stages, func(st hibernatorv1alpha1.Stage) bool {
+		return stage.Name != "" && st.Name == stage.Name
+	})
+	if idx < 0 {
+		return 0, nil
+	}
+	spec := plan.Spec.Execution.Strategy.Stages[idx]
+	if spec.PostDelay == "" && spec.ReadinessProbe == nil {
+		return 0, nil
+	}
+
+	completedAt := stageCompletedAt(plan, stage)
+	if completedAt.IsZero() {
+		return 0, nil
+	}
+	now := s.Clock.Now()
+
+	var delay time.Duration
+	if spec.PostDelay != "" {
+		if d, err := time.ParseDuration(spec.PostDelay); err == nil {
+			delay = d
+		}
+	}
+	if wait := completedAt.Add(delay).Sub(now); wait > 0 {
+		log.V(1).Info("waiting for stage post delay", "stage", stage.Name, "remaining", wait)
+		return wait, nil
+	}
+
+	probe := spec.ReadinessProbe
+	if probe == nil || operation != hibernatorv1alpha1.OperationWakeUp {
+		return 0, nil
+	}
+
+	err := s.checkStageReadiness(ctx, plan.Namespace, probe)
+	if err == nil {
+		log.Info("stage readiness probe passed", "stage", stage.Name)
+		return 0, nil
+	}
+
+	timeout := wellknown.DefaultStageReadinessTimeout
+	if probe.Timeout != "" {
+		if d, parseErr := time.ParseDuration(probe.Timeout); parseErr == nil {
+			timeout = d
+		}
+	}
+	if !now.Before(completedAt.Add(delay + timeout)) {
+		return 0, fmt.Errorf("stage %q not ready within %s: %w", stage.Name, timeout, err)
+	}
+
+	log.V(1).Info("waiting for stage readiness probe", "stage", stage.Name, "reason", err.Error())
+	return wellknown.StageReadinessProbeInterval, nil
+}
+
+// stageCompletedAt returns when the last target of the stage finished, or the
+// zero time when none of them recorded a finish time.
+func stageCompletedAt(plan *hibernatorv1alpha1.HibernatePlan, stage scheduler.ExecutionStage) time.Time {
+	var completedAt time.Time
+	for _, exec := range plan.Status.Executions {
+		if exec.FinishedAt == nil || !slices.Contains(stage.Targets, exec.Target) {
+			continue
+		}
+		if exec.FinishedAt.After(completedAt) {
+			completedAt = exec.FinishedAt.Time
+		}
+	}
+	return completedAt
+}
+
+// checkStageReadiness runs the check of a stage readiness probe once.
+func (s *state) checkStageReadiness(ctx context.Context, namespace string, probe *hibernatorv1alpha1.StageReadinessProbe) error {
+	ctx, cancel := context.WithTimeout(ctx, wellknown.StageReadinessCheckTimeout)
+	defer cancel()
+
+	switch {
+	case probe.HTTP != nil:
+		return checkHTTPReadiness(ctx, probe.HTTP.URL)
+	case probe.TCP != nil:
+		return checkTCPReadiness(ctx, probe.TCP.Address)
+	case probe.Resource != nil:
+		return s.checkResourceReadiness(ctx, namespace, probe.Resource)
+	default:
+		return fmt.Errorf("readiness probe sets no check")
+	}
+}
+
+func checkHTTPReadiness(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("GET %s returned %s", url, resp.Status)
+	}
+	return nil
+}
+
+func checkTCPReadiness(ctx context.Context, address string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// checkResourceReadiness reads the resource directly from the API server, so
+// probing a kind does not start an informer for it.
+func (s *state) checkResourceReadiness(ctx context.Context, namespace string, check *hibernatorv1alpha1.ResourceReadinessCheck) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(check.APIVersion, check.Kind))
+	if err := s.APIReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: check.Name}, obj); err != nil {
+		return fmt.Errorf("get %s %s: %w", check.Kind, check.Name, err)
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]any)
+		if !ok || cond["type"] != check.Condition {
+			continue
+		}
+		if cond["status"] == "True" {
+			return nil
+		}
+		return fmt.Errorf("%s %s condition %s is %v", check.Kind, check.Name, check.Condition, cond["status"])
+	}
+	return fmt.Errorf("%s %s has no %s condition", check.Kind, check.Name, check.Condition)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// newStageGatePlan returns a Staged plan whose "data" stage finished at
+// finishedAt and carries the given gates.
+func newStageGatePlan(finishedAt time.Time, postDelay string, probe *hibernatorv1alpha1.StageReadinessProbe) *hibernatorv1alpha1.HibernatePlan {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseWakingUp)
+	plan.Spec.Execution.Strategy = hibernatorv1alpha1.ExecutionStrategy{
+		Type: hibernatorv1alpha1.StrategyStaged,
+		Stages: []hibernatorv1alpha1.Stage{
+			{Name: "data", Targets: []string{"db"}, PostDelay: postDelay, ReadinessProbe: probe},
+			{Name: "apps", Targets: []string{"api"}},
+		},
+	}
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "db", State: hibernatorv1alpha1.StateCompleted, FinishedAt: &metav1.Time{Time: finishedAt}},
+		{Target: "api", State: hibernatorv1alpha1.StatePending},
+	}
+	return plan
+}
+
+var dataStage = scheduler.ExecutionStage{Name: "data", Targets: []string{"db"}}
+
+func TestStageGateWait_PostDelay(t *testing.T) {
+	plan := newStageGatePlan(time.Now(), "2m", nil)
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	clk := st.Clock.(*clocktesting.FakeClock)
+	clk.SetTime(plan.Status.Executions[0].FinishedAt.Add(30 * time.Second))
+
+	wait, err := st.stageGateWait(context.Background(), st.Log, plan, dataStage, hibernatorv1alpha1.OperationHibernate)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, wait)
+
+	clk.Step(90 * time.Second)
+	wait, err = st.stageGateWait(context.Background(), st.Log, plan, dataStage, hibernatorv1alpha1.OperationHibernate)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestStageGateWait_ReadinessProbe(t *testing.T) {
+	ready := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	probe := &hibernatorv1alpha1.StageReadinessProbe{HTTP: &hibernatorv1alpha1.HTTPReadinessCheck{URL: srv.URL}, Timeout: "5m"}
+	plan := newStageGatePlan(time.Now(), "", probe)
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	clk := st.Clock.(*clocktesting.FakeClock)
+	clk.SetTime(plan.Status.Executions[0].FinishedAt.Add(time.Minute))
+
+	wait, err := st.stageGateWait(context.Background(), st.Log, plan, dataStage, hibernatorv1alpha1.OperationHibernate)
+	require.NoError(t, err)
+	assert.Zero(t, wait, "the probe is not checked on hibernation")
+
+	wait, err = st.stageGateWait(context.Background(), st.Log, plan, dataStage, hibernatorv1alpha1.OperationWakeUp)
+	require.NoError(t, err)
+	assert.Equal(t, wellknown.StageReadinessProbeInterval, wait)
+
+	ready = true
+	wait, err = st.stageGateWait(context.Background(), st.Log, plan, dataStage, hibernatorv1alpha1.OperationWakeUp)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	ready = false
+	clk.Step(5 * time.Minute)
+	_, err = st.stageGateWait(context.Background(), st.Log, plan, dataStage, hibernatorv1alpha1.OperationWakeUp)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `stage "data" not ready within 5m0s`)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestCheckStageReadiness(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	require.NoError(t, closed.Close())
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: "default"},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+			{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+		}},
+	}
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseWakingUp)
+	st := newHandlerState(plan, newHandlerFakeClient(plan, pod))
+
+	resourceCheck := func(condition string) *hibernatorv1alpha1.StageReadinessProbe {
+		return &hibernatorv1alpha1.StageReadinessProbe{Resource: &hibernatorv1alpha1.ResourceReadinessCheck{
+			APIVersion: "v1", Kind: "Pod", Name: "db-0", Condition: condition,
+		}}
+	}
+
+	tests := []struct {
+		name    string
+		probe   *hibernatorv1alpha1.StageReadinessProbe
+		wantErr string
+	}{
+		{name: "tcp open", probe: &hibernatorv1alpha1.StageReadinessProbe{TCP: &hibernatorv1alpha1.TCPReadinessCheck{Address: listener.Addr().String()}}},
+		{name: "tcp refused", probe: &hibernatorv1alpha1.StageReadinessProbe{TCP: &hibernatorv1alpha1.TCPReadinessCheck{Address: closedAddr}}, wantErr: "refused"},
+		{name: "condition true", probe: resourceCheck("PodScheduled")},
+		{name: "condition false", probe: resourceCheck("Ready"), wantErr: "Pod db-0 condition Ready is False"},
+		{name: "condition missing", probe: resourceCheck("Initialized"), wantErr: "Pod db-0 has no Initialized condition"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := st.checkStageReadiness(context.Background(), "default", tt.probe)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
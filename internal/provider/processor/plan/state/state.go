@@ -19,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
 	"github.com/ardikabs/hibernator/internal/message"
 	"github.com/ardikabs/hibernator/internal/notification"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
@@ -130,8 +131,11 @@ func (f HandlerFunc) OnDeadline(ctx context.Context) (StateResult, error) { retu
 func (f HandlerFunc) OnError(_ context.Context, _ error) StateResult { return StateResult{} }
 
 // New creates a Handler for the given plan context. It constructs a fresh state
-// from the provided configuration and dispatches to the phase-appropriate handler.
-// Returns nil for unrecognised phases.
+// from the provided configuration, reconciles the observed generation (see
+// observeGeneration), the next transition times of the schedule (see
+// observeSchedule) and the TargetsResolved, ConnectorsReady and OperationPaused
+// conditions (see observeTargets, observeConnectors and observePause), and
+// dispatches to the phase-appropriate handler. Returns nil for unrecognised phases.
 //
 // The caller (Worker) is responsible for supplying a fresh Config on every
 // handle() call. Because planCtx.Plan is the same pointer stored in the worker's
@@ -142,6 +146,11 @@ func New(key types.NamespacedName, planCtx *message.PlanContext, cfg *Config) Ha
 		return nil
 	}
 	s := newState(key, planCtx, cfg)
+	s.observeGeneration()
+	s.observeSchedule()
+	s.observeTargets()
+	s.observeConnectors()
+	s.observePause()
 	return selectHandler(s)
 }
 
@@ -153,6 +162,7 @@ func newState(key types.NamespacedName, planCtx *message.PlanContext, cfg *Confi
 		Log:            cfg.Log,
 		Infrastructure: cfg.Infrastructure,
 		ExecutorInfra:  cfg.ExecutorInfra,
+		CostAllocation: cfg.CostAllocation,
 		Callbacks:      cfg.Callbacks,
 		Planner:        cfg.Planner,
 		RestoreManager: cfg.RestoreManager,
@@ -176,6 +186,7 @@ type state struct {
 	Key            types.NamespacedName
 	PlanCtx        *message.PlanContext
 	ExecutorInfra  ExecutorInfra
+	CostAllocation CostAllocation
 	Callbacks      StateCallbacks
 	Planner        *scheduler.Planner
 	RestoreManager *restore.Manager
@@ -245,7 +256,7 @@ func (s *state) findActiveExceptionOverride() *hibernatorv1alpha1.ScheduleExcept
 		if exc.Spec.Type == hibernatorv1alpha1.ExceptionSuspend {
 			continue
 		}
-		if !exc.Spec.ValidFrom.Time.Before(now) || !now.Before(exc.Spec.ValidUntil.Time) {
+		if from, until := exc.EffectiveWindow(); !from.Before(now) || !now.Before(until) {
 			continue
 		}
 		// Skip exceptions being deleted; they should not influence fresh cycles.
@@ -262,11 +273,27 @@ func (s *state) findActiveExceptionOverride() *hibernatorv1alpha1.ScheduleExcept
 	return result
 }
 
-// effectivePlan returns the plan to use for execution.
+// effectivePlan returns the plan to use for spec-related decisions.
+// While an operation is in flight (PhaseHibernating or PhaseWakingUp), it is the
+// cyclePlan, so the operation runs with the intent locked at cycle start. In
+// every other phase spec edits apply right away, with the execution overrides
+// of active exceptions applied by buildEffectivePlan.
+func (s *state) effectivePlan(plan *hibernatorv1alpha1.HibernatePlan) *hibernatorv1alpha1.HibernatePlan {
+	switch plan.Status.Phase {
+	case hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.PhaseWakingUp:
+		return s.cyclePlan(plan)
+	}
+	if ep := s.buildEffectivePlan(plan); ep != nil {
+		return ep
+	}
+	return plan
+}
+
+// cyclePlan returns the plan the current cycle runs with.
 // If a PlanSnapshot exists for the current cycle, it reconstructs the plan with
 // the snapshot's spec, preserving the current status. Otherwise it falls back to
 // buildEffectivePlan.
-func (s *state) effectivePlan(plan *hibernatorv1alpha1.HibernatePlan) *hibernatorv1alpha1.HibernatePlan {
+func (s *state) cyclePlan(plan *hibernatorv1alpha1.HibernatePlan) *hibernatorv1alpha1.HibernatePlan {
 	if snap := plan.Status.PlanSnapshot; snap != nil && snap.CycleID == plan.Status.CurrentCycleID {
 		log := s.Log.WithValues("plan", s.Key.String(), "cycleID", snap.CycleID)
 		log.V(1).Info("using locked plan snapshot for execution", "exception", snap.ExceptionName)
@@ -384,6 +411,8 @@ func (b *state) setError(_ context.Context, phaseErr error) {
 				return buildPayload(p, hibernatorv1alpha1.EventFailure, b.Clock.Now)
 			}),
 			b.phaseChangePostHook(previousPhase),
+			b.operationEventHook(hibernatorv1alpha1.EventFailure, plan.Status.CurrentOperation),
+			b.auditHook(previousPhase, audit.TriggerFailure, ""),
 		),
 	})
 }
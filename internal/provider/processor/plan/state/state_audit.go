@@ -0,0 +1,88 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// sourceSpecSuspend is the audit source of suspensions requested through spec.suspend.
+const sourceSpecSuspend = "spec.suspend"
+
+// auditHook returns a PostHook that records the transition of the written plan
+// from previousPhase in the audit log, attributed to trigger and actor. Returns
+// nil when no audit recorder is configured.
+func (s *state) auditHook(previousPhase hibernatorv1alpha1.PlanPhase, trigger audit.Trigger, actor string) func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	return s.recordAuditHook(previousPhase, trigger, func(*hibernatorv1alpha1.HibernatePlan) (string, string) {
+		return actor, ""
+	})
+}
+
+// manualAuditHook returns a PostHook that records a transition a user
+// requested through source, an annotation or spec.suspend. The actor is the
+// user the admission webhook recorded as the requester of the plan's manual
+// actions, empty when it is unknown.
+func (s *state) manualAuditHook(previousPhase hibernatorv1alpha1.PlanPhase, source string) func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	return s.recordAuditHook(previousPhase, audit.TriggerManual, func(plan *hibernatorv1alpha1.HibernatePlan) (string, string) {
+		return plan.Annotations[wellknown.AnnotationRequestedBy], source
+	})
+}
+
+// recordAuditHook returns a PostHook that records the transition of the
+// written plan from previousPhase, attributed to trigger and to the actor and
+// source attribute returns for the plan.
+func (s *state) recordAuditHook(previousPhase hibernatorv1alpha1.PlanPhase, trigger audit.Trigger, attribute func(*hibernatorv1alpha1.HibernatePlan) (actor, source string)) func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	if s.Audit == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
+		at := metav1.NewTime(s.Clock.Now())
+		if plan.Status.LastTransitionTime != nil {
+			at = *plan.Status.LastTransitionTime
+		}
+
+		record := audit.Record{
+			Time:      at,
+			From:      previousPhase,
+			To:        plan.Status.Phase,
+			Operation: plan.Status.CurrentOperation,
+			CycleID:   plan.Status.CurrentCycleID,
+			Trigger:   trigger,
+		}
+		record.Actor, record.Source = attribute(plan)
+		if plan.Status.Phase == hibernatorv1alpha1.PhaseError || trigger == audit.TriggerTimeout {
+			record.Message = plan.Status.ErrorMessage
+		}
+
+		if err := s.Audit.Record(ctx, plan, record); err != nil {
+			return fmt.Errorf("record audit log: %w", err)
+		}
+		return nil
+	}
+}
+
+// scheduleTrigger attributes a transition the schedule result calls for: to the
+// schedule exceptions active for the plan, or else to its schedule.
+func (s *state) scheduleTrigger() (audit.Trigger, string) {
+	if s.PlanCtx == nil || s.PlanCtx.Schedule == nil || len(s.PlanCtx.Schedule.Exceptions) == 0 {
+		return audit.TriggerSchedule, ""
+	}
+
+	names := make([]string, 0, len(s.PlanCtx.Schedule.Exceptions))
+	for _, exc := range s.PlanCtx.Schedule.Exceptions {
+		names = append(names, exc.Name)
+	}
+	return audit.TriggerException, strings.Join(names, ",")
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
+	"github.com/ardikabs/hibernator/internal/message"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+type fakeAuditRecorder struct {
+	records []audit.Record
+}
+
+func (f *fakeAuditRecorder) Record(_ context.Context, _ *hibernatorv1alpha1.HibernatePlan, record audit.Record) error {
+	f.records = append(f.records, record)
+	return nil
+}
+
+// applyNextUpdate applies the next queued status update to plan and runs its PostHook.
+func applyNextUpdate(t *testing.T, st *state, plan *hibernatorv1alpha1.HibernatePlan) {
+	t.Helper()
+	upd := <-planStatuses(st).C()
+	upd.Mutator.Mutate(plan)
+	if upd.PostHook != nil {
+		require.NoError(t, upd.PostHook(context.Background(), plan))
+	}
+}
+
+func TestAuditHook_RecordsTransition(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseError)
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationWakeUp
+	plan.Status.CurrentCycleID = "abc123"
+	plan.Status.ErrorMessage = "boom"
+	at := metav1.Now()
+	plan.Status.LastTransitionTime = &at
+
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	recorder := &fakeAuditRecorder{}
+	st.Audit = recorder
+
+	hook := st.auditHook(hibernatorv1alpha1.PhaseWakingUp, audit.TriggerFailure, "")
+	require.NotNil(t, hook)
+	require.NoError(t, hook(context.Background(), plan))
+
+	require.Len(t, recorder.records, 1)
+	assert.Equal(t, audit.Record{
+		Time:      at,
+		From:      hibernatorv1alpha1.PhaseWakingUp,
+		To:        hibernatorv1alpha1.PhaseError,
+		Operation: hibernatorv1alpha1.OperationWakeUp,
+		CycleID:   "abc123",
+		Trigger:   audit.TriggerFailure,
+		Message:   "boom",
+	}, recorder.records[0])
+}
+
+func TestAuditHook_NoRecorder(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	assert.Nil(t, st.auditHook(hibernatorv1alpha1.PhaseActive, audit.TriggerSchedule, ""))
+}
+
+func TestIdleState_AuditsTransitionTrigger(t *testing.T) {
+	tests := []struct {
+		name         string
+		manualSource string
+		requestedBy  string
+		exceptions   []string
+		wantTrigger  audit.Trigger
+		wantActor    string
+		wantSource   string
+	}{
+		{name: "schedule", wantTrigger: audit.TriggerSchedule},
+		{name: "exceptions", exceptions: []string{"holiday", "freeze"}, wantTrigger: audit.TriggerException, wantActor: "holiday,freeze"},
+		{name: "annotation", manualSource: wellknown.AnnotationOverrideAction, requestedBy: "alice", exceptions: []string{"holiday"},
+			wantTrigger: audit.TriggerManual, wantActor: "alice", wantSource: wellknown.AnnotationOverrideAction},
+		{name: "annotation by unknown user", manualSource: wellknown.AnnotationOverrideAction,
+			wantTrigger: audit.TriggerManual, wantSource: wellknown.AnnotationOverrideAction},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+			if tt.requestedBy != "" {
+				plan.Annotations = map[string]string{wellknown.AnnotationRequestedBy: tt.requestedBy}
+			}
+			st := newHandlerState(plan, newHandlerFakeClient(plan))
+			recorder := &fakeAuditRecorder{}
+			st.Audit = recorder
+			st.PlanCtx.Schedule = &message.ScheduleEvaluation{ShouldHibernate: true}
+			for _, name := range tt.exceptions {
+				st.PlanCtx.Schedule.Exceptions = append(st.PlanCtx.Schedule.Exceptions, hibernatorv1alpha1.ScheduleException{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+				})
+			}
+
+			h := &idleState{state: st, manualSource: tt.manualSource}
+			_, err := h.transitionToHibernating(context.Background(), st.Log, false)
+			require.NoError(t, err)
+			applyNextUpdate(t, st, plan)
+
+			require.Len(t, recorder.records, 1)
+			got := recorder.records[0]
+			assert.Equal(t, hibernatorv1alpha1.PhaseActive, got.From)
+			assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, got.To)
+			assert.Equal(t, hibernatorv1alpha1.OperationHibernate, got.Operation)
+			assert.Equal(t, tt.wantTrigger, got.Trigger)
+			assert.Equal(t, tt.wantActor, got.Actor)
+			assert.Equal(t, tt.wantSource, got.Source)
+		})
+	}
+}
+
+func TestSelectIdleHandler_AttributesAnnotation(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernated)
+	plan.Annotations = map[string]string{wellknown.AnnotationRestart: "true"}
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	h, ok := selectIdleHandler(st).(*restartState)
+	require.True(t, ok)
+	assert.Equal(t, wellknown.AnnotationRestart, h.manualSource)
+}
+
+func TestSuspendedState_AuditsDeadlineResume(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseSuspended)
+	plan.Spec.Suspend = true
+	plan.Annotations = map[string]string{
+		wellknown.AnnotationSuspendUntil:     "2020-01-01T00:00:00Z",
+		wellknown.AnnotationSuspendedAtPhase: string(hibernatorv1alpha1.PhaseActive),
+	}
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	recorder := &fakeAuditRecorder{}
+	st.Audit = recorder
+
+	h := &suspendedState{state: st}
+	_, err := h.OnDeadline(context.Background())
+	require.NoError(t, err)
+	applyNextUpdate(t, st, plan)
+
+	require.Len(t, recorder.records, 1)
+	got := recorder.records[0]
+	assert.Equal(t, hibernatorv1alpha1.PhaseSuspended, got.From)
+	assert.Equal(t, hibernatorv1alpha1.PhaseActive, got.To)
+	assert.Equal(t, audit.TriggerDeadline, got.Trigger)
+	assert.Equal(t, wellknown.AnnotationSuspendUntil, got.Actor)
+}
@@ -6,16 +6,29 @@ Licensed under the Apache License, Version 2.0.
 package state
 
 import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"time"
+
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
+	"github.com/ardikabs/hibernator/internal/history"
 	"github.com/ardikabs/hibernator/internal/message"
 	"github.com/ardikabs/hibernator/internal/notification"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/restore"
+	"github.com/ardikabs/hibernator/internal/runnerimage"
 	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/tracing"
+	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
 // Infrastructure groups the core Kubernetes client and runtime dependencies
@@ -27,14 +40,208 @@ type Infrastructure struct {
 	APIReader client.Reader
 	Scheme    *runtime.Scheme
 	Clock     clock.Clock
+
+	// Connectors reads CloudProvider and K8SCluster resources for notification
+	// enrichment, typically through a watch-invalidated cache. APIReader is
+	// used when nil.
+	Connectors client.Reader
+
+	// Recorder records the lifecycle Events of plans. Nil records no Events.
+	Recorder record.EventRecorder
+
+	// Audit records the phase transitions of plans in their audit log. Nil
+	// records no audit log.
+	Audit audit.Recorder
+
+	// Archiver archives the cycles pruned from the execution history of plans
+	// with spec.executionHistory.archive set. Nil archives no cycles.
+	Archiver history.Archiver
+}
+
+// connectorReader returns the reader used to resolve connector references.
+func (i Infrastructure) connectorReader() client.Reader {
+	if i.Connectors != nil {
+		return i.Connectors
+	}
+	return i.APIReader
 }
 
 // ExecutorInfra groups the configuration needed to create runner Jobs that
 // invoke executors for individual targets.
 type ExecutorInfra struct {
 	RunnerImage          string
+	RunnerImages         map[string]string
 	RunnerServiceAccount string
 	ControlPlaneEndpoint string
+
+	// RunnerServiceAccounts are the ServiceAccounts, besides RunnerServiceAccount,
+	// targets may select for their runner Jobs through runnerServiceAccount.
+	RunnerServiceAccounts []string
+
+	// RunnerImageAllowlist holds the images, besides RunnerImage and
+	// RunnerImages, targets may select for their runner Jobs through runnerImage.
+	RunnerImageAllowlist runnerimage.Allowlist
+
+	// EndpointChecker verifies ControlPlaneEndpoint before runner Jobs are
+	// created. When the check fails, runners are dispatched without streaming
+	// endpoints. Nil skips the check.
+	EndpointChecker EndpointChecker
+
+	// StreamToken configures the projected ServiceAccount token runners use to
+	// authenticate to the streaming servers.
+	StreamToken StreamTokenConfig
+
+	// ClientCerts issues the client certificates runners present to streaming
+	// servers that require mutual TLS. Nil dispatches runners over plaintext.
+	ClientCerts ClientCertIssuer
+
+	// Tracing configures the span export of runners. Runner Jobs continue the
+	// trace of the span dispatching them.
+	Tracing tracing.Config
+
+	// RunnerHeartbeatTimeout is how long an active runner Job may go without a
+	// recorded heartbeat before its execution is reported stale. Jobs whose
+	// runner never sent one, e.g. because streaming is unavailable, are not
+	// checked. Zero disables the check.
+	RunnerHeartbeatTimeout time.Duration
+
+	// StaleRunnerRestartAfter is how long after its last heartbeat the pod of
+	// a stale runner is deleted, so that its Job retries it within its backoff
+	// limit. Zero only reports stale runners.
+	StaleRunnerRestartAfter time.Duration
+
+	// JobLimiter bounds the runner Jobs running at once across all plans.
+	// Targets wait in Pending for a free slot. Nil leaves them unbounded.
+	JobLimiter *RunnerJobLimiter
+
+	// MaxResourcesPerTarget is the default of spec.behavior.maxResourcesPerTarget
+	// for plans that leave it unset. Zero leaves shutdowns unbounded.
+	MaxResourcesPerTarget int32
+
+	// RunnerClusters connects to the clusters of spec.execution.runnerCluster.
+	// Nil fails the dispatch of plans that set it.
+	RunnerClusters RunnerClusterConnector
+}
+
+// RunnerClusterConnector returns clients of the K8SClusters runner Jobs are
+// dispatched into.
+type RunnerClusterConnector interface {
+	ClientFor(ctx context.Context, namespace, name string) (client.Client, error)
+}
+
+// ClientCertIssuer issues runner client certificates as the data of a
+// kubernetes.io/tls Secret, including the CA bundle (ca.crt) the streaming
+// servers are verified with.
+type ClientCertIssuer interface {
+	Issue(commonName string) (map[string][]byte, error)
+}
+
+// StreamTokenConfig configures the projected stream token of runner Jobs. It
+// must agree with the audiences accepted by the streaming TokenValidator.
+// Zero fields fall back to the wellknown defaults.
+type StreamTokenConfig struct {
+	Audience   string
+	Expiration time.Duration
+	MountPath  string
+}
+
+// withDefaults returns c with zero fields set to the wellknown defaults.
+func (c StreamTokenConfig) withDefaults() StreamTokenConfig {
+	if c.Audience == "" {
+		c.Audience = wellknown.StreamTokenAudience
+	}
+	if c.Expiration <= 0 {
+		c.Expiration = wellknown.StreamTokenExpirationSeconds * time.Second
+	}
+	if c.MountPath == "" {
+		c.MountPath = wellknown.StreamTokenMountPath
+	}
+	return c
+}
+
+// TokenPath returns the path of the token file inside the runner container.
+func (c StreamTokenConfig) TokenPath() string {
+	return path.Join(c.MountPath, wellknown.StreamTokenFile)
+}
+
+// EndpointChecker reports whether the control-plane streaming endpoint is
+// reachable from the cluster.
+type EndpointChecker interface {
+	Check(ctx context.Context, endpoint string) error
+}
+
+// RunnerServiceAccountFor resolves the runner ServiceAccount for a target. The
+// target's own RunnerServiceAccount takes precedence over RunnerServiceAccount,
+// but must be one of RunnerServiceAccounts, so plan authors cannot run
+// executors with an arbitrary ServiceAccount of the namespace.
+func (e ExecutorInfra) RunnerServiceAccountFor(target *hibernatorv1alpha1.Target) (string, error) {
+	defaultSA := e.RunnerServiceAccount
+	if defaultSA == "" {
+		defaultSA = "hibernator-runner"
+	}
+	if target.RunnerServiceAccount == "" || target.RunnerServiceAccount == defaultSA {
+		return defaultSA, nil
+	}
+	if !slices.Contains(e.RunnerServiceAccounts, target.RunnerServiceAccount) {
+		return "", fmt.Errorf("runner service account %q is not allowed by the controller", target.RunnerServiceAccount)
+	}
+	return target.RunnerServiceAccount, nil
+}
+
+// RunnerImageFor resolves the runner image for a target. The target's own
+// RunnerImage takes precedence, then the per-type default, then RunnerImage,
+// and finally the built-in default image. The target's image must be one of
+// the configured images or allowed by RunnerImageAllowlist, as it runs with
+// the runner ServiceAccount and its credentials.
+func (e ExecutorInfra) RunnerImageFor(target *hibernatorv1alpha1.Target) (string, error) {
+	if target.RunnerImage != "" {
+		if !e.runnerImageAllowed(target.RunnerImage) {
+			return "", fmt.Errorf("runner image %q is not allowed by the controller", target.RunnerImage)
+		}
+		return target.RunnerImage, nil
+	}
+	if image := e.RunnerImages[target.Type]; image != "" {
+		return image, nil
+	}
+	if e.RunnerImage != "" {
+		return e.RunnerImage, nil
+	}
+	return wellknown.RunnerImage, nil
+}
+
+// runnerImageAllowed reports whether targets may select image.
+func (e ExecutorInfra) runnerImageAllowed(image string) bool {
+	if image == e.RunnerImage || image == wellknown.RunnerImage {
+		return true
+	}
+	for _, configured := range e.RunnerImages {
+		if image == configured {
+			return true
+		}
+	}
+	return e.RunnerImageAllowlist.Allows(image)
+}
+
+// CostAllocation maps chargeback dimensions (e.g., "team") to the plan label
+// keys they are read from. It is stamped onto every execution cycle so savings
+// can be attributed in chargeback reports.
+type CostAllocation map[string]string
+
+// For returns the chargeback metadata for a plan with the given labels, or nil
+// when none of the configured labels are set.
+func (c CostAllocation) For(labels map[string]string) map[string]string {
+	var out map[string]string
+	for dimension, labelKey := range c {
+		value, ok := labels[labelKey]
+		if !ok || value == "" {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(c))
+		}
+		out[dimension] = value
+	}
+	return out
 }
 
 // StateCallbacks groups worker-owned closure pairs that implement the
@@ -58,6 +265,7 @@ type Config struct {
 	Log            logr.Logger
 	Infrastructure Infrastructure
 	ExecutorInfra  ExecutorInfra
+	CostAllocation CostAllocation
 	Callbacks      StateCallbacks
 	Planner        *scheduler.Planner
 	RestoreManager *restore.Manager
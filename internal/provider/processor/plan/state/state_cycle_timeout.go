@@ -0,0 +1,214 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
+	"github.com/ardikabs/hibernator/internal/notification"
+	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// cycleTimeoutError reports that an operation ran past spec.behavior.maxCycleDuration.
+// The hibernating and wakingUp OnError handlers use it to record the abort reason in
+// the cycle history.
+type cycleTimeoutError struct {
+	reason string
+}
+
+func (e *cycleTimeoutError) Error() string { return e.reason }
+
+// cycleTimeoutReason returns a non-empty abort reason when the current operation has
+// run longer than spec.behavior.maxCycleDuration. The budget starts when the plan
+// entered the in-flight phase, and restarts on every retry and on resuming a paused
+// operation so a resumed operation gets a full window.
+func cycleTimeoutReason(clk clock.Clock, plan *hibernatorv1alpha1.HibernatePlan, operation hibernatorv1alpha1.PlanOperation, execPlan scheduler.ExecutionPlan) string {
+	limit, err := time.ParseDuration(plan.Spec.Behavior.MaxCycleDuration)
+	if err != nil || limit <= 0 {
+		return ""
+	}
+
+	start := plan.Status.LastTransitionTime
+	if start == nil || (plan.Status.LastRetryTime != nil && plan.Status.LastRetryTime.After(start.Time)) {
+		start = plan.Status.LastRetryTime
+	}
+	if resumed := resumedAt(plan); resumed != nil && (start == nil || resumed.After(start.Time)) {
+		start = resumed
+	}
+	if start == nil || clk.Since(start.Time) < limit {
+		return ""
+	}
+
+	stage := min(plan.Status.CurrentStageIndex+1, len(execPlan.Stages))
+	return fmt.Sprintf("%s exceeded maxCycleDuration of %s at stage %d of %d",
+		operation, limit, stage, len(execPlan.Stages))
+}
+
+// abortCycle stops an operation that exceeded maxCycleDuration. No further targets are
+// dispatched; once the targets already in flight reach a terminal state, the plan's
+// OnCycleTimeout policy decides how the operation ends:
+//   - Error: the plan transitions to PhaseError and the retry policy resumes it later.
+//   - Continue: targets that never started are aborted and the operation is finalized.
+//   - Rollback: the opposite operation runs on the targets that already changed. A
+//     rollback that itself times out falls back to Error to avoid flip-flopping.
+func (s *state) abortCycle(
+	ctx context.Context,
+	log logr.Logger,
+	plan *hibernatorv1alpha1.HibernatePlan,
+	jobs []batchv1.Job,
+	execPlan scheduler.ExecutionPlan,
+	operation hibernatorv1alpha1.PlanOperation,
+	reason string,
+	onFinalizeCallback func(context.Context, scheduler.ExecutionPlan, string),
+) (StateResult, error) {
+	for _, exec := range plan.Status.Executions {
+		if exec.State == hibernatorv1alpha1.StateRunning ||
+			(exec.State == hibernatorv1alpha1.StatePending && JobExistsForTarget(jobs, exec.Target, operation, plan.Status.CurrentCycleID)) {
+			log.Info("cycle exceeded maxCycleDuration, waiting for in-flight targets before aborting",
+				"reason", reason, "target", exec.Target)
+			return StateResult{RequeueAfter: wellknown.RequeueIntervalDuringStage}, nil
+		}
+	}
+
+	policy := plan.Spec.Behavior.OnCycleTimeout
+	if policy == hibernatorv1alpha1.CycleTimeoutRollback && isRollback(plan, operation) {
+		log.Info("rollback exceeded maxCycleDuration, falling back to Error policy", "reason", reason)
+		policy = hibernatorv1alpha1.CycleTimeoutError
+	}
+
+	switch policy {
+	case hibernatorv1alpha1.CycleTimeoutRollback:
+		log.Info("cycle exceeded maxCycleDuration, rolling back", "reason", reason)
+		s.rollbackCycle(plan, operation, reason)
+		return StateResult{Requeue: true}, nil
+
+	case hibernatorv1alpha1.CycleTimeoutContinue:
+		log.Info("cycle exceeded maxCycleDuration, aborting remaining targets", "reason", reason)
+		s.abortPendingTargets(reason)
+		onFinalizeCallback(ctx, execPlan, reason)
+		return StateResult{}, nil
+
+	default:
+		return StateResult{}, AsPlanError(&cycleTimeoutError{reason: reason})
+	}
+}
+
+// abortPendingTargets marks every target that has not started as StateAborted so the
+// operation can be finalized without dispatching them.
+func (s *state) abortPendingTargets(reason string) {
+	plan := s.plan()
+	prevSnapshot := snapshotExecutionStates(plan.Status.Executions)
+	message := "Aborted: " + reason
+
+	s.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: s.Key,
+		Resource:       plan,
+		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+			for i := range p.Status.Executions {
+				if p.Status.Executions[i].State == hibernatorv1alpha1.StatePending {
+					p.Status.Executions[i].State = hibernatorv1alpha1.StateAborted
+					p.Status.Executions[i].Message = message
+				}
+			}
+		}),
+		PostHook: s.executionProgressPostHook(prevSnapshot),
+	})
+}
+
+// rollbackCycle records the aborted operation in the cycle history and starts the
+// opposite operation within the same cycle. Only targets that already ran are
+// reversed; targets that never started are already in the desired state and are
+// marked Completed up front.
+func (s *state) rollbackCycle(plan *hibernatorv1alpha1.HibernatePlan, operation hibernatorv1alpha1.PlanOperation, reason string) {
+	summary := BuildOperationSummary(s.Clock, plan, operation)
+	summary.Success = false
+	summary.AbortReason = reason
+
+	reverseOperation := hibernatorv1alpha1.OperationWakeUp
+	reversePhase := hibernatorv1alpha1.PhaseWakingUp
+	if operation == hibernatorv1alpha1.OperationWakeUp {
+		reverseOperation = hibernatorv1alpha1.OperationHibernate
+		reversePhase = hibernatorv1alpha1.PhaseHibernating
+	}
+
+	executions := make([]hibernatorv1alpha1.ExecutionStatus, len(plan.Status.Executions))
+	for i, exec := range plan.Status.Executions {
+		executions[i] = hibernatorv1alpha1.ExecutionStatus{
+			Target:   exec.Target,
+			Executor: exec.Executor,
+			State:    hibernatorv1alpha1.StatePending,
+			Message:  "Target pending rollback",
+		}
+		if exec.State == hibernatorv1alpha1.StatePending || exec.State == hibernatorv1alpha1.StateAborted {
+			executions[i].State = hibernatorv1alpha1.StateCompleted
+			executions[i].Message = "Rollback skipped: target had not started when the cycle was aborted"
+		}
+	}
+
+	currentCycleID := plan.Status.CurrentCycleID
+	now := s.Clock.Now()
+	previousPhase := s.plan().Status.Phase
+
+	var pruned []hibernatorv1alpha1.ExecutionCycle
+	s.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: s.Key,
+		Resource:       s.plan(),
+		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+			cycleIdx := findOrAppendCycle(&p.Status, currentCycleID)
+			stampCostAllocation(&p.Status.ExecutionHistory[cycleIdx], p, s.CostAllocation)
+			if operation == hibernatorv1alpha1.OperationHibernate {
+				p.Status.ExecutionHistory[cycleIdx].ShutdownExecution = summary
+			} else {
+				p.Status.ExecutionHistory[cycleIdx].WakeupExecution = summary
+			}
+			pruned = pruneCycleHistory(&p.Status, historyLimit(p))
+
+			p.Status.Phase = reversePhase
+			p.Status.CurrentOperation = reverseOperation
+			p.Status.CurrentStageIndex = 0
+			p.Status.Executions = executions
+			p.Status.ErrorMessage = reason
+			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(now))
+		}),
+		PostHook: chainHooks(
+			s.notifyHook(hibernatorv1alpha1.EventFailure, func(p *hibernatorv1alpha1.HibernatePlan) notification.Payload {
+				return buildPayload(p, hibernatorv1alpha1.EventFailure, s.Clock.Now)
+			}),
+			s.phaseChangePostHook(previousPhase),
+			s.operationEventHook(hibernatorv1alpha1.EventFailure, operation),
+			s.auditHook(previousPhase, audit.TriggerTimeout, ""),
+			s.archiveHook(&pruned),
+		),
+	})
+}
+
+// isRollback reports whether the given operation is reversing an operation of the
+// current cycle that was aborted for exceeding maxCycleDuration.
+func isRollback(plan *hibernatorv1alpha1.HibernatePlan, operation hibernatorv1alpha1.PlanOperation) bool {
+	for _, cycle := range plan.Status.ExecutionHistory {
+		if cycle.CycleID != plan.Status.CurrentCycleID {
+			continue
+		}
+		opposite := cycle.WakeupExecution
+		if operation == hibernatorv1alpha1.OperationWakeUp {
+			opposite = cycle.ShutdownExecution
+		}
+		return opposite != nil && opposite.AbortReason != ""
+	}
+	return false
+}
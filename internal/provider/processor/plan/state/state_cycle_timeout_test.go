@@ -0,0 +1,174 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+)
+
+// timedOutPlan returns a sequential two-target plan midway through the given
+// operation whose in-flight phase started two hours ago, with a one-hour limit.
+// target-a has finished; target-b has not been dispatched yet.
+func timedOutPlan(st *state, phase hibernatorv1alpha1.PlanPhase, operation hibernatorv1alpha1.PlanOperation, policy hibernatorv1alpha1.CycleTimeoutPolicy) {
+	plan := st.plan()
+	plan.Status.Phase = phase
+	plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategySequential
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "target-a", Type: "eks"},
+		{Name: "target-b", Type: "rds"},
+	}
+	plan.Spec.Behavior = hibernatorv1alpha1.Behavior{
+		Mode:             hibernatorv1alpha1.BehaviorStrict,
+		MaxCycleDuration: "1h",
+		OnCycleTimeout:   policy,
+	}
+	plan.Status.CurrentCycleID = "cycle-001"
+	plan.Status.CurrentOperation = operation
+	plan.Status.CurrentStageIndex = 1
+	plan.Status.LastTransitionTime = ptr.To(metav1.NewTime(st.Clock.Now().Add(-2 * time.Hour)))
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "target-a", Executor: "eks", State: hibernatorv1alpha1.StateCompleted},
+		{Target: "target-b", Executor: "rds", State: hibernatorv1alpha1.StatePending},
+	}
+}
+
+func newTimedOutState(t *testing.T) *state {
+	t.Helper()
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	return newHandlerState(plan, newHandlerFakeClient(plan))
+}
+
+func TestCycleTimeoutReason(t *testing.T) {
+	st := newTimedOutState(t)
+	plan := st.plan()
+	execPlan := scheduler.ExecutionPlan{Stages: make([]scheduler.ExecutionStage, 2)}
+	now := st.Clock.Now()
+
+	// No limit configured.
+	plan.Status.LastTransitionTime = ptr.To(metav1.NewTime(now.Add(-2 * time.Hour)))
+	assert.Empty(t, cycleTimeoutReason(st.Clock, plan, hibernatorv1alpha1.OperationHibernate, execPlan))
+
+	// Limit exceeded.
+	plan.Spec.Behavior.MaxCycleDuration = "1h"
+	assert.Equal(t, "shutdown exceeded maxCycleDuration of 1h0m0s at stage 1 of 2",
+		cycleTimeoutReason(st.Clock, plan, hibernatorv1alpha1.OperationHibernate, execPlan))
+
+	// A recent retry restarts the budget.
+	plan.Status.LastRetryTime = ptr.To(metav1.NewTime(now.Add(-10 * time.Minute)))
+	assert.Empty(t, cycleTimeoutReason(st.Clock, plan, hibernatorv1alpha1.OperationHibernate, execPlan))
+}
+
+func TestHibernatingState_CycleTimeout_ErrorPolicy(t *testing.T) {
+	st := newTimedOutState(t)
+	timedOutPlan(st, hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.OperationHibernate, hibernatorv1alpha1.CycleTimeoutError)
+	h := &hibernatingState{state: st}
+
+	_, err := h.Handle(context.Background())
+	require.Error(t, err)
+	var timeout *cycleTimeoutError
+	require.True(t, errors.As(err, &timeout), "expected a cycle timeout error, got: %v", err)
+
+	h.OnError(context.Background(), err)
+
+	plan := st.plan()
+	assert.Equal(t, hibernatorv1alpha1.PhaseError, plan.Status.Phase)
+	assert.Equal(t, hibernatorv1alpha1.StatePending, plan.Status.Executions[1].State,
+		"pending targets stay pending so a retry can resume them")
+	require.Len(t, plan.Status.ExecutionHistory, 1)
+	summary := plan.Status.ExecutionHistory[0].ShutdownExecution
+	require.NotNil(t, summary)
+	assert.False(t, summary.Success)
+	assert.Contains(t, summary.AbortReason, "exceeded maxCycleDuration")
+}
+
+func TestHibernatingState_CycleTimeout_ContinuePolicy(t *testing.T) {
+	st := newTimedOutState(t)
+	timedOutPlan(st, hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.OperationHibernate, hibernatorv1alpha1.CycleTimeoutContinue)
+	h := &hibernatingState{state: st}
+
+	_, err := h.Handle(context.Background())
+	require.NoError(t, err)
+
+	plan := st.plan()
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernated, plan.Status.Phase)
+	assert.Equal(t, hibernatorv1alpha1.StateAborted, plan.Status.Executions[1].State)
+	require.Len(t, plan.Status.ExecutionHistory, 1)
+	summary := plan.Status.ExecutionHistory[0].ShutdownExecution
+	require.NotNil(t, summary)
+	assert.False(t, summary.Success)
+	assert.Contains(t, summary.AbortReason, "exceeded maxCycleDuration")
+}
+
+func TestHibernatingState_CycleTimeout_RollbackPolicy(t *testing.T) {
+	st := newTimedOutState(t)
+	timedOutPlan(st, hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.OperationHibernate, hibernatorv1alpha1.CycleTimeoutRollback)
+	h := &hibernatingState{state: st}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.True(t, result.Requeue)
+
+	plan := st.plan()
+	assert.Equal(t, hibernatorv1alpha1.PhaseWakingUp, plan.Status.Phase)
+	assert.Equal(t, hibernatorv1alpha1.OperationWakeUp, plan.Status.CurrentOperation)
+	assert.Equal(t, 0, plan.Status.CurrentStageIndex)
+	assert.Equal(t, "cycle-001", plan.Status.CurrentCycleID, "rollback stays within the cycle")
+	assert.Equal(t, hibernatorv1alpha1.StatePending, plan.Status.Executions[0].State,
+		"target that already hibernated must be woken up")
+	assert.Equal(t, hibernatorv1alpha1.StateCompleted, plan.Status.Executions[1].State,
+		"target that never started needs no rollback")
+
+	require.Len(t, plan.Status.ExecutionHistory, 1)
+	summary := plan.Status.ExecutionHistory[0].ShutdownExecution
+	require.NotNil(t, summary)
+	assert.Contains(t, summary.AbortReason, "exceeded maxCycleDuration")
+}
+
+func TestWakingUpState_CycleTimeout_RollbackOfRollbackErrors(t *testing.T) {
+	st := newTimedOutState(t)
+	timedOutPlan(st, hibernatorv1alpha1.PhaseWakingUp, hibernatorv1alpha1.OperationWakeUp, hibernatorv1alpha1.CycleTimeoutRollback)
+	st.plan().Status.ExecutionHistory = []hibernatorv1alpha1.ExecutionCycle{{
+		CycleID: "cycle-001",
+		ShutdownExecution: &hibernatorv1alpha1.ExecutionOperationSummary{
+			Operation:   hibernatorv1alpha1.OperationHibernate,
+			AbortReason: "shutdown exceeded maxCycleDuration of 1h0m0s at stage 2 of 2",
+		},
+	}}
+	h := &wakingUpState{state: st}
+
+	_, err := h.Handle(context.Background())
+	require.Error(t, err)
+	var timeout *cycleTimeoutError
+	assert.True(t, errors.As(err, &timeout), "a timed-out rollback must fall back to Error, got: %v", err)
+	assert.Equal(t, hibernatorv1alpha1.PhaseWakingUp, st.plan().Status.Phase)
+}
+
+func TestHibernatingState_CycleTimeout_WaitsForInFlightTargets(t *testing.T) {
+	st := newTimedOutState(t)
+	timedOutPlan(st, hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.OperationHibernate, hibernatorv1alpha1.CycleTimeoutContinue)
+	st.plan().Status.Executions[1].State = hibernatorv1alpha1.StateRunning
+	h := &hibernatingState{state: st}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.NotZero(t, result.RequeueAfter)
+
+	plan := st.plan()
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, plan.Status.Phase)
+	assert.Equal(t, hibernatorv1alpha1.StateRunning, plan.Status.Executions[1].State)
+	assert.Empty(t, plan.Status.ExecutionHistory)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/pkg/executorparams"
+)
+
+// drainTargets classifies the plan targets for node-drain aware ordering.
+// Targets on the same K8SCluster share a cluster, and a K8SCluster backed by
+// EKS also matches eks targets naming that cluster. When the K8SCluster
+// cannot be read the connector reference alone identifies the cluster.
+func (s *state) drainTargets(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) []scheduler.DrainTarget {
+	reader := s.connectorReader()
+	eksClusters := make(map[client.ObjectKey]string)
+
+	targets := make([]scheduler.DrainTarget, 0, len(plan.Spec.Targets))
+	for _, t := range plan.Spec.Targets {
+		role := scheduler.DrainRoleOf(t.Type)
+		if role == scheduler.DrainRoleNone {
+			continue
+		}
+
+		var cluster string
+		switch t.ConnectorRef.Kind {
+		case "K8SCluster":
+			key := client.ObjectKey{Namespace: t.ConnectorRef.Namespace, Name: t.ConnectorRef.Name}
+			if key.Namespace == "" {
+				key.Namespace = plan.Namespace
+			}
+
+			name, ok := eksClusters[key]
+			if !ok && reader != nil {
+				var kc hibernatorv1alpha1.K8SCluster
+				if err := reader.Get(ctx, key, &kc); err == nil && kc.Spec.EKS != nil {
+					name = kc.Spec.EKS.Name
+				}
+				eksClusters[key] = name
+			}
+
+			cluster = "K8SCluster/" + key.String()
+			if name != "" {
+				cluster = "eks/" + name
+			}
+		case "CloudProvider":
+			if t.Type == "eks" && t.Parameters != nil {
+				var params executorparams.EKSParameters
+				if err := json.Unmarshal(t.Parameters.Raw, &params); err == nil && params.ClusterName != "" {
+					cluster = "eks/" + params.ClusterName
+				}
+			}
+		}
+
+		targets = append(targets, scheduler.DrainTarget{Name: t.Name, Role: role, Cluster: cluster})
+	}
+	return targets
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// eventHook returns a PostHook that records an Event with the given reason on the
+// written plan, with the message returned by messageFn. Returns nil when no
+// recorder is configured, so callers can unconditionally chain it.
+func (s *state) eventHook(eventtype, reason string, messageFn func(*hibernatorv1alpha1.HibernatePlan) string) func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	if s.Recorder == nil {
+		return nil
+	}
+
+	return func(_ context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
+		s.Recorder.Event(plan, eventtype, reason, messageFn(plan))
+		return nil
+	}
+}
+
+// operationEventHook returns a PostHook that records the Event of a lifecycle step
+// of operation: its start, its completion, its failure or its retry, as given by
+// the notification event of the same step. Returns nil when no recorder is configured.
+func (s *state) operationEventHook(event hibernatorv1alpha1.NotificationEvent, operation hibernatorv1alpha1.PlanOperation) func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	hibernate := operation == hibernatorv1alpha1.OperationHibernate
+
+	switch event {
+	case hibernatorv1alpha1.EventStart:
+		reason := wellknown.EventReasonWakeUpStarted
+		if hibernate {
+			reason = wellknown.EventReasonHibernationStarted
+		}
+		return s.eventHook(corev1.EventTypeNormal, reason, func(p *hibernatorv1alpha1.HibernatePlan) string {
+			return fmt.Sprintf("Started %s of cycle %s", operation, p.Status.CurrentCycleID)
+		})
+
+	case hibernatorv1alpha1.EventSuccess:
+		reason := wellknown.EventReasonWakeUpCompleted
+		if hibernate {
+			reason = wellknown.EventReasonHibernationCompleted
+		}
+		return s.eventHook(corev1.EventTypeNormal, reason, func(p *hibernatorv1alpha1.HibernatePlan) string {
+			return fmt.Sprintf("Completed %s of cycle %s", operation, p.Status.CurrentCycleID)
+		})
+
+	case hibernatorv1alpha1.EventFailure:
+		if operation == "" {
+			return s.eventHook(corev1.EventTypeWarning, wellknown.EventReasonPlanFailed, func(p *hibernatorv1alpha1.HibernatePlan) string {
+				return fmt.Sprintf("Plan failed: %s", p.Status.ErrorMessage)
+			})
+		}
+		reason := wellknown.EventReasonWakeUpFailed
+		if hibernate {
+			reason = wellknown.EventReasonHibernationFailed
+		}
+		return s.eventHook(corev1.EventTypeWarning, reason, func(p *hibernatorv1alpha1.HibernatePlan) string {
+			return fmt.Sprintf("The %s of cycle %s failed: %s", operation, p.Status.CurrentCycleID, p.Status.ErrorMessage)
+		})
+
+	case hibernatorv1alpha1.EventRecovery:
+		return s.eventHook(corev1.EventTypeNormal, wellknown.EventReasonRecoveryStarted, func(p *hibernatorv1alpha1.HibernatePlan) string {
+			return fmt.Sprintf("Retrying %s of cycle %s, attempt %d", operation, p.Status.CurrentCycleID, p.Status.RetryCount)
+		})
+	}
+	return nil
+}
+
+// targetFailedEventHook returns a PostHook that records TargetShutdownFailed or
+// TargetWakeUpFailed for every target whose execution failed since prevSnapshot.
+// Returns nil when no recorder is configured.
+func (s *state) targetFailedEventHook(prevSnapshot map[string]executionSnapshot) func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	if s.Recorder == nil {
+		return nil
+	}
+
+	return func(_ context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
+		reason := wellknown.EventReasonTargetWakeUpFailed
+		if plan.Status.CurrentOperation == hibernatorv1alpha1.OperationHibernate {
+			reason = wellknown.EventReasonTargetShutdownFailed
+		}
+
+		for _, exec := range plan.Status.Executions {
+			if exec.State != hibernatorv1alpha1.StateFailed {
+				continue
+			}
+			if prev, ok := prevSnapshot[exec.Target]; ok && prev.State == hibernatorv1alpha1.StateFailed {
+				continue
+			}
+
+			message := fmt.Sprintf("Target %s (%s) failed: %s", exec.Target, exec.Executor, exec.Message)
+			if exec.FailureReason != "" {
+				message = fmt.Sprintf("Target %s (%s) failed with %s: %s", exec.Target, exec.Executor, exec.FailureReason, exec.Message)
+			}
+			s.Recorder.Event(plan, corev1.EventTypeWarning, reason, message)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func TestOperationEventHook(t *testing.T) {
+	tests := []struct {
+		name      string
+		event     hibernatorv1alpha1.NotificationEvent
+		operation hibernatorv1alpha1.PlanOperation
+		want      string
+	}{
+		{"hibernation started", hibernatorv1alpha1.EventStart, hibernatorv1alpha1.OperationHibernate, "Normal HibernationStarted Started shutdown of cycle abc123"},
+		{"wakeup started", hibernatorv1alpha1.EventStart, hibernatorv1alpha1.OperationWakeUp, "Normal WakeUpStarted Started wakeup of cycle abc123"},
+		{"hibernation completed", hibernatorv1alpha1.EventSuccess, hibernatorv1alpha1.OperationHibernate, "Normal HibernationCompleted Completed shutdown of cycle abc123"},
+		{"wakeup completed", hibernatorv1alpha1.EventSuccess, hibernatorv1alpha1.OperationWakeUp, "Normal WakeUpCompleted Completed wakeup of cycle abc123"},
+		{"wakeup failed", hibernatorv1alpha1.EventFailure, hibernatorv1alpha1.OperationWakeUp, "Warning WakeUpFailed The wakeup of cycle abc123 failed: boom"},
+		{"failure outside an operation", hibernatorv1alpha1.EventFailure, "", "Warning PlanFailed Plan failed: boom"},
+		{"retry", hibernatorv1alpha1.EventRecovery, hibernatorv1alpha1.OperationHibernate, "Normal RecoveryStarted Retrying shutdown of cycle abc123, attempt 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", hibernatorv1alpha1.PhaseError)
+			plan.Status.CurrentCycleID = "abc123"
+			plan.Status.ErrorMessage = "boom"
+			plan.Status.RetryCount = 2
+
+			st := newHandlerState(plan, newHandlerFakeClient(plan))
+			recorder := record.NewFakeRecorder(10)
+			st.Recorder = recorder
+
+			hook := st.operationEventHook(tt.event, tt.operation)
+			require.NotNil(t, hook)
+			require.NoError(t, hook(context.Background(), plan))
+
+			require.Len(t, recorder.Events, 1)
+			assert.Equal(t, tt.want, <-recorder.Events)
+		})
+	}
+}
+
+func TestEventHooks_NoRecorder(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	assert.Nil(t, st.operationEventHook(hibernatorv1alpha1.EventStart, hibernatorv1alpha1.OperationHibernate))
+	assert.Nil(t, st.targetFailedEventHook(nil))
+}
+
+func TestTargetFailedEventHook_RecordsNewFailures(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateRunning},
+		{Target: "cache", Executor: "ec2", State: hibernatorv1alpha1.StateFailed},
+		{Target: "app", Executor: "eks", State: hibernatorv1alpha1.StateRunning},
+	}
+	prevSnapshot := snapshotExecutionStates(plan.Status.Executions)
+
+	plan.Status.Executions[0].State = hibernatorv1alpha1.StateFailed
+	plan.Status.Executions[0].Message = "access denied"
+	plan.Status.Executions[0].FailureReason = hibernatorv1alpha1.FailureAuthError
+	plan.Status.Executions[2].State = hibernatorv1alpha1.StateCompleted
+
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	recorder := record.NewFakeRecorder(10)
+	st.Recorder = recorder
+
+	require.NoError(t, st.targetFailedEventHook(prevSnapshot)(context.Background(), plan))
+
+	require.Len(t, recorder.Events, 1, "only the target that failed since the snapshot is recorded")
+	assert.Equal(t, "Warning TargetShutdownFailed Target db (rds) failed with AuthError: access denied", <-recorder.Events)
+}
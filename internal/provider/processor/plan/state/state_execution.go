@@ -6,16 +6,25 @@ Licensed under the Apache License, Version 2.0.
 package state
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"path"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -25,8 +34,11 @@ import (
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/metrics"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
+	"github.com/ardikabs/hibernator/internal/remoterunner"
 	"github.com/ardikabs/hibernator/internal/restore"
 	"github.com/ardikabs/hibernator/internal/scheduler"
+	streamendpoint "github.com/ardikabs/hibernator/internal/streaming/endpoint"
+	"github.com/ardikabs/hibernator/internal/tracing"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/ardikabs/hibernator/pkg/executorparams"
 	"github.com/ardikabs/hibernator/pkg/k8sutil"
@@ -44,7 +56,7 @@ func (s *state) execute(
 	operation hibernatorv1alpha1.PlanOperation,
 	reverse bool,
 	onAdvanceStageCallback func(int),
-	onFinalizeCallback func(context.Context, scheduler.ExecutionPlan),
+	onFinalizeCallback func(context.Context, scheduler.ExecutionPlan, string),
 ) (StateResult, error) {
 	plan := s.plan()
 
@@ -65,6 +77,13 @@ func (s *state) execute(
 
 	s.updateExecutionStatuses(ctx, log, effectivePlan, jobs)
 
+	// A paused operation keeps tracking the jobs already in flight, but neither
+	// dispatches new targets nor advances stages until it is resumed.
+	if isOperationPaused(effectivePlan) {
+		log.V(1).Info("operation paused, not dispatching", "stageIndex", effectivePlan.Status.CurrentStageIndex)
+		return StateResult{RequeueAfter: wellknown.RequeueIntervalDuringStage}, nil
+	}
+
 	// Runtime validation: validate execution overrides before dispatching any jobs.
 	// This is the second validation layer (webhook is the first) that catches
 	// force-applied exceptions or plan changes after exception creation.
@@ -75,7 +94,7 @@ func (s *state) execute(
 		}
 	}
 
-	execPlan, err := s.buildExecutionPlan(effectivePlan, reverse)
+	execPlan, err := s.buildExecutionPlan(ctx, effectivePlan, reverse)
 	if err != nil {
 		return StateResult{}, AsPlanError(fmt.Errorf("failed to build execution plan: %w", err))
 	}
@@ -85,10 +104,18 @@ func (s *state) execute(
 		"currentStageIndex", effectivePlan.Status.CurrentStageIndex)
 
 	if effectivePlan.Status.CurrentStageIndex >= len(execPlan.Stages) {
-		onFinalizeCallback(ctx, execPlan)
+		onFinalizeCallback(ctx, execPlan, "")
 		return StateResult{}, nil
 	}
 
+	// Bound how long the plan can stay half-transitioned: once the operation runs past
+	// spec.behavior.maxCycleDuration, stop dispatching and apply the timeout policy.
+	if !IsOperationComplete(effectivePlan) {
+		if reason := cycleTimeoutReason(s.Clock, effectivePlan, operation, execPlan); reason != "" {
+			return s.abortCycle(ctx, log, effectivePlan, jobs, execPlan, operation, reason, onFinalizeCallback)
+		}
+	}
+
 	targetStage := execPlan.Stages[effectivePlan.Status.CurrentStageIndex]
 	stageStatus := GetStageStatus(log, effectivePlan, targetStage)
 
@@ -122,6 +149,17 @@ func (s *state) execute(
 
 		nextStageIndex := effectivePlan.Status.CurrentStageIndex + 1
 		if nextStageIndex < len(execPlan.Stages) {
+			wait, err := s.stageGateWait(ctx, log, effectivePlan, targetStage, operation)
+			if err != nil {
+				if effectivePlan.Spec.Behavior.Mode == hibernatorv1alpha1.BehaviorStrict {
+					return StateResult{}, AsPlanError(err)
+				}
+				log.Info("stage readiness probe failed, advancing anyway", "reason", err.Error())
+			}
+			if wait > 0 {
+				return StateResult{RequeueAfter: wait}, nil
+			}
+
 			log.V(1).Info("advancing to next stage", "currentStage", effectivePlan.Status.CurrentStageIndex, "nextStage", nextStageIndex)
 			onAdvanceStageCallback(nextStageIndex)
 
@@ -129,7 +167,7 @@ func (s *state) execute(
 			return s.executeForStage(ctx, log, effectivePlan, jobs, targetStage, operation)
 		}
 
-		onFinalizeCallback(ctx, execPlan)
+		onFinalizeCallback(ctx, execPlan, "")
 		return StateResult{}, nil
 	}
 
@@ -165,7 +203,7 @@ func (s *state) validateRuntimeOverrides(ctx context.Context, log logr.Logger, p
 		if exc.Spec.Type == hibernatorv1alpha1.ExceptionSuspend {
 			continue
 		}
-		if !exc.Spec.ValidFrom.Time.Before(now) || !now.Before(exc.Spec.ValidUntil.Time) {
+		if from, until := exc.EffectiveWindow(); !from.Before(now) || !now.Before(until) {
 			continue
 		}
 		if !exc.DeletionTimestamp.IsZero() {
@@ -223,7 +261,7 @@ func (s *state) executeForStage(
 	isDAG := plan.Spec.Execution.Strategy.Type == hibernatorv1alpha1.StrategyDAG
 
 	jobsCreated := 0
-	for _, targetName := range stage.Targets {
+	for _, targetName := range targetsByPriority(plan, stage.Targets) {
 		target := FindTarget(plan, targetName)
 		if target == nil {
 			continue
@@ -265,25 +303,52 @@ func (s *state) executeForStage(
 			break
 		}
 
+		release, err := s.ExecutorInfra.JobLimiter.Reserve(ctx)
+		if err != nil {
+			return StateResult{}, err
+		}
+		if release == nil {
+			log.Info("waiting for a free runner job slot",
+				"target", targetName, "maxConcurrentRunnerJobs", s.ExecutorInfra.JobLimiter.Limit())
+			break
+		}
+
 		log.Info("dispatching job for target", "target", targetName, "operation", operation)
-		if err := s.createRunnerJob(ctx, log,
+		err = s.createRunnerJob(ctx, log,
 			s.Clock, plan, target, operation,
-			s.ExecutorInfra); err != nil {
-
+			s.ExecutorInfra)
+		release()
+		if err != nil {
 			log.Error(err, "failed to create runner job", "target", targetName)
-			metrics.JobFailuresTotal.WithLabelValues(s.Key.String(), targetName).Inc()
+			metrics.JobFailuresTotal.WithLabelValues(metrics.PlanLabel(s.Key), targetName).Inc()
 
 			if plan.Spec.Behavior.Mode == hibernatorv1alpha1.BehaviorStrict && plan.Spec.Behavior.FailFast {
 				return StateResult{}, AsPlanError(fmt.Errorf("failed to create job for target %s: %w", targetName, err))
 			}
 		} else {
-			metrics.JobsCreatedTotal.WithLabelValues(s.Key.String(), targetName).Inc()
+			metrics.JobsCreatedTotal.WithLabelValues(metrics.PlanLabel(s.Key), targetName).Inc()
 		}
 		jobsCreated++
 	}
 	return StateResult{RequeueAfter: wellknown.RequeueIntervalDuringStage}, nil
 }
 
+// targetsByPriority returns the targets of a stage in dispatch order: higher
+// priority first, keeping the stage order among equal priorities.
+func targetsByPriority(plan *hibernatorv1alpha1.HibernatePlan, targets []string) []string {
+	priority := func(name string) int32 {
+		if target := FindTarget(plan, name); target != nil {
+			return target.Priority
+		}
+		return 0
+	}
+	ordered := slices.Clone(targets)
+	slices.SortStableFunc(ordered, func(a, b string) int {
+		return cmp.Compare(priority(b), priority(a))
+	})
+	return ordered
+}
+
 // pruneTarget marks a target as StateAborted with an abort message.
 // This is used during DAG BestEffort execution to skip targets whose upstream
 // dependencies have failed, while allowing independent branches to proceed.
@@ -318,25 +383,74 @@ func (s *state) pruneTarget(plan *hibernatorv1alpha1.HibernatePlan, targetName,
 // getCurrentCycleJobs returns the runner Jobs for the current execution cycle of a plan.
 // It reads from the API server directly via client.Reader (typically mgr.GetAPIReader())
 // to avoid cache staleness that could cause phantom "job missing" resets during
-// informer re-list gaps. Returns nil if the plan has no active cycle.
+// informer re-list gaps. Runner Jobs dispatched into a runner cluster are returned
+// as the views of their records. Returns nil if the plan has no active cycle.
 func (s *state) getCurrentCycleJobs(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) ([]batchv1.Job, error) {
 	if plan.Status.CurrentCycleID == "" || plan.Status.CurrentOperation == "" {
 		return nil, nil
 	}
+	selector := client.MatchingLabels{
+		wellknown.LabelPlan:      plan.Name,
+		wellknown.LabelCycleID:   plan.Status.CurrentCycleID,
+		wellknown.LabelOperation: string(plan.Status.CurrentOperation),
+	}
+
 	var jobList batchv1.JobList
-	if err := s.APIReader.List(ctx, &jobList,
+	if err := s.APIReader.List(ctx, &jobList, client.InNamespace(plan.Namespace), selector); err != nil {
+		return nil, err
+	}
+
+	var recordList corev1.ConfigMapList
+	if err := s.APIReader.List(ctx, &recordList,
 		client.InNamespace(plan.Namespace),
-		client.MatchingLabels{
-			wellknown.LabelPlan:      plan.Name,
-			wellknown.LabelCycleID:   plan.Status.CurrentCycleID,
-			wellknown.LabelOperation: string(plan.Status.CurrentOperation),
-		},
+		selector,
+		client.MatchingLabels{wellknown.LabelRemoteRunnerJob: "true"},
 	); err != nil {
 		return nil, err
 	}
+	var activeDeadline *int64
+	if policy := plan.Spec.Execution.JobPolicy; policy != nil {
+		activeDeadline = policy.ActiveDeadlineSeconds
+	}
+	for i := range recordList.Items {
+		jobList.Items = append(jobList.Items, remoterunner.JobView(&recordList.Items[i], activeDeadline, s.Clock.Now()))
+	}
 	return jobList.Items, nil
 }
 
+// deleteRunnerJob deletes a runner Job. Runner Jobs dispatched into a runner
+// cluster are deleted there, along with their record.
+func (s *state) deleteRunnerJob(ctx context.Context, job *batchv1.Job) error {
+	if !remoterunner.IsRecord(job) {
+		if err := s.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	cluster, namespace, name, ok := remoterunner.RemoteJob(job)
+	if !ok {
+		return fmt.Errorf("record %s does not name its runner job", job.Name)
+	}
+	if s.ExecutorInfra.RunnerClusters == nil {
+		return errors.New("runner clusters are not supported by this controller")
+	}
+	remote, err := s.ExecutorInfra.RunnerClusters.ClientFor(ctx, job.Namespace, cluster)
+	if err != nil {
+		return fmt.Errorf("connect to runner cluster %s: %w", cluster, err)
+	}
+	remoteJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	if err := remote.Delete(ctx, remoteJob, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	record := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: job.Namespace, Name: job.Name}}
+	if err := s.Delete(ctx, record); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 // updateExecutionStatuses updates execution statuses in the plan based on job conditions.
 // It mirrors updatePlanExecutionStatuses in the legacy controller exactly:
 //   - Iterates by execution status (not by job) to preserve ordering.
@@ -411,6 +525,7 @@ func (s *state) updateExecutionStatuses(ctx context.Context,
 					if msg := s.getTerminationMessageFromPod(ctx, &job); msg != "" {
 						exec.Message = msg
 					}
+					exec.FailureReason = ""
 					exec.FinishedAt = cond.LastTransitionTime.DeepCopy()
 					break
 				}
@@ -419,11 +534,23 @@ func (s *state) updateExecutionStatuses(ctx context.Context,
 					exec.State = hibernatorv1alpha1.StateFailed
 					if msg := s.getTerminationMessageFromPod(ctx, &job); msg != "" {
 						exec.Message = msg
+					} else if cond.Reason == batchv1.JobReasonDeadlineExceeded {
+						// The runner was killed before it could report why.
+						exec.Message = fmt.Sprintf("Runner exceeded the active deadline of the job policy: %s", cond.Message)
 					}
+					exec.FailureReason = failureReason(&job, cond)
 					exec.FinishedAt = cond.LastTransitionTime.DeepCopy()
 					break
 				}
 			}
+			exec.Stale = false
+			if exec.State == hibernatorv1alpha1.StateRunning && job.Status.Active > 0 {
+				s.checkRunnerLiveness(ctx, log, exec, &job)
+			}
+			if progress := executionProgress(log, &job); progress != nil {
+				exec.Progress = progress
+			}
+
 			// Emit per-target execution metrics on first transition to a terminal state.
 			if prevState != exec.State &&
 				(exec.State == hibernatorv1alpha1.StateCompleted || exec.State == hibernatorv1alpha1.StateFailed) {
@@ -433,10 +560,10 @@ func (s *state) updateExecutionStatuses(ctx context.Context,
 				if exec.State == hibernatorv1alpha1.StateFailed {
 					status = "failed"
 				}
-				metrics.ExecutionTotal.WithLabelValues(s.Key.String(), string(operation), exec.Executor, status).Inc()
+				metrics.ExecutionTotal.WithLabelValues(metrics.PlanLabel(s.Key), string(operation), exec.Executor, status).Inc()
 				if exec.StartedAt != nil && exec.FinishedAt != nil {
 					duration := exec.FinishedAt.Sub(exec.StartedAt.Time).Seconds()
-					metrics.ExecutionDuration.WithLabelValues(s.Key.String(), string(operation), exec.Executor, status).Observe(duration)
+					metrics.ExecutionDuration.WithLabelValues(metrics.PlanLabel(s.Key), string(operation), exec.Executor, status).Observe(duration)
 				}
 
 				// Mark job as stale immediately upon reaching terminal state.
@@ -489,15 +616,128 @@ func (s *state) updateExecutionStatuses(ctx context.Context,
 			Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
 				p.Status.Executions = executions
 			}),
-			PostHook: s.executionProgressPostHook(prevSnapshot),
+			PostHook: chainHooks(
+				s.executionProgressPostHook(prevSnapshot),
+				s.targetFailedEventHook(prevSnapshot),
+			),
 		})
 	}
 }
 
+// checkRunnerLiveness reports the execution of an active runner Job stale when
+// its runner stopped sending heartbeats, e.g. because it hangs on an API call,
+// and restarts the runner once StaleRunnerRestartAfter elapsed.
+func (s *state) checkRunnerLiveness(ctx context.Context,
+	log logr.Logger,
+	exec *hibernatorv1alpha1.ExecutionStatus,
+	job *batchv1.Job,
+) {
+	timeout := s.ExecutorInfra.RunnerHeartbeatTimeout
+	if timeout <= 0 {
+		return
+	}
+	lastHeartbeat, err := time.Parse(time.RFC3339, job.Annotations[wellknown.AnnotationLastHeartbeat])
+	if err != nil {
+		// The runner has not sent a heartbeat yet.
+		return
+	}
+	silence := s.Clock.Since(lastHeartbeat)
+	if silence <= timeout {
+		return
+	}
+
+	exec.Stale = true
+	exec.Message = fmt.Sprintf("Runner has not sent a heartbeat since %s", lastHeartbeat.UTC().Format(time.RFC3339))
+	log.V(1).Info("runner is stale", "target", exec.Target, "job", job.Name, "lastHeartbeat", lastHeartbeat)
+
+	// The pods of remote runner Jobs are out of reach, and not retried anyway.
+	if restartAfter := s.ExecutorInfra.StaleRunnerRestartAfter; restartAfter > 0 && silence > restartAfter && !remoterunner.IsRecord(job) {
+		s.restartStaleRunner(ctx, log, job, lastHeartbeat)
+	}
+}
+
+// executionProgress returns the per-resource progress the streaming servers
+// recorded on a runner Job, or nil when its runner reported none.
+func executionProgress(log logr.Logger, job *batchv1.Job) *hibernatorv1alpha1.ExecutionProgress {
+	raw, ok := job.Annotations[wellknown.AnnotationProgress]
+	if !ok {
+		return nil
+	}
+	var progress hibernatorv1alpha1.ExecutionProgress
+	if err := json.Unmarshal([]byte(raw), &progress); err != nil {
+		log.V(1).Info("ignoring malformed progress annotation", "job", job.Name, "error", err.Error())
+		return nil
+	}
+	return &progress
+}
+
+// failureReason returns the class of failure of a failed runner Job: the one
+// its runner reported, or Timeout when the Job exceeded its active deadline
+// before the runner could report one.
+func failureReason(job *batchv1.Job, failed batchv1.JobCondition) hibernatorv1alpha1.FailureReason {
+	switch reason := hibernatorv1alpha1.FailureReason(job.Annotations[wellknown.AnnotationFailureReason]); reason {
+	case hibernatorv1alpha1.FailureAuthError,
+		hibernatorv1alpha1.FailureThrottled,
+		hibernatorv1alpha1.FailureResourceNotFound,
+		hibernatorv1alpha1.FailureTimeout,
+		hibernatorv1alpha1.FailurePermanentAPIError,
+		hibernatorv1alpha1.FailureResourceLimitExceeded:
+		return reason
+	}
+	if failed.Reason == batchv1.JobReasonDeadlineExceeded {
+		return hibernatorv1alpha1.FailureTimeout
+	}
+	return ""
+}
+
+// restartStaleRunner deletes the pods of a stale runner Job that already ran
+// when its runner last sent a heartbeat. The Job counts them as failed and
+// retries within its backoff limit. The heartbeat annotation is removed first,
+// so the replacement pod is not judged by the heartbeats of its predecessor.
+func (s *state) restartStaleRunner(ctx context.Context, log logr.Logger, job *batchv1.Job, lastHeartbeat time.Time) {
+	log = log.WithValues("job", job.Name)
+
+	updated := job.DeepCopy()
+	delete(updated.Annotations, wellknown.AnnotationLastHeartbeat)
+	if err := s.Patch(ctx, updated, client.MergeFrom(job)); err != nil {
+		log.Error(err, "failed to reset heartbeat of stale runner job")
+		return
+	}
+
+	var podList corev1.PodList
+	if err := s.List(ctx, &podList,
+		client.InNamespace(job.Namespace),
+		client.MatchingLabels(job.Spec.Template.Labels),
+	); err != nil {
+		log.Error(err, "failed to list pods of stale runner job")
+		return
+	}
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.DeletionTimestamp != nil || pod.CreationTimestamp.After(lastHeartbeat) ||
+			(pod.Status.Phase != corev1.PodPending && pod.Status.Phase != corev1.PodRunning) {
+			continue
+		}
+		if err := s.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "failed to delete pod of stale runner job", "pod", pod.Name)
+			continue
+		}
+		metrics.StaleRunnerRestartTotal.WithLabelValues(metrics.PlanLabel(s.Key)).Inc()
+		log.Info("restarted stale runner", "pod", pod.Name, "lastHeartbeat", lastHeartbeat)
+	}
+}
+
 // getTerminationMessageFromPod fetches the termination message from a pod of a job.
 // This captures both error messages (from failed pods) and success messages (from completed pods)
 // written to /dev/termination-log by the runner.
 func (s *state) getTerminationMessageFromPod(ctx context.Context, job *batchv1.Job) string {
+	// Remote runners report their message with their result.
+	if remoterunner.IsRecord(job) {
+		res, _ := remoterunner.ResultOf(job)
+		return res.Message
+	}
+
 	var podList corev1.PodList
 	if err := s.List(ctx, &podList,
 		client.InNamespace(job.Namespace),
@@ -528,6 +768,16 @@ func (s *state) getTerminationMessageFromPod(ctx context.Context, job *batchv1.J
 // re-associated with execution status on restart. This is called asynchronously
 // when a job reaches a terminal state (Completed or Failed).
 func (s *state) markJobAsStale(ctx context.Context, log logr.Logger, job *batchv1.Job) {
+	// The record of a remote runner Job is deleted instead, as its TTL only
+	// removes the Job. Its result is in the plan status by now.
+	if remoterunner.IsRecord(job) {
+		record := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: job.Namespace, Name: job.Name}}
+		if err := s.Delete(ctx, record); err != nil && !apierrors.IsNotFound(err) {
+			log.Error(err, "failed to delete remote runner record", "record", job.Name)
+		}
+		return
+	}
+
 	patch := client.MergeFrom(job.DeepCopy())
 
 	if job.Labels == nil {
@@ -545,7 +795,10 @@ func (s *state) markJobAsStale(ctx context.Context, log logr.Logger, job *batchv
 }
 
 // buildExecutionPlan creates a scheduler.ExecutionPlan from the plan's strategy.
-func (s *state) buildExecutionPlan(plan *hibernatorv1alpha1.HibernatePlan, reverse bool) (scheduler.ExecutionPlan, error) {
+// Parallel and DAG plans additionally scale workloads down before the nodes of
+// the same cluster (and back up after them on wakeup); Sequential and Staged
+// plans keep the user's order as-is.
+func (s *state) buildExecutionPlan(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan, reverse bool) (scheduler.ExecutionPlan, error) {
 	strategy := plan.Spec.Execution.Strategy
 	maxConcurrency := ptr.Deref(strategy.MaxConcurrency, 0)
 
@@ -558,11 +811,29 @@ func (s *state) buildExecutionPlan(plan *hibernatorv1alpha1.HibernatePlan, rever
 		err      error
 	)
 
+	toPlanDeps := func(deps []scheduler.Dependency) []scheduler.Dependency {
+		return lo.Map(deps, func(d scheduler.Dependency, _ int) scheduler.Dependency {
+			return scheduler.Dependency{
+				From: lo.Ternary(reverse, d.To, d.From),
+				To:   lo.Ternary(reverse, d.From, d.To),
+			}
+		})
+	}
+
 	switch strategy.Type {
 	case hibernatorv1alpha1.StrategySequential:
 		execPlan = s.Planner.PlanSequential(ReverseIf(reverse, targets))
 	case hibernatorv1alpha1.StrategyParallel:
-		execPlan = s.Planner.PlanParallel(ReverseIf(reverse, targets), maxConcurrency)
+		drainDeps := scheduler.DrainDependencies(s.drainTargets(ctx, plan))
+		if len(drainDeps) == 0 {
+			execPlan = s.Planner.PlanParallel(ReverseIf(reverse, targets), maxConcurrency)
+			break
+		}
+
+		execPlan, err = s.Planner.PlanDAG(targets, toPlanDeps(drainDeps), maxConcurrency)
+		if err != nil {
+			return scheduler.ExecutionPlan{}, fmt.Errorf("build drain-ordered parallel execution plan: %w", err)
+		}
 	case hibernatorv1alpha1.StrategyStaged:
 		stages := lo.Map(strategy.Stages, func(s hibernatorv1alpha1.Stage, _ int) scheduler.Stage {
 			return scheduler.Stage{
@@ -575,14 +846,12 @@ func (s *state) buildExecutionPlan(plan *hibernatorv1alpha1.HibernatePlan, rever
 
 		execPlan = s.Planner.PlanStaged(ReverseIf(reverse, stages), maxConcurrency)
 	case hibernatorv1alpha1.StrategyDAG:
-		deps := lo.Map(strategy.Dependencies, func(d hibernatorv1alpha1.Dependency, _ int) scheduler.Dependency {
-			return scheduler.Dependency{
-				From: lo.Ternary(reverse, d.To, d.From),
-				To:   lo.Ternary(reverse, d.From, d.To),
-			}
+		explicit := lo.Map(strategy.Dependencies, func(d hibernatorv1alpha1.Dependency, _ int) scheduler.Dependency {
+			return scheduler.Dependency{From: d.From, To: d.To}
 		})
+		deps, _ := scheduler.MergeDependencies(explicit, scheduler.DrainDependencies(s.drainTargets(ctx, plan)))
 
-		execPlan, err = s.Planner.PlanDAG(targets, deps, maxConcurrency)
+		execPlan, err = s.Planner.PlanDAG(targets, toPlanDeps(deps), maxConcurrency)
 		if err != nil {
 			return scheduler.ExecutionPlan{}, fmt.Errorf("build DAG execution plan: %w", err)
 		}
@@ -598,13 +867,21 @@ func (s *state) createRunnerJob(ctx context.Context, log logr.Logger, clk clock.
 	plan *hibernatorv1alpha1.HibernatePlan,
 	target *hibernatorv1alpha1.Target,
 	operation hibernatorv1alpha1.PlanOperation,
-	infra ExecutorInfra) error {
+	infra ExecutorInfra) (err error) {
 
 	ts := fmt.Sprintf("%d", clk.Now().Unix())
 	baseID := fmt.Sprintf("%s-%s", plan.Name, target.Name)
 	maxBaseLen := 63 - len(ts) - 1
 	executionID := fmt.Sprintf("%s-%s", k8sutil.ShortenName(baseID, maxBaseLen), ts)
 
+	ctx, span := tracing.Tracer().Start(ctx, "RunnerJob.dispatch", trace.WithAttributes(
+		attribute.String("hibernator.target", target.Name),
+		attribute.String("hibernator.executor", target.Type),
+		attribute.String("hibernator.operation", string(operation)),
+		attribute.String("hibernator.execution_id", executionID),
+	))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	var paramsJSON []byte
 	if target.Parameters != nil {
 		paramsJSON = target.Parameters.Raw
@@ -612,13 +889,31 @@ func (s *state) createRunnerJob(ctx context.Context, log logr.Logger, clk clock.
 
 	backoffLimit := int32(wellknown.DefaultJobBackoffLimit)
 	ttlSeconds := int32(wellknown.DefaultJobTTLSeconds)
-	tokenExpiration := int64(wellknown.StreamTokenExpirationSeconds)
+	var activeDeadline *int64
+	if policy := plan.Spec.Execution.JobPolicy; policy != nil {
+		backoffLimit = ptr.Deref(policy.BackoffLimit, backoffLimit)
+		ttlSeconds = ptr.Deref(policy.TTLSecondsAfterFinished, ttlSeconds)
+		activeDeadline = policy.ActiveDeadlineSeconds
+	}
+	streamToken := infra.StreamToken.withDefaults()
+	tokenExpiration := int64(streamToken.Expiration / time.Second)
 
-	if infra.RunnerServiceAccount == "" {
-		infra.RunnerServiceAccount = "hibernator-runner"
+	serviceAccount, err := infra.RunnerServiceAccountFor(target)
+	if err != nil {
+		return err
+	}
+	runnerImage, err := infra.RunnerImageFor(target)
+	if err != nil {
+		return err
 	}
-	if infra.RunnerImage == "" {
-		infra.RunnerImage = wellknown.RunnerImage
+
+	var restoreStorage string
+	if plan.Spec.Restore != nil && plan.Spec.Restore.Storage != nil {
+		storageJSON, err := json.Marshal(plan.Spec.Restore.Storage)
+		if err != nil {
+			return fmt.Errorf("marshal restore storage: %w", err)
+		}
+		restoreStorage = string(storageJSON)
 	}
 
 	connectorNamespace := target.ConnectorRef.Namespace
@@ -626,6 +921,42 @@ func (s *state) createRunnerJob(ctx context.Context, log logr.Logger, clk clock.
 		connectorNamespace = plan.Namespace
 	}
 
+	var limitEnv []corev1.EnvVar
+	if operation == hibernatorv1alpha1.OperationHibernate {
+		maxResources := ptr.Deref(plan.Spec.Behavior.MaxResourcesPerTarget, infra.MaxResourcesPerTarget)
+		if maxResources > 0 {
+			limitEnv = []corev1.EnvVar{{Name: "HIBERNATOR_MAX_RESOURCES", Value: strconv.Itoa(int(maxResources))}}
+		}
+	}
+
+	var preWakeEnv []corev1.EnvVar
+	if operation == hibernatorv1alpha1.OperationPreWake && target.PreWake != nil && target.PreWake.Parameters != nil {
+		preWakeEnv = []corev1.EnvVar{{Name: "HIBERNATOR_PREWAKE_PARAMS", Value: string(target.PreWake.Parameters.Raw)}}
+	}
+
+	streamingEnv := runnerStreamingEnv(infra.ControlPlaneEndpoint, infra.ClientCerts != nil)
+	if infra.EndpointChecker != nil {
+		if err := infra.EndpointChecker.Check(ctx, infra.ControlPlaneEndpoint); err != nil {
+			// Remote runners can only report their result through streaming.
+			if plan.Spec.Execution.RunnerCluster != nil {
+				return fmt.Errorf("runner cluster requires the streaming endpoint: %w", err)
+			}
+			// Offline mode: without streaming endpoints the runner skips the
+			// streaming client instead of timing out on connect, and still
+			// reports its result through the Job status and termination log.
+			log.V(1).Info("dispatching runner without streaming", "target", target.Name, "reason", err.Error())
+			streamingEnv = nil
+		}
+	}
+
+	var clientCert map[string][]byte
+	if streamingEnv != nil && infra.ClientCerts != nil {
+		var err error
+		if clientCert, err = infra.ClientCerts.Issue(executionID); err != nil {
+			return fmt.Errorf("issue runner client certificate: %w", err)
+		}
+	}
+
 	generateNameBase := fmt.Sprintf("%s-%s", plan.Name, target.Name)
 	generateName := fmt.Sprintf("runner-%s-", k8sutil.ShortenName(generateNameBase, 50))
 
@@ -649,6 +980,7 @@ func (s *state) createRunnerJob(ctx context.Context, log logr.Logger, clk clock.
 		Spec: batchv1.JobSpec{
 			BackoffLimit:            &backoffLimit,
 			TTLSecondsAfterFinished: &ttlSeconds,
+			ActiveDeadlineSeconds:   activeDeadline,
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{
@@ -665,36 +997,36 @@ func (s *state) createRunnerJob(ctx context.Context, log logr.Logger, clk clock.
 					},
 				},
 				Spec: corev1.PodSpec{
-					RestartPolicy:      corev1.RestartPolicyNever,
-					ServiceAccountName: infra.RunnerServiceAccount,
+					RestartPolicy:                 corev1.RestartPolicyNever,
+					ServiceAccountName:            serviceAccount,
+					TerminationGracePeriodSeconds: ptr.To(int64(wellknown.RunnerTerminationGracePeriod.Seconds())),
 					Containers: []corev1.Container{
 						{
 							Name:  "runner",
-							Image: infra.RunnerImage,
+							Image: runnerImage,
 							Args: []string{
 								"--operation", string(operation),
 								"--target", target.Name,
 								"--target-type", target.Type,
 								"--plan", plan.Name,
 							},
-							Env: []corev1.EnvVar{
+							Env: append([]corev1.EnvVar{
 								{Name: "POD_NAMESPACE", Value: plan.Namespace},
 								{Name: "HIBERNATOR_EXECUTION_ID", Value: executionID},
+								{Name: "HIBERNATOR_JOB_NAME", ValueFrom: podLabelEnvSource(batchv1.JobNameLabel)},
+								{Name: "HIBERNATOR_JOB_UID", ValueFrom: podLabelEnvSource(batchv1.ControllerUidLabel)},
 								{Name: "HIBERNATOR_CYCLE_ID", Value: plan.Status.CurrentCycleID},
-								{Name: "HIBERNATOR_CONTROL_PLANE_ENDPOINT", Value: infra.ControlPlaneEndpoint},
-								{Name: "HIBERNATOR_USE_TLS", Value: "false"},
-								{Name: "HIBERNATOR_GRPC_ENDPOINT", Value: fmt.Sprintf("%s:9444", infra.ControlPlaneEndpoint)},
-								{Name: "HIBERNATOR_WEBSOCKET_ENDPOINT", Value: fmt.Sprintf("ws://%s:8082", infra.ControlPlaneEndpoint)},
-								{Name: "HIBERNATOR_HTTP_CALLBACK_ENDPOINT", Value: fmt.Sprintf("http://%s:8082", infra.ControlPlaneEndpoint)},
 								{Name: "HIBERNATOR_TARGET_PARAMS", Value: string(paramsJSON)},
 								{Name: "HIBERNATOR_CONNECTOR_KIND", Value: target.ConnectorRef.Kind},
 								{Name: "HIBERNATOR_CONNECTOR_NAME", Value: target.ConnectorRef.Name},
 								{Name: "HIBERNATOR_CONNECTOR_NAMESPACE", Value: connectorNamespace},
-							},
+								{Name: "HIBERNATOR_RESTORE_STORAGE", Value: restoreStorage},
+								{Name: "HIBERNATOR_TOKEN_PATH", Value: streamToken.TokenPath()},
+							}, slices.Concat(limitEnv, preWakeEnv, streamingEnv, runnerTracingEnv(ctx, infra.Tracing))...),
 							VolumeMounts: []corev1.VolumeMount{
 								{
 									Name:      "stream-token",
-									MountPath: "/var/run/secrets/stream",
+									MountPath: streamToken.MountPath,
 									ReadOnly:  true,
 								},
 							},
@@ -708,9 +1040,9 @@ func (s *state) createRunnerJob(ctx context.Context, log logr.Logger, clk clock.
 									Sources: []corev1.VolumeProjection{
 										{
 											ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
-												Audience:          wellknown.StreamTokenAudience,
+												Audience:          streamToken.Audience,
 												ExpirationSeconds: &tokenExpiration,
-												Path:              "token",
+												Path:              wellknown.StreamTokenFile,
 											},
 										},
 									},
@@ -723,10 +1055,307 @@ func (s *state) createRunnerJob(ctx context.Context, log logr.Logger, clk clock.
 		},
 	}
 
+	// Lets the Jobs of a traced cycle be listed by trace ID.
+	if sc := span.SpanContext(); sc.IsSampled() {
+		job.Labels[wellknown.LabelTraceID] = sc.TraceID().String()
+		job.Spec.Template.Labels[wellknown.LabelTraceID] = sc.TraceID().String()
+	}
+
+	if tmpl := plan.Spec.Execution.RunnerPodTemplate; tmpl != nil {
+		applyRunnerPodTemplate(&job.Spec.Template, tmpl)
+	}
+	if audience := s.gcpFederationAudience(ctx, plan.Namespace, target); audience != "" {
+		addGCPFederationToken(&job.Spec.Template.Spec, audience)
+	}
+	if clientCert != nil {
+		addStreamTLS(&job.Spec.Template.Spec, streamTLSSecretName(executionID))
+	}
+
+	if plan.Spec.Execution.RunnerCluster != nil {
+		return s.createRemoteRunnerJob(ctx, log, plan, job, clientCert, infra)
+	}
+
 	if err := controllerutil.SetControllerReference(plan, job, s.Scheme); err != nil {
 		return fmt.Errorf("set owner reference: %w", err)
 	}
 
 	log.V(1).Info("creating runner job", "target", target.Name, "operation", operation, "jobName", generateName)
-	return s.Create(ctx, job)
+	if err := s.Create(ctx, job); err != nil {
+		return err
+	}
+
+	if clientCert != nil {
+		return s.createStreamTLSSecret(ctx, s.Client, job, executionID, clientCert)
+	}
+	return nil
+}
+
+// createRemoteRunnerJob creates job in the cluster of spec.execution.runnerCluster
+// and records it next to the plan. The record is created first, so the
+// streaming servers know the execution as soon as the runner connects; it is
+// deleted again when the Job cannot be created.
+func (s *state) createRemoteRunnerJob(ctx context.Context, log logr.Logger,
+	plan *hibernatorv1alpha1.HibernatePlan,
+	job *batchv1.Job,
+	clientCert map[string][]byte,
+	infra ExecutorInfra) error {
+
+	rc := plan.Spec.Execution.RunnerCluster
+	if infra.ControlPlaneEndpoint == "" {
+		return errors.New("runner cluster requires a control plane endpoint")
+	}
+	if infra.RunnerClusters == nil {
+		return errors.New("runner clusters are not supported by this controller")
+	}
+	remote, err := infra.RunnerClusters.ClientFor(ctx, plan.Namespace, rc.ClusterRef)
+	if err != nil {
+		return fmt.Errorf("connect to runner cluster %s: %w", rc.ClusterRef, err)
+	}
+
+	executionID := job.Labels[wellknown.LabelExecutionID]
+	job.Namespace = lo.Ternary(rc.Namespace != "", rc.Namespace, plan.Namespace)
+	job.Name = k8sutil.ShortenName(job.GenerateName+executionID, 63)
+	job.GenerateName = ""
+	// The record cannot tell pod retries apart, so failed targets are retried
+	// by the plan's retry policy instead.
+	job.Spec.BackoffLimit = ptr.To[int32](0)
+	useControlPlaneSecret(&job.Spec.Template.Spec, rc.ControlPlaneSecretName)
+
+	record := remoterunner.NewRecord(job, rc.ClusterRef, plan.Namespace)
+	if err := controllerutil.SetControllerReference(plan, record, s.Scheme); err != nil {
+		return fmt.Errorf("set owner reference: %w", err)
+	}
+	if err := s.Create(ctx, record); err != nil {
+		return fmt.Errorf("create remote runner record: %w", err)
+	}
+
+	log.V(1).Info("creating remote runner job", "target", job.Labels[wellknown.LabelTarget],
+		"cluster", rc.ClusterRef, "job", job.Namespace+"/"+job.Name)
+	if err := remote.Create(ctx, job); err != nil {
+		if delErr := s.Delete(ctx, record); delErr != nil && !apierrors.IsNotFound(delErr) {
+			return fmt.Errorf("create runner job in cluster %s: %w (delete record: %v)", rc.ClusterRef, err, delErr)
+		}
+		return fmt.Errorf("create runner job in cluster %s: %w", rc.ClusterRef, err)
+	}
+
+	if clientCert != nil {
+		return s.createStreamTLSSecret(ctx, remote, job, executionID, clientCert)
+	}
+	return nil
+}
+
+// useControlPlaneSecret points the runner container of a remote runner Job at
+// the control plane through secretName: the kubeconfig it reads connectors and
+// restore data with, and the token it authenticates to the streaming servers
+// with, which replaces the projected token of the runner cluster. The Job name
+// and UID are dropped, as the runner must not own control plane objects by a
+// Job that does not exist there.
+func useControlPlaneSecret(spec *corev1.PodSpec, secretName string) {
+	for i, vol := range spec.Volumes {
+		if vol.Name == "stream-token" {
+			spec.Volumes[i].VolumeSource = corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+			}
+		}
+	}
+
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		if c.Name != "runner" {
+			continue
+		}
+
+		var mountPath string
+		for _, m := range c.VolumeMounts {
+			if m.Name == "stream-token" {
+				mountPath = m.MountPath
+			}
+		}
+		c.Env = slices.DeleteFunc(c.Env, func(env corev1.EnvVar) bool {
+			return env.Name == "HIBERNATOR_JOB_NAME" || env.Name == "HIBERNATOR_JOB_UID"
+		})
+		for j := range c.Env {
+			if c.Env[j].Name == "HIBERNATOR_TOKEN_PATH" {
+				c.Env[j].Value = path.Join(mountPath, wellknown.ControlPlaneTokenKey)
+			}
+		}
+		c.Env = append(c.Env, corev1.EnvVar{Name: "KUBECONFIG", Value: path.Join(mountPath, wellknown.ControlPlaneKubeconfigKey)})
+	}
+}
+
+// createStreamTLSSecret stores the runner's client certificate in the Secret its
+// Job mounts, through c, the client of the cluster the Job runs in. The Secret
+// is owned by the Job, so it is deleted with it. The pod
+// waits for the Secret to appear; when it cannot be created the Job is deleted,
+// so the target is dispatched again with a new certificate.
+func (s *state) createStreamTLSSecret(ctx context.Context, c client.Client, job *batchv1.Job, executionID string, data map[string][]byte) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      streamTLSSecretName(executionID),
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				wellknown.LabelPlan:        job.Labels[wellknown.LabelPlan],
+				wellknown.LabelExecutionID: executionID,
+			},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: data,
+	}
+
+	err := controllerutil.SetOwnerReference(job, secret, s.Scheme)
+	if err == nil {
+		err = c.Create(ctx, secret)
+	}
+	if err != nil {
+		if delErr := c.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); delErr != nil && !apierrors.IsNotFound(delErr) {
+			return fmt.Errorf("create runner client certificate secret: %w (delete job: %v)", err, delErr)
+		}
+		return fmt.Errorf("create runner client certificate secret: %w", err)
+	}
+	return nil
+}
+
+// podLabelEnvSource exposes a label the Job controller sets on runner pods to
+// the runner, which owns the resources it creates by its Job with them.
+func podLabelEnvSource(label string) *corev1.EnvVarSource {
+	return &corev1.EnvVarSource{
+		FieldRef: &corev1.ObjectFieldSelector{FieldPath: fmt.Sprintf("metadata.labels['%s']", label)},
+	}
+}
+
+func streamTLSSecretName(executionID string) string {
+	return executionID + "-stream-tls"
+}
+
+// addStreamTLS mounts the runner's client certificate Secret into the runner
+// container, where the runner presents it to the streaming servers.
+func addStreamTLS(spec *corev1.PodSpec, secretName string) {
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: "stream-tls",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+		},
+	})
+	for i := range spec.Containers {
+		spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+			Name:      "stream-tls",
+			MountPath: wellknown.StreamTLSMountPath,
+			ReadOnly:  true,
+		})
+	}
+}
+
+// applyRunnerPodTemplate merges the plan's runner pod settings into the
+// runner pod template. Labels and annotations set by Hibernator are kept, as
+// the controller selects runner Jobs and pods by them.
+func applyRunnerPodTemplate(pod *corev1.PodTemplateSpec, tmpl *hibernatorv1alpha1.RunnerPodTemplate) {
+	tmpl = tmpl.DeepCopy()
+	pod.Labels = lo.Assign(tmpl.Labels, pod.Labels)
+	pod.Annotations = lo.Assign(tmpl.Annotations, pod.Annotations)
+
+	spec := &pod.Spec
+	spec.NodeSelector = tmpl.NodeSelector
+	spec.Tolerations = tmpl.Tolerations
+	spec.Affinity = tmpl.Affinity
+	spec.PriorityClassName = tmpl.PriorityClassName
+	spec.SecurityContext = tmpl.SecurityContext
+	for i := range spec.Containers {
+		if tmpl.Resources != nil {
+			spec.Containers[i].Resources = *tmpl.Resources
+		}
+		spec.Containers[i].SecurityContext = tmpl.ContainerSecurityContext
+	}
+}
+
+// gcpFederationAudience returns the workload identity pool provider the target's
+// runner exchanges its ServiceAccount token with, or "" when the target's connector
+// does not use GCP Workload Identity Federation. K8SClusters use the CloudProvider
+// of their providerRef. Connectors that cannot be read are left to the runner,
+// which reports them when it starts.
+func (s *state) gcpFederationAudience(ctx context.Context, namespace string, target *hibernatorv1alpha1.Target) string {
+	reader := s.connectorReader()
+	ref := target.ConnectorRef
+	key := client.ObjectKey{Namespace: lo.CoalesceOrEmpty(ref.Namespace, namespace), Name: ref.Name}
+
+	switch ref.Kind {
+	case "CloudProvider":
+	case "K8SCluster":
+		var kc hibernatorv1alpha1.K8SCluster
+		if err := reader.Get(ctx, key, &kc); err != nil || kc.Spec.ProviderRef == nil {
+			return ""
+		}
+		key = client.ObjectKey{Namespace: lo.CoalesceOrEmpty(kc.Spec.ProviderRef.Namespace, kc.Namespace), Name: kc.Spec.ProviderRef.Name}
+	default:
+		return ""
+	}
+
+	var cp hibernatorv1alpha1.CloudProvider
+	if err := reader.Get(ctx, key, &cp); err != nil {
+		return ""
+	}
+	if cp.Spec.GCP == nil || cp.Spec.GCP.Auth.WorkloadIdentityFederation == nil {
+		return ""
+	}
+	return cp.Spec.GCP.Auth.WorkloadIdentityFederation.Audience
+}
+
+// addGCPFederationToken mounts a ServiceAccount token issued for audience into the
+// runner container, where the runner exchanges it for Google credentials.
+func addGCPFederationToken(spec *corev1.PodSpec, audience string) {
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: "gcp-federation-token",
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          audience,
+							ExpirationSeconds: ptr.To(int64(wellknown.GCPFederationTokenExpirationSeconds)),
+							Path:              wellknown.GCPFederationTokenFile,
+						},
+					},
+				},
+			},
+		},
+	})
+	for i := range spec.Containers {
+		spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+			Name:      "gcp-federation-token",
+			MountPath: wellknown.GCPFederationTokenMountPath,
+			ReadOnly:  true,
+		})
+	}
+}
+
+// runnerStreamingEnv returns the environment pointing a runner at the
+// control-plane streaming endpoints. IPv6 endpoints are bracketed. With mTLS,
+// runners connect over TLS with the client certificate mounted by addStreamTLS.
+// runnerTracingEnv configures the runner to export spans continuing the trace
+// of the span in ctx.
+func runnerTracingEnv(ctx context.Context, cfg tracing.Config) []corev1.EnvVar {
+	if !cfg.Enabled() {
+		return nil
+	}
+	env := []corev1.EnvVar{
+		{Name: tracing.EnvEndpoint, Value: cfg.Endpoint},
+		{Name: tracing.EnvInsecure, Value: strconv.FormatBool(cfg.Insecure)},
+	}
+	if traceparent := tracing.Traceparent(ctx); traceparent != "" {
+		env = append(env, corev1.EnvVar{Name: tracing.EnvTraceparent, Value: traceparent})
+	}
+	return env
+}
+
+func runnerStreamingEnv(endpoint string, mTLS bool) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: "HIBERNATOR_CONTROL_PLANE_ENDPOINT", Value: endpoint},
+		{Name: "HIBERNATOR_USE_TLS", Value: strconv.FormatBool(mTLS)},
+		{Name: "HIBERNATOR_GRPC_ENDPOINT", Value: streamendpoint.GRPCAddress(endpoint)},
+		{Name: "HIBERNATOR_WEBSOCKET_ENDPOINT", Value: streamendpoint.WebSocketURL(endpoint, mTLS)},
+		{Name: "HIBERNATOR_HTTP_CALLBACK_ENDPOINT", Value: streamendpoint.HTTPCallbackURL(endpoint, mTLS)},
+	}
+	if mTLS {
+		env = append(env, corev1.EnvVar{Name: "HIBERNATOR_TLS_DIR", Value: wellknown.StreamTLSMountPath})
+	}
+	return env
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/scheduler"
@@ -625,6 +626,30 @@ func TestEffectivePlan_UsesSnapshot_WhenCycleIDMatches(t *testing.T) {
 	assert.Equal(t, hibernatorv1alpha1.BehaviorBestEffort, effective.Spec.Behavior.Mode)
 }
 
+func TestEffectivePlan_AppliesSpecEditsOutsideOperation(t *testing.T) {
+	for _, phase := range []hibernatorv1alpha1.PlanPhase{
+		hibernatorv1alpha1.PhaseError,
+		hibernatorv1alpha1.PhaseHibernated,
+		hibernatorv1alpha1.PhaseActive,
+	} {
+		t.Run(string(phase), func(t *testing.T) {
+			plan := basePlanForState("p", phase)
+			plan.Status.CurrentCycleID = "cycle-001"
+			plan.Status.PlanSnapshot = &hibernatorv1alpha1.PlanSnapshot{
+				CycleID:  "cycle-001",
+				Behavior: hibernatorv1alpha1.Behavior{Mode: hibernatorv1alpha1.BehaviorStrict, Retries: ptr.To[int32](1)},
+			}
+			// Behavior edited after the cycle's snapshot was taken.
+			plan.Spec.Behavior = hibernatorv1alpha1.Behavior{Mode: hibernatorv1alpha1.BehaviorBestEffort, Retries: ptr.To[int32](5)}
+
+			st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+			assert.Equal(t, plan.Spec.Behavior, st.effectivePlan(plan).Spec.Behavior)
+			assert.Equal(t, plan.Status.PlanSnapshot.Behavior, st.cyclePlan(plan).Spec.Behavior)
+		})
+	}
+}
+
 func TestEffectivePlan_FallsBackToBuildEffectivePlan_WhenNoSnapshot(t *testing.T) {
 	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
 	plan.Spec.Targets = []hibernatorv1alpha1.Target{
@@ -748,7 +773,7 @@ func TestHibernatingState_Finalize_PreservesPlanSnapshot(t *testing.T) {
 	st := newHandlerState(plan, c)
 	h := &hibernatingState{state: st}
 
-	h.finalize(nil, st.Log, scheduler.ExecutionPlan{})
+	h.finalize(nil, st.Log, scheduler.ExecutionPlan{}, "")
 
 	upd := <-planStatuses(st).C()
 	require.NotNil(t, upd.Mutator)
@@ -777,7 +802,7 @@ func TestWakingUpState_Finalize_PreservesPlanSnapshot(t *testing.T) {
 	st := newHandlerState(plan, c)
 	h := &wakingUpState{state: st}
 
-	h.finalize(nil, st.Log, scheduler.ExecutionPlan{})
+	h.finalize(nil, st.Log, scheduler.ExecutionPlan{}, "")
 
 	upd := <-planStatuses(st).C()
 	require.NotNil(t, upd.Mutator)
@@ -6,13 +6,30 @@ Licensed under the Apache License, Version 2.0.
 package state
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/remoterunner"
+	"github.com/ardikabs/hibernator/internal/runnerimage"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/tracing"
+	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
 // ---------------------------------------------------------------------------
@@ -31,7 +48,7 @@ func TestBuildExecutionPlan_Sequential_OneStagePerTarget(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	execPlan, err := st.buildExecutionPlan(plan, false)
+	execPlan, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
 	assert.Len(t, execPlan.Stages, 3, "sequential: one stage per target")
 }
@@ -46,7 +63,7 @@ func TestBuildExecutionPlan_Parallel_SingleStage(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	execPlan, err := st.buildExecutionPlan(plan, false)
+	execPlan, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
 	assert.Len(t, execPlan.Stages, 1, "parallel: all targets in a single stage")
 }
@@ -65,7 +82,7 @@ func TestBuildExecutionPlan_DAG_RespectsOrder(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	execPlan, err := st.buildExecutionPlan(plan, false)
+	execPlan, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
 	require.GreaterOrEqual(t, len(execPlan.Stages), 2, "DAG: db before app → at least 2 stages")
 	assert.Contains(t, execPlan.Stages[0].Targets, "db")
@@ -85,7 +102,7 @@ func TestBuildExecutionPlan_Staged_ReturnsCorrectStages(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	execPlan, err := st.buildExecutionPlan(plan, false)
+	execPlan, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
 	assert.Len(t, execPlan.Stages, 2, "staged: two stages as defined")
 }
@@ -98,7 +115,7 @@ func TestBuildExecutionPlan_UnknownStrategy_ReturnsError(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	_, err := st.buildExecutionPlan(plan, false)
+	_, err := st.buildExecutionPlan(context.Background(), plan, false)
 	assert.Error(t, err, "unknown strategy must return error")
 }
 
@@ -114,9 +131,9 @@ func TestBuildExecutionPlan_Reverse_Sequential(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	forward, err := st.buildExecutionPlan(plan, false)
+	forward, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
-	backward, err := st.buildExecutionPlan(plan, true)
+	backward, err := st.buildExecutionPlan(context.Background(), plan, true)
 	require.NoError(t, err)
 
 	// Forward: [db] → [app] → [cache]
@@ -145,9 +162,9 @@ func TestBuildExecutionPlan_Reverse_Parallel(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	forward, err := st.buildExecutionPlan(plan, false)
+	forward, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
-	backward, err := st.buildExecutionPlan(plan, true)
+	backward, err := st.buildExecutionPlan(context.Background(), plan, true)
 	require.NoError(t, err)
 
 	// Forward: single stage [db, app, cache]
@@ -181,9 +198,9 @@ func TestBuildExecutionPlan_Reverse_DAG_Diamond(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	forward, err := st.buildExecutionPlan(plan, false)
+	forward, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
-	backward, err := st.buildExecutionPlan(plan, true)
+	backward, err := st.buildExecutionPlan(context.Background(), plan, true)
 	require.NoError(t, err)
 
 	// Forward (shutdown): [a, e] → [b, c] → [d]
@@ -222,9 +239,9 @@ func TestBuildExecutionPlan_Reverse_DAG_DiamondWithSpur(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	forward, err := st.buildExecutionPlan(plan, false)
+	forward, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
-	backward, err := st.buildExecutionPlan(plan, true)
+	backward, err := st.buildExecutionPlan(context.Background(), plan, true)
 	require.NoError(t, err)
 
 	// Forward (shutdown): [a, e] → [b, c] → [d]
@@ -258,9 +275,9 @@ func TestBuildExecutionPlan_Reverse_DAG_Chain(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	forward, err := st.buildExecutionPlan(plan, false)
+	forward, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
-	backward, err := st.buildExecutionPlan(plan, true)
+	backward, err := st.buildExecutionPlan(context.Background(), plan, true)
 	require.NoError(t, err)
 
 	// Forward: [a] → [b] → [c]
@@ -301,9 +318,9 @@ func TestBuildExecutionPlan_Reverse_DAG_MultiBranch(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	forward, err := st.buildExecutionPlan(plan, false)
+	forward, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
-	backward, err := st.buildExecutionPlan(plan, true)
+	backward, err := st.buildExecutionPlan(context.Background(), plan, true)
 	require.NoError(t, err)
 
 	// Forward: [a] → [b, c] → [d, e] → [f]
@@ -337,9 +354,9 @@ func TestBuildExecutionPlan_Reverse_Staged(t *testing.T) {
 	c := newHandlerFakeClient(plan)
 	st := newHandlerState(plan, c)
 
-	forward, err := st.buildExecutionPlan(plan, false)
+	forward, err := st.buildExecutionPlan(context.Background(), plan, false)
 	require.NoError(t, err)
-	backward, err := st.buildExecutionPlan(plan, true)
+	backward, err := st.buildExecutionPlan(context.Background(), plan, true)
 	require.NoError(t, err)
 
 	// Forward: stage[storage: db,cache] → stage[services: app,web]
@@ -352,3 +369,834 @@ func TestBuildExecutionPlan_Reverse_Staged(t *testing.T) {
 	assert.Equal(t, []string{"app", "web"}, backward.Stages[0].Targets)
 	assert.Equal(t, []string{"db", "cache"}, backward.Stages[1].Targets)
 }
+
+func TestBuildExecutionPlan_Parallel_DrainOrderPerCluster(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "nodes", Type: "eks", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+			Parameters: &hibernatorv1alpha1.Parameters{Raw: []byte(`{"clusterName":"prod"}`)}},
+		{Name: "apps", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "prod"}},
+		{Name: "pools", Type: "karpenter", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "prod"}},
+		{Name: "db", Type: "rds"},
+	}
+	plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategyParallel
+
+	cluster := &hibernatorv1alpha1.K8SCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec:       hibernatorv1alpha1.K8SClusterSpec{EKS: &hibernatorv1alpha1.EKSConfig{Name: "prod", Region: "ap-southeast-1"}},
+	}
+	c := newHandlerFakeClient(plan, cluster)
+	st := newHandlerState(plan, c)
+
+	forward, err := st.buildExecutionPlan(context.Background(), plan, false)
+	require.NoError(t, err)
+	backward, err := st.buildExecutionPlan(context.Background(), plan, true)
+	require.NoError(t, err)
+
+	// Shutdown: workloads before the EKS node groups and Karpenter pools of the same cluster.
+	require.Len(t, forward.Stages, 2)
+	assert.Equal(t, []string{"apps", "db"}, forward.Stages[0].Targets)
+	assert.Equal(t, []string{"nodes", "pools"}, forward.Stages[1].Targets)
+
+	// Wakeup: nodes first so workloads have somewhere to schedule.
+	require.Len(t, backward.Stages, 2)
+	assert.Equal(t, []string{"db", "nodes", "pools"}, backward.Stages[0].Targets)
+	assert.Equal(t, []string{"apps"}, backward.Stages[1].Targets)
+}
+
+func TestBuildExecutionPlan_DAG_DrainOrderKeepsExplicitEdges(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "apps", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev"}},
+		{Name: "pools", Type: "karpenter", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev"}},
+		{Name: "jobs", Type: "workloadscaler", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev"}},
+	}
+	plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategyDAG
+	// The user explicitly stops the pools before the batch jobs; that edge wins.
+	plan.Spec.Execution.Strategy.Dependencies = []hibernatorv1alpha1.Dependency{
+		{From: "pools", To: "jobs"},
+	}
+
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+
+	execPlan, err := st.buildExecutionPlan(context.Background(), plan, false)
+	require.NoError(t, err)
+	require.Len(t, execPlan.Stages, 3)
+	assert.Equal(t, []string{"apps"}, execPlan.Stages[0].Targets)
+	assert.Equal(t, []string{"pools"}, execPlan.Stages[1].Targets)
+	assert.Equal(t, []string{"jobs"}, execPlan.Stages[2].Targets)
+}
+
+// ---------------------------------------------------------------------------
+// ExecutorInfra.RunnerImageFor()
+// ---------------------------------------------------------------------------
+
+func TestRunnerImageFor_Precedence(t *testing.T) {
+	infra := ExecutorInfra{
+		RunnerImage:          "runner:global",
+		RunnerImages:         map[string]string{"rds": "rds-runner:v1"},
+		RunnerImageAllowlist: runnerimage.Allowlist{"custom"},
+	}
+
+	image := func(infra ExecutorInfra, target *hibernatorv1alpha1.Target) string {
+		t.Helper()
+		image, err := infra.RunnerImageFor(target)
+		require.NoError(t, err)
+		return image
+	}
+
+	assert.Equal(t, "custom:v2", image(infra, &hibernatorv1alpha1.Target{Type: "rds", RunnerImage: "custom:v2"}))
+	assert.Equal(t, "rds-runner:v1", image(infra, &hibernatorv1alpha1.Target{Type: "rds"}))
+	assert.Equal(t, "runner:global", image(infra, &hibernatorv1alpha1.Target{Type: "eks"}))
+	assert.Equal(t, wellknown.RunnerImage, image(ExecutorInfra{}, &hibernatorv1alpha1.Target{Type: "eks"}))
+}
+
+func TestRunnerImageFor_RejectsImagesNotAllowed(t *testing.T) {
+	infra := ExecutorInfra{
+		RunnerImage:          "runner:global",
+		RunnerImages:         map[string]string{"rds": "rds-runner:v1"},
+		RunnerImageAllowlist: runnerimage.Allowlist{"ghcr.io/acme/"},
+	}
+
+	_, err := infra.RunnerImageFor(&hibernatorv1alpha1.Target{Type: "eks", RunnerImage: "evil.io/runner:v1"})
+	assert.Error(t, err)
+
+	// Configured images may be selected by any target.
+	image, err := infra.RunnerImageFor(&hibernatorv1alpha1.Target{Type: "eks", RunnerImage: "rds-runner:v1"})
+	require.NoError(t, err)
+	assert.Equal(t, "rds-runner:v1", image)
+
+	image, err = infra.RunnerImageFor(&hibernatorv1alpha1.Target{Type: "eks", RunnerImage: "ghcr.io/acme/runner:v3"})
+	require.NoError(t, err)
+	assert.Equal(t, "ghcr.io/acme/runner:v3", image)
+}
+
+// ---------------------------------------------------------------------------
+// ExecutorInfra.RunnerServiceAccountFor()
+// ---------------------------------------------------------------------------
+
+func TestRunnerServiceAccountFor(t *testing.T) {
+	infra := ExecutorInfra{
+		RunnerServiceAccount:  "runner",
+		RunnerServiceAccounts: []string{"runner-prod"},
+	}
+
+	sa, err := infra.RunnerServiceAccountFor(&hibernatorv1alpha1.Target{})
+	require.NoError(t, err)
+	assert.Equal(t, "runner", sa)
+
+	sa, err = infra.RunnerServiceAccountFor(&hibernatorv1alpha1.Target{RunnerServiceAccount: "runner-prod"})
+	require.NoError(t, err)
+	assert.Equal(t, "runner-prod", sa)
+
+	sa, err = infra.RunnerServiceAccountFor(&hibernatorv1alpha1.Target{RunnerServiceAccount: "runner"})
+	require.NoError(t, err)
+	assert.Equal(t, "runner", sa)
+
+	_, err = infra.RunnerServiceAccountFor(&hibernatorv1alpha1.Target{RunnerServiceAccount: "admin"})
+	assert.ErrorContains(t, err, `runner service account "admin" is not allowed`)
+
+	sa, err = ExecutorInfra{}.RunnerServiceAccountFor(&hibernatorv1alpha1.Target{})
+	require.NoError(t, err)
+	assert.Equal(t, "hibernator-runner", sa)
+}
+
+func TestCreateRunnerJob_TargetServiceAccount(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+	infra := ExecutorInfra{RunnerServiceAccounts: []string{"hibernator-runner-prod"}}
+
+	target := &hibernatorv1alpha1.Target{Name: "db", Type: "rds", RunnerServiceAccount: "hibernator-runner-prod"}
+	require.NoError(t, st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, hibernatorv1alpha1.OperationHibernate, infra))
+
+	var jobs batchv1.JobList
+	require.NoError(t, c.List(context.Background(), &jobs, client.InNamespace("default")))
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, "hibernator-runner-prod", jobs.Items[0].Spec.Template.Spec.ServiceAccountName)
+
+	target = &hibernatorv1alpha1.Target{Name: "cache", Type: "rds", RunnerServiceAccount: "default"}
+	err := st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, hibernatorv1alpha1.OperationHibernate, infra)
+	require.Error(t, err)
+	require.NoError(t, c.List(context.Background(), &jobs, client.InNamespace("default")))
+	assert.Len(t, jobs.Items, 1, "no job is created for a service account that is not allowed")
+}
+
+func TestExecuteForStage_DispatchesByPriority(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "batch", Type: "ec2"},
+		{Name: "api", Type: "ec2", Priority: 10},
+		{Name: "worker", Type: "ec2"},
+		{Name: "db", Type: "rds", Priority: 10},
+	}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+
+	assert.Equal(t, []string{"api", "db", "batch", "worker"},
+		targetsByPriority(plan, []string{"batch", "api", "worker", "db"}))
+
+	stage := scheduler.ExecutionStage{Targets: []string{"batch", "api", "worker", "db"}, MaxConcurrency: 1}
+	_, err := st.executeForStage(context.Background(), st.Log, plan, nil, stage, hibernatorv1alpha1.OperationHibernate)
+	require.NoError(t, err)
+
+	var jobs batchv1.JobList
+	require.NoError(t, c.List(context.Background(), &jobs, client.InNamespace("default")))
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, "api", jobs.Items[0].Labels[wellknown.LabelTarget], "the first job slot goes to the highest-priority target")
+}
+
+// ---------------------------------------------------------------------------
+// runnerStreamingEnv()
+// ---------------------------------------------------------------------------
+
+func TestRunnerStreamingEnv(t *testing.T) {
+	env := map[string]string{}
+	for _, e := range runnerStreamingEnv("hibernator.hibernator-system.svc", false) {
+		env[e.Name] = e.Value
+	}
+
+	assert.Equal(t, "hibernator.hibernator-system.svc", env["HIBERNATOR_CONTROL_PLANE_ENDPOINT"])
+	assert.Equal(t, "hibernator.hibernator-system.svc:9444", env["HIBERNATOR_GRPC_ENDPOINT"])
+	assert.Equal(t, "ws://hibernator.hibernator-system.svc:8082", env["HIBERNATOR_WEBSOCKET_ENDPOINT"])
+	assert.Equal(t, "http://hibernator.hibernator-system.svc:8082", env["HIBERNATOR_HTTP_CALLBACK_ENDPOINT"])
+	assert.Equal(t, "false", env["HIBERNATOR_USE_TLS"])
+	assert.NotContains(t, env, "HIBERNATOR_TLS_DIR")
+}
+
+func TestRunnerStreamingEnv_MutualTLS(t *testing.T) {
+	env := map[string]string{}
+	for _, e := range runnerStreamingEnv("hibernator.hibernator-system.svc", true) {
+		env[e.Name] = e.Value
+	}
+
+	assert.Equal(t, "true", env["HIBERNATOR_USE_TLS"])
+	assert.Equal(t, wellknown.StreamTLSMountPath, env["HIBERNATOR_TLS_DIR"])
+	assert.Equal(t, "wss://hibernator.hibernator-system.svc:8082", env["HIBERNATOR_WEBSOCKET_ENDPOINT"])
+	assert.Equal(t, "https://hibernator.hibernator-system.svc:8082", env["HIBERNATOR_HTTP_CALLBACK_ENDPOINT"])
+}
+
+// ---------------------------------------------------------------------------
+// runnerTracingEnv()
+// ---------------------------------------------------------------------------
+
+func TestRunnerTracingEnv(t *testing.T) {
+	assert.Nil(t, runnerTracingEnv(context.Background(), tracing.Config{}), "tracing disabled")
+
+	provider := sdktrace.NewTracerProvider()
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+	ctx, span := provider.Tracer("test").Start(context.Background(), "dispatch")
+	defer span.End()
+
+	env := map[string]string{}
+	for _, e := range runnerTracingEnv(ctx, tracing.Config{Endpoint: "otel-collector:4317", Insecure: true}) {
+		env[e.Name] = e.Value
+	}
+
+	assert.Equal(t, "otel-collector:4317", env[tracing.EnvEndpoint])
+	assert.Equal(t, "true", env[tracing.EnvInsecure])
+	assert.Contains(t, env[tracing.EnvTraceparent], span.SpanContext().TraceID().String())
+
+	env = map[string]string{}
+	for _, e := range runnerTracingEnv(context.Background(), tracing.Config{Endpoint: "otel-collector:4317"}) {
+		env[e.Name] = e.Value
+	}
+	assert.NotContains(t, env, tracing.EnvTraceparent, "untraced dispatch")
+}
+
+// ---------------------------------------------------------------------------
+// createRunnerJob() with runner client certificates
+// ---------------------------------------------------------------------------
+
+type fakeClientCertIssuer struct {
+	commonNames []string
+}
+
+func (f *fakeClientCertIssuer) Issue(commonName string) (map[string][]byte, error) {
+	f.commonNames = append(f.commonNames, commonName)
+	return map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key"), "ca.crt": []byte("ca")}, nil
+}
+
+func TestCreateRunnerJob_MountsIssuedClientCertificate(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Status.CurrentCycleID = "cycle-001"
+	target := &hibernatorv1alpha1.Target{
+		Name:         "db",
+		Type:         "rds",
+		ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+	}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+	issuer := &fakeClientCertIssuer{}
+
+	err := st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, hibernatorv1alpha1.OperationHibernate, ExecutorInfra{
+		ControlPlaneEndpoint: "hibernator.hibernator-system.svc",
+		ClientCerts:          issuer,
+	})
+	require.NoError(t, err)
+
+	var jobs batchv1.JobList
+	require.NoError(t, c.List(context.Background(), &jobs, client.InNamespace("default")))
+	require.Len(t, jobs.Items, 1)
+	job := jobs.Items[0]
+	executionID := job.Labels[wellknown.LabelExecutionID]
+	assert.Equal(t, []string{executionID}, issuer.commonNames, "the certificate names the execution")
+
+	podSpec := job.Spec.Template.Spec
+	volume, ok := lo.Find(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == "stream-tls" })
+	require.True(t, ok)
+	require.NotNil(t, volume.Secret)
+	assert.Equal(t, executionID+"-stream-tls", volume.Secret.SecretName)
+	assert.True(t, lo.ContainsBy(podSpec.Containers[0].VolumeMounts, func(m corev1.VolumeMount) bool {
+		return m.Name == "stream-tls" && m.MountPath == wellknown.StreamTLSMountPath
+	}))
+
+	var secret corev1.Secret
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: volume.Secret.SecretName}, &secret))
+	assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+	assert.Equal(t, []byte("cert"), secret.Data["tls.crt"])
+	require.Len(t, secret.OwnerReferences, 1)
+	assert.Equal(t, "Job", secret.OwnerReferences[0].Kind)
+	assert.Equal(t, job.Name, secret.OwnerReferences[0].Name)
+}
+
+type failingEndpointChecker struct{}
+
+func (failingEndpointChecker) Check(context.Context, string) error {
+	return errors.New("unreachable")
+}
+
+func TestCreateRunnerJob_OfflineRunnerGetsNoClientCertificate(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	target := &hibernatorv1alpha1.Target{Name: "db", Type: "rds"}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+	issuer := &fakeClientCertIssuer{}
+
+	err := st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, hibernatorv1alpha1.OperationHibernate, ExecutorInfra{
+		ControlPlaneEndpoint: "hibernator.hibernator-system.svc",
+		EndpointChecker:      failingEndpointChecker{},
+		ClientCerts:          issuer,
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, issuer.commonNames)
+	var secrets corev1.SecretList
+	require.NoError(t, c.List(context.Background(), &secrets, client.InNamespace("default")))
+	assert.Empty(t, secrets.Items)
+}
+
+// ---------------------------------------------------------------------------
+// createRunnerJob() with spec.execution.runnerCluster
+// ---------------------------------------------------------------------------
+
+type fakeRunnerClusters map[string]client.Client
+
+func (f fakeRunnerClusters) ClientFor(_ context.Context, namespace, name string) (client.Client, error) {
+	c, ok := f[namespace+"/"+name]
+	if !ok {
+		return nil, fmt.Errorf("K8SCluster %s/%s not found", namespace, name)
+	}
+	return c, nil
+}
+
+func TestCreateRunnerJob_RunnerCluster(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Status.CurrentCycleID = "cycle-001"
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+	plan.Spec.Execution.RunnerCluster = &hibernatorv1alpha1.RunnerCluster{
+		ClusterRef:             "workloads",
+		Namespace:              "runners",
+		ControlPlaneSecretName: "control-plane",
+	}
+	target := &hibernatorv1alpha1.Target{
+		Name:         "db",
+		Type:         "rds",
+		ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+	}
+	c := newHandlerFakeClient(plan)
+	remote := newHandlerFakeClient()
+	st := newHandlerState(plan, c)
+	st.ExecutorInfra.RunnerClusters = fakeRunnerClusters{"default/workloads": remote}
+
+	err := st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, hibernatorv1alpha1.OperationHibernate, ExecutorInfra{
+		ControlPlaneEndpoint: "hibernator.example.com",
+		ClientCerts:          &fakeClientCertIssuer{},
+		RunnerClusters:       st.ExecutorInfra.RunnerClusters,
+	})
+	require.NoError(t, err)
+
+	var local batchv1.JobList
+	require.NoError(t, c.List(context.Background(), &local))
+	assert.Empty(t, local.Items, "no runner job is created next to the plan")
+
+	var remoteJobs batchv1.JobList
+	require.NoError(t, remote.List(context.Background(), &remoteJobs, client.InNamespace("runners")))
+	require.Len(t, remoteJobs.Items, 1)
+	job := remoteJobs.Items[0]
+	assert.Empty(t, job.OwnerReferences, "the plan cannot own a job in another cluster")
+	assert.Equal(t, int32(0), ptr.Deref(job.Spec.BackoffLimit, -1))
+
+	container := job.Spec.Template.Spec.Containers[0]
+	env := lo.SliceToMap(container.Env, func(e corev1.EnvVar) (string, corev1.EnvVar) { return e.Name, e })
+	assert.NotContains(t, env, "HIBERNATOR_JOB_NAME")
+	assert.NotContains(t, env, "HIBERNATOR_JOB_UID")
+	assert.Equal(t, "default", env["POD_NAMESPACE"].Value, "the runner reads the plan namespace of the control plane")
+	assert.Equal(t, wellknown.StreamTokenMountPath+"/kubeconfig", env["KUBECONFIG"].Value)
+	assert.Equal(t, wellknown.StreamTokenMountPath+"/token", env["HIBERNATOR_TOKEN_PATH"].Value)
+	volume, ok := lo.Find(job.Spec.Template.Spec.Volumes, func(v corev1.Volume) bool { return v.Name == "stream-token" })
+	require.True(t, ok)
+	require.NotNil(t, volume.Secret)
+	assert.Equal(t, "control-plane", volume.Secret.SecretName)
+
+	var secret corev1.Secret
+	executionID := job.Labels[wellknown.LabelExecutionID]
+	require.NoError(t, remote.Get(context.Background(), client.ObjectKey{Namespace: "runners", Name: executionID + "-stream-tls"}, &secret),
+		"the client certificate is stored next to the job")
+
+	jobs, err := st.getCurrentCycleJobs(context.Background(), plan)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	view := jobs[0]
+	assert.True(t, remoterunner.IsRecord(&view))
+	assert.Equal(t, "db", view.Labels[wellknown.LabelTarget])
+	assert.Equal(t, int32(1), view.Status.Active)
+
+	var record corev1.ConfigMap
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: view.Name}, &record))
+	require.Len(t, record.OwnerReferences, 1)
+	assert.Equal(t, "p", record.OwnerReferences[0].Name)
+
+	require.NoError(t, st.deleteRunnerJob(context.Background(), &view))
+	require.NoError(t, remote.List(context.Background(), &remoteJobs, client.InNamespace("runners")))
+	assert.Empty(t, remoteJobs.Items)
+	assert.True(t, apierrors.IsNotFound(c.Get(context.Background(), client.ObjectKeyFromObject(&record), &corev1.ConfigMap{})))
+}
+
+func TestCreateRunnerJob_RunnerClusterRequiresStreaming(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Spec.Execution.RunnerCluster = &hibernatorv1alpha1.RunnerCluster{ClusterRef: "workloads", ControlPlaneSecretName: "control-plane"}
+	target := &hibernatorv1alpha1.Target{Name: "db", Type: "rds"}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+
+	err := st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, hibernatorv1alpha1.OperationHibernate, ExecutorInfra{
+		ControlPlaneEndpoint: "hibernator.example.com",
+		EndpointChecker:      failingEndpointChecker{},
+		RunnerClusters:       fakeRunnerClusters{"default/workloads": newHandlerFakeClient()},
+	})
+	require.ErrorContains(t, err, "requires the streaming endpoint")
+}
+
+func TestCreateRunnerJob_AppliesRunnerPodTemplate(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	toleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "infra", Effect: corev1.TaintEffectNoSchedule}
+	plan.Spec.Execution.RunnerPodTemplate = &hibernatorv1alpha1.RunnerPodTemplate{
+		Labels:       map[string]string{"cost-center": "platform", wellknown.LabelPlan: "other"},
+		Annotations:  map[string]string{"sidecar.istio.io/inject": "false"},
+		NodeSelector: map[string]string{"node-pool": "infra"},
+		Tolerations:  []corev1.Toleration{toleration},
+		Resources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		},
+		PriorityClassName:        "infra-critical",
+		SecurityContext:          &corev1.PodSecurityContext{RunAsNonRoot: ptr.To(true)},
+		ContainerSecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(false)},
+	}
+	target := &hibernatorv1alpha1.Target{Name: "db", Type: "rds"}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+
+	err := st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, hibernatorv1alpha1.OperationHibernate, ExecutorInfra{})
+	require.NoError(t, err)
+
+	var jobs batchv1.JobList
+	require.NoError(t, c.List(context.Background(), &jobs, client.InNamespace("default")))
+	require.Len(t, jobs.Items, 1)
+	pod := jobs.Items[0].Spec.Template
+
+	assert.Equal(t, "platform", pod.Labels["cost-center"])
+	assert.Equal(t, "p", pod.Labels[wellknown.LabelPlan], "hibernator labels take precedence")
+	assert.Equal(t, "false", pod.Annotations["sidecar.istio.io/inject"])
+	assert.Equal(t, "p", pod.Annotations[wellknown.AnnotationPlan])
+	assert.Equal(t, map[string]string{"node-pool": "infra"}, pod.Spec.NodeSelector)
+	assert.Equal(t, []corev1.Toleration{toleration}, pod.Spec.Tolerations)
+	assert.Equal(t, "infra-critical", pod.Spec.PriorityClassName)
+	assert.Equal(t, ptr.To(true), pod.Spec.SecurityContext.RunAsNonRoot)
+	require.Len(t, pod.Spec.Containers, 1)
+	assert.True(t, resource.MustParse("100m").Equal(pod.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU]))
+	assert.Equal(t, ptr.To(false), pod.Spec.Containers[0].SecurityContext.AllowPrivilegeEscalation)
+	assert.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+}
+
+func TestCreateRunnerJob_JobPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       *hibernatorv1alpha1.JobPolicy
+		wantTTL      int32
+		wantBackoff  int32
+		wantDeadline *int64
+	}{
+		{
+			name:        "defaults",
+			wantTTL:     wellknown.DefaultJobTTLSeconds,
+			wantBackoff: wellknown.DefaultJobBackoffLimit,
+		},
+		{
+			name:         "overrides",
+			policy:       &hibernatorv1alpha1.JobPolicy{TTLSecondsAfterFinished: ptr.To[int32](86400), BackoffLimit: ptr.To[int32](0), ActiveDeadlineSeconds: ptr.To[int64](1800)},
+			wantTTL:      86400,
+			wantBackoff:  0,
+			wantDeadline: ptr.To[int64](1800),
+		},
+		{
+			name:        "partial policy keeps defaults",
+			policy:      &hibernatorv1alpha1.JobPolicy{BackoffLimit: ptr.To[int32](1)},
+			wantTTL:     wellknown.DefaultJobTTLSeconds,
+			wantBackoff: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+			plan.Spec.Execution.JobPolicy = tt.policy
+			target := &hibernatorv1alpha1.Target{Name: "db", Type: "rds"}
+			c := newHandlerFakeClient(plan)
+			st := newHandlerState(plan, c)
+
+			err := st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, hibernatorv1alpha1.OperationHibernate, ExecutorInfra{})
+			require.NoError(t, err)
+
+			var jobs batchv1.JobList
+			require.NoError(t, c.List(context.Background(), &jobs, client.InNamespace("default")))
+			require.Len(t, jobs.Items, 1)
+			spec := jobs.Items[0].Spec
+			assert.Equal(t, ptr.To(tt.wantTTL), spec.TTLSecondsAfterFinished)
+			assert.Equal(t, ptr.To(tt.wantBackoff), spec.BackoffLimit)
+			assert.Equal(t, tt.wantDeadline, spec.ActiveDeadlineSeconds)
+		})
+	}
+}
+
+func TestCreateRunnerJob_MaxResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		planLimit *int32
+		infra     int32
+		operation hibernatorv1alpha1.PlanOperation
+		want      string
+	}{
+		{name: "no limit", operation: hibernatorv1alpha1.OperationHibernate},
+		{name: "controller default", infra: 100, operation: hibernatorv1alpha1.OperationHibernate, want: "100"},
+		{name: "plan overrides the default", planLimit: ptr.To[int32](20), infra: 100, operation: hibernatorv1alpha1.OperationHibernate, want: "20"},
+		{name: "plan disables the default", planLimit: ptr.To[int32](0), infra: 100, operation: hibernatorv1alpha1.OperationHibernate},
+		{name: "wakeup is not limited", planLimit: ptr.To[int32](20), operation: hibernatorv1alpha1.OperationWakeUp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+			plan.Spec.Behavior.MaxResourcesPerTarget = tt.planLimit
+			target := &hibernatorv1alpha1.Target{Name: "db", Type: "rds"}
+			c := newHandlerFakeClient(plan)
+			st := newHandlerState(plan, c)
+
+			err := st.createRunnerJob(context.Background(), st.Log, st.Clock, plan, target, tt.operation, ExecutorInfra{MaxResourcesPerTarget: tt.infra})
+			require.NoError(t, err)
+
+			var jobs batchv1.JobList
+			require.NoError(t, c.List(context.Background(), &jobs, client.InNamespace("default")))
+			require.Len(t, jobs.Items, 1)
+			var got string
+			for _, env := range jobs.Items[0].Spec.Template.Spec.Containers[0].Env {
+				if env.Name == "HIBERNATOR_MAX_RESOURCES" {
+					got = env.Value
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// gcpFederationAudience() / addGCPFederationToken()
+// ---------------------------------------------------------------------------
+
+func TestGCPFederationAudience(t *testing.T) {
+	const audience = "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod"
+
+	gcp := &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "gcp", Namespace: "default"},
+		Spec: hibernatorv1alpha1.CloudProviderSpec{
+			Type: hibernatorv1alpha1.CloudProviderGCP,
+			GCP: &hibernatorv1alpha1.GCPConfig{
+				ProjectID: "my-project",
+				Auth: hibernatorv1alpha1.GCPAuth{
+					WorkloadIdentityFederation: &hibernatorv1alpha1.GCPWorkloadIdentityFederation{Audience: audience},
+				},
+			},
+		},
+	}
+	aws := &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "default"},
+		Spec:       hibernatorv1alpha1.CloudProviderSpec{Type: hibernatorv1alpha1.CloudProviderAWS},
+	}
+	gke := &hibernatorv1alpha1.K8SCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "gke", Namespace: "default"},
+		Spec: hibernatorv1alpha1.K8SClusterSpec{
+			ProviderRef: &hibernatorv1alpha1.ProviderRef{Name: "gcp"},
+			GKE:         &hibernatorv1alpha1.GKEConfig{Name: "prod", Project: "my-project", Location: "us-central1"},
+		},
+	}
+
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	st := newHandlerState(plan, newHandlerFakeClient(plan, gcp, aws, gke))
+
+	tests := []struct {
+		name string
+		ref  hibernatorv1alpha1.ConnectorRef
+		want string
+	}{
+		{name: "gcp cloud provider", ref: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "gcp"}, want: audience},
+		{name: "aws cloud provider", ref: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+		{name: "gke cluster through providerRef", ref: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "gke"}, want: audience},
+		{name: "missing connector", ref: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "missing"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &hibernatorv1alpha1.Target{Name: "t", ConnectorRef: tt.ref}
+			assert.Equal(t, tt.want, st.gcpFederationAudience(context.Background(), "default", target))
+		})
+	}
+}
+
+func TestAddGCPFederationToken(t *testing.T) {
+	spec := &corev1.PodSpec{Containers: []corev1.Container{{Name: "runner"}}}
+
+	addGCPFederationToken(spec, "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod")
+
+	require.Len(t, spec.Volumes, 1)
+	projection := spec.Volumes[0].Projected.Sources[0].ServiceAccountToken
+	require.NotNil(t, projection)
+	assert.Equal(t, "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod", projection.Audience)
+	assert.Equal(t, int64(wellknown.GCPFederationTokenExpirationSeconds), *projection.ExpirationSeconds)
+
+	require.Len(t, spec.Containers[0].VolumeMounts, 1)
+	assert.Equal(t, wellknown.GCPFederationTokenMountPath, spec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Equal(t, spec.Volumes[0].Name, spec.Containers[0].VolumeMounts[0].Name)
+}
+
+// ---------------------------------------------------------------------------
+// StreamTokenConfig
+// ---------------------------------------------------------------------------
+
+func TestStreamTokenConfig_Defaults(t *testing.T) {
+	def := StreamTokenConfig{}.withDefaults()
+	assert.Equal(t, wellknown.StreamTokenAudience, def.Audience)
+	assert.Equal(t, 10*time.Minute, def.Expiration)
+	assert.Equal(t, "/var/run/secrets/stream/token", def.TokenPath())
+
+	custom := StreamTokenConfig{Audience: "hibernator-v2", Expiration: time.Hour, MountPath: "/run/hibernator"}.withDefaults()
+	assert.Equal(t, "hibernator-v2", custom.Audience)
+	assert.Equal(t, time.Hour, custom.Expiration)
+	assert.Equal(t, "/run/hibernator/token", custom.TokenPath())
+}
+
+// ---------------------------------------------------------------------------
+// State.checkRunnerLiveness()
+// ---------------------------------------------------------------------------
+
+func newLivenessTestJob(now time.Time, lastHeartbeat string) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runner-db",
+			Namespace: "default",
+			Labels: map[string]string{
+				wellknown.LabelTarget:   "db",
+				wellknown.LabelExecutor: "rds",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"job-name": "runner-db"}},
+			},
+		},
+		Status: batchv1.JobStatus{Active: 1, StartTime: &metav1.Time{Time: now.Add(-10 * time.Minute)}},
+	}
+	if lastHeartbeat != "" {
+		job.Annotations = map[string]string{wellknown.AnnotationLastHeartbeat: lastHeartbeat}
+	}
+	return job
+}
+
+func newLivenessTestPod(name string, created time.Time, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            map[string]string{"job-name": "runner-db"},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestUpdateExecutionStatuses_RunnerLiveness(t *testing.T) {
+	tests := []struct {
+		name          string
+		lastHeartbeat time.Duration
+		noHeartbeat   bool
+		timeout       time.Duration
+		wantStale     bool
+	}{
+		{name: "recent heartbeat", lastHeartbeat: time.Minute, timeout: 3 * time.Minute},
+		{name: "heartbeat older than timeout", lastHeartbeat: 5 * time.Minute, timeout: 3 * time.Minute, wantStale: true},
+		{name: "no heartbeat sent", noHeartbeat: true, timeout: 3 * time.Minute},
+		{name: "check disabled", lastHeartbeat: time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+			plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+			plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateRunning}}
+			c := newHandlerFakeClient(plan)
+			st := newHandlerState(plan, c)
+			st.ExecutorInfra.RunnerHeartbeatTimeout = tt.timeout
+			now := st.Clock.Now()
+
+			lastHeartbeat := now.Add(-tt.lastHeartbeat).UTC().Format(time.RFC3339)
+			if tt.noHeartbeat {
+				lastHeartbeat = ""
+			}
+			st.updateExecutionStatuses(context.Background(), st.Log, plan, []batchv1.Job{*newLivenessTestJob(now, lastHeartbeat)})
+
+			exec := plan.Status.Executions[0]
+			assert.Equal(t, hibernatorv1alpha1.StateRunning, exec.State)
+			assert.Equal(t, tt.wantStale, exec.Stale)
+			if tt.wantStale {
+				assert.Equal(t, "Runner has not sent a heartbeat since "+lastHeartbeat, exec.Message)
+			}
+		})
+	}
+}
+
+func TestUpdateExecutionStatuses_RestartsStaleRunner(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateRunning}}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+	st.ExecutorInfra.RunnerHeartbeatTimeout = 3 * time.Minute
+	st.ExecutorInfra.StaleRunnerRestartAfter = 5 * time.Minute
+	now := st.Clock.Now()
+
+	job := newLivenessTestJob(now, now.Add(-6*time.Minute).UTC().Format(time.RFC3339))
+	require.NoError(t, c.Create(context.Background(), job))
+	require.NoError(t, c.Create(context.Background(), newLivenessTestPod("hung", now.Add(-10*time.Minute), corev1.PodRunning)))
+	require.NoError(t, c.Create(context.Background(), newLivenessTestPod("failed", now.Add(-20*time.Minute), corev1.PodFailed)))
+	require.NoError(t, c.Create(context.Background(), newLivenessTestPod("replacement", now.Add(-time.Minute), corev1.PodRunning)))
+
+	st.updateExecutionStatuses(context.Background(), st.Log, plan, []batchv1.Job{*job})
+	assert.True(t, plan.Status.Executions[0].Stale)
+
+	var pods corev1.PodList
+	require.NoError(t, c.List(context.Background(), &pods, client.InNamespace("default")))
+	assert.ElementsMatch(t, []string{"failed", "replacement"}, lo.Map(pods.Items, func(p corev1.Pod, _ int) string { return p.Name }),
+		"only the pod that sent the last heartbeat is restarted")
+
+	var updated batchv1.Job
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(job), &updated))
+	assert.NotContains(t, updated.Annotations, wellknown.AnnotationLastHeartbeat)
+}
+
+func TestUpdateExecutionStatuses_ResourceProgress(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateRunning}}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+	now := st.Clock.Now()
+
+	job := newLivenessTestJob(now, "")
+	job.Annotations = map[string]string{
+		wellknown.AnnotationProgress: `{"completed":7,"total":24,"resource":"instance:db-7","message":"stopped 7/24 RDS resource(s)"}`,
+	}
+	st.updateExecutionStatuses(context.Background(), st.Log, plan, []batchv1.Job{*job})
+
+	assert.Equal(t, &hibernatorv1alpha1.ExecutionProgress{
+		Completed: 7,
+		Total:     24,
+		Resource:  "instance:db-7",
+		Message:   "stopped 7/24 RDS resource(s)",
+	}, plan.Status.Executions[0].Progress)
+
+	// A malformed annotation keeps the progress last read.
+	job.Annotations[wellknown.AnnotationProgress] = "{"
+	st.updateExecutionStatuses(context.Background(), st.Log, plan, []batchv1.Job{*job})
+	assert.Equal(t, int32(7), plan.Status.Executions[0].Progress.Completed)
+}
+
+func TestUpdateExecutionStatuses_ActiveDeadlineExceeded(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateRunning}}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+	now := st.Clock.Now()
+
+	job := newLivenessTestJob(now, "")
+	job.Status.Active = 0
+	job.Status.Conditions = []batchv1.JobCondition{{
+		Type:               batchv1.JobFailed,
+		Status:             corev1.ConditionTrue,
+		Reason:             batchv1.JobReasonDeadlineExceeded,
+		Message:            "Job was active longer than specified deadline",
+		LastTransitionTime: metav1.NewTime(now),
+	}}
+	st.updateExecutionStatuses(context.Background(), st.Log, plan, []batchv1.Job{*job})
+
+	exec := plan.Status.Executions[0]
+	assert.Equal(t, hibernatorv1alpha1.StateFailed, exec.State)
+	assert.Equal(t, "Runner exceeded the active deadline of the job policy: Job was active longer than specified deadline", exec.Message)
+	assert.Equal(t, hibernatorv1alpha1.FailureTimeout, exec.FailureReason)
+}
+
+func TestUpdateExecutionStatuses_FailureReason(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		want       hibernatorv1alpha1.FailureReason
+	}{
+		{name: "reported by the runner", annotation: "AuthError", want: hibernatorv1alpha1.FailureAuthError},
+		{name: "exceeded resource limit", annotation: "ResourceLimitExceeded", want: hibernatorv1alpha1.FailureResourceLimitExceeded},
+		{name: "unknown reason is ignored", annotation: "Flaky", want: ""},
+		{name: "not reported", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+			plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+			plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateRunning}}
+			c := newHandlerFakeClient(plan)
+			st := newHandlerState(plan, c)
+			now := st.Clock.Now()
+
+			job := newLivenessTestJob(now, "")
+			job.Status.Active = 0
+			job.Status.Conditions = []batchv1.JobCondition{{
+				Type:               batchv1.JobFailed,
+				Status:             corev1.ConditionTrue,
+				Reason:             batchv1.JobReasonBackoffLimitExceeded,
+				LastTransitionTime: metav1.NewTime(now),
+			}}
+			if tt.annotation != "" {
+				job.Annotations = map[string]string{wellknown.AnnotationFailureReason: tt.annotation}
+			}
+			st.updateExecutionStatuses(context.Background(), st.Log, plan, []batchv1.Job{*job})
+
+			exec := plan.Status.Executions[0]
+			assert.Equal(t, hibernatorv1alpha1.StateFailed, exec.State)
+			assert.Equal(t, tt.want, exec.FailureReason)
+		})
+	}
+}
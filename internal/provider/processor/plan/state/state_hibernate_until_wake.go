@@ -0,0 +1,149 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// hibernateUntilWakeExceptionSuffix names the extend exceptions generated for the
+// hibernate-until-wake annotation: <plan>-<suffix>-<unix seconds>.
+const hibernateUntilWakeExceptionSuffix = "until-wake"
+
+// allDayWindows covers every minute of every day, so a trigger exception with these
+// windows applies for its whole validity period.
+var allDayWindows = []hibernatorv1alpha1.OffHourWindow{{
+	Start:      "00:00",
+	End:        "00:00",
+	DaysOfWeek: []string{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"},
+}}
+
+// hibernateUntilWakeState handles the one-shot hibernate-until-wake annotation
+// (hibernator.ardikabs.com/hibernate-until-wake=true) on an Active plan.
+//
+// It records the intent as an extend ScheduleException owned by the plan, valid from
+// now until the schedule's next regular wake-up, and starts hibernation immediately.
+// Subsequent schedule evaluations see the exception and keep the plan Hibernated;
+// when it expires at the wake-up boundary, the base schedule wakes the plan as usual.
+//
+// The annotation is consumed once the exception exists, or when the request cannot
+// be honoured (plan not Active, no upcoming wake-up, exception rejected), so it
+// cannot loop. Transient API errors leave it in place for the next tick.
+type hibernateUntilWakeState struct {
+	*idleState
+}
+
+func (s *hibernateUntilWakeState) Handle(ctx context.Context) (StateResult, error) {
+	plan := s.PlanCtx.Plan
+	log := s.Log.
+		WithName("hibernate-until-wake").
+		WithValues(
+			"plan", s.Key.String(),
+			"phase", plan.Status.Phase,
+		)
+
+	if plan.Status.Phase != hibernatorv1alpha1.PhaseActive {
+		log.Info("hibernate-until-wake: plan is not Active; no-op",
+			"hint", "the plan is already hibernated and wakes on its regular schedule")
+		return StateResult{}, s.consumeTrigger(ctx, wellknown.AnnotationHibernateUntilWake)
+	}
+
+	sched := s.PlanCtx.Schedule
+	now := s.Clock.Now()
+	if sched == nil || !sched.NextWakeUp.After(now) {
+		log.Info("hibernate-until-wake: schedule has no upcoming wake-up; no-op")
+		return StateResult{}, s.consumeTrigger(ctx, wellknown.AnnotationHibernateUntilWake)
+	}
+
+	name, ok, err := s.createTriggerException(ctx, log, hibernatorv1alpha1.ExceptionExtend, hibernateUntilWakeExceptionSuffix, sched.NextWakeUp)
+	if err != nil {
+		return StateResult{}, err
+	}
+	if err := s.consumeTrigger(ctx, wellknown.AnnotationHibernateUntilWake); err != nil {
+		return StateResult{}, err
+	}
+	if !ok {
+		return StateResult{}, nil
+	}
+
+	log.Info("hibernate-until-wake: hibernating until next scheduled wake-up",
+		"exception", name,
+		"wakeUp", sched.NextWakeUp.Format(time.RFC3339))
+	return s.transitionToHibernating(ctx, log, false)
+}
+
+// createTriggerException creates the ScheduleException of the given type that backs a
+// one-shot manual trigger, valid from now until the given time. It reports false when
+// the API server rejects the exception, in which case the trigger cannot be honoured.
+// An exception left behind by an earlier attempt of the same tick counts as created.
+func (s *idleState) createTriggerException(ctx context.Context, log logr.Logger, typ hibernatorv1alpha1.ExceptionType, suffix string, until time.Time) (string, bool, error) {
+	exc, err := s.buildTriggerException(s.PlanCtx.Plan, typ, suffix, s.Clock.Now(), until)
+	if err != nil {
+		return "", false, err
+	}
+
+	if err := s.Create(ctx, exc); err != nil {
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			log.V(1).Info("exception already exists", "exception", exc.Name)
+		case apierrors.IsInvalid(err) || apierrors.IsForbidden(err):
+			log.Error(err, "exception rejected; no-op", "exception", exc.Name)
+			return exc.Name, false, nil
+		default:
+			return "", false, fmt.Errorf("failed to create exception %s: %w", exc.Name, err)
+		}
+	}
+	return exc.Name, true, nil
+}
+
+// buildTriggerException returns an all-day exception of the given type for plan,
+// valid from now until the given time and owned by the plan.
+func (s *idleState) buildTriggerException(plan *hibernatorv1alpha1.HibernatePlan, typ hibernatorv1alpha1.ExceptionType, suffix string, now, until time.Time) (*hibernatorv1alpha1.ScheduleException, error) {
+	exc := &hibernatorv1alpha1.ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-%d", plan.Name, suffix, now.Unix()),
+			Namespace: plan.Namespace,
+			Labels: map[string]string{
+				wellknown.LabelPlan: plan.Name,
+			},
+		},
+		Spec: hibernatorv1alpha1.ScheduleExceptionSpec{
+			PlanRef:    hibernatorv1alpha1.PlanReference{Name: plan.Name},
+			ValidFrom:  metav1.NewTime(now.Truncate(time.Second)),
+			ValidUntil: metav1.NewTime(until),
+			Type:       typ,
+			Windows:    allDayWindows,
+		},
+	}
+
+	// Owned by the plan so it is garbage collected together with it.
+	if err := controllerutil.SetControllerReference(plan, exc, s.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference on exception: %w", err)
+	}
+	return exc, nil
+}
+
+// consumeTrigger removes a one-shot trigger annotation from the plan.
+func (s *idleState) consumeTrigger(ctx context.Context, key string) error {
+	plan := s.PlanCtx.Plan
+	orig := plan.DeepCopy()
+	delete(plan.Annotations, key)
+	if err := s.patchAndPreserveStatus(ctx, plan, client.MergeFrom(orig)); err != nil {
+		return fmt.Errorf("failed to consume %s annotation: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/message"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// ---------------------------------------------------------------------------
+// hibernateUntilWakeState — one-shot hibernation until the next scheduled wake-up
+// ---------------------------------------------------------------------------
+
+func TestNew_HibernateUntilWakeAnnotation_PhaseActive_ReturnsHibernateUntilWakeState(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Annotations = map[string]string{wellknown.AnnotationHibernateUntilWake: "true"}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+
+	h := New(st.Key, st.PlanCtx, buildTestConfig(c))
+	require.NotNil(t, h)
+	_, ok := h.(*hibernateUntilWakeState)
+	assert.True(t, ok, "expected *hibernateUntilWakeState for PhaseActive + hibernate-until-wake annotation")
+}
+
+func TestHibernateUntilWakeState_FromActive_CreatesExceptionAndHibernates(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Annotations = map[string]string{wellknown.AnnotationHibernateUntilWake: "true"}
+	st := newOverrideActionState(plan, nil, false)
+	wakeUp := st.Clock.Now().Add(36 * time.Hour).Truncate(time.Second)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{NextWakeUp: wakeUp}
+	h := &hibernateUntilWakeState{idleState: &idleState{state: st}}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, result.Requeue)
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, plan.Status.Phase)
+	assert.NotContains(t, plan.Annotations, wellknown.AnnotationHibernateUntilWake,
+		"annotation must be consumed (one-shot)")
+
+	var list hibernatorv1alpha1.ScheduleExceptionList
+	require.NoError(t, st.List(context.Background(), &list, client.InNamespace("default")))
+	require.Len(t, list.Items, 1)
+
+	exc := list.Items[0]
+	assert.Equal(t, hibernatorv1alpha1.ExceptionExtend, exc.Spec.Type)
+	assert.Equal(t, "p", exc.Spec.PlanRef.Name)
+	assert.Equal(t, "p", exc.Labels[wellknown.LabelPlan])
+	assert.True(t, exc.Spec.ValidUntil.Time.Equal(wakeUp), "exception must expire at the next wake-up")
+	assert.False(t, exc.Spec.ValidFrom.After(st.Clock.Now()))
+	assert.Equal(t, allDayWindows, exc.Spec.Windows)
+	require.Len(t, exc.OwnerReferences, 1)
+	assert.Equal(t, "p", exc.OwnerReferences[0].Name)
+}
+
+func TestHibernateUntilWakeState_ExceptionAlreadyExists_StillHibernates(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Annotations = map[string]string{wellknown.AnnotationHibernateUntilWake: "true"}
+	st := newOverrideActionState(plan, nil, false)
+	now := st.Clock.Now()
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{NextWakeUp: now.Add(time.Hour)}
+	h := &hibernateUntilWakeState{idleState: &idleState{state: st}}
+
+	existing, err := h.buildTriggerException(plan, hibernatorv1alpha1.ExceptionExtend, hibernateUntilWakeExceptionSuffix, now, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, st.Create(context.Background(), existing))
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, result.Requeue, "a retried tick must still start hibernation")
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, plan.Status.Phase)
+	assert.NotContains(t, plan.Annotations, wellknown.AnnotationHibernateUntilWake)
+}
+
+func TestHibernateUntilWakeState_Noops(t *testing.T) {
+	tests := []struct {
+		name     string
+		phase    hibernatorv1alpha1.PlanPhase
+		schedule func(now time.Time) *message.ScheduleEvaluation
+	}{
+		{
+			name:  "already hibernated",
+			phase: hibernatorv1alpha1.PhaseHibernated,
+			schedule: func(now time.Time) *message.ScheduleEvaluation {
+				return &message.ScheduleEvaluation{NextWakeUp: now.Add(time.Hour)}
+			},
+		},
+		{
+			name:     "no schedule evaluation",
+			phase:    hibernatorv1alpha1.PhaseActive,
+			schedule: func(time.Time) *message.ScheduleEvaluation { return nil },
+		},
+		{
+			name:  "no upcoming wake-up",
+			phase: hibernatorv1alpha1.PhaseActive,
+			schedule: func(time.Time) *message.ScheduleEvaluation {
+				return &message.ScheduleEvaluation{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", tt.phase)
+			plan.Annotations = map[string]string{wellknown.AnnotationHibernateUntilWake: "true"}
+			st := newOverrideActionState(plan, nil, false)
+			st.PlanCtx.Schedule = tt.schedule(st.Clock.Now())
+			h := &hibernateUntilWakeState{idleState: &idleState{state: st}}
+
+			result, err := h.Handle(context.Background())
+			require.NoError(t, err)
+
+			assert.False(t, result.Requeue)
+			assert.Equal(t, tt.phase, plan.Status.Phase)
+			assert.NotContains(t, plan.Annotations, wellknown.AnnotationHibernateUntilWake,
+				"annotation must be consumed even when the request is not honoured")
+
+			var list hibernatorv1alpha1.ScheduleExceptionList
+			require.NoError(t, st.List(context.Background(), &list))
+			assert.Empty(t, list.Items)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
 	"github.com/ardikabs/hibernator/internal/notification"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/scheduler"
@@ -42,7 +43,9 @@ func (state *hibernatingState) Handle(ctx context.Context) (StateResult, error)
 
 	return state.execute(ctx, log, hibernatorv1alpha1.OperationHibernate, false,
 		func(nextIdx int) { state.nextStage(nextIdx) },
-		func(ctx context.Context, ep scheduler.ExecutionPlan) { state.finalize(ctx, log, ep) },
+		func(ctx context.Context, ep scheduler.ExecutionPlan, abortReason string) {
+			state.finalize(ctx, log, ep, abortReason)
+		},
 	)
 }
 
@@ -55,25 +58,34 @@ func (state *hibernatingState) OnError(ctx context.Context, err error) StateResu
 	var pe *PlanError
 	if errors.As(err, &pe) {
 		plan := state.plan()
-		if hasExecutionProgress(plan) {
+		var timeout *cycleTimeoutError
+		aborted := errors.As(err, &timeout)
+		if hasExecutionProgress(plan) || aborted {
 			summary := BuildOperationSummary(state.Clock, plan, hibernatorv1alpha1.OperationHibernate)
+			if aborted {
+				summary.Success = false
+				summary.AbortReason = timeout.Error()
+			}
 			currentCycleID := plan.Status.CurrentCycleID
 
+			var pruned []hibernatorv1alpha1.ExecutionCycle
 			state.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
 				NamespacedName: state.Key,
 				Resource:       plan,
 				Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
 					cycleIdx := findOrAppendCycle(&p.Status, currentCycleID)
+					stampCostAllocation(&p.Status.ExecutionHistory[cycleIdx], p, state.CostAllocation)
 					p.Status.ExecutionHistory[cycleIdx].ShutdownExecution = summary
-					pruneCycleHistory(&p.Status)
+					pruned = pruneCycleHistory(&p.Status, historyLimit(p))
 				}),
+				PostHook: state.archiveHook(&pruned),
 			})
 		}
 	}
 	return state.state.OnError(ctx, err)
 }
 
-func (state *hibernatingState) finalize(_ context.Context, log logr.Logger, _ scheduler.ExecutionPlan) {
+func (state *hibernatingState) finalize(_ context.Context, log logr.Logger, _ scheduler.ExecutionPlan, abortReason string) {
 	plan := state.plan()
 
 	if !IsOperationComplete(plan) {
@@ -84,9 +96,11 @@ func (state *hibernatingState) finalize(_ context.Context, log logr.Logger, _ sc
 	log.Info("all stages completed, finalizing shutdown operation")
 
 	summary := BuildOperationSummary(state.Clock, plan, hibernatorv1alpha1.OperationHibernate)
+	summary.AbortReason = abortReason
 	currentCycleID := plan.Status.CurrentCycleID
 
 	previousPhase := plan.Status.Phase
+	var pruned []hibernatorv1alpha1.ExecutionCycle
 	state.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
 		NamespacedName: state.Key,
 		Resource:       plan,
@@ -95,8 +109,9 @@ func (state *hibernatingState) finalize(_ context.Context, log logr.Logger, _ sc
 			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(state.Clock.Now()))
 
 			cycleIdx := findOrAppendCycle(&p.Status, currentCycleID)
+			stampCostAllocation(&p.Status.ExecutionHistory[cycleIdx], p, state.CostAllocation)
 			p.Status.ExecutionHistory[cycleIdx].ShutdownExecution = summary
-			pruneCycleHistory(&p.Status)
+			pruned = pruneCycleHistory(&p.Status, historyLimit(p))
 
 			p.Status.RetryCount = 0
 			p.Status.LastRetryTime = nil
@@ -108,6 +123,9 @@ func (state *hibernatingState) finalize(_ context.Context, log logr.Logger, _ sc
 				return buildPayload(p, hibernatorv1alpha1.EventSuccess, state.Clock.Now)
 			}),
 			state.phaseChangePostHook(previousPhase),
+			state.operationEventHook(hibernatorv1alpha1.EventSuccess, hibernatorv1alpha1.OperationHibernate),
+			state.auditHook(previousPhase, audit.TriggerExecution, ""),
+			state.archiveHook(&pruned),
 		),
 	})
 }
@@ -0,0 +1,33 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// archiveHook returns a PostHook that archives the cycles the mutator pruned
+// from the written plan's execution history into pruned, when the plan sets
+// spec.executionHistory.archive. Returns nil when no archiver is configured.
+func (s *state) archiveHook(pruned *[]hibernatorv1alpha1.ExecutionCycle) func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	if s.Archiver == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
+		if len(*pruned) == 0 || plan.Spec.ExecutionHistory == nil || !plan.Spec.ExecutionHistory.Archive {
+			return nil
+		}
+
+		if err := s.Archiver.Archive(ctx, plan, *pruned, s.Clock.Now()); err != nil {
+			return fmt.Errorf("archive execution history: %w", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+)
+
+type fakeArchiver struct {
+	cycles []hibernatorv1alpha1.ExecutionCycle
+}
+
+func (f *fakeArchiver) Archive(_ context.Context, _ *hibernatorv1alpha1.HibernatePlan, cycles []hibernatorv1alpha1.ExecutionCycle, _ time.Time) error {
+	f.cycles = append(f.cycles, cycles...)
+	return nil
+}
+
+func planWithHistory(spec *hibernatorv1alpha1.ExecutionHistorySpec, cycleIDs ...string) *hibernatorv1alpha1.HibernatePlan {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Spec.ExecutionHistory = spec
+	for _, id := range cycleIDs {
+		plan.Status.ExecutionHistory = append(plan.Status.ExecutionHistory, hibernatorv1alpha1.ExecutionCycle{CycleID: id})
+	}
+	plan.Status.CurrentCycleID = "new"
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "db", State: hibernatorv1alpha1.StateCompleted},
+	}
+	return plan
+}
+
+func TestHibernatingState_Finalize_ArchivesPrunedCycles(t *testing.T) {
+	plan := planWithHistory(&hibernatorv1alpha1.ExecutionHistorySpec{Limit: 2, Archive: true}, "c1", "c2")
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	archiver := &fakeArchiver{}
+	st.Archiver = archiver
+
+	// Send mutates plan eagerly; the status writer applies the update to the stored plan.
+	stored := plan.DeepCopy()
+	h := &hibernatingState{state: st}
+	h.finalize(context.Background(), st.Log, scheduler.ExecutionPlan{}, "")
+	applyNextUpdate(t, st, stored)
+
+	require.Len(t, stored.Status.ExecutionHistory, 2)
+	assert.Equal(t, "c2", stored.Status.ExecutionHistory[0].CycleID)
+	assert.Equal(t, "new", stored.Status.ExecutionHistory[1].CycleID)
+
+	require.Len(t, archiver.cycles, 1)
+	assert.Equal(t, "c1", archiver.cycles[0].CycleID)
+}
+
+func TestHibernatingState_Finalize_ArchiveDisabled(t *testing.T) {
+	plan := planWithHistory(&hibernatorv1alpha1.ExecutionHistorySpec{Limit: 1}, "c1")
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	archiver := &fakeArchiver{}
+	st.Archiver = archiver
+
+	stored := plan.DeepCopy()
+	h := &hibernatingState{state: st}
+	h.finalize(context.Background(), st.Log, scheduler.ExecutionPlan{}, "")
+	applyNextUpdate(t, st, stored)
+
+	require.Len(t, stored.Status.ExecutionHistory, 1)
+	assert.Equal(t, "new", stored.Status.ExecutionHistory[0].CycleID)
+	assert.Empty(t, archiver.cycles)
+}
+
+func TestHistoryLimit(t *testing.T) {
+	assert.Equal(t, 5, historyLimit(planWithHistory(nil)))
+	assert.Equal(t, 5, historyLimit(planWithHistory(&hibernatorv1alpha1.ExecutionHistorySpec{Archive: true})))
+	assert.Equal(t, 12, historyLimit(planWithHistory(&hibernatorv1alpha1.ExecutionHistorySpec{Limit: 12})))
+}
@@ -7,6 +7,7 @@ package state
 
 import (
 	"context"
+	"time"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/notification"
@@ -21,6 +22,10 @@ import (
 // schedule result and driving Active→Hibernating and Hibernated→WakingUp transitions.
 type idleState struct {
 	*state
+
+	// manualSource names the annotation a user set to request the
+	// transitions of this handler, empty when the schedule drives them.
+	manualSource string
 }
 
 func (state *idleState) Handle(ctx context.Context) (StateResult, error) {
@@ -42,6 +47,15 @@ func (state *idleState) Handle(ctx context.Context) (StateResult, error) {
 	switch plan.Status.Phase {
 	case hibernatorv1alpha1.PhaseActive:
 		if shouldHibernate {
+			if result, deferred := state.deferForBlackout(log, "hibernation"); deferred {
+				return result, nil
+			}
+			if remaining, minimum := state.hibernationTooShort(); minimum > 0 {
+				log.Info("hibernation window shorter than the minimum, staying Active",
+					"untilWakeUp", remaining, "minimumHibernation", minimum)
+				return StateResult{}, nil
+			}
+
 			log.Info("schedule indicates hibernation, transitioning to Hibernating")
 			return state.transitionToHibernating(ctx, log, false)
 		}
@@ -51,17 +65,84 @@ func (state *idleState) Handle(ctx context.Context) (StateResult, error) {
 	case hibernatorv1alpha1.PhaseHibernated:
 		if !shouldHibernate {
 			if planCtx.HasRestoreData {
+				if result, deferred := state.deferForBlackout(log, "wake-up"); deferred {
+					return result, nil
+				}
 				log.Info("schedule indicates wake-up, transitioning to WakingUp")
 				return state.transitionToWakingUp(log)
 			}
 			log.Info("schedule indicates wake-up but no restore data found, skipping")
 		} else {
 			log.V(1).Info("schedule indicates hibernation period, staying Hibernated")
+			if result, deferred := state.deferForBlackout(log, "pre-wake hooks"); deferred {
+				return result, nil
+			}
+
+			due, untilReassert := state.reassertDue()
+			if due {
+				log.Info("reassert interval elapsed, reasserting hibernation",
+					"reassertInterval", state.effectivePlan(plan).Spec.Behavior.ReassertInterval)
+				untilReassert = state.reassert(ctx, log)
+			}
+
+			result := state.dispatchPreWakeHooks(ctx, log)
+			if untilReassert > 0 && (result.RequeueAfter == 0 || untilReassert < result.RequeueAfter) {
+				result.RequeueAfter = untilReassert
+			}
+			return result, nil
 		}
 	}
 	return StateResult{}, nil
 }
 
+// deferForBlackout reports whether a blackout window of the plan covers now,
+// returning a requeue for when the blackout, and any overlapping one, ends.
+func (state *idleState) deferForBlackout(log logr.Logger, operation string) (StateResult, bool) {
+	now := state.Clock.Now()
+	windows := state.plan().Spec.Schedule.BlackoutWindows
+
+	var names []string
+	until := now
+	for extended := true; extended; {
+		extended = false
+		for _, w := range windows {
+			if w.Active(until) {
+				names = append(names, w.Name)
+				until = w.End.Time
+				extended = true
+			}
+		}
+	}
+	if until.Equal(now) {
+		return StateResult{}, false
+	}
+
+	log.Info("blackout window active, deferring "+operation,
+		"blackouts", names, "until", until.Format(time.RFC3339))
+	return StateResult{RequeueAfter: until.Sub(now)}, true
+}
+
+// hibernationTooShort reports whether the time left until the next wakeup is
+// shorter than the plan's minimum hibernation, returning that time and the
+// minimum when it is. The minimum is zero when hibernation should proceed.
+func (state *idleState) hibernationTooShort() (remaining, minimum time.Duration) {
+	plan := state.plan()
+	nextWakeUp := state.PlanCtx.Schedule.NextWakeUp
+	if plan.Spec.Schedule.MinimumHibernation == "" || nextWakeUp.IsZero() {
+		return 0, 0
+	}
+
+	minimum, err := time.ParseDuration(plan.Spec.Schedule.MinimumHibernation)
+	if err != nil {
+		return 0, 0
+	}
+	remaining = nextWakeUp.Sub(state.Clock.Now())
+	if remaining >= minimum {
+		return 0, 0
+	}
+	return remaining, minimum
+}
+
 // transitionToHibernating initialises the shutdown operation, queues a status update,
 // and returns Requeue so the worker immediately drives the Hibernating phase handler.
 //
@@ -71,6 +152,13 @@ func (state *idleState) Handle(ctx context.Context) (StateResult, error) {
 func (state *idleState) transitionToHibernating(ctx context.Context, log logr.Logger, fresh bool) (StateResult, error) {
 	plan := state.plan()
 
+	// Starting a cycle would lock an incomplete target list into its snapshot.
+	// The provider re-delivers the plan once its targets resolve.
+	if state.PlanCtx.TargetsError != "" {
+		log.Info("targets unresolved, not starting hibernation", "error", state.PlanCtx.TargetsError)
+		return StateResult{}, nil
+	}
+
 	var cycleID string
 	if fresh {
 		// Fresh cycle: ignore any existing live restore data cycle ID and start anew.
@@ -100,10 +188,27 @@ func (state *idleState) transitionToHibernating(ctx context.Context, log logr.Lo
 		}
 	}
 
+	// Lock the cycle intent on every cycle, not only when an exception override applies,
+	// so spec edits made during the operation are deferred to the next cycle. A non-fresh
+	// restart of the same cycle without an override keeps its existing snapshot.
+	var snapshot *hibernatorv1alpha1.PlanSnapshot
+	targets := effectivePlan.Spec.Targets
+	if snap := plan.Status.PlanSnapshot; !fresh && appliedExceptionName == "" && snap != nil && snap.CycleID == cycleID {
+		targets = snap.Targets
+	} else {
+		snapshot = &hibernatorv1alpha1.PlanSnapshot{
+			CycleID:       cycleID,
+			ExceptionName: appliedExceptionName,
+			Targets:       effectivePlan.Spec.Targets,
+			Execution:     effectivePlan.Spec.Execution,
+			Behavior:      effectivePlan.Spec.Behavior,
+		}
+	}
+
 	now := state.Clock.Now()
 
-	executions := make([]hibernatorv1alpha1.ExecutionStatus, len(effectivePlan.Spec.Targets))
-	for i, t := range effectivePlan.Spec.Targets {
+	executions := make([]hibernatorv1alpha1.ExecutionStatus, len(targets))
+	for i, t := range targets {
 		executions[i] = hibernatorv1alpha1.ExecutionStatus{
 			Target:   t.Name,
 			Executor: t.Type,
@@ -124,14 +229,8 @@ func (state *idleState) transitionToHibernating(ctx context.Context, log logr.Lo
 			p.Status.Executions = executions
 			p.Status.AppliedExceptionOverride = appliedExceptionName
 			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(now))
-			if appliedExceptionName != "" {
-				p.Status.PlanSnapshot = &hibernatorv1alpha1.PlanSnapshot{
-					CycleID:       cycleID,
-					ExceptionName: appliedExceptionName,
-					Targets:       effectivePlan.Spec.Targets,
-					Execution:     effectivePlan.Spec.Execution,
-					Behavior:      effectivePlan.Spec.Behavior,
-				}
+			if snapshot != nil {
+				p.Status.PlanSnapshot = snapshot
 			}
 		}),
 		PostHook: chainHooks(
@@ -139,6 +238,8 @@ func (state *idleState) transitionToHibernating(ctx context.Context, log logr.Lo
 				return buildPayload(p, hibernatorv1alpha1.EventStart, state.Clock.Now)
 			}),
 			state.phaseChangePostHook(previousPhase),
+			state.operationEventHook(hibernatorv1alpha1.EventStart, hibernatorv1alpha1.OperationHibernate),
+			state.transitionAuditHook(previousPhase),
 		),
 	})
 
@@ -201,6 +302,8 @@ func (state *idleState) transitionToWakingUp(log logr.Logger) (StateResult, erro
 				return buildPayload(p, hibernatorv1alpha1.EventStart, state.Clock.Now)
 			}),
 			state.phaseChangePostHook(previousPhase),
+			state.operationEventHook(hibernatorv1alpha1.EventStart, hibernatorv1alpha1.OperationWakeUp),
+			state.transitionAuditHook(previousPhase),
 		),
 	})
 
@@ -237,3 +340,14 @@ func (state *idleState) getExistingCycleIDForHibernation(ctx context.Context, lo
 
 	return ""
 }
+
+// transitionAuditHook returns the audit PostHook of the transitions of the
+// handler: attributed to the user who set the annotation requesting them, or
+// else to the schedule.
+func (state *idleState) transitionAuditHook(previousPhase hibernatorv1alpha1.PlanPhase) func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	if state.manualSource != "" {
+		return state.manualAuditHook(previousPhase, state.manualSource)
+	}
+	trigger, actor := state.scheduleTrigger()
+	return state.auditHook(previousPhase, trigger, actor)
+}
@@ -8,14 +8,19 @@ package state
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ardikabs/hibernator/internal/restore"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/message"
+	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
 // newIdleState wires an idle-state State with the supplied ScheduleResult.
@@ -78,6 +83,92 @@ func TestIdleState_Handle_ActiveShouldNotHibernate_NoTransition(t *testing.T) {
 	assert.Zero(t, planStatuses(st).Len())
 }
 
+func TestIdleState_Handle_ActiveShouldHibernate_UnresolvedTargets_NoTransition(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategySequential
+	sr := &message.ScheduleEvaluation{ShouldHibernate: true}
+	st := newIdleState(plan, sr, false)
+	st.PlanCtx.TargetsError = "invalid targets: TargetPreset default/shared not found"
+	h := &idleState{state: st}
+
+	h.Handle(context.Background())
+
+	assert.Equal(t, hibernatorv1alpha1.PhaseActive, plan.Status.Phase)
+	assert.Zero(t, planStatuses(st).Len())
+}
+
+func TestIdleState_Handle_ActiveShouldHibernate_ShorterThanMinimum_NoTransition(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategySequential
+	plan.Spec.Schedule.MinimumHibernation = "1h"
+	st := newIdleState(plan, nil, false)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{
+		ShouldHibernate: true,
+		NextWakeUp:      st.Clock.Now().Add(20 * time.Minute),
+	}
+	h := &idleState{state: st}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, result)
+	assert.Equal(t, hibernatorv1alpha1.PhaseActive, plan.Status.Phase)
+	assert.Zero(t, planStatuses(st).Len())
+}
+
+func TestIdleState_Handle_ActiveShouldHibernate_LongerThanMinimum_TransitionsToHibernating(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategySequential
+	plan.Spec.Schedule.MinimumHibernation = "1h"
+	st := newIdleState(plan, nil, false)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{
+		ShouldHibernate: true,
+		NextWakeUp:      st.Clock.Now().Add(8 * time.Hour),
+	}
+	h := &idleState{state: st}
+
+	h.Handle(context.Background())
+
+	assert.NotEqual(t, hibernatorv1alpha1.PhaseActive, plan.Status.Phase)
+	assert.GreaterOrEqual(t, planStatuses(st).Len(), 1)
+}
+
+func TestIdleState_Handle_BlackoutDefersTransition(t *testing.T) {
+	tests := []struct {
+		name            string
+		phase           hibernatorv1alpha1.PlanPhase
+		shouldHibernate bool
+	}{
+		{name: "hibernation", phase: hibernatorv1alpha1.PhaseActive, shouldHibernate: true},
+		{name: "wake-up", phase: hibernatorv1alpha1.PhaseHibernated, shouldHibernate: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", tt.phase)
+			plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategySequential
+			st := newIdleState(plan, &message.ScheduleEvaluation{ShouldHibernate: tt.shouldHibernate}, true)
+			now := st.Clock.Now()
+			// Overlapping blackouts defer the transition until the last one ends.
+			plan.Spec.Schedule.BlackoutWindows = []hibernatorv1alpha1.BlackoutWindow{
+				{Name: "freeze", Start: metav1.NewTime(now.Add(-time.Hour)), End: metav1.NewTime(now.Add(time.Hour))},
+				{Name: "release", Start: metav1.NewTime(now.Add(30 * time.Minute)), End: metav1.NewTime(now.Add(3 * time.Hour))},
+				{Name: "past", Start: metav1.NewTime(now.Add(-48 * time.Hour)), End: metav1.NewTime(now.Add(-24 * time.Hour))},
+			}
+			h := &idleState{state: st}
+
+			result, err := h.Handle(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 3*time.Hour, result.RequeueAfter)
+			assert.Equal(t, tt.phase, plan.Status.Phase)
+			assert.Zero(t, planStatuses(st).Len())
+
+			plan.Spec.Schedule.BlackoutWindows = plan.Spec.Schedule.BlackoutWindows[2:]
+			h.Handle(context.Background())
+			assert.NotEqual(t, tt.phase, plan.Status.Phase, "the transition proceeds once no blackout is active")
+		})
+	}
+}
+
 func TestIdleState_Handle_HibernatedNoRestoreData_NoWakeUp(t *testing.T) {
 	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernated)
 	sr := &message.ScheduleEvaluation{ShouldHibernate: false}
@@ -103,6 +194,100 @@ func TestIdleState_Handle_HibernatedShouldStayHibernated(t *testing.T) {
 	assert.Zero(t, planStatuses(st).Len())
 }
 
+func TestIdleState_Handle_Hibernated_ReassertInterval(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernated)
+	plan.Spec.Behavior.ReassertInterval = "24h"
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+		{Name: "nodes", Type: "eks", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+	}
+	plan.Status.CurrentCycleID = "cycle-1"
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateCompleted},
+		{Target: "nodes", Executor: "eks", State: hibernatorv1alpha1.StateCompleted},
+	}
+	plan.Status.ExecutionHistory = []hibernatorv1alpha1.ExecutionCycle{{CycleID: "cycle-1"}}
+	st := newIdleState(plan, &message.ScheduleEvaluation{ShouldHibernate: true}, true)
+	plan.Status.LastTransitionTime = &metav1.Time{Time: st.Clock.Now().Add(-20 * time.Hour)}
+
+	spy := &spyNotifier{}
+	st.Notifier = spy
+	st.PlanCtx.Notifications = []hibernatorv1alpha1.HibernateNotification{{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1", Namespace: "default"},
+		Spec: hibernatorv1alpha1.HibernateNotificationSpec{
+			OnEvents: []hibernatorv1alpha1.NotificationEvent{hibernatorv1alpha1.EventStart, hibernatorv1alpha1.EventSuccess},
+			Sinks: []hibernatorv1alpha1.NotificationSink{{
+				Name:      "slack",
+				Type:      hibernatorv1alpha1.SinkSlack,
+				SecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "s1"},
+			}},
+		},
+	}}
+
+	require.NoError(t, st.RestoreManager.Save(context.Background(), plan.Namespace, plan.Name, "db", &restore.Data{
+		Target:   "db",
+		Executor: "rds",
+		IsLive:   true,
+		CycleID:  "cycle-1",
+		State:    map[string]any{"instance:db-1": map[string]any{"wasRunning": true}},
+	}))
+	before, err := st.RestoreManager.Load(context.Background(), plan.Namespace, plan.Name, "db")
+	require.NoError(t, err)
+
+	h := &idleState{state: st}
+	listReassertJobs := func() []batchv1.Job {
+		var jobs batchv1.JobList
+		require.NoError(t, st.List(context.Background(), &jobs,
+			client.MatchingLabels{wellknown.LabelOperation: string(hibernatorv1alpha1.OperationReassert)}))
+		return jobs.Items
+	}
+
+	// Before the interval passes, the plan stays Hibernated until it does.
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 4*time.Hour, result.RequeueAfter)
+	assert.Zero(t, planStatuses(st).Len())
+	assert.Empty(t, listReassertJobs())
+
+	// Once it has passed, only the target whose executor supports it is
+	// reasserted, and the plan stays Hibernated.
+	plan.Status.LastTransitionTime = &metav1.Time{Time: st.Clock.Now().Add(-25 * time.Hour)}
+	result, err = h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, result.RequeueAfter)
+
+	jobs := listReassertJobs()
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "db", jobs[0].Labels[wellknown.LabelTarget])
+	assert.Equal(t, "cycle-1", jobs[0].Labels[wellknown.LabelCycleID])
+
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernated, plan.Status.Phase)
+	assert.Equal(t, st.Clock.Now().Add(-25*time.Hour), plan.Status.LastTransitionTime.Time)
+	require.NotNil(t, plan.Status.LastReassertTime)
+	assert.Equal(t, st.Clock.Now(), plan.Status.LastReassertTime.Time)
+	assert.Equal(t, hibernatorv1alpha1.StateCompleted, plan.Status.Executions[0].State)
+	assert.Equal(t, []hibernatorv1alpha1.ExecutionCycle{{CycleID: "cycle-1"}}, plan.Status.ExecutionHistory)
+
+	// Restore data and notifications are left untouched.
+	for planStatuses(st).Len() > 0 {
+		upd := <-planStatuses(st).C()
+		if upd.PostHook != nil {
+			require.NoError(t, upd.PostHook(context.Background(), plan))
+		}
+	}
+	assert.Empty(t, spy.requests)
+	after, err := st.RestoreManager.Load(context.Background(), plan.Namespace, plan.Name, "db")
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+
+	// The next reassertion is due an interval after this one.
+	result, err = h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, result.RequeueAfter)
+	assert.Len(t, listReassertJobs(), 1)
+}
+
 func TestIdleState_TransitionToHibernating_StartNotificationUsesMutatedPendingTargets(t *testing.T) {
 	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
 	plan.Spec.Targets = []hibernatorv1alpha1.Target{
@@ -267,3 +452,86 @@ func TestIdleState_TransitionToHibernating_GeneratesNewCycleIDWhenNoLiveData(t *
 		"generated cycle ID should be 8 characters (UUID[:8])")
 	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, testPlan.Status.Phase)
 }
+
+func preWakePlan() *hibernatorv1alpha1.HibernatePlan {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernated)
+	plan.Status.CurrentCycleID = "cycle-1"
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{
+			Name:         "nodes",
+			Type:         "eks",
+			ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+			PreWake: &hibernatorv1alpha1.PreWakeHook{
+				LeadTime:   "15m",
+				Parameters: &hibernatorv1alpha1.Parameters{Raw: []byte(`{"warmNodes":2}`)},
+			},
+		},
+		{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+	}
+	return plan
+}
+
+func listPreWakeJobs(t *testing.T, st *state) []batchv1.Job {
+	t.Helper()
+	var jobs batchv1.JobList
+	require.NoError(t, st.List(context.Background(), &jobs,
+		client.MatchingLabels{wellknown.LabelOperation: string(hibernatorv1alpha1.OperationPreWake)}))
+	return jobs.Items
+}
+
+func TestIdleState_Handle_Hibernated_PreWakeDue_DispatchesHook(t *testing.T) {
+	plan := preWakePlan()
+	st := newIdleState(plan, nil, true)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{
+		ShouldHibernate: true,
+		NextWakeUp:      st.Clock.Now().Add(10 * time.Minute),
+	}
+	h := &idleState{state: st}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	jobs := listPreWakeJobs(t, st)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "nodes", jobs[0].Labels[wellknown.LabelTarget])
+	assert.Contains(t, jobs[0].Spec.Template.Spec.Containers[0].Env,
+		corev1.EnvVar{Name: "HIBERNATOR_PREWAKE_PARAMS", Value: `{"warmNodes":2}`})
+
+	require.NotNil(t, plan.Status.PreWake)
+	assert.Equal(t, "cycle-1", plan.Status.PreWake.CycleID)
+	assert.Equal(t, []string{"nodes"}, plan.Status.PreWake.Targets)
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernated, plan.Status.Phase)
+}
+
+func TestIdleState_Handle_Hibernated_PreWakeNotDue_RequeuesAtLeadTime(t *testing.T) {
+	plan := preWakePlan()
+	st := newIdleState(plan, nil, true)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{
+		ShouldHibernate: true,
+		NextWakeUp:      st.Clock.Now().Add(time.Hour),
+	}
+	h := &idleState{state: st}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 45*time.Minute, result.RequeueAfter)
+	assert.Empty(t, listPreWakeJobs(t, st))
+	assert.Zero(t, planStatuses(st).Len())
+}
+
+func TestIdleState_Handle_Hibernated_PreWakeAlreadyDispatched_Skips(t *testing.T) {
+	plan := preWakePlan()
+	plan.Status.PreWake = &hibernatorv1alpha1.PreWakeStatus{CycleID: "cycle-1", Targets: []string{"nodes"}}
+	st := newIdleState(plan, nil, true)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{
+		ShouldHibernate: true,
+		NextWakeUp:      st.Clock.Now().Add(5 * time.Minute),
+	}
+	h := &idleState{state: st}
+
+	_, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, listPreWakeJobs(t, st))
+	assert.Zero(t, planStatuses(st).Len())
+}
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/go-logr/logr"
@@ -102,6 +103,7 @@ func (state *lifecycleState) handle(ctx context.Context, log logr.Logger, plan *
 				p.Status.Phase = hibernatorv1alpha1.PhaseActive
 				p.Status.ObservedGeneration = plan.Generation
 			}),
+			PostHook: state.auditHook("", audit.TriggerController, ""),
 		})
 
 		// TODO: PrepareRestorePoint failure is treated as non-fatal
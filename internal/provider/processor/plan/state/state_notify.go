@@ -38,7 +38,7 @@ func (s *state) notifyHook(event hibernatorv1alpha1.NotificationEvent, payloadFn
 
 	return func(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
 		payload := payloadFn(plan)
-		enrichConnectorInfo(ctx, s.APIReader, plan.Namespace, payload.Targets)
+		enrichConnectorInfo(ctx, s.connectorReader(), plan.Namespace, payload.Targets)
 		for i := range notifications {
 			submitForNotification(ctx, s.Notifier, &notifications[i], event, payload)
 		}
@@ -61,7 +61,7 @@ func (s *state) phaseChangePostHook(previousPhase hibernatorv1alpha1.PlanPhase)
 	return func(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
 		payload := buildPayload(plan, hibernatorv1alpha1.EventPhaseChange, s.Clock.Now)
 		payload.PreviousPhase = string(previousPhase)
-		enrichConnectorInfo(ctx, s.APIReader, plan.Namespace, payload.Targets)
+		enrichConnectorInfo(ctx, s.connectorReader(), plan.Namespace, payload.Targets)
 		for i := range notifications {
 			submitForNotification(ctx, s.Notifier, &notifications[i], hibernatorv1alpha1.EventPhaseChange, payload)
 		}
@@ -257,7 +257,7 @@ func (s *state) executionProgressPostHook(
 
 	return func(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
 		payload := buildPayload(plan, hibernatorv1alpha1.EventExecutionProgress, s.Clock.Now)
-		enrichConnectorInfo(ctx, s.APIReader, plan.Namespace, payload.Targets)
+		enrichConnectorInfo(ctx, s.connectorReader(), plan.Namespace, payload.Targets)
 
 		for _, target := range payload.Targets {
 			prev, ok := prevSnapshot[target.Name]
@@ -32,12 +32,22 @@ import (
 // holds at the current execution phase indefinitely. Use the Job TTL or manual Job
 // deletion to unblock. When the deadline fires (onDeadline=true) the drain is
 // bypassed and the suspension is written immediately.
+//
+// Immediate suspension: with spec.suspendPolicy=Immediate there is no drain. The
+// unfinished runner Jobs of the current cycle are deleted, their executions are
+// marked StateCancelled, and the suspension is written right away. The execution
+// bookmark is otherwise kept as-is, so resumeFromExecution can re-dispatch the
+// cancelled targets when the plan resumes in the same window.
 type preSuspensionState struct {
 	*state
 }
 
 func (s *preSuspensionState) Handle(ctx context.Context) (StateResult, error) {
-	if s.isInExecutingPhase() {
+	if s.isInExecutingPhase() && s.plan().Spec.SuspendPolicy == hibernatorv1alpha1.SuspendImmediate {
+		if err := s.cancelExecutions(ctx); err != nil {
+			return StateResult{}, err
+		}
+	} else if s.isInExecutingPhase() {
 		drained, result, err := s.awaitExecutionDrain(ctx)
 		if err != nil {
 			return result, err
@@ -62,6 +72,59 @@ func (s *preSuspensionState) isInExecutingPhase() bool {
 	return phase == hibernatorv1alpha1.PhaseHibernating || phase == hibernatorv1alpha1.PhaseWakingUp
 }
 
+// cancelExecutions deletes the runner Jobs of the current cycle that have not
+// finished and marks their executions StateCancelled. Jobs that finished before
+// the deletion keep their recorded result.
+func (s *preSuspensionState) cancelExecutions(ctx context.Context) error {
+	log := s.Log.WithName("immediate-suspension")
+
+	plan := s.plan()
+	jobs, err := s.getCurrentCycleJobs(ctx, plan)
+	if err != nil {
+		return fmt.Errorf("failed to get current cycle jobs: %w", err)
+	}
+
+	s.updateExecutionStatuses(ctx, log, plan, jobs)
+
+	for i := range jobs {
+		job := &jobs[i]
+		if _, ok := job.Labels[wellknown.LabelStaleRunnerJob]; ok || isJobTerminal(job) {
+			continue
+		}
+		log.Info("deleting runner job for immediate suspension", "job", job.Name, "target", job.Labels[wellknown.LabelTarget])
+		if err := s.deleteRunnerJob(ctx, job); err != nil {
+			return fmt.Errorf("failed to delete runner job %s: %w", job.Name, err)
+		}
+	}
+
+	prevSnapshot := snapshotExecutionStates(plan.Status.Executions)
+	now := metav1.NewTime(s.Clock.Now())
+	cancel := func(executions []hibernatorv1alpha1.ExecutionStatus) {
+		for i := range executions {
+			if executions[i].State == hibernatorv1alpha1.StateRunning {
+				executions[i].State = hibernatorv1alpha1.StateCancelled
+				executions[i].Message = "Cancelled: runner job deleted by immediate suspension"
+				executions[i].FinishedAt = now.DeepCopy()
+			}
+		}
+	}
+
+	cancel(plan.Status.Executions)
+	if executionStatesEqual(prevSnapshot, plan.Status.Executions) {
+		return nil
+	}
+
+	s.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: s.Key,
+		Resource:       plan,
+		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+			cancel(p.Status.Executions)
+		}),
+		PostHook: s.executionProgressPostHook(prevSnapshot),
+	})
+	return nil
+}
+
 func (s *preSuspensionState) performSuspension(ctx context.Context) (StateResult, error) {
 	plan := s.plan()
 	orig := plan.DeepCopy()
@@ -69,7 +132,10 @@ func (s *preSuspensionState) performSuspension(ctx context.Context) (StateResult
 		plan.Annotations = make(map[string]string)
 	}
 
+	previousPhase := plan.Status.Phase
+	source := sourceSpecSuspend
 	if !plan.Spec.Suspend {
+		source = wellknown.AnnotationSuspendUntil
 		// auto-suspend path (triggered by suspend-until without explicit Spec.Suspend):
 		// align Spec with the PhaseSuspended status we are about to write.
 		plan.Spec.Suspend = true
@@ -88,6 +154,7 @@ func (s *preSuspensionState) performSuspension(ctx context.Context) (StateResult
 			p.Status.ErrorMessage = ""
 			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(s.Clock.Now()))
 		}),
+		PostHook: s.manualAuditHook(previousPhase, source),
 	})
 
 	return StateResult{Requeue: true}, nil
@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -114,6 +116,49 @@ func TestPreSuspensionState_Handle_InExecutingPhaseWithoutActiveTasks_PerformsSu
 		plan.Annotations[wellknown.AnnotationSuspendedAtPhase])
 }
 
+func TestPreSuspensionState_Handle_ImmediatePolicy_CancelsRunningJobs(t *testing.T) {
+	// With suspendPolicy Immediate, running Jobs are deleted and their executions
+	// marked Cancelled instead of draining; finished targets keep their result.
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernating)
+	plan.Spec.SuspendPolicy = hibernatorv1alpha1.SuspendImmediate
+	plan.Status.CurrentCycleID = "cycle-001"
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "app", Executor: "eks", State: hibernatorv1alpha1.StateRunning, LogsRef: "logs-ref"},
+		{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateCompleted, LogsRef: "logs-ref",
+			FinishedAt: ptr.To(metav1.NewTime(time.Now()))},
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runner-app",
+			Namespace: "default",
+			Labels: map[string]string{
+				wellknown.LabelPlan:      "p",
+				wellknown.LabelCycleID:   "cycle-001",
+				wellknown.LabelOperation: string(hibernatorv1alpha1.OperationHibernate),
+				wellknown.LabelTarget:    "app",
+				wellknown.LabelExecutor:  "eks",
+			},
+		},
+		Status: batchv1.JobStatus{Active: 1},
+	}
+	c := newHandlerFakeClient(plan, job)
+	ps := newPreSuspensionState(plan, c)
+
+	result, err := ps.Handle(context.Background())
+	require.NoError(t, err)
+	assert.True(t, result.Requeue, "suspension must not wait for the running job")
+
+	err = c.Get(context.Background(), client.ObjectKeyFromObject(job), &batchv1.Job{})
+	assert.True(t, apierrors.IsNotFound(err), "running job must be deleted")
+
+	assert.Equal(t, hibernatorv1alpha1.StateCancelled, plan.Status.Executions[0].State)
+	assert.NotNil(t, plan.Status.Executions[0].FinishedAt)
+	assert.Equal(t, hibernatorv1alpha1.StateCompleted, plan.Status.Executions[1].State)
+	assert.Equal(t, string(hibernatorv1alpha1.PhaseHibernating),
+		plan.Annotations[wellknown.AnnotationSuspendedAtPhase])
+}
+
 // ---------------------------------------------------------------------------
 // preSuspensionState.OnDeadline()
 // ---------------------------------------------------------------------------
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// previewState handles the one-shot preview annotation (hibernator.ardikabs.com/preview=true)
+// on an Active or Hibernated plan. It dispatches a preview runner Job for every target of
+// the spec; each runner only runs the discovery of its executor and reports the resources
+// the target selects in its termination message.
+//
+// Previews are best-effort like pre-wake hooks: a Job that cannot be created is logged,
+// and the Jobs are never tracked in status. The annotation is consumed before dispatching,
+// and the plan is requeued so the schedule is evaluated as usual.
+type previewState struct {
+	*idleState
+}
+
+func (s *previewState) Handle(ctx context.Context) (StateResult, error) {
+	plan := s.PlanCtx.Plan
+	log := s.Log.
+		WithName("preview").
+		WithValues(
+			"plan", s.Key.String(),
+			"phase", plan.Status.Phase,
+		)
+
+	if err := s.consumeTrigger(ctx, wellknown.AnnotationPreview); err != nil {
+		return StateResult{}, err
+	}
+
+	// Preview results are read from the termination messages of the runner
+	// pods, which are out of reach in a runner cluster.
+	if plan.Spec.Execution.RunnerCluster != nil {
+		log.Info("skipping previews, they are not supported with a runner cluster")
+		return StateResult{Requeue: true}, nil
+	}
+
+	for i := range plan.Spec.Targets {
+		target := &plan.Spec.Targets[i]
+		log.Info("dispatching preview", "target", target.Name, "executor", target.Type)
+		if err := s.createRunnerJob(ctx, log, s.Clock, plan, target,
+			hibernatorv1alpha1.OperationPreview, s.ExecutorInfra); err != nil {
+			log.Error(err, "failed to create preview job", "target", target.Name)
+		}
+	}
+
+	return StateResult{Requeue: true}, nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func TestNew_PreviewAnnotation_ReturnsPreviewState(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	plan.Annotations = map[string]string{wellknown.AnnotationPreview: "true"}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+
+	h := New(st.Key, st.PlanCtx, buildTestConfig(c))
+	require.NotNil(t, h)
+	_, ok := h.(*previewState)
+	assert.True(t, ok, "expected *previewState for PhaseActive + preview annotation")
+}
+
+func TestPreviewState_DispatchesJobPerTarget(t *testing.T) {
+	plan := preWakePlan()
+	plan.Annotations = map[string]string{wellknown.AnnotationPreview: "true"}
+	st := newIdleState(plan, nil, true)
+	h := &previewState{idleState: &idleState{state: st}}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.True(t, result.Requeue, "the schedule is evaluated once the previews are dispatched")
+	assert.NotContains(t, plan.Annotations, wellknown.AnnotationPreview,
+		"preview annotation must be consumed (one-shot)")
+
+	var jobs batchv1.JobList
+	require.NoError(t, st.List(context.Background(), &jobs,
+		client.MatchingLabels{wellknown.LabelOperation: string(hibernatorv1alpha1.OperationPreview)}))
+	require.Len(t, jobs.Items, 2)
+	targets := []string{jobs.Items[0].Labels[wellknown.LabelTarget], jobs.Items[1].Labels[wellknown.LabelTarget]}
+	assert.ElementsMatch(t, []string{"nodes", "db"}, targets)
+	assert.Contains(t, jobs.Items[0].Spec.Template.Spec.Containers[0].Args, "preview")
+
+	// Previews leave the plan and its status untouched.
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernated, plan.Status.Phase)
+	assert.Zero(t, planStatuses(st).Len())
+}
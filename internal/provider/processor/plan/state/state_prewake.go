@@ -0,0 +1,91 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// dispatchPreWakeHooks runs the pre-wake hooks of a hibernated plan once the
+// scheduled wakeup is within each target's lead time. Hooks are best-effort:
+// a failed Job is logged and never blocks the wakeup. Dispatched targets are
+// recorded in status.preWake so each hook runs once per cycle. The returned
+// result requeues the plan when the next hook falls due.
+func (state *idleState) dispatchPreWakeHooks(ctx context.Context, log logr.Logger) StateResult {
+	plan := state.plan()
+	nextWakeUp := state.PlanCtx.Schedule.NextWakeUp
+	if nextWakeUp.IsZero() || !state.PlanCtx.HasRestoreData {
+		return StateResult{}
+	}
+
+	targets := plan.Spec.Targets
+	if snap := plan.Status.PlanSnapshot; snap != nil && snap.CycleID == plan.Status.CurrentCycleID {
+		targets = snap.Targets
+	}
+
+	var dispatched []string
+	if pw := plan.Status.PreWake; pw != nil && pw.CycleID == plan.Status.CurrentCycleID {
+		dispatched = pw.Targets
+	}
+
+	now := state.Clock.Now()
+	var (
+		newlyDispatched []string
+		nextDue         time.Duration
+	)
+	for i := range targets {
+		target := &targets[i]
+		if target.PreWake == nil || slices.Contains(dispatched, target.Name) {
+			continue
+		}
+
+		leadTime := wellknown.DefaultPreWakeLeadTime
+		if target.PreWake.LeadTime != "" {
+			d, err := time.ParseDuration(target.PreWake.LeadTime)
+			if err != nil || d <= 0 {
+				log.Info("ignoring pre-wake hook with invalid lead time", "target", target.Name, "leadTime", target.PreWake.LeadTime)
+				continue
+			}
+			leadTime = d
+		}
+
+		if due := nextWakeUp.Add(-leadTime); now.Before(due) {
+			if wait := due.Sub(now); nextDue == 0 || wait < nextDue {
+				nextDue = wait
+			}
+			continue
+		}
+
+		log.Info("dispatching pre-wake hook", "target", target.Name, "nextWakeUp", nextWakeUp)
+		if err := state.createRunnerJob(ctx, log, state.Clock, plan, target,
+			hibernatorv1alpha1.OperationPreWake, state.ExecutorInfra); err != nil {
+			log.Error(err, "failed to create pre-wake job, wakeup proceeds without it", "target", target.Name)
+		}
+		newlyDispatched = append(newlyDispatched, target.Name)
+	}
+
+	if len(newlyDispatched) > 0 {
+		cycleID := plan.Status.CurrentCycleID
+		recorded := append(slices.Clone(dispatched), newlyDispatched...)
+		state.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
+			NamespacedName: state.Key,
+			Resource:       plan,
+			Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+				p.Status.PreWake = &hibernatorv1alpha1.PreWakeStatus{CycleID: cycleID, Targets: recorded}
+			}),
+		})
+	}
+
+	return StateResult{RequeueAfter: nextDue}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
+	"github.com/ardikabs/hibernator/pkg/executorparams"
+)
+
+// reassertDue reports whether the reassert interval of the plan has passed
+// since it hibernated or was last reasserted, returning the time left until it
+// does when it has not. The time left is zero when the plan sets no reassert
+// interval.
+func (state *idleState) reassertDue() (bool, time.Duration) {
+	plan := state.plan()
+	interval, err := time.ParseDuration(state.effectivePlan(plan).Spec.Behavior.ReassertInterval)
+	if err != nil || interval <= 0 || plan.Status.LastTransitionTime == nil {
+		return false, 0
+	}
+
+	since := plan.Status.LastTransitionTime.Time
+	if last := plan.Status.LastReassertTime; last != nil && last.After(since) {
+		since = last.Time
+	}
+	elapsed := state.Clock.Now().Sub(since)
+	if elapsed >= interval {
+		return true, 0
+	}
+	return false, interval - elapsed
+}
+
+// reassert dispatches a reassert Job for every target of the hibernated plan
+// whose executor supports it, so the resources that came back up by
+// themselves are stopped again. Unlike a hibernation, it leaves the phase,
+// executions, history and restore data of the plan as they are and sends no
+// notification; failed Jobs are logged and retried at the next interval. The
+// time is recorded in status.lastReassertTime, and the interval is returned
+// for the caller to requeue the plan.
+func (state *idleState) reassert(ctx context.Context, log logr.Logger) time.Duration {
+	plan := state.plan()
+	interval, _ := time.ParseDuration(state.effectivePlan(plan).Spec.Behavior.ReassertInterval)
+
+	targets := plan.Spec.Targets
+	if snap := plan.Status.PlanSnapshot; snap != nil && snap.CycleID == plan.Status.CurrentCycleID {
+		targets = snap.Targets
+	}
+
+	if state.PlanCtx.HasRestoreData {
+		for i := range targets {
+			target := &targets[i]
+			if !executorparams.SupportsReassert(target.Type) {
+				continue
+			}
+
+			log.Info("dispatching reassert job", "target", target.Name, "executor", target.Type)
+			if err := state.createRunnerJob(ctx, log, state.Clock, plan, target,
+				hibernatorv1alpha1.OperationReassert, state.ExecutorInfra); err != nil {
+				log.Error(err, "failed to create reassert job, retrying at the next interval", "target", target.Name)
+			}
+		}
+	}
+
+	now := state.Clock.Now()
+	state.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: state.Key,
+		Resource:       plan,
+		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+			p.Status.LastReassertTime = ptr.To(metav1.NewTime(now))
+		}),
+	})
+
+	return interval
+}
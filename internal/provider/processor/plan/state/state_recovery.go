@@ -10,13 +10,17 @@ import (
 	"fmt"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
 	"github.com/ardikabs/hibernator/internal/notification"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/recovery"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/go-logr/logr"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -49,8 +53,11 @@ func (state *recoveryState) Handle(ctx context.Context) (StateResult, error) {
 		if handled, result, err := state.handleManualRetry(ctx, log); handled {
 			return result, err
 		}
+		if result, handled := state.escapeOnScheduleChange(log); handled {
+			return result, nil
+		}
 		log.Info("error recovery aborted, manual intervention required",
-			"classification", recovery.ClassifyError(lastErr),
+			"classification", strategy.Classification,
 			"reason", strategy.Reason)
 		return StateResult{}, nil
 	}
@@ -102,16 +109,79 @@ func (state *recoveryState) handleManualRetry(ctx context.Context, log logr.Logg
 	return true, StateResult{Requeue: true}, nil
 }
 
+// escapeOnScheduleChange moves a plan that recovery gave up on out of PhaseError
+// once the schedule calls for the operation opposite to the failed one, when
+// spec.behavior.autoRecoverOnScheduleChange is set. The plan is routed to the
+// idle phase the new operation starts from, so idleState dispatches it:
+//
+//   - schedule says wake up: PhaseHibernated when restore data exists, since the
+//     failed shutdown may have stopped some targets; PhaseActive otherwise.
+//   - schedule says hibernate: PhaseActive, since the failed wakeup may have
+//     started some targets.
+func (state *recoveryState) escapeOnScheduleChange(log logr.Logger) (StateResult, bool) {
+	plan := state.plan()
+	if !plan.Spec.Behavior.AutoRecoverOnScheduleChange || state.PlanCtx.Schedule == nil {
+		return StateResult{}, false
+	}
+
+	scheduleOperation := hibernatorv1alpha1.OperationWakeUp
+	if state.PlanCtx.Schedule.ShouldHibernate {
+		scheduleOperation = hibernatorv1alpha1.OperationHibernate
+	}
+	if plan.Status.CurrentOperation == "" || plan.Status.CurrentOperation == scheduleOperation {
+		return StateResult{}, false
+	}
+
+	targetPhase := hibernatorv1alpha1.PhaseActive
+	if scheduleOperation == hibernatorv1alpha1.OperationWakeUp && state.PlanCtx.HasRestoreData {
+		targetPhase = hibernatorv1alpha1.PhaseHibernated
+	}
+
+	log.Info("schedule window changed, recovering from error",
+		"failedOperation", plan.Status.CurrentOperation,
+		"scheduleOperation", scheduleOperation,
+		"targetPhase", targetPhase)
+
+	now := state.Clock.Now()
+	state.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: state.Key,
+		Resource:       plan,
+		PreHook: state.notifyHook(hibernatorv1alpha1.EventRecovery, func(p *hibernatorv1alpha1.HibernatePlan) notification.Payload {
+			// PreHook sees pre-mutation state — override with target values.
+			payload := buildPayload(p, hibernatorv1alpha1.EventRecovery, state.Clock.Now)
+			payload.Phase = string(targetPhase)
+			payload.Operation = string(scheduleOperation)
+			return payload
+		}),
+		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+			p.Status.Phase = targetPhase
+			p.Status.RetryCount = 0
+			p.Status.ErrorMessage = ""
+			p.Status.LastRetryTime = nil
+			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(now))
+		}),
+		PostHook: chainHooks(
+			state.phaseChangePostHook(hibernatorv1alpha1.PhaseError),
+			state.eventHook(corev1.EventTypeNormal, wellknown.EventReasonRecoveryStarted, func(p *hibernatorv1alpha1.HibernatePlan) string {
+				return fmt.Sprintf("Schedule calls for %s, recovering from the failed %s", scheduleOperation, p.Status.CurrentOperation)
+			}),
+			state.auditHook(hibernatorv1alpha1.PhaseError, audit.TriggerRecovery, "spec.behavior.autoRecoverOnScheduleChange"),
+		),
+	})
+
+	return StateResult{Requeue: true}, true
+}
+
 func (state *recoveryState) handleRetry(ctx context.Context, log logr.Logger, lastErr error) (StateResult, error) {
 	plan := state.plan()
 
 	operation := state.determineRetryOperation(log, plan)
 	shouldHibernate := operation == hibernatorv1alpha1.OperationHibernate
 
-	// Use the locked effective plan so retry/resume preserves the original
+	// Use the locked cycle plan so retry/resume preserves the original
 	// exception intent captured at cycle start.
-	effectivePlan := state.effectivePlan(plan)
-	execPlan, err := state.buildExecutionPlan(effectivePlan, operation == hibernatorv1alpha1.OperationWakeUp)
+	effectivePlan := state.cyclePlan(plan)
+	execPlan, err := state.buildExecutionPlan(ctx, effectivePlan, operation == hibernatorv1alpha1.OperationWakeUp)
 	if err != nil {
 		log.Error(err, "failed to rebuild execution plan during recovery, repeat may be attempted if this is a transient error")
 		return StateResult{}, err
@@ -151,13 +221,18 @@ func (state *recoveryState) handleRetry(ctx context.Context, log logr.Logger, la
 					if exec.Target == targetName && exec.State == hibernatorv1alpha1.StateFailed {
 						p.Status.Executions[i].State = hibernatorv1alpha1.StatePending
 						p.Status.Executions[i].Message = "Execution state reset for retry after failure"
+						p.Status.Executions[i].FailureReason = ""
 					}
 				}
 			}
 
 			p.Status.Phase = targetPhase
 		}),
-		PostHook: state.phaseChangePostHook(currentPhase),
+		PostHook: chainHooks(
+			state.phaseChangePostHook(currentPhase),
+			state.operationEventHook(hibernatorv1alpha1.EventRecovery, operation),
+			state.auditHook(currentPhase, audit.TriggerRecovery, ""),
+		),
 	})
 
 	log.Info("transitioning on recovery",
@@ -201,7 +276,8 @@ func (state *recoveryState) determineRetryOperation(log logr.Logger, plan *hiber
 		// resume from the point of failure. If the operator wants the plan to
 		// follow the current schedule instead, they should suspend the plan and
 		// perform manual intervention, or delete and resubmit it to reset the
-		// status entirely.
+		// status entirely. Once retries are exhausted, autoRecoverOnScheduleChange
+		// lets the plan follow the schedule instead (see escapeOnScheduleChange).
 		scheduleOperation := hibernatorv1alpha1.OperationWakeUp
 		if scheduledShouldHibernate {
 			scheduleOperation = hibernatorv1alpha1.OperationHibernate
@@ -16,6 +16,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/message"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
@@ -56,3 +57,92 @@ func TestRecoveryState_Handle_BackoffPending_SchedulesRetryTimer(t *testing.T) {
 
 	assert.True(t, result.RequeueAfter > 0, "retry timer should be scheduled while within backoff window")
 }
+
+func TestRecoveryState_Handle_ScheduleChanged_AutoRecovers(t *testing.T) {
+	tests := []struct {
+		name            string
+		operation       hibernatorv1alpha1.PlanOperation
+		shouldHibernate bool
+		hasRestoreData  bool
+		wantPhase       hibernatorv1alpha1.PlanPhase
+	}{
+		{
+			name:           "failed shutdown, wakeup window with restore data",
+			operation:      hibernatorv1alpha1.OperationHibernate,
+			hasRestoreData: true,
+			wantPhase:      hibernatorv1alpha1.PhaseHibernated,
+		},
+		{
+			name:      "failed shutdown, wakeup window without restore data",
+			operation: hibernatorv1alpha1.OperationHibernate,
+			wantPhase: hibernatorv1alpha1.PhaseActive,
+		},
+		{
+			name:            "failed wakeup, shutdown window",
+			operation:       hibernatorv1alpha1.OperationWakeUp,
+			shouldHibernate: true,
+			hasRestoreData:  true,
+			wantPhase:       hibernatorv1alpha1.PhaseActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", hibernatorv1alpha1.PhaseError)
+			plan.Spec.Behavior.AutoRecoverOnScheduleChange = true
+			plan.Status.CurrentOperation = tt.operation
+			plan.Status.RetryCount = wellknown.DefaultRecoveryMaxRetryAttempts
+			plan.Status.ErrorMessage = "something went wrong"
+
+			c := newHandlerFakeClient(plan)
+			st := newHandlerState(plan, c)
+			st.PlanCtx.Schedule = &message.ScheduleEvaluation{ShouldHibernate: tt.shouldHibernate}
+			st.PlanCtx.HasRestoreData = tt.hasRestoreData
+
+			h := &recoveryState{state: st}
+			result, err := h.Handle(context.Background())
+			require.NoError(t, err)
+			assert.True(t, result.Requeue)
+
+			require.Equal(t, 1, planStatuses(st).Len())
+			update := <-planStatuses(st).C()
+			committed := plan.DeepCopy()
+			update.Mutator.Mutate(committed)
+			assert.Equal(t, tt.wantPhase, committed.Status.Phase)
+			assert.Zero(t, committed.Status.RetryCount)
+			assert.Empty(t, committed.Status.ErrorMessage)
+			assert.Nil(t, committed.Status.LastRetryTime)
+		})
+	}
+}
+
+func TestRecoveryState_Handle_ScheduleChanged_NoAutoRecover(t *testing.T) {
+	tests := []struct {
+		name            string
+		autoRecover     bool
+		shouldHibernate bool
+	}{
+		{name: "flag disabled", shouldHibernate: false},
+		{name: "schedule still in the failed window", autoRecover: true, shouldHibernate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", hibernatorv1alpha1.PhaseError)
+			plan.Spec.Behavior.AutoRecoverOnScheduleChange = tt.autoRecover
+			plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+			plan.Status.RetryCount = wellknown.DefaultRecoveryMaxRetryAttempts
+			plan.Status.ErrorMessage = "something went wrong"
+
+			c := newHandlerFakeClient(plan)
+			st := newHandlerState(plan, c)
+			st.PlanCtx.Schedule = &message.ScheduleEvaluation{ShouldHibernate: tt.shouldHibernate}
+
+			h := &recoveryState{state: st}
+			result, err := h.Handle(context.Background())
+			require.NoError(t, err)
+			assert.False(t, result.Requeue)
+			assert.Zero(t, planStatuses(st).Len())
+		})
+	}
+}
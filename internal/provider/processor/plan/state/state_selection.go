@@ -71,22 +71,47 @@ func selectHandler(s *state) Handler {
 //  1. override-action=true  → overrideActionState: suppresses the schedule; direction
 //     is taken from override-phase-target=hibernate|wakeup.
 //
-//  2. restart=true          → restartState: one-shot re-trigger of the last executor
+//  2. hibernate-until-wake=true → hibernateUntilWakeState: one-shot hibernation that
+//     lasts until the schedule's next regular wake-up, backed by an extend exception.
+//
+//  3. wake-until-hibernate=true → wakeUntilHibernateState: one-shot wakeup that lasts
+//     until the current off-hours window ends, backed by a suspend exception.
+//
+//  4. restart=true          → restartState: one-shot re-trigger of the last executor
 //     operation, determined by .Status.CurrentOperation (not by any annotation value).
 //
-//  3. (default)             → idleState: pure schedule-driven evaluation.
+//  5. preview=true          → previewState: one-shot dispatch of preview runner Jobs that
+//     list the resources each target selects, without changing them.
+//
+//  6. (default)             → idleState: pure schedule-driven evaluation.
 func selectIdleHandler(s *state) Handler {
 	plan := s.plan()
 	idle := &idleState{state: s}
 
 	if plan.Annotations[wellknown.AnnotationOverrideAction] == "true" {
+		idle.manualSource = wellknown.AnnotationOverrideAction
 		return &overrideActionState{idleState: idle}
 	}
 
+	if plan.Annotations[wellknown.AnnotationHibernateUntilWake] == "true" {
+		idle.manualSource = wellknown.AnnotationHibernateUntilWake
+		return &hibernateUntilWakeState{idleState: idle}
+	}
+
+	if plan.Annotations[wellknown.AnnotationWakeUntilHibernate] == "true" {
+		idle.manualSource = wellknown.AnnotationWakeUntilHibernate
+		return &wakeUntilHibernateState{idleState: idle}
+	}
+
 	if plan.Annotations[wellknown.AnnotationRestart] == "true" {
+		idle.manualSource = wellknown.AnnotationRestart
 		return &restartState{idleState: idle}
 	}
 
+	if plan.Annotations[wellknown.AnnotationPreview] == "true" {
+		return &previewState{idleState: idle}
+	}
+
 	return idle
 }
 
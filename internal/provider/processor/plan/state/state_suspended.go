@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/go-logr/logr"
@@ -27,6 +28,10 @@ import (
 //   - If Spec.Suspend is false → resume (cancel deadlineTimer + resume()).
 type suspendedState struct {
 	*state
+
+	// deadlineExpired is set when the suspend-until deadline, rather than a
+	// user clearing spec.suspend, ends the suspension.
+	deadlineExpired bool
 }
 
 func (state *suspendedState) Handle(ctx context.Context) (StateResult, error) {
@@ -60,6 +65,7 @@ func (state *suspendedState) Handle(ctx context.Context) (StateResult, error) {
 
 			// Deadline expired — patch Spec.Suspend=false.
 			log.Info("suspension deadline reached, revoking suspension", "deadline", deadline.Format(time.RFC3339))
+			state.deadlineExpired = true
 			orig := plan.DeepCopy()
 			plan.Spec.Suspend = false
 			delete(plan.Annotations, wellknown.AnnotationSuspendUntil)
@@ -89,6 +95,7 @@ func (state *suspendedState) OnDeadline(ctx context.Context) (StateResult, error
 		WithValues("plan", state.Key.String())
 
 	log.Info("suspension deadline fired, revoking suspension")
+	state.deadlineExpired = true
 
 	orig := plan.DeepCopy()
 	plan.Spec.Suspend = false
@@ -143,6 +150,7 @@ func (state *suspendedState) resume(ctx context.Context, log logr.Logger) (State
 			p.Status.Phase = hibernatorv1alpha1.PhaseActive
 			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(state.Clock.Now()))
 		}),
+		PostHook: state.resumeAuditHook(),
 	})
 
 	state.cleanupSuspensionAnnotations(ctx, log, plan)
@@ -204,6 +212,7 @@ func (state *suspendedState) resumeFromError(ctx context.Context, log logr.Logge
 			p.Status.LastRetryTime = nil
 			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(now))
 		}),
+		PostHook: state.resumeAuditHook(),
 	})
 
 	state.cleanupSuspensionAnnotations(ctx, log, plan)
@@ -215,7 +224,8 @@ func (state *suspendedState) resumeFromError(ctx context.Context, log logr.Logge
 //
 //   - PhaseHibernating + ShouldHibernate=true  → still in off-hours → resume to PhaseHibernating;
 //     existing CycleID/StageIndex/Executions are preserved so execute() re-observes in-flight
-//     Job results and continues from the exact stage bookmark.
+//     Job results and continues from the exact stage bookmark. Targets cancelled by an
+//     immediate suspension are reset to StatePending and dispatched again.
 //   - PhaseHibernating + ShouldHibernate=false → now on-hours → route to PhaseActive;
 //     execution bookmarks cleared; shutdown never completed, resource treated as running.
 //   - PhaseWakingUp   + ShouldHibernate=false → still in on-hours → resume to PhaseWakingUp;
@@ -264,6 +274,10 @@ func (state *suspendedState) resumeFromExecution(ctx context.Context, log logr.L
 		"targetPhase", targetPhase,
 		"shouldHibernate", shouldHibernate)
 
+	// Targets cancelled by an immediate suspension are re-dispatched when the
+	// operation continues.
+	continues := targetPhase == hibernatorv1alpha1.PlanPhase(suspendedAtPhase)
+
 	now := state.Clock.Now()
 	state.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
 		NamespacedName: state.Key,
@@ -271,13 +285,29 @@ func (state *suspendedState) resumeFromExecution(ctx context.Context, log logr.L
 		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
 			p.Status.Phase = targetPhase
 			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(now))
+			if continues {
+				resetCancelledExecutions(p.Status.Executions)
+			}
 		}),
+		PostHook: state.resumeAuditHook(),
 	})
 
 	state.cleanupSuspensionAnnotations(ctx, log, plan)
 	return StateResult{Requeue: true}, true, nil
 }
 
+// resetCancelledExecutions returns targets cancelled by an immediate suspension
+// to StatePending so the resumed operation dispatches them again.
+func resetCancelledExecutions(executions []hibernatorv1alpha1.ExecutionStatus) {
+	for i := range executions {
+		if executions[i].State == hibernatorv1alpha1.StateCancelled {
+			executions[i].State = hibernatorv1alpha1.StatePending
+			executions[i].Message = "Target pending re-dispatch after suspension"
+			executions[i].FinishedAt = nil
+		}
+	}
+}
+
 func (state *suspendedState) shouldForceWakeUpOnResume() bool {
 	planCtx := state.PlanCtx
 	plan := planCtx.Plan
@@ -337,3 +367,13 @@ func (state *suspendedState) cleanupSuspensionAnnotations(ctx context.Context, l
 		log.Error(err, "failed to clean up suspension annotations (non-fatal)")
 	}
 }
+
+// resumeAuditHook returns the audit PostHook of a resume from PhaseSuspended,
+// attributed to the expired suspend-until deadline or to the user who cleared
+// spec.suspend.
+func (state *suspendedState) resumeAuditHook() func(context.Context, *hibernatorv1alpha1.HibernatePlan) error {
+	if state.deadlineExpired {
+		return state.auditHook(hibernatorv1alpha1.PhaseSuspended, audit.TriggerDeadline, wellknown.AnnotationSuspendUntil)
+	}
+	return state.manualAuditHook(hibernatorv1alpha1.PhaseSuspended, sourceSpecSuspend)
+}
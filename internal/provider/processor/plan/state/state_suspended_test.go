@@ -287,6 +287,35 @@ func TestResumeFromExecution_Hibernating_SameWindow_ResumesToHibernating(t *test
 	assert.Len(t, plan.Status.Executions, 1)
 }
 
+func TestResumeFromExecution_SameWindow_ResetsCancelledTargets(t *testing.T) {
+	// Targets cancelled by an immediate suspension are dispatched again when the
+	// operation continues.
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseSuspended)
+	plan.Annotations = map[string]string{
+		wellknown.AnnotationSuspendedAtPhase: string(hibernatorv1alpha1.PhaseHibernating),
+	}
+	plan.Status.CurrentCycleID = "abc123"
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "db", State: hibernatorv1alpha1.StateCompleted},
+		{Target: "app", State: hibernatorv1alpha1.StateCancelled, FinishedAt: &metav1.Time{Time: time.Now()}},
+	}
+
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{ShouldHibernate: true}
+
+	h := &suspendedState{state: st}
+	_, handled, err := h.resumeFromExecution(context.Background(), logr.Discard())
+	require.NoError(t, err)
+	assert.True(t, handled)
+
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, plan.Status.Phase)
+	assert.Equal(t, hibernatorv1alpha1.StateCompleted, plan.Status.Executions[0].State)
+	assert.Equal(t, hibernatorv1alpha1.StatePending, plan.Status.Executions[1].State)
+	assert.Nil(t, plan.Status.Executions[1].FinishedAt)
+}
+
 func TestResumeFromExecution_Hibernating_DifferentWindow_ResumesToActive(t *testing.T) {
 	// Suspended mid-shutdown; clock is now on-hours → shutdown window passed,
 	// resource was never shut down → route to PhaseActive with preserved bookmarks.
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"time"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// wakeUntilHibernateExceptionSuffix names the suspend exceptions generated for the
+// wake-until-hibernate annotation: <plan>-<suffix>-<unix seconds>.
+const wakeUntilHibernateExceptionSuffix = "wake-now"
+
+// wakeUntilHibernateState handles the one-shot wake-until-hibernate annotation
+// (hibernator.ardikabs.com/wake-until-hibernate=true) on a Hibernated plan.
+//
+// It is the counterpart of hibernateUntilWakeState: the intent is recorded as a suspend
+// ScheduleException owned by the plan, valid from now until the current off-hours window
+// would have ended, and the wakeup starts immediately. Suspensions take precedence over
+// extensions, so this also cuts short a hibernate-until-wake exception.
+//
+// The annotation is consumed once the exception exists, or when the request cannot be
+// honoured (plan not Hibernated, schedule already says awake, no restore data, exception
+// rejected). Transient API errors leave it in place for the next tick.
+type wakeUntilHibernateState struct {
+	*idleState
+}
+
+func (s *wakeUntilHibernateState) Handle(ctx context.Context) (StateResult, error) {
+	plan := s.PlanCtx.Plan
+	log := s.Log.
+		WithName("wake-until-hibernate").
+		WithValues(
+			"plan", s.Key.String(),
+			"phase", plan.Status.Phase,
+		)
+
+	if plan.Status.Phase != hibernatorv1alpha1.PhaseHibernated {
+		log.Info("wake-until-hibernate: plan is not Hibernated; no-op",
+			"hint", "the plan is already awake and hibernates on its regular schedule")
+		return StateResult{}, s.consumeTrigger(ctx, wellknown.AnnotationWakeUntilHibernate)
+	}
+
+	sched := s.PlanCtx.Schedule
+	now := s.Clock.Now()
+	if sched == nil || !sched.ShouldHibernate || !sched.NextWakeUp.After(now) {
+		// Without an off-hours window in progress the idle handler wakes the plan anyway.
+		log.Info("wake-until-hibernate: no off-hours window in progress; no-op")
+		return StateResult{}, s.consumeTrigger(ctx, wellknown.AnnotationWakeUntilHibernate)
+	}
+
+	if !s.PlanCtx.HasRestoreData {
+		log.Info("wake-until-hibernate: no restore data available; no-op")
+		return StateResult{}, s.consumeTrigger(ctx, wellknown.AnnotationWakeUntilHibernate)
+	}
+
+	name, ok, err := s.createTriggerException(ctx, log, hibernatorv1alpha1.ExceptionSuspend, wakeUntilHibernateExceptionSuffix, sched.NextWakeUp)
+	if err != nil {
+		return StateResult{}, err
+	}
+	if err := s.consumeTrigger(ctx, wellknown.AnnotationWakeUntilHibernate); err != nil {
+		return StateResult{}, err
+	}
+	if !ok {
+		return StateResult{}, nil
+	}
+
+	log.Info("wake-until-hibernate: waking up until the off-hours window ends",
+		"exception", name,
+		"windowEnd", sched.NextWakeUp.Format(time.RFC3339))
+	return s.transitionToWakingUp(log)
+}
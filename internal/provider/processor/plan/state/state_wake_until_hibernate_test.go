@@ -0,0 +1,118 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/message"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// ---------------------------------------------------------------------------
+// wakeUntilHibernateState — one-shot wakeup until the off-hours window ends
+// ---------------------------------------------------------------------------
+
+func TestNew_WakeUntilHibernateAnnotation_PhaseHibernated_ReturnsWakeUntilHibernateState(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernated)
+	plan.Annotations = map[string]string{wellknown.AnnotationWakeUntilHibernate: "true"}
+	c := newHandlerFakeClient(plan)
+	st := newHandlerState(plan, c)
+
+	h := New(st.Key, st.PlanCtx, buildTestConfig(c))
+	require.NotNil(t, h)
+	_, ok := h.(*wakeUntilHibernateState)
+	assert.True(t, ok, "expected *wakeUntilHibernateState for PhaseHibernated + wake-until-hibernate annotation")
+}
+
+func TestWakeUntilHibernateState_FromHibernated_CreatesExceptionAndWakesUp(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseHibernated)
+	plan.Annotations = map[string]string{wellknown.AnnotationWakeUntilHibernate: "true"}
+	st := newOverrideActionState(plan, nil, true)
+	windowEnd := st.Clock.Now().Add(10 * time.Hour).Truncate(time.Second)
+	st.PlanCtx.Schedule = &message.ScheduleEvaluation{ShouldHibernate: true, NextWakeUp: windowEnd}
+	h := &wakeUntilHibernateState{idleState: &idleState{state: st}}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, result.Requeue)
+	assert.Equal(t, hibernatorv1alpha1.PhaseWakingUp, plan.Status.Phase)
+	assert.NotContains(t, plan.Annotations, wellknown.AnnotationWakeUntilHibernate,
+		"annotation must be consumed (one-shot)")
+
+	var list hibernatorv1alpha1.ScheduleExceptionList
+	require.NoError(t, st.List(context.Background(), &list, client.InNamespace("default")))
+	require.Len(t, list.Items, 1)
+
+	exc := list.Items[0]
+	assert.Equal(t, hibernatorv1alpha1.ExceptionSuspend, exc.Spec.Type)
+	assert.True(t, exc.Spec.ValidUntil.Time.Equal(windowEnd), "exception must expire when the window ends")
+	assert.Empty(t, exc.Spec.LeadTime)
+	require.Len(t, exc.OwnerReferences, 1)
+}
+
+func TestWakeUntilHibernateState_Noops(t *testing.T) {
+	tests := []struct {
+		name       string
+		phase      hibernatorv1alpha1.PlanPhase
+		hasRestore bool
+		schedule   func(now time.Time) *message.ScheduleEvaluation
+	}{
+		{
+			name:       "already active",
+			phase:      hibernatorv1alpha1.PhaseActive,
+			hasRestore: true,
+			schedule: func(now time.Time) *message.ScheduleEvaluation {
+				return &message.ScheduleEvaluation{ShouldHibernate: true, NextWakeUp: now.Add(time.Hour)}
+			},
+		},
+		{
+			name:       "schedule already says awake",
+			phase:      hibernatorv1alpha1.PhaseHibernated,
+			hasRestore: true,
+			schedule: func(now time.Time) *message.ScheduleEvaluation {
+				return &message.ScheduleEvaluation{NextWakeUp: now.Add(24 * time.Hour)}
+			},
+		},
+		{
+			name:       "no restore data",
+			phase:      hibernatorv1alpha1.PhaseHibernated,
+			hasRestore: false,
+			schedule: func(now time.Time) *message.ScheduleEvaluation {
+				return &message.ScheduleEvaluation{ShouldHibernate: true, NextWakeUp: now.Add(time.Hour)}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := basePlanForState("p", tt.phase)
+			plan.Annotations = map[string]string{wellknown.AnnotationWakeUntilHibernate: "true"}
+			st := newOverrideActionState(plan, nil, tt.hasRestore)
+			st.PlanCtx.Schedule = tt.schedule(st.Clock.Now())
+			h := &wakeUntilHibernateState{idleState: &idleState{state: st}}
+
+			result, err := h.Handle(context.Background())
+			require.NoError(t, err)
+
+			assert.False(t, result.Requeue)
+			assert.Equal(t, tt.phase, plan.Status.Phase)
+			assert.NotContains(t, plan.Annotations, wellknown.AnnotationWakeUntilHibernate)
+
+			var list hibernatorv1alpha1.ScheduleExceptionList
+			require.NoError(t, st.List(context.Background(), &list))
+			assert.Empty(t, list.Items)
+		})
+	}
+}
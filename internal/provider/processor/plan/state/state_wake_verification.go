@@ -0,0 +1,175 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+	"github.com/ardikabs/hibernator/pkg/k8sutil"
+)
+
+// wakeVerificationError reports that the spec.wakeVerification Job failed or could
+// not be created. The wakingUp OnError handler uses it to record the verification
+// result in the cycle history.
+type wakeVerificationError struct {
+	result *hibernatorv1alpha1.WakeVerificationResult
+	reason string
+}
+
+func (e *wakeVerificationError) Error() string { return e.reason }
+
+// runWakeVerification drives the spec.wakeVerification Job of the current cycle once
+// all wakeup stages have completed. It dispatches the Job on first call and returns a
+// nil result while the Job is running. A succeeded Job returns its result; a failed Job,
+// or a template that cannot be resolved, returns a PlanError wrapping
+// wakeVerificationError so the plan enters PhaseError and the retry policy verifies
+// again. Finished Jobs are marked stale once their result is read, so every retried or
+// restarted wakeup runs a fresh verification.
+func (s *state) runWakeVerification(ctx context.Context, log logr.Logger, plan *hibernatorv1alpha1.HibernatePlan) (*hibernatorv1alpha1.WakeVerificationResult, error) {
+	job, err := s.findWakeVerificationJob(ctx, plan)
+	if err != nil {
+		return nil, fmt.Errorf("list wake verification jobs: %w", err)
+	}
+	if job == nil {
+		return nil, s.createWakeVerificationJob(ctx, log, plan)
+	}
+
+	result := &hibernatorv1alpha1.WakeVerificationResult{
+		JobRef:    fmt.Sprintf("%s/%s", job.Namespace, job.Name),
+		StartedAt: job.Status.StartTime,
+	}
+	var failure string
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		if cond.Type == batchv1.JobComplete {
+			result.State = hibernatorv1alpha1.StateCompleted
+			result.FinishedAt = cond.LastTransitionTime.DeepCopy()
+			break
+		}
+		if cond.Type == batchv1.JobFailed {
+			result.State = hibernatorv1alpha1.StateFailed
+			result.FinishedAt = cond.LastTransitionTime.DeepCopy()
+			failure = cond.Reason
+			break
+		}
+	}
+	if result.State == "" {
+		log.V(1).Info("wake verification job still running", "job", result.JobRef)
+		return nil, nil
+	}
+
+	result.Message = s.getTerminationMessageFromPod(ctx, job)
+	s.markJobAsStale(ctx, log, job)
+
+	if result.State == hibernatorv1alpha1.StateFailed {
+		log.Info("wake verification failed", "job", result.JobRef, "reason", failure)
+		return nil, AsPlanError(&wakeVerificationError{
+			result: result,
+			reason: fmt.Sprintf("wake verification job %s failed: %s", result.JobRef, failure),
+		})
+	}
+
+	log.Info("wake verification succeeded", "job", result.JobRef)
+	return result, nil
+}
+
+// findWakeVerificationJob returns the newest non-stale wake verification Job of the
+// plan's current cycle, or nil if none has been dispatched yet.
+func (s *state) findWakeVerificationJob(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) (*batchv1.Job, error) {
+	var jobList batchv1.JobList
+	if err := s.APIReader.List(ctx, &jobList,
+		client.InNamespace(plan.Namespace),
+		client.MatchingLabels{
+			wellknown.LabelPlan:      plan.Name,
+			wellknown.LabelCycleID:   plan.Status.CurrentCycleID,
+			wellknown.LabelOperation: string(hibernatorv1alpha1.OperationWakeVerify),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	var newest *batchv1.Job
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if _, stale := job.Labels[wellknown.LabelStaleRunnerJob]; stale {
+			continue
+		}
+		if newest == nil || newest.CreationTimestamp.Before(&job.CreationTimestamp) {
+			newest = job
+		}
+	}
+	return newest, nil
+}
+
+// createWakeVerificationJob creates the verification Job from the jobTemplate of the
+// referenced CronJob. Containers fall back to their logs for the termination message
+// so a failing smoke test leaves its output in the cycle history.
+func (s *state) createWakeVerificationJob(ctx context.Context, log logr.Logger, plan *hibernatorv1alpha1.HibernatePlan) error {
+	key := types.NamespacedName{Namespace: plan.Namespace, Name: plan.Spec.WakeVerification.JobTemplateRef.Name}
+
+	var cronJob batchv1.CronJob
+	if err := s.APIReader.Get(ctx, key, &cronJob); err != nil {
+		if apierrors.IsNotFound(err) {
+			return AsPlanError(&wakeVerificationError{
+				reason: fmt.Sprintf("wake verification job template %s not found", key),
+			})
+		}
+		return fmt.Errorf("get wake verification job template: %w", err)
+	}
+
+	template := cronJob.Spec.JobTemplate
+	labels := maps.Clone(template.Labels)
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[wellknown.LabelPlan] = plan.Name
+	labels[wellknown.LabelCycleID] = plan.Status.CurrentCycleID
+	labels[wellknown.LabelOperation] = string(hibernatorv1alpha1.OperationWakeVerify)
+
+	annotations := maps.Clone(template.Annotations)
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[wellknown.AnnotationPlan] = plan.Name
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("verify-%s-", k8sutil.ShortenName(plan.Name, 50)),
+			Namespace:    plan.Namespace,
+			Labels:       labels,
+			Annotations:  annotations,
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	for i := range job.Spec.Template.Spec.Containers {
+		job.Spec.Template.Spec.Containers[i].TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+	}
+
+	if err := controllerutil.SetControllerReference(plan, job, s.Scheme); err != nil {
+		return fmt.Errorf("set owner reference: %w", err)
+	}
+
+	log.Info("dispatching wake verification job", "template", key.String())
+	if err := s.Create(ctx, job); err != nil {
+		return fmt.Errorf("create wake verification job: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// verifiedPlan returns a WakingUp plan whose only target has completed and which
+// references the smoke-tests CronJob for wake verification.
+func verifiedPlan() *hibernatorv1alpha1.HibernatePlan {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseWakingUp)
+	plan.Spec.Execution.Strategy.Type = hibernatorv1alpha1.StrategySequential
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{
+		{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}},
+	}
+	plan.Spec.WakeVerification = &hibernatorv1alpha1.WakeVerification{
+		JobTemplateRef: hibernatorv1alpha1.JobTemplateReference{Name: "smoke-tests"},
+	}
+	plan.Status.CurrentCycleID = "cycle-001"
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationWakeUp
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateCompleted},
+	}
+	return plan
+}
+
+func smokeTestCronJob() *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "smoke-tests", Namespace: "default"},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 0 * * *",
+			Suspend:  ptr.To(true),
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "smoke"}},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: "smoke", Image: "curlimages/curl"}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// verificationJob returns a wake verification Job of cycle-001 finished with the given condition.
+func verificationJob(condType batchv1.JobConditionType) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "verify-p-abc",
+			Namespace: "default",
+			Labels: map[string]string{
+				wellknown.LabelPlan:      "p",
+				wellknown.LabelCycleID:   "cycle-001",
+				wellknown.LabelOperation: string(hibernatorv1alpha1.OperationWakeVerify),
+			},
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:               condType,
+				Status:             corev1.ConditionTrue,
+				Reason:             "BackoffLimitExceeded",
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			}},
+		},
+	}
+}
+
+func TestWakingUpState_WakeVerification_DispatchesJobAndHoldsActive(t *testing.T) {
+	plan := verifiedPlan()
+	c := newHandlerFakeClient(plan, smokeTestCronJob())
+	st := newHandlerState(plan, c)
+	h := &wakingUpState{state: st}
+
+	result, err := h.Handle(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wellknown.RequeueIntervalDuringStage, result.RequeueAfter)
+	assert.Equal(t, hibernatorv1alpha1.PhaseWakingUp, plan.Status.Phase, "plan must wait for verification")
+
+	var jobs batchv1.JobList
+	require.NoError(t, c.List(context.Background(), &jobs,
+		client.MatchingLabels{wellknown.LabelOperation: string(hibernatorv1alpha1.OperationWakeVerify)}))
+	require.Len(t, jobs.Items, 1)
+	job := jobs.Items[0]
+	assert.Equal(t, "cycle-001", job.Labels[wellknown.LabelCycleID])
+	assert.Equal(t, "smoke", job.Labels["app"], "template labels are kept")
+	assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, job.Spec.Template.Spec.Containers[0].TerminationMessagePolicy)
+	require.Len(t, job.OwnerReferences, 1)
+	assert.Equal(t, "p", job.OwnerReferences[0].Name)
+
+	// A second pass waits on the running Job instead of dispatching another.
+	_, err = h.Handle(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, c.List(context.Background(), &jobs,
+		client.MatchingLabels{wellknown.LabelOperation: string(hibernatorv1alpha1.OperationWakeVerify)}))
+	assert.Len(t, jobs.Items, 1)
+}
+
+func TestWakingUpState_WakeVerification_SuccessCompletesWakeup(t *testing.T) {
+	plan := verifiedPlan()
+	c := newHandlerFakeClient(plan, smokeTestCronJob(), verificationJob(batchv1.JobComplete))
+	st := newHandlerState(plan, c)
+	h := &wakingUpState{state: st}
+
+	result, err := h.finalize(context.Background(), st.Log, scheduler.ExecutionPlan{}, "")
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	assert.Equal(t, hibernatorv1alpha1.PhaseActive, plan.Status.Phase)
+	require.Len(t, plan.Status.ExecutionHistory, 1)
+	verification := plan.Status.ExecutionHistory[0].WakeupExecution.Verification
+	require.NotNil(t, verification)
+	assert.Equal(t, "default/verify-p-abc", verification.JobRef)
+	assert.Equal(t, hibernatorv1alpha1.StateCompleted, verification.State)
+
+	var job batchv1.Job
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "verify-p-abc"}, &job))
+	assert.Equal(t, "true", job.Labels[wellknown.LabelStaleRunnerJob], "finished verification jobs are not reused")
+}
+
+func TestWakingUpState_WakeVerification_FailureRecordedInHistory(t *testing.T) {
+	plan := verifiedPlan()
+	c := newHandlerFakeClient(plan, smokeTestCronJob(), verificationJob(batchv1.JobFailed))
+	st := newHandlerState(plan, c)
+	h := &wakingUpState{state: st}
+
+	_, err := h.finalize(context.Background(), st.Log, scheduler.ExecutionPlan{}, "")
+	require.Error(t, err)
+	var pe *PlanError
+	require.True(t, errors.As(err, &pe), "failed verification must be a PlanError, got: %v", err)
+	assert.Contains(t, err.Error(), "BackoffLimitExceeded")
+	assert.Equal(t, hibernatorv1alpha1.PhaseWakingUp, plan.Status.Phase)
+
+	h.OnError(context.Background(), err)
+
+	assert.Equal(t, hibernatorv1alpha1.PhaseError, plan.Status.Phase)
+	require.Len(t, plan.Status.ExecutionHistory, 1)
+	wakeup := plan.Status.ExecutionHistory[0].WakeupExecution
+	require.NotNil(t, wakeup)
+	assert.False(t, wakeup.Success)
+	require.NotNil(t, wakeup.Verification)
+	assert.Equal(t, hibernatorv1alpha1.StateFailed, wakeup.Verification.State)
+}
+
+func TestWakingUpState_WakeVerification_MissingTemplate_IsPlanError(t *testing.T) {
+	plan := verifiedPlan()
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	h := &wakingUpState{state: st}
+
+	_, err := h.finalize(context.Background(), st.Log, scheduler.ExecutionPlan{}, "")
+	require.Error(t, err)
+	var pe *PlanError
+	assert.True(t, errors.As(err, &pe))
+	assert.Contains(t, err.Error(), "default/smoke-tests not found")
+}
+
+func TestWakingUpState_WakeVerification_SkippedForAbortedCycle(t *testing.T) {
+	plan := verifiedPlan()
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	h := &wakingUpState{state: st}
+
+	_, err := h.finalize(context.Background(), st.Log, scheduler.ExecutionPlan{}, "wakeup exceeded maxCycleDuration of 1h0m0s at stage 1 of 1")
+	require.NoError(t, err)
+	assert.Equal(t, hibernatorv1alpha1.PhaseActive, plan.Status.Phase)
+	require.Len(t, plan.Status.ExecutionHistory, 1)
+	assert.Nil(t, plan.Status.ExecutionHistory[0].WakeupExecution.Verification)
+}
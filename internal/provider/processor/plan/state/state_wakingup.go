@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
 	"github.com/ardikabs/hibernator/internal/notification"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/scheduler"
@@ -42,53 +43,104 @@ func (state *wakingUpState) Handle(ctx context.Context) (StateResult, error) {
 		return StateResult{}, AsPlanError(fmt.Errorf("mismatch between phase and operation: phase=%s operation=%s", plan.Status.Phase, plan.Status.CurrentOperation))
 	}
 
-	return state.execute(ctx, log, hibernatorv1alpha1.OperationWakeUp, true,
+	// finalize may hold the transition to Active while the wake verification Job runs;
+	// its result takes over from execute's.
+	var (
+		finalizeResult StateResult
+		finalizeErr    error
+	)
+	result, err := state.execute(ctx, log, hibernatorv1alpha1.OperationWakeUp, true,
 		func(nextIdx int) { state.nextStage(nextIdx) },
-		func(ctx context.Context, ep scheduler.ExecutionPlan) { state.finalize(ctx, log, ep) },
+		func(ctx context.Context, ep scheduler.ExecutionPlan, abortReason string) {
+			finalizeResult, finalizeErr = state.finalize(ctx, log, ep, abortReason)
+		},
 	)
+	if err != nil {
+		return result, err
+	}
+	if finalizeErr != nil || finalizeResult.RequeueAfter > 0 {
+		return finalizeResult, finalizeErr
+	}
+	return result, nil
 }
 
 // OnError overrides the base state.OnError to persist partial execution history
 // before transitioning to PhaseError. When the error is a PlanError and at least
 // one target has progressed past Pending, a partial WakeupExecution summary is
 // written to ExecutionHistory so that operators can inspect what ran before the
-// failure. The base OnError is always called to handle the PhaseError transition.
+// failure. A failed wake verification is recorded in the same summary. The base
+// OnError is always called to handle the PhaseError transition.
 func (state *wakingUpState) OnError(ctx context.Context, err error) StateResult {
 	var pe *PlanError
 	if errors.As(err, &pe) {
 		plan := state.plan()
-		if hasExecutionProgress(plan) {
+		var (
+			timeout      *cycleTimeoutError
+			verification *wakeVerificationError
+		)
+		aborted := errors.As(err, &timeout)
+		unverified := errors.As(err, &verification)
+		if hasExecutionProgress(plan) || aborted || unverified {
 			summary := BuildOperationSummary(state.Clock, plan, hibernatorv1alpha1.OperationWakeUp)
+			if aborted {
+				summary.Success = false
+				summary.AbortReason = timeout.Error()
+			}
+			if unverified {
+				summary.Success = false
+				summary.Verification = verification.result
+			}
 			currentCycleID := plan.Status.CurrentCycleID
 
+			var pruned []hibernatorv1alpha1.ExecutionCycle
 			state.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
 				NamespacedName: state.Key,
 				Resource:       plan,
 				Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
 					cycleIdx := findOrAppendCycle(&p.Status, currentCycleID)
+					stampCostAllocation(&p.Status.ExecutionHistory[cycleIdx], p, state.CostAllocation)
 					p.Status.ExecutionHistory[cycleIdx].WakeupExecution = summary
-					pruneCycleHistory(&p.Status)
+					pruned = pruneCycleHistory(&p.Status, historyLimit(p))
 				}),
+				PostHook: state.archiveHook(&pruned),
 			})
 		}
 	}
 	return state.state.OnError(ctx, err)
 }
 
-func (state *wakingUpState) finalize(ctx context.Context, log logr.Logger, _ scheduler.ExecutionPlan) {
+// finalize transitions the plan to Active once every target reached a terminal state.
+// With spec.wakeVerification set, the transition waits for the verification Job to
+// succeed; operations aborted by maxCycleDuration are finalized without verification.
+func (state *wakingUpState) finalize(ctx context.Context, log logr.Logger, _ scheduler.ExecutionPlan, abortReason string) (StateResult, error) {
 	plan := state.plan()
 
 	if !IsOperationComplete(plan) {
 		log.V(1).Info("targets still in progress, not completing wakeup yet")
-		return
+		return StateResult{}, nil
+	}
+
+	var verification *hibernatorv1alpha1.WakeVerificationResult
+	if plan.Spec.WakeVerification != nil && abortReason == "" {
+		result, err := state.runWakeVerification(ctx, log, plan)
+		if err != nil {
+			return StateResult{}, err
+		}
+		if result == nil {
+			return StateResult{RequeueAfter: wellknown.RequeueIntervalDuringStage}, nil
+		}
+		verification = result
 	}
 
 	log.Info("all stages completed, finalizing wakeup operation")
 
 	summary := BuildOperationSummary(state.Clock, plan, hibernatorv1alpha1.OperationWakeUp)
+	summary.AbortReason = abortReason
+	summary.Verification = verification
 	currentCycleID := plan.Status.CurrentCycleID
 
 	previousPhase := plan.Status.Phase
+	var pruned []hibernatorv1alpha1.ExecutionCycle
 	state.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
 		NamespacedName: state.Key,
 		Resource:       plan,
@@ -97,8 +149,9 @@ func (state *wakingUpState) finalize(ctx context.Context, log logr.Logger, _ sch
 			p.Status.LastTransitionTime = ptr.To(metav1.NewTime(state.Clock.Now()))
 
 			cycleIdx := findOrAppendCycle(&p.Status, currentCycleID)
+			stampCostAllocation(&p.Status.ExecutionHistory[cycleIdx], p, state.CostAllocation)
 			p.Status.ExecutionHistory[cycleIdx].WakeupExecution = summary
-			pruneCycleHistory(&p.Status)
+			pruned = pruneCycleHistory(&p.Status, historyLimit(p))
 
 			p.Status.RetryCount = 0
 			p.Status.LastRetryTime = nil
@@ -109,10 +162,14 @@ func (state *wakingUpState) finalize(ctx context.Context, log logr.Logger, _ sch
 				return buildPayload(p, hibernatorv1alpha1.EventSuccess, state.Clock.Now)
 			}),
 			state.phaseChangePostHook(previousPhase),
+			state.operationEventHook(hibernatorv1alpha1.EventSuccess, hibernatorv1alpha1.OperationWakeUp),
+			state.auditHook(previousPhase, audit.TriggerExecution, ""),
+			state.archiveHook(&pruned),
 		),
 	})
 
 	state.postWakeupCleanup(ctx, log, plan)
+	return StateResult{}, nil
 }
 
 func (state *wakingUpState) postWakeupCleanup(ctx context.Context, log logr.Logger, plan *hibernatorv1alpha1.HibernatePlan) {
@@ -133,6 +190,14 @@ func (state *wakingUpState) postWakeupCleanup(ctx context.Context, log logr.Logg
 		return
 	}
 
+	var history int32
+	if plan.Spec.Restore != nil {
+		history = plan.Spec.Restore.History
+	}
+	if err := state.RestoreManager.ArchiveRestoreData(ctx, plan.Namespace, plan.Name, int(history)); err != nil {
+		log.Error(err, "failed to archive restore data (non-fatal)")
+	}
+
 	log.Info("all targets restored, unlocking restore data")
 	if err := state.RestoreManager.UnlockRestoreData(ctx, plan.Namespace, plan.Name); err != nil {
 		log.Error(err, "failed to unlock restore data (non-fatal)")
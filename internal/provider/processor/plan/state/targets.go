@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// observeTargets reports the outcome of expanding spec.targetsFrom and connector
+// selectors in the TargetsResolved condition.
+//
+// The provider resolves targets before the plan reaches the processor; a failure
+// arrives as PlanContext.TargetsError. While it is set the condition is False and no new
+// hibernation cycle starts (see transitionToHibernating). Operations already in flight
+// are unaffected because they run from the targets locked in their PlanSnapshot.
+//
+// Plans with static targets only get no condition; the condition is removed once the
+// plan stops referencing presets and selectors. A status update is only queued when
+// something changes.
+func (s *state) observeTargets() {
+	plan := s.plan()
+	if plan.Status.Phase == "" {
+		return
+	}
+
+	current := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionTargetsResolved)
+	if !s.PlanCtx.DynamicTargets {
+		if current != nil {
+			s.queueGenerationStatus(plan, func(p *hibernatorv1alpha1.HibernatePlan) {
+				meta.RemoveStatusCondition(&p.Status.Conditions, hibernatorv1alpha1.ConditionTargetsResolved)
+			})
+		}
+		return
+	}
+
+	desired := metav1.Condition{
+		Type:               hibernatorv1alpha1.ConditionTargetsResolved,
+		Status:             metav1.ConditionTrue,
+		Reason:             hibernatorv1alpha1.ReasonTargetsExpanded,
+		Message:            fmt.Sprintf("%d target(s) after expansion", len(plan.Spec.Targets)),
+		ObservedGeneration: plan.Generation,
+	}
+	if msg := s.PlanCtx.TargetsError; msg != "" {
+		desired.Status = metav1.ConditionFalse
+		desired.Reason = hibernatorv1alpha1.ReasonTargetsInvalid
+		desired.Message = msg
+	}
+
+	if current != nil &&
+		current.Status == desired.Status &&
+		current.Reason == desired.Reason &&
+		current.Message == desired.Message &&
+		current.ObservedGeneration == desired.ObservedGeneration {
+		return
+	}
+
+	if desired.Status == metav1.ConditionFalse {
+		s.Log.Info("targets could not be resolved", "plan", s.Key.String(), "reason", desired.Message)
+	}
+	s.queueGenerationStatus(plan, func(p *hibernatorv1alpha1.HibernatePlan) {
+		meta.SetStatusCondition(&p.Status.Conditions, desired)
+	})
+}
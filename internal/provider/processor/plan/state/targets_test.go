@@ -0,0 +1,95 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// newDynamicTargetsState returns a state for a plan whose targets were expanded
+// from a TargetPreset.
+func newDynamicTargetsState(phase hibernatorv1alpha1.PlanPhase) (*state, *hibernatorv1alpha1.HibernatePlan) {
+	plan := basePlanForState("p", phase)
+	plan.Spec.TargetsFrom = []hibernatorv1alpha1.TargetPresetReference{{Name: "shared"}}
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{{Name: "db"}, {Name: "cache"}}
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+	st.PlanCtx.DynamicTargets = true
+	return st, plan
+}
+
+func TestObserveTargets_Resolved_SetsConditionTrue(t *testing.T) {
+	st, plan := newDynamicTargetsState(hibernatorv1alpha1.PhaseActive)
+
+	st.observeTargets()
+
+	require.Equal(t, 1, planStatuses(st).Len())
+	cond := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionTargetsResolved)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, hibernatorv1alpha1.ReasonTargetsExpanded, cond.Reason)
+	assert.Equal(t, "2 target(s) after expansion", cond.Message)
+
+	// Unchanged: no further status updates.
+	st.observeTargets()
+	assert.Equal(t, 1, planStatuses(st).Len())
+}
+
+func TestObserveTargets_Error_SetsConditionFalse(t *testing.T) {
+	st, plan := newDynamicTargetsState(hibernatorv1alpha1.PhaseActive)
+	st.PlanCtx.TargetsError = "invalid targets: TargetPreset default/shared not found"
+
+	st.observeTargets()
+
+	require.Equal(t, 1, planStatuses(st).Len())
+	cond := meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionTargetsResolved)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, hibernatorv1alpha1.ReasonTargetsInvalid, cond.Reason)
+	assert.Equal(t, st.PlanCtx.TargetsError, cond.Message)
+
+	st.observeTargets()
+	assert.Equal(t, 1, planStatuses(st).Len())
+}
+
+func TestObserveTargets_StaticTargets_RemovesCondition(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	meta.SetStatusCondition(&plan.Status.Conditions, metav1.Condition{
+		Type:   hibernatorv1alpha1.ConditionTargetsResolved,
+		Status: metav1.ConditionTrue,
+		Reason: hibernatorv1alpha1.ReasonTargetsExpanded,
+	})
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	st.observeTargets()
+
+	require.Equal(t, 1, planStatuses(st).Len())
+	assert.Nil(t, meta.FindStatusCondition(plan.Status.Conditions, hibernatorv1alpha1.ConditionTargetsResolved))
+}
+
+func TestObserveTargets_StaticTargets_NoUpdate(t *testing.T) {
+	plan := basePlanForState("p", hibernatorv1alpha1.PhaseActive)
+	st := newHandlerState(plan, newHandlerFakeClient(plan))
+
+	st.observeTargets()
+
+	assert.Zero(t, planStatuses(st).Len())
+}
+
+func TestObserveTargets_Uninitialized_NoUpdate(t *testing.T) {
+	st, _ := newDynamicTargetsState("")
+	st.PlanCtx.TargetsError = "invalid targets"
+
+	st.observeTargets()
+
+	assert.Zero(t, planStatuses(st).Len())
+}
@@ -14,6 +14,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/scheduler"
@@ -53,7 +54,8 @@ func GetStageStatus(log logr.Logger, plan *hibernatorv1alpha1.HibernatePlan, sta
 					terminalCount++
 				case hibernatorv1alpha1.StateRunning:
 					status.HasRunning = true
-				case hibernatorv1alpha1.StatePending:
+				case hibernatorv1alpha1.StatePending, hibernatorv1alpha1.StateCancelled:
+					// Cancelled targets are dispatched again when the plan resumes.
 					status.HasPending = true
 				}
 				break
@@ -183,7 +185,8 @@ func BuildOperationSummary(clk clock.Clock, plan *hibernatorv1alpha1.HibernatePl
 	}
 
 	for _, exec := range plan.Status.Executions {
-		if exec.State == hibernatorv1alpha1.StateFailed || exec.State == hibernatorv1alpha1.StateAborted {
+		if exec.State == hibernatorv1alpha1.StateFailed || exec.State == hibernatorv1alpha1.StateAborted ||
+			exec.State == hibernatorv1alpha1.StateCancelled {
 			summary.Success = false
 		}
 
@@ -277,24 +280,51 @@ func findOrAppendCycle(st *hibernatorv1alpha1.HibernatePlanStatus, cycleID strin
 	return len(st.ExecutionHistory) - 1
 }
 
-// pruneCycleHistory keeps only the most recent 5 cycles in the plan status history to prevent unbounded growth
-func pruneCycleHistory(st *hibernatorv1alpha1.HibernatePlanStatus) {
-	if len(st.ExecutionHistory) > wellknown.MaxCycleHistorySize {
-		st.ExecutionHistory = st.ExecutionHistory[len(st.ExecutionHistory)-wellknown.MaxCycleHistorySize:]
+// stampCostAllocation records the plan's chargeback metadata on the cycle the
+// first time the cycle is written, keeping attribution stable for the whole cycle.
+func stampCostAllocation(cycle *hibernatorv1alpha1.ExecutionCycle, plan *hibernatorv1alpha1.HibernatePlan, alloc CostAllocation) {
+	if cycle.CostAllocation != nil {
+		return
 	}
+	cycle.CostAllocation = alloc.For(plan.Labels)
+}
+
+// pruneCycleHistory keeps only the most recent limit cycles in the plan status history to
+// prevent unbounded growth, and returns the pruned cycles, oldest first.
+func pruneCycleHistory(st *hibernatorv1alpha1.HibernatePlanStatus, limit int) []hibernatorv1alpha1.ExecutionCycle {
+	if len(st.ExecutionHistory) <= limit {
+		return nil
+	}
+
+	cut := len(st.ExecutionHistory) - limit
+	pruned := append([]hibernatorv1alpha1.ExecutionCycle(nil), st.ExecutionHistory[:cut]...)
+	st.ExecutionHistory = st.ExecutionHistory[cut:]
+	return pruned
+}
+
+// historyLimit returns the number of cycles the plan keeps in its status history.
+func historyLimit(plan *hibernatorv1alpha1.HibernatePlan) int {
+	if plan.Spec.ExecutionHistory != nil && plan.Spec.ExecutionHistory.Limit > 0 {
+		return int(plan.Spec.ExecutionHistory.Limit)
+	}
+	return wellknown.MaxCycleHistorySize
 }
 
 // executionSnapshot captures the progress-relevant fields of an ExecutionStatus
 // for producer-side dedup in the execute() hot loop. Fields that change only on
 // state transitions (State) and fields that change during Running (Attempts,
-// StartedAt, JobRef, LogsRef, Message) are all included so that incremental
-// progress within a phase is persisted to K8s, not just terminal transitions.
+// StartedAt, JobRef, LogsRef, Message, Progress) are all included so that
+// incremental progress within a phase is persisted to K8s, not just terminal
+// transitions.
 type executionSnapshot struct {
-	State    hibernatorv1alpha1.ExecutionState
-	Attempts int32
-	Message  string
-	JobRef   string
-	LogsRef  string
+	State         hibernatorv1alpha1.ExecutionState
+	Attempts      int32
+	Message       string
+	FailureReason hibernatorv1alpha1.FailureReason
+	JobRef        string
+	LogsRef       string
+	Stale         bool
+	Progress      hibernatorv1alpha1.ExecutionProgress
 }
 
 // snapshotExecutionStates creates a map of target name to execution snapshot
@@ -302,11 +332,14 @@ type executionSnapshot struct {
 func snapshotExecutionStates(execs []hibernatorv1alpha1.ExecutionStatus) map[string]executionSnapshot {
 	return lo.Associate(execs, func(e hibernatorv1alpha1.ExecutionStatus) (string, executionSnapshot) {
 		return e.Target, executionSnapshot{
-			State:    e.State,
-			Attempts: e.Attempts,
-			Message:  e.Message,
-			JobRef:   e.JobRef,
-			LogsRef:  e.LogsRef,
+			State:         e.State,
+			Attempts:      e.Attempts,
+			Message:       e.Message,
+			FailureReason: e.FailureReason,
+			JobRef:        e.JobRef,
+			LogsRef:       e.LogsRef,
+			Stale:         e.Stale,
+			Progress:      ptr.Deref(e.Progress, hibernatorv1alpha1.ExecutionProgress{}),
 		}
 	})
 }
@@ -323,7 +356,8 @@ func executionStatesEqual(prev map[string]executionSnapshot, current []hibernato
 			return false
 		}
 		if p.State != e.State || p.Attempts != e.Attempts ||
-			p.Message != e.Message || p.JobRef != e.JobRef || p.LogsRef != e.LogsRef {
+			p.Message != e.Message || p.FailureReason != e.FailureReason || p.JobRef != e.JobRef || p.LogsRef != e.LogsRef ||
+			p.Stale != e.Stale || p.Progress != ptr.Deref(e.Progress, hibernatorv1alpha1.ExecutionProgress{}) {
 			return false
 		}
 	}
@@ -529,7 +529,7 @@ func TestPruneCycleHistory_UnderLimit_NoChange(t *testing.T) {
 		})
 	}
 
-	pruneCycleHistory(st)
+	assert.Empty(t, pruneCycleHistory(st, wellknown.MaxCycleHistorySize))
 	assert.Len(t, st.ExecutionHistory, wellknown.MaxCycleHistorySize)
 }
 
@@ -543,7 +543,9 @@ func TestPruneCycleHistory_OverLimit_KeepsNewest(t *testing.T) {
 	total := len(st.ExecutionHistory)
 	lastFive := st.ExecutionHistory[total-5:]
 
-	pruneCycleHistory(st)
+	pruned := pruneCycleHistory(st, wellknown.MaxCycleHistorySize)
+	require.Len(t, pruned, 3)
+	assert.Equal(t, "a", pruned[0].CycleID)
 
 	require.Len(t, st.ExecutionHistory, 5)
 	for i := range lastFive {
@@ -573,3 +575,50 @@ func TestFindOrAppendCycle_ExistingCycle_ReturnsIndex(t *testing.T) {
 	assert.Equal(t, 1, idx)
 	assert.Len(t, st.ExecutionHistory, 2, "should not append a duplicate")
 }
+
+// ---------------------------------------------------------------------------
+// stampCostAllocation
+// ---------------------------------------------------------------------------
+
+func TestStampCostAllocation_CopiesConfiguredLabels(t *testing.T) {
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			"team":                    "payments",
+			"example.com/cost-center": "cc-42",
+			"unrelated":               "x",
+		}},
+	}
+	alloc := CostAllocation{
+		"team":        "team",
+		"project":     "example.com/cost-center",
+		"environment": "environment",
+	}
+	cycle := &hibernatorv1alpha1.ExecutionCycle{CycleID: "c1"}
+
+	stampCostAllocation(cycle, plan, alloc)
+
+	assert.Equal(t, map[string]string{"team": "payments", "project": "cc-42"}, cycle.CostAllocation)
+}
+
+func TestStampCostAllocation_KeepsExistingAttribution(t *testing.T) {
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "search"}},
+	}
+	cycle := &hibernatorv1alpha1.ExecutionCycle{
+		CycleID:        "c1",
+		CostAllocation: map[string]string{"team": "payments"},
+	}
+
+	stampCostAllocation(cycle, plan, CostAllocation{"team": "team"})
+
+	assert.Equal(t, "payments", cycle.CostAllocation["team"], "labels changed mid-cycle must not re-attribute the cycle")
+}
+
+func TestStampCostAllocation_NoMatchingLabels_LeavesNil(t *testing.T) {
+	plan := &hibernatorv1alpha1.HibernatePlan{}
+	cycle := &hibernatorv1alpha1.ExecutionCycle{CycleID: "c1"}
+
+	stampCostAllocation(cycle, plan, CostAllocation{"team": "team"})
+
+	assert.Nil(t, cycle.CostAllocation)
+}
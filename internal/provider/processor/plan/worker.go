@@ -7,9 +7,13 @@ package plan
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
@@ -20,6 +24,7 @@ import (
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/restore"
 	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/tracing"
 	"github.com/ardikabs/hibernator/pkg/keyedworker"
 )
 
@@ -70,6 +75,7 @@ type Worker struct {
 	// Inbound context slot from the Coordinator — latest-wins delivery.
 	slot keyedworker.Slot[*message.PlanContext]
 
+	CostAllocation state.CostAllocation
 	Planner        *scheduler.Planner
 	RestoreManager *restore.Manager
 	Resources      *message.ControllerResources
@@ -148,6 +154,7 @@ func (s *Worker) buildConfig() *state.Config {
 		Log:            s.log,
 		Infrastructure: s.Infrastructure,
 		ExecutorInfra:  s.ExecutorInfra,
+		CostAllocation: s.CostAllocation,
 		Callbacks: state.StateCallbacks{
 			OnJobMissing: s.trackConsecutiveJobMiss,
 			OnJobFound:   s.resetConsecutiveJobMiss,
@@ -171,7 +178,6 @@ func (s *Worker) handleWithDepth(ctx context.Context, planCtx *message.PlanConte
 	}
 
 	plan := planCtx.Plan
-	planName := plan.Name
 	phaseBefore := string(plan.Status.Phase)
 
 	if depth >= maxHandleDepth {
@@ -193,23 +199,36 @@ func (s *Worker) handleWithDepth(ctx context.Context, planCtx *message.PlanConte
 		err    error
 	)
 
+	spanCtx, span := tracing.Tracer().Start(tracing.WithCycle(ctx, cycleTrace(plan)), "HibernatePlan.reconcile",
+		trace.WithAttributes(
+			attribute.String("hibernator.plan", s.key.String()),
+			attribute.String("hibernator.phase", phaseBefore),
+			attribute.String("hibernator.cycle_id", plan.Status.CurrentCycleID),
+			attribute.String("hibernator.operation", string(plan.Status.CurrentOperation)),
+			attribute.Bool("hibernator.on_deadline", onDeadline),
+		))
+
 	status := "success"
 	if onDeadline {
-		result, err = handler.OnDeadline(ctx)
+		result, err = handler.OnDeadline(spanCtx)
 	} else {
-		result, err = handler.Handle(ctx)
+		result, err = handler.Handle(spanCtx)
 	}
 	if err != nil {
 		status = "error"
-		result = handler.OnError(ctx, err)
+		result = handler.OnError(spanCtx, err)
 	}
 
 	duration := time.Since(start).Seconds()
 	phaseAfter := string(plan.Status.Phase)
+	span.SetAttributes(attribute.String("hibernator.phase_after", phaseAfter))
+	tracing.EndSpan(span, err)
 
-	// ReconcileTotal / ReconcileDuration — one observation per handle() call.
-	metrics.ReconcileTotal.WithLabelValues(planName, phaseBefore, status).Inc()
-	metrics.ReconcileDuration.WithLabelValues(planName, phaseBefore).Observe(duration)
+	// ReconcileTotal / ReconcileDuration / ReconcileOutcomeTotal — one observation per handle() call.
+	planLabel := metrics.PlanLabel(s.key)
+	metrics.ReconcileTotal.WithLabelValues(planLabel, phaseBefore, status).Inc()
+	metrics.ReconcileDuration.WithLabelValues(planLabel, phaseBefore).Observe(duration)
+	metrics.ReconcileOutcomeTotal.WithLabelValues(planLabel, reconcileOutcome(err, phaseBefore, phaseAfter)).Inc()
 
 	// ActivePlanGauge — update on phase transition.
 	if phaseBefore != phaseAfter {
@@ -231,6 +250,36 @@ func (s *Worker) handleWithDepth(ctx context.Context, planCtx *message.PlanConte
 	s.timers.Apply(result)
 }
 
+// cycleTrace identifies the trace shared by the passes executing the current
+// operation of the plan's cycle, or returns "" outside of an execution.
+func cycleTrace(plan *hibernatorv1alpha1.HibernatePlan) string {
+	switch plan.Status.Phase {
+	case hibernatorv1alpha1.PhaseHibernating, hibernatorv1alpha1.PhaseWakingUp:
+		if plan.Status.CurrentCycleID == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s/%s/%s", plan.UID, plan.Status.CurrentCycleID, plan.Status.CurrentOperation)
+	default:
+		return ""
+	}
+}
+
+// reconcileOutcome classifies a handle() call for ReconcileOutcomeTotal.
+// A handler error is either a write conflict or an execution error; a handler
+// that succeeds but moves the plan into PhaseError is an execution error too.
+func reconcileOutcome(err error, phaseBefore, phaseAfter string) string {
+	switch {
+	case err != nil && apierrors.IsConflict(err):
+		return metrics.OutcomeConflict
+	case err != nil:
+		return metrics.OutcomeExecutionError
+	case phaseAfter == string(hibernatorv1alpha1.PhaseError) && phaseBefore != phaseAfter:
+		return metrics.OutcomeExecutionError
+	default:
+		return metrics.OutcomeSuccess
+	}
+}
+
 // trackConsecutiveJobMiss increments the consecutive-miss counter for target and returns
 // true once the counter reaches consecutiveJobMissThreshold, signalling that the job
 // is genuinely gone and the target should be reset to StatePending for re-dispatch.
@@ -7,6 +7,7 @@ package plan
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -16,10 +17,13 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/clock"
 
 	"github.com/ardikabs/hibernator/internal/message"
+	"github.com/ardikabs/hibernator/internal/metrics"
 	"github.com/ardikabs/hibernator/internal/provider/processor/plan/state"
 	"github.com/ardikabs/hibernator/pkg/keyedworker"
 )
@@ -291,3 +295,31 @@ func TestWorker_TimerSet_Cleanup_ClearsAllTimers(t *testing.T) {
 	assert.False(t, w.timers.Deadline.IsArmed())
 	assert.False(t, w.timers.Inactivity.IsArmed())
 }
+
+func TestCycleTrace(t *testing.T) {
+	plan := &hibernatorv1alpha1.HibernatePlan{}
+	plan.UID = "uid"
+	plan.Status.CurrentCycleID = "cycle-1"
+	plan.Status.CurrentOperation = hibernatorv1alpha1.OperationHibernate
+
+	plan.Status.Phase = hibernatorv1alpha1.PhaseHibernating
+	assert.Equal(t, "uid/cycle-1/shutdown", cycleTrace(plan))
+
+	plan.Status.Phase = hibernatorv1alpha1.PhaseHibernated
+	assert.Empty(t, cycleTrace(plan), "outside of an execution")
+
+	plan.Status.Phase = hibernatorv1alpha1.PhaseWakingUp
+	plan.Status.CurrentCycleID = ""
+	assert.Empty(t, cycleTrace(plan), "without a cycle")
+}
+
+func TestReconcileOutcome(t *testing.T) {
+	conflict := apierrors.NewConflict(schema.GroupResource{Resource: "hibernateplans"}, "p", errors.New("stale"))
+
+	assert.Equal(t, metrics.OutcomeSuccess, reconcileOutcome(nil, "Active", "Hibernating"))
+	assert.Equal(t, metrics.OutcomeConflict, reconcileOutcome(conflict, "Active", "Active"))
+	assert.Equal(t, metrics.OutcomeExecutionError, reconcileOutcome(errors.New("boom"), "Active", "Active"))
+	assert.Equal(t, metrics.OutcomeExecutionError, reconcileOutcome(nil, "Hibernating", "Error"))
+	// Remaining in Error is not counted as a new failure.
+	assert.Equal(t, metrics.OutcomeSuccess, reconcileOutcome(nil, "Error", "Error"))
+}
@@ -6,7 +6,7 @@ Licensed under the Apache License, Version 2.0.
 // Package requeue provides the PlanRequeueProcessor, the sole owner of time-based
 // re-enqueuing for HibernatePlan reconciliation. It subscribes to PlanResources and
 // computes the next boundary time from both the schedule evaluation and exception
-// lifecycle (ValidFrom/ValidUntil). When a boundary is reached, it fires a
+// lifecycle (ValidFrom/ValidUntil and recurring occurrences). When a boundary is reached, it fires a
 // PlanEnqueuer.Enqueue() to trigger a fresh reconcile — no other component manages
 // time-based requeues.
 package requeue
@@ -134,6 +134,8 @@ func (p *PlanRequeueProcessor) Start(ctx context.Context) error {
 //   - Schedule.NextEvent: the absolute timestamp of the next schedule transition
 //     (already includes schedule buffer and safety buffer)
 //   - Exception ValidFrom/ValidUntil: any future boundary timestamp across all exceptions
+//   - Recurring exception occurrences: the start or end of the occurrence in status
+//   - Exception garbage collection: validUntil plus spec.ttlAfterExpiry
 //
 // Exception boundaries are evaluated purely from Spec timestamps, independent of
 // Status.State. This ensures fresh exceptions (Status.State == "") and exceptions
@@ -163,6 +165,16 @@ func computeBoundary(now time.Time, planCtx *message.PlanContext) (time.Time, bo
 		if !exc.Spec.ValidUntil.IsZero() && now.Before(exc.Spec.ValidUntil.Time) {
 			earliest = minTime(earliest, exc.Spec.ValidUntil.Time)
 		}
+		if deleteAt, ok := exc.DeleteAfter(); ok && now.Before(deleteAt) {
+			earliest = minTime(earliest, deleteAt)
+		}
+		if occ := exc.Status.Occurrence; exc.Spec.Recurrence != nil && occ != nil {
+			for _, t := range []time.Time{occ.Start.Time, occ.End.Time} {
+				if now.Before(t) {
+					earliest = minTime(earliest, t)
+				}
+			}
+		}
 	}
 
 	return earliest, !earliest.IsZero()
@@ -224,6 +224,33 @@ func TestComputeBoundary_MultipleExceptions_PicksEarliest(t *testing.T) {
 	assert.Equal(t, now.Add(1*time.Hour), boundary, "should pick the earliest ValidFrom across all exceptions")
 }
 
+func TestComputeBoundary_RecurringOccurrence_IsIncluded(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := planCtxWithException(now.Add(-time.Hour), now.Add(90*24*time.Hour))
+	ctx.Exceptions[0].Spec.Recurrence = &hibernatorv1alpha1.ExceptionRecurrence{
+		Frequency: hibernatorv1alpha1.RecurrenceMonthly,
+		Duration:  "48h",
+	}
+	ctx.Exceptions[0].Status.Occurrence = &hibernatorv1alpha1.ExceptionOccurrence{
+		Start: metav1.Time{Time: now.Add(36 * time.Hour)},
+		End:   metav1.Time{Time: now.Add(84 * time.Hour)},
+	}
+
+	boundary, ok := computeBoundary(now, ctx)
+	require.True(t, ok)
+	assert.Equal(t, now.Add(36*time.Hour), boundary, "should wake up for the next occurrence")
+}
+
+func TestComputeBoundary_ExpiredExceptionTTL_IsIncluded(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ctx := planCtxWithException(now.Add(-48*time.Hour), now.Add(-time.Hour))
+	ctx.Exceptions[0].Spec.TTLAfterExpiry = "24h"
+
+	boundary, ok := computeBoundary(now, ctx)
+	require.True(t, ok)
+	assert.Equal(t, now.Add(23*time.Hour), boundary, "should wake up to delete the expired exception")
+}
+
 // ---------------------------------------------------------------------------
 // PlanRequeueProcessor — timer fires and enqueues
 // ---------------------------------------------------------------------------
@@ -9,12 +9,16 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/telepresenceio/watchable"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -22,9 +26,17 @@ import (
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/message"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
+	"github.com/ardikabs/hibernator/internal/scheduler"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
+// maxApprovals caps the approval audit trail kept in exception status.
+const maxApprovals = 10
+
+// awaitingApprovalMessage is the status message of an exception held in
+// Pending until a plan owner approves it.
+const awaitingApprovalMessage = "Exception awaiting approval from a plan owner"
+
 // LifecycleProcessor manages the ScheduleException lifecycle state machine:
 // Pending → Active → Expired.
 //
@@ -38,12 +50,27 @@ import (
 //   - Finalizer management
 //   - Plan label management for efficient querying
 //   - State transitions based on ValidFrom/ValidUntil
+//   - Occurrence tracking for recurring exceptions
+//   - Approval gating and the approval audit trail
+//   - Deletion of expired exceptions after spec.ttlAfterExpiry
 //   - Deletion cleanup (removing exception reference from plan status)
+//   - ExceptionApplied Events on plans whose exceptions become active
 type LifecycleProcessor struct {
 	client.Client
 	Clock clock.Clock
 	Log   logr.Logger
 
+	// Recorder records ExceptionApplied Events on plans. Nil records no Events.
+	Recorder record.EventRecorder
+
+	// Shard is the shard whose plans the controller reconciles, empty when it
+	// reconciles all of them. An exception targeting plans of several shards
+	// reaches each of them; only the shard of the first plan by name drives its
+	// lifecycle, while every shard keeps the status of its own plans current.
+	Shard string
+	// APIReader reads the plans of every shard when Shard is set.
+	APIReader client.Reader
+
 	Resources *message.ControllerResources
 	Statuses  *statusprocessor.ControllerStatuses
 }
@@ -88,6 +115,16 @@ func (p *LifecycleProcessor) handlePlanUpdate(ctx context.Context, log logr.Logg
 		exc := &planCtx.Exceptions[i]
 		excKey := types.NamespacedName{Name: exc.Name, Namespace: exc.Namespace}
 
+		owned, err := p.ownsException(ctx, exc)
+		if err != nil {
+			errChan <- fmt.Errorf("exception %s: failed to resolve its owning shard: %w", excKey, err)
+			continue
+		}
+		if !owned {
+			log.V(1).Info("exception is driven by another shard", "exception", excKey)
+			continue
+		}
+
 		if !exc.DeletionTimestamp.IsZero() {
 			p.handleExceptionDelete(ctx, log, excKey, errChan)
 			continue
@@ -100,6 +137,39 @@ func (p *LifecycleProcessor) handlePlanUpdate(ctx context.Context, log logr.Logg
 	p.updateExceptionReferences(log, planKey, planCtx.Plan, planCtx.Exceptions)
 }
 
+// ownsException reports whether this shard drives the lifecycle of the
+// exception: its finalizer, labels, status and deletion. Every shard whose plans
+// an exception targets receives it, so with Shard set an exception targeting
+// several plans is owned by the shard of the first of its sharded plans by
+// name. An exception targeting a single plan is owned by the shard reconciling
+// that plan.
+func (p *LifecycleProcessor) ownsException(ctx context.Context, exception *hibernatorv1alpha1.ScheduleException) (bool, error) {
+	if p.Shard == "" || !exception.TargetsMultiplePlans() {
+		return true, nil
+	}
+
+	plans, err := p.referencedPlans(ctx, p.APIReader, exception)
+	if err != nil {
+		return false, err
+	}
+	var owner *hibernatorv1alpha1.HibernatePlan
+	for i := range plans {
+		plan := &plans[i]
+		if plan.Labels[wellknown.LabelShard] != "" && (owner == nil || plan.Name < owner.Name) {
+			owner = plan
+		}
+	}
+	return owner == nil || owner.Labels[wellknown.LabelShard] == p.Shard, nil
+}
+
+// planReader returns the reader of the plans of every shard.
+func (p *LifecycleProcessor) planReader() client.Reader {
+	if p.Shard != "" && p.APIReader != nil {
+		return p.APIReader
+	}
+	return p.Client
+}
+
 // updateExceptionReferences builds a sorted ExceptionReference list from the
 // PlanContext's exceptions and queues a plan status update. This keeps the plan's
 // ExceptionReferences in sync without requiring a separate observer or extra API calls,
@@ -156,15 +226,51 @@ func (p *LifecycleProcessor) updateExceptionReferences(log logr.Logger, key type
 		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
 			p.Status.ExceptionReferences = exceptionRefs
 		}),
+		PostHook: p.exceptionAppliedHook(plan.Status.ExceptionReferences, exceptionRefs),
 	})
 
 	log.V(1).Info("queued exception references update for plan", "plan", key, "count", len(exceptionRefs))
 }
 
+// exceptionAppliedHook returns a PostHook that records an ExceptionApplied Event on
+// the written plan for every exception that is active in refs but was not in
+// previous. Returns nil when no recorder is configured or no exception became active.
+func (p *LifecycleProcessor) exceptionAppliedHook(previous, refs []hibernatorv1alpha1.ExceptionReference) statusprocessor.HookFunc[*hibernatorv1alpha1.HibernatePlan] {
+	if p.Recorder == nil {
+		return nil
+	}
+
+	wasActive := make(map[string]bool, len(previous))
+	for _, ref := range previous {
+		wasActive[ref.Name] = ref.State == hibernatorv1alpha1.ExceptionStateActive
+	}
+	var applied []hibernatorv1alpha1.ExceptionReference
+	for _, ref := range refs {
+		if ref.State == hibernatorv1alpha1.ExceptionStateActive && !wasActive[ref.Name] {
+			applied = append(applied, ref)
+		}
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+
+	return func(_ context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
+		for _, ref := range applied {
+			message := fmt.Sprintf("Schedule exception %s (%s) is active", ref.Name, ref.Type)
+			if !ref.ValidUntil.IsZero() {
+				message += " until " + ref.ValidUntil.UTC().Format(time.RFC3339)
+			}
+			p.Recorder.Event(plan, corev1.EventTypeNormal, wellknown.EventReasonExceptionApplied, message)
+		}
+		return nil
+	}
+}
+
 // handlePlanDelete handles all exceptions associated with a deleted plan.
 // Exceptions with an OwnerReference to the plan are skipped — Kubernetes garbage
-// collection will cascade-delete them. All other exceptions are transitioned to
-// the Detached state to signal that their referenced plan no longer exists.
+// collection will cascade-delete them, as are exceptions targeting multiple plans.
+// All other exceptions are transitioned to the Detached state to signal that their
+// referenced plan no longer exists.
 func (p *LifecycleProcessor) handlePlanDelete(ctx context.Context, log logr.Logger, planKey types.NamespacedName, errChan chan error) {
 	// Use the cached client (not APIReader) because field indexes only work with the cache.
 	var exceptionList hibernatorv1alpha1.ScheduleExceptionList
@@ -180,6 +286,12 @@ func (p *LifecycleProcessor) handlePlanDelete(ctx context.Context, log logr.Logg
 		exc := &exceptionList.Items[i]
 		excKey := types.NamespacedName{Name: exc.Name, Namespace: exc.Namespace}
 
+		// An exception targeting several plans outlives any one of them.
+		if exc.TargetsMultiplePlans() {
+			log.V(1).Info("exception targets multiple plans, skipping detach", "exception", excKey)
+			continue
+		}
+
 		// If the exception has an OwnerReference to the plan, Kubernetes GC
 		// will cascade-delete it — nothing to do here.
 		if hasOwnerReferenceToPlan(exc, planKey) {
@@ -203,7 +315,7 @@ func (p *LifecycleProcessor) handleExceptionUpdate(ctx context.Context, log logr
 		"exception", exception.Name,
 		"namespace", exception.Namespace,
 		"type", exception.Spec.Type,
-		"plan", exception.Spec.PlanRef.Name,
+		"plan", describePlans(exception),
 	)
 
 	log.V(1).Info("processing exception",
@@ -234,15 +346,23 @@ func (p *LifecycleProcessor) handleExceptionUpdate(ctx context.Context, log logr
 		errChan <- fmt.Errorf("exception %s/%s: failed to ensure plan label: %w", exception.Namespace, exception.Name, err)
 	}
 
-	// Determine desired state
 	now := p.Clock.Now()
+
+	// Record a newly observed approval before gating on it
+	p.recordApproval(log, key, exception, now)
+
+	// Track the current occurrence of a recurring exception before deriving its state
+	p.syncOccurrence(log, key, exception, now)
+
+	// Determine desired state
 	desiredState := p.computeDesiredState(now, exception)
 
+	from, until := exception.EffectiveWindow()
 	log.V(1).Info("computed desired exception state",
 		"desiredState", desiredState,
 		"currentState", exception.Status.State,
-		"validFrom", exception.Spec.ValidFrom.Time,
-		"validUntil", exception.Spec.ValidUntil.Time)
+		"validFrom", from,
+		"validUntil", until)
 
 	// Transition state if needed
 	if exception.Status.State != desiredState {
@@ -250,6 +370,18 @@ func (p *LifecycleProcessor) handleExceptionUpdate(ctx context.Context, log logr
 		return
 	}
 
+	// Garbage-collect an exception whose ttlAfterExpiry has elapsed. The deletion goes
+	// through the finalizer, which prunes the exception from plan status.
+	if exception.Status.State == hibernatorv1alpha1.ExceptionStateExpired {
+		if deleteAt, ok := exception.DeleteAfter(); ok && !now.Before(deleteAt) {
+			log.Info("deleting expired exception", "ttlAfterExpiry", exception.Spec.TTLAfterExpiry)
+			if err := p.Delete(ctx, exception); client.IgnoreNotFound(err) != nil {
+				errChan <- fmt.Errorf("exception %s/%s: failed to delete after ttlAfterExpiry: %w", exception.Namespace, exception.Name, err)
+			}
+			return
+		}
+	}
+
 	log.V(1).Info("exception state is current, checking message update")
 
 	// Update informational message
@@ -257,6 +389,7 @@ func (p *LifecycleProcessor) handleExceptionUpdate(ctx context.Context, log logr
 }
 
 // computeDesiredState determines what state the exception should be in based on current time.
+// A recurring exception is Active only during an occurrence and Pending between them.
 func (p *LifecycleProcessor) computeDesiredState(now time.Time, exception *hibernatorv1alpha1.ScheduleException) hibernatorv1alpha1.ExceptionState {
 	if now.Before(exception.Spec.ValidFrom.Time) {
 		return hibernatorv1alpha1.ExceptionStatePending
@@ -264,9 +397,161 @@ func (p *LifecycleProcessor) computeDesiredState(now time.Time, exception *hiber
 	if !exception.Spec.ValidUntil.IsZero() && now.After(exception.Spec.ValidUntil.Time) {
 		return hibernatorv1alpha1.ExceptionStateExpired
 	}
+	if exception.Spec.Recurrence != nil {
+		start, end := exception.EffectiveWindow()
+		if start.IsZero() || now.Before(start) || !now.Before(end) {
+			return hibernatorv1alpha1.ExceptionStatePending
+		}
+	}
+	if awaitingApproval(exception) {
+		return hibernatorv1alpha1.ExceptionStatePending
+	}
 	return hibernatorv1alpha1.ExceptionStateActive
 }
 
+// toRecurrence converts the exception's recurrence into a scheduler rule.
+func toRecurrence(exception *hibernatorv1alpha1.ScheduleException) (scheduler.Recurrence, error) {
+	spec := exception.Spec.Recurrence
+	duration, err := time.ParseDuration(spec.Duration)
+	if err != nil {
+		return scheduler.Recurrence{}, fmt.Errorf("invalid duration %q: %w", spec.Duration, err)
+	}
+	loc := time.UTC
+	if spec.Timezone != "" {
+		if loc, err = time.LoadLocation(spec.Timezone); err != nil {
+			return scheduler.Recurrence{}, fmt.Errorf("invalid timezone %q: %w", spec.Timezone, err)
+		}
+	}
+
+	interval := int(spec.Interval)
+	if interval == 0 {
+		interval = 1
+	}
+	monthDays := make([]int, len(spec.ByMonthDay))
+	for i, d := range spec.ByMonthDay {
+		monthDays[i] = int(d)
+	}
+
+	return scheduler.Recurrence{
+		Frequency:  scheduler.RecurrenceFrequency(spec.Frequency),
+		Interval:   interval,
+		ByDay:      spec.ByDay,
+		ByMonthDay: monthDays,
+		Duration:   duration,
+		Location:   loc,
+	}, nil
+}
+
+// syncOccurrence records the current or next occurrence of a recurring exception in
+// its status. Consumers read the occurrence through EffectiveWindow, so the status
+// update is applied to the in-memory exception as well.
+func (p *LifecycleProcessor) syncOccurrence(log logr.Logger, key types.NamespacedName, exception *hibernatorv1alpha1.ScheduleException, now time.Time) {
+	var occurrence *hibernatorv1alpha1.ExceptionOccurrence
+	if exception.Spec.Recurrence != nil {
+		rule, err := toRecurrence(exception)
+		if err != nil {
+			log.Error(err, "invalid exception recurrence")
+		} else if start, end, ok := rule.Occurrence(now, exception.Spec.ValidFrom.Time, exception.Spec.ValidUntil.Time); ok {
+			occurrence = &hibernatorv1alpha1.ExceptionOccurrence{
+				Start: metav1.NewTime(start),
+				End:   metav1.NewTime(end),
+			}
+		}
+	}
+
+	if occurrenceEqual(exception.Status.Occurrence, occurrence) {
+		return
+	}
+
+	p.Statuses.ExceptionStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.ScheduleException]{
+		NamespacedName: key,
+		Resource:       exception,
+		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.ScheduleException](func(e *hibernatorv1alpha1.ScheduleException) {
+			e.Status.Occurrence = occurrence.DeepCopy()
+		}),
+	})
+
+	if occurrence != nil {
+		log.V(1).Info("updated exception occurrence", "start", occurrence.Start.Time, "end", occurrence.End.Time)
+	} else {
+		log.V(1).Info("cleared exception occurrence")
+	}
+}
+
+func occurrenceEqual(a, b *hibernatorv1alpha1.ExceptionOccurrence) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Start.Equal(&b.Start) && a.End.Equal(&b.End)
+}
+
+// approvedBy returns the plan owner who approved the exception's current
+// generation. Approvals of an earlier generation no longer count.
+func approvedBy(exception *hibernatorv1alpha1.ScheduleException) (string, bool) {
+	approver := exception.Annotations[wellknown.AnnotationApprovedBy]
+	if approver == "" {
+		return "", false
+	}
+	generation, err := strconv.ParseInt(exception.Annotations[wellknown.AnnotationApprovedGeneration], 10, 64)
+	if err != nil || generation != exception.Generation {
+		return "", false
+	}
+	return approver, true
+}
+
+// awaitingApproval reports whether the exception requires approval that has
+// not been given for its current generation.
+func awaitingApproval(exception *hibernatorv1alpha1.ScheduleException) bool {
+	if !exception.Spec.RequiresApproval {
+		return false
+	}
+	_, ok := approvedBy(exception)
+	return !ok
+}
+
+// hasApproval reports whether approvals already records approver for generation.
+func hasApproval(approvals []hibernatorv1alpha1.ExceptionApproval, approver string, generation int64) bool {
+	for _, a := range approvals {
+		if a.Approver == approver && a.Generation == generation {
+			return true
+		}
+	}
+	return false
+}
+
+// recordApproval appends the approval of the exception's current generation to
+// the status audit trail the first time it is observed.
+func (p *LifecycleProcessor) recordApproval(log logr.Logger, key types.NamespacedName, exception *hibernatorv1alpha1.ScheduleException, now time.Time) {
+	if !exception.Spec.RequiresApproval {
+		return
+	}
+	approver, ok := approvedBy(exception)
+	if !ok || hasApproval(exception.Status.Approvals, approver, exception.Generation) {
+		return
+	}
+
+	approval := hibernatorv1alpha1.ExceptionApproval{
+		Approver:   approver,
+		Generation: exception.Generation,
+		ApprovedAt: metav1.NewTime(now),
+	}
+	p.Statuses.ExceptionStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.ScheduleException]{
+		NamespacedName: key,
+		Resource:       exception,
+		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.ScheduleException](func(e *hibernatorv1alpha1.ScheduleException) {
+			if hasApproval(e.Status.Approvals, approval.Approver, approval.Generation) {
+				return
+			}
+			e.Status.Approvals = append(e.Status.Approvals, approval)
+			if n := len(e.Status.Approvals); n > maxApprovals {
+				e.Status.Approvals = e.Status.Approvals[n-maxApprovals:]
+			}
+		}),
+	})
+
+	log.Info("recorded exception approval", "approver", approver, "generation", exception.Generation)
+}
+
 // transitionState moves the exception to a new state.
 func (p *LifecycleProcessor) transitionState(_ context.Context, log logr.Logger, key types.NamespacedName, exception *hibernatorv1alpha1.ScheduleException, desiredState hibernatorv1alpha1.ExceptionState, now time.Time) {
 	oldState := exception.Status.State
@@ -287,6 +572,9 @@ func (p *LifecycleProcessor) transitionState(_ context.Context, log logr.Logger,
 				e.Status.ExpiredAt = nil
 				e.Status.DetachedAt = nil
 				e.Status.Message = "Exception pending"
+				if awaitingApproval(e) {
+					e.Status.Message = awaitingApprovalMessage
+				}
 			case hibernatorv1alpha1.ExceptionStateActive:
 				nowTime := now
 				e.Status.AppliedAt = &metav1.Time{Time: nowTime}
@@ -317,6 +605,9 @@ func (p *LifecycleProcessor) updateMessage(_ context.Context, log logr.Logger, k
 		newMessage = formatActiveMessage(now, exception)
 	case hibernatorv1alpha1.ExceptionStateExpired:
 		newMessage = "Exception expired"
+		if deleteAt, ok := exception.DeleteAfter(); ok {
+			newMessage = fmt.Sprintf("Exception expired, deleted after %s", deleteAt.UTC().Format(time.RFC3339))
+		}
 	case hibernatorv1alpha1.ExceptionStateDetached:
 		newMessage = fmt.Sprintf("Referenced plan %q no longer exists; exception is detached", exception.Spec.PlanRef.Name)
 	default:
@@ -344,11 +635,18 @@ func (p *LifecycleProcessor) updateMessage(_ context.Context, log logr.Logger, k
 
 // formatPendingMessage creates a human-readable message for pending exceptions.
 func formatPendingMessage(now time.Time, exception *hibernatorv1alpha1.ScheduleException) string {
-	if exception.Spec.ValidFrom.IsZero() {
+	if awaitingApproval(exception) {
+		return awaitingApprovalMessage
+	}
+	from, _ := exception.EffectiveWindow()
+	if exception.Spec.Recurrence != nil && from.IsZero() {
+		return "Exception pending, no further occurrences"
+	}
+	if from.IsZero() {
 		return "Exception pending"
 	}
 
-	remaining := exception.Spec.ValidFrom.Sub(now)
+	remaining := from.Sub(now)
 	days := int(remaining.Hours() / 24)
 	if days > 0 {
 		return fmt.Sprintf("Exception pending, activates in %d days", days)
@@ -364,11 +662,12 @@ func formatPendingMessage(now time.Time, exception *hibernatorv1alpha1.ScheduleE
 
 // formatActiveMessage creates a human-readable message for active exceptions.
 func formatActiveMessage(now time.Time, exception *hibernatorv1alpha1.ScheduleException) string {
-	if exception.Spec.ValidUntil.IsZero() {
+	_, until := exception.EffectiveWindow()
+	if until.IsZero() {
 		return "Exception active"
 	}
 
-	remaining := exception.Spec.ValidUntil.Sub(now)
+	remaining := until.Sub(now)
 	days := int(remaining.Hours() / 24)
 	if days > 0 {
 		return fmt.Sprintf("Exception active, expires in %d days", days)
@@ -383,7 +682,21 @@ func formatActiveMessage(now time.Time, exception *hibernatorv1alpha1.ScheduleEx
 }
 
 // ensurePlanLabel adds the plan label to the exception for efficient querying.
+// A label holds a single plan, so exceptions targeting multiple plans carry none.
 func (p *LifecycleProcessor) ensurePlanLabel(ctx context.Context, log logr.Logger, exception *hibernatorv1alpha1.ScheduleException) error {
+	if exception.TargetsMultiplePlans() {
+		if _, ok := exception.Labels[wellknown.LabelPlan]; !ok {
+			return nil
+		}
+		orig := exception.DeepCopy()
+		delete(exception.Labels, wellknown.LabelPlan)
+		if err := p.Patch(ctx, exception, client.MergeFrom(orig)); err != nil {
+			return fmt.Errorf("remove exception plan label: %w", err)
+		}
+		log.V(1).Info("removed plan label from multi-plan exception")
+		return nil
+	}
+
 	planName := exception.Spec.PlanRef.Name
 	if exception.Labels != nil && exception.Labels[wellknown.LabelPlan] == planName {
 		return nil
@@ -423,27 +736,29 @@ func (p *LifecycleProcessor) handleExceptionDelete(ctx context.Context, log logr
 	)
 	log.V(1).Info("handling exception deletion")
 
-	// Check if the referenced plan is mid-cycle with this exception's override.
+	// Check if a referenced plan is mid-cycle with this exception's override.
 	// If so, block finalizer removal to prevent the exception from disappearing
 	// while the plan is still using its overrides.
-	planKey := types.NamespacedName{
-		Name:      exception.Spec.PlanRef.Name,
-		Namespace: exception.Namespace,
-	}
-	plan := new(hibernatorv1alpha1.HibernatePlan)
-	if err := p.Get(ctx, planKey, plan); err != nil {
-		if client.IgnoreNotFound(err) == nil {
-			log.V(1).Info("referenced plan no longer exists, allowing finalizer removal")
-		} else {
-			log.Error(err, "failed to fetch referenced plan for mid-cycle check", "plan", planKey)
-			// Proceed cautiously: do not block finalizer removal on infrastructure errors.
+	// The plans of other shards count as well: the exception must outlive any
+	// cycle using its override.
+	plans, err := p.referencedPlans(ctx, p.planReader(), exception)
+	if err != nil {
+		log.Error(err, "failed to fetch referenced plans for mid-cycle check")
+		// Proceed cautiously: do not block finalizer removal on infrastructure errors.
+	}
+	if len(plans) == 0 {
+		log.V(1).Info("no referenced plan exists, allowing finalizer removal")
+	}
+	for i := range plans {
+		plan := &plans[i]
+		if plan.Status.Phase != hibernatorv1alpha1.PhaseHibernating &&
+			plan.Status.Phase != hibernatorv1alpha1.PhaseWakingUp &&
+			plan.Status.Phase != hibernatorv1alpha1.PhaseError {
+			continue
 		}
-	} else if plan.Status.Phase == hibernatorv1alpha1.PhaseHibernating ||
-		plan.Status.Phase == hibernatorv1alpha1.PhaseWakingUp ||
-		plan.Status.Phase == hibernatorv1alpha1.PhaseError {
 		if plan.Status.AppliedExceptionOverride == exception.Name {
 			log.Info("blocking exception finalizer removal: plan is mid-cycle with this exception's override",
-				"plan", planKey,
+				"plan", client.ObjectKeyFromObject(plan),
 				"phase", plan.Status.Phase,
 				"cycleID", plan.Status.CurrentCycleID)
 			// Remove from plan status but keep the finalizer so the exception persists
@@ -475,34 +790,84 @@ func (p *LifecycleProcessor) handleExceptionDelete(ctx context.Context, log logr
 }
 
 // removeFromPlanStatus queues a status update that removes the exception from the
-// HibernatePlan's ActiveExceptions list. The actual write is performed by the
-// status writer processor, preserving the architectural invariant that only the
+// ExceptionReferences of every HibernatePlan it targets. The actual write is performed
+// by the status writer processor, preserving the architectural invariant that only the
 // status writer writes status sub-resources.
-func (p *LifecycleProcessor) removeFromPlanStatus(_ context.Context, log logr.Logger, exception *hibernatorv1alpha1.ScheduleException) error {
-	planKey := types.NamespacedName{
+func (p *LifecycleProcessor) removeFromPlanStatus(ctx context.Context, log logr.Logger, exception *hibernatorv1alpha1.ScheduleException) error {
+	planKeys := []types.NamespacedName{{
 		Name:      exception.Spec.PlanRef.Name,
 		Namespace: exception.Namespace,
+	}}
+	if exception.TargetsMultiplePlans() {
+		// Other shards prune their own plans when the exception leaves them.
+		plans, err := p.referencedPlans(ctx, p.Client, exception)
+		if err != nil {
+			return err
+		}
+		planKeys = planKeys[:0]
+		for i := range plans {
+			planKeys = append(planKeys, client.ObjectKeyFromObject(&plans[i]))
+		}
 	}
 
 	exceptionName := exception.Name
-	p.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
-		NamespacedName: planKey,
-		Resource:       new(hibernatorv1alpha1.HibernatePlan),
-		Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
-			var updated []hibernatorv1alpha1.ExceptionReference
-			for _, ref := range p.Status.ExceptionReferences {
-				if ref.Name != exceptionName {
-					updated = append(updated, ref)
+	for _, planKey := range planKeys {
+		p.Statuses.PlanStatuses.Send(statusprocessor.Update[*hibernatorv1alpha1.HibernatePlan]{
+			NamespacedName: planKey,
+			Resource:       new(hibernatorv1alpha1.HibernatePlan),
+			Mutator: statusprocessor.MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+				var updated []hibernatorv1alpha1.ExceptionReference
+				for _, ref := range p.Status.ExceptionReferences {
+					if ref.Name != exceptionName {
+						updated = append(updated, ref)
+					}
 				}
-			}
-			p.Status.ExceptionReferences = updated
-		}),
-	})
+				p.Status.ExceptionReferences = updated
+			}),
+		})
 
-	log.V(1).Info("queued removal of exception from plan status", "plan", planKey.Name)
+		log.V(1).Info("queued removal of exception from plan status", "plan", planKey.Name)
+	}
 	return nil
 }
 
+// referencedPlans returns the existing HibernatePlans the exception targets
+// that reader holds.
+func (p *LifecycleProcessor) referencedPlans(ctx context.Context, reader client.Reader, exception *hibernatorv1alpha1.ScheduleException) ([]hibernatorv1alpha1.HibernatePlan, error) {
+	if !exception.TargetsMultiplePlans() {
+		plan := hibernatorv1alpha1.HibernatePlan{}
+		planKey := types.NamespacedName{Name: exception.Spec.PlanRef.Name, Namespace: exception.Namespace}
+		if err := reader.Get(ctx, planKey, &plan); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return []hibernatorv1alpha1.HibernatePlan{plan}, nil
+	}
+
+	var planList hibernatorv1alpha1.HibernatePlanList
+	if err := reader.List(ctx, &planList, client.InNamespace(exception.Namespace)); err != nil {
+		return nil, fmt.Errorf("list plans: %w", err)
+	}
+	var plans []hibernatorv1alpha1.HibernatePlan
+	for _, plan := range planList.Items {
+		if exception.AppliesToPlan(plan.Name, plan.Labels) {
+			plans = append(plans, plan)
+		}
+	}
+	return plans, nil
+}
+
+// describePlans returns a short description of the plans an exception targets.
+func describePlans(exception *hibernatorv1alpha1.ScheduleException) string {
+	desc := strings.Join(exception.PlanNames(), ",")
+	if exception.Spec.PlanSelector != nil {
+		if desc != "" {
+			desc += ","
+		}
+		desc += "selector(" + metav1.FormatLabelSelector(exception.Spec.PlanSelector) + ")"
+	}
+	return desc
+}
+
 // transitionToDetached queues a status update that moves the exception to the Detached state
 // and removes the finalizer. This is used when the referenced plan is deleted but the exception
 // has no OwnerReference, meaning it should not be cascade-deleted.
@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -52,7 +53,7 @@ func newTestProcessor(t *testing.T, objs ...client.Object) (*LifecycleProcessor,
 			if !ok {
 				return nil
 			}
-			return []string{exc.Spec.PlanRef.Name}
+			return exc.PlanNames()
 		}).
 		WithObjects(objs...).
 		Build()
@@ -157,10 +158,52 @@ func TestComputeDesiredState_ExactlyAtValidFrom_Active(t *testing.T) {
 		zeroLP().computeDesiredState(now, ex))
 }
 
+func approvedException(now time.Time, approver string, generation int64) *hibernatorv1alpha1.ScheduleException {
+	ex := exceptionWithWindow(now.Add(-1*time.Hour), now.Add(2*time.Hour))
+	ex.Generation = 3
+	ex.Spec.RequiresApproval = true
+	if approver != "" {
+		ex.Annotations = map[string]string{
+			wellknown.AnnotationApprovedBy:         approver,
+			wellknown.AnnotationApprovedGeneration: fmt.Sprint(generation),
+		}
+	}
+	return ex
+}
+
+func TestComputeDesiredState_RequiresApproval(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		ex   *hibernatorv1alpha1.ScheduleException
+		want hibernatorv1alpha1.ExceptionState
+	}{
+		{"not approved", approvedException(now, "", 0), hibernatorv1alpha1.ExceptionStatePending},
+		{"approved current generation", approvedException(now, "alice", 3), hibernatorv1alpha1.ExceptionStateActive},
+		{"approved earlier generation", approvedException(now, "alice", 2), hibernatorv1alpha1.ExceptionStatePending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, zeroLP().computeDesiredState(now, tt.ex))
+		})
+	}
+
+	expired := approvedException(now, "", 0)
+	expired.Spec.ValidUntil = metav1.Time{Time: now.Add(-time.Minute)}
+	assert.Equal(t, hibernatorv1alpha1.ExceptionStateExpired, zeroLP().computeDesiredState(now, expired),
+		"an unapproved exception still expires")
+}
+
 // ---------------------------------------------------------------------------
 // formatPendingMessage
 // ---------------------------------------------------------------------------
 
+func TestFormatPendingMessage_AwaitingApproval(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, awaitingApprovalMessage, formatPendingMessage(now, approvedException(now, "", 0)))
+}
+
 func TestFormatPendingMessage_ZeroValidFrom_Generic(t *testing.T) {
 	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
 	assert.Equal(t, "Exception pending",
@@ -245,6 +288,20 @@ func TestEnsurePlanLabel_AlreadySet_IsNoop(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEnsurePlanLabel_MultiPlan_RemovesLabel(t *testing.T) {
+	ex := baseScheduleException("ex3", "")
+	ex.Spec.PlanRefs = []hibernatorv1alpha1.PlanReference{{Name: "plan-a"}, {Name: "plan-b"}}
+	ex.Labels = map[string]string{wellknown.LabelPlan: "plan-a"}
+	p, _ := newTestProcessor(t, ex)
+
+	require.NoError(t, p.ensurePlanLabel(context.Background(), logr.Discard(), ex))
+
+	updated := &hibernatorv1alpha1.ScheduleException{}
+	require.NoError(t, p.Get(context.Background(),
+		types.NamespacedName{Name: "ex3", Namespace: "default"}, updated))
+	assert.NotContains(t, updated.Labels, wellknown.LabelPlan)
+}
+
 // ---------------------------------------------------------------------------
 // handleUpdate — finalizer path
 // ---------------------------------------------------------------------------
@@ -290,6 +347,178 @@ func TestHandleUpdate_AddsFinalizer(t *testing.T) {
 	assert.True(t, hasFinalizer, "finalizer should have been added")
 }
 
+func TestHandleUpdate_RecordsApprovalAndActivates(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	ex := approvedException(now, "alice", 3)
+	ex.Name = "ex-approved"
+	ex.Namespace = "default"
+	ex.Spec.PlanRef = hibernatorv1alpha1.PlanReference{Name: "plan-a"}
+	ex.Finalizers = []string{wellknown.ExceptionFinalizerName}
+	ex.Labels = map[string]string{wellknown.LabelPlan: "plan-a"}
+	ex.Status.State = hibernatorv1alpha1.ExceptionStatePending
+	p, statuses := newTestProcessor(t, ex)
+	p.Clock = clocktesting.NewFakeClock(now)
+
+	errChan := make(chan error, 1)
+	key := types.NamespacedName{Name: "ex-approved", Namespace: "default"}
+	p.handleExceptionUpdate(context.Background(), logr.Discard(), key, ex, errChan)
+	require.Empty(t, errChan)
+
+	updater := statuses.ExceptionStatuses.(*captureUpdater[*hibernatorv1alpha1.ScheduleException])
+	assert.Equal(t, 2, updater.Len(), "expected approval record and state transition")
+	assert.Equal(t, hibernatorv1alpha1.ExceptionStateActive, ex.Status.State)
+	require.Len(t, ex.Status.Approvals, 1)
+	assert.Equal(t, "alice", ex.Status.Approvals[0].Approver)
+	assert.Equal(t, int64(3), ex.Status.Approvals[0].Generation)
+	assert.True(t, ex.Status.Approvals[0].ApprovedAt.Time.Equal(now))
+
+	// The same approval is recorded only once.
+	p.handleExceptionUpdate(context.Background(), logr.Discard(), key, ex, errChan)
+	assert.Len(t, ex.Status.Approvals, 1)
+}
+
+func TestHandleUpdate_UnapprovedStaysPending(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	ex := approvedException(now, "alice", 2)
+	ex.Name = "ex-stale"
+	ex.Namespace = "default"
+	ex.Spec.PlanRef = hibernatorv1alpha1.PlanReference{Name: "plan-a"}
+	ex.Finalizers = []string{wellknown.ExceptionFinalizerName}
+	ex.Labels = map[string]string{wellknown.LabelPlan: "plan-a"}
+	ex.Status.State = hibernatorv1alpha1.ExceptionStateActive
+	p, _ := newTestProcessor(t, ex)
+	p.Clock = clocktesting.NewFakeClock(now)
+
+	errChan := make(chan error, 1)
+	p.handleExceptionUpdate(context.Background(), logr.Discard(),
+		types.NamespacedName{Name: "ex-stale", Namespace: "default"}, ex, errChan)
+	require.Empty(t, errChan)
+
+	assert.Equal(t, hibernatorv1alpha1.ExceptionStatePending, ex.Status.State)
+	assert.Equal(t, awaitingApprovalMessage, ex.Status.Message)
+	assert.Empty(t, ex.Status.Approvals)
+}
+
+func expiredException(name string, validUntil time.Time, ttl string) *hibernatorv1alpha1.ScheduleException {
+	ex := baseScheduleException(name, "plan-a")
+	ex.Finalizers = []string{wellknown.ExceptionFinalizerName}
+	ex.Labels = map[string]string{wellknown.LabelPlan: "plan-a"}
+	ex.Spec.ValidFrom = metav1.NewTime(validUntil.Add(-24 * time.Hour))
+	ex.Spec.ValidUntil = metav1.NewTime(validUntil)
+	ex.Spec.TTLAfterExpiry = ttl
+	ex.Status.State = hibernatorv1alpha1.ExceptionStateExpired
+	return ex
+}
+
+func TestHandleUpdate_Expired_TTLElapsed_DeletesException(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	ex := expiredException("ex-gc", now.Add(-25*time.Hour), "24h")
+	p, _ := newTestProcessor(t, ex)
+	p.Clock = clocktesting.NewFakeClock(now)
+
+	errChan := make(chan error, 1)
+	key := types.NamespacedName{Name: "ex-gc", Namespace: "default"}
+	p.handleExceptionUpdate(context.Background(), logr.Discard(), key, ex, errChan)
+	require.Empty(t, errChan)
+
+	// The finalizer holds the object until handleExceptionDelete prunes plan status.
+	got := &hibernatorv1alpha1.ScheduleException{}
+	require.NoError(t, p.Get(context.Background(), key, got))
+	assert.False(t, got.DeletionTimestamp.IsZero(), "exception should be marked for deletion")
+}
+
+func TestHandleUpdate_Expired_WithinTTL_Kept(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	ex := expiredException("ex-keep", now.Add(-time.Hour), "24h")
+	p, _ := newTestProcessor(t, ex)
+	p.Clock = clocktesting.NewFakeClock(now)
+
+	errChan := make(chan error, 1)
+	key := types.NamespacedName{Name: "ex-keep", Namespace: "default"}
+	p.handleExceptionUpdate(context.Background(), logr.Discard(), key, ex, errChan)
+	require.Empty(t, errChan)
+
+	got := &hibernatorv1alpha1.ScheduleException{}
+	require.NoError(t, p.Get(context.Background(), key, got))
+	assert.True(t, got.DeletionTimestamp.IsZero())
+	assert.Equal(t, "Exception expired, deleted after 2026-01-11T11:00:00Z", ex.Status.Message)
+}
+
+// recurringException returns an exception valid for 2026 that suspends the
+// first weekend of every month.
+func recurringException(name string) *hibernatorv1alpha1.ScheduleException {
+	ex := exceptionWithWindow(
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+	)
+	ex.Name = name
+	ex.Namespace = "default"
+	ex.Spec.PlanRef = hibernatorv1alpha1.PlanReference{Name: "plan-a"}
+	ex.Spec.Type = hibernatorv1alpha1.ExceptionSuspend
+	ex.Spec.Recurrence = &hibernatorv1alpha1.ExceptionRecurrence{
+		Frequency: hibernatorv1alpha1.RecurrenceMonthly,
+		Interval:  1,
+		ByDay:     []string{"1SAT"},
+		Duration:  "48h",
+	}
+	ex.Finalizers = []string{wellknown.ExceptionFinalizerName}
+	ex.Labels = map[string]string{wellknown.LabelPlan: "plan-a"}
+	return ex
+}
+
+func TestHandleUpdate_Recurring_TracksOccurrences(t *testing.T) {
+	ex := recurringException("ex-recurring")
+	ex.Status.State = hibernatorv1alpha1.ExceptionStatePending
+	p, _ := newTestProcessor(t, ex)
+	key := types.NamespacedName{Name: "ex-recurring", Namespace: "default"}
+	errChan := make(chan error, 1)
+
+	// Between occurrences: Pending, with the next occurrence recorded.
+	p.Clock = clocktesting.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	p.handleExceptionUpdate(context.Background(), logr.Discard(), key, ex, errChan)
+	require.Empty(t, errChan)
+	assert.Equal(t, hibernatorv1alpha1.ExceptionStatePending, ex.Status.State)
+	require.NotNil(t, ex.Status.Occurrence)
+	assert.True(t, ex.Status.Occurrence.Start.Time.Equal(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, ex.Status.Occurrence.End.Time.Equal(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)))
+
+	// During the first weekend: Active.
+	p.Clock = clocktesting.NewFakeClock(time.Date(2026, 1, 3, 10, 0, 0, 0, time.UTC))
+	p.handleExceptionUpdate(context.Background(), logr.Discard(), key, ex, errChan)
+	assert.Equal(t, hibernatorv1alpha1.ExceptionStateActive, ex.Status.State)
+	assert.Equal(t, "Exception active, expires in 1 days", formatActiveMessage(p.Clock.Now(), ex))
+
+	// After the weekend: back to Pending for February's occurrence.
+	p.Clock = clocktesting.NewFakeClock(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+	p.handleExceptionUpdate(context.Background(), logr.Discard(), key, ex, errChan)
+	assert.Equal(t, hibernatorv1alpha1.ExceptionStatePending, ex.Status.State)
+	require.NotNil(t, ex.Status.Occurrence)
+	assert.True(t, ex.Status.Occurrence.Start.Time.Equal(time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestHandleUpdate_Recurring_NoFurtherOccurrences(t *testing.T) {
+	ex := recurringException("ex-recurring")
+	ex.Spec.ValidUntil = metav1.NewTime(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC))
+	ex.Status.State = hibernatorv1alpha1.ExceptionStatePending
+	ex.Status.Occurrence = &hibernatorv1alpha1.ExceptionOccurrence{
+		Start: metav1.NewTime(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)),
+		End:   metav1.NewTime(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)),
+	}
+	p, _ := newTestProcessor(t, ex)
+	p.Clock = clocktesting.NewFakeClock(time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC))
+
+	errChan := make(chan error, 1)
+	p.handleExceptionUpdate(context.Background(), logr.Discard(),
+		types.NamespacedName{Name: "ex-recurring", Namespace: "default"}, ex, errChan)
+	require.Empty(t, errChan)
+
+	assert.Nil(t, ex.Status.Occurrence)
+	assert.Equal(t, hibernatorv1alpha1.ExceptionStatePending, ex.Status.State)
+	assert.Equal(t, "Exception pending, no further occurrences", ex.Status.Message)
+}
+
 func TestHandleUpdate_DeletionTimestamp_RemovesFromResources(t *testing.T) {
 	ex := baseScheduleException("ex-del", "plan-a")
 	// Add a finalizer so the fake client accepts the object with DeletionTimestamp.
@@ -366,6 +595,72 @@ func TestRemoveFromPlanStatus_QueuesStatusUpdate(t *testing.T) {
 		"should have queued one plan status update")
 }
 
+func TestRemoveFromPlanStatus_PlanSelector_QueuesUpdatePerMatchingPlan(t *testing.T) {
+	ex := baseScheduleException("ex-freeze", "")
+	ex.Spec.PlanSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"freeze": "true"}}
+	planA := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{
+		Name: "plan-a", Namespace: "default", Labels: map[string]string{"freeze": "true"}}}
+	planB := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{
+		Name: "plan-b", Namespace: "default", Labels: map[string]string{"freeze": "true"}}}
+	planC := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "plan-c", Namespace: "default"}}
+	p, statuses := newTestProcessor(t, planA, planB, planC)
+
+	require.NoError(t, p.removeFromPlanStatus(context.Background(), logr.Discard(), ex))
+
+	planUpdater := statuses.PlanStatuses.(*captureUpdater[*hibernatorv1alpha1.HibernatePlan])
+	require.Equal(t, 2, planUpdater.Len())
+	var names []string
+	for range 2 {
+		names = append(names, (<-planUpdater.C()).NamespacedName.Name)
+	}
+	assert.ElementsMatch(t, []string{"plan-a", "plan-b"}, names)
+}
+
+// ---------------------------------------------------------------------------
+// ownsException
+// ---------------------------------------------------------------------------
+
+func TestHandlePlanUpdate_TwoShards_OnlyOwningShardDrivesException(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	ex := baseScheduleException("ex-freeze", "")
+	ex.Spec.PlanSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"freeze": "true"}}
+	ex.Spec.ValidFrom = metav1.Time{Time: now.Add(-time.Hour)}
+	ex.Spec.ValidUntil = metav1.Time{Time: now.Add(time.Hour)}
+	planA := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{
+		Name: "plan-a", Namespace: "default", Labels: map[string]string{"freeze": "true", wellknown.LabelShard: "blue"}}}
+	planB := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{
+		Name: "plan-b", Namespace: "default", Labels: map[string]string{"freeze": "true", wellknown.LabelShard: "green"}}}
+
+	// Each shard caches its own plan; its API reader sees the plans of both.
+	apiReader, _ := newTestProcessor(t, ex.DeepCopy(), planA.DeepCopy(), planB.DeepCopy())
+	shards := map[string]*hibernatorv1alpha1.HibernatePlan{"blue": planA, "green": planB}
+	for shard, plan := range shards {
+		t.Run(shard, func(t *testing.T) {
+			p, statuses := newTestProcessor(t, ex.DeepCopy(), plan.DeepCopy())
+			p.Clock = clocktesting.NewFakeClock(now)
+			p.Shard = shard
+			p.APIReader = apiReader.Client
+
+			errChan := make(chan error, 1)
+			planCtx := &message.PlanContext{Plan: plan, Exceptions: []hibernatorv1alpha1.ScheduleException{*ex.DeepCopy()}}
+			p.handlePlanUpdate(context.Background(), logr.Discard(), client.ObjectKeyFromObject(plan), planCtx, errChan)
+			require.Empty(t, errChan)
+
+			updated := &hibernatorv1alpha1.ScheduleException{}
+			require.NoError(t, p.Get(context.Background(), client.ObjectKeyFromObject(ex), updated))
+			excUpdater := statuses.ExceptionStatuses.(*captureUpdater[*hibernatorv1alpha1.ScheduleException])
+			owner := shard == "blue" // plan-a is the first plan by name
+			assert.Equal(t, owner, controllerutil.ContainsFinalizer(updated, wellknown.ExceptionFinalizerName))
+			assert.Equal(t, owner, excUpdater.Len() > 0, "only the owning shard writes the exception status")
+
+			// Every shard keeps the exception references of its own plan current.
+			planUpdater := statuses.PlanStatuses.(*captureUpdater[*hibernatorv1alpha1.HibernatePlan])
+			require.Equal(t, 1, planUpdater.Len())
+			assert.Equal(t, plan.Name, (<-planUpdater.C()).NamespacedName.Name)
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // hasOwnerReferenceToPlan
 // ---------------------------------------------------------------------------
@@ -483,6 +778,21 @@ func TestHandlePlanDelete_NoOwnerRef_TransitionsToDetached(t *testing.T) {
 	}
 }
 
+func TestHandlePlanDelete_MultiPlanException_StaysAttached(t *testing.T) {
+	ex := baseScheduleException("freeze", "")
+	ex.Spec.PlanRefs = []hibernatorv1alpha1.PlanReference{{Name: "my-plan"}, {Name: "other-plan"}}
+	ex.Status.State = hibernatorv1alpha1.ExceptionStateActive
+
+	p, statuses := newTestProcessor(t, ex)
+	errChan := make(chan error, 1)
+	p.handlePlanDelete(context.Background(), logr.Discard(),
+		types.NamespacedName{Name: "my-plan", Namespace: "default"}, errChan)
+	require.Empty(t, errChan)
+
+	excUpdater := statuses.ExceptionStatuses.(*captureUpdater[*hibernatorv1alpha1.ScheduleException])
+	assert.Zero(t, excUpdater.Len(), "an exception targeting other plans must not be detached")
+}
+
 func TestHandlePlanDelete_WithOwnerRef_SkipsException(t *testing.T) {
 	ex := baseScheduleException("ex-owned", "my-plan")
 	ex.Status.State = hibernatorv1alpha1.ExceptionStateActive
@@ -957,3 +1267,28 @@ func TestUpdateExceptionReferences_NoExceptions_ClearsRefs(t *testing.T) {
 	upd := <-planUpdater.C()
 	assert.Empty(t, upd.Resource.Status.ExceptionReferences)
 }
+
+func TestExceptionAppliedHook_RecordsNewlyActiveExceptions(t *testing.T) {
+	until := metav1.NewTime(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC))
+	previous := []hibernatorv1alpha1.ExceptionReference{
+		{Name: "holiday", Type: hibernatorv1alpha1.ExceptionExtend, State: hibernatorv1alpha1.ExceptionStatePending, ValidUntil: until},
+		{Name: "freeze", Type: hibernatorv1alpha1.ExceptionSuspend, State: hibernatorv1alpha1.ExceptionStateActive, ValidUntil: until},
+	}
+	refs := []hibernatorv1alpha1.ExceptionReference{
+		{Name: "holiday", Type: hibernatorv1alpha1.ExceptionExtend, State: hibernatorv1alpha1.ExceptionStateActive, ValidUntil: until},
+		{Name: "freeze", Type: hibernatorv1alpha1.ExceptionSuspend, State: hibernatorv1alpha1.ExceptionStateActive, ValidUntil: until},
+	}
+
+	recorder := record.NewFakeRecorder(10)
+	p := &LifecycleProcessor{Recorder: recorder}
+
+	hook := p.exceptionAppliedHook(previous, refs)
+	require.NotNil(t, hook)
+	require.NoError(t, hook(context.Background(), &hibernatorv1alpha1.HibernatePlan{}))
+
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal ExceptionApplied Schedule exception holiday (extend) is active until 2026-12-31T00:00:00Z", <-recorder.Events)
+
+	assert.Nil(t, p.exceptionAppliedHook(refs, refs), "no hook when no exception became active")
+	assert.Nil(t, zeroLP().exceptionAppliedHook(previous, refs), "no hook without a recorder")
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// ExecutionStore externalizes the execution ledger of large HibernatePlans into
+// HibernateExecution objects, one per target, leaving only an ExecutionSummary
+// in the plan status.
+//
+// Plans whose ledger has fewer than threshold entries keep it inline. Only
+// entries that changed are written, so a status update for one target touches
+// one HibernateExecution instead of rewriting the whole ledger. Objects of
+// earlier cycles are pruned when a new ledger is stored.
+type ExecutionStore struct {
+	client    client.Client
+	reader    client.Reader
+	threshold int
+}
+
+var _ Externalizer[*hibernatorv1alpha1.HibernatePlan] = (*ExecutionStore)(nil)
+
+// NewExecutionStore creates an ExecutionStore. reader should be uncached so that
+// loaded ledgers are never older than the plan status they belong to. A threshold
+// of zero or less disables externalization.
+func NewExecutionStore(c client.Client, reader client.Reader, threshold int) *ExecutionStore {
+	return &ExecutionStore{
+		client:    c,
+		reader:    reader,
+		threshold: threshold,
+	}
+}
+
+// Load implements Externalizer.
+func (s *ExecutionStore) Load(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
+	return LoadExecutions(ctx, s.reader, plan)
+}
+
+// Store implements Externalizer.
+func (s *ExecutionStore) Store(ctx context.Context, before, plan *hibernatorv1alpha1.HibernatePlan) error {
+	if !s.externalize(plan) {
+		if before.Status.ExecutionSummary == nil {
+			return nil
+		}
+		// The ledger moves back inline; drop the objects of the previous one.
+		plan.Status.ExecutionSummary = nil
+		return s.prune(ctx, plan, nil)
+	}
+
+	summary := summarizeExecutions(plan)
+	prev := before.Status.ExecutionSummary
+	sameLedger := prev != nil && prev.CycleID == summary.CycleID && prev.Operation == summary.Operation
+
+	for i, exec := range plan.Status.Executions {
+		if sameLedger && i < len(before.Status.Executions) && equality.Semantic.DeepEqual(before.Status.Executions[i], exec) {
+			continue
+		}
+		if err := s.upsert(ctx, plan, summary, i, exec); err != nil {
+			return err
+		}
+	}
+
+	if !sameLedger || len(before.Status.Executions) > len(plan.Status.Executions) {
+		if err := s.prune(ctx, plan, summary); err != nil {
+			return err
+		}
+	}
+
+	plan.Status.ExecutionSummary = summary
+	plan.Status.Executions = nil
+	return nil
+}
+
+// externalize reports whether the plan's ledger is stored as HibernateExecution objects.
+func (s *ExecutionStore) externalize(plan *hibernatorv1alpha1.HibernatePlan) bool {
+	return s.threshold > 0 &&
+		len(plan.Status.Executions) >= s.threshold &&
+		plan.Status.CurrentCycleID != "" &&
+		plan.Status.CurrentOperation != ""
+}
+
+func (s *ExecutionStore) upsert(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan, summary *hibernatorv1alpha1.ExecutionSummary, index int, exec hibernatorv1alpha1.ExecutionStatus) error {
+	key := client.ObjectKey{
+		Namespace: plan.Namespace,
+		Name:      ExecutionObjectName(plan.Name, summary.CycleID, summary.Operation, index),
+	}
+
+	obj := &hibernatorv1alpha1.HibernateExecution{}
+	err := s.reader.Get(ctx, key, obj)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get HibernateExecution %s: %w", key, err)
+	}
+	exists := err == nil
+
+	obj.Name = key.Name
+	obj.Namespace = key.Namespace
+	obj.Labels = executionLabels(plan.Name, summary.CycleID, summary.Operation)
+	obj.Spec = hibernatorv1alpha1.HibernateExecutionSpec{
+		PlanRef:   hibernatorv1alpha1.PlanReference{Name: plan.Name, Namespace: plan.Namespace},
+		CycleID:   summary.CycleID,
+		Operation: summary.Operation,
+		Index:     int32(index),
+	}
+	obj.Status = *exec.DeepCopy()
+	if err := controllerutil.SetControllerReference(plan, obj, s.client.Scheme()); err != nil {
+		return fmt.Errorf("failed to set owner reference on HibernateExecution %s: %w", key, err)
+	}
+
+	if exists {
+		if err := s.client.Update(ctx, obj); err != nil {
+			return fmt.Errorf("failed to update HibernateExecution %s: %w", key, err)
+		}
+		return nil
+	}
+	if err := s.client.Create(ctx, obj); err != nil {
+		return fmt.Errorf("failed to create HibernateExecution %s: %w", key, err)
+	}
+	return nil
+}
+
+// prune deletes the plan's HibernateExecution objects that are not part of keep.
+// A nil keep deletes all of them.
+func (s *ExecutionStore) prune(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan, keep *hibernatorv1alpha1.ExecutionSummary) error {
+	var list hibernatorv1alpha1.HibernateExecutionList
+	if err := s.reader.List(ctx, &list,
+		client.InNamespace(plan.Namespace),
+		client.MatchingLabels{wellknown.LabelPlan: plan.Name},
+	); err != nil {
+		return fmt.Errorf("failed to list HibernateExecutions for plan %s/%s: %w", plan.Namespace, plan.Name, err)
+	}
+
+	for i := range list.Items {
+		item := &list.Items[i]
+		if keep != nil &&
+			item.Spec.CycleID == keep.CycleID &&
+			item.Spec.Operation == keep.Operation &&
+			item.Spec.Index < keep.Total {
+			continue
+		}
+		if err := s.client.Delete(ctx, item); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete HibernateExecution %s/%s: %w", item.Namespace, item.Name, err)
+		}
+	}
+	return nil
+}
+
+// LoadExecutions fills plan.Status.Executions from the plan's HibernateExecution
+// objects when its ledger is externalized. Plans with an inline ledger are left
+// untouched.
+func LoadExecutions(ctx context.Context, r client.Reader, plan *hibernatorv1alpha1.HibernatePlan) error {
+	summary := plan.Status.ExecutionSummary
+	if summary == nil || len(plan.Status.Executions) > 0 {
+		return nil
+	}
+
+	var list hibernatorv1alpha1.HibernateExecutionList
+	if err := r.List(ctx, &list,
+		client.InNamespace(plan.Namespace),
+		client.MatchingLabels(executionLabels(plan.Name, summary.CycleID, summary.Operation)),
+	); err != nil {
+		return fmt.Errorf("failed to list HibernateExecutions for plan %s/%s: %w", plan.Namespace, plan.Name, err)
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Spec.Index < list.Items[j].Spec.Index
+	})
+
+	execs := make([]hibernatorv1alpha1.ExecutionStatus, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.Spec.Index >= summary.Total {
+			continue
+		}
+		execs = append(execs, item.Status)
+	}
+	plan.Status.Executions = execs
+	return nil
+}
+
+// ExecutionObjectName returns the name of the HibernateExecution holding the
+// ledger entry at index for the given plan, cycle and operation.
+func ExecutionObjectName(planName, cycleID string, operation hibernatorv1alpha1.PlanOperation, index int) string {
+	return fmt.Sprintf("%s-%s-%s-%d", planName, cycleID, operation, index)
+}
+
+func executionLabels(planName, cycleID string, operation hibernatorv1alpha1.PlanOperation) map[string]string {
+	return map[string]string{
+		wellknown.LabelPlan:      planName,
+		wellknown.LabelCycleID:   cycleID,
+		wellknown.LabelOperation: string(operation),
+	}
+}
+
+func summarizeExecutions(plan *hibernatorv1alpha1.HibernatePlan) *hibernatorv1alpha1.ExecutionSummary {
+	summary := &hibernatorv1alpha1.ExecutionSummary{
+		CycleID:   plan.Status.CurrentCycleID,
+		Operation: plan.Status.CurrentOperation,
+		Total:     int32(len(plan.Status.Executions)),
+	}
+	for _, exec := range plan.Status.Executions {
+		switch exec.State {
+		case hibernatorv1alpha1.StatePending:
+			summary.Pending++
+		case hibernatorv1alpha1.StateRunning:
+			summary.Running++
+		case hibernatorv1alpha1.StateCompleted:
+			summary.Completed++
+		case hibernatorv1alpha1.StateFailed:
+			summary.Failed++
+		case hibernatorv1alpha1.StateAborted:
+			summary.Aborted++
+		case hibernatorv1alpha1.StateCancelled:
+			summary.Cancelled++
+		}
+	}
+	return summary
+}
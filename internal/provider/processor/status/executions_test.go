@@ -0,0 +1,216 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func planWithExecutions(n int, cycleID string, op hibernatorv1alpha1.PlanOperation) *hibernatorv1alpha1.HibernatePlan {
+	plan := basePlan("p1", "default", hibernatorv1alpha1.PhaseHibernating)
+	plan.UID = "plan-uid"
+	plan.Status.CurrentCycleID = cycleID
+	plan.Status.CurrentOperation = op
+	for i := range n {
+		plan.Status.Executions = append(plan.Status.Executions, hibernatorv1alpha1.ExecutionStatus{
+			Target: fmt.Sprintf("target-%d", i),
+			State:  hibernatorv1alpha1.StatePending,
+		})
+	}
+	return plan
+}
+
+func newExternalizedPlanProcessor(threshold int, objs ...client.Object) *UpdateProcessor[*hibernatorv1alpha1.HibernatePlan] {
+	c := newTestFakeClient(objs...)
+	return NewUpdateProcessor(logr.Discard(), c, c,
+		WithExternalizer[*hibernatorv1alpha1.HibernatePlan](NewExecutionStore(c, c, threshold)))
+}
+
+func listExecutions(t *testing.T, c client.Reader) []hibernatorv1alpha1.HibernateExecution {
+	t.Helper()
+	var list hibernatorv1alpha1.HibernateExecutionList
+	require.NoError(t, c.List(context.Background(), &list, client.InNamespace("default")))
+	return list.Items
+}
+
+func TestExecutionStore_Store_BelowThreshold_KeepsInline(t *testing.T) {
+	ctx := context.Background()
+	plan := planWithExecutions(2, "c1", hibernatorv1alpha1.OperationHibernate)
+	c := newTestFakeClient(plan)
+	store := NewExecutionStore(c, c, 3)
+
+	before := plan.DeepCopy()
+	require.NoError(t, store.Store(ctx, before, plan))
+
+	assert.Len(t, plan.Status.Executions, 2)
+	assert.Nil(t, plan.Status.ExecutionSummary)
+	assert.Empty(t, listExecutions(t, c))
+}
+
+func TestExecutionStore_Store_AtThreshold_Externalizes(t *testing.T) {
+	ctx := context.Background()
+	plan := planWithExecutions(3, "c1", hibernatorv1alpha1.OperationHibernate)
+	plan.Status.Executions[0].State = hibernatorv1alpha1.StateCompleted
+	c := newTestFakeClient(plan)
+	store := NewExecutionStore(c, c, 3)
+
+	require.NoError(t, store.Store(ctx, &hibernatorv1alpha1.HibernatePlan{}, plan))
+
+	assert.Empty(t, plan.Status.Executions)
+	require.NotNil(t, plan.Status.ExecutionSummary)
+	assert.Equal(t, hibernatorv1alpha1.ExecutionSummary{
+		CycleID:   "c1",
+		Operation: hibernatorv1alpha1.OperationHibernate,
+		Total:     3,
+		Pending:   2,
+		Completed: 1,
+	}, *plan.Status.ExecutionSummary)
+
+	items := listExecutions(t, c)
+	require.Len(t, items, 3)
+	for _, item := range items {
+		assert.Equal(t, "p1", item.Labels[wellknown.LabelPlan])
+		assert.Equal(t, "c1", item.Labels[wellknown.LabelCycleID])
+		assert.Equal(t, "p1", item.Spec.PlanRef.Name)
+		require.Len(t, item.OwnerReferences, 1)
+		assert.Equal(t, "p1", item.OwnerReferences[0].Name)
+	}
+}
+
+func TestExecutionStore_Store_OnlyWritesChangedEntries(t *testing.T) {
+	ctx := context.Background()
+	plan := planWithExecutions(3, "c1", hibernatorv1alpha1.OperationHibernate)
+	c := newTestFakeClient(plan)
+	store := NewExecutionStore(c, c, 3)
+
+	require.NoError(t, store.Store(ctx, &hibernatorv1alpha1.HibernatePlan{}, plan.DeepCopy()))
+	unchanged := &hibernatorv1alpha1.HibernateExecution{}
+	unchangedKey := types.NamespacedName{Namespace: "default", Name: ExecutionObjectName("p1", "c1", hibernatorv1alpha1.OperationHibernate, 0)}
+	require.NoError(t, c.Get(ctx, unchangedKey, unchanged))
+
+	before := plan.DeepCopy()
+	before.Status.ExecutionSummary = &hibernatorv1alpha1.ExecutionSummary{CycleID: "c1", Operation: hibernatorv1alpha1.OperationHibernate, Total: 3}
+	after := before.DeepCopy()
+	after.Status.Executions[1].State = hibernatorv1alpha1.StateRunning
+	require.NoError(t, store.Store(ctx, before, after))
+
+	got := &hibernatorv1alpha1.HibernateExecution{}
+	require.NoError(t, c.Get(ctx, unchangedKey, got))
+	assert.Equal(t, unchanged.ResourceVersion, got.ResourceVersion, "unchanged entry must not be rewritten")
+
+	changedKey := types.NamespacedName{Namespace: "default", Name: ExecutionObjectName("p1", "c1", hibernatorv1alpha1.OperationHibernate, 1)}
+	require.NoError(t, c.Get(ctx, changedKey, got))
+	assert.Equal(t, hibernatorv1alpha1.StateRunning, got.Status.State)
+	assert.Equal(t, int32(1), after.Status.ExecutionSummary.Running)
+}
+
+func TestExecutionStore_Store_NewCycle_PrunesPreviousLedger(t *testing.T) {
+	ctx := context.Background()
+	plan := planWithExecutions(3, "c1", hibernatorv1alpha1.OperationHibernate)
+	c := newTestFakeClient(plan)
+	store := NewExecutionStore(c, c, 3)
+
+	first := plan.DeepCopy()
+	require.NoError(t, store.Store(ctx, &hibernatorv1alpha1.HibernatePlan{}, first))
+
+	before := plan.DeepCopy()
+	before.Status.ExecutionSummary = first.Status.ExecutionSummary
+	after := planWithExecutions(3, "c1", hibernatorv1alpha1.OperationWakeUp)
+	require.NoError(t, store.Store(ctx, before, after))
+
+	items := listExecutions(t, c)
+	require.Len(t, items, 3)
+	for _, item := range items {
+		assert.Equal(t, hibernatorv1alpha1.OperationWakeUp, item.Spec.Operation)
+	}
+}
+
+func TestExecutionStore_Store_BackInline_DeletesObjects(t *testing.T) {
+	ctx := context.Background()
+	plan := planWithExecutions(3, "c1", hibernatorv1alpha1.OperationHibernate)
+	c := newTestFakeClient(plan)
+	store := NewExecutionStore(c, c, 3)
+
+	first := plan.DeepCopy()
+	require.NoError(t, store.Store(ctx, &hibernatorv1alpha1.HibernatePlan{}, first))
+
+	before := plan.DeepCopy()
+	before.Status.ExecutionSummary = first.Status.ExecutionSummary
+	after := planWithExecutions(1, "c2", hibernatorv1alpha1.OperationHibernate)
+	after.Status.ExecutionSummary = first.Status.ExecutionSummary
+	require.NoError(t, store.Store(ctx, before, after))
+
+	assert.Nil(t, after.Status.ExecutionSummary)
+	assert.Len(t, after.Status.Executions, 1)
+	assert.Empty(t, listExecutions(t, c))
+}
+
+func TestLoadExecutions_InlineLedger_Untouched(t *testing.T) {
+	plan := planWithExecutions(2, "c1", hibernatorv1alpha1.OperationHibernate)
+	c := newTestFakeClient()
+
+	require.NoError(t, LoadExecutions(context.Background(), c, plan))
+	assert.Len(t, plan.Status.Executions, 2)
+}
+
+func TestApply_Externalized_MutatorSeesLoadedLedger(t *testing.T) {
+	ctx := context.Background()
+	plan := planWithExecutions(3, "c1", hibernatorv1alpha1.OperationHibernate)
+	proc := newExternalizedPlanProcessor(3, plan)
+	key := types.NamespacedName{Name: "p1", Namespace: "default"}
+
+	// First write externalizes the ledger that was created inline.
+	require.NoError(t, proc.apply(ctx, Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: key,
+		Resource:       &hibernatorv1alpha1.HibernatePlan{},
+		Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+			p.Status.CurrentStageIndex = 1
+		}),
+	}))
+
+	stored := &hibernatorv1alpha1.HibernatePlan{}
+	require.NoError(t, proc.apiReader.Get(ctx, key, stored))
+	assert.Empty(t, stored.Status.Executions)
+	require.NotNil(t, stored.Status.ExecutionSummary)
+
+	var seen int
+	var written *hibernatorv1alpha1.HibernatePlan
+	require.NoError(t, proc.apply(ctx, Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: key,
+		Resource:       &hibernatorv1alpha1.HibernatePlan{},
+		Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+			seen = len(p.Status.Executions)
+			p.Status.Executions[2].State = hibernatorv1alpha1.StateFailed
+		}),
+		PostHook: func(_ context.Context, p *hibernatorv1alpha1.HibernatePlan) error {
+			written = p
+			return nil
+		},
+	}))
+
+	assert.Equal(t, 3, seen)
+	require.NotNil(t, written)
+	assert.Len(t, written.Status.Executions, 3, "PostHook sees the complete ledger")
+
+	require.NoError(t, proc.apiReader.Get(ctx, key, stored))
+	assert.Empty(t, stored.Status.Executions)
+	assert.Equal(t, int32(1), stored.Status.ExecutionSummary.Failed)
+	require.NoError(t, LoadExecutions(ctx, proc.apiReader, stored))
+	require.Len(t, stored.Status.Executions, 3)
+	assert.Equal(t, "target-2", stored.Status.Executions[2].Target)
+	assert.Equal(t, hibernatorv1alpha1.StateFailed, stored.Status.Executions[2].State)
+}
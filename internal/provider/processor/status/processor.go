@@ -2,6 +2,8 @@ package status
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"time"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
@@ -72,6 +74,9 @@ func (m MutatorFunc[T]) Mutate(obj T) {
 // Properties:
 //   - Per-key serial ordering: updates for the same key are processed strictly
 //     in FIFO order; different keys are processed in parallel.
+//   - Batched writes: the updates queued for a key while its previous write was
+//     in flight, e.g. the many a single reconcile sends, are written together
+//     as one status patch of the changed fields.
 //   - Non-blocking Send: Deliver to the pool's FIFOSlot never blocks the caller.
 //     If the per-key buffer (cap 1000) is full, the oldest update is dropped —
 //     safe because RetryOnConflict always fetches a fresh object from the API server.
@@ -90,6 +95,33 @@ type UpdateProcessor[T client.Object] struct {
 	client    client.Client
 	apiReader client.Reader
 	pool      *keyedworker.Pool[types.NamespacedName, Update[T]]
+
+	// externalizer, when set, keeps part of the status in separate objects.
+	externalizer Externalizer[T]
+}
+
+// Externalizer keeps part of an object's status outside the object itself, e.g.
+// to bound its size. The processor loads the external part into every freshly
+// fetched object, so mutators and hooks always see the complete status, and
+// stores it again right before the status write.
+type Externalizer[T client.Object] interface {
+	// Load restores the externalized part of the status into obj.
+	Load(ctx context.Context, obj T) error
+
+	// Store persists the externalized part of obj and strips it from obj, which
+	// is then written as the object's status. before is obj as loaded, prior to
+	// mutation, so unchanged parts can be skipped.
+	Store(ctx context.Context, before, obj T) error
+}
+
+// Option configures an UpdateProcessor.
+type Option[T client.Object] func(*UpdateProcessor[T])
+
+// WithExternalizer keeps part of the status outside the object via e.
+func WithExternalizer[T client.Object](e Externalizer[T]) Option[T] {
+	return func(u *UpdateProcessor[T]) {
+		u.externalizer = e
+	}
 }
 
 // NewUpdateProcessor creates a new UpdateProcessor. It must be registered as a
@@ -101,7 +133,7 @@ type UpdateProcessor[T client.Object] struct {
 // apiReader must be the uncached reader (mgr.GetAPIReader()) so that Get calls
 // inside RetryOnConflict always see the true server state rather than a potentially
 // stale informer-cache snapshot.
-func NewUpdateProcessor[T client.Object](log logr.Logger, c client.Client, apiReader client.Reader) *UpdateProcessor[T] {
+func NewUpdateProcessor[T client.Object](log logr.Logger, c client.Client, apiReader client.Reader, opts ...Option[T]) *UpdateProcessor[T] {
 	var zero T
 	kind := hibernatorv1alpha1.KindOf(zero)
 	u := &UpdateProcessor[T]{
@@ -110,6 +142,9 @@ func NewUpdateProcessor[T client.Object](log logr.Logger, c client.Client, apiRe
 		client:    c,
 		apiReader: apiReader,
 	}
+	for _, opt := range opts {
+		opt(u)
+	}
 	u.pool = keyedworker.New(
 		keyedworker.WithSlotFactory[types.NamespacedName](keyedworker.FIFOSlot[Update[T]](1000)),
 		keyedworker.WithLogger[types.NamespacedName, Update[T]](log.WithName("pool")),
@@ -145,10 +180,10 @@ func (u *UpdateProcessor[T]) Start(ctx context.Context) error {
 	u.log.Info("started status update processor")
 	defer u.log.Info("stopped status update processor")
 
-	u.pool.Register(ctx, keyedworker.RunnerFactory[types.NamespacedName](
+	u.pool.Register(ctx, keyedworker.BatchRunnerFactory[types.NamespacedName](
 		processorIdleTTL,
-		func(ctx context.Context, update Update[T]) error {
-			return u.apply(ctx, update)
+		func(ctx context.Context, updates []Update[T]) error {
+			return u.applyBatch(ctx, updates)
 		},
 		func(err error) { u.log.Error(err, "status update apply error") },
 	))
@@ -161,90 +196,192 @@ func (u *UpdateProcessor[T]) Start(ctx context.Context) error {
 	return nil
 }
 
-// apply performs the actual K8s status write for a single update.
-// It fetches a fresh copy of the object via the uncached APIReader to guard
-// against stale cache reads, applies the mutation, guards against no-op writes
-// via isStatusEqual, then calls Status().Update with RetryOnConflict.
+// apply writes a single update. See applyBatch.
 func (u *UpdateProcessor[T]) apply(ctx context.Context, update Update[T]) error {
+	return u.applyBatch(ctx, []Update[T]{update})
+}
+
+// applyBatch writes the updates queued for one object in a single status
+// patch.
+//
+// PreHooks run first, against a fresh copy of the object with the mutations of
+// the preceding updates applied, so each sees the object as if the updates
+// before it had been written one by one. An update whose PreHook fails is
+// dropped from the batch. The remaining mutators are then applied in order to
+// a copy fetched via the uncached APIReader. Unless the status ends up equal,
+// only the changed fields are sent, as a merge patch guarded by the resource
+// version and retried on conflict. PostHooks run once the patch is written, for
+// the updates whose mutation changed the status.
+func (u *UpdateProcessor[T]) applyBatch(ctx context.Context, updates []Update[T]) error {
 	startTime := time.Now()
-	key := update.NamespacedName.String()
-	obj := update.Resource
+	nn := updates[0].NamespacedName
+	key := nn.String()
+	obj := updates[0].Resource
 	objKind := hibernatorv1alpha1.KindOf(obj)
 
-	log := u.log.WithValues("key", key, "kind", objKind)
+	log := u.log.WithValues("key", key, "kind", objKind, "updates", len(updates))
 	log.V(1).Info("processing status update")
 	defer func() {
 		log.Info("finished processing status update", "duration", time.Since(startTime).String())
 	}()
 
-	if update.PreHook != nil {
-		// Fetch a fresh copy for the PreHook so it sees current server state.
-		current := obj.DeepCopyObject().(T)
-		if err := u.apiReader.Get(ctx, update.NamespacedName, current); err != nil {
-			if apierrors.IsNotFound(err) {
-				log.V(1).Info("object not found, skipping pre-hook")
-				return nil
-			}
-			return err
-		}
+	if len(updates) > 1 {
+		metrics.StatusWriterBatchedTotal.WithLabelValues(objKind, key).Add(float64(len(updates) - 1))
+	}
 
-		if err := update.PreHook(ctx, current); err != nil {
-			log.Error(err, "pre-hook failed, aborting update")
-			metrics.StatusWriterErrorsTotal.WithLabelValues(objKind, key, "pre_hook").Inc()
-			return err
-		}
+	accepted, hookErrs, err := u.runPreHooks(ctx, log, updates)
+	if err != nil {
+		return err
 	}
+	hookErr := errors.Join(hookErrs...)
 
-	if update.Mutator == nil {
+	var mutating []Update[T]
+	for _, update := range accepted {
+		if update.Mutator != nil {
+			mutating = append(mutating, update)
+		}
+	}
+	if len(mutating) == 0 {
 		log.V(1).Info("no mutator provided, skipping status update")
-		return nil
+		return hookErr
 	}
 
 	var (
-		written  T
-		didWrite bool
+		written T
+		changed []Update[T]
 	)
 	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		changed = nil
+
 		// Always fetch fresh from the uncached reader to avoid stale baseline.
 		fresh := obj.DeepCopyObject().(T)
-		if err := u.apiReader.Get(ctx, update.NamespacedName, fresh); err != nil {
+		if err := u.apiReader.Get(ctx, nn, fresh); err != nil {
 			if apierrors.IsNotFound(err) {
 				log.V(1).Info("object not found, skipping status update")
 				return nil
 			}
 			return err
 		}
+		// The patch is computed against the object as stored.
+		stored := fresh.DeepCopyObject().(T)
+		if err := u.load(ctx, fresh); err != nil {
+			return err
+		}
 
 		// Snapshot before mutation so we can detect no-op writes.
 		before := fresh.DeepCopyObject().(T)
-		update.Mutator.Mutate(fresh)
+		for _, update := range mutating {
+			if update.PostHook == nil {
+				update.Mutator.Mutate(fresh)
+				continue
+			}
+			prev := fresh.DeepCopyObject().(T)
+			update.Mutator.Mutate(fresh)
+			if !isStatusEqual(prev, fresh) {
+				changed = append(changed, update)
+			}
+		}
 		if isStatusEqual(before, fresh) {
 			log.V(1).Info("status unchanged, bypassing update")
 			metrics.StatusWriterNoopTotal.WithLabelValues(objKind, key).Inc()
+			changed = nil
 			return nil
 		}
 
-		if err := u.client.Status().Update(ctx, fresh); err != nil {
+		// Keep the complete status for the PostHooks; the externalizer strips
+		// its part from the object that is written.
+		complete := fresh
+		if u.externalizer != nil {
+			complete = fresh.DeepCopyObject().(T)
+			if err := u.externalizer.Store(ctx, before, fresh); err != nil {
+				metrics.StatusWriterErrorsTotal.WithLabelValues(objKind, key, "externalize").Inc()
+				return err
+			}
+		}
+
+		patch := client.MergeFromWithOptions(stored, client.MergeFromWithOptimisticLock{})
+		if err := u.client.Status().Patch(ctx, fresh, patch); err != nil {
+			if apierrors.IsConflict(err) {
+				metrics.StatusWriterConflictsTotal.WithLabelValues(objKind, key).Inc()
+				if objKind == hibernatorv1alpha1.KindOf(&hibernatorv1alpha1.HibernatePlan{}) {
+					metrics.ReconcileOutcomeTotal.WithLabelValues(metrics.PlanLabel(nn), metrics.OutcomeConflict).Inc()
+				}
+			}
 			return err
 		}
 
 		metrics.StatusWriterUpdatesTotal.WithLabelValues(objKind, key).Inc()
-		written = fresh
-		didWrite = true
+		complete.SetResourceVersion(fresh.GetResourceVersion())
+		written = complete
 		return nil
 	}); err != nil {
 		log.Error(err, "unable to update status")
 		metrics.StatusWriterErrorsTotal.WithLabelValues(objKind, key, "apply").Inc()
-		return err
+		return errors.Join(hookErr, err)
 	}
 
-	if update.PostHook != nil && didWrite {
+	for _, update := range changed {
 		if err := update.PostHook(ctx, written); err != nil {
 			log.Error(err, "post-transition hook failed (non-fatal)")
 			metrics.StatusWriterErrorsTotal.WithLabelValues(objKind, key, "post_hook").Inc()
 		}
 	}
 
+	return hookErr
+}
+
+// runPreHooks returns the updates whose PreHook passed, along with the errors
+// of those that failed. Updates without a PreHook always pass, and no
+// object is fetched when none has one. It returns no updates when the object
+// is gone.
+func (u *UpdateProcessor[T]) runPreHooks(ctx context.Context, log logr.Logger, updates []Update[T]) ([]Update[T], []error, error) {
+	if !slices.ContainsFunc(updates, func(update Update[T]) bool { return update.PreHook != nil }) {
+		return updates, nil, nil
+	}
+
+	// Fetch a fresh copy for the PreHooks so they see current server state.
+	current := updates[0].Resource.DeepCopyObject().(T)
+	if err := u.apiReader.Get(ctx, updates[0].NamespacedName, current); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("object not found, skipping pre-hook")
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if err := u.load(ctx, current); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		accepted []Update[T]
+		hookErrs []error
+	)
+	for _, update := range updates {
+		if update.PreHook != nil {
+			if err := update.PreHook(ctx, current.DeepCopyObject().(T)); err != nil {
+				log.Error(err, "pre-hook failed, aborting update")
+				metrics.StatusWriterErrorsTotal.WithLabelValues(hibernatorv1alpha1.KindOf(current), update.NamespacedName.String(), "pre_hook").Inc()
+				hookErrs = append(hookErrs, err)
+				continue
+			}
+		}
+		if update.Mutator != nil {
+			update.Mutator.Mutate(current)
+		}
+		accepted = append(accepted, update)
+	}
+	return accepted, hookErrs, nil
+}
+
+// load restores the externalized part of obj's status, if an externalizer is set.
+func (u *UpdateProcessor[T]) load(ctx context.Context, obj T) error {
+	if u.externalizer == nil {
+		return nil
+	}
+	if err := u.externalizer.Load(ctx, obj); err != nil {
+		metrics.StatusWriterErrorsTotal.WithLabelValues(hibernatorv1alpha1.KindOf(obj), client.ObjectKeyFromObject(obj).String(), "externalize").Inc()
+		return err
+	}
 	return nil
 }
 
@@ -272,6 +409,10 @@ func isStatusEqual(objA, objB any) bool {
 		if b, ok := objB.(*hibernatorv1alpha1.HibernateNotification); ok {
 			return cmp.Equal(a.Status, b.Status, defaultOpts)
 		}
+	case *hibernatorv1alpha1.ClusterHibernatePlan:
+		if b, ok := objB.(*hibernatorv1alpha1.ClusterHibernatePlan); ok {
+			return cmp.Equal(a.Status, b.Status, defaultOpts)
+		}
 	}
 
 	return false
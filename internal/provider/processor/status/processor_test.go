@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 // ---------------------------------------------------------------------------
@@ -254,6 +255,27 @@ func TestIsStatusEqual_ScheduleException_DifferentMessage_ReturnsFalse(t *testin
 	assert.False(t, isStatusEqual(a, b))
 }
 
+// ---------------------------------------------------------------------------
+// isStatusEqual — ClusterHibernatePlan
+// ---------------------------------------------------------------------------
+
+func TestIsStatusEqual_ClusterHibernatePlan(t *testing.T) {
+	a := &hibernatorv1alpha1.ClusterHibernatePlan{
+		Status: hibernatorv1alpha1.ClusterHibernatePlanStatus{
+			ObservedGeneration: 1,
+			SelectedNamespaces: 1,
+			Plans: []hibernatorv1alpha1.ClusterPlanMember{
+				{Namespace: "team-a", Name: "nightly", Phase: hibernatorv1alpha1.PhaseActive},
+			},
+		},
+	}
+	b := a.DeepCopy()
+	assert.True(t, isStatusEqual(a, b))
+
+	b.Status.Plans[0].Phase = hibernatorv1alpha1.PhaseHibernating
+	assert.False(t, isStatusEqual(a, b))
+}
+
 // ---------------------------------------------------------------------------
 // isStatusEqual — unknown type
 // ---------------------------------------------------------------------------
@@ -458,6 +480,171 @@ func TestApply_PostHook_ErrorIsNonFatal(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// ---------------------------------------------------------------------------
+// UpdateProcessor.applyBatch
+// ---------------------------------------------------------------------------
+
+func TestApplyBatch_WritesAllMutationsInOnePatch(t *testing.T) {
+	ctx := context.Background()
+	plan := basePlan("p1", "default", hibernatorv1alpha1.PhaseActive)
+
+	var patches int
+	c := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(plan).
+		WithStatusSubresource(&hibernatorv1alpha1.HibernatePlan{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				patches++
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+	proc := NewUpdateProcessor[*hibernatorv1alpha1.HibernatePlan](logr.Discard(), c, c)
+
+	var postHooks []string
+	postHook := func(name string) HookFunc[*hibernatorv1alpha1.HibernatePlan] {
+		return func(_ context.Context, _ *hibernatorv1alpha1.HibernatePlan) error {
+			postHooks = append(postHooks, name)
+			return nil
+		}
+	}
+	nn := types.NamespacedName{Name: "p1", Namespace: "default"}
+	updates := []Update[*hibernatorv1alpha1.HibernatePlan]{
+		{
+			NamespacedName: nn,
+			Resource:       plan,
+			Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+				p.Status.Phase = hibernatorv1alpha1.PhaseHibernating
+			}),
+			PostHook: postHook("phase"),
+		},
+		{
+			NamespacedName: nn,
+			Resource:       plan,
+			Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+				p.Status.CurrentStageIndex = 2
+			}),
+			PostHook: postHook("stage"),
+		},
+		{
+			NamespacedName: nn,
+			Resource:       plan,
+			// Already applied by the first update.
+			Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+				p.Status.Phase = hibernatorv1alpha1.PhaseHibernating
+			}),
+			PostHook: postHook("noop"),
+		},
+	}
+
+	require.NoError(t, proc.applyBatch(ctx, updates))
+
+	assert.Equal(t, 1, patches)
+	assert.Equal(t, []string{"phase", "stage"}, postHooks, "post-hooks run only for updates that changed the status")
+
+	fresh := &hibernatorv1alpha1.HibernatePlan{}
+	require.NoError(t, c.Get(ctx, nn, fresh))
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, fresh.Status.Phase)
+	assert.Equal(t, 2, fresh.Status.CurrentStageIndex)
+}
+
+func TestApplyBatch_PreHookError_DropsOnlyThatUpdate(t *testing.T) {
+	ctx := context.Background()
+	plan := basePlan("p1", "default", hibernatorv1alpha1.PhaseActive)
+	proc := newPlanProcessor(plan)
+
+	hookErr := errors.New("pre-hook failure")
+	var seenPhase hibernatorv1alpha1.PlanPhase
+	nn := types.NamespacedName{Name: "p1", Namespace: "default"}
+	updates := []Update[*hibernatorv1alpha1.HibernatePlan]{
+		{
+			NamespacedName: nn,
+			Resource:       plan,
+			Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+				p.Status.Phase = hibernatorv1alpha1.PhaseHibernating
+			}),
+		},
+		{
+			NamespacedName: nn,
+			Resource:       plan,
+			PreHook: func(_ context.Context, _ *hibernatorv1alpha1.HibernatePlan) error {
+				return hookErr
+			},
+			Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+				p.Status.CurrentStageIndex = 2
+			}),
+		},
+		{
+			NamespacedName: nn,
+			Resource:       plan,
+			PreHook: func(_ context.Context, p *hibernatorv1alpha1.HibernatePlan) error {
+				seenPhase = p.Status.Phase
+				return nil
+			},
+			Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+				p.Status.ErrorMessage = "stage failed"
+			}),
+		},
+	}
+
+	err := proc.applyBatch(ctx, updates)
+	require.ErrorIs(t, err, hookErr)
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, seenPhase, "pre-hooks see the mutations of preceding updates")
+
+	fresh := &hibernatorv1alpha1.HibernatePlan{}
+	require.NoError(t, proc.apiReader.Get(ctx, nn, fresh))
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, fresh.Status.Phase)
+	assert.Zero(t, fresh.Status.CurrentStageIndex)
+	assert.Equal(t, "stage failed", fresh.Status.ErrorMessage)
+}
+
+func TestApplyBatch_RetriesOnConflict(t *testing.T) {
+	ctx := context.Background()
+	plan := basePlan("p1", "default", hibernatorv1alpha1.PhaseActive)
+
+	var attempts int
+	c := fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(plan).
+		WithStatusSubresource(&hibernatorv1alpha1.HibernatePlan{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				attempts++
+				if attempts == 1 {
+					// Another writer changed the plan since it was read.
+					current := &hibernatorv1alpha1.HibernatePlan{}
+					if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+						return err
+					}
+					current.Annotations = map[string]string{"touched": "true"}
+					if err := c.Update(ctx, current); err != nil {
+						return err
+					}
+				}
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+	proc := NewUpdateProcessor[*hibernatorv1alpha1.HibernatePlan](logr.Discard(), c, c)
+
+	update := Update[*hibernatorv1alpha1.HibernatePlan]{
+		NamespacedName: types.NamespacedName{Name: "p1", Namespace: "default"},
+		Resource:       plan,
+		Mutator: MutatorFunc[*hibernatorv1alpha1.HibernatePlan](func(p *hibernatorv1alpha1.HibernatePlan) {
+			p.Status.Phase = hibernatorv1alpha1.PhaseHibernating
+		}),
+	}
+
+	require.NoError(t, proc.apply(ctx, update))
+	assert.Equal(t, 2, attempts, "the conflicting patch is retried on a fresh copy")
+
+	fresh := &hibernatorv1alpha1.HibernatePlan{}
+	require.NoError(t, c.Get(ctx, update.NamespacedName, fresh))
+	assert.Equal(t, hibernatorv1alpha1.PhaseHibernating, fresh.Status.Phase)
+	assert.Equal(t, "true", fresh.Annotations["touched"])
+}
+
 // ---------------------------------------------------------------------------
 // defaultUpdater.Send
 // ---------------------------------------------------------------------------
@@ -20,4 +20,7 @@ type ControllerStatuses struct {
 
 	// NotificationStatuses accepts status mutations for HibernateNotification objects.
 	NotificationStatuses Updater[*hibernatorv1alpha1.HibernateNotification]
+
+	// ClusterPlanStatuses accepts status mutations for ClusterHibernatePlan objects.
+	ClusterPlanStatuses Updater[*hibernatorv1alpha1.ClusterHibernatePlan]
 }
@@ -7,8 +7,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
 	"time"
 
@@ -34,6 +36,8 @@ import (
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/message"
+	"github.com/ardikabs/hibernator/internal/metrics"
+	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/restore"
 	"github.com/ardikabs/hibernator/internal/scheduler"
 	"github.com/ardikabs/hibernator/internal/wellknown"
@@ -81,21 +85,28 @@ type PlanReconciler struct {
 	NotificationBindings notificationBindingTracker
 }
 
+// +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=hibernateexecutions,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=hibernatenotifications,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=hibernatenotifications/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=hibernatenotifications/finalizers,verbs=update
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=hibernateplans,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=hibernateplans/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=hibernateplans/finalizers,verbs=update
+// +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=hibernationreports,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=scheduleexceptions,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=scheduleexceptions/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=scheduleexceptions/finalizers,verbs=update
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=cloudproviders,verbs=get;list;watch
+// +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=cloudproviders/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=k8sclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=k8sclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=list
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete
 
 // Reconcile handles HibernatePlan reconciliation by fetching all related resources
 // and storing an enriched PlanContext in the watchable map.
@@ -112,11 +123,34 @@ func (r *PlanReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 			r.Resources.PlanResources.Delete(key)
 			r.DependencyNonces.Delete(key)
 			r.deleteNotificationBindings(key)
+			metrics.ForgetPlan(key)
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, err
 	}
 
+	// Large plans keep their execution ledger in HibernateExecution objects. Read
+	// them uncached so the ledger is never older than the plan status it belongs to.
+	if err := statusprocessor.LoadExecutions(ctx, r.APIReader, plan); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Expand spec.targetsFrom and connector selectors before anything reads the
+	// targets. A plan whose targets cannot be resolved is still published so its
+	// status reports why.
+	dynamicTargets := hasDynamicTargets(plan)
+	var targetsErr string
+	if err := r.resolveTargets(ctx, plan); err != nil {
+		if !errors.Is(err, errInvalidTargets) {
+			return ctrl.Result{}, err
+		}
+		log.Info("failed to resolve targets", "error", err.Error())
+		targetsErr = err.Error()
+	}
+
+	// Connector health is informational; it does not stop the plan from running.
+	connectorsErr := r.unreadyConnectors(ctx, plan)
+
 	// Enrich the logger with cycle metadata when available.
 	if plan.Status.CurrentCycleID != "" && plan.Status.CurrentOperation != "" {
 		log = log.WithValues("cycleID", plan.Status.CurrentCycleID, "operation", plan.Status.CurrentOperation)
@@ -138,6 +172,7 @@ func (r *PlanReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	schedule, err := r.evaluateSchedule(ctx, plan, allExceptions, log)
 	if err != nil {
 		log.Error(err, "failed to evaluate schedule")
+		metrics.ReconcileOutcomeTotal.WithLabelValues(metrics.PlanLabel(key), metrics.OutcomeScheduleError).Inc()
 		return ctrl.Result{RequeueAfter: wellknown.RequeueIntervalOnScheduleError}, nil
 	}
 
@@ -153,12 +188,15 @@ func (r *PlanReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	// The reconciler is a pure data collector — it does not requeue.
 	// Time-based re-enqueuing is handled by the PlanRequeueProcessor.
 	planCtx := &message.PlanContext{
-		Plan:           plan,
-		Schedule:       schedule,
-		Exceptions:     allExceptions,
-		Notifications:  notifications,
-		HasRestoreData: hasRestoreData,
-		DeliveryNonce:  r.DependencyNonces.Get(key),
+		Plan:            plan,
+		Schedule:        schedule,
+		Exceptions:      allExceptions,
+		Notifications:   notifications,
+		HasRestoreData:  hasRestoreData,
+		DynamicTargets:  dynamicTargets,
+		TargetsError:    targetsErr,
+		ConnectorsError: connectorsErr,
+		DeliveryNonce:   r.DependencyNonces.Get(key),
 	}
 
 	r.Resources.PlanResources.Store(key, planCtx)
@@ -175,17 +213,34 @@ func (r *PlanReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 }
 
 // fetchAllExceptions retrieves ALL ScheduleExceptions for a given plan (any state)
-// using the field index on spec.planRef.name.
+// using the field index on spec.planRef.name. Exceptions that reference the plan
+// by name are looked up directly; those selecting plans by label are looked up
+// under the selector index value and matched against the plan's labels.
 func (r *PlanReconciler) fetchAllExceptions(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) ([]hibernatorv1alpha1.ScheduleException, error) {
-	var exceptionList hibernatorv1alpha1.ScheduleExceptionList
-	if err := r.List(ctx, &exceptionList,
+	var byName, bySelector hibernatorv1alpha1.ScheduleExceptionList
+	if err := r.List(ctx, &byName,
 		client.InNamespace(plan.Namespace),
 		client.MatchingFields{wellknown.FieldIndexExceptionPlanRef: plan.Name},
 	); err != nil {
 		return nil, err
 	}
+	if err := r.List(ctx, &bySelector,
+		client.InNamespace(plan.Namespace),
+		client.MatchingFields{wellknown.FieldIndexExceptionPlanRef: wellknown.ExceptionPlanSelectorIndexValue},
+	); err != nil {
+		return nil, err
+	}
+
+	exceptions := byName.Items
+	for _, exc := range bySelector.Items {
+		if exc.AppliesToPlan(plan.Name, plan.Labels) && !slices.ContainsFunc(exceptions, func(e hibernatorv1alpha1.ScheduleException) bool {
+			return e.Name == exc.Name
+		}) {
+			exceptions = append(exceptions, exc)
+		}
+	}
 
-	return exceptionList.Items, nil
+	return exceptions, nil
 }
 
 // fetchAndPublishNotifications retrieves all HibernateNotifications in the plan's
@@ -279,8 +334,15 @@ func (r *PlanReconciler) evaluateSchedule(_ context.Context, plan *hibernatorv1a
 		}
 	}
 
-	// Evaluate schedule with exceptions (if any)
-	result, err := r.ScheduleEvaluator.Evaluate(baseWindows, plan.Spec.Schedule.Timezone, exceptions)
+	dates := lo.Map(plan.Spec.Schedule.Dates, func(w hibernatorv1alpha1.DateWindow, _ int) scheduler.DateWindow {
+		return scheduler.DateWindow{Start: w.Start, End: w.End}
+	})
+
+	// Evaluate schedule with exceptions (if any), delayed by the plan's jitter
+	// and with its DST policy.
+	jitter := scheduler.JitterDelay(plan.Namespace+"/"+plan.Name, plan.Spec.Schedule.Jitter)
+	evaluator := r.ScheduleEvaluator.With(scheduler.WithDSTPolicy(scheduler.DSTPolicy(plan.Spec.Schedule.DSTPolicy)))
+	result, err := evaluator.EvaluateDelayed(jitter, baseWindows, dates, plan.Spec.Schedule.Timezone, exceptions)
 	if err != nil {
 		return nil, err
 	}
@@ -297,12 +359,15 @@ func (r *PlanReconciler) evaluateSchedule(_ context.Context, plan *hibernatorv1a
 		"nextHibernateTime", result.NextHibernateTime.Format(time.RFC3339),
 		"nextWakeUpTime", result.NextWakeUpTime.Format(time.RFC3339),
 		"nextEvent", nextEvent.Format(time.RFC3339),
+		"jitter", jitter,
 	)
 
 	return &message.ScheduleEvaluation{
 		Exceptions:      activeExceptions,
 		ShouldHibernate: result.ShouldHibernate,
 		NextEvent:       nextEvent,
+		NextHibernate:   result.NextHibernateTime,
+		NextWakeUp:      result.NextWakeUpTime,
 	}, nil
 }
 
@@ -340,7 +405,8 @@ func (r *PlanReconciler) filterActiveExceptions(allExceptions []hibernatorv1alph
 	now := r.Clock.Now()
 
 	activeExceptions := lo.Filter(allExceptions, func(exc hibernatorv1alpha1.ScheduleException, _ int) bool {
-		return now.After(exc.Spec.ValidFrom.Time) && now.Before(exc.Spec.ValidUntil.Time) && exc.DeletionTimestamp.IsZero()
+		from, until := exc.EffectiveWindow()
+		return now.After(from) && now.Before(until) && exc.DeletionTimestamp.IsZero()
 	})
 
 	// Sort by CreationTimestamp descending (newest first)
@@ -373,29 +439,66 @@ func convertException(exc hibernatorv1alpha1.ScheduleException) *scheduler.Excep
 		}
 	}
 
+	validFrom, validUntil := exc.EffectiveWindow()
 	return &scheduler.Exception{
 		Type:       scheduler.ExceptionType(exc.Spec.Type),
-		ValidFrom:  exc.Spec.ValidFrom.Time,
-		ValidUntil: exc.Spec.ValidUntil.Time,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
 		LeadTime:   leadTime,
 		Windows:    windows,
 	}
 }
 
+// exceptionPlanRefIndexer returns the FieldIndexExceptionPlanRef values of an
+// exception: every plan it names, plus the selector value when it selects plans
+// by label.
+func exceptionPlanRefIndexer(obj client.Object) []string {
+	exc, ok := obj.(*hibernatorv1alpha1.ScheduleException)
+	if !ok {
+		return nil
+	}
+	values := exc.PlanNames()
+	if exc.Spec.PlanSelector != nil {
+		values = append(values, wellknown.ExceptionPlanSelectorIndexValue)
+	}
+	return values
+}
+
 // findPlansForException returns reconcile requests for HibernatePlans when a ScheduleException changes.
+// An exception fans out to every plan it names and every plan its planSelector matches. On update the
+// map function also runs against the old object, so plans that no longer match are reconciled too.
 func (r *PlanReconciler) findPlansForException(ctx context.Context, obj client.Object) []reconcile.Request {
 	exception, ok := obj.(*hibernatorv1alpha1.ScheduleException)
 	if !ok {
 		return nil
 	}
-	return []reconcile.Request{
-		{
-			NamespacedName: types.NamespacedName{
-				Name:      exception.Spec.PlanRef.Name,
-				Namespace: exception.Namespace,
-			},
-		},
+
+	requests := lo.Map(exception.PlanNames(), func(name string, _ int) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: exception.Namespace}}
+	})
+	if exception.Spec.PlanSelector == nil {
+		return requests
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(exception.Spec.PlanSelector)
+	if err != nil {
+		return requests
+	}
+	var planList hibernatorv1alpha1.HibernatePlanList
+	if err := r.List(ctx, &planList,
+		client.InNamespace(exception.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		r.Log.Error(err, "failed to list plans for exception", "exception", client.ObjectKeyFromObject(exception))
+		return requests
+	}
+	for _, plan := range planList.Items {
+		req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&plan)}
+		if !slices.Contains(requests, req) {
+			requests = append(requests, req)
+		}
 	}
+	return requests
 }
 
 // findPlansForNotification returns reconcile requests for all HibernatePlans in the same namespace
@@ -484,6 +587,34 @@ func (r *PlanReconciler) onJobTerminalUpdate(e event.UpdateEvent) bool {
 	return condition
 }
 
+// onRemoteRunnerResult detects the first result a remote runner reported on
+// its record, the counterpart of a terminal transition of an owned Job for
+// runner Jobs dispatched into another cluster. On detection it increments
+// DependencyNonces for the owning plan, like onJobTerminalUpdate.
+func (r *PlanReconciler) onRemoteRunnerResult(oldCM, newCM *corev1.ConfigMap) bool {
+	if newCM.Labels[wellknown.LabelRemoteRunnerJob] != "true" {
+		return false
+	}
+	_, hadResult := oldCM.Annotations[wellknown.AnnotationRemoteResult]
+	_, hasResult := newCM.Annotations[wellknown.AnnotationRemoteResult]
+	if hadResult || !hasResult {
+		return false
+	}
+
+	owner := metav1.GetControllerOf(newCM)
+	if owner == nil {
+		return true
+	}
+	nn := types.NamespacedName{Name: owner.Name, Namespace: newCM.Namespace}
+	r.DependencyNonces.Inc(nn)
+
+	r.Log.V(1).Info("remote runner reported its result, enqueuing plan",
+		"plan", nn,
+		"record", client.ObjectKeyFromObject(newCM),
+	)
+	return true
+}
+
 // SetupWithManager sets up the provider reconciler with the Manager.
 func (r *PlanReconciler) SetupWithManager(mgr ctrl.Manager, workers int) error {
 	// configMapDataChangedPredicate fires only when a ConfigMap's Data or BinaryData
@@ -493,6 +624,8 @@ func (r *PlanReconciler) SetupWithManager(mgr ctrl.Manager, workers int) error {
 	// restore ConfigMap's annotations (not its Data). Without this predicate every
 	// such annotation write would trigger a provider reconcile even though HasRestoreData
 	// would return the same answer — producing one spurious reconcile per wakeup stage.
+	// Remote runner records are the exception: the result annotation is how a
+	// remote runner Job reports that it finished.
 	configMapDataChangedPredicate := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oldCM, okOld := e.ObjectOld.(*corev1.ConfigMap)
@@ -500,7 +633,7 @@ func (r *PlanReconciler) SetupWithManager(mgr ctrl.Manager, workers int) error {
 			if !okOld || !okNew {
 				return true // pass unknown types through
 			}
-			return !maps.Equal(oldCM.Data, newCM.Data)
+			return !maps.Equal(oldCM.Data, newCM.Data) || r.onRemoteRunnerResult(oldCM, newCM)
 		},
 		CreateFunc:  func(_ event.CreateEvent) bool { return true },
 		DeleteFunc:  func(_ event.DeleteEvent) bool { return true },
@@ -571,6 +704,33 @@ func (r *PlanReconciler) SetupWithManager(mgr ctrl.Manager, workers int) error {
 				notificationDeletionPredicate,
 			)),
 		).
+		Watches(
+			&hibernatorv1alpha1.TargetPreset{},
+			handler.EnqueueRequestsFromMapFunc(r.findPlansForTargetPreset),
+			// TargetPresets have no status; every generation is a target change.
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&hibernatorv1alpha1.CloudProvider{},
+			handler.EnqueueRequestsFromMapFunc(r.findPlansForConnector),
+			// Label changes move connectors in and out of selector targets.
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
+		Watches(
+			&hibernatorv1alpha1.K8SCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.findPlansForConnector),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
+		Watches(
+			&hibernatorv1alpha1.CloudProvider{},
+			handler.EnqueueRequestsFromMapFunc(r.findPlansUsingConnector),
+			builder.WithPredicates(connectorHealthChangedPredicate),
+		).
+		Watches(
+			&hibernatorv1alpha1.K8SCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.findPlansUsingConnector),
+			builder.WithPredicates(connectorHealthChangedPredicate),
+		).
 		WatchesRawSource(source.Channel(r.EnqueueCh, &handler.EnqueueRequestForObject{})).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: workers,
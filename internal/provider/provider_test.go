@@ -24,6 +24,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/message"
@@ -52,13 +53,10 @@ func newPlanReconciler(clk *clocktesting.FakeClock, objs ...client.Object) (*Pla
 		WithScheme(scheme).
 		WithObjects(objs...).
 		WithStatusSubresource(&hibernatorv1alpha1.HibernatePlan{}).
-		WithIndex(&hibernatorv1alpha1.ScheduleException{}, wellknown.FieldIndexExceptionPlanRef, func(obj client.Object) []string {
-			exc, ok := obj.(*hibernatorv1alpha1.ScheduleException)
-			if !ok {
-				return nil
-			}
-			return []string{exc.Spec.PlanRef.Name}
-		}).
+		WithIndex(&hibernatorv1alpha1.ScheduleException{}, wellknown.FieldIndexExceptionPlanRef, exceptionPlanRefIndexer).
+		WithIndex(&hibernatorv1alpha1.HibernatePlan{}, wellknown.FieldIndexPlanTargetPreset, planTargetPresetIndexer).
+		WithIndex(&hibernatorv1alpha1.HibernatePlan{}, wellknown.FieldIndexPlanConnectorSelector, planConnectorSelectorIndexer).
+		WithIndex(&hibernatorv1alpha1.HibernatePlan{}, wellknown.FieldIndexPlanConnectorRef, planConnectorRefIndexer).
 		Build()
 
 	resources := new(message.ControllerResources)
@@ -348,6 +346,57 @@ func TestFindPlansForException_ReturnsReconcileRequest(t *testing.T) {
 	assert.Equal(t, "default", requests[0].Namespace)
 }
 
+func TestFindPlansForException_MultiplePlans_FansOut(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	web := simplePlan("web", "default")
+	web.Labels = map[string]string{"freeze": "black-friday"}
+	batch := simplePlan("batch", "default")
+	batch.Labels = map[string]string{"freeze": "black-friday"}
+	other := simplePlan("other", "default")
+	r, _ := newPlanReconciler(clk, web, batch, other)
+
+	exception := &hibernatorv1alpha1.ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{Name: "freeze", Namespace: "default"},
+		Spec: hibernatorv1alpha1.ScheduleExceptionSpec{
+			PlanRefs:     []hibernatorv1alpha1.PlanReference{{Name: "reports"}, {Name: "web"}},
+			PlanSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"freeze": "black-friday"}},
+		},
+	}
+
+	requests := r.findPlansForException(context.Background(), exception)
+	names := lo.Map(requests, func(req reconcile.Request, _ int) string { return req.Name })
+	assert.ElementsMatch(t, []string{"reports", "web", "batch"}, names)
+}
+
+func TestFetchAllExceptions_IncludesSelectorMatches(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	plan := simplePlan("web", "default")
+	plan.Labels = map[string]string{"tier": "frontend"}
+
+	named := &hibernatorv1alpha1.ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{Name: "named", Namespace: "default"},
+		Spec:       hibernatorv1alpha1.ScheduleExceptionSpec{PlanRefs: []hibernatorv1alpha1.PlanReference{{Name: "api"}, {Name: "web"}}},
+	}
+	selected := &hibernatorv1alpha1.ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{Name: "selected", Namespace: "default"},
+		Spec: hibernatorv1alpha1.ScheduleExceptionSpec{
+			PlanSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}},
+		},
+	}
+	unrelated := &hibernatorv1alpha1.ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"},
+		Spec: hibernatorv1alpha1.ScheduleExceptionSpec{
+			PlanSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "backend"}},
+		},
+	}
+	r, _ := newPlanReconciler(clk, plan, named, selected, unrelated)
+
+	exceptions, err := r.fetchAllExceptions(context.Background(), plan)
+	require.NoError(t, err)
+	names := lo.Map(exceptions, func(exc hibernatorv1alpha1.ScheduleException, _ int) string { return exc.Name })
+	assert.ElementsMatch(t, []string{"named", "selected"}, names)
+}
+
 func TestFindPlansForException_NonExceptionObject_ReturnsNil(t *testing.T) {
 	clk := clocktesting.NewFakeClock(time.Now())
 	r, _ := newPlanReconciler(clk)
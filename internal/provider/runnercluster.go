@@ -0,0 +1,65 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/pkg/k8sutil"
+)
+
+// RunnerClusterClients connects to the K8SClusters plans dispatch their runner
+// Jobs into through spec.execution.runnerCluster. The controller reaches them
+// with the same access it probes them with, so EKS clusters need a
+// CloudProvider with static credentials.
+type RunnerClusterClients struct {
+	// Client reads K8SClusters and CloudProviders.
+	Client client.Reader
+
+	// APIReader reads kubeconfig and credential Secrets without caching every
+	// Secret in the cluster.
+	APIReader client.Reader
+
+	// Scheme is the scheme of the returned clients.
+	Scheme *runtime.Scheme
+}
+
+// ClientFor returns a client of the K8SCluster namespace/name.
+func (c RunnerClusterClients) ClientFor(ctx context.Context, namespace, name string) (client.Client, error) {
+	var kc hibernatorv1alpha1.K8SCluster
+	if err := c.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &kc); err != nil {
+		return nil, fmt.Errorf("get K8SCluster %s/%s: %w", namespace, name, err)
+	}
+
+	cfg, skip, err := clusterAccess(ctx, c.Client, c.APIReader, &kc)
+	if err != nil {
+		return nil, err
+	}
+	if skip != "" {
+		return nil, fmt.Errorf("K8SCluster %s/%s cannot run runner Jobs: %s", namespace, name, skip)
+	}
+
+	if cfg.UseEKSToken && cfg.ClusterEndpoint == "" {
+		if err := resolveEKSEndpoint(ctx, cfg); err != nil {
+			return nil, err
+		}
+	}
+	restConfig, err := k8sutil.BuildRESTConfig(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("build rest config: %w", err)
+	}
+
+	cl, err := client.New(restConfig, client.Options{Scheme: c.Scheme})
+	if err != nil {
+		return nil, fmt.Errorf("create client: %w", err)
+	}
+	return cl, nil
+}
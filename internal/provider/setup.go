@@ -8,15 +8,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/audit"
+	"github.com/ardikabs/hibernator/internal/history"
 	"github.com/ardikabs/hibernator/internal/message"
 	"github.com/ardikabs/hibernator/internal/notification"
 	notificationprocessor "github.com/ardikabs/hibernator/internal/provider/processor/notification"
@@ -26,7 +28,9 @@ import (
 	scheduleexceptionprocessor "github.com/ardikabs/hibernator/internal/provider/processor/scheduleexception"
 	statusprocessor "github.com/ardikabs/hibernator/internal/provider/processor/status"
 	"github.com/ardikabs/hibernator/internal/restore"
+	"github.com/ardikabs/hibernator/internal/runnerimage"
 	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/tracing"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
@@ -47,10 +51,74 @@ type ProviderOptions struct {
 	// ControlPlaneEndpoint is the address of the hibernator control-plane gRPC/webhook server,
 	// used by runner Jobs for streaming callbacks.
 	ControlPlaneEndpoint string
+	// ControlPlaneProbeTTL is how long the result of probing ControlPlaneEndpoint
+	// is cached. Runner Jobs are dispatched without streaming endpoints while
+	// the probe fails. Zero disables the probe.
+	ControlPlaneProbeTTL time.Duration
+	// ConnectorValidationInterval is how often CloudProvider credentials are
+	// validated and K8SCluster API servers probed into their status. Zero disables
+	// both.
+	ConnectorValidationInterval time.Duration
 	// RunnerImage is the container image used for executor runner Jobs.
 	RunnerImage string
+	// RunnerImages maps executor types to type-specific runner images
+	// (e.g., "rds" → a slim RDS-only runner). Types without an entry use RunnerImage.
+	RunnerImages map[string]string
 	// RunnerServiceAccount is the ServiceAccount name used by runner Jobs.
 	RunnerServiceAccount string
+	// RunnerImageAllowlist holds the images targets may select for their runner
+	// Jobs through runnerImage, besides RunnerImage and RunnerImages.
+	RunnerImageAllowlist runnerimage.Allowlist
+	// RunnerServiceAccounts are the ServiceAccounts targets may select for their
+	// runner Jobs instead of RunnerServiceAccount.
+	RunnerServiceAccounts []string
+	// RestoreS3Endpoints are the custom S3 endpoints plans may select for their
+	// restore storage.
+	RestoreS3Endpoints []string
+	// StreamToken configures the projected token mounted into runner Jobs.
+	// It must match the audiences accepted by the streaming servers.
+	StreamToken state.StreamTokenConfig
+	// RunnerClientCerts issues the client certificates runner Jobs present to
+	// streaming servers that require mutual TLS. Nil disables them.
+	RunnerClientCerts state.ClientCertIssuer
+	// Tracing is passed to runner Jobs so their spans join the traces of
+	// the reconcile passes dispatching them.
+	Tracing tracing.Config
+	// RunnerHeartbeatTimeout is how long an active runner Job may go without a
+	// heartbeat before its execution is reported stale. Zero disables it.
+	RunnerHeartbeatTimeout time.Duration
+	// StaleRunnerRestartAfter is how long after its last heartbeat a stale
+	// runner is restarted. Zero only reports stale runners.
+	StaleRunnerRestartAfter time.Duration
+	// MaxConcurrentRunnerJobs bounds the runner Jobs running at once across all
+	// plans. Zero leaves them unbounded.
+	MaxConcurrentRunnerJobs int
+	// MaxResourcesPerTarget is the default of spec.behavior.maxResourcesPerTarget.
+	// Zero leaves shutdowns of plans without it unbounded.
+	MaxResourcesPerTarget int32
+	// CostAllocationLabels maps chargeback dimensions (e.g., "team") to the plan
+	// label keys recorded on every execution cycle.
+	CostAllocationLabels map[string]string
+	// ExecutionObjectsThreshold is the number of targets from which a plan's
+	// execution ledger is stored in HibernateExecution objects instead of its
+	// status. Zero keeps every ledger inline.
+	ExecutionObjectsThreshold int
+	// Audit records the phase transitions of plans in their audit log. Nil
+	// disables the audit log.
+	Audit audit.Recorder
+	// ReportRollups are the HibernationReport roll-ups generated per namespace.
+	// Empty generates none.
+	ReportRollups []hibernatorv1alpha1.ReportType
+	// ReportRollupRetention is how long roll-ups are kept. Zero keeps them.
+	ReportRollupRetention time.Duration
+	// NamespaceScoped is set when the manager only watches some namespaces.
+	// ClusterHibernatePlans, which generate plans in any namespace, are then
+	// left to a controller watching all of them.
+	NamespaceScoped bool
+	// Shard is the shard whose plans the manager reconciles, empty when it
+	// reconciles all of them. An exception targeting plans of several shards is
+	// then driven by one of them only.
+	Shard string
 
 	// NotificationOptions configures the notification subsystem.
 	// E2E tests use this to inject custom sinks via notification.WithSink().
@@ -74,7 +142,9 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 		return fmt.Errorf("unable to register field indexes: %w", err)
 	}
 
-	restoreMgr := restore.NewManager(mgr.GetClient(), opts.Logger)
+	restoreMgr := restore.NewManager(mgr.GetClient(), opts.Logger, restore.WithBackendResolver(
+		restore.PlanStorageResolver(mgr.GetClient(), restore.WithS3Endpoints(opts.RestoreS3Endpoints)),
+	))
 	planner := scheduler.NewPlanner()
 	schedEvaluator := scheduler.NewScheduleEvaluator(clk, scheduler.WithScheduleBuffer(opts.ScheduleBufferDuration))
 
@@ -92,7 +162,9 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 	planStatusProcessor := statusprocessor.NewUpdateProcessor[*hibernatorv1alpha1.HibernatePlan](
 		opts.Logger.WithName("processor").WithName("plan-status"),
 		mgr.GetClient(),
-		mgr.GetAPIReader())
+		mgr.GetAPIReader(),
+		statusprocessor.WithExternalizer[*hibernatorv1alpha1.HibernatePlan](
+			statusprocessor.NewExecutionStore(mgr.GetClient(), mgr.GetAPIReader(), opts.ExecutionObjectsThreshold)))
 
 	exceptionStatusProcessor := statusprocessor.NewUpdateProcessor[*hibernatorv1alpha1.ScheduleException](
 		opts.Logger.WithName("processor").WithName("exception-status"),
@@ -104,10 +176,16 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 		mgr.GetClient(),
 		mgr.GetAPIReader())
 
+	clusterPlanStatusProcessor := statusprocessor.NewUpdateProcessor[*hibernatorv1alpha1.ClusterHibernatePlan](
+		opts.Logger.WithName("processor").WithName("clusterplan-status"),
+		mgr.GetClient(),
+		mgr.GetAPIReader())
+
 	statuses := &statusprocessor.ControllerStatuses{
 		PlanStatuses:         planStatusProcessor.Writer(),
 		ExceptionStatuses:    exceptionStatusProcessor.Writer(),
 		NotificationStatuses: notifStatusProcessor.Writer(),
+		ClusterPlanStatuses:  clusterPlanStatusProcessor.Writer(),
 	}
 
 	// --- Providers (K8s reconciler → watchable map) ---
@@ -130,6 +208,71 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 
 	log.Info("registered provider", "provider", "hibernateplan")
 
+	if !opts.NamespaceScoped {
+		clusterPlanProvider := &ClusterPlanReconciler{
+			Client:   mgr.GetClient(),
+			Log:      opts.Logger.WithName("clusterhibernateplan"),
+			Scheme:   mgr.GetScheme(),
+			Statuses: statuses.ClusterPlanStatuses,
+		}
+		if err := clusterPlanProvider.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create clusterhibernateplan provider: %w", err)
+		}
+
+		log.Info("registered provider", "provider", "clusterhibernateplan")
+	}
+
+	connectors, err := NewConnectorCache(mgr.GetAPIReader(), opts.Logger.WithName("connector-cache"))
+	if err != nil {
+		return err
+	}
+	if err := connectors.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create connector cache: %w", err)
+	}
+
+	log.Info("registered provider", "provider", "connector-cache")
+
+	if opts.ConnectorValidationInterval > 0 {
+		health := &CloudProviderHealthReconciler{
+			Client:    mgr.GetClient(),
+			APIReader: mgr.GetAPIReader(),
+			Clock:     clk,
+			Log:       opts.Logger.WithName("cloudprovider-health"),
+			Recorder:  mgr.GetEventRecorderFor("hibernator-connector-health"),
+			Validator: STSValidator{},
+			Interval:  opts.ConnectorValidationInterval,
+		}
+		if err := health.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create cloudprovider health provider: %w", err)
+		}
+
+		log.Info("registered provider", "provider", "cloudprovider-health")
+
+		clusterHealth := &K8SClusterHealthReconciler{
+			Client:    mgr.GetClient(),
+			APIReader: mgr.GetAPIReader(),
+			Clock:     clk,
+			Log:       opts.Logger.WithName("k8scluster-health"),
+			Recorder:  mgr.GetEventRecorderFor("hibernator-connector-health"),
+			Prober:    APIServerProber{},
+			Interval:  opts.ConnectorValidationInterval,
+		}
+		if err := clusterHealth.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create k8scluster health provider: %w", err)
+		}
+
+		log.Info("registered provider", "provider", "k8scluster-health")
+	}
+
+	var endpointChecker state.EndpointChecker
+	if opts.ControlPlaneProbeTTL > 0 {
+		probe, err := NewEndpointProbe(opts.ControlPlaneProbeTTL, opts.Logger.WithName("endpoint-probe"))
+		if err != nil {
+			return err
+		}
+		endpointChecker = probe
+	}
+
 	// --- Processors (watchable map → status updates) ---
 	// Registered as Runnables via mgr.Add() — started when the manager starts.
 
@@ -157,17 +300,38 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 			name: "hibernateplan.coordinator",
 			runnable: &planprocessor.Coordinator{
 				Infrastructure: state.Infrastructure{
-					Client:    mgr.GetClient(),
-					APIReader: mgr.GetAPIReader(),
-					Scheme:    mgr.GetScheme(),
-					Clock:     clk,
+					Client:     mgr.GetClient(),
+					APIReader:  mgr.GetAPIReader(),
+					Scheme:     mgr.GetScheme(),
+					Clock:      clk,
+					Connectors: connectors,
+					Recorder:   mgr.GetEventRecorderFor("hibernator-controller"),
+					Audit:      opts.Audit,
+					Archiver:   history.NewReportArchiver(mgr.GetClient()),
 				},
 				ExecutorInfra: state.ExecutorInfra{
-					ControlPlaneEndpoint: opts.ControlPlaneEndpoint,
-					RunnerImage:          opts.RunnerImage,
-					RunnerServiceAccount: opts.RunnerServiceAccount,
+					ControlPlaneEndpoint:    opts.ControlPlaneEndpoint,
+					EndpointChecker:         endpointChecker,
+					RunnerImage:             opts.RunnerImage,
+					RunnerImages:            opts.RunnerImages,
+					RunnerImageAllowlist:    opts.RunnerImageAllowlist,
+					RunnerServiceAccount:    opts.RunnerServiceAccount,
+					RunnerServiceAccounts:   opts.RunnerServiceAccounts,
+					StreamToken:             opts.StreamToken,
+					ClientCerts:             opts.RunnerClientCerts,
+					Tracing:                 opts.Tracing,
+					RunnerHeartbeatTimeout:  opts.RunnerHeartbeatTimeout,
+					StaleRunnerRestartAfter: opts.StaleRunnerRestartAfter,
+					JobLimiter:              state.NewRunnerJobLimiter(mgr.GetAPIReader(), opts.MaxConcurrentRunnerJobs),
+					MaxResourcesPerTarget:   opts.MaxResourcesPerTarget,
+					RunnerClusters: RunnerClusterClients{
+						Client:    mgr.GetClient(),
+						APIReader: mgr.GetAPIReader(),
+						Scheme:    mgr.GetScheme(),
+					},
 				},
 				Log:            opts.Logger.WithName("processor").WithName("plan"),
+				CostAllocation: opts.CostAllocationLabels,
 				Planner:        planner,
 				Resources:      resources,
 				Statuses:       statuses,
@@ -184,12 +348,23 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 				Enqueuer:  enqueuer,
 			},
 		},
+		{
+			name: "history.pruner",
+			runnable: &history.Pruner{
+				Client: mgr.GetClient(),
+				Clock:  clk,
+				Log:    opts.Logger.WithName("processor").WithName("history"),
+			},
+		},
 		{
 			name: "scheduleexception.processor",
 			runnable: &scheduleexceptionprocessor.LifecycleProcessor{
 				Client:    mgr.GetClient(),
 				Clock:     clk,
 				Log:       opts.Logger.WithName("processor").WithName("exception"),
+				Recorder:  mgr.GetEventRecorderFor("hibernator-controller"),
+				Shard:     opts.Shard,
+				APIReader: mgr.GetAPIReader(),
 				Resources: resources,
 				Statuses:  statuses,
 			},
@@ -202,6 +377,10 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 			name:     "exception.status",
 			runnable: exceptionStatusProcessor,
 		},
+		{
+			name:     "clusterplan.status",
+			runnable: clusterPlanStatusProcessor,
+		},
 		{
 			name:     "notification.lifecycle",
 			runnable: notifLifecycleProcessor,
@@ -216,6 +395,22 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 		},
 	}
 
+	if len(opts.ReportRollups) > 0 {
+		processors = append(processors, struct {
+			name     string
+			runnable manager.Runnable
+		}{
+			name: "history.rollup",
+			runnable: &history.Rollup{
+				Client:    mgr.GetClient(),
+				Clock:     clk,
+				Log:       opts.Logger.WithName("processor").WithName("history"),
+				Types:     opts.ReportRollups,
+				Retention: opts.ReportRollupRetention,
+			},
+		})
+	}
+
 	for _, p := range processors {
 		if err := mgr.Add(p.runnable); err != nil {
 			return fmt.Errorf("unable to add processor %s: %w", p.name, err)
@@ -228,16 +423,46 @@ func Setup(mgr ctrl.Manager, clk clock.Clock, opts ProviderOptions) error {
 
 // registerFieldIndexes sets up field indexes required by the reconciler pipeline.
 func registerFieldIndexes(mgr ctrl.Manager) error {
-	return mgr.GetFieldIndexer().IndexField(
+	if err := mgr.GetFieldIndexer().IndexField(
 		context.Background(),
 		&hibernatorv1alpha1.ScheduleException{},
 		wellknown.FieldIndexExceptionPlanRef,
-		func(obj client.Object) []string {
-			exc, ok := obj.(*hibernatorv1alpha1.ScheduleException)
-			if !ok {
-				return nil
-			}
-			return []string{exc.Spec.PlanRef.Name}
-		},
+		exceptionPlanRefIndexer,
+	); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&hibernatorv1alpha1.HibernatePlan{},
+		wellknown.FieldIndexPlanTargetPreset,
+		planTargetPresetIndexer,
+	); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&hibernatorv1alpha1.HibernatePlan{},
+		wellknown.FieldIndexPlanConnectorSelector,
+		planConnectorSelectorIndexer,
+	); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&hibernatorv1alpha1.HibernatePlan{},
+		wellknown.FieldIndexPlanConnectorRef,
+		planConnectorRefIndexer,
+	); err != nil {
+		return err
+	}
+
+	return mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&hibernatorv1alpha1.CloudProvider{},
+		wellknown.FieldIndexCloudProviderSecretRef,
+		cloudProviderSecretRefIndexer,
 	)
 }
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// errInvalidTargets marks target resolution failures caused by the plan or its
+// presets, as opposed to transient API errors.
+var errInvalidTargets = errors.New("invalid targets")
+
+// +kubebuilder:rbac:groups=hibernator.ardikabs.com,resources=targetpresets,verbs=get;list;watch
+
+// resolveTargets expands spec.targetsFrom and connector selectors into spec.targets
+// of the in-memory plan and validates the expanded list. Processors only ever see the
+// resolved plan; the stored spec is never rewritten.
+//
+// Errors wrapping errInvalidTargets leave the plan with its stored targets and are
+// reported through PlanContext.TargetsError; other errors are transient.
+func (r *PlanReconciler) resolveTargets(ctx context.Context, plan *hibernatorv1alpha1.HibernatePlan) error {
+	if !hasDynamicTargets(plan) {
+		return nil
+	}
+
+	targets := slices.Clone(plan.Spec.Targets)
+	for _, ref := range plan.Spec.TargetsFrom {
+		key := targetPresetKey(plan, ref)
+
+		var preset hibernatorv1alpha1.TargetPreset
+		if err := r.Get(ctx, key, &preset); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("%w: TargetPreset %s not found", errInvalidTargets, key)
+			}
+			return fmt.Errorf("get TargetPreset %s: %w", key, err)
+		}
+
+		// Connector references without a namespace keep resolving against the
+		// plan namespace, so one preset can serve plans in many namespaces.
+		targets = append(targets, preset.DeepCopy().Spec.Targets...)
+	}
+
+	targets, expandedNames, err := r.expandConnectorSelectors(ctx, plan, targets)
+	if err != nil {
+		return err
+	}
+
+	strategy := expandStrategy(plan.Spec.Execution.Strategy, expandedNames)
+	if err := r.validateTargets(strategy, targets); err != nil {
+		return err
+	}
+
+	plan.Spec.Targets = targets
+	plan.Spec.Execution.Strategy = strategy
+	return nil
+}
+
+// hasDynamicTargets reports whether the plan's target list depends on other objects.
+func hasDynamicTargets(plan *hibernatorv1alpha1.HibernatePlan) bool {
+	if len(plan.Spec.TargetsFrom) > 0 {
+		return true
+	}
+	return slices.ContainsFunc(plan.Spec.Targets, func(t hibernatorv1alpha1.Target) bool {
+		return t.ConnectorRef.Selector != nil
+	})
+}
+
+// validateTargets checks what the validation webhook cannot see before targets are
+// expanded: unique target names and strategy references to those names.
+func (r *PlanReconciler) validateTargets(strategy hibernatorv1alpha1.ExecutionStrategy, targets []hibernatorv1alpha1.Target) error {
+	if len(targets) == 0 {
+		return fmt.Errorf("%w: no targets after expansion", errInvalidTargets)
+	}
+
+	names := make([]string, 0, len(targets))
+	seen := make(map[string]bool, len(targets))
+	for _, t := range targets {
+		if seen[t.Name] {
+			return fmt.Errorf("%w: duplicate target name %q after expansion", errInvalidTargets, t.Name)
+		}
+		seen[t.Name] = true
+		names = append(names, t.Name)
+	}
+
+	switch strategy.Type {
+	case hibernatorv1alpha1.StrategyDAG:
+		deps := lo.Map(strategy.Dependencies, func(d hibernatorv1alpha1.Dependency, _ int) scheduler.Dependency {
+			return scheduler.Dependency{From: d.From, To: d.To}
+		})
+		if err := r.Planner.ValidateDAG(names, deps); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidTargets, err)
+		}
+
+	case hibernatorv1alpha1.StrategyStaged:
+		assigned := make(map[string]bool, len(targets))
+		for _, stage := range strategy.Stages {
+			for _, name := range stage.Targets {
+				if !seen[name] {
+					return fmt.Errorf("%w: stage %q references unknown target %q", errInvalidTargets, stage.Name, name)
+				}
+				assigned[name] = true
+			}
+		}
+		for _, name := range names {
+			if !assigned[name] {
+				return fmt.Errorf("%w: target %q is not assigned to any stage", errInvalidTargets, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// targetPresetKey returns the key of the TargetPreset ref points to, defaulting
+// to the plan namespace.
+func targetPresetKey(plan *hibernatorv1alpha1.HibernatePlan, ref hibernatorv1alpha1.TargetPresetReference) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: lo.Ternary(ref.Namespace != "", ref.Namespace, plan.Namespace),
+		Name:      ref.Name,
+	}
+}
+
+// planTargetPresetIndexer indexes HibernatePlans by the TargetPresets they reference.
+func planTargetPresetIndexer(obj client.Object) []string {
+	plan, ok := obj.(*hibernatorv1alpha1.HibernatePlan)
+	if !ok {
+		return nil
+	}
+	return lo.Map(plan.Spec.TargetsFrom, func(ref hibernatorv1alpha1.TargetPresetReference, _ int) string {
+		return targetPresetKey(plan, ref).String()
+	})
+}
+
+// findPlansForTargetPreset returns reconcile requests for HibernatePlans referencing
+// a TargetPreset. The plans' own resource versions do not change when a preset does,
+// so their dependency nonces are bumped to force re-delivery of the resolved context.
+func (r *PlanReconciler) findPlansForTargetPreset(ctx context.Context, obj client.Object) []reconcile.Request {
+	var planList hibernatorv1alpha1.HibernatePlanList
+	if err := r.List(ctx, &planList, client.MatchingFields{
+		wellknown.FieldIndexPlanTargetPreset: client.ObjectKeyFromObject(obj).String(),
+	}); err != nil {
+		r.Log.Error(err, "failed to list plans for target preset", "targetPreset", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(planList.Items))
+	for i := range planList.Items {
+		key := client.ObjectKeyFromObject(&planList.Items[i])
+		r.DependencyNonces.Inc(key)
+		requests = append(requests, reconcile.Request{NamespacedName: key})
+	}
+	return requests
+}
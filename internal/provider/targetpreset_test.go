@@ -0,0 +1,126 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func testTargetPreset(name, namespace string, targets ...string) *hibernatorv1alpha1.TargetPreset {
+	preset := &hibernatorv1alpha1.TargetPreset{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+	for _, t := range targets {
+		preset.Spec.Targets = append(preset.Spec.Targets, hibernatorv1alpha1.Target{Name: t, Type: "rds"})
+	}
+	return preset
+}
+
+func TestPlanReconciler_ResolveTargets_ExpandsPresets(t *testing.T) {
+	plan := simplePlan("my-plan", "default")
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{{Name: "inline", Type: "ec2"}}
+	plan.Spec.TargetsFrom = []hibernatorv1alpha1.TargetPresetReference{
+		{Name: "local"},
+		{Name: "shared", Namespace: "platform"},
+	}
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan,
+		testTargetPreset("local", "default", "db"),
+		testTargetPreset("shared", "platform", "cache"),
+	)
+
+	require.NoError(t, r.resolveTargets(context.Background(), plan))
+
+	names := make([]string, 0, len(plan.Spec.Targets))
+	for _, target := range plan.Spec.Targets {
+		names = append(names, target.Name)
+	}
+	assert.Equal(t, []string{"inline", "db", "cache"}, names)
+}
+
+func TestPlanReconciler_ResolveTargets_MissingPreset_IsInvalid(t *testing.T) {
+	plan := simplePlan("my-plan", "default")
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{{Name: "inline", Type: "ec2"}}
+	plan.Spec.TargetsFrom = []hibernatorv1alpha1.TargetPresetReference{{Name: "missing"}}
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan)
+
+	err := r.resolveTargets(context.Background(), plan)
+
+	require.ErrorIs(t, err, errInvalidTargets)
+	assert.Contains(t, err.Error(), "default/missing")
+	assert.Len(t, plan.Spec.Targets, 1, "inline targets must be left untouched")
+}
+
+func TestPlanReconciler_ResolveTargets_DuplicateName_IsInvalid(t *testing.T) {
+	plan := simplePlan("my-plan", "default")
+	plan.Spec.Targets = []hibernatorv1alpha1.Target{{Name: "db", Type: "rds"}}
+	plan.Spec.TargetsFrom = []hibernatorv1alpha1.TargetPresetReference{{Name: "shared"}}
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan,
+		testTargetPreset("shared", "default", "db"),
+	)
+
+	err := r.resolveTargets(context.Background(), plan)
+
+	require.ErrorIs(t, err, errInvalidTargets)
+	assert.Contains(t, err.Error(), `duplicate target name "db"`)
+}
+
+func TestPlanReconciler_ResolveTargets_StagedUnassigned_IsInvalid(t *testing.T) {
+	plan := simplePlan("my-plan", "default")
+	plan.Spec.Execution.Strategy = hibernatorv1alpha1.ExecutionStrategy{
+		Type:   hibernatorv1alpha1.StrategyStaged,
+		Stages: []hibernatorv1alpha1.Stage{{Name: "first", Targets: []string{"db"}}},
+	}
+	plan.Spec.TargetsFrom = []hibernatorv1alpha1.TargetPresetReference{{Name: "shared"}}
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan,
+		testTargetPreset("shared", "default", "db", "cache"),
+	)
+
+	err := r.resolveTargets(context.Background(), plan)
+
+	require.ErrorIs(t, err, errInvalidTargets)
+	assert.Contains(t, err.Error(), `target "cache" is not assigned`)
+}
+
+func TestPlanReconciler_Reconcile_UnresolvedPreset_StoresTargetsError(t *testing.T) {
+	plan := simplePlan("my-plan", "default")
+	plan.Spec.TargetsFrom = []hibernatorv1alpha1.TargetPresetReference{{Name: "missing"}}
+	r, resources := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), plan)
+
+	key := types.NamespacedName{Name: "my-plan", Namespace: "default"}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	stored, ok := resources.PlanResources.Load(key)
+	require.True(t, ok)
+	assert.Contains(t, stored.TargetsError, "default/missing")
+}
+
+func TestPlanReconciler_FindPlansForTargetPreset(t *testing.T) {
+	referencing := simplePlan("referencing", "default")
+	referencing.Spec.TargetsFrom = []hibernatorv1alpha1.TargetPresetReference{{Name: "shared", Namespace: "platform"}}
+	other := simplePlan("other", "default")
+	other.Spec.TargetsFrom = []hibernatorv1alpha1.TargetPresetReference{{Name: "shared"}}
+	preset := testTargetPreset("shared", "platform", "db")
+	r, _ := newPlanReconciler(clocktesting.NewFakeClock(time.Now()), referencing, other, preset)
+
+	requests := r.findPlansForTargetPreset(context.Background(), preset)
+
+	key := types.NamespacedName{Name: "referencing", Namespace: "default"}
+	require.Len(t, requests, 1)
+	assert.Equal(t, key, requests[0].NamespacedName)
+	assert.Equal(t, int64(1), r.DependencyNonces.Get(key))
+}
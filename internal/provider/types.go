@@ -125,6 +125,6 @@ func (e *channelEnqueuer) Enqueue(key types.NamespacedName) {
 	case e.ch <- event.GenericEvent{Object: obj}:
 	default:
 		e.logger.V(4).Info("enqueue channel is full, skipping event", "plan", key)
-		metrics.EnqueueDropTotal.WithLabelValues(key.String()).Inc()
+		metrics.EnqueueDropTotal.WithLabelValues(metrics.PlanLabel(key)).Inc()
 	}
 }
@@ -8,6 +8,7 @@ package recovery
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -124,11 +125,123 @@ func ClassifyError(err error) ErrorClassification {
 	return ErrorUnknown
 }
 
-// DetermineRecoveryStrategy decides if and when to retry based on plan state.
+// ClassifyFailureReason determines if the failure of an execution, as
+// classified by its runner, is transient or permanent.
+func ClassifyFailureReason(reason hibernatorv1alpha1.FailureReason) ErrorClassification {
+	switch reason {
+	case hibernatorv1alpha1.FailureThrottled, hibernatorv1alpha1.FailureTimeout:
+		return ErrorTransient
+	case hibernatorv1alpha1.FailureAuthError, hibernatorv1alpha1.FailureResourceNotFound, hibernatorv1alpha1.FailurePermanentAPIError,
+		hibernatorv1alpha1.FailureResourceLimitExceeded:
+		return ErrorPermanent
+	}
+	return ErrorUnknown
+}
+
+// classifyExecutionFailures classifies the failed executions of a plan by the
+// failure reasons their runners reported. A single permanent failure makes
+// the plan's error permanent, since retrying cannot complete its stage. It
+// returns ErrorUnknown, and an empty target, when no failed execution carries
+// a known reason.
+func classifyExecutionFailures(executions []hibernatorv1alpha1.ExecutionStatus) (ErrorClassification, hibernatorv1alpha1.ExecutionStatus) {
+	classification := ErrorUnknown
+	var cause hibernatorv1alpha1.ExecutionStatus
+	for _, exec := range executions {
+		if exec.State != hibernatorv1alpha1.StateFailed {
+			continue
+		}
+		switch ClassifyFailureReason(exec.FailureReason) {
+		case ErrorPermanent:
+			return ErrorPermanent, exec
+		case ErrorTransient:
+			if classification == ErrorUnknown {
+				classification, cause = ErrorTransient, exec
+			}
+		}
+	}
+	return classification, cause
+}
+
+// Policy is the retry policy of a plan with defaults applied.
+type Policy struct {
+	MaxRetries        int32
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier int32
+	RetryOn           []ErrorClassification
+}
+
+// PolicyFor resolves the retry policy of behavior. Unset fields, and durations
+// that fail to parse, take the defaults: behavior.retries attempts, 1m initial
+// backoff doubling up to 30m, retrying every class but Permanent.
+func PolicyFor(behavior hibernatorv1alpha1.Behavior) Policy {
+	policy := Policy{
+		MaxRetries:        ptr.Deref(behavior.Retries, wellknown.DefaultRecoveryMaxRetryAttempts),
+		InitialBackoff:    wellknown.DefaultRecoveryInitialBackoff,
+		MaxBackoff:        wellknown.DefaultRecoveryMaxBackoff,
+		BackoffMultiplier: wellknown.DefaultRecoveryBackoffMultiplier,
+		RetryOn:           []ErrorClassification{ErrorTransient, ErrorExecutionFailed, ErrorUnknown},
+	}
+
+	rp := behavior.RetryPolicy
+	if rp == nil {
+		return policy
+	}
+	if rp.MaxRetries != nil {
+		policy.MaxRetries = *rp.MaxRetries
+	}
+	if d, err := time.ParseDuration(rp.InitialBackoff); err == nil && d > 0 {
+		policy.InitialBackoff = d
+	}
+	if d, err := time.ParseDuration(rp.MaxBackoff); err == nil && d > 0 {
+		policy.MaxBackoff = d
+	}
+	if m := ptr.Deref(rp.BackoffMultiplier, 0); m >= 1 {
+		policy.BackoffMultiplier = m
+	}
+	if len(rp.RetryOn) > 0 {
+		policy.RetryOn = make([]ErrorClassification, len(rp.RetryOn))
+		for i, c := range rp.RetryOn {
+			policy.RetryOn[i] = ErrorClassification(c)
+		}
+	}
+	return policy
+}
+
+// Retries reports whether the policy retries errors of classification.
+func (p Policy) Retries(classification ErrorClassification) bool {
+	return slices.Contains(p.RetryOn, classification)
+}
+
+// Backoff returns the delay before retry attempt+1:
+// min(InitialBackoff * BackoffMultiplier^attempt, MaxBackoff).
+func (p Policy) Backoff(attempt int32) time.Duration {
+	if attempt < 0 {
+		attempt = 0
+	}
+
+	backoff := p.InitialBackoff
+	for i := int32(0); i < attempt; i++ {
+		if backoff > p.MaxBackoff/time.Duration(p.BackoffMultiplier) {
+			return p.MaxBackoff
+		}
+		backoff *= time.Duration(p.BackoffMultiplier)
+	}
+	return min(backoff, p.MaxBackoff)
+}
+
+// DetermineRecoveryStrategy decides if and when to retry based on plan state
+// and its retry policy. The failure reasons of failed executions take
+// precedence over classifying err, which covers plans failing without a
+// classified execution.
 func DetermineRecoveryStrategy(plan *hibernatorv1alpha1.HibernatePlan, clk clock.Clock, err error) ErrorRecoveryStrategy {
-	classification := ClassifyError(err)
+	classification, cause := classifyExecutionFailures(plan.Status.Executions)
+	if classification == ErrorUnknown {
+		classification = ClassifyError(err)
+	}
 
-	maxRetries := ptr.Deref(plan.Spec.Behavior.Retries, wellknown.DefaultRecoveryMaxRetryAttempts)
+	policy := PolicyFor(plan.Spec.Behavior)
+	maxRetries := policy.MaxRetries
 
 	if plan.Status.RetryCount >= maxRetries {
 		return ErrorRecoveryStrategy{
@@ -138,15 +251,22 @@ func DetermineRecoveryStrategy(plan *hibernatorv1alpha1.HibernatePlan, clk clock
 		}
 	}
 
-	if classification == ErrorPermanent {
+	if !policy.Retries(classification) {
+		reason := fmt.Sprintf("retry policy does not retry %s errors", classification)
+		if classification == ErrorPermanent {
+			reason = "error classified as permanent"
+			if cause.FailureReason != "" {
+				reason = fmt.Sprintf("target %s failed with %s, which a retry cannot fix", cause.Target, cause.FailureReason)
+			}
+		}
 		return ErrorRecoveryStrategy{
 			ShouldRetry:    false,
 			Classification: classification,
-			Reason:         "error classified as permanent",
+			Reason:         reason,
 		}
 	}
 
-	backoff := CalculateBackoff(plan.Status.RetryCount)
+	backoff := policy.Backoff(plan.Status.RetryCount)
 
 	if plan.Status.LastRetryTime != nil {
 		elapsed := clk.Since(plan.Status.LastRetryTime.Time)
@@ -168,28 +288,9 @@ func DetermineRecoveryStrategy(plan *hibernatorv1alpha1.HibernatePlan, clk clock
 	}
 }
 
-// CalculateBackoff returns exponential backoff: min(60s * 2^attempt, 30m)
+// CalculateBackoff returns the default exponential backoff: min(60s * 2^attempt, 30m)
 func CalculateBackoff(attempt int32) time.Duration {
-	base := 60 * time.Second
-	maxBackoff := 30 * time.Minute
-
-	if attempt < 0 {
-		attempt = 0
-	}
-
-	multiplier := int64(1)
-	for i := int32(0); i < attempt; i++ {
-		multiplier *= 2
-		if time.Duration(multiplier)*base >= maxBackoff {
-			return maxBackoff
-		}
-	}
-
-	backoff := time.Duration(multiplier) * base
-	if backoff > maxBackoff {
-		return maxBackoff
-	}
-	return backoff
+	return PolicyFor(hibernatorv1alpha1.Behavior{}).Backoff(attempt)
 }
 
 // RecordRetryAttempt updates the plan status for a retry attempt.
@@ -113,6 +113,88 @@ func TestCalculateBackoff(t *testing.T) {
 	}
 }
 
+func TestPolicy_Backoff(t *testing.T) {
+	policy := PolicyFor(hibernatorv1alpha1.Behavior{
+		RetryPolicy: &hibernatorv1alpha1.RetryPolicy{
+			InitialBackoff:    "10s",
+			MaxBackoff:        "5m",
+			BackoffMultiplier: ptr.To(int32(3)),
+		},
+	})
+
+	tests := []struct {
+		attempt int32
+		want    time.Duration
+	}{
+		{0, 10 * time.Second},
+		{1, 30 * time.Second},
+		{2, 90 * time.Second},
+		{3, 270 * time.Second},
+		{4, 5 * time.Minute},
+		{10, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, policy.Backoff(tt.attempt), "attempt %d", tt.attempt)
+	}
+
+	constant := PolicyFor(hibernatorv1alpha1.Behavior{
+		RetryPolicy: &hibernatorv1alpha1.RetryPolicy{InitialBackoff: "2m", BackoffMultiplier: ptr.To(int32(1))},
+	})
+	require.Equal(t, 2*time.Minute, constant.Backoff(5))
+}
+
+func TestPolicyFor(t *testing.T) {
+	defaults := PolicyFor(hibernatorv1alpha1.Behavior{})
+	require.Equal(t, Policy{
+		MaxRetries:        3,
+		InitialBackoff:    time.Minute,
+		MaxBackoff:        30 * time.Minute,
+		BackoffMultiplier: 2,
+		RetryOn:           []ErrorClassification{ErrorTransient, ErrorExecutionFailed, ErrorUnknown},
+	}, defaults)
+
+	policy := PolicyFor(hibernatorv1alpha1.Behavior{
+		Retries: ptr.To(int32(5)),
+		RetryPolicy: &hibernatorv1alpha1.RetryPolicy{
+			MaxRetries: ptr.To(int32(1)),
+			RetryOn:    []hibernatorv1alpha1.RetryClass{hibernatorv1alpha1.RetryOnTransient},
+		},
+	})
+	require.Equal(t, int32(1), policy.MaxRetries, "maxRetries takes precedence over retries")
+	require.True(t, policy.Retries(ErrorTransient))
+	require.False(t, policy.Retries(ErrorExecutionFailed))
+}
+
+func TestDetermineRecoveryStrategy_RetryPolicy(t *testing.T) {
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		Spec: hibernatorv1alpha1.HibernatePlanSpec{
+			Behavior: hibernatorv1alpha1.Behavior{
+				RetryPolicy: &hibernatorv1alpha1.RetryPolicy{
+					InitialBackoff: "10m",
+					RetryOn:        []hibernatorv1alpha1.RetryClass{hibernatorv1alpha1.RetryOnTransient},
+				},
+			},
+		},
+		Status: hibernatorv1alpha1.HibernatePlanStatus{
+			RetryCount:    1,
+			LastRetryTime: &metav1.Time{Time: fakeClock.Now().Add(-5 * time.Minute)},
+		},
+	}
+
+	strategy := DetermineRecoveryStrategy(plan, fakeClock, errors.New("connection refused"))
+	require.True(t, strategy.ShouldRetry)
+	require.Equal(t, 15*time.Minute, strategy.RetryAfter, "second retry waits 20m after the first")
+
+	strategy = DetermineRecoveryStrategy(plan, fakeClock, errors.New("one or more targets in stage 0 failed"))
+	require.False(t, strategy.ShouldRetry)
+	require.Equal(t, ErrorExecutionFailed, strategy.Classification)
+	require.Equal(t, "retry policy does not retry ExecutionFailed errors", strategy.Reason)
+
+	plan.Spec.Behavior.RetryPolicy = &hibernatorv1alpha1.RetryPolicy{MaxRetries: ptr.To(int32(0))}
+	strategy = DetermineRecoveryStrategy(plan, fakeClock, errors.New("connection refused"))
+	require.False(t, strategy.ShouldRetry, "maxRetries 0 disables retries")
+}
+
 func TestDetermineRecoveryStrategy_FirstRetry(t *testing.T) {
 	plan := &hibernatorv1alpha1.HibernatePlan{
 		Spec: hibernatorv1alpha1.HibernatePlanSpec{
@@ -190,6 +272,87 @@ func TestDetermineRecoveryStrategy_PermanentError(t *testing.T) {
 	}
 }
 
+func TestDetermineRecoveryStrategy_FailureReasons(t *testing.T) {
+	stageErr := errors.New("one or more targets in stage 0 failed")
+
+	tests := []struct {
+		name       string
+		executions []hibernatorv1alpha1.ExecutionStatus
+		err        error
+		wantRetry  bool
+		want       ErrorClassification
+	}{
+		{
+			name: "throttled target is retried",
+			executions: []hibernatorv1alpha1.ExecutionStatus{
+				{Target: "rds/db", State: hibernatorv1alpha1.StateFailed, FailureReason: hibernatorv1alpha1.FailureThrottled},
+			},
+			err:       stageErr,
+			wantRetry: true,
+			want:      ErrorTransient,
+		},
+		{
+			name: "auth error is not retried",
+			executions: []hibernatorv1alpha1.ExecutionStatus{
+				{Target: "ec2/app", State: hibernatorv1alpha1.StateCompleted},
+				{Target: "rds/db", State: hibernatorv1alpha1.StateFailed, FailureReason: hibernatorv1alpha1.FailureAuthError},
+			},
+			err:  stageErr,
+			want: ErrorPermanent,
+		},
+		{
+			name: "exceeded resource limit is not retried",
+			executions: []hibernatorv1alpha1.ExecutionStatus{
+				{Target: "rds/all", State: hibernatorv1alpha1.StateFailed, FailureReason: hibernatorv1alpha1.FailureResourceLimitExceeded},
+			},
+			err:  stageErr,
+			want: ErrorPermanent,
+		},
+		{
+			name: "a permanent failure outweighs a transient one",
+			executions: []hibernatorv1alpha1.ExecutionStatus{
+				{Target: "eks/cluster", State: hibernatorv1alpha1.StateFailed, FailureReason: hibernatorv1alpha1.FailureTimeout},
+				{Target: "rds/db", State: hibernatorv1alpha1.StateFailed, FailureReason: hibernatorv1alpha1.FailureResourceNotFound},
+			},
+			err:  stageErr,
+			want: ErrorPermanent,
+		},
+		{
+			name: "reason takes precedence over the error message",
+			executions: []hibernatorv1alpha1.ExecutionStatus{
+				{Target: "rds/db", State: hibernatorv1alpha1.StateFailed, FailureReason: hibernatorv1alpha1.FailureTimeout},
+			},
+			err:       errors.New("db instance not found"),
+			wantRetry: true,
+			want:      ErrorTransient,
+		},
+		{
+			name: "unclassified failures fall back to the error message",
+			executions: []hibernatorv1alpha1.ExecutionStatus{
+				{Target: "rds/db", State: hibernatorv1alpha1.StateFailed},
+			},
+			err:       stageErr,
+			wantRetry: true,
+			want:      ErrorExecutionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &hibernatorv1alpha1.HibernatePlan{
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Behavior: hibernatorv1alpha1.Behavior{Retries: ptr.To(int32(5))},
+				},
+				Status: hibernatorv1alpha1.HibernatePlanStatus{Executions: tt.executions},
+			}
+
+			strategy := DetermineRecoveryStrategy(plan, fakeClock, tt.err)
+			require.Equal(t, tt.wantRetry, strategy.ShouldRetry)
+			require.Equal(t, tt.want, strategy.Classification)
+		})
+	}
+}
+
 func TestDetermineRecoveryStrategy_WithinBackoff(t *testing.T) {
 	plan := &hibernatorv1alpha1.HibernatePlan{
 		Spec: hibernatorv1alpha1.HibernatePlanSpec{
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package remoterunner records the runner Jobs a plan dispatches into another
+// cluster through spec.execution.runnerCluster. The controller neither owns
+// nor watches those Jobs, so each one gets a record next to the plan: a
+// ConfigMap owned by the HibernatePlan that carries the labels of the Job.
+//
+// The streaming servers find the record by the execution ID label and
+// annotate it with the heartbeats, progress and result the runner reports,
+// like they annotate local runner Jobs. The plan state machine reads records
+// back as Jobs through JobView, so remote and local runners are tracked the
+// same way.
+package remoterunner
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// Job condition reasons of finished records.
+const (
+	// ReasonRunnerFailed marks a record whose runner reported a failure.
+	ReasonRunnerFailed = "RunnerFailed"
+	// ReasonRunnerCancelled marks a record whose runner was terminated before
+	// the execution finished.
+	ReasonRunnerCancelled = "RunnerCancelled"
+)
+
+// Result is the outcome a remote runner reported, recorded in
+// wellknown.AnnotationRemoteResult.
+type Result struct {
+	// Success is whether the execution succeeded.
+	Success bool `json:"success"`
+
+	// Cancelled is whether the runner was terminated before the execution
+	// finished.
+	Cancelled bool `json:"cancelled,omitempty"`
+
+	// Message is the error of a failed execution, or a summary of a
+	// successful one.
+	Message string `json:"message,omitempty"`
+
+	// FinishedAt is when the runner reported the result.
+	FinishedAt metav1.Time `json:"finishedAt"`
+}
+
+// RecordName returns the name of the record of an execution.
+func RecordName(executionID string) string {
+	return "remote-runner-" + executionID
+}
+
+// NewRecord returns the record of job, a runner Job created in cluster. The
+// record lives in namespace, which may differ from the namespace of job.
+func NewRecord(job *batchv1.Job, cluster, namespace string) *corev1.ConfigMap {
+	labels := maps.Clone(job.Labels)
+	labels[wellknown.LabelRemoteRunnerJob] = "true"
+
+	annotations := maps.Clone(job.Annotations)
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[wellknown.AnnotationRemoteJob] = fmt.Sprintf("%s/%s/%s", cluster, job.Namespace, job.Name)
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        RecordName(job.Labels[wellknown.LabelExecutionID]),
+			Namespace:   namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+	}
+}
+
+// IsRecord reports whether job is the view of a record rather than a runner Job.
+func IsRecord(job *batchv1.Job) bool {
+	return job.Labels[wellknown.LabelRemoteRunnerJob] == "true"
+}
+
+// RemoteJob returns the cluster, namespace and name of the runner Job a record
+// or its view stands for.
+func RemoteJob(obj metav1.Object) (cluster, namespace, name string, ok bool) {
+	parts := strings.Split(obj.GetAnnotations()[wellknown.AnnotationRemoteJob], "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], parts[2], true
+}
+
+// ResultPatch returns the merge patch that records res on a record.
+func ResultPatch(res Result) ([]byte, error) {
+	raw, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("encode remote runner result: %w", err)
+	}
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{wellknown.AnnotationRemoteResult: string(raw)},
+		},
+	})
+}
+
+// ResultOf returns the result recorded on a record or its view. It reports
+// false while the runner has not reported one.
+func ResultOf(obj metav1.Object) (Result, bool) {
+	raw, ok := obj.GetAnnotations()[wellknown.AnnotationRemoteResult]
+	if !ok {
+		return Result{}, false
+	}
+	var res Result
+	if err := json.Unmarshal([]byte(raw), &res); err != nil {
+		return Result{}, false
+	}
+	return res, true
+}
+
+// JobView returns the record as a runner Job. The view is active until the
+// runner reported a result, and then complete or failed accordingly. A record
+// without a result is failed with DeadlineExceeded once activeDeadline elapsed
+// since it was created, as the runner was evidently killed before it could
+// report; nil leaves it active.
+func JobView(record *corev1.ConfigMap, activeDeadline *int64, now time.Time) batchv1.Job {
+	job := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              record.Name,
+			Namespace:         record.Namespace,
+			UID:               record.UID,
+			CreationTimestamp: record.CreationTimestamp,
+			Labels:            maps.Clone(record.Labels),
+			Annotations:       maps.Clone(record.Annotations),
+		},
+	}
+	job.Status.StartTime = record.CreationTimestamp.DeepCopy()
+
+	if res, ok := ResultOf(record); ok {
+		switch {
+		case res.Success:
+			job.Status.Succeeded = 1
+			job.Status.Conditions = []batchv1.JobCondition{{
+				Type:               batchv1.JobComplete,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: res.FinishedAt,
+			}}
+		default:
+			reason := ReasonRunnerFailed
+			if res.Cancelled {
+				reason = ReasonRunnerCancelled
+			}
+			job.Status.Failed = 1
+			job.Status.Conditions = []batchv1.JobCondition{{
+				Type:               batchv1.JobFailed,
+				Status:             corev1.ConditionTrue,
+				Reason:             reason,
+				Message:            res.Message,
+				LastTransitionTime: res.FinishedAt,
+			}}
+		}
+		return job
+	}
+
+	if activeDeadline != nil {
+		deadline := record.CreationTimestamp.Add(time.Duration(*activeDeadline) * time.Second)
+		if now.After(deadline) {
+			job.Status.Failed = 1
+			job.Status.Conditions = []batchv1.JobCondition{{
+				Type:               batchv1.JobFailed,
+				Status:             corev1.ConditionTrue,
+				Reason:             batchv1.JobReasonDeadlineExceeded,
+				Message:            "Runner did not report a result within the active deadline",
+				LastTransitionTime: metav1.NewTime(deadline),
+			}}
+			return job
+		}
+	}
+
+	job.Status.Active = 1
+	return job
+}
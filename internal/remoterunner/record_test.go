@@ -0,0 +1,99 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package remoterunner
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func runnerJob() *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runner-p-app-abcde",
+			Namespace: "runners",
+			Labels: map[string]string{
+				wellknown.LabelPlan:        "p",
+				wellknown.LabelTarget:      "app",
+				wellknown.LabelExecutionID: "p-app-1700000000",
+			},
+			Annotations: map[string]string{wellknown.AnnotationPlan: "p"},
+		},
+	}
+}
+
+func TestNewRecord(t *testing.T) {
+	job := runnerJob()
+	record := NewRecord(job, "workloads", "default")
+
+	assert.Equal(t, "remote-runner-p-app-1700000000", record.Name)
+	assert.Equal(t, "default", record.Namespace)
+	assert.Equal(t, "true", record.Labels[wellknown.LabelRemoteRunnerJob])
+	assert.Equal(t, "app", record.Labels[wellknown.LabelTarget])
+	assert.NotContains(t, job.Labels, wellknown.LabelRemoteRunnerJob, "the Job labels are not modified")
+
+	cluster, namespace, name, ok := RemoteJob(record)
+	require.True(t, ok)
+	assert.Equal(t, "workloads", cluster)
+	assert.Equal(t, "runners", namespace)
+	assert.Equal(t, "runner-p-app-abcde", name)
+}
+
+func TestJobView(t *testing.T) {
+	created := time.Date(2026, 1, 15, 20, 0, 0, 0, time.UTC)
+	finished := metav1.NewTime(created.Add(2 * time.Minute))
+
+	newRecord := func(res *Result) *corev1.ConfigMap {
+		record := NewRecord(runnerJob(), "workloads", "default")
+		record.CreationTimestamp = metav1.NewTime(created)
+		if res != nil {
+			patch, err := ResultPatch(*res)
+			require.NoError(t, err)
+			var patched corev1.ConfigMap
+			require.NoError(t, json.Unmarshal(patch, &patched))
+			record.Annotations[wellknown.AnnotationRemoteResult] = patched.Annotations[wellknown.AnnotationRemoteResult]
+		}
+		return record
+	}
+
+	t.Run("active until a result is reported", func(t *testing.T) {
+		job := JobView(newRecord(nil), ptr.To[int64](600), created.Add(time.Minute))
+		assert.True(t, IsRecord(&job))
+		assert.Equal(t, int32(1), job.Status.Active)
+		assert.Empty(t, job.Status.Conditions)
+	})
+
+	t.Run("complete on success", func(t *testing.T) {
+		job := JobView(newRecord(&Result{Success: true, Message: "Completed successfully", FinishedAt: finished}), nil, created)
+		require.Len(t, job.Status.Conditions, 1)
+		assert.Equal(t, batchv1.JobComplete, job.Status.Conditions[0].Type)
+		assert.True(t, finished.Equal(&job.Status.Conditions[0].LastTransitionTime))
+	})
+
+	t.Run("failed on cancellation", func(t *testing.T) {
+		job := JobView(newRecord(&Result{Cancelled: true, Message: "terminated", FinishedAt: finished}), nil, created)
+		require.Len(t, job.Status.Conditions, 1)
+		assert.Equal(t, batchv1.JobFailed, job.Status.Conditions[0].Type)
+		assert.Equal(t, ReasonRunnerCancelled, job.Status.Conditions[0].Reason)
+	})
+
+	t.Run("failed once the active deadline elapsed without a result", func(t *testing.T) {
+		job := JobView(newRecord(nil), ptr.To[int64](600), created.Add(11*time.Minute))
+		require.Len(t, job.Status.Conditions, 1)
+		assert.Equal(t, batchv1.JobReasonDeadlineExceeded, job.Status.Conditions[0].Reason)
+		assert.Zero(t, job.Status.Active)
+	})
+}
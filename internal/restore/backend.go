@@ -0,0 +1,83 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ErrConflict is returned by a Backend when a Put loses an optimistic
+// concurrency race. The Manager retries the read-modify-write on conflict.
+var ErrConflict = errors.New("restore record was modified concurrently")
+
+// Record is the per-plan restore document held by a Backend.
+// Data maps "<target>.json" keys to JSON-encoded Data; Annotations carries
+// per-plan bookkeeping such as the restored-<target> markers.
+type Record struct {
+	Data        map[string]string `json:"data,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Revision is an opaque backend version used for optimistic concurrency.
+	// It is empty for records that have not been persisted yet.
+	Revision string `json:"-"`
+
+	// native holds the backend's underlying object (e.g., the ConfigMap) so
+	// updates preserve metadata the Record does not model.
+	native any
+}
+
+// Exists reports whether the record has been persisted.
+func (r *Record) Exists() bool {
+	return r.Revision != ""
+}
+
+// Backend persists restore records, one per plan.
+type Backend interface {
+	// Get returns the plan's record, or nil when none exists.
+	Get(ctx context.Context, namespace, planName string) (*Record, error)
+
+	// Put creates the record when rec.Exists() is false and otherwise replaces
+	// it, failing with a conflict error if it changed since it was read.
+	Put(ctx context.Context, namespace, planName string, rec *Record) error
+
+	// Delete removes the plan's record. Deleting a missing record is not an error.
+	Delete(ctx context.Context, namespace, planName string) error
+}
+
+// sizeLimited is implemented by backends that cap the size of a single value.
+type sizeLimited interface {
+	maxValueSize() int
+}
+
+// IsConflict reports whether err is an optimistic concurrency failure from a Backend.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict) || apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+}
+
+func newRecord() *Record {
+	return &Record{
+		Data:        make(map[string]string),
+		Annotations: make(map[string]string),
+	}
+}
+
+// clone returns a copy of the record whose maps can be mutated independently.
+func (r *Record) clone() *Record {
+	out := *r
+	out.Data = maps.Clone(r.Data)
+	out.Annotations = maps.Clone(r.Annotations)
+	if out.Data == nil {
+		out.Data = make(map[string]string)
+	}
+	if out.Annotations == nil {
+		out.Annotations = make(map[string]string)
+	}
+	return &out
+}
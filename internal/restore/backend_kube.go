@@ -0,0 +1,230 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// ConfigMapBackend stores restore records in a ConfigMap named
+// hibernator-restore-<plan> in the plan namespace. Records too large for a
+// single ConfigMap are split across up to MaxRestoreChunks chunk ConfigMaps.
+type ConfigMapBackend struct {
+	client client.Client
+}
+
+// NewConfigMapBackend creates a ConfigMap-backed restore storage.
+func NewConfigMapBackend(c client.Client) *ConfigMapBackend {
+	return &ConfigMapBackend{client: c}
+}
+
+// maxValueSize allows values up to the total chunk capacity; values that do
+// not fit in a single ConfigMap are split across chunks on Put.
+func (b *ConfigMapBackend) maxValueSize() int { return MaxConfigMapSize * MaxRestoreChunks }
+
+// Get implements Backend.
+func (b *ConfigMapBackend) Get(ctx context.Context, namespace, planName string) (*Record, error) {
+	cm := &corev1.ConfigMap{}
+	err := b.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configMapName(planName)}, cm)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get restore configmap: %w", err)
+	}
+
+	data, err := b.assemble(ctx, cm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Record{
+		Data:        data,
+		Annotations: cm.Annotations,
+		Revision:    cm.ResourceVersion,
+		native:      cm,
+	}, nil
+}
+
+// Put implements Backend. Data larger than MaxConfigMapSize is written to
+// chunk ConfigMaps first and the primary ConfigMap keeps only their index;
+// chunks of the replaced record are removed once the update succeeds.
+func (b *ConfigMapBackend) Put(ctx context.Context, namespace, planName string, rec *Record) error {
+	cm, ok := rec.native.(*corev1.ConfigMap)
+	if !rec.Exists() || !ok {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      configMapName(planName),
+				Namespace: namespace,
+				Labels: map[string]string{
+					wellknown.LabelPlan: planName,
+				},
+			},
+		}
+	} else {
+		cm = cm.DeepCopy()
+	}
+
+	var staleChunks []string
+	if idx, _ := parseChunkIndex(cm); idx != nil {
+		staleChunks = idx.chunkConfigMaps()
+	}
+
+	cm.Data = rec.Data
+	cm.Annotations = rec.Annotations
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+
+	var newChunks []string
+	if dataSize(cm.Data) > MaxConfigMapSize {
+		data, created, err := b.putChunks(ctx, namespace, planName, cm.Data)
+		if err != nil {
+			return err
+		}
+		cm.Data, newChunks = data, created
+	}
+
+	if !rec.Exists() {
+		if err := b.client.Create(ctx, cm); err != nil {
+			_ = b.deleteChunks(ctx, namespace, newChunks)
+			return fmt.Errorf("create restore configmap: %w", err)
+		}
+	} else {
+		cm.ResourceVersion = rec.Revision
+		if err := b.client.Update(ctx, cm); err != nil {
+			_ = b.deleteChunks(ctx, namespace, newChunks)
+			return fmt.Errorf("update restore configmap: %w", err)
+		}
+	}
+
+	// Stale chunks are unreachable once the primary is updated; a failure
+	// here only leaves garbage behind, so it does not fail the write.
+	_ = b.deleteChunks(ctx, namespace, staleChunks)
+
+	rec.Revision = cm.ResourceVersion
+	rec.native = cm
+	return nil
+}
+
+// Delete implements Backend. Chunk ConfigMaps are removed before the
+// primary so that a failed delete never leaves orphaned chunks unreferenced.
+func (b *ConfigMapBackend) Delete(ctx context.Context, namespace, planName string) error {
+	cm := &corev1.ConfigMap{}
+	err := b.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configMapName(planName)}, cm)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get restore configmap: %w", err)
+	}
+
+	if idx, _ := parseChunkIndex(cm); idx != nil {
+		if err := b.deleteChunks(ctx, namespace, idx.chunkConfigMaps()); err != nil {
+			return err
+		}
+	}
+
+	if err := b.client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete restore configmap: %w", err)
+	}
+	return nil
+}
+
+// SecretBackend stores restore records in a Secret named hibernator-restore-<plan>
+// in the plan namespace, benefiting from the cluster's Secret encryption at rest.
+type SecretBackend struct {
+	client client.Client
+}
+
+// NewSecretBackend creates a Secret-backed restore storage.
+func NewSecretBackend(c client.Client) *SecretBackend {
+	return &SecretBackend{client: c}
+}
+
+func (b *SecretBackend) maxValueSize() int { return MaxConfigMapSize }
+
+// Get implements Backend.
+func (b *SecretBackend) Get(ctx context.Context, namespace, planName string) (*Record, error) {
+	secret := &corev1.Secret{}
+	err := b.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configMapName(planName)}, secret)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get restore secret: %w", err)
+	}
+
+	data := make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		data[k] = string(v)
+	}
+
+	return &Record{
+		Data:        data,
+		Annotations: secret.Annotations,
+		Revision:    secret.ResourceVersion,
+		native:      secret,
+	}, nil
+}
+
+// Put implements Backend.
+func (b *SecretBackend) Put(ctx context.Context, namespace, planName string, rec *Record) error {
+	secret, ok := rec.native.(*corev1.Secret)
+	if !rec.Exists() || !ok {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      configMapName(planName),
+				Namespace: namespace,
+				Labels: map[string]string{
+					wellknown.LabelPlan: planName,
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+		}
+	} else {
+		secret = secret.DeepCopy()
+	}
+
+	secret.Annotations = rec.Annotations
+	secret.Data = make(map[string][]byte, len(rec.Data))
+	for k, v := range rec.Data {
+		secret.Data[k] = []byte(v)
+	}
+
+	if !rec.Exists() {
+		if err := b.client.Create(ctx, secret); err != nil {
+			return fmt.Errorf("create restore secret: %w", err)
+		}
+	} else {
+		secret.ResourceVersion = rec.Revision
+		if err := b.client.Update(ctx, secret); err != nil {
+			return fmt.Errorf("update restore secret: %w", err)
+		}
+	}
+
+	rec.Revision = secret.ResourceVersion
+	rec.native = secret
+	return nil
+}
+
+// Delete implements Backend.
+func (b *SecretBackend) Delete(ctx context.Context, namespace, planName string) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: configMapName(planName), Namespace: namespace}}
+	if err := b.client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete restore secret: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	storage "google.golang.org/api/storage/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// objectRequestTimeout bounds a single object store request.
+const objectRequestTimeout = 30 * time.Second
+
+// objectKey returns the object name of a plan's restore record.
+func objectKey(prefix, namespace, planName string) string {
+	return path.Join(strings.Trim(prefix, "/"), namespace, configMapName(planName)+".json")
+}
+
+func encodeObject(rec *Record) ([]byte, error) {
+	body, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal restore record: %w", err)
+	}
+	return body, nil
+}
+
+func decodeObject(body []byte, revision string) (*Record, error) {
+	rec := newRecord()
+	if err := json.Unmarshal(body, rec); err != nil {
+		return nil, fmt.Errorf("unmarshal restore record: %w", err)
+	}
+	if rec.Data == nil {
+		rec.Data = make(map[string]string)
+	}
+	rec.Revision = revision
+	return rec, nil
+}
+
+// S3Backend stores restore records as JSON objects in an S3 bucket, using
+// conditional writes (If-Match / If-None-Match) for optimistic concurrency.
+type S3Backend struct {
+	spec   hibernatorv1alpha1.S3RestoreStorage
+	client *s3.Client
+}
+
+// NewS3Backend creates an S3-backed restore storage using the default AWS
+// credential chain.
+func NewS3Backend(ctx context.Context, spec hibernatorv1alpha1.S3RestoreStorage) (*S3Backend, error) {
+	if spec.Bucket == "" || spec.Region == "" {
+		return nil, fmt.Errorf("s3 restore storage requires bucket and region")
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(spec.Region))
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config: %w", err)
+	}
+	return newS3Backend(spec, awsCfg), nil
+}
+
+func newS3Backend(spec hibernatorv1alpha1.S3RestoreStorage, awsCfg aws.Config) *S3Backend {
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if spec.Endpoint != "" {
+			o.BaseEndpoint = aws.String(spec.Endpoint)
+		}
+		o.UsePathStyle = spec.ForcePathStyle
+	})
+	return &S3Backend{spec: spec, client: client}
+}
+
+func (b *S3Backend) key(namespace, planName string) *string {
+	return aws.String(objectKey(b.spec.Prefix, namespace, planName))
+}
+
+// s3Status returns the HTTP status code of a failed S3 request, or 0.
+func s3Status(err error) int {
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.HTTPStatusCode()
+	}
+	return 0
+}
+
+// Get implements Backend.
+func (b *S3Backend) Get(ctx context.Context, namespace, planName string) (*Record, error) {
+	ctx, cancel := context.WithTimeout(ctx, objectRequestTimeout)
+	defer cancel()
+
+	out, err := b.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.spec.Bucket),
+		Key:    b.key(namespace, planName),
+	})
+	if err != nil {
+		if s3Status(err) == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get restore object: %w", err)
+	}
+	defer func() { _ = out.Body.Close() }()
+
+	body, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read restore object: %w", err)
+	}
+	return decodeObject(body, aws.ToString(out.ETag))
+}
+
+// Put implements Backend.
+func (b *S3Backend) Put(ctx context.Context, namespace, planName string, rec *Record) error {
+	ctx, cancel := context.WithTimeout(ctx, objectRequestTimeout)
+	defer cancel()
+
+	body, err := encodeObject(rec)
+	if err != nil {
+		return err
+	}
+
+	in := &s3.PutObjectInput{
+		Bucket:      aws.String(b.spec.Bucket),
+		Key:         b.key(namespace, planName),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/json"),
+	}
+	if rec.Exists() {
+		in.IfMatch = aws.String(rec.Revision)
+	} else {
+		in.IfNoneMatch = aws.String("*")
+	}
+	if b.spec.ServerSideEncryption != "" {
+		in.ServerSideEncryption = s3types.ServerSideEncryption(b.spec.ServerSideEncryption)
+		if b.spec.KMSKeyID != "" {
+			in.SSEKMSKeyId = aws.String(b.spec.KMSKeyID)
+		}
+	}
+
+	out, err := b.client.PutObject(ctx, in)
+	if err != nil {
+		switch s3Status(err) {
+		case http.StatusPreconditionFailed, http.StatusConflict:
+			return ErrConflict
+		}
+		return fmt.Errorf("put restore object: %w", err)
+	}
+	rec.Revision = aws.ToString(out.ETag)
+	return nil
+}
+
+// Delete implements Backend.
+func (b *S3Backend) Delete(ctx context.Context, namespace, planName string) error {
+	ctx, cancel := context.WithTimeout(ctx, objectRequestTimeout)
+	defer cancel()
+
+	_, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.spec.Bucket),
+		Key:    b.key(namespace, planName),
+	})
+	if err != nil && s3Status(err) != http.StatusNotFound {
+		return fmt.Errorf("delete restore object: %w", err)
+	}
+	return nil
+}
+
+// GCSBackend stores restore records as JSON objects in a GCS bucket, using
+// generation preconditions for optimistic concurrency. It authenticates with
+// Application Default Credentials (e.g., GKE Workload Identity).
+type GCSBackend struct {
+	spec hibernatorv1alpha1.GCSRestoreStorage
+	svc  *storage.Service
+}
+
+// NewGCSBackend creates a GCS-backed restore storage.
+func NewGCSBackend(ctx context.Context, spec hibernatorv1alpha1.GCSRestoreStorage) (*GCSBackend, error) {
+	return newGCSBackend(ctx, spec, option.WithScopes(storage.DevstorageReadWriteScope))
+}
+
+func newGCSBackend(ctx context.Context, spec hibernatorv1alpha1.GCSRestoreStorage, opts ...option.ClientOption) (*GCSBackend, error) {
+	if spec.Bucket == "" {
+		return nil, fmt.Errorf("gcs restore storage requires bucket")
+	}
+	svc, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("create GCS client: %w", err)
+	}
+	return &GCSBackend{spec: spec, svc: svc}, nil
+}
+
+// gcsStatus returns the HTTP status code of a failed GCS request, or 0.
+func gcsStatus(err error) int {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code
+	}
+	return 0
+}
+
+// Get implements Backend.
+func (b *GCSBackend) Get(ctx context.Context, namespace, planName string) (*Record, error) {
+	ctx, cancel := context.WithTimeout(ctx, objectRequestTimeout)
+	defer cancel()
+
+	resp, err := b.svc.Objects.Get(b.spec.Bucket, objectKey(b.spec.Prefix, namespace, planName)).Context(ctx).Download()
+	if err != nil {
+		if gcsStatus(err) == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get restore object: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read restore object: %w", err)
+	}
+	return decodeObject(body, resp.Header.Get("X-Goog-Generation"))
+}
+
+// Put implements Backend.
+func (b *GCSBackend) Put(ctx context.Context, namespace, planName string, rec *Record) error {
+	ctx, cancel := context.WithTimeout(ctx, objectRequestTimeout)
+	defer cancel()
+
+	body, err := encodeObject(rec)
+	if err != nil {
+		return err
+	}
+
+	// Generation 0 only matches an object that does not exist yet.
+	var generation int64
+	if rec.Exists() {
+		if generation, err = strconv.ParseInt(rec.Revision, 10, 64); err != nil {
+			return fmt.Errorf("parse restore object generation %q: %w", rec.Revision, err)
+		}
+	}
+
+	call := b.svc.Objects.Insert(b.spec.Bucket, &storage.Object{
+		Name:        objectKey(b.spec.Prefix, namespace, planName),
+		ContentType: "application/json",
+	}).IfGenerationMatch(generation).Media(bytes.NewReader(body), googleapi.ContentType("application/json"))
+	if b.spec.KMSKeyName != "" {
+		call = call.KmsKeyName(b.spec.KMSKeyName)
+	}
+
+	obj, err := call.Context(ctx).Do()
+	if err != nil {
+		if gcsStatus(err) == http.StatusPreconditionFailed {
+			return ErrConflict
+		}
+		return fmt.Errorf("put restore object: %w", err)
+	}
+	rec.Revision = strconv.FormatInt(obj.Generation, 10)
+	return nil
+}
+
+// Delete implements Backend.
+func (b *GCSBackend) Delete(ctx context.Context, namespace, planName string) error {
+	ctx, cancel := context.WithTimeout(ctx, objectRequestTimeout)
+	defer cancel()
+
+	err := b.svc.Objects.Delete(b.spec.Bucket, objectKey(b.spec.Prefix, namespace, planName)).Context(ctx).Do()
+	if err != nil && gcsStatus(err) != http.StatusNotFound {
+		return fmt.Errorf("delete restore object: %w", err)
+	}
+	return nil
+}
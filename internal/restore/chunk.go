@@ -0,0 +1,231 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+const (
+	// MaxRestoreChunks is the maximum number of chunk ConfigMaps a plan's
+	// restore data may be split across when it does not fit in one ConfigMap.
+	MaxRestoreChunks = 16
+
+	// chunkIndexKey holds the chunk index in the primary ConfigMap of a
+	// chunked record. It never collides with target or history keys.
+	chunkIndexKey = "chunks.index"
+)
+
+// chunkIndex records where each value of a chunked record is stored. A value
+// is split into ordered parts, each held under its own key in a chunk ConfigMap.
+type chunkIndex struct {
+	Parts map[string][]chunkPart `json:"parts"`
+}
+
+// chunkPart locates one part of a chunked value.
+type chunkPart struct {
+	ConfigMap string `json:"configMap"`
+	Key       string `json:"key"`
+}
+
+// IsChunked reports whether a restore ConfigMap keeps its data in chunk
+// ConfigMaps rather than inline. Such ConfigMaps must not be edited directly.
+func IsChunked(cm *corev1.ConfigMap) bool {
+	_, ok := cm.Data[chunkIndexKey]
+	return ok
+}
+
+// LoadConfigMap returns the restore ConfigMap of a plan with chunked data
+// reassembled into Data. The result is meant for inspection only.
+func LoadConfigMap(ctx context.Context, c client.Client, namespace, planName string) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: configMapName(planName)}, cm); err != nil {
+		return nil, err
+	}
+
+	data, err := NewConfigMapBackend(c).assemble(ctx, cm)
+	if err != nil {
+		return nil, err
+	}
+	cm.Data = data
+	return cm, nil
+}
+
+// chunkConfigMaps returns the names of the chunk ConfigMaps referenced by the index.
+func (idx *chunkIndex) chunkConfigMaps() []string {
+	seen := make(map[string]struct{})
+	for _, parts := range idx.Parts {
+		for _, p := range parts {
+			seen[p.ConfigMap] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(seen))
+}
+
+// parseChunkIndex returns the chunk index of cm, or nil when it stores its data inline.
+func parseChunkIndex(cm *corev1.ConfigMap) (*chunkIndex, error) {
+	raw, ok := cm.Data[chunkIndexKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var idx chunkIndex
+	if err := json.Unmarshal([]byte(raw), &idx); err != nil {
+		return nil, fmt.Errorf("decode restore chunk index: %w", err)
+	}
+	return &idx, nil
+}
+
+// dataSize returns the number of bytes data occupies in a ConfigMap.
+func dataSize(data map[string]string) int {
+	size := 0
+	for k, v := range data {
+		size += len(k) + len(v)
+	}
+	return size
+}
+
+// splitChunks packs data into chunk payloads of at most maxSize bytes each,
+// splitting values that do not fit on UTF-8 boundaries. Chunk i is meant to
+// be stored in the ConfigMap named by nameFn(i).
+func splitChunks(data map[string]string, maxSize int, nameFn func(int) string) ([]map[string]string, *chunkIndex) {
+	var (
+		chunks []map[string]string
+		size   int
+	)
+	idx := &chunkIndex{Parts: make(map[string][]chunkPart, len(data))}
+
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		val := data[key]
+		for part := 0; part == 0 || val != ""; part++ {
+			partKey := key + "." + strconv.Itoa(part)
+			if len(chunks) == 0 || maxSize-size-len(partKey) < utf8.UTFMax {
+				chunks = append(chunks, make(map[string]string))
+				size = 0
+			}
+
+			n := min(maxSize-size-len(partKey), len(val))
+			for n < len(val) && !utf8.RuneStart(val[n]) {
+				n--
+			}
+
+			chunks[len(chunks)-1][partKey] = val[:n]
+			size += len(partKey) + n
+			idx.Parts[key] = append(idx.Parts[key], chunkPart{ConfigMap: nameFn(len(chunks) - 1), Key: partKey})
+			val = val[n:]
+		}
+	}
+
+	return chunks, idx
+}
+
+// putChunks writes data to new chunk ConfigMaps and returns the primary
+// ConfigMap data referencing them, along with the names of the chunks created.
+// Chunk names are unique per write, so a failed or concurrent write never
+// touches chunks referenced by the current record.
+func (b *ConfigMapBackend) putChunks(ctx context.Context, namespace, planName string, data map[string]string) (map[string]string, []string, error) {
+	prefix := fmt.Sprintf("%s-%s", configMapName(planName), utilrand.String(5))
+	chunks, idx := splitChunks(data, MaxConfigMapSize, func(i int) string {
+		return fmt.Sprintf("%s-%d", prefix, i)
+	})
+	if len(chunks) > MaxRestoreChunks {
+		return nil, nil, fmt.Errorf("restore data of %d bytes exceeds the limit of %d chunks of %d bytes",
+			dataSize(data), MaxRestoreChunks, MaxConfigMapSize)
+	}
+
+	created := make([]string, 0, len(chunks))
+	for i, chunk := range chunks {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", prefix, i),
+				Namespace: namespace,
+				Labels: map[string]string{
+					wellknown.LabelPlan:         planName,
+					wellknown.LabelRestoreChunk: "true",
+				},
+			},
+			Data: chunk,
+		}
+		if err := b.client.Create(ctx, cm); err != nil {
+			_ = b.deleteChunks(ctx, namespace, created)
+			return nil, nil, fmt.Errorf("create restore chunk configmap %s: %w", cm.Name, err)
+		}
+		created = append(created, cm.Name)
+	}
+
+	raw, err := json.Marshal(idx)
+	if err != nil {
+		_ = b.deleteChunks(ctx, namespace, created)
+		return nil, nil, fmt.Errorf("encode restore chunk index: %w", err)
+	}
+
+	return map[string]string{chunkIndexKey: string(raw)}, created, nil
+}
+
+// assemble returns the restore data held by cm, reading chunk ConfigMaps when
+// cm is chunked. A missing chunk means the record was replaced concurrently,
+// so it is reported as a conflict.
+func (b *ConfigMapBackend) assemble(ctx context.Context, cm *corev1.ConfigMap) (map[string]string, error) {
+	idx, err := parseChunkIndex(cm)
+	if err != nil || idx == nil {
+		return cm.Data, err
+	}
+
+	chunks := make(map[string]*corev1.ConfigMap)
+	for _, name := range idx.chunkConfigMaps() {
+		chunk := &corev1.ConfigMap{}
+		err := b.client.Get(ctx, types.NamespacedName{Namespace: cm.Namespace, Name: name}, chunk)
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("restore chunk configmap %s not found: %w", name, ErrConflict)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get restore chunk configmap %s: %w", name, err)
+		}
+		chunks[name] = chunk
+	}
+
+	data := make(map[string]string, len(idx.Parts))
+	for key, parts := range idx.Parts {
+		var val []byte
+		for _, p := range parts {
+			part, ok := chunks[p.ConfigMap].Data[p.Key]
+			if !ok {
+				return nil, fmt.Errorf("restore chunk configmap %s is missing key %s: %w", p.ConfigMap, p.Key, ErrConflict)
+			}
+			val = append(val, part...)
+		}
+		data[key] = string(val)
+	}
+	return data, nil
+}
+
+// deleteChunks removes the named chunk ConfigMaps. Failures are returned but
+// already-deleted chunks are not an error.
+func (b *ConfigMapBackend) deleteChunks(ctx context.Context, namespace string, names []string) error {
+	var firstErr error
+	for _, name := range names {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		if err := b.client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) && firstErr == nil {
+			firstErr = fmt.Errorf("delete restore chunk configmap %s: %w", name, err)
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func newChunkTestClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).Build()
+}
+
+func listChunks(t *testing.T, c client.Client, namespace string) []corev1.ConfigMap {
+	t.Helper()
+	var list corev1.ConfigMapList
+	require.NoError(t, c.List(context.Background(), &list,
+		client.InNamespace(namespace), client.HasLabels{wellknown.LabelRestoreChunk}))
+	return list.Items
+}
+
+func TestSplitChunks(t *testing.T) {
+	data := map[string]string{
+		"a.json": strings.Repeat("x", 250),
+		"b.json": strings.Repeat("é", 100), // two bytes per rune
+		"c.json": "",
+	}
+
+	chunks, idx := splitChunks(data, 64, func(i int) string { return fmt.Sprintf("chunk-%d", i) })
+
+	byName := make(map[string]map[string]string, len(chunks))
+	for i, chunk := range chunks {
+		assert.LessOrEqual(t, dataSize(chunk), 64)
+		for _, v := range chunk {
+			assert.True(t, utf8.ValidString(v), "chunk values must not split runes")
+		}
+		byName[fmt.Sprintf("chunk-%d", i)] = chunk
+	}
+
+	require.Len(t, idx.Parts, len(data))
+	for key, want := range data {
+		var got strings.Builder
+		for _, p := range idx.Parts[key] {
+			got.WriteString(byName[p.ConfigMap][p.Key])
+		}
+		assert.Equal(t, want, got.String(), key)
+	}
+}
+
+func TestConfigMapBackend_ChunksOversizedRecord(t *testing.T) {
+	c := newChunkTestClient(t)
+	b := NewConfigMapBackend(c)
+	ctx := context.Background()
+
+	large := map[string]string{
+		"app.json": strings.Repeat("a", MaxConfigMapSize),
+		"db.json":  strings.Repeat("b", MaxConfigMapSize/2),
+	}
+	rec := newRecord()
+	rec.Data = large
+	require.NoError(t, b.Put(ctx, "default", "plan", rec))
+
+	primary := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Namespace: "default", Name: configMapName("plan")}, primary))
+	assert.True(t, IsChunked(primary))
+	assert.Len(t, primary.Data, 1)
+	chunks := listChunks(t, c, "default")
+	assert.Len(t, chunks, 2)
+	for _, chunk := range chunks {
+		assert.Equal(t, "plan", chunk.Labels[wellknown.LabelPlan])
+	}
+
+	got, err := b.Get(ctx, "default", "plan")
+	require.NoError(t, err)
+	assert.Equal(t, large, got.Data)
+
+	loaded, err := LoadConfigMap(ctx, c, "default", "plan")
+	require.NoError(t, err)
+	assert.Equal(t, large, loaded.Data)
+
+	// Shrinking the record stores it inline and removes the old chunks.
+	got.Data = map[string]string{"app.json": `{"small":true}`}
+	require.NoError(t, b.Put(ctx, "default", "plan", got))
+	assert.Empty(t, listChunks(t, c, "default"))
+
+	got, err = b.Get(ctx, "default", "plan")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app.json": `{"small":true}`}, got.Data)
+}
+
+func TestConfigMapBackend_RejectsDataBeyondChunkLimit(t *testing.T) {
+	c := newChunkTestClient(t)
+	b := NewConfigMapBackend(c)
+
+	rec := newRecord()
+	rec.Data = map[string]string{"app.json": strings.Repeat("a", MaxConfigMapSize*MaxRestoreChunks+1)}
+	err := b.Put(context.Background(), "default", "plan", rec)
+	require.Error(t, err)
+	assert.Empty(t, listChunks(t, c, "default"))
+}
+
+func TestConfigMapBackend_DeleteRemovesChunks(t *testing.T) {
+	c := newChunkTestClient(t)
+	b := NewConfigMapBackend(c)
+	ctx := context.Background()
+
+	rec := newRecord()
+	rec.Data = map[string]string{"app.json": strings.Repeat("a", 2*MaxConfigMapSize)}
+	require.NoError(t, b.Put(ctx, "default", "plan", rec))
+	require.NotEmpty(t, listChunks(t, c, "default"))
+
+	require.NoError(t, b.Delete(ctx, "default", "plan"))
+	assert.Empty(t, listChunks(t, c, "default"))
+
+	got, err := b.Get(ctx, "default", "plan")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestManager_SaveLoadChunked(t *testing.T) {
+	c := newChunkTestClient(t)
+	mgr := NewManager(c, logr.Discard())
+	ctx := context.Background()
+
+	data := &Data{
+		Target:    "fleet",
+		Executor:  "ec2",
+		Version:   1,
+		CreatedAt: metav1.Now(),
+		State:     map[string]any{"blob": strings.Repeat("z", 3*MaxConfigMapSize/2)},
+	}
+	require.NoError(t, mgr.Save(ctx, "default", "plan", "fleet", data))
+
+	loaded, err := mgr.Load(ctx, "default", "plan", "fleet")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Equal(t, data.State["blob"], loaded.State["blob"])
+}
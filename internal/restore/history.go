@@ -0,0 +1,197 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// historyKeySuffix marks record keys holding a target's archived snapshots.
+// History keys never end in ".json", so they are skipped wherever the current
+// restore data of each target is iterated.
+const historyKeySuffix = ".history"
+
+// Snapshot is an archived restore point for a target.
+type Snapshot struct {
+	// Version increases monotonically with each snapshot archived for the target.
+	Version int64 `json:"version"`
+
+	// ArchivedAt is when the snapshot was archived.
+	ArchivedAt metav1.Time `json:"archivedAt"`
+
+	// Data is the restore data as it was when the snapshot was archived.
+	Data Data `json:"data"`
+}
+
+// historyKey returns the record key holding the snapshots of a target.
+func historyKey(targetName string) string {
+	return targetName + historyKeySuffix
+}
+
+// isHistoryKey reports whether key holds archived snapshots rather than the
+// current restore data of a target.
+func isHistoryKey(key string) bool {
+	return strings.HasSuffix(key, historyKeySuffix)
+}
+
+// ArchiveRestoreData appends the current restore data of every target to its
+// snapshot history, keeping at most limit snapshots per target. Data already
+// archived for the same cycle is not archived twice. A limit of zero or less
+// drops any existing history.
+func (m *Manager) ArchiveRestoreData(ctx context.Context, namespace, planName string, limit int) error {
+	if limit <= 0 {
+		// Avoid rewriting the record on every wakeup for plans without history.
+		rec, err := m.load(ctx, namespace, planName)
+		if err != nil || rec == nil || !slices.ContainsFunc(slices.Collect(maps.Keys(rec.Data)), isHistoryKey) {
+			return err
+		}
+	}
+	maxSize := m.maxValueSize(ctx, namespace, planName)
+
+	// A missing record means there is nothing to archive.
+	return m.update(ctx, namespace, planName, false, func(rec *Record) error {
+		if limit <= 0 {
+			for key := range rec.Data {
+				if isHistoryKey(key) {
+					delete(rec.Data, key)
+				}
+			}
+			return nil
+		}
+
+		now := metav1.Now()
+		for key, val := range rec.Data {
+			if isHistoryKey(key) {
+				continue
+			}
+
+			var data Data
+			if err := json.Unmarshal([]byte(val), &data); err != nil || len(data.State) == 0 {
+				continue
+			}
+
+			snapshots, err := decodeSnapshots(rec.Data[historyKey(data.Target)])
+			if err != nil {
+				m.log.Error(err, "discarding unreadable restore history", "target", data.Target)
+				snapshots = nil
+			}
+
+			if n := len(snapshots); n > 0 && sameRestorePoint(&snapshots[n-1].Data, &data) {
+				continue
+			}
+
+			var version int64 = 1
+			if n := len(snapshots); n > 0 {
+				version = snapshots[n-1].Version + 1
+			}
+			snapshots = append(snapshots, Snapshot{Version: version, ArchivedAt: now, Data: data})
+			if len(snapshots) > limit {
+				snapshots = snapshots[len(snapshots)-limit:]
+			}
+
+			encoded, err := encodeSnapshots(snapshots, maxSize)
+			if err != nil {
+				return err
+			}
+			if encoded == "" {
+				m.log.Info("restore snapshot exceeds storage size limit, skipping history", "target", data.Target)
+				continue
+			}
+			rec.Data[historyKey(data.Target)] = encoded
+		}
+		return nil
+	})
+}
+
+// ListSnapshots returns the archived snapshots of a target, newest first.
+func (m *Manager) ListSnapshots(ctx context.Context, namespace, planName, targetName string) ([]Snapshot, error) {
+	rec, err := m.load(ctx, namespace, planName)
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		return nil, nil
+	}
+
+	snapshots, err := decodeSnapshots(rec.Data[historyKey(targetName)])
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool { return snapshots[i].Version > snapshots[j].Version })
+	return snapshots, nil
+}
+
+// PromoteSnapshot replaces the current restore data of a target with an
+// archived snapshot and marks it live, so the next wakeup restores from it.
+// The snapshot stays in the history.
+func (m *Manager) PromoteSnapshot(ctx context.Context, namespace, planName, targetName string, version int64) error {
+	return m.update(ctx, namespace, planName, false, func(rec *Record) error {
+		snapshots, err := decodeSnapshots(rec.Data[historyKey(targetName)])
+		if err != nil {
+			return err
+		}
+
+		for _, snapshot := range snapshots {
+			if snapshot.Version != version {
+				continue
+			}
+
+			data := snapshot.Data
+			data.IsLive = true
+			dataBytes, err := json.Marshal(&data)
+			if err != nil {
+				return fmt.Errorf("marshal restore data: %w", err)
+			}
+			rec.Data[fmt.Sprintf("%s.json", targetName)] = string(dataBytes)
+			return nil
+		}
+		return fmt.Errorf("restore snapshot version %d not found for target %q", version, targetName)
+	})
+}
+
+// sameRestorePoint reports whether a and b were captured by the same save.
+func sameRestorePoint(a, b *Data) bool {
+	if a.CapturedAt == nil || b.CapturedAt == nil {
+		return false
+	}
+	return a.CapturedAt.Equal(b.CapturedAt)
+}
+
+func decodeSnapshots(val string) ([]Snapshot, error) {
+	if val == "" {
+		return nil, nil
+	}
+	var snapshots []Snapshot
+	if err := json.Unmarshal([]byte(val), &snapshots); err != nil {
+		return nil, fmt.Errorf("unmarshal restore history: %w", err)
+	}
+	return snapshots, nil
+}
+
+// encodeSnapshots serializes snapshots, dropping the oldest until the result
+// fits within maxSize (0 means unlimited). It returns an empty string when not
+// even the newest snapshot fits.
+func encodeSnapshots(snapshots []Snapshot, maxSize int) (string, error) {
+	for len(snapshots) > 0 {
+		encoded, err := json.Marshal(snapshots)
+		if err != nil {
+			return "", fmt.Errorf("marshal restore history: %w", err)
+		}
+		if maxSize <= 0 || len(encoded) <= maxSize {
+			return string(encoded), nil
+		}
+		snapshots = snapshots[1:]
+	}
+	return "", nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newHistoryTestManager(t *testing.T) *Manager {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	return NewManager(fake.NewClientBuilder().WithScheme(scheme).Build(), logr.Discard())
+}
+
+func saveCapture(t *testing.T, mgr *Manager, ns, plan, target, instanceID string, capturedAt time.Time) {
+	t.Helper()
+	ts := metav1.NewTime(capturedAt)
+	data := &Data{
+		Target:     target,
+		Executor:   "rds",
+		IsLive:     true,
+		CreatedAt:  ts,
+		CapturedAt: &ts,
+		State:      map[string]any{"instance:" + instanceID: map[string]any{"wasRunning": true}},
+	}
+	if err := mgr.Save(context.Background(), ns, plan, target, data); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+}
+
+func TestManager_ArchiveRestoreData_KeepsLimit(t *testing.T) {
+	mgr := newHistoryTestManager(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, id := range []string{"db-1", "db-2", "db-3"} {
+		saveCapture(t, mgr, "ns", "plan", "db", id, base.Add(time.Duration(i)*time.Hour))
+		if err := mgr.ArchiveRestoreData(ctx, "ns", "plan", 2); err != nil {
+			t.Fatalf("ArchiveRestoreData() error = %v", err)
+		}
+	}
+
+	snapshots, err := mgr.ListSnapshots(ctx, "ns", "plan", "db")
+	if err != nil {
+		t.Fatalf("ListSnapshots() error = %v", err)
+	}
+	if len(snapshots) != 2 {
+		t.Fatalf("expected 2 snapshots, got %d", len(snapshots))
+	}
+	if snapshots[0].Version != 3 || snapshots[1].Version != 2 {
+		t.Errorf("expected versions [3 2] newest first, got [%d %d]", snapshots[0].Version, snapshots[1].Version)
+	}
+	if _, ok := snapshots[0].Data.State["instance:db-3"]; !ok {
+		t.Errorf("expected newest snapshot to hold db-3, got %v", snapshots[0].Data.State)
+	}
+
+	// History keys must not be mistaken for target data.
+	has, err := mgr.HasRestoreData(ctx, "ns", "plan")
+	if err != nil || !has {
+		t.Errorf("HasRestoreData() = %v, %v; want true, nil", has, err)
+	}
+}
+
+func TestManager_ArchiveRestoreData_SkipsAlreadyArchived(t *testing.T) {
+	mgr := newHistoryTestManager(t)
+	ctx := context.Background()
+
+	saveCapture(t, mgr, "ns", "plan", "db", "db-1", time.Now())
+	for range 3 {
+		if err := mgr.ArchiveRestoreData(ctx, "ns", "plan", 5); err != nil {
+			t.Fatalf("ArchiveRestoreData() error = %v", err)
+		}
+	}
+
+	snapshots, err := mgr.ListSnapshots(ctx, "ns", "plan", "db")
+	if err != nil {
+		t.Fatalf("ListSnapshots() error = %v", err)
+	}
+	if len(snapshots) != 1 {
+		t.Errorf("expected 1 snapshot, got %d", len(snapshots))
+	}
+}
+
+func TestManager_ArchiveRestoreData_ZeroLimitDropsHistory(t *testing.T) {
+	mgr := newHistoryTestManager(t)
+	ctx := context.Background()
+
+	saveCapture(t, mgr, "ns", "plan", "db", "db-1", time.Now())
+	if err := mgr.ArchiveRestoreData(ctx, "ns", "plan", 3); err != nil {
+		t.Fatalf("ArchiveRestoreData() error = %v", err)
+	}
+	if err := mgr.ArchiveRestoreData(ctx, "ns", "plan", 0); err != nil {
+		t.Fatalf("ArchiveRestoreData() error = %v", err)
+	}
+
+	snapshots, err := mgr.ListSnapshots(ctx, "ns", "plan", "db")
+	if err != nil {
+		t.Fatalf("ListSnapshots() error = %v", err)
+	}
+	if len(snapshots) != 0 {
+		t.Errorf("expected history to be dropped, got %d snapshots", len(snapshots))
+	}
+}
+
+func TestManager_PromoteSnapshot(t *testing.T) {
+	mgr := newHistoryTestManager(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	saveCapture(t, mgr, "ns", "plan", "db", "db-1", base)
+	if err := mgr.ArchiveRestoreData(ctx, "ns", "plan", 3); err != nil {
+		t.Fatalf("ArchiveRestoreData() error = %v", err)
+	}
+	if err := mgr.MarkTargetRestored(ctx, "ns", "plan", "db"); err != nil {
+		t.Fatalf("MarkTargetRestored() error = %v", err)
+	}
+	saveCapture(t, mgr, "ns", "plan", "db", "db-corrupt", base.Add(time.Hour))
+
+	if err := mgr.PromoteSnapshot(ctx, "ns", "plan", "db", 1); err != nil {
+		t.Fatalf("PromoteSnapshot() error = %v", err)
+	}
+
+	loaded, err := mgr.Load(ctx, "ns", "plan", "db")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if _, ok := loaded.State["instance:db-1"]; !ok {
+		t.Errorf("expected promoted snapshot state, got %v", loaded.State)
+	}
+	if !loaded.IsLive {
+		t.Error("expected promoted snapshot to be live")
+	}
+
+	if err := mgr.PromoteSnapshot(ctx, "ns", "plan", "db", 42); err == nil {
+		t.Error("expected error for unknown snapshot version")
+	}
+}
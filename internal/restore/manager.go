@@ -9,13 +9,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/go-logr/logr"
-	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,18 +26,95 @@ const (
 	MaxConfigMapSize = 900 * 1024
 )
 
-// Manager handles restore data persistence using ConfigMaps.
+// Manager handles restore data persistence. Records are stored in ConfigMaps
+// unless a different Backend is configured.
 type Manager struct {
-	client client.Client
-	log    logr.Logger
+	client  client.Client
+	log     logr.Logger
+	resolve BackendResolver
+}
+
+// Option configures a Manager.
+type Option func(*Manager)
+
+// WithBackend stores restore data for every plan in b.
+func WithBackend(b Backend) Option {
+	return func(m *Manager) {
+		m.resolve = func(context.Context, string, string) (Backend, error) { return b, nil }
+	}
+}
+
+// WithBackendResolver selects the backend per plan, e.g. PlanStorageResolver.
+func WithBackendResolver(r BackendResolver) Option {
+	return func(m *Manager) {
+		m.resolve = r
+	}
 }
 
 // NewManager creates a new restore data manager.
-func NewManager(c client.Client, log logr.Logger) *Manager {
+func NewManager(c client.Client, log logr.Logger, opts ...Option) *Manager {
 	if log.GetSink() == nil {
 		log = logr.Discard()
 	}
-	return &Manager{client: c, log: log}
+	m := &Manager{client: c, log: log}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.resolve == nil {
+		WithBackend(NewConfigMapBackend(c))(m)
+	}
+	return m
+}
+
+// load returns the plan's restore record, or nil when none exists.
+func (m *Manager) load(ctx context.Context, namespace, planName string) (*Record, error) {
+	backend, err := m.resolve(ctx, namespace, planName)
+	if err != nil {
+		return nil, fmt.Errorf("resolve restore backend: %w", err)
+	}
+	return backend.Get(ctx, namespace, planName)
+}
+
+// update performs a read-modify-write of the plan's restore record, retrying
+// on optimistic concurrency conflicts. When the record does not exist, mutate
+// is invoked on a fresh record if create is true; otherwise update is a no-op.
+func (m *Manager) update(ctx context.Context, namespace, planName string, create bool, mutate func(rec *Record) error) error {
+	backend, err := m.resolve(ctx, namespace, planName)
+	if err != nil {
+		return fmt.Errorf("resolve restore backend: %w", err)
+	}
+
+	return retry.OnError(retry.DefaultRetry, IsConflict, func() error {
+		rec, err := backend.Get(ctx, namespace, planName)
+		if err != nil {
+			return err
+		}
+		if rec == nil {
+			if !create {
+				return nil
+			}
+			rec = newRecord()
+		} else {
+			rec = rec.clone()
+		}
+
+		if err := mutate(rec); err != nil {
+			return err
+		}
+		return backend.Put(ctx, namespace, planName, rec)
+	})
+}
+
+// maxValueSize returns the per-target size limit of the plan's backend, or 0 when unlimited.
+func (m *Manager) maxValueSize(ctx context.Context, namespace, planName string) int {
+	backend, err := m.resolve(ctx, namespace, planName)
+	if err != nil {
+		return 0
+	}
+	if limited, ok := backend.(sizeLimited); ok {
+		return limited.maxValueSize()
+	}
+	return 0
 }
 
 // ResourceStatus tracks per-resource metadata for staleness tracking and future extensions.
@@ -106,85 +182,56 @@ func GetRestoreConfigMap(planName string) string {
 	return configMapName(planName)
 }
 
-// PrepareRestorePoint ensures a clean ConfigMap exists for storing restore data for the plan.
+// PrepareRestorePoint ensures a clean restore record exists for the plan.
 func (m *Manager) PrepareRestorePoint(ctx context.Context, namespace, planName string) error {
-	cmName := configMapName(planName)
-
-	// Check if ConfigMap exists
-	cm := &corev1.ConfigMap{}
-	err := m.client.Get(ctx, types.NamespacedName{
-		Namespace: namespace,
-		Name:      cmName,
-	}, cm)
-
-	if apierrors.IsNotFound(err) {
-		// Create new ConfigMap
-		cm = &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      cmName,
-				Namespace: namespace,
-				Labels: map[string]string{
-					wellknown.LabelPlan: planName,
-				},
-			},
-			Data: make(map[string]string),
+	return m.update(ctx, namespace, planName, true, func(rec *Record) error {
+		if !rec.Exists() && len(rec.Data) == 0 {
+			// Fresh record - nothing to reset.
+			return nil
 		}
-		return m.client.Create(ctx, cm)
-	}
-
-	if err != nil {
-		return fmt.Errorf("get restore configmap: %w", err)
-	}
-
-	// Otherwise exists - clear restore point
-	// Assuming plan reset means reset all live data to not live, and snapshot the previous state as-is in the annotation
-	if cm.Annotations == nil {
-		cm.Annotations = make(map[string]string)
-	}
 
-	previous, err := json.Marshal(cm.Data)
-	if err == nil {
-		if len(previous) == 0 {
-			previous = []byte("n/a")
+		// Otherwise exists - clear restore point
+		// Assuming plan reset means reset all live data to not live, and snapshot the previous state as-is in the annotation
+		previous, err := json.Marshal(rec.Data)
+		if err == nil {
+			if len(previous) == 0 {
+				previous = []byte("n/a")
+			}
+			rec.Annotations[wellknown.AnnotationPreviousRestoreState] = string(previous)
 		}
-		cm.Annotations[wellknown.AnnotationPreviousRestoreState] = string(previous)
-	}
 
-	for key, val := range cm.Data {
-		state := &Data{}
-		if err := json.Unmarshal([]byte(val), state); err == nil {
-			// Reset IsLive flag
-			state.IsLive = false
-		}
+		for key, val := range rec.Data {
+			if isHistoryKey(key) {
+				continue
+			}
 
-		stateBytes, err := json.Marshal(state)
-		if err == nil {
-			cm.Data[key] = string(stateBytes)
-		}
-	}
+			state := &Data{}
+			if err := json.Unmarshal([]byte(val), state); err == nil {
+				// Reset IsLive flag
+				state.IsLive = false
+			}
 
-	return m.client.Update(ctx, cm)
+			stateBytes, err := json.Marshal(state)
+			if err == nil {
+				rec.Data[key] = string(stateBytes)
+			}
+		}
+		return nil
+	})
 }
 
 // Load retrieves restore data for a target.
 func (m *Manager) Load(ctx context.Context, namespace, planName, targetName string) (*Data, error) {
-	cmName := configMapName(planName)
-
-	var cm corev1.ConfigMap
-	err := m.client.Get(ctx, types.NamespacedName{
-		Namespace: namespace,
-		Name:      cmName,
-	}, &cm)
-
-	if apierrors.IsNotFound(err) {
-		return nil, nil // No restore data
-	}
+	rec, err := m.load(ctx, namespace, planName)
 	if err != nil {
-		return nil, fmt.Errorf("get restore configmap: %w", err)
+		return nil, err
+	}
+	if rec == nil {
+		return nil, nil // No restore data
 	}
 
 	key := fmt.Sprintf("%s.json", targetName)
-	dataStr, ok := cm.Data[key]
+	dataStr, ok := rec.Data[key]
 	if !ok {
 		return nil, nil // No restore data for this target
 	}
@@ -211,67 +258,42 @@ func isDemandedState(state map[string]any) bool {
 // MarkTargetRestored marks a target as successfully restored.
 // Sets annotation: hibernator.ardikabs.com/restored-{targetName}: "true"
 func (m *Manager) MarkTargetRestored(ctx context.Context, namespace, planName, targetName string) error {
-	cmName := configMapName(planName)
-
-	var cm corev1.ConfigMap
-	err := m.client.Get(ctx, types.NamespacedName{
-		Namespace: namespace,
-		Name:      cmName,
-	}, &cm)
-
-	if apierrors.IsNotFound(err) {
-		// ConfigMap doesn't exist - nothing to mark
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("get restore configmap: %w", err)
-	}
-
-	// Set annotation
-	if cm.Annotations == nil {
-		cm.Annotations = make(map[string]string)
-	}
-	annotationKey := wellknown.AnnotationRestoredPrefix + targetName
-	cm.Annotations[annotationKey] = "true"
-
-	// Reset IsLive flag and clear CycleID for this target's data after successful restore
-	key := fmt.Sprintf("%s.json", targetName)
-	if val, ok := cm.Data[key]; ok {
-		var data Data
-		if err := json.Unmarshal([]byte(val), &data); err == nil {
-			// Mark data as consumed - next hibernation should capture fresh live state
-			data.IsLive = false
-			if dataBytes, err := json.Marshal(&data); err == nil {
-				cm.Data[key] = string(dataBytes)
+	// A missing record means there is nothing to mark.
+	return m.update(ctx, namespace, planName, false, func(rec *Record) error {
+		annotationKey := wellknown.AnnotationRestoredPrefix + targetName
+		rec.Annotations[annotationKey] = "true"
+
+		// Reset IsLive flag and clear CycleID for this target's data after successful restore
+		key := fmt.Sprintf("%s.json", targetName)
+		if val, ok := rec.Data[key]; ok {
+			var data Data
+			if err := json.Unmarshal([]byte(val), &data); err == nil {
+				// Mark data as consumed - next hibernation should capture fresh live state
+				data.IsLive = false
+				if dataBytes, err := json.Marshal(&data); err == nil {
+					rec.Data[key] = string(dataBytes)
+				}
 			}
 		}
-	}
-
-	return m.client.Update(ctx, &cm)
+		return nil
+	})
 }
 
 // MarkAllTargetsRestored checks if all targets have been restored.
 func (m *Manager) MarkAllTargetsRestored(ctx context.Context, namespace, planName string, targetNames []string) (bool, error) {
-	cmName := configMapName(planName)
-
-	var cm corev1.ConfigMap
-	err := m.client.Get(ctx, types.NamespacedName{
-		Namespace: namespace,
-		Name:      cmName,
-	}, &cm)
-
-	if apierrors.IsNotFound(err) {
-		// No ConfigMap means no restore data, consider all restored
-		return true, nil
-	}
+	rec, err := m.load(ctx, namespace, planName)
 	if err != nil {
-		return false, fmt.Errorf("get restore configmap: %w", err)
+		return false, err
+	}
+	if rec == nil {
+		// No restore record means no restore data, consider all restored
+		return true, nil
 	}
 
 	// Check if all targets have restored annotation
 	for _, targetName := range targetNames {
 		annotationKey := wellknown.AnnotationRestoredPrefix + targetName
-		if cm.Annotations[annotationKey] != "true" {
+		if rec.Annotations[annotationKey] != "true" {
 			return false, nil
 		}
 	}
@@ -282,68 +304,53 @@ func (m *Manager) MarkAllTargetsRestored(ctx context.Context, namespace, planNam
 // UnlockRestoreData clears all restored-* annotations and resets CycleID for all targets.
 // This unlocks the restore data for the next hibernation cycle.
 func (m *Manager) UnlockRestoreData(ctx context.Context, namespace, planName string) error {
-	cmName := configMapName(planName)
-
-	cm := &corev1.ConfigMap{}
-	err := m.client.Get(ctx, types.NamespacedName{
-		Namespace: namespace,
-		Name:      cmName,
-	}, cm)
-
-	if apierrors.IsNotFound(err) {
-		// No ConfigMap to unlock
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("get restore configmap: %w", err)
-	}
-
-	// Remove all restored-* annotations
-	if cm.Annotations != nil {
-		for key := range cm.Annotations {
-			if len(key) > len(wellknown.AnnotationRestoredPrefix) && key[:len(wellknown.AnnotationRestoredPrefix)] == wellknown.AnnotationRestoredPrefix {
-				delete(cm.Annotations, key)
+	// A missing record means there is nothing to unlock.
+	return m.update(ctx, namespace, planName, false, func(rec *Record) error {
+		// Remove all restored-* annotations
+		for key := range rec.Annotations {
+			if len(key) > len(wellknown.AnnotationRestoredPrefix) && strings.HasPrefix(key, wellknown.AnnotationRestoredPrefix) {
+				delete(rec.Annotations, key)
 			}
 		}
-	}
 
-	// Clear CycleID from all target data to mark restoration as complete
-	for key, val := range cm.Data {
-		var data Data
-		if err := json.Unmarshal([]byte(val), &data); err == nil && data.CycleID != "" {
-			m.log.V(1).Info("clearing CycleID after successful restoration",
-				"target", data.Target,
-				"clearedCycleID", data.CycleID,
-			)
-			data.CycleID = ""
-			if dataBytes, err := json.Marshal(&data); err == nil {
-				cm.Data[key] = string(dataBytes)
+		// Clear CycleID from all target data to mark restoration as complete
+		for key, val := range rec.Data {
+			if isHistoryKey(key) {
+				continue
 			}
-		}
-	}
 
-	return m.client.Update(ctx, cm)
+			var data Data
+			if err := json.Unmarshal([]byte(val), &data); err == nil && data.CycleID != "" {
+				m.log.V(1).Info("clearing CycleID after successful restoration",
+					"target", data.Target,
+					"clearedCycleID", data.CycleID,
+				)
+				data.CycleID = ""
+				if dataBytes, err := json.Marshal(&data); err == nil {
+					rec.Data[key] = string(dataBytes)
+				}
+			}
+		}
+		return nil
+	})
 }
 
-// HasRestoreData checks if restore ConfigMap exists for the plan, and at least have eligible restore point,
+// HasRestoreData checks if a restore record exists for the plan, and at least have eligible restore point,
 // as indicated by `.isLive=true`
 func (m *Manager) HasRestoreData(ctx context.Context, namespace, planName string) (bool, error) {
-	cmName := configMapName(planName)
-
-	var cm corev1.ConfigMap
-	err := m.client.Get(ctx, types.NamespacedName{
-		Namespace: namespace,
-		Name:      cmName,
-	}, &cm)
-
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
+	rec, err := m.load(ctx, namespace, planName)
 	if err != nil {
-		return false, fmt.Errorf("get restore configmap: %w", err)
+		return false, err
+	}
+	if rec == nil {
+		return false, nil
 	}
 
-	for _, val := range cm.Data {
+	for key, val := range rec.Data {
+		if isHistoryKey(key) {
+			continue
+		}
+
 		var data Data
 		if err := json.Unmarshal([]byte(val), &data); err != nil {
 			return false, nil
@@ -356,5 +363,5 @@ func (m *Manager) HasRestoreData(ctx context.Context, namespace, planName string
 		}
 	}
 
-	return len(cm.Data) > 0, nil
+	return len(rec.Data) > 0, nil
 }
@@ -10,43 +10,11 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/ardikabs/hibernator/internal/wellknown"
-	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Save persists restore data for a target.
 func (m *Manager) Save(ctx context.Context, namespace, planName, targetName string, data *Data) error {
-	cmName := configMapName(planName)
-
-	// Get or create the ConfigMap
-	cm := &corev1.ConfigMap{}
-	err := m.client.Get(ctx, types.NamespacedName{
-		Namespace: namespace,
-		Name:      cmName,
-	}, cm)
-
-	if apierrors.IsNotFound(err) {
-		// Create new ConfigMap
-		cm = &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      cmName,
-				Namespace: namespace,
-				Labels: map[string]string{
-					wellknown.LabelPlan: planName,
-				},
-			},
-			Data: make(map[string]string),
-		}
-	} else if err != nil {
-		return fmt.Errorf("get restore configmap: %w", err)
-	}
-
-	patch := client.MergeFrom(cm.DeepCopy())
-
 	// Serialize data
 	dataBytes, err := json.Marshal(data)
 	if err != nil {
@@ -54,22 +22,16 @@ func (m *Manager) Save(ctx context.Context, namespace, planName, targetName stri
 	}
 
 	// Check size
-	if len(dataBytes) > MaxConfigMapSize {
-		return fmt.Errorf("restore data too large (%d bytes), max %d", len(dataBytes), MaxConfigMapSize)
+	if limit := m.maxValueSize(ctx, namespace, planName); limit > 0 && len(dataBytes) > limit {
+		return fmt.Errorf("restore data too large (%d bytes), max %d", len(dataBytes), limit)
 	}
 
 	// Store with target-specific key
 	key := fmt.Sprintf("%s.json", targetName)
-	if cm.Data == nil {
-		cm.Data = make(map[string]string)
-	}
-	cm.Data[key] = string(dataBytes)
-
-	if cm.ResourceVersion == "" {
-		return m.client.Create(ctx, cm)
-	}
-
-	return m.client.Patch(ctx, cm, patch)
+	return m.update(ctx, namespace, planName, true, func(rec *Record) error {
+		rec.Data[key] = string(dataBytes)
+		return nil
+	})
 }
 
 // SaveState saves the reported state from the current shutdown cycle and performs
@@ -0,0 +1,404 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// DefaultEncryptionKey is the Secret key read when RestoreEncryption.KeySecretRef.Key is unset.
+const DefaultEncryptionKey = "key"
+
+// encryptedPrefix marks values encrypted by encryptingBackend. It is followed
+// by the ID of the key that encrypted the value and a colon.
+const encryptedPrefix = "enc:v1:"
+
+// BackendResolver returns the Backend holding a plan's restore data.
+type BackendResolver func(ctx context.Context, namespace, planName string) (Backend, error)
+
+// NewBackend builds the Backend described by storage. A nil storage selects the
+// ConfigMap backend. Non-ConfigMap backends fall back to reading an existing
+// restore ConfigMap and delete it once the data has been written to the new
+// backend, so plans can switch storage without losing restore data.
+func NewBackend(ctx context.Context, c client.Client, namespace string, storage *hibernatorv1alpha1.RestoreStorage) (Backend, error) {
+	legacy := NewConfigMapBackend(c)
+	if storage == nil {
+		return legacy, nil
+	}
+
+	var (
+		primary Backend
+		err     error
+	)
+	switch storage.Type {
+	case "", hibernatorv1alpha1.RestoreStorageConfigMap:
+		primary = legacy
+	case hibernatorv1alpha1.RestoreStorageSecret:
+		primary = NewSecretBackend(c)
+	case hibernatorv1alpha1.RestoreStorageS3:
+		if storage.S3 == nil {
+			return nil, fmt.Errorf("restore storage type S3 requires s3 configuration")
+		}
+		primary, err = NewS3Backend(ctx, *storage.S3)
+	case hibernatorv1alpha1.RestoreStorageGCS:
+		if storage.GCS == nil {
+			return nil, fmt.Errorf("restore storage type GCS requires gcs configuration")
+		}
+		primary, err = NewGCSBackend(ctx, *storage.GCS)
+	default:
+		return nil, fmt.Errorf("unsupported restore storage type %q", storage.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	migrate := primary != Backend(legacy)
+
+	if storage.Encryption != nil {
+		key, previous, err := loadEncryptionKeys(ctx, c, namespace, *storage.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		if primary, err = newEncryptingBackend(primary, key, previous...); err != nil {
+			return nil, err
+		}
+	}
+
+	if !migrate {
+		return primary, nil
+	}
+	return &migratingBackend{primary: primary, legacy: legacy}, nil
+}
+
+// ResolverOption configures PlanStorageResolver.
+type ResolverOption func(*resolverOptions)
+
+type resolverOptions struct {
+	s3Endpoints []string
+}
+
+// WithS3Endpoints sets the custom S3 endpoints plans may select. The resolver
+// refuses other endpoints, which would receive the caller's AWS credentials.
+func WithS3Endpoints(endpoints []string) ResolverOption {
+	return func(o *resolverOptions) {
+		o.s3Endpoints = endpoints
+	}
+}
+
+// S3EndpointAllowed reports whether a plan may select the S3 endpoint. The
+// default endpoint of the bucket region is always allowed; custom endpoints
+// must be listed in allowed.
+func S3EndpointAllowed(endpoint string, allowed []string) bool {
+	if endpoint == "" {
+		return true
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	return slices.ContainsFunc(allowed, func(a string) bool {
+		return strings.TrimSuffix(a, "/") == endpoint
+	})
+}
+
+// cachedBackend is an object store backend built for one storage spec of a
+// plan and one version of its encryption key Secret.
+type cachedBackend struct {
+	storage    string
+	keyVersion string
+	backend    Backend
+}
+
+// PlanStorageResolver returns a BackendResolver that selects the backend from
+// the HibernatePlan's spec.restore.storage. Plans that no longer exist resolve
+// to the ConfigMap backend. Object store backends are reused across calls for
+// a plan until its storage spec or the resource version of its encryption key
+// Secret changes, so a rotated key is picked up on the next call; the SDK
+// clients refresh their cloud credentials themselves.
+func PlanStorageResolver(c client.Client, opts ...ResolverOption) BackendResolver {
+	var o resolverOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var (
+		mu           sync.Mutex
+		objectStores = map[types.NamespacedName]cachedBackend{}
+	)
+	evict := func(key types.NamespacedName) {
+		mu.Lock()
+		defer mu.Unlock()
+		delete(objectStores, key)
+	}
+
+	return func(ctx context.Context, namespace, planName string) (Backend, error) {
+		key := types.NamespacedName{Namespace: namespace, Name: planName}
+		plan := &hibernatorv1alpha1.HibernatePlan{}
+		if err := c.Get(ctx, key, plan); err != nil {
+			if apierrors.IsNotFound(err) {
+				evict(key)
+				return NewConfigMapBackend(c), nil
+			}
+			return nil, fmt.Errorf("get plan for restore storage: %w", err)
+		}
+
+		var storage *hibernatorv1alpha1.RestoreStorage
+		if plan.Spec.Restore != nil {
+			storage = plan.Spec.Restore.Storage
+		}
+		isObjectStore := storage != nil &&
+			(storage.Type == hibernatorv1alpha1.RestoreStorageS3 || storage.Type == hibernatorv1alpha1.RestoreStorageGCS)
+		if !isObjectStore {
+			evict(key)
+			return NewBackend(ctx, c, namespace, storage)
+		}
+
+		if storage.Type == hibernatorv1alpha1.RestoreStorageS3 && storage.S3 != nil &&
+			!S3EndpointAllowed(storage.S3.Endpoint, o.s3Endpoints) {
+			return nil, fmt.Errorf("s3 endpoint %q is not allowed by the controller's --restore-s3-endpoints", storage.S3.Endpoint)
+		}
+
+		var keyVersion string
+		if storage.Encryption != nil {
+			secret := &corev1.Secret{}
+			if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: storage.Encryption.KeySecretRef.Name}, secret); err != nil {
+				return nil, fmt.Errorf("get restore encryption key secret: %w", err)
+			}
+			keyVersion = secret.ResourceVersion
+		}
+
+		spec, err := json.Marshal(storage)
+		if err != nil {
+			return nil, err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if cached, ok := objectStores[key]; ok && cached.storage == string(spec) && cached.keyVersion == keyVersion {
+			return cached.backend, nil
+		}
+
+		// A changed spec replaces the plan's entry, so backends of earlier
+		// specs are not kept around.
+		b, err := NewBackend(ctx, c, namespace, storage)
+		if err != nil {
+			return nil, err
+		}
+		objectStores[key] = cachedBackend{storage: string(spec), keyVersion: keyVersion, backend: b}
+		return b, nil
+	}
+}
+
+// loadEncryptionKeys returns the current key and the previous keys of the
+// encryption key Secret.
+func loadEncryptionKeys(ctx context.Context, c client.Client, namespace string, enc hibernatorv1alpha1.RestoreEncryption) ([]byte, [][]byte, error) {
+	ref := enc.KeySecretRef
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return nil, nil, fmt.Errorf("get restore encryption key secret: %w", err)
+	}
+
+	lookup := func(keyName string) ([]byte, error) {
+		key, ok := secret.Data[keyName]
+		if !ok {
+			return nil, fmt.Errorf("restore encryption key secret %s/%s has no key %q", namespace, ref.Name, keyName)
+		}
+		return key, nil
+	}
+
+	key, err := lookup(ptr.Deref(ref.Key, DefaultEncryptionKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	previous := make([][]byte, 0, len(enc.PreviousKeys))
+	for _, keyName := range enc.PreviousKeys {
+		k, err := lookup(keyName)
+		if err != nil {
+			return nil, nil, err
+		}
+		previous = append(previous, k)
+	}
+	return key, previous, nil
+}
+
+// encryptionKeyID identifies an encryption key in encrypted values without
+// revealing it.
+func encryptionKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// encryptingBackend encrypts record values with AES-GCM before they reach the
+// wrapped backend. Values are encrypted with the current key and tagged with
+// its ID; values tagged with a previous key are decrypted with that key, so
+// records stay readable across a key rotation. Values without the encryption
+// prefix are returned as-is, so existing plaintext records remain readable
+// after encryption is enabled.
+type encryptingBackend struct {
+	Backend
+	keyID string
+	keys  map[string]cipher.AEAD // key ID -> AEAD, current and previous keys
+}
+
+func newEncryptingBackend(inner Backend, key []byte, previous ...[]byte) (*encryptingBackend, error) {
+	b := &encryptingBackend{Backend: inner, keyID: encryptionKeyID(key), keys: map[string]cipher.AEAD{}}
+	for _, k := range append([][]byte{key}, previous...) {
+		block, err := aes.NewCipher(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid restore encryption key: %w", err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid restore encryption key: %w", err)
+		}
+		if _, ok := b.keys[encryptionKeyID(k)]; !ok {
+			b.keys[encryptionKeyID(k)] = aead
+		}
+	}
+	return b, nil
+}
+
+func (b *encryptingBackend) maxValueSize() int {
+	if limited, ok := b.Backend.(sizeLimited); ok {
+		// Account for nonce, tag, prefix, key ID and base64 expansion.
+		aead := b.keys[b.keyID]
+		return limited.maxValueSize()*3/4 - aead.NonceSize() - aead.Overhead() - len(encryptedPrefix) - len(b.keyID) - 1
+	}
+	return 0
+}
+
+// open decrypts an encrypted value stored under the record key k.
+func (b *encryptingBackend) open(k, v string) (string, error) {
+	keyID, payload, ok := strings.Cut(strings.TrimPrefix(v, encryptedPrefix), ":")
+	if !ok {
+		return "", fmt.Errorf("decode encrypted restore value %q: missing key ID", k)
+	}
+	aead, ok := b.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("decrypt restore value %q: encrypted with key %s, which is neither the current key nor one of previousKeys", k, keyID)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil || len(raw) < aead.NonceSize() {
+		return "", fmt.Errorf("decode encrypted restore value %q: malformed ciphertext", k)
+	}
+	nonce, ciphertext := raw[:aead.NonceSize()], raw[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(k))
+	if err != nil {
+		return "", fmt.Errorf("decrypt restore value %q: %w", k, err)
+	}
+	return string(plaintext), nil
+}
+
+// Get implements Backend.
+func (b *encryptingBackend) Get(ctx context.Context, namespace, planName string) (*Record, error) {
+	rec, err := b.Backend.Get(ctx, namespace, planName)
+	if err != nil || rec == nil {
+		return rec, err
+	}
+
+	out := rec.clone()
+	for k, v := range out.Data {
+		if !strings.HasPrefix(v, encryptedPrefix) {
+			continue
+		}
+		plaintext, err := b.open(k, v)
+		if err != nil {
+			return nil, err
+		}
+		out.Data[k] = plaintext
+	}
+	return out, nil
+}
+
+// Put implements Backend.
+func (b *encryptingBackend) Put(ctx context.Context, namespace, planName string, rec *Record) error {
+	aead := b.keys[b.keyID]
+	sealed := rec.clone()
+	for k, v := range sealed.Data {
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return fmt.Errorf("generate nonce: %w", err)
+		}
+		ciphertext := aead.Seal(nonce, nonce, []byte(v), []byte(k))
+		sealed.Data[k] = encryptedPrefix + b.keyID + ":" + base64.StdEncoding.EncodeToString(ciphertext)
+	}
+
+	if err := b.Backend.Put(ctx, namespace, planName, sealed); err != nil {
+		return err
+	}
+	rec.Revision = sealed.Revision
+	rec.native = sealed.native
+	return nil
+}
+
+// migratingBackend reads from a legacy backend when the primary backend has no
+// record yet, and deletes the legacy record once the primary write succeeds.
+type migratingBackend struct {
+	primary Backend
+	legacy  Backend
+}
+
+func (b *migratingBackend) maxValueSize() int {
+	if limited, ok := b.primary.(sizeLimited); ok {
+		return limited.maxValueSize()
+	}
+	return 0
+}
+
+// Get implements Backend.
+func (b *migratingBackend) Get(ctx context.Context, namespace, planName string) (*Record, error) {
+	rec, err := b.primary.Get(ctx, namespace, planName)
+	if err != nil || rec != nil {
+		return rec, err
+	}
+
+	legacy, err := b.legacy.Get(ctx, namespace, planName)
+	if err != nil || legacy == nil {
+		return nil, err
+	}
+
+	// Present the legacy data as a not-yet-persisted primary record.
+	out := legacy.clone()
+	out.Revision = ""
+	out.native = nil
+	return out, nil
+}
+
+// Put implements Backend.
+func (b *migratingBackend) Put(ctx context.Context, namespace, planName string, rec *Record) error {
+	created := !rec.Exists()
+	if err := b.primary.Put(ctx, namespace, planName, rec); err != nil {
+		return err
+	}
+	if created {
+		return b.legacy.Delete(ctx, namespace, planName)
+	}
+	return nil
+}
+
+// Delete implements Backend.
+func (b *migratingBackend) Delete(ctx context.Context, namespace, planName string) error {
+	if err := b.primary.Delete(ctx, namespace, planName); err != nil {
+		return err
+	}
+	return b.legacy.Delete(ctx, namespace, planName)
+}
@@ -0,0 +1,444 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package restore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func newStorageTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = hibernatorv1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func sampleData(target string) *Data {
+	return &Data{
+		Target:    target,
+		Executor:  "rds",
+		IsLive:    true,
+		CreatedAt: metav1.Now(),
+		State:     map[string]any{"db-1": map[string]any{"wasRunning": true}},
+	}
+}
+
+func TestSecretBackend_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	c := newStorageTestClient()
+	mgr := NewManager(c, logr.Discard(), WithBackend(NewSecretBackend(c)))
+
+	require.NoError(t, mgr.Save(ctx, "ns", "plan", "db", sampleData("db")))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "hibernator-restore-plan"}, secret))
+	assert.Contains(t, secret.Data, "db.json")
+
+	loaded, err := mgr.Load(ctx, "ns", "plan", "db")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.True(t, loaded.IsLive)
+
+	require.NoError(t, mgr.MarkTargetRestored(ctx, "ns", "plan", "db"))
+	done, err := mgr.MarkAllTargetsRestored(ctx, "ns", "plan", []string{"db"})
+	require.NoError(t, err)
+	assert.True(t, done)
+}
+
+func TestNewBackend_MigratesFromConfigMap(t *testing.T) {
+	ctx := context.Background()
+	c := newStorageTestClient()
+
+	// Existing restore data lives in the legacy ConfigMap.
+	legacyMgr := NewManager(c, logr.Discard())
+	require.NoError(t, legacyMgr.Save(ctx, "ns", "plan", "db", sampleData("db")))
+
+	backend, err := NewBackend(ctx, c, "ns", &hibernatorv1alpha1.RestoreStorage{Type: hibernatorv1alpha1.RestoreStorageSecret})
+	require.NoError(t, err)
+	mgr := NewManager(c, logr.Discard(), WithBackend(backend))
+
+	// Reads fall back to the ConfigMap until the first write.
+	has, err := mgr.HasRestoreData(ctx, "ns", "plan")
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	require.NoError(t, mgr.Save(ctx, "ns", "plan", "cache", sampleData("cache")))
+
+	cm := &corev1.ConfigMap{}
+	err = c.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "hibernator-restore-plan"}, cm)
+	assert.True(t, apierrors.IsNotFound(err), "legacy ConfigMap should be deleted after migration")
+
+	for _, target := range []string{"db", "cache"} {
+		loaded, err := mgr.Load(ctx, "ns", "plan", target)
+		require.NoError(t, err)
+		require.NotNil(t, loaded, "target %s should survive migration", target)
+	}
+}
+
+func TestNewBackend_Encryption(t *testing.T) {
+	ctx := context.Background()
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "restore-key", Namespace: "ns"},
+		Data:       map[string][]byte{DefaultEncryptionKey: []byte("0123456789abcdef0123456789abcdef")},
+	}
+	c := newStorageTestClient(keySecret)
+
+	// Plaintext data written before encryption was enabled.
+	require.NoError(t, NewManager(c, logr.Discard()).Save(ctx, "ns", "plan", "old", sampleData("old")))
+
+	backend, err := NewBackend(ctx, c, "ns", &hibernatorv1alpha1.RestoreStorage{
+		Type: hibernatorv1alpha1.RestoreStorageConfigMap,
+		Encryption: &hibernatorv1alpha1.RestoreEncryption{
+			KeySecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "restore-key"},
+		},
+	})
+	require.NoError(t, err)
+	mgr := NewManager(c, logr.Discard(), WithBackend(backend))
+
+	require.NoError(t, mgr.Save(ctx, "ns", "plan", "db", sampleData("db")))
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "hibernator-restore-plan"}, cm))
+	assert.True(t, strings.HasPrefix(cm.Data["db.json"], encryptedPrefix))
+	assert.NotContains(t, cm.Data["db.json"], "wasRunning")
+
+	for _, target := range []string{"old", "db"} {
+		loaded, err := mgr.Load(ctx, "ns", "plan", target)
+		require.NoError(t, err)
+		require.NotNil(t, loaded)
+		assert.Equal(t, target, loaded.Target)
+	}
+}
+
+func TestNewBackend_EncryptionKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	oldKey := []byte("0123456789abcdef0123456789abcdef")
+	newKey := []byte("fedcba9876543210fedcba9876543210")
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "restore-key", Namespace: "ns"},
+		Data:       map[string][]byte{DefaultEncryptionKey: oldKey},
+	}
+	c := newStorageTestClient(keySecret)
+	manager := func(encryption hibernatorv1alpha1.RestoreEncryption) (*Manager, error) {
+		backend, err := NewBackend(ctx, c, "ns", &hibernatorv1alpha1.RestoreStorage{
+			Type:       hibernatorv1alpha1.RestoreStorageSecret,
+			Encryption: &encryption,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewManager(c, logr.Discard(), WithBackend(backend)), nil
+	}
+	stored := func() string {
+		secret := &corev1.Secret{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "hibernator-restore-plan"}, secret))
+		return string(secret.Data["db.json"])
+	}
+
+	mgr, err := manager(hibernatorv1alpha1.RestoreEncryption{KeySecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "restore-key"}})
+	require.NoError(t, err)
+	require.NoError(t, mgr.Save(ctx, "ns", "plan", "db", sampleData("db")))
+	assert.True(t, strings.HasPrefix(stored(), encryptedPrefix+encryptionKeyID(oldKey)+":"))
+
+	// Rotate: the new key encrypts, the old one is kept for decryption.
+	keySecret.Data = map[string][]byte{DefaultEncryptionKey: newKey, "previous": oldKey}
+	require.NoError(t, c.Update(ctx, keySecret))
+
+	// Without the previous key, the record cannot be read.
+	mgr, err = manager(hibernatorv1alpha1.RestoreEncryption{KeySecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "restore-key"}})
+	require.NoError(t, err)
+	_, err = mgr.Load(ctx, "ns", "plan", "db")
+	assert.ErrorContains(t, err, "neither the current key nor one of previousKeys")
+
+	mgr, err = manager(hibernatorv1alpha1.RestoreEncryption{
+		KeySecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "restore-key"},
+		PreviousKeys: []string{"previous"},
+	})
+	require.NoError(t, err)
+	loaded, err := mgr.Load(ctx, "ns", "plan", "db")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Equal(t, "db", loaded.Target)
+
+	// The next write encrypts the whole record with the new key.
+	require.NoError(t, mgr.Save(ctx, "ns", "plan", "cache", sampleData("cache")))
+	assert.True(t, strings.HasPrefix(stored(), encryptedPrefix+encryptionKeyID(newKey)+":"))
+
+	_, err = manager(hibernatorv1alpha1.RestoreEncryption{
+		KeySecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "restore-key"},
+		PreviousKeys: []string{"missing"},
+	})
+	assert.ErrorContains(t, err, `has no key "missing"`)
+}
+
+func TestPlanStorageResolver_ObjectStores(t *testing.T) {
+	ctx := context.Background()
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "restore-key", Namespace: "ns"},
+		Data:       map[string][]byte{DefaultEncryptionKey: []byte("0123456789abcdef0123456789abcdef")},
+	}
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "plan", Namespace: "ns"},
+		Spec: hibernatorv1alpha1.HibernatePlanSpec{
+			Restore: &hibernatorv1alpha1.RestoreSpec{Storage: &hibernatorv1alpha1.RestoreStorage{
+				Type: hibernatorv1alpha1.RestoreStorageS3,
+				S3: &hibernatorv1alpha1.S3RestoreStorage{
+					Bucket:   "restore",
+					Region:   "us-east-1",
+					Endpoint: "https://minio.example.com/",
+				},
+				Encryption: &hibernatorv1alpha1.RestoreEncryption{
+					KeySecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "restore-key"},
+				},
+			}},
+		},
+	}
+	c := newStorageTestClient(keySecret, plan)
+
+	// Custom endpoints would receive the controller's AWS credentials.
+	_, err := PlanStorageResolver(c)(ctx, "ns", "plan")
+	assert.ErrorContains(t, err, `s3 endpoint "https://minio.example.com/" is not allowed`)
+
+	resolve := PlanStorageResolver(c, WithS3Endpoints([]string{"https://minio.example.com"}))
+	first, err := resolve(ctx, "ns", "plan")
+	require.NoError(t, err)
+	again, err := resolve(ctx, "ns", "plan")
+	require.NoError(t, err)
+	assert.Same(t, first, again, "backends are reused for the same storage")
+
+	// A rotated encryption key builds a new backend.
+	keySecret.Data[DefaultEncryptionKey] = []byte("fedcba9876543210fedcba9876543210")
+	require.NoError(t, c.Update(ctx, keySecret))
+	rotated, err := resolve(ctx, "ns", "plan")
+	require.NoError(t, err)
+	assert.NotSame(t, first, rotated)
+
+	// A changed storage spec replaces the plan's backend.
+	plan.Spec.Restore.Storage.S3.Prefix = "prod"
+	require.NoError(t, c.Update(ctx, plan))
+	moved, err := resolve(ctx, "ns", "plan")
+	require.NoError(t, err)
+	assert.NotSame(t, rotated, moved)
+	again, err = resolve(ctx, "ns", "plan")
+	require.NoError(t, err)
+	assert.Same(t, moved, again)
+}
+
+func TestNewBackend_InvalidKey(t *testing.T) {
+	ctx := context.Background()
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "restore-key", Namespace: "ns"},
+		Data:       map[string][]byte{DefaultEncryptionKey: []byte("short")},
+	}
+	c := newStorageTestClient(keySecret)
+
+	_, err := NewBackend(ctx, c, "ns", &hibernatorv1alpha1.RestoreStorage{
+		Type:       hibernatorv1alpha1.RestoreStorageSecret,
+		Encryption: &hibernatorv1alpha1.RestoreEncryption{KeySecretRef: hibernatorv1alpha1.ObjectKeyReference{Name: "restore-key"}},
+	})
+	assert.Error(t, err)
+}
+
+// fakeObjectStore is a minimal object store supporting conditional writes.
+type fakeObjectStore struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	versions map[string]int
+	requests []*http.Request
+}
+
+func newFakeObjectStore() *fakeObjectStore {
+	return &fakeObjectStore{objects: map[string][]byte{}, versions: map[string]int{}}
+}
+
+func (s *fakeObjectStore) get(w http.ResponseWriter, name, revisionHeader string) {
+	body, ok := s.objects[name]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set(revisionHeader, fmt.Sprint(s.versions[name]))
+	_, _ = w.Write(body)
+}
+
+func (s *fakeObjectStore) put(w http.ResponseWriter, name, expected string, body []byte) bool {
+	current := fmt.Sprint(s.versions[name])
+	if _, exists := s.objects[name]; !exists {
+		current = "0"
+	}
+	if expected != current {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return false
+	}
+	s.objects[name] = body
+	s.versions[name]++
+	return true
+}
+
+func (s *fakeObjectStore) s3Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, r)
+
+		name := r.URL.Path
+		switch r.Method {
+		case http.MethodGet:
+			s.get(w, name, "ETag")
+		case http.MethodPut:
+			expected := r.Header.Get("If-Match")
+			if r.Header.Get("If-None-Match") == "*" {
+				expected = "0"
+			}
+			body, _ := io.ReadAll(r.Body)
+			if s.put(w, name, expected, body) {
+				w.Header().Set("ETag", fmt.Sprint(s.versions[name]))
+			}
+		case http.MethodDelete:
+			delete(s.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+func TestS3Backend_ManagerRoundTrip(t *testing.T) {
+	store := newFakeObjectStore()
+	srv := httptest.NewServer(store.s3Handler())
+	defer srv.Close()
+
+	backend := newS3Backend(hibernatorv1alpha1.S3RestoreStorage{
+		Bucket:               "restore",
+		Prefix:               "hibernator/",
+		Region:               "us-east-1",
+		Endpoint:             srv.URL,
+		ForcePathStyle:       true,
+		ServerSideEncryption: "aws:kms",
+		KMSKeyID:             "alias/restore",
+	}, aws.Config{
+		Region:      "us-east-1",
+		Credentials: aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider("AKID", "SECRET", "")),
+		HTTPClient:  srv.Client(),
+	})
+
+	ctx := context.Background()
+	mgr := NewManager(newStorageTestClient(), logr.Discard(), WithBackend(backend))
+
+	require.NoError(t, mgr.PrepareRestorePoint(ctx, "ns", "plan"))
+	require.NoError(t, mgr.Save(ctx, "ns", "plan", "db", sampleData("db")))
+	require.NoError(t, mgr.Save(ctx, "ns", "plan", "cache", sampleData("cache")))
+
+	assert.Contains(t, store.objects, "/restore/hibernator/ns/hibernator-restore-plan.json")
+
+	loaded, err := mgr.Load(ctx, "ns", "plan", "cache")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+
+	last := store.requests[len(store.requests)-1]
+	assert.Contains(t, last.Header.Get("Authorization"), "AWS4-HMAC-SHA256")
+
+	var sawSSE bool
+	for _, r := range store.requests {
+		if r.Method == http.MethodPut && r.Header.Get("X-Amz-Server-Side-Encryption") == "aws:kms" {
+			sawSSE = true
+		}
+	}
+	assert.True(t, sawSSE, "puts should request server-side encryption")
+
+	// A stale revision is reported as a conflict.
+	err = backend.Put(ctx, "ns", "plan", &Record{Data: map[string]string{}, Revision: "1"})
+	assert.True(t, IsConflict(err))
+}
+
+func TestGCSBackend_ManagerRoundTrip(t *testing.T) {
+	store := newFakeObjectStore()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/upload/storage/v1/b/restore/o", func(w http.ResponseWriter, r *http.Request) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+
+		// Multipart uploads carry the object metadata, then its content.
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		parts := multipart.NewReader(r.Body, params["boundary"])
+		meta, err := parts.NextPart()
+		require.NoError(t, err)
+		var obj struct {
+			Name string `json:"name"`
+		}
+		require.NoError(t, json.NewDecoder(meta).Decode(&obj))
+		media, err := parts.NextPart()
+		require.NoError(t, err)
+		body, _ := io.ReadAll(media)
+
+		if store.put(w, obj.Name, r.URL.Query().Get("ifGenerationMatch"), body) {
+			_, _ = fmt.Fprintf(w, `{"generation":"%d"}`, store.versions[obj.Name])
+		}
+	})
+	mux.HandleFunc("/storage/v1/b/restore/o/", func(w http.ResponseWriter, r *http.Request) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+
+		name := strings.TrimPrefix(r.URL.Path, "/storage/v1/b/restore/o/")
+		switch r.Method {
+		case http.MethodGet:
+			store.get(w, name, "X-Goog-Generation")
+		case http.MethodDelete:
+			delete(store.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ctx := context.Background()
+	backend, err := newGCSBackend(ctx, hibernatorv1alpha1.GCSRestoreStorage{Bucket: "restore"},
+		option.WithEndpoint(srv.URL+"/storage/v1/"),
+		option.WithHTTPClient(srv.Client()),
+	)
+	require.NoError(t, err)
+
+	mgr := NewManager(newStorageTestClient(), logr.Discard(), WithBackend(backend))
+
+	require.NoError(t, mgr.Save(ctx, "ns", "plan", "db", sampleData("db")))
+	require.NoError(t, mgr.MarkTargetRestored(ctx, "ns", "plan", "db"))
+
+	assert.Contains(t, store.objects, "ns/hibernator-restore-plan.json")
+
+	done, err := mgr.MarkAllTargetsRestored(ctx, "ns", "plan", []string{"db"})
+	require.NoError(t, err)
+	assert.True(t, done)
+
+	require.NoError(t, backend.Delete(ctx, "ns", "plan"))
+	has, err := mgr.HasRestoreData(ctx, "ns", "plan")
+	require.NoError(t, err)
+	assert.False(t, has)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package ringlog stores JSON-lines logs in ConfigMaps bounded by a size
+// limit. Each log is a ring buffer: once it reaches its size limit, its oldest
+// lines are dropped and counted in wellknown.AnnotationLogDropped, so readers
+// can tell the log is incomplete.
+package ringlog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// Dropped returns the number of oldest lines cm dropped to stay within its
+// size limit.
+func Dropped(cm *corev1.ConfigMap) int {
+	n, _ := strconv.Atoi(cm.Annotations[wellknown.AnnotationLogDropped])
+	return n
+}
+
+// Decode returns the JSON lines stored under dataKey of cm, oldest first.
+func Decode[T any](cm *corev1.ConfigMap, dataKey string) []T {
+	items := []T{}
+
+	data := cm.Data[dataKey]
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		var item T
+		// A malformed line only loses itself, not the rest of the log.
+		if err := json.Unmarshal(scanner.Bytes(), &item); err == nil {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Append appends newline-terminated lines to the log stored under dataKey of
+// cm, dropping its oldest lines beyond maxSize. dropped counts lines that were
+// dropped before they reached cm, e.g. from a bounded in-memory buffer.
+func Append(cm *corev1.ConfigMap, dataKey string, lines [][]byte, maxSize, dropped int) {
+	var buf bytes.Buffer
+	if cm.Data != nil {
+		buf.WriteString(cm.Data[dataKey])
+	}
+	for _, line := range lines {
+		buf.Write(line)
+	}
+
+	data := buf.Bytes()
+	dropped += Dropped(cm)
+	for len(data) > maxSize {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			data = nil
+			break
+		}
+		data = data[i+1:]
+		dropped++
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[dataKey] = string(data)
+
+	if dropped > 0 {
+		if cm.Annotations == nil {
+			cm.Annotations = make(map[string]string)
+		}
+		cm.Annotations[wellknown.AnnotationLogDropped] = strconv.Itoa(dropped)
+	}
+}
+
+// Write applies mutate to the ConfigMap at key and writes it back, creating
+// it from newConfigMap when it does not exist yet. Conflicting writes are
+// retried on a fresh copy.
+func Write(ctx context.Context, c client.Client, key types.NamespacedName, newConfigMap func() *corev1.ConfigMap, mutate func(*corev1.ConfigMap)) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		cm := &corev1.ConfigMap{}
+		err := c.Get(ctx, key, cm)
+		if apierrors.IsNotFound(err) {
+			cm = newConfigMap()
+			mutate(cm)
+			err = c.Create(ctx, cm)
+			if apierrors.IsAlreadyExists(err) {
+				// Written concurrently since the read; write to that copy.
+				return apierrors.NewConflict(corev1.Resource("configmaps"), key.Name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		mutate(cm)
+		return c.Update(ctx, cm)
+	})
+}
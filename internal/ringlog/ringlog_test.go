@@ -0,0 +1,61 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package ringlog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+type line struct {
+	N int `json:"n"`
+}
+
+func TestAppend_DropsOldestLinesBeyondMaxSize(t *testing.T) {
+	cm := &corev1.ConfigMap{}
+	Append(cm, "log.jsonl", [][]byte{[]byte(`{"n":1}` + "\n"), []byte(`{"n":2}` + "\n")}, 16, 0)
+	assert.Equal(t, 0, Dropped(cm))
+
+	// One line was already dropped from the caller's buffer.
+	Append(cm, "log.jsonl", [][]byte{[]byte(`{"n":3}` + "\n")}, 16, 1)
+	assert.Equal(t, []line{{N: 2}, {N: 3}}, Decode[line](cm, "log.jsonl"))
+	assert.Equal(t, 2, Dropped(cm))
+	assert.Equal(t, "2", cm.Annotations[wellknown.AnnotationLogDropped])
+}
+
+func TestDecode_SkipsMalformedLines(t *testing.T) {
+	cm := &corev1.ConfigMap{Data: map[string]string{"log.jsonl": "{\"n\":1}\nnot json\n{\"n\":2}\n"}}
+	assert.Equal(t, []line{{N: 1}, {N: 2}}, Decode[line](cm, "log.jsonl"))
+	assert.Empty(t, Decode[line](&corev1.ConfigMap{}, "log.jsonl"))
+}
+
+func TestWrite_CreatesThenUpdates(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	key := types.NamespacedName{Namespace: "team-a", Name: "dev-audit"}
+	newConfigMap := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+	}
+
+	for _, l := range []string{`{"n":1}`, `{"n":2}`} {
+		require.NoError(t, Write(ctx, c, key, newConfigMap, func(cm *corev1.ConfigMap) {
+			Append(cm, "log.jsonl", [][]byte{[]byte(l + "\n")}, 1024, 0)
+		}))
+	}
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(ctx, key, cm))
+	assert.Equal(t, []line{{N: 1}, {N: 2}}, Decode[line](cm, "log.jsonl"))
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package runnerimage decides which runner images targets may select through
+// spec.targets[].runnerImage. The runner image runs with the runner
+// ServiceAccount, which holds the cloud credentials of the executors and a
+// token the streaming servers trust, so only images the controller operator
+// allowed may be selected.
+package runnerimage
+
+import "strings"
+
+// Allowlist holds the images targets may select. An entry ending in "/" is a
+// registry or repository prefix that allows every image below it. Any other
+// entry allows that image, and any tag or digest of it when it has neither.
+// An empty Allowlist allows no image.
+type Allowlist []string
+
+// Allows reports whether image may be selected.
+func (a Allowlist) Allows(image string) bool {
+	for _, entry := range a {
+		switch {
+		case entry == "":
+			continue
+		case strings.HasSuffix(entry, "/"):
+			if strings.HasPrefix(image, entry) {
+				return true
+			}
+		case image == entry,
+			strings.HasPrefix(image, entry+":") && !hasReference(entry),
+			strings.HasPrefix(image, entry+"@") && !hasReference(entry):
+			return true
+		}
+	}
+	return false
+}
+
+// hasReference reports whether image already names a tag or digest. A colon
+// before the last slash is the port of the registry.
+func hasReference(image string) bool {
+	if strings.Contains(image, "@") {
+		return true
+	}
+	return strings.LastIndex(image, ":") > strings.LastIndex(image, "/")
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package runnerimage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowlist_Allows(t *testing.T) {
+	allowlist := Allowlist{
+		"ghcr.io/ardikabs/",
+		"registry.internal:5000/runners/rds-runner",
+		"docker.io/acme/runner:v1",
+	}
+
+	tests := []struct {
+		image string
+		want  bool
+	}{
+		{image: "ghcr.io/ardikabs/hibernator-runner:v0.2.0", want: true},
+		{image: "ghcr.io/ardikabs-evil/runner:v1", want: false},
+		{image: "registry.internal:5000/runners/rds-runner", want: true},
+		{image: "registry.internal:5000/runners/rds-runner:v2", want: true},
+		{image: "registry.internal:5000/runners/rds-runner@sha256:abc", want: true},
+		{image: "registry.internal:5000/runners/rds-runner-evil:v2", want: false},
+		{image: "docker.io/acme/runner:v1", want: true},
+		{image: "docker.io/acme/runner:v2", want: false},
+		{image: "docker.io/acme/runner:v1-evil", want: false},
+		{image: "evil.io/runner:v1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			assert.Equal(t, tt.want, allowlist.Allows(tt.image))
+		})
+	}
+
+	assert.False(t, Allowlist(nil).Allows("ghcr.io/ardikabs/hibernator-runner:v0.2.0"))
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// DateLayout is the format of the dates of a DateWindow.
+const DateLayout = "2006-01-02"
+
+// DateWindow is a one-off off-hour window covering whole calendar days, from
+// the start of Start to the end of End in the schedule's timezone.
+type DateWindow struct {
+	Start string // YYYY-MM-DD format (e.g., "2026-12-24")
+	End   string // YYYY-MM-DD format, inclusive; empty means Start
+}
+
+// dateInterval is a DateWindow resolved to absolute times; End is exclusive.
+type dateInterval struct {
+	Start, End time.Time
+}
+
+// resolveDateWindows resolves windows in loc, sorted by start and with
+// overlapping or adjacent windows merged.
+func resolveDateWindows(windows []DateWindow, loc *time.Location) ([]dateInterval, error) {
+	intervals := make([]dateInterval, 0, len(windows))
+	for _, w := range windows {
+		start, err := time.ParseInLocation(DateLayout, w.Start, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date window start %q: %w", w.Start, err)
+		}
+		end := start
+		if w.End != "" {
+			if end, err = time.ParseInLocation(DateLayout, w.End, loc); err != nil {
+				return nil, fmt.Errorf("invalid date window end %q: %w", w.End, err)
+			}
+		}
+		if end.Before(start) {
+			return nil, fmt.Errorf("date window ends (%s) before it starts (%s)", w.End, w.Start)
+		}
+		intervals = append(intervals, dateInterval{Start: start, End: end.AddDate(0, 0, 1)})
+	}
+
+	slices.SortFunc(intervals, func(a, b dateInterval) int { return a.Start.Compare(b.Start) })
+
+	var merged []dateInterval
+	for _, iv := range intervals {
+		if n := len(merged); n > 0 && !iv.Start.After(merged[n-1].End) {
+			if iv.End.After(merged[n-1].End) {
+				merged[n-1].End = iv.End
+			}
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged, nil
+}
+
+// applyDates combines a pre-computed result of the weekly windows with date
+// windows using a union (OR), like applyExtend: hibernation occurs when either
+// the weekly windows or a date window say hibernate.
+func (e *ScheduleEvaluator) applyDates(baseResult *EvaluationResult, windows []OffHourWindow, dates []DateWindow, timezone string) (*EvaluationResult, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %s: %w", timezone, err)
+	}
+	intervals, err := resolveDateWindows(dates, loc)
+	if err != nil {
+		return nil, err
+	}
+	now := e.Clock.Now().In(loc)
+
+	result := *baseResult
+	for _, iv := range intervals {
+		if iv.Start.After(now) {
+			result.NextHibernateTime = earlierTime(result.NextHibernateTime, iv.Start)
+			break
+		}
+	}
+
+	covering := func(t time.Time) *dateInterval {
+		for i := range intervals {
+			if !t.Before(intervals[i].Start) && t.Before(intervals[i].End) {
+				return &intervals[i]
+			}
+		}
+		return nil
+	}
+
+	if iv := covering(now); iv != nil {
+		result.ShouldHibernate = true
+		result.CurrentState = "hibernated"
+		result.InGracePeriod = false
+		result.GracePeriodEnd = time.Time{}
+		result.NextWakeUpTime, err = e.wakeUpAfter(iv.End, windows, timezone)
+		return &result, err
+	}
+
+	// A weekly wakeup landing inside a date window is skipped, as in applyExtend.
+	if next := result.NextWakeUpTime; !next.IsZero() {
+		if iv := covering(next.In(loc)); iv != nil {
+			result.NextWakeUpTime, err = e.wakeUpAfter(iv.End, windows, timezone)
+		}
+	}
+	return &result, err
+}
+
+// wakeUpAfter returns the wakeup that follows the end of a date window at t:
+// t itself, unless the weekly windows are hibernating at t and wake up later.
+func (e *ScheduleEvaluator) wakeUpAfter(t time.Time, windows []OffHourWindow, timezone string) (time.Time, error) {
+	at := *e
+	at.Clock = fixedClock{Clock: e.Clock, t: t}
+	result, err := at.evaluateWindows(windows, timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !result.ShouldHibernate {
+		return t, nil
+	}
+	return result.NextWakeUpTime, nil
+}
+
+// fixedClock reads t as the current time.
+type fixedClock struct {
+	clock.Clock
+	t time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.t
+}
+
+func (c fixedClock) Since(t time.Time) time.Duration {
+	return c.t.Sub(t)
+}
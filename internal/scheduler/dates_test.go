@@ -0,0 +1,111 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestEvaluateWithDates(t *testing.T) {
+	weekdays := []OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON", "TUE", "WED", "THU", "FRI"}}}
+	// Thursday Dec 24 to Saturday Jan 2, plus an adjacent Sunday Jan 3.
+	dates := []DateWindow{{Start: "2026-12-24", End: "2027-01-02"}, {Start: "2027-01-03"}}
+
+	tests := []struct {
+		name          string
+		now           time.Time
+		wantHibernate bool
+		wantNextHib   time.Time
+		wantNextWake  time.Time
+	}{
+		{
+			name:        "weekday before the date window",
+			now:         time.Date(2026, 12, 23, 12, 0, 0, 0, time.UTC),
+			wantNextHib: time.Date(2026, 12, 23, 20, 0, 0, 0, time.UTC),
+			// The weekly 06:00 wakeup on Dec 24 falls inside the date window,
+			// which ends while the weekday schedule keeps the weekend hibernated.
+			wantNextWake: time.Date(2027, 1, 4, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "weekly night running into the date window",
+			now:           time.Date(2026, 12, 23, 22, 0, 0, 0, time.UTC),
+			wantHibernate: true,
+			wantNextHib:   time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC),
+			wantNextWake:  time.Date(2027, 1, 4, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "date window ending inside the weekend",
+			now:           time.Date(2027, 1, 3, 12, 0, 0, 0, time.UTC),
+			wantHibernate: true,
+			wantNextHib:   time.Date(2027, 1, 4, 20, 0, 0, 0, time.UTC),
+			wantNextWake:  time.Date(2027, 1, 4, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "daytime inside the date window",
+			now:           time.Date(2026, 12, 30, 12, 0, 0, 0, time.UTC),
+			wantHibernate: true,
+			wantNextHib:   time.Date(2026, 12, 30, 20, 0, 0, 0, time.UTC),
+			wantNextWake:  time.Date(2027, 1, 4, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "after the date window",
+			now:          time.Date(2027, 1, 4, 12, 0, 0, 0, time.UTC),
+			wantNextHib:  time.Date(2027, 1, 4, 20, 0, 0, 0, time.UTC),
+			wantNextWake: time.Date(2027, 1, 5, 6, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eval := NewScheduleEvaluator(clocktesting.NewFakeClock(tt.now))
+			result, err := eval.EvaluateWithDates(weekdays, dates, "UTC", nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHibernate, result.ShouldHibernate)
+			assert.True(t, tt.wantNextHib.Equal(result.NextHibernateTime), "next hibernate %s, want %s", result.NextHibernateTime, tt.wantNextHib)
+			assert.True(t, tt.wantNextWake.Equal(result.NextWakeUpTime), "next wakeup %s, want %s", result.NextWakeUpTime, tt.wantNextWake)
+		})
+	}
+}
+
+func TestEvaluateWithDates_SuspendAndReplace(t *testing.T) {
+	weekdays := []OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON", "TUE", "WED", "THU", "FRI"}}}
+	dates := []DateWindow{{Start: "2026-12-24", End: "2026-12-26"}}
+	now := time.Date(2026, 12, 24, 12, 0, 0, 0, time.UTC)
+	eval := NewScheduleEvaluator(clocktesting.NewFakeClock(now))
+
+	suspend := &Exception{
+		Type:       ExceptionSuspend,
+		ValidFrom:  now.Add(-time.Hour),
+		ValidUntil: now.Add(time.Hour),
+		Windows:    []OffHourWindow{{Start: "09:00", End: "17:00", DaysOfWeek: []string{"THU"}}},
+	}
+	result, err := eval.EvaluateWithDates(weekdays, dates, "UTC", []*Exception{suspend})
+	require.NoError(t, err)
+	assert.False(t, result.ShouldHibernate, "suspensions carve out date windows too")
+
+	replace := &Exception{
+		Type:       ExceptionReplace,
+		ValidFrom:  now.Add(-time.Hour),
+		ValidUntil: now.Add(time.Hour),
+		Windows:    []OffHourWindow{{Start: "22:00", End: "05:00", DaysOfWeek: []string{"THU"}}},
+	}
+	result, err = eval.EvaluateWithDates(weekdays, dates, "UTC", []*Exception{replace})
+	require.NoError(t, err)
+	assert.False(t, result.ShouldHibernate, "a replace exception substitutes the date windows")
+}
+
+func TestResolveDateWindows_Invalid(t *testing.T) {
+	_, err := resolveDateWindows([]DateWindow{{Start: "2026-12-31", End: "2026-12-24"}}, time.UTC)
+	assert.ErrorContains(t, err, "before it starts")
+
+	_, err = resolveDateWindows([]DateWindow{{Start: "24 Dec"}}, time.UTC)
+	assert.ErrorContains(t, err, "invalid date window start")
+}
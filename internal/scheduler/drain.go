@@ -0,0 +1,156 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+// DrainRole classifies a target for node-drain aware ordering.
+type DrainRole int
+
+const (
+	// DrainRoleNone marks targets that do not take part in drain ordering.
+	DrainRoleNone DrainRole = iota
+	// DrainRoleWorkload marks targets that scale workloads running on a cluster.
+	DrainRoleWorkload
+	// DrainRoleNode marks targets that scale the nodes of a cluster.
+	DrainRoleNode
+)
+
+// DrainRoleOf returns the drain role of an executor type.
+func DrainRoleOf(executorType string) DrainRole {
+	switch executorType {
+	case "workloadscaler":
+		return DrainRoleWorkload
+	case "eks", "karpenter", "gke":
+		return DrainRoleNode
+	default:
+		return DrainRoleNone
+	}
+}
+
+// DrainTarget describes a target's drain role and the cluster it acts on.
+type DrainTarget struct {
+	Name    string
+	Role    DrainRole
+	Cluster string
+}
+
+// DrainDependencies returns the shutdown edges that scale workloads down
+// before the nodes of the same cluster. Targets without a role or cluster are
+// ignored. Wakeup uses the same edges reversed.
+func DrainDependencies(targets []DrainTarget) []Dependency {
+	var deps []Dependency
+	for _, w := range targets {
+		if w.Role != DrainRoleWorkload || w.Cluster == "" {
+			continue
+		}
+		for _, n := range targets {
+			if n.Role == DrainRoleNode && n.Cluster == w.Cluster {
+				deps = append(deps, Dependency{From: w.Name, To: n.Name})
+			}
+		}
+	}
+	return deps
+}
+
+// MergeDependencies adds the implicit edges to the explicit ones. An implicit
+// edge is skipped when the graph already orders its targets the other way,
+// so user-defined ordering always wins and no cycle is introduced. The
+// skipped edges are returned so callers can report the contradiction.
+func MergeDependencies(explicit, implicit []Dependency) (merged, skipped []Dependency) {
+	adj := make(map[string][]string)
+	merged = make([]Dependency, 0, len(explicit)+len(implicit))
+	for _, d := range explicit {
+		adj[d.From] = append(adj[d.From], d.To)
+		merged = append(merged, d)
+	}
+
+	for _, d := range implicit {
+		if reachable(adj, d.To, d.From) {
+			skipped = append(skipped, d)
+			continue
+		}
+		if reachable(adj, d.From, d.To) {
+			continue
+		}
+		adj[d.From] = append(adj[d.From], d.To)
+		merged = append(merged, d)
+	}
+	return merged, skipped
+}
+
+// reachable reports whether to can be reached from from.
+func reachable(adj map[string][]string, from, to string) bool {
+	seen := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur == to {
+			return true
+		}
+		for _, next := range adj[cur] {
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// DrainConflict is a workload target that the shutdown plan does not run
+// strictly before a node target of the same cluster.
+type DrainConflict struct {
+	Workload string
+	Node     string
+	Cluster  string
+	// Concurrent is true when both targets may run at the same time rather
+	// than the node target running first.
+	Concurrent bool
+}
+
+// DrainConflicts returns the workload/node pairs that plan runs out of drain
+// order. Targets of a stage run in list order when the stage's concurrency is
+// one, and concurrently otherwise.
+func DrainConflicts(plan ExecutionPlan, targets []DrainTarget) []DrainConflict {
+	type position struct {
+		stage, index int
+		sequential   bool
+	}
+	positions := make(map[string]position)
+	for i, stage := range plan.Stages {
+		for j, t := range stage.Targets {
+			positions[t] = position{stage: i, index: j, sequential: stage.MaxConcurrency == 1}
+		}
+	}
+
+	byName := make(map[string]DrainTarget, len(targets))
+	for _, t := range targets {
+		byName[t.Name] = t
+	}
+
+	var conflicts []DrainConflict
+	for _, d := range DrainDependencies(targets) {
+		w, okW := positions[d.From]
+		n, okN := positions[d.To]
+		if !okW || !okN {
+			continue
+		}
+
+		conflict := DrainConflict{Workload: d.From, Node: d.To, Cluster: byName[d.From].Cluster}
+		switch {
+		case w.stage < n.stage:
+			continue
+		case w.stage > n.stage:
+			conflicts = append(conflicts, conflict)
+		case !w.sequential:
+			conflict.Concurrent = true
+			conflicts = append(conflicts, conflict)
+		case n.index < w.index:
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func drainFixture() []DrainTarget {
+	return []DrainTarget{
+		{Name: "apps", Role: DrainRoleWorkload, Cluster: "eks/prod"},
+		{Name: "nodegroups", Role: DrainRoleNode, Cluster: "eks/prod"},
+		{Name: "karpenter", Role: DrainRoleNode, Cluster: "eks/prod"},
+		{Name: "staging-nodes", Role: DrainRoleNode, Cluster: "eks/staging"},
+		{Name: "unknown-apps", Role: DrainRoleWorkload},
+	}
+}
+
+func TestDrainRoleOf(t *testing.T) {
+	cases := map[string]DrainRole{
+		"workloadscaler": DrainRoleWorkload,
+		"eks":            DrainRoleNode,
+		"karpenter":      DrainRoleNode,
+		"gke":            DrainRoleNode,
+		"rds":            DrainRoleNone,
+	}
+	for typ, want := range cases {
+		if got := DrainRoleOf(typ); got != want {
+			t.Errorf("DrainRoleOf(%q) = %v, want %v", typ, got, want)
+		}
+	}
+}
+
+func TestDrainDependencies_SameClusterOnly(t *testing.T) {
+	got := DrainDependencies(drainFixture())
+	want := []Dependency{
+		{From: "apps", To: "nodegroups"},
+		{From: "apps", To: "karpenter"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DrainDependencies() = %v, want %v", got, want)
+	}
+}
+
+func TestDrainDependencies_ParallelPlanScalesWorkloadsFirst(t *testing.T) {
+	p := NewPlanner()
+	targets := []string{"nodegroups", "apps", "rds", "karpenter"}
+	plan, err := p.PlanDAG(targets, DrainDependencies(drainFixture()), 0)
+	if err != nil {
+		t.Fatalf("PlanDAG() error = %v", err)
+	}
+
+	if len(plan.Stages) != 2 {
+		t.Fatalf("expected 2 stages, got %d", len(plan.Stages))
+	}
+	if want := []string{"apps", "rds"}; !reflect.DeepEqual(plan.Stages[0].Targets, want) {
+		t.Errorf("stage 0: expected %v, got %v", want, plan.Stages[0].Targets)
+	}
+	if want := []string{"karpenter", "nodegroups"}; !reflect.DeepEqual(plan.Stages[1].Targets, want) {
+		t.Errorf("stage 1: expected %v, got %v", want, plan.Stages[1].Targets)
+	}
+}
+
+func TestMergeDependencies_ExplicitOrderWins(t *testing.T) {
+	explicit := []Dependency{
+		{From: "karpenter", To: "db"},
+		{From: "db", To: "apps"},
+	}
+	implicit := []Dependency{
+		{From: "apps", To: "nodegroups"},
+		{From: "apps", To: "karpenter"},
+	}
+
+	merged, skipped := MergeDependencies(explicit, implicit)
+
+	wantMerged := []Dependency{explicit[0], explicit[1], implicit[0]}
+	if !reflect.DeepEqual(merged, wantMerged) {
+		t.Errorf("merged = %v, want %v", merged, wantMerged)
+	}
+	if want := []Dependency{implicit[1]}; !reflect.DeepEqual(skipped, want) {
+		t.Errorf("skipped = %v, want %v", skipped, want)
+	}
+
+	if err := NewPlanner().ValidateDAG([]string{"karpenter", "db", "apps", "nodegroups"}, merged); err != nil {
+		t.Errorf("merged dependencies must stay acyclic: %v", err)
+	}
+}
+
+func TestMergeDependencies_SkipsRedundantEdges(t *testing.T) {
+	explicit := []Dependency{{From: "apps", To: "db"}, {From: "db", To: "nodegroups"}}
+	merged, skipped := MergeDependencies(explicit, []Dependency{{From: "apps", To: "nodegroups"}})
+
+	if !reflect.DeepEqual(merged, explicit) {
+		t.Errorf("merged = %v, want %v", merged, explicit)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("skipped = %v, want none", skipped)
+	}
+}
+
+func TestDrainConflicts(t *testing.T) {
+	p := NewPlanner()
+	targets := drainFixture()
+
+	tests := []struct {
+		name string
+		plan ExecutionPlan
+		want []DrainConflict
+	}{
+		{
+			name: "sequential in drain order",
+			plan: p.PlanSequential([]string{"apps", "nodegroups", "karpenter"}),
+		},
+		{
+			name: "sequential node first",
+			plan: p.PlanSequential([]string{"nodegroups", "apps", "karpenter"}),
+			want: []DrainConflict{{Workload: "apps", Node: "nodegroups", Cluster: "eks/prod"}},
+		},
+		{
+			name: "sequential stage keeps list order",
+			plan: p.PlanStaged([]Stage{{Name: "all", Targets: []string{"karpenter", "apps", "nodegroups"}}}, 0),
+			want: []DrainConflict{{Workload: "apps", Node: "karpenter", Cluster: "eks/prod"}},
+		},
+		{
+			name: "parallel stage runs together",
+			plan: p.PlanStaged([]Stage{
+				{Name: "all", Parallel: true, Targets: []string{"apps", "nodegroups"}},
+				{Name: "rest", Targets: []string{"karpenter"}},
+			}, 0),
+			want: []DrainConflict{{Workload: "apps", Node: "nodegroups", Cluster: "eks/prod", Concurrent: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DrainConflicts(tt.plan, targets)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DrainConflicts() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
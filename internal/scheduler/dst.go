@@ -0,0 +1,160 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// DSTPolicy defines how window boundaries at a local time that a daylight saving
+// time change skips or repeats are resolved.
+type DSTPolicy string
+
+const (
+	// DSTShiftForward moves a boundary in a skipped hour forward by the length of
+	// the gap, e.g. 02:30 becomes 03:30, and runs a boundary in a repeated hour
+	// once, at its first occurrence. It is the default.
+	DSTShiftForward DSTPolicy = "ShiftForward"
+
+	// DSTSkip skips a boundary in a skipped or repeated hour on the day of the change.
+	DSTSkip DSTPolicy = "Skip"
+)
+
+// cronStarBit marks a cron field given as "*", as set by the cron parser.
+const cronStarBit = 1 << 63
+
+// WithDSTPolicy sets how boundaries across daylight saving time changes are
+// resolved. An empty policy means DSTShiftForward.
+func WithDSTPolicy(policy DSTPolicy) ScheduleEvaluatorOption {
+	return func(se *ScheduleEvaluator) {
+		se.dstPolicy = policy
+	}
+}
+
+// With returns a copy of the evaluator with opts applied, e.g. to evaluate a
+// plan with its own DST policy on an evaluator shared across plans.
+func (e *ScheduleEvaluator) With(opts ...ScheduleEvaluatorOption) *ScheduleEvaluator {
+	se := *e
+	for _, o := range opts {
+		o(&se)
+	}
+	return &se
+}
+
+// parse parses a cron expression generated by ParseWindowToCron, resolving its
+// occurrences across daylight saving time changes with the evaluator's policy.
+func (e *ScheduleEvaluator) parse(spec string) (cron.Schedule, error) {
+	sched, err := e.parser.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := sched.(*cron.SpecSchedule); ok {
+		return dstSchedule{spec: s, policy: e.dstPolicy}, nil
+	}
+	return sched, nil
+}
+
+// dstSchedule computes the occurrences of a cron schedule day by day in local
+// wall-clock time. Unlike cron.SpecSchedule, which silently drops occurrences in
+// a skipped hour and fires twice in a repeated one, it resolves them with policy.
+type dstSchedule struct {
+	spec   *cron.SpecSchedule
+	policy DSTPolicy
+}
+
+// Next returns the first occurrence after t, or the zero time if there is none
+// within five years.
+func (s dstSchedule) Next(t time.Time) time.Time {
+	loc := s.spec.Location
+	if loc == time.Local {
+		loc = t.Location()
+	}
+	local := t.In(loc)
+	first := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i <= 5*366; i++ {
+		day := first.AddDate(0, 0, i)
+		if !s.dayMatches(day) {
+			continue
+		}
+
+		var next time.Time
+		for hour := 0; hour < 24; hour++ {
+			if s.spec.Hour&(1<<uint(hour)) == 0 {
+				continue
+			}
+			for minute := 0; minute < 60; minute++ {
+				if s.spec.Minute&(1<<uint(minute)) == 0 {
+					continue
+				}
+				at, ok := resolveLocal(day.Year(), day.Month(), day.Day(), hour, minute, loc, s.policy)
+				if ok && at.After(t) && (next.IsZero() || at.Before(next)) {
+					next = at
+				}
+			}
+		}
+		if !next.IsZero() {
+			return next.In(t.Location())
+		}
+	}
+	return time.Time{}
+}
+
+// dayMatches reports whether the schedule fires on the calendar day d, with the
+// cron day-of-month and day-of-week semantics of cron.SpecSchedule.
+func (s dstSchedule) dayMatches(d time.Time) bool {
+	if s.spec.Month&(1<<uint(d.Month())) == 0 {
+		return false
+	}
+	domMatch := s.spec.Dom&(1<<uint(d.Day())) > 0
+	dowMatch := s.spec.Dow&(1<<uint(d.Weekday())) > 0
+	if s.spec.Dom&cronStarBit > 0 || s.spec.Dow&cronStarBit > 0 {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// resolveLocal returns the instant the wall clock in loc reads hour:minute on
+// the given day. ok is false when policy skips a time that the day's daylight
+// saving time change skips or repeats.
+func resolveLocal(year int, month time.Month, day, hour, minute int, loc *time.Location, policy DSTPolicy) (time.Time, bool) {
+	wall := time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+
+	// The offsets in effect a day before and after the wall time differ only
+	// when a change happens in between; each gives one candidate instant.
+	_, offsetBefore := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, offsetAfter := wall.Add(24 * time.Hour).In(loc).Zone()
+	withBefore := wall.Add(-time.Duration(offsetBefore) * time.Second)
+	withAfter := wall.Add(-time.Duration(offsetAfter) * time.Second)
+
+	reads := func(t time.Time) bool {
+		l := t.In(loc)
+		return l.Day() == day && l.Hour() == hour && l.Minute() == minute
+	}
+	beforeOK, afterOK := reads(withBefore), reads(withAfter)
+
+	switch {
+	case beforeOK && afterOK && !withBefore.Equal(withAfter):
+		// Repeated: clocks moved back over the wall time.
+		if policy == DSTSkip {
+			return time.Time{}, false
+		}
+		return withBefore, true
+	case beforeOK:
+		return withBefore, true
+	case afterOK:
+		return withAfter, true
+	default:
+		// Skipped: clocks moved forward over the wall time. Read with the
+		// offset before the change, the instant falls the gap later.
+		if policy == DSTSkip {
+			return time.Time{}, false
+		}
+		return withBefore, true
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestResolveLocal(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		day    time.Time
+		hour   int
+		minute int
+		policy DSTPolicy
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name: "regular day",
+			day:  time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC), hour: 2, minute: 30,
+			want: time.Date(2026, 3, 7, 7, 30, 0, 0, time.UTC), wantOK: true,
+		},
+		{
+			name: "skipped time shifts forward",
+			day:  time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), hour: 2, minute: 30,
+			want: time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC), wantOK: true, // 03:30 EDT
+		},
+		{
+			name: "skipped time is skipped",
+			day:  time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), hour: 2, minute: 30,
+			policy: DSTSkip,
+		},
+		{
+			name: "repeated time resolves to its first occurrence",
+			day:  time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), hour: 1, minute: 30,
+			want: time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC), wantOK: true, // 01:30 EDT
+		},
+		{
+			name: "repeated time is skipped",
+			day:  time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), hour: 1, minute: 30,
+			policy: DSTSkip,
+		},
+		{
+			name: "time after the change",
+			day:  time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), hour: 6, minute: 0,
+			policy: DSTSkip,
+			want:   time.Date(2026, 11, 1, 11, 0, 0, 0, time.UTC), wantOK: true, // 06:00 EST
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := resolveLocal(tt.day.Year(), tt.day.Month(), tt.day.Day(), tt.hour, tt.minute, loc, tt.policy)
+			require.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.True(t, tt.want.Equal(got), "got %s, want %s", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate_DSTTransitions(t *testing.T) {
+	everyDay := []string{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}
+
+	tests := []struct {
+		name          string
+		window        OffHourWindow
+		now           time.Time
+		policy        DSTPolicy
+		wantHibernate bool
+		wantNextHib   time.Time
+		wantNextWake  time.Time
+	}{
+		{
+			name:         "spring forward: skipped start shifts forward",
+			window:       OffHourWindow{Start: "02:30", End: "06:00", DaysOfWeek: everyDay},
+			now:          time.Date(2026, 3, 8, 6, 0, 0, 0, time.UTC),  // 01:00 EST
+			wantNextHib:  time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC), // 03:30 EDT
+			wantNextWake: time.Date(2026, 3, 8, 10, 0, 0, 0, time.UTC), // 06:00 EDT
+		},
+		{
+			name:          "spring forward: hibernating after the shifted start",
+			window:        OffHourWindow{Start: "02:30", End: "06:00", DaysOfWeek: everyDay},
+			now:           time.Date(2026, 3, 8, 8, 0, 0, 0, time.UTC), // 04:00 EDT
+			wantHibernate: true,
+			wantNextHib:   time.Date(2026, 3, 9, 6, 30, 0, 0, time.UTC),
+			wantNextWake:  time.Date(2026, 3, 8, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "spring forward: skipped start is skipped",
+			window:       OffHourWindow{Start: "02:30", End: "06:00", DaysOfWeek: everyDay},
+			now:          time.Date(2026, 3, 8, 8, 0, 0, 0, time.UTC), // 04:00 EDT
+			policy:       DSTSkip,
+			wantNextHib:  time.Date(2026, 3, 9, 6, 30, 0, 0, time.UTC), // 02:30 EDT
+			wantNextWake: time.Date(2026, 3, 8, 10, 0, 0, 0, time.UTC), // 06:00 EDT
+		},
+		{
+			name:          "fall back: repeated start runs once",
+			window:        OffHourWindow{Start: "01:30", End: "06:00", DaysOfWeek: everyDay},
+			now:           time.Date(2026, 11, 1, 6, 15, 0, 0, time.UTC), // 01:15 EST, after 01:30 EDT
+			wantHibernate: true,
+			wantNextHib:   time.Date(2026, 11, 2, 6, 30, 0, 0, time.UTC), // 01:30 EST
+			wantNextWake:  time.Date(2026, 11, 1, 11, 0, 0, 0, time.UTC), // 06:00 EST
+		},
+		{
+			name:         "fall back: repeated start is skipped",
+			window:       OffHourWindow{Start: "01:30", End: "06:00", DaysOfWeek: everyDay},
+			now:          time.Date(2026, 11, 1, 6, 15, 0, 0, time.UTC),
+			policy:       DSTSkip,
+			wantNextHib:  time.Date(2026, 11, 2, 6, 30, 0, 0, time.UTC),
+			wantNextWake: time.Date(2026, 11, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "overnight window keeps local wakeup across the change",
+			window:        OffHourWindow{Start: "20:00", End: "06:00", DaysOfWeek: everyDay},
+			now:           time.Date(2026, 11, 1, 3, 0, 0, 0, time.UTC), // Oct 31 23:00 EDT
+			wantHibernate: true,
+			wantNextHib:   time.Date(2026, 11, 2, 1, 0, 0, 0, time.UTC),  // Nov 1 20:00 EST
+			wantNextWake:  time.Date(2026, 11, 1, 11, 0, 0, 0, time.UTC), // 06:00 EST
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eval := NewScheduleEvaluator(clocktesting.NewFakeClock(tt.now)).With(WithDSTPolicy(tt.policy))
+			result, err := eval.Evaluate([]OffHourWindow{tt.window}, "America/New_York", nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHibernate, result.ShouldHibernate)
+			assert.True(t, tt.wantNextHib.Equal(result.NextHibernateTime), "next hibernate %s, want %s", result.NextHibernateTime.UTC(), tt.wantNextHib)
+			assert.True(t, tt.wantNextWake.Equal(result.NextWakeUpTime), "next wakeup %s, want %s", result.NextWakeUpTime.UTC(), tt.wantNextWake)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"hash/fnv"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// JitterDelay returns the delay, in whole seconds within the jitter window
+// (a duration string, e.g. "5m"), applied to the schedule boundaries of the
+// plan identified by key, typically "namespace/name". It is derived from key
+// alone, so a plan keeps the same delay every cycle while plans sharing a
+// schedule spread over the window. It is zero for an empty or invalid window.
+func JitterDelay(key, jitter string) time.Duration {
+	window, err := time.ParseDuration(jitter)
+	if err != nil || window <= 0 {
+		return 0
+	}
+	seconds := uint64(window / time.Second)
+	if seconds == 0 {
+		return 0
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return time.Duration(h.Sum64()%seconds) * time.Second
+}
+
+// EvaluateDelayed evaluates the schedule like EvaluateWithDates, with every
+// boundary of the windows and exceptions delayed by delay.
+func (e *ScheduleEvaluator) EvaluateDelayed(delay time.Duration, baseWindows []OffHourWindow, dates []DateWindow, timezone string, exceptions []*Exception) (*EvaluationResult, error) {
+	if delay <= 0 {
+		return e.EvaluateWithDates(baseWindows, dates, timezone, exceptions)
+	}
+
+	// Evaluating at now-delay and shifting the result forward is the same as
+	// evaluating at now with every boundary shifted by delay.
+	delayed := *e
+	delayed.Clock = delayedClock{Clock: e.Clock, delay: delay}
+
+	result, err := delayed.EvaluateWithDates(baseWindows, dates, timezone, exceptions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range []*time.Time{&result.NextHibernateTime, &result.NextWakeUpTime, &result.GracePeriodEnd} {
+		if !t.IsZero() {
+			*t = t.Add(delay)
+		}
+	}
+	return result, nil
+}
+
+// delayedClock reads the time of Clock delay ago.
+type delayedClock struct {
+	clock.Clock
+	delay time.Duration
+}
+
+func (c delayedClock) Now() time.Time {
+	return c.Clock.Now().Add(-c.delay)
+}
+
+func (c delayedClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
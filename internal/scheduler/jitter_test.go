@@ -0,0 +1,78 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestJitterDelay(t *testing.T) {
+	assert.Zero(t, JitterDelay("default/p", ""))
+	assert.Zero(t, JitterDelay("default/p", "invalid"))
+	assert.Zero(t, JitterDelay("default/p", "500ms"))
+	assert.Equal(t, JitterDelay("default/p", "10m"), JitterDelay("default/p", "10m"), "the delay is stable per plan")
+
+	delays := make(map[time.Duration]struct{})
+	for i := range 20 {
+		d := JitterDelay(fmt.Sprintf("team-%d/nightly", i), "10m")
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.Less(t, d, 10*time.Minute)
+		assert.Zero(t, d%time.Second)
+		delays[d] = struct{}{}
+	}
+	assert.Greater(t, len(delays), 1, "plans sharing a schedule are spread over the window")
+}
+
+func TestEvaluateDelayed(t *testing.T) {
+	windows := []OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON", "TUE", "WED", "THU", "FRI"}}}
+	delay := 5 * time.Minute
+
+	tests := []struct {
+		name          string
+		now           time.Time
+		wantHibernate bool
+		wantNext      time.Time
+	}{
+		{
+			name:     "before the delayed hibernation",
+			now:      time.Date(2026, 1, 28, 20, 3, 0, 0, time.UTC),
+			wantNext: time.Date(2026, 1, 28, 20, 5, 0, 0, time.UTC),
+		},
+		{
+			name:          "after the delayed hibernation",
+			now:           time.Date(2026, 1, 28, 20, 6, 0, 0, time.UTC),
+			wantHibernate: true,
+			wantNext:      time.Date(2026, 1, 29, 6, 5, 0, 0, time.UTC),
+		},
+		{
+			name:          "before the delayed wakeup",
+			now:           time.Date(2026, 1, 29, 6, 3, 0, 0, time.UTC),
+			wantHibernate: true,
+			wantNext:      time.Date(2026, 1, 29, 6, 5, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eval := NewScheduleEvaluator(clocktesting.NewFakeClock(tt.now))
+			result, err := eval.EvaluateDelayed(delay, windows, nil, "UTC", nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHibernate, result.ShouldHibernate)
+
+			next := result.NextHibernateTime
+			if result.ShouldHibernate {
+				next = result.NextWakeUpTime
+			}
+			assert.True(t, tt.wantNext.Equal(next), "next event %s, want %s", next, tt.wantNext)
+		})
+	}
+}
@@ -40,6 +40,8 @@ type ExecutionPlan struct {
 
 // ExecutionStage is a group of targets that can execute together.
 type ExecutionStage struct {
+	// Name of the stage; set for Staged plans only.
+	Name string
 	// Targets to execute in this stage.
 	Targets []string
 	// MaxConcurrency limits parallelism (0 = unlimited).
@@ -172,6 +174,7 @@ func (p *Planner) PlanStaged(stages []Stage, globalMaxConcurrency int32) Executi
 			}
 		}
 		result[i] = ExecutionStage{
+			Name:           s.Name,
 			Targets:        s.Targets,
 			MaxConcurrency: mc,
 		}
@@ -150,4 +150,7 @@ func TestPlanStaged(t *testing.T) {
 	if plan.Stages[1].MaxConcurrency != 2 {
 		t.Errorf("stage 1: expected maxConcurrency=2, got %d", plan.Stages[1].MaxConcurrency)
 	}
+	if plan.Stages[0].Name != "storage" || plan.Stages[1].Name != "compute" {
+		t.Errorf("expected stage names to be kept, got %q and %q", plan.Stages[0].Name, plan.Stages[1].Name)
+	}
 }
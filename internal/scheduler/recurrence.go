@@ -0,0 +1,228 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// RecurrenceFrequency is the period a recurring exception repeats on.
+type RecurrenceFrequency string
+
+const (
+	// RecurWeekly repeats every Interval weeks on the ByDay weekdays.
+	RecurWeekly RecurrenceFrequency = "Weekly"
+	// RecurMonthly repeats every Interval months on the ByDay or ByMonthDay days.
+	RecurMonthly RecurrenceFrequency = "Monthly"
+)
+
+// maxRecurrenceLookahead bounds how far ahead Occurrence scans for the next
+// matching day. It covers a monthly rule with the largest allowed interval.
+const maxRecurrenceLookahead = 2 * 366
+
+// byDayPattern matches an RRULE BYDAY entry: an optional ordinal (1..5 or -1..-5)
+// followed by a weekday, e.g. "SAT", "1SAT", "-1FRI".
+var byDayPattern = regexp.MustCompile(`^(-?[1-5])?(MON|TUE|WED|THU|FRI|SAT|SUN)$`)
+
+// Recurrence is an RRULE-like rule repeating an exception window. Each occurrence
+// starts at the time of day of the exception's validFrom, on a day selected by the
+// rule, and lasts Duration. Occurrences never start before validFrom and are cut
+// off at validUntil.
+type Recurrence struct {
+	Frequency  RecurrenceFrequency
+	Interval   int
+	ByDay      []string // weekday, with an optional ordinal for Monthly ("1SAT", "-1FRI")
+	ByMonthDay []int    // 1..31, or -1..-31 counting from the end of the month
+	Duration   time.Duration
+	Location   *time.Location
+}
+
+// byDay is a parsed ByDay entry. Ordinal 0 means every such weekday.
+type byDay struct {
+	ordinal int
+	weekday time.Weekday
+}
+
+// ParseByDay parses a ByDay entry such as "SAT", "1SAT", or "-1FRI".
+func ParseByDay(s string) (ordinal int, weekday time.Weekday, err error) {
+	m := byDayPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, 0, fmt.Errorf("invalid day %q, expected an optional ordinal (1..5, -1..-5) followed by MON..SUN", s)
+	}
+	if m[1] != "" {
+		ordinal, _ = strconv.Atoi(m[1])
+	}
+	weekday, _ = parseWeekday(m[2])
+	return ordinal, weekday, nil
+}
+
+// Validate reports whether the rule is well formed.
+func (r Recurrence) Validate() error {
+	switch r.Frequency {
+	case RecurWeekly, RecurMonthly:
+	default:
+		return fmt.Errorf("unsupported frequency %q, expected Weekly or Monthly", r.Frequency)
+	}
+	if r.Interval < 1 {
+		return fmt.Errorf("interval must be at least 1")
+	}
+	if r.Duration <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+	for _, d := range r.ByDay {
+		ordinal, _, err := ParseByDay(d)
+		if err != nil {
+			return err
+		}
+		if ordinal != 0 && r.Frequency != RecurMonthly {
+			return fmt.Errorf("day %q: ordinals are only supported with Monthly frequency", d)
+		}
+	}
+	for _, d := range r.ByMonthDay {
+		if d == 0 || d < -31 || d > 31 {
+			return fmt.Errorf("invalid month day %d, expected 1..31 or -1..-31", d)
+		}
+		if r.Frequency != RecurMonthly {
+			return fmt.Errorf("month days are only supported with Monthly frequency")
+		}
+	}
+	return nil
+}
+
+// Occurrence returns the occurrence that contains now, or the next one to start
+// after now. ok is false when no further occurrence starts before until.
+func (r Recurrence) Occurrence(now, from, until time.Time) (start, end time.Time, ok bool) {
+	if r.Validate() != nil || !from.Before(until) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	loc := r.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	anchor := from.In(loc)
+	days, err := r.parseByDay()
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	// An occurrence that started up to Duration ago may still be running.
+	scanFrom := anchor
+	if earliest := now.Add(-r.Duration).In(loc); earliest.After(scanFrom) {
+		scanFrom = earliest
+	}
+	day := time.Date(scanFrom.Year(), scanFrom.Month(), scanFrom.Day(), 0, 0, 0, 0, loc)
+
+	for i := 0; i <= maxRecurrenceLookahead; i++ {
+		d := day.AddDate(0, 0, i)
+		start = time.Date(d.Year(), d.Month(), d.Day(), anchor.Hour(), anchor.Minute(), anchor.Second(), 0, loc)
+		if !start.Before(until) {
+			break
+		}
+		if start.Before(from) || !r.matches(d, anchor, days) {
+			continue
+		}
+		end = start.Add(r.Duration)
+		if end.After(until) {
+			end = until
+		}
+		if end.After(now) {
+			return start, end, true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+func (r Recurrence) parseByDay() ([]byDay, error) {
+	days := make([]byDay, 0, len(r.ByDay))
+	for _, s := range r.ByDay {
+		ordinal, weekday, err := ParseByDay(s)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, byDay{ordinal: ordinal, weekday: weekday})
+	}
+	return days, nil
+}
+
+// matches reports whether day d (midnight in the rule's location) is selected by
+// the rule anchored at anchor.
+func (r Recurrence) matches(d, anchor time.Time, days []byDay) bool {
+	switch r.Frequency {
+	case RecurWeekly:
+		weeks := int(weekStart(d).Sub(weekStart(anchor)).Hours()+12) / (24 * 7)
+		if weeks%r.Interval != 0 {
+			return false
+		}
+		if len(days) == 0 {
+			return d.Weekday() == anchor.Weekday()
+		}
+		for _, bd := range days {
+			if d.Weekday() == bd.weekday {
+				return true
+			}
+		}
+		return false
+
+	case RecurMonthly:
+		months := (d.Year()-anchor.Year())*12 + int(d.Month()) - int(anchor.Month())
+		if months%r.Interval != 0 {
+			return false
+		}
+		if len(days) == 0 && len(r.ByMonthDay) == 0 {
+			return d.Day() == anchor.Day()
+		}
+		// Like RRULE, ByDay and ByMonthDay narrow each other when both are set.
+		return (len(days) == 0 || matchesByDay(d, days)) &&
+			(len(r.ByMonthDay) == 0 || matchesMonthDay(d, r.ByMonthDay))
+	}
+	return false
+}
+
+func matchesByDay(d time.Time, days []byDay) bool {
+	last := daysInMonth(d)
+	for _, bd := range days {
+		if d.Weekday() != bd.weekday {
+			continue
+		}
+		switch {
+		case bd.ordinal == 0:
+			return true
+		case bd.ordinal > 0 && (d.Day()-1)/7+1 == bd.ordinal:
+			return true
+		case bd.ordinal < 0 && (last-d.Day())/7+1 == -bd.ordinal:
+			return true
+		}
+	}
+	return false
+}
+
+func matchesMonthDay(d time.Time, monthDays []int) bool {
+	last := daysInMonth(d)
+	for _, md := range monthDays {
+		if md > 0 && d.Day() == md {
+			return true
+		}
+		if md < 0 && d.Day() == last+md+1 {
+			return true
+		}
+	}
+	return false
+}
+
+// weekStart returns midnight of the Monday starting d's week.
+func weekStart(d time.Time) time.Time {
+	offset := (int(d.Weekday()) + 6) % 7
+	y, m, day := d.AddDate(0, 0, -offset).Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, d.Location())
+}
+
+func daysInMonth(d time.Time) int {
+	return time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location()).Day()
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurrence_Occurrence(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	require.NoError(t, err)
+
+	utc := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	yearFrom := utc(time.January, 1, 0)
+	yearUntil := utc(time.December, 31, 0)
+
+	firstWeekend := Recurrence{Frequency: RecurMonthly, Interval: 1, ByDay: []string{"1SAT"}, Duration: 48 * time.Hour}
+
+	tests := []struct {
+		name      string
+		rule      Recurrence
+		from      time.Time
+		until     time.Time
+		now       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantOK    bool
+	}{
+		{
+			name: "next first weekend", rule: firstWeekend, from: yearFrom, until: yearUntil,
+			now:       utc(time.January, 1, 12),
+			wantStart: utc(time.January, 3, 0), wantEnd: utc(time.January, 5, 0), wantOK: true,
+		},
+		{
+			name: "inside first weekend", rule: firstWeekend, from: yearFrom, until: yearUntil,
+			now:       utc(time.January, 4, 10),
+			wantStart: utc(time.January, 3, 0), wantEnd: utc(time.January, 5, 0), wantOK: true,
+		},
+		{
+			name: "rolls over to next month", rule: firstWeekend, from: yearFrom, until: yearUntil,
+			now:       utc(time.January, 5, 0),
+			wantStart: utc(time.February, 7, 0), wantEnd: utc(time.February, 9, 0), wantOK: true,
+		},
+		{
+			name: "last friday of the month",
+			rule: Recurrence{Frequency: RecurMonthly, Interval: 1, ByDay: []string{"-1FRI"}, Duration: 24 * time.Hour},
+			from: yearFrom, until: yearUntil,
+			now:       utc(time.January, 10, 0),
+			wantStart: utc(time.January, 30, 0), wantEnd: utc(time.January, 31, 0), wantOK: true,
+		},
+		{
+			name: "last day of february",
+			rule: Recurrence{Frequency: RecurMonthly, Interval: 1, ByMonthDay: []int{-1}, Duration: time.Hour},
+			from: yearFrom, until: yearUntil,
+			now:       utc(time.February, 2, 0),
+			wantStart: utc(time.February, 28, 0), wantEnd: utc(time.February, 28, 1), wantOK: true,
+		},
+		{
+			name: "every other monday keeps validFrom time of day",
+			rule: Recurrence{Frequency: RecurWeekly, Interval: 2, ByDay: []string{"MON"}, Duration: 8 * time.Hour},
+			from: utc(time.January, 5, 9), until: yearUntil,
+			now:       utc(time.January, 6, 0),
+			wantStart: utc(time.January, 19, 9), wantEnd: utc(time.January, 19, 17), wantOK: true,
+		},
+		{
+			name: "occurrence is cut off at validUntil", rule: firstWeekend, from: yearFrom, until: utc(time.January, 4, 0),
+			now:       utc(time.January, 2, 0),
+			wantStart: utc(time.January, 3, 0), wantEnd: utc(time.January, 4, 0), wantOK: true,
+		},
+		{
+			name: "no occurrence left before validUntil", rule: firstWeekend, from: yearFrom, until: utc(time.February, 1, 0),
+			now: utc(time.January, 6, 0),
+		},
+		{
+			name: "days are evaluated in the rule timezone",
+			rule: Recurrence{Frequency: RecurMonthly, Interval: 1, ByDay: []string{"1SAT"}, Duration: 48 * time.Hour, Location: jakarta},
+			from: time.Date(2026, time.January, 1, 0, 0, 0, 0, jakarta), until: yearUntil,
+			now:       utc(time.January, 1, 0),
+			wantStart: time.Date(2026, time.January, 3, 0, 0, 0, 0, jakarta),
+			wantEnd:   time.Date(2026, time.January, 5, 0, 0, 0, 0, jakarta),
+			wantOK:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := tt.rule.Occurrence(tt.now, tt.from, tt.until)
+			require.Equal(t, tt.wantOK, ok)
+			if !tt.wantOK {
+				return
+			}
+			assert.True(t, tt.wantStart.Equal(start), "start = %s, want %s", start, tt.wantStart)
+			assert.True(t, tt.wantEnd.Equal(end), "end = %s, want %s", end, tt.wantEnd)
+		})
+	}
+}
+
+func TestRecurrence_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Recurrence
+		wantErr string
+	}{
+		{name: "monthly ordinal", rule: Recurrence{Frequency: RecurMonthly, Interval: 1, ByDay: []string{"-1FRI"}, Duration: time.Hour}},
+		{name: "weekly days", rule: Recurrence{Frequency: RecurWeekly, Interval: 2, ByDay: []string{"SAT", "SUN"}, Duration: time.Hour}},
+		{name: "unknown frequency", rule: Recurrence{Frequency: "Daily", Interval: 1, Duration: time.Hour}, wantErr: "unsupported frequency"},
+		{name: "zero interval", rule: Recurrence{Frequency: RecurWeekly, Duration: time.Hour}, wantErr: "interval"},
+		{name: "zero duration", rule: Recurrence{Frequency: RecurWeekly, Interval: 1}, wantErr: "duration"},
+		{name: "bad day", rule: Recurrence{Frequency: RecurMonthly, Interval: 1, ByDay: []string{"6SAT"}, Duration: time.Hour}, wantErr: "invalid day"},
+		{name: "weekly ordinal", rule: Recurrence{Frequency: RecurWeekly, Interval: 1, ByDay: []string{"1SAT"}, Duration: time.Hour}, wantErr: "only supported with Monthly"},
+		{name: "month day zero", rule: Recurrence{Frequency: RecurMonthly, Interval: 1, ByMonthDay: []int{0}, Duration: time.Hour}, wantErr: "invalid month day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -50,6 +50,7 @@ type ScheduleEvaluator struct {
 
 	parser         cron.Parser
 	scheduleBuffer time.Duration
+	dstPolicy      DSTPolicy
 }
 
 type ScheduleEvaluatorOption func(*ScheduleEvaluator)
@@ -129,12 +130,12 @@ func (e *ScheduleEvaluator) eval(window ScheduleWindow) (*EvaluationResult, erro
 	localNow := now.In(loc)
 
 	// Parse cron expressions
-	hibernateSched, err := e.parser.Parse(window.HibernateCron)
+	hibernateSched, err := e.parse(window.HibernateCron)
 	if err != nil {
 		return nil, fmt.Errorf("invalid hibernate cron %q: %w", window.HibernateCron, err)
 	}
 
-	wakeUpSched, err := e.parser.Parse(window.WakeUpCron)
+	wakeUpSched, err := e.parse(window.WakeUpCron)
 	if err != nil {
 		return nil, fmt.Errorf("invalid wakeUp cron %q: %w", window.WakeUpCron, err)
 	}
@@ -280,6 +281,13 @@ func (e *ScheduleEvaluator) NextRequeueTime(result *EvaluationResult) time.Durat
 // hibernation set (effectiveBase ∪ extend.Windows) so it correctly sees all
 // windows that could trigger hibernation.
 func (e *ScheduleEvaluator) Evaluate(baseWindows []OffHourWindow, timezone string, exceptions []*Exception) (*EvaluationResult, error) {
+	return e.EvaluateWithDates(baseWindows, nil, timezone, exceptions)
+}
+
+// EvaluateWithDates evaluates the schedule like Evaluate, with the date
+// windows unioned with the weekly base windows before exceptions apply. A
+// replace exception substitutes both.
+func (e *ScheduleEvaluator) EvaluateWithDates(baseWindows []OffHourWindow, dates []DateWindow, timezone string, exceptions []*Exception) (*EvaluationResult, error) {
 	activeExceptions := e.filterActive(exceptions)
 	rep := mergeByType(activeExceptions, ExceptionReplace)
 	ext := mergeByType(activeExceptions, ExceptionExtend)
@@ -297,6 +305,11 @@ func (e *ScheduleEvaluator) Evaluate(baseWindows []OffHourWindow, timezone strin
 			return e.evaluateWindows(baseWindows, timezone)
 		},
 
+		// Stage 1b: Dates — union the one-off date windows with the base.
+		evaluateWhen(rep == nil && len(dates) > 0, func(r *EvaluationResult) (*EvaluationResult, error) {
+			return e.applyDates(r, baseWindows, dates, timezone)
+		}),
+
 		// Stage 2: Extend — union additional hibernation windows on top of the base.
 		evaluateWhen(ext != nil, func(r *EvaluationResult) (*EvaluationResult, error) {
 			return e.applyExtend(r, ext.Windows, timezone)
@@ -628,7 +641,7 @@ func (e *ScheduleEvaluator) findSuspensionEnd(windows []OffHourWindow, now time.
 			endTime := time.Date(now.Year(), now.Month(), now.Day(), endHour, endMin, 0, 0, now.Location())
 			if endMinutes <= startMinutes && currentTimeMinutes >= startMinutes {
 				// Overnight window, end is tomorrow
-				endTime = endTime.Add(24 * time.Hour)
+				endTime = endTime.AddDate(0, 0, 1)
 			}
 			return endTime
 		}
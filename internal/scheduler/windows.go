@@ -96,7 +96,7 @@ func isInLeadTimeWindow(windows []OffHourWindow, now time.Time, leadTime time.Du
 		checkDays := []string{currentDay}
 
 		if currentHour > 12 {
-			nextDay := now.Add(24 * time.Hour).Weekday()
+			nextDay := now.AddDate(0, 0, 1).Weekday()
 			checkDays = append(checkDays, strings.ToUpper(nextDay.String()[:3]))
 		}
 
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package statusapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ardikabs/hibernator/internal/audit"
+)
+
+// AuditPath is the route of the audit log of a plan's phase transitions.
+const AuditPath = "/v1alpha1/plans/{namespace}/{name}/audit"
+
+// handleAudit serves the audit log of a plan, oldest record first. The
+// optional tail query parameter limits it to the most recent records.
+func (s *Server) handleAudit(w http.ResponseWriter, r *http.Request) {
+	key := types.NamespacedName{Namespace: r.PathValue("namespace"), Name: r.PathValue("name")}
+	if !s.authorize(w, r, key) {
+		return
+	}
+
+	tail := 0
+	if value := r.URL.Query().Get("tail"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			http.Error(w, fmt.Sprintf("invalid tail %q", value), http.StatusBadRequest)
+			return
+		}
+		tail = n
+	}
+
+	log, err := audit.Get(r.Context(), s.client, key.Namespace, key.Name)
+	if apierrors.IsNotFound(err) {
+		http.Error(w, fmt.Sprintf("no audit log stored for plan %s", key), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.log.Error(err, "failed to get audit log", "plan", key.String())
+		http.Error(w, "failed to get audit log", http.StatusInternalServerError)
+		return
+	}
+
+	if tail > 0 && len(log.Records) > tail {
+		log.Records = log.Records[len(log.Records)-tail:]
+	}
+	writeJSON(w, log)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package statusapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/ardikabs/hibernator/internal/audit"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+const storedRecords = `{"time":"2026-10-16T20:00:00Z","from":"Active","to":"Hibernating","operation":"shutdown","cycleId":"abc123","trigger":"Schedule"}
+{"time":"2026-10-16T20:05:00Z","from":"Hibernating","to":"Hibernated","operation":"shutdown","cycleId":"abc123","trigger":"Execution"}
+{"time":"2026-10-17T02:00:00Z","from":"Hibernated","to":"WakingUp","operation":"wakeup","cycleId":"abc123","trigger":"Manual","actor":"alice@example.com","source":"hibernator.ardikabs.com/override-action"}
+`
+
+func TestServer_Audit(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	srv := newTestServer(t, &fakeReviews{}, clk, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      audit.ConfigMapName("dev"),
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelAuditLog: "true",
+				wellknown.LabelPlan:     "dev",
+			},
+		},
+		Data: map[string]string{audit.DataKey: storedRecords},
+	})
+
+	rec := get(srv, "/v1alpha1/plans/team-a/dev/audit?tail=2", "grafana")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var log audit.Log
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &log))
+	require.Len(t, log.Records, 2)
+	assert.Equal(t, audit.TriggerExecution, log.Records[0].Trigger)
+	assert.Equal(t, audit.TriggerManual, log.Records[1].Trigger)
+	assert.Equal(t, "alice@example.com", log.Records[1].Actor)
+	assert.Equal(t, "hibernator.ardikabs.com/override-action", log.Records[1].Source)
+
+	assert.Equal(t, http.StatusForbidden, get(srv, "/v1alpha1/plans/team-a/prod/audit", "grafana").Code)
+	assert.Equal(t, http.StatusBadRequest, get(srv, "/v1alpha1/plans/team-a/dev/audit?tail=x", "grafana").Code)
+}
+
+func TestServer_Audit_NotFound(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	srv := newTestServer(t, &fakeReviews{}, clk)
+
+	assert.Equal(t, http.StatusNotFound, get(srv, "/v1alpha1/plans/team-a/dev/audit", "grafana").Code)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package statusapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
+)
+
+// decisionKey identifies a cached authorization decision. The token is stored as
+// its SHA-256 digest so the cache never holds bearer tokens.
+type decisionKey struct {
+	token [sha256.Size]byte
+	attrs authorizationv1.ResourceAttributes
+}
+
+type decision struct {
+	allowed bool
+	expires time.Time
+}
+
+// TokenAuthorizer authorizes requests of external systems with the Kubernetes API:
+// the bearer token is authenticated with a TokenReview, and the resulting user
+// must be allowed the requested resource access, as checked with a
+// SubjectAccessReview. Any identity the API server accepts works, such as a
+// ServiceAccount token mounted into a Grafana datasource.
+//
+// Decisions, both allow and deny, are cached for the configured TTL so dashboards
+// polling many panels do not issue two API requests per panel refresh.
+type TokenAuthorizer struct {
+	clientset kubernetes.Interface
+	clock     clock.Clock
+	ttl       time.Duration
+
+	mu        sync.Mutex
+	decisions map[decisionKey]decision
+}
+
+// NewTokenAuthorizer creates a TokenAuthorizer that caches decisions for ttl.
+// A zero ttl disables caching.
+func NewTokenAuthorizer(clientset kubernetes.Interface, clk clock.Clock, ttl time.Duration) *TokenAuthorizer {
+	return &TokenAuthorizer{
+		clientset: clientset,
+		clock:     clk,
+		ttl:       ttl,
+		decisions: make(map[decisionKey]decision),
+	}
+}
+
+// Authorize reports whether the holder of token is allowed attrs. An error means
+// the API server could not be asked; the request must then be rejected without
+// caching the outcome.
+func (a *TokenAuthorizer) Authorize(ctx context.Context, token string, attrs authorizationv1.ResourceAttributes) (bool, error) {
+	key := decisionKey{token: sha256.Sum256([]byte(token)), attrs: attrs}
+	now := a.clock.Now()
+
+	a.mu.Lock()
+	d, ok := a.decisions[key]
+	a.mu.Unlock()
+	if ok && now.Before(d.expires) {
+		return d.allowed, nil
+	}
+
+	allowed, err := a.review(ctx, token, attrs)
+	if err != nil {
+		return false, err
+	}
+
+	if a.ttl > 0 {
+		a.mu.Lock()
+		for k, d := range a.decisions {
+			if !now.Before(d.expires) {
+				delete(a.decisions, k)
+			}
+		}
+		a.decisions[key] = decision{allowed: allowed, expires: now.Add(a.ttl)}
+		a.mu.Unlock()
+	}
+	return allowed, nil
+}
+
+// review asks the API server whether token authenticates a user that is allowed attrs.
+func (a *TokenAuthorizer) review(ctx context.Context, token string, attrs authorizationv1.ResourceAttributes) (bool, error) {
+	tr, err := a.clientset.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("token review: %w", err)
+	}
+	if !tr.Status.Authenticated {
+		return false, nil
+	}
+
+	user := tr.Status.User
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+
+	sar, err := a.clientset.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:               user.Username,
+			UID:                user.UID,
+			Groups:             user.Groups,
+			Extra:              extra,
+			ResourceAttributes: &attrs,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("subject access review: %w", err)
+	}
+	return sar.Status.Allowed, nil
+}
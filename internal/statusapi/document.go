@@ -0,0 +1,292 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package statusapi
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+	"k8s.io/utils/clock"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
+)
+
+// PlanStatus is the status document served for a HibernatePlan. It is flat and
+// numeric where possible so dashboards can plot fields directly: timestamps are
+// RFC 3339 and every duration is in whole seconds. Field names are part of the
+// API and must not change.
+type PlanStatus struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+
+	// Phase is the plan's current phase.
+	Phase string `json:"phase"`
+
+	// Suspended is true when spec.suspend is set.
+	Suspended bool `json:"suspended"`
+
+	// Operation is the current or last executed operation (hibernate or wakeup).
+	Operation string `json:"operation,omitempty"`
+
+	// CycleID identifies the current or last execution cycle.
+	CycleID string `json:"cycleId,omitempty"`
+
+	// PhaseSince is when the plan entered its current phase.
+	PhaseSince *time.Time `json:"phaseSince,omitempty"`
+
+	// PhaseDurationSeconds is how long the plan has been in its current phase.
+	PhaseDurationSeconds int64 `json:"phaseDurationSeconds"`
+
+	Schedule  ScheduleStatus `json:"schedule"`
+	Targets   []TargetStatus `json:"targets"`
+	LastCycle *CycleStatus   `json:"lastCycle,omitempty"`
+	Savings   SavingsStatus  `json:"savings"`
+
+	// GeneratedAt is when the document was built. Responses are cached, so it
+	// may lag the request time by up to the cache TTL.
+	GeneratedAt time.Time `json:"generatedAt"`
+}
+
+// ScheduleStatus reports the plan's schedule as the controller evaluates it,
+// with active ScheduleExceptions applied.
+type ScheduleStatus struct {
+	Timezone        string     `json:"timezone"`
+	ShouldHibernate bool       `json:"shouldHibernate"`
+	NextHibernateAt *time.Time `json:"nextHibernateAt,omitempty"`
+	NextWakeUpAt    *time.Time `json:"nextWakeUpAt,omitempty"`
+
+	// ActiveExceptions names the ScheduleExceptions applied to the evaluation.
+	ActiveExceptions []string `json:"activeExceptions,omitempty"`
+
+	// Error is set instead of the fields above when the schedule cannot be evaluated.
+	Error string `json:"error,omitempty"`
+}
+
+// TargetStatus reports the execution state of one target in the current cycle.
+type TargetStatus struct {
+	Name     string `json:"name"`
+	Executor string `json:"executor,omitempty"`
+	State    string `json:"state"`
+
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// DurationSeconds is the execution time so far for a running target and
+	// the total execution time for a finished one.
+	DurationSeconds int64  `json:"durationSeconds"`
+	Attempts        int32  `json:"attempts"`
+	Message         string `json:"message,omitempty"`
+}
+
+// CycleStatus summarizes the most recent entry of the plan's execution history.
+type CycleStatus struct {
+	CycleID string `json:"cycleId"`
+
+	ShutdownDurationSeconds int64 `json:"shutdownDurationSeconds"`
+	WakeupDurationSeconds   int64 `json:"wakeupDurationSeconds"`
+
+	// Success is true when every recorded operation of the cycle succeeded.
+	Success bool `json:"success"`
+}
+
+// SavingsStatus measures how long the plan's targets were kept hibernated,
+// which is the input to any cost savings estimate.
+type SavingsStatus struct {
+	// HibernatedSeconds is the total time hibernated across the retained
+	// execution history, including the ongoing hibernation.
+	HibernatedSeconds int64 `json:"hibernatedSeconds"`
+
+	// CurrentHibernationSeconds is the length of the ongoing hibernation, or 0
+	// when the plan is awake.
+	CurrentHibernationSeconds int64 `json:"currentHibernationSeconds"`
+
+	// Cycles is the number of hibernation periods counted in HibernatedSeconds.
+	Cycles int `json:"cycles"`
+
+	// CostAllocation is the chargeback metadata of the latest cycle.
+	CostAllocation map[string]string `json:"costAllocation,omitempty"`
+}
+
+// buildPlanStatus assembles the status document of plan at now. exceptions are
+// the ScheduleExceptions that apply to the plan; only active ones affect the
+// schedule evaluation.
+func buildPlanStatus(plan *hibernatorv1alpha1.HibernatePlan, exceptions []hibernatorv1alpha1.ScheduleException, now time.Time) *PlanStatus {
+	doc := &PlanStatus{
+		Namespace:   plan.Namespace,
+		Name:        plan.Name,
+		Phase:       string(plan.Status.Phase),
+		Suspended:   plan.Spec.Suspend,
+		Operation:   string(plan.Status.CurrentOperation),
+		CycleID:     plan.Status.CurrentCycleID,
+		Schedule:    evaluateSchedule(plan, exceptions, now),
+		Targets:     make([]TargetStatus, 0, len(plan.Status.Executions)),
+		Savings:     computeSavings(plan, now),
+		GeneratedAt: now,
+	}
+
+	if plan.Status.LastTransitionTime != nil {
+		since := plan.Status.LastTransitionTime.Time
+		doc.PhaseSince = &since
+		doc.PhaseDurationSeconds = seconds(now.Sub(since))
+	}
+
+	for _, exec := range plan.Status.Executions {
+		target := TargetStatus{
+			Name:     exec.Target,
+			Executor: exec.Executor,
+			State:    string(exec.State),
+			Attempts: exec.Attempts,
+			Message:  exec.Message,
+		}
+		if exec.StartedAt != nil {
+			target.StartedAt = lo.ToPtr(exec.StartedAt.Time)
+			end := now
+			if exec.FinishedAt != nil {
+				target.FinishedAt = lo.ToPtr(exec.FinishedAt.Time)
+				end = exec.FinishedAt.Time
+			}
+			target.DurationSeconds = seconds(end.Sub(exec.StartedAt.Time))
+		}
+		doc.Targets = append(doc.Targets, target)
+	}
+
+	if n := len(plan.Status.ExecutionHistory); n > 0 {
+		cycle := plan.Status.ExecutionHistory[n-1]
+		last := &CycleStatus{CycleID: cycle.CycleID, Success: true}
+		if s := cycle.ShutdownExecution; s != nil {
+			last.ShutdownDurationSeconds = operationSeconds(s, now)
+			last.Success = last.Success && s.Success
+		}
+		if s := cycle.WakeupExecution; s != nil {
+			last.WakeupDurationSeconds = operationSeconds(s, now)
+			last.Success = last.Success && s.Success
+		}
+		doc.LastCycle = last
+	}
+
+	return doc
+}
+
+// evaluateSchedule evaluates the plan's off-hours with its active exceptions at now.
+func evaluateSchedule(plan *hibernatorv1alpha1.HibernatePlan, exceptions []hibernatorv1alpha1.ScheduleException, now time.Time) ScheduleStatus {
+	status := ScheduleStatus{Timezone: plan.Spec.Schedule.Timezone}
+
+	windows := lo.Map(plan.Spec.Schedule.OffHours, func(w hibernatorv1alpha1.OffHourWindow, _ int) scheduler.OffHourWindow {
+		return scheduler.OffHourWindow{Start: w.Start, End: w.End, DaysOfWeek: w.DaysOfWeek}
+	})
+	dates := lo.Map(plan.Spec.Schedule.Dates, func(w hibernatorv1alpha1.DateWindow, _ int) scheduler.DateWindow {
+		return scheduler.DateWindow{Start: w.Start, End: w.End}
+	})
+
+	var active []*scheduler.Exception
+	for _, exc := range exceptions {
+		if exc.Status.State != hibernatorv1alpha1.ExceptionStateActive || !exc.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if from, until := exc.EffectiveWindow(); now.Before(from) || now.After(until) {
+			continue
+		}
+		active = append(active, convertException(exc))
+		status.ActiveExceptions = append(status.ActiveExceptions, exc.Name)
+	}
+
+	jitter := scheduler.JitterDelay(plan.Namespace+"/"+plan.Name, plan.Spec.Schedule.Jitter)
+	result, err := scheduler.NewScheduleEvaluator(fixedClock{t: now}, scheduler.WithDSTPolicy(scheduler.DSTPolicy(plan.Spec.Schedule.DSTPolicy))).EvaluateDelayed(jitter, windows, dates, plan.Spec.Schedule.Timezone, active)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	status.ShouldHibernate = result.ShouldHibernate
+	if !result.NextHibernateTime.IsZero() {
+		status.NextHibernateAt = lo.ToPtr(result.NextHibernateTime)
+	}
+	if !result.NextWakeUpTime.IsZero() {
+		status.NextWakeUpAt = lo.ToPtr(result.NextWakeUpTime)
+	}
+	return status
+}
+
+// convertException converts an active ScheduleException into the scheduler type.
+func convertException(exc hibernatorv1alpha1.ScheduleException) *scheduler.Exception {
+	var leadTime time.Duration
+	if exc.Spec.LeadTime != "" {
+		leadTime, _ = time.ParseDuration(exc.Spec.LeadTime)
+	}
+
+	validFrom, validUntil := exc.EffectiveWindow()
+	return &scheduler.Exception{
+		Type:       scheduler.ExceptionType(exc.Spec.Type),
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+		LeadTime:   leadTime,
+		Windows: lo.Map(exc.Spec.Windows, func(w hibernatorv1alpha1.OffHourWindow, _ int) scheduler.OffHourWindow {
+			return scheduler.OffHourWindow{Start: w.Start, End: w.End, DaysOfWeek: w.DaysOfWeek}
+		}),
+	}
+}
+
+// fixedClock pins the schedule evaluator to the document's generation time.
+type fixedClock struct {
+	clock.RealClock
+
+	t time.Time
+}
+
+func (c fixedClock) Now() time.Time                  { return c.t }
+func (c fixedClock) Since(t time.Time) time.Duration { return c.t.Sub(t) }
+
+// computeSavings sums the hibernated periods recorded in the execution history.
+// A period runs from the end of a successful shutdown to the start of the wakeup
+// of the same cycle; a cycle without a wakeup yet is still hibernated and counts
+// up to now.
+func computeSavings(plan *hibernatorv1alpha1.HibernatePlan, now time.Time) SavingsStatus {
+	var savings SavingsStatus
+
+	history := plan.Status.ExecutionHistory
+	for i, cycle := range history {
+		shutdown := cycle.ShutdownExecution
+		if shutdown == nil || !shutdown.Success || shutdown.EndTime == nil {
+			continue
+		}
+
+		var d time.Duration
+		switch {
+		case cycle.WakeupExecution != nil:
+			d = cycle.WakeupExecution.StartTime.Sub(shutdown.EndTime.Time)
+		case i == len(history)-1 && plan.Status.Phase == hibernatorv1alpha1.PhaseHibernated:
+			d = now.Sub(shutdown.EndTime.Time)
+			savings.CurrentHibernationSeconds = seconds(d)
+		default:
+			continue
+		}
+
+		savings.HibernatedSeconds += seconds(d)
+		savings.Cycles++
+	}
+
+	if n := len(history); n > 0 {
+		savings.CostAllocation = history[n-1].CostAllocation
+	}
+	return savings
+}
+
+// operationSeconds returns the duration of an operation, counting up to now
+// while it is still running.
+func operationSeconds(s *hibernatorv1alpha1.ExecutionOperationSummary, now time.Time) int64 {
+	end := now
+	if s.EndTime != nil {
+		end = s.EndTime.Time
+	}
+	return seconds(end.Sub(s.StartTime.Time))
+}
+
+// seconds truncates d to whole seconds, clamping negative durations caused by
+// clock skew to zero.
+func seconds(d time.Duration) int64 {
+	return int64(max(d, 0) / time.Second)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package statusapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func TestBuildPlanStatus_TargetsAndLastCycle(t *testing.T) {
+	now := time.Date(2026, 3, 2, 21, 0, 0, 0, time.UTC)
+	plan := testPlan("dev")
+	plan.Status.Phase = hibernatorv1alpha1.PhaseHibernating
+	plan.Status.CurrentCycleID = "c2"
+	plan.Status.LastTransitionTime = ptr.To(metav1.NewTime(now.Add(-5 * time.Minute)))
+	plan.Status.Executions = []hibernatorv1alpha1.ExecutionStatus{
+		{Target: "db", Executor: "rds", State: hibernatorv1alpha1.StateCompleted, Attempts: 1,
+			StartedAt: ptr.To(metav1.NewTime(now.Add(-5 * time.Minute))), FinishedAt: ptr.To(metav1.NewTime(now.Add(-3 * time.Minute)))},
+		{Target: "eks", Executor: "eks", State: hibernatorv1alpha1.StateRunning, Attempts: 1,
+			StartedAt: ptr.To(metav1.NewTime(now.Add(-2 * time.Minute)))},
+		{Target: "ec2", Executor: "ec2", State: hibernatorv1alpha1.StatePending},
+	}
+	plan.Status.ExecutionHistory = []hibernatorv1alpha1.ExecutionCycle{{
+		CycleID: "c2",
+		ShutdownExecution: &hibernatorv1alpha1.ExecutionOperationSummary{
+			Operation: hibernatorv1alpha1.OperationHibernate,
+			StartTime: metav1.NewTime(now.Add(-5 * time.Minute)),
+		},
+	}}
+
+	doc := buildPlanStatus(plan, nil, now)
+
+	assert.Equal(t, int64(300), doc.PhaseDurationSeconds)
+	require.Len(t, doc.Targets, 3)
+	assert.Equal(t, int64(120), doc.Targets[0].DurationSeconds)
+	assert.Equal(t, int64(120), doc.Targets[1].DurationSeconds, "running targets count up to now")
+	assert.Nil(t, doc.Targets[1].FinishedAt)
+	assert.Zero(t, doc.Targets[2].DurationSeconds)
+
+	require.NotNil(t, doc.LastCycle)
+	assert.Equal(t, "c2", doc.LastCycle.CycleID)
+	assert.Equal(t, int64(300), doc.LastCycle.ShutdownDurationSeconds)
+	assert.False(t, doc.LastCycle.Success, "an unfinished shutdown has not succeeded yet")
+}
+
+func TestComputeSavings(t *testing.T) {
+	now := time.Date(2026, 3, 4, 2, 0, 0, 0, time.UTC)
+	at := func(day, hour int) metav1.Time {
+		return metav1.NewTime(time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC))
+	}
+	cycle := func(id string, shutdownEnd metav1.Time, success bool) hibernatorv1alpha1.ExecutionCycle {
+		return hibernatorv1alpha1.ExecutionCycle{
+			CycleID:        id,
+			CostAllocation: map[string]string{"team": id},
+			ShutdownExecution: &hibernatorv1alpha1.ExecutionOperationSummary{
+				StartTime: shutdownEnd, EndTime: &shutdownEnd, Success: success,
+			},
+		}
+	}
+
+	plan := testPlan("dev")
+	plan.Status.Phase = hibernatorv1alpha1.PhaseHibernated
+	c1 := cycle("c1", at(2, 20), true)
+	c1.WakeupExecution = &hibernatorv1alpha1.ExecutionOperationSummary{StartTime: at(3, 6)}
+	failed := cycle("failed", at(3, 12), false)
+	plan.Status.ExecutionHistory = []hibernatorv1alpha1.ExecutionCycle{c1, failed, cycle("c3", at(3, 20), true)}
+
+	savings := computeSavings(plan, now)
+	assert.Equal(t, int64(6*3600), savings.CurrentHibernationSeconds)
+	assert.Equal(t, int64((10+6)*3600), savings.HibernatedSeconds)
+	assert.Equal(t, 2, savings.Cycles)
+	assert.Equal(t, map[string]string{"team": "c3"}, savings.CostAllocation)
+
+	plan.Status.Phase = hibernatorv1alpha1.PhaseActive
+	savings = computeSavings(plan, now)
+	assert.Zero(t, savings.CurrentHibernationSeconds, "an awake plan has no ongoing hibernation")
+	assert.Equal(t, int64(10*3600), savings.HibernatedSeconds)
+}
+
+func TestEvaluateSchedule_AppliesActiveExceptions(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	plan := testPlan("dev")
+	extend := hibernatorv1alpha1.ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{Name: "maintenance"},
+		Spec: hibernatorv1alpha1.ScheduleExceptionSpec{
+			Type:       hibernatorv1alpha1.ExceptionExtend,
+			ValidFrom:  metav1.NewTime(now.Add(-time.Hour)),
+			ValidUntil: metav1.NewTime(now.Add(time.Hour)),
+			Windows:    []hibernatorv1alpha1.OffHourWindow{{Start: "11:00", End: "13:00", DaysOfWeek: []string{"MON"}}},
+		},
+		Status: hibernatorv1alpha1.ScheduleExceptionStatus{State: hibernatorv1alpha1.ExceptionStateActive},
+	}
+	pending := *extend.DeepCopy()
+	pending.Name = "later"
+	pending.Status.State = hibernatorv1alpha1.ExceptionStatePending
+
+	status := evaluateSchedule(plan, []hibernatorv1alpha1.ScheduleException{extend, pending}, now)
+	assert.Empty(t, status.Error)
+	assert.True(t, status.ShouldHibernate)
+	assert.Equal(t, []string{"maintenance"}, status.ActiveExceptions)
+
+	plan.Spec.Schedule.Timezone = "Mars/Olympus"
+	status = evaluateSchedule(plan, nil, now)
+	assert.NotEmpty(t, status.Error)
+}
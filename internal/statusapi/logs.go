@@ -0,0 +1,111 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package statusapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ardikabs/hibernator/internal/executionlog"
+)
+
+const (
+	// ExecutionsPath is the route listing the executions of a plan with stored logs.
+	ExecutionsPath = "/v1alpha1/plans/{namespace}/{name}/executions"
+
+	// ExecutionLogsPath is the route of the stored logs of one execution.
+	ExecutionLogsPath = "/v1alpha1/plans/{namespace}/{name}/executions/{executionId}/logs"
+)
+
+// ExecutionLogSummary describes the stored logs of an execution.
+type ExecutionLogSummary struct {
+	ExecutionID string    `json:"executionId"`
+	Target      string    `json:"target"`
+	Entries     int       `json:"entries"`
+	Dropped     int       `json:"dropped,omitempty"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// handleExecutions lists the executions of a plan with stored logs, oldest first.
+func (s *Server) handleExecutions(w http.ResponseWriter, r *http.Request) {
+	key := types.NamespacedName{Namespace: r.PathValue("namespace"), Name: r.PathValue("name")}
+	if !s.authorize(w, r, key) {
+		return
+	}
+
+	logs, err := executionlog.List(r.Context(), s.client, key.Namespace, key.Name)
+	if err != nil {
+		s.log.Error(err, "failed to list execution logs", "plan", key.String())
+		http.Error(w, "failed to list execution logs", http.StatusInternalServerError)
+		return
+	}
+
+	summaries := make([]ExecutionLogSummary, 0, len(logs))
+	for _, log := range logs {
+		summaries = append(summaries, ExecutionLogSummary{
+			ExecutionID: log.ExecutionID,
+			Target:      log.Target,
+			Entries:     len(log.Entries),
+			Dropped:     log.Dropped,
+			UpdatedAt:   log.UpdatedAt,
+		})
+	}
+	writeJSON(w, summaries)
+}
+
+// handleExecutionLogs serves the stored logs of one execution of a plan. The
+// optional tail query parameter limits them to the most recent entries.
+func (s *Server) handleExecutionLogs(w http.ResponseWriter, r *http.Request) {
+	key := types.NamespacedName{Namespace: r.PathValue("namespace"), Name: r.PathValue("name")}
+	executionID := r.PathValue("executionId")
+	if !s.authorize(w, r, key) {
+		return
+	}
+
+	tail := 0
+	if value := r.URL.Query().Get("tail"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			http.Error(w, fmt.Sprintf("invalid tail %q", value), http.StatusBadRequest)
+			return
+		}
+		tail = n
+	}
+
+	log, err := executionlog.Get(r.Context(), s.client, key.Namespace, executionID)
+	// Access is authorized per plan, so another plan's log must not be served.
+	if apierrors.IsNotFound(err) || (err == nil && log.Plan != key.Name) {
+		http.Error(w, fmt.Sprintf("no logs stored for execution %s of plan %s", executionID, key), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		s.log.Error(err, "failed to get execution log", "plan", key.String(), "executionId", executionID)
+		http.Error(w, "failed to get execution log", http.StatusInternalServerError)
+		return
+	}
+
+	if tail > 0 && len(log.Entries) > tail {
+		log.Entries = log.Entries[len(log.Entries)-tail:]
+	}
+	writeJSON(w, log)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package statusapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/ardikabs/hibernator/internal/executionlog"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func storedLog(plan, target, executionID string, updated time.Time, lines string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      executionlog.ConfigMapName(executionID),
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionLog: "true",
+				wellknown.LabelPlan:         plan,
+				wellknown.LabelTarget:       target,
+				wellknown.LabelExecutionID:  executionID,
+			},
+			Annotations: map[string]string{
+				wellknown.AnnotationLogUpdatedAt: updated.Format(time.RFC3339),
+				wellknown.AnnotationLogDropped:   "2",
+			},
+		},
+		Data: map[string]string{executionlog.DataKey: lines},
+	}
+}
+
+const storedLines = `{"ts":"2026-10-16T03:00:00Z","level":"INFO","msg":"starting runner"}
+{"ts":"2026-10-16T03:00:05Z","level":"INFO","msg":"scaling node group"}
+{"ts":"2026-10-16T03:05:00Z","level":"ERROR","msg":"wakeup failed","fields":{"error":"timeout"}}
+`
+
+func TestServer_ExecutionLogs(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	updated := time.Date(2026, 10, 16, 3, 5, 0, 0, time.UTC)
+	srv := newTestServer(t, &fakeReviews{}, clk,
+		storedLog("dev", "eks", "dev-eks-1", updated, storedLines),
+		storedLog("dev", "db", "dev-db-1", updated.Add(-time.Minute), storedLines),
+		storedLog("prod", "db", "prod-db-1", updated, storedLines),
+	)
+
+	rec := get(srv, "/v1alpha1/plans/team-a/dev/executions", "grafana")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var summaries []ExecutionLogSummary
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &summaries))
+	require.Len(t, summaries, 2)
+	assert.Equal(t, ExecutionLogSummary{ExecutionID: "dev-db-1", Target: "db", Entries: 3, Dropped: 2, UpdatedAt: updated.Add(-time.Minute)}, summaries[0])
+	assert.Equal(t, "dev-eks-1", summaries[1].ExecutionID)
+
+	rec = get(srv, "/v1alpha1/plans/team-a/dev/executions/dev-eks-1/logs?tail=1", "grafana")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var log executionlog.Log
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &log))
+	assert.Equal(t, "eks", log.Target)
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, "wakeup failed", log.Entries[0].Message)
+	assert.Equal(t, "timeout", log.Entries[0].Fields["error"])
+}
+
+func TestServer_ExecutionLogs_ScopedToPlan(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	srv := newTestServer(t, &fakeReviews{}, clk,
+		storedLog("prod", "db", "prod-db-1", time.Now(), storedLines),
+	)
+
+	assert.Equal(t, http.StatusNotFound, get(srv, "/v1alpha1/plans/team-a/dev/executions/prod-db-1/logs", "grafana").Code,
+		"logs of another plan are not served through an authorized plan")
+	assert.Equal(t, http.StatusForbidden, get(srv, "/v1alpha1/plans/team-a/prod/executions/prod-db-1/logs", "grafana").Code)
+	assert.Equal(t, http.StatusUnauthorized, get(srv, "/v1alpha1/plans/team-a/dev/executions", "").Code)
+	assert.Equal(t, http.StatusBadRequest, get(srv, "/v1alpha1/plans/team-a/dev/executions/prod-db-1/logs?tail=-1", "grafana").Code)
+}
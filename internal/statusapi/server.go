@@ -0,0 +1,286 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package statusapi serves a compact, machine-readable status document per
+// HibernatePlan for dashboards such as Grafana JSON datasource panels and
+// wallboards, and the persisted runner logs of the plan's executions. Both are
+// read from the manager's cache, so every replica serves them without leader
+// election.
+package statusapi
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/streaming/auth"
+	"github.com/ardikabs/hibernator/internal/streaming/streamtls"
+)
+
+const (
+	// PlanStatusPath is the route of the per-plan status document.
+	PlanStatusPath = "/v1alpha1/plans/{namespace}/{name}/status"
+
+	// DefaultCacheTTL is how long a status document and an authorization
+	// decision are reused.
+	DefaultCacheTTL = 15 * time.Second
+)
+
+// Options configures the status API server.
+type Options struct {
+	// Addr is the listen address. An empty Addr disables the server.
+	Addr  string
+	Clock clock.Clock
+
+	// TLSCertDir holds the tls.crt and tls.key the server presents, reloaded
+	// when they change. Required when Addr is set: callers send Kubernetes
+	// bearer tokens, which must not cross the network in cleartext.
+	TLSCertDir string
+
+	// CacheTTL bounds how stale a served document may be. Zero uses DefaultCacheTTL.
+	CacheTTL time.Duration
+}
+
+type cachedDocument struct {
+	body    []byte
+	expires time.Time
+}
+
+// Server serves PlanStatus documents over HTTP.
+type Server struct {
+	addr       string
+	tlsConfig  *tls.Config
+	client     client.Reader
+	authorizer *TokenAuthorizer
+	clock      clock.Clock
+	cacheTTL   time.Duration
+	log        logr.Logger
+
+	mu    sync.Mutex
+	cache map[types.NamespacedName]cachedDocument
+}
+
+// NewServer creates a status API server that reads plans from c and authorizes
+// requests with authorizer.
+func NewServer(opts Options, c client.Reader, authorizer *TokenAuthorizer, log logr.Logger) *Server {
+	srv := &Server{
+		addr:       opts.Addr,
+		client:     c,
+		authorizer: authorizer,
+		clock:      clock.RealClock{},
+		cacheTTL:   opts.CacheTTL,
+		log:        log.WithName("status-api"),
+		cache:      make(map[types.NamespacedName]cachedDocument),
+	}
+	if opts.Clock != nil {
+		srv.clock = opts.Clock
+	}
+	if srv.cacheTTL == 0 {
+		srv.cacheTTL = DefaultCacheTTL
+	}
+	return srv
+}
+
+// SetupWithManager adds the status API server to the manager. It is a no-op
+// when opts.Addr is empty.
+func SetupWithManager(mgr ctrl.Manager, opts Options) error {
+	if opts.Addr == "" {
+		return nil
+	}
+	if opts.TLSCertDir == "" {
+		return fmt.Errorf("status API requires TLS: set --stream-tls-cert-dir or disable it with an empty --status-api-address")
+	}
+	tlsConfig, err := streamtls.ServingConfig(opts.TLSCertDir)
+	if err != nil {
+		return fmt.Errorf("failed to load status API TLS configuration: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create kubernetes clientset: %w", err)
+	}
+
+	clk := opts.Clock
+	if clk == nil {
+		clk = clock.RealClock{}
+	}
+	ttl := opts.CacheTTL
+	if ttl == 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	srv := NewServer(opts, mgr.GetClient(), NewTokenAuthorizer(clientset, clk, ttl), ctrl.Log)
+	srv.tlsConfig = tlsConfig
+	if err := mgr.Add(srv); err != nil {
+		return fmt.Errorf("failed to add status api server to manager: %w", err)
+	}
+	return nil
+}
+
+// Handler returns the HTTP handler of the status API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+PlanStatusPath, s.handlePlanStatus)
+	mux.HandleFunc("GET "+ExecutionsPath, s.handleExecutions)
+	mux.HandleFunc("GET "+ExecutionLogsPath, s.handleExecutionLogs)
+	mux.HandleFunc("GET "+AuditPath, s.handleAudit)
+	return mux
+}
+
+// Start starts the status API server and blocks until ctx is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	if s.tlsConfig == nil {
+		return fmt.Errorf("status API server requires TLS")
+	}
+
+	server := &http.Server{
+		Addr:              s.addr,
+		Handler:           s.Handler(),
+		TLSConfig:         s.tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	s.log.Info("starting status API server", "addr", s.addr)
+
+	errCh := make(chan error, 1)
+	go func() {
+		// The certificate comes from TLSConfig.
+		if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("status API server: %w", err)
+	case <-ctx.Done():
+	}
+
+	s.log.Info("shutting down status API server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
+}
+
+// NeedLeaderElection reports false: the document is built from the cache, which
+// every replica keeps in sync.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// handlePlanStatus serves the PlanStatus document of one plan.
+func (s *Server) handlePlanStatus(w http.ResponseWriter, r *http.Request) {
+	key := types.NamespacedName{Namespace: r.PathValue("namespace"), Name: r.PathValue("name")}
+
+	if !s.authorize(w, r, key) {
+		return
+	}
+
+	body, err := s.document(r.Context(), key)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("plan %s not found", key), http.StatusNotFound)
+			return
+		}
+		s.log.Error(err, "failed to build plan status", "plan", key.String())
+		http.Error(w, "failed to build plan status", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "private, max-age="+strconv.Itoa(int(s.cacheTTL/time.Second)))
+	_, _ = w.Write(body)
+}
+
+// authorize checks that the request's bearer token may get the plan key and
+// writes the error response when it may not.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request, key types.NamespacedName) bool {
+	token, err := auth.ExtractTokenFromHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return false
+	}
+
+	allowed, err := s.authorizer.Authorize(r.Context(), token, authorizationv1.ResourceAttributes{
+		Namespace: key.Namespace,
+		Verb:      "get",
+		Group:     hibernatorv1alpha1.GroupVersion.Group,
+		Resource:  "hibernateplans",
+		Name:      key.Name,
+	})
+	if err != nil {
+		s.log.Error(err, "failed to authorize request", "plan", key.String())
+		http.Error(w, "authorization failed", http.StatusInternalServerError)
+		return false
+	}
+	if !allowed {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+// document returns the encoded PlanStatus of key, reusing a cached copy until
+// it expires.
+func (s *Server) document(ctx context.Context, key types.NamespacedName) ([]byte, error) {
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[key]
+	s.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.body, nil
+	}
+
+	var plan hibernatorv1alpha1.HibernatePlan
+	if err := s.client.Get(ctx, key, &plan); err != nil {
+		return nil, err
+	}
+
+	var list hibernatorv1alpha1.ScheduleExceptionList
+	if err := s.client.List(ctx, &list, client.InNamespace(key.Namespace)); err != nil {
+		return nil, fmt.Errorf("list schedule exceptions: %w", err)
+	}
+	var exceptions []hibernatorv1alpha1.ScheduleException
+	for _, exc := range list.Items {
+		if exc.AppliesToPlan(plan.Name, plan.Labels) {
+			exceptions = append(exceptions, exc)
+		}
+	}
+
+	body, err := json.Marshal(buildPlanStatus(&plan, exceptions, now))
+	if err != nil {
+		return nil, fmt.Errorf("encode plan status: %w", err)
+	}
+
+	s.mu.Lock()
+	for k, c := range s.cache {
+		if !now.Before(c.expires) {
+			delete(s.cache, k)
+		}
+	}
+	s.cache[key] = cachedDocument{body: body, expires: now.Add(s.cacheTTL)}
+	s.mu.Unlock()
+
+	return body, nil
+}
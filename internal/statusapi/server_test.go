@@ -0,0 +1,157 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package statusapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// fakeReviews answers TokenReviews for the "grafana" token and allows the
+// resulting user to read plan "dev" only. It counts the reviews it serves.
+type fakeReviews struct {
+	tokenReviews  int
+	accessReviews int
+}
+
+func (f *fakeReviews) clientset() *k8sfake.Clientset {
+	cs := k8sfake.NewSimpleClientset()
+	cs.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		f.tokenReviews++
+		tr := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if tr.Spec.Token == "grafana" {
+			tr.Status.Authenticated = true
+			tr.Status.User = authenticationv1.UserInfo{Username: "system:serviceaccount:monitoring:grafana"}
+		}
+		return true, tr, nil
+	})
+	cs.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		f.accessReviews++
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attrs := sar.Spec.ResourceAttributes
+		sar.Status.Allowed = sar.Spec.User == "system:serviceaccount:monitoring:grafana" &&
+			attrs.Resource == "hibernateplans" && attrs.Verb == "get" && attrs.Name == "dev"
+		return true, sar, nil
+	})
+	return cs
+}
+
+func newTestServer(t *testing.T, reviews *fakeReviews, clk *clocktesting.FakeClock, objs ...client.Object) *Server {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, hibernatorv1alpha1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	authorizer := NewTokenAuthorizer(reviews.clientset(), clk, time.Minute)
+	return NewServer(Options{Clock: clk, CacheTTL: 30 * time.Second}, c, authorizer, logr.Discard())
+}
+
+func testPlan(name string) *hibernatorv1alpha1.HibernatePlan {
+	return &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a"},
+		Spec: hibernatorv1alpha1.HibernatePlanSpec{
+			Schedule: hibernatorv1alpha1.Schedule{
+				Timezone: "UTC",
+				OffHours: []hibernatorv1alpha1.OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON", "TUE", "WED", "THU", "FRI"}}},
+			},
+		},
+		Status: hibernatorv1alpha1.HibernatePlanStatus{Phase: hibernatorv1alpha1.PhaseActive},
+	}
+}
+
+func get(srv *Server, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServer_PlanStatus_ServesDocument(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC))
+	srv := newTestServer(t, &fakeReviews{}, clk, testPlan("dev"))
+
+	rec := get(srv, "/v1alpha1/plans/team-a/dev/status", "grafana")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "private, max-age=30", rec.Header().Get("Cache-Control"))
+
+	var doc PlanStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	assert.Equal(t, "dev", doc.Name)
+	assert.Equal(t, "Active", doc.Phase)
+	assert.False(t, doc.Schedule.ShouldHibernate)
+	require.NotNil(t, doc.Schedule.NextHibernateAt)
+	assert.Equal(t, time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC), doc.Schedule.NextHibernateAt.UTC())
+}
+
+func TestServer_PlanStatus_RejectsUnauthorized(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	srv := newTestServer(t, &fakeReviews{}, clk, testPlan("dev"), testPlan("prod"))
+
+	assert.Equal(t, http.StatusUnauthorized, get(srv, "/v1alpha1/plans/team-a/dev/status", "").Code)
+	assert.Equal(t, http.StatusForbidden, get(srv, "/v1alpha1/plans/team-a/dev/status", "stolen").Code)
+	assert.Equal(t, http.StatusForbidden, get(srv, "/v1alpha1/plans/team-a/prod/status", "grafana").Code,
+		"access is granted per plan")
+}
+
+func TestServer_PlanStatus_NotFound(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Now())
+	srv := newTestServer(t, &fakeReviews{}, clk)
+
+	assert.Equal(t, http.StatusNotFound, get(srv, "/v1alpha1/plans/team-a/dev/status", "grafana").Code)
+}
+
+func TestServer_PlanStatus_CachesDocumentAndDecision(t *testing.T) {
+	clk := clocktesting.NewFakeClock(time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC))
+	reviews := &fakeReviews{}
+	srv := newTestServer(t, reviews, clk, testPlan("dev"))
+
+	first := get(srv, "/v1alpha1/plans/team-a/dev/status", "grafana")
+	require.Equal(t, http.StatusOK, first.Code)
+
+	clk.Step(10 * time.Second)
+	second := get(srv, "/v1alpha1/plans/team-a/dev/status", "grafana")
+	assert.Equal(t, first.Body.String(), second.Body.String(), "document is reused within the TTL")
+	assert.Equal(t, 1, reviews.tokenReviews)
+	assert.Equal(t, 1, reviews.accessReviews)
+
+	clk.Step(time.Minute)
+	third := get(srv, "/v1alpha1/plans/team-a/dev/status", "grafana")
+	assert.NotEqual(t, first.Body.String(), third.Body.String(), "expired document is rebuilt")
+	assert.Equal(t, 2, reviews.tokenReviews)
+}
+
+func TestSetupWithManager_RequiresTLS(t *testing.T) {
+	// Bearer tokens must not be accepted over plaintext HTTP.
+	err := SetupWithManager(nil, Options{Addr: ":8083"})
+	assert.ErrorContains(t, err, "requires TLS")
+
+	srv := newTestServer(t, &fakeReviews{}, clocktesting.NewFakeClock(time.Now()))
+	assert.ErrorContains(t, srv.Start(t.Context()), "requires TLS")
+}
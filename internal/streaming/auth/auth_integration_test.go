@@ -11,6 +11,7 @@ package auth
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -220,6 +221,69 @@ func TestValidateToken_ValidBearerToken(t *testing.T) {
 	}
 }
 
+func TestValidateToken_RotatedAudiences(t *testing.T) {
+	var requested []string
+	fakeClient := k8sfake.NewSimpleClientset()
+	fakeClient.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		req := action.(k8stesting.CreateAction).GetObject().(*authv1.TokenReview)
+		requested = req.Spec.Audiences
+		review := &authv1.TokenReview{
+			Status: authv1.TokenReviewStatus{
+				Authenticated: true,
+				Audiences:     []string{"hibernator-old"},
+				User: authv1.UserInfo{
+					Username: "system:serviceaccount:hibernator-system:hibernator-runner",
+				},
+			},
+		}
+		return true, review, nil
+	})
+
+	v := NewTokenValidator(fakeClient, logr.Discard(), "hibernator-runner", "hibernator-system").
+		WithAudiences("hibernator-new", "hibernator-old", "", "hibernator-new")
+
+	result := v.ValidateToken(context.Background(), "old-audience-token")
+	if !result.Valid {
+		t.Errorf("token for an additional audience should be valid, got error: %v", result.Error)
+	}
+	if fmt.Sprint(requested) != "[hibernator-new hibernator-old]" {
+		t.Errorf("requested audiences = %v", requested)
+	}
+
+	v.WithAudiences("hibernator-new")
+	if result := v.ValidateToken(context.Background(), "old-audience-token"); result.Valid {
+		t.Error("token for a retired audience should not be valid")
+	}
+}
+
+func TestValidateToken_AdditionalServiceAccounts(t *testing.T) {
+	newValidator := func(username string) *TokenValidator {
+		return buildValidatorWithTokenReactor(true, []string{ExpectedAudience}, username)
+	}
+
+	v := newValidator("system:serviceaccount:hibernator-system:hibernator-runner-prod").
+		WithServiceAccounts("hibernator-runner-prod", "", "hibernator-runner")
+	if result := v.ValidateToken(context.Background(), "prod-token"); !result.Valid {
+		t.Errorf("token of an additional service account should be valid, got error: %v", result.Error)
+	}
+
+	v = newValidator("system:serviceaccount:hibernator-system:hibernator-runner").
+		WithServiceAccounts("hibernator-runner-prod")
+	if result := v.ValidateToken(context.Background(), "default-token"); !result.Valid {
+		t.Errorf("token of the runner service account should stay valid, got error: %v", result.Error)
+	}
+
+	v = newValidator("system:serviceaccount:hibernator-system:default").
+		WithServiceAccounts("hibernator-runner-prod")
+	result := v.ValidateToken(context.Background(), "other-token")
+	if result.Valid {
+		t.Fatal("token of a service account not allowed should not be valid")
+	}
+	if !strings.Contains(result.Error.Error(), "service account mismatch") {
+		t.Errorf("unexpected error: %v", result.Error)
+	}
+}
+
 // ---- GRPCInterceptor ----
 
 func buildValidatorWithTokenReactor(authenticated bool, audiences []string, username string) *TokenValidator {
@@ -8,6 +8,7 @@ package auth
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -29,7 +30,9 @@ type TokenValidator struct {
 	clientset              kubernetes.Interface
 	log                    logr.Logger
 	audience               string
+	additionalAudiences    []string
 	expectedServiceAccount string
+	additionalAccounts     []string
 	expectedNamespace      string
 }
 
@@ -46,6 +49,41 @@ func NewTokenValidator(clientset kubernetes.Interface, log logr.Logger, expected
 	}
 }
 
+// WithAudiences overrides the audience runner tokens are issued for. Tokens
+// carrying one of the additional audiences are accepted as well, which lets an
+// installation rotate its audience without rejecting runners still holding
+// tokens for the previous one. Empty audiences are ignored.
+func (v *TokenValidator) WithAudiences(audience string, additional ...string) *TokenValidator {
+	if audience != "" {
+		v.audience = audience
+	}
+	v.additionalAudiences = nil
+	for _, aud := range additional {
+		if aud != "" && aud != v.audience && !slices.Contains(v.additionalAudiences, aud) {
+			v.additionalAudiences = append(v.additionalAudiences, aud)
+		}
+	}
+	return v
+}
+
+// WithServiceAccounts accepts tokens of the additional ServiceAccounts of the
+// expected namespace, which targets may select for their runners. Empty names
+// are ignored.
+func (v *TokenValidator) WithServiceAccounts(additional ...string) *TokenValidator {
+	v.additionalAccounts = nil
+	for _, sa := range additional {
+		if sa != "" && sa != v.expectedServiceAccount && !slices.Contains(v.additionalAccounts, sa) {
+			v.additionalAccounts = append(v.additionalAccounts, sa)
+		}
+	}
+	return v
+}
+
+// acceptedAudiences returns the primary audience followed by the additional ones.
+func (v *TokenValidator) acceptedAudiences() []string {
+	return append([]string{v.audience}, v.additionalAudiences...)
+}
+
 // ValidationResult contains the result of token validation.
 type ValidationResult struct {
 	// Valid indicates if the token is valid.
@@ -78,11 +116,13 @@ func (v *TokenValidator) ValidateToken(ctx context.Context, token string) *Valid
 		return result
 	}
 
-	// Create TokenReview
+	// Create TokenReview. The API server intersects the requested audiences
+	// with the token's, so every accepted audience is requested.
+	accepted := v.acceptedAudiences()
 	review := &authv1.TokenReview{
 		Spec: authv1.TokenReviewSpec{
 			Token:     token,
-			Audiences: []string{v.audience},
+			Audiences: accepted,
 		},
 	}
 
@@ -109,13 +149,13 @@ func (v *TokenValidator) ValidateToken(ctx context.Context, token string) *Valid
 
 	audienceValid := false
 	for _, aud := range reviewResult.Status.Audiences {
-		if aud == v.audience {
+		if slices.Contains(accepted, aud) {
 			audienceValid = true
 			break
 		}
 	}
 	if !audienceValid {
-		result.Error = fmt.Errorf("audience mismatch: expected %s, got %v", v.audience, reviewResult.Status.Audiences)
+		result.Error = fmt.Errorf("audience mismatch: expected one of %v, got %v", accepted, reviewResult.Status.Audiences)
 		return result
 	}
 
@@ -138,10 +178,11 @@ func (v *TokenValidator) ValidateToken(ctx context.Context, token string) *Valid
 		return result
 	}
 
-	if result.ServiceAccount != v.expectedServiceAccount {
-		result.Error = fmt.Errorf("service account mismatch: expected %s, got %s", v.expectedServiceAccount, result.ServiceAccount)
+	if result.ServiceAccount != v.expectedServiceAccount && !slices.Contains(v.additionalAccounts, result.ServiceAccount) {
+		expected := append([]string{v.expectedServiceAccount}, v.additionalAccounts...)
+		result.Error = fmt.Errorf("service account mismatch: expected one of %v, got %s", expected, result.ServiceAccount)
 		v.log.Info("token rejected: service account mismatch",
-			"expected", v.expectedServiceAccount,
+			"expected", expected,
 			"got", result.ServiceAccount,
 		)
 		return result
@@ -6,10 +6,13 @@ Licensed under the Apache License, Version 2.0.
 // Package client provides streaming clients for runner-to-controller communication.
 // It supports both gRPC (preferred) and HTTP webhook transports with automatic fallback.
 // The client handles log streaming, progress reporting, completion notifications, and heartbeats.
+// ReliableClient adds at-least-once delivery on top of the transports.
 package client
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -80,6 +83,10 @@ type ClientConfig struct {
 	// UseTLS enables TLS for gRPC connections.
 	UseTLS bool
 
+	// TLSConfig configures TLS connections of all transports, e.g. to present a
+	// client certificate to servers that require mutual TLS.
+	TLSConfig *tls.Config
+
 	// Timeout is the HTTP client timeout for webhook requests.
 	Timeout time.Duration
 
@@ -96,6 +103,7 @@ func NewClient(cfg ClientConfig) (StreamingClient, error) {
 			ExecutionID: cfg.ExecutionID,
 			TokenPath:   cfg.TokenPath,
 			UseTLS:      cfg.UseTLS,
+			TLSConfig:   cfg.TLSConfig,
 			Log:         cfg.Log,
 		}), nil
 
@@ -104,6 +112,7 @@ func NewClient(cfg ClientConfig) (StreamingClient, error) {
 			URL:         cfg.WebSocketURL,
 			ExecutionID: cfg.ExecutionID,
 			TokenPath:   cfg.TokenPath,
+			TLSConfig:   cfg.TLSConfig,
 			Log:         cfg.Log,
 		}), nil
 
@@ -113,6 +122,7 @@ func NewClient(cfg ClientConfig) (StreamingClient, error) {
 			ExecutionID: cfg.ExecutionID,
 			TokenPath:   cfg.TokenPath,
 			Timeout:     cfg.Timeout,
+			TLSConfig:   cfg.TLSConfig,
 			Log:         cfg.Log,
 		}), nil
 
@@ -126,6 +136,7 @@ func NewClient(cfg ClientConfig) (StreamingClient, error) {
 			ExecutionID: cfg.ExecutionID,
 			TokenPath:   cfg.TokenPath,
 			Timeout:     cfg.Timeout,
+			TLSConfig:   cfg.TLSConfig,
 			Log:         cfg.Log,
 		}), nil
 	}
@@ -149,12 +160,14 @@ func NewAutoClient(cfg ClientConfig) *AutoClient {
 			ExecutionID: cfg.ExecutionID,
 			TokenPath:   cfg.TokenPath,
 			UseTLS:      cfg.UseTLS,
+			TLSConfig:   cfg.TLSConfig,
 			Log:         cfg.Log,
 		}),
 		wsClient: NewWebSocketClient(WebSocketClientOptions{
 			URL:         cfg.WebSocketURL,
 			ExecutionID: cfg.ExecutionID,
 			TokenPath:   cfg.TokenPath,
+			TLSConfig:   cfg.TLSConfig,
 			Log:         cfg.Log,
 		}),
 		webhookClient: NewWebhookClient(WebhookClientOptions{
@@ -162,6 +175,7 @@ func NewAutoClient(cfg ClientConfig) *AutoClient {
 			ExecutionID: cfg.ExecutionID,
 			TokenPath:   cfg.TokenPath,
 			Timeout:     cfg.Timeout,
+			TLSConfig:   cfg.TLSConfig,
 			Log:         cfg.Log,
 		}),
 		cfg: cfg,
@@ -253,6 +267,15 @@ func (c *AutoClient) ReportCompletion(ctx context.Context, success bool, errorMs
 	return nil
 }
 
+// DeliverEvents delivers events through the active transport.
+func (c *AutoClient) DeliverEvents(ctx context.Context, events []*Event) error {
+	deliverer, ok := c.active.(EventDeliverer)
+	if !ok {
+		return fmt.Errorf("no active connection")
+	}
+	return deliverer.DeliverEvents(ctx, events)
+}
+
 // Close closes the active connection.
 func (c *AutoClient) Close() error {
 	if c.active != nil {
@@ -267,4 +290,14 @@ var (
 	_ StreamingClient = (*WebhookClient)(nil)
 	_ StreamingClient = (*WebSocketClient)(nil)
 	_ StreamingClient = (*AutoClient)(nil)
+	_ StreamingClient = (*ReliableClient)(nil)
+
+	_ EventDeliverer = (*GRPCClient)(nil)
+	_ EventDeliverer = (*WebhookClient)(nil)
+	_ EventDeliverer = (*WebSocketClient)(nil)
+	_ EventDeliverer = (*AutoClient)(nil)
+
+	_ CancellationReporter     = (*ReliableClient)(nil)
+	_ FailureReporter          = (*ReliableClient)(nil)
+	_ ResourceProgressReporter = (*ReliableClient)(nil)
 )
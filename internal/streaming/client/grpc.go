@@ -7,6 +7,7 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"os"
 	"sync"
@@ -40,6 +41,7 @@ type GRPCClient struct {
 	executionID string
 	tokenPath   string
 	useTLS      bool
+	tlsConfig   *tls.Config
 	log         logr.Logger
 
 	// log streaming management
@@ -61,7 +63,10 @@ type GRPCClientOptions struct {
 	ExecutionID string
 	TokenPath   string
 	UseTLS      bool
-	Log         logr.Logger
+	// TLSConfig is used instead of the system roots when UseTLS is set, e.g.
+	// to present a client certificate.
+	TLSConfig *tls.Config
+	Log       logr.Logger
 }
 
 // NewGRPCClient creates a new gRPC client for runner-to-controller communication.
@@ -75,6 +80,7 @@ func NewGRPCClient(opts GRPCClientOptions) *GRPCClient {
 		executionID: opts.ExecutionID,
 		tokenPath:   opts.TokenPath,
 		useTLS:      opts.UseTLS,
+		tlsConfig:   opts.TLSConfig,
 		log:         opts.Log.WithName("grpc-client"),
 	}
 }
@@ -90,9 +96,12 @@ func (c *GRPCClient) Connect(ctx context.Context) error {
 
 	// Configure credentials
 	var creds credentials.TransportCredentials
-	if c.useTLS {
+	switch {
+	case c.useTLS && c.tlsConfig != nil:
+		creds = credentials.NewTLS(c.tlsConfig)
+	case c.useTLS:
 		creds = credentials.NewClientTLSFromCert(nil, "")
-	} else {
+	default:
 		creds = insecure.NewCredentials()
 	}
 
@@ -333,6 +342,64 @@ func (c *GRPCClient) ReportCompletion(ctx context.Context, success bool, errorMs
 	return nil
 }
 
+// DeliverEvents delivers events in order: each run of log entries over a log
+// stream of its own, whose response confirms the number of entries received,
+// and reports through their RPCs.
+func (c *GRPCClient) DeliverEvents(ctx context.Context, events []*Event) error {
+	c.mu.Lock()
+	if c.conn == nil {
+		c.mu.Unlock()
+		return fmt.Errorf("not connected to streaming server")
+	}
+	client := c.client
+	c.mu.Unlock()
+
+	for len(events) > 0 {
+		n := 1
+		switch e := events[0]; {
+		case e.Log != nil:
+			for n < len(events) && events[n].Log != nil {
+				n++
+			}
+			if err := deliverLogs(ctx, client, events[:n]); err != nil {
+				return err
+			}
+		case e.Progress != nil:
+			if _, err := client.ReportProgress(ctx, e.Progress); err != nil {
+				return fmt.Errorf("failed to report progress: %w", err)
+			}
+		case e.Completion != nil:
+			if _, err := client.ReportCompletion(ctx, e.Completion); err != nil {
+				return fmt.Errorf("failed to report completion: %w", err)
+			}
+		}
+		events = events[n:]
+	}
+	return nil
+}
+
+func deliverLogs(ctx context.Context, client streamingv1alpha1.ExecutionServiceClient, events []*Event) error {
+	stream, err := client.StreamLogs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open log stream: %w", err)
+	}
+
+	for _, e := range events {
+		if err := stream.Send(e.Log); err != nil {
+			return fmt.Errorf("failed to stream logs: %w", err)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("failed to close log stream: %w", err)
+	}
+	if resp.ReceivedCount != int64(len(events)) {
+		return fmt.Errorf("server received %d of %d log entries", resp.ReceivedCount, len(events))
+	}
+	return nil
+}
+
 // Close closes the log stream and gRPC connection.
 func (c *GRPCClient) Close() error {
 	c.StopHeartbeat()
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	streamingv1alpha1 "github.com/ardikabs/hibernator/api/streaming/v1alpha1"
+)
+
+// Event is a log entry, progress report or completion report awaiting its
+// acknowledgement by the control plane. Exactly one of the fields is set.
+type Event struct {
+	Log        *streamingv1alpha1.LogEntry         `json:"log,omitempty"`
+	Progress   *streamingv1alpha1.ProgressReport   `json:"progress,omitempty"`
+	Completion *streamingv1alpha1.CompletionReport `json:"completion,omitempty"`
+}
+
+// Sequence returns the sequence number of the event within its session.
+func (e *Event) Sequence() int64 {
+	switch {
+	case e.Log != nil:
+		return e.Log.Sequence
+	case e.Progress != nil:
+		return e.Progress.Sequence
+	case e.Completion != nil:
+		return e.Completion.Sequence
+	}
+	return 0
+}
+
+// outbox queues events in order until they are acknowledged. Up to memoryLimit
+// events are kept in memory, later ones are spilled to a file and read back
+// once the events before them are acknowledged. Without a spill file, log
+// entries beyond the limit are dropped; progress and completion reports are
+// always kept.
+type outbox struct {
+	mu          sync.Mutex
+	memory      []*Event
+	memoryLimit int
+
+	spillPath  string
+	spillFile  *os.File
+	readOffset int64
+	spilled    int
+
+	dropped int
+}
+
+func newOutbox(memoryLimit int, spillPath string) *outbox {
+	return &outbox{memoryLimit: memoryLimit, spillPath: spillPath}
+}
+
+// push appends an event. An error reports that the event could not be
+// spilled; it is then kept in memory unless it is a log entry.
+func (o *outbox) push(e *Event) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.spilled == 0 && len(o.memory) < o.memoryLimit {
+		o.memory = append(o.memory, e)
+		return nil
+	}
+
+	var err error
+	if o.spillPath != "" {
+		if err = o.spill(e); err == nil {
+			o.spilled++
+			return nil
+		}
+	}
+
+	if e.Log != nil {
+		o.dropped++
+		return err
+	}
+	o.memory = append(o.memory, e)
+	return err
+}
+
+func (o *outbox) spill(e *Event) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if o.spillFile == nil {
+		f, err := os.OpenFile(o.spillPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open spill file: %w", err)
+		}
+		o.spillFile = f
+	}
+
+	if _, err := o.spillFile.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to spill event: %w", err)
+	}
+	return nil
+}
+
+// peek returns up to n of the oldest events without removing them.
+func (o *outbox) peek(n int) ([]*Event, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var err error
+	if len(o.memory) == 0 && o.spilled > 0 {
+		err = o.refill()
+	}
+
+	n = min(n, len(o.memory))
+	return append([]*Event(nil), o.memory[:n]...), err
+}
+
+// refill reads the oldest spilled events back into memory. The spill file is
+// removed once all of its events are read.
+func (o *outbox) refill() error {
+	f, err := os.Open(o.spillPath)
+	if err != nil {
+		return fmt.Errorf("failed to open spill file: %w", err)
+	}
+	// nolint:errcheck
+	defer f.Close()
+
+	if _, err := f.Seek(o.readOffset, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read spill file: %w", err)
+	}
+
+	reader := bufio.NewReader(f)
+	for o.spilled > 0 && len(o.memory) < o.memoryLimit {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			// Events that did not make it to the file are lost.
+			if errors.Is(err, io.EOF) {
+				o.dropped += o.spilled
+				o.spilled = 0
+				break
+			}
+			return fmt.Errorf("failed to read spill file: %w", err)
+		}
+		o.readOffset += int64(len(line))
+		o.spilled--
+
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			o.dropped++
+			continue
+		}
+		o.memory = append(o.memory, &e)
+	}
+
+	if o.spilled == 0 {
+		o.removeSpillFile()
+	}
+	return nil
+}
+
+// ack removes the n oldest events, which were delivered.
+func (o *outbox) ack(n int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	n = min(n, len(o.memory))
+	clear(o.memory[:n])
+	o.memory = o.memory[n:]
+}
+
+// len returns the number of queued events.
+func (o *outbox) len() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.memory) + o.spilled
+}
+
+// droppedCount returns the number of log entries dropped so far.
+func (o *outbox) droppedCount() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.dropped
+}
+
+// close removes the spill file; queued events are discarded.
+func (o *outbox) close() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.removeSpillFile()
+}
+
+func (o *outbox) removeSpillFile() {
+	if o.spillFile != nil {
+		// nolint:errcheck
+		o.spillFile.Close()
+		o.spillFile = nil
+	}
+	if o.spillPath != "" {
+		// nolint:errcheck
+		os.Remove(o.spillPath)
+	}
+	o.readOffset = 0
+	o.spilled = 0
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	streamingv1alpha1 "github.com/ardikabs/hibernator/api/streaming/v1alpha1"
+)
+
+func logEvent(seq int64) *Event {
+	return &Event{Log: &streamingv1alpha1.LogEntry{Message: fmt.Sprintf("log-%d", seq), Sequence: seq}}
+}
+
+func progressEvent(seq int64) *Event {
+	return &Event{Progress: &streamingv1alpha1.ProgressReport{Phase: "Running", Sequence: seq}}
+}
+
+func sequences(events []*Event) []int64 {
+	seqs := make([]int64, 0, len(events))
+	for _, e := range events {
+		seqs = append(seqs, e.Sequence())
+	}
+	return seqs
+}
+
+func TestOutbox_SpillsBeyondMemoryLimit(t *testing.T) {
+	spillPath := filepath.Join(t.TempDir(), "outbox.jsonl")
+	box := newOutbox(2, spillPath)
+
+	for seq := int64(1); seq <= 5; seq++ {
+		require.NoError(t, box.push(logEvent(seq)))
+	}
+	require.NoError(t, box.push(progressEvent(6)))
+	assert.Equal(t, 6, box.len())
+	assert.FileExists(t, spillPath)
+
+	var delivered []int64
+	for box.len() > 0 {
+		batch, err := box.peek(10)
+		require.NoError(t, err)
+		require.NotEmpty(t, batch)
+		delivered = append(delivered, sequences(batch)...)
+		box.ack(len(batch))
+	}
+
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, delivered)
+	assert.Zero(t, box.droppedCount())
+	assert.NoFileExists(t, spillPath, "the spill file is removed once read")
+
+	require.NoError(t, box.push(logEvent(7)))
+	batch, err := box.peek(10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7}, sequences(batch))
+}
+
+func TestOutbox_PeekKeepsEventsUntilAcknowledged(t *testing.T) {
+	box := newOutbox(10, "")
+	for seq := int64(1); seq <= 3; seq++ {
+		require.NoError(t, box.push(logEvent(seq)))
+	}
+
+	batch, err := box.peek(2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, sequences(batch))
+
+	batch, err = box.peek(2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, sequences(batch), "replayed until acknowledged")
+
+	box.ack(2)
+	batch, err = box.peek(2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, sequences(batch))
+}
+
+func TestOutbox_DropsOnlyLogsWithoutSpillFile(t *testing.T) {
+	box := newOutbox(1, "")
+
+	require.NoError(t, box.push(logEvent(1)))
+	require.NoError(t, box.push(logEvent(2)))
+	require.NoError(t, box.push(progressEvent(3)))
+
+	batch, err := box.peek(10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, sequences(batch))
+	assert.Equal(t, 1, box.droppedCount())
+}
+
+func TestOutbox_KeepsReportsWhenSpillFails(t *testing.T) {
+	box := newOutbox(1, filepath.Join(t.TempDir(), "missing", "outbox.jsonl"))
+
+	require.NoError(t, box.push(logEvent(1)))
+	assert.Error(t, box.push(logEvent(2)))
+	assert.Error(t, box.push(progressEvent(3)))
+
+	batch, err := box.peek(10)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, sequences(batch))
+}
+
+func TestOutbox_CloseRemovesSpillFile(t *testing.T) {
+	spillPath := filepath.Join(t.TempDir(), "outbox.jsonl")
+	box := newOutbox(1, spillPath)
+	require.NoError(t, box.push(logEvent(1)))
+	require.NoError(t, box.push(logEvent(2)))
+
+	box.close()
+	_, err := os.Stat(spillPath)
+	assert.True(t, os.IsNotExist(err))
+}
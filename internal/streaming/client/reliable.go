@@ -0,0 +1,378 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+
+	streamingv1alpha1 "github.com/ardikabs/hibernator/api/streaming/v1alpha1"
+)
+
+const (
+	// DefaultOutboxMemoryLimit is the number of undelivered events kept in memory.
+	DefaultOutboxMemoryLimit = 1000
+
+	// DefaultDeliveryBatchSize is the maximum number of events delivered at once.
+	DefaultDeliveryBatchSize = 100
+
+	// DefaultDeliveryTimeout bounds the delivery of one batch of events.
+	DefaultDeliveryTimeout = 30 * time.Second
+
+	// DefaultRetryInterval is the initial delay before undelivered events are replayed.
+	DefaultRetryInterval = time.Second
+
+	// DefaultMaxRetryInterval caps the delay between replays.
+	DefaultMaxRetryInterval = 30 * time.Second
+
+	// DefaultDrainTimeout is how long Close waits for queued events to be delivered.
+	DefaultDrainTimeout = 30 * time.Second
+)
+
+// EventDeliverer is a streaming client that confirms the delivery of events.
+type EventDeliverer interface {
+	StreamingClient
+
+	// DeliverEvents delivers events in order and returns once the server
+	// acknowledged all of them. Events may be delivered again after an error.
+	DeliverEvents(ctx context.Context, events []*Event) error
+}
+
+// CancellationReporter is a streaming client that reports executions cancelled
+// before they finished.
+type CancellationReporter interface {
+	// ReportCancellation sends a completion report marked cancelled.
+	ReportCancellation(ctx context.Context, message string, durationMs int64) error
+}
+
+// FailureReporter is a streaming client that reports failed executions along
+// with the class of their failure.
+type FailureReporter interface {
+	// ReportFailure sends a failed completion report carrying reason, e.g.
+	// AuthError or Throttled.
+	ReportFailure(ctx context.Context, reason, message string, durationMs int64) error
+}
+
+// ResourceProgressReporter is a streaming client that reports how many of its
+// target's resources an execution has processed.
+type ResourceProgressReporter interface {
+	// ReportResourceProgress sends a progress update carrying per-resource
+	// counts and the resource processed last.
+	ReportResourceProgress(ctx context.Context, phase string, percent int32, message string, completed, total int32, resource string) error
+}
+
+// ReliableClientOptions configures the reliable client.
+type ReliableClientOptions struct {
+	ExecutionID string
+
+	// SpillDir holds the events beyond MemoryLimit until they are delivered.
+	// Without it, log entries beyond the limit are dropped.
+	SpillDir string
+
+	MemoryLimit      int
+	BatchSize        int
+	RetryInterval    time.Duration
+	MaxRetryInterval time.Duration
+	DrainTimeout     time.Duration
+	Log              logr.Logger
+}
+
+// ReliableClient delivers log entries, progress and completion reports at least
+// once. Events are numbered per session and queued in an outbox; a background
+// sender delivers them through the transport and replays the unacknowledged
+// ones after failures such as transient disconnects, reconnecting in between.
+// Each control plane replica skips the sequences of a session it already
+// processed; events replayed to another replica may be processed twice.
+type ReliableClient struct {
+	transport   EventDeliverer
+	executionID string
+	sessionID   string
+	outbox      *outbox
+	opts        ReliableClientOptions
+	log         logr.Logger
+
+	// mu orders sequence assignment with queueing.
+	mu       sync.Mutex
+	sequence int64
+
+	wake   chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	startOnce sync.Once
+	closeOnce sync.Once
+	started   bool
+}
+
+// NewReliableClient creates a client delivering events through transport.
+func NewReliableClient(transport EventDeliverer, opts ReliableClientOptions) *ReliableClient {
+	if opts.MemoryLimit <= 0 {
+		opts.MemoryLimit = DefaultOutboxMemoryLimit
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultDeliveryBatchSize
+	}
+	if opts.RetryInterval <= 0 {
+		opts.RetryInterval = DefaultRetryInterval
+	}
+	if opts.MaxRetryInterval <= 0 {
+		opts.MaxRetryInterval = DefaultMaxRetryInterval
+	}
+	if opts.DrainTimeout <= 0 {
+		opts.DrainTimeout = DefaultDrainTimeout
+	}
+
+	sessionID := uuid.NewString()
+	var spillPath string
+	if opts.SpillDir != "" {
+		spillPath = filepath.Join(opts.SpillDir, fmt.Sprintf("%s-%s.jsonl", opts.ExecutionID, sessionID))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ReliableClient{
+		transport:   transport,
+		executionID: opts.ExecutionID,
+		sessionID:   sessionID,
+		outbox:      newOutbox(opts.MemoryLimit, spillPath),
+		opts:        opts,
+		log:         opts.Log.WithName("reliable-client"),
+		wake:        make(chan struct{}, 1),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+// Connect connects the transport and starts delivering queued events.
+func (c *ReliableClient) Connect(ctx context.Context) error {
+	if err := c.transport.Connect(ctx); err != nil {
+		return err
+	}
+
+	c.startOnce.Do(func() {
+		c.mu.Lock()
+		c.started = true
+		c.mu.Unlock()
+		go c.run()
+	})
+	return nil
+}
+
+// StartHeartbeat starts the heartbeat of the transport.
+func (c *ReliableClient) StartHeartbeat(interval time.Duration) {
+	c.transport.StartHeartbeat(interval)
+}
+
+// StopHeartbeat stops the heartbeat of the transport.
+func (c *ReliableClient) StopHeartbeat() {
+	c.transport.StopHeartbeat()
+}
+
+// Log queues a log entry for delivery.
+func (c *ReliableClient) Log(ctx context.Context, level, message string, fields map[string]string) error {
+	c.enqueue(func(seq int64) *Event {
+		return &Event{Log: &streamingv1alpha1.LogEntry{
+			ExecutionId: c.executionID,
+			Timestamp:   time.Now().Format(time.RFC3339),
+			Level:       level,
+			Message:     message,
+			Fields:      fields,
+			Sequence:    seq,
+			SessionId:   c.sessionID,
+		}}
+	})
+	return nil
+}
+
+// ReportProgress queues a progress report for delivery.
+func (c *ReliableClient) ReportProgress(ctx context.Context, phase string, percent int32, message string) error {
+	c.enqueue(func(seq int64) *Event {
+		return &Event{Progress: &streamingv1alpha1.ProgressReport{
+			ExecutionId:     c.executionID,
+			Phase:           phase,
+			ProgressPercent: percent,
+			Message:         message,
+			Timestamp:       time.Now().Format(time.RFC3339),
+			Sequence:        seq,
+			SessionId:       c.sessionID,
+		}}
+	})
+	return nil
+}
+
+// ReportResourceProgress queues a per-resource progress report for delivery.
+func (c *ReliableClient) ReportResourceProgress(ctx context.Context, phase string, percent int32, message string, completed, total int32, resource string) error {
+	c.enqueue(func(seq int64) *Event {
+		return &Event{Progress: &streamingv1alpha1.ProgressReport{
+			ExecutionId:        c.executionID,
+			Phase:              phase,
+			ProgressPercent:    percent,
+			Message:            message,
+			Timestamp:          time.Now().Format(time.RFC3339),
+			Sequence:           seq,
+			SessionId:          c.sessionID,
+			CompletedResources: completed,
+			TotalResources:     total,
+			Resource:           resource,
+		}}
+	})
+	return nil
+}
+
+// ReportCompletion queues a completion report for delivery. Close waits for it
+// to be delivered.
+func (c *ReliableClient) ReportCompletion(ctx context.Context, success bool, errorMsg string, durationMs int64) error {
+	c.enqueue(func(seq int64) *Event {
+		return &Event{Completion: &streamingv1alpha1.CompletionReport{
+			ExecutionId:  c.executionID,
+			Success:      success,
+			ErrorMessage: errorMsg,
+			DurationMs:   durationMs,
+			Timestamp:    time.Now().Format(time.RFC3339),
+			Sequence:     seq,
+			SessionId:    c.sessionID,
+		}}
+	})
+	return nil
+}
+
+// ReportCancellation queues a completion report marked cancelled for
+// delivery. Close waits for it to be delivered.
+func (c *ReliableClient) ReportCancellation(ctx context.Context, message string, durationMs int64) error {
+	c.enqueue(func(seq int64) *Event {
+		return &Event{Completion: &streamingv1alpha1.CompletionReport{
+			ExecutionId:  c.executionID,
+			ErrorMessage: message,
+			DurationMs:   durationMs,
+			Timestamp:    time.Now().Format(time.RFC3339),
+			Sequence:     seq,
+			SessionId:    c.sessionID,
+			Cancelled:    true,
+		}}
+	})
+	return nil
+}
+
+// ReportFailure queues a failed completion report carrying the class of the
+// failure for delivery. Close waits for it to be delivered.
+func (c *ReliableClient) ReportFailure(ctx context.Context, reason, message string, durationMs int64) error {
+	c.enqueue(func(seq int64) *Event {
+		return &Event{Completion: &streamingv1alpha1.CompletionReport{
+			ExecutionId:   c.executionID,
+			ErrorMessage:  message,
+			DurationMs:    durationMs,
+			Timestamp:     time.Now().Format(time.RFC3339),
+			Sequence:      seq,
+			SessionId:     c.sessionID,
+			FailureReason: reason,
+		}}
+	})
+	return nil
+}
+
+// Close waits up to the drain timeout for queued events to be delivered,
+// then closes the transport.
+func (c *ReliableClient) Close() error {
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		started := c.started
+		c.mu.Unlock()
+
+		if started {
+			close(c.stop)
+			select {
+			case <-c.done:
+			case <-time.After(c.opts.DrainTimeout):
+				c.log.Info("giving up delivering events", "pending", c.outbox.len())
+				c.cancel()
+				<-c.done
+			}
+		}
+		c.cancel()
+
+		if dropped := c.outbox.droppedCount(); dropped > 0 {
+			c.log.Info("dropped log entries beyond the outbox limit", "dropped", dropped)
+		}
+		c.outbox.close()
+	})
+	return c.transport.Close()
+}
+
+func (c *ReliableClient) enqueue(build func(seq int64) *Event) {
+	c.mu.Lock()
+	c.sequence++
+	err := c.outbox.push(build(c.sequence))
+	c.mu.Unlock()
+
+	if err != nil {
+		c.log.V(1).Info("failed to spill event", "error", err.Error())
+	}
+
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers queued events until the client is closed and the outbox is
+// empty, or the drain timeout passed.
+func (c *ReliableClient) run() {
+	defer close(c.done)
+
+	backoff := c.opts.RetryInterval
+	for {
+		batch, err := c.outbox.peek(c.opts.BatchSize)
+		if err != nil {
+			c.log.Error(err, "failed to read queued events")
+		}
+
+		if len(batch) == 0 {
+			select {
+			case <-c.wake:
+			case <-c.stop:
+				if c.outbox.len() == 0 {
+					return
+				}
+			case <-c.ctx.Done():
+				return
+			}
+			continue
+		}
+
+		if err := c.deliver(batch); err != nil {
+			c.log.V(1).Info("failed to deliver events, replaying", "events", len(batch), "retryIn", backoff, "error", err.Error())
+			select {
+			case <-time.After(backoff):
+			case <-c.ctx.Done():
+				return
+			}
+			backoff = min(backoff*2, c.opts.MaxRetryInterval)
+
+			if err := c.transport.Connect(c.ctx); err != nil {
+				c.log.V(1).Info("failed to reconnect", "error", err.Error())
+			}
+			continue
+		}
+
+		backoff = c.opts.RetryInterval
+		c.outbox.ack(len(batch))
+	}
+}
+
+func (c *ReliableClient) deliver(batch []*Event) error {
+	ctx, cancel := context.WithTimeout(c.ctx, DefaultDeliveryTimeout)
+	defer cancel()
+	return c.transport.DeliverEvents(ctx, batch)
+}
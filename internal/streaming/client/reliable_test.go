@@ -0,0 +1,192 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyTransport fails the first failures deliveries and records the others.
+type flakyTransport struct {
+	mu        sync.Mutex
+	failures  int
+	attempts  int
+	connects  int
+	closed    bool
+	delivered []*Event
+}
+
+func (f *flakyTransport) Connect(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.connects++
+	return nil
+}
+
+func (f *flakyTransport) StartHeartbeat(interval time.Duration) {}
+func (f *flakyTransport) StopHeartbeat()                        {}
+
+func (f *flakyTransport) Log(ctx context.Context, level, message string, fields map[string]string) error {
+	return errors.New("not used")
+}
+
+func (f *flakyTransport) ReportProgress(ctx context.Context, phase string, percent int32, message string) error {
+	return errors.New("not used")
+}
+
+func (f *flakyTransport) ReportCompletion(ctx context.Context, success bool, errorMsg string, durationMs int64) error {
+	return errors.New("not used")
+}
+
+func (f *flakyTransport) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func (f *flakyTransport) DeliverEvents(ctx context.Context, events []*Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.attempts++
+	if f.failures < 0 || f.attempts <= f.failures {
+		return errors.New("connection reset")
+	}
+	f.delivered = append(f.delivered, events...)
+	return nil
+}
+
+func TestReliableClient_ReplaysUntilDelivered(t *testing.T) {
+	transport := &flakyTransport{failures: 2}
+	c := NewReliableClient(transport, ReliableClientOptions{
+		ExecutionID:   "exec-1",
+		SpillDir:      t.TempDir(),
+		MemoryLimit:   2,
+		RetryInterval: time.Millisecond,
+		Log:           logr.Discard(),
+	})
+	require.NoError(t, c.Connect(context.Background()))
+
+	require.NoError(t, c.Log(context.Background(), "INFO", "starting", nil))
+	require.NoError(t, c.ReportProgress(context.Background(), "Running", 50, "halfway"))
+	require.NoError(t, c.Log(context.Background(), "INFO", "stopping", nil))
+	require.NoError(t, c.ReportCompletion(context.Background(), true, "", 1200))
+	require.NoError(t, c.Close())
+
+	assert.True(t, transport.closed)
+	assert.GreaterOrEqual(t, transport.connects, 3, "reconnects after failed deliveries")
+	require.Equal(t, []int64{1, 2, 3, 4}, sequences(transport.delivered))
+
+	completion := transport.delivered[3].Completion
+	require.NotNil(t, completion)
+	assert.Equal(t, "exec-1", completion.ExecutionId)
+	assert.True(t, completion.Success)
+	assert.Equal(t, int64(1200), completion.DurationMs)
+	assert.NotEmpty(t, completion.SessionId)
+	assert.Equal(t, completion.SessionId, transport.delivered[0].Log.SessionId)
+}
+
+func TestReliableClient_ReportCancellation(t *testing.T) {
+	transport := &flakyTransport{}
+	c := NewReliableClient(transport, ReliableClientOptions{
+		ExecutionID:   "exec-1",
+		SpillDir:      t.TempDir(),
+		RetryInterval: time.Millisecond,
+		Log:           logr.Discard(),
+	})
+	require.NoError(t, c.Connect(context.Background()))
+
+	require.NoError(t, c.ReportCancellation(context.Background(), "execution cancelled: context canceled", 800))
+	require.NoError(t, c.Close())
+
+	require.Len(t, transport.delivered, 1)
+	completion := transport.delivered[0].Completion
+	require.NotNil(t, completion)
+	assert.True(t, completion.Cancelled)
+	assert.False(t, completion.Success)
+	assert.Equal(t, "execution cancelled: context canceled", completion.ErrorMessage)
+	assert.Equal(t, int64(800), completion.DurationMs)
+}
+
+func TestReliableClient_ReportFailure(t *testing.T) {
+	transport := &flakyTransport{}
+	c := NewReliableClient(transport, ReliableClientOptions{
+		ExecutionID:   "exec-1",
+		SpillDir:      t.TempDir(),
+		RetryInterval: time.Millisecond,
+		Log:           logr.Discard(),
+	})
+	require.NoError(t, c.Connect(context.Background()))
+
+	require.NoError(t, c.ReportFailure(context.Background(), "AuthError", "api error ExpiredToken: token expired", 300))
+	require.NoError(t, c.Close())
+
+	require.Len(t, transport.delivered, 1)
+	completion := transport.delivered[0].Completion
+	require.NotNil(t, completion)
+	assert.False(t, completion.Success)
+	assert.False(t, completion.Cancelled)
+	assert.Equal(t, "AuthError", completion.FailureReason)
+	assert.Equal(t, "api error ExpiredToken: token expired", completion.ErrorMessage)
+}
+
+func TestReliableClient_ReportResourceProgress(t *testing.T) {
+	transport := &flakyTransport{}
+	c := NewReliableClient(transport, ReliableClientOptions{
+		ExecutionID:   "exec-1",
+		SpillDir:      t.TempDir(),
+		RetryInterval: time.Millisecond,
+		Log:           logr.Discard(),
+	})
+	require.NoError(t, c.Connect(context.Background()))
+
+	require.NoError(t, c.ReportResourceProgress(context.Background(), "executing", 61, "stopped 7/24 RDS resource(s)", 7, 24, "instance:db-7"))
+	require.NoError(t, c.Close())
+
+	require.Len(t, transport.delivered, 1)
+	progress := transport.delivered[0].Progress
+	require.NotNil(t, progress)
+	assert.Equal(t, int32(61), progress.ProgressPercent)
+	assert.Equal(t, int32(7), progress.CompletedResources)
+	assert.Equal(t, int32(24), progress.TotalResources)
+	assert.Equal(t, "instance:db-7", progress.Resource)
+}
+
+func TestReliableClient_CloseGivesUpAfterDrainTimeout(t *testing.T) {
+	transport := &flakyTransport{failures: -1}
+	c := NewReliableClient(transport, ReliableClientOptions{
+		ExecutionID:   "exec-1",
+		RetryInterval: time.Millisecond,
+		DrainTimeout:  50 * time.Millisecond,
+		Log:           logr.Discard(),
+	})
+	require.NoError(t, c.Connect(context.Background()))
+	require.NoError(t, c.ReportCompletion(context.Background(), false, "boom", 10))
+
+	start := time.Now()
+	require.NoError(t, c.Close())
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.True(t, transport.closed)
+	assert.Empty(t, transport.delivered)
+}
+
+func TestReliableClient_CloseWithoutConnect(t *testing.T) {
+	transport := &flakyTransport{}
+	c := NewReliableClient(transport, ReliableClientOptions{ExecutionID: "exec-1", Log: logr.Discard()})
+	require.NoError(t, c.Log(context.Background(), "INFO", "queued", nil))
+
+	require.NoError(t, c.Close())
+	assert.True(t, transport.closed)
+	assert.Zero(t, transport.attempts)
+}
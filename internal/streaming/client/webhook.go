@@ -8,6 +8,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -44,7 +45,9 @@ type WebhookClientOptions struct {
 	ExecutionID string
 	TokenPath   string
 	Timeout     time.Duration
-	Log         logr.Logger
+	// TLSConfig configures https:// requests, e.g. to present a client certificate.
+	TLSConfig *tls.Config
+	Log       logr.Logger
 }
 
 // NewWebhookClient creates a new webhook client for runner-to-controller communication.
@@ -56,10 +59,15 @@ func NewWebhookClient(opts WebhookClientOptions) *WebhookClient {
 		opts.Timeout = 30 * time.Second
 	}
 
+	httpClient := &http.Client{Timeout: opts.Timeout}
+	if opts.TLSConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = opts.TLSConfig
+		httpClient.Transport = transport
+	}
+
 	return &WebhookClient{
-		httpClient: &http.Client{
-			Timeout: opts.Timeout,
-		},
+		httpClient:  httpClient,
 		baseURL:     opts.BaseURL,
 		executionID: opts.ExecutionID,
 		tokenPath:   opts.TokenPath,
@@ -230,6 +238,61 @@ func (c *WebhookClient) ReportCompletion(ctx context.Context, success bool, erro
 	return nil
 }
 
+// DeliverEvents delivers events in order: each run of log entries in a single
+// request, and reports through their endpoints.
+func (c *WebhookClient) DeliverEvents(ctx context.Context, events []*Event) error {
+	for len(events) > 0 {
+		n := 1
+		var err error
+		switch e := events[0]; {
+		case e.Log != nil:
+			for n < len(events) && events[n].Log != nil {
+				n++
+			}
+			entries := make([]*streamingv1alpha1.LogEntry, 0, n)
+			for _, e := range events[:n] {
+				entries = append(entries, e.Log)
+			}
+			err = c.postAcknowledged(ctx, "/v1alpha1/logs", entries)
+		case e.Progress != nil:
+			err = c.postAcknowledged(ctx, "/v1alpha1/progress", e.Progress)
+		case e.Completion != nil:
+			err = c.postAcknowledged(ctx, "/v1alpha1/completion", e.Completion)
+		}
+		if err != nil {
+			return err
+		}
+		events = events[n:]
+	}
+	return nil
+}
+
+// postAcknowledged posts payload and fails unless the server acknowledges it.
+func (c *WebhookClient) postAcknowledged(ctx context.Context, path string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return err
+	}
+	// nolint:errcheck
+	defer resp.Body.Close()
+
+	var response struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if !response.Acknowledged {
+		return fmt.Errorf("%s not acknowledged", path)
+	}
+	return nil
+}
+
 // Close stops the heartbeat (HTTP connections are stateless).
 func (c *WebhookClient) Close() error {
 	c.StopHeartbeat()
@@ -7,6 +7,7 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -34,16 +35,23 @@ const (
 
 // WebSocketMessage wraps messages sent over WebSocket.
 type WebSocketMessage struct {
-	Type string          `json:"type"` // "log", "progress", "completion", "heartbeat"
+	Type string          `json:"type"` // "log", "progress", "completion", "heartbeat", "ack"
 	Data json.RawMessage `json:"data"`
 }
 
+// WebSocketAck is the data of the "ack" message the server replies with once
+// it processed a message with a sequence.
+type WebSocketAck struct {
+	Sequence int64 `json:"sequence"`
+}
+
 // WebSocketClient provides streaming communication with the control plane via WebSocket.
 type WebSocketClient struct {
 	conn        *websocket.Conn
 	url         string
 	executionID string
 	tokenPath   string
+	tlsConfig   *tls.Config
 	log         logr.Logger
 
 	// heartbeat management
@@ -51,6 +59,11 @@ type WebSocketClient struct {
 	heartbeatCancel context.CancelFunc
 	heartbeatWg     sync.WaitGroup
 
+	// acks receives the sequences acknowledged by the server; readDone is
+	// closed when the reader of the current connection stops.
+	acks     chan int64
+	readDone chan struct{}
+
 	// connection management
 	writeMu sync.Mutex
 	mu      sync.Mutex
@@ -61,7 +74,9 @@ type WebSocketClientOptions struct {
 	URL         string
 	ExecutionID string
 	TokenPath   string
-	Log         logr.Logger
+	// TLSConfig configures wss:// connections, e.g. to present a client certificate.
+	TLSConfig *tls.Config
+	Log       logr.Logger
 }
 
 // NewWebSocketClient creates a new WebSocket client for runner-to-controller communication.
@@ -74,7 +89,9 @@ func NewWebSocketClient(opts WebSocketClientOptions) *WebSocketClient {
 		url:         opts.URL,
 		executionID: opts.ExecutionID,
 		tokenPath:   opts.TokenPath,
+		tlsConfig:   opts.TLSConfig,
 		log:         opts.Log.WithName("websocket-client"),
+		acks:        make(chan int64, DefaultDeliveryBatchSize),
 	}
 }
 
@@ -108,6 +125,7 @@ func (c *WebSocketClient) Connect(ctx context.Context) error {
 	c.log.Info("connecting to WebSocket server", "url", wsURL)
 	dialer := websocket.Dialer{
 		HandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:  c.tlsConfig,
 	}
 
 	conn, resp, err := dialer.DialContext(ctx, wsURL, header)
@@ -125,23 +143,68 @@ func (c *WebSocketClient) Connect(ctx context.Context) error {
 		}
 	}()
 
+	c.writeMu.Lock()
 	c.conn = conn
+	c.writeMu.Unlock()
 	c.log.Info("WebSocket connection established")
 
 	// Set ping/pong handlers
-	c.conn.SetPingHandler(func(appData string) error {
+	conn.SetPingHandler(func(appData string) error {
 		c.log.V(2).Info("received ping from server")
-		return c.conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(DefaultWebSocketWriteTimeout))
+		return conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(DefaultWebSocketWriteTimeout))
 	})
 
-	c.conn.SetPongHandler(func(appData string) error {
+	conn.SetPongHandler(func(appData string) error {
 		c.log.V(2).Info("received pong from server")
 		return nil
 	})
 
+	// Control messages are only handled while reading.
+	c.readDone = make(chan struct{})
+	go c.readMessages(conn, c.readDone)
+
 	return nil
 }
 
+// readMessages reads the messages of conn, forwarding acknowledgements, until
+// the connection fails. A failed connection is dropped, so the next Connect
+// dials again.
+func (c *WebSocketClient) readMessages(conn *websocket.Conn, done chan struct{}) {
+	defer close(done)
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			c.mu.Lock()
+			c.writeMu.Lock()
+			if c.conn == conn {
+				c.conn = nil
+				// nolint:errcheck
+				conn.Close()
+				c.log.Info("WebSocket connection lost", "error", err.Error())
+			}
+			c.writeMu.Unlock()
+			c.mu.Unlock()
+			return
+		}
+
+		var msg WebSocketMessage
+		if err := json.Unmarshal(data, &msg); err != nil || msg.Type != "ack" {
+			continue
+		}
+		var ack WebSocketAck
+		if err := json.Unmarshal(msg.Data, &ack); err != nil {
+			continue
+		}
+
+		// Unclaimed acknowledgements are dropped; their events are replayed.
+		select {
+		case c.acks <- ack.Sequence:
+		default:
+		}
+	}
+}
+
 // buildURL constructs the WebSocket URL with execution ID.
 func (c *WebSocketClient) buildURL() (string, error) {
 	u, err := url.Parse(c.url)
@@ -320,6 +383,66 @@ func (c *WebSocketClient) ReportCompletion(ctx context.Context, success bool, er
 	return c.sendMessage(msg)
 }
 
+// DeliverEvents sends events in order and waits until the server acknowledged
+// each of them.
+func (c *WebSocketClient) DeliverEvents(ctx context.Context, events []*Event) error {
+	c.mu.Lock()
+	readDone := c.readDone
+	c.mu.Unlock()
+
+	// Discard acknowledgements left over from an earlier attempt.
+	for len(c.acks) > 0 {
+		<-c.acks
+	}
+
+	pending := make(map[int64]struct{}, len(events))
+	for _, e := range events {
+		msg, err := webSocketMessageOf(e)
+		if err != nil {
+			return err
+		}
+		if err := c.sendMessage(msg); err != nil {
+			return err
+		}
+		pending[e.Sequence()] = struct{}{}
+	}
+
+	for len(pending) > 0 {
+		select {
+		case seq := <-c.acks:
+			delete(pending, seq)
+		case <-readDone:
+			return fmt.Errorf("WebSocket connection lost with %d events unacknowledged", len(pending))
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for acknowledgements: %w", ctx.Err())
+		}
+	}
+	return nil
+}
+
+func webSocketMessageOf(e *Event) (WebSocketMessage, error) {
+	var (
+		msgType string
+		payload any
+	)
+	switch {
+	case e.Log != nil:
+		msgType, payload = "log", e.Log
+	case e.Progress != nil:
+		msgType, payload = "progress", e.Progress
+	case e.Completion != nil:
+		msgType, payload = "completion", e.Completion
+	default:
+		return WebSocketMessage{}, fmt.Errorf("empty event")
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return WebSocketMessage{}, fmt.Errorf("failed to marshal %s: %w", msgType, err)
+	}
+	return WebSocketMessage{Type: msgType, Data: data}, nil
+}
+
 // sendMessage sends a WebSocket message to the server.
 func (c *WebSocketClient) sendMessage(msg WebSocketMessage) error {
 	c.writeMu.Lock()
@@ -378,7 +501,9 @@ func (c *WebSocketClient) Close() error {
 		}
 	}
 
+	c.writeMu.Lock()
 	c.conn = nil
+	c.writeMu.Unlock()
 	c.log.Info("WebSocket connection closed")
 	return err
 }
@@ -512,3 +512,92 @@ func TestWebSocketMessage_Marshal(t *testing.T) {
 		t.Errorf("type = %s, want %s", decoded.Type, msg.Type)
 	}
 }
+
+func TestWebSocketClient_DeliverEvents_WaitsForAcks(t *testing.T) {
+	var upgrader = websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool { return true },
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _ := upgrader.Upgrade(w, r, nil)
+		defer conn.Close()
+
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg WebSocketMessage
+			json.Unmarshal(data, &msg)
+			var ack WebSocketAck
+			json.Unmarshal(msg.Data, &ack)
+
+			reply, _ := json.Marshal(ack)
+			conn.WriteJSON(WebSocketMessage{Type: "ack", Data: reply})
+		}
+	}))
+	defer server.Close()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	os.WriteFile(tokenPath, []byte("test-token"), 0600)
+
+	client := NewWebSocketClient(WebSocketClientOptions{
+		URL:         server.URL,
+		ExecutionID: "exec-deliver",
+		TokenPath:   tokenPath,
+		Log:         logr.Discard(),
+	})
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	events := []*Event{logEvent(1), progressEvent(2), {Completion: &streamingv1alpha1.CompletionReport{Success: true, Sequence: 3}}}
+	if err := client.DeliverEvents(ctx, events); err != nil {
+		t.Fatalf("DeliverEvents() error = %v", err)
+	}
+}
+
+func TestWebSocketClient_DeliverEvents_ConnectionLost(t *testing.T) {
+	var upgrader = websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool { return true },
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _ := upgrader.Upgrade(w, r, nil)
+		// Close without acknowledging anything.
+		conn.ReadMessage()
+		conn.Close()
+	}))
+	defer server.Close()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	os.WriteFile(tokenPath, []byte("test-token"), 0600)
+
+	client := NewWebSocketClient(WebSocketClientOptions{
+		URL:         server.URL,
+		ExecutionID: "exec-lost",
+		TokenPath:   tokenPath,
+		Log:         logr.Discard(),
+	})
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := client.DeliverEvents(ctx, []*Event{progressEvent(1)}); err == nil {
+		t.Fatal("DeliverEvents() succeeded without acknowledgement")
+	}
+	if ctx.Err() != nil {
+		t.Fatal("expected the lost connection to be detected before the timeout")
+	}
+
+	// The lost connection is dropped, so Connect dials again.
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("reconnect error = %v", err)
+	}
+}
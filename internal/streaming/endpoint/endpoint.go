@@ -0,0 +1,53 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package endpoint builds the streaming addresses runners use to reach the
+// control plane. Endpoints may be DNS names, IPv4 literals, or IPv6 literals
+// with or without brackets; IPv6 literals are always bracketed when a port or
+// URL scheme is added, so the same endpoint works on IPv4, IPv6 and
+// dual-stack clusters.
+package endpoint
+
+import (
+	"net"
+	"strings"
+
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// Host returns the bare host of a control-plane endpoint, stripping the
+// brackets of an IPv6 literal ("[fd00::1]" becomes "fd00::1").
+func Host(endpoint string) string {
+	endpoint = strings.TrimSpace(endpoint)
+	if strings.HasPrefix(endpoint, "[") && strings.HasSuffix(endpoint, "]") {
+		return endpoint[1 : len(endpoint)-1]
+	}
+	return endpoint
+}
+
+// GRPCAddress returns the host:port address of the gRPC streaming server.
+func GRPCAddress(endpoint string) string {
+	return net.JoinHostPort(Host(endpoint), wellknown.StreamGRPCPort)
+}
+
+// WebSocketURL returns the URL of the WebSocket streaming server, using wss
+// when the server is served over TLS.
+func WebSocketURL(endpoint string, secure bool) string {
+	scheme := "ws://"
+	if secure {
+		scheme = "wss://"
+	}
+	return scheme + net.JoinHostPort(Host(endpoint), wellknown.StreamWebSocketPort)
+}
+
+// HTTPCallbackURL returns the base URL of the HTTP callback server, using
+// https when the server is served over TLS.
+func HTTPCallbackURL(endpoint string, secure bool) string {
+	scheme := "http://"
+	if secure {
+		scheme = "https://"
+	}
+	return scheme + net.JoinHostPort(Host(endpoint), wellknown.StreamWebSocketPort)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddresses(t *testing.T) {
+	tests := []struct {
+		endpoint  string
+		host      string
+		grpc      string
+		websocket string
+		callback  string
+	}{
+		{
+			endpoint:  "hibernator.hibernator-system.svc",
+			host:      "hibernator.hibernator-system.svc",
+			grpc:      "hibernator.hibernator-system.svc:9444",
+			websocket: "ws://hibernator.hibernator-system.svc:8082",
+			callback:  "http://hibernator.hibernator-system.svc:8082",
+		},
+		{
+			endpoint:  "10.96.0.20",
+			host:      "10.96.0.20",
+			grpc:      "10.96.0.20:9444",
+			websocket: "ws://10.96.0.20:8082",
+			callback:  "http://10.96.0.20:8082",
+		},
+		{
+			endpoint:  "fd00:10:96::20",
+			host:      "fd00:10:96::20",
+			grpc:      "[fd00:10:96::20]:9444",
+			websocket: "ws://[fd00:10:96::20]:8082",
+			callback:  "http://[fd00:10:96::20]:8082",
+		},
+		{
+			endpoint:  "[fd00:10:96::20]",
+			host:      "fd00:10:96::20",
+			grpc:      "[fd00:10:96::20]:9444",
+			websocket: "ws://[fd00:10:96::20]:8082",
+			callback:  "http://[fd00:10:96::20]:8082",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			assert.Equal(t, tt.host, Host(tt.endpoint))
+			assert.Equal(t, tt.grpc, GRPCAddress(tt.endpoint))
+			assert.Equal(t, tt.websocket, WebSocketURL(tt.endpoint, false))
+			assert.Equal(t, tt.callback, HTTPCallbackURL(tt.endpoint, false))
+		})
+	}
+}
+
+func TestSecureURLs(t *testing.T) {
+	assert.Equal(t, "wss://[fd00:10:96::20]:8082", WebSocketURL("fd00:10:96::20", true))
+	assert.Equal(t, "https://hibernator.hibernator-system.svc:8082", HTTPCallbackURL("hibernator.hibernator-system.svc", true))
+}
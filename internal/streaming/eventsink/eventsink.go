@@ -0,0 +1,204 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package eventsink republishes the progress and completion events runners
+// report to the streaming servers onto a message bus, NATS or Kafka, so
+// platform consumers can follow hibernation lifecycles without polling the
+// Kubernetes API.
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/ardikabs/hibernator/internal/metrics"
+	"github.com/ardikabs/hibernator/internal/streaming/server"
+)
+
+const (
+	// TypeNATS publishes events to a NATS subject.
+	TypeNATS = "nats"
+
+	// TypeKafka produces events to a Kafka topic through a Kafka REST Proxy.
+	TypeKafka = "kafka"
+
+	// DefaultTopic is the subject or topic events are published to by default.
+	DefaultTopic = "hibernator.executions"
+
+	// bufferSize is the number of events queued while the bus is slow or
+	// unreachable. Events beyond it are dropped.
+	bufferSize = 1024
+
+	// publishTimeout bounds the publication of a single event.
+	publishTimeout = 10 * time.Second
+
+	// drainTimeout bounds the publication of queued events on shutdown.
+	drainTimeout = 5 * time.Second
+)
+
+// Config selects the bus events are republished to.
+type Config struct {
+	// Type is TypeNATS or TypeKafka. Empty disables the sink.
+	Type string
+
+	// URL is the comma-separated NATS server URLs, or the base URL of the
+	// Kafka REST Proxy. Credentials may be given as its user info.
+	URL string
+
+	// Topic is the NATS subject or the Kafka topic.
+	Topic string
+}
+
+// Enabled reports whether events are republished.
+func (c Config) Enabled() bool {
+	return c.Type != ""
+}
+
+// Message is an encoded event. Key groups the events of a plan, so that
+// partitioned buses keep them in order.
+type Message struct {
+	Key     string
+	Payload []byte
+}
+
+// Transport delivers messages to a bus.
+type Transport interface {
+	Send(ctx context.Context, msg Message) error
+	Close() error
+}
+
+// Relay is a controller-runtime Runnable that queues the events of the
+// execution service and publishes them in the background, so that a slow or
+// unreachable bus never delays the runners reporting them. It implements
+// server.EventSink.
+type Relay struct {
+	log       logr.Logger
+	sink      string
+	transport Transport
+	events    chan server.WatchEvent
+}
+
+// New returns a Relay publishing to the bus configured by cfg.
+func New(cfg Config, log logr.Logger) (*Relay, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("event sink URL is required")
+	}
+	if cfg.Topic == "" {
+		return nil, errors.New("event sink topic is required")
+	}
+
+	var transport Transport
+	var err error
+	switch cfg.Type {
+	case TypeNATS:
+		transport, err = newNATSTransport(cfg.URL, cfg.Topic)
+	case TypeKafka:
+		transport, err = newKafkaRESTTransport(cfg.URL, cfg.Topic)
+	default:
+		return nil, fmt.Errorf("unsupported event sink type %q, must be %q or %q", cfg.Type, TypeNATS, TypeKafka)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewWithTransport(cfg.Type, transport, log), nil
+}
+
+// NewWithTransport returns a Relay publishing with transport. sink names the
+// bus in metrics.
+func NewWithTransport(sink string, transport Transport, log logr.Logger) *Relay {
+	return &Relay{
+		log:       log.WithName("event-sink").WithValues("sink", sink),
+		sink:      sink,
+		transport: transport,
+		events:    make(chan server.WatchEvent, bufferSize),
+	}
+}
+
+// Publish queues event, dropping it when the queue is full.
+func (r *Relay) Publish(event server.WatchEvent) {
+	select {
+	case r.events <- event:
+	default:
+		metrics.EventSinkDropTotal.WithLabelValues(r.sink, event.Type).Inc()
+	}
+}
+
+// Start publishes queued events until ctx is done, then publishes the events
+// still queued for up to drainTimeout and closes the transport.
+func (r *Relay) Start(ctx context.Context) error {
+	defer func() {
+		if err := r.transport.Close(); err != nil {
+			r.log.Error(err, "failed to close event sink transport")
+		}
+	}()
+
+	for {
+		select {
+		case event := <-r.events:
+			r.send(ctx, event)
+		case <-ctx.Done():
+			r.drain()
+			return nil
+		}
+	}
+}
+
+// NeedLeaderElection returns false: every replica relays the events of the
+// runners connected to it.
+func (r *Relay) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *Relay) drain() {
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	for ctx.Err() == nil {
+		select {
+		case event := <-r.events:
+			r.send(ctx, event)
+		default:
+			return
+		}
+	}
+}
+
+func (r *Relay) send(ctx context.Context, event server.WatchEvent) {
+	msg, err := encode(event)
+	if err == nil {
+		sendCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+		err = r.transport.Send(sendCtx, msg)
+		cancel()
+	}
+	if err != nil {
+		metrics.EventSinkErrorsTotal.WithLabelValues(r.sink, event.Type).Inc()
+		r.log.Error(err, "failed to publish execution event",
+			"type", event.Type,
+			"namespace", event.Namespace,
+			"plan", event.Plan,
+			"executionId", event.ExecutionID)
+		return
+	}
+	metrics.EventSinkPublishedTotal.WithLabelValues(r.sink, event.Type).Inc()
+}
+
+// encode wraps event in the envelope the watch endpoint sends, so consumers of
+// both share one schema.
+func encode(event server.WatchEvent) (Message, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return Message{}, fmt.Errorf("encode %s event: %w", event.Type, err)
+	}
+	payload, err := json.Marshal(server.WebSocketMessage{Type: event.Type, Data: data})
+	if err != nil {
+		return Message{}, fmt.Errorf("encode %s event: %w", event.Type, err)
+	}
+	return Message{Key: event.Namespace + "/" + event.Plan, Payload: payload}, nil
+}
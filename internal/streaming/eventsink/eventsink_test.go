@@ -0,0 +1,146 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardikabs/hibernator/internal/streaming/server"
+)
+
+// recordingTransport records the messages sent with it and fails with err.
+type recordingTransport struct {
+	mu     sync.Mutex
+	msgs   []Message
+	err    error
+	closed bool
+}
+
+func (t *recordingTransport) Send(_ context.Context, msg Message) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.err != nil {
+		return t.err
+	}
+	t.msgs = append(t.msgs, msg)
+	return nil
+}
+
+func (t *recordingTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	return nil
+}
+
+func (t *recordingTransport) sent() []Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Message(nil), t.msgs...)
+}
+
+func TestNew_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{name: "missing URL", cfg: Config{Type: TypeKafka, Topic: DefaultTopic}},
+		{name: "missing topic", cfg: Config{Type: TypeKafka, URL: "http://kafka-rest:8082"}},
+		{name: "unsupported type", cfg: Config{Type: "sqs", URL: "http://sqs", Topic: DefaultTopic}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg, logr.Discard())
+			assert.Error(t, err)
+		})
+	}
+
+	relay, err := New(Config{Type: TypeKafka, URL: "http://kafka-rest:8082", Topic: DefaultTopic}, logr.Discard())
+	require.NoError(t, err)
+	assert.False(t, relay.NeedLeaderElection())
+}
+
+func TestRelay_PublishesQueuedEvents(t *testing.T) {
+	transport := &recordingTransport{}
+	relay := NewWithTransport("test", transport, logr.Discard())
+
+	relay.Publish(server.WatchEvent{
+		Type:            "progress",
+		Namespace:       "team-a",
+		Plan:            "nightly",
+		Target:          "database",
+		ExecutionID:     "exec-1",
+		ProgressPercent: 50,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- relay.Start(ctx) }()
+	require.Eventually(t, func() bool { return len(transport.sent()) == 1 }, time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+	assert.True(t, transport.closed)
+
+	msg := transport.sent()[0]
+	assert.Equal(t, "team-a/nightly", msg.Key)
+
+	var envelope server.WebSocketMessage
+	require.NoError(t, json.Unmarshal(msg.Payload, &envelope))
+	assert.Equal(t, "progress", envelope.Type)
+	var event server.WatchEvent
+	require.NoError(t, json.Unmarshal(envelope.Data, &event))
+	assert.Equal(t, "database", event.Target)
+	assert.Equal(t, "exec-1", event.ExecutionID)
+	assert.Equal(t, int32(50), event.ProgressPercent)
+}
+
+func TestRelay_DropsEventsBeyondBuffer(t *testing.T) {
+	transport := &recordingTransport{}
+	relay := NewWithTransport("test", transport, logr.Discard())
+
+	for range bufferSize + 10 {
+		relay.Publish(server.WatchEvent{Type: "progress", Namespace: "team-a", Plan: "nightly"})
+	}
+	assert.Len(t, relay.events, bufferSize)
+}
+
+func TestRelay_DrainsQueueOnShutdown(t *testing.T) {
+	transport := &recordingTransport{}
+	relay := NewWithTransport("test", transport, logr.Discard())
+	for range 3 {
+		relay.Publish(server.WatchEvent{Type: "completion", Namespace: "team-a", Plan: "nightly"})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, relay.Start(ctx))
+
+	assert.Len(t, transport.sent(), 3)
+	assert.Empty(t, relay.events)
+}
+
+func TestRelay_ContinuesAfterSendFailure(t *testing.T) {
+	transport := &recordingTransport{err: errors.New("unreachable")}
+	relay := NewWithTransport("test", transport, logr.Discard())
+	relay.Publish(server.WatchEvent{Type: "completion", Namespace: "team-a", Plan: "nightly"})
+	relay.Publish(server.WatchEvent{Type: "completion", Namespace: "team-a", Plan: "nightly"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, relay.Start(ctx))
+
+	assert.Empty(t, transport.sent())
+	assert.Empty(t, relay.events, "failed events are not retried")
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package eventsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	kafkaRESTContentType = "application/vnd.kafka.json.v2+json"
+	kafkaRESTAccept      = "application/vnd.kafka.v2+json"
+
+	// maxErrorBodySize bounds the response body quoted in errors.
+	maxErrorBodySize = 1024
+)
+
+// kafkaRESTTransport produces messages to a Kafka topic through the v2 API of
+// a Kafka REST Proxy, keyed so that the events of a plan share a partition.
+type kafkaRESTTransport struct {
+	client   *http.Client
+	endpoint string
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func newKafkaRESTTransport(baseURL, topic string) (*kafkaRESTTransport, error) {
+	endpoint, err := url.JoinPath(baseURL, "topics", topic)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Kafka REST Proxy URL: %w", err)
+	}
+	return &kafkaRESTTransport{client: &http.Client{}, endpoint: endpoint}, nil
+}
+
+func (t *kafkaRESTTransport) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(kafkaProduceRequest{Records: []kafkaRecord{{Key: msg.Key, Value: msg.Payload}}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaRESTContentType)
+	req.Header.Set("Accept", kafkaRESTAccept)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("produce to Kafka REST Proxy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("kafka REST Proxy returned %s: %s", resp.Status, bytes.TrimSpace(data))
+	}
+
+	var produced kafkaProduceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&produced); err != nil {
+		return fmt.Errorf("decode Kafka REST Proxy response: %w", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("kafka rejected the record (error code %d): %s", *offset.ErrorCode, offset.Error)
+		}
+	}
+	return nil
+}
+
+func (t *kafkaRESTTransport) Close() error {
+	t.client.CloseIdleConnections()
+	return nil
+}
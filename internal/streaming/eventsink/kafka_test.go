@@ -0,0 +1,75 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package eventsink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaRESTTransport_Send(t *testing.T) {
+	var got kafkaProduceRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/proxy/topics/hibernator.executions", r.URL.Path)
+		assert.Equal(t, kafkaRESTContentType, r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.Header().Set("Content-Type", kafkaRESTAccept)
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":42,"error_code":null,"error":null}]}`))
+	}))
+	defer srv.Close()
+
+	transport, err := newKafkaRESTTransport(srv.URL+"/proxy", "hibernator.executions")
+	require.NoError(t, err)
+	require.NoError(t, transport.Send(context.Background(), Message{Key: "team-a/nightly", Payload: []byte(`{"type":"progress"}`)}))
+
+	require.Len(t, got.Records, 1)
+	assert.Equal(t, "team-a/nightly", got.Records[0].Key)
+	assert.JSONEq(t, `{"type":"progress"}`, string(got.Records[0].Value))
+}
+
+func TestKafkaRESTTransport_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		errMsg string
+	}{
+		{
+			name:   "proxy error",
+			status: http.StatusNotFound,
+			body:   `{"error_code":40401,"message":"Topic not found."}`,
+			errMsg: "Topic not found.",
+		},
+		{
+			name:   "record rejected",
+			status: http.StatusOK,
+			body:   `{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"Kafka error"}]}`,
+			errMsg: "error code 50002",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			transport, err := newKafkaRESTTransport(srv.URL, DefaultTopic)
+			require.NoError(t, err)
+			err = transport.Send(context.Background(), Message{Key: "team-a/nightly", Payload: []byte(`{}`)})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package eventsink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsTransport publishes messages to a NATS subject. The connection
+// reconnects in the background and buffers messages while disconnected. The
+// key is not sent: headers are only available once connected to a server
+// supporting them, and the payload names the plan anyway.
+type natsTransport struct {
+	conn    *nats.Conn
+	subject string
+}
+
+func newNATSTransport(url, subject string) (*natsTransport, error) {
+	conn, err := nats.Connect(url,
+		nats.Name("hibernator-controller"),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect to NATS: %w", err)
+	}
+	return &natsTransport{conn: conn, subject: subject}, nil
+}
+
+func (t *natsTransport) Send(_ context.Context, msg Message) error {
+	return t.conn.Publish(t.subject, msg.Payload)
+}
+
+// Close flushes the published messages when connected. Messages buffered
+// while disconnected are lost.
+func (t *natsTransport) Close() error {
+	defer t.conn.Close()
+	if !t.conn.IsConnected() {
+		return nil
+	}
+	return t.conn.FlushTimeout(drainTimeout)
+}
@@ -0,0 +1,28 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package eventsink
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNATSTransport_BuffersWhileUnreachable(t *testing.T) {
+	// The controller starts even when NATS is down and publishes once it
+	// connects.
+	transport, err := newNATSTransport("nats://127.0.0.1:1", DefaultTopic)
+	require.NoError(t, err)
+
+	assert.NoError(t, transport.Send(context.Background(), Message{Key: "team-a/nightly", Payload: []byte(`{}`)}))
+	buffered, err := transport.conn.Buffered()
+	require.NoError(t, err)
+	assert.Positive(t, buffered)
+	assert.NoError(t, transport.Close())
+	assert.True(t, transport.conn.IsClosed())
+}
@@ -6,14 +6,23 @@ Licensed under the Apache License, Version 2.0.
 package streaming
 
 import (
+	"crypto/tls"
 	"fmt"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/ardikabs/hibernator/internal/executionlog"
+	"github.com/ardikabs/hibernator/internal/metrics"
+	"github.com/ardikabs/hibernator/internal/statusapi"
 	"github.com/ardikabs/hibernator/internal/streaming/auth"
+	"github.com/ardikabs/hibernator/internal/streaming/eventsink"
 	"github.com/ardikabs/hibernator/internal/streaming/server"
+	"github.com/ardikabs/hibernator/internal/streaming/streamtls"
+	"github.com/ardikabs/hibernator/pkg/dedup"
 )
 
 // Options configuration for streaming servers
@@ -23,6 +32,34 @@ type Options struct {
 	Clock                         clock.Clock
 	RunnerServiceAccount          string
 	RunnerServiceAccountNamespace string
+
+	// RunnerServiceAccounts are the ServiceAccounts, besides
+	// RunnerServiceAccount, whose runner tokens are accepted.
+	RunnerServiceAccounts []string
+
+	// TokenAudience is the audience runner tokens are issued for. Tokens for
+	// any of AdditionalTokenAudiences are accepted as well, so an audience can
+	// be rotated while runners holding tokens for the previous one finish.
+	TokenAudience            string
+	AdditionalTokenAudiences []string
+
+	// TLSCertDir holds the certificate (tls.crt, tls.key) the servers present
+	// and the CA bundle (ca.crt) client certificates must be issued by. When
+	// set, the servers only accept mutually authenticated TLS connections;
+	// runner tokens are still validated on top. Empty serves plaintext.
+	TLSCertDir string
+
+	// LogStore persists the log entries runners stream. Nil only relays them
+	// into the controller logs.
+	LogStore *executionlog.Store
+
+	// EventDedup bounds how many identical Events (same plan, reason and message)
+	// are recorded per window. A zero Window disables deduplication.
+	EventDedup dedup.Config
+
+	// EventSink republishes execution progress and completion events to a
+	// message bus. A zero Type disables it.
+	EventSink eventsink.Config
 }
 
 // SetupStreamingServerWithManager sets up the streaming servers to the controller manager
@@ -36,38 +73,85 @@ func SetupStreamingServerWithManager(mgr ctrl.Manager, opts Options) error {
 	}
 
 	// Create event recorder for streaming events
-	eventRecorder := mgr.GetEventRecorderFor("hibernator-streaming")
+	// Identical progress/failure Events produced by flapping runners are
+	// aggregated so a retry loop cannot flood the plan's Event stream.
+	eventRecorder := dedup.NewEventRecorder(
+		mgr.GetEventRecorderFor("hibernator-streaming"),
+		dedup.New(opts.EventDedup, dedup.WithClock(opts.Clock)),
+	)
+	eventRecorder.OnSuppress = func(_, reason string) {
+		metrics.EventSuppressedTotal.WithLabelValues(reason).Inc()
+	}
 
 	// Create shared execution service
 	// Runners persist restore data directly to ConfigMap - controller only orchestrates
 	execService := server.NewExecutionServiceServer(mgr.GetClient(), eventRecorder, opts.Clock)
+	if opts.LogStore != nil {
+		execService.WithLogStore(opts.LogStore)
+	}
+	if opts.EventSink.Enabled() {
+		relay, err := eventsink.New(opts.EventSink, log)
+		if err != nil {
+			return fmt.Errorf("failed to create event sink: %w", err)
+		}
+		if err := mgr.Add(relay); err != nil {
+			return fmt.Errorf("failed to add event sink to manager: %w", err)
+		}
+		execService.WithEventSink(relay)
+	}
 
 	// Create token validator with expected runner service account and namespace
 	// This validator is shared across all streaming servers
-	validator := auth.NewTokenValidator(clientset, log, opts.RunnerServiceAccount, opts.RunnerServiceAccountNamespace)
+	validator := auth.NewTokenValidator(clientset, log, opts.RunnerServiceAccount, opts.RunnerServiceAccountNamespace).
+		WithAudiences(opts.TokenAudience, opts.AdditionalTokenAudiences...).
+		WithServiceAccounts(opts.RunnerServiceAccounts...)
+
+	var tlsConfig *tls.Config
+	var grpcOpts []grpc.ServerOption
+	if opts.TLSCertDir != "" {
+		tlsConfig, err = streamtls.ServerConfig(opts.TLSCertDir)
+		if err != nil {
+			return fmt.Errorf("failed to load streaming TLS configuration: %w", err)
+		}
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
 
 	if opts.GRPCAddr != "" {
 		// Start gRPC server
-		grpcServer := server.NewServer(opts.GRPCAddr, validator, execService, log)
+		grpcServer := server.NewServer(opts.GRPCAddr, validator, execService, log, grpcOpts...)
 
 		if err := mgr.Add(grpcServer); err != nil {
 			return fmt.Errorf("failed to add grpc server to manager: %w", err)
 		}
+		if err := mgr.AddReadyzCheck("streaming-grpc", grpcServer.ReadyCheck); err != nil {
+			return fmt.Errorf("failed to add grpc server readiness check: %w", err)
+		}
 	}
 
 	if opts.WebSocketAddr != "" {
 		// Start WebSocket server
+		// External consumers watching plans are authorized like status API
+		// clients.
+		clk := opts.Clock
+		if clk == nil {
+			clk = clock.RealClock{}
+		}
 		wsServer := server.NewWebSocketServer(server.WebSocketServerOptions{
 			Addr:        opts.WebSocketAddr,
 			Clock:       opts.Clock,
 			ExecService: execService,
 			Validator:   validator,
 			Log:         log,
+			TLSConfig:   tlsConfig,
+			Authorizer:  statusapi.NewTokenAuthorizer(clientset, clk, statusapi.DefaultCacheTTL),
 		})
 
 		if err := mgr.Add(wsServer); err != nil {
 			return fmt.Errorf("failed to add websocket server to manager: %w", err)
 		}
+		if err := mgr.AddReadyzCheck("streaming-websocket", wsServer.ReadyCheck); err != nil {
+			return fmt.Errorf("failed to add websocket server readiness check: %w", err)
+		}
 	}
 
 	return nil
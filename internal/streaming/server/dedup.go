@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+// sequenceTracker records the event sequences processed per runner session, so
+// events replayed by a runner after a lost acknowledgement are acknowledged
+// again without being processed twice. The sequences are only known to this
+// replica: events a runner replays to another one are processed again there,
+// which the Job annotations they are recorded in tolerate. The zero value is
+// ready to use.
+type sequenceTracker struct {
+	mu       sync.Mutex
+	sessions map[sessionKey]*processedSequences
+}
+
+type sessionKey struct {
+	executionID string
+	sessionID   string
+}
+
+// processedSequences holds the processed sequences of a session: all sequences
+// up to contiguous, and the ones processed beyond it out of order.
+type processedSequences struct {
+	contiguous int64
+	beyond     map[int64]struct{}
+	lastSeen   time.Time
+}
+
+// firstDelivery records sequence as processed and reports whether it was not
+// processed before. Events without a sequence are always processed.
+func (t *sequenceTracker) firstDelivery(executionID, sessionID string, sequence int64, now time.Time) bool {
+	if sequence <= 0 {
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.sessions == nil {
+		t.sessions = make(map[sessionKey]*processedSequences)
+	}
+	key := sessionKey{executionID: executionID, sessionID: sessionID}
+	seqs, ok := t.sessions[key]
+	if !ok {
+		seqs = &processedSequences{beyond: make(map[int64]struct{})}
+		t.sessions[key] = seqs
+	}
+	seqs.lastSeen = now
+
+	if _, seen := seqs.beyond[sequence]; seen || sequence <= seqs.contiguous {
+		return false
+	}
+
+	seqs.beyond[sequence] = struct{}{}
+	for {
+		if _, ok := seqs.beyond[seqs.contiguous+1]; !ok {
+			break
+		}
+		seqs.contiguous++
+		delete(seqs.beyond, seqs.contiguous)
+	}
+	return true
+}
+
+// evictIdle forgets the sessions without events since cutoff. They are kept
+// beyond their completion, which runners replay when its acknowledgement is lost.
+func (t *sequenceTracker) evictIdle(cutoff time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, seqs := range t.sessions {
+		if seqs.lastSeen.Before(cutoff) {
+			delete(t.sessions, key)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	streamingv1alpha1 "github.com/ardikabs/hibernator/api/streaming/v1alpha1"
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func TestSequenceTracker_FirstDelivery(t *testing.T) {
+	var tracker sequenceTracker
+	now := time.Now()
+
+	assert.True(t, tracker.firstDelivery("exec", "s1", 1, now))
+	assert.True(t, tracker.firstDelivery("exec", "s1", 3, now), "out of order")
+	assert.False(t, tracker.firstDelivery("exec", "s1", 1, now), "replayed")
+	assert.False(t, tracker.firstDelivery("exec", "s1", 3, now), "replayed out of order")
+	assert.True(t, tracker.firstDelivery("exec", "s1", 2, now))
+	assert.False(t, tracker.firstDelivery("exec", "s1", 2, now))
+
+	seqs := tracker.sessions[sessionKey{executionID: "exec", sessionID: "s1"}]
+	assert.Equal(t, int64(3), seqs.contiguous)
+	assert.Empty(t, seqs.beyond)
+
+	assert.True(t, tracker.firstDelivery("exec", "s2", 1, now), "a retried runner starts a new session")
+	assert.True(t, tracker.firstDelivery("other", "s1", 1, now))
+	assert.True(t, tracker.firstDelivery("exec", "", 0, now), "unsequenced")
+	assert.True(t, tracker.firstDelivery("exec", "", 0, now), "unsequenced")
+}
+
+func TestSequenceTracker_EvictIdle(t *testing.T) {
+	var tracker sequenceTracker
+	now := time.Now()
+
+	tracker.firstDelivery("idle", "s1", 1, now.Add(-2*time.Hour))
+	tracker.firstDelivery("active", "s1", 1, now)
+	tracker.evictIdle(now.Add(-time.Hour))
+
+	assert.True(t, tracker.firstDelivery("idle", "s1", 1, now), "evicted sessions are forgotten")
+	assert.False(t, tracker.firstDelivery("active", "s1", 1, now))
+}
+
+func TestReportCompletion_AcknowledgesReplayOnce(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, hibernatorv1alpha1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	plan := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "test-plan", Namespace: "team-a"}}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job, plan).Build()
+	recorder := record.NewFakeRecorder(10)
+	server := NewExecutionServiceServer(fakeClient, recorder, clk)
+
+	report := &streamingv1alpha1.CompletionReport{
+		ExecutionId: "test-plan-test-target-1234567890",
+		Success:     true,
+		Sequence:    7,
+		SessionId:   "session",
+	}
+	for range 2 {
+		resp, err := server.ReportCompletion(context.Background(), report)
+		require.NoError(t, err)
+		assert.True(t, resp.Acknowledged)
+	}
+
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "ExecutionCompleted")
+}
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -28,21 +30,26 @@ type GRPCServer struct {
 	execService *ExecutionServiceServer
 	log         logr.Logger
 	address     string
+
+	// serving is whether the server accepts connections.
+	serving atomic.Bool
 }
 
 // NewServer creates a new streaming server.
 // The validator should be pre-configured with expected runner service account and namespace.
+// Additional server options, such as transport credentials, are applied after the auth interceptors.
 func NewServer(
 	address string,
 	validator *auth.TokenValidator,
 	execService *ExecutionServiceServer,
 	log logr.Logger,
+	serverOpts ...grpc.ServerOption,
 ) *GRPCServer {
 	// Create gRPC server with auth interceptors
-	grpcServer := grpc.NewServer(
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{
 		grpc.UnaryInterceptor(auth.GRPCInterceptor(validator, log)),
 		grpc.StreamInterceptor(auth.GRPCStreamInterceptor(validator, log)),
-	)
+	}, serverOpts...)...)
 
 	// Register gRPC services
 	streamingv1alpha1.RegisterExecutionServiceServer(grpcServer, execService)
@@ -67,6 +74,8 @@ func (s *GRPCServer) Start(ctx context.Context) error {
 	}
 
 	s.log.Info("starting gRPC server", "address", s.address)
+	s.serving.Store(true)
+	defer s.serving.Store(false)
 
 	// Start execution cleanup routine to handle stale executions (e.g., crashed runners)
 	go s.execService.StartCleanupRoutine(ctx, DefaultStaleExecutionDuration)
@@ -75,6 +84,9 @@ func (s *GRPCServer) Start(ctx context.Context) error {
 	go func() {
 		<-ctx.Done()
 		s.log.Info("shutting down gRPC server")
+		// Report unready first, so the Service stops routing new runner
+		// connections here while the open ones drain.
+		s.serving.Store(false)
 		s.grpcServer.GracefulStop()
 	}()
 
@@ -85,6 +97,16 @@ func (s *GRPCServer) Start(ctx context.Context) error {
 	return nil
 }
 
+// ReadyCheck is a readiness check that fails while the server does not accept
+// connections. Every replica serves runners, so the streaming Service only
+// routes them to replicas passing it.
+func (s *GRPCServer) ReadyCheck(_ *http.Request) error {
+	if !s.serving.Load() {
+		return errors.New("gRPC streaming server is not serving")
+	}
+	return nil
+}
+
 // NeedLeaderElection indicates whether the server requires leader election.
 func (s *GRPCServer) NeedLeaderElection() bool {
 	return false
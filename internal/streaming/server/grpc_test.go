@@ -10,7 +10,9 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -192,3 +194,30 @@ func TestIsGracefulStreamClosure_StatusCodes(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCServer_ReadyCheck(t *testing.T) {
+	srv := NewServer("127.0.0.1:0", nil, NewExecutionServiceServer(nil, nil, clk), logr.Discard())
+	if err := srv.ReadyCheck(nil); err == nil {
+		t.Fatal("expected the server to be unready before it starts")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.Start(ctx) }()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for srv.ReadyCheck(nil) != nil {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the server to become ready")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error stopping the server: %v", err)
+	}
+	if err := srv.ReadyCheck(nil); err == nil {
+		t.Fatal("expected the server to be unready after it stopped")
+	}
+}
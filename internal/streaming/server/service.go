@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"sync"
@@ -13,13 +14,18 @@ import (
 	"google.golang.org/grpc/status"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	streamingv1alpha1 "github.com/ardikabs/hibernator/api/streaming/v1alpha1"
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/executionlog"
+	"github.com/ardikabs/hibernator/internal/remoterunner"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
@@ -29,6 +35,11 @@ type ExecutionMetadata struct {
 	PlanName    string
 	TargetName  string
 	ExecutionID string
+	JobName     string
+
+	// Remote is whether the runner Job runs in another cluster, in which case
+	// JobName names its record in the plan namespace instead.
+	Remote bool
 }
 
 // ExecutionState holds the current state of an execution
@@ -41,6 +52,31 @@ type ExecutionState struct {
 	Completed       bool
 	Success         bool
 	Error           string
+
+	// CompletedResources, TotalResources and Resource hold the last
+	// per-resource progress; TotalResources is zero until one is reported.
+	CompletedResources int32
+	TotalResources     int32
+	Resource           string
+
+	// HeartbeatRecordedAt is when a heartbeat was last recorded on the
+	// runner Job.
+	HeartbeatRecordedAt time.Time
+
+	// ProgressRecordedAt is when per-resource progress was last recorded on
+	// the runner Job.
+	ProgressRecordedAt time.Time
+}
+
+// LogStore persists runner log entries beyond the controller's own logs.
+type LogStore interface {
+	Append(exec executionlog.Execution, entry executionlog.Entry)
+}
+
+// EventSink republishes the progress and completion events of executions to
+// an external system. Publish must not block the reporting runner.
+type EventSink interface {
+	Publish(event WatchEvent)
 }
 
 // ExecutionServiceServer implements the business logic for execution tracking
@@ -52,6 +88,8 @@ type ExecutionServiceServer struct {
 	log           logr.Logger
 	k8sClient     client.Client
 	eventRecorder record.EventRecorder
+	logStore      LogStore
+	eventSink     EventSink
 
 	executionStatus   map[string]*ExecutionState
 	executionStatusMu sync.RWMutex
@@ -60,6 +98,13 @@ type ExecutionServiceServer struct {
 	// Metadata is cached on first access and evicted when execution completes.
 	metadataCache   map[string]*ExecutionMetadata
 	metadataCacheMu sync.RWMutex
+
+	// processed deduplicates events replayed by runners. Unlike the state
+	// above, it outlives completions and is only evicted once idle.
+	processed sequenceTracker
+
+	// watchers receives the events of executions with known metadata.
+	watchers watchHub
 }
 
 // NewExecutionServiceServer creates a new ExecutionServiceServer
@@ -80,6 +125,28 @@ func NewExecutionServiceServer(
 	}
 }
 
+// WithLogStore persists the log entries of executions with known metadata in store.
+func (s *ExecutionServiceServer) WithLogStore(store LogStore) *ExecutionServiceServer {
+	s.logStore = store
+	return s
+}
+
+// WithEventSink republishes the progress and completion events of executions
+// with known metadata to sink.
+func (s *ExecutionServiceServer) WithEventSink(sink EventSink) *ExecutionServiceServer {
+	s.eventSink = sink
+	return s
+}
+
+// publishLifecycle relays a progress or completion event to the watchers of
+// its plan and to the event sink, if any.
+func (s *ExecutionServiceServer) publishLifecycle(event WatchEvent) {
+	s.watchers.publish(event)
+	if s.eventSink != nil {
+		s.eventSink.Publish(event)
+	}
+}
+
 // StreamLogs receives a stream of log entries from a runner via gRPC.
 // This is a transport-layer method that delegates to ExecutionServiceServer.
 func (s *ExecutionServiceServer) StreamLogs(stream grpc.ClientStreamingServer[streamingv1alpha1.LogEntry, streamingv1alpha1.StreamLogsResponse]) error {
@@ -150,10 +217,15 @@ func (s *ExecutionServiceServer) StreamLogs(stream grpc.ClientStreamingServer[st
 // EmitLog forwards a log entry to the controller's logging sink with execution context.
 // Logs are piped to the same output as controller logs, allowing them to be
 // viewed via "kubectl logs" on the controller pod with full execution context.
+// With a log store, entries are persisted as well so they outlive the pods.
 func (s *ExecutionServiceServer) EmitLog(ctx context.Context, entry *streamingv1alpha1.LogEntry) error {
 	if entry == nil {
 		return fmt.Errorf("log entry is nil")
 	}
+	if !s.processed.firstDelivery(entry.ExecutionId, entry.SessionId, entry.Sequence, s.clock.Now()) {
+		s.log.V(2).Info("skipping replayed log entry", "executionId", entry.ExecutionId, "sequence", entry.Sequence)
+		return nil
+	}
 
 	log := s.log.WithName("runner-logs")
 
@@ -203,11 +275,43 @@ func (s *ExecutionServiceServer) EmitLog(ctx context.Context, entry *streamingv1
 		log.V(1).Info(entry.Message, kvs...)
 	}
 
+	// Entries of unknown executions cannot be attributed to a plan namespace.
+	if err == nil {
+		s.watchers.publish(WatchEvent{
+			Type:        "log",
+			Namespace:   meta.Namespace,
+			Plan:        meta.PlanName,
+			Target:      meta.TargetName,
+			ExecutionID: entry.ExecutionId,
+			Timestamp:   entry.Timestamp,
+			Level:       entry.Level,
+			Message:     entry.Message,
+			Fields:      entry.Fields,
+		})
+	}
+	if s.logStore != nil && err == nil {
+		s.logStore.Append(executionlog.Execution{
+			Namespace:   meta.Namespace,
+			Plan:        meta.PlanName,
+			Target:      meta.TargetName,
+			ExecutionID: entry.ExecutionId,
+		}, executionlog.Entry{
+			Timestamp: entry.Timestamp,
+			Level:     entry.Level,
+			Message:   entry.Message,
+			Fields:    entry.Fields,
+		})
+	}
+
 	return nil
 }
 
 // ReportProgress handles progress reporting for an execution
 func (s *ExecutionServiceServer) ReportProgress(ctx context.Context, req *streamingv1alpha1.ProgressReport) (*streamingv1alpha1.ProgressResponse, error) {
+	if !s.processed.firstDelivery(req.ExecutionId, req.SessionId, req.Sequence, s.clock.Now()) {
+		s.log.V(1).Info("Acknowledging replayed progress report", "executionId", req.ExecutionId, "sequence", req.Sequence)
+		return &streamingv1alpha1.ProgressResponse{Acknowledged: true}, nil
+	}
 
 	s.executionStatusMu.Lock()
 	state, exists := s.executionStatus[req.ExecutionId]
@@ -221,8 +325,25 @@ func (s *ExecutionServiceServer) ReportProgress(ctx context.Context, req *stream
 	state.ProgressPercent = req.ProgressPercent
 	state.Message = req.Message
 	state.LastUpdate = s.clock.Now()
+	// Per-resource progress is recorded throttled, except for the last
+	// resource, so the final count always reaches the plan status.
+	recordProgress := false
+	if req.TotalResources > 0 {
+		state.CompletedResources = req.CompletedResources
+		state.TotalResources = req.TotalResources
+		state.Resource = req.Resource
+		recordProgress = req.CompletedResources >= req.TotalResources ||
+			s.clock.Since(state.ProgressRecordedAt) >= wellknown.RunnerProgressRecordInterval
+		if recordProgress {
+			state.ProgressRecordedAt = state.LastUpdate
+		}
+	}
 	s.executionStatusMu.Unlock()
 
+	if recordProgress {
+		s.recordProgress(ctx, req)
+	}
+
 	// Get execution metadata from cache (queries K8s API only on first access)
 	meta, err := s.getOrCacheExecutionMetadata(ctx, req.ExecutionId)
 	if err != nil {
@@ -244,8 +365,24 @@ func (s *ExecutionServiceServer) ReportProgress(ctx context.Context, req *stream
 		"executionId", req.ExecutionId,
 		"progress", req.ProgressPercent,
 		"message", req.Message,
+		"resource", req.Resource,
 	)
 
+	s.publishLifecycle(WatchEvent{
+		Type:               "progress",
+		Namespace:          meta.Namespace,
+		Plan:               meta.PlanName,
+		Target:             meta.TargetName,
+		ExecutionID:        req.ExecutionId,
+		Timestamp:          req.Timestamp,
+		Message:            req.Message,
+		Phase:              req.Phase,
+		ProgressPercent:    req.ProgressPercent,
+		CompletedResources: req.CompletedResources,
+		TotalResources:     req.TotalResources,
+		Resource:           req.Resource,
+	})
+
 	// Fetch HibernatePlan for event recording
 	plan, err := s.fetchHibernatePlan(ctx, meta.Namespace, meta.PlanName)
 	if err != nil {
@@ -253,7 +390,7 @@ func (s *ExecutionServiceServer) ReportProgress(ctx context.Context, req *stream
 			"namespace", meta.Namespace,
 			"plan", meta.PlanName)
 	} else if plan != nil {
-		s.eventRecorder.Eventf(plan, corev1.EventTypeNormal, "ExecutionProgress",
+		s.eventRecorder.Eventf(plan, corev1.EventTypeNormal, wellknown.EventReasonExecutionProgress,
 			"[%s/%s] target=%s: %d%% - %s",
 			meta.Namespace, meta.PlanName, meta.TargetName, req.ProgressPercent, req.Message)
 	}
@@ -268,9 +405,15 @@ func (s *ExecutionServiceServer) ReportCompletion(ctx context.Context, req *stre
 	s.log.V(1).Info("Received completion report",
 		"executionId", req.ExecutionId,
 		"success", req.Success,
+		"cancelled", req.Cancelled,
 		"errorMsg", req.ErrorMessage,
 	)
 
+	if !s.processed.firstDelivery(req.ExecutionId, req.SessionId, req.Sequence, s.clock.Now()) {
+		s.log.V(1).Info("Acknowledging replayed completion report", "executionId", req.ExecutionId, "sequence", req.Sequence)
+		return &streamingv1alpha1.CompletionResponse{Acknowledged: true}, nil
+	}
+
 	s.executionStatusMu.Lock()
 	state, exists := s.executionStatus[req.ExecutionId]
 	if !exists {
@@ -295,6 +438,7 @@ func (s *ExecutionServiceServer) ReportCompletion(ctx context.Context, req *stre
 		s.log.Info("Completion reported",
 			"executionId", req.ExecutionId,
 			"success", req.Success,
+			"cancelled", req.Cancelled,
 			"message", req.ErrorMessage,
 		)
 	} else {
@@ -304,9 +448,31 @@ func (s *ExecutionServiceServer) ReportCompletion(ctx context.Context, req *stre
 			"target", meta.TargetName,
 			"executionId", req.ExecutionId,
 			"success", req.Success,
+			"cancelled", req.Cancelled,
 			"message", req.ErrorMessage,
+			"failureReason", req.FailureReason,
 		)
 
+		if !req.Success && req.FailureReason != "" {
+			s.recordFailureReason(ctx, meta, req)
+		}
+		if meta.Remote {
+			s.recordRemoteResult(ctx, meta, req)
+		}
+
+		s.publishLifecycle(WatchEvent{
+			Type:        "completion",
+			Namespace:   meta.Namespace,
+			Plan:        meta.PlanName,
+			Target:      meta.TargetName,
+			ExecutionID: req.ExecutionId,
+			Timestamp:   req.Timestamp,
+			Success:     ptr.To(req.Success),
+			Error:       req.ErrorMessage,
+			DurationMs:  req.DurationMs,
+			Cancelled:   req.Cancelled,
+		})
+
 		// Fetch HibernatePlan for event recording
 		plan, fetchErr := s.fetchHibernatePlan(ctx, meta.Namespace, meta.PlanName)
 		if fetchErr != nil {
@@ -315,15 +481,22 @@ func (s *ExecutionServiceServer) ReportCompletion(ctx context.Context, req *stre
 				"plan", meta.PlanName)
 		} else if plan != nil {
 			eventType := corev1.EventTypeNormal
-			reason := "ExecutionCompleted"
-			if !req.Success {
+			reason := wellknown.EventReasonExecutionCompleted
+			switch {
+			case req.Cancelled:
+				eventType = corev1.EventTypeWarning
+				reason = wellknown.EventReasonExecutionCancelled
+			case !req.Success:
 				eventType = corev1.EventTypeWarning
-				reason = "ExecutionFailed"
+				reason = wellknown.EventReasonExecutionFailed
 			}
 			message := "Completed successfully"
 			if req.ErrorMessage != "" {
 				message = req.ErrorMessage
 			}
+			if !req.Success && !req.Cancelled && req.FailureReason != "" {
+				message = fmt.Sprintf("%s (%s)", message, req.FailureReason)
+			}
 			s.eventRecorder.Eventf(plan, eventType, reason,
 				"[%s/%s] target=%s: %s",
 				meta.Namespace, meta.PlanName, meta.TargetName, message)
@@ -349,16 +522,142 @@ func (s *ExecutionServiceServer) Heartbeat(ctx context.Context, req *streamingv1
 		s.executionStatus[req.ExecutionId] = state
 	}
 	state.LastUpdate = s.clock.Now()
+	record := s.clock.Since(state.HeartbeatRecordedAt) >= wellknown.RunnerHeartbeatRecordInterval
+	if record {
+		state.HeartbeatRecordedAt = state.LastUpdate
+	}
 	s.executionStatusMu.Unlock()
 
 	s.log.V(2).Info("Heartbeat received", "executionId", req.ExecutionId)
 
+	if record {
+		s.recordHeartbeat(ctx, req.ExecutionId)
+	}
+
 	return &streamingv1alpha1.HeartbeatResponse{
 		Acknowledged: true,
 		ServerTime:   s.clock.Now().Format(time.RFC3339),
 	}, nil
 }
 
+// recordHeartbeat annotates the runner Job of an execution with the current
+// time. Heartbeats reach whichever replica the runner is connected to; the
+// annotation lets the reconciling replica detect runners that stopped sending
+// them.
+func (s *ExecutionServiceServer) recordHeartbeat(ctx context.Context, executionID string) {
+	if s.k8sClient == nil {
+		return
+	}
+	meta, err := s.getOrCacheExecutionMetadata(ctx, executionID)
+	if err != nil {
+		s.log.V(1).Info("Skipping heartbeat of unknown execution", "executionId", executionID, "error", err.Error())
+		return
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`,
+		wellknown.AnnotationLastHeartbeat, s.clock.Now().UTC().Format(time.RFC3339))
+	if err := s.k8sClient.Patch(ctx, runnerObject(meta), client.RawPatch(types.MergePatchType, []byte(patch))); err != nil {
+		s.log.Error(err, "Failed to record heartbeat on runner job",
+			"executionId", executionID,
+			"job", meta.Namespace+"/"+meta.JobName)
+	}
+}
+
+// recordProgress annotates the runner Job of an execution with its latest
+// per-resource progress, which the reconciling replica copies into the plan
+// status.
+func (s *ExecutionServiceServer) recordProgress(ctx context.Context, req *streamingv1alpha1.ProgressReport) {
+	if s.k8sClient == nil {
+		return
+	}
+	meta, err := s.getOrCacheExecutionMetadata(ctx, req.ExecutionId)
+	if err != nil {
+		s.log.V(1).Info("Skipping progress of unknown execution", "executionId", req.ExecutionId, "error", err.Error())
+		return
+	}
+
+	progress, err := json.Marshal(hibernatorv1alpha1.ExecutionProgress{
+		Completed: req.CompletedResources,
+		Total:     req.TotalResources,
+		Resource:  req.Resource,
+		Message:   req.Message,
+	})
+	if err != nil {
+		s.log.Error(err, "Failed to encode progress", "executionId", req.ExecutionId)
+		return
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{wellknown.AnnotationProgress: string(progress)},
+		},
+	})
+	if err != nil {
+		s.log.Error(err, "Failed to encode progress patch", "executionId", req.ExecutionId)
+		return
+	}
+	if err := s.k8sClient.Patch(ctx, runnerObject(meta), client.RawPatch(types.MergePatchType, patch)); err != nil {
+		s.log.Error(err, "Failed to record progress on runner job",
+			"executionId", req.ExecutionId,
+			"job", meta.Namespace+"/"+meta.JobName)
+	}
+}
+
+// recordFailureReason annotates the runner Job of a failed execution with the
+// class of its failure, which the reconciling replica copies into the plan
+// status once the Job fails.
+func (s *ExecutionServiceServer) recordFailureReason(ctx context.Context, meta *ExecutionMetadata, req *streamingv1alpha1.CompletionReport) {
+	if s.k8sClient == nil {
+		return
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, wellknown.AnnotationFailureReason, req.FailureReason)
+	if err := s.k8sClient.Patch(ctx, runnerObject(meta), client.RawPatch(types.MergePatchType, []byte(patch))); err != nil {
+		s.log.Error(err, "Failed to record failure reason on runner job",
+			"executionId", req.ExecutionId,
+			"job", meta.Namespace+"/"+meta.JobName)
+	}
+}
+
+// recordRemoteResult records the completion of a remote runner on its record.
+// The controller cannot watch runner Jobs in other clusters, so the record is
+// how it learns that one finished.
+func (s *ExecutionServiceServer) recordRemoteResult(ctx context.Context, meta *ExecutionMetadata, req *streamingv1alpha1.CompletionReport) {
+	if s.k8sClient == nil {
+		return
+	}
+
+	message := "Completed successfully"
+	if req.ErrorMessage != "" {
+		message = req.ErrorMessage
+	}
+	patch, err := remoterunner.ResultPatch(remoterunner.Result{
+		Success:    req.Success,
+		Cancelled:  req.Cancelled,
+		Message:    message,
+		FinishedAt: metav1.NewTime(s.clock.Now()),
+	})
+	if err != nil {
+		s.log.Error(err, "Failed to encode remote runner result", "executionId", req.ExecutionId)
+		return
+	}
+	if err := s.k8sClient.Patch(ctx, runnerObject(meta), client.RawPatch(types.MergePatchType, patch)); err != nil {
+		s.log.Error(err, "Failed to record result on remote runner record",
+			"executionId", req.ExecutionId,
+			"record", meta.Namespace+"/"+meta.JobName)
+	}
+}
+
+// runnerObject returns the object the annotations of an execution are recorded
+// on: its runner Job, or the record of a remote runner Job.
+func runnerObject(meta *ExecutionMetadata) client.Object {
+	objMeta := metav1.ObjectMeta{Namespace: meta.Namespace, Name: meta.JobName}
+	if meta.Remote {
+		return &corev1.ConfigMap{ObjectMeta: objMeta}
+	}
+	return &batchv1.Job{ObjectMeta: objMeta}
+}
+
 // getOrCacheExecutionMetadata retrieves metadata from cache or queries K8s API on cache miss.
 // This prevents repeated API calls for the same execution during log streaming.
 func (s *ExecutionServiceServer) getOrCacheExecutionMetadata(ctx context.Context, executionID string) (*ExecutionMetadata, error) {
@@ -438,10 +737,13 @@ func (s *ExecutionServiceServer) cleanupStaleExecutions(staleDuration time.Durat
 		s.log.Info("cleaning up stale execution", "executionId", id, "staleDuration", staleDuration)
 		s.cleanupExecution(id)
 	}
+
+	s.processed.evictIdle(now.Add(-staleDuration))
 }
 
 // getExecutionMetadata retrieves metadata about an execution by querying the runner Job.
 // It queries Jobs by the execution ID label and extracts namespace, plan name, and target name.
+// Runner Jobs dispatched into another cluster are found through their record instead.
 func (s *ExecutionServiceServer) getExecutionMetadata(ctx context.Context, executionID string) (*ExecutionMetadata, error) {
 	// If no k8s client available, return unknown metadata to avoid panic
 	if s.k8sClient == nil {
@@ -462,7 +764,7 @@ func (s *ExecutionServiceServer) getExecutionMetadata(ctx context.Context, execu
 	}
 
 	if len(jobList.Items) == 0 {
-		return nil, fmt.Errorf("no job found for execution %s", executionID)
+		return s.getRemoteExecutionMetadata(ctx, executionID)
 	}
 
 	// Use the first matching job (there should only be one per execution)
@@ -474,6 +776,7 @@ func (s *ExecutionServiceServer) getExecutionMetadata(ctx context.Context, execu
 		PlanName:    job.Labels[wellknown.LabelPlan],
 		TargetName:  job.Labels[wellknown.LabelTarget],
 		ExecutionID: executionID,
+		JobName:     job.Name,
 	}
 
 	if meta.PlanName == "" {
@@ -483,6 +786,38 @@ func (s *ExecutionServiceServer) getExecutionMetadata(ctx context.Context, execu
 	return meta, nil
 }
 
+// getRemoteExecutionMetadata retrieves metadata about an execution whose runner
+// Job runs in another cluster from the record of that Job.
+func (s *ExecutionServiceServer) getRemoteExecutionMetadata(ctx context.Context, executionID string) (*ExecutionMetadata, error) {
+	var cmList corev1.ConfigMapList
+	if err := s.k8sClient.List(ctx, &cmList, client.MatchingLabels{
+		wellknown.LabelExecutionID:     executionID,
+		wellknown.LabelRemoteRunnerJob: "true",
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list remote runner records for execution %s: %w", executionID, err)
+	}
+
+	if len(cmList.Items) == 0 {
+		return nil, fmt.Errorf("no job found for execution %s", executionID)
+	}
+
+	record := &cmList.Items[0]
+	meta := &ExecutionMetadata{
+		Namespace:   record.Namespace,
+		PlanName:    record.Labels[wellknown.LabelPlan],
+		TargetName:  record.Labels[wellknown.LabelTarget],
+		ExecutionID: executionID,
+		JobName:     record.Name,
+		Remote:      true,
+	}
+
+	if meta.PlanName == "" {
+		return nil, fmt.Errorf("remote runner record %s/%s missing plan label", record.Namespace, record.Name)
+	}
+
+	return meta, nil
+}
+
 // fetchHibernatePlan retrieves a HibernatePlan by namespace and name.
 // Returns nil if the k8s client is not available.
 func (s *ExecutionServiceServer) fetchHibernatePlan(ctx context.Context, namespace, name string) (*hibernatorv1alpha1.HibernatePlan, error) {
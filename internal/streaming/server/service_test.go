@@ -7,20 +7,28 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	streamingv1alpha1 "github.com/ardikabs/hibernator/api/streaming/v1alpha1"
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/executionlog"
+	"github.com/ardikabs/hibernator/internal/remoterunner"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 )
 
@@ -189,6 +197,183 @@ func TestHeartbeat_NonExistentExecution(t *testing.T) {
 	assert.True(t, resp.Acknowledged, "expected acknowledged response even for non-existent execution")
 }
 
+func TestHeartbeat_RecordsOnRunnerJob(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hibernate-runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()
+	fakeClock := clocktesting.NewFakeClock(time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC))
+	server := NewExecutionServiceServer(fakeClient, nil, fakeClock)
+
+	lastHeartbeat := func() string {
+		var got batchv1.Job
+		require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(job), &got))
+		return got.Annotations[wellknown.AnnotationLastHeartbeat]
+	}
+	heartbeat := func() {
+		_, err := server.Heartbeat(context.Background(), &streamingv1alpha1.HeartbeatRequest{ExecutionId: "test-plan-test-target-1234567890"})
+		require.NoError(t, err)
+	}
+
+	heartbeat()
+	assert.Equal(t, "2026-10-16T03:00:00Z", lastHeartbeat())
+
+	fakeClock.Step(wellknown.RunnerHeartbeatInterval)
+	heartbeat()
+	assert.Equal(t, "2026-10-16T03:00:00Z", lastHeartbeat(), "heartbeats within the record interval are not recorded")
+
+	fakeClock.Step(wellknown.RunnerHeartbeatRecordInterval - wellknown.RunnerHeartbeatInterval)
+	heartbeat()
+	assert.Equal(t, "2026-10-16T03:01:00Z", lastHeartbeat())
+}
+
+func TestReportProgress_RecordsResourceProgressOnRunnerJob(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hibernate-runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()
+	fakeClock := clocktesting.NewFakeClock(time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC))
+	server := NewExecutionServiceServer(fakeClient, nil, fakeClock)
+
+	progress := func() hibernatorv1alpha1.ExecutionProgress {
+		var got batchv1.Job
+		require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(job), &got))
+		var p hibernatorv1alpha1.ExecutionProgress
+		if raw := got.Annotations[wellknown.AnnotationProgress]; raw != "" {
+			require.NoError(t, json.Unmarshal([]byte(raw), &p))
+		}
+		return p
+	}
+	report := func(completed int32, resource string) {
+		_, err := server.ReportProgress(context.Background(), &streamingv1alpha1.ProgressReport{
+			ExecutionId:        "test-plan-test-target-1234567890",
+			Phase:              "executing",
+			Message:            fmt.Sprintf("stopped %d/3 RDS resource(s)", completed),
+			CompletedResources: completed,
+			TotalResources:     3,
+			Resource:           resource,
+		})
+		require.NoError(t, err)
+	}
+
+	report(1, "instance:db-1")
+	assert.Equal(t, hibernatorv1alpha1.ExecutionProgress{
+		Completed: 1,
+		Total:     3,
+		Resource:  "instance:db-1",
+		Message:   "stopped 1/3 RDS resource(s)",
+	}, progress())
+
+	report(2, "instance:db-2")
+	assert.Equal(t, int32(1), progress().Completed, "progress within the record interval is not recorded")
+
+	report(3, "instance:db-3")
+	assert.Equal(t, int32(3), progress().Completed, "the last resource is always recorded")
+
+	fakeClock.Step(wellknown.RunnerProgressRecordInterval)
+	_, err := server.ReportProgress(context.Background(), &streamingv1alpha1.ProgressReport{
+		ExecutionId: "test-plan-test-target-1234567890",
+		Phase:       "finalizing",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), progress().Completed, "phase progress leaves resource progress untouched")
+}
+
+func TestReportCompletion_RecordsFailureReasonOnRunnerJob(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hibernate-runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()
+	server := NewExecutionServiceServer(fakeClient, nil, clocktesting.NewFakeClock(time.Now()))
+
+	_, err := server.ReportCompletion(context.Background(), &streamingv1alpha1.CompletionReport{
+		ExecutionId:   "test-plan-test-target-1234567890",
+		ErrorMessage:  "operation error RDS: StopDBInstance, api error ThrottlingException: Rate exceeded",
+		FailureReason: "Throttled",
+	})
+	require.NoError(t, err)
+
+	var got batchv1.Job
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(job), &got))
+	assert.Equal(t, "Throttled", got.Annotations[wellknown.AnnotationFailureReason])
+}
+
+func TestReportCompletion_RecordsResultOnRemoteRunnerRecord(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	runnerJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hibernate-runner-test-plan-test-target-abcd",
+			Namespace: "runners",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	rec := remoterunner.NewRecord(runnerJob, "workloads", "team-a")
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rec).Build()
+	server := NewExecutionServiceServer(fakeClient, nil, clocktesting.NewFakeClock(time.Now()))
+
+	_, err := server.Heartbeat(context.Background(), &streamingv1alpha1.HeartbeatRequest{
+		ExecutionId: "test-plan-test-target-1234567890",
+	})
+	require.NoError(t, err)
+
+	_, err = server.ReportCompletion(context.Background(), &streamingv1alpha1.CompletionReport{
+		ExecutionId:   "test-plan-test-target-1234567890",
+		ErrorMessage:  "operation error RDS: StopDBInstance, api error ThrottlingException: Rate exceeded",
+		FailureReason: "Throttled",
+	})
+	require.NoError(t, err)
+
+	var got corev1.ConfigMap
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+	assert.NotEmpty(t, got.Annotations[wellknown.AnnotationLastHeartbeat])
+	assert.Equal(t, "Throttled", got.Annotations[wellknown.AnnotationFailureReason])
+
+	res, ok := remoterunner.ResultOf(&got)
+	require.True(t, ok)
+	assert.False(t, res.Success)
+	assert.Contains(t, res.Message, "ThrottlingException")
+}
+
 func TestEmitLog(t *testing.T) {
 	// Create a fake client with a runner Job
 	scheme := runtime.NewScheme()
@@ -260,6 +445,196 @@ func TestEmitLog_JobNotFound(t *testing.T) {
 	require.NoError(t, err, "EmitLog() should not error when Job not found")
 }
 
+// recordingLogStore records the entries appended to it.
+type recordingLogStore struct {
+	execs   []executionlog.Execution
+	entries []executionlog.Entry
+}
+
+func (r *recordingLogStore) Append(exec executionlog.Execution, entry executionlog.Entry) {
+	r.execs = append(r.execs, exec)
+	r.entries = append(r.entries, entry)
+}
+
+func TestEmitLog_PersistsToLogStore(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hibernate-runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()
+
+	store := &recordingLogStore{}
+	server := NewExecutionServiceServer(fakeClient, nil, clk).WithLogStore(store)
+
+	require.NoError(t, server.EmitLog(context.Background(), &streamingv1alpha1.LogEntry{
+		ExecutionId: "test-plan-test-target-1234567890",
+		Timestamp:   "2026-10-16T03:00:00Z",
+		Level:       "ERROR",
+		Message:     "wakeup failed",
+		Fields:      map[string]string{"error": "timeout"},
+	}))
+	require.NoError(t, server.EmitLog(context.Background(), &streamingv1alpha1.LogEntry{
+		ExecutionId: "nonexistent-execution",
+		Level:       "INFO",
+		Message:     "not attributable",
+	}))
+
+	require.Len(t, store.entries, 1, "entries of unknown executions are not persisted")
+	assert.Equal(t, executionlog.Execution{
+		Namespace:   "team-a",
+		Plan:        "test-plan",
+		Target:      "test-target",
+		ExecutionID: "test-plan-test-target-1234567890",
+	}, store.execs[0])
+	assert.Equal(t, executionlog.Entry{
+		Timestamp: "2026-10-16T03:00:00Z",
+		Level:     "ERROR",
+		Message:   "wakeup failed",
+		Fields:    map[string]string{"error": "timeout"},
+	}, store.entries[0])
+}
+
+// recordingEventSink records the events published to it.
+type recordingEventSink struct {
+	events []WatchEvent
+}
+
+func (r *recordingEventSink) Publish(event WatchEvent) {
+	r.events = append(r.events, event)
+}
+
+func TestReportProgressAndCompletion_PublishToEventSink(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, hibernatorv1alpha1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hibernate-runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()
+
+	sink := &recordingEventSink{}
+	server := NewExecutionServiceServer(fakeClient, record.NewFakeRecorder(10), clk).WithEventSink(sink)
+
+	require.NoError(t, server.EmitLog(context.Background(), &streamingv1alpha1.LogEntry{
+		ExecutionId: "test-plan-test-target-1234567890",
+		Level:       "INFO",
+		Message:     "logs are not republished",
+	}))
+	_, err := server.ReportProgress(context.Background(), &streamingv1alpha1.ProgressReport{
+		ExecutionId:     "test-plan-test-target-1234567890",
+		Phase:           "Running",
+		ProgressPercent: 50,
+		Message:         "stopping instances",
+	})
+	require.NoError(t, err)
+	_, err = server.ReportCompletion(context.Background(), &streamingv1alpha1.CompletionReport{
+		ExecutionId:  "test-plan-test-target-1234567890",
+		Success:      false,
+		ErrorMessage: "timeout",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sink.events, 2)
+	assert.Equal(t, "progress", sink.events[0].Type)
+	assert.Equal(t, int32(50), sink.events[0].ProgressPercent)
+	assert.Equal(t, "completion", sink.events[1].Type)
+	assert.Equal(t, "test-target", sink.events[1].Target)
+	assert.Equal(t, ptr.To(false), sink.events[1].Success)
+	assert.Equal(t, "timeout", sink.events[1].Error)
+}
+
+func TestReportCompletion_Cancelled(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, hibernatorv1alpha1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	plan := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "test-plan", Namespace: "team-a"}}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job, plan).Build()
+	recorder := record.NewFakeRecorder(10)
+	sink := &recordingEventSink{}
+	server := NewExecutionServiceServer(fakeClient, recorder, clk).WithEventSink(sink)
+
+	_, err := server.ReportCompletion(context.Background(), &streamingv1alpha1.CompletionReport{
+		ExecutionId:  "test-plan-test-target-1234567890",
+		ErrorMessage: "execution cancelled: context canceled",
+		Cancelled:    true,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, recorder.Events, 1)
+	event := <-recorder.Events
+	assert.Contains(t, event, "Warning ExecutionCancelled")
+	assert.Contains(t, event, "execution cancelled: context canceled")
+
+	require.Len(t, sink.events, 1)
+	assert.True(t, sink.events[0].Cancelled)
+	assert.Equal(t, ptr.To(false), sink.events[0].Success)
+}
+
+func TestReportCompletion_FailedEventIncludesFailureReason(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, hibernatorv1alpha1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	plan := &hibernatorv1alpha1.HibernatePlan{ObjectMeta: metav1.ObjectMeta{Name: "test-plan", Namespace: "team-a"}}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job, plan).Build()
+	recorder := record.NewFakeRecorder(10)
+	server := NewExecutionServiceServer(fakeClient, recorder, clk)
+
+	_, err := server.ReportCompletion(context.Background(), &streamingv1alpha1.CompletionReport{
+		ExecutionId:   "test-plan-test-target-1234567890",
+		ErrorMessage:  "access denied",
+		FailureReason: "AuthError",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, recorder.Events, 1)
+	event := <-recorder.Events
+	assert.Contains(t, event, "Warning ExecutionFailed")
+	assert.Contains(t, event, "access denied (AuthError)")
+}
+
 func TestGetExecutionMetadata(t *testing.T) {
 	// Create a fake client with a runner Job
 	scheme := runtime.NewScheme()
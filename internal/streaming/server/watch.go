@@ -0,0 +1,231 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/streaming/auth"
+)
+
+const (
+	// WatchPath is the route external consumers subscribe to the live
+	// execution events of a plan on, given by the namespace and plan query
+	// parameters.
+	WatchPath = "/v1alpha1/watch"
+
+	// watchBufferSize is the number of events buffered per watcher. Watchers
+	// falling further behind are disconnected.
+	watchBufferSize = 256
+
+	// watchReadLimit bounds the messages of watchers, which only send control frames.
+	watchReadLimit = 4096
+)
+
+// Authorizer decides whether the holder of a bearer token is allowed a
+// resource access, e.g. with a TokenReview and a SubjectAccessReview.
+type Authorizer interface {
+	Authorize(ctx context.Context, token string, attrs authorizationv1.ResourceAttributes) (bool, error)
+}
+
+// WatchEvent is an execution event relayed to the watchers of its plan. The
+// fields set depend on Type: "log", "progress" or "completion".
+type WatchEvent struct {
+	Type            string            `json:"-"`
+	Namespace       string            `json:"namespace"`
+	Plan            string            `json:"plan"`
+	Target          string            `json:"target"`
+	ExecutionID     string            `json:"executionId"`
+	Timestamp       string            `json:"timestamp,omitempty"`
+	Level           string            `json:"level,omitempty"`
+	Message         string            `json:"message,omitempty"`
+	Fields          map[string]string `json:"fields,omitempty"`
+	Phase           string            `json:"phase,omitempty"`
+	ProgressPercent int32             `json:"progressPercent,omitempty"`
+	Success         *bool             `json:"success,omitempty"`
+	Error           string            `json:"error,omitempty"`
+	DurationMs      int64             `json:"durationMs,omitempty"`
+	Cancelled       bool              `json:"cancelled,omitempty"`
+
+	CompletedResources int32  `json:"completedResources,omitempty"`
+	TotalResources     int32  `json:"totalResources,omitempty"`
+	Resource           string `json:"resource,omitempty"`
+}
+
+// subscription receives the events of one plan. lagged is closed when the
+// subscriber fell behind and missed events.
+type subscription struct {
+	plan   types.NamespacedName
+	events chan WatchEvent
+	lagged chan struct{}
+	once   sync.Once
+}
+
+// watchHub relays execution events to the subscriptions of their plan. The
+// zero value is ready to use.
+type watchHub struct {
+	mu   sync.RWMutex
+	subs map[types.NamespacedName]map[*subscription]struct{}
+}
+
+func (h *watchHub) subscribe(plan types.NamespacedName) *subscription {
+	sub := &subscription{
+		plan:   plan,
+		events: make(chan WatchEvent, watchBufferSize),
+		lagged: make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs == nil {
+		h.subs = make(map[types.NamespacedName]map[*subscription]struct{})
+	}
+	if h.subs[plan] == nil {
+		h.subs[plan] = make(map[*subscription]struct{})
+	}
+	h.subs[plan][sub] = struct{}{}
+	return sub
+}
+
+func (h *watchHub) unsubscribe(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs[sub.plan], sub)
+	if len(h.subs[sub.plan]) == 0 {
+		delete(h.subs, sub.plan)
+	}
+}
+
+// publish hands event to the subscriptions of its plan without blocking.
+func (h *watchHub) publish(event WatchEvent) {
+	plan := types.NamespacedName{Namespace: event.Namespace, Name: event.Plan}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subs[plan] {
+		select {
+		case sub.events <- event:
+		default:
+			sub.once.Do(func() { close(sub.lagged) })
+		}
+	}
+}
+
+// handleWatch streams the execution events of a plan to a read-only
+// subscriber, such as a dashboard or the CLI. Subscribers must be allowed to
+// watch the plan. Events are only relayed by the replica the runner reports to.
+func (s *WebSocketServer) handleWatch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	plan := types.NamespacedName{Namespace: query.Get("namespace"), Name: query.Get("plan")}
+	if plan.Namespace == "" || plan.Name == "" {
+		http.Error(w, "namespace and plan query parameters are required", http.StatusBadRequest)
+		return
+	}
+
+	token, err := auth.ExtractTokenFromHeader(r.Header.Get("Authorization"))
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	allowed, err := s.authorizer.Authorize(r.Context(), token, authorizationv1.ResourceAttributes{
+		Namespace: plan.Namespace,
+		Verb:      "watch",
+		Group:     hibernatorv1alpha1.GroupVersion.Group,
+		Resource:  "hibernateplans",
+		Name:      plan.Name,
+	})
+	if err != nil {
+		s.log.Error(err, "failed to authorize watcher", "plan", plan.String())
+		http.Error(w, "authorization failed", http.StatusInternalServerError)
+		return
+	}
+	if !allowed {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.log.Error(err, "failed to upgrade watcher to WebSocket", "plan", plan.String())
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			s.log.V(1).Info("failed to close watcher connection", "plan", plan.String(), "error", err.Error())
+		}
+	}()
+
+	sub := s.execService.watchers.subscribe(plan)
+	defer s.execService.watchers.unsubscribe(sub)
+	s.log.V(1).Info("watcher subscribed", "plan", plan.String())
+
+	conn.SetReadLimit(watchReadLimit)
+	if err := conn.SetReadDeadline(s.clock.Now().Add(s.readTimeout)); err != nil {
+		return
+	}
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(s.clock.Now().Add(s.readTimeout))
+	})
+
+	// Reading handles control frames and notices the watcher leaving.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	pingTicker := time.NewTicker(s.pingInterval)
+	defer pingTicker.Stop()
+
+	var writeMu sync.Mutex
+	for {
+		select {
+		case <-done:
+			return
+		case <-sub.lagged:
+			s.log.Info("disconnecting watcher that fell behind", "plan", plan.String())
+			_ = conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "watcher fell behind"),
+				s.clock.Now().Add(s.writeTimeout))
+			return
+		case event := <-sub.events:
+			if err := s.sendWatchEvent(conn, event); err != nil {
+				s.log.V(1).Info("failed to send watch event", "plan", plan.String(), "error", err.Error())
+				return
+			}
+		case <-pingTicker.C:
+			if err := s.sendPing(conn, &writeMu); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *WebSocketServer) sendWatchEvent(conn *websocket.Conn, event WatchEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetWriteDeadline(s.clock.Now().Add(s.writeTimeout)); err != nil {
+		return err
+	}
+	return conn.WriteJSON(WebSocketMessage{Type: event.Type, Data: data})
+}
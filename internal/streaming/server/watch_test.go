@@ -0,0 +1,203 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	streamingv1alpha1 "github.com/ardikabs/hibernator/api/streaming/v1alpha1"
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+// fakeAuthorizer allows the token "allowed" and records the last attributes.
+type fakeAuthorizer struct {
+	attrs authorizationv1.ResourceAttributes
+}
+
+func (f *fakeAuthorizer) Authorize(_ context.Context, token string, attrs authorizationv1.ResourceAttributes) (bool, error) {
+	f.attrs = attrs
+	return token == "allowed", nil
+}
+
+func TestWatchHub_PublishesToPlanSubscribers(t *testing.T) {
+	var hub watchHub
+	planA := types.NamespacedName{Namespace: "team-a", Name: "plan"}
+	planB := types.NamespacedName{Namespace: "team-b", Name: "plan"}
+
+	subA := hub.subscribe(planA)
+	subB := hub.subscribe(planB)
+	hub.publish(WatchEvent{Type: "log", Namespace: "team-a", Plan: "plan", Message: "hello"})
+
+	require.Len(t, subA.events, 1)
+	assert.Equal(t, "hello", (<-subA.events).Message)
+	assert.Empty(t, subB.events)
+
+	hub.unsubscribe(subA)
+	hub.unsubscribe(subB)
+	assert.Empty(t, hub.subs)
+	hub.publish(WatchEvent{Type: "log", Namespace: "team-a", Plan: "plan"})
+	assert.Empty(t, subA.events)
+}
+
+func TestWatchHub_FlagsLaggingSubscribers(t *testing.T) {
+	var hub watchHub
+	sub := hub.subscribe(types.NamespacedName{Namespace: "team-a", Name: "plan"})
+
+	for range watchBufferSize + 2 {
+		hub.publish(WatchEvent{Type: "log", Namespace: "team-a", Plan: "plan"})
+	}
+
+	assert.Len(t, sub.events, watchBufferSize)
+	select {
+	case <-sub.lagged:
+	default:
+		t.Fatal("lagging subscriber is not flagged")
+	}
+}
+
+func TestEmitLog_PublishesToWatchers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, hibernatorv1alpha1.AddToScheme(scheme))
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runner-test-plan-test-target-abcd",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				wellknown.LabelExecutionID: "test-plan-test-target-1234567890",
+				wellknown.LabelPlan:        "test-plan",
+				wellknown.LabelTarget:      "test-target",
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()
+	server := NewExecutionServiceServer(fakeClient, record.NewFakeRecorder(10), clk)
+	sub := server.watchers.subscribe(types.NamespacedName{Namespace: "team-a", Name: "test-plan"})
+
+	require.NoError(t, server.EmitLog(context.Background(), &streamingv1alpha1.LogEntry{
+		ExecutionId: "test-plan-test-target-1234567890",
+		Level:       "INFO",
+		Message:     "scaling down",
+	}))
+
+	require.Len(t, sub.events, 1)
+	event := <-sub.events
+	assert.Equal(t, "log", event.Type)
+	assert.Equal(t, "test-target", event.Target)
+	assert.Equal(t, "scaling down", event.Message)
+}
+
+func newWatchTestServer(t *testing.T) (*WebSocketServer, *fakeAuthorizer, *httptest.Server) {
+	t.Helper()
+	authorizer := &fakeAuthorizer{}
+	srv := NewWebSocketServer(WebSocketServerOptions{
+		Addr:        ":0",
+		Clock:       clock.RealClock{},
+		ExecService: NewExecutionServiceServer(nil, nil, clk),
+		Log:         logr.Discard(),
+		Authorizer:  authorizer,
+	})
+	httpSrv := httptest.NewServer(http.HandlerFunc(srv.handleWatch))
+	t.Cleanup(httpSrv.Close)
+	return srv, authorizer, httpSrv
+}
+
+func TestWebSocketServer_HandleWatch_Rejects(t *testing.T) {
+	_, _, httpSrv := newWatchTestServer(t)
+
+	tests := []struct {
+		name  string
+		query string
+		token string
+		want  int
+	}{
+		{name: "missing plan", query: "?namespace=team-a", token: "allowed", want: http.StatusBadRequest},
+		{name: "missing token", query: "?namespace=team-a&plan=p", want: http.StatusUnauthorized},
+		{name: "forbidden", query: "?namespace=team-a&plan=p", token: "denied", want: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, httpSrv.URL+tt.query, nil)
+			require.NoError(t, err)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
+
+func TestWebSocketServer_HandleWatch_StreamsPlanEvents(t *testing.T) {
+	srv, authorizer, httpSrv := newWatchTestServer(t)
+
+	url := "ws" + strings.TrimPrefix(httpSrv.URL, "http") + "?namespace=team-a&plan=test-plan"
+	conn, resp, err := websocket.DefaultDialer.Dial(url, http.Header{"Authorization": {"Bearer allowed"}})
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	_ = resp.Body.Close()
+
+	assert.Equal(t, authorizationv1.ResourceAttributes{
+		Namespace: "team-a",
+		Verb:      "watch",
+		Group:     hibernatorv1alpha1.GroupVersion.Group,
+		Resource:  "hibernateplans",
+		Name:      "test-plan",
+	}, authorizer.attrs)
+
+	// The subscription is registered after the upgrade.
+	require.Eventually(t, func() bool {
+		srv.execService.watchers.mu.RLock()
+		defer srv.execService.watchers.mu.RUnlock()
+		return len(srv.execService.watchers.subs) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	srv.execService.watchers.publish(WatchEvent{Type: "log", Namespace: "team-b", Plan: "test-plan", Message: "other"})
+	srv.execService.watchers.publish(WatchEvent{
+		Type:        "completion",
+		Namespace:   "team-a",
+		Plan:        "test-plan",
+		Target:      "db",
+		ExecutionID: "exec-1",
+		Success:     new(bool),
+		Error:       "boom",
+	})
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg WebSocketMessage
+	require.NoError(t, conn.ReadJSON(&msg))
+	assert.Equal(t, "completion", msg.Type)
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(msg.Data, &event))
+	assert.Equal(t, "db", event["target"])
+	assert.Equal(t, "exec-1", event["executionId"])
+	assert.Equal(t, false, event["success"])
+	assert.Equal(t, "boom", event["error"])
+}
@@ -7,10 +7,14 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -19,6 +23,7 @@ import (
 
 	streamingv1alpha1 "github.com/ardikabs/hibernator/api/streaming/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/streaming/auth"
+	"github.com/ardikabs/hibernator/internal/streaming/streamtls"
 )
 
 const (
@@ -37,10 +42,16 @@ const (
 
 // WebSocketMessage wraps messages sent over WebSocket.
 type WebSocketMessage struct {
-	Type string          `json:"type"` // "log", "progress", "completion", "heartbeat"
+	Type string          `json:"type"` // "log", "progress", "completion", "heartbeat", "ack"
 	Data json.RawMessage `json:"data"`
 }
 
+// WebSocketAck is the data of the "ack" message the server replies with once
+// it processed a message with a sequence.
+type WebSocketAck struct {
+	Sequence int64 `json:"sequence"`
+}
+
 // WebSocketServer provides WebSocket streaming endpoints for runner communication.
 type WebSocketServer struct {
 	clock          clock.Clock
@@ -55,6 +66,15 @@ type WebSocketServer struct {
 	writeTimeout   time.Duration
 	readTimeout    time.Duration
 	maxMessageSize int64
+	tlsConfig      *tls.Config
+
+	// authorizer enables the watch route. With it, client certificates are
+	// optional on TLS connections and only required of runners.
+	authorizer        Authorizer
+	requireClientCert bool
+
+	// serving is whether the server accepts connections.
+	serving atomic.Bool
 }
 
 // WebSocketServerOptions configures the WebSocket server.
@@ -68,6 +88,13 @@ type WebSocketServerOptions struct {
 	WriteTimeout   time.Duration
 	ReadTimeout    time.Duration
 	MaxMessageSize int64
+
+	// TLSConfig serves WebSocket connections over TLS when set.
+	TLSConfig *tls.Config
+
+	// Authorizer authorizes the subscribers of WatchPath, which is only
+	// served when it is set.
+	Authorizer Authorizer
 }
 
 // NewWebSocketServer creates a new WebSocket streaming server.
@@ -104,6 +131,14 @@ func NewWebSocketServer(opts WebSocketServerOptions) *WebSocketServer {
 		writeTimeout:   opts.WriteTimeout,
 		readTimeout:    opts.ReadTimeout,
 		maxMessageSize: opts.MaxMessageSize,
+		tlsConfig:      opts.TLSConfig,
+		authorizer:     opts.Authorizer,
+	}
+
+	// Watchers authenticate with their token alone.
+	if srv.authorizer != nil && srv.tlsConfig != nil {
+		srv.tlsConfig = streamtls.WithOptionalClientCert(srv.tlsConfig)
+		srv.requireClientCert = true
 	}
 
 	if opts.Clock != nil {
@@ -117,17 +152,35 @@ func NewWebSocketServer(opts WebSocketServerOptions) *WebSocketServer {
 func (s *WebSocketServer) Start(ctx context.Context) error {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/v1alpha1/stream/", s.handleWebSocket)
+	if s.authorizer != nil {
+		mux.HandleFunc(WatchPath, s.handleWatch)
+	}
 
 	server := &http.Server{
-		Addr:    s.addr,
-		Handler: mux,
+		Addr:      s.addr,
+		Handler:   mux,
+		TLSConfig: s.tlsConfig,
+	}
+
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.addr, err)
 	}
 
-	s.log.Info("starting WebSocket server", "addr", s.addr)
+	s.log.Info("starting WebSocket server", "addr", s.addr, "tls", s.tlsConfig != nil)
+	s.serving.Store(true)
 
 	// Start server in goroutine
 	go func() {
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if s.tlsConfig != nil {
+			// The certificate comes from TLSConfig.
+			err = server.ServeTLS(listener, "", "")
+		} else {
+			err = server.Serve(listener)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			s.serving.Store(false)
 			s.log.Error(err, "WebSocket server error")
 		}
 	}()
@@ -135,6 +188,7 @@ func (s *WebSocketServer) Start(ctx context.Context) error {
 	// Wait for context cancellation
 	<-ctx.Done()
 	s.log.Info("shutting down WebSocket server")
+	s.serving.Store(false)
 
 	// Graceful shutdown
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -143,6 +197,15 @@ func (s *WebSocketServer) Start(ctx context.Context) error {
 	return server.Shutdown(shutdownCtx)
 }
 
+// ReadyCheck is a readiness check that fails while the server does not accept
+// connections. See GRPCServer.ReadyCheck.
+func (s *WebSocketServer) ReadyCheck(_ *http.Request) error {
+	if !s.serving.Load() {
+		return errors.New("WebSocket streaming server is not serving")
+	}
+	return nil
+}
+
 // NeedLeaderElection indicates whether the websocket server requires leader election.
 func (s *WebSocketServer) NeedLeaderElection() bool {
 	return false
@@ -158,6 +221,11 @@ func (s *WebSocketServer) handleWebSocket(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	if s.requireClientCert && (r.TLS == nil || len(r.TLS.PeerCertificates) == 0) {
+		http.Error(w, "client certificate required", http.StatusUnauthorized)
+		return
+	}
+
 	// Authenticate request
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
@@ -217,9 +285,12 @@ func (s *WebSocketServer) handleWebSocket(w http.ResponseWriter, r *http.Request
 	pingTicker := time.NewTicker(s.pingInterval)
 	defer pingTicker.Stop()
 
+	// Pings and acknowledgements are written from different goroutines.
+	var writeMu sync.Mutex
+
 	// Handle messages
 	done := make(chan struct{})
-	go s.readMessages(conn, executionID, done)
+	go s.readMessages(conn, &writeMu, executionID, done)
 
 	// Wait for completion or context cancellation
 	for {
@@ -227,7 +298,7 @@ func (s *WebSocketServer) handleWebSocket(w http.ResponseWriter, r *http.Request
 		case <-done:
 			return
 		case <-pingTicker.C:
-			if err := s.sendPing(conn); err != nil {
+			if err := s.sendPing(conn, &writeMu); err != nil {
 				s.log.Error(err, "failed to send ping", "executionId", executionID)
 				return
 			}
@@ -236,7 +307,7 @@ func (s *WebSocketServer) handleWebSocket(w http.ResponseWriter, r *http.Request
 }
 
 // readMessages reads and processes WebSocket messages.
-func (s *WebSocketServer) readMessages(conn *websocket.Conn, executionID string, done chan struct{}) {
+func (s *WebSocketServer) readMessages(conn *websocket.Conn, writeMu *sync.Mutex, executionID string, done chan struct{}) {
 	defer close(done)
 
 	for {
@@ -264,10 +335,41 @@ func (s *WebSocketServer) readMessages(conn *websocket.Conn, executionID string,
 		// Process message based on type
 		if err := s.processMessage(executionID, &msg); err != nil {
 			s.log.Error(err, "failed to process message", "executionId", executionID, "type", msg.Type)
+			continue
+		}
+
+		// Unacknowledged messages are replayed by the runner.
+		if err := s.acknowledge(conn, writeMu, &msg); err != nil {
+			s.log.Error(err, "failed to acknowledge message", "executionId", executionID, "type", msg.Type)
+			return
 		}
 	}
 }
 
+// acknowledge replies to a processed message that carries a sequence.
+func (s *WebSocketServer) acknowledge(conn *websocket.Conn, writeMu *sync.Mutex, msg *WebSocketMessage) error {
+	var ack WebSocketAck
+	if err := json.Unmarshal(msg.Data, &ack); err != nil || ack.Sequence == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(ack)
+	if err != nil {
+		return err
+	}
+	reply, err := json.Marshal(WebSocketMessage{Type: "ack", Data: data})
+	if err != nil {
+		return err
+	}
+
+	writeMu.Lock()
+	defer writeMu.Unlock()
+	if err := conn.SetWriteDeadline(s.clock.Now().Add(s.writeTimeout)); err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.TextMessage, reply)
+}
+
 // processMessage processes a WebSocket message.
 func (s *WebSocketServer) processMessage(executionID string, msg *WebSocketMessage) error {
 	ctx := context.Background()
@@ -321,7 +423,9 @@ func (s *WebSocketServer) handleLog(ctx context.Context, entry *streamingv1alpha
 }
 
 // sendPing sends a ping to keep the connection alive.
-func (s *WebSocketServer) sendPing(conn *websocket.Conn) error {
+func (s *WebSocketServer) sendPing(conn *websocket.Conn, writeMu *sync.Mutex) error {
+	writeMu.Lock()
+	defer writeMu.Unlock()
 	if err := conn.SetWriteDeadline(s.clock.Now().Add(s.writeTimeout)); err != nil {
 		return err
 	}
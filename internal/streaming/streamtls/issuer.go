@@ -0,0 +1,131 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package streamtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// DefaultClientCertValidity is how long runner client certificates are valid.
+const DefaultClientCertValidity = 24 * time.Hour
+
+// clockSkew backdates certificates so nodes whose clocks lag the controller's
+// accept them right away.
+const clockSkew = 5 * time.Minute
+
+// Issuer issues the client certificates runners present to the streaming
+// servers. They are signed by the CA in caDir, whose files are reloaded when
+// they change, and bundled with the CA runners verify the servers with.
+type Issuer struct {
+	ca       *fileCache[*tls.Certificate]
+	serverCA *fileCache[[]byte]
+	validity time.Duration
+	clock    clock.Clock
+}
+
+// NewIssuer returns an Issuer signing with the certificate and key in caDir.
+// serverCAFile is the CA bundle issued certificates are bundled with as ca.crt.
+// A zero validity uses DefaultClientCertValidity.
+func NewIssuer(caDir, serverCAFile string, validity time.Duration, clk clock.Clock) (*Issuer, error) {
+	if validity <= 0 {
+		validity = DefaultClientCertValidity
+	}
+
+	i := &Issuer{
+		ca: newKeyPairFile(caDir),
+		serverCA: &fileCache[[]byte]{
+			paths: []string{serverCAFile},
+			parse: func(data [][]byte) ([]byte, error) { return data[0], nil },
+		},
+		validity: validity,
+		clock:    clk,
+	}
+
+	ca, err := i.ca.get()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCA(ca); err != nil {
+		return nil, fmt.Errorf("%s: %w", caDir, err)
+	}
+	if _, err := i.serverCA.get(); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// Issue returns a new client certificate for commonName as the data of a
+// kubernetes.io/tls Secret, with the CA bundle of the servers as ca.crt.
+func (i *Issuer) Issue(commonName string) (map[string][]byte, error) {
+	ca, err := i.ca.get()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCA(ca); err != nil {
+		return nil, err
+	}
+	serverCA, err := i.serverCA.get()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("generate serial number: %w", err)
+	}
+
+	now := i.clock.Now()
+	notAfter := now.Add(i.validity)
+	if notAfter.After(ca.Leaf.NotAfter) {
+		notAfter = ca.Leaf.NotAfter
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-clockSkew),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Leaf, key.Public(), ca.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign client certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("encode key: %w", err)
+	}
+
+	return map[string][]byte{
+		CertFile: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		KeyFile:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		CAFile:   serverCA,
+	}, nil
+}
+
+func checkCA(ca *tls.Certificate) error {
+	if ca.Leaf == nil || !ca.Leaf.IsCA {
+		return errors.New("certificate is not a CA")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package streamtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestIssuer_Issue(t *testing.T) {
+	ca := newTestCA(t, "stream-ca")
+	caDir := t.TempDir()
+	writeDir(t, caDir, map[string][]byte{CertFile: ca.certPEM, KeyFile: ca.keyPEM, CAFile: ca.certPEM})
+
+	now := time.Now().Truncate(time.Second)
+	issuer, err := NewIssuer(caDir, filepath.Join(caDir, CAFile), 30*time.Minute, clocktesting.NewFakeClock(now))
+	require.NoError(t, err)
+
+	data, err := issuer.Issue("p-db-1700000000")
+	require.NoError(t, err)
+	assert.Equal(t, ca.certPEM, data[CAFile])
+
+	pair, err := tls.X509KeyPair(data[CertFile], data[KeyFile])
+	require.NoError(t, err)
+	cert := pair.Leaf
+	assert.Equal(t, "p-db-1700000000", cert.Subject.CommonName)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+	assert.True(t, now.Add(30*time.Minute).Equal(cert.NotAfter))
+	assert.True(t, cert.NotBefore.Before(now), "backdated for clock skew")
+	require.NoError(t, cert.CheckSignatureFrom(ca.cert))
+}
+
+func TestIssuer_ValidityCappedByCA(t *testing.T) {
+	ca := newTestCA(t, "stream-ca")
+	caDir := t.TempDir()
+	writeDir(t, caDir, map[string][]byte{CertFile: ca.certPEM, KeyFile: ca.keyPEM, CAFile: ca.certPEM})
+
+	issuer, err := NewIssuer(caDir, filepath.Join(caDir, CAFile), 0, clocktesting.NewFakeClock(time.Now()))
+	require.NoError(t, err)
+
+	data, err := issuer.Issue("runner")
+	require.NoError(t, err)
+	pair, err := tls.X509KeyPair(data[CertFile], data[KeyFile])
+	require.NoError(t, err)
+	assert.Equal(t, ca.cert.NotAfter, pair.Leaf.NotAfter, "certificates never outlive the CA")
+}
+
+func TestNewIssuer_RejectsLeafCertificate(t *testing.T) {
+	ca := newTestCA(t, "stream-ca")
+	leaf := newTestCert(t, ca, &x509.Certificate{Subject: pkix.Name{CommonName: "leaf"}})
+	dir := t.TempDir()
+	writeDir(t, dir, map[string][]byte{CertFile: leaf.certPEM, KeyFile: leaf.keyPEM, CAFile: ca.certPEM})
+
+	_, err := NewIssuer(dir, filepath.Join(dir, CAFile), time.Hour, clocktesting.NewFakeClock(time.Now()))
+	assert.ErrorContains(t, err, "not a CA")
+}
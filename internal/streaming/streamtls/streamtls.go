@@ -0,0 +1,250 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package streamtls provides the mutual TLS configuration of the streaming
+// servers and the runners that connect to them. Certificates are read from
+// directories laid out like a kubernetes.io/tls Secret (tls.crt, tls.key and
+// ca.crt), such as the Secrets cert-manager issues, and are reloaded when the
+// files change so rotated certificates are used without a restart.
+package streamtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// CertFile is the file name of a PEM-encoded certificate chain.
+	CertFile = "tls.crt"
+
+	// KeyFile is the file name of the PEM-encoded private key of CertFile.
+	KeyFile = "tls.key"
+
+	// CAFile is the file name of the PEM-encoded CA bundle peers are verified with.
+	CAFile = "ca.crt"
+)
+
+// ServerConfig returns the TLS configuration of the streaming servers. They
+// present the certificate in dir and require client certificates issued by a
+// CA in dir's ca.crt. All files are reloaded when they change.
+func ServerConfig(dir string) (*tls.Config, error) {
+	keyPair := newKeyPairFile(dir)
+	if _, err := keyPair.get(); err != nil {
+		return nil, err
+	}
+	clientCAs := newCertPoolFile(filepath.Join(dir, CAFile))
+	if _, err := clientCAs.get(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return keyPair.get()
+		},
+		// Client certificates are verified against the current CA bundle below
+		// instead of a fixed ClientCAs pool, so a rotated CA applies to new
+		// connections immediately.
+		ClientAuth: tls.RequireAnyClientCert,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			pool, err := clientCAs.get()
+			if err != nil {
+				return err
+			}
+			return verifyClientCertificate(rawCerts, pool)
+		},
+	}, nil
+}
+
+// ServingConfig returns a TLS configuration that presents the streaming server
+// certificate in dir without requesting client certificates. It serves HTTP
+// APIs whose callers authenticate with bearer tokens, which must not cross the
+// network in cleartext. The key pair is reloaded when it changes.
+func ServingConfig(dir string) (*tls.Config, error) {
+	keyPair := newKeyPairFile(dir)
+	if _, err := keyPair.get(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return keyPair.get()
+		},
+	}, nil
+}
+
+// WithOptionalClientCert returns a copy of a ServerConfig that also accepts
+// connections without a client certificate. Certificates presented are still
+// verified; servers must check for one where it is required.
+func WithOptionalClientCert(cfg *tls.Config) *tls.Config {
+	optional := cfg.Clone()
+	optional.ClientAuth = tls.RequestClientCert
+	verify := cfg.VerifyPeerCertificate
+	optional.VerifyPeerCertificate = func(rawCerts [][]byte, chains [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return nil
+		}
+		return verify(rawCerts, chains)
+	}
+	return optional
+}
+
+// ClientConfig returns the TLS configuration runners connect to the streaming
+// servers with. They present the certificate in dir and verify the servers with
+// dir's ca.crt.
+func ClientConfig(dir string) (*tls.Config, error) {
+	keyPair := newKeyPairFile(dir)
+	if _, err := keyPair.get(); err != nil {
+		return nil, err
+	}
+	rootCAs, err := newCertPoolFile(filepath.Join(dir, CAFile)).get()
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    rootCAs,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return keyPair.get()
+		},
+	}, nil
+}
+
+func verifyClientCertificate(rawCerts [][]byte, roots *x509.CertPool) error {
+	if len(rawCerts) == 0 {
+		return errors.New("no client certificate")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("parse client certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("verify client certificate: %w", err)
+	}
+	return nil
+}
+
+func newKeyPairFile(dir string) *fileCache[*tls.Certificate] {
+	return &fileCache[*tls.Certificate]{
+		paths: []string{filepath.Join(dir, CertFile), filepath.Join(dir, KeyFile)},
+		parse: func(data [][]byte) (*tls.Certificate, error) {
+			cert, err := tls.X509KeyPair(data[0], data[1])
+			if err != nil {
+				return nil, fmt.Errorf("load key pair from %s: %w", dir, err)
+			}
+			return &cert, nil
+		},
+	}
+}
+
+func newCertPoolFile(path string) *fileCache[*x509.CertPool] {
+	return &fileCache[*x509.CertPool]{
+		paths: []string{path},
+		parse: func(data [][]byte) (*x509.CertPool, error) {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(data[0]) {
+				return nil, fmt.Errorf("no PEM certificates found in %s", path)
+			}
+			return pool, nil
+		},
+	}
+}
+
+// fileCache holds a value parsed from files and parses them again when their
+// size or modification time changes. Secret volumes swap all files at once, so
+// a changed file is never read alongside a stale one.
+type fileCache[T any] struct {
+	paths []string
+	parse func(data [][]byte) (T, error)
+
+	mu     sync.Mutex
+	stamps []fileStamp
+	value  T
+	loaded bool
+}
+
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// get returns the parsed files. When the files changed but cannot be parsed,
+// the previously parsed value is kept so a partially written update does not
+// break established configuration; the files are read again on the next call.
+func (c *fileCache[T]) get() (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stamps := make([]fileStamp, len(c.paths))
+	for i, path := range c.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return c.keep(fmt.Errorf("stat %s: %w", path, err))
+		}
+		stamps[i] = fileStamp{size: info.Size(), modTime: info.ModTime()}
+	}
+	if c.loaded && equalStamps(stamps, c.stamps) {
+		return c.value, nil
+	}
+
+	data := make([][]byte, len(c.paths))
+	for i, path := range c.paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return c.keep(fmt.Errorf("read %s: %w", path, err))
+		}
+		data[i] = b
+	}
+
+	value, err := c.parse(data)
+	if err != nil {
+		return c.keep(err)
+	}
+	c.value, c.stamps, c.loaded = value, stamps, true
+	return value, nil
+}
+
+func (c *fileCache[T]) keep(err error) (T, error) {
+	if c.loaded {
+		return c.value, nil
+	}
+	var zero T
+	return zero, err
+}
+
+func equalStamps(a, b []fileStamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].size != b[i].size || !a[i].modTime.Equal(b[i].modTime) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package streamtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// newTestCert returns a certificate signed by parent, or a self-signed one when
+// parent is nil.
+func newTestCert(t *testing.T, parent *testCert, template *x509.Certificate) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template.SerialNumber = serial
+	if template.NotBefore.IsZero() {
+		template.NotBefore = time.Now().Add(-time.Hour)
+	}
+	if template.NotAfter.IsZero() {
+		template.NotAfter = time.Now().Add(time.Hour)
+	}
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, key.Public(), signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func newTestCA(t *testing.T, name string) *testCert {
+	return newTestCert(t, nil, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: name},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	})
+}
+
+func newTestServerCert(t *testing.T, ca *testCert) *testCert {
+	return newTestCert(t, ca, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "hibernator"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+}
+
+// writeDir writes files into dir, moving their modification time forward so a
+// rewrite within the same second is still noticed.
+func writeDir(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+
+	mtime := time.Now().Add(time.Duration(len(files)) * time.Minute)
+	if info, err := os.Stat(filepath.Join(dir, CertFile)); err == nil {
+		mtime = info.ModTime().Add(time.Minute)
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+	}
+}
+
+// newMutualTLSServer starts an HTTPS server configured with ServerConfig for a
+// server certificate issued by ca and returns its URL.
+func newMutualTLSServer(t *testing.T, ca *testCert) (string, string) {
+	t.Helper()
+	return newTLSServer(t, ca, func(cfg *tls.Config) *tls.Config { return cfg })
+}
+
+// newTLSServer is newMutualTLSServer with the configuration passed through wrap.
+func newTLSServer(t *testing.T, ca *testCert, wrap func(*tls.Config) *tls.Config) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	server := newTestServerCert(t, ca)
+	writeDir(t, dir, map[string][]byte{CertFile: server.certPEM, KeyFile: server.keyPEM, CAFile: ca.certPEM})
+
+	cfg, err := ServerConfig(dir)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+		ErrorLog: log.New(io.Discard, "", 0),
+	}
+	go func() { _ = srv.Serve(tls.NewListener(listener, wrap(cfg))) }()
+	t.Cleanup(func() { _ = srv.Close() })
+	return "https://" + listener.Addr().String(), dir
+}
+
+func newHTTPClient(cfg *tls.Config) *http.Client {
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+}
+
+func get(client *http.Client, url string) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// issueClientDir issues a runner client certificate with a CA and writes it
+// into a directory for ClientConfig.
+func issueClientDir(t *testing.T, ca *testCert, serverCAFile string) string {
+	t.Helper()
+
+	caDir := t.TempDir()
+	writeDir(t, caDir, map[string][]byte{CertFile: ca.certPEM, KeyFile: ca.keyPEM})
+	issuer, err := NewIssuer(caDir, serverCAFile, time.Hour, clocktesting.NewFakeClock(time.Now()))
+	require.NoError(t, err)
+	data, err := issuer.Issue("runner")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeDir(t, dir, data)
+	return dir
+}
+
+func TestServerConfig_RequiresClientCertificateIssuedByCA(t *testing.T) {
+	ca := newTestCA(t, "stream-ca")
+	url, serverDir := newMutualTLSServer(t, ca)
+
+	clientCfg, err := ClientConfig(issueClientDir(t, ca, filepath.Join(serverDir, CAFile)))
+	require.NoError(t, err)
+	require.NoError(t, get(newHTTPClient(clientCfg), url))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	err = get(newHTTPClient(&tls.Config{RootCAs: roots}), url)
+	assert.Error(t, err, "clients without a certificate are rejected")
+
+	otherCA := newTestCA(t, "other-ca")
+	otherCfg, err := ClientConfig(issueClientDir(t, otherCA, filepath.Join(serverDir, CAFile)))
+	require.NoError(t, err)
+	assert.Error(t, get(newHTTPClient(otherCfg), url), "certificates of another CA are rejected")
+}
+
+func TestWithOptionalClientCert(t *testing.T) {
+	ca := newTestCA(t, "stream-ca")
+	url, serverDir := newTLSServer(t, ca, WithOptionalClientCert)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	require.NoError(t, get(newHTTPClient(&tls.Config{RootCAs: roots}), url), "clients without a certificate are accepted")
+
+	clientCfg, err := ClientConfig(issueClientDir(t, ca, filepath.Join(serverDir, CAFile)))
+	require.NoError(t, err)
+	require.NoError(t, get(newHTTPClient(clientCfg), url))
+
+	otherCA := newTestCA(t, "other-ca")
+	otherCfg, err := ClientConfig(issueClientDir(t, otherCA, filepath.Join(serverDir, CAFile)))
+	require.NoError(t, err)
+	assert.Error(t, get(newHTTPClient(otherCfg), url), "certificates presented are still verified")
+}
+
+func TestServingConfig(t *testing.T) {
+	ca := newTestCA(t, "stream-ca")
+	dir := t.TempDir()
+	server := newTestServerCert(t, ca)
+	writeDir(t, dir, map[string][]byte{CertFile: server.certPEM, KeyFile: server.keyPEM})
+
+	cfg, err := ServingConfig(dir)
+	require.NoError(t, err)
+	assert.Equal(t, tls.NoClientCert, cfg.ClientAuth, "callers authenticate with bearer tokens")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+		ErrorLog: log.New(io.Discard, "", 0),
+	}
+	go func() { _ = srv.Serve(tls.NewListener(listener, cfg)) }()
+	t.Cleanup(func() { _ = srv.Close() })
+	url := "https://" + listener.Addr().String()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	require.NoError(t, get(newHTTPClient(&tls.Config{RootCAs: roots}), url))
+	assert.Error(t, get(newHTTPClient(&tls.Config{}), url), "the server certificate is verified by clients")
+
+	_, err = ServingConfig(t.TempDir())
+	assert.Error(t, err, "a missing key pair is reported")
+}
+
+func TestServerConfig_ReloadsRotatedFiles(t *testing.T) {
+	oldCA := newTestCA(t, "old-ca")
+	url, serverDir := newMutualTLSServer(t, oldCA)
+
+	// Rotate to a new CA: the server presents a certificate of the new CA and
+	// accepts client certificates of both while runners move over.
+	newCA := newTestCA(t, "new-ca")
+	server := newTestServerCert(t, newCA)
+	writeDir(t, serverDir, map[string][]byte{
+		CertFile: server.certPEM,
+		KeyFile:  server.keyPEM,
+		CAFile:   append(append([]byte{}, oldCA.certPEM...), newCA.certPEM...),
+	})
+
+	for _, ca := range []*testCert{oldCA, newCA} {
+		clientCfg, err := ClientConfig(issueClientDir(t, ca, filepath.Join(serverDir, CAFile)))
+		require.NoError(t, err)
+		require.NoError(t, get(newHTTPClient(clientCfg), url), ca.cert.Subject.CommonName)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(oldCA.cert)
+	clientCfg, err := ClientConfig(issueClientDir(t, oldCA, filepath.Join(serverDir, CAFile)))
+	require.NoError(t, err)
+	clientCfg.RootCAs = roots
+	assert.Error(t, get(newHTTPClient(clientCfg), url), "the server presents the rotated certificate")
+}
+
+func TestServerConfig_KeepsLastGoodFiles(t *testing.T) {
+	ca := newTestCA(t, "stream-ca")
+	url, serverDir := newMutualTLSServer(t, ca)
+	clientDir := issueClientDir(t, ca, filepath.Join(serverDir, CAFile))
+
+	writeDir(t, serverDir, map[string][]byte{CertFile: []byte("partial"), CAFile: []byte("partial")})
+
+	clientCfg, err := ClientConfig(clientDir)
+	require.NoError(t, err)
+	assert.NoError(t, get(newHTTPClient(clientCfg), url))
+}
+
+func TestServerConfig_MissingFiles(t *testing.T) {
+	_, err := ServerConfig(t.TempDir())
+	assert.Error(t, err)
+
+	ca := newTestCA(t, "stream-ca")
+	dir := t.TempDir()
+	writeDir(t, dir, map[string][]byte{CertFile: ca.certPEM, KeyFile: ca.keyPEM, CAFile: []byte("not pem")})
+	_, err = ServerConfig(dir)
+	assert.ErrorContains(t, err, "no PEM certificates")
+}
+
+func TestServerConfig_GRPC(t *testing.T) {
+	ca := newTestCA(t, "stream-ca")
+	serverDir := t.TempDir()
+	server := newTestServerCert(t, ca)
+	writeDir(t, serverDir, map[string][]byte{CertFile: server.certPEM, KeyFile: server.keyPEM, CAFile: ca.certPEM})
+
+	serverCfg, err := ServerConfig(serverDir)
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(serverCfg)))
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	clientCfg, err := ClientConfig(issueClientDir(t, ca, filepath.Join(serverDir, CAFile)))
+	require.NoError(t, err)
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientCfg)))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+}
@@ -16,6 +16,8 @@ type LogEntry struct {
 	Level       string            `json:"level"`
 	Message     string            `json:"message"`
 	Fields      map[string]string `json:"fields,omitempty"`
+	Sequence    int64             `json:"sequence,omitempty"`
+	SessionID   string            `json:"sessionId,omitempty"`
 }
 
 // ToProto converts internal LogEntry to proto LogEntry.
@@ -26,6 +28,8 @@ func (e *LogEntry) ToProto() *streamingv1alpha1.LogEntry {
 		Level:       e.Level,
 		Message:     e.Message,
 		Fields:      e.Fields,
+		Sequence:    e.Sequence,
+		SessionId:   e.SessionID,
 	}
 }
 
@@ -41,6 +45,8 @@ func FromProtoLogEntry(p *streamingv1alpha1.LogEntry) (*LogEntry, error) {
 		Level:       p.Level,
 		Message:     p.Message,
 		Fields:      p.Fields,
+		Sequence:    p.Sequence,
+		SessionID:   p.SessionId,
 	}, nil
 }
 
@@ -56,16 +62,29 @@ type ProgressReport struct {
 	ProgressPercent int32     `json:"progressPercent"`
 	Message         string    `json:"message"`
 	Timestamp       time.Time `json:"timestamp"`
+	Sequence        int64     `json:"sequence,omitempty"`
+	SessionID       string    `json:"sessionId,omitempty"`
+
+	// CompletedResources, TotalResources and Resource carry per-resource
+	// progress; TotalResources is zero for coarse phase progress.
+	CompletedResources int32  `json:"completedResources,omitempty"`
+	TotalResources     int32  `json:"totalResources,omitempty"`
+	Resource           string `json:"resource,omitempty"`
 }
 
 // ToProto converts internal ProgressReport to proto ProgressReport.
 func (p *ProgressReport) ToProto() *streamingv1alpha1.ProgressReport {
 	return &streamingv1alpha1.ProgressReport{
-		ExecutionId:     p.ExecutionID,
-		Phase:           p.Phase,
-		ProgressPercent: p.ProgressPercent,
-		Message:         p.Message,
-		Timestamp:       p.Timestamp.Format(time.RFC3339),
+		ExecutionId:        p.ExecutionID,
+		Phase:              p.Phase,
+		ProgressPercent:    p.ProgressPercent,
+		Message:            p.Message,
+		Timestamp:          p.Timestamp.Format(time.RFC3339),
+		Sequence:           p.Sequence,
+		SessionId:          p.SessionID,
+		CompletedResources: p.CompletedResources,
+		TotalResources:     p.TotalResources,
+		Resource:           p.Resource,
 	}
 }
 
@@ -77,21 +96,29 @@ type ProgressResponse struct {
 // CompletionReport reports the final result of an execution (internal representation).
 // Note: RestoreData removed - runners persist directly to ConfigMap.
 type CompletionReport struct {
-	ExecutionID  string    `json:"executionId"`
-	Success      bool      `json:"success"`
-	ErrorMessage string    `json:"errorMessage,omitempty"`
-	DurationMs   int64     `json:"durationMs"`
-	Timestamp    time.Time `json:"timestamp"`
+	ExecutionID   string    `json:"executionId"`
+	Success       bool      `json:"success"`
+	ErrorMessage  string    `json:"errorMessage,omitempty"`
+	DurationMs    int64     `json:"durationMs"`
+	Timestamp     time.Time `json:"timestamp"`
+	Sequence      int64     `json:"sequence,omitempty"`
+	SessionID     string    `json:"sessionId,omitempty"`
+	Cancelled     bool      `json:"cancelled,omitempty"`
+	FailureReason string    `json:"failureReason,omitempty"`
 }
 
 // ToProto converts internal CompletionReport to proto CompletionReport.
 func (c *CompletionReport) ToProto() *streamingv1alpha1.CompletionReport {
 	return &streamingv1alpha1.CompletionReport{
-		ExecutionId:  c.ExecutionID,
-		Success:      c.Success,
-		ErrorMessage: c.ErrorMessage,
-		DurationMs:   c.DurationMs,
-		Timestamp:    c.Timestamp.Format(time.RFC3339),
+		ExecutionId:   c.ExecutionID,
+		Success:       c.Success,
+		ErrorMessage:  c.ErrorMessage,
+		DurationMs:    c.DurationMs,
+		Timestamp:     c.Timestamp.Format(time.RFC3339),
+		Sequence:      c.Sequence,
+		SessionId:     c.SessionID,
+		Cancelled:     c.Cancelled,
+		FailureReason: c.FailureReason,
 	}
 }
 
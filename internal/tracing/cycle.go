@@ -0,0 +1,55 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type cycleKey struct{}
+
+// WithCycle returns ctx whose root spans belong to the trace of cycle. The
+// controller handles a hibernation cycle over many reconcile passes; deriving
+// their trace ID from the cycle groups them, and the runners they dispatch,
+// into a single trace.
+func WithCycle(ctx context.Context, cycle string) context.Context {
+	if cycle == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, cycleKey{}, cycle)
+}
+
+// CycleTraceID returns the trace ID of the spans of cycle.
+func CycleTraceID(cycle string) trace.TraceID {
+	sum := sha256.Sum256([]byte(cycle))
+	var id trace.TraceID
+	copy(id[:], sum[:])
+	return id
+}
+
+// cycleIDGenerator derives the trace ID of root spans from the cycle of their
+// context and generates all other IDs randomly.
+type cycleIDGenerator struct{}
+
+func (g cycleIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	var traceID trace.TraceID
+	if cycle, ok := ctx.Value(cycleKey{}).(string); ok {
+		traceID = CycleTraceID(cycle)
+	} else {
+		_, _ = rand.Read(traceID[:])
+	}
+	return traceID, g.NewSpanID(ctx, traceID)
+}
+
+func (cycleIDGenerator) NewSpanID(_ context.Context, _ trace.TraceID) trace.SpanID {
+	var spanID trace.SpanID
+	_, _ = rand.Read(spanID[:])
+	return spanID
+}
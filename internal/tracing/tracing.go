@@ -0,0 +1,133 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package tracing sets up OpenTelemetry tracing for the controller and the
+// runner, and carries the trace context of a hibernation cycle from the
+// controller into the runner Jobs it dispatches.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ardikabs/hibernator/internal/version"
+)
+
+const (
+	// TracerName is the instrumentation scope of Hibernator's spans.
+	TracerName = "github.com/ardikabs/hibernator"
+
+	// EnvEndpoint and EnvInsecure pass the exporter settings of the
+	// controller to runner Jobs.
+	EnvEndpoint = "HIBERNATOR_TRACING_ENDPOINT"
+	EnvInsecure = "HIBERNATOR_TRACING_INSECURE"
+
+	// EnvTraceparent carries the W3C trace context of the span that
+	// dispatched a runner Job.
+	EnvTraceparent = "TRACEPARENT"
+)
+
+// Config configures the export of spans.
+type Config struct {
+	// Endpoint is the host:port of an OTLP gRPC collector. Empty disables
+	// tracing.
+	Endpoint string
+
+	// Insecure exports spans without TLS.
+	Insecure bool
+
+	// SampleRatio is the fraction of hibernation cycles traced. Spans
+	// continuing a trace follow the sampling decision of their parent.
+	SampleRatio float64
+}
+
+// Enabled reports whether spans are exported.
+func (c Config) Enabled() bool {
+	return c.Endpoint != ""
+}
+
+// Setup installs the global tracer provider and W3C trace context
+// propagation. The returned function flushes and stops the exporter. When
+// tracing is disabled, the global no-op provider is kept and shutdown does
+// nothing.
+func Setup(ctx context.Context, serviceName string, cfg Config) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	if !cfg.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("tracing sample ratio %v must be between 0 and 1", cfg.SampleRatio)
+	}
+
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.GetVersion()),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("build trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithIDGenerator(cycleIDGenerator{}),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns Hibernator's tracer from the global provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// EndSpan records err on span, if any, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Traceparent returns the W3C traceparent of the span in ctx, or "" when ctx
+// carries no sampled span.
+func Traceparent(ctx context.Context) string {
+	if !trace.SpanContextFromContext(ctx).IsSampled() {
+		return ""
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// ContextWithTraceparent returns ctx with the remote span described by the W3C
+// traceparent as parent of the spans started from it. Invalid values are
+// ignored.
+func ContextWithTraceparent(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceparent})
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestProvider(t *testing.T) (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(recorder),
+		sdktrace.WithIDGenerator(cycleIDGenerator{}),
+	)
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	return provider, recorder
+}
+
+func TestSetup_Disabled(t *testing.T) {
+	shutdown, err := Setup(context.Background(), "test", Config{})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+	_, span := Tracer().Start(context.Background(), "noop")
+	assert.False(t, span.SpanContext().IsValid(), "spans are not recorded")
+}
+
+func TestSetup_RejectsInvalidSampleRatio(t *testing.T) {
+	_, err := Setup(context.Background(), "test", Config{Endpoint: "localhost:4317", SampleRatio: 2})
+	assert.Error(t, err)
+}
+
+func TestTraceparent_RoundTrip(t *testing.T) {
+	provider, _ := newTestProvider(t)
+	ctx, span := provider.Tracer(TracerName).Start(context.Background(), "dispatch")
+	defer span.End()
+
+	traceparent := Traceparent(ctx)
+	require.NotEmpty(t, traceparent)
+
+	_, child := provider.Tracer(TracerName).Start(ContextWithTraceparent(context.Background(), traceparent), "runner")
+	defer child.End()
+	assert.Equal(t, span.SpanContext().TraceID(), child.SpanContext().TraceID())
+
+	assert.Empty(t, Traceparent(context.Background()), "no span")
+	assert.Equal(t, context.Background(), ContextWithTraceparent(context.Background(), ""))
+}
+
+func TestWithCycle_SharesTraceAcrossRootSpans(t *testing.T) {
+	provider, recorder := newTestProvider(t)
+	tracer := provider.Tracer(TracerName)
+
+	for range 2 {
+		_, span := tracer.Start(WithCycle(context.Background(), "uid/cycle-1/shutdown"), "reconcile")
+		span.End()
+	}
+	_, other := tracer.Start(WithCycle(context.Background(), "uid/cycle-1/wakeup"), "reconcile")
+	other.End()
+	_, random := tracer.Start(WithCycle(context.Background(), ""), "reconcile")
+	random.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 4)
+	assert.Equal(t, CycleTraceID("uid/cycle-1/shutdown"), spans[0].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.NotEqual(t, spans[0].SpanContext().SpanID(), spans[1].SpanContext().SpanID())
+	assert.Equal(t, CycleTraceID("uid/cycle-1/wakeup"), spans[2].SpanContext().TraceID())
+	assert.NotEqual(t, spans[0].SpanContext().TraceID(), spans[3].SpanContext().TraceID())
+	assert.True(t, spans[3].SpanContext().TraceID().IsValid())
+}
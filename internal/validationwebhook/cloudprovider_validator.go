@@ -8,6 +8,7 @@ package validationwebhook
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -73,6 +74,18 @@ func (v *CloudProviderValidator) validate(cp *hibernatorv1alpha1.CloudProvider)
 					"at least one authentication method must be specified: spec.aws.auth.serviceAccount or spec.aws.auth.static",
 				))
 			}
+			allErrs = append(allErrs, validateRoleChain(cp.Spec.AWS, field.NewPath("spec", "aws", "auth", "roleChain"))...)
+		}
+	}
+
+	if cp.Spec.Type == hibernatorv1alpha1.CloudProviderGCP {
+		if cp.Spec.GCP == nil {
+			allErrs = append(allErrs, field.Required(
+				field.NewPath("spec", "gcp"),
+				"spec.gcp is required when type is 'gcp'",
+			))
+		} else {
+			allErrs = append(allErrs, validateGCPAuth(&cp.Spec.GCP.Auth, field.NewPath("spec", "gcp", "auth"))...)
 		}
 	}
 
@@ -81,3 +94,73 @@ func (v *CloudProviderValidator) validate(cp *hibernatorv1alpha1.CloudProvider)
 	}
 	return nil, nil
 }
+
+// Limits AWS STS places on session tags.
+const (
+	maxSessionTagKeyLength   = 128
+	maxSessionTagValueLength = 256
+)
+
+// validateRoleChain checks the roles of spec.aws.auth.roleChain and the session tags
+// passed to them. A role assumed twice in a row, including the last role of the
+// chain followed by the same assumeRoleArn, is rejected as a likely copy mistake.
+func validateRoleChain(spec *hibernatorv1alpha1.AWSConfig, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	previous := ""
+	for i, step := range spec.Auth.RoleChain {
+		stepPath := path.Index(i)
+		if step.RoleArn == "" {
+			allErrs = append(allErrs, field.Required(stepPath.Child("roleArn"), "roleArn is required"))
+		} else if step.RoleArn == previous {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("roleArn"), step.RoleArn, "role is assumed twice in a row"))
+		}
+		previous = step.RoleArn
+
+		for key, value := range step.SessionTags {
+			tagPath := stepPath.Child("sessionTags").Key(key)
+			switch {
+			case key == "" || len(key) > maxSessionTagKeyLength:
+				allErrs = append(allErrs, field.Invalid(tagPath, key, fmt.Sprintf("session tag keys must be 1-%d characters", maxSessionTagKeyLength)))
+			case strings.HasPrefix(strings.ToLower(key), "aws:"):
+				allErrs = append(allErrs, field.Invalid(tagPath, key, "session tag keys must not use the reserved aws: prefix"))
+			case len(value) > maxSessionTagValueLength:
+				allErrs = append(allErrs, field.Invalid(tagPath, value, fmt.Sprintf("session tag values must be at most %d characters", maxSessionTagValueLength)))
+			}
+		}
+	}
+
+	if spec.AssumeRoleArn != "" && spec.AssumeRoleArn == previous {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "aws", "assumeRoleArn"), spec.AssumeRoleArn,
+			"assumeRoleArn repeats the last role of spec.aws.auth.roleChain"))
+	}
+	return allErrs
+}
+
+// validateGCPAuth checks that exactly one credential source is configured and that
+// the impersonation chain names each service account once.
+func validateGCPAuth(auth *hibernatorv1alpha1.GCPAuth, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case auth.ServiceAccount == nil && auth.WorkloadIdentityFederation == nil:
+		allErrs = append(allErrs, field.Required(path,
+			"exactly one authentication method must be specified: spec.gcp.auth.serviceAccount or spec.gcp.auth.workloadIdentityFederation"))
+	case auth.ServiceAccount != nil && auth.WorkloadIdentityFederation != nil:
+		allErrs = append(allErrs, field.Forbidden(path.Child("workloadIdentityFederation"),
+			"spec.gcp.auth.serviceAccount and spec.gcp.auth.workloadIdentityFederation are mutually exclusive"))
+	}
+
+	seen := map[string]bool{}
+	for i, email := range auth.ImpersonationChain {
+		chainPath := path.Child("impersonationChain").Index(i)
+		if !strings.HasSuffix(email, ".iam.gserviceaccount.com") {
+			allErrs = append(allErrs, field.Invalid(chainPath, email, "must be a service account email ending in .iam.gserviceaccount.com"))
+		}
+		if seen[email] {
+			allErrs = append(allErrs, field.Duplicate(chainPath, email))
+		}
+		seen[email] = true
+	}
+	return allErrs
+}
@@ -145,6 +145,41 @@ func TestCloudProviderValidator_ValidateCreate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "at least one authentication method must be specified",
 		},
+		{
+			name: "valid - role chain through a security account",
+			provider: roleChainProvider("arn:aws:iam::123456789012:role/hibernator-target",
+				hibernatorv1alpha1.AssumeRoleStep{
+					RoleArn:     "arn:aws:iam::111111111111:role/security-hop",
+					ExternalID:  "hibernator",
+					SessionTags: map[string]string{"team": "platform"},
+				}),
+			wantErr: false,
+		},
+		{
+			name: "invalid - role chain repeats a role",
+			provider: roleChainProvider("",
+				hibernatorv1alpha1.AssumeRoleStep{RoleArn: "arn:aws:iam::111111111111:role/security-hop"},
+				hibernatorv1alpha1.AssumeRoleStep{RoleArn: "arn:aws:iam::111111111111:role/security-hop"}),
+			wantErr: true,
+			errMsg:  "role is assumed twice in a row",
+		},
+		{
+			name: "invalid - assumeRoleArn repeats the last role of the chain",
+			provider: roleChainProvider("arn:aws:iam::111111111111:role/security-hop",
+				hibernatorv1alpha1.AssumeRoleStep{RoleArn: "arn:aws:iam::111111111111:role/security-hop"}),
+			wantErr: true,
+			errMsg:  "assumeRoleArn repeats the last role",
+		},
+		{
+			name: "invalid - reserved session tag prefix",
+			provider: roleChainProvider("",
+				hibernatorv1alpha1.AssumeRoleStep{
+					RoleArn:     "arn:aws:iam::111111111111:role/security-hop",
+					SessionTags: map[string]string{"aws:team": "platform"},
+				}),
+			wantErr: true,
+			errMsg:  "reserved aws: prefix",
+		},
 		{
 			name: "invalid - AWS config missing when type is aws",
 			provider: &hibernatorv1alpha1.CloudProvider{
@@ -157,6 +192,51 @@ func TestCloudProviderValidator_ValidateCreate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "spec.aws is required when type is 'aws'",
 		},
+		{
+			name: "valid - GCP workload identity federation with impersonation",
+			provider: gcpProvider(hibernatorv1alpha1.GCPAuth{
+				WorkloadIdentityFederation: &hibernatorv1alpha1.GCPWorkloadIdentityFederation{
+					Audience: "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod",
+				},
+				ImpersonationChain: []string{"hop@security.iam.gserviceaccount.com", "hibernator@prod.iam.gserviceaccount.com"},
+			}),
+			wantErr: false,
+		},
+		{
+			name:     "invalid - GCP auth missing",
+			provider: gcpProvider(hibernatorv1alpha1.GCPAuth{}),
+			wantErr:  true,
+			errMsg:   "exactly one authentication method must be specified",
+		},
+		{
+			name: "invalid - GCP auth methods both set",
+			provider: gcpProvider(hibernatorv1alpha1.GCPAuth{
+				ServiceAccount: &hibernatorv1alpha1.ServiceAccountAuth{},
+				WorkloadIdentityFederation: &hibernatorv1alpha1.GCPWorkloadIdentityFederation{
+					Audience: "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod",
+				},
+			}),
+			wantErr: true,
+			errMsg:  "mutually exclusive",
+		},
+		{
+			name: "invalid - GCP impersonation chain repeats a service account",
+			provider: gcpProvider(hibernatorv1alpha1.GCPAuth{
+				ServiceAccount:     &hibernatorv1alpha1.ServiceAccountAuth{},
+				ImpersonationChain: []string{"hibernator@prod.iam.gserviceaccount.com", "hibernator@prod.iam.gserviceaccount.com"},
+			}),
+			wantErr: true,
+			errMsg:  "Duplicate value",
+		},
+		{
+			name: "invalid - GCP config missing when type is gcp",
+			provider: &hibernatorv1alpha1.CloudProvider{
+				ObjectMeta: metav1.ObjectMeta{Name: "gcp-no-config", Namespace: "default"},
+				Spec:       hibernatorv1alpha1.CloudProviderSpec{Type: hibernatorv1alpha1.CloudProviderGCP},
+			},
+			wantErr: true,
+			errMsg:  "spec.gcp is required when type is 'gcp'",
+		},
 	}
 
 	for _, tt := range tests {
@@ -177,6 +257,34 @@ func TestCloudProviderValidator_ValidateCreate(t *testing.T) {
 	}
 }
 
+func roleChainProvider(assumeRoleArn string, chain ...hibernatorv1alpha1.AssumeRoleStep) *hibernatorv1alpha1.CloudProvider {
+	return &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-chain", Namespace: "default"},
+		Spec: hibernatorv1alpha1.CloudProviderSpec{
+			Type: hibernatorv1alpha1.CloudProviderAWS,
+			AWS: &hibernatorv1alpha1.AWSConfig{
+				AccountId:     "123456789012",
+				Region:        "us-east-1",
+				AssumeRoleArn: assumeRoleArn,
+				Auth: hibernatorv1alpha1.AWSAuth{
+					ServiceAccount: &hibernatorv1alpha1.ServiceAccountAuth{},
+					RoleChain:      chain,
+				},
+			},
+		},
+	}
+}
+
+func gcpProvider(auth hibernatorv1alpha1.GCPAuth) *hibernatorv1alpha1.CloudProvider {
+	return &hibernatorv1alpha1.CloudProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "gcp", Namespace: "default"},
+		Spec: hibernatorv1alpha1.CloudProviderSpec{
+			Type: hibernatorv1alpha1.CloudProviderGCP,
+			GCP:  &hibernatorv1alpha1.GCPConfig{ProjectID: "prod", Auth: auth},
+		},
+	}
+}
+
 func TestCloudProviderValidator_ValidateCreate_WrongType(t *testing.T) {
 	validator := NewCloudProviderValidator(logr.Discard())
 	wrongType := &hibernatorv1alpha1.HibernatePlan{}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package validationwebhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// ClusterHibernatePlanValidator validates ClusterHibernatePlan resources.
+type ClusterHibernatePlanValidator struct {
+	log  logr.Logger
+	plan *HibernatePlanValidator
+}
+
+// NewClusterHibernatePlanValidator creates a new ClusterHibernatePlanValidator.
+func NewClusterHibernatePlanValidator(log logr.Logger) *ClusterHibernatePlanValidator {
+	return &ClusterHibernatePlanValidator{
+		log:  log.WithName("clusterhibernateplan"),
+		plan: NewHibernatePlanValidator(log),
+	}
+}
+
+var _ admission.CustomValidator = &ClusterHibernatePlanValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *ClusterHibernatePlanValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cp, ok := obj.(*hibernatorv1alpha1.ClusterHibernatePlan)
+	if !ok {
+		return nil, fmt.Errorf("expected ClusterHibernatePlan but got %T", obj)
+	}
+	v.log.V(1).Info("validate create", "name", cp.Name)
+	return v.validate(cp)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *ClusterHibernatePlanValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	cp, ok := newObj.(*hibernatorv1alpha1.ClusterHibernatePlan)
+	if !ok {
+		return nil, fmt.Errorf("expected ClusterHibernatePlan but got %T", newObj)
+	}
+	v.log.V(1).Info("validate update", "name", cp.Name)
+	return v.validate(cp)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *ClusterHibernatePlanValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate performs validation on the ClusterHibernatePlan.
+func (v *ClusterHibernatePlanValidator) validate(cp *hibernatorv1alpha1.ClusterHibernatePlan) (admission.Warnings, error) {
+	var allErrs field.ErrorList
+
+	selectorPath := field.NewPath("spec", "namespaceSelector")
+	if _, err := metav1.LabelSelectorAsSelector(&cp.Spec.NamespaceSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(selectorPath, cp.Spec.NamespaceSelector, err.Error()))
+	}
+
+	templateErrs, warnings := v.validateTemplate(cp)
+	allErrs = append(allErrs, templateErrs...)
+
+	if len(allErrs) > 0 {
+		return warnings, allErrs.ToAggregate()
+	}
+	return warnings, nil
+}
+
+// validateTemplate validates the template spec with the HibernatePlan rules, so
+// invalid plans are rejected here instead of failing in every namespace.
+// Field paths are re-rooted under spec.template.
+func (v *ClusterHibernatePlanValidator) validateTemplate(cp *hibernatorv1alpha1.ClusterHibernatePlan) (field.ErrorList, admission.Warnings) {
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: cp.Name},
+		Spec:       cp.Spec.Template.Spec,
+	}
+
+	errs, warnings := v.plan.validateSpec(plan)
+	for _, err := range errs {
+		err.Field = "spec.template." + err.Field
+	}
+	return errs, warnings
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package validationwebhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func newTestClusterPlan() *hibernatorv1alpha1.ClusterHibernatePlan {
+	return &hibernatorv1alpha1.ClusterHibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+		Spec: hibernatorv1alpha1.ClusterHibernatePlanSpec{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			Template: hibernatorv1alpha1.HibernatePlanTemplate{
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: hibernatorv1alpha1.Schedule{
+						Timezone: "UTC",
+						OffHours: []hibernatorv1alpha1.OffHourWindow{
+							{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON", "TUE"}},
+						},
+					},
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{
+							Name:         "db",
+							Type:         "rds",
+							ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+							Parameters:   rdsParams(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestClusterHibernatePlanValidator_Valid(t *testing.T) {
+	v := NewClusterHibernatePlanValidator(logr.Discard())
+
+	_, err := v.ValidateCreate(context.Background(), newTestClusterPlan())
+	require.NoError(t, err)
+}
+
+func TestClusterHibernatePlanValidator_InvalidNamespaceSelector(t *testing.T) {
+	v := NewClusterHibernatePlanValidator(logr.Discard())
+
+	cp := newTestClusterPlan()
+	cp.Spec.NamespaceSelector = metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Bogus"}},
+	}
+
+	_, err := v.ValidateCreate(context.Background(), cp)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.namespaceSelector")
+}
+
+func TestClusterHibernatePlanValidator_InvalidTemplate(t *testing.T) {
+	v := NewClusterHibernatePlanValidator(logr.Discard())
+
+	cp := newTestClusterPlan()
+	cp.Spec.Template.Spec.Schedule.Timezone = ""
+
+	_, err := v.ValidateUpdate(context.Background(), newTestClusterPlan(), cp)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.template.spec.schedule.timezone")
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package validationwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+	"github.com/go-logr/logr"
+)
+
+// MutatePath is the mutating admission endpoint for HibernatePlans.
+const MutatePath = "/mutate"
+
+// manualActionAnnotations are the annotations whose change requests a manual
+// action on a plan.
+var manualActionAnnotations = []string{
+	wellknown.AnnotationOverrideAction,
+	wellknown.AnnotationOverridePhaseTarget,
+	wellknown.AnnotationHibernateUntilWake,
+	wellknown.AnnotationWakeUntilHibernate,
+	wellknown.AnnotationRestart,
+	wellknown.AnnotationSuspendUntil,
+}
+
+// planRequesterHandler records in AnnotationRequestedBy who requested the
+// manual actions of a HibernatePlan, so the audit log can attribute the
+// transitions they cause to a user rather than only to an annotation.
+type planRequesterHandler struct {
+	log     logr.Logger
+	decoder admission.Decoder
+
+	// controllerUser is the username the controller authenticates as. Its own
+	// changes, e.g. clearing a one-shot annotation, request nothing.
+	controllerUser string
+}
+
+var _ admission.Handler = &planRequesterHandler{}
+
+// Handle implements admission.Handler.
+func (h *planRequesterHandler) Handle(_ context.Context, req admission.Request) admission.Response {
+	plan := &hibernatorv1alpha1.HibernatePlan{}
+	if err := h.decoder.Decode(req, plan); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	old := &hibernatorv1alpha1.HibernatePlan{}
+	if req.Operation == admissionv1.Update {
+		if err := h.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	// Keep the recorded requester unless this request is a manual action:
+	// the annotation cannot be set by hand.
+	requestedBy := old.Annotations[wellknown.AnnotationRequestedBy]
+	if req.UserInfo.Username != h.controllerUser && requestsManualAction(old, plan) {
+		requestedBy = req.UserInfo.Username
+		h.log.V(1).Info("recording manual action requester", "plan", req.Namespace+"/"+req.Name, "user", requestedBy)
+	}
+	if requestedBy == plan.Annotations[wellknown.AnnotationRequestedBy] {
+		return admission.Allowed("")
+	}
+
+	if requestedBy == "" {
+		delete(plan.Annotations, wellknown.AnnotationRequestedBy)
+	} else {
+		if plan.Annotations == nil {
+			plan.Annotations = make(map[string]string)
+		}
+		plan.Annotations[wellknown.AnnotationRequestedBy] = requestedBy
+	}
+
+	marshaled, err := json.Marshal(plan)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// requestsManualAction reports whether the change from old to plan sets
+// spec.suspend or a manual action annotation.
+func requestsManualAction(old, plan *hibernatorv1alpha1.HibernatePlan) bool {
+	if old.Spec.Suspend != plan.Spec.Suspend {
+		return true
+	}
+	for _, key := range manualActionAnnotations {
+		value, ok := plan.Annotations[key]
+		if ok && value != old.Annotations[key] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package validationwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/wellknown"
+)
+
+func TestPlanRequesterHandler(t *testing.T) {
+	const controller = "system:serviceaccount:hibernator-system:hibernator-controller"
+
+	plan := func(suspend bool, annotations map[string]string) *hibernatorv1alpha1.HibernatePlan {
+		return &hibernatorv1alpha1.HibernatePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "team-a", Annotations: annotations},
+			Spec:       hibernatorv1alpha1.HibernatePlanSpec{Suspend: suspend},
+		}
+	}
+
+	tests := []struct {
+		name string
+		user string
+		old  *hibernatorv1alpha1.HibernatePlan
+		new  *hibernatorv1alpha1.HibernatePlan
+		want string // expected requested-by; "-" when the plan is not patched
+	}{
+		{
+			name: "annotation sets the requester",
+			user: "alice",
+			old:  plan(false, nil),
+			new:  plan(false, map[string]string{wellknown.AnnotationRestart: "true"}),
+			want: "alice",
+		},
+		{
+			name: "spec.suspend sets the requester",
+			user: "bob",
+			old:  plan(false, map[string]string{wellknown.AnnotationRequestedBy: "alice"}),
+			new:  plan(true, map[string]string{wellknown.AnnotationRequestedBy: "alice"}),
+			want: "bob",
+		},
+		{
+			name: "other edits keep the requester",
+			user: "bob",
+			old:  plan(false, map[string]string{wellknown.AnnotationRequestedBy: "alice"}),
+			new:  plan(false, map[string]string{wellknown.AnnotationRequestedBy: "alice", "team": "a"}),
+			want: "-",
+		},
+		{
+			name: "forged requester is discarded",
+			user: "mallory",
+			old:  plan(false, map[string]string{wellknown.AnnotationRequestedBy: "alice"}),
+			new:  plan(false, map[string]string{wellknown.AnnotationRequestedBy: "bob"}),
+			want: "alice",
+		},
+		{
+			name: "controller changes request nothing",
+			user: controller,
+			old:  plan(false, map[string]string{wellknown.AnnotationRequestedBy: "alice", wellknown.AnnotationRestart: "true"}),
+			new:  plan(true, map[string]string{wellknown.AnnotationRequestedBy: "alice", wellknown.AnnotationSuspendUntil: "2026-01-01T00:00:00Z"}),
+			want: "-",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, hibernatorv1alpha1.AddToScheme(scheme))
+	h := &planRequesterHandler{log: logr.Discard(), decoder: admission.NewDecoder(scheme), controllerUser: controller}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldRaw, err := json.Marshal(tt.old)
+			require.NoError(t, err)
+			newRaw, err := json.Marshal(tt.new)
+			require.NoError(t, err)
+
+			resp := h.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  authenticationv1.UserInfo{Username: tt.user},
+				Object:    runtime.RawExtension{Raw: newRaw},
+				OldObject: runtime.RawExtension{Raw: oldRaw},
+			}})
+			require.True(t, resp.Allowed)
+
+			if tt.want == "-" {
+				assert.Empty(t, resp.Patches)
+				return
+			}
+			require.Len(t, resp.Patches, 1)
+			assert.Equal(t, "/metadata/annotations/hibernator.ardikabs.com~1requested-by", resp.Patches[0].Path)
+			assert.Equal(t, tt.want, resp.Patches[0].Value)
+		})
+	}
+}
@@ -7,16 +7,22 @@ package validationwebhook
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/restore"
+	"github.com/ardikabs/hibernator/internal/runnerimage"
+	"github.com/ardikabs/hibernator/internal/scheduler"
 	"github.com/ardikabs/hibernator/pkg/executorparams"
 	"github.com/go-logr/logr"
 )
@@ -24,6 +30,13 @@ import (
 // HibernatePlanValidator validates HibernatePlan resources.
 type HibernatePlanValidator struct {
 	log logr.Logger
+
+	// runnerImages are the images targets may select through runnerImage.
+	runnerImages runnerimage.Allowlist
+
+	// restoreS3Endpoints are the custom S3 endpoints plans may select for
+	// their restore storage.
+	restoreS3Endpoints []string
 }
 
 // NewHibernatePlanValidator creates a new HibernatePlanValidator.
@@ -67,6 +80,12 @@ func (v *HibernatePlanValidator) ValidateUpdate(ctx context.Context, oldObj, new
 				fmt.Sprintf("targets cannot be modified while plan is in %s phase; wait for Active, Suspended, or Error phase", oldPlan.Status.Phase),
 			)
 		}
+		if !reflect.DeepEqual(oldPlan.Spec.TargetsFrom, newPlan.Spec.TargetsFrom) {
+			return nil, field.Forbidden(
+				field.NewPath("spec", "targetsFrom"),
+				fmt.Sprintf("targetsFrom cannot be modified while plan is in %s phase; wait for Active, Suspended, or Error phase", oldPlan.Status.Phase),
+			)
+		}
 	}
 
 	v.log.V(1).Info("validate update", "name", newPlan.Name)
@@ -80,6 +99,16 @@ func (v *HibernatePlanValidator) ValidateDelete(ctx context.Context, obj runtime
 
 // validate performs validation on the HibernatePlan.
 func (v *HibernatePlanValidator) validate(plan *hibernatorv1alpha1.HibernatePlan) (admission.Warnings, error) {
+	allErrs, warnings := v.validateSpec(plan)
+	if len(allErrs) > 0 {
+		return warnings, allErrs.ToAggregate()
+	}
+	return warnings, nil
+}
+
+// validateSpec runs all spec validations and returns the errors unaggregated,
+// so callers validating an embedded plan spec can re-root the field paths.
+func (v *HibernatePlanValidator) validateSpec(plan *hibernatorv1alpha1.HibernatePlan) (field.ErrorList, admission.Warnings) {
 	var allErrs field.ErrorList
 	var warnings admission.Warnings
 
@@ -95,10 +124,70 @@ func (v *HibernatePlanValidator) validate(plan *hibernatorv1alpha1.HibernatePlan
 	allErrs = append(allErrs, strategyErrs...)
 	warnings = append(warnings, strategyWarnings...)
 
-	if len(allErrs) > 0 {
-		return warnings, allErrs.ToAggregate()
+	allErrs = append(allErrs, v.validateRestore(plan)...)
+	allErrs = append(allErrs, validateBehavior(plan.Spec.Behavior, field.NewPath("spec", "behavior"))...)
+
+	return allErrs, warnings
+}
+
+// validateBehavior validates the failure-handling settings of a plan.
+func validateBehavior(behavior hibernatorv1alpha1.Behavior, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if d := behavior.MaxCycleDuration; d != "" {
+		if parsed, err := time.ParseDuration(d); err != nil || parsed <= 0 {
+			errs = append(errs, field.Invalid(path.Child("maxCycleDuration"), d, "must be a positive duration"))
+		}
 	}
-	return warnings, nil
+
+	if d := behavior.ReassertInterval; d != "" {
+		if parsed, err := time.ParseDuration(d); err != nil || parsed < time.Hour {
+			errs = append(errs, field.Invalid(path.Child("reassertInterval"), d, "must be a duration of at least 1h"))
+		}
+	}
+
+	if rp := behavior.RetryPolicy; rp != nil {
+		errs = append(errs, validateRetryPolicy(rp, path.Child("retryPolicy"))...)
+	}
+
+	switch behavior.OnCycleTimeout {
+	case "", hibernatorv1alpha1.CycleTimeoutError, hibernatorv1alpha1.CycleTimeoutRollback, hibernatorv1alpha1.CycleTimeoutContinue:
+	default:
+		errs = append(errs, field.NotSupported(path.Child("onCycleTimeout"), behavior.OnCycleTimeout, []string{
+			string(hibernatorv1alpha1.CycleTimeoutError),
+			string(hibernatorv1alpha1.CycleTimeoutRollback),
+			string(hibernatorv1alpha1.CycleTimeoutContinue),
+		}))
+	}
+
+	return errs
+}
+
+// validateRetryPolicy validates the backoff durations of a retry policy.
+func validateRetryPolicy(rp *hibernatorv1alpha1.RetryPolicy, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	var initial, maxBackoff time.Duration
+	if d := rp.InitialBackoff; d != "" {
+		parsed, err := time.ParseDuration(d)
+		if err != nil || parsed <= 0 {
+			errs = append(errs, field.Invalid(path.Child("initialBackoff"), d, "must be a positive duration"))
+		}
+		initial = parsed
+	}
+	if d := rp.MaxBackoff; d != "" {
+		parsed, err := time.ParseDuration(d)
+		if err != nil || parsed <= 0 {
+			errs = append(errs, field.Invalid(path.Child("maxBackoff"), d, "must be a positive duration"))
+		}
+		maxBackoff = parsed
+	}
+	if len(errs) == 0 && initial > 0 && maxBackoff > 0 && initial > maxBackoff {
+		errs = append(errs, field.Invalid(path.Child("maxBackoff"), rp.MaxBackoff,
+			fmt.Sprintf("must not be shorter than initialBackoff (%s)", rp.InitialBackoff)))
+	}
+
+	return errs
 }
 
 // validateSchedule validates the schedule configuration.
@@ -206,6 +295,34 @@ func (v *HibernatePlanValidator) validateSchedule(plan *hibernatorv1alpha1.Hiber
 		}
 	}
 
+	for i, window := range plan.Spec.Schedule.Dates {
+		windowPath := schedulePath.Child("dates").Index(i)
+		start, err := time.Parse(scheduler.DateLayout, window.Start)
+		if err != nil {
+			errs = append(errs, field.Invalid(windowPath.Child("start"), window.Start, "must be a date in YYYY-MM-DD format"))
+			continue
+		}
+		if window.End == "" {
+			continue
+		}
+		end, err := time.Parse(scheduler.DateLayout, window.End)
+		if err != nil {
+			errs = append(errs, field.Invalid(windowPath.Child("end"), window.End, "must be a date in YYYY-MM-DD format"))
+		} else if end.Before(start) {
+			errs = append(errs, field.Invalid(windowPath.Child("end"), window.End, "must not be before start"))
+		}
+	}
+
+	for i, blackout := range plan.Spec.Schedule.BlackoutWindows {
+		if !blackout.End.After(blackout.Start.Time) {
+			errs = append(errs, field.Invalid(
+				schedulePath.Child("blackoutWindows").Index(i).Child("end"),
+				blackout.End.Format(time.RFC3339),
+				"end must be after start",
+			))
+		}
+	}
+
 	return errs, warnings
 }
 
@@ -215,6 +332,26 @@ func (v *HibernatePlanValidator) validateTargets(plan *hibernatorv1alpha1.Hibern
 	var warnings admission.Warnings
 	targetsPath := field.NewPath("spec", "targets")
 
+	if len(plan.Spec.Targets) == 0 && len(plan.Spec.TargetsFrom) == 0 {
+		errs = append(errs, field.Required(targetsPath, "at least one target is required in targets or targetsFrom"))
+	}
+
+	targetsFromPath := field.NewPath("spec", "targetsFrom")
+	seenPresets := make(map[hibernatorv1alpha1.TargetPresetReference]int)
+	for i, ref := range plan.Spec.TargetsFrom {
+		if ref.Name == "" {
+			errs = append(errs, field.Required(targetsFromPath.Index(i).Child("name"), "preset name is required"))
+			continue
+		}
+		if prevIdx, ok := seenPresets[ref]; ok {
+			errs = append(errs, field.Duplicate(
+				targetsFromPath.Index(i),
+				fmt.Sprintf("TargetPreset %q already referenced at index %d", ref.Name, prevIdx),
+			))
+		}
+		seenPresets[ref] = i
+	}
+
 	seen := make(map[string]int)
 	for i, target := range plan.Spec.Targets {
 		if prevIdx, ok := seen[target.Name]; ok {
@@ -238,16 +375,27 @@ func (v *HibernatePlanValidator) validateTargets(plan *hibernatorv1alpha1.Hibern
 			))
 		}
 
-		if target.ConnectorRef.Name == "" {
+		connectorPath := targetsPath.Index(i).Child("connectorRef")
+		switch {
+		case target.ConnectorRef.Name == "" && target.ConnectorRef.Selector == nil:
 			errs = append(errs, field.Required(
-				targetsPath.Index(i).Child("connectorRef", "name"),
-				"connector name is required",
+				connectorPath.Child("name"),
+				"connector name or selector is required",
+			))
+		case target.ConnectorRef.Name != "" && target.ConnectorRef.Selector != nil:
+			errs = append(errs, field.Forbidden(
+				connectorPath.Child("selector"),
+				"selector cannot be combined with a connector name",
 			))
+		case target.ConnectorRef.Selector != nil:
+			if _, err := metav1.LabelSelectorAsSelector(target.ConnectorRef.Selector); err != nil {
+				errs = append(errs, field.Invalid(connectorPath.Child("selector"), target.ConnectorRef.Selector, err.Error()))
+			}
 		}
 
 		validTypes := []string{
 			"ec2", "eks", "rds", "karpenter", "workloadscaler",
-			"gke", "cloudsql", "noop",
+			"gke", "cloudsql", "noop", "delay",
 		}
 		isValidType := false
 		for _, vt := range validTypes {
@@ -279,6 +427,51 @@ func (v *HibernatePlanValidator) validateTargets(plan *hibernatorv1alpha1.Hibern
 				warnings = append(warnings, fmt.Sprintf("target %q: %s", target.Name, warnMsg))
 			}
 		}
+
+		if target.RunnerImage != "" && !v.runnerImages.Allows(target.RunnerImage) {
+			errs = append(errs, field.Forbidden(
+				targetsPath.Index(i).Child("runnerImage"),
+				fmt.Sprintf("runner image %q is not allowed by the controller's --runner-image-allowlist", target.RunnerImage),
+			))
+		}
+
+		if target.PreWake != nil {
+			preWakeErrs, preWakeWarnings := validatePreWake(target, targetsPath.Index(i).Child("preWake"))
+			errs = append(errs, preWakeErrs...)
+			warnings = append(warnings, preWakeWarnings...)
+		}
+	}
+
+	return errs, warnings
+}
+
+// validatePreWake validates a target's pre-wake hook.
+func validatePreWake(target hibernatorv1alpha1.Target, path *field.Path) (field.ErrorList, admission.Warnings) {
+	var errs field.ErrorList
+	var warnings admission.Warnings
+
+	if !executorparams.SupportsPreWake(target.Type) {
+		errs = append(errs, field.Forbidden(path, fmt.Sprintf("executor type %q does not support pre-wake hooks", target.Type)))
+		return errs, warnings
+	}
+
+	if lt := target.PreWake.LeadTime; lt != "" {
+		if d, err := time.ParseDuration(lt); err != nil || d <= 0 {
+			errs = append(errs, field.Invalid(path.Child("leadTime"), lt, "must be a positive duration"))
+		}
+	}
+
+	var paramsRaw []byte
+	if target.PreWake.Parameters != nil {
+		paramsRaw = target.PreWake.Parameters.Raw
+	}
+	if result := executorparams.ValidatePreWakeParams(target.Type, paramsRaw); result != nil {
+		for _, errMsg := range result.Errors {
+			errs = append(errs, field.Invalid(path.Child("parameters"), target.PreWake.Parameters, errMsg))
+		}
+		for _, warnMsg := range result.Warnings {
+			warnings = append(warnings, fmt.Sprintf("target %q: %s", target.Name, warnMsg))
+		}
 	}
 
 	return errs, warnings
@@ -292,6 +485,12 @@ func (v *HibernatePlanValidator) validateStrategy(plan *hibernatorv1alpha1.Hiber
 
 	strategy := plan.Spec.Execution.Strategy
 
+	// Targets from presets are unknown until the controller expands them; it
+	// validates dependencies and stages against the expanded list instead.
+	if len(plan.Spec.TargetsFrom) > 0 && (strategy.Type == hibernatorv1alpha1.StrategyDAG || strategy.Type == hibernatorv1alpha1.StrategyStaged) {
+		return errs, warnings
+	}
+
 	targetNames := make(map[string]bool)
 	for _, t := range plan.Spec.Targets {
 		targetNames[t.Name] = true
@@ -323,9 +522,175 @@ func (v *HibernatePlanValidator) validateStrategy(plan *hibernatorv1alpha1.Hiber
 		))
 	}
 
+	if len(errs) == 0 {
+		warnings = append(warnings, v.validateDrainOrder(plan)...)
+	}
+
 	return errs, warnings
 }
 
+// validateDrainOrder warns when the user's ordering stops the nodes of a
+// cluster before, or together with, the workloads running on it. Parallel and
+// DAG plans get the drain order implicitly, so only explicit DAG edges in the
+// opposite direction are reported for them. Clusters are matched by
+// K8SCluster reference and by EKS cluster name; the controller additionally
+// resolves K8SClusters backed by EKS.
+func (v *HibernatePlanValidator) validateDrainOrder(plan *hibernatorv1alpha1.HibernatePlan) admission.Warnings {
+	targets := staticDrainTargets(plan)
+	drainDeps := scheduler.DrainDependencies(targets)
+	if len(drainDeps) == 0 {
+		return nil
+	}
+
+	strategy := plan.Spec.Execution.Strategy
+	planner := scheduler.NewPlanner()
+
+	var conflicts []scheduler.DrainConflict
+	switch strategy.Type {
+	case hibernatorv1alpha1.StrategySequential:
+		names := make([]string, len(plan.Spec.Targets))
+		for i, t := range plan.Spec.Targets {
+			names[i] = t.Name
+		}
+		conflicts = scheduler.DrainConflicts(planner.PlanSequential(names), targets)
+
+	case hibernatorv1alpha1.StrategyStaged:
+		stages := make([]scheduler.Stage, len(strategy.Stages))
+		for i, s := range strategy.Stages {
+			stages[i] = scheduler.Stage{Name: s.Name, Parallel: s.Parallel, Targets: s.Targets}
+			if s.MaxConcurrency != nil {
+				stages[i].MaxConcurrency = *s.MaxConcurrency
+			}
+		}
+		var maxConcurrency int32
+		if strategy.MaxConcurrency != nil {
+			maxConcurrency = *strategy.MaxConcurrency
+		}
+		conflicts = scheduler.DrainConflicts(planner.PlanStaged(stages, maxConcurrency), targets)
+
+	case hibernatorv1alpha1.StrategyDAG:
+		explicit := make([]scheduler.Dependency, len(strategy.Dependencies))
+		for i, d := range strategy.Dependencies {
+			explicit[i] = scheduler.Dependency{From: d.From, To: d.To}
+		}
+		_, skipped := scheduler.MergeDependencies(explicit, drainDeps)
+		for _, d := range skipped {
+			conflicts = append(conflicts, scheduler.DrainConflict{Workload: d.From, Node: d.To})
+		}
+	}
+
+	var warnings admission.Warnings
+	for _, c := range conflicts {
+		if c.Concurrent {
+			warnings = append(warnings, fmt.Sprintf(
+				"targets %q and %q run concurrently on the same cluster; scale workloads down before nodes to avoid stuck drains and orphaned pods",
+				c.Workload, c.Node,
+			))
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"node target %q is scaled down before workload target %q on the same cluster; scale workloads down before nodes to avoid stuck drains and orphaned pods",
+			c.Node, c.Workload,
+		))
+	}
+	return warnings
+}
+
+// staticDrainTargets classifies the plan targets for drain ordering using only
+// what the plan itself states about the cluster each target acts on.
+func staticDrainTargets(plan *hibernatorv1alpha1.HibernatePlan) []scheduler.DrainTarget {
+	var targets []scheduler.DrainTarget
+	for _, t := range plan.Spec.Targets {
+		role := scheduler.DrainRoleOf(t.Type)
+		if role == scheduler.DrainRoleNone {
+			continue
+		}
+
+		var cluster string
+		switch t.ConnectorRef.Kind {
+		case "K8SCluster":
+			namespace := t.ConnectorRef.Namespace
+			if namespace == "" {
+				namespace = plan.Namespace
+			}
+			cluster = "K8SCluster/" + namespace + "/" + t.ConnectorRef.Name
+		case "CloudProvider":
+			if t.Type == "eks" && t.Parameters != nil {
+				var params executorparams.EKSParameters
+				if err := json.Unmarshal(t.Parameters.Raw, &params); err == nil && params.ClusterName != "" {
+					cluster = "eks/" + params.ClusterName
+				}
+			}
+		}
+
+		targets = append(targets, scheduler.DrainTarget{Name: t.Name, Role: role, Cluster: cluster})
+	}
+	return targets
+}
+
+// validateRestore validates the restore storage configuration.
+func (v *HibernatePlanValidator) validateRestore(plan *hibernatorv1alpha1.HibernatePlan) field.ErrorList {
+	var errs field.ErrorList
+	if plan.Spec.Restore == nil || plan.Spec.Restore.Storage == nil {
+		return errs
+	}
+
+	storage := plan.Spec.Restore.Storage
+	storagePath := field.NewPath("spec", "restore", "storage")
+
+	switch storage.Type {
+	case hibernatorv1alpha1.RestoreStorageS3:
+		if storage.S3 == nil {
+			errs = append(errs, field.Required(storagePath.Child("s3"), "s3 configuration is required for S3 storage"))
+		} else {
+			if storage.S3.Bucket == "" {
+				errs = append(errs, field.Required(storagePath.Child("s3", "bucket"), "bucket is required"))
+			}
+			if storage.S3.Region == "" {
+				errs = append(errs, field.Required(storagePath.Child("s3", "region"), "region is required"))
+			}
+			if !restore.S3EndpointAllowed(storage.S3.Endpoint, v.restoreS3Endpoints) {
+				errs = append(errs, field.Forbidden(
+					storagePath.Child("s3", "endpoint"),
+					fmt.Sprintf("s3 endpoint %q is not allowed by the controller's --restore-s3-endpoints", storage.S3.Endpoint),
+				))
+			}
+		}
+	case hibernatorv1alpha1.RestoreStorageGCS:
+		if storage.GCS == nil {
+			errs = append(errs, field.Required(storagePath.Child("gcs"), "gcs configuration is required for GCS storage"))
+		} else if storage.GCS.Bucket == "" {
+			errs = append(errs, field.Required(storagePath.Child("gcs", "bucket"), "bucket is required"))
+		}
+	case "", hibernatorv1alpha1.RestoreStorageConfigMap, hibernatorv1alpha1.RestoreStorageSecret:
+		// No additional validation needed
+	default:
+		errs = append(errs, field.NotSupported(
+			storagePath.Child("type"),
+			storage.Type,
+			[]string{
+				string(hibernatorv1alpha1.RestoreStorageConfigMap),
+				string(hibernatorv1alpha1.RestoreStorageSecret),
+				string(hibernatorv1alpha1.RestoreStorageS3),
+				string(hibernatorv1alpha1.RestoreStorageGCS),
+			},
+		))
+	}
+
+	if storage.Encryption != nil && storage.Encryption.KeySecretRef.Name == "" {
+		errs = append(errs, field.Required(storagePath.Child("encryption", "keySecretRef", "name"), "encryption key secret name is required"))
+	}
+	if storage.Encryption != nil {
+		for i, keyName := range storage.Encryption.PreviousKeys {
+			if keyName == "" {
+				errs = append(errs, field.Required(storagePath.Child("encryption", "previousKeys").Index(i), "previous key name must not be empty"))
+			}
+		}
+	}
+
+	return errs
+}
+
 // validateDAG validates DAG dependencies and checks for cycles.
 func (v *HibernatePlanValidator) validateDAG(plan *hibernatorv1alpha1.HibernatePlan, targetNames map[string]bool, strategyPath *field.Path) (field.ErrorList, admission.Warnings) {
 	var errs field.ErrorList
@@ -469,6 +834,10 @@ func (v *HibernatePlanValidator) validateStaged(plan *hibernatorv1alpha1.Hiberna
 			}
 			assignedTargets[targetName] = stage.Name
 		}
+
+		if stage.ReadinessProbe != nil {
+			errs = append(errs, validateStageReadinessProbe(stage.ReadinessProbe, stagesPath.Index(i).Child("readinessProbe"))...)
+		}
 	}
 
 	for name := range targetNames {
@@ -484,6 +853,30 @@ func (v *HibernatePlanValidator) validateStaged(plan *hibernatorv1alpha1.Hiberna
 	return errs
 }
 
+// validateStageReadinessProbe validates that a stage readiness probe sets
+// exactly one check and a positive timeout.
+func validateStageReadinessProbe(probe *hibernatorv1alpha1.StageReadinessProbe, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	checks := 0
+	for _, set := range []bool{probe.HTTP != nil, probe.TCP != nil, probe.Resource != nil} {
+		if set {
+			checks++
+		}
+	}
+	if checks != 1 {
+		errs = append(errs, field.Invalid(path, fmt.Sprintf("%d checks", checks), "exactly one of http, tcp and resource must be set"))
+	}
+
+	if probe.Timeout != "" {
+		if d, err := time.ParseDuration(probe.Timeout); err != nil || d <= 0 {
+			errs = append(errs, field.Invalid(path.Child("timeout"), probe.Timeout, "must be a positive duration"))
+		}
+	}
+
+	return errs
+}
+
 // parseTimeValues parses HH:MM format and returns (hour, minute, error).
 func parseTimeValues(timeStr string) (int, int, error) {
 	parts := strings.Split(timeStr, ":")
@@ -499,3 +892,17 @@ func parseTimeValues(timeStr string) (int, int, error) {
 
 	return hour, min, nil
 }
+
+// hasDynamicTargets reports whether the controller expands the plan's targets from
+// TargetPresets or connector selectors, so the stored spec does not list them all.
+func hasDynamicTargets(plan *hibernatorv1alpha1.HibernatePlan) bool {
+	if len(plan.Spec.TargetsFrom) > 0 {
+		return true
+	}
+	for _, t := range plan.Spec.Targets {
+		if t.ConnectorRef.Selector != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -9,11 +9,14 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/runnerimage"
 	"github.com/go-logr/logr"
 )
 
@@ -72,6 +75,47 @@ func TestHibernatePlanValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid S3 restore storage",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "target1", Type: "ec2", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: ec2Params()},
+					},
+					Restore: &hibernatorv1alpha1.RestoreSpec{
+						Storage: &hibernatorv1alpha1.RestoreStorage{
+							Type: hibernatorv1alpha1.RestoreStorageS3,
+							S3:   &hibernatorv1alpha1.S3RestoreStorage{Bucket: "restore", Region: "us-east-1"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "S3 restore storage without s3 configuration",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "target1", Type: "ec2", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: ec2Params()},
+					},
+					Restore: &hibernatorv1alpha1.RestoreSpec{
+						Storage: &hibernatorv1alpha1.RestoreStorage{Type: hibernatorv1alpha1.RestoreStorageS3},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid time format",
 			plan: &hibernatorv1alpha1.HibernatePlan{
@@ -297,6 +341,98 @@ func TestHibernatePlanValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "staged with readiness probe",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{
+							Type: hibernatorv1alpha1.StrategyStaged,
+							Stages: []hibernatorv1alpha1.Stage{
+								{Name: "data", Targets: []string{"c"}, PostDelay: "30s", ReadinessProbe: &hibernatorv1alpha1.StageReadinessProbe{TCP: &hibernatorv1alpha1.TCPReadinessCheck{Address: "db:5432"}, Timeout: "5m"}},
+								{Name: "apps", Targets: []string{"a"}},
+							},
+						},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "a", Type: "ec2", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: ec2Params()},
+						{Name: "c", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: rdsParams()},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "staged readiness probe without check",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{
+							Type: hibernatorv1alpha1.StrategyStaged,
+							Stages: []hibernatorv1alpha1.Stage{
+								{Name: "data", Targets: []string{"c"}, PostDelay: "30s", ReadinessProbe: &hibernatorv1alpha1.StageReadinessProbe{Timeout: "5m"}},
+								{Name: "apps", Targets: []string{"a"}},
+							},
+						},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "a", Type: "ec2", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: ec2Params()},
+						{Name: "c", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: rdsParams()},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "staged readiness probe with two checks",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{
+							Type: hibernatorv1alpha1.StrategyStaged,
+							Stages: []hibernatorv1alpha1.Stage{
+								{Name: "data", Targets: []string{"c"}, PostDelay: "30s", ReadinessProbe: &hibernatorv1alpha1.StageReadinessProbe{TCP: &hibernatorv1alpha1.TCPReadinessCheck{Address: "db:5432"}, HTTP: &hibernatorv1alpha1.HTTPReadinessCheck{URL: "http://db"}}},
+								{Name: "apps", Targets: []string{"a"}},
+							},
+						},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "a", Type: "ec2", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: ec2Params()},
+						{Name: "c", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: rdsParams()},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "staged readiness probe with zero timeout",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{
+							Type: hibernatorv1alpha1.StrategyStaged,
+							Stages: []hibernatorv1alpha1.Stage{
+								{Name: "data", Targets: []string{"c"}, PostDelay: "30s", ReadinessProbe: &hibernatorv1alpha1.StageReadinessProbe{TCP: &hibernatorv1alpha1.TCPReadinessCheck{Address: "db:5432"}, Timeout: "0s"}},
+								{Name: "apps", Targets: []string{"a"}},
+							},
+						},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "a", Type: "ec2", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: ec2Params()},
+						{Name: "c", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: rdsParams()},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "staged with duplicate stage names",
 			plan: &hibernatorv1alpha1.HibernatePlan{
@@ -320,6 +456,131 @@ func TestHibernatePlanValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "targets only from presets",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					TargetsFrom: []hibernatorv1alpha1.TargetPresetReference{{Name: "standard-eks-scale-down"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "DAG dependencies on preset targets are left to the controller",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{
+							Type:         hibernatorv1alpha1.StrategyDAG,
+							Dependencies: []hibernatorv1alpha1.Dependency{{From: "db", To: "cluster"}},
+						},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}, Parameters: rdsParams()},
+					},
+					TargetsFrom: []hibernatorv1alpha1.TargetPresetReference{{Name: "standard-eks-scale-down"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no targets and no presets",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate preset reference",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					TargetsFrom: []hibernatorv1alpha1.TargetPresetReference{{Name: "eks"}, {Name: "eks"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "connector selector",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}}, Parameters: rdsParams()},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "connector name and selector",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws", Selector: &metav1.LabelSelector{}}, Parameters: rdsParams()},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "connector without name or selector",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider"}, Parameters: rdsParams()},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid connector selector",
+			plan: &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{
+						Strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+					},
+					Targets: []hibernatorv1alpha1.Target{
+						{Name: "db", Type: "rds", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Bogus"}}}}, Parameters: rdsParams()},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -671,3 +932,391 @@ func TestHibernatePlanValidator_SmallGapWindowWarning(t *testing.T) {
 		})
 	}
 }
+
+func TestHibernatePlanValidator_DrainOrderWarnings(t *testing.T) {
+	validator := NewHibernatePlanValidator(logr.Discard())
+
+	k8sCluster := hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "dev"}
+	targets := []hibernatorv1alpha1.Target{
+		{Name: "pools", Type: "karpenter", ConnectorRef: k8sCluster},
+		{Name: "apps", Type: "workloadscaler", ConnectorRef: k8sCluster},
+		{Name: "other-pools", Type: "karpenter", ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "K8SCluster", Name: "prod"}},
+	}
+
+	tests := []struct {
+		name        string
+		strategy    hibernatorv1alpha1.ExecutionStrategy
+		wantWarning string
+	}{
+		{
+			name:        "sequential node before workload",
+			strategy:    hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategySequential},
+			wantWarning: `node target "pools" is scaled down before workload target "apps"`,
+		},
+		{
+			name: "staged parallel stage",
+			strategy: hibernatorv1alpha1.ExecutionStrategy{
+				Type: hibernatorv1alpha1.StrategyStaged,
+				Stages: []hibernatorv1alpha1.Stage{
+					{Name: "all", Parallel: true, Targets: []string{"pools", "apps", "other-pools"}},
+				},
+			},
+			wantWarning: `targets "apps" and "pools" run concurrently`,
+		},
+		{
+			name: "DAG explicit edge contradicts drain order",
+			strategy: hibernatorv1alpha1.ExecutionStrategy{
+				Type:         hibernatorv1alpha1.StrategyDAG,
+				Dependencies: []hibernatorv1alpha1.Dependency{{From: "pools", To: "apps"}},
+			},
+			wantWarning: `node target "pools" is scaled down before workload target "apps"`,
+		},
+		{
+			name:     "parallel is ordered implicitly",
+			strategy: hibernatorv1alpha1.ExecutionStrategy{Type: hibernatorv1alpha1.StrategyParallel},
+		},
+		{
+			name: "staged in drain order",
+			strategy: hibernatorv1alpha1.ExecutionStrategy{
+				Type: hibernatorv1alpha1.StrategyStaged,
+				Stages: []hibernatorv1alpha1.Stage{
+					{Name: "workloads", Targets: []string{"apps"}},
+					{Name: "nodes", Parallel: true, Targets: []string{"pools", "other-pools"}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "drain", Namespace: "default"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule:  validSchedule(),
+					Execution: hibernatorv1alpha1.Execution{Strategy: tt.strategy},
+					Targets:   targets,
+				},
+			}
+
+			warnings := validator.validateDrainOrder(plan)
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no drain warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning) {
+				t.Errorf("expected one warning containing %q, got %v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestHibernatePlanValidator_PreWake(t *testing.T) {
+	validator := NewHibernatePlanValidator(logr.Discard())
+	connector := hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"}
+
+	tests := []struct {
+		name        string
+		target      hibernatorv1alpha1.Target
+		wantErr     string
+		wantWarning string
+	}{
+		{
+			name: "eks with lead time and warm nodes",
+			target: hibernatorv1alpha1.Target{
+				Name: "nodes", Type: "eks", ConnectorRef: connector,
+				PreWake: &hibernatorv1alpha1.PreWakeHook{
+					LeadTime:   "15m",
+					Parameters: &hibernatorv1alpha1.Parameters{Raw: []byte(`{"warmNodes":2}`)},
+				},
+			},
+		},
+		{
+			name: "unsupported executor",
+			target: hibernatorv1alpha1.Target{
+				Name: "db", Type: "rds", ConnectorRef: connector,
+				PreWake: &hibernatorv1alpha1.PreWakeHook{},
+			},
+			wantErr: `executor type "rds" does not support pre-wake hooks`,
+		},
+		{
+			name: "zero lead time",
+			target: hibernatorv1alpha1.Target{
+				Name: "nodes", Type: "eks", ConnectorRef: connector,
+				PreWake: &hibernatorv1alpha1.PreWakeHook{LeadTime: "0s"},
+			},
+			wantErr: "must be a positive duration",
+		},
+		{
+			name: "invalid warm nodes",
+			target: hibernatorv1alpha1.Target{
+				Name: "nodes", Type: "eks", ConnectorRef: connector,
+				PreWake: &hibernatorv1alpha1.PreWakeHook{
+					Parameters: &hibernatorv1alpha1.Parameters{Raw: []byte(`{"warmNodes":0}`)},
+				},
+			},
+			wantErr: "warmNodes must be at least 1",
+		},
+		{
+			name: "unknown parameter",
+			target: hibernatorv1alpha1.Target{
+				Name: "nodes", Type: "eks", ConnectorRef: connector,
+				PreWake: &hibernatorv1alpha1.PreWakeHook{
+					Parameters: &hibernatorv1alpha1.Parameters{Raw: []byte(`{"warmNode":2}`)},
+				},
+			},
+			wantWarning: "warmNode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := `{"clusterName":"prod"}`
+			if tt.target.Type == "rds" {
+				params = `{"selector":{"includeAll":true,"discoverInstances":true}}`
+			}
+			tt.target.Parameters = &hibernatorv1alpha1.Parameters{Raw: []byte(params)}
+
+			plan := &hibernatorv1alpha1.HibernatePlan{
+				ObjectMeta: metav1.ObjectMeta{Name: "prewake", Namespace: "default"},
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Schedule: validSchedule(),
+					Targets:  []hibernatorv1alpha1.Target{tt.target},
+				},
+			}
+			errs, warnings := validator.validateTargets(plan)
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+			} else if len(errs) != 1 || !strings.Contains(errs[0].Error(), tt.wantErr) {
+				t.Errorf("expected one error containing %q, got %v", tt.wantErr, errs)
+			}
+
+			if tt.wantWarning != "" && (len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning)) {
+				t.Errorf("expected one warning containing %q, got %v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestValidateBehavior(t *testing.T) {
+	tests := []struct {
+		name     string
+		behavior hibernatorv1alpha1.Behavior
+		wantErr  string
+	}{
+		{
+			name:     "no limit",
+			behavior: hibernatorv1alpha1.Behavior{Mode: hibernatorv1alpha1.BehaviorStrict},
+		},
+		{
+			name: "limit with rollback",
+			behavior: hibernatorv1alpha1.Behavior{
+				MaxCycleDuration: "90m",
+				OnCycleTimeout:   hibernatorv1alpha1.CycleTimeoutRollback,
+			},
+		},
+		{
+			name:     "zero limit",
+			behavior: hibernatorv1alpha1.Behavior{MaxCycleDuration: "0s"},
+			wantErr:  "must be a positive duration",
+		},
+		{
+			name:     "malformed limit",
+			behavior: hibernatorv1alpha1.Behavior{MaxCycleDuration: "two hours"},
+			wantErr:  "must be a positive duration",
+		},
+		{
+			name:     "unknown policy",
+			behavior: hibernatorv1alpha1.Behavior{MaxCycleDuration: "1h", OnCycleTimeout: "Retry"},
+			wantErr:  "spec.behavior.onCycleTimeout",
+		},
+		{
+			name: "retry policy",
+			behavior: hibernatorv1alpha1.Behavior{RetryPolicy: &hibernatorv1alpha1.RetryPolicy{
+				InitialBackoff: "30s",
+				MaxBackoff:     "10m",
+				RetryOn:        []hibernatorv1alpha1.RetryClass{hibernatorv1alpha1.RetryOnTransient},
+			}},
+		},
+		{
+			name:     "malformed initial backoff",
+			behavior: hibernatorv1alpha1.Behavior{RetryPolicy: &hibernatorv1alpha1.RetryPolicy{InitialBackoff: "soon"}},
+			wantErr:  "spec.behavior.retryPolicy.initialBackoff",
+		},
+		{
+			name:     "max backoff shorter than initial backoff",
+			behavior: hibernatorv1alpha1.Behavior{RetryPolicy: &hibernatorv1alpha1.RetryPolicy{InitialBackoff: "10m", MaxBackoff: "5m"}},
+			wantErr:  "must not be shorter than initialBackoff",
+		},
+		{
+			name:     "reassert interval",
+			behavior: hibernatorv1alpha1.Behavior{ReassertInterval: "24h"},
+		},
+		{
+			name:     "reassert interval shorter than an hour",
+			behavior: hibernatorv1alpha1.Behavior{ReassertInterval: "30m"},
+			wantErr:  "spec.behavior.reassertInterval",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateBehavior(tt.behavior, field.NewPath("spec", "behavior"))
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+			} else if len(errs) != 1 || !strings.Contains(errs[0].Error(), tt.wantErr) {
+				t.Errorf("expected one error containing %q, got %v", tt.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestHibernatePlanValidator_RunnerImage(t *testing.T) {
+	validator := NewHibernatePlanValidator(logr.Discard())
+	validator.runnerImages = runnerimage.Allowlist{"ghcr.io/ardikabs/"}
+
+	tests := []struct {
+		name    string
+		image   string
+		wantErr bool
+	}{
+		{name: "no override"},
+		{name: "allowed prefix", image: "ghcr.io/ardikabs/hibernator-rds-runner:v0.2.0"},
+		{name: "not allowed", image: "evil.io/runner:latest", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &hibernatorv1alpha1.HibernatePlan{
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Targets: []hibernatorv1alpha1.Target{{
+						Name:         "db",
+						Type:         "rds",
+						ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+						Parameters:   rdsParams(),
+						RunnerImage:  tt.image,
+					}},
+				},
+			}
+
+			errs, _ := validator.validateTargets(plan)
+			if !tt.wantErr {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+			} else if len(errs) != 1 || !strings.Contains(errs[0].Error(), "spec.targets[0].runnerImage") {
+				t.Errorf("expected one runner image error, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestHibernatePlanValidator_RestoreS3Endpoint(t *testing.T) {
+	validator := NewHibernatePlanValidator(logr.Discard())
+	validator.restoreS3Endpoints = []string{"https://minio.storage.svc:9000"}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		wantErr  bool
+	}{
+		{name: "default endpoint"},
+		{name: "allowed endpoint", endpoint: "https://minio.storage.svc:9000/"},
+		{name: "not allowed", endpoint: "https://collector.example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &hibernatorv1alpha1.HibernatePlan{
+				Spec: hibernatorv1alpha1.HibernatePlanSpec{
+					Restore: &hibernatorv1alpha1.RestoreSpec{
+						Storage: &hibernatorv1alpha1.RestoreStorage{
+							Type: hibernatorv1alpha1.RestoreStorageS3,
+							S3: &hibernatorv1alpha1.S3RestoreStorage{
+								Bucket:   "restore",
+								Region:   "us-east-1",
+								Endpoint: tt.endpoint,
+							},
+						},
+					},
+				},
+			}
+
+			errs := validator.validateRestore(plan)
+			if !tt.wantErr {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+			} else if len(errs) != 1 || !strings.Contains(errs[0].Error(), "spec.restore.storage.s3.endpoint") {
+				t.Errorf("expected one s3 endpoint error, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestHibernatePlanValidator_BlackoutWindows(t *testing.T) {
+	validator := NewHibernatePlanValidator(logr.Discard())
+	start := metav1.NewTime(time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		end     metav1.Time
+		wantErr bool
+	}{
+		{name: "end after start", end: metav1.NewTime(start.Add(72 * time.Hour))},
+		{name: "end equals start", end: start, wantErr: true},
+		{name: "end before start", end: metav1.NewTime(start.Add(-time.Hour)), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &hibernatorv1alpha1.HibernatePlan{Spec: hibernatorv1alpha1.HibernatePlanSpec{Schedule: validSchedule()}}
+			plan.Spec.Schedule.BlackoutWindows = []hibernatorv1alpha1.BlackoutWindow{{Name: "freeze", Start: start, End: tt.end}}
+
+			errs, _ := validator.validateSchedule(plan)
+			if !tt.wantErr {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+			} else if len(errs) != 1 || !strings.Contains(errs[0].Error(), "spec.schedule.blackoutWindows[0].end") {
+				t.Errorf("expected one blackout window error, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestHibernatePlanValidator_DateWindows(t *testing.T) {
+	validator := NewHibernatePlanValidator(logr.Discard())
+
+	tests := []struct {
+		name    string
+		window  hibernatorv1alpha1.DateWindow
+		wantErr string
+	}{
+		{name: "single day", window: hibernatorv1alpha1.DateWindow{Start: "2026-12-25"}},
+		{name: "date range", window: hibernatorv1alpha1.DateWindow{Start: "2026-12-24", End: "2027-01-02"}},
+		{name: "invalid start", window: hibernatorv1alpha1.DateWindow{Start: "2026-13-01"}, wantErr: "spec.schedule.dates[0].start"},
+		{name: "end before start", window: hibernatorv1alpha1.DateWindow{Start: "2026-12-24", End: "2026-12-20"}, wantErr: "spec.schedule.dates[0].end"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &hibernatorv1alpha1.HibernatePlan{Spec: hibernatorv1alpha1.HibernatePlanSpec{Schedule: validSchedule()}}
+			plan.Spec.Schedule.Dates = []hibernatorv1alpha1.DateWindow{tt.window}
+
+			errs, _ := validator.validateSchedule(plan)
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+			} else if len(errs) != 1 || !strings.Contains(errs[0].Error(), tt.wantErr) {
+				t.Errorf("expected one %s error, got %v", tt.wantErr, errs)
+			}
+		})
+	}
+}
@@ -10,15 +10,20 @@ import (
 	"fmt"
 	"regexp"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/scheduler"
 	"github.com/ardikabs/hibernator/internal/wellknown"
 	"github.com/ardikabs/hibernator/pkg/executorparams"
 	"github.com/go-logr/logr"
@@ -28,6 +33,10 @@ import (
 type ScheduleExceptionValidator struct {
 	log    logr.Logger
 	client client.Reader
+
+	// controllerUser is the username the controller authenticates as. Exceptions
+	// it creates on behalf of a plan are exempt from the plan ownership rules.
+	controllerUser string
 }
 
 // NewScheduleExceptionValidator creates a new ScheduleExceptionValidator with the given client.
@@ -47,7 +56,7 @@ func (v *ScheduleExceptionValidator) ValidateCreate(ctx context.Context, obj run
 		return nil, fmt.Errorf("expected ScheduleException but got %T", obj)
 	}
 	v.log.V(1).Info("validate create", "name", exception.Name)
-	return v.validate(ctx, exception)
+	return v.validate(ctx, nil, exception)
 }
 
 // ValidateUpdate implements webhook.CustomValidator.
@@ -66,7 +75,7 @@ func (v *ScheduleExceptionValidator) ValidateUpdate(ctx context.Context, oldObj,
 		}
 	}
 
-	return v.validate(ctx, exception)
+	return v.validate(ctx, oldExc, exception)
 }
 
 // ValidateDelete implements webhook.CustomValidator.
@@ -133,7 +142,8 @@ func executionStrategyEqual(a, b *hibernatorv1alpha1.ExecutionStrategy) bool {
 		return false
 	}
 	return slices.EqualFunc(a.Stages, b.Stages, func(s1, s2 hibernatorv1alpha1.Stage) bool {
-		if s1.Name != s2.Name || s1.Parallel != s2.Parallel || !ptrEqual(s1.MaxConcurrency, s2.MaxConcurrency) {
+		if s1.Name != s2.Name || s1.Parallel != s2.Parallel || !ptrEqual(s1.MaxConcurrency, s2.MaxConcurrency) ||
+			s1.PostDelay != s2.PostDelay || !equality.Semantic.DeepEqual(s1.ReadinessProbe, s2.ReadinessProbe) {
 			return false
 		}
 		return slices.Equal(s1.Targets, s2.Targets)
@@ -148,7 +158,10 @@ func behaviorEqual(a, b *hibernatorv1alpha1.Behavior) bool {
 	if a == nil {
 		return true
 	}
-	return a.Mode == b.Mode && a.FailFast == b.FailFast && ptrEqual(a.Retries, b.Retries)
+	return a.Mode == b.Mode && a.FailFast == b.FailFast && ptrEqual(a.Retries, b.Retries) &&
+		equality.Semantic.DeepEqual(a.RetryPolicy, b.RetryPolicy) && a.AutoRecoverOnScheduleChange == b.AutoRecoverOnScheduleChange &&
+		a.MaxCycleDuration == b.MaxCycleDuration && a.OnCycleTimeout == b.OnCycleTimeout &&
+		a.ReassertInterval == b.ReassertInterval
 }
 
 // ptrEqual compares two *int32 pointers for equality.
@@ -162,30 +175,90 @@ func ptrEqual(a, b *int32) bool {
 	return *a == *b
 }
 
-// checkMidCycleBlock returns an error if the referenced plan is mid-cycle with this exception.
+// checkMidCycleBlock returns an error if a referenced plan is mid-cycle with this exception.
 func (v *ScheduleExceptionValidator) checkMidCycleBlock(ctx context.Context, exception *hibernatorv1alpha1.ScheduleException) error {
-	planKey := client.ObjectKey{
-		Name:      exception.Spec.PlanRef.Name,
-		Namespace: exception.Namespace,
+	plans, err := v.targetPlans(ctx, exception)
+	if err != nil {
+		return fmt.Errorf("failed to fetch referenced plans for mid-cycle check: %w", err)
+	}
+	for _, plan := range plans {
+		if plan.Status.Phase == hibernatorv1alpha1.PhaseHibernating || plan.Status.Phase == hibernatorv1alpha1.PhaseWakingUp {
+			if plan.Status.AppliedExceptionOverride == exception.Name {
+				return fmt.Errorf("cannot modify or delete ScheduleException %s/%s while plan %s is mid-cycle (%s) with this exception's override",
+					exception.Namespace, exception.Name, plan.Name, plan.Status.Phase)
+			}
+		}
 	}
-	plan := new(hibernatorv1alpha1.HibernatePlan)
-	if err := v.client.Get(ctx, planKey, plan); err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
+	return nil
+}
+
+// targetPlans returns the existing HibernatePlans the exception applies to.
+func (v *ScheduleExceptionValidator) targetPlans(ctx context.Context, exception *hibernatorv1alpha1.ScheduleException) ([]hibernatorv1alpha1.HibernatePlan, error) {
+	if !exception.TargetsMultiplePlans() {
+		plan := hibernatorv1alpha1.HibernatePlan{}
+		planKey := client.ObjectKey{Name: exception.Spec.PlanRef.Name, Namespace: exception.Namespace}
+		if err := v.client.Get(ctx, planKey, &plan); err != nil {
+			return nil, client.IgnoreNotFound(err)
 		}
-		return fmt.Errorf("failed to fetch referenced plan %s for mid-cycle check: %w", planKey, err)
+		return []hibernatorv1alpha1.HibernatePlan{plan}, nil
 	}
-	if plan.Status.Phase == hibernatorv1alpha1.PhaseHibernating || plan.Status.Phase == hibernatorv1alpha1.PhaseWakingUp {
-		if plan.Status.AppliedExceptionOverride == exception.Name {
-			return fmt.Errorf("cannot modify or delete ScheduleException %s/%s while plan %s is mid-cycle (%s) with this exception's override",
-				exception.Namespace, exception.Name, planKey.Name, plan.Status.Phase)
+
+	planList := &hibernatorv1alpha1.HibernatePlanList{}
+	if err := v.client.List(ctx, planList, client.InNamespace(exception.Namespace)); err != nil {
+		return nil, err
+	}
+	var plans []hibernatorv1alpha1.HibernatePlan
+	for _, plan := range planList.Items {
+		if exception.AppliesToPlan(plan.Name, plan.Labels) {
+			plans = append(plans, plan)
 		}
 	}
-	return nil
+	return plans, nil
+}
+
+// sharesPlan reports whether two exceptions apply to a common plan. Named plans
+// are compared directly; selectors are resolved against the given plans.
+func sharesPlan(a, b *hibernatorv1alpha1.ScheduleException, plans []hibernatorv1alpha1.HibernatePlan) bool {
+	for _, name := range a.PlanNames() {
+		if slices.Contains(b.PlanNames(), name) {
+			return true
+		}
+	}
+	for _, plan := range plans {
+		if a.AppliesToPlan(plan.Name, plan.Labels) && b.AppliesToPlan(plan.Name, plan.Labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// exceptionsSharingPlans returns the other exceptions in the namespace that apply
+// to at least one plan the given exception applies to.
+func (v *ScheduleExceptionValidator) exceptionsSharingPlans(ctx context.Context, exception *hibernatorv1alpha1.ScheduleException) ([]hibernatorv1alpha1.ScheduleException, error) {
+	exceptionList := &hibernatorv1alpha1.ScheduleExceptionList{}
+	if err := v.client.List(ctx, exceptionList, client.InNamespace(exception.Namespace)); err != nil {
+		return nil, err
+	}
+	planList := &hibernatorv1alpha1.HibernatePlanList{}
+	if err := v.client.List(ctx, planList, client.InNamespace(exception.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var related []hibernatorv1alpha1.ScheduleException
+	for i := range exceptionList.Items {
+		existing := &exceptionList.Items[i]
+		if existing.Name == exception.Name {
+			continue
+		}
+		if sharesPlan(exception, existing, planList.Items) {
+			related = append(related, *existing)
+		}
+	}
+	return related, nil
 }
 
-// validate performs validation on the ScheduleException.
-func (v *ScheduleExceptionValidator) validate(ctx context.Context, exception *hibernatorv1alpha1.ScheduleException) (admission.Warnings, error) {
+// validate performs validation on the ScheduleException. old is nil on create.
+func (v *ScheduleExceptionValidator) validate(ctx context.Context, old, exception *hibernatorv1alpha1.ScheduleException) (admission.Warnings, error) {
 	if !exception.DeletionTimestamp.IsZero() {
 		return nil, nil
 	}
@@ -208,12 +281,19 @@ func (v *ScheduleExceptionValidator) validate(ctx context.Context, exception *hi
 	windowErrs := v.validateWindows(exception)
 	allErrs = append(allErrs, windowErrs...)
 
+	recurrenceErrs := v.validateRecurrence(exception)
+	allErrs = append(allErrs, recurrenceErrs...)
+
 	activeErrs := v.validateNoOverlappingExceptions(ctx, exception)
 	allErrs = append(allErrs, activeErrs...)
 
 	overrideErrs := v.validateExecutionOverrides(ctx, exception)
 	allErrs = append(allErrs, overrideErrs...)
 
+	approvalWarnings, approvalErrs := v.validateApproval(ctx, old, exception)
+	warnings = append(warnings, approvalWarnings...)
+	allErrs = append(allErrs, approvalErrs...)
+
 	if len(allErrs) > 0 {
 		return warnings, apierrors.NewInvalid(
 			exception.GroupVersionKind().GroupKind(),
@@ -225,55 +305,237 @@ func (v *ScheduleExceptionValidator) validate(ctx context.Context, exception *hi
 	return warnings, nil
 }
 
-// validatePlanRef validates the planRef field.
-// A missing HibernatePlan is reported as a warning (the exception is still
-// created but won't be picked up until a matching plan exists).
+// validatePlanRef validates the planRef, planRefs, and planSelector fields.
+// Exactly one of them must be set. A missing HibernatePlan is reported as a
+// warning (the exception is still created but won't be picked up until a
+// matching plan exists).
 func (v *ScheduleExceptionValidator) validatePlanRef(ctx context.Context, exception *hibernatorv1alpha1.ScheduleException) (admission.Warnings, field.ErrorList) {
 	var allErrs field.ErrorList
-	planRefPath := field.NewPath("spec", "planRef")
+	specPath := field.NewPath("spec")
+	planRefPath := specPath.Child("planRef")
 
-	if exception.Spec.PlanRef.Name == "" {
-		allErrs = append(allErrs, field.Required(planRefPath.Child("name"), "planRef.name must be specified"))
+	set := 0
+	for _, isSet := range []bool{exception.Spec.PlanRef.Name != "", len(exception.Spec.PlanRefs) > 0, exception.Spec.PlanSelector != nil} {
+		if isSet {
+			set++
+		}
+	}
+	switch {
+	case set == 0:
+		allErrs = append(allErrs, field.Required(planRefPath.Child("name"), "one of planRef.name, planRefs, or planSelector must be specified"))
+		return nil, allErrs
+	case set > 1:
+		allErrs = append(allErrs, field.Forbidden(specPath, "only one of planRef, planRefs, or planSelector may be specified"))
 		return nil, allErrs
 	}
 
-	targetNamespace := exception.Spec.PlanRef.Namespace
-	if targetNamespace == "" {
-		targetNamespace = exception.Namespace
+	if exception.Spec.PlanSelector != nil {
+		return v.validatePlanSelector(ctx, exception)
 	}
 
-	if targetNamespace != exception.Namespace {
-		allErrs = append(allErrs, field.Invalid(
-			planRefPath.Child("namespace"),
-			targetNamespace,
-			fmt.Sprintf("planRef must reference a HibernatePlan in the same namespace (%s)", exception.Namespace),
-		))
-		return nil, allErrs
+	refs := []hibernatorv1alpha1.PlanReference{exception.Spec.PlanRef}
+	refPath := func(int) *field.Path { return planRefPath }
+	if len(exception.Spec.PlanRefs) > 0 {
+		refs = exception.Spec.PlanRefs
+		refPath = func(i int) *field.Path { return specPath.Child("planRefs").Index(i) }
 	}
 
-	plan := &hibernatorv1alpha1.HibernatePlan{}
-	planKey := client.ObjectKey{
-		Namespace: targetNamespace,
-		Name:      exception.Spec.PlanRef.Name,
+	var warnings admission.Warnings
+	seen := make(map[string]bool, len(refs))
+	for i, ref := range refs {
+		path := refPath(i)
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("name"), "plan name must be specified"))
+			continue
+		}
+		if seen[ref.Name] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), ref.Name))
+			continue
+		}
+		seen[ref.Name] = true
+
+		if ref.Namespace != "" && ref.Namespace != exception.Namespace {
+			allErrs = append(allErrs, field.Invalid(
+				path.Child("namespace"),
+				ref.Namespace,
+				fmt.Sprintf("planRef must reference a HibernatePlan in the same namespace (%s)", exception.Namespace),
+			))
+			continue
+		}
+
+		plan := &hibernatorv1alpha1.HibernatePlan{}
+		planKey := client.ObjectKey{Namespace: exception.Namespace, Name: ref.Name}
+		if err := v.client.Get(ctx, planKey, plan); err != nil {
+			if apierrors.IsNotFound(err) {
+				warnings = append(warnings, fmt.Sprintf(
+					"HibernatePlan %q not found in namespace %q; this exception will have no effect on it until the plan is created",
+					ref.Name, exception.Namespace))
+				continue
+			}
+			allErrs = append(allErrs, field.InternalError(
+				path.Child("name"),
+				fmt.Errorf("failed to verify HibernatePlan existence: %w", err),
+			))
+		}
 	}
-	if err := v.client.Get(ctx, planKey, plan); err != nil {
-		if apierrors.IsNotFound(err) {
-			return admission.Warnings{
-				fmt.Sprintf("HibernatePlan %q not found in namespace %q; this exception will have no effect until the plan is created",
-					exception.Spec.PlanRef.Name, targetNamespace),
-			}, nil
+
+	return warnings, allErrs
+}
+
+// validatePlanSelector validates spec.planSelector and warns when it matches no plan.
+func (v *ScheduleExceptionValidator) validatePlanSelector(ctx context.Context, exception *hibernatorv1alpha1.ScheduleException) (admission.Warnings, field.ErrorList) {
+	selectorPath := field.NewPath("spec", "planSelector")
+	selector, err := metav1.LabelSelectorAsSelector(exception.Spec.PlanSelector)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(selectorPath, exception.Spec.PlanSelector, err.Error())}
+	}
+
+	planList := &hibernatorv1alpha1.HibernatePlanList{}
+	if err := v.client.List(ctx, planList,
+		client.InNamespace(exception.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, field.ErrorList{field.InternalError(selectorPath, fmt.Errorf("failed to list HibernatePlans: %w", err))}
+	}
+	if len(planList.Items) == 0 {
+		return admission.Warnings{fmt.Sprintf(
+			"planSelector matches no HibernatePlan in namespace %q; this exception will have no effect until a matching plan exists",
+			exception.Namespace)}, nil
+	}
+	return nil, nil
+}
+
+// validateApproval enforces the plan ownership rules using the identity of the
+// requesting user. When the referenced plan declares owners, spec changes made
+// by anyone else must keep requiresApproval set. Setting the approval annotations
+// is an approval: only an owner may do it, naming themselves and the generation
+// being approved.
+func (v *ScheduleExceptionValidator) validateApproval(ctx context.Context, old, exception *hibernatorv1alpha1.ScheduleException) (admission.Warnings, field.ErrorList) {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		// Not called through the admission webhook; there is no user to check.
+		return nil, nil
+	}
+	user := req.UserInfo
+	if v.controllerUser != "" && user.Username == v.controllerUser {
+		return nil, nil
+	}
+
+	var (
+		allErrs  field.ErrorList
+		warnings admission.Warnings
+	)
+
+	plans, err := v.targetPlans(ctx, exception)
+	if err != nil {
+		return nil, field.ErrorList{field.InternalError(
+			field.NewPath("spec", "planRef", "name"),
+			fmt.Errorf("failed to fetch HibernatePlan owners: %w", err),
+		)}
+	}
+	if len(plans) == 0 {
+		return nil, nil // validatePlanRef already warns about the missing plan
+	}
+
+	// The user must own every targeted plan that declares owners.
+	var notOwned, withoutOwners []string
+	for i := range plans {
+		owners := planOwners(&plans[i])
+		switch {
+		case len(owners) == 0:
+			withoutOwners = append(withoutOwners, plans[i].Name)
+		case !ownerMatches(owners, user.Username, user.Groups):
+			notOwned = append(notOwned, plans[i].Name)
 		}
+	}
+	isOwner := len(notOwned) == 0 && len(withoutOwners) < len(plans)
 
-		allErrs = append(allErrs, field.InternalError(
-			planRefPath.Child("name"),
-			fmt.Errorf("failed to verify HibernatePlan existence: %w", err),
+	specChanged := old == nil || !equality.Semantic.DeepEqual(old.Spec, exception.Spec)
+	if len(notOwned) > 0 && specChanged && !exception.Spec.RequiresApproval {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("spec", "requiresApproval"),
+			fmt.Sprintf("must be true: %q is not an owner of HibernatePlan %s, so a plan owner has to approve this exception",
+				user.Username, quoteNames(notOwned)),
 		))
 	}
 
-	return nil, allErrs
+	if exception.Spec.RequiresApproval && len(withoutOwners) == len(plans) {
+		warnings = append(warnings, fmt.Sprintf(
+			"HibernatePlan %s declares no owners in the %s annotation; this exception cannot be approved until it does",
+			quoteNames(withoutOwners), wellknown.AnnotationOwners))
+	}
+
+	approver := exception.Annotations[wellknown.AnnotationApprovedBy]
+	generation := exception.Annotations[wellknown.AnnotationApprovedGeneration]
+	approvalChanged := old == nil ||
+		approver != old.Annotations[wellknown.AnnotationApprovedBy] ||
+		generation != old.Annotations[wellknown.AnnotationApprovedGeneration]
+	if approver == "" || !approvalChanged {
+		return warnings, allErrs
+	}
+
+	annotationsPath := field.NewPath("metadata", "annotations")
+	switch {
+	case !isOwner:
+		allErrs = append(allErrs, field.Forbidden(
+			annotationsPath.Key(wellknown.AnnotationApprovedBy),
+			fmt.Sprintf("only owners of HibernatePlan %s may approve exceptions", quoteNames(append(notOwned, withoutOwners...))),
+		))
+	case approver != user.Username:
+		allErrs = append(allErrs, field.Invalid(
+			annotationsPath.Key(wellknown.AnnotationApprovedBy),
+			approver,
+			fmt.Sprintf("must be the approving user %q", user.Username),
+		))
+	}
+	if generation != strconv.FormatInt(exception.Generation, 10) {
+		allErrs = append(allErrs, field.Invalid(
+			annotationsPath.Key(wellknown.AnnotationApprovedGeneration),
+			generation,
+			fmt.Sprintf("must be the exception's current generation (%d)", exception.Generation),
+		))
+	}
+
+	return warnings, allErrs
 }
 
-// validateTimeRange validates validFrom and validUntil.
+// quoteNames formats plan names for error messages, e.g. "a", "b".
+func quoteNames(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// planOwners parses the plan's owners annotation.
+func planOwners(plan *hibernatorv1alpha1.HibernatePlan) []string {
+	var owners []string
+	for _, owner := range strings.Split(plan.Annotations[wellknown.AnnotationOwners], ",") {
+		if owner = strings.TrimSpace(owner); owner != "" {
+			owners = append(owners, owner)
+		}
+	}
+	return owners
+}
+
+// ownerMatches reports whether the user or one of its groups is listed in owners.
+func ownerMatches(owners []string, username string, groups []string) bool {
+	for _, owner := range owners {
+		if group, ok := strings.CutPrefix(owner, wellknown.OwnerGroupPrefix); ok {
+			if slices.Contains(groups, group) {
+				return true
+			}
+			continue
+		}
+		if owner == username {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTimeRange validates validFrom, validUntil, and ttlAfterExpiry.
 func (v *ScheduleExceptionValidator) validateTimeRange(exception *hibernatorv1alpha1.ScheduleException) field.ErrorList {
 	var allErrs field.ErrorList
 	specPath := field.NewPath("spec")
@@ -286,16 +548,86 @@ func (v *ScheduleExceptionValidator) validateTimeRange(exception *hibernatorv1al
 		))
 	}
 
+	// A recurring exception only applies during its occurrences, so it may span a year.
 	duration := exception.Spec.ValidUntil.Sub(exception.Spec.ValidFrom.Time)
-	maxDuration := 90 * 24 * time.Hour
-	if duration > maxDuration {
+	maxDays := 90
+	if exception.Spec.Recurrence != nil {
+		maxDays = 366
+	}
+	if duration > time.Duration(maxDays)*24*time.Hour {
 		allErrs = append(allErrs, field.Invalid(
 			specPath.Child("validUntil"),
 			exception.Spec.ValidUntil.Format(time.RFC3339),
-			fmt.Sprintf("exception duration (%v) exceeds maximum of 90 days", duration),
+			fmt.Sprintf("exception duration (%v) exceeds maximum of %d days", duration, maxDays),
 		))
 	}
 
+	if ttl := exception.Spec.TTLAfterExpiry; ttl != "" {
+		if _, err := time.ParseDuration(ttl); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				specPath.Child("ttlAfterExpiry"),
+				ttl,
+				fmt.Sprintf("invalid duration format: %v", err),
+			))
+		}
+	}
+
+	return allErrs
+}
+
+// validateRecurrence validates spec.recurrence.
+func (v *ScheduleExceptionValidator) validateRecurrence(exception *hibernatorv1alpha1.ScheduleException) field.ErrorList {
+	spec := exception.Spec.Recurrence
+	if spec == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	recurrencePath := field.NewPath("spec", "recurrence")
+
+	duration, err := time.ParseDuration(spec.Duration)
+	if err != nil || duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(recurrencePath.Child("duration"), spec.Duration,
+			"must be a positive duration (e.g., \"48h\")"))
+	}
+
+	loc := time.UTC
+	if spec.Timezone != "" {
+		if loc, err = time.LoadLocation(spec.Timezone); err != nil {
+			allErrs = append(allErrs, field.Invalid(recurrencePath.Child("timezone"), spec.Timezone,
+				fmt.Sprintf("invalid timezone: %v", err)))
+		}
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	interval := int(spec.Interval)
+	if interval == 0 {
+		interval = 1
+	}
+	monthDays := make([]int, len(spec.ByMonthDay))
+	for i, d := range spec.ByMonthDay {
+		monthDays[i] = int(d)
+	}
+	rule := scheduler.Recurrence{
+		Frequency:  scheduler.RecurrenceFrequency(spec.Frequency),
+		Interval:   interval,
+		ByDay:      spec.ByDay,
+		ByMonthDay: monthDays,
+		Duration:   duration,
+		Location:   loc,
+	}
+	if err := rule.Validate(); err != nil {
+		return append(allErrs, field.Invalid(recurrencePath, spec, err.Error()))
+	}
+
+	from, until := exception.Spec.ValidFrom.Time, exception.Spec.ValidUntil.Time
+	if _, _, ok := rule.Occurrence(from, from, until); !ok {
+		allErrs = append(allErrs, field.Invalid(recurrencePath, spec,
+			"recurrence has no occurrence between validFrom and validUntil"))
+	}
+
 	return allErrs
 }
 
@@ -405,8 +737,14 @@ func (v *ScheduleExceptionValidator) validateExecutionOverrides(ctx context.Cont
 		return allErrs
 	}
 
-	// Rule 2: Validate targetOverrides exist in the referenced plan
-	if len(exception.Spec.TargetOverrides) > 0 {
+	// Rule 2: Validate targetOverrides exist in the referenced plan. Target names are
+	// plan-specific, so an exception targeting multiple plans cannot override them.
+	if len(exception.Spec.TargetOverrides) > 0 && exception.TargetsMultiplePlans() {
+		allErrs = append(allErrs, field.Forbidden(
+			specPath.Child("targetOverrides"),
+			"targetOverrides require a single planRef; they are not allowed with planRefs or planSelector",
+		))
+	} else if len(exception.Spec.TargetOverrides) > 0 {
 		// Fetch the referenced plan
 		targetNamespace := exception.Spec.PlanRef.Namespace
 		if targetNamespace == "" {
@@ -440,10 +778,14 @@ func (v *ScheduleExceptionValidator) validateExecutionOverrides(ctx context.Cont
 				// Validate targetName exists
 				target, ok := targetMap[override.TargetName]
 				if !ok {
-					allErrs = append(allErrs, field.NotFound(
-						overridePath.Child("targetName"),
-						override.TargetName,
-					))
+					// Targets expanded from TargetPresets or connector selectors only
+					// exist in the controller, so unknown names cannot be rejected here.
+					if !hasDynamicTargets(plan) {
+						allErrs = append(allErrs, field.NotFound(
+							overridePath.Child("targetName"),
+							override.TargetName,
+						))
+					}
 					continue
 				}
 
@@ -496,28 +838,16 @@ func (v *ScheduleExceptionValidator) validateExecutionOverrides(ctx context.Cont
 				))
 			}
 		}
+
+		if override.Behavior != nil {
+			allErrs = append(allErrs, validateBehavior(*override.Behavior, specPath.Child("executionOverride", "behavior"))...)
+		}
 	}
 
 	// Rule 4: Only one active exception may have execution overrides per plan
 	if len(exception.Spec.TargetOverrides) > 0 || exception.Spec.ExecutionOverride != nil {
-		targetNamespace := exception.Spec.PlanRef.Namespace
-		if targetNamespace == "" {
-			targetNamespace = exception.Namespace
-		}
-
-		exceptionList := &hibernatorv1alpha1.ScheduleExceptionList{}
-		listOpts := []client.ListOption{
-			client.InNamespace(targetNamespace),
-			client.MatchingLabels{
-				wellknown.LabelPlan: exception.Spec.PlanRef.Name,
-			},
-		}
-
-		if err := v.client.List(ctx, exceptionList, listOpts...); err == nil {
-			for _, existing := range exceptionList.Items {
-				if existing.Namespace == exception.Namespace && existing.Name == exception.Name {
-					continue
-				}
+		if related, err := v.exceptionsSharingPlans(ctx, exception); err == nil {
+			for _, existing := range related {
 				if existing.Status.State == hibernatorv1alpha1.ExceptionStateExpired ||
 					existing.Status.State == hibernatorv1alpha1.ExceptionStateDetached {
 					continue
@@ -557,7 +887,7 @@ func hasDependencyOn(plan *hibernatorv1alpha1.HibernatePlan, targetName string)
 }
 
 // validateNoOverlappingExceptions checks that the incoming exception does not
-// conflict with existing Active or Pending exceptions for the same plan.
+// conflict with existing Active or Pending exceptions sharing a plan with it.
 //
 // Validation follows a two-tier approach:
 //  1. Window collision — if validity periods overlap, check whether any pair of
@@ -569,20 +899,8 @@ func hasDependencyOn(plan *hibernatorv1alpha1.HibernatePlan, targetName string)
 func (v *ScheduleExceptionValidator) validateNoOverlappingExceptions(ctx context.Context, exception *hibernatorv1alpha1.ScheduleException) field.ErrorList {
 	var allErrs field.ErrorList
 
-	targetNamespace := exception.Spec.PlanRef.Namespace
-	if targetNamespace == "" {
-		targetNamespace = exception.Namespace
-	}
-
-	exceptionList := &hibernatorv1alpha1.ScheduleExceptionList{}
-	listOpts := []client.ListOption{
-		client.InNamespace(targetNamespace),
-		client.MatchingLabels{
-			wellknown.LabelPlan: exception.Spec.PlanRef.Name,
-		},
-	}
-
-	if err := v.client.List(ctx, exceptionList, listOpts...); err != nil {
+	related, err := v.exceptionsSharingPlans(ctx, exception)
+	if err != nil {
 		allErrs = append(allErrs, field.InternalError(
 			field.NewPath("spec", "planRef"),
 			fmt.Errorf("failed to query existing exceptions: %w", err),
@@ -590,11 +908,7 @@ func (v *ScheduleExceptionValidator) validateNoOverlappingExceptions(ctx context
 		return allErrs
 	}
 
-	for _, existing := range exceptionList.Items {
-		if existing.Namespace == exception.Namespace && existing.Name == exception.Name {
-			continue
-		}
-
+	for _, existing := range related {
 		if existing.Status.State == hibernatorv1alpha1.ExceptionStateExpired ||
 			existing.Status.State == hibernatorv1alpha1.ExceptionStateDetached {
 			continue
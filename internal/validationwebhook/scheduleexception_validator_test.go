@@ -13,10 +13,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
 	"github.com/ardikabs/hibernator/internal/wellknown"
@@ -237,6 +240,22 @@ func TestScheduleExceptionValidator_ValidateCreate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "leadTime is only valid when type is 'suspend'",
 		},
+		{
+			name: "invalid ttlAfterExpiry",
+			exception: func() *hibernatorv1alpha1.ScheduleException {
+				exc := validException()
+				exc.Spec.TTLAfterExpiry = "1d"
+				return exc
+			}(),
+			setup: func() client.Client {
+				plan := &hibernatorv1alpha1.HibernatePlan{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-plan", Namespace: "default"},
+				}
+				return setupTestClient(plan)
+			},
+			wantErr: true,
+			errMsg:  "spec.ttlAfterExpiry",
+		},
 		{
 			name: "invalid windows - bad time format",
 			exception: &hibernatorv1alpha1.ScheduleException{
@@ -892,6 +911,44 @@ func TestScheduleExceptionValidator_ValidateCreate_ExtendWithInvalidTargetName_R
 	assert.Contains(t, err.Error(), "nonexistent")
 }
 
+func TestScheduleExceptionValidator_ValidateCreate_ExtendWithExpandedTargetName_Allowed(t *testing.T) {
+	exc := &hibernatorv1alpha1.ScheduleException{
+		ObjectMeta: metav1.ObjectMeta{Name: "override-exc", Namespace: "default"},
+		Spec: hibernatorv1alpha1.ScheduleExceptionSpec{
+			PlanRef:    hibernatorv1alpha1.PlanReference{Name: "test-plan", Namespace: "default"},
+			ValidFrom:  metav1.Time{Time: time.Now().Add(-24 * time.Hour)},
+			ValidUntil: metav1.Time{Time: time.Now().Add(7 * 24 * time.Hour)},
+			Type:       hibernatorv1alpha1.ExceptionExtend,
+			Windows:    []hibernatorv1alpha1.OffHourWindow{{Start: "00:00", End: "23:59", DaysOfWeek: []string{"MON"}}},
+			TargetOverrides: []hibernatorv1alpha1.TargetOverride{
+				{TargetName: "workloads-dev-a", Disabled: true},
+			},
+		},
+	}
+
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-plan", Namespace: "default"},
+		Spec: hibernatorv1alpha1.HibernatePlanSpec{
+			Schedule: hibernatorv1alpha1.Schedule{Timezone: "UTC", OffHours: []hibernatorv1alpha1.OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON"}}}},
+			Targets: []hibernatorv1alpha1.Target{
+				{
+					Name: "workloads",
+					Type: "workloadscaler",
+					ConnectorRef: hibernatorv1alpha1.ConnectorRef{
+						Kind:     "K8SCluster",
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+					},
+				},
+			},
+		},
+	}
+	c := setupTestClient(plan, exc)
+	v := NewScheduleExceptionValidator(logr.Discard(), c)
+
+	_, err := v.ValidateCreate(context.Background(), exc)
+	require.NoError(t, err)
+}
+
 func TestScheduleExceptionValidator_ValidateCreate_ExtendWithInvalidStrategyType_Rejected(t *testing.T) {
 	exc := &hibernatorv1alpha1.ScheduleException{
 		ObjectMeta: metav1.ObjectMeta{Name: "override-exc", Namespace: "default"},
@@ -1103,3 +1160,318 @@ func TestScheduleExceptionValidator_ValidateUpdate_MidCycleExecutionOverrideUnch
 	_, err := v.ValidateUpdate(context.Background(), oldExc, newExc)
 	require.NoError(t, err)
 }
+
+func TestScheduleExceptionValidator_Approval(t *testing.T) {
+	ownedPlan := func(owners string) client.Client {
+		plan := &hibernatorv1alpha1.HibernatePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-plan", Namespace: "default"},
+			Spec: hibernatorv1alpha1.HibernatePlanSpec{
+				Schedule: hibernatorv1alpha1.Schedule{
+					Timezone: "UTC",
+					OffHours: []hibernatorv1alpha1.OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON"}}},
+				},
+			},
+		}
+		if owners != "" {
+			plan.Annotations = map[string]string{wellknown.AnnotationOwners: owners}
+		}
+		return setupTestClient(plan)
+	}
+	requested := func(approval bool) *hibernatorv1alpha1.ScheduleException {
+		exc := validException()
+		exc.Generation = 2
+		exc.Spec.RequiresApproval = approval
+		return exc
+	}
+	approved := func(approver, generation string) *hibernatorv1alpha1.ScheduleException {
+		exc := requested(true)
+		exc.Annotations = map[string]string{
+			wellknown.AnnotationApprovedBy:         approver,
+			wellknown.AnnotationApprovedGeneration: generation,
+		}
+		return exc
+	}
+
+	tests := []struct {
+		name        string
+		owners      string
+		user        authenticationv1.UserInfo
+		old         *hibernatorv1alpha1.ScheduleException
+		exception   *hibernatorv1alpha1.ScheduleException
+		errMsg      string
+		wantWarning string
+	}{
+		{
+			name:      "non-owner must request approval",
+			owners:    "alice,group:platform",
+			user:      authenticationv1.UserInfo{Username: "bob"},
+			exception: requested(false),
+			errMsg:    "spec.requiresApproval",
+		},
+		{
+			name:      "non-owner requesting approval",
+			owners:    "alice,group:platform",
+			user:      authenticationv1.UserInfo{Username: "bob"},
+			exception: requested(true),
+		},
+		{
+			name:      "owner by group skips approval",
+			owners:    "alice,group:platform",
+			user:      authenticationv1.UserInfo{Username: "carol", Groups: []string{"platform"}},
+			exception: requested(false),
+		},
+		{
+			name:      "controller skips approval",
+			owners:    "alice",
+			user:      authenticationv1.UserInfo{Username: "system:serviceaccount:hibernator-system:hibernator-controller"},
+			exception: requested(false),
+		},
+		{
+			name:      "plan without owners",
+			user:      authenticationv1.UserInfo{Username: "bob"},
+			exception: requested(false),
+		},
+		{
+			name:        "approval required without owners",
+			user:        authenticationv1.UserInfo{Username: "bob"},
+			exception:   requested(true),
+			wantWarning: "declares no owners",
+		},
+		{
+			name:      "non-owner cannot turn approval off",
+			owners:    "alice",
+			user:      authenticationv1.UserInfo{Username: "bob"},
+			old:       requested(true),
+			exception: requested(false),
+			errMsg:    "spec.requiresApproval",
+		},
+		{
+			name:      "owner approves",
+			owners:    "alice",
+			user:      authenticationv1.UserInfo{Username: "alice"},
+			old:       requested(true),
+			exception: approved("alice", "2"),
+		},
+		{
+			name:      "non-owner cannot approve",
+			owners:    "alice",
+			user:      authenticationv1.UserInfo{Username: "bob"},
+			old:       requested(true),
+			exception: approved("bob", "2"),
+			errMsg:    "only owners",
+		},
+		{
+			name:      "owner cannot approve on behalf of someone else",
+			owners:    "alice,dave",
+			user:      authenticationv1.UserInfo{Username: "alice"},
+			old:       requested(true),
+			exception: approved("dave", "2"),
+			errMsg:    `must be the approving user "alice"`,
+		},
+		{
+			name:      "approval must name the current generation",
+			owners:    "alice",
+			user:      authenticationv1.UserInfo{Username: "alice"},
+			old:       requested(true),
+			exception: approved("alice", "1"),
+			errMsg:    "current generation (2)",
+		},
+		{
+			name:      "unchanged approval survives non-owner metadata updates",
+			owners:    "alice",
+			user:      authenticationv1.UserInfo{Username: "bob"},
+			old:       approved("alice", "2"),
+			exception: approved("alice", "2"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewScheduleExceptionValidator(logr.Discard(), ownedPlan(tt.owners))
+			validator.controllerUser = "system:serviceaccount:hibernator-system:hibernator-controller"
+
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{UserInfo: tt.user},
+			})
+
+			var (
+				warnings admission.Warnings
+				err      error
+			)
+			if tt.old == nil {
+				warnings, err = validator.ValidateCreate(ctx, tt.exception)
+			} else {
+				warnings, err = validator.ValidateUpdate(ctx, tt.old, tt.exception)
+			}
+
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantWarning != "" {
+				assert.Contains(t, strings.Join(warnings, "\n"), tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestScheduleExceptionValidator_Recurrence(t *testing.T) {
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-plan", Namespace: "default"},
+		Spec: hibernatorv1alpha1.HibernatePlanSpec{
+			Schedule: hibernatorv1alpha1.Schedule{Timezone: "UTC", OffHours: []hibernatorv1alpha1.OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON"}}}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		recurrence *hibernatorv1alpha1.ExceptionRecurrence
+		validFor   time.Duration
+		errMsg     string
+	}{
+		{
+			name:       "first weekend of every month for a year",
+			recurrence: &hibernatorv1alpha1.ExceptionRecurrence{Frequency: hibernatorv1alpha1.RecurrenceMonthly, Interval: 1, ByDay: []string{"1SAT"}, Duration: "48h"},
+			validFor:   365 * 24 * time.Hour,
+		},
+		{
+			name:       "recurring exception still bounded to a year",
+			recurrence: &hibernatorv1alpha1.ExceptionRecurrence{Frequency: hibernatorv1alpha1.RecurrenceMonthly, Interval: 1, ByDay: []string{"1SAT"}, Duration: "48h"},
+			validFor:   400 * 24 * time.Hour,
+			errMsg:     "exceeds maximum of 366 days",
+		},
+		{
+			name:       "invalid timezone",
+			recurrence: &hibernatorv1alpha1.ExceptionRecurrence{Frequency: hibernatorv1alpha1.RecurrenceWeekly, Interval: 1, Duration: "24h", Timezone: "Mars/Olympus"},
+			validFor:   30 * 24 * time.Hour,
+			errMsg:     "spec.recurrence.timezone",
+		},
+		{
+			name:       "ordinal day with weekly frequency",
+			recurrence: &hibernatorv1alpha1.ExceptionRecurrence{Frequency: hibernatorv1alpha1.RecurrenceWeekly, Interval: 1, ByDay: []string{"1SAT"}, Duration: "24h"},
+			validFor:   30 * 24 * time.Hour,
+			errMsg:     "only supported with Monthly",
+		},
+		{
+			name:       "no occurrence within the validity period",
+			recurrence: &hibernatorv1alpha1.ExceptionRecurrence{Frequency: hibernatorv1alpha1.RecurrenceMonthly, Interval: 1, ByMonthDay: []int32{31}, Duration: "1h"},
+			validFor:   24 * time.Hour,
+			errMsg:     "no occurrence between validFrom and validUntil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+			exc := validException()
+			exc.Spec.ValidFrom = metav1.NewTime(from)
+			exc.Spec.ValidUntil = metav1.NewTime(from.Add(tt.validFor))
+			exc.Spec.Recurrence = tt.recurrence
+
+			v := NewScheduleExceptionValidator(logr.Discard(), setupTestClient(plan))
+			_, err := v.ValidateCreate(context.Background(), exc)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestScheduleExceptionValidator_MultiplePlans(t *testing.T) {
+	newPlan := func(name string, labels map[string]string) *hibernatorv1alpha1.HibernatePlan {
+		return &hibernatorv1alpha1.HibernatePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec: hibernatorv1alpha1.HibernatePlanSpec{
+				Schedule: hibernatorv1alpha1.Schedule{Timezone: "UTC", OffHours: []hibernatorv1alpha1.OffHourWindow{{Start: "20:00", End: "06:00", DaysOfWeek: []string{"MON"}}}},
+			},
+		}
+	}
+	prodSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+
+	t.Run("planRefs accepted", func(t *testing.T) {
+		exc := validException()
+		exc.Spec.PlanRef = hibernatorv1alpha1.PlanReference{}
+		exc.Spec.PlanRefs = []hibernatorv1alpha1.PlanReference{{Name: "plan-a"}, {Name: "plan-b"}}
+
+		v := NewScheduleExceptionValidator(logr.Discard(), setupTestClient(newPlan("plan-a", nil), newPlan("plan-b", nil)))
+		warnings, err := v.ValidateCreate(context.Background(), exc)
+		assert.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("planRefs with duplicate name rejected", func(t *testing.T) {
+		exc := validException()
+		exc.Spec.PlanRef = hibernatorv1alpha1.PlanReference{}
+		exc.Spec.PlanRefs = []hibernatorv1alpha1.PlanReference{{Name: "plan-a"}, {Name: "plan-a"}}
+
+		v := NewScheduleExceptionValidator(logr.Discard(), setupTestClient(newPlan("plan-a", nil)))
+		_, err := v.ValidateCreate(context.Background(), exc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "spec.planRefs[1].name")
+	})
+
+	t.Run("planRefs and planSelector together rejected", func(t *testing.T) {
+		exc := validException()
+		exc.Spec.PlanRef = hibernatorv1alpha1.PlanReference{}
+		exc.Spec.PlanRefs = []hibernatorv1alpha1.PlanReference{{Name: "plan-a"}}
+		exc.Spec.PlanSelector = prodSelector
+
+		v := NewScheduleExceptionValidator(logr.Discard(), setupTestClient(newPlan("plan-a", nil)))
+		_, err := v.ValidateCreate(context.Background(), exc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only one of planRef, planRefs, or planSelector")
+	})
+
+	t.Run("planSelector matching no plan warns", func(t *testing.T) {
+		exc := validException()
+		exc.Spec.PlanRef = hibernatorv1alpha1.PlanReference{}
+		exc.Spec.PlanSelector = prodSelector
+
+		v := NewScheduleExceptionValidator(logr.Discard(), setupTestClient(newPlan("plan-a", map[string]string{"env": "dev"})))
+		warnings, err := v.ValidateCreate(context.Background(), exc)
+		assert.NoError(t, err)
+		require.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], "planSelector matches no HibernatePlan")
+	})
+
+	t.Run("planSelector with targetOverrides rejected", func(t *testing.T) {
+		exc := validException()
+		exc.Spec.PlanRef = hibernatorv1alpha1.PlanReference{}
+		exc.Spec.PlanSelector = prodSelector
+		exc.Spec.TargetOverrides = []hibernatorv1alpha1.TargetOverride{{TargetName: "db", Disabled: true}}
+
+		v := NewScheduleExceptionValidator(logr.Discard(), setupTestClient(newPlan("plan-a", map[string]string{"env": "prod"})))
+		_, err := v.ValidateCreate(context.Background(), exc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "targetOverrides require a single planRef")
+	})
+
+	t.Run("planSelector overlapping a named exception rejected", func(t *testing.T) {
+		existing := validException()
+		existing.Name = "plan-a-freeze"
+		existing.Spec.PlanRef = hibernatorv1alpha1.PlanReference{Name: "plan-a"}
+		existing.Spec.Type = hibernatorv1alpha1.ExceptionSuspend
+		existing.Spec.Windows = []hibernatorv1alpha1.OffHourWindow{{Start: "00:00", End: "23:59", DaysOfWeek: []string{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}}}
+		existing.Status.State = hibernatorv1alpha1.ExceptionStateActive
+
+		exc := validException()
+		exc.Spec.PlanRef = hibernatorv1alpha1.PlanReference{}
+		exc.Spec.PlanSelector = prodSelector
+		exc.Spec.Type = hibernatorv1alpha1.ExceptionSuspend
+		exc.Spec.Windows = existing.Spec.Windows
+
+		v := NewScheduleExceptionValidator(logr.Discard(), setupTestClient(
+			newPlan("plan-a", map[string]string{"env": "prod"}),
+			newPlan("plan-b", map[string]string{"env": "prod"}),
+			existing,
+		))
+		_, err := v.ValidateCreate(context.Background(), exc)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "plan-a-freeze")
+	})
+}
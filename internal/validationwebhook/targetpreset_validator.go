@@ -0,0 +1,74 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package validationwebhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+// TargetPresetValidator validates TargetPreset resources.
+type TargetPresetValidator struct {
+	log  logr.Logger
+	plan *HibernatePlanValidator
+}
+
+// NewTargetPresetValidator creates a new TargetPresetValidator.
+func NewTargetPresetValidator(log logr.Logger) *TargetPresetValidator {
+	return &TargetPresetValidator{
+		log:  log.WithName("targetpreset"),
+		plan: NewHibernatePlanValidator(log),
+	}
+}
+
+var _ admission.CustomValidator = &TargetPresetValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *TargetPresetValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	preset, ok := obj.(*hibernatorv1alpha1.TargetPreset)
+	if !ok {
+		return nil, fmt.Errorf("expected TargetPreset but got %T", obj)
+	}
+	v.log.V(1).Info("validate create", "name", preset.Name, "namespace", preset.Namespace)
+	return v.validate(preset)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *TargetPresetValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	preset, ok := newObj.(*hibernatorv1alpha1.TargetPreset)
+	if !ok {
+		return nil, fmt.Errorf("expected TargetPreset but got %T", newObj)
+	}
+	v.log.V(1).Info("validate update", "name", preset.Name, "namespace", preset.Namespace)
+	return v.validate(preset)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *TargetPresetValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate applies the HibernatePlan target rules to the preset's targets. Both
+// live under spec.targets, so field paths need no rewriting. Name clashes with
+// the targets of referencing plans are reported by the controller on expansion.
+func (v *TargetPresetValidator) validate(preset *hibernatorv1alpha1.TargetPreset) (admission.Warnings, error) {
+	plan := &hibernatorv1alpha1.HibernatePlan{
+		ObjectMeta: preset.ObjectMeta,
+		Spec:       hibernatorv1alpha1.HibernatePlanSpec{Targets: preset.Spec.Targets},
+	}
+
+	errs, warnings := v.plan.validateTargets(plan)
+	if len(errs) > 0 {
+		return warnings, errs.ToAggregate()
+	}
+	return warnings, nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package validationwebhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+)
+
+func newTestTargetPreset(targets ...hibernatorv1alpha1.Target) *hibernatorv1alpha1.TargetPreset {
+	return &hibernatorv1alpha1.TargetPreset{
+		ObjectMeta: metav1.ObjectMeta{Name: "standard-eks-scale-down", Namespace: "default"},
+		Spec:       hibernatorv1alpha1.TargetPresetSpec{Targets: targets},
+	}
+}
+
+func TestTargetPresetValidator_Valid(t *testing.T) {
+	v := NewTargetPresetValidator(logr.Discard())
+
+	preset := newTestTargetPreset(hibernatorv1alpha1.Target{
+		Name:         "cluster",
+		Type:         "eks",
+		ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+		Parameters:   eksParams(),
+	})
+
+	_, err := v.ValidateCreate(context.Background(), preset)
+	require.NoError(t, err)
+}
+
+func TestTargetPresetValidator_InvalidTargets(t *testing.T) {
+	v := NewTargetPresetValidator(logr.Discard())
+
+	target := hibernatorv1alpha1.Target{
+		Name:         "db",
+		Type:         "rds",
+		ConnectorRef: hibernatorv1alpha1.ConnectorRef{Kind: "CloudProvider", Name: "aws"},
+		Parameters:   rdsParams(),
+	}
+	unsupported := target
+	unsupported.Name = "other"
+	unsupported.Type = "lambda"
+
+	_, err := v.ValidateUpdate(context.Background(), newTestTargetPreset(), newTestTargetPreset(target, target, unsupported))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.targets[1].name")
+	assert.Contains(t, err.Error(), "spec.targets[2].type")
+}
@@ -7,23 +7,39 @@ package validationwebhook
 
 import (
 	"context"
+	"time"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	hibernatorv1alpha1 "github.com/ardikabs/hibernator/api/v1alpha1"
+	"github.com/ardikabs/hibernator/internal/runnerimage"
 	"github.com/go-logr/logr"
 )
 
 // WebhookPath is the single admission endpoint for all Hibernator resources.
 const WebhookPath = "/validate"
 
+// Options configures the validation webhook.
+type Options struct {
+	// RunnerImages are the images targets may select through runnerImage.
+	// Empty rejects every target that sets one.
+	RunnerImages runnerimage.Allowlist
+
+	// RestoreS3Endpoints are the custom S3 endpoints plans may select for
+	// their restore storage. Empty rejects every plan that sets one.
+	RestoreS3Endpoints []string
+}
+
 // SetupWithManager registers a single multiplexing validation webhook that
 // handles all Hibernator CRD types on one path. This avoids per-resource
-// webhook entries in the ValidatingWebhookConfiguration.
-func SetupWithManager(mgr ctrl.Manager, log logr.Logger) error {
+// webhook entries in the ValidatingWebhookConfiguration. It also registers the
+// mutating webhook recording who requested the manual actions of plans.
+func SetupWithManager(mgr ctrl.Manager, log logr.Logger, opts Options) error {
 	s := mgr.GetScheme()
 
 	mux := &muxHandler{
@@ -31,11 +47,17 @@ func SetupWithManager(mgr ctrl.Manager, log logr.Logger) error {
 		handlers: make(map[schema.GroupVersionKind]admission.Handler),
 	}
 
+	planValidator := NewHibernatePlanValidator(log)
+	planValidator.runnerImages = opts.RunnerImages
+	planValidator.restoreS3Endpoints = opts.RestoreS3Endpoints
 	mux.handlers[hibernatorv1alpha1.GroupVersion.WithKind("HibernatePlan")] =
-		admission.WithCustomValidator(s, &hibernatorv1alpha1.HibernatePlan{}, NewHibernatePlanValidator(log))
+		admission.WithCustomValidator(s, &hibernatorv1alpha1.HibernatePlan{}, planValidator)
 
+	controllerUser := controllerUsername(mgr.GetClient(), log)
+	exceptionValidator := NewScheduleExceptionValidator(log, mgr.GetClient())
+	exceptionValidator.controllerUser = controllerUser
 	mux.handlers[hibernatorv1alpha1.GroupVersion.WithKind("ScheduleException")] =
-		admission.WithCustomValidator(s, &hibernatorv1alpha1.ScheduleException{}, NewScheduleExceptionValidator(log, mgr.GetClient()))
+		admission.WithCustomValidator(s, &hibernatorv1alpha1.ScheduleException{}, exceptionValidator)
 
 	mux.handlers[hibernatorv1alpha1.GroupVersion.WithKind("CloudProvider")] =
 		admission.WithCustomValidator(s, &hibernatorv1alpha1.CloudProvider{}, NewCloudProviderValidator(log))
@@ -43,10 +65,41 @@ func SetupWithManager(mgr ctrl.Manager, log logr.Logger) error {
 	mux.handlers[hibernatorv1alpha1.GroupVersion.WithKind("HibernateNotification")] =
 		admission.WithCustomValidator(s, &hibernatorv1alpha1.HibernateNotification{}, NewHibernateNotificationValidator(log))
 
+	clusterPlanValidator := NewClusterHibernatePlanValidator(log)
+	clusterPlanValidator.plan.runnerImages = opts.RunnerImages
+	clusterPlanValidator.plan.restoreS3Endpoints = opts.RestoreS3Endpoints
+	mux.handlers[hibernatorv1alpha1.GroupVersion.WithKind("ClusterHibernatePlan")] =
+		admission.WithCustomValidator(s, &hibernatorv1alpha1.ClusterHibernatePlan{}, clusterPlanValidator)
+
+	presetValidator := NewTargetPresetValidator(log)
+	presetValidator.plan.runnerImages = opts.RunnerImages
+	mux.handlers[hibernatorv1alpha1.GroupVersion.WithKind("TargetPreset")] =
+		admission.WithCustomValidator(s, &hibernatorv1alpha1.TargetPreset{}, presetValidator)
+
 	mgr.GetWebhookServer().Register(WebhookPath, &webhook.Admission{Handler: mux})
+	mgr.GetWebhookServer().Register(MutatePath, &webhook.Admission{Handler: &planRequesterHandler{
+		log:            log.WithName("hibernateplan-requester"),
+		decoder:        admission.NewDecoder(s),
+		controllerUser: controllerUser,
+	}})
 	return nil
 }
 
+// controllerUsername asks the API server who the controller authenticates as,
+// so the webhook can recognise requests the controller makes itself. It returns
+// an empty string when the identity cannot be determined.
+func controllerUsername(c client.Client, log logr.Logger) string {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	review := &authenticationv1.SelfSubjectReview{}
+	if err := c.Create(ctx, review); err != nil {
+		log.Error(err, "failed to resolve controller identity; exceptions it creates follow plan ownership rules")
+		return ""
+	}
+	return review.Status.UserInfo.Username
+}
+
 // muxHandler dispatches admission requests to per-resource validators based on
 // the request's GroupVersionKind. controller-runtime decodes the body once;
 // this handler only inspects the already-parsed request.
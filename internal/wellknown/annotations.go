@@ -88,8 +88,138 @@ const (
 	//   # Force wakeup with a fresh snapshot from the current exception
 	//   kubectl annotate hibernateplan <name> hibernator.ardikabs.com/override-action=true hibernator.ardikabs.com/override-phase-target=wakeup hibernator.ardikabs.com/fresh=true
 	AnnotationFresh = "hibernator.ardikabs.com/fresh"
+
+	// AnnotationHibernateUntilWake is a one-shot annotation that hibernates an Active plan
+	// immediately and keeps it hibernated until the schedule's next regular wake-up.
+	//
+	// The controller consumes (deletes) this annotation, creates an extend ScheduleException
+	// owned by the plan that covers the time until that wake-up, and starts hibernation.
+	// The exception expires on its own at the wake-up boundary, handing control back to
+	// the schedule.
+	//
+	// Value: must be "true" — any other value is treated as absent.
+	//
+	//   # Stop paying for the environment now, resume on the next scheduled wake-up
+	//   kubectl annotate hibernateplan <name> hibernator.ardikabs.com/hibernate-until-wake=true
+	AnnotationHibernateUntilWake = "hibernator.ardikabs.com/hibernate-until-wake"
+
+	// AnnotationWakeUntilHibernate is a one-shot annotation that wakes a Hibernated plan
+	// immediately and keeps it awake until the end of the current off-hours window.
+	//
+	// The controller consumes (deletes) this annotation, creates a suspend ScheduleException
+	// owned by the plan that lasts until the window would have ended, and starts the wakeup.
+	// The next scheduled hibernation then happens as usual.
+	//
+	// Value: must be "true" — any other value is treated as absent.
+	//
+	//   # Bring the environment back early for an out-of-hours fix
+	//   kubectl annotate hibernateplan <name> hibernator.ardikabs.com/wake-until-hibernate=true
+	AnnotationWakeUntilHibernate = "hibernator.ardikabs.com/wake-until-hibernate"
+
+	// AnnotationPreview is a one-shot annotation that dispatches a preview runner Job for
+	// every target of an Active or Hibernated plan. The runner only runs the discovery of
+	// the executor and reports the resources the target selects in its termination
+	// message, so selectors can be verified before the first real shutdown. Nothing is
+	// stopped and no restore data is written.
+	//
+	// The controller consumes (deletes) this annotation before dispatching the Jobs.
+	//
+	// Value: must be "true" — any other value is treated as absent.
+	//
+	//   # List the resources each target would hibernate
+	//   kubectl annotate hibernateplan <name> hibernator.ardikabs.com/preview=true
+	AnnotationPreview = "hibernator.ardikabs.com/preview"
+
+	// AnnotationPauseOperation holds an in-flight Hibernating or WakingUp operation between
+	// stages. Runner Jobs already dispatched run to completion and their results are still
+	// recorded, but no further targets or stages are dispatched until the annotation is
+	// removed. The OperationPaused condition reports the paused state.
+	//
+	// The annotation is persistent — the controller never removes it. A paused operation
+	// is not aborted by spec.behavior.maxCycleDuration, and the budget restarts on resume.
+	//
+	// Value: must be "true" — any other value is treated as absent.
+	//
+	//   # Hold the current operation while investigating a failing stage
+	//   kubectl annotate hibernateplan <name> hibernator.ardikabs.com/pause-operation=true
+	//
+	//   # Resume it
+	//   kubectl annotate hibernateplan <name> hibernator.ardikabs.com/pause-operation-
+	AnnotationPauseOperation = "hibernator.ardikabs.com/pause-operation"
+
+	// AnnotationOwners lists the owners of a HibernatePlan as a comma-separated list of
+	// usernames and "group:<name>" entries. Only owners may approve ScheduleExceptions
+	// that set spec.requiresApproval, and exceptions created by anyone else must set it.
+	// Plans without this annotation accept exceptions from anyone allowed to create them.
+	//
+	//   kubectl annotate hibernateplan <name> hibernator.ardikabs.com/owners=alice@example.com,group:platform
+	AnnotationOwners = "hibernator.ardikabs.com/owners"
+
+	// AnnotationApprovedBy records the plan owner who approved a ScheduleException.
+	// The admission webhook only accepts it when the value is the requesting user and
+	// that user is an owner of the referenced plan.
+	AnnotationApprovedBy = "hibernator.ardikabs.com/approved-by"
+
+	// AnnotationApprovedGeneration is the companion to AnnotationApprovedBy. It holds the
+	// exception generation that was approved, so editing the spec afterwards requires a
+	// fresh approval.
+	//
+	//   kubectl hibernator exception approve <name>
+	AnnotationApprovedGeneration = "hibernator.ardikabs.com/approved-generation"
+
+	// AnnotationRequestedBy records the user who last requested a manual action on a
+	// HibernatePlan: setting spec.suspend or one of the override-action, override-phase-target,
+	// hibernate-until-wake, wake-until-hibernate, restart or suspend-until annotations. The
+	// mutating admission webhook sets it from the request's user and discards values set by
+	// anyone else; the audit log records it as the actor of manual transitions.
+	AnnotationRequestedBy = "hibernator.ardikabs.com/requested-by"
+
+	// AnnotationIncidents lists the open incidents, as comma-separated "<source>/<id>"
+	// entries, that keep a ScheduleException created by the incident webhook valid.
+	// The webhook expires the exception once the last listed incident is resolved.
+	AnnotationIncidents = "hibernator.ardikabs.com/incidents"
+
+	// AnnotationLogUpdatedAt records when entries were last appended to an
+	// execution log ConfigMap. Logs older than the retention are pruned by it.
+	AnnotationLogUpdatedAt = "hibernator.ardikabs.com/log-updated-at"
+
+	// AnnotationLogDropped counts the oldest entries an execution or audit log
+	// ConfigMap dropped to stay within its size limit.
+	AnnotationLogDropped = "hibernator.ardikabs.com/log-dropped"
+
+	// AnnotationLastHeartbeat records on a runner Job when its runner last
+	// sent a heartbeat, in RFC 3339. Active Jobs whose heartbeat is older than
+	// the runner heartbeat timeout are reported stale.
+	AnnotationLastHeartbeat = "hibernator.ardikabs.com/last-heartbeat"
+
+	// AnnotationProgress records on a runner Job the per-resource progress its
+	// runner last reported, as a JSON-encoded ExecutionProgress.
+	AnnotationProgress = "hibernator.ardikabs.com/progress"
+
+	// AnnotationFailureReason records on a runner Job the class of failure its
+	// runner reported, e.g. AuthError or Throttled. The controller copies it into
+	// the execution status when the Job fails.
+	AnnotationFailureReason = "hibernator.ardikabs.com/failure-reason"
+
+	// AnnotationRemoteJob records on the local record of a runner Job dispatched
+	// into another cluster where that Job lives, as "<k8scluster>/<namespace>/<name>".
+	AnnotationRemoteJob = "hibernator.ardikabs.com/remote-job"
+
+	// AnnotationRemoteResult records on the local record of a runner Job dispatched
+	// into another cluster the result its runner reported, as a JSON-encoded
+	// remoterunner.Result. The controller cannot read the Job itself, so the
+	// record is finished once the annotation is set.
+	AnnotationRemoteResult = "hibernator.ardikabs.com/remote-result"
+
+	// AnnotationHourlyCost declares on a plan what its targets cost per hour
+	// while awake, as a decimal number in any currency (e.g. "12.50"). Report
+	// roll-ups multiply the plan's hibernated hours by it to estimate savings.
+	AnnotationHourlyCost = "hibernator.ardikabs.com/hourly-cost"
 )
 
+// OwnerGroupPrefix marks an AnnotationOwners entry that names a group rather than a user.
+const OwnerGroupPrefix = "group:"
+
 // Override phase target values for AnnotationOverridePhaseTarget.
 const (
 	// OverridePhaseTargetHibernate targets the Hibernated phase (forces plan to hibernate).
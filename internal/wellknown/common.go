@@ -12,8 +12,35 @@ const (
 
 	// FieldIndexExceptionPlanRef is the field index path for ScheduleException.spec.planRef.name.
 	// Used by the field indexer to enable efficient lookups of exceptions by plan name.
+	// Exceptions are indexed under every name in planRef and planRefs, and under
+	// ExceptionPlanSelectorIndexValue when they set planSelector.
 	FieldIndexExceptionPlanRef = ".spec.planRef.name"
 
+	// ExceptionPlanSelectorIndexValue is the FieldIndexExceptionPlanRef value of
+	// exceptions that select plans by label. It is not a valid object name, so it
+	// never collides with a plan.
+	ExceptionPlanSelectorIndexValue = "*"
+
+	// FieldIndexPlanTargetPreset is the field index path for HibernatePlan.spec.targetsFrom.
+	// Values are "<namespace>/<name>" of each referenced TargetPreset, so plans can be
+	// looked up when a preset changes, including from other namespaces.
+	FieldIndexPlanTargetPreset = ".spec.targetsFrom"
+
+	// FieldIndexPlanConnectorSelector is the field index path for connector selectors in
+	// HibernatePlan.spec.targets. Values are "<kind>/<namespace>" of the connectors each
+	// selector target lists, so plans can be re-expanded when a connector changes.
+	FieldIndexPlanConnectorSelector = ".spec.targets.connectorRef.selector"
+
+	// FieldIndexPlanConnectorRef is the field index path for connectors referenced by
+	// name in HibernatePlan.spec.targets. Values are "<kind>/<namespace>/<name>", so
+	// plans can report a connector's health when it changes.
+	FieldIndexPlanConnectorRef = ".spec.targets.connectorRef.name"
+
+	// FieldIndexCloudProviderSecretRef is the field index path for the Secret holding a
+	// CloudProvider's static credentials. Values are "<namespace>/<name>", so credentials
+	// can be revalidated when the Secret changes.
+	FieldIndexCloudProviderSecretRef = ".spec.aws.auth.static.secretRef"
+
 	// RunnerImage is the default runner image.
 	RunnerImage = "ghcr.io/ardikabs/hibernator-runner:latest"
 
@@ -23,6 +50,41 @@ const (
 	// StreamTokenExpirationSeconds is the token expiration time.
 	StreamTokenExpirationSeconds = 600
 
+	// StreamTokenMountPath is the directory the projected stream token is mounted at.
+	StreamTokenMountPath = "/var/run/secrets/stream"
+
+	// StreamTokenFile is the file name of the projected stream token.
+	StreamTokenFile = "token"
+
+	// ControlPlaneKubeconfigKey is the key of the spec.execution.runnerCluster
+	// Secret holding the kubeconfig remote runners reach the control plane with.
+	ControlPlaneKubeconfigKey = "kubeconfig"
+
+	// ControlPlaneTokenKey is the key of the spec.execution.runnerCluster Secret
+	// holding the token remote runners authenticate to the streaming servers with.
+	ControlPlaneTokenKey = "token"
+
+	// StreamTLSMountPath is the directory the runner client certificate for
+	// mutual TLS streaming is mounted at.
+	StreamTLSMountPath = "/var/run/secrets/stream-tls"
+
+	// GCPFederationTokenMountPath is the directory the projected ServiceAccount token
+	// runners exchange through GCP Workload Identity Federation is mounted at.
+	GCPFederationTokenMountPath = "/var/run/secrets/gcp-federation"
+
+	// GCPFederationTokenFile is the file name of the projected federation token.
+	GCPFederationTokenFile = "token"
+
+	// GCPFederationTokenExpirationSeconds is the federation token expiration time.
+	GCPFederationTokenExpirationSeconds = 3600
+
+	// StreamGRPCPort is the control-plane port runners use for gRPC streaming.
+	StreamGRPCPort = "9444"
+
+	// StreamWebSocketPort is the control-plane port runners use for WebSocket
+	// streaming and HTTP callbacks.
+	StreamWebSocketPort = "8082"
+
 	// DefaultJobTTLSeconds is the TTL for completed runner jobs (1 hour).
 	DefaultJobTTLSeconds = 3600
 
@@ -32,6 +94,10 @@ const (
 	// DefaultRecoveryMaxRetryAttempts is the default max retry attempts for recovery.
 	DefaultRecoveryMaxRetryAttempts = int32(3)
 
+	// DefaultRecoveryBackoffMultiplier is the factor the delay between
+	// recovery retries grows by after each retry.
+	DefaultRecoveryBackoffMultiplier = int32(2)
+
 	// TerminationLogPath is the path where the runner writes its termination message.
 	// Ref: https://kubernetes.io/docs/tasks/debug/debug-application/determine-reason-pod-failure/#customizing-the-termination-message
 	TerminationLogPath = "/dev/termination-log"
@@ -0,0 +1,55 @@
+package wellknown
+
+// Reasons of the Kubernetes Events recorded on HibernatePlans.
+const (
+	// EventReasonHibernationStarted is recorded when a shutdown operation starts.
+	EventReasonHibernationStarted = "HibernationStarted"
+
+	// EventReasonHibernationCompleted is recorded when all targets are shut down.
+	EventReasonHibernationCompleted = "HibernationCompleted"
+
+	// EventReasonHibernationFailed is recorded when a shutdown operation fails.
+	EventReasonHibernationFailed = "HibernationFailed"
+
+	// EventReasonWakeUpStarted is recorded when a wakeup operation starts.
+	EventReasonWakeUpStarted = "WakeUpStarted"
+
+	// EventReasonWakeUpCompleted is recorded when all targets are woken up.
+	EventReasonWakeUpCompleted = "WakeUpCompleted"
+
+	// EventReasonWakeUpFailed is recorded when a wakeup operation fails.
+	EventReasonWakeUpFailed = "WakeUpFailed"
+
+	// EventReasonPlanFailed is recorded when a plan fails outside an operation.
+	EventReasonPlanFailed = "PlanFailed"
+
+	// EventReasonTargetShutdownFailed is recorded when the shutdown of a target fails.
+	EventReasonTargetShutdownFailed = "TargetShutdownFailed"
+
+	// EventReasonTargetWakeUpFailed is recorded when the wakeup of a target fails.
+	EventReasonTargetWakeUpFailed = "TargetWakeUpFailed"
+
+	// EventReasonRecoveryStarted is recorded when a plan in the Error phase is retried,
+	// or recovers because the schedule window changed.
+	EventReasonRecoveryStarted = "RecoveryStarted"
+
+	// EventReasonExceptionApplied is recorded when a ScheduleException becomes active
+	// for the plan.
+	EventReasonExceptionApplied = "ExceptionApplied"
+
+	// EventReasonDriftDetected is recorded when the plan spec drifts from the intent
+	// locked for the operation in progress.
+	EventReasonDriftDetected = "DriftDetected"
+
+	// EventReasonExecutionProgress is recorded when a runner reports progress.
+	EventReasonExecutionProgress = "ExecutionProgress"
+
+	// EventReasonExecutionCompleted is recorded when a runner reports success.
+	EventReasonExecutionCompleted = "ExecutionCompleted"
+
+	// EventReasonExecutionFailed is recorded when a runner reports a failure.
+	EventReasonExecutionFailed = "ExecutionFailed"
+
+	// EventReasonExecutionCancelled is recorded when a runner reports cancellation.
+	EventReasonExecutionCancelled = "ExecutionCancelled"
+)
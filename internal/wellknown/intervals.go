@@ -18,10 +18,51 @@ const (
 	// RequeueIntervalOnRecoveryError is the requeue interval when an error occurs during recovery.
 	RequeueIntervalOnRecoveryError = 1 * time.Minute
 
+	// DefaultRecoveryInitialBackoff is the delay before the first recovery
+	// retry when the plan's retry policy does not set one.
+	DefaultRecoveryInitialBackoff = 1 * time.Minute
+
+	// DefaultRecoveryMaxBackoff caps the delay between recovery retries when
+	// the plan's retry policy does not set a cap.
+	DefaultRecoveryMaxBackoff = 30 * time.Minute
+
 	// RequeueIntervalOnTransientError is the requeue interval when a handler
 	// encounters a transient (non-plan-level) error during Handle or OnDeadline.
 	RequeueIntervalOnTransientError = 30 * time.Second
 
 	// TimeoutTransitionToSuspended is the timeout duration for transitioning to suspended state when in-flight executions are present.
 	TimeoutTransitionToSuspended = 30 * time.Minute
+
+	// DefaultPreWakeLeadTime is how long before the scheduled wakeup a target's
+	// pre-wake hook runs when the hook does not set a lead time.
+	DefaultPreWakeLeadTime = 10 * time.Minute
+
+	// RunnerHeartbeatInterval is how often runners send heartbeats to the
+	// streaming servers.
+	RunnerHeartbeatInterval = 30 * time.Second
+
+	// RunnerHeartbeatRecordInterval is how often the streaming servers record
+	// the heartbeats of a runner on its Job. A runner heartbeat timeout must
+	// exceed it plus RunnerHeartbeatInterval.
+	RunnerHeartbeatRecordInterval = time.Minute
+
+	// RunnerProgressRecordInterval is how often the streaming servers record
+	// the per-resource progress of a runner on its Job.
+	RunnerProgressRecordInterval = 10 * time.Second
+
+	// RunnerTerminationGracePeriod is how long a terminated runner has to
+	// flush the restore data gathered so far and report its cancellation
+	// before it is killed.
+	RunnerTerminationGracePeriod = 3 * time.Minute
+
+	// DefaultStageReadinessTimeout is how long a stage readiness probe may keep
+	// failing before the stage fails when the probe does not set a timeout.
+	DefaultStageReadinessTimeout = 10 * time.Minute
+
+	// StageReadinessProbeInterval is how often a failing stage readiness probe
+	// is checked again.
+	StageReadinessProbeInterval = 10 * time.Second
+
+	// StageReadinessCheckTimeout bounds a single stage readiness check.
+	StageReadinessCheckTimeout = 5 * time.Second
 )
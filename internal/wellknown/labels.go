@@ -21,6 +21,9 @@ const (
 	// LabelCycleID is the label key for the cycle ID (isolates jobs by cycle).
 	LabelCycleID = "hibernator.ardikabs.com/cycle-id"
 
+	// LabelTraceID is the label key for the trace ID of a traced runner Job.
+	LabelTraceID = "hibernator.ardikabs.com/trace-id"
+
 	// LabelStaleRunnerJob is the label key to mark stale runner jobs.
 	LabelStaleRunnerJob = "hibernator.ardikabs.com/stale"
 
@@ -29,4 +32,38 @@ const (
 
 	// LabelException is the label key for the exception name.
 	LabelException = "hibernator.ardikabs.com/exception"
+
+	// LabelClusterPlan is the label key for the ClusterHibernatePlan that
+	// generated a HibernatePlan.
+	LabelClusterPlan = "hibernator.ardikabs.com/cluster-plan"
+
+	// LabelShard is the label key assigning a HibernatePlan, ClusterHibernatePlan
+	// or ScheduleException to the controller deployment started with that --shard.
+	LabelShard = "hibernator.ardikabs.com/shard"
+
+	// LabelRestoreChunk marks ConfigMaps holding a chunk of a plan's restore data.
+	LabelRestoreChunk = "hibernator.ardikabs.com/restore-chunk"
+
+	// LabelExecutionLog marks ConfigMaps holding the persisted logs of an execution.
+	LabelExecutionLog = "hibernator.ardikabs.com/execution-log"
+
+	// LabelAuditLog marks ConfigMaps holding the audit log of a plan.
+	LabelAuditLog = "hibernator.ardikabs.com/audit-log"
+
+	// LabelRemoteRunnerJob marks ConfigMaps recording a runner Job dispatched
+	// into another cluster through spec.execution.runnerCluster.
+	LabelRemoteRunnerJob = "hibernator.ardikabs.com/remote-runner-job"
+
+	// LabelReportType is the label key for the type of a HibernationReport
+	// (Cycle, Daily or Weekly).
+	LabelReportType = "hibernator.ardikabs.com/report-type"
+
+	// LabelProtected marks a cloud resource (as a tag) or a Kubernetes object
+	// (as a label) that executors must leave alone, whatever the selector of
+	// the target. Only the value "true" protects.
+	LabelProtected = "hibernator.ardikabs.com/protected"
+
+	// GCPLabelProtected is LabelProtected for GCP resource labels, whose keys
+	// may not contain "." or "/".
+	GCPLabelProtected = "hibernator-protected"
 )
@@ -8,12 +8,18 @@ package awsutil
 import (
 	"context"
 	"fmt"
+	"maps"
+	"net/http"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/aws/smithy-go/middleware"
 )
 
 // BuildAWSConfig builds an AWS SDK config from the connector configuration.
@@ -22,7 +28,9 @@ func BuildAWSConfig(ctx context.Context, cfg *AWSConnectorConfig) (aws.Config, e
 		return aws.Config{}, fmt.Errorf("AWS connector config is required")
 	}
 
-	var opts []func(*config.LoadOptions) error
+	opts := []func(*config.LoadOptions) error{
+		config.WithAPIOptions([]func(*middleware.Stack) error{addTracingMiddleware}),
+	}
 	if cfg.Region != "" {
 		opts = append(opts, config.WithRegion(cfg.Region))
 	}
@@ -30,17 +38,57 @@ func BuildAWSConfig(ctx context.Context, cfg *AWSConnectorConfig) (aws.Config, e
 		provider := credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, cfg.SessionToken)
 		opts = append(opts, config.WithCredentialsProvider(provider))
 	}
+	if cfg.Network != nil {
+		var transportErr error
+		client := awshttp.NewBuildableClient().WithTransportOptions(func(t *http.Transport) {
+			transportErr = cfg.Network.ConfigureTransport(t)
+		})
+		if transportErr != nil {
+			return aws.Config{}, fmt.Errorf("configure AWS transport: %w", transportErr)
+		}
+		opts = append(opts, config.WithHTTPClient(client))
+	}
 
 	awsCfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
 		return aws.Config{}, fmt.Errorf("load AWS config: %w", err)
 	}
 
-	if cfg.AssumeRoleArn != "" {
+	// Each hop assumes its role with the credentials of the previous one.
+	for _, step := range assumeRoleSteps(cfg) {
 		stsClient := sts.NewFromConfig(awsCfg)
-		creds := stscreds.NewAssumeRoleProvider(stsClient, cfg.AssumeRoleArn)
+		creds := stscreds.NewAssumeRoleProvider(stsClient, step.RoleArn, func(o *stscreds.AssumeRoleOptions) {
+			if step.ExternalID != "" {
+				o.ExternalID = aws.String(step.ExternalID)
+			}
+			o.Tags = sessionTags(step.SessionTags)
+		})
 		awsCfg.Credentials = aws.NewCredentialsCache(creds)
 	}
 
 	return awsCfg, nil
 }
+
+// assumeRoleSteps returns the roles to assume in order: the role chain, then
+// AssumeRoleArn.
+func assumeRoleSteps(cfg *AWSConnectorConfig) []AssumeRoleStep {
+	steps := append([]AssumeRoleStep(nil), cfg.RoleChain...)
+	if cfg.AssumeRoleArn != "" {
+		steps = append(steps, AssumeRoleStep{RoleArn: cfg.AssumeRoleArn})
+	}
+	return steps
+}
+
+// sessionTags converts tags into STS session tags, ordered by key.
+func sessionTags(tags map[string]string) []ststypes.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	keys := slices.Sorted(maps.Keys(tags))
+	out := make([]ststypes.Tag, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, ststypes.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return out
+}
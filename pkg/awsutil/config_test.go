@@ -0,0 +1,69 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package awsutil
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssumeRoleSteps(t *testing.T) {
+	hop := AssumeRoleStep{RoleArn: "arn:aws:iam::111111111111:role/security-hop", ExternalID: "hibernator"}
+
+	tests := []struct {
+		name string
+		cfg  *AWSConnectorConfig
+		want []AssumeRoleStep
+	}{
+		{
+			name: "no roles",
+			cfg:  &AWSConnectorConfig{},
+			want: nil,
+		},
+		{
+			name: "assume role only",
+			cfg:  &AWSConnectorConfig{AssumeRoleArn: "arn:aws:iam::123456789012:role/target"},
+			want: []AssumeRoleStep{{RoleArn: "arn:aws:iam::123456789012:role/target"}},
+		},
+		{
+			name: "chain then assume role",
+			cfg: &AWSConnectorConfig{
+				AssumeRoleArn: "arn:aws:iam::123456789012:role/target",
+				RoleChain:     []AssumeRoleStep{hop},
+			},
+			want: []AssumeRoleStep{hop, {RoleArn: "arn:aws:iam::123456789012:role/target"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, assumeRoleSteps(tt.cfg))
+		})
+	}
+}
+
+func TestAssumeRoleSteps_DoesNotModifyChain(t *testing.T) {
+	chain := make([]AssumeRoleStep, 1, 2)
+	chain[0] = AssumeRoleStep{RoleArn: "arn:aws:iam::111111111111:role/security-hop"}
+	cfg := &AWSConnectorConfig{AssumeRoleArn: "arn:aws:iam::123456789012:role/target", RoleChain: chain}
+
+	assumeRoleSteps(cfg)
+	assert.Len(t, cfg.RoleChain, 1)
+	assert.Empty(t, chain[:2][1].RoleArn, "the chain's spare capacity must not be written")
+}
+
+func TestSessionTags_SortedByKey(t *testing.T) {
+	tags := sessionTags(map[string]string{"team": "platform", "env": "prod"})
+
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keys = append(keys, aws.ToString(tag.Key))
+	}
+	assert.Equal(t, []string{"env", "team"}, keys)
+	assert.Nil(t, sessionTags(nil))
+}
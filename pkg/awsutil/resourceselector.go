@@ -0,0 +1,112 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package awsutil
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ResourceSelector combines the selection methods shared by the AWS executors:
+// explicit resource IDs, a name regex and a tag selector. Every method that is
+// set must match; an empty selector matches every resource.
+type ResourceSelector struct {
+	// IDs restricts the selection to the listed resource IDs.
+	IDs []string
+
+	// NamePattern is a regular expression (RE2 syntax) the whole resource name
+	// must match.
+	NamePattern string
+
+	// TagSelector is matched against the resource tags.
+	TagSelector *TagSelector
+}
+
+// Resource is the view of an AWS resource a ResourceSelector is matched against.
+type Resource struct {
+	// ID is the resource identifier, e.g. an instance ID.
+	ID string
+
+	// Name is the human-readable name, e.g. the Name tag of an EC2 instance or
+	// the identifier of an RDS instance.
+	Name string
+
+	// Tags are the resource tags.
+	Tags map[string]string
+}
+
+// ResourceMatcher is a compiled ResourceSelector.
+type ResourceMatcher struct {
+	ids  map[string]struct{}
+	name *regexp.Regexp
+	tags *TagSelector
+}
+
+// NewResourceMatcher compiles the selector. It fails when the name pattern is
+// not a valid regular expression.
+func NewResourceMatcher(selector ResourceSelector) (*ResourceMatcher, error) {
+	m := &ResourceMatcher{tags: selector.TagSelector}
+
+	if len(selector.IDs) > 0 {
+		m.ids = make(map[string]struct{}, len(selector.IDs))
+		for _, id := range selector.IDs {
+			m.ids[id] = struct{}{}
+		}
+	}
+
+	if selector.NamePattern != "" {
+		re, err := compileNamePattern(selector.NamePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name pattern %q: %w", selector.NamePattern, err)
+		}
+		m.name = re
+	}
+
+	return m, nil
+}
+
+// Match reports whether the resource satisfies every method of the selector.
+func (m *ResourceMatcher) Match(res Resource) bool {
+	if m.ids != nil {
+		if _, ok := m.ids[res.ID]; !ok {
+			return false
+		}
+	}
+	if m.name != nil && !m.name.MatchString(res.Name) {
+		return false
+	}
+	return Match(res.Tags, m.tags)
+}
+
+// NeedsTags reports whether matching depends on the resource tags, so callers
+// can skip fetching them otherwise.
+func (m *ResourceMatcher) NeedsTags() bool {
+	return m.tags != nil && (len(m.tags.MatchTags) > 0 || len(m.tags.MatchExpressions) > 0)
+}
+
+// ValidateNamePattern validates a name regex of a selector.
+func ValidateNamePattern(pattern string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if pattern == "" {
+		return allErrs
+	}
+	if _, err := compileNamePattern(pattern); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, pattern, fmt.Sprintf("invalid regular expression: %v", err)))
+	}
+	return allErrs
+}
+
+// compileNamePattern anchors the pattern so it must match the whole name. The
+// pattern is compiled on its own first, so unbalanced groups cannot escape the
+// anchors.
+func compileNamePattern(pattern string) (*regexp.Regexp, error) {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, err
+	}
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
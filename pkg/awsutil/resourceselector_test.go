@@ -0,0 +1,116 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package awsutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestResourceMatcher_Match(t *testing.T) {
+	res := Resource{
+		ID:   "i-123",
+		Name: "api-staging-1",
+		Tags: map[string]string{"env": "staging", "team": "backend"},
+	}
+
+	tests := []struct {
+		name     string
+		selector ResourceSelector
+		want     bool
+	}{
+		{
+			name:     "empty selector matches everything",
+			selector: ResourceSelector{},
+			want:     true,
+		},
+		{
+			name:     "ID listed",
+			selector: ResourceSelector{IDs: []string{"i-000", "i-123"}},
+			want:     true,
+		},
+		{
+			name:     "ID not listed",
+			selector: ResourceSelector{IDs: []string{"i-000"}},
+			want:     false,
+		},
+		{
+			name:     "name matches pattern",
+			selector: ResourceSelector{NamePattern: `api-(staging|dev)-\d+`},
+			want:     true,
+		},
+		{
+			name:     "pattern must match the whole name",
+			selector: ResourceSelector{NamePattern: "api"},
+			want:     false,
+		},
+		{
+			name: "matchExpressions In and Exists",
+			selector: ResourceSelector{TagSelector: &TagSelector{MatchExpressions: []TagSelectorRequirement{
+				{Key: "env", Operator: "In", Values: []string{"dev", "staging"}},
+				{Key: "team", Operator: "Exists"},
+			}}},
+			want: true,
+		},
+		{
+			name: "matchExpressions NotIn excludes",
+			selector: ResourceSelector{TagSelector: &TagSelector{MatchExpressions: []TagSelectorRequirement{
+				{Key: "env", Operator: "NotIn", Values: []string{"staging"}},
+			}}},
+			want: false,
+		},
+		{
+			name: "all methods are ANDed",
+			selector: ResourceSelector{
+				IDs:         []string{"i-123"},
+				NamePattern: "api-.*",
+				TagSelector: &TagSelector{MatchTags: map[string]string{"env": "prod"}},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewResourceMatcher(tt.selector)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, m.Match(res))
+		})
+	}
+}
+
+func TestNewResourceMatcher_InvalidPattern(t *testing.T) {
+	_, err := NewResourceMatcher(ResourceSelector{NamePattern: "api-("})
+	assert.Error(t, err)
+
+	// Unbalanced groups must not escape the anchors.
+	_, err = NewResourceMatcher(ResourceSelector{NamePattern: "a)|(b"})
+	assert.Error(t, err)
+}
+
+func TestResourceMatcher_NeedsTags(t *testing.T) {
+	m, err := NewResourceMatcher(ResourceSelector{NamePattern: "db-.*"})
+	require.NoError(t, err)
+	assert.False(t, m.NeedsTags())
+
+	m, err = NewResourceMatcher(ResourceSelector{TagSelector: &TagSelector{MatchTags: map[string]string{"env": "prod"}}})
+	require.NoError(t, err)
+	assert.True(t, m.NeedsTags())
+}
+
+func TestValidateNamePattern(t *testing.T) {
+	fldPath := field.NewPath("selector").Child("namePattern")
+
+	assert.Empty(t, ValidateNamePattern("", fldPath))
+	assert.Empty(t, ValidateNamePattern(`db-\d+`, fldPath))
+
+	errs := ValidateNamePattern("db-[", fldPath)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "selector.namePattern", errs[0].Field)
+}
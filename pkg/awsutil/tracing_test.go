@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package awsutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAddTracingMiddleware(t *testing.T) {
+	stack := middleware.NewStack("StopInstances", smithyhttp.NewStackRequest)
+	require.NoError(t, addTracingMiddleware(stack))
+
+	_, ok := stack.Initialize.Get("HibernatorTracing")
+	assert.True(t, ok)
+}
+
+func TestTraceOperation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	metadata := awsmiddleware.RegisterServiceMetadata{ServiceID: "EC2", Region: "eu-west-1", OperationName: "StopInstances"}
+	_, _, err := metadata.HandleInitialize(context.Background(), middleware.InitializeInput{},
+		middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+			return traceOperation(ctx, in, middleware.InitializeHandlerFunc(
+				func(context.Context, middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+					return middleware.InitializeOutput{}, middleware.Metadata{}, errors.New("throttled")
+				}))
+		}))
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "EC2.StopInstances", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Contains(t, spans[0].Attributes(), attribute.String("cloud.region", "eu-west-1"))
+	assert.Contains(t, spans[0].Attributes(), attribute.String("rpc.method", "StopInstances"))
+}
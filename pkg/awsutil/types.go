@@ -5,6 +5,8 @@ Licensed under the Apache License, Version 2.0.
 
 package awsutil
 
+import "github.com/ardikabs/hibernator/pkg/netutil"
+
 // AWSConnectorConfig holds AWS connector settings.
 type AWSConnectorConfig struct {
 	Region          string
@@ -13,4 +15,17 @@ type AWSConnectorConfig struct {
 	AccessKeyID     string
 	SecretAccessKey string
 	SessionToken    string
+
+	// RoleChain lists roles assumed in order before AssumeRoleArn.
+	RoleChain []AssumeRoleStep
+
+	// Network is the proxy and CA bundle used to reach AWS APIs.
+	Network *netutil.Config
+}
+
+// AssumeRoleStep is one hop of a role chain.
+type AssumeRoleStep struct {
+	RoleArn     string
+	ExternalID  string
+	SessionTags map[string]string
 }
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+// Package dedup aggregates repeated, identical occurrences (Kubernetes Events,
+// notifications) so that flapping or retry loops do not flood their
+// destination.
+//
+// Each key is allowed Burst emissions per Window. Further occurrences in the
+// same window are suppressed and counted. The first occurrence after the
+// window elapses is emitted together with a summary of what was suppressed,
+// so consumers can render messages such as "failed 12 times in the last hour".
+package dedup
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+const (
+	// DefaultWindow is the default aggregation window.
+	DefaultWindow = time.Hour
+
+	// DefaultBurst is the default number of identical occurrences emitted per window.
+	DefaultBurst = 3
+)
+
+// Config holds deduplication settings.
+type Config struct {
+	// Window is the aggregation window. Zero or negative disables deduplication:
+	// every occurrence is emitted.
+	Window time.Duration
+
+	// Burst is the number of identical occurrences emitted per Window before
+	// suppression starts. Values below 1 are treated as 1.
+	Burst int
+}
+
+// DefaultConfig returns the default deduplication configuration.
+func DefaultConfig() Config {
+	return Config{
+		Window: DefaultWindow,
+		Burst:  DefaultBurst,
+	}
+}
+
+// Enabled reports whether the configuration performs any deduplication.
+func (c Config) Enabled() bool {
+	return c.Window > 0
+}
+
+// Decision is the outcome of observing a single occurrence.
+type Decision struct {
+	// Emit is true when the occurrence should be delivered.
+	Emit bool
+
+	// Suppressed is the number of identical occurrences that were withheld
+	// since the previous emission. Only meaningful when Emit is true.
+	Suppressed int
+
+	// Count is the total number of identical occurrences observed since Since,
+	// including the current one. Only meaningful when Suppressed > 0.
+	Count int
+
+	// Since is the start of the window in which the suppressed occurrences
+	// were first observed. Only meaningful when Suppressed > 0.
+	Since time.Time
+}
+
+// Summarized reports whether the decision carries a summary of suppressed occurrences.
+func (d Decision) Summarized() bool {
+	return d.Emit && d.Suppressed > 0
+}
+
+// entry tracks the state of a single key.
+type entry struct {
+	windowStart time.Time
+	emitted     int
+	suppressed  int
+
+	// carry* describe the previous window when it ended with suppressed
+	// occurrences that have not yet been reported.
+	carrySuppressed int
+	carryCount      int
+	carrySince      time.Time
+}
+
+// Aggregator counts occurrences per key and decides which ones to emit.
+// It is safe for concurrent use.
+type Aggregator struct {
+	cfg   Config
+	clock clock.PassiveClock
+
+	mu        sync.Mutex
+	entries   map[string]*entry
+	lastSweep time.Time
+}
+
+// Option configures an Aggregator.
+type Option func(*Aggregator)
+
+// WithClock overrides the clock used to evaluate windows. Defaults to the real clock.
+func WithClock(clk clock.PassiveClock) Option {
+	return func(a *Aggregator) {
+		a.clock = clk
+	}
+}
+
+// New creates a new Aggregator.
+func New(cfg Config, opts ...Option) *Aggregator {
+	if cfg.Burst < 1 {
+		cfg.Burst = 1
+	}
+
+	a := &Aggregator{
+		cfg:     cfg,
+		clock:   clock.RealClock{},
+		entries: make(map[string]*entry),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	a.lastSweep = a.clock.Now()
+	return a
+}
+
+// Config returns the effective configuration of the aggregator.
+func (a *Aggregator) Config() Config {
+	return a.cfg
+}
+
+// Observe records one occurrence of key and reports whether it should be emitted.
+func (a *Aggregator) Observe(key string) Decision {
+	if !a.cfg.Enabled() {
+		return Decision{Emit: true}
+	}
+
+	now := a.clock.Now()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.sweepLocked(now)
+
+	e, ok := a.entries[key]
+	if !ok {
+		a.entries[key] = &entry{windowStart: now, emitted: 1}
+		return Decision{Emit: true}
+	}
+
+	if now.Sub(e.windowStart) >= a.cfg.Window {
+		// The previous window closed. Its suppressed occurrences are carried
+		// into this emission as a summary.
+		if e.suppressed > 0 {
+			e.carrySuppressed = e.suppressed
+			e.carryCount = e.emitted + e.suppressed
+			e.carrySince = e.windowStart
+		}
+		e.windowStart = now
+		e.emitted = 0
+		e.suppressed = 0
+	}
+
+	if e.emitted >= a.cfg.Burst {
+		e.suppressed++
+		return Decision{}
+	}
+
+	e.emitted++
+	d := Decision{Emit: true}
+	if e.carrySuppressed > 0 {
+		d.Suppressed = e.carrySuppressed
+		d.Count = e.carryCount + 1
+		d.Since = e.carrySince
+		e.carrySuppressed, e.carryCount, e.carrySince = 0, 0, time.Time{}
+	}
+	return d
+}
+
+// Len returns the number of tracked keys.
+func (a *Aggregator) Len() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.entries)
+}
+
+// sweepLocked drops keys that have been idle for two full windows and carry no
+// pending summary. It runs at most once per window.
+func (a *Aggregator) sweepLocked(now time.Time) {
+	if now.Sub(a.lastSweep) < a.cfg.Window {
+		return
+	}
+	a.lastSweep = now
+
+	for key, e := range a.entries {
+		if e.suppressed == 0 && e.carrySuppressed == 0 && now.Sub(e.windowStart) >= 2*a.cfg.Window {
+			delete(a.entries, key)
+		}
+	}
+}
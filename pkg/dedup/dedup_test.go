@@ -0,0 +1,129 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package dedup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestAggregator_Disabled(t *testing.T) {
+	a := New(Config{})
+	for range 10 {
+		assert.True(t, a.Observe("k").Emit)
+	}
+	assert.Equal(t, 0, a.Len())
+}
+
+func TestAggregator_BurstThenSuppress(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC))
+	a := New(Config{Window: time.Hour, Burst: 2}, WithClock(clk))
+
+	assert.True(t, a.Observe("k").Emit)
+	assert.True(t, a.Observe("k").Emit)
+	for range 10 {
+		assert.False(t, a.Observe("k").Emit)
+	}
+
+	// Other keys are independent.
+	assert.True(t, a.Observe("other").Emit)
+}
+
+func TestAggregator_SummaryAfterWindow(t *testing.T) {
+	start := time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakePassiveClock(start)
+	a := New(Config{Window: time.Hour, Burst: 1}, WithClock(clk))
+
+	require.True(t, a.Observe("k").Emit)
+	for range 11 {
+		clk.SetTime(clk.Now().Add(time.Minute))
+		require.False(t, a.Observe("k").Emit)
+	}
+
+	clk.SetTime(start.Add(time.Hour))
+	d := a.Observe("k")
+	require.True(t, d.Summarized())
+	assert.Equal(t, 11, d.Suppressed)
+	assert.Equal(t, 13, d.Count)
+	assert.Equal(t, start, d.Since)
+
+	// The summary is reported only once.
+	clk.SetTime(start.Add(3 * time.Hour))
+	d = a.Observe("k")
+	assert.True(t, d.Emit)
+	assert.False(t, d.Summarized())
+}
+
+func TestAggregator_NoSummaryWithoutSuppression(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC))
+	a := New(Config{Window: time.Hour, Burst: 3}, WithClock(clk))
+
+	a.Observe("k")
+	a.Observe("k")
+	clk.SetTime(clk.Now().Add(2 * time.Hour))
+
+	d := a.Observe("k")
+	assert.True(t, d.Emit)
+	assert.False(t, d.Summarized())
+}
+
+func TestAggregator_SweepsIdleKeys(t *testing.T) {
+	clk := clocktesting.NewFakePassiveClock(time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC))
+	a := New(Config{Window: time.Hour, Burst: 1}, WithClock(clk))
+
+	a.Observe("idle")
+	a.Observe("suppressed")
+	a.Observe("suppressed")
+	require.Equal(t, 2, a.Len())
+
+	clk.SetTime(clk.Now().Add(2 * time.Hour))
+	a.Observe("fresh")
+
+	// "idle" is dropped; "suppressed" is kept because its summary is pending.
+	assert.Equal(t, 2, a.Len())
+	d := a.Observe("suppressed")
+	assert.True(t, d.Summarized())
+}
+
+func TestAggregator_BurstFloor(t *testing.T) {
+	a := New(Config{Window: time.Hour, Burst: 0})
+	assert.Equal(t, 1, a.Config().Burst)
+}
+
+func TestEventRecorder_Deduplicates(t *testing.T) {
+	start := time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakePassiveClock(start)
+	fake := record.NewFakeRecorder(100)
+
+	var suppressed int
+	rec := NewEventRecorder(fake, New(Config{Window: time.Hour, Burst: 2}, WithClock(clk)))
+	rec.OnSuppress = func(_, _ string) { suppressed++ }
+
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "plan", Namespace: "ns", UID: "uid-1"}}
+	for range 5 {
+		rec.Eventf(obj, corev1.EventTypeWarning, "ExecutionFailed", "target=%s: %s", "db", "boom")
+	}
+	// A different message is tracked separately.
+	rec.Event(obj, corev1.EventTypeWarning, "ExecutionFailed", "target=db: other")
+
+	require.Len(t, fake.Events, 3)
+	assert.Equal(t, 3, suppressed)
+	for range 3 {
+		<-fake.Events
+	}
+
+	clk.SetTime(start.Add(time.Hour))
+	rec.Eventf(obj, corev1.EventTypeWarning, "ExecutionFailed", "target=%s: %s", "db", "boom")
+	require.Len(t, fake.Events, 1)
+	assert.Contains(t, <-fake.Events, "(occurred 6 times since 2026-01-01T02:00:00Z, 3 suppressed)")
+}
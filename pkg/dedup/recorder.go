@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package dedup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// EventRecorder wraps a record.EventRecorder and drops identical Events
+// (same object, type, reason and message) beyond the aggregator's burst.
+// The next Event emitted after suppression carries a summary suffix.
+type EventRecorder struct {
+	recorder   record.EventRecorder
+	aggregator *Aggregator
+
+	// OnSuppress is invoked for every dropped Event. Optional.
+	OnSuppress func(eventtype, reason string)
+}
+
+var _ record.EventRecorder = (*EventRecorder)(nil)
+
+// NewEventRecorder returns an EventRecorder that deduplicates through agg.
+func NewEventRecorder(recorder record.EventRecorder, agg *Aggregator) *EventRecorder {
+	return &EventRecorder{
+		recorder:   recorder,
+		aggregator: agg,
+	}
+}
+
+// Event implements record.EventRecorder.
+func (r *EventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	d := r.observe(object, eventtype, reason, message)
+	if !d.Emit {
+		return
+	}
+	r.recorder.Event(object, eventtype, reason, message+summarySuffix(d))
+}
+
+// Eventf implements record.EventRecorder.
+func (r *EventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...any) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// AnnotatedEventf implements record.EventRecorder.
+func (r *EventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...any) {
+	message := fmt.Sprintf(messageFmt, args...)
+	d := r.observe(object, eventtype, reason, message)
+	if !d.Emit {
+		return
+	}
+	r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message+summarySuffix(d))
+}
+
+func (r *EventRecorder) observe(object runtime.Object, eventtype, reason, message string) Decision {
+	d := r.aggregator.Observe(eventKey(object, eventtype, reason, message))
+	if !d.Emit && r.OnSuppress != nil {
+		r.OnSuppress(eventtype, reason)
+	}
+	return d
+}
+
+// eventKey identifies an Event by its involved object, type, reason and message.
+func eventKey(object runtime.Object, eventtype, reason, message string) string {
+	var id string
+	if accessor, err := meta.Accessor(object); err == nil {
+		id = string(accessor.GetUID())
+		if id == "" {
+			id = accessor.GetNamespace() + "/" + accessor.GetName()
+		}
+	}
+	return strings.Join([]string{id, eventtype, reason, message}, "|")
+}
+
+// summarySuffix renders the suppression summary appended to an emitted message.
+func summarySuffix(d Decision) string {
+	if !d.Summarized() {
+		return ""
+	}
+	return fmt.Sprintf(" (occurred %d times since %s, %d suppressed)",
+		d.Count, d.Since.UTC().Format(time.RFC3339), d.Suppressed)
+}
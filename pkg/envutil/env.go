@@ -38,6 +38,16 @@ func GetInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// GetFloat64 returns the environment variable value as float64 if set and valid, otherwise returns the default value.
+func GetFloat64(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
 // GetDuration returns the environment variable value as time.Duration if set and valid, otherwise returns the default value.
 func GetDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
@@ -210,6 +210,63 @@ func TestGetInt(t *testing.T) {
 	}
 }
 
+func TestGetFloat64(t *testing.T) {
+	tests := []struct {
+		name         string
+		envKey       string
+		envValue     string
+		defaultValue float64
+		expected     float64
+	}{
+		{
+			name:         "returns env value when valid float",
+			envKey:       "TEST_FLOAT_VALID",
+			envValue:     "0.25",
+			defaultValue: 1,
+			expected:     0.25,
+		},
+		{
+			name:         "returns env value when int",
+			envKey:       "TEST_FLOAT_INT",
+			envValue:     "1",
+			defaultValue: 0.5,
+			expected:     1,
+		},
+		{
+			name:         "returns default when env is invalid float",
+			envKey:       "TEST_FLOAT_INVALID",
+			envValue:     "not-a-number",
+			defaultValue: 0.5,
+			expected:     0.5,
+		},
+		{
+			name:         "returns default when env is not set",
+			envKey:       "TEST_FLOAT_UNSET",
+			envValue:     "",
+			defaultValue: 0.5,
+			expected:     0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			if tt.envValue != "" {
+				os.Setenv(tt.envKey, tt.envValue)
+				defer os.Unsetenv(tt.envKey)
+			}
+
+			// Execute
+			result := GetFloat64(tt.envKey, tt.defaultValue)
+
+			// Assert
+			if result != tt.expected {
+				t.Errorf("GetFloat64() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestGetDuration(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -54,6 +54,22 @@ type EC2Parameters struct {
 
 	// AwaitCompletion configures whether to wait for EC2 instances to reach the desired state.
 	AwaitCompletion AwaitCompletion `json:"awaitCompletion"`
+
+	// Hibernate stops instances configured for hibernation with Hibernate=true,
+	// preserving their RAM across the stop. Other instances are stopped normally.
+	Hibernate bool `json:"hibernate,omitempty"`
+
+	// ReleaseElasticIPs releases the Elastic IPs associated with the stopped
+	// instances, which are billed while the instances are stopped. At wakeup the
+	// same addresses are recovered when still available, or new ones allocated,
+	// and associated back with the instances' network interfaces.
+	ReleaseElasticIPs bool `json:"releaseElasticIps,omitempty"`
+
+	// SnapshotUnattachedVolumes snapshots and deletes the unattached EBS volumes
+	// matching selector.tags or selector.tagSelector, and recreates them from
+	// their snapshots at wakeup. The snapshots are kept after the volumes are
+	// recreated. Requires a tag-based selector.
+	SnapshotUnattachedVolumes bool `json:"snapshotUnattachedVolumes,omitempty"`
 }
 
 // EC2Selector defines how to find EC2 instances.
@@ -62,9 +78,11 @@ type EC2Parameters struct {
 //   - Tags: server-side filter via AWS DescribeInstances Filters
 //   - InstanceIDs: server-side filter via explicit InstanceIds
 //
-// CLIENT-SIDE FILTER:
+// CLIENT-SIDE FILTERS:
 //   - TagSelector: applied AFTER instances are fetched. Can be used alone or combined
 //     with InstanceIDs, but is mutually exclusive with Tags.
+//   - NamePattern: applied AFTER instances are fetched. Can be used alone or combined
+//     with any other method.
 //
 // At least one selection method must be specified.
 type EC2Selector struct {
@@ -82,6 +100,10 @@ type EC2Selector struct {
 	// Applied server-side via DescribeInstances InstanceIds.
 	// Mutually exclusive with Tags (both are server-side filters).
 	InstanceIDs []string `json:"instanceIds,omitempty"`
+
+	// NamePattern is a regular expression (RE2 syntax) the whole Name tag of an
+	// instance must match. Applied client-side after instances are fetched.
+	NamePattern string `json:"namePattern,omitempty"`
 }
 
 // RDSParameters defines the expected parameters for the RDS executor.
@@ -94,13 +116,38 @@ type RDSParameters struct {
 
 	// AwaitCompletion configures whether to wait for RDS resources to reach the desired state.
 	AwaitCompletion AwaitCompletion `json:"awaitCompletion"`
+
+	// ClusterMode selects how DB clusters are hibernated: "stop" (default) stops them,
+	// "scaleCapacity" keeps Aurora Serverless v2 clusters running and scales their
+	// capacity range down to CapacityFloor instead. Use it for clusters that cannot be
+	// stopped, such as clusters with cross-region replicas.
+	ClusterMode string `json:"clusterMode,omitempty"`
+
+	// CapacityFloor is the Serverless v2 capacity range applied by the "scaleCapacity"
+	// cluster mode. Defaults to 0.5-1 ACUs.
+	CapacityFloor *RDSCapacityRange `json:"capacityFloor,omitempty"`
+}
+
+// RDS cluster modes.
+const (
+	RDSClusterModeStop          = "stop"
+	RDSClusterModeScaleCapacity = "scaleCapacity"
+)
+
+// RDSCapacityRange is an Aurora Serverless v2 capacity range in ACUs.
+type RDSCapacityRange struct {
+	// MinCapacity is the minimum capacity, 0 lets the cluster auto-pause.
+	MinCapacity float64 `json:"minCapacity"`
+
+	// MaxCapacity is the maximum capacity, at least 1.
+	MaxCapacity float64 `json:"maxCapacity"`
 }
 
 // RDSSelector defines how to find RDS instances and clusters.
 //
 // MUTUAL EXCLUSIVITY RULES:
 // Only ONE of the following selection methods can be used:
-//  1. Tag-based selection: `tags` OR `excludeTags` OR `tagSelector`
+//  1. Tag-based selection: `tags` OR `excludeTags` OR `tagSelector`, optionally narrowed by `namePattern`
 //  2. Explicit IDs: `instanceIds` and/or `clusterIds` (intent-based, discovers exactly what you specify)
 //  3. Discovery mode: `includeAll`
 //
@@ -110,7 +157,7 @@ type RDSParameters struct {
 //   - If `clusterIds` specified → discovers clusters
 //   - If both specified → discovers both
 //
-// For dynamic discovery (`tags`/`excludeTags`/`tagSelector`/`includeAll`), `discoverInstances` and `discoverClusters`
+// For dynamic discovery (`tags`/`excludeTags`/`tagSelector`/`namePattern`/`includeAll`), `discoverInstances` and `discoverClusters`
 // must be explicitly enabled (opt-out by default):
 //   - Neither set: no resources discovered (no-op)
 //   - `discoverInstances`: true only: discovers only DB instances
@@ -121,6 +168,7 @@ type RDSParameters struct {
 //   - `{tags: {"env": "prod"}, discoverInstances: true}` — tag-based, discovers only DB instances
 //   - `{excludeTags: {"critical": "true"}, discoverClusters: true}` — exclusion-based, discovers only DB clusters
 //   - `{tagSelector: {matchTags: {"env": "prod"}}, discoverInstances: true}` — expression-based
+//   - `{namePattern: "staging-.*", discoverInstances: true}` — name-based, matches instance identifiers
 //   - `{instanceIds: ["db-1", "db-2"], clusterIds: ["cluster-1"]}` — explicit IDs; resource types inferred from which IDs are provided
 //   - `{includeAll: true, discoverInstances: true, discoverClusters: true}` — discovers all instances and clusters in the region
 //
@@ -148,6 +196,11 @@ type RDSSelector struct {
 	// Mutually exclusive with Tags and ExcludeTags.
 	TagSelector *awsutil.TagSelector `json:"tagSelector,omitempty"`
 
+	// NamePattern is a regular expression (RE2 syntax) the whole DB instance or
+	// cluster identifier must match. Can be combined with Tags, ExcludeTags or
+	// TagSelector; mutually exclusive with InstanceIDs, ClusterIDs, IncludeAll.
+	NamePattern string `json:"namePattern,omitempty"`
+
 	// Explicit DB instance IDs to target.
 	// Can be combined with ClusterIDs, but mutually exclusive with tag-based selection or IncludeAll.
 	InstanceIds []string `json:"instanceIds,omitempty"`
@@ -161,12 +214,12 @@ type RDSSelector struct {
 	IncludeAll bool `json:"includeAll,omitempty"`
 
 	// DiscoverInstances controls whether to discover DB instances for dynamic selection methods.
-	// Only used with `tags`, `excludeTags`, `tagSelector`, or `includeAll` (ignored for explicit `instanceIds`/`clusterIds`).
+	// Only used with `tags`, `excludeTags`, `tagSelector`, `namePattern`, or `includeAll` (ignored for explicit `instanceIds`/`clusterIds`).
 	// Must be explicitly set to true to discover instances. Default: false (opt-out, no-op).
 	DiscoverInstances bool `json:"discoverInstances,omitempty"`
 
 	// DiscoverClusters controls whether to discover DB clusters for dynamic selection methods.
-	// Only used with `tags`, `excludeTags`, `tagSelector`, or `includeAll` (ignored for explicit `instanceIds`/`clusterIds`).
+	// Only used with `tags`, `excludeTags`, `tagSelector`, `namePattern`, or `includeAll` (ignored for explicit `instanceIds`/`clusterIds`).
 	// Must be explicitly set to true to discover clusters. Default: false (opt-out, no-op).
 	DiscoverClusters bool `json:"discoverClusters,omitempty"`
 }
@@ -190,6 +243,13 @@ type EKSNodeGroup struct {
 	Name string `json:"name"`
 }
 
+// EKSPreWakeParameters defines the pre-wake hook parameters for the EKS executor.
+type EKSPreWakeParameters struct {
+	// WarmNodes is the number of nodes started in each restored node group ahead
+	// of the wakeup, capped at the node group's restored desired size. Default: 1.
+	WarmNodes *int32 `json:"warmNodes,omitempty"`
+}
+
 // KarpenterParameters defines the expected parameters for the Karpenter executor.
 type KarpenterParameters struct {
 	// NodePools is a list of Karpenter NodePool names to hibernate.
@@ -203,17 +263,55 @@ type KarpenterParameters struct {
 
 	// AwaitCompletion configures whether to wait for node pools to drain.
 	AwaitCompletion AwaitCompletion `json:"awaitCompletion"`
+
+	// Drain configures cordoning and draining the nodes of each NodePool before
+	// it is deleted.
+	Drain KarpenterDrain `json:"drain"`
+}
+
+// KarpenterDrain configures how the Karpenter executor drains the nodes of a
+// NodePool before deleting it. While the nodes drain, the NodePool limits are
+// zeroed so Karpenter does not provision replacement nodes for the evicted pods.
+type KarpenterDrain struct {
+	// Enabled cordons the nodes and evicts their pods before the NodePool is
+	// deleted, instead of leaving the eviction to Karpenter's node termination.
+	// Default: false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// GracePeriod overrides the termination grace period of the evicted pods.
+	// Format: duration string (e.g., "30s", "2m")
+	// Empty string uses each pod's own terminationGracePeriodSeconds.
+	GracePeriod string `json:"gracePeriod,omitempty"`
+
+	// Timeout is the maximum duration to wait for the pods to be evicted.
+	// Evictions blocked by PodDisruptionBudgets are retried until then; pods
+	// still running afterwards are left to Karpenter's node termination.
+	// Format: duration string (e.g., "5m")
+	// Empty string defaults to 5m.
+	Timeout string `json:"timeout,omitempty"`
 }
 
 // GKEParameters defines the expected parameters for the GKE executor.
 type GKEParameters struct {
 	// NodePools is a list of GKE node pool names to hibernate.
 	NodePools []string `json:"nodePools"`
+
+	// NodeAutoProvisioning disables node auto-provisioning of the cluster at
+	// shutdown, so GKE creates no node pools while it is hibernated, and
+	// restores its resource limits (minimum and maximum) at wakeup.
+	// Default: false
+	NodeAutoProvisioning bool `json:"nodeAutoProvisioning,omitempty"`
+
+	// Autopilot hibernates an Autopilot cluster, whose nodes are managed by GKE,
+	// by scaling its workloads to zero with the workloadscaler semantics.
+	// Mutually exclusive with NodePools and NodeAutoProvisioning.
+	Autopilot *WorkloadScalerParameters `json:"autopilot,omitempty"`
 }
 
 // CloudSQLParameters defines the expected parameters for the Cloud SQL executor.
 type CloudSQLParameters struct {
-	// InstanceName is the Cloud SQL instance name.
+	// InstanceName is the Cloud SQL instance name. It must be a primary
+	// instance; its replicas are hibernated along with it.
 	InstanceName string `json:"instanceName"`
 
 	// Project is the GCP project ID containing the instance.
@@ -259,3 +357,27 @@ type NoOpParameters struct {
 	// If empty, a default message will be used.
 	FailureMessage string `json:"failureMessage,omitempty"`
 }
+
+// DelayParameters defines the expected parameters for the delay executor.
+type DelayParameters struct {
+	// Duration is how long each operation sleeps, e.g. "30s" or "2m".
+	// Maximum allowed is 1h. Defaults to "5s".
+	Duration string `json:"duration,omitempty"`
+
+	// FailureProbability is the chance, between 0 and 1, that an operation fails
+	// after sleeping. Defaults to 0 (never fail).
+	FailureProbability float64 `json:"failureProbability,omitempty"`
+
+	// FailOn limits failure injection to an operation. Valid values: "shutdown", "wakeup", "both".
+	// Defaults to "both".
+	FailOn string `json:"failOn,omitempty"`
+
+	// FailureMessage allows customizing the error message for injected failures.
+	// If empty, a default message will be used.
+	FailureMessage string `json:"failureMessage,omitempty"`
+
+	// RestorePayloadBytes is the size of the filler payload written as restore
+	// data on shutdown, used to rehearse restore storage limits.
+	// Maximum allowed is 524288 (512KiB). Defaults to 0.
+	RestorePayloadBytes int `json:"restorePayloadBytes,omitempty"`
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executorparams
+
+import (
+	"encoding/json"
+)
+
+// preWakeRegistry holds the pre-wake hook validators of executors that
+// support a pre-wake action.
+var preWakeRegistry = make(map[string]validatorEntry)
+
+// RegisterPreWake adds a pre-wake parameter validator for an executor type,
+// marking the type as supporting pre-wake hooks.
+func RegisterPreWake(executorType string, knownFields []string, validator ParamValidator) {
+	preWakeRegistry[executorType] = validatorEntry{
+		validator:   validator,
+		knownFields: knownFields,
+	}
+}
+
+// SupportsPreWake returns true if the executor type implements a pre-wake action.
+func SupportsPreWake(executorType string) bool {
+	_, ok := preWakeRegistry[executorType]
+	return ok
+}
+
+// ValidatePreWakeParams validates pre-wake hook parameters for a given executor type.
+// Returns nil if the executor type does not support pre-wake hooks.
+func ValidatePreWakeParams(executorType string, params []byte) *Result {
+	entry, ok := preWakeRegistry[executorType]
+	if !ok {
+		return nil
+	}
+
+	result := &Result{}
+	if len(entry.knownFields) > 0 && len(params) > 0 {
+		result.Warnings = append(result.Warnings, checkUnknownFields(params, entry.knownFields, executorType+" preWake")...)
+	}
+	if entry.validator != nil {
+		result.Merge(entry.validator(params))
+	}
+	return result
+}
+
+func init() {
+	RegisterPreWake("eks", []string{"warmNodes"}, validateEKSPreWakeParams)
+}
+
+// validateEKSPreWakeParams validates EKS pre-wake hook parameters.
+func validateEKSPreWakeParams(params []byte) *Result {
+	result := &Result{}
+	if len(params) == 0 {
+		return result
+	}
+
+	var p EKSPreWakeParameters
+	if err := json.Unmarshal(params, &p); err != nil {
+		result.AddError("invalid JSON format: %v", err)
+		return result
+	}
+
+	if p.WarmNodes != nil && *p.WarmNodes < 1 {
+		result.AddError("warmNodes must be at least 1, got %d", *p.WarmNodes)
+	}
+	return result
+}
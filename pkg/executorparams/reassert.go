@@ -0,0 +1,28 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package executorparams
+
+// reassertRegistry holds the executor types that can reassert the hibernation
+// of their resources.
+var reassertRegistry = make(map[string]struct{})
+
+// RegisterReassert marks an executor type as supporting reassertion: stopping
+// again the resources of a hibernated target that came back up by themselves,
+// without touching their restore data.
+func RegisterReassert(executorType string) {
+	reassertRegistry[executorType] = struct{}{}
+}
+
+// SupportsReassert returns true if the executor type implements reassertion.
+func SupportsReassert(executorType string) bool {
+	_, ok := reassertRegistry[executorType]
+	return ok
+}
+
+func init() {
+	// AWS starts stopped RDS instances and clusters after seven days.
+	RegisterReassert("rds")
+}
@@ -8,6 +8,7 @@ package executorparams
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -147,25 +148,28 @@ func checkUnknownFields(params []byte, knownFields []string, executorType string
 // init registers all built-in executor validators.
 func init() {
 	// EC2 validator
-	Register("ec2", []string{"selector", "awaitCompletion"}, validateEC2Params)
+	Register("ec2", []string{"selector", "awaitCompletion", "hibernate", "releaseElasticIps", "snapshotUnattachedVolumes"}, validateEC2Params)
 
 	// RDS validator
-	Register("rds", []string{"selector", "snapshotBeforeStop", "awaitCompletion"}, validateRDSParams)
+	Register("rds", []string{"selector", "snapshotBeforeStop", "awaitCompletion", "clusterMode", "capacityFloor"}, validateRDSParams)
 
 	// EKS validator (only handles Managed Node Groups via AWS API)
 	Register("eks", []string{"clusterName", "nodeGroups", "awaitCompletion"}, validateEKSParams)
 
 	// Karpenter validator
-	Register("karpenter", []string{"nodePools", "awaitCompletion"}, validateKarpenterParams)
+	Register("karpenter", []string{"nodePools", "nodeSelector", "awaitCompletion", "drain"}, validateKarpenterParams)
 
 	// GKE validator
-	Register("gke", []string{"nodePools"}, validateGKEParams)
+	Register("gke", []string{"nodePools", "nodeAutoProvisioning", "autopilot"}, validateGKEParams)
 
 	// CloudSQL validator
 	Register("cloudsql", []string{"instanceName", "project"}, validateCloudSQLParams)
 
 	// WorkloadScaler validator
 	Register("workloadscaler", []string{"includedGroups", "namespace", "workloadSelector", "awaitCompletion"}, validateWorkloadScalerParams)
+
+	// Delay validator
+	Register("delay", []string{"duration", "failureProbability", "failOn", "failureMessage", "restorePayloadBytes"}, validateDelayParams)
 }
 
 // validateEC2Params validates EC2 executor parameters.
@@ -173,7 +177,7 @@ func validateEC2Params(params []byte) *Result {
 	result := &Result{}
 
 	if len(params) == 0 {
-		result.AddError("parameters required: either selector.tags, selector.tagSelector, or selector.instanceIds must be specified")
+		result.AddError("parameters required: either selector.tags, selector.tagSelector, selector.namePattern, or selector.instanceIds must be specified")
 		return result
 	}
 
@@ -187,9 +191,10 @@ func validateEC2Params(params []byte) *Result {
 	hasTagSelector := p.Selector.TagSelector != nil && (len(p.Selector.TagSelector.MatchTags) > 0 || len(p.Selector.TagSelector.MatchExpressions) > 0)
 	hasTags := len(p.Selector.Tags) > 0
 	hasInstanceIDs := len(p.Selector.InstanceIDs) > 0
+	hasNamePattern := p.Selector.NamePattern != ""
 
-	if !hasTags && !hasTagSelector && !hasInstanceIDs {
-		result.AddError("either selector.tags, selector.tagSelector, or selector.instanceIds must be specified")
+	if !hasTags && !hasTagSelector && !hasInstanceIDs && !hasNamePattern {
+		result.AddError("either selector.tags, selector.tagSelector, selector.namePattern, or selector.instanceIds must be specified")
 	}
 
 	// Tags and InstanceIDs are mutually exclusive (both are server-side filters)
@@ -202,6 +207,11 @@ func validateEC2Params(params []byte) *Result {
 		result.AddError("selector.tags and selector.tagSelector are mutually exclusive")
 	}
 
+	// Unattached volumes are only selectable by tags
+	if p.SnapshotUnattachedVolumes && !hasTags && !hasTagSelector {
+		result.AddError("snapshotUnattachedVolumes requires selector.tags or selector.tagSelector")
+	}
+
 	// Validate TagSelector structure if present
 	if p.Selector.TagSelector != nil {
 		if errs := awsutil.ValidateTagSelector(p.Selector.TagSelector, field.NewPath("selector").Child("tagSelector")); len(errs) > 0 {
@@ -211,6 +221,10 @@ func validateEC2Params(params []byte) *Result {
 		}
 	}
 
+	for _, err := range awsutil.ValidateNamePattern(p.Selector.NamePattern, field.NewPath("selector").Child("namePattern")) {
+		result.AddError("%s", err)
+	}
+
 	// Validate AwaitCompletion timeout format if waiting is enabled
 	if p.AwaitCompletion.Enabled && p.AwaitCompletion.Timeout != "" {
 		if err := validateWaitTimeout(p.AwaitCompletion.Timeout); err != nil {
@@ -237,22 +251,24 @@ func validateRDSParams(params []byte) *Result {
 	}
 
 	hasTagSelector := p.Selector.TagSelector != nil && (len(p.Selector.TagSelector.MatchTags) > 0 || len(p.Selector.TagSelector.MatchExpressions) > 0)
+	hasNamePattern := p.Selector.NamePattern != ""
 
 	// Validate selector - at least one selection method required
 	hasSelection := len(p.Selector.Tags) > 0 ||
 		len(p.Selector.ExcludeTags) > 0 ||
 		hasTagSelector ||
+		hasNamePattern ||
 		len(p.Selector.InstanceIds) > 0 ||
 		len(p.Selector.ClusterIds) > 0 ||
 		p.Selector.IncludeAll
 
 	if !hasSelection {
-		result.AddError("selector must specify at least one of: tags, excludeTags, tagSelector, instanceIds, clusterIds, or includeAll")
+		result.AddError("selector must specify at least one of: tags, excludeTags, tagSelector, namePattern, instanceIds, clusterIds, or includeAll")
 	}
 
 	// Count selection methods used
 	methodCount := 0
-	if len(p.Selector.Tags) > 0 || len(p.Selector.ExcludeTags) > 0 || hasTagSelector {
+	if len(p.Selector.Tags) > 0 || len(p.Selector.ExcludeTags) > 0 || hasTagSelector || hasNamePattern {
 		methodCount++
 	}
 	if len(p.Selector.InstanceIds) > 0 || len(p.Selector.ClusterIds) > 0 {
@@ -264,7 +280,7 @@ func validateRDSParams(params []byte) *Result {
 
 	// Only one selection method allowed
 	if methodCount > 1 {
-		result.AddError("selector must use only one method: either (tags/excludeTags/tagSelector), (instanceIds/clusterIds), or includeAll")
+		result.AddError("selector must use only one method: either (tags/excludeTags/tagSelector/namePattern), (instanceIds/clusterIds), or includeAll")
 	}
 
 	// Tags and ExcludeTags are mutually exclusive
@@ -286,8 +302,12 @@ func validateRDSParams(params []byte) *Result {
 		}
 	}
 
+	for _, err := range awsutil.ValidateNamePattern(p.Selector.NamePattern, field.NewPath("selector").Child("namePattern")) {
+		result.AddError("%s", err)
+	}
+
 	// Validate DiscoverInstances and DiscoverClusters are only used with dynamic discovery
-	isDynamicDiscovery := len(p.Selector.Tags) > 0 || len(p.Selector.ExcludeTags) > 0 || hasTagSelector || p.Selector.IncludeAll
+	isDynamicDiscovery := len(p.Selector.Tags) > 0 || len(p.Selector.ExcludeTags) > 0 || hasTagSelector || hasNamePattern || p.Selector.IncludeAll
 	isIntentBased := len(p.Selector.InstanceIds) > 0 || len(p.Selector.ClusterIds) > 0
 
 	if isIntentBased && (p.Selector.DiscoverInstances || p.Selector.DiscoverClusters) {
@@ -297,7 +317,7 @@ func validateRDSParams(params []byte) *Result {
 	// For dynamic discovery, at least one resource type must be explicitly enabled (opt-out)
 	if isDynamicDiscovery {
 		if !p.Selector.DiscoverInstances && !p.Selector.DiscoverClusters {
-			result.AddError("at least one of discoverInstances or discoverClusters must be explicitly set to true for dynamic discovery (tags/excludeTags/tagSelector/includeAll)")
+			result.AddError("at least one of discoverInstances or discoverClusters must be explicitly set to true for dynamic discovery (tags/excludeTags/tagSelector/namePattern/includeAll)")
 		}
 	}
 
@@ -308,6 +328,36 @@ func validateRDSParams(params []byte) *Result {
 		}
 	}
 
+	switch p.ClusterMode {
+	case "", RDSClusterModeStop:
+		if p.CapacityFloor != nil {
+			result.AddWarning("capacityFloor is ignored unless clusterMode is %q", RDSClusterModeScaleCapacity)
+		}
+	case RDSClusterModeScaleCapacity:
+		if p.SnapshotBeforeStop {
+			result.AddWarning("snapshotBeforeStop does not apply to clusters scaled by the %q cluster mode", RDSClusterModeScaleCapacity)
+		}
+		if f := p.CapacityFloor; f != nil {
+			// Aurora Serverless v2 accepts 0-256 ACUs in 0.5 steps, with a maximum of at least 1.
+			for _, acu := range []struct {
+				name  string
+				value float64
+			}{{"minCapacity", f.MinCapacity}, {"maxCapacity", f.MaxCapacity}} {
+				if acu.value < 0 || acu.value > 256 || math.Mod(acu.value*2, 1) != 0 {
+					result.AddError("capacityFloor.%s must be between 0 and 256 ACUs in steps of 0.5, got %v", acu.name, acu.value)
+				}
+			}
+			if f.MaxCapacity < 1 {
+				result.AddError("capacityFloor.maxCapacity must be at least 1 ACU")
+			}
+			if f.MinCapacity > f.MaxCapacity {
+				result.AddError("capacityFloor.minCapacity must not exceed capacityFloor.maxCapacity")
+			}
+		}
+	default:
+		result.AddError("clusterMode must be %q or %q, got %q", RDSClusterModeStop, RDSClusterModeScaleCapacity, p.ClusterMode)
+	}
+
 	return result
 }
 
@@ -380,6 +430,16 @@ func validateKarpenterParams(params []byte) *Result {
 		}
 	}
 
+	// Validate drain durations if draining is enabled
+	if p.Drain.Enabled {
+		if d, err := time.ParseDuration(p.Drain.GracePeriod); p.Drain.GracePeriod != "" && (err != nil || d < 0) {
+			result.AddError("drain.gracePeriod must be a non-negative duration, got %q", p.Drain.GracePeriod)
+		}
+		if err := validateWaitTimeout(p.Drain.Timeout); err != nil {
+			result.AddError("drain.timeout has invalid duration format: %v", err)
+		}
+	}
+
 	return result
 }
 
@@ -398,7 +458,20 @@ func validateGKEParams(params []byte) *Result {
 		return result
 	}
 
-	if len(p.NodePools) == 0 {
+	if p.Autopilot != nil {
+		// Autopilot clusters have neither node pools nor auto-provisioning to manage
+		if len(p.NodePools) > 0 || p.NodeAutoProvisioning {
+			result.AddError("autopilot is mutually exclusive with nodePools and nodeAutoProvisioning")
+		}
+
+		autopilot, _ := json.Marshal(p.Autopilot)
+		for _, err := range validateWorkloadScalerParams(autopilot).Errors {
+			result.AddError("autopilot: %s", err)
+		}
+		return result
+	}
+
+	if len(p.NodePools) == 0 && !p.NodeAutoProvisioning {
 		result.AddError("nodePools must be specified and non-empty")
 	}
 
@@ -490,6 +563,58 @@ func validateLabelSelector(ls *metav1.LabelSelector) error {
 	return nil
 }
 
+const (
+	// MaxDelayDuration is the longest sleep the delay executor accepts.
+	MaxDelayDuration = time.Hour
+
+	// MaxDelayRestorePayloadBytes is the largest fake restore payload the delay
+	// executor writes. Restore data of all targets shares a 1MiB ConfigMap.
+	MaxDelayRestorePayloadBytes = 512 * 1024
+)
+
+// validateDelayParams validates delay executor parameters.
+func validateDelayParams(params []byte) *Result {
+	result := &Result{}
+
+	if len(params) == 0 {
+		return result
+	}
+
+	var p DelayParameters
+	if err := json.Unmarshal(params, &p); err != nil {
+		result.AddError("invalid JSON format: %v", err)
+		return result
+	}
+
+	if p.Duration != "" {
+		d, err := time.ParseDuration(p.Duration)
+		switch {
+		case err != nil:
+			result.AddError("duration has invalid duration format: %v", err)
+		case d < 0 || d > MaxDelayDuration:
+			result.AddError("duration must be between 0s and %s, got %s", MaxDelayDuration, p.Duration)
+		}
+	}
+
+	if p.FailureProbability < 0 || p.FailureProbability > 1 {
+		result.AddError("failureProbability must be between 0 and 1, got %g", p.FailureProbability)
+	}
+
+	switch p.FailOn {
+	case "", "shutdown", "wakeup", "both":
+	default:
+		result.AddError("invalid failOn: %s. Valid values: shutdown, wakeup, both", p.FailOn)
+	}
+
+	if p.RestorePayloadBytes < 0 || p.RestorePayloadBytes > MaxDelayRestorePayloadBytes {
+		result.AddError("restorePayloadBytes must be between 0 and %d, got %d", MaxDelayRestorePayloadBytes, p.RestorePayloadBytes)
+	} else if p.RestorePayloadBytes > MaxDelayRestorePayloadBytes/2 {
+		result.AddWarning("restorePayloadBytes %d uses over half of the restore ConfigMap; several such targets in one plan exceed its 1MiB limit", p.RestorePayloadBytes)
+	}
+
+	return result
+}
+
 // validateWaitTimeout validates the timeout field in AwaitCompletion.
 // Empty string is valid (means no timeout). Non-empty must be parseable as duration.
 func validateWaitTimeout(timeout string) error {
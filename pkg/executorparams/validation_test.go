@@ -28,6 +28,33 @@ func TestValidateParams_EC2_Valid(t *testing.T) {
 	}
 }
 
+func TestValidateParams_EC2_Hibernate(t *testing.T) {
+	params := []byte(`{"selector": {"tags": {"Environment": "dev"}}, "hibernate": true}`)
+	result := ValidateParams("ec2", params)
+
+	if result.HasErrors() {
+		t.Errorf("expected no errors, got: %v", result.Errors)
+	}
+	if len(result.Warnings) > 0 {
+		t.Errorf("expected no warnings, got: %v", result.Warnings)
+	}
+}
+
+func TestValidateParams_EC2_SnapshotUnattachedVolumesRequiresTags(t *testing.T) {
+	params := []byte(`{"selector": {"instanceIds": ["i-123"]}, "releaseElasticIps": true, "snapshotUnattachedVolumes": true}`)
+	result := ValidateParams("ec2", params)
+
+	if !result.HasErrors() {
+		t.Fatal("expected error for snapshotUnattachedVolumes without a tag selector")
+	}
+	if result.Errors[0] != "snapshotUnattachedVolumes requires selector.tags or selector.tagSelector" {
+		t.Errorf("unexpected error: %v", result.Errors)
+	}
+	if len(result.Warnings) > 0 {
+		t.Errorf("expected no warnings, got: %v", result.Warnings)
+	}
+}
+
 func TestValidateParams_EC2_MissingSelector(t *testing.T) {
 	params := []byte(`{}`)
 	result := ValidateParams("ec2", params)
@@ -102,6 +129,56 @@ func TestValidateParams_RDS_MissingBoth(t *testing.T) {
 	}
 }
 
+func TestValidateParams_RDS_ClusterMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    string
+		wantError string
+	}{
+		{name: "stop", params: `{"selector": {"clusterIds": ["db"]}, "clusterMode": "stop"}`},
+		{name: "scale capacity with default floor", params: `{"selector": {"clusterIds": ["db"]}, "clusterMode": "scaleCapacity"}`},
+		{
+			name:   "scale capacity to zero",
+			params: `{"selector": {"clusterIds": ["db"]}, "clusterMode": "scaleCapacity", "capacityFloor": {"minCapacity": 0, "maxCapacity": 1}}`,
+		},
+		{
+			name:      "unknown mode",
+			params:    `{"selector": {"clusterIds": ["db"]}, "clusterMode": "pause"}`,
+			wantError: `clusterMode must be "stop" or "scaleCapacity", got "pause"`,
+		},
+		{
+			name:      "capacity off the 0.5 step",
+			params:    `{"selector": {"clusterIds": ["db"]}, "clusterMode": "scaleCapacity", "capacityFloor": {"minCapacity": 0.25, "maxCapacity": 1}}`,
+			wantError: "capacityFloor.minCapacity must be between 0 and 256 ACUs in steps of 0.5, got 0.25",
+		},
+		{
+			name:      "maximum below one",
+			params:    `{"selector": {"clusterIds": ["db"]}, "clusterMode": "scaleCapacity", "capacityFloor": {"minCapacity": 0, "maxCapacity": 0.5}}`,
+			wantError: "capacityFloor.maxCapacity must be at least 1 ACU",
+		},
+		{
+			name:      "minimum above maximum",
+			params:    `{"selector": {"clusterIds": ["db"]}, "clusterMode": "scaleCapacity", "capacityFloor": {"minCapacity": 4, "maxCapacity": 2}}`,
+			wantError: "capacityFloor.minCapacity must not exceed capacityFloor.maxCapacity",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidateParams("rds", []byte(tt.params))
+			if tt.wantError == "" {
+				if result.HasErrors() || len(result.Warnings) > 0 {
+					t.Errorf("expected no errors or warnings, got: %v %v", result.Errors, result.Warnings)
+				}
+				return
+			}
+			if len(result.Errors) != 1 || result.Errors[0] != tt.wantError {
+				t.Errorf("expected error %q, got: %v", tt.wantError, result.Errors)
+			}
+		})
+	}
+}
+
 func TestValidateParams_EKS_EmptyParams(t *testing.T) {
 	// EKS requires clusterName
 	result := ValidateParams("eks", nil)
@@ -187,6 +264,24 @@ func TestValidateParams_Karpenter_MissingNodePools(t *testing.T) {
 	}
 }
 
+func TestValidateParams_Karpenter_Drain(t *testing.T) {
+	params := []byte(`{"nodeSelector": {"matchLabels": {"team": "a"}}, "drain": {"enabled": true, "gracePeriod": "30s", "timeout": "10m"}}`)
+	result := ValidateParams("karpenter", params)
+
+	if result.HasErrors() {
+		t.Errorf("expected no errors, got: %v", result.Errors)
+	}
+	if len(result.Warnings) > 0 {
+		t.Errorf("expected no warnings, got: %v", result.Warnings)
+	}
+
+	params = []byte(`{"drain": {"enabled": true, "gracePeriod": "-1s", "timeout": "soon"}}`)
+	result = ValidateParams("karpenter", params)
+	if len(result.Errors) != 2 {
+		t.Errorf("expected errors for gracePeriod and timeout, got: %v", result.Errors)
+	}
+}
+
 func TestValidateParams_GKE_Valid(t *testing.T) {
 	params := []byte(`{"nodePools": ["default-pool"]}`)
 	result := ValidateParams("gke", params)
@@ -199,6 +294,43 @@ func TestValidateParams_GKE_Valid(t *testing.T) {
 	}
 }
 
+func TestValidateParams_GKE_AutopilotAndNodeAutoProvisioning(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    string
+		wantError string
+	}{
+		{name: "autoprovisioning only", params: `{"nodeAutoProvisioning": true}`},
+		{name: "autopilot", params: `{"autopilot": {"namespace": {"literals": ["apps"]}}}`},
+		{
+			name:      "autopilot with node pools",
+			params:    `{"nodePools": ["default-pool"], "autopilot": {"namespace": {"literals": ["apps"]}}}`,
+			wantError: "autopilot is mutually exclusive with nodePools and nodeAutoProvisioning",
+		},
+		{
+			name:      "autopilot without namespace",
+			params:    `{"autopilot": {}}`,
+			wantError: "autopilot: namespace must specify either literals or selector",
+		},
+		{name: "nothing to hibernate", params: `{}`, wantError: "nodePools must be specified and non-empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidateParams("gke", []byte(tt.params))
+			if tt.wantError == "" {
+				if result.HasErrors() || len(result.Warnings) > 0 {
+					t.Errorf("expected no errors or warnings, got: %v %v", result.Errors, result.Warnings)
+				}
+				return
+			}
+			if len(result.Errors) != 1 || result.Errors[0] != tt.wantError {
+				t.Errorf("expected error %q, got: %v", tt.wantError, result.Errors)
+			}
+		})
+	}
+}
+
 func TestValidateParams_CloudSQL_Valid(t *testing.T) {
 	params := []byte(`{"instanceName": "my-db", "project": "my-project"}`)
 	result := ValidateParams("cloudsql", params)
@@ -235,6 +367,65 @@ func TestValidateParams_CloudSQL_MissingProject(t *testing.T) {
 	}
 }
 
+func TestValidateParams_Delay_EmptyParams(t *testing.T) {
+	result := ValidateParams("delay", nil)
+
+	if result == nil {
+		t.Fatal("expected non-nil result")
+	}
+	if result.HasErrors() {
+		t.Errorf("expected no errors, got: %v", result.Errors)
+	}
+}
+
+func TestValidateParams_Delay_Valid(t *testing.T) {
+	params := []byte(`{"duration": "2m", "failureProbability": 0.25, "failOn": "wakeup", "restorePayloadBytes": 4096}`)
+	result := ValidateParams("delay", params)
+
+	if result == nil {
+		t.Fatal("expected non-nil result")
+	}
+	if result.HasErrors() {
+		t.Errorf("expected no errors, got: %v", result.Errors)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("expected no warnings, got: %v", result.Warnings)
+	}
+}
+
+func TestValidateParams_Delay_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"unparseable duration":  `{"duration": "soon"}`,
+		"duration too long":     `{"duration": "2h"}`,
+		"probability above one": `{"failureProbability": 1.5}`,
+		"unknown failOn":        `{"failOn": "always"}`,
+		"payload too large":     `{"restorePayloadBytes": 1048576}`,
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := ValidateParams("delay", []byte(params))
+			if result == nil || !result.HasErrors() {
+				t.Errorf("expected errors for %s, got %+v", params, result)
+			}
+		})
+	}
+}
+
+func TestValidateParams_Delay_LargePayloadWarning(t *testing.T) {
+	result := ValidateParams("delay", []byte(`{"restorePayloadBytes": 400000}`))
+
+	if result == nil {
+		t.Fatal("expected non-nil result")
+	}
+	if result.HasErrors() {
+		t.Errorf("expected no errors, got: %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 {
+		t.Errorf("expected one warning, got: %v", result.Warnings)
+	}
+}
+
 func TestResult_Merge(t *testing.T) {
 	r1 := &Result{Errors: []string{"err1"}, Warnings: []string{"warn1"}}
 	r2 := &Result{Errors: []string{"err2"}, Warnings: []string{"warn2"}}
@@ -272,6 +463,7 @@ func TestRegisteredTypes(t *testing.T) {
 		"karpenter": false,
 		"gke":       false,
 		"cloudsql":  false,
+		"delay":     false,
 	}
 
 	for _, typ := range types {
@@ -641,6 +833,35 @@ func TestValidateParams_RDS_TagSelectorAndTags_MutualExclusivity(t *testing.T) {
 	}
 }
 
+func TestValidateParams_EC2_NamePattern(t *testing.T) {
+	result := ValidateParams("ec2", []byte(`{"selector": {"namePattern": "app-(dev|staging)-.*"}}`))
+	if result.HasErrors() {
+		t.Errorf("expected no errors, got: %v", result.Errors)
+	}
+
+	result = ValidateParams("ec2", []byte(`{"selector": {"namePattern": "app-["}}`))
+	if !result.HasErrors() {
+		t.Error("expected errors for invalid namePattern")
+	}
+}
+
+func TestValidateParams_RDS_NamePattern(t *testing.T) {
+	result := ValidateParams("rds", []byte(`{"selector": {"namePattern": "staging-.*", "excludeTags": {"critical": ""}, "discoverInstances": true}}`))
+	if result.HasErrors() {
+		t.Errorf("expected no errors, got: %v", result.Errors)
+	}
+
+	result = ValidateParams("rds", []byte(`{"selector": {"namePattern": "staging-.*", "instanceIds": ["db-1"]}}`))
+	if !result.HasErrors() {
+		t.Error("expected errors for namePattern combined with instanceIds")
+	}
+
+	result = ValidateParams("rds", []byte(`{"selector": {"namePattern": "staging-.*"}}`))
+	if !result.HasErrors() {
+		t.Error("expected errors for namePattern without discoverInstances or discoverClusters")
+	}
+}
+
 func TestValidateParams_Karpenter_NodeSelector_Valid(t *testing.T) {
 	params := []byte(`{"nodeSelector": {"matchLabels": {"hibernator.ardikabs.com/enabled": "true"}}}`)
 	result := ValidateParams("karpenter", params)
@@ -664,3 +885,37 @@ func TestValidateParams_Karpenter_NodeSelectorAndNodePools_MutualExclusivity(t *
 		t.Error("expected errors for mutually exclusive nodePools and nodeSelector")
 	}
 }
+
+func TestSupportsReassert(t *testing.T) {
+	if !SupportsReassert("rds") {
+		t.Error("expected rds to support reassertion")
+	}
+	if SupportsReassert("eks") {
+		t.Error("expected eks not to support reassertion")
+	}
+}
+
+func TestValidatePreWakeParams(t *testing.T) {
+	if !SupportsPreWake("eks") {
+		t.Fatal("expected eks to support pre-wake hooks")
+	}
+	if SupportsPreWake("rds") {
+		t.Error("expected rds not to support pre-wake hooks")
+	}
+	if result := ValidatePreWakeParams("rds", nil); result != nil {
+		t.Errorf("expected nil result for unsupported type, got %+v", result)
+	}
+
+	if result := ValidatePreWakeParams("eks", nil); result.HasErrors() {
+		t.Errorf("empty params should be valid, got %v", result.Errors)
+	}
+	if result := ValidatePreWakeParams("eks", []byte(`{"warmNodes": 2}`)); result.HasErrors() {
+		t.Errorf("unexpected errors: %v", result.Errors)
+	}
+	if result := ValidatePreWakeParams("eks", []byte(`{"warmNodes": 0}`)); !result.HasErrors() {
+		t.Error("expected error for warmNodes=0")
+	}
+	if result := ValidatePreWakeParams("eks", []byte(`{"warm": 1}`)); len(result.Warnings) != 1 {
+		t.Errorf("expected one unknown field warning, got %v", result.Warnings)
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package gcputil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/google/externalaccount"
+
+	"github.com/ardikabs/hibernator/pkg/netutil"
+)
+
+// CloudPlatformScope grants access to all Google Cloud APIs the identity is
+// authorized for.
+const CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+const (
+	stsTokenURL          = "https://sts.googleapis.com/v1/token"
+	jwtSubjectTokenType  = "urn:ietf:params:oauth:token-type:jwt"
+	iamCredentialsURL    = "https://iamcredentials.googleapis.com/v1"
+	impersonatedLifetime = time.Hour
+)
+
+// TokenSource returns a token source for the identity described by cfg. With an
+// Audience, the Kubernetes ServiceAccount token in SubjectTokenFile is exchanged
+// through Workload Identity Federation; otherwise Application Default Credentials
+// are used. The resulting credentials then impersonate each service account of
+// ImpersonationChain in order. Token requests go through cfg.Network.
+func TokenSource(ctx context.Context, cfg *GCPConnectorConfig) (oauth2.TokenSource, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("GCP connector config is required")
+	}
+
+	if cfg.Network != nil {
+		client, err := netutil.NewHTTPClient(cfg.Network)
+		if err != nil {
+			return nil, fmt.Errorf("configure GCP transport: %w", err)
+		}
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+	}
+
+	var base oauth2.TokenSource
+	if cfg.Audience != "" {
+		if cfg.SubjectTokenFile == "" {
+			return nil, fmt.Errorf("subject token file is required for workload identity federation")
+		}
+		ts, err := externalaccount.NewTokenSource(ctx, externalaccount.Config{
+			Audience:         cfg.Audience,
+			SubjectTokenType: jwtSubjectTokenType,
+			TokenURL:         stsTokenURL,
+			CredentialSource: &externalaccount.CredentialSource{File: cfg.SubjectTokenFile},
+			Scopes:           []string{CloudPlatformScope},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create workload identity federation token source: %w", err)
+		}
+		base = ts
+	} else {
+		ts, err := google.DefaultTokenSource(ctx, CloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("find default credentials: %w", err)
+		}
+		base = ts
+	}
+
+	if len(cfg.ImpersonationChain) == 0 {
+		return base, nil
+	}
+	return impersonate(ctx, base, cfg.ImpersonationChain, iamCredentialsURL), nil
+}
+
+// HTTPClient returns an HTTP client authorized as the identity described by cfg,
+// for the Google API clients. Requests go through cfg.Network.
+func HTTPClient(ctx context.Context, cfg *GCPConnectorConfig) (*http.Client, error) {
+	ts, err := TokenSource(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Network != nil {
+		base, err := netutil.NewHTTPClient(cfg.Network)
+		if err != nil {
+			return nil, fmt.Errorf("configure GCP transport: %w", err)
+		}
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, base)
+	}
+	return oauth2.NewClient(ctx, ts), nil
+}
+
+// impersonate returns a token source for the last service account of chain,
+// reached from base through the others as delegates.
+func impersonate(ctx context.Context, base oauth2.TokenSource, chain []string, endpoint string) oauth2.TokenSource {
+	n := len(chain)
+	return oauth2.ReuseTokenSource(nil, &impersonatedTokenSource{
+		client:    oauth2.NewClient(ctx, base),
+		endpoint:  endpoint,
+		target:    chain[n-1],
+		delegates: chain[:n-1],
+	})
+}
+
+// impersonatedTokenSource creates access tokens for a service account with the
+// IAM Credentials API.
+type impersonatedTokenSource struct {
+	client    *http.Client
+	endpoint  string
+	target    string
+	delegates []string
+}
+
+type generateAccessTokenRequest struct {
+	Delegates []string `json:"delegates,omitempty"`
+	Scope     []string `json:"scope"`
+	Lifetime  string   `json:"lifetime"`
+}
+
+type generateAccessTokenResponse struct {
+	AccessToken string `json:"accessToken"`
+	ExpireTime  string `json:"expireTime"`
+}
+
+// Token implements oauth2.TokenSource.
+func (s *impersonatedTokenSource) Token() (*oauth2.Token, error) {
+	req := generateAccessTokenRequest{
+		Scope:    []string{CloudPlatformScope},
+		Lifetime: fmt.Sprintf("%ds", int(impersonatedLifetime.Seconds())),
+	}
+	for _, d := range s.delegates {
+		req.Delegates = append(req.Delegates, serviceAccountName(d))
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s:generateAccessToken", s.endpoint, serviceAccountName(s.target))
+	resp, err := s.client.Post(u, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("impersonate %s: %w", s.target, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("impersonate %s: read response: %w", s.target, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("impersonate %s: %s: %s", s.target, resp.Status, bytes.TrimSpace(data))
+	}
+
+	var out generateAccessTokenResponse
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("impersonate %s: decode response: %w", s.target, err)
+	}
+	expiry, err := time.Parse(time.RFC3339, out.ExpireTime)
+	if err != nil {
+		return nil, fmt.Errorf("impersonate %s: parse expireTime: %w", s.target, err)
+	}
+	return &oauth2.Token{AccessToken: out.AccessToken, TokenType: "Bearer", Expiry: expiry}, nil
+}
+
+// serviceAccountName returns the IAM resource name of a service account email.
+func serviceAccountName(email string) string {
+	return "projects/-/serviceAccounts/" + url.PathEscape(email)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package gcputil
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestTokenSource_RequiresConfig(t *testing.T) {
+	_, err := TokenSource(context.Background(), nil)
+	assert.ErrorContains(t, err, "GCP connector config is required")
+
+	_, err = TokenSource(context.Background(), &GCPConnectorConfig{
+		Audience: "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/prod",
+	})
+	assert.ErrorContains(t, err, "subject token file is required")
+}
+
+func TestImpersonate_Chain(t *testing.T) {
+	expiry := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var gotPath, gotAuth string
+	var gotReq generateAccessTokenRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotReq))
+		_ = json.NewEncoder(w).Encode(generateAccessTokenResponse{
+			AccessToken: "impersonated",
+			ExpireTime:  expiry.Format(time.RFC3339),
+		})
+	}))
+	defer srv.Close()
+
+	base := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "federated"})
+	chain := []string{
+		"hop@security.iam.gserviceaccount.com",
+		"hibernator@prod.iam.gserviceaccount.com",
+	}
+
+	tok, err := impersonate(context.Background(), base, chain, srv.URL).Token()
+	require.NoError(t, err)
+
+	assert.Equal(t, "impersonated", tok.AccessToken)
+	assert.True(t, expiry.Equal(tok.Expiry))
+	assert.Equal(t, "Bearer federated", gotAuth)
+	assert.Equal(t, "/projects/-/serviceAccounts/hibernator@prod.iam.gserviceaccount.com:generateAccessToken", gotPath)
+	assert.Equal(t, []string{"projects/-/serviceAccounts/hop@security.iam.gserviceaccount.com"}, gotReq.Delegates)
+	assert.Equal(t, []string{CloudPlatformScope}, gotReq.Scope)
+	assert.Equal(t, "3600s", gotReq.Lifetime)
+}
+
+func TestImpersonate_PermissionDenied(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":{"status":"PERMISSION_DENIED"}}`, http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	base := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "federated"})
+	_, err := impersonate(context.Background(), base, []string{"hibernator@prod.iam.gserviceaccount.com"}, srv.URL).Token()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "impersonate hibernator@prod.iam.gserviceaccount.com: 403 Forbidden")
+	assert.Contains(t, err.Error(), "PERMISSION_DENIED")
+}
@@ -0,0 +1,25 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package gcputil
+
+import "github.com/ardikabs/hibernator/pkg/netutil"
+
+// GCPConnectorConfig holds GCP connector settings.
+type GCPConnectorConfig struct {
+	ProjectID string
+
+	// Audience is the workload identity pool provider SubjectTokenFile is exchanged
+	// with. Application Default Credentials are used when it is empty.
+	Audience         string
+	SubjectTokenFile string
+
+	// ImpersonationChain lists service accounts impersonated in order. The last one
+	// is the identity used; the others are delegates.
+	ImpersonationChain []string
+
+	// Network is the proxy and CA bundle used to reach Google APIs.
+	Network *netutil.Config
+}
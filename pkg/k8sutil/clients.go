@@ -8,6 +8,7 @@ package k8sutil
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +18,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/ardikabs/hibernator/pkg/awsutil"
+	"github.com/ardikabs/hibernator/pkg/gcputil"
+	"github.com/ardikabs/hibernator/pkg/netutil"
 )
 
 // K8SConnectorConfig holds Kubernetes connector settings.
@@ -28,6 +31,10 @@ type K8SConnectorConfig struct {
 	ClusterCAData   []byte
 	UseEKSToken     bool
 	AWS             *awsutil.AWSConnectorConfig
+	GCP             *gcputil.GCPConnectorConfig
+
+	// Network is the proxy and CA bundle used to reach the API server.
+	Network *netutil.Config
 }
 
 // BuildClients builds Kubernetes dynamic and typed clients from the connector config.
@@ -36,7 +43,7 @@ func BuildClients(ctx context.Context, cfg *K8SConnectorConfig) (dynamic.Interfa
 		return nil, nil, fmt.Errorf("K8S connector config is required")
 	}
 
-	restConfig, err := buildRestConfig(ctx, cfg)
+	restConfig, err := BuildRESTConfig(ctx, cfg)
 	if err != nil {
 		return nil, nil, fmt.Errorf("build rest config: %w", err)
 	}
@@ -54,7 +61,9 @@ func BuildClients(ctx context.Context, cfg *K8SConnectorConfig) (dynamic.Interfa
 	return dynamicClient, k8sClient, nil
 }
 
-func buildRestConfig(ctx context.Context, cfg *K8SConnectorConfig) (*rest.Config, error) {
+// BuildRESTConfig builds the REST config for the cluster described by cfg. EKS
+// clusters authenticate with a token that is refreshed before it expires.
+func BuildRESTConfig(ctx context.Context, cfg *K8SConnectorConfig) (*rest.Config, error) {
 	restConfig, err := resolveRestConfig(cfg)
 	if err != nil {
 		return nil, err
@@ -68,9 +77,40 @@ func buildRestConfig(ctx context.Context, cfg *K8SConnectorConfig) (*rest.Config
 		wrapTokenTransport(restConfig, source)
 	}
 
+	if err := applyNetwork(restConfig, cfg.Network); err != nil {
+		return nil, err
+	}
+
 	return restConfig, nil
 }
 
+// applyNetwork routes restConfig through the proxy of network and adds its CA
+// bundle to the certificates trusted for the API server, which a TLS-intercepting
+// proxy re-signs.
+func applyNetwork(restConfig *rest.Config, network *netutil.Config) error {
+	if network == nil {
+		return nil
+	}
+	if network.ProxyURL != "" {
+		restConfig.Proxy = network.ProxyFunc()
+	}
+	if len(network.CABundle) == 0 {
+		return nil
+	}
+
+	caData := restConfig.CAData
+	if len(caData) == 0 && restConfig.CAFile != "" {
+		data, err := os.ReadFile(restConfig.CAFile)
+		if err != nil {
+			return fmt.Errorf("read CA file: %w", err)
+		}
+		caData = data
+	}
+	restConfig.CAFile = ""
+	restConfig.CAData = append(append(append([]byte(nil), caData...), '\n'), network.CABundle...)
+	return nil
+}
+
 func resolveRestConfig(cfg *K8SConnectorConfig) (*rest.Config, error) {
 	if len(cfg.Kubeconfig) > 0 {
 		restConfig, err := clientcmd.RESTConfigFromKubeConfig(cfg.Kubeconfig)
@@ -6,10 +6,14 @@ Licensed under the Apache License, Version 2.0.
 package k8sutil
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	"github.com/ardikabs/hibernator/pkg/netutil"
 )
 
 func TestObjectKeyFromString_ValidInput(t *testing.T) {
@@ -51,3 +55,29 @@ func TestObjectKeyFromString_EmptyName(t *testing.T) {
 	assert.Equal(t, "default", nn.Namespace)
 	assert.Equal(t, "", nn.Name)
 }
+
+func TestApplyNetwork_AppendsCABundleAndProxy(t *testing.T) {
+	restConfig := &rest.Config{
+		Host:            "https://ABC.gr7.us-east-1.eks.amazonaws.com",
+		TLSClientConfig: rest.TLSClientConfig{CAData: []byte("cluster-ca")},
+	}
+
+	err := applyNetwork(restConfig, &netutil.Config{ProxyURL: "http://proxy.corp.example:3128", CABundle: []byte("proxy-ca")})
+	require.NoError(t, err)
+
+	assert.Equal(t, "cluster-ca\nproxy-ca", string(restConfig.CAData))
+	require.NotNil(t, restConfig.Proxy)
+	req, err := http.NewRequest(http.MethodGet, restConfig.Host, nil)
+	require.NoError(t, err)
+	proxyURL, err := restConfig.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "proxy.corp.example:3128", proxyURL.Host)
+}
+
+func TestApplyNetwork_Nil(t *testing.T) {
+	restConfig := &rest.Config{TLSClientConfig: rest.TLSClientConfig{CAData: []byte("cluster-ca")}}
+
+	require.NoError(t, applyNetwork(restConfig, nil))
+	assert.Equal(t, "cluster-ca", string(restConfig.CAData))
+	assert.Nil(t, restConfig.Proxy)
+}
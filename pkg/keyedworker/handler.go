@@ -99,6 +99,71 @@ func RunnerFactory[K comparable, V any](
 	}
 }
 
+// BatchRunnerFactory is like RunnerFactory, but hands the handler every value
+// pending in the slot at once, in delivery order, instead of one at a time.
+// Consumers that can coalesce a burst of values into a single operation use it
+// to do one unit of work per burst, e.g. the status processor writing all
+// mutations queued for an object in one API call.
+//
+// The slot must be drained by Recv only, as with FIFOSlot; values that arrive
+// while the handler runs form the next batch.
+func BatchRunnerFactory[K comparable, V any](
+	idleTTL time.Duration,
+	handler func(ctx context.Context, values []V) error,
+	onErr func(error),
+) func(K, Slot[V]) func(context.Context) {
+	return func(key K, slot Slot[V]) func(context.Context) {
+		return func(ctx context.Context) {
+			idleTimer := time.NewTimer(idleTTL)
+			defer idleTimer.Stop()
+
+			handle := func() {
+				// Recv re-arms the signal while values remain, so a signal may
+				// outlive the values a previous batch already took.
+				if slot.Len() == 0 {
+					return
+				}
+				values := []V{slot.Recv()}
+				for slot.Len() > 0 {
+					values = append(values, slot.Recv())
+				}
+				if err := handleWithCrashRecovery(ctx, key, values, handler); err != nil && onErr != nil {
+					onErr(err)
+				}
+			}
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+
+				case <-slot.C():
+					if !idleTimer.Stop() {
+						select {
+						case <-idleTimer.C:
+						default:
+						}
+					}
+					idleTimer.Reset(idleTTL)
+					handle()
+
+				case <-idleTimer.C:
+					// Flush values that arrived while deciding to go idle, as
+					// RunnerFactory does.
+					for {
+						select {
+						case <-slot.C():
+							handle()
+						default:
+							return
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
 func handleWithCrashRecovery[K comparable, V any](
 	ctx context.Context,
 	key K,
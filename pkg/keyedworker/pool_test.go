@@ -112,6 +112,48 @@ func TestPool_Deliver_PerKeyFIFO_OrderPreserved(t *testing.T) {
 	}
 }
 
+func TestPool_BatchRunnerFactory_BatchesPendingValuesInOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	batches := make(chan []int, 16)
+	p := New(WithSlotFactory[string](FIFOSlot[int](64)))
+	p.Register(ctx, BatchRunnerFactory[string](testIdleTTL,
+		func(_ context.Context, values []int) error {
+			if values[0] == 0 {
+				close(started)
+				<-release // hold the worker so the next values queue up
+			}
+			batches <- values
+			return nil
+		},
+		nil,
+	))
+
+	p.Deliver("k", 0)
+	<-started
+	for i := 1; i <= 5; i++ {
+		p.Deliver("k", i)
+	}
+	close(release)
+
+	assert.Equal(t, []int{0}, <-batches)
+	select {
+	case got := <-batches:
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, got)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the second batch")
+	}
+
+	select {
+	case got := <-batches:
+		t.Fatalf("unexpected extra batch %v", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Pre-Start Deliver buffering
 // ---------------------------------------------------------------------------
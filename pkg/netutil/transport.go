@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package netutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Config describes how a connector's clients reach their APIs.
+type Config struct {
+	// ProxyURL is the proxy for HTTP and HTTPS requests. When empty, the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment of the process applies.
+	ProxyURL string
+	// NoProxy lists hosts, domains and CIDRs reached without the proxy.
+	NoProxy []string
+	// CABundle holds PEM certificates trusted in addition to the system roots.
+	CABundle []byte
+}
+
+// ProxyFunc returns the function selecting the proxy of each request.
+func (c *Config) ProxyFunc() func(*http.Request) (*url.URL, error) {
+	if c == nil || c.ProxyURL == "" {
+		return http.ProxyFromEnvironment
+	}
+
+	proxy := (&httpproxy.Config{
+		HTTPProxy:  c.ProxyURL,
+		HTTPSProxy: c.ProxyURL,
+		NoProxy:    strings.Join(c.NoProxy, ","),
+	}).ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+}
+
+// RootCAs returns the system roots extended with CABundle, or nil when there is
+// no bundle and the system roots apply unchanged.
+func (c *Config) RootCAs() (*x509.CertPool, error) {
+	if c == nil || len(c.CABundle) == 0 {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(c.CABundle) {
+		return nil, fmt.Errorf("CA bundle contains no PEM certificates")
+	}
+	return pool, nil
+}
+
+// ConfigureTransport makes t use the proxy and CA bundle of c.
+func (c *Config) ConfigureTransport(t *http.Transport) error {
+	t.Proxy = c.ProxyFunc()
+
+	pool, err := c.RootCAs()
+	if err != nil {
+		return err
+	}
+	if pool != nil {
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+	return nil
+}
+
+// NewHTTPClient returns an HTTP client using the proxy and CA bundle of cfg.
+func NewHTTPClient(cfg *Config) (*http.Client, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if err := cfg.ConfigureTransport(t); err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: t}, nil
+}
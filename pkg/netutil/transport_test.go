@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Ardika Saputro.
+Licensed under the Apache License, Version 2.0.
+*/
+
+package netutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCA returns a self-signed CA certificate in PEM form.
+func testCA(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Corp Egress CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestProxyFunc(t *testing.T) {
+	cfg := &Config{ProxyURL: "http://proxy.corp.example:3128", NoProxy: []string{".svc", "10.0.0.0/8"}}
+	proxy := cfg.ProxyFunc()
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://sts.us-east-1.amazonaws.com/", want: "http://proxy.corp.example:3128"},
+		{url: "http://ec2.us-east-1.amazonaws.com/", want: "http://proxy.corp.example:3128"},
+		{url: "https://kubernetes.default.svc/api", want: ""},
+		{url: "https://10.1.2.3:443/", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+
+			got, err := proxy(req)
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestRootCAs(t *testing.T) {
+	pool, err := (*Config)(nil).RootCAs()
+	require.NoError(t, err)
+	assert.Nil(t, pool, "no bundle keeps the system roots")
+
+	pool, err = (&Config{CABundle: testCA(t)}).RootCAs()
+	require.NoError(t, err)
+	assert.NotNil(t, pool)
+
+	_, err = (&Config{CABundle: []byte("not a certificate")}).RootCAs()
+	assert.ErrorContains(t, err, "no PEM certificates")
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	client, err := NewHTTPClient(&Config{ProxyURL: "http://proxy.corp.example:3128", CABundle: testCA(t)})
+	require.NoError(t, err)
+
+	transport, ok := client.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.NotNil(t, transport.TLSClientConfig)
+	assert.NotNil(t, transport.TLSClientConfig.RootCAs)
+
+	req, err := http.NewRequest(http.MethodGet, "https://iamcredentials.googleapis.com/", nil)
+	require.NoError(t, err)
+	proxyURL, err := transport.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "proxy.corp.example:3128", proxyURL.Host)
+}
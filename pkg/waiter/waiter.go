@@ -130,7 +130,7 @@ func (w *Waiter) Poll(description string, checkFunc CheckFunc) error {
 		case <-ctx.Done():
 			// Check if it's parent context cancellation or timeout
 			if errors.Is(ctx.Err(), context.DeadlineExceeded) && w.timeout > 0 {
-				return fmt.Errorf("timeout waiting for %s after %v", description, w.timeout)
+				return fmt.Errorf("timeout waiting for %s after %v: %w", description, w.timeout, ctx.Err())
 			}
 			w.log.Info("wait interrupted by context cancellation", "description", description)
 			return fmt.Errorf("wait for %s interrupted: %w", description, ctx.Err())
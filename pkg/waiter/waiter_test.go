@@ -175,6 +175,9 @@ func TestPoll_Timeout(t *testing.T) {
 	if err == nil {
 		t.Error("Poll() error = nil, want timeout error")
 	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Poll() error = %v, want context.DeadlineExceeded", err)
+	}
 	if duration > 500*time.Millisecond {
 		t.Errorf("Poll() duration = %v, want < 500ms", duration)
 	}
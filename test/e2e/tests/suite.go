@@ -102,7 +102,7 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 
 	By("registering validation webhooks")
-	err = validationwebhook.SetupWithManager(mgr, ctrl.Log.WithName("webhook"))
+	err = validationwebhook.SetupWithManager(mgr, ctrl.Log.WithName("webhook"), validationwebhook.Options{})
 	Expect(err).NotTo(HaveOccurred())
 
 	fakeClock = clocktesting.NewFakeClock(time.Now())